@@ -0,0 +1,131 @@
+// Команда banner-check-plugin - пример плагина проверок core-service. Плагин подключается
+// к TCP цели host:port, при необходимости отправляет строку send и проверяет, что баннер
+// сервиса соответствует регулярному выражению expect. Проверку собственного протокола,
+// например FIX, можно написать по этому образцу: реализовать сервис CheckerPlugin из
+// proto/api/plugin/v1 и вызвать plugin.Serve.
+//
+// Установка: собрать бинарь, указать его в checker_plugins конфигурации core-service и
+// создать проверку типа plugin с config.plugin=banner:
+//
+//	go build -o /opt/uptimeping/plugins/banner-check ./cmd/banner-check-plugin
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/plugin"
+	pluginv1 "UptimePingPlatform/proto/api/plugin/v1"
+)
+
+// name имя проверки, которое указывается в config.plugin
+const name = "banner"
+
+// maxBanner ограничивает размер прочитанного баннера
+const maxBanner = 4096
+
+// bannerConfig конфигурация проверки баннера
+type bannerConfig struct {
+	// Expect регулярное выражение, которому должен соответствовать баннер
+	Expect string `json:"expect"`
+	// Send строка, которая отправляется перед чтением баннера
+	Send string `json:"send"`
+}
+
+// checker проверяет баннеры TCP сервисов
+type checker struct{}
+
+func (c *checker) Describe(ctx context.Context, req *pluginv1.DescribeRequest) (*pluginv1.DescribeCheckerResponse, error) {
+	return &pluginv1.DescribeCheckerResponse{
+		Name:        name,
+		Version:     "1.0.0",
+		Description: "Matches the greeting banner of a TCP service",
+	}, nil
+}
+
+func (c *checker) ValidateConfig(ctx context.Context, req *pluginv1.ValidateConfigRequest) (*pluginv1.ValidateConfigResponse, error) {
+	if _, _, err := parseConfig(req.ConfigJson); err != nil {
+		return &pluginv1.ValidateConfigResponse{Error: err.Error()}, nil
+	}
+	return &pluginv1.ValidateConfigResponse{}, nil
+}
+
+func (c *checker) Check(ctx context.Context, req *pluginv1.CheckRequest) (*pluginv1.CheckResponse, error) {
+	cfg, expect, err := parseConfig(req.ConfigJson)
+	if err != nil {
+		return &pluginv1.CheckResponse{Error: err.Error()}, nil
+	}
+
+	start := time.Now()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", req.Target)
+	if err != nil {
+		return &pluginv1.CheckResponse{Error: err.Error(), DurationMs: time.Since(start).Milliseconds()}, nil
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if cfg.Send != "" {
+		if _, err := conn.Write([]byte(cfg.Send)); err != nil {
+			return &pluginv1.CheckResponse{Error: err.Error(), DurationMs: time.Since(start).Milliseconds()}, nil
+		}
+	}
+	banner, err := bufio.NewReaderSize(conn, maxBanner).ReadString('\n')
+	duration := time.Since(start).Milliseconds()
+	if banner == "" && err != nil {
+		return &pluginv1.CheckResponse{Error: fmt.Sprintf("failed to read banner: %v", err), DurationMs: duration}, nil
+	}
+
+	if !expect.MatchString(banner) {
+		return &pluginv1.CheckResponse{
+			Error:        fmt.Sprintf("banner does not match %q", cfg.Expect),
+			DurationMs:   duration,
+			ResponseBody: banner,
+		}, nil
+	}
+	return &pluginv1.CheckResponse{
+		Success:      true,
+		DurationMs:   duration,
+		ResponseBody: banner,
+		Metadata:     map[string]string{"remote_addr": conn.RemoteAddr().String()},
+	}, nil
+}
+
+// parseConfig разбирает конфигурацию проверки и компилирует expect
+func parseConfig(configJSON string) (*bannerConfig, *regexp.Regexp, error) {
+	var cfg bannerConfig
+	if err := json.Unmarshal([]byte(configJSON), &cfg); err != nil {
+		return nil, nil, fmt.Errorf("invalid config: %w", err)
+	}
+	if cfg.Expect == "" {
+		return nil, nil, fmt.Errorf("config.expect is required")
+	}
+	expect, err := regexp.Compile(cfg.Expect)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid config.expect: %w", err)
+	}
+	return &cfg, expect, nil
+}
+
+func main() {
+	err := plugin.Serve(plugin.ServeConfig{
+		ProtocolVersion: 1,
+		Register: func(server *grpc.Server) {
+			pluginv1.RegisterCheckerPluginServer(server, &checker{})
+		},
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/rabbitmq"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+// loadTag тег, которым помечаются синтетические проверки
+const loadTag = "loadgen"
+
+// options параметры прогона
+type options struct {
+	Tenant       string
+	Checks       int
+	Interval     time.Duration
+	Timeout      time.Duration
+	Duration     time.Duration
+	Concurrency  int
+	TargetURL    string
+	Queue        string
+	QueuePoll    time.Duration
+	ResultSample int
+	Cleanup      bool
+}
+
+// syntheticCheck созданная синтетическая проверка
+type syntheticCheck struct {
+	Key       string
+	ID        string
+	CreatedAt time.Time
+}
+
+// queueSample глубина очереди задач в момент опроса
+type queueSample struct {
+	At    time.Time
+	Ready int
+	Unack int
+}
+
+// runner выполняет прогон: создает проверки, наблюдает за выполнением и очередью,
+// считает сохраненные результаты и удаляет проверки
+type runner struct {
+	opts      options
+	scheduler schedulerv1.SchedulerServiceClient
+	// core и monitor необязательны: без них не считаются результаты и глубина очереди
+	core    corev1.CoreServiceClient
+	monitor *rabbitmq.QueueMonitor
+	runID   string
+}
+
+// createResult итог создания проверок
+type createResult struct {
+	Checks    []syntheticCheck
+	Latencies []time.Duration
+	Errors    int
+	LastError error
+	Elapsed   time.Duration
+}
+
+// createChecks создает проверки в Concurrency потоков
+func (r *runner) createChecks(ctx context.Context) createResult {
+	jobs := make(chan int)
+	var (
+		mu     sync.Mutex
+		result createResult
+		wg     sync.WaitGroup
+	)
+
+	start := time.Now()
+	for w := 0; w < r.opts.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				key := fmt.Sprintf("%s-%06d", r.runID, i)
+				begin := time.Now()
+				check, err := r.scheduler.CreateCheck(ctx, r.checkRequest(key))
+				latency := time.Since(begin)
+
+				mu.Lock()
+				if err != nil {
+					result.Errors++
+					result.LastError = err
+				} else {
+					result.Latencies = append(result.Latencies, latency)
+					result.Checks = append(result.Checks, syntheticCheck{Key: key, ID: check.Id, CreatedAt: time.Now()})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := 0; i < r.opts.Checks; i++ {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			i = r.opts.Checks
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	result.Elapsed = time.Since(start)
+	return result
+}
+
+// checkRequest запрос на создание синтетической HTTP проверки, указывающей на targetServer
+func (r *runner) checkRequest(key string) *schedulerv1.CreateCheckRequest {
+	return &schedulerv1.CreateCheckRequest{
+		TenantId:    r.opts.Tenant,
+		Name:        "loadgen " + key,
+		Description: "Synthetic check created by cmd/loadgen run " + r.runID,
+		Type:        "http",
+		Target:      r.opts.TargetURL + probePrefix + key,
+		Interval:    int32(r.opts.Interval / time.Second),
+		Timeout:     int32(r.opts.Timeout / time.Second),
+		Status:      "active",
+		Tags:        []string{loadTag, loadTag + ":" + r.runID},
+		Config:      map[string]string{"method": "GET"},
+	}
+}
+
+// watchQueue опрашивает глубину очереди задач до отмены контекста
+func (r *runner) watchQueue(ctx context.Context) []queueSample {
+	if r.monitor == nil {
+		return nil
+	}
+
+	var samples []queueSample
+	ticker := time.NewTicker(r.opts.QueuePoll)
+	defer ticker.Stop()
+	for {
+		if stats, err := r.monitor.Poll(ctx, r.opts.Queue); err == nil {
+			samples = append(samples, queueSample{At: time.Now(), Ready: stats.MessagesReady, Unack: stats.MessagesUnacknowledged})
+		}
+
+		select {
+		case <-ctx.Done():
+			return samples
+		case <-ticker.C:
+		}
+	}
+}
+
+// resultCount результаты, сохраненные core-service для выборки проверок
+type resultCount struct {
+	Sampled int
+	Results int
+	Errors  int
+}
+
+// countResults запрашивает историю у core-service для первых ResultSample проверок и считает
+// результаты, полученные в окне [from, to]
+func (r *runner) countResults(ctx context.Context, checks []syntheticCheck, from, to time.Time) resultCount {
+	var count resultCount
+	if r.core == nil {
+		return count
+	}
+
+	limit := int32(to.Sub(from)/r.opts.Interval) + 10
+	for _, check := range checks {
+		if count.Sampled >= r.opts.ResultSample {
+			break
+		}
+		count.Sampled++
+
+		resp, err := r.core.GetCheckHistory(ctx, &corev1.GetCheckHistoryRequest{
+			CheckId:   check.ID,
+			Limit:     limit,
+			StartTime: from.UTC().Format(time.RFC3339),
+			EndTime:   to.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			count.Errors++
+			continue
+		}
+		for _, result := range resp.Results {
+			checkedAt, err := time.Parse(time.RFC3339Nano, result.CheckedAt)
+			if err != nil || (!checkedAt.Before(from) && !checkedAt.After(to)) {
+				count.Results++
+			}
+		}
+	}
+	return count
+}
+
+// deleteChecks удаляет созданные проверки, возвращает число неудачных удалений
+func (r *runner) deleteChecks(ctx context.Context, checks []syntheticCheck) int {
+	jobs := make(chan string)
+	var (
+		mu     sync.Mutex
+		failed int
+		wg     sync.WaitGroup
+	)
+
+	for w := 0; w < r.opts.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				if _, err := r.scheduler.DeleteCheck(ctx, &schedulerv1.DeleteCheckRequest{CheckId: id}); err != nil {
+					mu.Lock()
+					failed++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, check := range checks {
+		jobs <- check.ID
+	}
+	close(jobs)
+	wg.Wait()
+	return failed
+}
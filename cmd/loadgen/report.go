@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// queueSummary глубина очереди задач за время прогона
+type queueSummary struct {
+	Samples    int     `json:"samples"`
+	MaxReady   int     `json:"max_ready"`
+	MeanReady  float64 `json:"mean_ready"`
+	FinalReady int     `json:"final_ready"`
+	MaxUnack   int     `json:"max_unacknowledged"`
+	// MaxLag оценка отставания: сколько времени нужно, чтобы разобрать максимальную очередь
+	// при ожидаемом темпе выполнения
+	MaxLag time.Duration `json:"max_lag"`
+}
+
+// resultSummary результаты, сохраненные core-service
+type resultSummary struct {
+	Sampled int `json:"sampled_checks"`
+	Stored  int `json:"stored_results"`
+	Errors  int `json:"errors"`
+	// Rate пересчитанный на все проверки темп сохранения результатов в секунду
+	Rate float64 `json:"rate_per_second"`
+}
+
+// report итог прогона
+type report struct {
+	RunID         string         `json:"run_id"`
+	Requested     int            `json:"requested_checks"`
+	Created       int            `json:"created_checks"`
+	CreateErrors  int            `json:"create_errors"`
+	CreateRate    float64        `json:"create_rate_per_second"`
+	CreateLatency latencySummary `json:"create_latency"`
+	Interval      time.Duration  `json:"interval"`
+	Window        time.Duration  `json:"window"`
+	ExpectedRate  float64        `json:"expected_executions_per_second"`
+	ExecutionRate float64        `json:"executions_per_second"`
+	Executed      int            `json:"executed"`
+	Expected      int            `json:"expected"`
+	Missed        int            `json:"missed"`
+	NeverRun      int            `json:"never_run"`
+	FirstRun      latencySummary `json:"first_run_latency"`
+	Lateness      latencySummary `json:"dispatch_lateness"`
+	Queue         queueSummary   `json:"queue"`
+	Results       resultSummary  `json:"results"`
+}
+
+// buildReport собирает итог прогона. window - время от начала создания проверок до конца наблюдения
+func buildReport(runID string, opts options, created createResult, dispatch dispatchStats, samples []queueSample, results resultCount, window time.Duration) report {
+	r := report{
+		RunID:         runID,
+		Requested:     opts.Checks,
+		Created:       len(created.Checks),
+		CreateErrors:  created.Errors,
+		CreateLatency: summarize(created.Latencies),
+		Interval:      opts.Interval,
+		Window:        window,
+		Executed:      dispatch.Executed,
+		Expected:      dispatch.Expected,
+		Missed:        dispatch.Missed(),
+		NeverRun:      dispatch.NeverRun,
+		FirstRun:      summarize(dispatch.FirstRun),
+		Lateness:      summarize(dispatch.Lateness),
+		Queue:         summarizeQueue(samples),
+		Results:       resultSummary{Sampled: results.Sampled, Stored: results.Results, Errors: results.Errors},
+	}
+
+	if created.Elapsed > 0 {
+		r.CreateRate = float64(r.Created) / created.Elapsed.Seconds()
+	}
+	if opts.Interval > 0 {
+		r.ExpectedRate = float64(r.Created) / opts.Interval.Seconds()
+	}
+	if window > 0 {
+		r.ExecutionRate = float64(r.Executed) / window.Seconds()
+		if results.Sampled > 0 {
+			perCheck := float64(results.Results) / float64(results.Sampled)
+			r.Results.Rate = perCheck * float64(r.Created) / window.Seconds()
+		}
+	}
+	if r.ExpectedRate > 0 {
+		r.Queue.MaxLag = time.Duration(float64(r.Queue.MaxReady) / r.ExpectedRate * float64(time.Second))
+	}
+	return r
+}
+
+// summarizeQueue агрегирует опросы глубины очереди
+func summarizeQueue(samples []queueSample) queueSummary {
+	summary := queueSummary{Samples: len(samples)}
+	if len(samples) == 0 {
+		return summary
+	}
+
+	total := 0
+	for _, sample := range samples {
+		total += sample.Ready
+		if sample.Ready > summary.MaxReady {
+			summary.MaxReady = sample.Ready
+		}
+		if sample.Unack > summary.MaxUnack {
+			summary.MaxUnack = sample.Unack
+		}
+	}
+	summary.MeanReady = float64(total) / float64(len(samples))
+	summary.FinalReady = samples[len(samples)-1].Ready
+	return summary
+}
+
+// Print выводит итог в читаемом виде
+func (r report) Print(w io.Writer) {
+	fmt.Fprintf(w, "Load run %s\n", r.RunID)
+	fmt.Fprintf(w, "Checks:\n")
+	fmt.Fprintf(w, "  created:            %d/%d (%d errors, %.1f/s)\n", r.Created, r.Requested, r.CreateErrors, r.CreateRate)
+	fmt.Fprintf(w, "  create latency:     %s\n", r.CreateLatency)
+	fmt.Fprintf(w, "Dispatch (interval %s, window %s):\n", r.Interval, r.Window.Round(time.Second))
+	fmt.Fprintf(w, "  executions:         %d of %d expected (%d missed, %d checks never ran)\n", r.Executed, r.Expected, r.Missed, r.NeverRun)
+	fmt.Fprintf(w, "  throughput:         %.1f/s (expected %.1f/s)\n", r.ExecutionRate, r.ExpectedRate)
+	fmt.Fprintf(w, "  first run after:    %s\n", r.FirstRun)
+	fmt.Fprintf(w, "  lateness:           %s\n", r.Lateness)
+
+	fmt.Fprintf(w, "Queue:\n")
+	if r.Queue.Samples == 0 {
+		fmt.Fprintf(w, "  not measured\n")
+	} else {
+		fmt.Fprintf(w, "  ready:              max %d, mean %.1f, final %d (%d samples)\n", r.Queue.MaxReady, r.Queue.MeanReady, r.Queue.FinalReady, r.Queue.Samples)
+		fmt.Fprintf(w, "  unacknowledged:     max %d\n", r.Queue.MaxUnack)
+		fmt.Fprintf(w, "  max lag:            %s\n", r.Queue.MaxLag.Round(time.Millisecond))
+	}
+
+	fmt.Fprintf(w, "Results:\n")
+	if r.Results.Sampled == 0 {
+		fmt.Fprintf(w, "  not measured\n")
+	} else {
+		fmt.Fprintf(w, "  stored:             %d for %d sampled checks (%d errors)\n", r.Results.Stored, r.Results.Sampled, r.Results.Errors)
+		fmt.Fprintf(w, "  throughput:         %.1f/s (extrapolated to all checks)\n", r.Results.Rate)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// latencySummary распределение задержек
+type latencySummary struct {
+	Count int           `json:"count"`
+	Min   time.Duration `json:"min"`
+	Mean  time.Duration `json:"mean"`
+	P50   time.Duration `json:"p50"`
+	P95   time.Duration `json:"p95"`
+	P99   time.Duration `json:"p99"`
+	Max   time.Duration `json:"max"`
+}
+
+// summarize считает перцентили по выборке. Исходный срез не изменяется
+func summarize(samples []time.Duration) latencySummary {
+	if len(samples) == 0 {
+		return latencySummary{}
+	}
+
+	sorted := append([]time.Duration(nil), samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, sample := range sorted {
+		total += sample
+	}
+
+	return latencySummary{
+		Count: len(sorted),
+		Min:   sorted[0],
+		Mean:  total / time.Duration(len(sorted)),
+		P50:   percentile(sorted, 50),
+		P95:   percentile(sorted, 95),
+		P99:   percentile(sorted, 99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// String краткая запись распределения задержек
+func (s latencySummary) String() string {
+	if s.Count == 0 {
+		return "no samples"
+	}
+	return fmt.Sprintf("p50 %s, p95 %s, p99 %s, max %s (n=%d)",
+		s.P50.Round(time.Millisecond), s.P95.Round(time.Millisecond), s.P99.Round(time.Millisecond), s.Max.Round(time.Millisecond), s.Count)
+}
+
+// percentile значение перцентиля p по отсортированной выборке (nearest-rank)
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// dispatchStats задержки выполнения синтетических проверок относительно расписания
+type dispatchStats struct {
+	// FirstRun время от создания проверки до первого выполнения
+	FirstRun []time.Duration
+	// Lateness опоздание повторных выполнений относительно интервала
+	Lateness []time.Duration
+	Executed int
+	Expected int
+	// NeverRun проверки, не выполненные ни разу за время прогона
+	NeverRun int
+}
+
+// Missed число ожидаемых, но не состоявшихся выполнений
+func (s dispatchStats) Missed() int {
+	if s.Expected > s.Executed {
+		return s.Expected - s.Executed
+	}
+	return 0
+}
+
+// computeDispatch сопоставляет обращения к цели с расписанием проверок. Ожидаемое число
+// выполнений считается от первого выполнения до конца окна наблюдения
+func computeDispatch(checks []syntheticCheck, hits map[string][]time.Time, interval time.Duration, end time.Time) dispatchStats {
+	var stats dispatchStats
+	for _, check := range checks {
+		times := append([]time.Time(nil), hits[check.Key]...)
+		if len(times) == 0 {
+			stats.NeverRun++
+			// Проверка должна была выполниться хотя бы раз, если прошел полный интервал
+			if end.Sub(check.CreatedAt) >= interval {
+				stats.Expected++
+			}
+			continue
+		}
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+		stats.Executed += len(times)
+		stats.Expected += int(end.Sub(times[0])/interval) + 1
+		stats.FirstRun = append(stats.FirstRun, nonNegative(times[0].Sub(check.CreatedAt)))
+		for i := 1; i < len(times); i++ {
+			stats.Lateness = append(stats.Lateness, nonNegative(times[i].Sub(times[i-1])-interval))
+		}
+	}
+	return stats
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
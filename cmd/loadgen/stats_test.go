@@ -0,0 +1,93 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	var samples []time.Duration
+	for i := 100; i >= 1; i-- {
+		samples = append(samples, time.Duration(i)*time.Millisecond)
+	}
+
+	summary := summarize(samples)
+	if summary.Count != 100 || summary.Min != time.Millisecond || summary.Max != 100*time.Millisecond {
+		t.Fatalf("unexpected bounds: %+v", summary)
+	}
+	if summary.P50 != 50*time.Millisecond || summary.P95 != 95*time.Millisecond || summary.P99 != 99*time.Millisecond {
+		t.Errorf("unexpected percentiles: %+v", summary)
+	}
+	if summary.Mean != 50500*time.Microsecond {
+		t.Errorf("expected mean 50.5ms, got %s", summary.Mean)
+	}
+	if samples[0] != 100*time.Millisecond {
+		t.Error("summarize must not reorder the input")
+	}
+
+	if empty := summarize(nil); empty.Count != 0 || empty.String() != "no samples" {
+		t.Errorf("unexpected empty summary: %+v", empty)
+	}
+}
+
+func TestComputeDispatch(t *testing.T) {
+	created := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	interval := 10 * time.Second
+	end := created.Add(45 * time.Second)
+
+	checks := []syntheticCheck{
+		{Key: "on-time", CreatedAt: created},
+		{Key: "late", CreatedAt: created},
+		{Key: "never", CreatedAt: created},
+	}
+	hits := map[string][]time.Time{
+		// 5s до первого запуска, далее точно по интервалу: ожидается 5 выполнений, все состоялись
+		"on-time": {created.Add(5 * time.Second), created.Add(15 * time.Second), created.Add(25 * time.Second), created.Add(35 * time.Second), created.Add(45 * time.Second)},
+		// Опоздания на 2s и 8s, два выполнения из четырех ожидаемых
+		"late": {created.Add(12 * time.Second), created.Add(24 * time.Second)},
+	}
+
+	stats := computeDispatch(checks, hits, interval, end)
+
+	if stats.Executed != 7 {
+		t.Errorf("expected 7 executions, got %d", stats.Executed)
+	}
+	// on-time: 5, late: (45-12)/10+1 = 4, never: 1
+	if stats.Expected != 10 || stats.Missed() != 3 {
+		t.Errorf("expected 10 expected and 3 missed, got %d and %d", stats.Expected, stats.Missed())
+	}
+	if stats.NeverRun != 1 {
+		t.Errorf("expected 1 check that never ran, got %d", stats.NeverRun)
+	}
+	if len(stats.FirstRun) != 2 || stats.FirstRun[1] != 12*time.Second {
+		t.Errorf("unexpected first run latencies: %v", stats.FirstRun)
+	}
+
+	lateness := summarize(stats.Lateness)
+	if lateness.Count != 5 || lateness.Max != 2*time.Second {
+		t.Errorf("unexpected lateness: %+v", lateness)
+	}
+}
+
+func TestBuildReport(t *testing.T) {
+	opts := options{Checks: 100, Interval: 10 * time.Second, ResultSample: 10}
+	created := createResult{Checks: make([]syntheticCheck, 100), Elapsed: 2 * time.Second}
+	samples := []queueSample{{Ready: 10}, {Ready: 50, Unack: 5}, {Ready: 0}}
+	results := resultCount{Sampled: 10, Results: 60}
+
+	rep := buildReport("run", opts, created, dispatchStats{Executed: 500}, samples, results, 60*time.Second)
+
+	if rep.CreateRate != 50 {
+		t.Errorf("expected create rate 50/s, got %.1f", rep.CreateRate)
+	}
+	if rep.ExpectedRate != 10 || rep.ExecutionRate < 8.3 || rep.ExecutionRate > 8.4 {
+		t.Errorf("unexpected rates: expected %.1f, actual %.2f", rep.ExpectedRate, rep.ExecutionRate)
+	}
+	if rep.Queue.MaxReady != 50 || rep.Queue.FinalReady != 0 || rep.Queue.MaxUnack != 5 || rep.Queue.MaxLag != 5*time.Second {
+		t.Errorf("unexpected queue summary: %+v", rep.Queue)
+	}
+	// 6 результатов на проверку за минуту, 100 проверок
+	if rep.Results.Rate != 10 {
+		t.Errorf("expected result rate 10/s, got %.2f", rep.Results.Rate)
+	}
+}
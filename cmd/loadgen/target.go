@@ -0,0 +1,67 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// probePrefix путь, по которому синтетические проверки обращаются к цели
+const probePrefix = "/probe/"
+
+// targetServer HTTP цель синтетических проверок: отвечает с заданной задержкой и запоминает
+// время каждого обращения по ключу проверки. Так нагрузка не уходит на внешние сайты, а
+// моменты фактического выполнения проверок видны без доступа к базе core-service
+type targetServer struct {
+	delay time.Duration
+	now   func() time.Time
+
+	mu   sync.Mutex
+	hits map[string][]time.Time
+}
+
+// newTargetServer создает цель, отвечающую с задержкой delay
+func newTargetServer(delay time.Duration) *targetServer {
+	return &targetServer{
+		delay: delay,
+		now:   time.Now,
+		hits:  make(map[string][]time.Time),
+	}
+}
+
+// ServeHTTP фиксирует обращение и отвечает 200 после задержки
+func (s *targetServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, probePrefix)
+	if key == "" || key == r.URL.Path {
+		http.NotFound(w, r)
+		return
+	}
+
+	at := s.now()
+	s.mu.Lock()
+	s.hits[key] = append(s.hits[key], at)
+	s.mu.Unlock()
+
+	if s.delay > 0 {
+		select {
+		case <-time.After(s.delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.Write([]byte("ok"))
+}
+
+// Hits возвращает копию времен обращений по ключам проверок
+func (s *targetServer) Hits() map[string][]time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hits := make(map[string][]time.Time, len(s.hits))
+	for key, times := range s.hits {
+		hits[key] = append([]time.Time(nil), times...)
+	}
+	return hits
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTargetServerRecordsHits(t *testing.T) {
+	target := newTargetServer(0)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	target.now = func() time.Time { return now }
+
+	for _, path := range []string{"/probe/run-000001", "/probe/run-000001", "/probe/run-000002"} {
+		recorder := httptest.NewRecorder()
+		target.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("expected 200 for %s, got %d", path, recorder.Code)
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	target.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/other", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("expected 404 outside of probe path, got %d", recorder.Code)
+	}
+
+	hits := target.Hits()
+	if len(hits["run-000001"]) != 2 || len(hits["run-000002"]) != 1 || len(hits) != 2 {
+		t.Errorf("unexpected hits: %v", hits)
+	}
+	if !hits["run-000002"][0].Equal(now) {
+		t.Errorf("expected hit time %s, got %s", now, hits["run-000002"][0])
+	}
+}
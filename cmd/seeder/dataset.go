@@ -0,0 +1,489 @@
+package main
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// tenantRecord демо тенант
+type tenantRecord struct {
+	ID       string
+	Name     string
+	Slug     string
+	Plan     string
+	Settings map[string]interface{}
+}
+
+// userRecord пользователь демо тенанта
+type userRecord struct {
+	ID        string
+	Email     string
+	FirstName string
+	LastName  string
+	IsAdmin   bool
+}
+
+// channelRecord канал уведомлений
+type channelRecord struct {
+	ID     string
+	Name   string
+	Type   string
+	Config map[string]string
+}
+
+// checkRecord проверка с профилем поведения для генерации истории
+type checkRecord struct {
+	ID                string
+	Name              string
+	Description       string
+	Type              string
+	Target            string
+	IntervalSeconds   int
+	TimeoutSeconds    int
+	Enabled           bool
+	Config            map[string]interface{}
+	Owner             string
+	Team              string
+	RunbookURL        string
+	EscalationContact string
+	Tags              []string
+
+	// baseLatency типичное время ответа в миллисекундах
+	baseLatency float64
+	// jitter разброс времени ответа в миллисекундах
+	jitter float64
+	// flakiness вероятность единичного сбоя вне окон недоступности
+	flakiness float64
+	outages   []outage
+}
+
+// outage окно недоступности проверки, отсчитываемое от момента генерации
+type outage struct {
+	// Ago начало окна относительно текущего момента
+	Ago time.Duration
+	// Duration длительность; окно, доходящее до текущего момента, дает открытый инцидент
+	Duration time.Duration
+	Severity string
+	// Acknowledged инцидент по окну подтвержден дежурным
+	Acknowledged bool
+	Error        string
+}
+
+// resultRecord результат выполнения проверки
+type resultRecord struct {
+	ID             string
+	CheckID        string
+	Status         string
+	ResponseTimeMs float64
+	StatusCode     int
+	ErrorMessage   string
+	CreatedAt      time.Time
+}
+
+// incidentRecord инцидент по окну недоступности
+type incidentRecord struct {
+	ID          string
+	CheckID     string
+	Title       string
+	Description string
+	Status      string
+	Severity    string
+	StartedAt   time.Time
+	ResolvedAt  *time.Time
+	Events      []incidentEventRecord
+}
+
+// incidentEventRecord событие в хронологии инцидента
+type incidentEventRecord struct {
+	ID          string
+	EventType   string
+	Description string
+	CreatedAt   time.Time
+}
+
+// dataset полный набор демо данных
+type dataset struct {
+	Tenant    tenantRecord
+	Users     []userRecord
+	Channels  []channelRecord
+	Checks    []checkRecord
+	Results   []resultRecord
+	Incidents []incidentRecord
+}
+
+// datasetOptions параметры генерации
+type datasetOptions struct {
+	Slug string
+	Now  time.Time
+	// History глубина синтетической истории результатов
+	History time.Duration
+	// Step шаг между результатами; не меньше интервала проверки
+	Step time.Duration
+	Seed int64
+}
+
+// stableID возвращает UUID, детерминированно выведенный из частей имени, чтобы повторный
+// запуск сидера создавал записи с теми же идентификаторами
+func stableID(parts ...string) string {
+	hash := sha1.New()
+	hash.Write([]byte("uptimeping-seed"))
+	for _, part := range parts {
+		hash.Write([]byte{0})
+		hash.Write([]byte(part))
+	}
+	sum := hash.Sum(nil)
+	// UUID версии 5 (RFC 4122)
+	sum[6] = (sum[6] & 0x0f) | 0x50
+	sum[8] = (sum[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// buildDataset генерирует демо тенант, пользователей, каналы, проверки, историю результатов
+// и инциденты. Результат полностью определяется параметрами
+func buildDataset(opts datasetOptions) *dataset {
+	now := opts.Now.UTC().Truncate(time.Second)
+	slug := opts.Slug
+	domain := slug + ".uptimeping.local"
+
+	ds := &dataset{
+		Tenant: tenantRecord{
+			ID:   stableID(slug, "tenant"),
+			Name: "Demo Company",
+			Slug: slug,
+			Plan: "pro",
+			Settings: map[string]interface{}{
+				"timezone": "Europe/Berlin",
+				"demo":     true,
+			},
+		},
+	}
+
+	for _, user := range []struct {
+		name, first, last string
+		admin             bool
+	}{
+		{"admin", "Alice", "Admin", true},
+		{"oncall", "Oliver", "Oncall", false},
+		{"viewer", "Victoria", "Viewer", false},
+	} {
+		ds.Users = append(ds.Users, userRecord{
+			ID:        stableID(slug, "user", user.name),
+			Email:     user.name + "@" + domain,
+			FirstName: user.first,
+			LastName:  user.last,
+			IsAdmin:   user.admin,
+		})
+	}
+
+	ds.Channels = []channelRecord{
+		{
+			ID:     stableID(slug, "channel", "email"),
+			Name:   "On-call email",
+			Type:   "email",
+			Config: map[string]string{"to": "oncall@" + domain},
+		},
+		{
+			ID:     stableID(slug, "channel", "slack"),
+			Name:   "Slack #incidents",
+			Type:   "slack",
+			Config: map[string]string{"webhook_url": "https://hooks.slack.com/services/DEMO/DEMO/DEMO", "channel": "#incidents"},
+		},
+		{
+			ID:     stableID(slug, "channel", "webhook"),
+			Name:   "Status page webhook",
+			Type:   "webhook",
+			Config: map[string]string{"url": "http://localhost:8080/api/v1/demo/webhook"},
+		},
+	}
+
+	ds.Checks = demoChecks(slug)
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	for i := range ds.Checks {
+		check := &ds.Checks[i]
+		if check.Enabled {
+			ds.Results = append(ds.Results, generateResults(rng, check, now, opts.History, opts.Step)...)
+		}
+		for j, window := range check.outages {
+			if window.Ago > opts.History {
+				continue
+			}
+			ds.Incidents = append(ds.Incidents, buildIncident(slug, check, j, window, now))
+		}
+	}
+
+	return ds
+}
+
+// demoChecks набор проверок разных типов с заранее заданными сбоями
+func demoChecks(slug string) []checkRecord {
+	checks := []checkRecord{
+		{
+			Name:            "Marketing site",
+			Description:     "Public landing page",
+			Type:            "https",
+			Target:          "https://www.example.com",
+			IntervalSeconds: 60,
+			TimeoutSeconds:  10,
+			Enabled:         true,
+			Config:          map[string]interface{}{"method": "GET", "url": "https://www.example.com", "expected_status": 200, "follow_redirects": true},
+			Team:            "web",
+			Tags:            []string{"web", "public"},
+			baseLatency:     180,
+			jitter:          60,
+			flakiness:       0.002,
+		},
+		{
+			Name:              "Public API health",
+			Description:       "Health endpoint of the public REST API",
+			Type:              "https",
+			Target:            "https://api.example.com/health",
+			IntervalSeconds:   30,
+			TimeoutSeconds:    5,
+			Enabled:           true,
+			Config:            map[string]interface{}{"method": "GET", "url": "https://api.example.com/health", "expected_status": 200},
+			Owner:             "Alice Admin",
+			Team:              "platform",
+			RunbookURL:        "https://runbooks.example.com/api-health",
+			EscalationContact: "platform-oncall@example.com",
+			Tags:              []string{"api", "critical"},
+			baseLatency:       90,
+			jitter:            30,
+			flakiness:         0.001,
+			outages: []outage{
+				{Ago: 50 * time.Hour, Duration: 25 * time.Minute, Severity: "critical", Error: "HTTP 503 Service Unavailable"},
+			},
+		},
+		{
+			Name:              "Checkout service",
+			Description:       "Checkout flow backend",
+			Type:              "https",
+			Target:            "https://shop.example.com/api/checkout/health",
+			IntervalSeconds:   60,
+			TimeoutSeconds:    10,
+			Enabled:           true,
+			Config:            map[string]interface{}{"method": "GET", "url": "https://shop.example.com/api/checkout/health", "expected_status": 200},
+			Owner:             "Oliver Oncall",
+			Team:              "payments",
+			RunbookURL:        "https://runbooks.example.com/checkout",
+			EscalationContact: "payments-oncall@example.com",
+			Tags:              []string{"payments", "critical"},
+			baseLatency:       240,
+			jitter:            90,
+			flakiness:         0.004,
+			outages: []outage{
+				{Ago: 6 * 24 * time.Hour, Duration: 12 * time.Minute, Severity: "error", Error: "HTTP 502 Bad Gateway"},
+				{Ago: 12 * time.Minute, Duration: 12 * time.Minute, Severity: "critical", Error: "HTTP 500 Internal Server Error"},
+			},
+		},
+		{
+			Name:            "Auth gRPC",
+			Description:     "Authentication service gRPC health",
+			Type:            "grpc",
+			Target:          "auth.example.com:443",
+			IntervalSeconds: 60,
+			TimeoutSeconds:  5,
+			Enabled:         true,
+			Config:          map[string]interface{}{"service": "grpc.health.v1.Health", "method": "Check", "host": "auth.example.com", "port": 443},
+			Team:            "identity",
+			Tags:            []string{"grpc", "internal"},
+			baseLatency:     35,
+			jitter:          15,
+			flakiness:       0.001,
+		},
+		{
+			Name:            "Catalog GraphQL",
+			Description:     "Product catalog GraphQL endpoint",
+			Type:            "graphql",
+			Target:          "https://shop.example.com/graphql",
+			IntervalSeconds: 120,
+			TimeoutSeconds:  10,
+			Enabled:         true,
+			Config:          map[string]interface{}{"url": "https://shop.example.com/graphql", "query": "{ __typename }"},
+			Team:            "catalog",
+			Tags:            []string{"graphql", "shop"},
+			baseLatency:     320,
+			jitter:          140,
+			flakiness:       0.01,
+			outages: []outage{
+				{Ago: 40 * time.Minute, Duration: 40 * time.Minute, Severity: "warning", Acknowledged: true, Error: "request timed out after 10s"},
+			},
+		},
+		{
+			Name:            "Postgres primary",
+			Description:     "TCP reachability of the primary database",
+			Type:            "tcp",
+			Target:          "db.example.internal:5432",
+			IntervalSeconds: 30,
+			TimeoutSeconds:  3,
+			Enabled:         true,
+			Config:          map[string]interface{}{"host": "db.example.internal", "port": 5432},
+			Team:            "platform",
+			Tags:            []string{"database", "internal"},
+			baseLatency:     4,
+			jitter:          2,
+		},
+		{
+			Name:            "Redis cache",
+			Description:     "TCP reachability of the cache cluster",
+			Type:            "tcp",
+			Target:          "cache.example.internal:6379",
+			IntervalSeconds: 30,
+			TimeoutSeconds:  3,
+			Enabled:         true,
+			Config:          map[string]interface{}{"host": "cache.example.internal", "port": 6379},
+			Team:            "platform",
+			Tags:            []string{"cache", "internal"},
+			baseLatency:     2,
+			jitter:          1,
+			outages: []outage{
+				{Ago: 5*24*time.Hour + 3*time.Hour, Duration: 8 * time.Minute, Severity: "error", Error: "dial tcp: connection refused"},
+			},
+		},
+		{
+			Name:            "Legacy billing",
+			Description:     "Decommissioned billing portal, kept for reference",
+			Type:            "http",
+			Target:          "http://billing.example.com",
+			IntervalSeconds: 300,
+			TimeoutSeconds:  30,
+			Enabled:         false,
+			Config:          map[string]interface{}{"method": "GET", "url": "http://billing.example.com", "expected_status": 200},
+			Team:            "finance",
+			Tags:            []string{"legacy"},
+		},
+	}
+
+	for i := range checks {
+		checks[i].ID = stableID(slug, "check", checks[i].Name)
+	}
+	return checks
+}
+
+// generateResults строит историю результатов проверки: задержка с суточной волной и шумом,
+// сбои внутри окон недоступности и редкие единичные сбои
+func generateResults(rng *rand.Rand, check *checkRecord, now time.Time, history, step time.Duration) []resultRecord {
+	interval := time.Duration(check.IntervalSeconds) * time.Second
+	if step < interval {
+		step = interval
+	}
+	start := now.Add(-history).Truncate(step)
+
+	results := make([]resultRecord, 0, int(history/step)+1)
+	for at := start; !at.After(now); at = at.Add(step) {
+		result := resultRecord{
+			ID:        stableID(check.ID, "result", at.Format(time.RFC3339)),
+			CheckID:   check.ID,
+			CreatedAt: at,
+		}
+
+		window, inOutage := check.outageAt(now, at)
+		switch {
+		case inOutage:
+			result.Status = "down"
+			result.ErrorMessage = window.Error
+			result.ResponseTimeMs = float64(check.TimeoutSeconds) * 1000
+			result.StatusCode = statusCodeFor(check.Type, window.Error)
+		case rng.Float64() < check.flakiness:
+			result.Status = "down"
+			result.ErrorMessage = "request timed out"
+			result.ResponseTimeMs = float64(check.TimeoutSeconds) * 1000
+		default:
+			result.Status = "up"
+			result.ResponseTimeMs = latency(rng, check, at)
+			if isHTTPType(check.Type) {
+				result.StatusCode = 200
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// outageAt возвращает окно недоступности, в которое попадает момент at
+func (c *checkRecord) outageAt(now, at time.Time) (outage, bool) {
+	for _, window := range c.outages {
+		begin := now.Add(-window.Ago)
+		end := begin.Add(window.Duration)
+		// Окно, доходящее до текущего момента, еще не завершено
+		if !at.Before(begin) && (at.Before(end) || !end.Before(now)) {
+			return window, true
+		}
+	}
+	return outage{}, false
+}
+
+// latency время ответа: базовое значение с пиком нагрузки днем и случайным шумом
+func latency(rng *rand.Rand, check *checkRecord, at time.Time) float64 {
+	hour := float64(at.Hour()) + float64(at.Minute())/60
+	diurnal := 1 + 0.35*math.Sin((hour-8)/24*2*math.Pi)
+	value := check.baseLatency*diurnal + rng.NormFloat64()*check.jitter/3
+	if value < 1 {
+		value = 1
+	}
+	return math.Round(value*1000) / 1000
+}
+
+// isHTTPType проверяет, возвращает ли проверка HTTP код ответа
+func isHTTPType(checkType string) bool {
+	return checkType == "http" || checkType == "https" || checkType == "graphql"
+}
+
+// statusCodeFor извлекает HTTP код из текста ошибки для HTTP проверок
+func statusCodeFor(checkType, message string) int {
+	if !isHTTPType(checkType) {
+		return 0
+	}
+	var code int
+	if _, err := fmt.Sscanf(message, "HTTP %d", &code); err == nil {
+		return code
+	}
+	return 0
+}
+
+// buildIncident создает инцидент и его хронологию по окну недоступности
+func buildIncident(slug string, check *checkRecord, index int, window outage, now time.Time) incidentRecord {
+	id := stableID(slug, "incident", check.ID, fmt.Sprint(index))
+	startedAt := now.Add(-window.Ago)
+	incident := incidentRecord{
+		ID:          id,
+		CheckID:     check.ID,
+		Title:       check.Name + " is down",
+		Description: window.Error,
+		Status:      "open",
+		Severity:    window.Severity,
+		StartedAt:   startedAt,
+		Events: []incidentEventRecord{{
+			ID:          stableID(id, "opened"),
+			EventType:   "opened",
+			Description: "Check " + check.Name + " failed: " + window.Error,
+			CreatedAt:   startedAt,
+		}},
+	}
+
+	if window.Acknowledged {
+		incident.Status = "acknowledged"
+		incident.Events = append(incident.Events, incidentEventRecord{
+			ID:          stableID(id, "acknowledged"),
+			EventType:   "acknowledged",
+			Description: "Acknowledged by oncall@" + slug + ".uptimeping.local",
+			CreatedAt:   startedAt.Add(4 * time.Minute),
+		})
+	}
+
+	endedAt := startedAt.Add(window.Duration)
+	if endedAt.Before(now) {
+		incident.Status = "resolved"
+		incident.ResolvedAt = &endedAt
+		incident.Events = append(incident.Events, incidentEventRecord{
+			ID:          stableID(id, "resolved"),
+			EventType:   "resolved",
+			Description: "Check " + check.Name + " recovered",
+			CreatedAt:   endedAt,
+		})
+	}
+	return incident
+}
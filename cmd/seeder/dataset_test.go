@@ -0,0 +1,133 @@
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func testOptions() datasetOptions {
+	return datasetOptions{
+		Slug:    "demo",
+		Now:     time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC),
+		History: 7 * 24 * time.Hour,
+		Step:    5 * time.Minute,
+		Seed:    1,
+	}
+}
+
+func TestStableID(t *testing.T) {
+	id := stableID("demo", "tenant")
+	if !uuidPattern.MatchString(id) {
+		t.Fatalf("stableID() = %q, want a version 5 UUID", id)
+	}
+	if stableID("demo", "tenant") != id {
+		t.Fatal("stableID() is not deterministic")
+	}
+	if stableID("demo", "ten", "ant") == id {
+		t.Fatal("stableID() must separate parts")
+	}
+}
+
+func TestBuildDatasetIsDeterministic(t *testing.T) {
+	first := buildDataset(testOptions())
+	second := buildDataset(testOptions())
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("buildDataset() returned different data for the same options")
+	}
+
+	other := testOptions()
+	other.Slug = "sales"
+	if buildDataset(other).Tenant.ID == first.Tenant.ID {
+		t.Fatal("different slugs must produce different tenant ids")
+	}
+}
+
+func TestBuildDatasetResults(t *testing.T) {
+	opts := testOptions()
+	ds := buildDataset(opts)
+
+	ids := make(map[string]bool)
+	perCheck := make(map[string]int)
+	for _, result := range ds.Results {
+		if ids[result.ID] {
+			t.Fatalf("duplicate result id %s", result.ID)
+		}
+		ids[result.ID] = true
+		perCheck[result.CheckID]++
+
+		if result.CreatedAt.After(opts.Now) || result.CreatedAt.Before(opts.Now.Add(-opts.History-opts.Step)) {
+			t.Fatalf("result %s created at %s is outside of the history window", result.ID, result.CreatedAt)
+		}
+		if result.Status == "down" && result.ErrorMessage == "" {
+			t.Fatalf("failed result %s has no error message", result.ID)
+		}
+	}
+
+	want := int(opts.History/opts.Step) + 1
+	for _, check := range ds.Checks {
+		if !check.Enabled {
+			if perCheck[check.ID] != 0 {
+				t.Fatalf("disabled check %s has results", check.Name)
+			}
+			continue
+		}
+		if perCheck[check.ID] != want {
+			t.Fatalf("check %s has %d results, want %d", check.Name, perCheck[check.ID], want)
+		}
+	}
+}
+
+func TestBuildDatasetIncidentsMatchOutages(t *testing.T) {
+	opts := testOptions()
+	ds := buildDataset(opts)
+
+	statuses := make(map[string]int)
+	for _, incident := range ds.Incidents {
+		statuses[incident.Status]++
+		if incident.Status == "resolved" {
+			if incident.ResolvedAt == nil || !incident.ResolvedAt.After(incident.StartedAt) {
+				t.Fatalf("resolved incident %s has invalid resolved_at", incident.Title)
+			}
+		} else if incident.ResolvedAt != nil {
+			t.Fatalf("active incident %s must not have resolved_at", incident.Title)
+		}
+	}
+	if statuses["open"] != 1 || statuses["acknowledged"] != 1 || statuses["resolved"] != 3 {
+		t.Fatalf("unexpected incident statuses: %v", statuses)
+	}
+
+	// Последний результат проверки с открытым инцидентом - сбой
+	for _, incident := range ds.Incidents {
+		if incident.Status != "open" {
+			continue
+		}
+		var last resultRecord
+		for _, result := range ds.Results {
+			if result.CheckID == incident.CheckID && result.CreatedAt.After(last.CreatedAt) {
+				last = result
+			}
+		}
+		if last.Status != "down" || last.StatusCode != 500 {
+			t.Fatalf("latest result of %s = %s/%d, want down/500", incident.Title, last.Status, last.StatusCode)
+		}
+	}
+}
+
+func TestBuildDatasetRespectsHistory(t *testing.T) {
+	opts := testOptions()
+	opts.History = 24 * time.Hour
+	ds := buildDataset(opts)
+
+	for _, incident := range ds.Incidents {
+		if incident.StartedAt.Before(opts.Now.Add(-opts.History)) {
+			t.Fatalf("incident %s started before the history window", incident.Title)
+		}
+	}
+	if len(ds.Incidents) != 2 {
+		t.Fatalf("got %d incidents, want 2 active ones", len(ds.Incidents))
+	}
+}
@@ -0,0 +1,101 @@
+// Команда seeder наполняет локальную базу платформы демо данными: тенант, пользователи,
+// каналы уведомлений, проверки разных типов, синтетическая история результатов и инциденты.
+//
+// Подключение к PostgreSQL настраивается переменными DB_HOST, DB_PORT, DB_USER, DB_PASSWORD,
+// DB_NAME или DATABASE_URL. Запуск против docker-compose окружения: make seed
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"UptimePingPlatform/pkg/database"
+)
+
+func main() {
+	var (
+		slug     = flag.String("tenant", "demo", "slug демо тенанта")
+		password = flag.String("password", "DemoPassword1", "пароль демо пользователей")
+		history  = flag.Duration("history", 7*24*time.Hour, "глубина синтетической истории результатов")
+		step     = flag.Duration("step", 5*time.Minute, "шаг между синтетическими результатами")
+		seed     = flag.Int64("seed", 1, "зерно генератора случайных чисел")
+		clean    = flag.Bool("clean", false, "удалить демо тенант без повторного наполнения")
+	)
+	flag.Parse()
+
+	if *slug == "" {
+		log.Fatal("tenant slug is required")
+	}
+	if *history <= 0 || *step <= 0 {
+		log.Fatal("history and step must be positive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	config := database.GetConfig()
+	config.MinConns = 1
+	postgres, err := database.Connect(ctx, config)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer postgres.Close()
+
+	s := newSeeder(postgres.Pool)
+
+	if *clean {
+		removed, err := s.Clean(ctx, *slug)
+		if err != nil {
+			log.Fatalf("Failed to remove demo tenant: %v", err)
+		}
+		if removed {
+			fmt.Printf("Demo tenant %q removed\n", *slug)
+		} else {
+			fmt.Printf("Demo tenant %q not found\n", *slug)
+		}
+		return
+	}
+
+	ds := buildDataset(datasetOptions{
+		Slug:    *slug,
+		Now:     time.Now(),
+		History: *history,
+		Step:    *step,
+		Seed:    *seed,
+	})
+	if err := s.Seed(ctx, ds, *password); err != nil {
+		log.Fatalf("Failed to seed demo data: %v", err)
+	}
+
+	printSummary(ds, *password)
+}
+
+// printSummary выводит состав демо данных и учетные данные для входа
+func printSummary(ds *dataset, password string) {
+	open := 0
+	for _, incident := range ds.Incidents {
+		if incident.Status != "resolved" {
+			open++
+		}
+	}
+
+	fmt.Printf("Demo tenant %q (%s) seeded:\n", ds.Tenant.Slug, ds.Tenant.ID)
+	fmt.Printf("  checks:                %d\n", len(ds.Checks))
+	fmt.Printf("  check results:         %d\n", len(ds.Results))
+	fmt.Printf("  incidents:             %d (%d active)\n", len(ds.Incidents), open)
+	fmt.Printf("  notification channels: %d\n", len(ds.Channels))
+	fmt.Println("Users:")
+	for _, user := range ds.Users {
+		role := "member"
+		if user.IsAdmin {
+			role = "admin"
+		}
+		fmt.Printf("  %-32s %-8s password: %s\n", user.Email, role, password)
+	}
+}
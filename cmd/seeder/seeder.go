@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// resultBatchSize количество результатов проверок в одном пакете запросов
+const resultBatchSize = 1000
+
+// seeder записывает демо данные в базу платформы
+type seeder struct {
+	pool *pgxpool.Pool
+}
+
+// newSeeder создает сидер
+func newSeeder(pool *pgxpool.Pool) *seeder {
+	return &seeder{pool: pool}
+}
+
+// Clean удаляет демо тенант; связанные записи удаляются каскадно
+func (s *seeder) Clean(ctx context.Context, slug string) (bool, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	removed, err := s.removeTenant(ctx, tx, slug)
+	if err != nil {
+		return false, err
+	}
+	return removed, tx.Commit(ctx)
+}
+
+// Seed заменяет данные демо тенанта набором ds в одной транзакции, поэтому повторный
+// запуск не создает дубликатов
+func (s *seeder) Seed(ctx context.Context, ds *dataset, password string) error {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash demo password: %w", err)
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := s.removeTenant(ctx, tx, ds.Tenant.Slug); err != nil {
+		return err
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"tenant", func() error { return s.insertTenant(ctx, tx, ds.Tenant) }},
+		{"users", func() error { return s.insertUsers(ctx, tx, ds.Tenant.ID, ds.Users, string(passwordHash)) }},
+		{"notification channels", func() error { return s.insertChannels(ctx, tx, ds.Tenant.ID, ds.Channels) }},
+		{"checks", func() error { return s.insertChecks(ctx, tx, ds.Tenant.ID, ds.Checks) }},
+		{"check results", func() error { return s.insertResults(ctx, tx, ds.Results) }},
+		{"incidents", func() error { return s.insertIncidents(ctx, tx, ds.Incidents) }},
+	}
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			return fmt.Errorf("failed to seed %s: %w", step.name, err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// removeTenant удаляет тенант по slug
+func (s *seeder) removeTenant(ctx context.Context, tx pgx.Tx, slug string) (bool, error) {
+	tag, err := tx.Exec(ctx, `DELETE FROM tenants WHERE slug = $1`, slug)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove tenant %s: %w", slug, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// insertTenant создает тенант
+func (s *seeder) insertTenant(ctx context.Context, tx pgx.Tx, tenant tenantRecord) error {
+	settings, err := json.Marshal(tenant.Settings)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO tenants (id, name, slug, plan, status, settings)
+		VALUES ($1, $2, $3, $4, 'active', $5)`,
+		tenant.ID, tenant.Name, tenant.Slug, tenant.Plan, settings)
+	return err
+}
+
+// insertUsers создает пользователей с общим демо паролем
+func (s *seeder) insertUsers(ctx context.Context, tx pgx.Tx, tenantID string, users []userRecord, passwordHash string) error {
+	batch := &pgx.Batch{}
+	for _, user := range users {
+		batch.Queue(`
+			INSERT INTO users (id, tenant_id, email, first_name, last_name, password_hash, is_active, is_verified, is_admin)
+			VALUES ($1, $2, $3, $4, $5, $6, true, true, $7)`,
+			user.ID, tenantID, user.Email, user.FirstName, user.LastName, passwordHash, user.IsAdmin)
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// insertChannels создает каналы уведомлений
+func (s *seeder) insertChannels(ctx context.Context, tx pgx.Tx, tenantID string, channels []channelRecord) error {
+	batch := &pgx.Batch{}
+	for _, channel := range channels {
+		config, err := json.Marshal(channel.Config)
+		if err != nil {
+			return err
+		}
+		batch.Queue(`
+			INSERT INTO notification_channels (id, tenant_id, name, type, config, is_active)
+			VALUES ($1, $2, $3, $4, $5, true)`,
+			channel.ID, tenantID, channel.Name, channel.Type, config)
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// insertChecks создает проверки, их расписания и реестр тегов
+func (s *seeder) insertChecks(ctx context.Context, tx pgx.Tx, tenantID string, checks []checkRecord) error {
+	batch := &pgx.Batch{}
+	tags := make(map[string]bool)
+	now := time.Now().UTC()
+	for _, check := range checks {
+		config, err := json.Marshal(check.Config)
+		if err != nil {
+			return err
+		}
+		batch.Queue(`
+			INSERT INTO checks (id, tenant_id, name, description, type, target, interval_seconds, timeout_seconds,
+				enabled, config, owner, team, runbook_url, escalation_contact, tags)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+			check.ID, tenantID, check.Name, check.Description, check.Type, check.Target,
+			check.IntervalSeconds, check.TimeoutSeconds, check.Enabled, config,
+			check.Owner, check.Team, check.RunbookURL, check.EscalationContact, check.Tags)
+
+		if check.Enabled {
+			batch.Queue(`
+				INSERT INTO schedules (check_id, next_run_at, last_run_at, status)
+				VALUES ($1, $2, $3, 'pending')`,
+				check.ID, now.Add(time.Duration(check.IntervalSeconds)*time.Second), now)
+		}
+		for _, tag := range check.Tags {
+			if !tags[tag] {
+				tags[tag] = true
+				batch.Queue(`INSERT INTO check_tags (tenant_id, name) VALUES ($1, $2)`, tenantID, tag)
+			}
+		}
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// insertResults записывает историю результатов пакетами
+func (s *seeder) insertResults(ctx context.Context, tx pgx.Tx, results []resultRecord) error {
+	for start := 0; start < len(results); start += resultBatchSize {
+		end := start + resultBatchSize
+		if end > len(results) {
+			end = len(results)
+		}
+
+		batch := &pgx.Batch{}
+		for _, result := range results[start:end] {
+			batch.Queue(`
+				INSERT INTO check_results (id, check_id, status, response_time_ms, status_code, error_message, created_at)
+				VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7)`,
+				result.ID, result.CheckID, result.Status, result.ResponseTimeMs,
+				nullableStatusCode(result.StatusCode), result.ErrorMessage, result.CreatedAt)
+		}
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertIncidents создает инциденты и их хронологию
+func (s *seeder) insertIncidents(ctx context.Context, tx pgx.Tx, incidents []incidentRecord) error {
+	batch := &pgx.Batch{}
+	for _, incident := range incidents {
+		batch.Queue(`
+			INSERT INTO incidents (id, check_id, title, description, status, severity, started_at, resolved_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $7)`,
+			incident.ID, incident.CheckID, incident.Title, incident.Description,
+			incident.Status, incident.Severity, incident.StartedAt, incident.ResolvedAt)
+		for _, event := range incident.Events {
+			batch.Queue(`
+				INSERT INTO incident_events (id, incident_id, event_type, description, created_at)
+				VALUES ($1, $2, $3, $4, $5)`,
+				event.ID, incident.ID, event.EventType, event.Description, event.CreatedAt)
+		}
+	}
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// nullableStatusCode возвращает nil для проверок без HTTP кода
+func nullableStatusCode(code int) interface{} {
+	if code == 0 {
+		return nil
+	}
+	return code
+}
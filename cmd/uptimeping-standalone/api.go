@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	pkg_errors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	core "UptimePingPlatform/services/core-service/standalone"
+	incidents "UptimePingPlatform/services/incident-manager/standalone"
+	scheduler "UptimePingPlatform/services/scheduler-service/standalone"
+)
+
+// Состояния проверки в /api/v1/status
+const (
+	statusUp       = "up"
+	statusDown     = "down"
+	statusPending  = "pending"
+	statusDegraded = "degraded"
+	statusPaused   = "paused"
+)
+
+// defaultListLimit число записей в ответе, если limit не указан
+const defaultListLimit = 100
+
+// checkStatus проверка и ее последний результат
+type checkStatus struct {
+	scheduler.CheckInfo
+	Status     string       `json:"status"`
+	LastResult *core.Result `json:"last_result,omitempty"`
+}
+
+// api HTTP API состояния однопроцессного запуска
+type api struct {
+	checks    *scheduler.Scheduler
+	worker    *core.Worker
+	incidents *incidents.Manager
+	queue     *queue.Queue
+	logger    logger.Logger
+}
+
+// newAPI создает HTTP API
+func newAPI(checks *scheduler.Scheduler, worker *core.Worker, incidents *incidents.Manager, q *queue.Queue, log logger.Logger) *api {
+	return &api{checks: checks, worker: worker, incidents: incidents, queue: q, logger: log}
+}
+
+// routes регистрирует обработчики
+func (a *api) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", a.health)
+	mux.HandleFunc("GET /api/v1/status", a.status)
+	mux.HandleFunc("GET /api/v1/checks/{id}/history", a.history)
+	mux.HandleFunc("GET /api/v1/incidents", a.listIncidents)
+	mux.HandleFunc("POST /api/v1/incidents/{id}/acknowledge", a.acknowledgeIncident)
+	mux.HandleFunc("POST /api/v1/incidents/{id}/resolve", a.resolveIncident)
+	mux.HandleFunc("GET /api/v1/queue", a.queueStats)
+	return mux
+}
+
+// health отвечает, что процесс работает
+func (a *api) health(w http.ResponseWriter, r *http.Request) {
+	a.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status":    "ok",
+		"timestamp": time.Now().UTC(),
+	})
+}
+
+// status возвращает проверки с последними результатами
+func (a *api) status(w http.ResponseWriter, r *http.Request) {
+	checks, err := a.checks.Checks(r.Context())
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+
+	statuses := make([]checkStatus, 0, len(checks))
+	for _, check := range checks {
+		status, err := a.checkStatus(r.Context(), check)
+		if err != nil {
+			a.writeError(w, err)
+			return
+		}
+		statuses = append(statuses, status)
+	}
+	a.writeJSON(w, http.StatusOK, map[string]interface{}{"checks": statuses})
+}
+
+// checkStatus определяет состояние проверки: подтвержденное порогами incidents.confirmations,
+// а до первого учтенного результата - по последнему результату
+func (a *api) checkStatus(ctx context.Context, check scheduler.CheckInfo) (checkStatus, error) {
+	status := checkStatus{CheckInfo: check, Status: statusPending}
+	results, err := a.worker.History(ctx, check.ID, 1)
+	if err != nil {
+		return status, err
+	}
+	if len(results) > 0 {
+		status.LastResult = &results[0]
+		status.Status = statusDown
+		if results[0].Success {
+			status.Status = statusUp
+		}
+	}
+	state, err := a.incidents.CheckState(ctx, check.ID)
+	if err != nil {
+		return status, err
+	}
+	if state != "" {
+		status.Status = state
+	}
+	if check.Paused {
+		status.Status = statusPaused
+	}
+	return status, nil
+}
+
+// history возвращает последние результаты проверки
+func (a *api) history(w http.ResponseWriter, r *http.Request) {
+	limit, ok := a.limit(w, r)
+	if !ok {
+		return
+	}
+	results, err := a.worker.History(r.Context(), r.PathValue("id"), limit)
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+	a.writeJSON(w, http.StatusOK, map[string]interface{}{"results": results})
+}
+
+// listIncidents возвращает инциденты, новые первыми; ?status= фильтрует по статусу
+func (a *api) listIncidents(w http.ResponseWriter, r *http.Request) {
+	limit, ok := a.limit(w, r)
+	if !ok {
+		return
+	}
+	list, err := a.incidents.Incidents(r.Context(), r.URL.Query().Get("status"), limit)
+	if err != nil {
+		a.writeError(w, err)
+		return
+	}
+	if list == nil {
+		list = []*incidents.Incident{}
+	}
+	a.writeJSON(w, http.StatusOK, map[string]interface{}{"incidents": list})
+}
+
+// acknowledgeIncident подтверждает инцидент
+func (a *api) acknowledgeIncident(w http.ResponseWriter, r *http.Request) {
+	if err := a.incidents.Acknowledge(r.Context(), r.PathValue("id")); err != nil {
+		a.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// resolveIncident закрывает инцидент
+func (a *api) resolveIncident(w http.ResponseWriter, r *http.Request) {
+	if err := a.incidents.Resolve(r.Context(), r.PathValue("id")); err != nil {
+		a.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// queueStats возвращает состояние тем встроенной очереди
+func (a *api) queueStats(w http.ResponseWriter, r *http.Request) {
+	a.writeJSON(w, http.StatusOK, map[string]interface{}{"topics": a.queue.Stats()})
+}
+
+// limit разбирает параметр limit; при ошибке отвечает 400
+func (a *api) limit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return defaultListLimit, true
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		a.writeJSON(w, http.StatusBadRequest, map[string]string{"error": "limit must be a positive integer"})
+		return 0, false
+	}
+	return limit, true
+}
+
+// writeError отвечает кодом HTTP по коду ошибки платформы. Сервисы оборачивают ненайденные
+// записи во внутреннюю ошибку, поэтому ErrNotFound ищется по всей цепочке
+func (a *api) writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var platformErr *pkg_errors.Error
+	switch {
+	case errors.Is(err, pkg_errors.New(pkg_errors.ErrNotFound, "")):
+		status = http.StatusNotFound
+	case errors.As(err, &platformErr):
+		status = platformErr.HTTPStatus()
+	}
+	if status >= http.StatusInternalServerError {
+		a.logger.Error("Request failed", logger.Error(err))
+	}
+	a.writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// writeJSON отвечает JSON телом
+func (a *api) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		a.logger.Error("Failed to write response", logger.Error(err))
+	}
+}
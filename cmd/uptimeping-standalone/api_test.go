@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	core "UptimePingPlatform/services/core-service/standalone"
+	incidents "UptimePingPlatform/services/incident-manager/standalone"
+	scheduler "UptimePingPlatform/services/scheduler-service/standalone"
+)
+
+func newTestAPI(t *testing.T) http.Handler {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "uptimeping-standalone", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := queue.New(queue.DefaultConfig(), log)
+	checks := scheduler.NewScheduler(q, log)
+	for _, check := range []scheduler.Check{
+		{Name: "site", Type: "http", Target: "https://example.com", Interval: time.Minute},
+		{Name: "db", Type: "tcp", Target: "db.example.com:5432", Interval: time.Minute, Paused: true},
+	} {
+		if err := checks.AddCheck(context.Background(), check); err != nil {
+			t.Fatal(err)
+		}
+	}
+	worker, err := core.NewWorker(q, 10, config.CheckTargetsConfig{Restrict: true}, checkDefinitions{checks}, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := incidents.NewManager(q, incidents.Config{}, log)
+	return newAPI(checks, worker, manager, q, log).routes()
+}
+
+func TestAPI_Status(t *testing.T) {
+	handler := newTestAPI(t)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/status", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", recorder.Code, recorder.Body)
+	}
+	var body struct {
+		Checks []checkStatus `json:"checks"`
+	}
+	if err := json.NewDecoder(recorder.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Checks) != 2 || body.Checks[0].Status != statusPaused || body.Checks[1].Status != statusPending {
+		t.Errorf("checks = %+v, want paused db and pending site", body.Checks)
+	}
+}
+
+func TestAPI_Errors(t *testing.T) {
+	handler := newTestAPI(t)
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{http.MethodGet, "/api/v1/incidents?limit=0", http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/incidents/not-a-uuid/resolve", http.StatusBadRequest},
+		{http.MethodPost, "/api/v1/incidents/11111111-1111-1111-1111-111111111111/acknowledge", http.StatusNotFound},
+		{http.MethodDelete, "/api/v1/status", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, nil))
+		if recorder.Code != tt.want {
+			t.Errorf("%s %s = %d, want %d (%s)", tt.method, tt.path, recorder.Code, tt.want, recorder.Body)
+		}
+	}
+}
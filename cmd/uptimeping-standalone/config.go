@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/queue"
+	incidents "UptimePingPlatform/services/incident-manager/standalone"
+	"UptimePingPlatform/services/scheduler-service/standalone"
+)
+
+// Config конфигурация однопроцессного запуска
+type Config struct {
+	Environment string `yaml:"environment"`
+	LogLevel    string `yaml:"log_level"`
+	// Port порт HTTP API состояния проверок
+	Port int `yaml:"port"`
+	// Workers число параллельно выполняемых проверок
+	Workers int `yaml:"workers"`
+	// History число последних результатов каждой проверки, которые хранятся в памяти
+	History int `yaml:"history"`
+	// SnapshotFile JSON файл, в который периодически сохраняются история, инциденты и состояния
+	// проверок; пусто - состояние только в памяти. Это снимок, а не база: при аварийном
+	// завершении теряется все, что изменилось после последнего сохранения
+	SnapshotFile string `yaml:"snapshot_file"`
+	// SnapshotInterval период сохранения снимка в SnapshotFile; снимок сохраняется и при остановке
+	SnapshotInterval time.Duration   `yaml:"snapshot_interval"`
+	Queue            QueueConfig     `yaml:"queue"`
+	Incidents        IncidentsConfig `yaml:"incidents"`
+	// Providers каналы уведомлений в формате notification-service
+	Providers config.ProvidersConfig `yaml:"providers"`
+	// CheckTargets сети, с которыми проверки не соединяются, в формате core-service
+	CheckTargets config.CheckTargetsConfig `yaml:"check_targets"`
+	Checks       []standalone.Check        `yaml:"checks"`
+}
+
+// QueueConfig настройки встроенной очереди
+type QueueConfig struct {
+	Capacity    int           `yaml:"capacity"`
+	MaxAttempts int           `yaml:"max_attempts"`
+	RetryDelay  time.Duration `yaml:"retry_delay"`
+}
+
+// IncidentsConfig настройки инцидентов
+type IncidentsConfig struct {
+	// AutoResolveAfter время без ошибок, после которого успешная проверка закрывает инцидент
+	AutoResolveAfter time.Duration `yaml:"auto_resolve_after"`
+	// Retention срок хранения закрытых инцидентов
+	Retention time.Duration `yaml:"retention"`
+	// TTL время без ошибок, после которого инцидент закрывается, даже если проверка больше
+	// не выполняется; 0 - без срока
+	TTL time.Duration `yaml:"ttl"`
+	// Confirmations число ошибок подряд до открытия инцидента (down) и успехов подряд до его
+	// закрытия (up)
+	Confirmations incidents.Confirmations `yaml:"confirmations"`
+	// DegradedAfter время ответа, с которого проверка считается деградировавшей; 0 - без degraded
+	DegradedAfter time.Duration `yaml:"degraded_after"`
+}
+
+// defaultConfig конфигурация по умолчанию
+func defaultConfig() *Config {
+	queueConfig := queue.DefaultConfig()
+	return &Config{
+		Environment:      "dev",
+		LogLevel:         "info",
+		Port:             8080,
+		Workers:          4,
+		History:          1000,
+		SnapshotFile:     "uptimeping-snapshot.json",
+		SnapshotInterval: time.Minute,
+		Queue: QueueConfig{
+			Capacity:    queueConfig.Capacity,
+			MaxAttempts: queueConfig.MaxAttempts,
+			RetryDelay:  queueConfig.RetryDelay,
+		},
+		Incidents: IncidentsConfig{
+			AutoResolveAfter: time.Minute,
+			Retention:        7 * 24 * time.Hour,
+			TTL:              7 * 24 * time.Hour,
+			Confirmations:    incidents.Confirmations{Down: 1, Up: 1},
+		},
+		CheckTargets: config.CheckTargetsConfig{Restrict: true},
+	}
+}
+
+// loadConfig читает конфигурацию из YAML файла поверх значений по умолчанию
+func loadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	cfg := defaultConfig()
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// validate проверяет значения, которые не проверяют сервисы платформы
+func (c *Config) validate() error {
+	switch {
+	case c.Port <= 0 || c.Port > 65535:
+		return fmt.Errorf("port must be between 1 and 65535")
+	case c.Workers <= 0:
+		return fmt.Errorf("workers must be positive")
+	case c.History <= 0:
+		return fmt.Errorf("history must be positive")
+	case c.SnapshotFile != "" && c.SnapshotInterval <= 0:
+		return fmt.Errorf("snapshot_interval must be positive when snapshot_file is set")
+	case c.Queue.Capacity <= 0 || c.Queue.MaxAttempts <= 0 || c.Queue.RetryDelay <= 0:
+		return fmt.Errorf("queue capacity, max_attempts and retry_delay must be positive")
+	case c.Incidents.Confirmations.Down < 0 || c.Incidents.Confirmations.Up < 0 || c.Incidents.DegradedAfter < 0:
+		return fmt.Errorf("incidents confirmations and degraded_after must not be negative")
+	case len(c.Checks) == 0:
+		return fmt.Errorf("at least one check is required")
+	}
+
+	names := make(map[string]bool, len(c.Checks))
+	for _, check := range c.Checks {
+		if check.Name == "" {
+			return fmt.Errorf("check name is required")
+		}
+		if names[check.Name] {
+			return fmt.Errorf("duplicate check name %q", check.Name)
+		}
+		names[check.Name] = true
+	}
+	return nil
+}
+
+// queueConfig настройки очереди в формате pkg/queue
+func (c *Config) queueConfig() queue.Config {
+	return queue.Config{
+		Capacity:    c.Queue.Capacity,
+		MaxAttempts: c.Queue.MaxAttempts,
+		RetryDelay:  c.Queue.RetryDelay,
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	scheduler "UptimePingPlatform/services/scheduler-service/standalone"
+)
+
+func TestLoadConfig_Example(t *testing.T) {
+	cfg, err := loadConfig("standalone.example.yaml")
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	if cfg.Port != 8080 || cfg.Queue.RetryDelay != 5*time.Second || cfg.Incidents.Retention != 168*time.Hour ||
+		cfg.Incidents.Confirmations.Down != 1 || !cfg.CheckTargets.Restrict || cfg.SnapshotFile != "uptimeping-snapshot.json" {
+		t.Errorf("config = %+v", cfg)
+	}
+	if len(cfg.Checks) != 4 || cfg.Checks[0].Interval != time.Minute || !cfg.Checks[2].Paused {
+		t.Fatalf("checks = %+v", cfg.Checks)
+	}
+
+	// Проверки примера проходят валидацию планировщика, включая переопределение уведомлений
+	log, err := logger.NewLogger("test", "error", "uptimeping-standalone", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checks := scheduler.NewScheduler(queue.New(queue.DefaultConfig(), log), log)
+	for _, check := range cfg.Checks {
+		if err := checks.AddCheck(context.Background(), check); err != nil {
+			t.Errorf("AddCheck(%s) error = %v", check.Name, err)
+		}
+	}
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	path := writeConfig(t, "checks:\n  - name: site\n    type: http\n    target: https://example.com\n    interval: 1m\n")
+	cfg, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+	defaults := defaultConfig()
+	if cfg.Port != defaults.Port || cfg.Workers != defaults.Workers || cfg.queueConfig() != queue.DefaultConfig() {
+		t.Errorf("config = %+v, want defaults", cfg)
+	}
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"no checks", "port: 8080\n", "at least one check"},
+		{"bad port", "port: 70000\nchecks: [{name: a}]\n", "port"},
+		{"duplicate names", "checks: [{name: a}, {name: a}]\n", "duplicate check name"},
+		{"unnamed check", "checks: [{type: http}]\n", "check name is required"},
+		{"bad duration", "queue: {retry_delay: soon}\nchecks: [{name: a}]\n", "failed to parse"},
+		{"negative confirmations", "incidents: {confirmations: {down: -1}}\nchecks: [{name: a}]\n", "confirmations"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadConfig(writeConfig(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("loadConfig() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "standalone.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
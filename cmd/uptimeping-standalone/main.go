@@ -0,0 +1,169 @@
+// Команда uptimeping-standalone запускает платформу одним процессом без внешних зависимостей:
+// планировщик, исполнитель проверок, менеджер инцидентов и отправка уведомлений связаны
+// встроенной очередью вместо RabbitMQ, состояние хранится в памяти вместо PostgreSQL и Redis.
+// Проверки задаются YAML конфигурацией (см. standalone.example.yaml), состояние проверок
+// и инциденты доступны через HTTP API. История результатов, инциденты и состояния проверок
+// раз в snapshot_interval и при остановке сохраняются JSON снимком в snapshot_file и
+// восстанавливаются при запуске. Снимок не заменяет базу данных: при аварийном завершении
+// процесса теряется все, что изменилось после последнего снимка, а каждое сохранение
+// перезаписывает файл целиком, поэтому режим рассчитан на небольшие установки.
+//
+// Запуск: go run ./cmd/uptimeping-standalone -config standalone.yaml
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	core "UptimePingPlatform/services/core-service/standalone"
+	incidents "UptimePingPlatform/services/incident-manager/standalone"
+	notifications "UptimePingPlatform/services/notification-service/standalone"
+	scheduler "UptimePingPlatform/services/scheduler-service/standalone"
+)
+
+// shutdownTimeout время на обработку задач, оставшихся в очереди при остановке
+const shutdownTimeout = 30 * time.Second
+
+func main() {
+	configPath := flag.String("config", "standalone.yaml", "путь к YAML конфигурации")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	appLogger, err := logger.NewLogger(cfg.Environment, cfg.LogLevel, "uptimeping-standalone", false)
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	defer appLogger.Sync()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Темы очереди повторяют очереди RabbitMQ распределенной установки:
+	// check_tasks -> check_results -> notifications
+	q := queue.New(cfg.queueConfig(), appLogger)
+	checks := scheduler.NewScheduler(q, appLogger)
+	worker, err := core.NewWorker(q, cfg.History, cfg.CheckTargets, checkDefinitions{checks}, appLogger)
+	if err != nil {
+		log.Fatalf("Failed to initialize checks: %v", err)
+	}
+	manager := incidents.NewManager(q, incidents.Config{
+		AutoResolveAfter:     cfg.Incidents.AutoResolveAfter,
+		Retention:            cfg.Incidents.Retention,
+		TTL:                  cfg.Incidents.TTL,
+		Confirmations:        cfg.Incidents.Confirmations,
+		DegradedResponseTime: cfg.Incidents.DegradedAfter,
+		Checks:               checks,
+	}, appLogger)
+	notifier := notifications.NewNotifier(cfg.Providers, appLogger)
+	defer notifier.Close()
+
+	var store *snapshotStore
+	if cfg.SnapshotFile != "" {
+		store = newSnapshotStore(cfg.SnapshotFile, worker, manager, appLogger)
+		if err := store.Load(); err != nil {
+			log.Fatalf("Failed to restore state: %v", err)
+		}
+	}
+
+	for _, subscription := range []struct {
+		topic   string
+		workers int
+		handler queue.Handler
+	}{
+		{scheduler.TopicTasks, cfg.Workers, worker.Handle},
+		{core.TopicResults, 1, manager.Handle},
+		{incidents.TopicNotifications, 1, notifier.Handle},
+	} {
+		if err := q.Subscribe(subscription.topic, subscription.workers, subscription.handler); err != nil {
+			log.Fatalf("Failed to subscribe to %s: %v", subscription.topic, err)
+		}
+	}
+
+	for _, check := range cfg.Checks {
+		if err := checks.AddCheck(ctx, check); err != nil {
+			log.Fatalf("Invalid check: %v", err)
+		}
+	}
+	if err := checks.Start(ctx); err != nil {
+		log.Fatalf("Failed to start scheduler: %v", err)
+	}
+	go manager.Run(ctx)
+	go notifier.Run(ctx)
+	if store != nil {
+		go store.Run(ctx, cfg.SnapshotInterval)
+	}
+
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Port),
+		Handler:           newAPI(checks, worker, manager, q, appLogger).routes(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		appLogger.Info(fmt.Sprintf("Starting HTTP server on port %d", cfg.Port),
+			logger.Int("checks", len(cfg.Checks)),
+		)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			appLogger.Error("HTTP server failed", logger.Error(err))
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	appLogger.Info("Shutting down...")
+
+	// Сначала прекращается планирование, затем очередь дорабатывает опубликованные задачи,
+	// чтобы их результаты успели дойти до инцидентов и уведомлений
+	checks.Stop()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		appLogger.Error("HTTP server shutdown failed", logger.Error(err))
+	}
+	if err := q.Close(shutdownCtx); err != nil {
+		appLogger.Error("Queue shutdown failed", logger.Error(err))
+	}
+	if store != nil {
+		if err := store.Save(); err != nil {
+			appLogger.Error("Failed to save state", logger.Error(err))
+		}
+	}
+
+	appLogger.Info("Stopped")
+}
+
+// checkDefinitions передает исполнителю проверки планировщика: задачи в очереди несут только
+// идентификатор проверки и версию конфигурации, как и в распределенной установке
+type checkDefinitions struct {
+	scheduler *scheduler.Scheduler
+}
+
+// CheckDefinition возвращает проверку планировщика; ErrNotFound, если проверки нет
+func (d checkDefinitions) CheckDefinition(ctx context.Context, checkID string) (*core.CheckDefinition, error) {
+	check, err := d.scheduler.Definition(ctx, checkID)
+	if err != nil {
+		return nil, err
+	}
+	return &core.CheckDefinition{
+		TenantID:      check.TenantID,
+		Type:          check.Type,
+		Target:        check.Target,
+		Timeout:       check.Timeout,
+		Config:        check.Config,
+		Enabled:       !check.Paused,
+		ConfigVersion: check.ConfigVersion,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	core "UptimePingPlatform/services/core-service/standalone"
+	incidents "UptimePingPlatform/services/incident-manager/standalone"
+)
+
+// snapshotVersion версия формата файла состояния
+const snapshotVersion = 1
+
+// snapshot состояние однопроцессного запуска в файле snapshot_file
+type snapshot struct {
+	Version   int              `json:"version"`
+	SavedAt   time.Time        `json:"saved_at"`
+	Results   *core.State      `json:"results"`
+	Incidents *incidents.State `json:"incidents"`
+}
+
+// snapshotStore сохраняет историю результатов, инциденты и состояния проверок JSON снимком,
+// чтобы они переживали перезапуск. Снимок пишется целиком по таймеру и при остановке, поэтому
+// при аварийном завершении изменения после последнего снимка теряются. Файл заменяется
+// атомарно: при сбое во время записи остается предыдущий снимок
+type snapshotStore struct {
+	path    string
+	worker  *core.Worker
+	manager *incidents.Manager
+	logger  logger.Logger
+}
+
+// newSnapshotStore создает хранилище состояния в файле path
+func newSnapshotStore(path string, worker *core.Worker, manager *incidents.Manager, log logger.Logger) *snapshotStore {
+	return &snapshotStore{path: path, worker: worker, manager: manager, logger: log}
+}
+
+// Load восстанавливает состояние из файла; отсутствие файла - первый запуск
+func (s *snapshotStore) Load() error {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read state %s: %w", s.path, err)
+	}
+
+	var state snapshot
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to parse state %s: %w", s.path, err)
+	}
+	if state.Version != snapshotVersion {
+		return fmt.Errorf("unsupported state version %d in %s", state.Version, s.path)
+	}
+	if state.Results != nil {
+		s.worker.Restore(state.Results)
+	}
+	if state.Incidents != nil {
+		s.manager.Restore(state.Incidents)
+	}
+
+	s.logger.Info("State restored",
+		logger.String("path", s.path),
+		logger.String("saved_at", state.SavedAt.Format(time.RFC3339)),
+	)
+	return nil
+}
+
+// Save записывает текущее состояние во временный файл и заменяет им файл состояния
+func (s *snapshotStore) Save() error {
+	data, err := json.Marshal(&snapshot{
+		Version:   snapshotVersion,
+		SavedAt:   time.Now().UTC(),
+		Results:   s.worker.State(),
+		Incidents: s.manager.State(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create state file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to replace state %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// Run сохраняет состояние с заданным интервалом до отмены контекста
+func (s *snapshotStore) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Save(); err != nil {
+				s.logger.Error("Failed to save state", logger.Error(err))
+			}
+		}
+	}
+}
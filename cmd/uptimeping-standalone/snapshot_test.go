@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	core "UptimePingPlatform/services/core-service/standalone"
+	incidents "UptimePingPlatform/services/incident-manager/standalone"
+	scheduler "UptimePingPlatform/services/scheduler-service/standalone"
+)
+
+const snapshotCheckID = "11111111-1111-1111-1111-111111111111"
+
+func newTestStore(t *testing.T, path string) (*snapshotStore, *core.Worker, *incidents.Manager) {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "uptimeping-standalone", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := queue.New(queue.DefaultConfig(), log)
+	worker, err := core.NewWorker(q, 10, config.CheckTargetsConfig{Restrict: true},
+		checkDefinitions{scheduler.NewScheduler(q, log)}, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager := incidents.NewManager(q, incidents.Config{}, log)
+	return newSnapshotStore(path, worker, manager, log), worker, manager
+}
+
+func TestSnapshotStore_SaveLoad(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "state.json")
+	store, worker, manager := newTestStore(t, path)
+
+	var results core.State
+	if err := json.Unmarshal([]byte(`{"results": [
+		{"id": "1", "check_id": "`+snapshotCheckID+`", "success": true, "checked_at": "2026-01-01T00:00:00Z"},
+		{"id": "2", "check_id": "`+snapshotCheckID+`", "success": false, "error": "timeout", "checked_at": "2026-01-01T00:01:00Z"}
+	]}`), &results); err != nil {
+		t.Fatal(err)
+	}
+	worker.Restore(&results)
+
+	body, headers, err := events.Encode(events.TypeCheckResult, &events.CheckResult{
+		CheckID:   snapshotCheckID,
+		TenantID:  "00000000-0000-0000-0000-000000000001",
+		Error:     "timeout",
+		CheckedAt: time.Now(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.Handle(ctx, &queue.Message{Topic: core.TopicResults, Headers: headers, Body: body}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	restored, worker, manager := newTestStore(t, path)
+	if err := restored.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := worker.History(ctx, snapshotCheckID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].Error != "timeout" {
+		t.Errorf("history = %+v, want 2 results, newest first", history)
+	}
+	open, err := manager.Incidents(ctx, "open", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(open) != 1 || open[0].CheckID != snapshotCheckID {
+		t.Errorf("open incidents = %+v, want one incident of the check", open)
+	}
+	if state, err := manager.CheckState(ctx, snapshotCheckID); err != nil || state != "down" {
+		t.Errorf("check state = %q, %v, want down", state, err)
+	}
+}
+
+func TestSnapshotStore_LoadMissingFile(t *testing.T) {
+	store, _, _ := newTestStore(t, filepath.Join(t.TempDir(), "missing.json"))
+	if err := store.Load(); err != nil {
+		t.Errorf("Load() = %v, want nil for the first start", err)
+	}
+}
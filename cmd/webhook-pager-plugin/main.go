@@ -0,0 +1,104 @@
+// Команда webhook-pager-plugin - пример плагина провайдера уведомлений. Плагин обслуживает
+// канал webhook-pager и отправляет каждое уведомление JSON запросом POST на PAGER_URL.
+// Собственный провайдер, например внутреннюю систему дежурств, можно написать по этому образцу:
+// реализовать сервис NotificationPlugin из proto/api/plugin/v1 и вызвать plugin.Serve.
+//
+// Установка: собрать бинарь в каталог плагинов notification-service (NOTIFICATION_PLUGINS_DIR)
+// и зарегистрировать канал типа plugin с config.plugin=webhook-pager. Окружение сервиса плагин
+// не наследует, PAGER_URL задается в providers.plugins.env:
+//
+//	go build -o /etc/uptimeping/plugins/webhook-pager ./cmd/webhook-pager-plugin
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/plugin"
+	pluginv1 "UptimePingPlatform/proto/api/plugin/v1"
+)
+
+// channel канал уведомлений, который обслуживает плагин
+const channel = "webhook-pager"
+
+// pager отправляет уведомления на адрес системы дежурств
+type pager struct {
+	url    string
+	client *http.Client
+}
+
+func (p *pager) Describe(ctx context.Context, req *pluginv1.DescribeRequest) (*pluginv1.DescribeResponse, error) {
+	return &pluginv1.DescribeResponse{
+		Channel:     channel,
+		Version:     "1.0.0",
+		Description: "Posts notifications as JSON to PAGER_URL",
+	}, nil
+}
+
+func (p *pager) Send(ctx context.Context, req *pluginv1.SendRequest) (*pluginv1.SendResponse, error) {
+	notification := req.GetNotification()
+	body, err := json.Marshal(map[string]interface{}{
+		"id":        notification.GetId(),
+		"tenant_id": notification.GetTenantId(),
+		"team":      notification.GetRecipient(),
+		"severity":  notification.GetSeverity(),
+		"summary":   notification.GetSubject(),
+		"details":   notification.GetBody(),
+		"time":      notification.GetFormattedTime(),
+	})
+	if err != nil {
+		return &pluginv1.SendResponse{Error: err.Error()}, nil
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return &pluginv1.SendResponse{Error: err.Error()}, nil
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(httpReq)
+	if err != nil {
+		return &pluginv1.SendResponse{Error: err.Error(), Retryable: true}, nil
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return &pluginv1.SendResponse{
+			Error:     fmt.Sprintf("pager responded with status %d", resp.StatusCode),
+			Retryable: resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests,
+		}, nil
+	}
+	return &pluginv1.SendResponse{ExternalId: resp.Header.Get("X-Incident-Id")}, nil
+}
+
+func (p *pager) Health(ctx context.Context, req *pluginv1.HealthRequest) (*pluginv1.HealthResponse, error) {
+	if p.url == "" {
+		return &pluginv1.HealthResponse{Healthy: false, Message: "PAGER_URL is not set"}, nil
+	}
+	return &pluginv1.HealthResponse{Healthy: true}, nil
+}
+
+func main() {
+	impl := &pager{
+		url:    os.Getenv("PAGER_URL"),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+
+	err := plugin.Serve(plugin.ServeConfig{
+		ProtocolVersion: 1,
+		Register: func(server *grpc.Server) {
+			pluginv1.RegisterNotificationPluginServer(server, impl)
+		},
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
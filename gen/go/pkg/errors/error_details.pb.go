@@ -1,128 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.1
-// 	protoc        (unknown)
-// source: pkg/errors/error_details.proto
-
-package errors
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// ErrorDetails представляет детали ошибки для gRPC
-type ErrorDetails struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Details       string                 `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ErrorDetails) Reset() {
-	*x = ErrorDetails{}
-	mi := &file_pkg_errors_error_details_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ErrorDetails) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ErrorDetails) ProtoMessage() {}
-
-func (x *ErrorDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_errors_error_details_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ErrorDetails.ProtoReflect.Descriptor instead.
-func (*ErrorDetails) Descriptor() ([]byte, []int) {
-	return file_pkg_errors_error_details_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *ErrorDetails) GetDetails() string {
-	if x != nil {
-		return x.Details
-	}
-	return ""
-}
-
-var File_pkg_errors_error_details_proto protoreflect.FileDescriptor
-
-var file_pkg_errors_error_details_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x28, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x69, 0x6e, 0x67,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_pkg_errors_error_details_proto_rawDescOnce sync.Once
-	file_pkg_errors_error_details_proto_rawDescData = file_pkg_errors_error_details_proto_rawDesc
-)
-
-func file_pkg_errors_error_details_proto_rawDescGZIP() []byte {
-	file_pkg_errors_error_details_proto_rawDescOnce.Do(func() {
-		file_pkg_errors_error_details_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_errors_error_details_proto_rawDescData)
-	})
-	return file_pkg_errors_error_details_proto_rawDescData
-}
-
-var file_pkg_errors_error_details_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_pkg_errors_error_details_proto_goTypes = []any{
-	(*ErrorDetails)(nil), // 0: errors.ErrorDetails
-}
-var file_pkg_errors_error_details_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_pkg_errors_error_details_proto_init() }
-func file_pkg_errors_error_details_proto_init() {
-	if File_pkg_errors_error_details_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pkg_errors_error_details_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
-			NumExtensions: 0,
-			NumServices:   0,
-		},
-		GoTypes:           file_pkg_errors_error_details_proto_goTypes,
-		DependencyIndexes: file_pkg_errors_error_details_proto_depIdxs,
-		MessageInfos:      file_pkg_errors_error_details_proto_msgTypes,
-	}.Build()
-	File_pkg_errors_error_details_proto = out.File
-	file_pkg_errors_error_details_proto_rawDesc = nil
-	file_pkg_errors_error_details_proto_goTypes = nil
-	file_pkg_errors_error_details_proto_depIdxs = nil
-}
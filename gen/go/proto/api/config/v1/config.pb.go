@@ -1,970 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.1
-// 	protoc        (unknown)
-// source: proto/api/config/v1/config.proto
-
-package v1
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	structpb "google.golang.org/protobuf/types/known/structpb"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// CheckType определяет тип проверки
-type CheckType int32
-
-const (
-	CheckType_CHECK_TYPE_UNSPECIFIED CheckType = 0
-	CheckType_CHECK_TYPE_HTTP        CheckType = 1
-	CheckType_CHECK_TYPE_GRPC        CheckType = 2
-	CheckType_CHECK_TYPE_GRAPHQL     CheckType = 3
-	CheckType_CHECK_TYPE_TCP         CheckType = 4
-)
-
-// Enum value maps for CheckType.
-var (
-	CheckType_name = map[int32]string{
-		0: "CHECK_TYPE_UNSPECIFIED",
-		1: "CHECK_TYPE_HTTP",
-		2: "CHECK_TYPE_GRPC",
-		3: "CHECK_TYPE_GRAPHQL",
-		4: "CHECK_TYPE_TCP",
-	}
-	CheckType_value = map[string]int32{
-		"CHECK_TYPE_UNSPECIFIED": 0,
-		"CHECK_TYPE_HTTP":        1,
-		"CHECK_TYPE_GRPC":        2,
-		"CHECK_TYPE_GRAPHQL":     3,
-		"CHECK_TYPE_TCP":         4,
-	}
-)
-
-func (x CheckType) Enum() *CheckType {
-	p := new(CheckType)
-	*p = x
-	return p
-}
-
-func (x CheckType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (CheckType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_api_config_v1_config_proto_enumTypes[0].Descriptor()
-}
-
-func (CheckType) Type() protoreflect.EnumType {
-	return &file_proto_api_config_v1_config_proto_enumTypes[0]
-}
-
-func (x CheckType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use CheckType.Descriptor instead.
-func (CheckType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{0}
-}
-
-// CheckStatus определяет статус проверки
-type CheckStatus int32
-
-const (
-	CheckStatus_CHECK_STATUS_UNSPECIFIED CheckStatus = 0
-	CheckStatus_CHECK_STATUS_ACTIVE      CheckStatus = 1
-	CheckStatus_CHECK_STATUS_PAUSED      CheckStatus = 2
-	CheckStatus_CHECK_STATUS_DISABLED    CheckStatus = 3
-)
-
-// Enum value maps for CheckStatus.
-var (
-	CheckStatus_name = map[int32]string{
-		0: "CHECK_STATUS_UNSPECIFIED",
-		1: "CHECK_STATUS_ACTIVE",
-		2: "CHECK_STATUS_PAUSED",
-		3: "CHECK_STATUS_DISABLED",
-	}
-	CheckStatus_value = map[string]int32{
-		"CHECK_STATUS_UNSPECIFIED": 0,
-		"CHECK_STATUS_ACTIVE":      1,
-		"CHECK_STATUS_PAUSED":      2,
-		"CHECK_STATUS_DISABLED":    3,
-	}
-)
-
-func (x CheckStatus) Enum() *CheckStatus {
-	p := new(CheckStatus)
-	*p = x
-	return p
-}
-
-func (x CheckStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (CheckStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_api_config_v1_config_proto_enumTypes[1].Descriptor()
-}
-
-func (CheckStatus) Type() protoreflect.EnumType {
-	return &file_proto_api_config_v1_config_proto_enumTypes[1]
-}
-
-func (x CheckStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use CheckStatus.Descriptor instead.
-func (CheckStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{1}
-}
-
-// Check представляет конфигурацию проверки
-type Check struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          CheckType              `protobuf:"varint,4,opt,name=type,proto3,enum=uptimeping.config.v1.CheckType" json:"type,omitempty"`
-	Target        string                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
-	Interval      int32                  `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	Timeout       int32                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Status        CheckStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=uptimeping.config.v1.CheckStatus" json:"status,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Check) Reset() {
-	*x = Check{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Check) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Check) ProtoMessage() {}
-
-func (x *Check) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Check.ProtoReflect.Descriptor instead.
-func (*Check) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Check) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Check) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *Check) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Check) GetType() CheckType {
-	if x != nil {
-		return x.Type
-	}
-	return CheckType_CHECK_TYPE_UNSPECIFIED
-}
-
-func (x *Check) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *Check) GetInterval() int32 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *Check) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *Check) GetStatus() CheckStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CheckStatus_CHECK_STATUS_UNSPECIFIED
-}
-
-func (x *Check) GetConfig() *structpb.Struct {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-func (x *Check) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *Check) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
-}
-
-// CreateCheckRequest содержит данные для создания проверки
-type CreateCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          CheckType              `protobuf:"varint,3,opt,name=type,proto3,enum=uptimeping.config.v1.CheckType" json:"type,omitempty"`
-	Target        string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
-	Interval      int32                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
-	Timeout       int32                  `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateCheckRequest) Reset() {
-	*x = CreateCheckRequest{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateCheckRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateCheckRequest) ProtoMessage() {}
-
-func (x *CreateCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateCheckRequest.ProtoReflect.Descriptor instead.
-func (*CreateCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreateCheckRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *CreateCheckRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateCheckRequest) GetType() CheckType {
-	if x != nil {
-		return x.Type
-	}
-	return CheckType_CHECK_TYPE_UNSPECIFIED
-}
-
-func (x *CreateCheckRequest) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *CreateCheckRequest) GetInterval() int32 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *CreateCheckRequest) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *CreateCheckRequest) GetConfig() *structpb.Struct {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-// GetCheckRequest содержит ID проверки
-type GetCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CheckId       string                 `protobuf:"bytes,1,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetCheckRequest) Reset() {
-	*x = GetCheckRequest{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetCheckRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetCheckRequest) ProtoMessage() {}
-
-func (x *GetCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetCheckRequest.ProtoReflect.Descriptor instead.
-func (*GetCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *GetCheckRequest) GetCheckId() string {
-	if x != nil {
-		return x.CheckId
-	}
-	return ""
-}
-
-func (x *GetCheckRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-// UpdateCheckRequest содержит данные для обновления проверки
-type UpdateCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CheckId       string                 `protobuf:"bytes,1,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          CheckType              `protobuf:"varint,4,opt,name=type,proto3,enum=uptimeping.config.v1.CheckType" json:"type,omitempty"`
-	Target        string                 `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
-	Interval      int32                  `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	Timeout       int32                  `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Status        CheckStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=uptimeping.config.v1.CheckStatus" json:"status,omitempty"`
-	Config        *structpb.Struct       `protobuf:"bytes,9,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateCheckRequest) Reset() {
-	*x = UpdateCheckRequest{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateCheckRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateCheckRequest) ProtoMessage() {}
-
-func (x *UpdateCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateCheckRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *UpdateCheckRequest) GetCheckId() string {
-	if x != nil {
-		return x.CheckId
-	}
-	return ""
-}
-
-func (x *UpdateCheckRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *UpdateCheckRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateCheckRequest) GetType() CheckType {
-	if x != nil {
-		return x.Type
-	}
-	return CheckType_CHECK_TYPE_UNSPECIFIED
-}
-
-func (x *UpdateCheckRequest) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *UpdateCheckRequest) GetInterval() int32 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *UpdateCheckRequest) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *UpdateCheckRequest) GetStatus() CheckStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CheckStatus_CHECK_STATUS_UNSPECIFIED
-}
-
-func (x *UpdateCheckRequest) GetConfig() *structpb.Struct {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-// DeleteCheckRequest содержит ID проверки для удаления
-type DeleteCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CheckId       string                 `protobuf:"bytes,1,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteCheckRequest) Reset() {
-	*x = DeleteCheckRequest{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteCheckRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteCheckRequest) ProtoMessage() {}
-
-func (x *DeleteCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteCheckRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *DeleteCheckRequest) GetCheckId() string {
-	if x != nil {
-		return x.CheckId
-	}
-	return ""
-}
-
-func (x *DeleteCheckRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-// DeleteCheckResponse подтверждает удаление
-type DeleteCheckResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteCheckResponse) Reset() {
-	*x = DeleteCheckResponse{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteCheckResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteCheckResponse) ProtoMessage() {}
-
-func (x *DeleteCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteCheckResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCheckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *DeleteCheckResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-// ListChecksRequest содержит параметры фильтрации и пагинации
-type ListChecksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     int32                  `protobuf:"varint,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListChecksRequest) Reset() {
-	*x = ListChecksRequest{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListChecksRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListChecksRequest) ProtoMessage() {}
-
-func (x *ListChecksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListChecksRequest.ProtoReflect.Descriptor instead.
-func (*ListChecksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ListChecksRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *ListChecksRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListChecksRequest) GetPageToken() int32 {
-	if x != nil {
-		return x.PageToken
-	}
-	return 0
-}
-
-func (x *ListChecksRequest) GetFilter() string {
-	if x != nil {
-		return x.Filter
-	}
-	return ""
-}
-
-func (x *ListChecksRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-// ListChecksResponse содержит список проверок
-type ListChecksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Checks        []*Check               `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
-	NextPageToken int32                  `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListChecksResponse) Reset() {
-	*x = ListChecksResponse{}
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListChecksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListChecksResponse) ProtoMessage() {}
-
-func (x *ListChecksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_config_v1_config_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListChecksResponse.ProtoReflect.Descriptor instead.
-func (*ListChecksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_config_v1_config_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ListChecksResponse) GetChecks() []*Check {
-	if x != nil {
-		return x.Checks
-	}
-	return nil
-}
-
-func (x *ListChecksResponse) GetNextPageToken() int32 {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return 0
-}
-
-var File_proto_api_config_v1_config_proto protoreflect.FileDescriptor
-
-var file_proto_api_config_v1_config_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x14, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x02, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1f, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e,
-	0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf9,
-	0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e,
-	0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x49, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xcf, 0x02, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70,
-	0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x75, 0x70,
-	0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4c, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a,
-	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x71, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
-	0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x2a, 0x7d, 0x0a, 0x09, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x45, 0x43,
-	0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x50, 0x43, 0x10, 0x02, 0x12, 0x16,
-	0x0a, 0x12, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x41,
-	0x50, 0x48, 0x51, 0x4c, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x04, 0x2a, 0x78, 0x0a, 0x0b, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x45, 0x43, 0x4b,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01,
-	0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
-	0x45, 0x44, 0x10, 0x03, 0x32, 0xda, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69,
-	0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x50,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x00,
-	0x12, 0x56, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
-	0x28, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x75, 0x70, 0x74, 0x69,
-	0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61,
-	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x2e, 0x75,
-	0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69,
-	0x6e, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x72, 0x6f, 0x64, 0x69, 0x6f, 0x6e, 0x6f, 0x76, 0x5f, 0x76, 0x5f, 0x61, 0x6c, 0x2f, 0x55, 0x70,
-	0x74, 0x69, 0x6d, 0x65, 0x50, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_api_config_v1_config_proto_rawDescOnce sync.Once
-	file_proto_api_config_v1_config_proto_rawDescData = file_proto_api_config_v1_config_proto_rawDesc
-)
-
-func file_proto_api_config_v1_config_proto_rawDescGZIP() []byte {
-	file_proto_api_config_v1_config_proto_rawDescOnce.Do(func() {
-		file_proto_api_config_v1_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_api_config_v1_config_proto_rawDescData)
-	})
-	return file_proto_api_config_v1_config_proto_rawDescData
-}
-
-var file_proto_api_config_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_api_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_proto_api_config_v1_config_proto_goTypes = []any{
-	(CheckType)(0),              // 0: uptimeping.config.v1.CheckType
-	(CheckStatus)(0),            // 1: uptimeping.config.v1.CheckStatus
-	(*Check)(nil),               // 2: uptimeping.config.v1.Check
-	(*CreateCheckRequest)(nil),  // 3: uptimeping.config.v1.CreateCheckRequest
-	(*GetCheckRequest)(nil),     // 4: uptimeping.config.v1.GetCheckRequest
-	(*UpdateCheckRequest)(nil),  // 5: uptimeping.config.v1.UpdateCheckRequest
-	(*DeleteCheckRequest)(nil),  // 6: uptimeping.config.v1.DeleteCheckRequest
-	(*DeleteCheckResponse)(nil), // 7: uptimeping.config.v1.DeleteCheckResponse
-	(*ListChecksRequest)(nil),   // 8: uptimeping.config.v1.ListChecksRequest
-	(*ListChecksResponse)(nil),  // 9: uptimeping.config.v1.ListChecksResponse
-	(*structpb.Struct)(nil),     // 10: google.protobuf.Struct
-}
-var file_proto_api_config_v1_config_proto_depIdxs = []int32{
-	0,  // 0: uptimeping.config.v1.Check.type:type_name -> uptimeping.config.v1.CheckType
-	1,  // 1: uptimeping.config.v1.Check.status:type_name -> uptimeping.config.v1.CheckStatus
-	10, // 2: uptimeping.config.v1.Check.config:type_name -> google.protobuf.Struct
-	0,  // 3: uptimeping.config.v1.CreateCheckRequest.type:type_name -> uptimeping.config.v1.CheckType
-	10, // 4: uptimeping.config.v1.CreateCheckRequest.config:type_name -> google.protobuf.Struct
-	0,  // 5: uptimeping.config.v1.UpdateCheckRequest.type:type_name -> uptimeping.config.v1.CheckType
-	1,  // 6: uptimeping.config.v1.UpdateCheckRequest.status:type_name -> uptimeping.config.v1.CheckStatus
-	10, // 7: uptimeping.config.v1.UpdateCheckRequest.config:type_name -> google.protobuf.Struct
-	2,  // 8: uptimeping.config.v1.ListChecksResponse.checks:type_name -> uptimeping.config.v1.Check
-	3,  // 9: uptimeping.config.v1.ConfigService.CreateCheck:input_type -> uptimeping.config.v1.CreateCheckRequest
-	4,  // 10: uptimeping.config.v1.ConfigService.GetCheck:input_type -> uptimeping.config.v1.GetCheckRequest
-	5,  // 11: uptimeping.config.v1.ConfigService.UpdateCheck:input_type -> uptimeping.config.v1.UpdateCheckRequest
-	6,  // 12: uptimeping.config.v1.ConfigService.DeleteCheck:input_type -> uptimeping.config.v1.DeleteCheckRequest
-	8,  // 13: uptimeping.config.v1.ConfigService.ListChecks:input_type -> uptimeping.config.v1.ListChecksRequest
-	2,  // 14: uptimeping.config.v1.ConfigService.CreateCheck:output_type -> uptimeping.config.v1.Check
-	2,  // 15: uptimeping.config.v1.ConfigService.GetCheck:output_type -> uptimeping.config.v1.Check
-	2,  // 16: uptimeping.config.v1.ConfigService.UpdateCheck:output_type -> uptimeping.config.v1.Check
-	7,  // 17: uptimeping.config.v1.ConfigService.DeleteCheck:output_type -> uptimeping.config.v1.DeleteCheckResponse
-	9,  // 18: uptimeping.config.v1.ConfigService.ListChecks:output_type -> uptimeping.config.v1.ListChecksResponse
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
-}
-
-func init() { file_proto_api_config_v1_config_proto_init() }
-func file_proto_api_config_v1_config_proto_init() {
-	if File_proto_api_config_v1_config_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_api_config_v1_config_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   8,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_api_config_v1_config_proto_goTypes,
-		DependencyIndexes: file_proto_api_config_v1_config_proto_depIdxs,
-		EnumInfos:         file_proto_api_config_v1_config_proto_enumTypes,
-		MessageInfos:      file_proto_api_config_v1_config_proto_msgTypes,
-	}.Build()
-	File_proto_api_config_v1_config_proto = out.File
-	file_proto_api_config_v1_config_proto_rawDesc = nil
-	file_proto_api_config_v1_config_proto_goTypes = nil
-	file_proto_api_config_v1_config_proto_depIdxs = nil
-}
@@ -1,285 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: proto/api/config/v1/config.proto
-
-package v1
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	ConfigService_CreateCheck_FullMethodName = "/uptimeping.config.v1.ConfigService/CreateCheck"
-	ConfigService_GetCheck_FullMethodName    = "/uptimeping.config.v1.ConfigService/GetCheck"
-	ConfigService_UpdateCheck_FullMethodName = "/uptimeping.config.v1.ConfigService/UpdateCheck"
-	ConfigService_DeleteCheck_FullMethodName = "/uptimeping.config.v1.ConfigService/DeleteCheck"
-	ConfigService_ListChecks_FullMethodName  = "/uptimeping.config.v1.ConfigService/ListChecks"
-)
-
-// ConfigServiceClient is the client API for ConfigService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// ConfigService предоставляет методы для управления конфигурациями проверок
-type ConfigServiceClient interface {
-	// CreateCheck создает новую конфигурацию проверки
-	CreateCheck(ctx context.Context, in *CreateCheckRequest, opts ...grpc.CallOption) (*Check, error)
-	// GetCheck возвращает конфигурацию проверки по ID
-	GetCheck(ctx context.Context, in *GetCheckRequest, opts ...grpc.CallOption) (*Check, error)
-	// UpdateCheck обновляет существующую конфигурацию проверки
-	UpdateCheck(ctx context.Context, in *UpdateCheckRequest, opts ...grpc.CallOption) (*Check, error)
-	// DeleteCheck удаляет конфигурацию проверки
-	DeleteCheck(ctx context.Context, in *DeleteCheckRequest, opts ...grpc.CallOption) (*DeleteCheckResponse, error)
-	// ListChecks возвращает список конфигураций проверок с пагинацией
-	ListChecks(ctx context.Context, in *ListChecksRequest, opts ...grpc.CallOption) (*ListChecksResponse, error)
-}
-
-type configServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewConfigServiceClient(cc grpc.ClientConnInterface) ConfigServiceClient {
-	return &configServiceClient{cc}
-}
-
-func (c *configServiceClient) CreateCheck(ctx context.Context, in *CreateCheckRequest, opts ...grpc.CallOption) (*Check, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Check)
-	err := c.cc.Invoke(ctx, ConfigService_CreateCheck_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *configServiceClient) GetCheck(ctx context.Context, in *GetCheckRequest, opts ...grpc.CallOption) (*Check, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Check)
-	err := c.cc.Invoke(ctx, ConfigService_GetCheck_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *configServiceClient) UpdateCheck(ctx context.Context, in *UpdateCheckRequest, opts ...grpc.CallOption) (*Check, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Check)
-	err := c.cc.Invoke(ctx, ConfigService_UpdateCheck_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *configServiceClient) DeleteCheck(ctx context.Context, in *DeleteCheckRequest, opts ...grpc.CallOption) (*DeleteCheckResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteCheckResponse)
-	err := c.cc.Invoke(ctx, ConfigService_DeleteCheck_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *configServiceClient) ListChecks(ctx context.Context, in *ListChecksRequest, opts ...grpc.CallOption) (*ListChecksResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListChecksResponse)
-	err := c.cc.Invoke(ctx, ConfigService_ListChecks_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// ConfigServiceServer is the server API for ConfigService service.
-// All implementations should embed UnimplementedConfigServiceServer
-// for forward compatibility.
-//
-// ConfigService предоставляет методы для управления конфигурациями проверок
-type ConfigServiceServer interface {
-	// CreateCheck создает новую конфигурацию проверки
-	CreateCheck(context.Context, *CreateCheckRequest) (*Check, error)
-	// GetCheck возвращает конфигурацию проверки по ID
-	GetCheck(context.Context, *GetCheckRequest) (*Check, error)
-	// UpdateCheck обновляет существующую конфигурацию проверки
-	UpdateCheck(context.Context, *UpdateCheckRequest) (*Check, error)
-	// DeleteCheck удаляет конфигурацию проверки
-	DeleteCheck(context.Context, *DeleteCheckRequest) (*DeleteCheckResponse, error)
-	// ListChecks возвращает список конфигураций проверок с пагинацией
-	ListChecks(context.Context, *ListChecksRequest) (*ListChecksResponse, error)
-}
-
-// UnimplementedConfigServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedConfigServiceServer struct{}
-
-func (UnimplementedConfigServiceServer) CreateCheck(context.Context, *CreateCheckRequest) (*Check, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCheck not implemented")
-}
-func (UnimplementedConfigServiceServer) GetCheck(context.Context, *GetCheckRequest) (*Check, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCheck not implemented")
-}
-func (UnimplementedConfigServiceServer) UpdateCheck(context.Context, *UpdateCheckRequest) (*Check, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCheck not implemented")
-}
-func (UnimplementedConfigServiceServer) DeleteCheck(context.Context, *DeleteCheckRequest) (*DeleteCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCheck not implemented")
-}
-func (UnimplementedConfigServiceServer) ListChecks(context.Context, *ListChecksRequest) (*ListChecksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListChecks not implemented")
-}
-func (UnimplementedConfigServiceServer) testEmbeddedByValue() {}
-
-// UnsafeConfigServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ConfigServiceServer will
-// result in compilation errors.
-type UnsafeConfigServiceServer interface {
-	mustEmbedUnimplementedConfigServiceServer()
-}
-
-func RegisterConfigServiceServer(s grpc.ServiceRegistrar, srv ConfigServiceServer) {
-	// If the following call pancis, it indicates UnimplementedConfigServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&ConfigService_ServiceDesc, srv)
-}
-
-func _ConfigService_CreateCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCheckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ConfigServiceServer).CreateCheck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ConfigService_CreateCheck_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).CreateCheck(ctx, req.(*CreateCheckRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ConfigService_GetCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCheckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ConfigServiceServer).GetCheck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ConfigService_GetCheck_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).GetCheck(ctx, req.(*GetCheckRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ConfigService_UpdateCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCheckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ConfigServiceServer).UpdateCheck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ConfigService_UpdateCheck_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).UpdateCheck(ctx, req.(*UpdateCheckRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ConfigService_DeleteCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCheckRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ConfigServiceServer).DeleteCheck(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ConfigService_DeleteCheck_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).DeleteCheck(ctx, req.(*DeleteCheckRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ConfigService_ListChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChecksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ConfigServiceServer).ListChecks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ConfigService_ListChecks_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServiceServer).ListChecks(ctx, req.(*ListChecksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// ConfigService_ServiceDesc is the grpc.ServiceDesc for ConfigService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ConfigService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "uptimeping.config.v1.ConfigService",
-	HandlerType: (*ConfigServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateCheck",
-			Handler:    _ConfigService_CreateCheck_Handler,
-		},
-		{
-			MethodName: "GetCheck",
-			Handler:    _ConfigService_GetCheck_Handler,
-		},
-		{
-			MethodName: "UpdateCheck",
-			Handler:    _ConfigService_UpdateCheck_Handler,
-		},
-		{
-			MethodName: "DeleteCheck",
-			Handler:    _ConfigService_DeleteCheck_Handler,
-		},
-		{
-			MethodName: "ListChecks",
-			Handler:    _ConfigService_ListChecks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/api/config/v1/config.proto",
-}
@@ -1,1272 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.1
-// 	protoc        (unknown)
-// source: proto/api/forge/v1/forge.proto
-
-package v1
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// CheckType определяет тип проверки
-type CheckType int32
-
-const (
-	CheckType_CHECK_TYPE_UNSPECIFIED CheckType = 0
-	CheckType_CHECK_TYPE_HTTP        CheckType = 1
-	CheckType_CHECK_TYPE_GRPC        CheckType = 2
-	CheckType_CHECK_TYPE_GRAPHQL     CheckType = 3
-)
-
-// Enum value maps for CheckType.
-var (
-	CheckType_name = map[int32]string{
-		0: "CHECK_TYPE_UNSPECIFIED",
-		1: "CHECK_TYPE_HTTP",
-		2: "CHECK_TYPE_GRPC",
-		3: "CHECK_TYPE_GRAPHQL",
-	}
-	CheckType_value = map[string]int32{
-		"CHECK_TYPE_UNSPECIFIED": 0,
-		"CHECK_TYPE_HTTP":        1,
-		"CHECK_TYPE_GRPC":        2,
-		"CHECK_TYPE_GRAPHQL":     3,
-	}
-)
-
-func (x CheckType) Enum() *CheckType {
-	p := new(CheckType)
-	*p = x
-	return p
-}
-
-func (x CheckType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (CheckType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_api_forge_v1_forge_proto_enumTypes[0].Descriptor()
-}
-
-func (CheckType) Type() protoreflect.EnumType {
-	return &file_proto_api_forge_v1_forge_proto_enumTypes[0]
-}
-
-func (x CheckType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use CheckType.Descriptor instead.
-func (CheckType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{0}
-}
-
-// ParseProtoRequest содержит данные для парсинга .proto файла
-type ParseProtoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProtoContent  string                 `protobuf:"bytes,1,opt,name=proto_content,json=protoContent,proto3" json:"proto_content,omitempty"`
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ParseProtoRequest) Reset() {
-	*x = ParseProtoRequest{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ParseProtoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ParseProtoRequest) ProtoMessage() {}
-
-func (x *ParseProtoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ParseProtoRequest.ProtoReflect.Descriptor instead.
-func (*ParseProtoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *ParseProtoRequest) GetProtoContent() string {
-	if x != nil {
-		return x.ProtoContent
-	}
-	return ""
-}
-
-func (x *ParseProtoRequest) GetFileName() string {
-	if x != nil {
-		return x.FileName
-	}
-	return ""
-}
-
-// ParseProtoResponse содержит результат парсинга
-type ParseProtoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceInfo   *ServiceInfo           `protobuf:"bytes,1,opt,name=service_info,json=serviceInfo,proto3" json:"service_info,omitempty"`
-	IsValid       bool                   `protobuf:"varint,2,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ParseProtoResponse) Reset() {
-	*x = ParseProtoResponse{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ParseProtoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ParseProtoResponse) ProtoMessage() {}
-
-func (x *ParseProtoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ParseProtoResponse.ProtoReflect.Descriptor instead.
-func (*ParseProtoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *ParseProtoResponse) GetServiceInfo() *ServiceInfo {
-	if x != nil {
-		return x.ServiceInfo
-	}
-	return nil
-}
-
-func (x *ParseProtoResponse) GetIsValid() bool {
-	if x != nil {
-		return x.IsValid
-	}
-	return false
-}
-
-func (x *ParseProtoResponse) GetWarnings() []string {
-	if x != nil {
-		return x.Warnings
-	}
-	return nil
-}
-
-// ServiceInfo содержит информацию о сервисе из .proto файла
-type ServiceInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PackageName   string                 `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	Methods       []*MethodInfo          `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
-	Messages      []*MessageInfo         `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ServiceInfo) Reset() {
-	*x = ServiceInfo{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ServiceInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ServiceInfo) ProtoMessage() {}
-
-func (x *ServiceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ServiceInfo.ProtoReflect.Descriptor instead.
-func (*ServiceInfo) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *ServiceInfo) GetPackageName() string {
-	if x != nil {
-		return x.PackageName
-	}
-	return ""
-}
-
-func (x *ServiceInfo) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
-}
-
-func (x *ServiceInfo) GetMethods() []*MethodInfo {
-	if x != nil {
-		return x.Methods
-	}
-	return nil
-}
-
-func (x *ServiceInfo) GetMessages() []*MessageInfo {
-	if x != nil {
-		return x.Messages
-	}
-	return nil
-}
-
-// MethodInfo содержит информацию о методе
-type MethodInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	InputType     string                 `protobuf:"bytes,2,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
-	OutputType    string                 `protobuf:"bytes,3,opt,name=output_type,json=outputType,proto3" json:"output_type,omitempty"`
-	HttpMethod    string                 `protobuf:"bytes,4,opt,name=http_method,json=httpMethod,proto3" json:"http_method,omitempty"`
-	HttpPath      string                 `protobuf:"bytes,5,opt,name=http_path,json=httpPath,proto3" json:"http_path,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MethodInfo) Reset() {
-	*x = MethodInfo{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MethodInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MethodInfo) ProtoMessage() {}
-
-func (x *MethodInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MethodInfo.ProtoReflect.Descriptor instead.
-func (*MethodInfo) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *MethodInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *MethodInfo) GetInputType() string {
-	if x != nil {
-		return x.InputType
-	}
-	return ""
-}
-
-func (x *MethodInfo) GetOutputType() string {
-	if x != nil {
-		return x.OutputType
-	}
-	return ""
-}
-
-func (x *MethodInfo) GetHttpMethod() string {
-	if x != nil {
-		return x.HttpMethod
-	}
-	return ""
-}
-
-func (x *MethodInfo) GetHttpPath() string {
-	if x != nil {
-		return x.HttpPath
-	}
-	return ""
-}
-
-// MessageInfo содержит информацию о сообщении
-type MessageInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Fields        []*FieldInfo           `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MessageInfo) Reset() {
-	*x = MessageInfo{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MessageInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MessageInfo) ProtoMessage() {}
-
-func (x *MessageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MessageInfo.ProtoReflect.Descriptor instead.
-func (*MessageInfo) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *MessageInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *MessageInfo) GetFields() []*FieldInfo {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-// FieldInfo содержит информацию о поле
-type FieldInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
-	Repeated      bool                   `protobuf:"varint,4,opt,name=repeated,proto3" json:"repeated,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *FieldInfo) Reset() {
-	*x = FieldInfo{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FieldInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FieldInfo) ProtoMessage() {}
-
-func (x *FieldInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FieldInfo.ProtoReflect.Descriptor instead.
-func (*FieldInfo) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *FieldInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *FieldInfo) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *FieldInfo) GetNumber() int32 {
-	if x != nil {
-		return x.Number
-	}
-	return 0
-}
-
-func (x *FieldInfo) GetRepeated() bool {
-	if x != nil {
-		return x.Repeated
-	}
-	return false
-}
-
-// GenerateConfigRequest содержит данные для генерации конфигурации
-type GenerateConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProtoContent  string                 `protobuf:"bytes,1,opt,name=proto_content,json=protoContent,proto3" json:"proto_content,omitempty"`
-	Options       *ConfigOptions         `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateConfigRequest) Reset() {
-	*x = GenerateConfigRequest{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateConfigRequest) ProtoMessage() {}
-
-func (x *GenerateConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateConfigRequest.ProtoReflect.Descriptor instead.
-func (*GenerateConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GenerateConfigRequest) GetProtoContent() string {
-	if x != nil {
-		return x.ProtoContent
-	}
-	return ""
-}
-
-func (x *GenerateConfigRequest) GetOptions() *ConfigOptions {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-// ConfigOptions содержит опции генерации конфигурации
-type ConfigOptions struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetHost    string                 `protobuf:"bytes,1,opt,name=target_host,json=targetHost,proto3" json:"target_host,omitempty"`
-	TargetPort    int32                  `protobuf:"varint,2,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
-	CheckInterval int32                  `protobuf:"varint,3,opt,name=check_interval,json=checkInterval,proto3" json:"check_interval,omitempty"`
-	Timeout       int32                  `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ConfigOptions) Reset() {
-	*x = ConfigOptions{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ConfigOptions) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ConfigOptions) ProtoMessage() {}
-
-func (x *ConfigOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ConfigOptions.ProtoReflect.Descriptor instead.
-func (*ConfigOptions) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ConfigOptions) GetTargetHost() string {
-	if x != nil {
-		return x.TargetHost
-	}
-	return ""
-}
-
-func (x *ConfigOptions) GetTargetPort() int32 {
-	if x != nil {
-		return x.TargetPort
-	}
-	return 0
-}
-
-func (x *ConfigOptions) GetCheckInterval() int32 {
-	if x != nil {
-		return x.CheckInterval
-	}
-	return 0
-}
-
-func (x *ConfigOptions) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *ConfigOptions) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *ConfigOptions) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-// GenerateConfigResponse содержит сгенерированную конфигурацию
-type GenerateConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigYaml    string                 `protobuf:"bytes,1,opt,name=config_yaml,json=configYaml,proto3" json:"config_yaml,omitempty"`
-	CheckConfig   *CheckConfig           `protobuf:"bytes,2,opt,name=check_config,json=checkConfig,proto3" json:"check_config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateConfigResponse) Reset() {
-	*x = GenerateConfigResponse{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateConfigResponse) ProtoMessage() {}
-
-func (x *GenerateConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateConfigResponse.ProtoReflect.Descriptor instead.
-func (*GenerateConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GenerateConfigResponse) GetConfigYaml() string {
-	if x != nil {
-		return x.ConfigYaml
-	}
-	return ""
-}
-
-func (x *GenerateConfigResponse) GetCheckConfig() *CheckConfig {
-	if x != nil {
-		return x.CheckConfig
-	}
-	return nil
-}
-
-// CheckConfig содержит конфигурацию проверки
-type CheckConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          CheckType              `protobuf:"varint,2,opt,name=type,proto3,enum=uptimeping.forge.v1.CheckType" json:"type,omitempty"`
-	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	Interval      int32                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
-	Timeout       int32                  `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	Config        string                 `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CheckConfig) Reset() {
-	*x = CheckConfig{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CheckConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CheckConfig) ProtoMessage() {}
-
-func (x *CheckConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CheckConfig.ProtoReflect.Descriptor instead.
-func (*CheckConfig) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *CheckConfig) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CheckConfig) GetType() CheckType {
-	if x != nil {
-		return x.Type
-	}
-	return CheckType_CHECK_TYPE_UNSPECIFIED
-}
-
-func (x *CheckConfig) GetTarget() string {
-	if x != nil {
-		return x.Target
-	}
-	return ""
-}
-
-func (x *CheckConfig) GetInterval() int32 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *CheckConfig) GetTimeout() int32 {
-	if x != nil {
-		return x.Timeout
-	}
-	return 0
-}
-
-func (x *CheckConfig) GetConfig() string {
-	if x != nil {
-		return x.Config
-	}
-	return ""
-}
-
-// GenerateCodeRequest содержит данные для генерации кода
-type GenerateCodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProtoContent  string                 `protobuf:"bytes,1,opt,name=proto_content,json=protoContent,proto3" json:"proto_content,omitempty"`
-	Options       *CodeOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateCodeRequest) Reset() {
-	*x = GenerateCodeRequest{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateCodeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateCodeRequest) ProtoMessage() {}
-
-func (x *GenerateCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateCodeRequest.ProtoReflect.Descriptor instead.
-func (*GenerateCodeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GenerateCodeRequest) GetProtoContent() string {
-	if x != nil {
-		return x.ProtoContent
-	}
-	return ""
-}
-
-func (x *GenerateCodeRequest) GetOptions() *CodeOptions {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-// CodeOptions содержит опции генерации кода
-type CodeOptions struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
-	Framework     string                 `protobuf:"bytes,2,opt,name=framework,proto3" json:"framework,omitempty"`
-	Template      string                 `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CodeOptions) Reset() {
-	*x = CodeOptions{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CodeOptions) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CodeOptions) ProtoMessage() {}
-
-func (x *CodeOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[11]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CodeOptions.ProtoReflect.Descriptor instead.
-func (*CodeOptions) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *CodeOptions) GetLanguage() string {
-	if x != nil {
-		return x.Language
-	}
-	return ""
-}
-
-func (x *CodeOptions) GetFramework() string {
-	if x != nil {
-		return x.Framework
-	}
-	return ""
-}
-
-func (x *CodeOptions) GetTemplate() string {
-	if x != nil {
-		return x.Template
-	}
-	return ""
-}
-
-// GenerateCodeResponse содержит сгенерированный код
-type GenerateCodeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GenerateCodeResponse) Reset() {
-	*x = GenerateCodeResponse{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateCodeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GenerateCodeResponse) ProtoMessage() {}
-
-func (x *GenerateCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateCodeResponse.ProtoReflect.Descriptor instead.
-func (*GenerateCodeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GenerateCodeResponse) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *GenerateCodeResponse) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *GenerateCodeResponse) GetLanguage() string {
-	if x != nil {
-		return x.Language
-	}
-	return ""
-}
-
-// ValidateProtoRequest содержит данные для валидации .proto файла
-type ValidateProtoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProtoContent  string                 `protobuf:"bytes,1,opt,name=proto_content,json=protoContent,proto3" json:"proto_content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidateProtoRequest) Reset() {
-	*x = ValidateProtoRequest{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ValidateProtoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValidateProtoRequest) ProtoMessage() {}
-
-func (x *ValidateProtoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[13]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ValidateProtoRequest.ProtoReflect.Descriptor instead.
-func (*ValidateProtoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ValidateProtoRequest) GetProtoContent() string {
-	if x != nil {
-		return x.ProtoContent
-	}
-	return ""
-}
-
-// ValidateProtoResponse содержит результат валидации
-type ValidateProtoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsValid       bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
-	Warnings      []string               `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidateProtoResponse) Reset() {
-	*x = ValidateProtoResponse{}
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ValidateProtoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValidateProtoResponse) ProtoMessage() {}
-
-func (x *ValidateProtoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_forge_v1_forge_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ValidateProtoResponse.ProtoReflect.Descriptor instead.
-func (*ValidateProtoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_forge_v1_forge_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ValidateProtoResponse) GetIsValid() bool {
-	if x != nil {
-		return x.IsValid
-	}
-	return false
-}
-
-func (x *ValidateProtoResponse) GetErrors() []string {
-	if x != nil {
-		return x.Errors
-	}
-	return nil
-}
-
-func (x *ValidateProtoResponse) GetWarnings() []string {
-	if x != nil {
-		return x.Warnings
-	}
-	return nil
-}
-
-var File_proto_api_forge_v1_forge_proto protoreflect.FileDescriptor
-
-var file_proto_api_forge_v1_forge_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x67,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x13, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x55, 0x0a, 0x11, 0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x90, 0x01, 0x0a,
-	0x12, 0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x70, 0x74, 0x69,
-	0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22,
-	0xcc, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70,
-	0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
-	0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x9e,
-	0x01, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x50, 0x61, 0x74, 0x68, 0x22,
-	0x59, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x67, 0x0a, 0x09, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x22, 0x7a, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xba, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x6f,
-	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50,
-	0x6f, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67,
-	0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
-	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7e, 0x0a, 0x16,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x59, 0x61, 0x6d, 0x6c, 0x12, 0x43, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xbb, 0x01, 0x0a,
-	0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x32, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
-	0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x76, 0x0a, 0x13, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x63, 0x0a, 0x0b, 0x43, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x62, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x14, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
-	0x2a, 0x69, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
-	0x16, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x01, 0x12, 0x13,
-	0x0a, 0x0f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x50,
-	0x43, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x51, 0x4c, 0x10, 0x03, 0x32, 0xad, 0x03, 0x0a, 0x0c,
-	0x46, 0x6f, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x0a,
-	0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x26, 0x2e, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a,
-	0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x2a, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x70,
-	0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x2e, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e,
-	0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x68, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x29, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67,
-	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x69, 0x6f, 0x6e,
-	0x6f, 0x76, 0x5f, 0x76, 0x5f, 0x61, 0x6c, 0x2f, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x69,
-	0x6e, 0x67, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_api_forge_v1_forge_proto_rawDescOnce sync.Once
-	file_proto_api_forge_v1_forge_proto_rawDescData = file_proto_api_forge_v1_forge_proto_rawDesc
-)
-
-func file_proto_api_forge_v1_forge_proto_rawDescGZIP() []byte {
-	file_proto_api_forge_v1_forge_proto_rawDescOnce.Do(func() {
-		file_proto_api_forge_v1_forge_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_api_forge_v1_forge_proto_rawDescData)
-	})
-	return file_proto_api_forge_v1_forge_proto_rawDescData
-}
-
-var file_proto_api_forge_v1_forge_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_api_forge_v1_forge_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_proto_api_forge_v1_forge_proto_goTypes = []any{
-	(CheckType)(0),                 // 0: uptimeping.forge.v1.CheckType
-	(*ParseProtoRequest)(nil),      // 1: uptimeping.forge.v1.ParseProtoRequest
-	(*ParseProtoResponse)(nil),     // 2: uptimeping.forge.v1.ParseProtoResponse
-	(*ServiceInfo)(nil),            // 3: uptimeping.forge.v1.ServiceInfo
-	(*MethodInfo)(nil),             // 4: uptimeping.forge.v1.MethodInfo
-	(*MessageInfo)(nil),            // 5: uptimeping.forge.v1.MessageInfo
-	(*FieldInfo)(nil),              // 6: uptimeping.forge.v1.FieldInfo
-	(*GenerateConfigRequest)(nil),  // 7: uptimeping.forge.v1.GenerateConfigRequest
-	(*ConfigOptions)(nil),          // 8: uptimeping.forge.v1.ConfigOptions
-	(*GenerateConfigResponse)(nil), // 9: uptimeping.forge.v1.GenerateConfigResponse
-	(*CheckConfig)(nil),            // 10: uptimeping.forge.v1.CheckConfig
-	(*GenerateCodeRequest)(nil),    // 11: uptimeping.forge.v1.GenerateCodeRequest
-	(*CodeOptions)(nil),            // 12: uptimeping.forge.v1.CodeOptions
-	(*GenerateCodeResponse)(nil),   // 13: uptimeping.forge.v1.GenerateCodeResponse
-	(*ValidateProtoRequest)(nil),   // 14: uptimeping.forge.v1.ValidateProtoRequest
-	(*ValidateProtoResponse)(nil),  // 15: uptimeping.forge.v1.ValidateProtoResponse
-	nil,                            // 16: uptimeping.forge.v1.ConfigOptions.MetadataEntry
-}
-var file_proto_api_forge_v1_forge_proto_depIdxs = []int32{
-	3,  // 0: uptimeping.forge.v1.ParseProtoResponse.service_info:type_name -> uptimeping.forge.v1.ServiceInfo
-	4,  // 1: uptimeping.forge.v1.ServiceInfo.methods:type_name -> uptimeping.forge.v1.MethodInfo
-	5,  // 2: uptimeping.forge.v1.ServiceInfo.messages:type_name -> uptimeping.forge.v1.MessageInfo
-	6,  // 3: uptimeping.forge.v1.MessageInfo.fields:type_name -> uptimeping.forge.v1.FieldInfo
-	8,  // 4: uptimeping.forge.v1.GenerateConfigRequest.options:type_name -> uptimeping.forge.v1.ConfigOptions
-	16, // 5: uptimeping.forge.v1.ConfigOptions.metadata:type_name -> uptimeping.forge.v1.ConfigOptions.MetadataEntry
-	10, // 6: uptimeping.forge.v1.GenerateConfigResponse.check_config:type_name -> uptimeping.forge.v1.CheckConfig
-	0,  // 7: uptimeping.forge.v1.CheckConfig.type:type_name -> uptimeping.forge.v1.CheckType
-	12, // 8: uptimeping.forge.v1.GenerateCodeRequest.options:type_name -> uptimeping.forge.v1.CodeOptions
-	1,  // 9: uptimeping.forge.v1.ForgeService.ParseProto:input_type -> uptimeping.forge.v1.ParseProtoRequest
-	7,  // 10: uptimeping.forge.v1.ForgeService.GenerateConfig:input_type -> uptimeping.forge.v1.GenerateConfigRequest
-	11, // 11: uptimeping.forge.v1.ForgeService.GenerateCode:input_type -> uptimeping.forge.v1.GenerateCodeRequest
-	14, // 12: uptimeping.forge.v1.ForgeService.ValidateProto:input_type -> uptimeping.forge.v1.ValidateProtoRequest
-	2,  // 13: uptimeping.forge.v1.ForgeService.ParseProto:output_type -> uptimeping.forge.v1.ParseProtoResponse
-	9,  // 14: uptimeping.forge.v1.ForgeService.GenerateConfig:output_type -> uptimeping.forge.v1.GenerateConfigResponse
-	13, // 15: uptimeping.forge.v1.ForgeService.GenerateCode:output_type -> uptimeping.forge.v1.GenerateCodeResponse
-	15, // 16: uptimeping.forge.v1.ForgeService.ValidateProto:output_type -> uptimeping.forge.v1.ValidateProtoResponse
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
-}
-
-func init() { file_proto_api_forge_v1_forge_proto_init() }
-func file_proto_api_forge_v1_forge_proto_init() {
-	if File_proto_api_forge_v1_forge_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_api_forge_v1_forge_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_api_forge_v1_forge_proto_goTypes,
-		DependencyIndexes: file_proto_api_forge_v1_forge_proto_depIdxs,
-		EnumInfos:         file_proto_api_forge_v1_forge_proto_enumTypes,
-		MessageInfos:      file_proto_api_forge_v1_forge_proto_msgTypes,
-	}.Build()
-	File_proto_api_forge_v1_forge_proto = out.File
-	file_proto_api_forge_v1_forge_proto_rawDesc = nil
-	file_proto_api_forge_v1_forge_proto_goTypes = nil
-	file_proto_api_forge_v1_forge_proto_depIdxs = nil
-}
@@ -1,245 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: proto/api/forge/v1/forge.proto
-
-package v1
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	ForgeService_ParseProto_FullMethodName     = "/uptimeping.forge.v1.ForgeService/ParseProto"
-	ForgeService_GenerateConfig_FullMethodName = "/uptimeping.forge.v1.ForgeService/GenerateConfig"
-	ForgeService_GenerateCode_FullMethodName   = "/uptimeping.forge.v1.ForgeService/GenerateCode"
-	ForgeService_ValidateProto_FullMethodName  = "/uptimeping.forge.v1.ForgeService/ValidateProto"
-)
-
-// ForgeServiceClient is the client API for ForgeService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// ForgeService предоставляет методы для генерации конфигураций из .proto файлов
-type ForgeServiceClient interface {
-	// ParseProto загружает и парсит .proto файл
-	ParseProto(ctx context.Context, in *ParseProtoRequest, opts ...grpc.CallOption) (*ParseProtoResponse, error)
-	// GenerateConfig генерирует конфигурацию проверки из .proto файла
-	GenerateConfig(ctx context.Context, in *GenerateConfigRequest, opts ...grpc.CallOption) (*GenerateConfigResponse, error)
-	// GenerateCode генерирует код для проверки gRPC методов
-	GenerateCode(ctx context.Context, in *GenerateCodeRequest, opts ...grpc.CallOption) (*GenerateCodeResponse, error)
-	// ValidateProto проверяет валидность .proto файла
-	ValidateProto(ctx context.Context, in *ValidateProtoRequest, opts ...grpc.CallOption) (*ValidateProtoResponse, error)
-}
-
-type forgeServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewForgeServiceClient(cc grpc.ClientConnInterface) ForgeServiceClient {
-	return &forgeServiceClient{cc}
-}
-
-func (c *forgeServiceClient) ParseProto(ctx context.Context, in *ParseProtoRequest, opts ...grpc.CallOption) (*ParseProtoResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ParseProtoResponse)
-	err := c.cc.Invoke(ctx, ForgeService_ParseProto_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *forgeServiceClient) GenerateConfig(ctx context.Context, in *GenerateConfigRequest, opts ...grpc.CallOption) (*GenerateConfigResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GenerateConfigResponse)
-	err := c.cc.Invoke(ctx, ForgeService_GenerateConfig_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *forgeServiceClient) GenerateCode(ctx context.Context, in *GenerateCodeRequest, opts ...grpc.CallOption) (*GenerateCodeResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GenerateCodeResponse)
-	err := c.cc.Invoke(ctx, ForgeService_GenerateCode_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *forgeServiceClient) ValidateProto(ctx context.Context, in *ValidateProtoRequest, opts ...grpc.CallOption) (*ValidateProtoResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ValidateProtoResponse)
-	err := c.cc.Invoke(ctx, ForgeService_ValidateProto_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// ForgeServiceServer is the server API for ForgeService service.
-// All implementations should embed UnimplementedForgeServiceServer
-// for forward compatibility.
-//
-// ForgeService предоставляет методы для генерации конфигураций из .proto файлов
-type ForgeServiceServer interface {
-	// ParseProto загружает и парсит .proto файл
-	ParseProto(context.Context, *ParseProtoRequest) (*ParseProtoResponse, error)
-	// GenerateConfig генерирует конфигурацию проверки из .proto файла
-	GenerateConfig(context.Context, *GenerateConfigRequest) (*GenerateConfigResponse, error)
-	// GenerateCode генерирует код для проверки gRPC методов
-	GenerateCode(context.Context, *GenerateCodeRequest) (*GenerateCodeResponse, error)
-	// ValidateProto проверяет валидность .proto файла
-	ValidateProto(context.Context, *ValidateProtoRequest) (*ValidateProtoResponse, error)
-}
-
-// UnimplementedForgeServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedForgeServiceServer struct{}
-
-func (UnimplementedForgeServiceServer) ParseProto(context.Context, *ParseProtoRequest) (*ParseProtoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParseProto not implemented")
-}
-func (UnimplementedForgeServiceServer) GenerateConfig(context.Context, *GenerateConfigRequest) (*GenerateConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfig not implemented")
-}
-func (UnimplementedForgeServiceServer) GenerateCode(context.Context, *GenerateCodeRequest) (*GenerateCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateCode not implemented")
-}
-func (UnimplementedForgeServiceServer) ValidateProto(context.Context, *ValidateProtoRequest) (*ValidateProtoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateProto not implemented")
-}
-func (UnimplementedForgeServiceServer) testEmbeddedByValue() {}
-
-// UnsafeForgeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ForgeServiceServer will
-// result in compilation errors.
-type UnsafeForgeServiceServer interface {
-	mustEmbedUnimplementedForgeServiceServer()
-}
-
-func RegisterForgeServiceServer(s grpc.ServiceRegistrar, srv ForgeServiceServer) {
-	// If the following call pancis, it indicates UnimplementedForgeServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&ForgeService_ServiceDesc, srv)
-}
-
-func _ForgeService_ParseProto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParseProtoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).ParseProto(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_ParseProto_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).ParseProto(ctx, req.(*ParseProtoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ForgeService_GenerateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).GenerateConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_GenerateConfig_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).GenerateConfig(ctx, req.(*GenerateConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ForgeService_GenerateCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateCodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).GenerateCode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_GenerateCode_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).GenerateCode(ctx, req.(*GenerateCodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ForgeService_ValidateProto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateProtoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).ValidateProto(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_ValidateProto_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).ValidateProto(ctx, req.(*ValidateProtoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// ForgeService_ServiceDesc is the grpc.ServiceDesc for ForgeService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ForgeService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "uptimeping.forge.v1.ForgeService",
-	HandlerType: (*ForgeServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ParseProto",
-			Handler:    _ForgeService_ParseProto_Handler,
-		},
-		{
-			MethodName: "GenerateConfig",
-			Handler:    _ForgeService_GenerateConfig_Handler,
-		},
-		{
-			MethodName: "GenerateCode",
-			Handler:    _ForgeService_GenerateCode_Handler,
-		},
-		{
-			MethodName: "ValidateProto",
-			Handler:    _ForgeService_ValidateProto_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/api/forge/v1/forge.proto",
-}
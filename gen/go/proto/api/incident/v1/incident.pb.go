@@ -1,1027 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.1
-// 	protoc        (unknown)
-// source: proto/api/incident/v1/incident.proto
-
-package v1
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// IncidentStatus определяет статус инцидента
-type IncidentStatus int32
-
-const (
-	IncidentStatus_INCIDENT_STATUS_UNSPECIFIED  IncidentStatus = 0
-	IncidentStatus_INCIDENT_STATUS_OPEN         IncidentStatus = 1
-	IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED IncidentStatus = 2
-	IncidentStatus_INCIDENT_STATUS_RESOLVED     IncidentStatus = 3
-)
-
-// Enum value maps for IncidentStatus.
-var (
-	IncidentStatus_name = map[int32]string{
-		0: "INCIDENT_STATUS_UNSPECIFIED",
-		1: "INCIDENT_STATUS_OPEN",
-		2: "INCIDENT_STATUS_ACKNOWLEDGED",
-		3: "INCIDENT_STATUS_RESOLVED",
-	}
-	IncidentStatus_value = map[string]int32{
-		"INCIDENT_STATUS_UNSPECIFIED":  0,
-		"INCIDENT_STATUS_OPEN":         1,
-		"INCIDENT_STATUS_ACKNOWLEDGED": 2,
-		"INCIDENT_STATUS_RESOLVED":     3,
-	}
-)
-
-func (x IncidentStatus) Enum() *IncidentStatus {
-	p := new(IncidentStatus)
-	*p = x
-	return p
-}
-
-func (x IncidentStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (IncidentStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_api_incident_v1_incident_proto_enumTypes[0].Descriptor()
-}
-
-func (IncidentStatus) Type() protoreflect.EnumType {
-	return &file_proto_api_incident_v1_incident_proto_enumTypes[0]
-}
-
-func (x IncidentStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use IncidentStatus.Descriptor instead.
-func (IncidentStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{0}
-}
-
-// IncidentSeverity определяет серьезность инцидента
-type IncidentSeverity int32
-
-const (
-	IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED IncidentSeverity = 0
-	IncidentSeverity_INCIDENT_SEVERITY_WARNING     IncidentSeverity = 1
-	IncidentSeverity_INCIDENT_SEVERITY_ERROR       IncidentSeverity = 2
-	IncidentSeverity_INCIDENT_SEVERITY_CRITICAL    IncidentSeverity = 3
-)
-
-// Enum value maps for IncidentSeverity.
-var (
-	IncidentSeverity_name = map[int32]string{
-		0: "INCIDENT_SEVERITY_UNSPECIFIED",
-		1: "INCIDENT_SEVERITY_WARNING",
-		2: "INCIDENT_SEVERITY_ERROR",
-		3: "INCIDENT_SEVERITY_CRITICAL",
-	}
-	IncidentSeverity_value = map[string]int32{
-		"INCIDENT_SEVERITY_UNSPECIFIED": 0,
-		"INCIDENT_SEVERITY_WARNING":     1,
-		"INCIDENT_SEVERITY_ERROR":       2,
-		"INCIDENT_SEVERITY_CRITICAL":    3,
-	}
-)
-
-func (x IncidentSeverity) Enum() *IncidentSeverity {
-	p := new(IncidentSeverity)
-	*p = x
-	return p
-}
-
-func (x IncidentSeverity) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (IncidentSeverity) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_api_incident_v1_incident_proto_enumTypes[1].Descriptor()
-}
-
-func (IncidentSeverity) Type() protoreflect.EnumType {
-	return &file_proto_api_incident_v1_incident_proto_enumTypes[1]
-}
-
-func (x IncidentSeverity) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use IncidentSeverity.Descriptor instead.
-func (IncidentSeverity) EnumDescriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{1}
-}
-
-// Incident представляет инцидент системы
-type Incident struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CheckId       string                 `protobuf:"bytes,2,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Status        IncidentStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=uptimeping.incident.v1.IncidentStatus" json:"status,omitempty"`
-	Severity      IncidentSeverity       `protobuf:"varint,5,opt,name=severity,proto3,enum=uptimeping.incident.v1.IncidentSeverity" json:"severity,omitempty"`
-	FirstSeen     string                 `protobuf:"bytes,6,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
-	LastSeen      string                 `protobuf:"bytes,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	Count         int32                  `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	ErrorHash     string                 `protobuf:"bytes,10,opt,name=error_hash,json=errorHash,proto3" json:"error_hash,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Incident) Reset() {
-	*x = Incident{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Incident) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Incident) ProtoMessage() {}
-
-func (x *Incident) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
-func (*Incident) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Incident) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Incident) GetCheckId() string {
-	if x != nil {
-		return x.CheckId
-	}
-	return ""
-}
-
-func (x *Incident) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *Incident) GetStatus() IncidentStatus {
-	if x != nil {
-		return x.Status
-	}
-	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
-}
-
-func (x *Incident) GetSeverity() IncidentSeverity {
-	if x != nil {
-		return x.Severity
-	}
-	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
-}
-
-func (x *Incident) GetFirstSeen() string {
-	if x != nil {
-		return x.FirstSeen
-	}
-	return ""
-}
-
-func (x *Incident) GetLastSeen() string {
-	if x != nil {
-		return x.LastSeen
-	}
-	return ""
-}
-
-func (x *Incident) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *Incident) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
-func (x *Incident) GetErrorHash() string {
-	if x != nil {
-		return x.ErrorHash
-	}
-	return ""
-}
-
-// CreateIncidentRequest содержит данные для создания инцидента
-type CreateIncidentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CheckId       string                 `protobuf:"bytes,1,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Severity      IncidentSeverity       `protobuf:"varint,3,opt,name=severity,proto3,enum=uptimeping.incident.v1.IncidentSeverity" json:"severity,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateIncidentRequest) Reset() {
-	*x = CreateIncidentRequest{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateIncidentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateIncidentRequest) ProtoMessage() {}
-
-func (x *CreateIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateIncidentRequest.ProtoReflect.Descriptor instead.
-func (*CreateIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreateIncidentRequest) GetCheckId() string {
-	if x != nil {
-		return x.CheckId
-	}
-	return ""
-}
-
-func (x *CreateIncidentRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *CreateIncidentRequest) GetSeverity() IncidentSeverity {
-	if x != nil {
-		return x.Severity
-	}
-	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
-}
-
-func (x *CreateIncidentRequest) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
-// UpdateIncidentRequest содержит данные для обновления инцидента
-type UpdateIncidentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IncidentId    string                 `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	Status        IncidentStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=uptimeping.incident.v1.IncidentStatus" json:"status,omitempty"`
-	Severity      IncidentSeverity       `protobuf:"varint,3,opt,name=severity,proto3,enum=uptimeping.incident.v1.IncidentSeverity" json:"severity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateIncidentRequest) Reset() {
-	*x = UpdateIncidentRequest{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateIncidentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateIncidentRequest) ProtoMessage() {}
-
-func (x *UpdateIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateIncidentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *UpdateIncidentRequest) GetIncidentId() string {
-	if x != nil {
-		return x.IncidentId
-	}
-	return ""
-}
-
-func (x *UpdateIncidentRequest) GetStatus() IncidentStatus {
-	if x != nil {
-		return x.Status
-	}
-	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
-}
-
-func (x *UpdateIncidentRequest) GetSeverity() IncidentSeverity {
-	if x != nil {
-		return x.Severity
-	}
-	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
-}
-
-// ResolveIncidentRequest содержит ID инцидента для закрытия
-type ResolveIncidentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IncidentId    string                 `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ResolveIncidentRequest) Reset() {
-	*x = ResolveIncidentRequest{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ResolveIncidentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ResolveIncidentRequest) ProtoMessage() {}
-
-func (x *ResolveIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ResolveIncidentRequest.ProtoReflect.Descriptor instead.
-func (*ResolveIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *ResolveIncidentRequest) GetIncidentId() string {
-	if x != nil {
-		return x.IncidentId
-	}
-	return ""
-}
-
-// ResolveIncidentResponse подтверждает закрытие инцидента
-type ResolveIncidentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ResolveIncidentResponse) Reset() {
-	*x = ResolveIncidentResponse{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ResolveIncidentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ResolveIncidentResponse) ProtoMessage() {}
-
-func (x *ResolveIncidentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ResolveIncidentResponse.ProtoReflect.Descriptor instead.
-func (*ResolveIncidentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ResolveIncidentResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-// ListIncidentsRequest содержит параметры фильтрации и пагинации
-type ListIncidentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Status        IncidentStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=uptimeping.incident.v1.IncidentStatus" json:"status,omitempty"`
-	Severity      IncidentSeverity       `protobuf:"varint,3,opt,name=severity,proto3,enum=uptimeping.incident.v1.IncidentSeverity" json:"severity,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     int32                  `protobuf:"varint,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListIncidentsRequest) Reset() {
-	*x = ListIncidentsRequest{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListIncidentsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListIncidentsRequest) ProtoMessage() {}
-
-func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
-func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListIncidentsRequest) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
-	}
-	return ""
-}
-
-func (x *ListIncidentsRequest) GetStatus() IncidentStatus {
-	if x != nil {
-		return x.Status
-	}
-	return IncidentStatus_INCIDENT_STATUS_UNSPECIFIED
-}
-
-func (x *ListIncidentsRequest) GetSeverity() IncidentSeverity {
-	if x != nil {
-		return x.Severity
-	}
-	return IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED
-}
-
-func (x *ListIncidentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListIncidentsRequest) GetPageToken() int32 {
-	if x != nil {
-		return x.PageToken
-	}
-	return 0
-}
-
-// ListIncidentsResponse содержит список инцидентов
-type ListIncidentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Incidents     []*Incident            `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
-	NextPageToken int32                  `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListIncidentsResponse) Reset() {
-	*x = ListIncidentsResponse{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListIncidentsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListIncidentsResponse) ProtoMessage() {}
-
-func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
-func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ListIncidentsResponse) GetIncidents() []*Incident {
-	if x != nil {
-		return x.Incidents
-	}
-	return nil
-}
-
-func (x *ListIncidentsResponse) GetNextPageToken() int32 {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return 0
-}
-
-// GetIncidentRequest содержит ID инцидента
-type GetIncidentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IncidentId    string                 `protobuf:"bytes,1,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetIncidentRequest) Reset() {
-	*x = GetIncidentRequest{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetIncidentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetIncidentRequest) ProtoMessage() {}
-
-func (x *GetIncidentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetIncidentRequest.ProtoReflect.Descriptor instead.
-func (*GetIncidentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *GetIncidentRequest) GetIncidentId() string {
-	if x != nil {
-		return x.IncidentId
-	}
-	return ""
-}
-
-// GetIncidentResponse содержит детали инцидента и его историю
-type GetIncidentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
-	Events        []*IncidentEvent       `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetIncidentResponse) Reset() {
-	*x = GetIncidentResponse{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetIncidentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetIncidentResponse) ProtoMessage() {}
-
-func (x *GetIncidentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetIncidentResponse.ProtoReflect.Descriptor instead.
-func (*GetIncidentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GetIncidentResponse) GetIncident() *Incident {
-	if x != nil {
-		return x.Incident
-	}
-	return nil
-}
-
-func (x *GetIncidentResponse) GetEvents() []*IncidentEvent {
-	if x != nil {
-		return x.Events
-	}
-	return nil
-}
-
-// IncidentEvent представляет событие в жизненном цикле инцидента
-type IncidentEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IncidentId    string                 `protobuf:"bytes,2,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *IncidentEvent) Reset() {
-	*x = IncidentEvent{}
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *IncidentEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*IncidentEvent) ProtoMessage() {}
-
-func (x *IncidentEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_api_incident_v1_incident_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use IncidentEvent.ProtoReflect.Descriptor instead.
-func (*IncidentEvent) Descriptor() ([]byte, []int) {
-	return file_proto_api_incident_v1_incident_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *IncidentEvent) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *IncidentEvent) GetIncidentId() string {
-	if x != nil {
-		return x.IncidentId
-	}
-	return ""
-}
-
-func (x *IncidentEvent) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *IncidentEvent) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *IncidentEvent) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *IncidentEvent) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-var File_proto_api_incident_v1_incident_proto protoreflect.FileDescriptor
-
-var file_proto_api_incident_v1_incident_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x63, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69,
-	0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x22, 0xee,
-	0x02, 0x0a, 0x08, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67,
-	0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69,
-	0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x22,
-	0xba, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
-	0x64, 0x12, 0x44, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67,
-	0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73,
-	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbe, 0x01, 0x0a,
-	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
-	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x75, 0x70, 0x74, 0x69,
-	0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72,
-	0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x22, 0x39, 0x0a,
-	0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
-	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xf5, 0x01,
-	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67,
-	0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69,
-	0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x09, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69,
-	0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26,
-	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x92, 0x01,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67,
-	0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x2a, 0x8b, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45,
-	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x43, 0x49, 0x44,
-	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10,
-	0x01, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44, 0x47, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10,
-	0x03, 0x2a, 0x91, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45,
-	0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x43,
-	0x49, 0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57,
-	0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x43, 0x49,
-	0x44, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x43, 0x49, 0x44, 0x45, 0x4e,
-	0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x43, 0x52, 0x49, 0x54, 0x49,
-	0x43, 0x41, 0x4c, 0x10, 0x03, 0x32, 0xab, 0x04, 0x0a, 0x0f, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x75, 0x70,
-	0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x63,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x12, 0x2d, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e,
-	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x6e,
-	0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70,
-	0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70,
-	0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x75, 0x70, 0x74,
-	0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d,
-	0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d,
-	0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e,
-	0x67, 0x2e, 0x69, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x63, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x72, 0x6f, 0x64, 0x69, 0x6f, 0x6e, 0x6f, 0x76, 0x5f, 0x76, 0x5f, 0x61, 0x6c, 0x2f,
-	0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x63,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_api_incident_v1_incident_proto_rawDescOnce sync.Once
-	file_proto_api_incident_v1_incident_proto_rawDescData = file_proto_api_incident_v1_incident_proto_rawDesc
-)
-
-func file_proto_api_incident_v1_incident_proto_rawDescGZIP() []byte {
-	file_proto_api_incident_v1_incident_proto_rawDescOnce.Do(func() {
-		file_proto_api_incident_v1_incident_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_api_incident_v1_incident_proto_rawDescData)
-	})
-	return file_proto_api_incident_v1_incident_proto_rawDescData
-}
-
-var file_proto_api_incident_v1_incident_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_api_incident_v1_incident_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
-var file_proto_api_incident_v1_incident_proto_goTypes = []any{
-	(IncidentStatus)(0),             // 0: uptimeping.incident.v1.IncidentStatus
-	(IncidentSeverity)(0),           // 1: uptimeping.incident.v1.IncidentSeverity
-	(*Incident)(nil),                // 2: uptimeping.incident.v1.Incident
-	(*CreateIncidentRequest)(nil),   // 3: uptimeping.incident.v1.CreateIncidentRequest
-	(*UpdateIncidentRequest)(nil),   // 4: uptimeping.incident.v1.UpdateIncidentRequest
-	(*ResolveIncidentRequest)(nil),  // 5: uptimeping.incident.v1.ResolveIncidentRequest
-	(*ResolveIncidentResponse)(nil), // 6: uptimeping.incident.v1.ResolveIncidentResponse
-	(*ListIncidentsRequest)(nil),    // 7: uptimeping.incident.v1.ListIncidentsRequest
-	(*ListIncidentsResponse)(nil),   // 8: uptimeping.incident.v1.ListIncidentsResponse
-	(*GetIncidentRequest)(nil),      // 9: uptimeping.incident.v1.GetIncidentRequest
-	(*GetIncidentResponse)(nil),     // 10: uptimeping.incident.v1.GetIncidentResponse
-	(*IncidentEvent)(nil),           // 11: uptimeping.incident.v1.IncidentEvent
-}
-var file_proto_api_incident_v1_incident_proto_depIdxs = []int32{
-	0,  // 0: uptimeping.incident.v1.Incident.status:type_name -> uptimeping.incident.v1.IncidentStatus
-	1,  // 1: uptimeping.incident.v1.Incident.severity:type_name -> uptimeping.incident.v1.IncidentSeverity
-	1,  // 2: uptimeping.incident.v1.CreateIncidentRequest.severity:type_name -> uptimeping.incident.v1.IncidentSeverity
-	0,  // 3: uptimeping.incident.v1.UpdateIncidentRequest.status:type_name -> uptimeping.incident.v1.IncidentStatus
-	1,  // 4: uptimeping.incident.v1.UpdateIncidentRequest.severity:type_name -> uptimeping.incident.v1.IncidentSeverity
-	0,  // 5: uptimeping.incident.v1.ListIncidentsRequest.status:type_name -> uptimeping.incident.v1.IncidentStatus
-	1,  // 6: uptimeping.incident.v1.ListIncidentsRequest.severity:type_name -> uptimeping.incident.v1.IncidentSeverity
-	2,  // 7: uptimeping.incident.v1.ListIncidentsResponse.incidents:type_name -> uptimeping.incident.v1.Incident
-	2,  // 8: uptimeping.incident.v1.GetIncidentResponse.incident:type_name -> uptimeping.incident.v1.Incident
-	11, // 9: uptimeping.incident.v1.GetIncidentResponse.events:type_name -> uptimeping.incident.v1.IncidentEvent
-	3,  // 10: uptimeping.incident.v1.IncidentService.CreateIncident:input_type -> uptimeping.incident.v1.CreateIncidentRequest
-	4,  // 11: uptimeping.incident.v1.IncidentService.UpdateIncident:input_type -> uptimeping.incident.v1.UpdateIncidentRequest
-	5,  // 12: uptimeping.incident.v1.IncidentService.ResolveIncident:input_type -> uptimeping.incident.v1.ResolveIncidentRequest
-	7,  // 13: uptimeping.incident.v1.IncidentService.ListIncidents:input_type -> uptimeping.incident.v1.ListIncidentsRequest
-	9,  // 14: uptimeping.incident.v1.IncidentService.GetIncident:input_type -> uptimeping.incident.v1.GetIncidentRequest
-	2,  // 15: uptimeping.incident.v1.IncidentService.CreateIncident:output_type -> uptimeping.incident.v1.Incident
-	2,  // 16: uptimeping.incident.v1.IncidentService.UpdateIncident:output_type -> uptimeping.incident.v1.Incident
-	6,  // 17: uptimeping.incident.v1.IncidentService.ResolveIncident:output_type -> uptimeping.incident.v1.ResolveIncidentResponse
-	8,  // 18: uptimeping.incident.v1.IncidentService.ListIncidents:output_type -> uptimeping.incident.v1.ListIncidentsResponse
-	10, // 19: uptimeping.incident.v1.IncidentService.GetIncident:output_type -> uptimeping.incident.v1.GetIncidentResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
-}
-
-func init() { file_proto_api_incident_v1_incident_proto_init() }
-func file_proto_api_incident_v1_incident_proto_init() {
-	if File_proto_api_incident_v1_incident_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_proto_api_incident_v1_incident_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   10,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_proto_api_incident_v1_incident_proto_goTypes,
-		DependencyIndexes: file_proto_api_incident_v1_incident_proto_depIdxs,
-		EnumInfos:         file_proto_api_incident_v1_incident_proto_enumTypes,
-		MessageInfos:      file_proto_api_incident_v1_incident_proto_msgTypes,
-	}.Build()
-	File_proto_api_incident_v1_incident_proto = out.File
-	file_proto_api_incident_v1_incident_proto_rawDesc = nil
-	file_proto_api_incident_v1_incident_proto_goTypes = nil
-	file_proto_api_incident_v1_incident_proto_depIdxs = nil
-}
@@ -1,285 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: proto/api/incident/v1/incident.proto
-
-package v1
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	IncidentService_CreateIncident_FullMethodName  = "/uptimeping.incident.v1.IncidentService/CreateIncident"
-	IncidentService_UpdateIncident_FullMethodName  = "/uptimeping.incident.v1.IncidentService/UpdateIncident"
-	IncidentService_ResolveIncident_FullMethodName = "/uptimeping.incident.v1.IncidentService/ResolveIncident"
-	IncidentService_ListIncidents_FullMethodName   = "/uptimeping.incident.v1.IncidentService/ListIncidents"
-	IncidentService_GetIncident_FullMethodName     = "/uptimeping.incident.v1.IncidentService/GetIncident"
-)
-
-// IncidentServiceClient is the client API for IncidentService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// IncidentService предоставляет методы для управления инцидентами
-type IncidentServiceClient interface {
-	// CreateIncident создает новый инцидент
-	CreateIncident(ctx context.Context, in *CreateIncidentRequest, opts ...grpc.CallOption) (*Incident, error)
-	// UpdateIncident обновляет существующий инцидент
-	UpdateIncident(ctx context.Context, in *UpdateIncidentRequest, opts ...grpc.CallOption) (*Incident, error)
-	// ResolveIncident закрывает инцидент
-	ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*ResolveIncidentResponse, error)
-	// ListIncidents возвращает список инцидентов с фильтрацией
-	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
-	// GetIncident возвращает детали инцидента
-	GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*GetIncidentResponse, error)
-}
-
-type incidentServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewIncidentServiceClient(cc grpc.ClientConnInterface) IncidentServiceClient {
-	return &incidentServiceClient{cc}
-}
-
-func (c *incidentServiceClient) CreateIncident(ctx context.Context, in *CreateIncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Incident)
-	err := c.cc.Invoke(ctx, IncidentService_CreateIncident_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *incidentServiceClient) UpdateIncident(ctx context.Context, in *UpdateIncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Incident)
-	err := c.cc.Invoke(ctx, IncidentService_UpdateIncident_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *incidentServiceClient) ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*ResolveIncidentResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ResolveIncidentResponse)
-	err := c.cc.Invoke(ctx, IncidentService_ResolveIncident_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *incidentServiceClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListIncidentsResponse)
-	err := c.cc.Invoke(ctx, IncidentService_ListIncidents_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *incidentServiceClient) GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*GetIncidentResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetIncidentResponse)
-	err := c.cc.Invoke(ctx, IncidentService_GetIncident_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// IncidentServiceServer is the server API for IncidentService service.
-// All implementations should embed UnimplementedIncidentServiceServer
-// for forward compatibility.
-//
-// IncidentService предоставляет методы для управления инцидентами
-type IncidentServiceServer interface {
-	// CreateIncident создает новый инцидент
-	CreateIncident(context.Context, *CreateIncidentRequest) (*Incident, error)
-	// UpdateIncident обновляет существующий инцидент
-	UpdateIncident(context.Context, *UpdateIncidentRequest) (*Incident, error)
-	// ResolveIncident закрывает инцидент
-	ResolveIncident(context.Context, *ResolveIncidentRequest) (*ResolveIncidentResponse, error)
-	// ListIncidents возвращает список инцидентов с фильтрацией
-	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
-	// GetIncident возвращает детали инцидента
-	GetIncident(context.Context, *GetIncidentRequest) (*GetIncidentResponse, error)
-}
-
-// UnimplementedIncidentServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedIncidentServiceServer struct{}
-
-func (UnimplementedIncidentServiceServer) CreateIncident(context.Context, *CreateIncidentRequest) (*Incident, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIncident not implemented")
-}
-func (UnimplementedIncidentServiceServer) UpdateIncident(context.Context, *UpdateIncidentRequest) (*Incident, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateIncident not implemented")
-}
-func (UnimplementedIncidentServiceServer) ResolveIncident(context.Context, *ResolveIncidentRequest) (*ResolveIncidentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveIncident not implemented")
-}
-func (UnimplementedIncidentServiceServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
-}
-func (UnimplementedIncidentServiceServer) GetIncident(context.Context, *GetIncidentRequest) (*GetIncidentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIncident not implemented")
-}
-func (UnimplementedIncidentServiceServer) testEmbeddedByValue() {}
-
-// UnsafeIncidentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to IncidentServiceServer will
-// result in compilation errors.
-type UnsafeIncidentServiceServer interface {
-	mustEmbedUnimplementedIncidentServiceServer()
-}
-
-func RegisterIncidentServiceServer(s grpc.ServiceRegistrar, srv IncidentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedIncidentServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&IncidentService_ServiceDesc, srv)
-}
-
-func _IncidentService_CreateIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateIncidentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(IncidentServiceServer).CreateIncident(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: IncidentService_CreateIncident_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IncidentServiceServer).CreateIncident(ctx, req.(*CreateIncidentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _IncidentService_UpdateIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateIncidentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(IncidentServiceServer).UpdateIncident(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: IncidentService_UpdateIncident_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IncidentServiceServer).UpdateIncident(ctx, req.(*UpdateIncidentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _IncidentService_ResolveIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResolveIncidentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(IncidentServiceServer).ResolveIncident(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: IncidentService_ResolveIncident_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IncidentServiceServer).ResolveIncident(ctx, req.(*ResolveIncidentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _IncidentService_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIncidentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(IncidentServiceServer).ListIncidents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: IncidentService_ListIncidents_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IncidentServiceServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _IncidentService_GetIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIncidentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(IncidentServiceServer).GetIncident(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: IncidentService_GetIncident_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IncidentServiceServer).GetIncident(ctx, req.(*GetIncidentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// IncidentService_ServiceDesc is the grpc.ServiceDesc for IncidentService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var IncidentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "uptimeping.incident.v1.IncidentService",
-	HandlerType: (*IncidentServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateIncident",
-			Handler:    _IncidentService_CreateIncident_Handler,
-		},
-		{
-			MethodName: "UpdateIncident",
-			Handler:    _IncidentService_UpdateIncident_Handler,
-		},
-		{
-			MethodName: "ResolveIncident",
-			Handler:    _IncidentService_ResolveIncident_Handler,
-		},
-		{
-			MethodName: "ListIncidents",
-			Handler:    _IncidentService_ListIncidents_Handler,
-		},
-		{
-			MethodName: "GetIncident",
-			Handler:    _IncidentService_GetIncident_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/api/incident/v1/incident.proto",
-}
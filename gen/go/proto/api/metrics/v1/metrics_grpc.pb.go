@@ -1,209 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: proto/api/metrics/v1/metrics.proto
-
-package v1
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	MetricsService_CollectMetrics_FullMethodName = "/uptimeping.metrics.v1.MetricsService/CollectMetrics"
-	MetricsService_ExportMetrics_FullMethodName  = "/uptimeping.metrics.v1.MetricsService/ExportMetrics"
-	MetricsService_GetMetrics_FullMethodName     = "/uptimeping.metrics.v1.MetricsService/GetMetrics"
-)
-
-// MetricsServiceClient is the client API for MetricsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// MetricsService предоставляет методы для сбора и экспорта метрик
-type MetricsServiceClient interface {
-	// CollectMetrics собирает метрики со всех сервисов
-	CollectMetrics(ctx context.Context, in *CollectMetricsRequest, opts ...grpc.CallOption) (*CollectMetricsResponse, error)
-	// ExportMetrics экспортирует метрики в указанный формат
-	ExportMetrics(ctx context.Context, in *ExportMetricsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMetricsResponse], error)
-	// GetMetrics возвращает текущие значения метрик
-	GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error)
-}
-
-type metricsServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewMetricsServiceClient(cc grpc.ClientConnInterface) MetricsServiceClient {
-	return &metricsServiceClient{cc}
-}
-
-func (c *metricsServiceClient) CollectMetrics(ctx context.Context, in *CollectMetricsRequest, opts ...grpc.CallOption) (*CollectMetricsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CollectMetricsResponse)
-	err := c.cc.Invoke(ctx, MetricsService_CollectMetrics_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *metricsServiceClient) ExportMetrics(ctx context.Context, in *ExportMetricsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportMetricsResponse], error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MetricsService_ServiceDesc.Streams[0], MetricsService_ExportMetrics_FullMethodName, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &grpc.GenericClientStream[ExportMetricsRequest, ExportMetricsResponse]{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type MetricsService_ExportMetricsClient = grpc.ServerStreamingClient[ExportMetricsResponse]
-
-func (c *metricsServiceClient) GetMetrics(ctx context.Context, in *GetMetricsRequest, opts ...grpc.CallOption) (*GetMetricsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetMetricsResponse)
-	err := c.cc.Invoke(ctx, MetricsService_GetMetrics_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// MetricsServiceServer is the server API for MetricsService service.
-// All implementations should embed UnimplementedMetricsServiceServer
-// for forward compatibility.
-//
-// MetricsService предоставляет методы для сбора и экспорта метрик
-type MetricsServiceServer interface {
-	// CollectMetrics собирает метрики со всех сервисов
-	CollectMetrics(context.Context, *CollectMetricsRequest) (*CollectMetricsResponse, error)
-	// ExportMetrics экспортирует метрики в указанный формат
-	ExportMetrics(*ExportMetricsRequest, grpc.ServerStreamingServer[ExportMetricsResponse]) error
-	// GetMetrics возвращает текущие значения метрик
-	GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error)
-}
-
-// UnimplementedMetricsServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMetricsServiceServer struct{}
-
-func (UnimplementedMetricsServiceServer) CollectMetrics(context.Context, *CollectMetricsRequest) (*CollectMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CollectMetrics not implemented")
-}
-func (UnimplementedMetricsServiceServer) ExportMetrics(*ExportMetricsRequest, grpc.ServerStreamingServer[ExportMetricsResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method ExportMetrics not implemented")
-}
-func (UnimplementedMetricsServiceServer) GetMetrics(context.Context, *GetMetricsRequest) (*GetMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
-}
-func (UnimplementedMetricsServiceServer) testEmbeddedByValue() {}
-
-// UnsafeMetricsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MetricsServiceServer will
-// result in compilation errors.
-type UnsafeMetricsServiceServer interface {
-	mustEmbedUnimplementedMetricsServiceServer()
-}
-
-func RegisterMetricsServiceServer(s grpc.ServiceRegistrar, srv MetricsServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMetricsServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&MetricsService_ServiceDesc, srv)
-}
-
-func _MetricsService_CollectMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CollectMetricsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetricsServiceServer).CollectMetrics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MetricsService_CollectMetrics_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetricsServiceServer).CollectMetrics(ctx, req.(*CollectMetricsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _MetricsService_ExportMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ExportMetricsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(MetricsServiceServer).ExportMetrics(m, &grpc.GenericServerStream[ExportMetricsRequest, ExportMetricsResponse]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type MetricsService_ExportMetricsServer = grpc.ServerStreamingServer[ExportMetricsResponse]
-
-func _MetricsService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetricsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetricsServiceServer).GetMetrics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MetricsService_GetMetrics_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetricsServiceServer).GetMetrics(ctx, req.(*GetMetricsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// MetricsService_ServiceDesc is the grpc.ServiceDesc for MetricsService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MetricsService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "uptimeping.metrics.v1.MetricsService",
-	HandlerType: (*MetricsServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CollectMetrics",
-			Handler:    _MetricsService_CollectMetrics_Handler,
-		},
-		{
-			MethodName: "GetMetrics",
-			Handler:    _MetricsService_GetMetrics_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ExportMetrics",
-			Handler:       _MetricsService_ExportMetrics_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/api/metrics/v1/metrics.proto",
-}
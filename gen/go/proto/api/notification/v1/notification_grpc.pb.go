@@ -1,245 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: proto/api/notification/v1/notification.proto
-
-package v1
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	NotificationService_SendNotification_FullMethodName  = "/uptimeping.notification.v1.NotificationService/SendNotification"
-	NotificationService_RegisterChannel_FullMethodName   = "/uptimeping.notification.v1.NotificationService/RegisterChannel"
-	NotificationService_UnregisterChannel_FullMethodName = "/uptimeping.notification.v1.NotificationService/UnregisterChannel"
-	NotificationService_ListChannels_FullMethodName      = "/uptimeping.notification.v1.NotificationService/ListChannels"
-)
-
-// NotificationServiceClient is the client API for NotificationService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// NotificationService предоставляет методы для отправки уведомлений
-type NotificationServiceClient interface {
-	// SendNotification отправляет уведомление через указанные каналы
-	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
-	// RegisterChannel регистрирует новый канал уведомлений
-	RegisterChannel(ctx context.Context, in *RegisterChannelRequest, opts ...grpc.CallOption) (*Channel, error)
-	// UnregisterChannel удаляет канал уведомлений
-	UnregisterChannel(ctx context.Context, in *UnregisterChannelRequest, opts ...grpc.CallOption) (*UnregisterChannelResponse, error)
-	// ListChannels возвращает список каналов уведомлений
-	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
-}
-
-type notificationServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
-	return &notificationServiceClient{cc}
-}
-
-func (c *notificationServiceClient) SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SendNotificationResponse)
-	err := c.cc.Invoke(ctx, NotificationService_SendNotification_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *notificationServiceClient) RegisterChannel(ctx context.Context, in *RegisterChannelRequest, opts ...grpc.CallOption) (*Channel, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Channel)
-	err := c.cc.Invoke(ctx, NotificationService_RegisterChannel_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *notificationServiceClient) UnregisterChannel(ctx context.Context, in *UnregisterChannelRequest, opts ...grpc.CallOption) (*UnregisterChannelResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UnregisterChannelResponse)
-	err := c.cc.Invoke(ctx, NotificationService_UnregisterChannel_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *notificationServiceClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListChannelsResponse)
-	err := c.cc.Invoke(ctx, NotificationService_ListChannels_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// NotificationServiceServer is the server API for NotificationService service.
-// All implementations should embed UnimplementedNotificationServiceServer
-// for forward compatibility.
-//
-// NotificationService предоставляет методы для отправки уведомлений
-type NotificationServiceServer interface {
-	// SendNotification отправляет уведомление через указанные каналы
-	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
-	// RegisterChannel регистрирует новый канал уведомлений
-	RegisterChannel(context.Context, *RegisterChannelRequest) (*Channel, error)
-	// UnregisterChannel удаляет канал уведомлений
-	UnregisterChannel(context.Context, *UnregisterChannelRequest) (*UnregisterChannelResponse, error)
-	// ListChannels возвращает список каналов уведомлений
-	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
-}
-
-// UnimplementedNotificationServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedNotificationServiceServer struct{}
-
-func (UnimplementedNotificationServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
-}
-func (UnimplementedNotificationServiceServer) RegisterChannel(context.Context, *RegisterChannelRequest) (*Channel, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterChannel not implemented")
-}
-func (UnimplementedNotificationServiceServer) UnregisterChannel(context.Context, *UnregisterChannelRequest) (*UnregisterChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterChannel not implemented")
-}
-func (UnimplementedNotificationServiceServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
-}
-func (UnimplementedNotificationServiceServer) testEmbeddedByValue() {}
-
-// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NotificationServiceServer will
-// result in compilation errors.
-type UnsafeNotificationServiceServer interface {
-	mustEmbedUnimplementedNotificationServiceServer()
-}
-
-func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
-	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&NotificationService_ServiceDesc, srv)
-}
-
-func _NotificationService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendNotificationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NotificationServiceServer).SendNotification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: NotificationService_SendNotification_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotificationServiceServer).SendNotification(ctx, req.(*SendNotificationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _NotificationService_RegisterChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterChannelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NotificationServiceServer).RegisterChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: NotificationService_RegisterChannel_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotificationServiceServer).RegisterChannel(ctx, req.(*RegisterChannelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _NotificationService_UnregisterChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterChannelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NotificationServiceServer).UnregisterChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: NotificationService_UnregisterChannel_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotificationServiceServer).UnregisterChannel(ctx, req.(*UnregisterChannelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _NotificationService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListChannelsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NotificationServiceServer).ListChannels(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: NotificationService_ListChannels_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NotificationServiceServer).ListChannels(ctx, req.(*ListChannelsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var NotificationService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "uptimeping.notification.v1.NotificationService",
-	HandlerType: (*NotificationServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendNotification",
-			Handler:    _NotificationService_SendNotification_Handler,
-		},
-		{
-			MethodName: "RegisterChannel",
-			Handler:    _NotificationService_RegisterChannel_Handler,
-		},
-		{
-			MethodName: "UnregisterChannel",
-			Handler:    _NotificationService_UnregisterChannel_Handler,
-		},
-		{
-			MethodName: "ListChannels",
-			Handler:    _NotificationService_ListChannels_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/api/notification/v1/notification.proto",
-}
@@ -1,1695 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.1
-// 	protoc        (unknown)
-// source: services/forge-service/proto/example.proto
-
-package proto
-
-import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Формат конфигурации
-type ConfigFormat int32
-
-const (
-	ConfigFormat_CONFIG_FORMAT_UNSPECIFIED ConfigFormat = 0
-	ConfigFormat_CONFIG_FORMAT_JSON        ConfigFormat = 1
-	ConfigFormat_CONFIG_FORMAT_YAML        ConfigFormat = 2
-	ConfigFormat_CONFIG_FORMAT_TOML        ConfigFormat = 3
-	ConfigFormat_CONFIG_FORMAT_XML         ConfigFormat = 4
-)
-
-// Enum value maps for ConfigFormat.
-var (
-	ConfigFormat_name = map[int32]string{
-		0: "CONFIG_FORMAT_UNSPECIFIED",
-		1: "CONFIG_FORMAT_JSON",
-		2: "CONFIG_FORMAT_YAML",
-		3: "CONFIG_FORMAT_TOML",
-		4: "CONFIG_FORMAT_XML",
-	}
-	ConfigFormat_value = map[string]int32{
-		"CONFIG_FORMAT_UNSPECIFIED": 0,
-		"CONFIG_FORMAT_JSON":        1,
-		"CONFIG_FORMAT_YAML":        2,
-		"CONFIG_FORMAT_TOML":        3,
-		"CONFIG_FORMAT_XML":         4,
-	}
-)
-
-func (x ConfigFormat) Enum() *ConfigFormat {
-	p := new(ConfigFormat)
-	*p = x
-	return p
-}
-
-func (x ConfigFormat) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (ConfigFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_forge_service_proto_example_proto_enumTypes[0].Descriptor()
-}
-
-func (ConfigFormat) Type() protoreflect.EnumType {
-	return &file_services_forge_service_proto_example_proto_enumTypes[0]
-}
-
-func (x ConfigFormat) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use ConfigFormat.Descriptor instead.
-func (ConfigFormat) EnumDescriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{0}
-}
-
-// Статус конфигурации
-type ConfigStatus int32
-
-const (
-	ConfigStatus_CONFIG_STATUS_UNSPECIFIED ConfigStatus = 0
-	ConfigStatus_CONFIG_STATUS_DRAFT       ConfigStatus = 1
-	ConfigStatus_CONFIG_STATUS_ACTIVE      ConfigStatus = 2
-	ConfigStatus_CONFIG_STATUS_DEPRECATED  ConfigStatus = 3
-	ConfigStatus_CONFIG_STATUS_ARCHIVED    ConfigStatus = 4
-)
-
-// Enum value maps for ConfigStatus.
-var (
-	ConfigStatus_name = map[int32]string{
-		0: "CONFIG_STATUS_UNSPECIFIED",
-		1: "CONFIG_STATUS_DRAFT",
-		2: "CONFIG_STATUS_ACTIVE",
-		3: "CONFIG_STATUS_DEPRECATED",
-		4: "CONFIG_STATUS_ARCHIVED",
-	}
-	ConfigStatus_value = map[string]int32{
-		"CONFIG_STATUS_UNSPECIFIED": 0,
-		"CONFIG_STATUS_DRAFT":       1,
-		"CONFIG_STATUS_ACTIVE":      2,
-		"CONFIG_STATUS_DEPRECATED":  3,
-		"CONFIG_STATUS_ARCHIVED":    4,
-	}
-)
-
-func (x ConfigStatus) Enum() *ConfigStatus {
-	p := new(ConfigStatus)
-	*p = x
-	return p
-}
-
-func (x ConfigStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (ConfigStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_forge_service_proto_example_proto_enumTypes[1].Descriptor()
-}
-
-func (ConfigStatus) Type() protoreflect.EnumType {
-	return &file_services_forge_service_proto_example_proto_enumTypes[1]
-}
-
-func (x ConfigStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use ConfigStatus.Descriptor instead.
-func (ConfigStatus) EnumDescriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{1}
-}
-
-// Уровень валидации
-type ValidationLevel int32
-
-const (
-	ValidationLevel_VALIDATION_LEVEL_UNSPECIFIED   ValidationLevel = 0
-	ValidationLevel_VALIDATION_LEVEL_BASIC         ValidationLevel = 1
-	ValidationLevel_VALIDATION_LEVEL_STRICT        ValidationLevel = 2
-	ValidationLevel_VALIDATION_LEVEL_COMPREHENSIVE ValidationLevel = 3
-)
-
-// Enum value maps for ValidationLevel.
-var (
-	ValidationLevel_name = map[int32]string{
-		0: "VALIDATION_LEVEL_UNSPECIFIED",
-		1: "VALIDATION_LEVEL_BASIC",
-		2: "VALIDATION_LEVEL_STRICT",
-		3: "VALIDATION_LEVEL_COMPREHENSIVE",
-	}
-	ValidationLevel_value = map[string]int32{
-		"VALIDATION_LEVEL_UNSPECIFIED":   0,
-		"VALIDATION_LEVEL_BASIC":         1,
-		"VALIDATION_LEVEL_STRICT":        2,
-		"VALIDATION_LEVEL_COMPREHENSIVE": 3,
-	}
-)
-
-func (x ValidationLevel) Enum() *ValidationLevel {
-	p := new(ValidationLevel)
-	*p = x
-	return p
-}
-
-func (x ValidationLevel) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (ValidationLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_forge_service_proto_example_proto_enumTypes[2].Descriptor()
-}
-
-func (ValidationLevel) Type() protoreflect.EnumType {
-	return &file_services_forge_service_proto_example_proto_enumTypes[2]
-}
-
-func (x ValidationLevel) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use ValidationLevel.Descriptor instead.
-func (ValidationLevel) EnumDescriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{2}
-}
-
-// Коды ошибок валидации
-type ValidationErrorCode int32
-
-const (
-	ValidationErrorCode_VALIDATION_ERROR_CODE_UNSPECIFIED ValidationErrorCode = 0
-	ValidationErrorCode_VALIDATION_ERROR_CODE_SYNTAX      ValidationErrorCode = 1
-	ValidationErrorCode_VALIDATION_ERROR_CODE_SCHEMA      ValidationErrorCode = 2
-	ValidationErrorCode_VALIDATION_ERROR_CODE_DEPENDENCY  ValidationErrorCode = 3
-	ValidationErrorCode_VALIDATION_ERROR_CODE_SECURITY    ValidationErrorCode = 4
-	ValidationErrorCode_VALIDATION_ERROR_CODE_PERFORMANCE ValidationErrorCode = 5
-)
-
-// Enum value maps for ValidationErrorCode.
-var (
-	ValidationErrorCode_name = map[int32]string{
-		0: "VALIDATION_ERROR_CODE_UNSPECIFIED",
-		1: "VALIDATION_ERROR_CODE_SYNTAX",
-		2: "VALIDATION_ERROR_CODE_SCHEMA",
-		3: "VALIDATION_ERROR_CODE_DEPENDENCY",
-		4: "VALIDATION_ERROR_CODE_SECURITY",
-		5: "VALIDATION_ERROR_CODE_PERFORMANCE",
-	}
-	ValidationErrorCode_value = map[string]int32{
-		"VALIDATION_ERROR_CODE_UNSPECIFIED": 0,
-		"VALIDATION_ERROR_CODE_SYNTAX":      1,
-		"VALIDATION_ERROR_CODE_SCHEMA":      2,
-		"VALIDATION_ERROR_CODE_DEPENDENCY":  3,
-		"VALIDATION_ERROR_CODE_SECURITY":    4,
-		"VALIDATION_ERROR_CODE_PERFORMANCE": 5,
-	}
-)
-
-func (x ValidationErrorCode) Enum() *ValidationErrorCode {
-	p := new(ValidationErrorCode)
-	*p = x
-	return p
-}
-
-func (x ValidationErrorCode) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (ValidationErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_forge_service_proto_example_proto_enumTypes[3].Descriptor()
-}
-
-func (ValidationErrorCode) Type() protoreflect.EnumType {
-	return &file_services_forge_service_proto_example_proto_enumTypes[3]
-}
-
-func (x ValidationErrorCode) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use ValidationErrorCode.Descriptor instead.
-func (ValidationErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{3}
-}
-
-// Коды предупреждений валидации
-type ValidationWarningCode int32
-
-const (
-	ValidationWarningCode_VALIDATION_WARNING_CODE_UNSPECIFIED    ValidationWarningCode = 0
-	ValidationWarningCode_VALIDATION_WARNING_CODE_DEPRECATED     ValidationWarningCode = 1
-	ValidationWarningCode_VALIDATION_WARNING_CODE_RECOMMENDATION ValidationWarningCode = 2
-	ValidationWarningCode_VALIDATION_WARNING_CODE_STYLE          ValidationWarningCode = 3
-)
-
-// Enum value maps for ValidationWarningCode.
-var (
-	ValidationWarningCode_name = map[int32]string{
-		0: "VALIDATION_WARNING_CODE_UNSPECIFIED",
-		1: "VALIDATION_WARNING_CODE_DEPRECATED",
-		2: "VALIDATION_WARNING_CODE_RECOMMENDATION",
-		3: "VALIDATION_WARNING_CODE_STYLE",
-	}
-	ValidationWarningCode_value = map[string]int32{
-		"VALIDATION_WARNING_CODE_UNSPECIFIED":    0,
-		"VALIDATION_WARNING_CODE_DEPRECATED":     1,
-		"VALIDATION_WARNING_CODE_RECOMMENDATION": 2,
-		"VALIDATION_WARNING_CODE_STYLE":          3,
-	}
-)
-
-func (x ValidationWarningCode) Enum() *ValidationWarningCode {
-	p := new(ValidationWarningCode)
-	*p = x
-	return p
-}
-
-func (x ValidationWarningCode) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (ValidationWarningCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_services_forge_service_proto_example_proto_enumTypes[4].Descriptor()
-}
-
-func (ValidationWarningCode) Type() protoreflect.EnumType {
-	return &file_services_forge_service_proto_example_proto_enumTypes[4]
-}
-
-func (x ValidationWarningCode) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use ValidationWarningCode.Descriptor instead.
-func (ValidationWarningCode) EnumDescriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{4}
-}
-
-// Запрос на создание конфигурации
-type CreateConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	ConfigContent string                 `protobuf:"bytes,2,opt,name=config_content,json=configContent,proto3" json:"config_content,omitempty"`
-	Format        ConfigFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=forge.example.ConfigFormat" json:"format,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateConfigRequest) Reset() {
-	*x = CreateConfigRequest{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateConfigRequest) ProtoMessage() {}
-
-func (x *CreateConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateConfigRequest.ProtoReflect.Descriptor instead.
-func (*CreateConfigRequest) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *CreateConfigRequest) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
-}
-
-func (x *CreateConfigRequest) GetConfigContent() string {
-	if x != nil {
-		return x.ConfigContent
-	}
-	return ""
-}
-
-func (x *CreateConfigRequest) GetFormat() ConfigFormat {
-	if x != nil {
-		return x.Format
-	}
-	return ConfigFormat_CONFIG_FORMAT_UNSPECIFIED
-}
-
-func (x *CreateConfigRequest) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-// Ответ на создание конфигурации
-type CreateConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Status        ConfigStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=forge.example.ConfigStatus" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateConfigResponse) Reset() {
-	*x = CreateConfigResponse{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateConfigResponse) ProtoMessage() {}
-
-func (x *CreateConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateConfigResponse.ProtoReflect.Descriptor instead.
-func (*CreateConfigResponse) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreateConfigResponse) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *CreateConfigResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *CreateConfigResponse) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *CreateConfigResponse) GetStatus() ConfigStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ConfigStatus_CONFIG_STATUS_UNSPECIFIED
-}
-
-// Запрос на получение конфигурации
-type GetConfigRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId        string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	Version         string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	IncludeMetadata bool                   `protobuf:"varint,3,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *GetConfigRequest) Reset() {
-	*x = GetConfigRequest{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetConfigRequest) ProtoMessage() {}
-
-func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *GetConfigRequest) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *GetConfigRequest) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *GetConfigRequest) GetIncludeMetadata() bool {
-	if x != nil {
-		return x.IncludeMetadata
-	}
-	return false
-}
-
-// Ответ на получение конфигурации
-type GetConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	ConfigContent string                 `protobuf:"bytes,3,opt,name=config_content,json=configContent,proto3" json:"config_content,omitempty"`
-	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	Format        ConfigFormat           `protobuf:"varint,5,opt,name=format,proto3,enum=forge.example.ConfigFormat" json:"format,omitempty"`
-	Status        ConfigStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=forge.example.ConfigStatus" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetConfigResponse) Reset() {
-	*x = GetConfigResponse{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetConfigResponse) ProtoMessage() {}
-
-func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *GetConfigResponse) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *GetConfigResponse) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
-}
-
-func (x *GetConfigResponse) GetConfigContent() string {
-	if x != nil {
-		return x.ConfigContent
-	}
-	return ""
-}
-
-func (x *GetConfigResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *GetConfigResponse) GetFormat() ConfigFormat {
-	if x != nil {
-		return x.Format
-	}
-	return ConfigFormat_CONFIG_FORMAT_UNSPECIFIED
-}
-
-func (x *GetConfigResponse) GetStatus() ConfigStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ConfigStatus_CONFIG_STATUS_UNSPECIFIED
-}
-
-func (x *GetConfigResponse) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *GetConfigResponse) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *GetConfigResponse) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-// Запрос на обновление конфигурации
-type UpdateConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	ConfigContent string                 `protobuf:"bytes,2,opt,name=config_content,json=configContent,proto3" json:"config_content,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateConfigRequest) Reset() {
-	*x = UpdateConfigRequest{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateConfigRequest) ProtoMessage() {}
-
-func (x *UpdateConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateConfigRequest) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *UpdateConfigRequest) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *UpdateConfigRequest) GetConfigContent() string {
-	if x != nil {
-		return x.ConfigContent
-	}
-	return ""
-}
-
-func (x *UpdateConfigRequest) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-// Ответ на обновление конфигурации
-type UpdateConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Status        ConfigStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=forge.example.ConfigStatus" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateConfigResponse) Reset() {
-	*x = UpdateConfigResponse{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateConfigResponse) ProtoMessage() {}
-
-func (x *UpdateConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[5]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateConfigResponse.ProtoReflect.Descriptor instead.
-func (*UpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *UpdateConfigResponse) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *UpdateConfigResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *UpdateConfigResponse) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *UpdateConfigResponse) GetStatus() ConfigStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ConfigStatus_CONFIG_STATUS_UNSPECIFIED
-}
-
-// Запрос на удаление конфигурации
-type DeleteConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteConfigRequest) Reset() {
-	*x = DeleteConfigRequest{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteConfigRequest) ProtoMessage() {}
-
-func (x *DeleteConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[6]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteConfigRequest.ProtoReflect.Descriptor instead.
-func (*DeleteConfigRequest) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *DeleteConfigRequest) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *DeleteConfigRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-// Ответ на удаление конфигурации
-type DeleteConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	DeletedAt     int64                  `protobuf:"varint,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteConfigResponse) Reset() {
-	*x = DeleteConfigResponse{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteConfigResponse) ProtoMessage() {}
-
-func (x *DeleteConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[7]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteConfigResponse.ProtoReflect.Descriptor instead.
-func (*DeleteConfigResponse) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *DeleteConfigResponse) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *DeleteConfigResponse) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
-	}
-	return false
-}
-
-func (x *DeleteConfigResponse) GetDeletedAt() int64 {
-	if x != nil {
-		return x.DeletedAt
-	}
-	return 0
-}
-
-// Запрос на список конфигураций
-type ListConfigsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	StatusFilter  ConfigStatus           `protobuf:"varint,2,opt,name=status_filter,json=statusFilter,proto3,enum=forge.example.ConfigStatus" json:"status_filter,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListConfigsRequest) Reset() {
-	*x = ListConfigsRequest{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListConfigsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListConfigsRequest) ProtoMessage() {}
-
-func (x *ListConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListConfigsRequest.ProtoReflect.Descriptor instead.
-func (*ListConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ListConfigsRequest) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
-}
-
-func (x *ListConfigsRequest) GetStatusFilter() ConfigStatus {
-	if x != nil {
-		return x.StatusFilter
-	}
-	return ConfigStatus_CONFIG_STATUS_UNSPECIFIED
-}
-
-func (x *ListConfigsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *ListConfigsRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-// Ответ на список конфигураций
-type ListConfigsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Configs       []*ConfigInfo          `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListConfigsResponse) Reset() {
-	*x = ListConfigsResponse{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListConfigsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListConfigsResponse) ProtoMessage() {}
-
-func (x *ListConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[9]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListConfigsResponse.ProtoReflect.Descriptor instead.
-func (*ListConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListConfigsResponse) GetConfigs() []*ConfigInfo {
-	if x != nil {
-		return x.Configs
-	}
-	return nil
-}
-
-func (x *ListConfigsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListConfigsResponse) GetHasMore() bool {
-	if x != nil {
-		return x.HasMore
-	}
-	return false
-}
-
-// Запрос на валидацию конфигурации
-type ValidateConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigContent string                 `protobuf:"bytes,1,opt,name=config_content,json=configContent,proto3" json:"config_content,omitempty"`
-	Format        ConfigFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=forge.example.ConfigFormat" json:"format,omitempty"`
-	Level         ValidationLevel        `protobuf:"varint,3,opt,name=level,proto3,enum=forge.example.ValidationLevel" json:"level,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidateConfigRequest) Reset() {
-	*x = ValidateConfigRequest{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ValidateConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValidateConfigRequest) ProtoMessage() {}
-
-func (x *ValidateConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ValidateConfigRequest.ProtoReflect.Descriptor instead.
-func (*ValidateConfigRequest) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *ValidateConfigRequest) GetConfigContent() string {
-	if x != nil {
-		return x.ConfigContent
-	}
-	return ""
-}
-
-func (x *ValidateConfigRequest) GetFormat() ConfigFormat {
-	if x != nil {
-		return x.Format
-	}
-	return ConfigFormat_CONFIG_FORMAT_UNSPECIFIED
-}
-
-func (x *ValidateConfigRequest) GetLevel() ValidationLevel {
-	if x != nil {
-		return x.Level
-	}
-	return ValidationLevel_VALIDATION_LEVEL_UNSPECIFIED
-}
-
-// Ответ на валидацию конфигурации
-type ValidateConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	Errors        []*ValidationError     `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
-	Warnings      []*ValidationWarning   `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidateConfigResponse) Reset() {
-	*x = ValidateConfigResponse{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ValidateConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValidateConfigResponse) ProtoMessage() {}
-
-func (x *ValidateConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[11]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ValidateConfigResponse.ProtoReflect.Descriptor instead.
-func (*ValidateConfigResponse) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *ValidateConfigResponse) GetValid() bool {
-	if x != nil {
-		return x.Valid
-	}
-	return false
-}
-
-func (x *ValidateConfigResponse) GetErrors() []*ValidationError {
-	if x != nil {
-		return x.Errors
-	}
-	return nil
-}
-
-func (x *ValidateConfigResponse) GetWarnings() []*ValidationWarning {
-	if x != nil {
-		return x.Warnings
-	}
-	return nil
-}
-
-// Информация о конфигурации
-type ConfigInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConfigId      string                 `protobuf:"bytes,1,opt,name=config_id,json=configId,proto3" json:"config_id,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Format        ConfigFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=forge.example.ConfigFormat" json:"format,omitempty"`
-	Status        ConfigStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=forge.example.ConfigStatus" json:"status,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ConfigInfo) Reset() {
-	*x = ConfigInfo{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ConfigInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ConfigInfo) ProtoMessage() {}
-
-func (x *ConfigInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[12]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ConfigInfo.ProtoReflect.Descriptor instead.
-func (*ConfigInfo) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *ConfigInfo) GetConfigId() string {
-	if x != nil {
-		return x.ConfigId
-	}
-	return ""
-}
-
-func (x *ConfigInfo) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
-}
-
-func (x *ConfigInfo) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *ConfigInfo) GetFormat() ConfigFormat {
-	if x != nil {
-		return x.Format
-	}
-	return ConfigFormat_CONFIG_FORMAT_UNSPECIFIED
-}
-
-func (x *ConfigInfo) GetStatus() ConfigStatus {
-	if x != nil {
-		return x.Status
-	}
-	return ConfigStatus_CONFIG_STATUS_UNSPECIFIED
-}
-
-func (x *ConfigInfo) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *ConfigInfo) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-// Ошибка валидации
-type ValidationError struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Code          ValidationErrorCode    `protobuf:"varint,3,opt,name=code,proto3,enum=forge.example.ValidationErrorCode" json:"code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidationError) Reset() {
-	*x = ValidationError{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ValidationError) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValidationError) ProtoMessage() {}
-
-func (x *ValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[13]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ValidationError.ProtoReflect.Descriptor instead.
-func (*ValidationError) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ValidationError) GetField() string {
-	if x != nil {
-		return x.Field
-	}
-	return ""
-}
-
-func (x *ValidationError) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ValidationError) GetCode() ValidationErrorCode {
-	if x != nil {
-		return x.Code
-	}
-	return ValidationErrorCode_VALIDATION_ERROR_CODE_UNSPECIFIED
-}
-
-// Предупреждение валидации
-type ValidationWarning struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Code          ValidationWarningCode  `protobuf:"varint,3,opt,name=code,proto3,enum=forge.example.ValidationWarningCode" json:"code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ValidationWarning) Reset() {
-	*x = ValidationWarning{}
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ValidationWarning) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ValidationWarning) ProtoMessage() {}
-
-func (x *ValidationWarning) ProtoReflect() protoreflect.Message {
-	mi := &file_services_forge_service_proto_example_proto_msgTypes[14]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ValidationWarning.ProtoReflect.Descriptor instead.
-func (*ValidationWarning) Descriptor() ([]byte, []int) {
-	return file_services_forge_service_proto_example_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ValidationWarning) GetField() string {
-	if x != nil {
-		return x.Field
-	}
-	return ""
-}
-
-func (x *ValidationWarning) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *ValidationWarning) GetCode() ValidationWarningCode {
-	if x != nil {
-		return x.Code
-	}
-	return ValidationWarningCode_VALIDATION_WARNING_CODE_UNSPECIFIED
-}
-
-var File_services_forge_service_proto_example_proto protoreflect.FileDescriptor
-
-var file_services_forge_service_proto_example_proto_rawDesc = []byte{
-	0x0a, 0x2a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x67, 0x65,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x66, 0x6f,
-	0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x13,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa1, 0x01,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66,
-	0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x74, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc5, 0x03, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
-	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
-	0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xe4, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x48, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0d,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x33, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f,
-	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72,
-	0x65, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xa4, 0x01,
-	0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x36,
-	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e,
-	0x69, 0x6e, 0x67, 0x73, 0x22, 0x8e, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
-	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x79, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x22, 0x7d, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x2a,
-	0x8c, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
-	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
-	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x02, 0x12,
-	0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
-	0x5f, 0x54, 0x4f, 0x4d, 0x4c, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4d, 0x4c, 0x10, 0x04, 0x2a, 0x9a,
-	0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
-	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
-	0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x90, 0x01, 0x0a, 0x0f,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x20, 0x0a, 0x1c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x1b, 0x0a,
-	0x17, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45,
-	0x4c, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x52, 0x45, 0x48, 0x45, 0x4e, 0x53, 0x49, 0x56, 0x45, 0x10, 0x03, 0x2a, 0xf1,
-	0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a,
-	0x1c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x59, 0x4e, 0x54, 0x41, 0x58, 0x10, 0x01, 0x12,
-	0x20, 0x0a, 0x1c, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10,
-	0x02, 0x12, 0x24, 0x0a, 0x20, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e,
-	0x44, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x4e, 0x43, 0x45,
-	0x10, 0x05, 0x2a, 0xb7, 0x01, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x23,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49,
-	0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x44, 0x45, 0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2a, 0x0a,
-	0x26, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x52, 0x4e,
-	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45,
-	0x4e, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45, 0x10, 0x03, 0x32, 0xa2, 0x04, 0x0a,
-	0x0c, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x2e,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1f, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x6f, 0x72,
-	0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x57, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x22, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e,
-	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x6f, 0x72,
-	0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
-	0x12, 0x5f, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x24, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x66, 0x6f, 0x72, 0x67, 0x65,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
-	0x01, 0x42, 0x31, 0x5a, 0x2f, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x69, 0x6e, 0x67, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2f, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_services_forge_service_proto_example_proto_rawDescOnce sync.Once
-	file_services_forge_service_proto_example_proto_rawDescData = file_services_forge_service_proto_example_proto_rawDesc
-)
-
-func file_services_forge_service_proto_example_proto_rawDescGZIP() []byte {
-	file_services_forge_service_proto_example_proto_rawDescOnce.Do(func() {
-		file_services_forge_service_proto_example_proto_rawDescData = protoimpl.X.CompressGZIP(file_services_forge_service_proto_example_proto_rawDescData)
-	})
-	return file_services_forge_service_proto_example_proto_rawDescData
-}
-
-var file_services_forge_service_proto_example_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_services_forge_service_proto_example_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
-var file_services_forge_service_proto_example_proto_goTypes = []any{
-	(ConfigFormat)(0),              // 0: forge.example.ConfigFormat
-	(ConfigStatus)(0),              // 1: forge.example.ConfigStatus
-	(ValidationLevel)(0),           // 2: forge.example.ValidationLevel
-	(ValidationErrorCode)(0),       // 3: forge.example.ValidationErrorCode
-	(ValidationWarningCode)(0),     // 4: forge.example.ValidationWarningCode
-	(*CreateConfigRequest)(nil),    // 5: forge.example.CreateConfigRequest
-	(*CreateConfigResponse)(nil),   // 6: forge.example.CreateConfigResponse
-	(*GetConfigRequest)(nil),       // 7: forge.example.GetConfigRequest
-	(*GetConfigResponse)(nil),      // 8: forge.example.GetConfigResponse
-	(*UpdateConfigRequest)(nil),    // 9: forge.example.UpdateConfigRequest
-	(*UpdateConfigResponse)(nil),   // 10: forge.example.UpdateConfigResponse
-	(*DeleteConfigRequest)(nil),    // 11: forge.example.DeleteConfigRequest
-	(*DeleteConfigResponse)(nil),   // 12: forge.example.DeleteConfigResponse
-	(*ListConfigsRequest)(nil),     // 13: forge.example.ListConfigsRequest
-	(*ListConfigsResponse)(nil),    // 14: forge.example.ListConfigsResponse
-	(*ValidateConfigRequest)(nil),  // 15: forge.example.ValidateConfigRequest
-	(*ValidateConfigResponse)(nil), // 16: forge.example.ValidateConfigResponse
-	(*ConfigInfo)(nil),             // 17: forge.example.ConfigInfo
-	(*ValidationError)(nil),        // 18: forge.example.ValidationError
-	(*ValidationWarning)(nil),      // 19: forge.example.ValidationWarning
-	nil,                            // 20: forge.example.CreateConfigRequest.MetadataEntry
-	nil,                            // 21: forge.example.GetConfigResponse.MetadataEntry
-	nil,                            // 22: forge.example.UpdateConfigRequest.MetadataEntry
-}
-var file_services_forge_service_proto_example_proto_depIdxs = []int32{
-	0,  // 0: forge.example.CreateConfigRequest.format:type_name -> forge.example.ConfigFormat
-	20, // 1: forge.example.CreateConfigRequest.metadata:type_name -> forge.example.CreateConfigRequest.MetadataEntry
-	1,  // 2: forge.example.CreateConfigResponse.status:type_name -> forge.example.ConfigStatus
-	0,  // 3: forge.example.GetConfigResponse.format:type_name -> forge.example.ConfigFormat
-	1,  // 4: forge.example.GetConfigResponse.status:type_name -> forge.example.ConfigStatus
-	21, // 5: forge.example.GetConfigResponse.metadata:type_name -> forge.example.GetConfigResponse.MetadataEntry
-	22, // 6: forge.example.UpdateConfigRequest.metadata:type_name -> forge.example.UpdateConfigRequest.MetadataEntry
-	1,  // 7: forge.example.UpdateConfigResponse.status:type_name -> forge.example.ConfigStatus
-	1,  // 8: forge.example.ListConfigsRequest.status_filter:type_name -> forge.example.ConfigStatus
-	17, // 9: forge.example.ListConfigsResponse.configs:type_name -> forge.example.ConfigInfo
-	0,  // 10: forge.example.ValidateConfigRequest.format:type_name -> forge.example.ConfigFormat
-	2,  // 11: forge.example.ValidateConfigRequest.level:type_name -> forge.example.ValidationLevel
-	18, // 12: forge.example.ValidateConfigResponse.errors:type_name -> forge.example.ValidationError
-	19, // 13: forge.example.ValidateConfigResponse.warnings:type_name -> forge.example.ValidationWarning
-	0,  // 14: forge.example.ConfigInfo.format:type_name -> forge.example.ConfigFormat
-	1,  // 15: forge.example.ConfigInfo.status:type_name -> forge.example.ConfigStatus
-	3,  // 16: forge.example.ValidationError.code:type_name -> forge.example.ValidationErrorCode
-	4,  // 17: forge.example.ValidationWarning.code:type_name -> forge.example.ValidationWarningCode
-	5,  // 18: forge.example.ForgeService.CreateConfig:input_type -> forge.example.CreateConfigRequest
-	7,  // 19: forge.example.ForgeService.GetConfig:input_type -> forge.example.GetConfigRequest
-	9,  // 20: forge.example.ForgeService.UpdateConfig:input_type -> forge.example.UpdateConfigRequest
-	11, // 21: forge.example.ForgeService.DeleteConfig:input_type -> forge.example.DeleteConfigRequest
-	13, // 22: forge.example.ForgeService.ListConfigs:input_type -> forge.example.ListConfigsRequest
-	15, // 23: forge.example.ForgeService.ValidateConfig:input_type -> forge.example.ValidateConfigRequest
-	6,  // 24: forge.example.ForgeService.CreateConfig:output_type -> forge.example.CreateConfigResponse
-	8,  // 25: forge.example.ForgeService.GetConfig:output_type -> forge.example.GetConfigResponse
-	10, // 26: forge.example.ForgeService.UpdateConfig:output_type -> forge.example.UpdateConfigResponse
-	12, // 27: forge.example.ForgeService.DeleteConfig:output_type -> forge.example.DeleteConfigResponse
-	14, // 28: forge.example.ForgeService.ListConfigs:output_type -> forge.example.ListConfigsResponse
-	16, // 29: forge.example.ForgeService.ValidateConfig:output_type -> forge.example.ValidateConfigResponse
-	24, // [24:30] is the sub-list for method output_type
-	18, // [18:24] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
-}
-
-func init() { file_services_forge_service_proto_example_proto_init() }
-func file_services_forge_service_proto_example_proto_init() {
-	if File_services_forge_service_proto_example_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_services_forge_service_proto_example_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   18,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_services_forge_service_proto_example_proto_goTypes,
-		DependencyIndexes: file_services_forge_service_proto_example_proto_depIdxs,
-		EnumInfos:         file_services_forge_service_proto_example_proto_enumTypes,
-		MessageInfos:      file_services_forge_service_proto_example_proto_msgTypes,
-	}.Build()
-	File_services_forge_service_proto_example_proto = out.File
-	file_services_forge_service_proto_example_proto_rawDesc = nil
-	file_services_forge_service_proto_example_proto_goTypes = nil
-	file_services_forge_service_proto_example_proto_depIdxs = nil
-}
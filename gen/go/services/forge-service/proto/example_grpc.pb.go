@@ -1,322 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: services/forge-service/proto/example.proto
-
-package proto
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	ForgeService_CreateConfig_FullMethodName   = "/forge.example.ForgeService/CreateConfig"
-	ForgeService_GetConfig_FullMethodName      = "/forge.example.ForgeService/GetConfig"
-	ForgeService_UpdateConfig_FullMethodName   = "/forge.example.ForgeService/UpdateConfig"
-	ForgeService_DeleteConfig_FullMethodName   = "/forge.example.ForgeService/DeleteConfig"
-	ForgeService_ListConfigs_FullMethodName    = "/forge.example.ForgeService/ListConfigs"
-	ForgeService_ValidateConfig_FullMethodName = "/forge.example.ForgeService/ValidateConfig"
-)
-
-// ForgeServiceClient is the client API for ForgeService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// Forge Service API
-type ForgeServiceClient interface {
-	// Создает новую конфигурацию сервиса
-	CreateConfig(ctx context.Context, in *CreateConfigRequest, opts ...grpc.CallOption) (*CreateConfigResponse, error)
-	// Получает конфигурацию сервиса
-	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
-	// Обновляет конфигурацию сервиса
-	UpdateConfig(ctx context.Context, in *UpdateConfigRequest, opts ...grpc.CallOption) (*UpdateConfigResponse, error)
-	// Удаляет конфигурацию сервиса
-	DeleteConfig(ctx context.Context, in *DeleteConfigRequest, opts ...grpc.CallOption) (*DeleteConfigResponse, error)
-	// Получает список всех конфигураций
-	ListConfigs(ctx context.Context, in *ListConfigsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListConfigsResponse], error)
-	// Валидирует конфигурацию
-	ValidateConfig(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ValidateConfigRequest, ValidateConfigResponse], error)
-}
-
-type forgeServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewForgeServiceClient(cc grpc.ClientConnInterface) ForgeServiceClient {
-	return &forgeServiceClient{cc}
-}
-
-func (c *forgeServiceClient) CreateConfig(ctx context.Context, in *CreateConfigRequest, opts ...grpc.CallOption) (*CreateConfigResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateConfigResponse)
-	err := c.cc.Invoke(ctx, ForgeService_CreateConfig_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *forgeServiceClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetConfigResponse)
-	err := c.cc.Invoke(ctx, ForgeService_GetConfig_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *forgeServiceClient) UpdateConfig(ctx context.Context, in *UpdateConfigRequest, opts ...grpc.CallOption) (*UpdateConfigResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateConfigResponse)
-	err := c.cc.Invoke(ctx, ForgeService_UpdateConfig_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *forgeServiceClient) DeleteConfig(ctx context.Context, in *DeleteConfigRequest, opts ...grpc.CallOption) (*DeleteConfigResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteConfigResponse)
-	err := c.cc.Invoke(ctx, ForgeService_DeleteConfig_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *forgeServiceClient) ListConfigs(ctx context.Context, in *ListConfigsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListConfigsResponse], error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &ForgeService_ServiceDesc.Streams[0], ForgeService_ListConfigs_FullMethodName, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &grpc.GenericClientStream[ListConfigsRequest, ListConfigsResponse]{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ForgeService_ListConfigsClient = grpc.ServerStreamingClient[ListConfigsResponse]
-
-func (c *forgeServiceClient) ValidateConfig(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ValidateConfigRequest, ValidateConfigResponse], error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &ForgeService_ServiceDesc.Streams[1], ForgeService_ValidateConfig_FullMethodName, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &grpc.GenericClientStream[ValidateConfigRequest, ValidateConfigResponse]{ClientStream: stream}
-	return x, nil
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ForgeService_ValidateConfigClient = grpc.ClientStreamingClient[ValidateConfigRequest, ValidateConfigResponse]
-
-// ForgeServiceServer is the server API for ForgeService service.
-// All implementations should embed UnimplementedForgeServiceServer
-// for forward compatibility.
-//
-// Forge Service API
-type ForgeServiceServer interface {
-	// Создает новую конфигурацию сервиса
-	CreateConfig(context.Context, *CreateConfigRequest) (*CreateConfigResponse, error)
-	// Получает конфигурацию сервиса
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	// Обновляет конфигурацию сервиса
-	UpdateConfig(context.Context, *UpdateConfigRequest) (*UpdateConfigResponse, error)
-	// Удаляет конфигурацию сервиса
-	DeleteConfig(context.Context, *DeleteConfigRequest) (*DeleteConfigResponse, error)
-	// Получает список всех конфигураций
-	ListConfigs(*ListConfigsRequest, grpc.ServerStreamingServer[ListConfigsResponse]) error
-	// Валидирует конфигурацию
-	ValidateConfig(grpc.ClientStreamingServer[ValidateConfigRequest, ValidateConfigResponse]) error
-}
-
-// UnimplementedForgeServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedForgeServiceServer struct{}
-
-func (UnimplementedForgeServiceServer) CreateConfig(context.Context, *CreateConfigRequest) (*CreateConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateConfig not implemented")
-}
-func (UnimplementedForgeServiceServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
-}
-func (UnimplementedForgeServiceServer) UpdateConfig(context.Context, *UpdateConfigRequest) (*UpdateConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfig not implemented")
-}
-func (UnimplementedForgeServiceServer) DeleteConfig(context.Context, *DeleteConfigRequest) (*DeleteConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfig not implemented")
-}
-func (UnimplementedForgeServiceServer) ListConfigs(*ListConfigsRequest, grpc.ServerStreamingServer[ListConfigsResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method ListConfigs not implemented")
-}
-func (UnimplementedForgeServiceServer) ValidateConfig(grpc.ClientStreamingServer[ValidateConfigRequest, ValidateConfigResponse]) error {
-	return status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
-}
-func (UnimplementedForgeServiceServer) testEmbeddedByValue() {}
-
-// UnsafeForgeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ForgeServiceServer will
-// result in compilation errors.
-type UnsafeForgeServiceServer interface {
-	mustEmbedUnimplementedForgeServiceServer()
-}
-
-func RegisterForgeServiceServer(s grpc.ServiceRegistrar, srv ForgeServiceServer) {
-	// If the following call pancis, it indicates UnimplementedForgeServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&ForgeService_ServiceDesc, srv)
-}
-
-func _ForgeService_CreateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).CreateConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_CreateConfig_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).CreateConfig(ctx, req.(*CreateConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ForgeService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).GetConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_GetConfig_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ForgeService_UpdateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).UpdateConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_UpdateConfig_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).UpdateConfig(ctx, req.(*UpdateConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ForgeService_DeleteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ForgeServiceServer).DeleteConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ForgeService_DeleteConfig_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ForgeServiceServer).DeleteConfig(ctx, req.(*DeleteConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ForgeService_ListConfigs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListConfigsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ForgeServiceServer).ListConfigs(m, &grpc.GenericServerStream[ListConfigsRequest, ListConfigsResponse]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ForgeService_ListConfigsServer = grpc.ServerStreamingServer[ListConfigsResponse]
-
-func _ForgeService_ValidateConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ForgeServiceServer).ValidateConfig(&grpc.GenericServerStream[ValidateConfigRequest, ValidateConfigResponse]{ServerStream: stream})
-}
-
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ForgeService_ValidateConfigServer = grpc.ClientStreamingServer[ValidateConfigRequest, ValidateConfigResponse]
-
-// ForgeService_ServiceDesc is the grpc.ServiceDesc for ForgeService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ForgeService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "forge.example.ForgeService",
-	HandlerType: (*ForgeServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateConfig",
-			Handler:    _ForgeService_CreateConfig_Handler,
-		},
-		{
-			MethodName: "GetConfig",
-			Handler:    _ForgeService_GetConfig_Handler,
-		},
-		{
-			MethodName: "UpdateConfig",
-			Handler:    _ForgeService_UpdateConfig_Handler,
-		},
-		{
-			MethodName: "DeleteConfig",
-			Handler:    _ForgeService_DeleteConfig_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "ListConfigs",
-			Handler:       _ForgeService_ListConfigs_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "ValidateConfig",
-			Handler:       _ForgeService_ValidateConfig_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "services/forge-service/proto/example.proto",
-}
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Длительности фаз HTTP запроса (DNS, TCP connect, TLS, TTFB, total) для результатов проверок
+ALTER TABLE check_results ADD COLUMN IF NOT EXISTS timings JSONB;
+
+-- +goose Down
+ALTER TABLE check_results DROP COLUMN IF EXISTS timings;
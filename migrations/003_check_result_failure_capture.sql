@@ -0,0 +1,10 @@
+-- +goose Up
+-- Сжатый (gzip JSON) снимок заголовков, фрагмента тела и HAR-записей неудачной проверки
+ALTER TABLE check_results ADD COLUMN IF NOT EXISTS failure_capture BYTEA;
+
+CREATE INDEX IF NOT EXISTS idx_check_results_failure_capture_created_at
+    ON check_results (created_at) WHERE failure_capture IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_check_results_failure_capture_created_at;
+ALTER TABLE check_results DROP COLUMN IF EXISTS failure_capture;
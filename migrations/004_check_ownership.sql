@@ -0,0 +1,15 @@
+-- +goose Up
+-- Владелец проверки, команда, runbook и контакт эскалации для уведомлений об инцидентах
+ALTER TABLE checks ADD COLUMN IF NOT EXISTS owner VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE checks ADD COLUMN IF NOT EXISTS team VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE checks ADD COLUMN IF NOT EXISTS runbook_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE checks ADD COLUMN IF NOT EXISTS escalation_contact VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_checks_team ON checks (tenant_id, team) WHERE team <> '';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_checks_team;
+ALTER TABLE checks DROP COLUMN IF EXISTS escalation_contact;
+ALTER TABLE checks DROP COLUMN IF EXISTS runbook_url;
+ALTER TABLE checks DROP COLUMN IF EXISTS team;
+ALTER TABLE checks DROP COLUMN IF EXISTS owner;
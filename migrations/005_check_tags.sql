@@ -0,0 +1,18 @@
+-- +goose Up
+-- Теги проверок и реестр тегов tenant со статистикой использования
+ALTER TABLE checks ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_checks_tags ON checks USING GIN (tags);
+
+CREATE TABLE IF NOT EXISTS check_tags (
+    tenant_id UUID NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, name),
+    CONSTRAINT fk_check_tags_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS check_tags;
+DROP INDEX IF EXISTS idx_checks_tags;
+ALTER TABLE checks DROP COLUMN IF EXISTS tags;
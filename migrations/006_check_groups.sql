@@ -0,0 +1,24 @@
+-- +goose Up
+-- Иерархические группы (папки) проверок. path - материализованный путь "/root-id/child-id/"
+CREATE TABLE IF NOT EXISTS check_groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    parent_id UUID REFERENCES check_groups(id) ON DELETE RESTRICT,
+    name VARCHAR(255) NOT NULL,
+    path TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_check_groups_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_check_groups_path ON check_groups (tenant_id, path text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_check_groups_parent ON check_groups (parent_id);
+
+ALTER TABLE checks ADD COLUMN IF NOT EXISTS group_id UUID REFERENCES check_groups(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_checks_group ON checks (group_id) WHERE group_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_checks_group;
+ALTER TABLE checks DROP COLUMN IF EXISTS group_id;
+DROP TABLE IF EXISTS check_groups;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Переключатели функциональности, которыми управляют операторы через admin API.
+-- tenant_id NULL - глобальное значение, иначе переопределение для тенанта
+CREATE TABLE IF NOT EXISTS feature_flags (
+    name VARCHAR(100) NOT NULL,
+    tenant_id UUID REFERENCES tenants(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    description TEXT,
+    updated_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_feature_flags_scope
+    ON feature_flags (name, COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid));
+
+-- +goose Down
+DROP TABLE IF EXISTS feature_flags;
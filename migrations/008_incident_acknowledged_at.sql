@@ -0,0 +1,6 @@
+-- +goose Up
+-- Момент подтверждения инцидента для SLA подтверждения и расчета MTTA
+ALTER TABLE incidents ADD COLUMN IF NOT EXISTS acknowledged_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE incidents DROP COLUMN IF EXISTS acknowledged_at;
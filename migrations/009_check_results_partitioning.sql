@@ -0,0 +1,77 @@
+-- +goose Up
+-- Партиционирование check_results по created_at: старые данные удаляются сбросом
+-- дневных партиций вместо долгих DELETE. Существующая таблица становится партицией
+-- check_results_legacy до дня, следующего за последним результатом, новые дневные
+-- партиции check_results_pYYYYMMDD (UTC) создает обслуживание партиций core-service
+ALTER TABLE check_results RENAME TO check_results_legacy;
+ALTER TABLE check_results_legacy RENAME CONSTRAINT check_results_pkey TO check_results_legacy_pkey;
+ALTER TABLE check_results_legacy DROP CONSTRAINT fk_check_results_check;
+ALTER INDEX idx_check_results_check_id RENAME TO idx_check_results_legacy_check_id;
+ALTER INDEX idx_check_results_status RENAME TO idx_check_results_legacy_status;
+ALTER INDEX idx_check_results_created_at RENAME TO idx_check_results_legacy_created_at;
+ALTER INDEX idx_check_results_check_created RENAME TO idx_check_results_legacy_check_created;
+ALTER INDEX IF EXISTS idx_check_results_failure_capture_created_at RENAME TO idx_check_results_legacy_failure_capture_created_at;
+
+-- Ключ партиционирования не может быть NULL
+UPDATE check_results_legacy SET created_at = CURRENT_TIMESTAMP WHERE created_at IS NULL;
+ALTER TABLE check_results_legacy ALTER COLUMN created_at SET NOT NULL;
+
+CREATE TABLE check_results (LIKE check_results_legacy INCLUDING DEFAULTS) PARTITION BY RANGE (created_at);
+ALTER TABLE check_results ADD CONSTRAINT check_results_pkey PRIMARY KEY (id, created_at);
+ALTER TABLE check_results ADD CONSTRAINT fk_check_results_check
+    FOREIGN KEY (check_id) REFERENCES checks(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_check_results_check_id ON check_results(check_id);
+CREATE INDEX idx_check_results_status ON check_results(status);
+CREATE INDEX idx_check_results_created_at ON check_results(created_at);
+CREATE INDEX idx_check_results_check_created ON check_results(check_id, created_at DESC);
+CREATE INDEX idx_check_results_failure_capture_created_at
+    ON check_results (created_at) WHERE failure_capture IS NOT NULL;
+
+-- +goose StatementBegin
+DO $$
+DECLARE
+    cutover TIMESTAMPTZ;
+    partition_day TIMESTAMPTZ;
+BEGIN
+    SELECT GREATEST(
+        date_trunc('day', now() AT TIME ZONE 'UTC') + INTERVAL '1 day',
+        COALESCE(date_trunc('day', max(created_at) AT TIME ZONE 'UTC') + INTERVAL '1 day', '-infinity')
+    ) AT TIME ZONE 'UTC'
+    INTO cutover
+    FROM check_results_legacy;
+
+    -- Ограничение позволяет подключить партицию без повторной проверки строк
+    EXECUTE format('ALTER TABLE check_results_legacy ADD CONSTRAINT check_results_legacy_range CHECK (created_at < %L)', cutover);
+    EXECUTE format('ALTER TABLE check_results ATTACH PARTITION check_results_legacy FOR VALUES FROM (MINVALUE) TO (%L)', cutover);
+    ALTER TABLE check_results_legacy DROP CONSTRAINT check_results_legacy_range;
+
+    FOR i IN 0..7 LOOP
+        partition_day := cutover + make_interval(days => i);
+        EXECUTE format(
+            'CREATE TABLE IF NOT EXISTS %I PARTITION OF check_results FOR VALUES FROM (%L) TO (%L)',
+            'check_results_p' || to_char(partition_day AT TIME ZONE 'UTC', 'YYYYMMDD'), partition_day, partition_day + INTERVAL '1 day'
+        );
+    END LOOP;
+END $$;
+-- +goose StatementEnd
+
+-- Страховка на случай, если обслуживание партиций не успело создать партицию на день
+CREATE TABLE check_results_default PARTITION OF check_results DEFAULT;
+
+-- +goose Down
+CREATE TABLE check_results_plain (LIKE check_results INCLUDING DEFAULTS);
+INSERT INTO check_results_plain SELECT * FROM check_results;
+DROP TABLE check_results;
+ALTER TABLE check_results_plain RENAME TO check_results;
+ALTER TABLE check_results ALTER COLUMN created_at DROP NOT NULL;
+ALTER TABLE check_results ADD CONSTRAINT check_results_pkey PRIMARY KEY (id);
+ALTER TABLE check_results ADD CONSTRAINT fk_check_results_check
+    FOREIGN KEY (check_id) REFERENCES checks(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_check_results_check_id ON check_results(check_id);
+CREATE INDEX idx_check_results_status ON check_results(status);
+CREATE INDEX idx_check_results_created_at ON check_results(created_at);
+CREATE INDEX idx_check_results_check_created ON check_results(check_id, created_at DESC);
+CREATE INDEX idx_check_results_failure_capture_created_at
+    ON check_results (created_at) WHERE failure_capture IS NOT NULL;
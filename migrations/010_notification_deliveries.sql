@@ -0,0 +1,22 @@
+-- +goose Up
+-- Журнал доставки уведомлений: одна строка на отправку в канал
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    incident_id VARCHAR(100),
+    channel_id UUID REFERENCES notification_channels(id) ON DELETE SET NULL,
+    channel_type VARCHAR(50) NOT NULL,
+    recipient VARCHAR(255),
+    subject TEXT,
+    body TEXT,
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_tenant_created ON notification_deliveries(tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_incident_id ON notification_deliveries(incident_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_deliveries;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- Компоненты страницы статуса. override - ручной статус оператора (JSONB domain.StatusOverride)
+CREATE TABLE IF NOT EXISTS status_page_components (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    check_ids UUID[] NOT NULL DEFAULT '{}',
+    position INTEGER NOT NULL DEFAULT 0,
+    override JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_status_page_components_tenant ON status_page_components(tenant_id, position);
+
+-- Объявления о плановом обслуживании
+CREATE TABLE IF NOT EXISTS status_page_maintenances (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    component_ids UUID[] NOT NULL DEFAULT '{}',
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    cancelled_at TIMESTAMPTZ,
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_status_page_maintenances_window CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_status_page_maintenances_tenant_ends ON status_page_maintenances(tenant_id, ends_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS status_page_maintenances;
+DROP TABLE IF EXISTS status_page_components;
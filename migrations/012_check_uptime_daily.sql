@@ -0,0 +1,20 @@
+-- +goose Up
+-- Дневные сводки доступности проверок для 90-дневной истории на страницах статуса.
+-- Строки пересчитывает задача агрегации core-service по check_results; сутки в UTC
+CREATE TABLE IF NOT EXISTS check_uptime_daily (
+    check_id UUID NOT NULL,
+    day DATE NOT NULL,
+    operational_seconds BIGINT NOT NULL DEFAULT 0,
+    degraded_seconds BIGINT NOT NULL DEFAULT 0,
+    down_seconds BIGINT NOT NULL DEFAULT 0,
+    total_checks INTEGER NOT NULL DEFAULT 0,
+    failed_checks INTEGER NOT NULL DEFAULT 0,
+    avg_response_time_ms INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (check_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_check_uptime_daily_day ON check_uptime_daily(day);
+
+-- +goose Down
+DROP TABLE IF EXISTS check_uptime_daily;
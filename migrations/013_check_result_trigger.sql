@@ -0,0 +1,8 @@
+-- +goose Up
+-- Причина запуска проверки (schedule, api, cli, retry) и инициатор: воркер планировщика или пользователь
+ALTER TABLE check_results ADD COLUMN IF NOT EXISTS trigger VARCHAR(20);
+ALTER TABLE check_results ADD COLUMN IF NOT EXISTS triggered_by VARCHAR(255);
+
+-- +goose Down
+ALTER TABLE check_results DROP COLUMN IF EXISTS triggered_by;
+ALTER TABLE check_results DROP COLUMN IF EXISTS trigger;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Метаданные артефактов проверок (скриншоты, HAR, снимки ответов). Содержимое хранится
+-- в S3-совместимом хранилище по ключу, в PostgreSQL остаются только ссылки
+CREATE TABLE IF NOT EXISTS check_artifacts (
+    key VARCHAR(512) PRIMARY KEY,
+    check_id UUID NOT NULL,
+    execution_id VARCHAR(255) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_check_artifacts_execution ON check_artifacts(check_id, execution_id);
+CREATE INDEX IF NOT EXISTS idx_check_artifacts_expires_at ON check_artifacts(expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS check_artifacts;
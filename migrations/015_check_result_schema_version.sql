@@ -0,0 +1,12 @@
+-- +goose Up
+-- Версия схемы строки результата: строки, записанные до версионирования, получают версию 1 и
+-- переводятся на текущую backfill'ом (services/core-service/cmd/result-backfill)
+ALTER TABLE check_results ADD COLUMN IF NOT EXISTS schema_version SMALLINT NOT NULL DEFAULT 1;
+
+-- Индекс по строкам, ожидающим backfill; при росте текущей версии (4) пересоздается
+CREATE INDEX IF NOT EXISTS idx_check_results_schema_version_pending
+    ON check_results (schema_version) WHERE schema_version < 4;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_check_results_schema_version_pending;
+ALTER TABLE check_results DROP COLUMN IF EXISTS schema_version;
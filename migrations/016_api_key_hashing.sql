@@ -0,0 +1,19 @@
+-- +goose Up
+-- API ключи хранятся только в виде соленого хеша пары key/secret; ключ ищется по открытому
+-- префиксу публичной части. Несоленый хеш ключа больше не хранится
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS secret_hash VARCHAR(255);
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS allowed_ips TEXT[] NOT NULL DEFAULT '{}';
+
+-- Ключи без соленого хеша проверить нельзя: они отзываются и должны быть выпущены заново
+UPDATE api_keys SET is_active = false WHERE secret_hash IS NULL;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS key_hash;
+
+DROP INDEX IF EXISTS idx_api_keys_key_prefix;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_key_prefix ON api_keys(key_prefix);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_api_keys_key_prefix;
+CREATE INDEX IF NOT EXISTS idx_api_keys_key_prefix ON api_keys(key_prefix);
+ALTER TABLE api_keys ADD COLUMN IF NOT EXISTS key_hash VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE api_keys DROP COLUMN IF EXISTS allowed_ips;
+ALTER TABLE api_keys DROP COLUMN IF EXISTS secret_hash;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Страны, из которых входили пользователи: вход из новой страны уведомляет администраторов тенанта
+CREATE TABLE IF NOT EXISTS user_login_countries (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    country VARCHAR(2) NOT NULL,
+    first_seen_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, country)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_login_countries;
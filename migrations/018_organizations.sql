@@ -0,0 +1,30 @@
+-- +goose Up
+-- Организации объединяют тенанты одного клиента (например, prod и staging): общий тарифный план,
+-- платежный адрес и каталог пользователей. Данные тенантов остаются изолированными
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    plan VARCHAR(50) NOT NULL DEFAULT 'free',
+    billing_email VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_tenants_organization_id ON tenants(organization_id);
+
+-- Роли на уровне организации; пользователь состоит не более чем в одной организации
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS organization_members;
+DROP INDEX IF EXISTS idx_tenants_organization_id;
+ALTER TABLE tenants DROP COLUMN IF EXISTS organization_id;
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Ключи данных арендаторов для шифрования секретов каналов уведомлений (токены ботов, пароли
+-- SMTP). Ключ хранится зашифрованным мастер-ключом Notification Service (NOTIFICATION_SECRETS_KEY)
+CREATE TABLE IF NOT EXISTS notification_data_keys (
+    tenant_id UUID PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Секреты существующих каналов шифрует Notification Service при старте с мастер-ключом
+-- (service.EncryptStoredChannels); до этого он читает оба формата
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_data_keys;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Версия конфигурации проверки: растет при изменении типа, цели, таймаута, конфигурации или
+-- включенности. Планировщик ставит версию в задачу, core-service сверяет ее с текущей и не
+-- выполняет задачи удаленных или измененных проверок, застрявшие в кэше или очереди
+ALTER TABLE checks ADD COLUMN IF NOT EXISTS config_version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE checks DROP COLUMN IF EXISTS config_version;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Мета-tenant самомониторинга: scheduler-service создает в нем проверки собственных компонентов
+-- платформы (self_monitoring в конфигурации). ID совпадает с config.PlatformTenantID
+INSERT INTO tenants (id, name, slug, plan)
+VALUES ('00000000-0000-0000-0000-000000000001', 'UptimePing Platform', 'uptimeping-platform', 'enterprise')
+ON CONFLICT (id) DO NOTHING;
+
+-- +goose Down
+DELETE FROM tenants WHERE id = '00000000-0000-0000-0000-000000000001';
@@ -0,0 +1,16 @@
+-- +goose Up
+-- Журнал аудита тенанта: действия пользователей, операторов и события безопасности.
+-- Журнал входит в экспорт данных тенанта и удаляется вместе с тенантом
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    event VARCHAR(100) NOT NULL,
+    actor_id VARCHAR(255),
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_tenant_created ON audit_events(tenant_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,142 @@
+package breakglass
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
+)
+
+// recordingNotifier запоминает отправленные оповещения
+type recordingNotifier struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, alert Alert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+// failingNotifier недоступный канал оповещения
+type failingNotifier struct{}
+
+func (failingNotifier) Notify(ctx context.Context, alert Alert) error {
+	return errors.New("smtp server is down")
+}
+
+func newTestLogger(t *testing.T) logger.Logger {
+	log, err := logger.NewLogger("test", "error", "breakglass", false)
+	require.NoError(t, err)
+	return log
+}
+
+func TestWatchdog_Check(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewFake(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	var rabbitErr error
+	probes := []Probe{
+		{Name: "rabbitmq", Check: func(ctx context.Context) error { return rabbitErr }},
+		{Name: "postgres", Check: func(ctx context.Context) error { return nil }},
+	}
+	notifier := &recordingNotifier{}
+	watchdog := NewWatchdog("scheduler-service", probes, []Notifier{failingNotifier{}, notifier}, 3, time.Hour, newTestLogger(t)).
+		WithClock(clk)
+
+	// Два неудачных опроса ниже порога не оповещают
+	rabbitErr = errors.New("connection refused")
+	started := clk.Now()
+	watchdog.Check(ctx)
+	clk.Advance(30 * time.Second)
+	watchdog.Check(ctx)
+	assert.Empty(t, notifier.alerts)
+
+	// Третий оповещает, несмотря на сбой первого канала
+	clk.Advance(30 * time.Second)
+	watchdog.Check(ctx)
+	require.Len(t, notifier.alerts, 1)
+	alert := notifier.alerts[0]
+	assert.Equal(t, "rabbitmq", alert.Component)
+	assert.Equal(t, StatusDown, alert.Status)
+	assert.Equal(t, "connection refused", alert.Error)
+	assert.Equal(t, started, alert.Since)
+	assert.Equal(t, 3, alert.Failures)
+	assert.Equal(t, "scheduler-service", alert.Source)
+
+	// Продолжающийся сбой оповещает повторно только через час
+	clk.Advance(30 * time.Minute)
+	watchdog.Check(ctx)
+	assert.Len(t, notifier.alerts, 1)
+	clk.Advance(30 * time.Minute)
+	watchdog.Check(ctx)
+	require.Len(t, notifier.alerts, 2)
+	assert.Equal(t, StatusDown, notifier.alerts[1].Status)
+
+	rabbitErr = nil
+	watchdog.Check(ctx)
+	require.Len(t, notifier.alerts, 3)
+	assert.Equal(t, StatusRecovered, notifier.alerts[2].Status)
+	assert.Equal(t, started, notifier.alerts[2].Since)
+
+	// После восстановления счетчик сбоев начинается заново
+	rabbitErr = errors.New("connection refused")
+	watchdog.Check(ctx)
+	assert.Len(t, notifier.alerts, 3)
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	var received Alert
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if err := webhooks.NewVerifier("hook-secret").Verify(r.Context(), r.Header, body); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.NoError(t, json.Unmarshal(body, &received))
+	}))
+	defer server.Close()
+
+	alert := Alert{Component: "postgres", Status: StatusDown, Error: "dial tcp: i/o timeout", Failures: 3}
+	require.NoError(t, NewWebhookNotifier([]string{server.URL}, "hook-secret").Notify(context.Background(), alert))
+	assert.Equal(t, "postgres", received.Component)
+	assert.Equal(t, StatusDown, received.Status)
+
+	err := NewWebhookNotifier([]string{server.URL}, "wrong-secret").Notify(context.Background(), alert)
+	assert.EqualError(t, err, "webhook returned status 401")
+}
+
+func TestProbes(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	assert.NoError(t, HTTPProbe("gateway", server.URL+"/health", time.Second).Check(ctx))
+	assert.EqualError(t, HTTPProbe("gateway", server.URL+"/ready", time.Second).Check(ctx), "unexpected status 503")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	assert.NoError(t, TCPProbe("redis", address, time.Second).Check(ctx))
+	listener.Close()
+	assert.Error(t, TCPProbe("redis", address, time.Second).Check(ctx))
+}
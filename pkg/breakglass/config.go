@@ -0,0 +1,44 @@
+package breakglass
+
+import (
+	"time"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/logger"
+)
+
+// NewFromConfig создает Watchdog для компонентов самомониторинга с BreakGlass. Письма
+// отправляются через SMTP из email, если заданы получатели и SMTP сервер
+func NewFromConfig(source string, monitoring config.SelfMonitoringConfig, email config.EmailProviderConfig, log logger.Logger) *Watchdog {
+	var probes []Probe
+	for _, component := range monitoring.Components {
+		if !component.BreakGlass {
+			continue
+		}
+		switch component.Type {
+		case "http":
+			probes = append(probes, HTTPProbe(component.Name, component.Target, monitoring.Timeout))
+		case "tcp":
+			probes = append(probes, TCPProbe(component.Name, component.Target, monitoring.Timeout))
+		}
+	}
+
+	breakGlass := monitoring.BreakGlass
+	var notifiers []Notifier
+	if len(breakGlass.Emails) > 0 && email.SMTPHost != "" {
+		timeout, _ := time.ParseDuration(email.Timeout)
+		notifiers = append(notifiers, NewSMTPNotifier(SMTPConfig{
+			Host:     email.SMTPHost,
+			Port:     email.SMTPPort,
+			Username: email.Username,
+			Password: email.Password,
+			From:     email.FromAddress,
+			Timeout:  timeout,
+		}, breakGlass.Emails))
+	}
+	if len(breakGlass.WebhookURLs) > 0 {
+		notifiers = append(notifiers, NewWebhookNotifier(breakGlass.WebhookURLs, breakGlass.WebhookSecret))
+	}
+
+	return NewWatchdog(source, probes, notifiers, breakGlass.FailureThreshold, breakGlass.RepeatInterval, log)
+}
@@ -0,0 +1,179 @@
+package breakglass
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/webhooks"
+)
+
+// defaultNotifyTimeout ограничивает отправку одного оповещения
+const defaultNotifyTimeout = 10 * time.Second
+
+// WebhookNotifier отправляет оповещение POST запросом с JSON на каждый адрес
+type WebhookNotifier struct {
+	urls   []string
+	signer *webhooks.Signer
+	client *http.Client
+}
+
+// NewWebhookNotifier создает оповещение по webhook; непустой secret подписывает запросы
+// схемой webhooks.DefaultScheme
+func NewWebhookNotifier(urls []string, secret string) *WebhookNotifier {
+	notifier := &WebhookNotifier{
+		urls:   urls,
+		client: &http.Client{Timeout: defaultNotifyTimeout},
+	}
+	if secret != "" {
+		notifier.signer = webhooks.NewSigner(secret)
+	}
+	return notifier
+}
+
+// Notify реализует Notifier; возвращает первую ошибку, но отправляет на все адреса
+func (n *WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to encode alert: %w", err)
+	}
+
+	var firstErr error
+	for _, url := range n.urls {
+		if err := n.post(ctx, url, body); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// post отправляет тело на один адрес
+func (n *WebhookNotifier) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.signer != nil {
+		n.signer.SignRequest(req, body)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SMTPConfig параметры SMTP сервера для аварийных писем
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+// SMTPNotifier отправляет оповещение письмом напрямую через SMTP, без notification-service
+type SMTPNotifier struct {
+	config     SMTPConfig
+	recipients []string
+}
+
+// NewSMTPNotifier создает оповещение по email
+func NewSMTPNotifier(config SMTPConfig, recipients []string) *SMTPNotifier {
+	if config.Port == 0 {
+		config.Port = 587
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultNotifyTimeout
+	}
+	return &SMTPNotifier{config: config, recipients: recipients}
+}
+
+// Notify реализует Notifier
+func (n *SMTPNotifier) Notify(ctx context.Context, alert Alert) error {
+	address := net.JoinHostPort(n.config.Host, strconv.Itoa(n.config.Port))
+	dialer := &net.Dialer{Timeout: n.config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	conn.SetDeadline(time.Now().Add(n.config.Timeout))
+
+	client, err := smtp.NewClient(conn, n.config.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(nil); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	if n.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+	if err := client.Mail(n.config.From); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+	for _, recipient := range n.recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("failed to add recipient %s: %w", recipient, err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start message: %w", err)
+	}
+	if _, err := writer.Write(n.message(alert)); err != nil {
+		writer.Close()
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+	return client.Quit()
+}
+
+// message формирует текстовое письмо
+func (n *SMTPNotifier) message(alert Alert) []byte {
+	subject := fmt.Sprintf("[UptimePing break-glass] %s is %s", alert.Component, alert.Status)
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Component: %s\r\n", alert.Component)
+	fmt.Fprintf(&body, "Status: %s\r\n", alert.Status)
+	fmt.Fprintf(&body, "Since: %s\r\n", alert.Since.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&body, "Failed probes: %d\r\n", alert.Failures)
+	if alert.Error != "" {
+		fmt.Fprintf(&body, "Error: %s\r\n", alert.Error)
+	}
+	fmt.Fprintf(&body, "Reported by: %s\r\n", alert.Source)
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", n.config.From)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(n.recipients, ", "))
+	fmt.Fprintf(&message, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&message, "Date: %s\r\n", alert.At.UTC().Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	message.WriteString(body.String())
+	return []byte(message.String())
+}
@@ -0,0 +1,47 @@
+package breakglass
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// HTTPProbe проверяет, что GET url отвечает статусом 2xx за timeout
+func HTTPProbe(name, url string, timeout time.Duration) Probe {
+	client := &http.Client{Timeout: timeout}
+	return Probe{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			if err != nil {
+				return err
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				return fmt.Errorf("unexpected status %d", resp.StatusCode)
+			}
+			return nil
+		},
+	}
+}
+
+// TCPProbe проверяет, что на address принимаются TCP соединения
+func TCPProbe(name, address string, timeout time.Duration) Probe {
+	dialer := &net.Dialer{Timeout: timeout}
+	return Probe{
+		Name: name,
+		Check: func(ctx context.Context) error {
+			conn, err := dialer.DialContext(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		},
+	}
+}
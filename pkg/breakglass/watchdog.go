@@ -0,0 +1,180 @@
+// Package breakglass аварийное оповещение о компонентах платформы. Обычные уведомления
+// проходят через RabbitMQ, incident-manager и notification-service и не доставляются, когда
+// недоступна сама эта инфраструктура. Watchdog опрашивает компоненты напрямую и оповещает
+// по SMTP и webhook, не завися от остальной платформы
+package breakglass
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/logger"
+)
+
+// Статусы компонента в оповещении
+const (
+	StatusDown      = "down"
+	StatusRecovered = "recovered"
+)
+
+// Probe прямая проверка компонента платформы
+type Probe struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Alert аварийное оповещение о смене состояния компонента или о продолжающемся сбое
+type Alert struct {
+	Component string    `json:"component"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	Since     time.Time `json:"since"`
+	At        time.Time `json:"at"`
+	Failures  int       `json:"failures"`
+	Source    string    `json:"source"`
+}
+
+// Notifier канал аварийного оповещения
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// probeState состояние компонента между опросами
+type probeState struct {
+	failures   int
+	since      time.Time
+	down       bool
+	notifiedAt time.Time
+}
+
+// Watchdog опрашивает компоненты и оповещает, когда компонент не отвечает threshold опросов
+// подряд. Пока сбой продолжается, оповещение повторяется раз в repeat (0 - без повторов);
+// после восстановления отправляется сообщение о восстановлении
+type Watchdog struct {
+	source    string
+	probes    []Probe
+	notifiers []Notifier
+	threshold int
+	repeat    time.Duration
+	clock     clock.Clock
+	logger    logger.Logger
+
+	mu     sync.Mutex
+	states map[string]*probeState
+}
+
+// NewWatchdog создает Watchdog; source - имя отправителя в оповещениях
+func NewWatchdog(source string, probes []Probe, notifiers []Notifier, threshold int, repeat time.Duration, log logger.Logger) *Watchdog {
+	if threshold <= 0 {
+		threshold = 1
+	}
+	return &Watchdog{
+		source:    source,
+		probes:    probes,
+		notifiers: notifiers,
+		threshold: threshold,
+		repeat:    repeat,
+		clock:     clock.Real(),
+		logger:    log,
+		states:    make(map[string]*probeState, len(probes)),
+	}
+}
+
+// WithClock задает источник текущего времени
+func (w *Watchdog) WithClock(c clock.Clock) *Watchdog {
+	w.clock = clock.OrReal(c)
+	return w
+}
+
+// Run опрашивает компоненты каждые interval до отмены ctx
+func (w *Watchdog) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		w.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check опрашивает все компоненты одновременно и отправляет оповещения о сменах состояния
+func (w *Watchdog) Check(ctx context.Context) {
+	errs := make([]error, len(w.probes))
+	var wg sync.WaitGroup
+	for i, probe := range w.probes {
+		wg.Add(1)
+		go func(i int, probe Probe) {
+			defer wg.Done()
+			errs[i] = probe.Check(ctx)
+		}(i, probe)
+	}
+	wg.Wait()
+
+	for i, probe := range w.probes {
+		if alert, ok := w.observe(probe.Name, errs[i]); ok {
+			w.notify(ctx, alert)
+		}
+	}
+}
+
+// observe учитывает результат опроса и возвращает оповещение, если его нужно отправить
+func (w *Watchdog) observe(name string, err error) (Alert, bool) {
+	now := w.clock.Now()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	state, ok := w.states[name]
+	if !ok {
+		state = &probeState{}
+		w.states[name] = state
+	}
+
+	if err == nil {
+		if !state.down {
+			*state = probeState{}
+			return Alert{}, false
+		}
+		alert := Alert{Component: name, Status: StatusRecovered, Since: state.since, At: now, Failures: state.failures, Source: w.source}
+		*state = probeState{}
+		return alert, true
+	}
+
+	if state.failures == 0 {
+		state.since = now
+	}
+	state.failures++
+	if state.failures < w.threshold {
+		return Alert{}, false
+	}
+	if state.down && (w.repeat <= 0 || now.Sub(state.notifiedAt) < w.repeat) {
+		return Alert{}, false
+	}
+	state.down = true
+	state.notifiedAt = now
+	return Alert{Component: name, Status: StatusDown, Error: err.Error(), Since: state.since, At: now, Failures: state.failures, Source: w.source}, true
+}
+
+// notify отправляет оповещение во все каналы; сбой одного канала не мешает остальным
+func (w *Watchdog) notify(ctx context.Context, alert Alert) {
+	w.logger.Error("Break-glass alert",
+		logger.String("component", alert.Component),
+		logger.String("status", alert.Status),
+		logger.String("error", alert.Error),
+		logger.Int("failures", alert.Failures),
+	)
+	for _, notifier := range w.notifiers {
+		if err := notifier.Notify(ctx, alert); err != nil {
+			w.logger.Error("Failed to send break-glass alert",
+				logger.String("component", alert.Component),
+				logger.Error(err),
+			)
+		}
+	}
+}
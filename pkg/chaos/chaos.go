@@ -0,0 +1,187 @@
+// Package chaos внедряет искусственные сбои (задержки, ошибки, потерю сообщений) для проверки
+// поведения платформы при частичных отказах: retry, circuit breaker, открытие инцидентов.
+// Включается только конфигурацией и предназначен для staging окружений
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ErrInjected ошибка, внедренная вместо реального результата операции
+var ErrInjected = errors.New("chaos: injected fault")
+
+// Виды внедряемых сбоев
+const (
+	FaultLatency = "latency"
+	FaultError   = "error"
+	FaultDrop    = "drop"
+)
+
+// Config настройки внедрения сбоев. Проценты задаются в диапазоне 0-100 и применяются
+// к каждой операции независимо
+type Config struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Latency задержка, добавляемая к операции
+	Latency        time.Duration `json:"latency" yaml:"latency"`
+	LatencyPercent float64       `json:"latency_percent" yaml:"latency_percent"`
+	ErrorPercent   float64       `json:"error_percent" yaml:"error_percent"`
+	// DropPercent доля потерянных сообщений или запросов
+	DropPercent float64 `json:"drop_percent" yaml:"drop_percent"`
+	// Targets шаблоны операций (path.Match), к которым применяются сбои; пустой список - ко всем.
+	// gRPC методы: "/uptimeping.core.v1.CoreService/*", RabbitMQ: "publish/<routing_key>", "consume/<queue>"
+	Targets []string `json:"targets" yaml:"targets"`
+}
+
+// Validate проверяет корректность настроек
+func (c Config) Validate() error {
+	for name, percent := range map[string]float64{
+		"latency_percent": c.LatencyPercent,
+		"error_percent":   c.ErrorPercent,
+		"drop_percent":    c.DropPercent,
+	} {
+		if percent < 0 || percent > 100 {
+			return fmt.Errorf("chaos %s must be between 0 and 100", name)
+		}
+	}
+	if c.Latency < 0 {
+		return fmt.Errorf("chaos latency must not be negative")
+	}
+	if c.LatencyPercent > 0 && c.Latency == 0 {
+		return fmt.Errorf("chaos latency is required when latency_percent is set")
+	}
+	for _, target := range c.Targets {
+		if _, err := path.Match(target, ""); err != nil {
+			return fmt.Errorf("invalid chaos target %q: %w", target, err)
+		}
+	}
+	return nil
+}
+
+// Decision сбои, выбранные для одной операции
+type Decision struct {
+	Delay time.Duration
+	Fail  bool
+	Drop  bool
+}
+
+var faultsInjected = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "uptimeping",
+		Subsystem: "chaos",
+		Name:      "faults_injected_total",
+		Help:      "Total number of faults injected by the chaos layer",
+	},
+	[]string{"component", "fault"},
+)
+
+func init() {
+	prometheus.MustRegister(faultsInjected)
+}
+
+// Injector принимает решения о сбоях. Нулевой указатель и выключенная конфигурация
+// не внедряют ничего, поэтому инжектор можно передавать без проверок
+type Injector struct {
+	config    Config
+	component string
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewInjector создает инжектор для компонента (используется как метка метрик).
+// Для выключенной конфигурации возвращает nil
+func NewInjector(component string, config Config) (*Injector, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Injector{
+		config:    config,
+		component: component,
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}, nil
+}
+
+// WithSeed фиксирует генератор случайных чисел для воспроизводимых прогонов
+func (i *Injector) WithSeed(seed int64) *Injector {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.rand = rand.New(rand.NewSource(seed))
+	return i
+}
+
+// Enabled проверяет, внедряет ли инжектор сбои
+func (i *Injector) Enabled() bool {
+	return i != nil && i.config.Enabled
+}
+
+// Decide выбирает сбои для операции target. Ошибка и потеря взаимоисключающие
+func (i *Injector) Decide(target string) Decision {
+	if !i.Enabled() || !i.matches(target) {
+		return Decision{}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var decision Decision
+	if i.config.LatencyPercent > 0 && i.rand.Float64()*100 < i.config.LatencyPercent {
+		decision.Delay = i.config.Latency
+	}
+	roll := i.rand.Float64() * 100
+	switch {
+	case roll < i.config.ErrorPercent:
+		decision.Fail = true
+	case roll < i.config.ErrorPercent+i.config.DropPercent:
+		decision.Drop = true
+	}
+	return decision
+}
+
+// Apply выбирает сбои для операции, выдерживает задержку и возвращает ErrInjected,
+// если операция должна завершиться ошибкой. Решение о потере возвращается вызывающему коду
+func (i *Injector) Apply(ctx context.Context, target string) (Decision, error) {
+	decision := i.Decide(target)
+
+	if decision.Delay > 0 {
+		faultsInjected.WithLabelValues(i.component, FaultLatency).Inc()
+		timer := time.NewTimer(decision.Delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return decision, ctx.Err()
+		}
+	}
+	if decision.Fail {
+		faultsInjected.WithLabelValues(i.component, FaultError).Inc()
+		return decision, fmt.Errorf("%w in %s", ErrInjected, target)
+	}
+	if decision.Drop {
+		faultsInjected.WithLabelValues(i.component, FaultDrop).Inc()
+	}
+	return decision, nil
+}
+
+// matches проверяет, относится ли операция к настроенным целям
+func (i *Injector) matches(target string) bool {
+	if len(i.config.Targets) == 0 {
+		return true
+	}
+	for _, pattern := range i.config.Targets {
+		if ok, _ := path.Match(pattern, target); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "valid", config: Config{Enabled: true, Latency: time.Second, LatencyPercent: 10, ErrorPercent: 5, DropPercent: 5}},
+		{name: "percent above 100", config: Config{ErrorPercent: 150}, wantErr: true},
+		{name: "negative percent", config: Config{DropPercent: -1}, wantErr: true},
+		{name: "latency percent without latency", config: Config{LatencyPercent: 10}, wantErr: true},
+		{name: "bad target pattern", config: Config{Targets: []string{"[publish"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewInjectorDisabled(t *testing.T) {
+	injector, err := NewInjector("test", Config{ErrorPercent: 100})
+	require.NoError(t, err)
+	assert.Nil(t, injector)
+	assert.False(t, injector.Enabled())
+	assert.Equal(t, Decision{}, injector.Decide("anything"))
+
+	_, err = injector.Apply(context.Background(), "anything")
+	assert.NoError(t, err)
+}
+
+func TestDecideAlwaysFails(t *testing.T) {
+	injector, err := NewInjector("test", Config{Enabled: true, ErrorPercent: 100})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		decision := injector.Decide("publish/check.result")
+		assert.True(t, decision.Fail)
+		assert.False(t, decision.Drop)
+	}
+}
+
+func TestDecidePercentages(t *testing.T) {
+	injector, err := NewInjector("test", Config{Enabled: true, ErrorPercent: 20, DropPercent: 30})
+	require.NoError(t, err)
+	injector.WithSeed(42)
+
+	var fails, drops int
+	const total = 10000
+	for i := 0; i < total; i++ {
+		decision := injector.Decide("consume/checks")
+		if decision.Fail {
+			fails++
+		}
+		if decision.Drop {
+			drops++
+		}
+	}
+
+	assert.InDelta(t, 0.2, float64(fails)/total, 0.02)
+	assert.InDelta(t, 0.3, float64(drops)/total, 0.02)
+}
+
+func TestDecideTargets(t *testing.T) {
+	injector, err := NewInjector("test", Config{
+		Enabled:      true,
+		ErrorPercent: 100,
+		Targets:      []string{"/uptimeping.core.v1.CoreService/*", "publish/incident.*"},
+	})
+	require.NoError(t, err)
+
+	assert.True(t, injector.Decide("/uptimeping.core.v1.CoreService/ExecuteCheck").Fail)
+	assert.True(t, injector.Decide("publish/incident.created").Fail)
+	assert.False(t, injector.Decide("/uptimeping.scheduler.v1.SchedulerService/GetCheck").Fail)
+	assert.False(t, injector.Decide("consume/incident.created").Fail)
+}
+
+func TestApply(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		injector, err := NewInjector("test", Config{Enabled: true, ErrorPercent: 100})
+		require.NoError(t, err)
+
+		decision, err := injector.Apply(context.Background(), "publish/check.result")
+		assert.True(t, decision.Fail)
+		assert.True(t, errors.Is(err, ErrInjected))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		injector, err := NewInjector("test", Config{Enabled: true, Latency: 20 * time.Millisecond, LatencyPercent: 100})
+		require.NoError(t, err)
+
+		start := time.Now()
+		decision, err := injector.Apply(context.Background(), "publish/check.result")
+		require.NoError(t, err)
+		assert.Equal(t, 20*time.Millisecond, decision.Delay)
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+
+	t.Run("latency respects context", func(t *testing.T) {
+		injector, err := NewInjector("test", Config{Enabled: true, Latency: time.Minute, LatencyPercent: 100})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = injector.Apply(ctx, "publish/check.result")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		injector, err := NewInjector("test", Config{Enabled: true, DropPercent: 100})
+		require.NoError(t, err)
+
+		decision, err := injector.Apply(context.Background(), "consume/checks")
+		require.NoError(t, err)
+		assert.True(t, decision.Drop)
+	})
+}
@@ -0,0 +1,82 @@
+// Package clock источник текущего времени. Сервисы получают время через Clock, а не
+// time.Now, чтобы тесты могли переводить часы вперед: таймауты эскалации, автоматическое
+// закрытие и расписания проверяются без ожидания
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock источник текущего времени
+type Clock interface {
+	// Now возвращает текущее время
+	Now() time.Time
+	// Since возвращает время, прошедшее с t
+	Since(t time.Time) time.Duration
+}
+
+// realClock системные часы
+type realClock struct{}
+
+// Real возвращает системные часы
+func Real() Clock {
+	return realClock{}
+}
+
+// Now реализует Clock
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Since реализует Clock
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+// OrReal возвращает c или системные часы, если c не задан
+func OrReal(c Clock) Clock {
+	if c == nil {
+		return Real()
+	}
+	return c
+}
+
+// Fake часы для тестов: время стоит на месте, пока его не переведут Set или Advance.
+// Безопасны для одновременного использования
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake создает часы, показывающие now
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now реализует Clock
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Since реализует Clock
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// Set переводит часы на now
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
+
+// Advance переводит часы вперед на d и возвращает новое время
+func (f *Fake) Advance(d time.Duration) time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+	return f.now
+}
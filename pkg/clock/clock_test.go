@@ -0,0 +1,28 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	c := NewFake(start)
+
+	assert.Equal(t, start, c.Now())
+	assert.Equal(t, start.Add(time.Hour), c.Advance(time.Hour))
+	assert.Equal(t, time.Hour, c.Since(start))
+
+	c.Set(start)
+	assert.Equal(t, time.Duration(0), c.Since(start))
+}
+
+func TestOrReal(t *testing.T) {
+	fake := NewFake(time.Unix(0, 0))
+	assert.Same(t, fake, OrReal(fake))
+
+	before := time.Now()
+	assert.False(t, OrReal(nil).Now().Before(before))
+}
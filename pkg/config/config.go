@@ -2,17 +2,23 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/netip"
+	"net/url"
 	"os"
 	"path/filepath"
-	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
 	"time"
 
 	"gopkg.in/yaml.v2"
+
+	"UptimePingPlatform/pkg/chaos"
+	"UptimePingPlatform/pkg/ratelimit"
 )
 
 // Config представляет конфигурацию приложения. Структура содержит вложенные структуры для различных компонентов приложения.
@@ -26,6 +32,9 @@ type Config struct {
 	RabbitMQ     RabbitMQConfig  `json:"rabbitmq" yaml:"rabbitmq"`
 	GRPC         GRPCConfig      `json:"grpc" yaml:"grpc"`
 	RateLimiting RateLimitConfig `json:"rate_limiting" yaml:"rate_limiting"`
+	Maintenance  MaintenanceConfig `json:"maintenance" yaml:"maintenance"`
+	CORS         CORSConfig      `json:"cors" yaml:"cors"`
+	CookieAuth   CookieAuthConfig `json:"cookie_auth" yaml:"cookie_auth"`
 	Providers    ProvidersConfig `json:"providers" yaml:"providers"`
 	Forge        ForgeConfig     `json:"forge" yaml:"forge"`
 	Metrics      MetricsConfig   `json:"metrics" yaml:"metrics"`
@@ -33,7 +42,14 @@ type Config struct {
 	Services     ServicesConfig  `json:"services" yaml:"services"`
 	Recipients   RecipientsConfig `json:"recipients" yaml:"recipients"`
 	Scheduler    SchedulerConfig `json:"scheduler" yaml:"scheduler"`
+	CheckResults CheckResultsConfig `json:"check_results" yaml:"check_results"`
+	CheckTargets CheckTargetsConfig `json:"check_targets" yaml:"check_targets"`
+	Artifacts    ArtifactsConfig `json:"artifacts" yaml:"artifacts"`
 	IncidentManager IncidentManagerConfig `json:"incident_manager" yaml:"incident_manager"`
+	Startup      StartupConfig   `json:"startup" yaml:"startup"`
+	Chaos        chaos.Config    `json:"chaos" yaml:"chaos"`
+	CheckerPlugins []CheckerPluginConfig `json:"checker_plugins" yaml:"checker_plugins"`
+	SelfMonitoring SelfMonitoringConfig `json:"self_monitoring" yaml:"self_monitoring"`
 }
 
 // ServerConfig представляет конфигурацию сервера. Содержит настройки хоста и порта для HTTP-сервера.
@@ -49,6 +65,15 @@ type DatabaseConfig struct {
 	Name     string `json:"name" yaml:"name"`
 	User     string `json:"user" yaml:"user"`
 	Password string `json:"password" yaml:"password"`
+	// Пул подключений для записи; StatementTimeout ограничивает время запроса на стороне PostgreSQL
+	MaxConns         int           `json:"max_conns" yaml:"max_conns"`
+	MinConns         int           `json:"min_conns" yaml:"min_conns"`
+	StatementTimeout time.Duration `json:"statement_timeout" yaml:"statement_timeout"`
+	// ReadMaxConns > 0 включает отдельный пул для чтения, чтобы тяжелые выборки не занимали соединения записи
+	ReadMaxConns         int           `json:"read_max_conns" yaml:"read_max_conns"`
+	ReadStatementTimeout time.Duration `json:"read_statement_timeout" yaml:"read_statement_timeout"`
+	// ReplicaURL строка подключения к реплике: пул чтения подключается к ней, а не к primary
+	ReplicaURL string `json:"replica_url" yaml:"replica_url"`
 }
 
 // LoggerConfig представляет конфигурацию логгера. Определяет уровень логирования и формат вывода логов.
@@ -63,6 +88,14 @@ type RabbitMQConfig struct {
 	Exchange   string `json:"exchange" yaml:"exchange"`
 	RoutingKey string `json:"routing_key" yaml:"routing_key"`
 	Queue      string `json:"queue" yaml:"queue"`
+	// MaxPriority включает очередь с приоритетами (x-max-priority); 0 - обычная очередь.
+	// Изменение значения требует пересоздания очереди в брокере
+	MaxPriority int `json:"max_priority" yaml:"max_priority"`
+	// VirtualQueues число виртуальных очередей задач: задачи tenant маршрутизируются по хешу
+	// ID tenant, и всплеск задач одного tenant не задерживает остальных; 0 - одна общая очередь
+	VirtualQueues int `json:"virtual_queues" yaml:"virtual_queues"`
+	// TenantPrefetch число неподтвержденных задач на подписку виртуальной очереди; 0 - по умолчанию
+	TenantPrefetch int `json:"tenant_prefetch" yaml:"tenant_prefetch"`
 }
 
 // RedisConfig представляет конфигурацию Redis
@@ -86,6 +119,12 @@ type IncidentManagerConfig struct {
 type RateLimitConfig struct {
 	RequestsPerMinute int `json:"requests_per_minute" yaml:"requests_per_minute"`
 	BurstSize         int `json:"burst_size" yaml:"burst_size"`
+	// TrustedProxies CIDR подсети прокси, которым разрешено передавать IP клиента
+	// в X-Forwarded-For/X-Real-IP; пустой список - заголовки игнорируются
+	TrustedProxies []string `json:"trusted_proxies" yaml:"trusted_proxies"`
+	// WarnThreshold доля лимита, после которой ответы содержат предупреждающие заголовки
+	// X-RateLimit-*; 0 - без предупреждений
+	WarnThreshold float64 `json:"warn_threshold" yaml:"warn_threshold"`
 }
 
 // JWTConfig представляет конфигурацию JWT
@@ -99,6 +138,127 @@ type JWTConfig struct {
 // GRPCConfig представляет конфигурацию gRPC
 type GRPCConfig struct {
 	Port int `json:"port" yaml:"port"`
+	// Reflection регистрирует gRPC reflection; если не задано, reflection включен везде, кроме prod
+	Reflection *bool `json:"reflection,omitempty" yaml:"reflection,omitempty"`
+	// MaxRecvMsgSize и MaxSendMsgSize ограничивают размер сообщения в байтах; 0 - 4 МБ по умолчанию gRPC
+	MaxRecvMsgSize int `json:"max_recv_msg_size" yaml:"max_recv_msg_size"`
+	MaxSendMsgSize int `json:"max_send_msg_size" yaml:"max_send_msg_size"`
+	// MaxConcurrentStreams ограничивает число одновременных вызовов в соединении; 0 - без ограничения
+	MaxConcurrentStreams uint32 `json:"max_concurrent_streams" yaml:"max_concurrent_streams"`
+	// MaxConnections ограничивает число открытых соединений; 0 - без ограничения
+	MaxConnections int                 `json:"max_connections" yaml:"max_connections"`
+	Keepalive      GRPCKeepaliveConfig `json:"keepalive" yaml:"keepalive"`
+	// ServiceToken общий секрет внутренних вызовов между сервисами платформы; сервис с пустым
+	// значением не подписывает свои вызовы и не принимает внутренние вызовы без прав пользователя
+	ServiceToken string `json:"service_token" yaml:"service_token"`
+}
+
+// GRPCKeepaliveConfig представляет настройки keepalive gRPC сервера
+type GRPCKeepaliveConfig struct {
+	// Time и Timeout - интервал пинга простаивающего клиента и ожидание ответа на него
+	Time    time.Duration `json:"time" yaml:"time"`
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+	// MinTime - минимальный интервал пингов клиента; клиент, пингующий чаще, отключается
+	MinTime time.Duration `json:"min_time" yaml:"min_time"`
+	// PermitWithoutStream разрешает клиенту пинговать без активных вызовов
+	PermitWithoutStream bool `json:"permit_without_stream" yaml:"permit_without_stream"`
+	// MaxConnectionIdle, MaxConnectionAge и MaxConnectionAgeGrace ограничивают жизнь соединения; 0 - без ограничения
+	MaxConnectionIdle     time.Duration `json:"max_connection_idle" yaml:"max_connection_idle"`
+	MaxConnectionAge      time.Duration `json:"max_connection_age" yaml:"max_connection_age"`
+	MaxConnectionAgeGrace time.Duration `json:"max_connection_age_grace" yaml:"max_connection_age_grace"`
+}
+
+// DefaultGRPCConfig возвращает конфигурацию gRPC по умолчанию
+func DefaultGRPCConfig() GRPCConfig {
+	return GRPCConfig{
+		Port:           50051,
+		MaxRecvMsgSize: 4 << 20,
+		MaxSendMsgSize: 4 << 20,
+		Keepalive: GRPCKeepaliveConfig{
+			Time:    2 * time.Hour,
+			Timeout: 20 * time.Second,
+			MinTime: 30 * time.Second,
+		},
+	}
+}
+
+// ReflectionEnabled сообщает, регистрировать ли gRPC reflection в окружении environment
+func (c GRPCConfig) ReflectionEnabled(environment string) bool {
+	if c.Reflection != nil {
+		return *c.Reflection
+	}
+	return environment != "prod"
+}
+
+// LoadFromEnv переопределяет настройки gRPC переменными окружения GRPC_*
+func (c *GRPCConfig) LoadFromEnv() error {
+	if value := os.Getenv("GRPC_SERVICE_TOKEN"); value != "" {
+		c.ServiceToken = value
+	}
+	if value := os.Getenv("GRPC_REFLECTION"); value != "" {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid GRPC_REFLECTION: %s", value)
+		}
+		c.Reflection = &enabled
+	}
+	for name, target := range map[string]*int{
+		"GRPC_MAX_RECV_MSG_SIZE": &c.MaxRecvMsgSize,
+		"GRPC_MAX_SEND_MSG_SIZE": &c.MaxSendMsgSize,
+		"GRPC_MAX_CONNECTIONS":   &c.MaxConnections,
+	} {
+		if value := os.Getenv(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", name, value)
+			}
+			*target = parsed
+		}
+	}
+	if value := os.Getenv("GRPC_MAX_CONCURRENT_STREAMS"); value != "" {
+		streams, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid GRPC_MAX_CONCURRENT_STREAMS: %s", value)
+		}
+		c.MaxConcurrentStreams = uint32(streams)
+	}
+	for name, target := range map[string]*time.Duration{
+		"GRPC_KEEPALIVE_TIME":           &c.Keepalive.Time,
+		"GRPC_KEEPALIVE_TIMEOUT":        &c.Keepalive.Timeout,
+		"GRPC_KEEPALIVE_MIN_TIME":       &c.Keepalive.MinTime,
+		"GRPC_MAX_CONNECTION_IDLE":      &c.Keepalive.MaxConnectionIdle,
+		"GRPC_MAX_CONNECTION_AGE":       &c.Keepalive.MaxConnectionAge,
+		"GRPC_MAX_CONNECTION_AGE_GRACE": &c.Keepalive.MaxConnectionAgeGrace,
+	} {
+		if value := os.Getenv(name); value != "" {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", name, value)
+			}
+			*target = duration
+		}
+	}
+	if value := os.Getenv("GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM"); value != "" {
+		permit, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM: %s", value)
+		}
+		c.Keepalive.PermitWithoutStream = permit
+	}
+	return nil
+}
+
+// Validate проверяет настройки gRPC сервера
+func (c GRPCConfig) Validate() error {
+	if c.MaxRecvMsgSize < 0 || c.MaxSendMsgSize < 0 || c.MaxConnections < 0 {
+		return fmt.Errorf("max_recv_msg_size, max_send_msg_size and max_connections must not be negative")
+	}
+	keepalive := c.Keepalive
+	if keepalive.Time < 0 || keepalive.Timeout < 0 || keepalive.MinTime < 0 ||
+		keepalive.MaxConnectionIdle < 0 || keepalive.MaxConnectionAge < 0 || keepalive.MaxConnectionAgeGrace < 0 {
+		return fmt.Errorf("keepalive durations must not be negative")
+	}
+	return nil
 }
 
 // ProvidersConfig представляет конфигурацию провайдеров уведомлений
@@ -106,6 +266,17 @@ type ProvidersConfig struct {
 	Telegram TelegramProviderConfig `json:"telegram" yaml:"telegram"`
 	Slack    SlackProviderConfig    `json:"slack" yaml:"slack"`
 	Email    EmailProviderConfig    `json:"email" yaml:"email"`
+	Plugins  PluginProvidersConfig  `json:"plugins" yaml:"plugins"`
+}
+
+// PluginProvidersConfig представляет настройки внешних провайдеров уведомлений: каждый
+// исполняемый файл каталога Dir запускается плагином (см. pkg/plugin). Пустой Dir отключает плагины
+type PluginProvidersConfig struct {
+	Dir          string `json:"dir" yaml:"dir"`
+	StartTimeout string `json:"start_timeout" yaml:"start_timeout"`
+	Timeout      string `json:"timeout" yaml:"timeout"`
+	// Env переменные окружения плагинов в виде KEY=value; окружение сервиса плагины не наследуют
+	Env []string `json:"env" yaml:"env"`
 }
 
 // TelegramProviderConfig представляет конфигурацию Telegram провайдера
@@ -141,12 +312,26 @@ type EmailProviderConfig struct {
 // LoadConfig загружает конфигурацию в следующем порядке приоритета:
 // 1. Загрузка значений по умолчанию
 // 2. Загрузка из файла (если указан)
-// 3. Переопределение значениями из переменных окружения
-// 4. Валидация конфигурации
+// 3. Переопределение значениями из переменных окружения без префикса (SERVER_HOST, DATABASE_HOST...)
+// 4. Переопределение переменными UPTIMEPING_<ПУТЬ_КЛЮЧА> (см. EnvPrefix и EnvVars), которые
+//    покрывают все ключи и имеют наивысший приоритет
+// 5. Валидация конфигурации
 // Возвращает готовую конфигурацию или ошибку.
+// Явно указанный файл обязан существовать.
 func LoadConfig(configFile string) (*Config, error) {
-	// Initialize config with default values
-	config := &Config{
+	if configFile != "" {
+		// If configFile is just "dev", "staging", or "prod", add .yaml extension
+		if !strings.HasSuffix(configFile, ".yaml") && !strings.HasSuffix(configFile, ".json") {
+			configFile = configFile + ".yaml"
+		}
+	}
+
+	return loadLayeredConfig(configFile)
+}
+
+// DefaultConfig возвращает конфигурацию со значениями по умолчанию
+func DefaultConfig() *Config {
+	return &Config{
 		Server: ServerConfig{
 			Host: "0.0.0.0",
 			Port: 8080,
@@ -157,6 +342,9 @@ func LoadConfig(configFile string) (*Config, error) {
 			Name:     "uptimeping",
 			User:     "uptimeping",
 			Password: "uptimeping",
+			MaxConns:         20,
+			MinConns:         5,
+			StatementTimeout: 30 * time.Second,
 		},
 		Logger: LoggerConfig{
 			Level:  "info",
@@ -185,11 +373,18 @@ func LoadConfig(configFile string) (*Config, error) {
 			RoutingKey: "notification.events",
 			Queue:      "notifications",
 		},
-		GRPC: GRPCConfig{
-			Port: 50051,
-		},
+		GRPC: DefaultGRPCConfig(),
 		RateLimiting: RateLimitConfig{
 			RequestsPerMinute: 100,
+			WarnThreshold:     ratelimit.DefaultWarnThreshold,
+		},
+		Maintenance: MaintenanceConfig{
+			RetryAfter: 5 * time.Minute,
+		},
+		CookieAuth: CookieAuthConfig{
+			Secure:     true,
+			SameSite:   "strict",
+			SessionTTL: 24 * time.Hour,
 		},
 		Providers: ProvidersConfig{
 			Telegram: TelegramProviderConfig{
@@ -216,6 +411,10 @@ func LoadConfig(configFile string) (*Config, error) {
 				Timeout:       "30s",
 				RetryAttempts: 3,
 			},
+			Plugins: PluginProvidersConfig{
+				StartTimeout: "10s",
+				Timeout:      "30s",
+			},
 		},
 		Forge: ForgeConfig{
 			ProtoDir:  "proto",
@@ -304,22 +503,83 @@ func LoadConfig(configFile string) (*Config, error) {
 			TaskTimeout:        30 * time.Second,
 			CleanupInterval:    1 * time.Hour,
 			LockTimeout:        5 * time.Minute,
+			CheckCacheTTL:      5 * time.Minute,
+			AdaptiveInterval: AdaptiveIntervalConfig{
+				StableAfter: 10,
+				Factor:      2,
+				MaxInterval: 15 * time.Minute,
+			},
+		},
+		CheckResults: CheckResultsConfig{
+			Retention:           90 * 24 * time.Hour,
+			PartitionsAhead:     7,
+			MaintenanceInterval: 1 * time.Hour,
+
+			UptimeRollupInterval: 10 * time.Minute,
+			DegradedResponseTime: 2 * time.Second,
+
+			ConsensusWindow: 10 * time.Minute,
+
+			CaptureRetention:     7 * 24 * time.Hour,
+			CapturePurgeInterval: 1 * time.Hour,
+		},
+		CheckTargets: CheckTargetsConfig{
+			Restrict: true,
+		},
+		Artifacts: ArtifactsConfig{
+			Region:          "us-east-1",
+			PathStyle:       true,
+			TTL:             7 * 24 * time.Hour,
+			URLTTL:          15 * time.Minute,
+			CleanupInterval: 1 * time.Hour,
+		},
+		Startup: StartupConfig{
+			RetryAttempts:     5,
+			RetryInitialDelay: 1 * time.Second,
+			RetryMaxDelay:     10 * time.Second,
+		},
+		SelfMonitoring: SelfMonitoringConfig{
+			TenantID: PlatformTenantID,
+			Interval: 1 * time.Minute,
+			Timeout:  10 * time.Second,
+			Components: []PlatformComponentConfig{
+				{Name: "api-gateway", Type: "http", Target: "http://api-gateway:8080/health"},
+				{Name: "scheduler-service", Type: "http", Target: "http://scheduler-service:51052/ready"},
+				{Name: "core-service", Type: "http", Target: "http://core-service:51054/health", BreakGlass: true},
+				{Name: "postgres", Type: "tcp", Target: "postgres:5432", BreakGlass: true},
+				{Name: "redis", Type: "tcp", Target: "redis:6379", BreakGlass: true},
+				{Name: "rabbitmq", Type: "tcp", Target: "rabbitmq:5672", BreakGlass: true},
+			},
+			BreakGlass: BreakGlassConfig{
+				Interval:         30 * time.Second,
+				FailureThreshold: 3,
+				RepeatInterval:   1 * time.Hour,
+			},
+			Pipeline: PipelineSLIConfig{
+				Window:                5 * time.Minute,
+				EvaluationInterval:    30 * time.Second,
+				Quantile:              0.95,
+				MinSamples:            20,
+				QueueLagThreshold:     1 * time.Minute,
+				PersistDelayThreshold: 10 * time.Second,
+			},
 		},
 	}
+}
+
+// loadLayeredConfig единый путь загрузки конфигурации: значения по умолчанию,
+// затем файл (если указан; отсутствующий файл пропускается),
+// затем переменные окружения и валидация.
+// Каждый следующий слой переопределяет только заданные в нем значения
+func loadLayeredConfig(configFile string) (*Config, error) {
+	config := DefaultConfig()
 
-	// Load from file if specified and exists
 	if configFile != "" {
-		// If configFile is just "dev", "staging", or "prod", add .yaml extension
-		if !strings.HasSuffix(configFile, ".yaml") && !strings.HasSuffix(configFile, ".json") {
-			configFile = configFile + ".yaml"
-		}
-		
 		if err := loadConfigFromFile(config, configFile); err != nil {
-			// If file doesn't exist, continue with defaults
-			if !os.IsNotExist(err) {
+			// Отсутствующий файл не ошибка: установки, передающие путь, могут задавать все через окружение
+			if !errors.Is(err, os.ErrNotExist) {
 				return nil, fmt.Errorf("failed to load config from file: %w", err)
 			}
-			// File doesn't exist, continue with defaults and env vars
 		}
 	}
 
@@ -328,6 +588,11 @@ func LoadConfig(configFile string) (*Config, error) {
 		return nil, fmt.Errorf("failed to load config from environment: %w", err)
 	}
 
+	// UPTIMEPING_* override every key, including the legacy variables above
+	if err := applyEnvOverrides(config, os.LookupEnv); err != nil {
+		return nil, fmt.Errorf("failed to load config from environment: %w", err)
+	}
+
 	// Validate configuration
 	if err := validateConfig(config); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
@@ -338,7 +603,10 @@ func LoadConfig(configFile string) (*Config, error) {
 
 func loadConfigFromFile(config *Config, filename string) error {
 	// Expand environment variables in the file path
-	filename = os.ExpandEnv(filename)
+	filename, err := ExpandEnv(filename)
+	if err != nil {
+		return fmt.Errorf("failed to expand config file path: %w", err)
+	}
 	
 	// If filename doesn't contain a path, look in config/ directory
 	if !strings.Contains(filename, "/") {
@@ -347,7 +615,7 @@ func loadConfigFromFile(config *Config, filename string) error {
 
 	// Check if file exists
 	if _, err := os.Stat(filename); os.IsNotExist(err) {
-		return fmt.Errorf("config file does not exist: %s", filename)
+		return fmt.Errorf("config file does not exist: %s: %w", filename, os.ErrNotExist)
 	}
 
 	file, err := os.Open(filename)
@@ -362,8 +630,11 @@ func loadConfigFromFile(config *Config, filename string) error {
 		return err
 	}
 
-	// Process environment variables in format ${VAR:default}
-	processedContent := expandEnvVars(string(content))
+	// Process environment variables in format ${VAR} and ${VAR:default}
+	processedContent, err := ExpandEnv(string(content))
+	if err != nil {
+		return fmt.Errorf("failed to expand environment variables in %s: %w", filename, err)
+	}
 
 	// Try to unmarshal as YAML first, then JSON
 	if err := yaml.Unmarshal([]byte(processedContent), config); err != nil {
@@ -405,6 +676,34 @@ func loadConfigFromEnv(config *Config) error {
 	if password := os.Getenv("DATABASE_PASSWORD"); password != "" {
 		config.Database.Password = password
 	}
+	if replicaURL := os.Getenv("DATABASE_REPLICA_URL"); replicaURL != "" {
+		config.Database.ReplicaURL = replicaURL
+	}
+	for name, target := range map[string]*int{
+		"DATABASE_MAX_CONNS":      &config.Database.MaxConns,
+		"DATABASE_MIN_CONNS":      &config.Database.MinConns,
+		"DATABASE_READ_MAX_CONNS": &config.Database.ReadMaxConns,
+	} {
+		if value := os.Getenv(name); value != "" {
+			conns, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", name, value)
+			}
+			*target = conns
+		}
+	}
+	for name, target := range map[string]*time.Duration{
+		"DATABASE_STATEMENT_TIMEOUT":      &config.Database.StatementTimeout,
+		"DATABASE_READ_STATEMENT_TIMEOUT": &config.Database.ReadStatementTimeout,
+	} {
+		if value := os.Getenv(name); value != "" {
+			timeout, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", name, value)
+			}
+			*target = timeout
+		}
+	}
 
 	// Logger config
 	if level := os.Getenv("LOGGER_LEVEL"); level != "" {
@@ -419,6 +718,11 @@ func loadConfigFromEnv(config *Config) error {
 		config.Environment = env
 	}
 
+	// gRPC server config
+	if err := config.GRPC.LoadFromEnv(); err != nil {
+		return err
+	}
+
 	// Forge config
 	if protoDir := os.Getenv("PROTO_DIR"); protoDir != "" {
 		config.Forge.ProtoDir = protoDir
@@ -427,6 +731,228 @@ func loadConfigFromEnv(config *Config) error {
 		config.Forge.OutputDir = outputDir
 	}
 
+	// Chaos config
+	if chaosEnabled := os.Getenv("CHAOS_ENABLED"); chaosEnabled != "" {
+		enabled, err := strconv.ParseBool(chaosEnabled)
+		if err != nil {
+			return fmt.Errorf("invalid CHAOS_ENABLED: %s", chaosEnabled)
+		}
+		config.Chaos.Enabled = enabled
+	}
+	if chaosLatency := os.Getenv("CHAOS_LATENCY"); chaosLatency != "" {
+		latency, err := time.ParseDuration(chaosLatency)
+		if err != nil {
+			return fmt.Errorf("invalid CHAOS_LATENCY: %s", chaosLatency)
+		}
+		config.Chaos.Latency = latency
+	}
+	for name, target := range map[string]*float64{
+		"CHAOS_LATENCY_PERCENT": &config.Chaos.LatencyPercent,
+		"CHAOS_ERROR_PERCENT":   &config.Chaos.ErrorPercent,
+		"CHAOS_DROP_PERCENT":    &config.Chaos.DropPercent,
+	} {
+		if value := os.Getenv(name); value != "" {
+			percent, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", name, value)
+			}
+			*target = percent
+		}
+	}
+	if chaosTargets := os.Getenv("CHAOS_TARGETS"); chaosTargets != "" {
+		config.Chaos.Targets = strings.Split(chaosTargets, ",")
+	}
+
+	// Scheduler check cache config
+	if cacheTTL := os.Getenv("SCHEDULER_CHECK_CACHE_TTL"); cacheTTL != "" {
+		ttl, err := time.ParseDuration(cacheTTL)
+		if err != nil {
+			return fmt.Errorf("invalid SCHEDULER_CHECK_CACHE_TTL: %s", cacheTTL)
+		}
+		config.Scheduler.CheckCacheTTL = ttl
+	}
+
+	// Scheduler adaptive interval config
+	if adaptiveEnabled := os.Getenv("SCHEDULER_ADAPTIVE_INTERVAL_ENABLED"); adaptiveEnabled != "" {
+		enabled, err := strconv.ParseBool(adaptiveEnabled)
+		if err != nil {
+			return fmt.Errorf("invalid SCHEDULER_ADAPTIVE_INTERVAL_ENABLED: %s", adaptiveEnabled)
+		}
+		config.Scheduler.AdaptiveInterval.Enabled = enabled
+	}
+	if stableAfter := os.Getenv("SCHEDULER_ADAPTIVE_STABLE_AFTER"); stableAfter != "" {
+		value, err := strconv.Atoi(stableAfter)
+		if err != nil {
+			return fmt.Errorf("invalid SCHEDULER_ADAPTIVE_STABLE_AFTER: %s", stableAfter)
+		}
+		config.Scheduler.AdaptiveInterval.StableAfter = value
+	}
+	if factor := os.Getenv("SCHEDULER_ADAPTIVE_FACTOR"); factor != "" {
+		value, err := strconv.ParseFloat(factor, 64)
+		if err != nil {
+			return fmt.Errorf("invalid SCHEDULER_ADAPTIVE_FACTOR: %s", factor)
+		}
+		config.Scheduler.AdaptiveInterval.Factor = value
+	}
+	if maxInterval := os.Getenv("SCHEDULER_ADAPTIVE_MAX_INTERVAL"); maxInterval != "" {
+		value, err := time.ParseDuration(maxInterval)
+		if err != nil {
+			return fmt.Errorf("invalid SCHEDULER_ADAPTIVE_MAX_INTERVAL: %s", maxInterval)
+		}
+		config.Scheduler.AdaptiveInterval.MaxInterval = value
+	}
+
+	// Check results partitioning config
+	if maintenance := os.Getenv("CHECK_RESULTS_PARTITION_MAINTENANCE"); maintenance != "" {
+		enabled, err := strconv.ParseBool(maintenance)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_PARTITION_MAINTENANCE: %s", maintenance)
+		}
+		config.CheckResults.PartitionMaintenance = enabled
+	}
+	if retention := os.Getenv("CHECK_RESULTS_RETENTION"); retention != "" {
+		value, err := time.ParseDuration(retention)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_RETENTION: %s", retention)
+		}
+		config.CheckResults.Retention = value
+	}
+	if ahead := os.Getenv("CHECK_RESULTS_PARTITIONS_AHEAD"); ahead != "" {
+		value, err := strconv.Atoi(ahead)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_PARTITIONS_AHEAD: %s", ahead)
+		}
+		config.CheckResults.PartitionsAhead = value
+	}
+	if interval := os.Getenv("CHECK_RESULTS_MAINTENANCE_INTERVAL"); interval != "" {
+		value, err := time.ParseDuration(interval)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_MAINTENANCE_INTERVAL: %s", interval)
+		}
+		config.CheckResults.MaintenanceInterval = value
+	}
+	if rollup := os.Getenv("CHECK_RESULTS_UPTIME_ROLLUP"); rollup != "" {
+		enabled, err := strconv.ParseBool(rollup)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_UPTIME_ROLLUP: %s", rollup)
+		}
+		config.CheckResults.UptimeRollup = enabled
+	}
+	if interval := os.Getenv("CHECK_RESULTS_UPTIME_ROLLUP_INTERVAL"); interval != "" {
+		value, err := time.ParseDuration(interval)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_UPTIME_ROLLUP_INTERVAL: %s", interval)
+		}
+		config.CheckResults.UptimeRollupInterval = value
+	}
+	if degraded := os.Getenv("CHECK_RESULTS_DEGRADED_RESPONSE_TIME"); degraded != "" {
+		value, err := time.ParseDuration(degraded)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_DEGRADED_RESPONSE_TIME: %s", degraded)
+		}
+		config.CheckResults.DegradedResponseTime = value
+	}
+	if region := os.Getenv("CHECK_RESULTS_REGION"); region != "" {
+		config.CheckResults.Region = region
+	}
+	if window := os.Getenv("CHECK_RESULTS_CONSENSUS_WINDOW"); window != "" {
+		value, err := time.ParseDuration(window)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_CONSENSUS_WINDOW: %s", window)
+		}
+		config.CheckResults.ConsensusWindow = value
+	}
+	if retention := os.Getenv("CHECK_RESULTS_CAPTURE_RETENTION"); retention != "" {
+		value, err := time.ParseDuration(retention)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_CAPTURE_RETENTION: %s", retention)
+		}
+		config.CheckResults.CaptureRetention = value
+	}
+	if interval := os.Getenv("CHECK_RESULTS_CAPTURE_PURGE_INTERVAL"); interval != "" {
+		value, err := time.ParseDuration(interval)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_RESULTS_CAPTURE_PURGE_INTERVAL: %s", interval)
+		}
+		config.CheckResults.CapturePurgeInterval = value
+	}
+
+	// Check targets restriction config
+	if restrict := os.Getenv("CHECK_TARGETS_RESTRICT"); restrict != "" {
+		enabled, err := strconv.ParseBool(restrict)
+		if err != nil {
+			return fmt.Errorf("invalid CHECK_TARGETS_RESTRICT: %s", restrict)
+		}
+		config.CheckTargets.Restrict = enabled
+	}
+	if deny := os.Getenv("CHECK_TARGETS_DENY"); deny != "" {
+		config.CheckTargets.Deny = strings.Split(deny, ",")
+	}
+	if allow := os.Getenv("CHECK_TARGETS_ALLOW"); allow != "" {
+		config.CheckTargets.Allow = strings.Split(allow, ",")
+	}
+
+	if dir := os.Getenv("NOTIFICATION_PLUGINS_DIR"); dir != "" {
+		config.Providers.Plugins.Dir = dir
+	}
+
+	// Artifact storage config
+	for name, target := range map[string]*string{
+		"ARTIFACTS_ENDPOINT":          &config.Artifacts.Endpoint,
+		"ARTIFACTS_REGION":            &config.Artifacts.Region,
+		"ARTIFACTS_BUCKET":            &config.Artifacts.Bucket,
+		"ARTIFACTS_ACCESS_KEY_ID":     &config.Artifacts.AccessKeyID,
+		"ARTIFACTS_SECRET_ACCESS_KEY": &config.Artifacts.SecretAccessKey,
+	} {
+		if value := os.Getenv(name); value != "" {
+			*target = value
+		}
+	}
+	if pathStyle := os.Getenv("ARTIFACTS_PATH_STYLE"); pathStyle != "" {
+		enabled, err := strconv.ParseBool(pathStyle)
+		if err != nil {
+			return fmt.Errorf("invalid ARTIFACTS_PATH_STYLE: %s", pathStyle)
+		}
+		config.Artifacts.PathStyle = enabled
+	}
+	for name, target := range map[string]*time.Duration{
+		"ARTIFACTS_TTL":              &config.Artifacts.TTL,
+		"ARTIFACTS_URL_TTL":          &config.Artifacts.URLTTL,
+		"ARTIFACTS_CLEANUP_INTERVAL": &config.Artifacts.CleanupInterval,
+	} {
+		if value := os.Getenv(name); value != "" {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", name, value)
+			}
+			*target = duration
+		}
+	}
+
+	// Startup dependency config
+	if attempts := os.Getenv("STARTUP_RETRY_ATTEMPTS"); attempts != "" {
+		value, err := strconv.Atoi(attempts)
+		if err != nil {
+			return fmt.Errorf("invalid STARTUP_RETRY_ATTEMPTS: %s", attempts)
+		}
+		config.Startup.RetryAttempts = value
+	}
+	for name, target := range map[string]*time.Duration{
+		"STARTUP_RETRY_INITIAL_DELAY": &config.Startup.RetryInitialDelay,
+		"STARTUP_RETRY_MAX_DELAY":     &config.Startup.RetryMaxDelay,
+	} {
+		if value := os.Getenv(name); value != "" {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", name, value)
+			}
+			*target = duration
+		}
+	}
+	if required := os.Getenv("STARTUP_REQUIRED"); required != "" {
+		config.Startup.Required = strings.Split(required, ",")
+	}
+
 	// Metrics config
 	if metricsEnabled := os.Getenv("METRICS_ENABLED"); metricsEnabled != "" {
 		if enabled, err := strconv.ParseBool(metricsEnabled); err == nil {
@@ -478,6 +1004,25 @@ func loadConfigFromEnv(config *Config) error {
 	if rabbitmqQueue := os.Getenv("RABBITMQ_QUEUE"); rabbitmqQueue != "" {
 		config.RabbitMQ.Queue = rabbitmqQueue
 	}
+	if maxPriority := os.Getenv("RABBITMQ_MAX_PRIORITY"); maxPriority != "" {
+		if _, err := fmt.Sscanf(maxPriority, "%d", &config.RabbitMQ.MaxPriority); err != nil {
+			return fmt.Errorf("invalid RABBITMQ_MAX_PRIORITY: %s", maxPriority)
+		}
+	}
+	if virtualQueues := os.Getenv("RABBITMQ_VIRTUAL_QUEUES"); virtualQueues != "" {
+		queues, err := strconv.Atoi(virtualQueues)
+		if err != nil {
+			return fmt.Errorf("invalid RABBITMQ_VIRTUAL_QUEUES: %s", virtualQueues)
+		}
+		config.RabbitMQ.VirtualQueues = queues
+	}
+	if tenantPrefetch := os.Getenv("RABBITMQ_TENANT_PREFETCH"); tenantPrefetch != "" {
+		prefetch, err := strconv.Atoi(tenantPrefetch)
+		if err != nil {
+			return fmt.Errorf("invalid RABBITMQ_TENANT_PREFETCH: %s", tenantPrefetch)
+		}
+		config.RabbitMQ.TenantPrefetch = prefetch
+	}
 
 	// gRPC config
 	if grpcPort := os.Getenv("GRPC_PORT"); grpcPort != "" {
@@ -492,6 +1037,70 @@ func loadConfigFromEnv(config *Config) error {
 			return fmt.Errorf("invalid RATE_LIMIT_REQUESTS_PER_MINUTE: %s", rateLimitRequests)
 		}
 	}
+	if trustedProxies := os.Getenv("RATE_LIMIT_TRUSTED_PROXIES"); trustedProxies != "" {
+		config.RateLimiting.TrustedProxies = strings.Split(trustedProxies, ",")
+	}
+	if warnThreshold := os.Getenv("RATE_LIMIT_WARN_THRESHOLD"); warnThreshold != "" {
+		threshold, err := strconv.ParseFloat(warnThreshold, 64)
+		if err != nil {
+			return fmt.Errorf("invalid RATE_LIMIT_WARN_THRESHOLD: %s", warnThreshold)
+		}
+		config.RateLimiting.WarnThreshold = threshold
+	}
+
+	// Maintenance mode config
+	if readOnly := os.Getenv("MAINTENANCE_READ_ONLY"); readOnly != "" {
+		enabled, err := strconv.ParseBool(readOnly)
+		if err != nil {
+			return fmt.Errorf("invalid MAINTENANCE_READ_ONLY: %s", readOnly)
+		}
+		config.Maintenance.ReadOnly = enabled
+	}
+	if message := os.Getenv("MAINTENANCE_MESSAGE"); message != "" {
+		config.Maintenance.Message = message
+	}
+	if retryAfter := os.Getenv("MAINTENANCE_RETRY_AFTER"); retryAfter != "" {
+		duration, err := time.ParseDuration(retryAfter)
+		if err != nil {
+			return fmt.Errorf("invalid MAINTENANCE_RETRY_AFTER: %s", retryAfter)
+		}
+		config.Maintenance.RetryAfter = duration
+	}
+
+	// CORS and cookie auth config
+	if origins := os.Getenv("CORS_ALLOWED_ORIGINS"); origins != "" {
+		config.CORS.AllowedOrigins = strings.Split(origins, ",")
+	}
+	if enabled := os.Getenv("COOKIE_AUTH_ENABLED"); enabled != "" {
+		value, err := strconv.ParseBool(enabled)
+		if err != nil {
+			return fmt.Errorf("invalid COOKIE_AUTH_ENABLED: %s", enabled)
+		}
+		config.CookieAuth.Enabled = value
+	}
+	if domain := os.Getenv("COOKIE_AUTH_DOMAIN"); domain != "" {
+		config.CookieAuth.Domain = domain
+	}
+	if secure := os.Getenv("COOKIE_AUTH_SECURE"); secure != "" {
+		value, err := strconv.ParseBool(secure)
+		if err != nil {
+			return fmt.Errorf("invalid COOKIE_AUTH_SECURE: %s", secure)
+		}
+		config.CookieAuth.Secure = value
+	}
+	if sameSite := os.Getenv("COOKIE_AUTH_SAME_SITE"); sameSite != "" {
+		config.CookieAuth.SameSite = sameSite
+	}
+	if sessionTTL := os.Getenv("COOKIE_AUTH_SESSION_TTL"); sessionTTL != "" {
+		duration, err := time.ParseDuration(sessionTTL)
+		if err != nil {
+			return fmt.Errorf("invalid COOKIE_AUTH_SESSION_TTL: %s", sessionTTL)
+		}
+		config.CookieAuth.SessionTTL = duration
+	}
+	if secret := os.Getenv("COOKIE_AUTH_CSRF_SECRET"); secret != "" {
+		config.CookieAuth.CSRFSecret = secret
+	}
 
 	// Metrics config
 	if metricsEnabled := os.Getenv("METRICS_ENABLED"); metricsEnabled != "" {
@@ -592,6 +1201,16 @@ func validateConfig(config *Config) error {
 	if config.Database.Password == "" {
 		return fmt.Errorf("database.password is required")
 	}
+	// Нулевые лимиты пула означают значения по умолчанию пакета database
+	if config.Database.MaxConns < 0 || config.Database.MinConns < 0 || config.Database.ReadMaxConns < 0 {
+		return fmt.Errorf("database pool limits must not be negative")
+	}
+	if config.Database.MaxConns > 0 && config.Database.MinConns > config.Database.MaxConns {
+		return fmt.Errorf("database.min_conns must not exceed database.max_conns")
+	}
+	if config.Database.StatementTimeout < 0 || config.Database.ReadStatementTimeout < 0 {
+		return fmt.Errorf("database statement timeouts must not be negative")
+	}
 
 	// Валидация конфигурации логгера
 	// Проверяем, что уровень и формат логирования заданы
@@ -602,6 +1221,125 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("logger.format is required")
 	}
 
+	// Валидация доверенных прокси для rate limiting
+	if _, err := ratelimit.ParseTrustedProxies(config.RateLimiting.TrustedProxies); err != nil {
+		return fmt.Errorf("rate_limiting.trusted_proxies: %w", err)
+	}
+	if config.RateLimiting.WarnThreshold < 0 || config.RateLimiting.WarnThreshold > 1 {
+		return fmt.Errorf("rate_limiting.warn_threshold must be between 0 and 1")
+	}
+
+	if adaptive := config.Scheduler.AdaptiveInterval; adaptive.Enabled {
+		if adaptive.StableAfter <= 0 {
+			return fmt.Errorf("scheduler.adaptive_interval.stable_after must be positive")
+		}
+		if adaptive.Factor <= 1 {
+			return fmt.Errorf("scheduler.adaptive_interval.factor must be greater than 1")
+		}
+		if adaptive.MaxInterval <= 0 {
+			return fmt.Errorf("scheduler.adaptive_interval.max_interval must be positive")
+		}
+	}
+
+	if results := config.CheckResults; results.PartitionMaintenance {
+		if results.Retention < 0 {
+			return fmt.Errorf("check_results.retention must not be negative")
+		}
+		if results.Retention > 0 && results.Retention < 24*time.Hour {
+			return fmt.Errorf("check_results.retention must be at least 24h")
+		}
+		if results.PartitionsAhead < 0 {
+			return fmt.Errorf("check_results.partitions_ahead must not be negative")
+		}
+		if results.MaintenanceInterval <= 0 {
+			return fmt.Errorf("check_results.maintenance_interval must be positive")
+		}
+	}
+	if config.Maintenance.RetryAfter < 0 {
+		return fmt.Errorf("maintenance.retry_after must not be negative")
+	}
+	if err := validateCookieAuth(config.CookieAuth, config.CORS); err != nil {
+		return err
+	}
+	if err := validateTargetNetworks("check_targets.deny", config.CheckTargets.Deny); err != nil {
+		return err
+	}
+	if err := validateTargetNetworks("check_targets.allow", config.CheckTargets.Allow); err != nil {
+		return err
+	}
+	if results := config.CheckResults; results.UptimeRollup {
+		if results.UptimeRollupInterval <= 0 {
+			return fmt.Errorf("check_results.uptime_rollup_interval must be positive")
+		}
+		if results.DegradedResponseTime < 0 {
+			return fmt.Errorf("check_results.degraded_response_time must not be negative")
+		}
+	}
+	if config.CheckResults.Region != "" && config.CheckResults.ConsensusWindow <= 0 {
+		return fmt.Errorf("check_results.consensus_window must be positive")
+	}
+	if results := config.CheckResults; results.CaptureRetention < 0 {
+		return fmt.Errorf("check_results.capture_retention must not be negative")
+	} else if results.CaptureRetention > 0 && results.CapturePurgeInterval <= 0 {
+		return fmt.Errorf("check_results.capture_purge_interval must be positive")
+	}
+
+	for i, plugin := range config.CheckerPlugins {
+		if plugin.Path == "" {
+			return fmt.Errorf("checker_plugins[%d].path is required", i)
+		}
+		if plugin.Timeout < 0 || plugin.MaxConcurrent < 0 || plugin.MemoryLimitMB < 0 || plugin.MaxOpenFiles < 0 {
+			return fmt.Errorf("checker_plugins[%d] limits must not be negative", i)
+		}
+	}
+
+	if err := validateSelfMonitoring(config.SelfMonitoring); err != nil {
+		return err
+	}
+
+	// RabbitMQ поддерживает приоритеты от 1 до 255, рекомендуется не больше 10
+	if config.RabbitMQ.MaxPriority < 0 || config.RabbitMQ.MaxPriority > 255 {
+		return fmt.Errorf("rabbitmq.max_priority must be between 0 and 255")
+	}
+	// Каждая виртуальная очередь - отдельная очередь и подписка в брокере
+	if config.RabbitMQ.VirtualQueues < 0 || config.RabbitMQ.VirtualQueues > 256 {
+		return fmt.Errorf("rabbitmq.virtual_queues must be between 0 and 256")
+	}
+	if config.RabbitMQ.TenantPrefetch < 0 {
+		return fmt.Errorf("rabbitmq.tenant_prefetch must not be negative")
+	}
+
+	if artifacts := config.Artifacts; artifacts.Enabled() {
+		if artifacts.Bucket == "" {
+			return fmt.Errorf("artifacts.bucket is required when artifacts.endpoint is set")
+		}
+		if artifacts.TTL <= 0 || artifacts.URLTTL <= 0 || artifacts.CleanupInterval <= 0 {
+			return fmt.Errorf("artifacts.ttl, artifacts.url_ttl and artifacts.cleanup_interval must be positive")
+		}
+		// Подписанная ссылка SigV4 действует не дольше 7 дней
+		if artifacts.URLTTL > 7*24*time.Hour {
+			return fmt.Errorf("artifacts.url_ttl must not exceed 168h")
+		}
+	}
+
+	if err := config.GRPC.Validate(); err != nil {
+		return fmt.Errorf("grpc: %w", err)
+	}
+
+	if startup := config.Startup; startup.RetryAttempts < 0 || startup.RetryInitialDelay < 0 || startup.RetryMaxDelay < 0 {
+		return fmt.Errorf("startup.retry_attempts, startup.retry_initial_delay and startup.retry_max_delay must not be negative")
+	}
+
+	// Внедрение сбоев допустимо только в тестовых окружениях
+	if config.Chaos.Enabled {
+		if config.Environment == "prod" {
+			return fmt.Errorf("chaos.enabled is not allowed in prod environment")
+		}
+		if err := config.Chaos.Validate(); err != nil {
+			return fmt.Errorf("chaos: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -624,29 +1362,6 @@ func (c *Config) Save(filename string) error {
 	return os.WriteFile(filename, content, 0644)
 }
 
-// expandEnvVars processes environment variables in format ${VAR:default}
-func expandEnvVars(content string) string {
-	// Use regex to find ${VAR:default} patterns
-	re := regexp.MustCompile(`\$\{([^}:]+):([^}]*)\}`)
-
-	return re.ReplaceAllStringFunc(content, func(match string) string {
-		// Extract VAR and default from the match
-		parts := strings.SplitN(match[2:len(match)-1], ":", 2)
-		if len(parts) != 2 {
-			return match // Return original if format is wrong
-		}
-
-		varName := parts[0]
-		defaultValue := parts[1]
-
-		// Get environment variable or use default
-		if envValue := os.Getenv(varName); envValue != "" {
-			return envValue
-		}
-		return defaultValue
-	})
-}
-
 // ForgeConfig представляет конфигурацию Forge Service
 type ForgeConfig struct {
 	ProtoDir  string `json:"proto_dir" yaml:"proto_dir"`
@@ -689,6 +1404,16 @@ type HealthConfig struct {
 	CheckInterval string `json:"check_interval" yaml:"check_interval"`
 }
 
+// CheckIntervalDuration возвращает период проверки здоровья для истории статусов;
+// по умолчанию 30 секунд
+func (c HealthConfig) CheckIntervalDuration() time.Duration {
+	interval, err := time.ParseDuration(c.CheckInterval)
+	if err != nil || interval <= 0 {
+		return 30 * time.Second
+	}
+	return interval
+}
+
 // ServicesConfig представляет конфигурацию сервисов для мониторинга
 type ServicesConfig struct {
 	AuthService      ServiceConfig `json:"auth_service" yaml:"auth_service"`
@@ -740,6 +1465,284 @@ type SchedulerConfig struct {
 	TaskTimeout        time.Duration `json:"task_timeout" yaml:"task_timeout"`
 	CleanupInterval    time.Duration `json:"cleanup_interval" yaml:"cleanup_interval"`
 	LockTimeout        time.Duration `json:"lock_timeout" yaml:"lock_timeout"`
+	// CheckCacheTTL время жизни определений проверок в кэше Redis; 0 отключает кэш
+	CheckCacheTTL time.Duration `json:"check_cache_ttl" yaml:"check_cache_ttl"`
+	// AdaptiveInterval реже запускает стабильные проверки
+	AdaptiveInterval AdaptiveIntervalConfig `json:"adaptive_interval" yaml:"adaptive_interval"`
+}
+
+// AdaptiveIntervalConfig конфигурация адаптивного интервала: после StableAfter успешных
+// проверок подряд интервал умножается на Factor, но не выше MaxInterval
+type AdaptiveIntervalConfig struct {
+	Enabled     bool          `json:"enabled" yaml:"enabled"`
+	StableAfter int           `json:"stable_after" yaml:"stable_after"`
+	Factor      float64       `json:"factor" yaml:"factor"`
+	MaxInterval time.Duration `json:"max_interval" yaml:"max_interval"`
+}
+
+// CheckerPluginConfig внешний плагин проверок core-service (см. pkg/plugin). Плагин запускается
+// при старте сервиса и выполняет проверки типа plugin, у которых config.plugin равен его имени
+type CheckerPluginConfig struct {
+	Path string   `json:"path" yaml:"path"`
+	Args []string `json:"args" yaml:"args"`
+	Env  []string `json:"env" yaml:"env"`
+	// Timeout верхняя граница одной проверки; 0 - значение по умолчанию core-service
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+	// MaxConcurrent число одновременных проверок плагина
+	MaxConcurrent int `json:"max_concurrent" yaml:"max_concurrent"`
+	// MemoryLimitMB и MaxOpenFiles лимиты процесса плагина (только Linux); 0 снимает ограничение
+	MemoryLimitMB int `json:"memory_limit_mb" yaml:"memory_limit_mb"`
+	MaxOpenFiles  int `json:"max_open_files" yaml:"max_open_files"`
+}
+
+// CheckResultsConfig конфигурация хранения результатов проверок: дневные партиции
+// check_results создаются на PartitionsAhead дней вперед, партиции старше Retention удаляются
+type CheckResultsConfig struct {
+	PartitionMaintenance bool `json:"partition_maintenance" yaml:"partition_maintenance"`
+	// Retention срок хранения результатов; 0 отключает удаление партиций
+	Retention           time.Duration `json:"retention" yaml:"retention"`
+	PartitionsAhead     int           `json:"partitions_ahead" yaml:"partitions_ahead"`
+	MaintenanceInterval time.Duration `json:"maintenance_interval" yaml:"maintenance_interval"`
+
+	// UptimeRollup включает расчет дневных сводок доступности для 90-дневной истории
+	UptimeRollup         bool          `json:"uptime_rollup" yaml:"uptime_rollup"`
+	UptimeRollupInterval time.Duration `json:"uptime_rollup_interval" yaml:"uptime_rollup_interval"`
+	// DegradedResponseTime успешный ответ не быстрее этого порога считается деградацией; 0 отключает
+	DegradedResponseTime time.Duration `json:"degraded_response_time" yaml:"degraded_response_time"`
+
+	// Region регион проб этого экземпляра core-service для задач без явного региона; пустое
+	// значение - результаты не объединяются по политике консенсуса
+	Region string `json:"region" yaml:"region"`
+	// ConsensusWindow сколько ждать результатов остальных регионов одного запуска проверки
+	ConsensusWindow time.Duration `json:"consensus_window" yaml:"consensus_window"`
+
+	// CaptureRetention срок хранения диагностических снимков неудачных проверок; сами результаты
+	// остаются до удаления партиции. 0 отключает очистку снимков
+	CaptureRetention     time.Duration `json:"capture_retention" yaml:"capture_retention"`
+	CapturePurgeInterval time.Duration `json:"capture_purge_interval" yaml:"capture_purge_interval"`
+}
+
+// MaintenanceConfig режим обслуживания платформы: пока он включен, api-gateway обслуживает
+// только чтение, чтобы миграции баз данных выполнялись без отключения всего API
+type MaintenanceConfig struct {
+	// ReadOnly отклоняет запросы, изменяющие данные, с 503 и Retry-After
+	ReadOnly bool `json:"read_only" yaml:"read_only"`
+	// Message текст баннера для пользователей
+	Message string `json:"message" yaml:"message"`
+	// RetryAfter через сколько клиентам повторять отклоненные запросы
+	RetryAfter time.Duration `json:"retry_after" yaml:"retry_after"`
+}
+
+// CORSConfig источники браузерных клиентов, которым api-gateway разрешает кросс-доменные запросы
+type CORSConfig struct {
+	// AllowedOrigins разрешенные значения Origin; пустой список запрещает кросс-доменные запросы,
+	// "*" разрешает любой источник, но без передачи cookie
+	AllowedOrigins []string `json:"allowed_origins" yaml:"allowed_origins"`
+}
+
+// CookieAuthConfig аутентификация веб-клиентов через cookie: api-gateway хранит токены в HttpOnly
+// cookie и принимает изменяющие запросы только с CSRF токеном, привязанным к сессии
+type CookieAuthConfig struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Domain домен cookie; пустое значение ограничивает cookie хостом gateway
+	Domain string `json:"domain" yaml:"domain"`
+	// Secure передавать cookie только по HTTPS
+	Secure bool `json:"secure" yaml:"secure"`
+	// SameSite политика SameSite cookie: strict или lax
+	SameSite string `json:"same_site" yaml:"same_site"`
+	// SessionTTL время жизни cookie сессии
+	SessionTTL time.Duration `json:"session_ttl" yaml:"session_ttl"`
+	// CSRFSecret ключ подписи CSRF токенов, общий для всех экземпляров gateway
+	CSRFSecret string `json:"csrf_secret" yaml:"csrf_secret"`
+}
+
+// minCSRFSecretLength минимальная длина ключа подписи CSRF токенов
+const minCSRFSecretLength = 32
+
+// validateCookieAuth проверяет настройки cookie аутентификации и их совместимость с CORS
+func validateCookieAuth(cookieAuth CookieAuthConfig, cors CORSConfig) error {
+	if !cookieAuth.Enabled {
+		return nil
+	}
+	switch strings.ToLower(cookieAuth.SameSite) {
+	case "strict", "lax":
+	default:
+		return fmt.Errorf("cookie_auth.same_site must be strict or lax, got %q", cookieAuth.SameSite)
+	}
+	if cookieAuth.SessionTTL <= 0 {
+		return fmt.Errorf("cookie_auth.session_ttl must be positive")
+	}
+	if len(cookieAuth.CSRFSecret) < minCSRFSecretLength {
+		return fmt.Errorf("cookie_auth.csrf_secret must be at least %d characters", minCSRFSecretLength)
+	}
+	for _, origin := range cors.AllowedOrigins {
+		if strings.TrimSpace(origin) == "*" {
+			return fmt.Errorf("cors.allowed_origins must list origins explicitly when cookie_auth is enabled")
+		}
+	}
+	return nil
+}
+
+// CheckTargetsConfig ограничение адресов, к которым проверки устанавливают соединения (защита от SSRF)
+type CheckTargetsConfig struct {
+	// Restrict запрещает соединения с внутренними сетями и адресами метаданных облака
+	Restrict bool `json:"restrict" yaml:"restrict"`
+	// Deny сети (CIDR или адреса), запрещенные в дополнение к встроенному списку
+	Deny []string `json:"deny" yaml:"deny"`
+	// Allow сети установки, которые можно проверять несмотря на запрет, например внутренние сервисы
+	Allow []string `json:"allow" yaml:"allow"`
+}
+
+// validateTargetNetworks проверяет, что каждая сеть задана в виде CIDR или IP адреса
+func validateTargetNetworks(field string, networks []string) error {
+	for _, network := range networks {
+		network = strings.TrimSpace(network)
+		if network == "" {
+			continue
+		}
+		if _, err := netip.ParsePrefix(network); err == nil {
+			continue
+		}
+		if _, err := netip.ParseAddr(network); err != nil {
+			return fmt.Errorf("%s: %q is not a CIDR or IP address", field, network)
+		}
+	}
+	return nil
+}
+
+// ArtifactsConfig конфигурация S3-совместимого хранилища крупных результатов проверок
+// (скриншоты, HAR, снимки ответов). Пустой Endpoint отключает хранилище
+type ArtifactsConfig struct {
+	Endpoint        string `json:"endpoint" yaml:"endpoint"`
+	Region          string `json:"region" yaml:"region"`
+	Bucket          string `json:"bucket" yaml:"bucket"`
+	AccessKeyID     string `json:"access_key_id" yaml:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key" yaml:"secret_access_key"`
+	// PathStyle адресует bucket в пути (MinIO), иначе в имени хоста
+	PathStyle bool `json:"path_style" yaml:"path_style"`
+	// TTL срок хранения артефактов, после которого они удаляются
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+	// URLTTL срок действия подписанной ссылки на артефакт
+	URLTTL          time.Duration `json:"url_ttl" yaml:"url_ttl"`
+	CleanupInterval time.Duration `json:"cleanup_interval" yaml:"cleanup_interval"`
+}
+
+// Enabled сообщает, настроено ли хранилище артефактов
+func (c ArtifactsConfig) Enabled() bool {
+	return c.Endpoint != ""
+}
+
+// StartupConfig политика подключения к зависимостям при запуске сервиса: число попыток
+// и задержки между ними, нулевые значения заменяются значениями по умолчанию. Required делает обязательными зависимости, которые сервис
+// по умолчанию считает необязательными (например redis); без обязательной зависимости
+// сервис не запускается, без необязательной - работает в деградированном режиме
+type StartupConfig struct {
+	RetryAttempts     int           `json:"retry_attempts" yaml:"retry_attempts"`
+	RetryInitialDelay time.Duration `json:"retry_initial_delay" yaml:"retry_initial_delay"`
+	RetryMaxDelay     time.Duration `json:"retry_max_delay" yaml:"retry_max_delay"`
+	Required          []string      `json:"required" yaml:"required"`
+}
+
+// PlatformTenantID tenant самомониторинга платформы, создаваемый миграцией 021
+const PlatformTenantID = "00000000-0000-0000-0000-000000000001"
+
+// SelfMonitoringConfig самомониторинг платформы. Scheduler-service создает в мета-tenant TenantID
+// проверки компонентов платформы, которые выполняются обычным конвейером и открывают обычные
+// инциденты. Когда недоступна сама инфраструктура конвейера, эти инциденты никто не доставит,
+// поэтому компоненты с BreakGlass дополнительно опрашиваются напрямую и оповещают по SMTP и
+// webhook в обход RabbitMQ
+type SelfMonitoringConfig struct {
+	Enabled    bool                      `json:"enabled" yaml:"enabled"`
+	TenantID   string                    `json:"tenant_id" yaml:"tenant_id"`
+	Interval   time.Duration             `json:"interval" yaml:"interval"`
+	Timeout    time.Duration             `json:"timeout" yaml:"timeout"`
+	Components []PlatformComponentConfig `json:"components" yaml:"components"`
+	BreakGlass BreakGlassConfig          `json:"break_glass" yaml:"break_glass"`
+	Pipeline   PipelineSLIConfig         `json:"pipeline" yaml:"pipeline"`
+}
+
+// PlatformComponentConfig компонент платформы под самомониторингом: Type http с URL в Target
+// или tcp с host:port
+type PlatformComponentConfig struct {
+	Name   string `json:"name" yaml:"name"`
+	Type   string `json:"type" yaml:"type"`
+	Target string `json:"target" yaml:"target"`
+	// BreakGlass опрашивает компонент напрямую: без него конвейер проверок не работает
+	BreakGlass bool `json:"break_glass" yaml:"break_glass"`
+}
+
+// BreakGlassConfig аварийное оповещение о компонентах платформы. Оповещение отправляется после
+// FailureThreshold неудачных опросов подряд, повторяется раз в RepeatInterval и завершается
+// сообщением о восстановлении. SMTP берется из providers.email. Без получателей оповещения
+// только пишутся в лог
+type BreakGlassConfig struct {
+	Interval         time.Duration `json:"interval" yaml:"interval"`
+	FailureThreshold int           `json:"failure_threshold" yaml:"failure_threshold"`
+	RepeatInterval   time.Duration `json:"repeat_interval" yaml:"repeat_interval"`
+	Emails           []string      `json:"emails" yaml:"emails"`
+	// WebhookURLs получают JSON с подписью X-UptimePing-Signature при заданном WebhookSecret
+	WebhookURLs   []string `json:"webhook_urls" yaml:"webhook_urls"`
+	WebhookSecret string   `json:"webhook_secret" yaml:"webhook_secret"`
+}
+
+// PipelineSLIConfig SLI конвейера проверок в Core Service: задержка очереди (от scheduled_at задачи
+// до ее получения) и задержка сохранения (от получения до записи результата). Если квантиль Quantile
+// задержки за окно Window превышает порог, в мета-tenant открывается внутренний инцидент, который
+// закрывается после возвращения в норму. Нулевой порог отключает оповещение по своему SLI
+type PipelineSLIConfig struct {
+	Window                time.Duration `json:"window" yaml:"window"`
+	EvaluationInterval    time.Duration `json:"evaluation_interval" yaml:"evaluation_interval"`
+	Quantile              float64       `json:"quantile" yaml:"quantile"`
+	MinSamples            int           `json:"min_samples" yaml:"min_samples"`
+	QueueLagThreshold     time.Duration `json:"queue_lag_threshold" yaml:"queue_lag_threshold"`
+	PersistDelayThreshold time.Duration `json:"persist_delay_threshold" yaml:"persist_delay_threshold"`
+}
+
+// validateSelfMonitoring проверяет компоненты и параметры аварийного оповещения
+func validateSelfMonitoring(monitoring SelfMonitoringConfig) error {
+	if !monitoring.Enabled {
+		return nil
+	}
+	if monitoring.TenantID == "" {
+		return fmt.Errorf("self_monitoring.tenant_id is required")
+	}
+	if monitoring.Interval <= 0 || monitoring.Timeout <= 0 || monitoring.Timeout >= monitoring.Interval {
+		return fmt.Errorf("self_monitoring.timeout must be positive and less than self_monitoring.interval")
+	}
+	names := make(map[string]bool, len(monitoring.Components))
+	for i, component := range monitoring.Components {
+		if component.Name == "" || names[component.Name] {
+			return fmt.Errorf("self_monitoring.components[%d].name must be unique and not empty", i)
+		}
+		names[component.Name] = true
+		switch component.Type {
+		case "http":
+			if parsed, err := url.Parse(component.Target); err != nil || parsed.Host == "" {
+				return fmt.Errorf("self_monitoring.components[%d].target must be an http URL", i)
+			}
+		case "tcp":
+			if _, _, err := net.SplitHostPort(component.Target); err != nil {
+				return fmt.Errorf("self_monitoring.components[%d].target must be host:port", i)
+			}
+		default:
+			return fmt.Errorf("self_monitoring.components[%d].type must be http or tcp", i)
+		}
+	}
+	breakGlass := monitoring.BreakGlass
+	if breakGlass.Interval <= 0 || breakGlass.FailureThreshold <= 0 || breakGlass.RepeatInterval < 0 {
+		return fmt.Errorf("self_monitoring.break_glass interval and failure_threshold must be positive")
+	}
+	pipeline := monitoring.Pipeline
+	if pipeline.Window <= 0 || pipeline.EvaluationInterval <= 0 || pipeline.MinSamples <= 0 {
+		return fmt.Errorf("self_monitoring.pipeline window, evaluation_interval and min_samples must be positive")
+	}
+	if pipeline.Quantile <= 0 || pipeline.Quantile > 1 {
+		return fmt.Errorf("self_monitoring.pipeline.quantile must be in (0, 1]")
+	}
+	if pipeline.QueueLagThreshold < 0 || pipeline.PersistDelayThreshold < 0 {
+		return fmt.Errorf("self_monitoring.pipeline thresholds must not be negative")
+	}
+	return nil
 }
 
 // GetServicePath автоматически определяет путь к сервису
@@ -805,20 +1808,30 @@ func GetServicePath() string {
 	return ""
 }
 
-// LoadConfigWithAutoPath загружает конфигурацию с автоматическим определением пути
+// LoadConfigWithAutoPath загружает конфигурацию с автоматическим определением пути.
+// Если файл окружения не найден ни в одном из известных мест, используются
+// значения по умолчанию с переопределением из переменных окружения
 func LoadConfigWithAutoPath(env string) (*Config, error) {
+	return loadLayeredConfig(findConfigFile(env))
+}
+
+// findConfigFile ищет файл конфигурации окружения; возвращает пустую строку, если файл не найден
+func findConfigFile(env string) string {
 	// Пробуем несколько стратегий поиска конфигурации
 	
 	// Стратегия 1: Используем рабочую директорию
 	wd, err := os.Getwd()
 	if err == nil {
-		// Если мы в директории cmd, поднимаемся на уровень
-		if filepath.Base(filepath.Dir(wd)) == "cmd" {
-			servicePath := filepath.Dir(filepath.Dir(wd))
+		// Если мы в директории cmd или ее подкаталоге, поднимаемся до сервиса
+		if filepath.Base(wd) == "cmd" || filepath.Base(filepath.Dir(wd)) == "cmd" {
+			servicePath := filepath.Dir(wd)
+			if filepath.Base(wd) != "cmd" {
+				servicePath = filepath.Dir(servicePath)
+			}
 			configPath := filepath.Join(servicePath, "config", env+".yaml")
 			if _, err := os.Stat(configPath); err == nil {
 				fmt.Printf("DEBUG: Using config file (strategy 1): %s\n", configPath)
-				return loadConfigFromPath(configPath)
+				return configPath
 			}
 		}
 		
@@ -827,7 +1840,7 @@ func LoadConfigWithAutoPath(env string) (*Config, error) {
 			configPath := filepath.Join(wd, "config", env+".yaml")
 			if _, err := os.Stat(configPath); err == nil {
 				fmt.Printf("DEBUG: Using config file (strategy 2): %s\n", configPath)
-				return loadConfigFromPath(configPath)
+				return configPath
 			}
 		}
 	}
@@ -838,7 +1851,7 @@ func LoadConfigWithAutoPath(env string) (*Config, error) {
 		configPath := filepath.Join("services", serviceName, "config", env+".yaml")
 		if _, err := os.Stat(configPath); err == nil {
 			fmt.Printf("DEBUG: Using config file (strategy 3): %s\n", configPath)
-			return loadConfigFromPath(configPath)
+			return configPath
 		}
 	}
 	
@@ -848,11 +1861,11 @@ func LoadConfigWithAutoPath(env string) (*Config, error) {
 		configPath := filepath.Join("services", service, "config", env+".yaml")
 		if _, err := os.Stat(configPath); err == nil {
 			fmt.Printf("DEBUG: Using config file (strategy 4): %s\n", configPath)
-			return loadConfigFromPath(configPath)
+			return configPath
 		}
 	}
 	
-	return nil, fmt.Errorf("config file not found in any known location")
+	return ""
 }
 
 // getServiceNameFromCaller определяет имя сервиса из стека вызовов
@@ -877,43 +1890,3 @@ func getServiceNameFromCaller() string {
 	}
 	return ""
 }
-
-// loadConfigFromPath загружает конфигурацию из указанного пути
-func loadConfigFromPath(configPath string) (*Config, error) {
-	// Инициализируем конфигурацию с значениями по умолчанию
-	config := &Config{
-		Server: ServerConfig{
-			Host: "0.0.0.0",
-			Port: 8080,
-		},
-		Database: DatabaseConfig{
-			Host:     "localhost",
-			Port:     5432,
-			Name:     "uptimeping",
-			User:     "uptimeping",
-			Password: "uptimeping123",
-		},
-		Logger: LoggerConfig{
-			Level:  "info",
-			Format: "json",
-		},
-		Environment: "dev",
-	}
-	
-	// Загружаем из файла
-	if err := loadConfigFromFile(config, configPath); err != nil {
-		return nil, err
-	}
-	
-	// Загружаем из переменных окружения
-	if err := loadConfigFromEnv(config); err != nil {
-		return nil, err
-	}
-	
-	// Валидируем конфигурацию
-	if err := validateConfig(config); err != nil {
-		return nil, err
-	}
-	
-	return config, nil
-}
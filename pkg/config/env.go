@@ -0,0 +1,116 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// UnsetEnvError возвращается, если в конфигурации используются переменные окружения
+// без значения по умолчанию, которые не заданы
+type UnsetEnvError struct {
+	Variables []string
+}
+
+// Error возвращает сообщение об ошибке со списком незаданных переменных
+func (e *UnsetEnvError) Error() string {
+	return fmt.Sprintf("environment variables are not set: %s", strings.Join(e.Variables, ", "))
+}
+
+// ExpandEnv подставляет переменные окружения в содержимое конфигурации.
+//
+// Поддерживаемый синтаксис:
+//   - ${VAR}          - значение переменной; если переменная не задана, возвращается UnsetEnvError
+//   - ${VAR:default}  - значение переменной или default, если переменная не задана или пуста
+//   - $$              - литеральный символ $
+//
+// Символ $, за которым не следует { или $, остается без изменений,
+// поэтому значения вроде паролей с $ не портятся.
+func ExpandEnv(content string) (string, error) {
+	return ExpandEnvWith(content, os.LookupEnv)
+}
+
+// ExpandEnvWith подставляет переменные, используя lookup вместо окружения процесса
+func ExpandEnvWith(content string, lookup func(string) (string, bool)) (string, error) {
+	var builder strings.Builder
+	builder.Grow(len(content))
+
+	unset := make(map[string]struct{})
+	for i := 0; i < len(content); i++ {
+		if content[i] != '$' || i+1 >= len(content) {
+			builder.WriteByte(content[i])
+			continue
+		}
+
+		switch content[i+1] {
+		case '$':
+			builder.WriteByte('$')
+			i++
+		case '{':
+			end := strings.IndexByte(content[i+2:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated variable reference at offset %d", i)
+			}
+			expr := content[i+2 : i+2+end]
+			value, err := resolveEnvExpr(expr, lookup, unset)
+			if err != nil {
+				return "", fmt.Errorf("invalid variable reference ${%s}: %w", expr, err)
+			}
+			builder.WriteString(value)
+			i += 2 + end
+		default:
+			builder.WriteByte('$')
+		}
+	}
+
+	if len(unset) > 0 {
+		names := make([]string, 0, len(unset))
+		for name := range unset {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", &UnsetEnvError{Variables: names}
+	}
+
+	return builder.String(), nil
+}
+
+// resolveEnvExpr вычисляет выражение VAR или VAR:default; незаданные переменные без
+// значения по умолчанию накапливаются в unset, чтобы сообщить обо всех сразу
+func resolveEnvExpr(expr string, lookup func(string) (string, bool), unset map[string]struct{}) (string, error) {
+	name, defaultValue, hasDefault := strings.Cut(expr, ":")
+	if !isValidEnvName(name) {
+		return "", fmt.Errorf("invalid variable name %q", name)
+	}
+
+	value, ok := lookup(name)
+	if hasDefault {
+		if !ok || value == "" {
+			return defaultValue, nil
+		}
+		return value, nil
+	}
+
+	if !ok {
+		unset[name] = struct{}{}
+		return "", nil
+	}
+	return value, nil
+}
+
+// isValidEnvName проверяет имя переменной окружения: буквы, цифры и _, не начинается с цифры
+func isValidEnvName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func testLookup(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := vars[name]
+		return value, ok
+	}
+}
+
+// TestExpandEnvWith проверяет подстановку переменных, значения по умолчанию и экранирование
+func TestExpandEnvWith(t *testing.T) {
+	lookup := testLookup(map[string]string{
+		"HOST":  "db.internal",
+		"EMPTY": "",
+		"PASS":  "pa$$word",
+	})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"set variable", "host: ${HOST}", "host: db.internal"},
+		{"default when unset", "port: ${PORT:5432}", "port: 5432"},
+		{"default when empty", "value: ${EMPTY:fallback}", "value: fallback"},
+		{"set variable ignores default", "host: ${HOST:localhost}", "host: db.internal"},
+		{"default with colon", "addr: ${REDIS_ADDR:localhost:6379}", "addr: localhost:6379"},
+		{"empty default", "token: ${TOKEN:}", "token: "},
+		{"set but empty without default", "value: ${EMPTY}", "value: "},
+		{"escaped dollar", "password: p$$ss", "password: p$ss"},
+		{"escaped reference", "literal: $${HOST}", "literal: ${HOST}"},
+		{"bare dollar kept", "price: $5 and $HOST", "price: $5 and $HOST"},
+		{"trailing dollar kept", "end$", "end$"},
+		{"value is not re-expanded", "password: ${PASS}", "password: pa$$word"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ExpandEnvWith(tt.input, lookup)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+// TestExpandEnvWith_UnsetVariables проверяет, что все незаданные переменные попадают в одну ошибку
+func TestExpandEnvWith_UnsetVariables(t *testing.T) {
+	_, err := ExpandEnvWith("a: ${B_VAR}\nb: ${A_VAR}\nc: ${B_VAR}\nd: ${C_VAR:ok}", testLookup(nil))
+	if err == nil {
+		t.Fatal("Expected error for unset variables")
+	}
+
+	var unsetErr *UnsetEnvError
+	if !errors.As(err, &unsetErr) {
+		t.Fatalf("Expected UnsetEnvError, got %T", err)
+	}
+	if !reflect.DeepEqual(unsetErr.Variables, []string{"A_VAR", "B_VAR"}) {
+		t.Errorf("Expected [A_VAR B_VAR], got %v", unsetErr.Variables)
+	}
+}
+
+// TestExpandEnvWith_InvalidSyntax проверяет ошибки синтаксиса ссылок на переменные
+func TestExpandEnvWith_InvalidSyntax(t *testing.T) {
+	inputs := []string{
+		"host: ${HOST",
+		"host: ${}",
+		"host: ${:default}",
+		"host: ${1HOST}",
+		"host: ${HOST-NAME}",
+	}
+
+	for _, input := range inputs {
+		if _, err := ExpandEnvWith(input, testLookup(nil)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+// TestLoadConfig_UnsetEnvVariable проверяет, что загрузка конфигурации не скрывает незаданные переменные
+func TestLoadConfig_UnsetEnvVariable(t *testing.T) {
+	os.Unsetenv("UPTIMEPING_TEST_UNSET_HOST")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := "database:\n  host: ${UPTIMEPING_TEST_UNSET_HOST}\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	_, err := LoadConfig(path)
+	var unsetErr *UnsetEnvError
+	if !errors.As(err, &unsetErr) {
+		t.Fatalf("Expected UnsetEnvError, got %v", err)
+	}
+
+	t.Setenv("UPTIMEPING_TEST_UNSET_HOST", "db.example.com")
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Database.Host != "db.example.com" {
+		t.Errorf("Expected database host to be \"db.example.com\", got %s", config.Database.Host)
+	}
+}
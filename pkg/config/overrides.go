@@ -0,0 +1,277 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// EnvPrefix префикс переменных окружения, которые переопределяют любой ключ конфигурации.
+// Имя переменной - путь ключа в YAML в верхнем регистре через _: database.host ->
+// UPTIMEPING_DATABASE_HOST, grpc.keepalive.min_time -> UPTIMEPING_GRPC_KEEPALIVE_MIN_TIME
+const EnvPrefix = "UPTIMEPING_"
+
+// redactedValue значение секретов в выводе конфигурации
+const redactedValue = "******"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// EnvVar переменная окружения ключа конфигурации
+type EnvVar struct {
+	// Name имя переменной, например UPTIMEPING_DATABASE_HOST
+	Name string
+	// Key путь ключа в YAML, например database.host
+	Key string
+}
+
+// EnvVars возвращает переменные окружения всех ключей конфигурации в порядке полей Config
+func EnvVars() []EnvVar {
+	var vars []EnvVar
+	walkConfigKeys(reflect.ValueOf(DefaultConfig()).Elem(), nil, func(path []string, _ reflect.Value) {
+		vars = append(vars, EnvVar{Name: envName(path), Key: strings.Join(path, ".")})
+	})
+	return vars
+}
+
+// applyEnvOverrides применяет переменные UPTIMEPING_* поверх остальных слоев конфигурации.
+// Пустые значения пропускаются. Скаляры задаются строкой, длительности - в формате
+// time.ParseDuration, списки скаляров - через запятую, списки структур и словари - YAML или JSON
+func applyEnvOverrides(config *Config, lookup func(string) (string, bool)) error {
+	var invalid []string
+	walkConfigKeys(reflect.ValueOf(config).Elem(), nil, func(path []string, field reflect.Value) {
+		name := envName(path)
+		value, ok := lookup(name)
+		if !ok || value == "" {
+			return
+		}
+		if err := setFieldValue(field, value); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %v", name, err))
+		}
+	})
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid environment overrides: %s", strings.Join(invalid, "; "))
+	}
+	return nil
+}
+
+// walkConfigKeys обходит ключи конфигурации; вложенные структуры раскрываются, остальные
+// поля (скаляры, списки, словари) считаются одним ключом
+func walkConfigKeys(value reflect.Value, path []string, visit func(path []string, field reflect.Value)) {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		name := yamlKey(valueType.Field(i))
+		if name == "" {
+			continue
+		}
+		field := value.Field(i)
+		fieldPath := append(append([]string(nil), path...), name)
+		if field.Kind() == reflect.Struct {
+			walkConfigKeys(field, fieldPath, visit)
+			continue
+		}
+		visit(fieldPath, field)
+	}
+}
+
+// yamlKey возвращает имя ключа поля в YAML; пустая строка для неэкспортируемых и скрытых полей
+func yamlKey(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+// envName имя переменной окружения ключа
+func envName(path []string) string {
+	return EnvPrefix + strings.ToUpper(strings.Join(path, "_"))
+}
+
+// setFieldValue разбирает значение переменной окружения в поле конфигурации
+func setFieldValue(field reflect.Value, value string) error {
+	if field.Type() == durationType {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(duration))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(parsed)
+	case reflect.Ptr:
+		elem := reflect.New(field.Type().Elem())
+		if err := setFieldValue(elem.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(elem)
+	case reflect.Slice:
+		if isScalar(field.Type().Elem()) {
+			items := reflect.MakeSlice(field.Type(), 0, 0)
+			for _, item := range strings.Split(value, ",") {
+				item = strings.TrimSpace(item)
+				if item == "" {
+					continue
+				}
+				elem := reflect.New(field.Type().Elem()).Elem()
+				if err := setFieldValue(elem, item); err != nil {
+					return err
+				}
+				items = reflect.Append(items, elem)
+			}
+			field.Set(items)
+			return nil
+		}
+		return setStructuredValue(field, value)
+	case reflect.Map:
+		return setStructuredValue(field, value)
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
+
+// setStructuredValue разбирает список структур или словарь из YAML или JSON
+func setStructuredValue(field reflect.Value, value string) error {
+	parsed := reflect.New(field.Type())
+	if err := yaml.Unmarshal([]byte(value), parsed.Interface()); err != nil {
+		return err
+	}
+	field.Set(parsed.Elem())
+	return nil
+}
+
+// isScalar проверяет, что значение типа задается одной строкой
+func isScalar(t reflect.Type) bool {
+	if t == durationType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// Redacted возвращает действующую конфигурацию в виде ключ -> значение для вывода при запуске.
+// Пароли, секреты и токены заменяются на ******, пароли в URL подключения скрываются
+func (c *Config) Redacted() map[string]string {
+	values := make(map[string]string)
+	flattenRedacted(reflect.ValueOf(c).Elem(), "", values)
+	return values
+}
+
+// flattenRedacted раскладывает значение в плоские ключи; элементы списков структур и словарей
+// получают индекс или ключ словаря в пути, чтобы секреты внутри них тоже скрывались
+func flattenRedacted(value reflect.Value, key string, values map[string]string) {
+	switch {
+	case value.Kind() == reflect.Struct:
+		valueType := value.Type()
+		for i := 0; i < valueType.NumField(); i++ {
+			if name := yamlKey(valueType.Field(i)); name != "" {
+				flattenRedacted(value.Field(i), joinKey(key, name), values)
+			}
+		}
+	case value.Kind() == reflect.Slice && !isScalar(value.Type().Elem()):
+		for i := 0; i < value.Len(); i++ {
+			flattenRedacted(value.Index(i), joinKey(key, strconv.Itoa(i)), values)
+		}
+	case value.Kind() == reflect.Map:
+		for _, mapKey := range value.MapKeys() {
+			flattenRedacted(value.MapIndex(mapKey), joinKey(key, fmt.Sprint(mapKey.Interface())), values)
+		}
+	default:
+		values[key] = redact(key, formatValue(value))
+	}
+}
+
+// joinKey добавляет сегмент к пути ключа
+func joinKey(key, name string) string {
+	if key == "" {
+		return name
+	}
+	return key + "." + name
+}
+
+// formatValue форматирует скаляр или список скаляров
+func formatValue(value reflect.Value) string {
+	switch {
+	case value.Type() == durationType:
+		return time.Duration(value.Int()).String()
+	case value.Kind() == reflect.Ptr:
+		if value.IsNil() {
+			return ""
+		}
+		return formatValue(value.Elem())
+	case value.Kind() == reflect.Slice:
+		items := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, formatValue(value.Index(i)))
+		}
+		return strings.Join(items, ",")
+	case isScalar(value.Type()):
+		return fmt.Sprint(value.Interface())
+	default:
+		encoded, _ := json.Marshal(value.Interface())
+		return string(encoded)
+	}
+}
+
+// redact скрывает значение секретного ключа и пароль в URL подключения
+func redact(key, value string) string {
+	if value == "" {
+		return value
+	}
+	name := key[strings.LastIndex(key, ".")+1:]
+	if name == "env" || strings.HasPrefix(name, "webhook_url") || strings.Contains(name, "password") ||
+		strings.Contains(name, "secret") || strings.HasSuffix(name, "_token") {
+		return redactedValue
+	}
+	if parsed, err := url.Parse(value); err == nil && parsed.User != nil {
+		if _, ok := parsed.User.Password(); ok {
+			return parsed.Redacted()
+		}
+	}
+	return value
+}
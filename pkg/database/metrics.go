@@ -0,0 +1,71 @@
+package database
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolCollector экспортирует состояние пулов подключений в Prometheus. Рост
+// db_pool_empty_acquire_total и времени ожидания показывает насыщение пула
+type PoolCollector struct {
+	service string
+	pools   map[string]*pgxpool.Pool
+
+	acquired        *prometheus.Desc
+	idle            *prometheus.Desc
+	total           *prometheus.Desc
+	max             *prometheus.Desc
+	acquires        *prometheus.Desc
+	emptyAcquires   *prometheus.Desc
+	canceled        *prometheus.Desc
+	acquireDuration *prometheus.Desc
+}
+
+// NewPoolCollector создает коллектор метрик пулов записи и чтения сервиса
+func NewPoolCollector(service string, pools *Pools) *PoolCollector {
+	named := map[string]*pgxpool.Pool{"write": pools.Write}
+	if pools.Separate() {
+		named["read"] = pools.Read
+	}
+
+	labels := []string{"service", "pool"}
+	return &PoolCollector{
+		service:         service,
+		pools:           named,
+		acquired:        prometheus.NewDesc("db_pool_acquired_conns", "Number of connections currently in use", labels, nil),
+		idle:            prometheus.NewDesc("db_pool_idle_conns", "Number of idle connections in the pool", labels, nil),
+		total:           prometheus.NewDesc("db_pool_total_conns", "Total number of connections in the pool", labels, nil),
+		max:             prometheus.NewDesc("db_pool_max_conns", "Maximum size of the pool", labels, nil),
+		acquires:        prometheus.NewDesc("db_pool_acquire_total", "Total number of successful connection acquires", labels, nil),
+		emptyAcquires:   prometheus.NewDesc("db_pool_empty_acquire_total", "Acquires that had to wait for a connection because the pool was empty", labels, nil),
+		canceled:        prometheus.NewDesc("db_pool_canceled_acquire_total", "Acquires canceled by context while waiting for a connection", labels, nil),
+		acquireDuration: prometheus.NewDesc("db_pool_acquire_duration_seconds_total", "Total time spent acquiring connections", labels, nil),
+	}
+}
+
+// Describe реализует prometheus.Collector
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquired
+	ch <- c.idle
+	ch <- c.total
+	ch <- c.max
+	ch <- c.acquires
+	ch <- c.emptyAcquires
+	ch <- c.canceled
+	ch <- c.acquireDuration
+}
+
+// Collect реализует prometheus.Collector
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, pool := range c.pools {
+		stat := pool.Stat()
+		ch <- prometheus.MustNewConstMetric(c.acquired, prometheus.GaugeValue, float64(stat.AcquiredConns()), c.service, name)
+		ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stat.IdleConns()), c.service, name)
+		ch <- prometheus.MustNewConstMetric(c.total, prometheus.GaugeValue, float64(stat.TotalConns()), c.service, name)
+		ch <- prometheus.MustNewConstMetric(c.max, prometheus.GaugeValue, float64(stat.MaxConns()), c.service, name)
+		ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()), c.service, name)
+		ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()), c.service, name)
+		ch <- prometheus.MustNewConstMetric(c.canceled, prometheus.CounterValue, float64(stat.CanceledAcquireCount()), c.service, name)
+		ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds(), c.service, name)
+	}
+}
@@ -0,0 +1,92 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Pools пулы подключений для записи и чтения. Если отдельный пул чтения
+// не настроен, Read совпадает с Write
+type Pools struct {
+	Write *pgxpool.Pool
+	Read  *pgxpool.Pool
+}
+
+// NewPools использует один пул и для записи, и для чтения
+func NewPools(pool *pgxpool.Pool) *Pools {
+	return &Pools{Write: pool, Read: pool}
+}
+
+// ConnectCluster подключает primary и, если задан ReplicaDSN, пул чтения к реплике
+func ConnectCluster(ctx context.Context, config *Config) (*Pools, error) {
+	return ConnectPools(ctx, config, config.Replica())
+}
+
+// ConnectPools подключает пул записи и, если read не nil, отдельный пул чтения
+func ConnectPools(ctx context.Context, write, read *Config) (*Pools, error) {
+	writeDB, err := Connect(ctx, write)
+	if err != nil {
+		return nil, err
+	}
+	if read == nil {
+		return NewPools(writeDB.Pool), nil
+	}
+
+	readDB, err := Connect(ctx, read)
+	if err != nil {
+		writeDB.Close()
+		return nil, fmt.Errorf("failed to connect read pool: %w", err)
+	}
+	return &Pools{Write: writeDB.Pool, Read: readDB.Pool}, nil
+}
+
+// Separate сообщает, используется ли для чтения отдельный пул
+func (p *Pools) Separate() bool {
+	return p.Read != nil && p.Read != p.Write
+}
+
+// Close закрывает пулы
+func (p *Pools) Close() {
+	if p.Separate() {
+		p.Read.Close()
+	}
+	if p.Write != nil {
+		p.Write.Close()
+	}
+}
+
+// Replica возвращает конфигурацию пула чтения к реплике или nil, если реплика не задана.
+// Лимиты пула наследуются, подготовленные запросы не переносятся: запись идет только в primary
+func (c *Config) Replica() *Config {
+	if c.ReplicaDSN == "" {
+		return nil
+	}
+	replica := *c
+	replica.DSN = c.ReplicaDSN
+	replica.ReplicaDSN = ""
+	replica.PreparedStatements = nil
+	return &replica
+}
+
+// ReadPool возвращает копию конфигурации с лимитами пула чтения. Нулевые значения
+// наследуются от исходной конфигурации, подготовленные запросы пула записи не переносятся
+func (c *Config) ReadPool(maxConns, minConns int, statementTimeout time.Duration) *Config {
+	read := *c
+	read.PreparedStatements = nil
+	if maxConns > 0 {
+		read.MaxConns = maxConns
+	}
+	if minConns > 0 {
+		read.MinConns = minConns
+	}
+	if read.MinConns > read.MaxConns {
+		read.MinConns = read.MaxConns
+	}
+	if statementTimeout > 0 {
+		read.StatementTimeout = statementTimeout
+	}
+	return &read
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -33,6 +34,16 @@ type Config struct {
 	// Retry settings
 	MaxRetries    int
 	RetryInterval time.Duration
+	// StatementTimeout ограничивает время выполнения запроса на стороне PostgreSQL; 0 без ограничения
+	StatementTimeout time.Duration
+	// PreparedStatements запросы, подготавливаемые на каждом новом соединении пула.
+	// Запрос выполняется как подготовленный, если передать в Exec/Query тот же текст
+	PreparedStatements []string
+	// DSN строка подключения; если задана, заменяет Host, Port, User, Password, Database и SSLMode
+	DSN string
+	// ReplicaDSN строка подключения к реплике для пула чтения. Для нескольких реплик
+	// с переходом на primary подходит multi-host DSN с target_session_attrs=prefer-standby
+	ReplicaDSN string
 }
 
 // NewConfig создает конфигурацию по умолчанию
@@ -61,35 +72,25 @@ func Connect(ctx context.Context, config *Config) (*Postgres, error) {
 	// Пытаемся подключиться с retry
 	for i := 0; i <= config.MaxRetries; i++ {
 		// Создаем строку подключения
-		connString := fmt.Sprintf(
-			"postgres://%s:%s@%s:%d/%s?sslmode=%s&pool_max_conns=%d&pool_min_conns=%d&pool_max_conn_lifetime=%s&pool_max_conn_idle_time=%s",
-			config.User, config.Password, config.Host, config.Port, config.Database,
-			config.SSLMode, config.MaxConns, config.MinConns, config.MaxConnLife, config.MaxConnIdle,
-		)
+		connString := config.DSN
+		if connString == "" {
+			connString = fmt.Sprintf(
+				"postgres://%s:%s@%s:%d/%s?sslmode=%s&pool_max_conns=%d&pool_min_conns=%d&pool_max_conn_lifetime=%s&pool_max_conn_idle_time=%s",
+				config.User, config.Password, config.Host, config.Port, config.Database,
+				config.SSLMode, config.MaxConns, config.MinConns, config.MaxConnLife, config.MaxConnIdle,
+			)
+		}
 
 		// Создаем конфигурацию пула
-		poolConfig, err := pgxpool.ParseConfig(connString)
+		poolConfig, err := newPoolConfig(connString, config)
 		if err != nil {
-			lastErr = fmt.Errorf("failed to parse pool config: %w", err)
+			lastErr = err
 			if i < config.MaxRetries {
 				time.Sleep(config.RetryInterval)
 			}
 			continue
 		}
 
-		// Создаем пул подключений
-		poolConfig.HealthCheckPeriod = config.HealthCheck
-		// Устанавливаем лимиты соединений
-		poolConfig.MaxConns = int32(config.MaxConns)
-		poolConfig.MinConns = int32(config.MinConns)
-
-		// Устанавливаем максимальное время жизни соединения
-		poolConfig.MaxConnLifetime = config.MaxConnLife
-		poolConfig.MaxConnIdleTime = config.MaxConnIdle
-
-		// Устанавливаем максимальное время ожидания соединения
-		poolConfig.MaxConnLifetimeJitter = 30 * time.Second
-
 		// Пытаемся подключиться
 		pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 		if err != nil {
@@ -116,6 +117,47 @@ func Connect(ctx context.Context, config *Config) (*Postgres, error) {
 	return nil, fmt.Errorf("failed to connect to database after %d retries: %w", config.MaxRetries, lastErr)
 }
 
+// newPoolConfig строит конфигурацию пула: лимиты соединений, таймаут запросов
+// и подготовка запросов на каждом новом соединении
+func newPoolConfig(connString string, config *Config) (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pool config: %w", err)
+	}
+
+	// Создаем пул подключений
+	poolConfig.HealthCheckPeriod = config.HealthCheck
+	// Устанавливаем лимиты соединений
+	poolConfig.MaxConns = int32(config.MaxConns)
+	poolConfig.MinConns = int32(config.MinConns)
+
+	// Устанавливаем максимальное время жизни соединения
+	poolConfig.MaxConnLifetime = config.MaxConnLife
+	poolConfig.MaxConnIdleTime = config.MaxConnIdle
+
+	// Устанавливаем максимальное время ожидания соединения
+	poolConfig.MaxConnLifetimeJitter = 30 * time.Second
+
+	if config.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(config.StatementTimeout.Milliseconds(), 10)
+	}
+
+	if len(config.PreparedStatements) > 0 {
+		statements := append([]string(nil), config.PreparedStatements...)
+		poolConfig.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+			for _, sql := range statements {
+				// Имя совпадает с текстом: pgx использует подготовленный запрос при выполнении по тексту
+				if _, err := conn.Prepare(ctx, sql, sql); err != nil {
+					return fmt.Errorf("failed to prepare statement: %w", err)
+				}
+			}
+			return nil
+		}
+	}
+
+	return poolConfig, nil
+}
+
 // Close закрывает подключение к базе данных
 func (p *Postgres) Close() {
 	if p.Pool != nil {
@@ -200,7 +242,16 @@ func GetConfig() *Config {
 			config.RetryInterval = ri
 		}
 	}
+	if statementTimeout := os.Getenv("DB_STATEMENT_TIMEOUT"); statementTimeout != "" {
+		if st, err := time.ParseDuration(statementTimeout); err == nil {
+			config.StatementTimeout = st
+		}
+	}
 	
+	if replicaURL := os.Getenv("DB_REPLICA_URL"); replicaURL != "" {
+		config.ReplicaDSN = replicaURL
+	}
+
 	// Поддержка DATABASE_URL для совместимости
 	if databaseURL := os.Getenv("DATABASE_URL"); databaseURL != "" {
 		// Парсим DATABASE_URL и извлекаем параметры
@@ -189,6 +189,7 @@ func FromGRPCErr(err error) *Error {
 		return &Error{
 			Code:    code,
 			Message: grpcStatus.Message(),
+			Details: ExtractErrorDetails(err),
 		}
 	}
 
@@ -133,6 +133,7 @@ func TestFromGRPCErr_WithDetails(t *testing.T) {
 	assert.NotNil(t, customErr)
 	assert.Equal(t, ErrValidation, customErr.Code)
 	assert.Equal(t, "validation failed", customErr.Message)
+	assert.Equal(t, "field 'email' is required", customErr.Details)
 
 	// Проверяем, что детали можно извлечь обратно
 	details := ExtractErrorDetails(grpcErr)
@@ -0,0 +1,179 @@
+// Package events описывает версионированные контракты сообщений RabbitMQ: схемы событий
+// check.result, incident.* и notification.*, проверку при публикации и терпимое чтение при потреблении.
+//
+// Правила версионирования: добавление необязательного поля не меняет версию схемы, потребители
+// игнорируют неизвестные поля. Удаление, переименование или смена типа поля требуют новой версии;
+// потребитель принимает сообщения с версией не выше известной ему, поэтому сначала выкатываются
+// потребители новой версии, затем производители
+package events
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Заголовки AMQP сообщения с типом события и версией схемы
+const (
+	HeaderEventType     = "event_type"
+	HeaderSchemaVersion = "schema_version"
+)
+
+// ContentType тип содержимого сообщений
+const ContentType = "application/json"
+
+// Ошибки кодирования и чтения событий
+var (
+	ErrUnknownEventType   = errors.New("event type does not match the schema")
+	ErrUnsupportedVersion = errors.New("event schema version is newer than supported")
+	ErrInvalidPayload     = errors.New("invalid event payload")
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// Schema версионированная схема семейства событий. Pattern - тип события или префикс вида "incident.*"
+type Schema struct {
+	Name    string
+	Pattern string
+	Version int
+}
+
+// Matches проверяет, относится ли тип события к семейству схемы
+func (s Schema) Matches(eventType string) bool {
+	if prefix, ok := strings.CutSuffix(s.Pattern, "*"); ok {
+		return strings.HasPrefix(eventType, prefix) && len(eventType) > len(prefix)
+	}
+	return eventType == s.Pattern
+}
+
+// JSONSchema возвращает JSON Schema текущей версии для производителей и потребителей на других языках
+func (s Schema) JSONSchema() ([]byte, error) {
+	return schemaFiles.ReadFile(fmt.Sprintf("schemas/%s.v%d.json", s.Name, s.Version))
+}
+
+// Payload тело события с его схемой
+type Payload interface {
+	Schema() Schema
+	// Validate проверяет обязательные поля
+	Validate() error
+	meta() *Meta
+}
+
+// Meta общие поля всех событий
+type Meta struct {
+	EventType     string `json:"event_type"`
+	SchemaVersion int    `json:"schema_version"`
+}
+
+func (m *Meta) meta() *Meta {
+	return m
+}
+
+// Schemas возвращает зарегистрированные схемы, упорядоченные по имени
+func Schemas() []Schema {
+	schemas := []Schema{checkResultSchema, incidentSchema, notificationSchema}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Name < schemas[j].Name })
+	return schemas
+}
+
+// Lookup находит схему по типу события
+func Lookup(eventType string) (Schema, bool) {
+	for _, schema := range Schemas() {
+		if schema.Matches(eventType) {
+			return schema, true
+		}
+	}
+	return Schema{}, false
+}
+
+// Encode проверяет событие и сериализует его текущей версией схемы. Возвращает тело и заголовки сообщения
+func Encode(eventType string, payload Payload) ([]byte, map[string]interface{}, error) {
+	schema := payload.Schema()
+	if !schema.Matches(eventType) {
+		return nil, nil, fmt.Errorf("%w: %s is not %s", ErrUnknownEventType, eventType, schema.Pattern)
+	}
+	if err := payload.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s: %v", ErrInvalidPayload, eventType, err)
+	}
+
+	meta := payload.meta()
+	meta.EventType = eventType
+	meta.SchemaVersion = schema.Version
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+	headers := map[string]interface{}{
+		HeaderEventType:     eventType,
+		HeaderSchemaVersion: int32(schema.Version),
+	}
+	return body, headers, nil
+}
+
+// Decode читает событие терпимо к изменениям: неизвестные поля игнорируются, сообщения без версии
+// считаются первой версией, более старые версии принимаются. Версия новее известной отклоняется
+func Decode(headers map[string]interface{}, body []byte, payload Payload) error {
+	schema := payload.Schema()
+	// Версия из заголовка проверяется до разбора: тело новой версии может не разобраться старым типом
+	if version, ok := headerInt(headers[HeaderSchemaVersion]); ok && version > schema.Version {
+		return unsupportedVersion(schema, version)
+	}
+	if err := json.Unmarshal(body, payload); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, schema.Name, err)
+	}
+	if version := messageVersion(headers, payload); version > schema.Version {
+		return unsupportedVersion(schema, version)
+	}
+	if err := payload.Validate(); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, schema.Name, err)
+	}
+	return nil
+}
+
+// unsupportedVersion ошибка версии новее известной потребителю
+func unsupportedVersion(schema Schema, version int) error {
+	return fmt.Errorf("%w: %s v%d, supported v%d", ErrUnsupportedVersion, schema.Name, version, schema.Version)
+}
+
+// messageVersion версия из заголовка, затем из тела; без версии - 1
+func messageVersion(headers map[string]interface{}, payload Payload) int {
+	if version, ok := headerInt(headers[HeaderSchemaVersion]); ok && version > 0 {
+		return version
+	}
+	if version := payload.meta().SchemaVersion; version > 0 {
+		return version
+	}
+	return 1
+}
+
+// headerInt приводит значение заголовка AMQP к int
+func headerInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case uint8:
+		return int(v), true
+	case uint16:
+		return int(v), true
+	case uint32:
+		return int(v), true
+	case string:
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
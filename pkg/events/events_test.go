@@ -0,0 +1,155 @@
+package events
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testIncident() *Incident {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	return &Incident{
+		Timestamp:  now,
+		Service:    "incident-manager",
+		IncidentID: "incident-1",
+		CheckID:    "check-1",
+		TenantID:   "tenant-1",
+		Status:     "open",
+		Severity:   "critical",
+		Count:      3,
+		FirstSeen:  now.Add(-time.Minute),
+		LastSeen:   now,
+		Data:       map[string]interface{}{"owner": "team-a"},
+	}
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	body, headers, err := Encode(TypeIncidentOpened, testIncident())
+	require.NoError(t, err)
+	assert.Equal(t, TypeIncidentOpened, headers[HeaderEventType])
+	assert.Equal(t, int32(1), headers[HeaderSchemaVersion])
+
+	var decoded Incident
+	require.NoError(t, Decode(headers, body, &decoded))
+	assert.Equal(t, TypeIncidentOpened, decoded.EventType)
+	assert.Equal(t, 1, decoded.SchemaVersion)
+	assert.Equal(t, "incident-1", decoded.IncidentID)
+	assert.Equal(t, "team-a", decoded.Data["owner"])
+}
+
+func TestEncode_Rejects(t *testing.T) {
+	_, _, err := Encode(TypeCheckResult, testIncident())
+	assert.ErrorIs(t, err, ErrUnknownEventType)
+
+	incident := testIncident()
+	incident.TenantID = ""
+	_, _, err = Encode(TypeIncidentOpened, incident)
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+
+	_, _, err = Encode(TypeCheckResult, &CheckResult{CheckID: "check-1", TenantID: "tenant-1"})
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
+
+func TestDecode_TolerantReader(t *testing.T) {
+	// Сообщение старого производителя без версии и с полем, неизвестным потребителю
+	body := []byte(`{"incident_id":"incident-1","tenant_id":"tenant-1","status":"open","severity":"warning","added_later":{"x":1}}`)
+
+	var decoded Incident
+	require.NoError(t, Decode(nil, body, &decoded))
+	assert.Equal(t, "incident-1", decoded.IncidentID)
+	assert.Equal(t, 0, decoded.SchemaVersion)
+
+	var missing Incident
+	err := Decode(nil, []byte(`{"incident_id":"incident-1"}`), &missing)
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
+
+func TestDecode_RejectsNewerVersion(t *testing.T) {
+	body := []byte(`{"schema_version":2,"incident_id":"incident-1","tenant_id":"tenant-1","status":"open","severity":"warning"}`)
+
+	var fromBody Incident
+	assert.ErrorIs(t, Decode(nil, body, &fromBody), ErrUnsupportedVersion)
+
+	// Заголовок проверяется до разбора тела, даже если тело несовместимо со старым типом
+	var fromHeader Incident
+	err := Decode(map[string]interface{}{HeaderSchemaVersion: "2"}, []byte(`{"count":"many"}`), &fromHeader)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		eventType string
+		name      string
+		ok        bool
+	}{
+		{TypeCheckResult, "check.result", true},
+		{TypeIncidentSLABreached, "incident", true},
+		{"notification.requested", "notification", true},
+		{"incident.", "", false},
+		{"check.failed", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			schema, ok := Lookup(tt.eventType)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.name, schema.Name)
+		})
+	}
+}
+
+// TestJSONSchemas_MatchPayloads сверяет свойства JSON Schema с полями Go типов
+func TestJSONSchemas_MatchPayloads(t *testing.T) {
+	payloads := map[string]Payload{
+		checkResultSchema.Name:  &CheckResult{},
+		incidentSchema.Name:     &Incident{},
+		notificationSchema.Name: &Notification{},
+	}
+	require.Len(t, payloads, len(Schemas()))
+
+	for _, schema := range Schemas() {
+		t.Run(schema.Name, func(t *testing.T) {
+			payload, ok := payloads[schema.Name]
+			require.True(t, ok)
+
+			content, err := schema.JSONSchema()
+			require.NoError(t, err)
+			var document struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+				Required   []string                   `json:"required"`
+			}
+			require.NoError(t, json.Unmarshal(content, &document))
+
+			properties := make([]string, 0, len(document.Properties))
+			for name := range document.Properties {
+				properties = append(properties, name)
+			}
+			sort.Strings(properties)
+			assert.Equal(t, jsonFields(reflect.TypeOf(payload).Elem()), properties)
+			for _, name := range document.Required {
+				assert.Contains(t, properties, name)
+			}
+		})
+	}
+}
+
+// jsonFields имена JSON полей структуры с учетом встроенных структур
+func jsonFields(typ reflect.Type) []string {
+	var fields []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous {
+			fields = append(fields, jsonFields(field.Type)...)
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	return fields
+}
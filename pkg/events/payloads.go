@@ -0,0 +1,125 @@
+package events
+
+import (
+	"fmt"
+	"time"
+)
+
+// Схемы событий текущих версий
+var (
+	checkResultSchema  = Schema{Name: "check.result", Pattern: TypeCheckResult, Version: 1}
+	incidentSchema     = Schema{Name: "incident", Pattern: "incident.*", Version: 1}
+	notificationSchema = Schema{Name: "notification", Pattern: "notification.*", Version: 1}
+)
+
+// Типы событий
+const (
+	TypeCheckResult = "check.result"
+
+	TypeIncidentOpened      = "incident.opened"
+	TypeIncidentUpdated     = "incident.updated"
+	TypeIncidentResolved    = "incident.resolved"
+	TypeIncidentGrouped     = "incident.grouped"
+	TypeIncidentSLABreached = "incident.sla_breached"
+	TypeIncidentReminder    = "incident.reminder"
+
+	// TypeSecurityPrefix префикс уведомлений безопасности тенанта: notification.security.<событие>
+	TypeSecurityPrefix = "notification.security."
+)
+
+// CheckResult результат выполнения проверки
+type CheckResult struct {
+	Meta
+	CheckID     string            `json:"check_id"`
+	TenantID    string            `json:"tenant_id"`
+	ExecutionID string            `json:"execution_id,omitempty"`
+	Success     bool              `json:"success"`
+	DurationMs  int64             `json:"duration_ms"`
+	StatusCode  int               `json:"status_code,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	Region      string            `json:"region,omitempty"`
+	CheckedAt   time.Time         `json:"checked_at"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// Schema возвращает схему check.result
+func (e *CheckResult) Schema() Schema {
+	return checkResultSchema
+}
+
+// Validate проверяет обязательные поля
+func (e *CheckResult) Validate() error {
+	if err := required("check_id", e.CheckID, "tenant_id", e.TenantID); err != nil {
+		return err
+	}
+	if e.CheckedAt.IsZero() {
+		return fmt.Errorf("checked_at is required")
+	}
+	if e.DurationMs < 0 {
+		return fmt.Errorf("duration_ms must not be negative")
+	}
+	return nil
+}
+
+// Incident событие жизненного цикла инцидента
+type Incident struct {
+	Meta
+	Timestamp    time.Time              `json:"timestamp"`
+	Service      string                 `json:"service"`
+	IncidentID   string                 `json:"incident_id"`
+	CheckID      string                 `json:"check_id"`
+	TenantID     string                 `json:"tenant_id"`
+	Status       string                 `json:"status"`
+	Severity     string                 `json:"severity"`
+	Count        int                    `json:"count"`
+	Duration     int64                  `json:"duration"`
+	ErrorMessage string                 `json:"error_message,omitempty"`
+	ErrorHash    string                 `json:"error_hash,omitempty"`
+	FirstSeen    time.Time              `json:"first_seen"`
+	LastSeen     time.Time              `json:"last_seen"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Data         map[string]interface{} `json:"data,omitempty"`
+}
+
+// Schema возвращает схему incident.*
+func (e *Incident) Schema() Schema {
+	return incidentSchema
+}
+
+// Validate проверяет обязательные поля
+func (e *Incident) Validate() error {
+	return required("incident_id", e.IncidentID, "tenant_id", e.TenantID, "status", e.Status, "severity", e.Severity)
+}
+
+// Notification запрос уведомления о событии тенанта
+type Notification struct {
+	Meta
+	ID        string                 `json:"id"`
+	TenantID  string                 `json:"tenant_id"`
+	Severity  string                 `json:"severity"`
+	Source    string                 `json:"source,omitempty"`
+	Title     string                 `json:"title"`
+	Message   string                 `json:"message,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	Timestamp time.Time              `json:"timestamp"`
+}
+
+// Schema возвращает схему notification.*
+func (e *Notification) Schema() Schema {
+	return notificationSchema
+}
+
+// Validate проверяет обязательные поля
+func (e *Notification) Validate() error {
+	return required("tenant_id", e.TenantID)
+}
+
+// required проверяет, что значения пар имя-значение не пустые
+func required(pairs ...string) error {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			return fmt.Errorf("%s is required", pairs[i])
+		}
+	}
+	return nil
+}
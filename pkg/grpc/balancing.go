@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// balancedServiceConfig конфигурация клиента по умолчанию: round_robin по всем адресам,
+// полученным из DNS, и исключение подканалов, не отвечающих SERVING на grpc.health.v1
+const balancedServiceConfig = `{
+	"loadBalancingConfig": [{"round_robin": {}}],
+	"healthCheckConfig": {"serviceName": %q}
+}`
+
+// RegisterHealthServer регистрирует grpc.health.v1 на сервере и помечает общий статус и
+// перечисленные сервисы как SERVING. Вызов Shutdown у возвращенного сервера переводит их
+// в NOT_SERVING, и клиенты убирают реплику из балансировки до ее остановки
+func RegisterHealthServer(server *grpc.Server, services ...string) *health.Server {
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	for _, service := range services {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(server, healthServer)
+	return healthServer
+}
+
+// BalancedTarget возвращает цель для клиентской балансировки: адрес без схемы
+// разрешается через dns:///, чтобы headless сервис отдавал адреса всех реплик
+func BalancedTarget(address string) string {
+	if strings.Contains(address, "://") || strings.HasPrefix(address, "unix:") {
+		return address
+	}
+	return "dns:///" + address
+}
+
+// BalancedDialOptions дополняет opts round_robin балансировкой и проверкой здоровья
+// подканалов. Пустой service проверяет общий статус сервера. Серверы без grpc.health.v1
+// считаются здоровыми
+func BalancedDialOptions(service string, opts ...grpc.DialOption) []grpc.DialOption {
+	return append([]grpc.DialOption{
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(balancedServiceConfig, service)),
+	}, opts...)
+}
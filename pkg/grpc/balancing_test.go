@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// countingReplica gRPC сервер с grpc.health.v1, считающий принятые вызовы Check
+type countingReplica struct {
+	address string
+	health  *health.Server
+	calls   atomic.Int64
+}
+
+func startReplica(t *testing.T) *countingReplica {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	replica := &countingReplica{address: lis.Addr().String()}
+	server := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		replica.calls.Add(1)
+		return handler(ctx, req)
+	}))
+	replica.health = RegisterHealthServer(server, "uptimeping.test.v1.TestService")
+
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+	return replica
+}
+
+func TestBalancedTarget(t *testing.T) {
+	assert.Equal(t, "dns:///core-service:50051", BalancedTarget("core-service:50051"))
+	assert.Equal(t, "dns:///core-service-headless.uptimeping.svc:50051", BalancedTarget("core-service-headless.uptimeping.svc:50051"))
+	assert.Equal(t, "dns://10.0.0.10/core-service:50051", BalancedTarget("dns://10.0.0.10/core-service:50051"))
+	assert.Equal(t, "passthrough:///localhost:50051", BalancedTarget("passthrough:///localhost:50051"))
+	assert.Equal(t, "unix:///var/run/core.sock", BalancedTarget("unix:///var/run/core.sock"))
+}
+
+func TestRegisterHealthServer(t *testing.T) {
+	replica := startReplica(t)
+	conn, err := grpc.NewClient(replica.address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+	for _, service := range []string{"", "uptimeping.test.v1.TestService"} {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status, service)
+	}
+
+	replica.health.Shutdown()
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+}
+
+func TestBalancedDialOptions_RoundRobinWithHealthEviction(t *testing.T) {
+	first, second := startReplica(t), startReplica(t)
+
+	// Ручной резолвер заменяет DNS headless сервиса с двумя репликами
+	builder := manual.NewBuilderWithScheme("replicas")
+	builder.InitialState(resolver.State{Addresses: []resolver.Address{
+		{Addr: first.address}, {Addr: second.address},
+	}})
+
+	conn, err := grpc.NewClient("replicas:///test", BalancedDialOptions("",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithResolvers(builder),
+	)...)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	check := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{}, grpc.WaitForReady(true))
+		require.NoError(t, err)
+	}
+
+	require.Eventually(t, func() bool {
+		check()
+		return first.calls.Load() > 0 && second.calls.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond, "traffic is spread across replicas")
+
+	// Реплика в NOT_SERVING исключается из балансировки
+	first.health.Shutdown()
+	require.Eventually(t, func() bool {
+		before := first.calls.Load()
+		for i := 0; i < 10; i++ {
+			check()
+		}
+		return first.calls.Load() == before
+	}, 5*time.Second, 10*time.Millisecond, "unhealthy replica is evicted")
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/chaos"
+)
+
+// FaultInjectionServerOptions опции gRPC сервера с внедрением сбоев. Для выключенного
+// инжектора возвращает пустой список
+func FaultInjectionServerOptions(injector *chaos.Injector) []grpc.ServerOption {
+	if !injector.Enabled() {
+		return nil
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(FaultInjectionUnaryServerInterceptor(injector)),
+		grpc.ChainStreamInterceptor(FaultInjectionStreamServerInterceptor(injector)),
+	}
+}
+
+// FaultInjectionUnaryServerInterceptor внедряет задержки, ошибки и потерю запросов перед вызовом обработчика
+func FaultInjectionUnaryServerInterceptor(injector *chaos.Injector) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := injectFault(ctx, injector, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// FaultInjectionStreamServerInterceptor внедряет сбои перед открытием потока
+func FaultInjectionStreamServerInterceptor(injector *chaos.Injector) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := injectFault(stream.Context(), injector, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// FaultInjectionUnaryClientInterceptor внедряет сбои на стороне клиента до отправки запроса
+func FaultInjectionUnaryClientInterceptor(injector *chaos.Injector) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := injectFault(ctx, injector, method); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// injectFault применяет решение инжектора и переводит его в gRPC статус. Потерянный запрос
+// не получает ответа до истечения дедлайна, как при недоступном сервере
+func injectFault(ctx context.Context, injector *chaos.Injector, method string) error {
+	decision, err := injector.Apply(ctx, method)
+	if err != nil {
+		if errors.Is(err, chaos.ErrInjected) {
+			return status.Error(codes.Unavailable, err.Error())
+		}
+		return status.FromContextError(err).Err()
+	}
+	if !decision.Drop {
+		return nil
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		return status.Errorf(codes.Unavailable, "chaos: request to %s dropped", method)
+	}
+	<-ctx.Done()
+	return status.FromContextError(ctx.Err()).Err()
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/chaos"
+)
+
+func newInjector(t *testing.T, config chaos.Config) *chaos.Injector {
+	t.Helper()
+	config.Enabled = true
+	injector, err := chaos.NewInjector("grpc_test", config)
+	require.NoError(t, err)
+	return injector
+}
+
+func TestFaultInjectionUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/uptimeping.core.v1.CoreService/ExecuteCheck"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	t.Run("disabled injector passes requests through", func(t *testing.T) {
+		resp, err := FaultInjectionUnaryServerInterceptor(nil)(context.Background(), nil, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("injected error becomes unavailable", func(t *testing.T) {
+		interceptor := FaultInjectionUnaryServerInterceptor(newInjector(t, chaos.Config{ErrorPercent: 100}))
+		_, err := interceptor(context.Background(), nil, info, handler)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("dropped request waits for the deadline", func(t *testing.T) {
+		interceptor := FaultInjectionUnaryServerInterceptor(newInjector(t, chaos.Config{DropPercent: 100}))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := interceptor(ctx, nil, info, handler)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+
+	t.Run("other targets are not affected", func(t *testing.T) {
+		interceptor := FaultInjectionUnaryServerInterceptor(newInjector(t, chaos.Config{
+			ErrorPercent: 100,
+			Targets:      []string{"/uptimeping.scheduler.v1.SchedulerService/*"},
+		}))
+		resp, err := interceptor(context.Background(), nil, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+func TestFaultInjectionServerOptions(t *testing.T) {
+	assert.Empty(t, FaultInjectionServerOptions(nil))
+	assert.Len(t, FaultInjectionServerOptions(newInjector(t, chaos.Config{ErrorPercent: 1})), 2)
+}
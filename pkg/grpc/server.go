@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"net"
+
+	"golang.org/x/net/netutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+
+	"UptimePingPlatform/pkg/config"
+)
+
+// ServerOptions опции gRPC сервера из конфигурации: размеры сообщений, число потоков
+// в соединении и keepalive. Нулевые значения оставляют значения по умолчанию gRPC
+func ServerOptions(cfg config.GRPCConfig) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	if cfg.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(cfg.MaxSendMsgSize))
+	}
+	if cfg.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(cfg.MaxConcurrentStreams))
+	}
+
+	keepaliveCfg := cfg.Keepalive
+	return append(opts,
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  keepaliveCfg.Time,
+			Timeout:               keepaliveCfg.Timeout,
+			MaxConnectionIdle:     keepaliveCfg.MaxConnectionIdle,
+			MaxConnectionAge:      keepaliveCfg.MaxConnectionAge,
+			MaxConnectionAgeGrace: keepaliveCfg.MaxConnectionAgeGrace,
+		}),
+		// Клиент, пингующий чаще MinTime, получает GOAWAY too_many_pings
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveCfg.MinTime,
+			PermitWithoutStream: keepaliveCfg.PermitWithoutStream,
+		}),
+	)
+}
+
+// LimitListener ограничивает число одновременно открытых соединений listener значением
+// MaxConnections: сверх лимита новые соединения ждут в очереди accept. 0 - без ограничения
+func LimitListener(lis net.Listener, cfg config.GRPCConfig) net.Listener {
+	if cfg.MaxConnections <= 0 {
+		return lis
+	}
+	return netutil.LimitListener(lis, cfg.MaxConnections)
+}
+
+// RegisterReflection регистрирует gRPC reflection, если он разрешен для окружения environment.
+// Возвращает, зарегистрирован ли reflection
+func RegisterReflection(server *grpc.Server, cfg config.GRPCConfig, environment string) bool {
+	if !cfg.ReflectionEnabled(environment) {
+		return false
+	}
+	reflection.Register(server)
+	return true
+}
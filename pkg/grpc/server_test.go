@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+
+	"UptimePingPlatform/pkg/config"
+)
+
+func TestServerOptions(t *testing.T) {
+	assert.Len(t, ServerOptions(config.GRPCConfig{}), 2)
+
+	cfg := config.DefaultGRPCConfig()
+	cfg.MaxConcurrentStreams = 100
+	assert.Len(t, ServerOptions(cfg), 5)
+}
+
+func TestLimitListener(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	assert.Same(t, lis, LimitListener(lis, config.GRPCConfig{}))
+	assert.NotSame(t, lis, LimitListener(lis, config.GRPCConfig{MaxConnections: 10}))
+}
+
+// listServices запрашивает список сервисов через reflection
+func listServices(t *testing.T, server *grpc.Server) error {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		return err
+	}
+	_, err = stream.Recv()
+	return err
+}
+
+func TestRegisterReflection(t *testing.T) {
+	cfg := config.DefaultGRPCConfig()
+
+	server := grpc.NewServer(ServerOptions(cfg)...)
+	assert.True(t, RegisterReflection(server, cfg, "dev"))
+	assert.NoError(t, listServices(t, server))
+
+	server = grpc.NewServer(ServerOptions(cfg)...)
+	assert.False(t, RegisterReflection(server, cfg, "prod"))
+	assert.Error(t, listServices(t, server))
+
+	enabled := true
+	cfg.Reflection = &enabled
+	assert.True(t, RegisterReflection(grpc.NewServer(), cfg, "prod"))
+}
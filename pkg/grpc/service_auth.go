@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ServiceTokenMetadata ключ метаданных с общим секретом внутренних вызовов сервисов платформы
+const ServiceTokenMetadata = "x-service-token"
+
+// serviceTokenCredentials передает общий секрет в метаданных каждого вызова
+type serviceTokenCredentials struct {
+	token string
+}
+
+// GetRequestMetadata добавляет секрет в метаданные вызова
+func (c serviceTokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{ServiceTokenMetadata: c.token}, nil
+}
+
+// RequireTransportSecurity разрешает передачу по соединениям без TLS внутри кластера
+func (c serviceTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// WithServiceToken опция клиента, подписывающая вызовы как внутренние вызовы сервиса платформы.
+// Пустой token ничего не добавляет
+func WithServiceToken(token string) grpc.DialOption {
+	if token == "" {
+		return grpc.EmptyDialOption{}
+	}
+	return grpc.WithPerRPCCredentials(serviceTokenCredentials{token: token})
+}
+
+// IsInternalService проверяет, что вызов подписан общим секретом token. С пустым token
+// внутренние вызовы не принимаются
+func IsInternalService(ctx context.Context, token string) bool {
+	if token == "" {
+		return false
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(ServiceTokenMetadata) {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
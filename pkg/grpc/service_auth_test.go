@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIsInternalService(t *testing.T) {
+	creds := serviceTokenCredentials{token: "secret"}
+	md, err := creds.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	forged := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ServiceTokenMetadata, "guess"))
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		token string
+		want  bool
+	}{
+		{"signed call", signed, "secret", true},
+		{"wrong token", forged, "secret", false},
+		{"no metadata", context.Background(), "secret", false},
+		{"token not configured", signed, "", false},
+	}
+	for _, tt := range tests {
+		if got := IsInternalService(tt.ctx, tt.token); got != tt.want {
+			t.Errorf("%s: IsInternalService() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
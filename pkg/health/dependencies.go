@@ -0,0 +1,161 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/connection"
+	"UptimePingPlatform/pkg/logger"
+)
+
+// Общие статусы сервиса в HealthStatus
+const (
+	StatusHealthy   = "healthy"
+	StatusDegraded  = "degraded"
+	StatusUnhealthy = "unhealthy"
+)
+
+// Статусы отдельной зависимости в HealthStatus.Services
+const (
+	DependencyUp   = "up"
+	DependencyDown = "down"
+)
+
+// dependencyPingTimeout ограничивает проверку зависимости при запросе /ready
+const dependencyPingTimeout = 2 * time.Second
+
+// Dependency внешняя зависимость сервиса. Connect выполняется при запуске с повторами
+// и обычно сохраняет созданный клиент; Ping, если задан, проверяет подключенную
+// зависимость при каждом запросе /ready
+type Dependency struct {
+	Name     string
+	Required bool
+	Connect  func(ctx context.Context) error
+	Ping     func(ctx context.Context) error
+}
+
+// dependencyState состояние зависимости после запуска
+type dependencyState struct {
+	Dependency
+	connected bool
+	err       error
+}
+
+// Dependencies подключает зависимости сервиса при запуске по единой политике и отражает
+// их состояние в /ready. Недоступная после всех попыток обязательная зависимость
+// останавливает запуск, необязательная переводит сервис в деградированный режим:
+// сервис работает без нее и остается готовым, а /ready возвращает статус degraded.
+// Необязательная зависимость, не подключившаяся при запуске, не переподключается до перезапуска
+type Dependencies struct {
+	version  string
+	retry    connection.RetryConfig
+	required map[string]bool
+	logger   logger.Logger
+
+	mu   sync.RWMutex
+	deps []*dependencyState
+}
+
+// NewDependencies создает Dependencies с политикой повторов из cfg. Зависимости из
+// cfg.Required становятся обязательными независимо от Dependency.Required
+func NewDependencies(version string, cfg config.StartupConfig, log logger.Logger) *Dependencies {
+	retry := connection.DefaultRetryConfig()
+	if cfg.RetryAttempts > 0 {
+		retry.MaxAttempts = cfg.RetryAttempts
+	}
+	if cfg.RetryInitialDelay > 0 {
+		retry.InitialDelay = cfg.RetryInitialDelay
+	}
+	if cfg.RetryMaxDelay > 0 {
+		retry.MaxDelay = cfg.RetryMaxDelay
+	}
+
+	required := make(map[string]bool, len(cfg.Required))
+	for _, name := range cfg.Required {
+		required[name] = true
+	}
+
+	return &Dependencies{
+		version:  version,
+		retry:    retry,
+		required: required,
+		logger:   log,
+	}
+}
+
+// Start подключает зависимость с повторами. Возвращает ошибку, только если обязательная
+// зависимость недоступна после всех попыток; ошибка необязательной зависимости
+// запоминается и отражается в Check
+func (d *Dependencies) Start(ctx context.Context, dep Dependency) error {
+	dep.Required = dep.Required || d.required[dep.Name]
+
+	attempt := 0
+	err := connection.WithRetry(ctx, d.retry, func(ctx context.Context) error {
+		attempt++
+		err := dep.Connect(ctx)
+		if err != nil && attempt < d.retry.MaxAttempts {
+			d.logger.Warn("Dependency is not available, retrying",
+				logger.String("dependency", dep.Name),
+				logger.Int("attempt", attempt),
+				logger.Error(err),
+			)
+		}
+		return err
+	})
+
+	d.mu.Lock()
+	d.deps = append(d.deps, &dependencyState{Dependency: dep, connected: err == nil, err: err})
+	d.mu.Unlock()
+
+	switch {
+	case err == nil:
+		d.logger.Info("Dependency connected", logger.String("dependency", dep.Name))
+		return nil
+	case dep.Required:
+		return fmt.Errorf("required dependency %s is not available: %w", dep.Name, err)
+	default:
+		d.logger.Warn("Optional dependency is not available, running in degraded mode",
+			logger.String("dependency", dep.Name),
+			logger.Error(err),
+		)
+		return nil
+	}
+}
+
+// Check реализует HealthChecker: unhealthy, если недоступна обязательная зависимость,
+// degraded, если недоступна необязательная, иначе healthy
+func (d *Dependencies) Check() *HealthStatus {
+	d.mu.RLock()
+	deps := append([]*dependencyState(nil), d.deps...)
+	d.mu.RUnlock()
+
+	status := &HealthStatus{
+		Status:    StatusHealthy,
+		Timestamp: time.Now(),
+		Services:  make(map[string]Status, len(deps)),
+		Version:   d.version,
+	}
+	for _, dep := range deps {
+		err := dep.err
+		if dep.connected && dep.Ping != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), dependencyPingTimeout)
+			err = dep.Ping(ctx)
+			cancel()
+		}
+
+		if err == nil {
+			status.Services[dep.Name] = Status{Status: DependencyUp}
+			continue
+		}
+		status.Services[dep.Name] = Status{Status: DependencyDown, Details: err.Error()}
+		if dep.Required {
+			status.Status = StatusUnhealthy
+		} else if status.Status == StatusHealthy {
+			status.Status = StatusDegraded
+		}
+	}
+	return status
+}
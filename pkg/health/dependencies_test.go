@@ -0,0 +1,92 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/logger"
+)
+
+func newTestDependencies(t *testing.T, required ...string) *Dependencies {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "health-test", false)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	return NewDependencies("v1.0.0", config.StartupConfig{
+		RetryAttempts:     3,
+		RetryInitialDelay: time.Millisecond,
+		RetryMaxDelay:     time.Millisecond,
+		Required:          required,
+	}, log)
+}
+
+// TestDependencies_Start проверяет повторы и политику обязательных зависимостей
+func TestDependencies_Start(t *testing.T) {
+	deps := newTestDependencies(t, "redis")
+	unavailable := errors.New("connection refused")
+
+	attempts := 0
+	err := deps.Start(context.Background(), Dependency{Name: "database", Required: true, Connect: func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return unavailable
+		}
+		return nil
+	}})
+	if err != nil || attempts != 3 {
+		t.Fatalf("Expected database to connect on third attempt, got %v after %d attempts", err, attempts)
+	}
+
+	fail := func(ctx context.Context) error { return unavailable }
+	if err := deps.Start(context.Background(), Dependency{Name: "rabbitmq", Connect: fail}); err != nil {
+		t.Errorf("Expected optional dependency failure to be tolerated, got %v", err)
+	}
+	if err := deps.Start(context.Background(), Dependency{Name: "redis", Connect: fail}); !errors.Is(err, unavailable) {
+		t.Errorf("Expected dependency required by config to fail startup, got %v", err)
+	}
+}
+
+// TestDependencies_Check проверяет статус сервиса по состоянию зависимостей
+func TestDependencies_Check(t *testing.T) {
+	deps := newTestDependencies(t)
+	ok := func(ctx context.Context) error { return nil }
+	var pingErr error
+
+	deps.Start(context.Background(), Dependency{Name: "database", Required: true, Connect: ok, Ping: func(ctx context.Context) error { return pingErr }})
+	if status := deps.Check(); status.Status != StatusHealthy || status.Services["database"].Status != DependencyUp {
+		t.Errorf("Expected healthy status, got %+v", status)
+	}
+
+	deps.Start(context.Background(), Dependency{Name: "redis", Connect: func(ctx context.Context) error { return errors.New("timeout") }})
+	status := deps.Check()
+	if status.Status != StatusDegraded || status.Services["redis"].Status != DependencyDown || status.Services["redis"].Details == "" {
+		t.Errorf("Expected degraded status, got %+v", status)
+	}
+
+	w := httptest.NewRecorder()
+	ReadyHandler(deps)(w, httptest.NewRequest("GET", "/ready", nil))
+	var response map[string]interface{}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if w.Code != http.StatusOK || response["status"] != StatusDegraded {
+		t.Errorf("Expected degraded service to stay ready, got %d %v", w.Code, response["status"])
+	}
+
+	pingErr = errors.New("connection reset")
+	if status := deps.Check(); status.Status != StatusUnhealthy {
+		t.Errorf("Expected unhealthy status when required dependency fails ping, got %s", status.Status)
+	}
+	w = httptest.NewRecorder()
+	ReadyHandler(deps)(w, httptest.NewRequest("GET", "/ready", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+}
@@ -59,16 +59,22 @@ func Handler(checker HealthChecker) http.HandlerFunc {
 
 // ReadyHandler создает HTTP обработчик для ready check эндпоинта
 // Возвращает 200 если сервис готов принимать трафик
-// Использует HealthChecker для проверки готовности зависимостей
+// Использует HealthChecker для проверки готовности зависимостей; в деградированном
+// режиме сервис остается готовым и возвращает статус degraded
 func ReadyHandler(checker HealthChecker) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		status := "ready"
 		statusCode := http.StatusOK
 
 		// Проверяем готовность через HealthChecker
+		var healthStatus *HealthStatus
 		if checker != nil {
-			healthStatus := checker.Check()
-			if healthStatus.Status != "healthy" {
+			healthStatus = checker.Check()
+			switch healthStatus.Status {
+			case StatusHealthy:
+			case StatusDegraded:
+				status = StatusDegraded
+			default:
 				status = "not ready"
 				statusCode = http.StatusServiceUnavailable
 			}
@@ -83,8 +89,8 @@ func ReadyHandler(checker HealthChecker) http.HandlerFunc {
 			"timestamp": time.Now().UTC().Format(time.RFC3339),
 		}
 
-		if checker != nil {
-			response["details"] = checker.Check().Services
+		if healthStatus != nil {
+			response["details"] = healthStatus.Services
 		}
 
 		json.NewEncoder(w).Encode(response)
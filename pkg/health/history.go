@@ -0,0 +1,218 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// DefaultHistorySize число последних переходов, которые History хранит в памяти
+const DefaultHistorySize = 256
+
+// maxHistoryLimit наибольшее число переходов в ответе /health/history
+const maxHistoryLimit = 1000
+
+// ServiceComponent компонент истории, соответствующий общему статусу сервиса
+const ServiceComponent = "service"
+
+// Transition смена статуса компонента. From пуст для первого наблюдения после запуска
+type Transition struct {
+	Component string    `json:"component"`
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to"`
+	Details   string    `json:"details,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// HistoryStore внешнее хранилище переходов: история переживает перезапуск сервиса
+type HistoryStore interface {
+	// Append сохраняет переход
+	Append(ctx context.Context, transition Transition) error
+	// List возвращает до limit последних переходов, новые первыми
+	List(ctx context.Context, limit int) ([]Transition, error)
+}
+
+// History записывает смены статусов сервиса и его зависимостей, чтобы момент сбоя
+// зависимости был виден без поиска по логам. Реализует HealthChecker: статус проверяется
+// при каждом запросе /ready и периодически в Run
+type History struct {
+	checker HealthChecker
+	store   HistoryStore
+	logger  logger.Logger
+
+	mu       sync.Mutex
+	statuses map[string]string
+	ring     []Transition
+	next     int
+	full     bool
+}
+
+// NewHistory создает историю статусов checker на size последних переходов
+func NewHistory(checker HealthChecker, size int) *History {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+	return &History{
+		checker:  checker,
+		statuses: make(map[string]string),
+		ring:     make([]Transition, size),
+	}
+}
+
+// WithStore дополнительно сохраняет переходы в store. Ошибки хранилища логируются,
+// а история читается из памяти
+func (h *History) WithStore(store HistoryStore, log logger.Logger) *History {
+	h.store = store
+	h.logger = log
+	return h
+}
+
+// Check реализует HealthChecker и записывает смены статусов
+func (h *History) Check() *HealthStatus {
+	status := h.checker.Check()
+	h.Observe(status)
+	return status
+}
+
+// Run проверяет статус каждые interval до отмены ctx
+func (h *History) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		h.Check()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Observe записывает переходы компонентов status относительно предыдущего наблюдения
+func (h *History) Observe(status *HealthStatus) {
+	at := status.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	observed := []Transition{{Component: ServiceComponent, To: status.Status, At: at}}
+	names := make([]string, 0, len(status.Services))
+	for name := range status.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		service := status.Services[name]
+		observed = append(observed, Transition{Component: name, To: service.Status, Details: service.Details, At: at})
+	}
+
+	var changed []Transition
+	h.mu.Lock()
+	for _, transition := range observed {
+		previous, seen := h.statuses[transition.Component]
+		if seen && previous == transition.To {
+			continue
+		}
+		transition.From = previous
+		h.statuses[transition.Component] = transition.To
+		h.ring[h.next] = transition
+		h.next = (h.next + 1) % len(h.ring)
+		h.full = h.full || h.next == 0
+		changed = append(changed, transition)
+	}
+	h.mu.Unlock()
+
+	if h.store == nil {
+		return
+	}
+	for _, transition := range changed {
+		if err := h.store.Append(context.Background(), transition); err != nil {
+			h.logger.Warn("Failed to store health transition",
+				logger.String("component", transition.Component),
+				logger.Error(err),
+			)
+		}
+	}
+}
+
+// Transitions возвращает до limit последних переходов компонента (все компоненты при
+// пустом component), новые первыми
+func (h *History) Transitions(ctx context.Context, component string, limit int) []Transition {
+	var transitions []Transition
+	if h.store != nil {
+		// Хранилище читается с запасом: переходы других компонентов отфильтровываются
+		stored, err := h.store.List(ctx, maxHistoryLimit)
+		if err == nil {
+			transitions = stored
+		} else {
+			h.logger.Warn("Failed to read health history from store, using in-memory history", logger.Error(err))
+		}
+	}
+	if transitions == nil {
+		transitions = h.recent()
+	}
+
+	filtered := make([]Transition, 0, limit)
+	for _, transition := range transitions {
+		if component != "" && transition.Component != component {
+			continue
+		}
+		filtered = append(filtered, transition)
+		if len(filtered) == limit {
+			break
+		}
+	}
+	return filtered
+}
+
+// recent возвращает переходы из памяти, новые первыми
+func (h *History) recent() []Transition {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	count := h.next
+	if h.full {
+		count = len(h.ring)
+	}
+	transitions := make([]Transition, 0, count)
+	for i := 1; i <= count; i++ {
+		transitions = append(transitions, h.ring[(h.next-i+len(h.ring))%len(h.ring)])
+	}
+	return transitions
+}
+
+// HistoryHandler создает HTTP обработчик /health/history: переходы статусов с фильтром
+// component и ограничением limit (по умолчанию 100)
+func HistoryHandler(history *History) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := 100
+		if value := r.URL.Query().Get("limit"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed <= 0 {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"error": "limit must be a positive integer"})
+				return
+			}
+			limit = parsed
+		}
+		if limit > maxHistoryLimit {
+			limit = maxHistoryLimit
+		}
+
+		transitions := history.Transitions(r.Context(), r.URL.Query().Get("component"), limit)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"transitions": transitions,
+		})
+	}
+}
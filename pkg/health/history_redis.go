@@ -0,0 +1,58 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pkg_redis "UptimePingPlatform/pkg/redis"
+)
+
+// RedisHistoryStore хранит последние переходы сервиса в списке Redis
+type RedisHistoryStore struct {
+	client *pkg_redis.Client
+	key    string
+	size   int
+}
+
+// NewRedisHistoryStore создает хранилище истории сервиса service на size последних переходов
+func NewRedisHistoryStore(client *pkg_redis.Client, service string, size int) *RedisHistoryStore {
+	if size <= 0 {
+		size = DefaultHistorySize
+	}
+	return &RedisHistoryStore{
+		client: client,
+		key:    fmt.Sprintf("health:history:%s", service),
+		size:   size,
+	}
+}
+
+// Append реализует HistoryStore
+func (s *RedisHistoryStore) Append(ctx context.Context, transition Transition) error {
+	value, err := json.Marshal(transition)
+	if err != nil {
+		return err
+	}
+	pipe := s.client.Client.TxPipeline()
+	pipe.LPush(ctx, s.key, value)
+	pipe.LTrim(ctx, s.key, 0, int64(s.size-1))
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// List реализует HistoryStore
+func (s *RedisHistoryStore) List(ctx context.Context, limit int) ([]Transition, error) {
+	values, err := s.client.Client.LRange(ctx, s.key, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+	transitions := make([]Transition, 0, len(values))
+	for _, value := range values {
+		var transition Transition
+		if err := json.Unmarshal([]byte(value), &transition); err != nil {
+			return nil, fmt.Errorf("invalid health transition: %w", err)
+		}
+		transitions = append(transitions, transition)
+	}
+	return transitions, nil
+}
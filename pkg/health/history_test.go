@@ -0,0 +1,170 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// staticChecker возвращает заданный статус
+type staticChecker struct {
+	status *HealthStatus
+}
+
+func (c *staticChecker) Check() *HealthStatus {
+	return c.status
+}
+
+// memoryHistoryStore хранилище истории в памяти; err имитирует недоступность
+type memoryHistoryStore struct {
+	transitions []Transition
+	err         error
+}
+
+func (s *memoryHistoryStore) Append(ctx context.Context, transition Transition) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.transitions = append([]Transition{transition}, s.transitions...)
+	return nil
+}
+
+func (s *memoryHistoryStore) List(ctx context.Context, limit int) ([]Transition, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if len(s.transitions) > limit {
+		return s.transitions[:limit], nil
+	}
+	return s.transitions, nil
+}
+
+func healthStatus(at time.Time, status, redis string) *HealthStatus {
+	return &HealthStatus{
+		Status:    status,
+		Timestamp: at,
+		Services: map[string]Status{
+			"database": {Status: DependencyUp},
+			"redis":    {Status: redis},
+		},
+	}
+}
+
+// TestHistory_Observe проверяет запись только смен статусов
+func TestHistory_Observe(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	checker := &staticChecker{status: healthStatus(start, StatusHealthy, DependencyUp)}
+	history := NewHistory(checker, 10)
+
+	history.Check()
+	history.Check()
+	checker.status = healthStatus(start.Add(time.Minute), StatusDegraded, DependencyDown)
+	checker.status.Services["redis"] = Status{Status: DependencyDown, Details: "connection refused"}
+	history.Check()
+	checker.status = healthStatus(start.Add(2*time.Minute), StatusHealthy, DependencyUp)
+	history.Check()
+
+	// Три первых наблюдения, затем сбой и восстановление redis и сервиса
+	all := history.Transitions(context.Background(), "", 100)
+	if len(all) != 7 {
+		t.Fatalf("Expected 7 transitions, got %d: %+v", len(all), all)
+	}
+
+	redis := history.Transitions(context.Background(), "redis", 100)
+	if len(redis) != 3 {
+		t.Fatalf("Expected 3 redis transitions, got %+v", redis)
+	}
+	down := redis[1]
+	if down.From != DependencyUp || down.To != DependencyDown || down.Details != "connection refused" || !down.At.Equal(start.Add(time.Minute)) {
+		t.Errorf("Unexpected redis failure transition: %+v", down)
+	}
+	if redis[0].To != DependencyUp || redis[2].From != "" {
+		t.Errorf("Expected newest transitions first, got %+v", redis)
+	}
+
+	if limited := history.Transitions(context.Background(), "", 2); len(limited) != 2 || !limited[1].At.Equal(start.Add(2*time.Minute)) {
+		t.Errorf("Expected 2 newest transitions, got %+v", limited)
+	}
+}
+
+// TestHistory_RingBuffer проверяет вытеснение старых переходов
+func TestHistory_RingBuffer(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	checker := &staticChecker{}
+	history := NewHistory(checker, 4)
+
+	for i := 0; i < 5; i++ {
+		status := StatusHealthy
+		if i%2 == 1 {
+			status = StatusUnhealthy
+		}
+		checker.status = &HealthStatus{Status: status, Timestamp: start.Add(time.Duration(i) * time.Minute)}
+		history.Check()
+	}
+
+	transitions := history.Transitions(context.Background(), ServiceComponent, 100)
+	if len(transitions) != 4 {
+		t.Fatalf("Expected 4 transitions, got %d", len(transitions))
+	}
+	if !transitions[0].At.Equal(start.Add(4*time.Minute)) || !transitions[3].At.Equal(start.Add(time.Minute)) {
+		t.Errorf("Expected the oldest transition to be evicted, got %+v", transitions)
+	}
+}
+
+// TestHistory_Store проверяет чтение из хранилища и откат к памяти при его сбое
+func TestHistory_Store(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "health", false)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	store := &memoryHistoryStore{transitions: []Transition{{Component: "redis", To: DependencyDown, At: start.Add(-time.Hour)}}}
+	checker := &staticChecker{status: healthStatus(start, StatusHealthy, DependencyUp)}
+	history := NewHistory(checker, 10).WithStore(store, log)
+
+	history.Check()
+	redis := history.Transitions(context.Background(), "redis", 100)
+	if len(redis) != 2 || !redis[1].At.Equal(start.Add(-time.Hour)) {
+		t.Errorf("Expected transitions before restart from store, got %+v", redis)
+	}
+
+	store.err = errors.New("redis is down")
+	if redis := history.Transitions(context.Background(), "redis", 100); len(redis) != 1 {
+		t.Errorf("Expected in-memory transitions, got %+v", redis)
+	}
+}
+
+// TestHistoryHandler проверяет ответ /health/history
+func TestHistoryHandler(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	history := NewHistory(&staticChecker{status: healthStatus(start, StatusHealthy, DependencyUp)}, 10)
+	history.Check()
+	handler := HistoryHandler(history)
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/health/history?component=redis", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	var response struct {
+		Transitions []Transition `json:"transitions"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(response.Transitions) != 1 || response.Transitions[0].Component != "redis" {
+		t.Errorf("Unexpected transitions: %+v", response.Transitions)
+	}
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/health/history?limit=0", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for invalid limit, got %d", w.Code)
+	}
+}
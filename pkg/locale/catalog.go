@@ -0,0 +1,55 @@
+package locale
+
+import "sync"
+
+// Catalog каталог переводов: сообщения по ключу для каждого языка. Отсутствующий перевод
+// берется из языка по умолчанию, отсутствующий ключ возвращается как есть, чтобы пропуск
+// в каталоге был виден в тексте, а не ронял отправку
+type Catalog struct {
+	mu       sync.RWMutex
+	messages map[string]map[string]string
+}
+
+// NewCatalog создает пустой каталог
+func NewCatalog() *Catalog {
+	return &Catalog{messages: make(map[string]map[string]string)}
+}
+
+// Add добавляет переводы языка; существующие ключи перезаписываются
+func (c *Catalog) Add(lang string, messages map[string]string) *Catalog {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.messages[lang] == nil {
+		c.messages[lang] = make(map[string]string, len(messages))
+	}
+	for key, message := range messages {
+		c.messages[lang][key] = message
+	}
+	return c
+}
+
+// Translate возвращает перевод ключа на язык lang
+func (c *Catalog) Translate(lang, key string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if message, ok := c.messages[lang][key]; ok {
+		return message
+	}
+	if message, ok := c.messages[DefaultLocale][key]; ok {
+		return message
+	}
+	return key
+}
+
+// Missing возвращает ключи языка по умолчанию, для которых нет перевода на lang
+func (c *Catalog) Missing(lang string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var missing []string
+	for key := range c.messages[DefaultLocale] {
+		if _, ok := c.messages[lang][key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,30 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog_Translate(t *testing.T) {
+	catalog := NewCatalog().
+		Add("en", map[string]string{"severity": "Severity", "time": "Time"}).
+		Add("ru", map[string]string{"severity": "Критичность"})
+
+	assert.Equal(t, "Критичность", catalog.Translate("ru", "severity"))
+	assert.Equal(t, "Severity", catalog.Translate("en", "severity"))
+
+	// Нет перевода - язык по умолчанию, нет ключа - сам ключ
+	assert.Equal(t, "Time", catalog.Translate("ru", "time"))
+	assert.Equal(t, "Time", catalog.Translate("de", "time"))
+	assert.Equal(t, "tenant", catalog.Translate("ru", "tenant"))
+
+	assert.Equal(t, []string{"time"}, catalog.Missing("ru"))
+	assert.Empty(t, catalog.Missing("en"))
+}
+
+func TestIsSupported(t *testing.T) {
+	assert.True(t, IsSupported("ru"))
+	assert.False(t, IsSupported(""))
+	assert.False(t, IsSupported("de"))
+}
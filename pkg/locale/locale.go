@@ -0,0 +1,111 @@
+package locale
+
+import (
+	"fmt"
+	"time"
+
+	// База часовых поясов встраивается в бинарь: в образах без tzdata пояса тенантов иначе не загрузятся
+	_ "time/tzdata"
+)
+
+// Значения по умолчанию для тенанта, который не задал часовой пояс и язык
+const (
+	DefaultTimezone = "UTC"
+	DefaultLocale   = "en"
+)
+
+// SupportedLocales языки, в которых форматируются даты уведомлений и отчетов
+var SupportedLocales = []string{"en", "ru"}
+
+// localFormats форматы времени без смещения, которые интерпретируются в часовом поясе тенанта;
+// дата без времени означает начало суток
+var localFormats = []string{"2006-01-02T15:04:05", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// Settings часовой пояс (имя IANA) и язык тенанта. Пустые поля означают значения по умолчанию
+type Settings struct {
+	Timezone string `json:"timezone"`
+	Locale   string `json:"locale"`
+}
+
+// Default возвращает настройки по умолчанию: UTC и английский
+func Default() Settings {
+	return Settings{Timezone: DefaultTimezone, Locale: DefaultLocale}
+}
+
+// Normalize заполняет пустые поля значениями по умолчанию
+func (s Settings) Normalize() Settings {
+	if s.Timezone == "" {
+		s.Timezone = DefaultTimezone
+	}
+	if s.Locale == "" {
+		s.Locale = DefaultLocale
+	}
+	return s
+}
+
+// Validate проверяет, что часовой пояс известен и язык поддерживается
+func (s Settings) Validate() error {
+	s = s.Normalize()
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("unknown timezone: %s", s.Timezone)
+	}
+	if !IsSupported(s.Locale) {
+		return fmt.Errorf("locale must be one of %v", SupportedLocales)
+	}
+	return nil
+}
+
+// IsSupported проверяет, поддерживается ли язык
+func IsSupported(lang string) bool {
+	for _, supported := range SupportedLocales {
+		if lang == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// Location возвращает часовой пояс тенанта; неизвестный пояс заменяется UTC
+func (s Settings) Location() *time.Location {
+	if s.Timezone == "" {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// FormatTime форматирует момент времени в часовом поясе и по правилам языка тенанта
+func (s Settings) FormatTime(t time.Time) string {
+	t = t.In(s.Location())
+	switch s.Locale {
+	case "ru":
+		return t.Format("02.01.2006 15:04:05 MST")
+	default:
+		return t.Format("2006-01-02 15:04:05 MST")
+	}
+}
+
+// ParseTime разбирает время из запроса тенанта: RFC3339 со смещением принимается как есть,
+// время без смещения и дата (начало суток) интерпретируются в часовом поясе тенанта
+func (s Settings) ParseTime(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	location := s.Location()
+	for _, format := range localFormats {
+		if parsed, err := time.ParseInLocation(format, value, location); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected RFC3339, local date-time or date", value)
+}
+
+// StartOfDay возвращает начало суток момента t в часовом поясе тенанта
+func (s Settings) StartOfDay(t time.Time) time.Time {
+	year, month, day := t.In(s.Location()).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, s.Location())
+}
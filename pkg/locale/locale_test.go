@@ -0,0 +1,74 @@
+package locale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettings_Validate(t *testing.T) {
+	assert.NoError(t, Settings{}.Validate())
+	assert.NoError(t, Settings{Timezone: "Europe/Moscow", Locale: "ru"}.Validate())
+	assert.Error(t, Settings{Timezone: "Mars/Olympus"}.Validate())
+	assert.Error(t, Settings{Locale: "xx"}.Validate())
+}
+
+func TestSettings_FormatTime(t *testing.T) {
+	at := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "2026-10-16 09:30:00 UTC", Settings{}.FormatTime(at))
+	assert.Equal(t, "16.10.2026 12:30:00 MSK", Settings{Timezone: "Europe/Moscow", Locale: "ru"}.FormatTime(at))
+	assert.Equal(t, "2026-10-16 09:30:00 UTC", Settings{Timezone: "Mars/Olympus"}.FormatTime(at))
+}
+
+func TestSettings_ParseTime(t *testing.T) {
+	moscow := Settings{Timezone: "Europe/Moscow"}
+
+	parsed, err := moscow.ParseTime("2026-10-16T10:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC), parsed.UTC())
+
+	parsed, err = moscow.ParseTime("2026-10-16T10:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 16, 7, 0, 0, 0, time.UTC), parsed.UTC())
+
+	parsed, err = moscow.ParseTime("2026-10-16")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 15, 21, 0, 0, 0, time.UTC), parsed.UTC())
+
+	_, err = moscow.ParseTime("16/10/2026")
+	assert.Error(t, err)
+}
+
+func TestSettings_StartOfDay(t *testing.T) {
+	start := Settings{Timezone: "America/New_York"}.StartOfDay(time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC))
+	assert.Equal(t, time.Date(2026, 10, 15, 4, 0, 0, 0, time.UTC), start.UTC())
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	calls := 0
+	var lookupErr error
+	resolver := NewResolver(func(ctx context.Context, tenantID string) (Settings, error) {
+		calls++
+		return Settings{Timezone: "Europe/Berlin"}, lookupErr
+	}, time.Minute)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+
+	assert.Equal(t, Settings{Timezone: "Europe/Berlin", Locale: DefaultLocale}, resolver.Resolve(context.Background(), "tenant-1"))
+	resolver.Resolve(context.Background(), "tenant-1")
+	assert.Equal(t, 1, calls)
+
+	// После истечения ttl ошибка загрузки не сбрасывает известные настройки
+	now = now.Add(2 * time.Minute)
+	lookupErr = errors.New("unavailable")
+	assert.Equal(t, "Europe/Berlin", resolver.Resolve(context.Background(), "tenant-1").Timezone)
+	assert.Equal(t, Default(), resolver.Resolve(context.Background(), "tenant-2"))
+
+	var nilResolver *Resolver
+	assert.Equal(t, Default(), nilResolver.Resolve(context.Background(), "tenant-1"))
+}
@@ -0,0 +1,70 @@
+package locale
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// lookupTimeout ограничивает загрузку настроек тенанта
+const lookupTimeout = 2 * time.Second
+
+// Lookup загружает часовой пояс и язык тенанта
+type Lookup func(ctx context.Context, tenantID string) (Settings, error)
+
+// cachedSettings настройки тенанта и время их загрузки
+type cachedSettings struct {
+	settings Settings
+	loadedAt time.Time
+}
+
+// Resolver возвращает настройки тенантов, кэшируя их на ttl. Ошибка загрузки не мешает
+// отправке уведомлений: используются последние загруженные настройки или значения по умолчанию
+type Resolver struct {
+	lookup Lookup
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedSettings
+}
+
+// NewResolver создает Resolver поверх lookup
+func NewResolver(lookup Lookup, ttl time.Duration) *Resolver {
+	return &Resolver{
+		lookup:  lookup,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cachedSettings),
+	}
+}
+
+// Resolve возвращает настройки тенанта; nil Resolver возвращает значения по умолчанию
+func (r *Resolver) Resolve(ctx context.Context, tenantID string) Settings {
+	if r == nil || tenantID == "" {
+		return Default()
+	}
+
+	r.mu.Lock()
+	cached, ok := r.entries[tenantID]
+	r.mu.Unlock()
+	if ok && r.now().Sub(cached.loadedAt) < r.ttl {
+		return cached.settings
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+	settings, err := r.lookup(ctx, tenantID)
+	if err != nil {
+		if ok {
+			return cached.settings
+		}
+		return Default()
+	}
+
+	settings = settings.Normalize()
+	r.mu.Lock()
+	r.entries[tenantID] = cachedSettings{settings: settings, loadedAt: r.now()}
+	r.mu.Unlock()
+	return settings
+}
@@ -0,0 +1,226 @@
+package plugin
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// Значения ClientConfig по умолчанию
+const (
+	DefaultStartTimeout = 10 * time.Second
+	// stopTimeout время на корректное завершение плагина перед принудительной остановкой
+	stopTimeout = 2 * time.Second
+)
+
+// ClientConfig параметры запуска плагина
+type ClientConfig struct {
+	// Path путь к исполняемому файлу плагина
+	Path string
+	Args []string
+	// Env дополнительные переменные окружения плагина
+	Env []string
+	// ProtocolVersion версия протокола сервиса плагина, которую ожидает хост
+	ProtocolVersion int
+	// StartTimeout ограничивает ожидание рукопожатия; по умолчанию DefaultStartTimeout
+	StartTimeout time.Duration
+	// Limits лимиты ресурсов процесса плагина
+	Limits Limits
+	Logger logger.Logger
+}
+
+// Limits лимиты ресурсов процесса плагина; нулевое значение снимает ограничение.
+// Лимиты применяются сразу после запуска процесса и поддерживаются только на Linux
+type Limits struct {
+	// MemoryBytes лимит сегмента данных процесса (RLIMIT_DATA)
+	MemoryBytes uint64
+	// OpenFiles лимит открытых файлов и сокетов (RLIMIT_NOFILE)
+	OpenFiles uint64
+}
+
+// inheritedEnv переменные окружения хоста, которые получает плагин
+var inheritedEnv = []string{"PATH"}
+
+// pluginEnv окружение процесса плагина. Плагин не наследует окружение хоста (секреты, адреса
+// сервисов): он получает только рукопожатие, PATH и переменные из конфигурации
+func pluginEnv(cfg ClientConfig) []string {
+	env := []string{
+		MagicCookieKey + "=" + MagicCookieValue,
+		ProtocolVersionKey + "=" + strconv.Itoa(cfg.ProtocolVersion),
+	}
+	for _, name := range inheritedEnv {
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return append(env, cfg.Env...)
+}
+
+// Client запущенный процесс плагина и gRPC подключение к нему
+type Client struct {
+	name   string
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+	logger logger.Logger
+
+	done     chan struct{}
+	exitErr  error
+	stopOnce sync.Once
+}
+
+// Start запускает плагин, дожидается рукопожатия и подключается к нему. Плагин, не
+// приславший рукопожатие за StartTimeout, останавливается
+func Start(cfg ClientConfig) (*Client, error) {
+	if cfg.StartTimeout <= 0 {
+		cfg.StartTimeout = DefaultStartTimeout
+	}
+	name := filepath.Base(cfg.Path)
+
+	handshakes := make(chan string, 1)
+	stdout := &lineWriter{}
+	stdout.line = func(line string) {
+		select {
+		case handshakes <- line:
+			// Первая строка stdout - рукопожатие, остальной вывод идет в журнал
+			stdout.line = func(line string) {
+				cfg.Logger.Info("Plugin output", logger.String("plugin", name), logger.String("output", line))
+			}
+		default:
+		}
+	}
+
+	cmd := exec.Command(cfg.Path, cfg.Args...)
+	cmd.Env = pluginEnv(cfg)
+	cmd.Stdout = stdout
+	cmd.Stderr = &lineWriter{line: func(line string) {
+		cfg.Logger.Warn("Plugin error output", logger.String("plugin", name), logger.String("output", line))
+	}}
+	// Потомки плагина, удерживающие его вывод, не задерживают остановку
+	cmd.WaitDelay = stopTimeout
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start plugin %s: %w", name, err)
+	}
+	client := &Client{name: name, cmd: cmd, logger: cfg.Logger, done: make(chan struct{})}
+	go func() {
+		client.exitErr = cmd.Wait()
+		close(client.done)
+	}()
+
+	if err := applyLimits(cmd.Process.Pid, cfg.Limits); err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("failed to apply resource limits to plugin %s: %w", name, err)
+	}
+
+	var line string
+	select {
+	case line = <-handshakes:
+	case <-client.done:
+		return nil, fmt.Errorf("plugin %s exited before handshake: %v", name, client.exitErr)
+	case <-time.After(cfg.StartTimeout):
+		client.Kill()
+		return nil, fmt.Errorf("plugin %s did not complete handshake within %s", name, cfg.StartTimeout)
+	}
+
+	h, err := parseHandshake(line, cfg.ProtocolVersion)
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("plugin %s: %w", name, err)
+	}
+
+	target := h.address
+	if h.network == "unix" {
+		target = "unix://" + h.address
+	}
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		client.Kill()
+		return nil, fmt.Errorf("failed to connect to plugin %s: %w", name, err)
+	}
+	client.conn = conn
+
+	cfg.Logger.Info("Plugin started",
+		logger.String("plugin", name),
+		logger.Int("pid", cmd.Process.Pid),
+		logger.String("address", h.address),
+	)
+	return client, nil
+}
+
+// Name возвращает имя исполняемого файла плагина
+func (c *Client) Name() string {
+	return c.name
+}
+
+// Conn возвращает gRPC подключение к плагину
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Exited сообщает, что процесс плагина завершился
+func (c *Client) Exited() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Kill закрывает подключение и останавливает плагин: сначала сигналом завершения,
+// через stopTimeout - принудительно
+func (c *Client) Kill() {
+	c.stopOnce.Do(func() {
+		if c.conn != nil {
+			c.conn.Close()
+		}
+		if c.Exited() {
+			return
+		}
+
+		c.cmd.Process.Signal(os.Interrupt)
+		select {
+		case <-c.done:
+		case <-time.After(stopTimeout):
+			c.logger.Warn("Plugin did not stop in time, killing", logger.String("plugin", c.name))
+			c.cmd.Process.Kill()
+			<-c.done
+		}
+	})
+}
+
+// lineWriter передает вывод процесса построчно
+type lineWriter struct {
+	mu   sync.Mutex
+	buf  []byte
+	line func(line string)
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := string(bytes.TrimRight(w.buf[:i], "\r"))
+		w.buf = w.buf[i+1:]
+		if line != "" {
+			w.line(line)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,20 @@
+package plugin
+
+import "golang.org/x/sys/unix"
+
+// applyLimits устанавливает лимиты ресурсов запущенному процессу плагина
+func applyLimits(pid int, limits Limits) error {
+	if limits.MemoryBytes > 0 {
+		rlimit := &unix.Rlimit{Cur: limits.MemoryBytes, Max: limits.MemoryBytes}
+		if err := unix.Prlimit(pid, unix.RLIMIT_DATA, rlimit, nil); err != nil {
+			return err
+		}
+	}
+	if limits.OpenFiles > 0 {
+		rlimit := &unix.Rlimit{Cur: limits.OpenFiles, Max: limits.OpenFiles}
+		if err := unix.Prlimit(pid, unix.RLIMIT_NOFILE, rlimit, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package plugin
+
+// applyLimits на платформах без prlimit не ограничивает процесс; время вызовов
+// ограничивает хост
+func applyLimits(pid int, limits Limits) error {
+	return nil
+}
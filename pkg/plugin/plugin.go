@@ -0,0 +1,100 @@
+// Package plugin запускает внешние плагины отдельными процессами и связывает их с сервисом
+// по gRPC через локальный сокет, по схеме hashicorp/go-plugin.
+//
+// Хост запускает исполняемый файл плагина с переменными окружения MagicCookieKey и
+// ProtocolVersionKey. Плагин, вызвав Serve, открывает сокет и печатает в stdout строку
+// рукопожатия
+//
+//	CORE-VERSION|PROTOCOL-VERSION|NETWORK|ADDRESS|grpc
+//
+// после чего хост подключается к ADDRESS и вызывает сервис плагина. Вывод плагина в stderr
+// попадает в журнал хоста
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Переменные окружения, которые хост передает плагину
+const (
+	// MagicCookieKey и MagicCookieValue отличают запуск хостом от запуска пользователем
+	MagicCookieKey   = "UPTIMEPING_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "7f1c6b0e9a4d4c2f-uptimeping-plugin"
+	// ProtocolVersionKey версия протокола плагина, которую ожидает хост
+	ProtocolVersionKey = "UPTIMEPING_PLUGIN_PROTOCOL_VERSION"
+)
+
+// CoreProtocolVersion версия рукопожатия и транспорта; меняется при несовместимых изменениях pkg/plugin
+const CoreProtocolVersion = 1
+
+// handshakeProtocol единственный поддерживаемый протокол вызова плагина
+const handshakeProtocol = "grpc"
+
+// handshake строка рукопожатия плагина
+type handshake struct {
+	coreVersion     int
+	protocolVersion int
+	network         string
+	address         string
+}
+
+// String форматирует строку рукопожатия
+func (h handshake) String() string {
+	return fmt.Sprintf("%d|%d|%s|%s|%s", h.coreVersion, h.protocolVersion, h.network, h.address, handshakeProtocol)
+}
+
+// parseHandshake разбирает строку рукопожатия и проверяет версии
+func parseHandshake(line string, protocolVersion int) (handshake, error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 5 {
+		return handshake{}, fmt.Errorf("invalid handshake %q: expected CORE-VERSION|PROTOCOL-VERSION|NETWORK|ADDRESS|grpc", line)
+	}
+
+	var h handshake
+	var err error
+	if h.coreVersion, err = strconv.Atoi(parts[0]); err != nil || h.coreVersion != CoreProtocolVersion {
+		return handshake{}, fmt.Errorf("unsupported core protocol version %q, expected %d", parts[0], CoreProtocolVersion)
+	}
+	if h.protocolVersion, err = strconv.Atoi(parts[1]); err != nil || h.protocolVersion != protocolVersion {
+		return handshake{}, fmt.Errorf("unsupported plugin protocol version %q, expected %d", parts[1], protocolVersion)
+	}
+	if parts[2] != "unix" && parts[2] != "tcp" {
+		return handshake{}, fmt.Errorf("unsupported network %q", parts[2])
+	}
+	if parts[4] != handshakeProtocol {
+		return handshake{}, fmt.Errorf("unsupported protocol %q", parts[4])
+	}
+	h.network, h.address = parts[2], parts[3]
+	return h, nil
+}
+
+// Discover возвращает исполняемые файлы каталога dir в порядке имен. Скрытые файлы и
+// подкаталоги пропускаются; отсутствующий каталог означает отсутствие плагинов
+func Discover(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugins directory %s: %w", dir, err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
@@ -0,0 +1,168 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// testPluginEnv запускает тестовый бинарь как плагин с сервисом grpc.health.v1
+const testPluginEnv = "UPTIMEPING_PLUGIN_TEST_SERVE"
+
+// testSecretEnv переменная окружения хоста, которую плагин не должен видеть
+const testSecretEnv = "UPTIMEPING_PLUGIN_TEST_SECRET"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(testPluginEnv) != "" {
+		err := Serve(ServeConfig{
+			ProtocolVersion: 1,
+			Register: func(server *grpc.Server) {
+				// Видимые плагину переменные окружения публикуются как сервисы env/<имя>
+				healthServer := health.NewServer()
+				for _, name := range []string{"PATH", testSecretEnv} {
+					if os.Getenv(name) != "" {
+						healthServer.SetServingStatus("env/"+name, healthpb.HealthCheckResponse_SERVING)
+					}
+				}
+				healthpb.RegisterHealthServer(server, healthServer)
+			},
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func testLogger(t *testing.T) logger.Logger {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "plugin", false)
+	require.NoError(t, err)
+	return log
+}
+
+func TestParseHandshake(t *testing.T) {
+	h, err := parseHandshake("1|2|unix|/tmp/plugin.sock|grpc\n", 2)
+	require.NoError(t, err)
+	assert.Equal(t, handshake{coreVersion: 1, protocolVersion: 2, network: "unix", address: "/tmp/plugin.sock"}, h)
+	assert.Equal(t, "1|2|unix|/tmp/plugin.sock|grpc", h.String())
+
+	for _, line := range []string{
+		"hello",
+		"2|2|unix|/tmp/plugin.sock|grpc",
+		"1|3|unix|/tmp/plugin.sock|grpc",
+		"1|2|udp|127.0.0.1:1|grpc",
+		"1|2|tcp|127.0.0.1:1|netrpc",
+	} {
+		_, err := parseHandshake(line, 2)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pager"), []byte("#!/bin/sh\n"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "alerts"), []byte("#!/bin/sh\n"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("docs"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("#!/bin/sh\n"), 0o755))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "lib"), 0o755))
+
+	paths, err := Discover(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "alerts"), filepath.Join(dir, "pager")}, paths)
+
+	paths, err = Discover(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+}
+
+func TestStart_ServesPluginOverSocket(t *testing.T) {
+	client, err := Start(ClientConfig{
+		Path:            os.Args[0],
+		Env:             []string{testPluginEnv + "=1"},
+		ProtocolVersion: 1,
+		Logger:          testLogger(t),
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := healthpb.NewHealthClient(client.Conn()).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	client.Kill()
+	assert.True(t, client.Exited())
+}
+
+func TestStart_DoesNotLeakHostEnv(t *testing.T) {
+	t.Setenv(testSecretEnv, "database-password")
+	client, err := Start(ClientConfig{
+		Path:            os.Args[0],
+		Env:             []string{testPluginEnv + "=1"},
+		ProtocolVersion: 1,
+		Logger:          testLogger(t),
+	})
+	require.NoError(t, err)
+	defer client.Kill()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	health := healthpb.NewHealthClient(client.Conn())
+	_, err = health.Check(ctx, &healthpb.HealthCheckRequest{Service: "env/" + testSecretEnv})
+	assert.Equal(t, codes.NotFound, status.Code(err), "plugin must not see host environment")
+	_, err = health.Check(ctx, &healthpb.HealthCheckRequest{Service: "env/PATH"})
+	assert.NoError(t, err)
+}
+
+func TestStart_AppliesResourceLimits(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("resource limits are applied only on linux")
+	}
+	client, err := Start(ClientConfig{
+		Path:            os.Args[0],
+		Env:             []string{testPluginEnv + "=1"},
+		ProtocolVersion: 1,
+		Limits:          Limits{MemoryBytes: 1 << 30, OpenFiles: 64},
+		Logger:          testLogger(t),
+	})
+	require.NoError(t, err)
+	defer client.Kill()
+
+	limits, err := os.ReadFile(fmt.Sprintf("/proc/%d/limits", client.cmd.Process.Pid))
+	require.NoError(t, err)
+	assert.Regexp(t, `Max data size\s+1073741824\s+1073741824`, string(limits))
+	assert.Regexp(t, `Max open files\s+64\s+64`, string(limits))
+}
+
+func TestStart_ProtocolVersionMismatch(t *testing.T) {
+	_, err := Start(ClientConfig{
+		Path:            os.Args[0],
+		Env:             []string{testPluginEnv + "=1"},
+		ProtocolVersion: 2,
+		StartTimeout:    5 * time.Second,
+		Logger:          testLogger(t),
+	})
+	assert.Error(t, err)
+}
+
+func TestServe_RequiresHost(t *testing.T) {
+	t.Setenv(MagicCookieKey, "")
+	assert.ErrorIs(t, Serve(ServeConfig{ProtocolVersion: 1}), ErrNotPlugin)
+}
@@ -0,0 +1,82 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"syscall"
+
+	"google.golang.org/grpc"
+)
+
+// ErrNotPlugin исполняемый файл плагина запущен не хостом
+var ErrNotPlugin = errors.New("this binary is an UptimePing plugin and must be started by the service that loads it")
+
+// ServeConfig параметры сервера плагина
+type ServeConfig struct {
+	// ProtocolVersion версия протокола сервиса плагина; должна совпадать с версией хоста
+	ProtocolVersion int
+	// Register регистрирует сервис плагина на gRPC сервере
+	Register      func(server *grpc.Server)
+	ServerOptions []grpc.ServerOption
+}
+
+// Serve запускает сервер плагина на локальном сокете, сообщает хосту адрес строкой
+// рукопожатия и обслуживает вызовы до сигнала завершения от хоста
+func Serve(cfg ServeConfig) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return ErrNotPlugin
+	}
+	if version := os.Getenv(ProtocolVersionKey); version != strconv.Itoa(cfg.ProtocolVersion) {
+		return fmt.Errorf("host expects plugin protocol version %s, plugin implements %d", version, cfg.ProtocolVersion)
+	}
+
+	listener, cleanup, err := listen()
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	defer cleanup()
+
+	server := grpc.NewServer(cfg.ServerOptions...)
+	cfg.Register(server)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		<-signals
+		server.GracefulStop()
+	}()
+
+	fmt.Fprintln(os.Stdout, handshake{
+		coreVersion:     CoreProtocolVersion,
+		protocolVersion: cfg.ProtocolVersion,
+		network:         listener.Addr().Network(),
+		address:         listener.Addr().String(),
+	})
+	return server.Serve(listener)
+}
+
+// listen открывает unix сокет во временном каталоге, а на Windows - TCP порт на loopback
+func listen() (net.Listener, func(), error) {
+	if runtime.GOOS == "windows" {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		return listener, func() {}, err
+	}
+
+	dir, err := os.MkdirTemp("", "uptimeping-plugin")
+	if err != nil {
+		return nil, nil, err
+	}
+	listener, err := net.Listen("unix", filepath.Join(dir, "plugin.sock"))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, err
+	}
+	return listener, func() { os.RemoveAll(dir) }, nil
+}
@@ -0,0 +1,357 @@
+// Package queue встроенная очередь сообщений в памяти процесса. Заменяет RabbitMQ, когда
+// сервисы платформы запускаются в одном процессе: сообщения не переживают перезапуск,
+// зато не нужен брокер. Порядок внутри темы - по приоритету, затем по времени публикации
+package queue
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// ErrClosed возвращается при публикации в закрытую очередь
+var ErrClosed = fmt.Errorf("queue is closed")
+
+// Message сообщение очереди
+type Message struct {
+	// Topic тема, по которой сообщение доставляется подписчику
+	Topic string
+	// Key ключ маршрутизации внутри темы, аналог routing key RabbitMQ
+	Key         string
+	ContentType string
+	Headers     map[string]interface{}
+	Body        []byte
+	// Priority приоритет 0-10, сообщения с большим приоритетом доставляются раньше
+	Priority uint8
+	// Attempt номер попытки доставки, начиная с 1
+	Attempt int
+
+	seq uint64
+}
+
+// Redelivered сообщает, что сообщение доставляется повторно после ошибки обработчика
+func (m *Message) Redelivered() bool {
+	return m.Attempt > 1
+}
+
+// Handler обрабатывает сообщение; ошибка приводит к повторной доставке
+type Handler func(ctx context.Context, msg *Message) error
+
+// Config конфигурация очереди
+type Config struct {
+	// Capacity максимальное число ожидающих сообщений в теме; публикация ждет свободного места
+	Capacity int
+	// MaxAttempts число попыток доставки, после которого сообщение отбрасывается
+	MaxAttempts int
+	// RetryDelay задержка перед повторной доставкой
+	RetryDelay time.Duration
+}
+
+// DefaultConfig возвращает конфигурацию по умолчанию
+func DefaultConfig() Config {
+	return Config{
+		Capacity:    10000,
+		MaxAttempts: 3,
+		RetryDelay:  5 * time.Second,
+	}
+}
+
+// TopicStats счетчики темы
+type TopicStats struct {
+	Pending   int   `json:"pending"`
+	Published int64 `json:"published"`
+	Processed int64 `json:"processed"`
+	Failed    int64 `json:"failed"`
+	Dropped   int64 `json:"dropped"`
+}
+
+// Queue очередь сообщений в памяти
+type Queue struct {
+	config Config
+	logger logger.Logger
+
+	mu     sync.Mutex
+	topics map[string]*topic
+	closed bool
+	seq    uint64
+
+	// inflight сообщения, которые еще не обработаны окончательно, включая ожидающие повтора
+	inflight int
+	// idle закрывается, когда при остановке очереди не осталось необработанных сообщений
+	idle     chan struct{}
+	draining bool
+	closing  bool
+
+	workers sync.WaitGroup
+	stop    chan struct{}
+}
+
+// topic тема с подписчиком
+type topic struct {
+	name    string
+	handler Handler
+
+	mu    sync.Mutex
+	items messageHeap
+	slots chan struct{}
+	ready chan struct{}
+
+	published, processed, failed, dropped atomic.Int64
+}
+
+// New создает очередь
+func New(config Config, log logger.Logger) *Queue {
+	defaults := DefaultConfig()
+	if config.Capacity <= 0 {
+		config.Capacity = defaults.Capacity
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaults.MaxAttempts
+	}
+	if config.RetryDelay < 0 {
+		config.RetryDelay = 0
+	}
+	return &Queue{
+		config: config,
+		logger: log,
+		topics: make(map[string]*topic),
+		idle:   make(chan struct{}),
+		stop:   make(chan struct{}),
+	}
+}
+
+// Subscribe назначает обработчик темы и запускает workers обработчиков. У темы один подписчик
+func (q *Queue) Subscribe(name string, workers int, handler Handler) error {
+	if workers <= 0 {
+		workers = 1
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrClosed
+	}
+	if _, ok := q.topics[name]; ok {
+		return fmt.Errorf("topic %q already has a subscriber", name)
+	}
+
+	t := &topic{
+		name:    name,
+		handler: handler,
+		slots:   make(chan struct{}, q.config.Capacity),
+		ready:   make(chan struct{}, q.config.Capacity),
+	}
+	q.topics[name] = t
+	for i := 0; i < workers; i++ {
+		q.workers.Add(1)
+		go q.work(t)
+	}
+	return nil
+}
+
+// Publish ставит сообщение в очередь темы. Если тема заполнена, ждет свободного места
+// до отмены контекста. Во время остановки публикация разрешена, чтобы обработчики
+// могли передать сообщения дальше по цепочке
+func (q *Queue) Publish(ctx context.Context, msg Message) error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return ErrClosed
+	}
+	t, ok := q.topics[msg.Topic]
+	if !ok {
+		q.mu.Unlock()
+		return fmt.Errorf("topic %q has no subscriber", msg.Topic)
+	}
+	q.inflight++
+	q.mu.Unlock()
+
+	if msg.Attempt <= 0 {
+		msg.Attempt = 1
+	}
+	if err := q.enqueue(ctx, t, &msg); err != nil {
+		q.done()
+		return err
+	}
+	t.published.Add(1)
+	return nil
+}
+
+// enqueue занимает место в теме и кладет сообщение в кучу
+func (q *Queue) enqueue(ctx context.Context, t *topic, msg *Message) error {
+	select {
+	case t.slots <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("topic %q is full: %w", t.name, ctx.Err())
+	case <-q.stop:
+		return ErrClosed
+	}
+
+	msg.seq = atomic.AddUint64(&q.seq, 1)
+	t.mu.Lock()
+	heap.Push(&t.items, msg)
+	t.mu.Unlock()
+	t.ready <- struct{}{}
+	return nil
+}
+
+// work обрабатывает сообщения темы до остановки очереди
+func (q *Queue) work(t *topic) {
+	defer q.workers.Done()
+	for {
+		select {
+		case <-q.stop:
+			return
+		case <-t.ready:
+		}
+
+		t.mu.Lock()
+		msg := heap.Pop(&t.items).(*Message)
+		t.mu.Unlock()
+		<-t.slots
+
+		q.handle(t, msg)
+	}
+}
+
+// handle вызывает обработчик и планирует повтор при ошибке
+func (q *Queue) handle(t *topic, msg *Message) {
+	err := q.call(t, msg)
+	if err == nil {
+		t.processed.Add(1)
+		q.done()
+		return
+	}
+
+	t.failed.Add(1)
+	if msg.Attempt >= q.config.MaxAttempts {
+		t.dropped.Add(1)
+		q.logger.Error("Message dropped after max delivery attempts",
+			logger.String("topic", t.name),
+			logger.String("key", msg.Key),
+			logger.Int("attempts", msg.Attempt),
+			logger.Error(err),
+		)
+		q.done()
+		return
+	}
+
+	q.logger.Warn("Message handler failed, scheduling redelivery",
+		logger.String("topic", t.name),
+		logger.String("key", msg.Key),
+		logger.Int("attempt", msg.Attempt),
+		logger.Duration("retry_delay", q.config.RetryDelay),
+		logger.Error(err),
+	)
+	retry := *msg
+	retry.Attempt++
+	time.AfterFunc(q.config.RetryDelay, func() {
+		if err := q.enqueue(context.Background(), t, &retry); err != nil {
+			t.dropped.Add(1)
+			q.done()
+		}
+	})
+}
+
+// call вызывает обработчик, паника обработчика считается ошибкой
+func (q *Queue) call(t *topic, msg *Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panic: %v", r)
+		}
+	}()
+	return t.handler(context.Background(), msg)
+}
+
+// Stats возвращает счетчики тем
+func (q *Queue) Stats() map[string]TopicStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := make(map[string]TopicStats, len(q.topics))
+	for name, t := range q.topics {
+		t.mu.Lock()
+		pending := t.items.Len()
+		t.mu.Unlock()
+		stats[name] = TopicStats{
+			Pending:   pending,
+			Published: t.published.Load(),
+			Processed: t.processed.Load(),
+			Failed:    t.failed.Load(),
+			Dropped:   t.dropped.Load(),
+		}
+	}
+	return stats
+}
+
+// done отмечает окончательную обработку сообщения
+func (q *Queue) done() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.inflight--
+	if q.draining && q.inflight == 0 {
+		q.draining = false
+		close(q.idle)
+	}
+}
+
+// Close дожидается обработки опубликованных сообщений до отмены контекста, после чего
+// перестает принимать сообщения и останавливает обработчиков. Необработанные сообщения теряются
+func (q *Queue) Close(ctx context.Context) error {
+	q.mu.Lock()
+	if q.closing {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closing = true
+	if q.inflight == 0 {
+		close(q.idle)
+	} else {
+		q.draining = true
+	}
+	q.mu.Unlock()
+
+	var err error
+	select {
+	case <-q.idle:
+	case <-ctx.Done():
+		err = fmt.Errorf("queue closed with unprocessed messages: %w", ctx.Err())
+	}
+
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	close(q.stop)
+	q.workers.Wait()
+	return err
+}
+
+// messageHeap куча сообщений: больший приоритет, затем более раннее сообщение
+type messageHeap []*Message
+
+func (h messageHeap) Len() int { return len(h) }
+
+func (h messageHeap) Less(i, j int) bool {
+	if h[i].Priority != h[j].Priority {
+		return h[i].Priority > h[j].Priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h messageHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *messageHeap) Push(x interface{}) { *h = append(*h, x.(*Message)) }
+
+func (h *messageHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return item
+}
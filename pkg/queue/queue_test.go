@@ -0,0 +1,130 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+func newTestQueue(t *testing.T, config Config) *Queue {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "queue", false)
+	require.NoError(t, err)
+	return New(config, log)
+}
+
+func TestQueue_DeliversByPriority(t *testing.T) {
+	q := newTestQueue(t, Config{})
+
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var keys []string
+	require.NoError(t, q.Subscribe("tasks", 1, func(ctx context.Context, msg *Message) error {
+		if msg.Key == "block" {
+			<-release
+		}
+		mu.Lock()
+		keys = append(keys, msg.Key)
+		mu.Unlock()
+		return nil
+	}))
+
+	ctx := context.Background()
+	require.NoError(t, q.Publish(ctx, Message{Topic: "tasks", Key: "block"}))
+	// Единственный обработчик занят: следующие сообщения ждут в куче
+	require.Eventually(t, func() bool { return q.Stats()["tasks"].Pending == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, q.Publish(ctx, Message{Topic: "tasks", Key: "low-1", Priority: 1}))
+	require.NoError(t, q.Publish(ctx, Message{Topic: "tasks", Key: "critical", Priority: 10}))
+	require.NoError(t, q.Publish(ctx, Message{Topic: "tasks", Key: "low-2", Priority: 1}))
+	close(release)
+
+	require.NoError(t, q.Close(ctx))
+	assert.Equal(t, []string{"block", "critical", "low-1", "low-2"}, keys)
+	assert.Equal(t, TopicStats{Published: 4, Processed: 4}, q.Stats()["tasks"])
+}
+
+func TestQueue_RedeliversFailedMessages(t *testing.T) {
+	q := newTestQueue(t, Config{MaxAttempts: 3, RetryDelay: time.Millisecond})
+
+	var mu sync.Mutex
+	attempts := map[string][]int{}
+	require.NoError(t, q.Subscribe("results", 2, func(ctx context.Context, msg *Message) error {
+		mu.Lock()
+		attempts[msg.Key] = append(attempts[msg.Key], msg.Attempt)
+		mu.Unlock()
+		switch {
+		case msg.Key == "poison":
+			return errors.New("cannot process")
+		case msg.Key == "panic":
+			panic("boom")
+		case !msg.Redelivered():
+			return errors.New("temporary failure")
+		}
+		return nil
+	}))
+
+	ctx := context.Background()
+	for _, key := range []string{"flaky", "poison", "panic"} {
+		require.NoError(t, q.Publish(ctx, Message{Topic: "results", Key: key}))
+	}
+	require.NoError(t, q.Close(ctx))
+
+	assert.Equal(t, []int{1, 2}, attempts["flaky"])
+	assert.Equal(t, []int{1, 2, 3}, attempts["poison"])
+	assert.Equal(t, []int{1, 2, 3}, attempts["panic"])
+	assert.Equal(t, TopicStats{Published: 3, Processed: 1, Failed: 7, Dropped: 2}, q.Stats()["results"])
+}
+
+func TestQueue_CloseDrainsChainedTopics(t *testing.T) {
+	q := newTestQueue(t, Config{})
+
+	var delivered []string
+	require.NoError(t, q.Subscribe("notifications", 1, func(ctx context.Context, msg *Message) error {
+		delivered = append(delivered, string(msg.Body))
+		return nil
+	}))
+	require.NoError(t, q.Subscribe("results", 1, func(ctx context.Context, msg *Message) error {
+		time.Sleep(10 * time.Millisecond)
+		return q.Publish(ctx, Message{Topic: "notifications", Body: msg.Body})
+	}))
+
+	ctx := context.Background()
+	require.NoError(t, q.Publish(ctx, Message{Topic: "results", Body: []byte("check-1 down")}))
+	require.NoError(t, q.Close(ctx))
+
+	assert.Equal(t, []string{"check-1 down"}, delivered)
+	assert.ErrorIs(t, q.Publish(ctx, Message{Topic: "results"}), ErrClosed)
+	assert.NoError(t, q.Close(ctx))
+}
+
+func TestQueue_PublishErrors(t *testing.T) {
+	q := newTestQueue(t, Config{Capacity: 1})
+
+	release := make(chan struct{})
+	require.NoError(t, q.Subscribe("tasks", 1, func(ctx context.Context, msg *Message) error {
+		<-release
+		return nil
+	}))
+	assert.Error(t, q.Subscribe("tasks", 1, func(ctx context.Context, msg *Message) error { return nil }))
+	assert.ErrorContains(t, q.Publish(context.Background(), Message{Topic: "unknown"}), "has no subscriber")
+
+	// Первое сообщение занимает обработчик, второе - единственное место в теме
+	require.NoError(t, q.Publish(context.Background(), Message{Topic: "tasks"}))
+	require.Eventually(t, func() bool { return q.Stats()["tasks"].Pending == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, q.Publish(context.Background(), Message{Topic: "tasks"}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorContains(t, q.Publish(ctx, Message{Topic: "tasks"}), "is full")
+
+	close(release)
+	require.NoError(t, q.Close(context.Background()))
+	assert.Equal(t, int64(2), q.Stats()["tasks"].Processed)
+}
@@ -0,0 +1,81 @@
+package rabbitmq
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ContentEncodingGzip кодировка тела сообщения, сжатого gzip
+const ContentEncodingGzip = "gzip"
+
+// DefaultCompressionThreshold рекомендуемый порог RABBITMQ_COMPRESSION_THRESHOLD в байтах.
+// Мелкие сообщения не сжимаются: заголовок gzip и время сжатия не окупаются. По умолчанию
+// сжатие выключено: consumer прежней версии не читает ContentEncoding и не разберет сжатое
+// тело, поэтому сжатие включают после обновления всех потребителей
+const DefaultCompressionThreshold = 1024
+
+// maxDecompressedSize предел размера распакованного тела: сообщение-бомба не исчерпает память
+const maxDecompressedSize = 16 << 20
+
+// gzipWriters переиспользуемые компрессоры: gzip.Writer выделяет сотни килобайт при создании
+var gzipWriters = sync.Pool{
+	New: func() interface{} {
+		writer, _ := gzip.NewWriterLevel(io.Discard, gzip.BestSpeed)
+		return writer
+	},
+}
+
+// CompressBody сжимает тело не меньше threshold байт и возвращает его вместе с кодировкой для
+// ContentEncoding. Тело меньше порога или несжимаемое возвращается как есть с пустой кодировкой;
+// threshold <= 0 отключает сжатие
+func CompressBody(body []byte, threshold int) ([]byte, string, error) {
+	if threshold <= 0 || len(body) < threshold {
+		return body, "", nil
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(len(body) / 2)
+	writer := gzipWriters.Get().(*gzip.Writer)
+	defer gzipWriters.Put(writer)
+	writer.Reset(&buf)
+	if _, err := writer.Write(body); err != nil {
+		return nil, "", fmt.Errorf("failed to compress message body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to compress message body: %w", err)
+	}
+
+	if buf.Len() >= len(body) {
+		return body, "", nil
+	}
+	return buf.Bytes(), ContentEncodingGzip, nil
+}
+
+// DecompressBody возвращает исходное тело сообщения по его ContentEncoding
+func DecompressBody(body []byte, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return body, nil
+	case ContentEncodingGzip:
+	default:
+		return nil, fmt.Errorf("unsupported content encoding: %s", encoding)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message body: %w", err)
+	}
+	defer reader.Close()
+
+	decoded, err := io.ReadAll(io.LimitReader(reader, maxDecompressedSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message body: %w", err)
+	}
+	if len(decoded) > maxDecompressedSize {
+		return nil, fmt.Errorf("decompressed message body exceeds %d bytes", maxDecompressedSize)
+	}
+	return decoded, nil
+}
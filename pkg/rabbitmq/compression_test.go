@@ -0,0 +1,195 @@
+package rabbitmq
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// samplePayload сообщение с неудачным результатом HTTP проверки: заголовки и фрагмент тела ответа
+// повторяются в задачах и событиях и хорошо сжимаются
+func samplePayload(tb testing.TB, snapshotSize int) []byte {
+	tb.Helper()
+	headers := make(map[string]string)
+	for i := 0; i < 12; i++ {
+		headers[fmt.Sprintf("X-Upstream-Header-%02d", i)] = "value-" + strings.Repeat("a", 24)
+	}
+	line := "<div class=\"error\">Service Unavailable</div>\n"
+	body, err := json.Marshal(map[string]interface{}{
+		"check_id":     "0f8c8e1e-3a6b-5d5c-9e4b-8c7c1f0b2a11",
+		"execution_id": "6c1f5d2e-8a3b-4e8f-9c1d-2b7a4e9f0c33",
+		"tenant_id":    "00000000-0000-0000-0000-000000000001",
+		"success":      false,
+		"status_code":  503,
+		"error":        "unexpected status code: 503",
+		"metadata": map[string]string{
+			"owner":       "payments",
+			"team":        "sre",
+			"runbook_url": "https://runbooks.example.com/payments/api-unavailable",
+			"tags":        "payments,api,critical",
+		},
+		"headers":  headers,
+		"snapshot": strings.Repeat(line, snapshotSize/len(line)+1)[:snapshotSize],
+	})
+	if err != nil {
+		tb.Fatalf("Failed to encode sample payload: %v", err)
+	}
+	return body
+}
+
+// TestCompressBody_RoundTrip проверяет сжатие крупного тела и его распаковку
+func TestCompressBody_RoundTrip(t *testing.T) {
+	body := samplePayload(t, 4096)
+
+	compressed, encoding, err := CompressBody(body, DefaultCompressionThreshold)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if encoding != ContentEncodingGzip {
+		t.Fatalf("Expected gzip encoding, got %q", encoding)
+	}
+	if len(compressed) >= len(body)/2 {
+		t.Errorf("Expected body to shrink at least twice, got %d of %d bytes", len(compressed), len(body))
+	}
+
+	decoded, err := DecompressBody(compressed, encoding)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !bytes.Equal(decoded, body) {
+		t.Error("Expected decompressed body to match the original")
+	}
+}
+
+// TestCompressBody_Skipped проверяет, что мелкие и несжимаемые тела отправляются как есть
+func TestCompressBody_Skipped(t *testing.T) {
+	small := []byte(`{"check_id":"check-1"}`)
+	random := make([]byte, 4096)
+	if _, err := rand.Read(random); err != nil {
+		t.Fatalf("Failed to generate random body: %v", err)
+	}
+	large := samplePayload(t, 4096)
+
+	tests := []struct {
+		name      string
+		body      []byte
+		threshold int
+	}{
+		{"below threshold", small, DefaultCompressionThreshold},
+		{"incompressible", random, DefaultCompressionThreshold},
+		{"disabled", large, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, encoding, err := CompressBody(tt.body, tt.threshold)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if encoding != "" || !bytes.Equal(body, tt.body) {
+				t.Errorf("Expected body to be sent as is, got encoding %q and %d bytes", encoding, len(body))
+			}
+		})
+	}
+}
+
+// TestDecompressBody_Errors проверяет отказ для неизвестной кодировки, битого и слишком большого тела
+func TestDecompressBody_Errors(t *testing.T) {
+	if _, err := DecompressBody([]byte("data"), "br"); err == nil {
+		t.Error("Expected error for unsupported encoding")
+	}
+	if _, err := DecompressBody([]byte("not gzip"), ContentEncodingGzip); err == nil {
+		t.Error("Expected error for corrupted body")
+	}
+
+	var bomb bytes.Buffer
+	writer := gzip.NewWriter(&bomb)
+	if _, err := writer.Write(make([]byte, maxDecompressedSize+1)); err != nil {
+		t.Fatalf("Failed to compress body: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Failed to compress body: %v", err)
+	}
+	if _, err := DecompressBody(bomb.Bytes(), ContentEncodingGzip); err == nil {
+		t.Error("Expected error for body above the size limit")
+	}
+}
+
+// TestConsumer_HandleCompressed проверяет, что обработчик получает распакованное тело
+func TestConsumer_HandleCompressed(t *testing.T) {
+	consumer := NewConsumer(&Connection{}, NewConfig())
+	body := samplePayload(t, 4096)
+	compressed, encoding, err := CompressBody(body, DefaultCompressionThreshold)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var received amqp091.Delivery
+	handler := func(ctx context.Context, msg amqp091.Delivery) error {
+		received = msg
+		return nil
+	}
+	delivery := amqp091.Delivery{ContentEncoding: encoding, Body: compressed}
+	if err := consumer.handle(context.Background(), "test-queue", handler, delivery); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !bytes.Equal(received.Body, body) || received.ContentEncoding != "" {
+		t.Errorf("Expected handler to receive the original body, got encoding %q and %d bytes",
+			received.ContentEncoding, len(received.Body))
+	}
+
+	// Нераспаковываемое сообщение проходит путь retry и DLQ, обработчик не вызывается
+	received = amqp091.Delivery{}
+	delivery = amqp091.Delivery{ContentEncoding: ContentEncodingGzip, Body: []byte("not gzip")}
+	if err := consumer.handle(context.Background(), "test-queue", handler, delivery); err == nil {
+		t.Error("Expected error for corrupted body")
+	}
+	if received.Body != nil {
+		t.Error("Expected handler not to be called")
+	}
+}
+
+// BenchmarkCompressBody измеряет сжатие тел разного размера; ratio - доля исходного размера на проводе
+func BenchmarkCompressBody(b *testing.B) {
+	for _, size := range []int{512, 4096, 65536} {
+		body := samplePayload(b, size)
+		b.Run(fmt.Sprintf("%dB", len(body)), func(b *testing.B) {
+			b.SetBytes(int64(len(body)))
+			b.ReportAllocs()
+			var compressed []byte
+			for i := 0; i < b.N; i++ {
+				var err error
+				if compressed, _, err = CompressBody(body, DefaultCompressionThreshold); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(len(compressed))/float64(len(body)), "ratio")
+		})
+	}
+}
+
+// BenchmarkDecompressBody измеряет распаковку на стороне consumer
+func BenchmarkDecompressBody(b *testing.B) {
+	for _, size := range []int{4096, 65536} {
+		body := samplePayload(b, size)
+		compressed, encoding, err := CompressBody(body, DefaultCompressionThreshold)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("%dB", len(body)), func(b *testing.B) {
+			b.SetBytes(int64(len(body)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := DecompressBody(compressed, encoding); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
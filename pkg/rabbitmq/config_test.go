@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/rabbitmq/amqp091-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -147,9 +148,45 @@ func clearEnvVars() {
 		"RABBITMQ_GLOBAL",
 		"RABBITMQ_MAX_RETRY_ATTEMPTS",
 		"RABBITMQ_RETRY_DELAY",
+		"RABBITMQ_MAX_PRIORITY",
+		"RABBITMQ_COMPRESSION_THRESHOLD",
 	}
 	
 	for _, v := range vars {
 		os.Unsetenv(v)
 	}
 }
+
+func TestGetConfig_MaxPriority(t *testing.T) {
+	clearEnvVars()
+	defer clearEnvVars()
+
+	config := GetConfig()
+	assert.Equal(t, uint8(0), config.MaxPriority)
+	assert.Nil(t, config.QueueArguments())
+
+	os.Setenv("RABBITMQ_MAX_PRIORITY", "10")
+	config = GetConfig()
+	assert.Equal(t, uint8(10), config.MaxPriority)
+	assert.Equal(t, amqp091.Table{"x-max-priority": uint8(10)}, config.QueueArguments())
+	assert.NoError(t, config.QueueArguments().Validate())
+
+	// Значение вне диапазона игнорируется
+	os.Setenv("RABBITMQ_MAX_PRIORITY", "300")
+	assert.Equal(t, uint8(0), GetConfig().MaxPriority)
+}
+
+func TestGetConfig_CompressionThreshold(t *testing.T) {
+	clearEnvVars()
+	defer clearEnvVars()
+
+	// Сжатие включается только явно
+	assert.Equal(t, 0, GetConfig().CompressionThreshold)
+
+	os.Setenv("RABBITMQ_COMPRESSION_THRESHOLD", "4096")
+	assert.Equal(t, 4096, GetConfig().CompressionThreshold)
+
+	// Отрицательное значение игнорируется
+	os.Setenv("RABBITMQ_COMPRESSION_THRESHOLD", "-1")
+	assert.Equal(t, 0, GetConfig().CompressionThreshold)
+}
@@ -24,6 +24,9 @@ type Config struct {
 	Queue      string
 	DLX        string // Dead Letter Exchange
 	DLQ        string // Dead Letter Queue
+	// ExchangeType тип exchange, объявляемого consumer'ом перед привязкой очередей;
+	// пустое значение - exchange должен существовать
+	ExchangeType string
 	// Connection settings
 	ReconnectInterval time.Duration
 	MaxRetries        int
@@ -34,6 +37,11 @@ type Config struct {
 	// Retry settings
 	MaxRetryAttempts int
 	RetryDelay       time.Duration
+	// MaxPriority объявляет очереди с приоритетами (x-max-priority); 0 - обычные очереди
+	MaxPriority uint8
+	// CompressionThreshold размер тела, начиная с которого продюсер сжимает сообщение gzip;
+	// 0 (по умолчанию) - без сжатия. Consumer распаковывает сжатые сообщения независимо от настройки
+	CompressionThreshold int
 }
 
 // NewConfig создает конфигурацию по умолчанию
@@ -163,6 +171,14 @@ func (c *Connection) Close() error {
 	return connErr
 }
 
+// QueueArguments аргументы объявления очереди; nil для обычной очереди без приоритетов
+func (c *Config) QueueArguments() amqp091.Table {
+	if c.MaxPriority == 0 {
+		return nil
+	}
+	return amqp091.Table{"x-max-priority": c.MaxPriority}
+}
+
 // Channel возвращает канал для использования
 func (c *Connection) Channel() *amqp091.Channel {
 	return c.channel
@@ -247,6 +263,20 @@ func GetConfig() *Config {
 			config.RetryDelay = delay
 		}
 	}
+
+	// Загружаем максимальный приоритет очередей
+	if maxPriority := os.Getenv("RABBITMQ_MAX_PRIORITY"); maxPriority != "" {
+		if priority, err := strconv.ParseUint(maxPriority, 10, 8); err == nil {
+			config.MaxPriority = uint8(priority)
+		}
+	}
+
+	// Загружаем порог сжатия сообщений
+	if threshold := os.Getenv("RABBITMQ_COMPRESSION_THRESHOLD"); threshold != "" {
+		if size, err := strconv.Atoi(threshold); err == nil && size >= 0 {
+			config.CompressionThreshold = size
+		}
+	}
 	
 	return config
 }
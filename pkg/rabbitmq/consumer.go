@@ -3,9 +3,13 @@ package rabbitmq
 import (
 	"context"
 	"fmt"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/rabbitmq/amqp091-go"
+
+	"UptimePingPlatform/pkg/chaos"
 )
 
 // Consumer представляет консьюмера сообщений
@@ -13,6 +17,17 @@ type Consumer struct {
 	conn     *Connection
 	config   *Config
 	handlers map[string]MessageHandler
+	bindings map[string]string
+	injector *chaos.Injector
+
+	// Остановка с дренированием: обработчики не зависят от контекста Start и
+	// прерываются только по истечении срока Drain
+	mu            sync.Mutex
+	draining      bool
+	tags          map[string]string
+	inflight      sync.WaitGroup
+	handlerCtx    context.Context
+	cancelHandler context.CancelFunc
 }
 
 // MessageHandler функция для обработки сообщения
@@ -20,18 +35,36 @@ type MessageHandler func(context.Context, amqp091.Delivery) error
 
 // NewConsumer создает нового консьюмера
 func NewConsumer(conn *Connection, config *Config) *Consumer {
+	handlerCtx, cancelHandler := context.WithCancel(context.Background())
 	return &Consumer{
-		conn:     conn,
-		config:   config,
-		handlers: make(map[string]MessageHandler),
+		conn:          conn,
+		config:        config,
+		handlers:      make(map[string]MessageHandler),
+		bindings:      make(map[string]string),
+		tags:          make(map[string]string),
+		handlerCtx:    handlerCtx,
+		cancelHandler: cancelHandler,
 	}
 }
 
+// WithFaultInjector включает внедрение сбоев при обработке сообщений (цель "consume/<queue>")
+func (c *Consumer) WithFaultInjector(injector *chaos.Injector) *Consumer {
+	c.injector = injector
+	return c
+}
+
 // RegisterHandler регистрирует обработчик для конкретной очереди
 func (c *Consumer) RegisterHandler(queueName string, handler MessageHandler) {
 	c.handlers[queueName] = handler
 }
 
+// RegisterBinding регистрирует обработчик очереди, привязанной к exchange со своим ключом
+// вместо общего RoutingKey конфигурации
+func (c *Consumer) RegisterBinding(queueName, routingKey string, handler MessageHandler) {
+	c.handlers[queueName] = handler
+	c.bindings[queueName] = routingKey
+}
+
 // Start запускает консьюмера для всех зарегистрированных очередей
 func (c *Consumer) Start(ctx context.Context) error {
 	for queueName, handler := range c.handlers {
@@ -43,6 +76,9 @@ func (c *Consumer) Start(ctx context.Context) error {
 				case <-ctx.Done():
 					return
 				default:
+					if c.isDraining() {
+						return
+					}
 					if err := c.consume(ctx, queue, h); err != nil {
 						fmt.Printf("Error consuming from queue %s: %v. Reconnecting in %s...\n", queue, err, c.config.ReconnectInterval)
 						time.Sleep(c.config.ReconnectInterval)
@@ -64,14 +100,14 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 		return fmt.Errorf("rabbitmq channel is not initialized")
 	}
 
-	// Объявляем очередь
+	// Объявляем очередь; при MaxPriority сообщения с большим приоритетом доставляются первыми
 	_, err := c.conn.Channel().QueueDeclare(
 		queueName,
 		true,  // durable
 		false, // delete when unused
 		false, // exclusive
 		false, // no-wait
-		nil,   // arguments
+		c.config.QueueArguments(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to declare queue %s: %w", queueName, err)
@@ -83,9 +119,19 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 		if c.conn.Channel() == nil {
 			return fmt.Errorf("rabbitmq channel is not initialized")
 		}
+		if c.config.ExchangeType != "" {
+			err = c.conn.Channel().ExchangeDeclare(c.config.Exchange, c.config.ExchangeType, true, false, false, false, nil)
+			if err != nil {
+				return fmt.Errorf("failed to declare exchange %s: %w", c.config.Exchange, err)
+			}
+		}
+		routingKey, bound := c.bindings[queueName]
+		if !bound {
+			routingKey = c.config.RoutingKey
+		}
 		err = c.conn.Channel().QueueBind(
 			queueName,
-			c.config.RoutingKey,
+			routingKey,
 			c.config.Exchange,
 			false,
 			nil,
@@ -100,10 +146,22 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 		return fmt.Errorf("rabbitmq channel is not initialized")
 	}
 
-	// Получаем сообщения
+	// Prefetch без global действует на каждую подписку канала отдельно: очереди не забирают
+	// друг у друга окно неподтвержденных сообщений
+	if c.config.PrefetchCount > 0 {
+		if err := c.conn.Channel().Qos(c.config.PrefetchCount, c.config.PrefetchSize, false); err != nil {
+			return fmt.Errorf("failed to set QoS for queue %s: %w", queueName, err)
+		}
+	}
+
+	// Получаем сообщения. Тег сохраняется, чтобы Drain мог отменить подписку
+	tag, ok := c.registerTag(queueName)
+	if !ok {
+		return nil
+	}
 	msgs, err := c.conn.Channel().Consume(
 		queueName,
-		"",    // consumer
+		tag,   // consumer
 		false, // auto-ack
 		false, // exclusive
 		false, // no-local
@@ -114,20 +172,42 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 		return fmt.Errorf("failed to register a consumer: %w", err)
 	}
 
+	return c.deliver(queueName, handler, msgs)
+}
+
+// deliver обрабатывает поток сообщений подписки до его закрытия
+func (c *Consumer) deliver(queueName string, handler MessageHandler, msgs <-chan amqp091.Delivery) error {
 	// Обрабатываем сообщения
 	for msg := range msgs {
-		// Создаем контекст для обработки сообщения
-		msgCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		ObserveDelivery(queueName, msg)
+
+		// Во время остановки полученные заранее сообщения возвращаются в очередь необработанными
+		if !c.startDelivery() {
+			if err := NackDelivery(queueName, msg, true); err != nil {
+				fmt.Printf("Error requeueing delivery %d during drain: %v\n", msg.DeliveryTag, err)
+			}
+			continue
+		}
+
+		// Контекст обработки не зависит от ctx: начатая проверка завершается при остановке
+		msgCtx, cancel := context.WithTimeout(c.handlerCtx, 30*time.Second)
 
 		// Обрабатываем сообщение
-		err := handler(msgCtx, msg)
+		start := time.Now()
+		err := c.handle(msgCtx, queueName, handler, msg)
+		ObserveProcessing(queueName, time.Since(start), err)
 
 		// Отправляем ack/nack в зависимости от результата
 		if err == nil {
 			// Успешная обработка - отправляем ack
-			if err := msg.Ack(false); err != nil {
+			if err := AckDelivery(queueName, msg); err != nil {
 				fmt.Printf("Error sending ack for delivery %d: %v\n", msg.DeliveryTag, err)
 			}
+		} else if c.isDraining() {
+			// Обработка прервана остановкой: без задержки retry и без DLQ
+			if err := NackDelivery(queueName, msg, true); err != nil {
+				fmt.Printf("Error requeueing delivery %d during drain: %v\n", msg.DeliveryTag, err)
+			}
 		} else {
 			// Ошибка при обработке - отправляем nack с retry логикой
 			
@@ -154,7 +234,7 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 				time.Sleep(retryDelay)
 				
 				// Отправляем nack с requeue для повторной обработки
-				if err := msg.Nack(false, true); err != nil {
+				if err := NackDelivery(queueName, msg, true); err != nil {
 					fmt.Printf("Error sending nack with requeue for delivery %d: %v\n", msg.DeliveryTag, err)
 				}
 			} else {
@@ -167,18 +247,18 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 					if err := c.publishToDLQ(msg, err); err != nil {
 						fmt.Printf("Error publishing to DLQ: %v\n", err)
 						// Если не удалось отправить в DLQ, просто отправляем nack без requeue
-						if err := msg.Nack(false, false); err != nil {
+						if err := NackDelivery(queueName, msg, false); err != nil {
 							fmt.Printf("Error sending nack without requeue for delivery %d: %v\n", msg.DeliveryTag, err)
 						}
 					} else {
 						// Успешно отправили в DLQ, отправляем ack
-						if err := msg.Ack(false); err != nil {
+						if err := AckDelivery(queueName, msg); err != nil {
 							fmt.Printf("Error sending ack after DLQ publish for delivery %d: %v\n", msg.DeliveryTag, err)
 						}
 					}
 				} else {
 					// DLQ не настроен, просто отправляем nack без requeue
-					if err := msg.Nack(false, false); err != nil {
+					if err := NackDelivery(queueName, msg, false); err != nil {
 						fmt.Printf("Error sending nack without requeue for delivery %d: %v\n", msg.DeliveryTag, err)
 					}
 				}
@@ -187,6 +267,12 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 
 		// Завершаем контекст
 		cancel()
+		c.inflight.Done()
+	}
+
+	// Подписка отменена через Drain
+	if c.isDraining() {
+		return nil
 	}
 
 	// Если канал закрыт, возвращаем ошибку
@@ -202,6 +288,108 @@ func (c *Consumer) consume(ctx context.Context, queueName string, handler Messag
 	return nil
 }
 
+// Drain останавливает прием сообщений: подписки отменяются, уже полученные, но не начатые
+// сообщения возвращаются в очередь, начатые обрабатываются до истечения ctx. По истечении
+// ctx контексты обработчиков отменяются, неподтвержденные сообщения брокер вернет в очередь
+// при закрытии соединения
+func (c *Consumer) Drain(ctx context.Context) error {
+	c.mu.Lock()
+	c.draining = true
+	tags := make([]string, 0, len(c.tags))
+	for _, tag := range c.tags {
+		tags = append(tags, tag)
+	}
+	c.mu.Unlock()
+
+	if channel := c.conn.Channel(); channel != nil {
+		for _, tag := range tags {
+			if err := channel.Cancel(tag, false); err != nil {
+				fmt.Printf("Error cancelling consumer %s: %v\n", tag, err)
+			}
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		c.cancelHandler()
+		return nil
+	case <-ctx.Done():
+		c.cancelHandler()
+		return ctx.Err()
+	}
+}
+
+// isDraining сообщает, идет ли остановка
+func (c *Consumer) isDraining() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.draining
+}
+
+// registerTag создает тег подписки на очередь; во время остановки новые подписки не создаются
+func (c *Consumer) registerTag(queueName string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.draining {
+		return "", false
+	}
+	tag := fmt.Sprintf("%s-%d-%d", queueName, os.Getpid(), time.Now().UnixNano())
+	c.tags[queueName] = tag
+	return tag, true
+}
+
+// startDelivery учитывает начатую обработку; во время остановки новые обработки не начинаются
+func (c *Consumer) startDelivery() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.draining {
+		return false
+	}
+	c.inflight.Add(1)
+	return true
+}
+
+// handle вызывает обработчик с учетом внедряемых сбоев. Внедренная ошибка проходит
+// обычный путь retry и DLQ, потерянное сообщение подтверждается без обработки.
+// Сжатое тело распаковывается: обработчик всегда получает исходное сообщение
+func (c *Consumer) handle(ctx context.Context, queueName string, handler MessageHandler, msg amqp091.Delivery) error {
+	decision, err := c.injector.Apply(ctx, "consume/"+queueName)
+	if err != nil || decision.Drop {
+		return err
+	}
+	if msg.ContentEncoding != "" {
+		if msg.Body, err = DecompressBody(msg.Body, msg.ContentEncoding); err != nil {
+			return err
+		}
+		msg.ContentEncoding = ""
+	}
+	return handler(ctx, msg)
+}
+
+// AckDelivery подтверждает сообщение и учитывает подтверждение в метриках
+func AckDelivery(queue string, msg amqp091.Delivery) error {
+	if err := msg.Ack(false); err != nil {
+		return err
+	}
+	ObserveAck(queue)
+	return nil
+}
+
+// NackDelivery отклоняет сообщение и учитывает отклонение в метриках
+func NackDelivery(queue string, msg amqp091.Delivery, requeue bool) error {
+	if err := msg.Nack(false, requeue); err != nil {
+		return err
+	}
+	ObserveNack(queue, requeue)
+	return nil
+}
+
 // publishToDLQ публикует сообщение в Dead Letter Queue
 func (c *Consumer) publishToDLQ(msg amqp091.Delivery, processingError error) error {
 	// Проверяем, что канал инициализирован
@@ -230,11 +418,12 @@ func (c *Consumer) publishToDLQ(msg amqp091.Delivery, processingError error) err
 		false,
 		false,
 		amqp091.Publishing{
-			ContentType:  msg.ContentType,
-			Headers:      dlqHeaders,
-			Timestamp:    msg.Timestamp,
-			MessageId:    msg.MessageId,
-			Body:         msg.Body,
+			ContentType:     msg.ContentType,
+			ContentEncoding: msg.ContentEncoding,
+			Headers:         dlqHeaders,
+			Timestamp:       msg.Timestamp,
+			MessageId:       msg.MessageId,
+			Body:            msg.Body,
 		},
 	)
 	
@@ -2,11 +2,14 @@ package rabbitmq
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/rabbitmq/amqp091-go"
+
+	"UptimePingPlatform/pkg/chaos"
 )
 
 // TestConsumer_RegisterHandler проверяет регистрацию обработчика
@@ -102,3 +105,171 @@ func TestConsumer_HealthCheck(t *testing.T) {
 		t.Error("Expected error when connection is not initialized")
 	}
 }
+
+// TestConsumer_HandleFaultInjection проверяет внедрение сбоев при обработке сообщений
+func TestConsumer_HandleFaultInjection(t *testing.T) {
+	ctx := context.Background()
+	var handled int
+	handler := func(ctx context.Context, msg amqp091.Delivery) error {
+		handled++
+		return nil
+	}
+
+	// Без инжектора сообщение обрабатывается как обычно
+	consumer := NewConsumer(&Connection{}, NewConfig())
+	if err := consumer.handle(ctx, "test-queue", handler, amqp091.Delivery{}); err != nil || handled != 1 {
+		t.Errorf("Expected message to be handled, got err=%v handled=%d", err, handled)
+	}
+
+	// Внедренная ошибка уходит в retry без вызова обработчика
+	injector, err := chaos.NewInjector("consumer_test", chaos.Config{Enabled: true, ErrorPercent: 100})
+	if err != nil {
+		t.Fatalf("Failed to create injector: %v", err)
+	}
+	consumer.WithFaultInjector(injector)
+	if err := consumer.handle(ctx, "test-queue", handler, amqp091.Delivery{}); !errors.Is(err, chaos.ErrInjected) || handled != 1 {
+		t.Errorf("Expected injected error, got err=%v handled=%d", err, handled)
+	}
+
+	// Потерянное сообщение подтверждается без обработки
+	injector, err = chaos.NewInjector("consumer_test", chaos.Config{Enabled: true, DropPercent: 100, Targets: []string{"consume/test-*"}})
+	if err != nil {
+		t.Fatalf("Failed to create injector: %v", err)
+	}
+	consumer.WithFaultInjector(injector)
+	if err := consumer.handle(ctx, "test-queue", handler, amqp091.Delivery{}); err != nil || handled != 1 {
+		t.Errorf("Expected message to be dropped, got err=%v handled=%d", err, handled)
+	}
+	if err := consumer.handle(ctx, "other-queue", handler, amqp091.Delivery{}); err != nil || handled != 2 {
+		t.Errorf("Expected other queues to be unaffected, got err=%v handled=%d", err, handled)
+	}
+}
+
+// recordingAcknowledger запоминает подтверждения и отклонения сообщений
+type recordingAcknowledger struct {
+	mu      sync.Mutex
+	acked   []uint64
+	requeue []uint64
+}
+
+func (a *recordingAcknowledger) Ack(tag uint64, multiple bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.acked = append(a.acked, tag)
+	return nil
+}
+
+func (a *recordingAcknowledger) Nack(tag uint64, multiple, requeue bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if requeue {
+		a.requeue = append(a.requeue, tag)
+	}
+	return nil
+}
+
+func (a *recordingAcknowledger) Reject(tag uint64, requeue bool) error {
+	return a.Nack(tag, false, requeue)
+}
+
+func (a *recordingAcknowledger) snapshot() ([]uint64, []uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]uint64(nil), a.acked...), append([]uint64(nil), a.requeue...)
+}
+
+// TestConsumer_DrainFinishesInFlight проверяет, что начатое сообщение обрабатывается до конца,
+// а полученные заранее возвращаются в очередь
+func TestConsumer_DrainFinishesInFlight(t *testing.T) {
+	consumer := NewConsumer(&Connection{}, NewConfig())
+	ack := &recordingAcknowledger{}
+	msgs := make(chan amqp091.Delivery, 3)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := func(ctx context.Context, msg amqp091.Delivery) error {
+		if msg.DeliveryTag == 1 {
+			close(started)
+			<-release
+		}
+		return ctx.Err()
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- consumer.deliver("test-queue", handler, msgs) }()
+
+	msgs <- amqp091.Delivery{Acknowledger: ack, DeliveryTag: 1}
+	<-started
+
+	drained := make(chan error, 1)
+	go func() { drained <- consumer.Drain(context.Background()) }()
+
+	// Сообщения, полученные после начала остановки, не обрабатываются
+	for !consumer.isDraining() {
+		time.Sleep(time.Millisecond)
+	}
+	msgs <- amqp091.Delivery{Acknowledger: ack, DeliveryTag: 2}
+	msgs <- amqp091.Delivery{Acknowledger: ack, DeliveryTag: 3}
+
+	select {
+	case err := <-drained:
+		t.Fatalf("Drain returned before in-flight message finished: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-drained; err != nil {
+		t.Fatalf("Expected drain to complete, got %v", err)
+	}
+	close(msgs)
+	if err := <-done; err != nil {
+		t.Fatalf("Expected deliver to stop cleanly after drain, got %v", err)
+	}
+
+	acked, requeued := ack.snapshot()
+	if len(acked) != 1 || acked[0] != 1 {
+		t.Errorf("Expected in-flight message to be acked, got %v", acked)
+	}
+	if len(requeued) != 2 {
+		t.Errorf("Expected unstarted messages to be requeued, got %v", requeued)
+	}
+}
+
+// TestConsumer_DrainDeadline проверяет, что по истечении срока обработка прерывается
+// и сообщение возвращается в очередь без задержки retry
+func TestConsumer_DrainDeadline(t *testing.T) {
+	config := NewConfig()
+	config.RetryDelay = time.Hour
+	consumer := NewConsumer(&Connection{}, config)
+	ack := &recordingAcknowledger{}
+	msgs := make(chan amqp091.Delivery, 1)
+
+	started := make(chan struct{})
+	handler := func(ctx context.Context, msg amqp091.Delivery) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	go consumer.deliver("test-queue", handler, msgs)
+	msgs <- amqp091.Delivery{Acknowledger: ack, DeliveryTag: 1}
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := consumer.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, requeued := ack.snapshot(); len(requeued) == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected interrupted message to be requeued")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(msgs)
+}
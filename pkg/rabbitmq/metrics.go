@@ -0,0 +1,195 @@
+package rabbitmq
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// Метрики RabbitMQ регистрируются в реестре Prometheus по умолчанию,
+// поэтому отдаются существующим /metrics эндпоинтом каждого сервиса.
+// Метки ограничены exchange и очередью: routing key может содержать ID tenant
+const (
+	metricsNamespace = "uptimeping"
+	metricsSubsystem = "rabbitmq"
+
+	// StatusSuccess результат успешной публикации или обработки
+	StatusSuccess = "success"
+	// StatusError результат неуспешной публикации или обработки
+	StatusError = "error"
+)
+
+var (
+	messagesPublished = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "messages_published_total",
+			Help:      "Total number of messages published to RabbitMQ",
+		},
+		[]string{"exchange", "status"},
+	)
+
+	messagesConsumed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "messages_consumed_total",
+			Help:      "Total number of messages delivered to consumers",
+		},
+		[]string{"queue"},
+	)
+
+	messagesAcked = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "messages_acked_total",
+			Help:      "Total number of acknowledged messages",
+		},
+		[]string{"queue"},
+	)
+
+	messagesNacked = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "messages_nacked_total",
+			Help:      "Total number of negatively acknowledged messages",
+		},
+		[]string{"queue", "requeue"},
+	)
+
+	messagesRedelivered = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "messages_redelivered_total",
+			Help:      "Total number of redelivered messages",
+		},
+		[]string{"queue"},
+	)
+
+	publishedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "published_bytes_total",
+			Help:      "Total size of published message bodies after compression",
+		},
+		[]string{"exchange", "encoding"},
+	)
+
+	publishDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "publish_duration_seconds",
+			Help:      "Duration of message publishing including broker confirmation",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"exchange"},
+	)
+
+	processingDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "message_processing_duration_seconds",
+			Help:      "Duration of message processing by consumer handlers",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"queue", "status"},
+	)
+
+	queueMessages = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "queue_messages",
+			Help:      "Number of messages in queue by state, polled from the management API",
+		},
+		[]string{"queue", "state"},
+	)
+
+	queueConsumers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "queue_consumers",
+			Help:      "Number of consumers attached to queue, polled from the management API",
+		},
+		[]string{"queue"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		messagesPublished,
+		messagesConsumed,
+		messagesAcked,
+		messagesNacked,
+		messagesRedelivered,
+		publishedBytes,
+		publishDuration,
+		processingDuration,
+		queueMessages,
+		queueConsumers,
+	)
+}
+
+// ObservePublish учитывает публикацию сообщения в exchange; пустой exchange - default exchange
+func ObservePublish(exchange string, duration time.Duration, err error) {
+	exchange = exchangeLabel(exchange)
+	publishDuration.WithLabelValues(exchange).Observe(duration.Seconds())
+	messagesPublished.WithLabelValues(exchange, statusLabel(err)).Inc()
+}
+
+// ObservePayload учитывает размер опубликованного тела; пустая кодировка - тело без сжатия
+func ObservePayload(exchange, encoding string, size int) {
+	if encoding == "" {
+		encoding = "identity"
+	}
+	publishedBytes.WithLabelValues(exchangeLabel(exchange), encoding).Add(float64(size))
+}
+
+// ObserveDelivery учитывает получение сообщения из очереди, включая повторные доставки
+func ObserveDelivery(queue string, delivery amqp091.Delivery) {
+	messagesConsumed.WithLabelValues(queue).Inc()
+	if delivery.Redelivered {
+		messagesRedelivered.WithLabelValues(queue).Inc()
+	}
+}
+
+// ObserveProcessing учитывает время обработки сообщения обработчиком
+func ObserveProcessing(queue string, duration time.Duration, err error) {
+	processingDuration.WithLabelValues(queue, statusLabel(err)).Observe(duration.Seconds())
+}
+
+// ObserveAck учитывает подтверждение сообщения
+func ObserveAck(queue string) {
+	messagesAcked.WithLabelValues(queue).Inc()
+}
+
+// ObserveNack учитывает отклонение сообщения
+func ObserveNack(queue string, requeue bool) {
+	messagesNacked.WithLabelValues(queue, strconv.FormatBool(requeue)).Inc()
+}
+
+// exchangeLabel возвращает метку для exchange
+func exchangeLabel(exchange string) string {
+	if exchange == "" {
+		return "(default)"
+	}
+	return exchange
+}
+
+// statusLabel возвращает метку результата операции
+func statusLabel(err error) string {
+	if err != nil {
+		return StatusError
+	}
+	return StatusSuccess
+}
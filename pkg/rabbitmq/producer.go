@@ -6,12 +6,15 @@ import (
 	"time"
 
 	"github.com/rabbitmq/amqp091-go"
+
+	"UptimePingPlatform/pkg/chaos"
 )
 
 // Producer представляет продюсера сообщений
 type Producer struct {
-	conn   *Connection
-	config *Config
+	conn     *Connection
+	config   *Config
+	injector *chaos.Injector
 }
 
 // NewProducer создает нового продюсера
@@ -19,6 +22,12 @@ func NewProducer(conn *Connection, config *Config) *Producer {
 	return &Producer{conn: conn, config: config}
 }
 
+// WithFaultInjector включает внедрение сбоев при публикации (цель "publish/<routing_key>")
+func (p *Producer) WithFaultInjector(injector *chaos.Injector) *Producer {
+	p.injector = injector
+	return p
+}
+
 // Publish публикует сообщение в RabbitMQ с подтверждениями
 func (p *Producer) Publish(ctx context.Context, body []byte, options ...PublishOption) error {
 	// Устанавливаем опции по умолчанию
@@ -34,6 +43,20 @@ func (p *Producer) Publish(ctx context.Context, body []byte, options ...PublishO
 		option(opts)
 	}
 
+	// Потерянное сообщение считается опубликованным, но до брокера не доходит
+	decision, err := p.injector.Apply(ctx, "publish/"+opts.RoutingKey)
+	if err != nil || decision.Drop {
+		return err
+	}
+
+	start := time.Now()
+	err = p.publish(ctx, body, opts)
+	ObservePublish(opts.Exchange, time.Since(start), err)
+	return err
+}
+
+// publish публикует сообщение и ожидает подтверждение брокера
+func (p *Producer) publish(ctx context.Context, body []byte, opts *PublishOptions) error {
 	// Проверяем, что канал инициализирован
 	if p.conn.Channel() == nil {
 		return fmt.Errorf("rabbitmq channel is not initialized")
@@ -48,12 +71,20 @@ func (p *Producer) Publish(ctx context.Context, body []byte, options ...PublishO
 	confirms := p.conn.Channel().NotifyPublish(make(chan amqp091.Confirmation, 1))
 	defer close(confirms)
 
+	// Крупные тела сжимаются, consumer распаковывает их по ContentEncoding
+	body, encoding, err := CompressBody(body, p.config.CompressionThreshold)
+	if err != nil {
+		return err
+	}
+
 	// Публикуем сообщение
 	msg := amqp091.Publishing{
-		ContentType:  "application/json",
-		Body:         body,
-		DeliveryMode: amqp091.Persistent,
-		Timestamp:    time.Now(),
+		ContentType:     "application/json",
+		ContentEncoding: encoding,
+		Body:            body,
+		DeliveryMode:    amqp091.Persistent,
+		Timestamp:       time.Now(),
+		Priority:        opts.Priority,
 	}
 
 	// Устанавливаем заголовки, если есть
@@ -71,6 +102,7 @@ func (p *Producer) Publish(ctx context.Context, body []byte, options ...PublishO
 	); err != nil {
 		return fmt.Errorf("failed to publish message: %w", err)
 	}
+	ObservePayload(opts.Exchange, encoding, len(body))
 
 	// Ожидаем подтверждение
 	select {
@@ -113,6 +145,8 @@ type PublishOptions struct {
 	Mandatory  bool
 	Immediate  bool
 	Headers    amqp091.Table
+	// Priority приоритет сообщения; учитывается очередью с x-max-priority
+	Priority uint8
 }
 
 // PublishOption функция для настройки опций публикации
@@ -152,3 +186,10 @@ func WithHeaders(headers amqp091.Table) PublishOption {
 		opts.Headers = headers
 	}
 }
+
+// WithPriority устанавливает приоритет сообщения
+func WithPriority(priority uint8) PublishOption {
+	return func(opts *PublishOptions) {
+		opts.Priority = priority
+	}
+}
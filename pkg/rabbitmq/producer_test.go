@@ -2,10 +2,13 @@ package rabbitmq
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/rabbitmq/amqp091-go"
+
+	"UptimePingPlatform/pkg/chaos"
 )
 
 // TestProducer_Publish проверяет публикацию сообщения
@@ -92,4 +95,38 @@ func TestPublishOptions(t *testing.T) {
 	if opts.Headers["test"] != "value" {
 		t.Errorf("Expected header 'test' with value 'value', got %v", opts.Headers["test"])
 	}
+
+	// Сбрасываем
+	opts = &PublishOptions{}
+
+	// Тестируем WithPriority
+	WithPriority(9)(opts)
+	if opts.Priority != 9 {
+		t.Errorf("Expected priority 9, got %d", opts.Priority)
+	}
+}
+
+// TestProducer_PublishFaultInjection проверяет внедрение сбоев при публикации
+func TestProducer_PublishFaultInjection(t *testing.T) {
+	ctx := context.Background()
+
+	// Внедренная ошибка возвращается до обращения к брокеру
+	injector, err := chaos.NewInjector("producer_test", chaos.Config{Enabled: true, ErrorPercent: 100})
+	if err != nil {
+		t.Fatalf("Failed to create injector: %v", err)
+	}
+	producer := NewProducer(&Connection{}, NewConfig()).WithFaultInjector(injector)
+	if err := producer.Publish(ctx, []byte("test message")); !errors.Is(err, chaos.ErrInjected) {
+		t.Errorf("Expected injected error, got %v", err)
+	}
+
+	// Потерянное сообщение не публикуется и не возвращает ошибку
+	injector, err = chaos.NewInjector("producer_test", chaos.Config{Enabled: true, DropPercent: 100})
+	if err != nil {
+		t.Fatalf("Failed to create injector: %v", err)
+	}
+	producer = NewProducer(&Connection{}, NewConfig()).WithFaultInjector(injector)
+	if err := producer.Publish(ctx, []byte("test message")); err != nil {
+		t.Errorf("Expected dropped message to be reported as published, got %v", err)
+	}
 }
@@ -0,0 +1,165 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// ManagementConfig конфигурация опроса RabbitMQ management API
+type ManagementConfig struct {
+	URL          string // Адрес management API, например http://rabbitmq:15672
+	Username     string
+	Password     string
+	VHost        string
+	Queues       []string
+	PollInterval time.Duration
+	Timeout      time.Duration
+}
+
+// NewManagementConfig создает конфигурацию management API на основе AMQP URL:
+// учетные данные и vhost берутся из amqpURL, адрес API - тот же хост на порту 15672.
+// Адрес можно переопределить переменной окружения RABBITMQ_MANAGEMENT_URL
+func NewManagementConfig(amqpURL string, queues ...string) (*ManagementConfig, error) {
+	parsed, err := url.Parse(amqpURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rabbitmq url: %w", err)
+	}
+
+	config := &ManagementConfig{
+		URL:          fmt.Sprintf("http://%s", net.JoinHostPort(parsed.Hostname(), "15672")),
+		Username:     parsed.User.Username(),
+		VHost:        "/",
+		Queues:       queues,
+		PollInterval: 15 * time.Second,
+		Timeout:      5 * time.Second,
+	}
+	if password, ok := parsed.User.Password(); ok {
+		config.Password = password
+	}
+	if vhost := strings.TrimPrefix(parsed.Path, "/"); vhost != "" {
+		config.VHost = vhost
+	}
+	if managementURL := os.Getenv("RABBITMQ_MANAGEMENT_URL"); managementURL != "" {
+		config.URL = strings.TrimSuffix(managementURL, "/")
+	}
+
+	return config, nil
+}
+
+// QueueStats состояние очереди по данным management API
+type QueueStats struct {
+	Messages               int `json:"messages"`
+	MessagesReady          int `json:"messages_ready"`
+	MessagesUnacknowledged int `json:"messages_unacknowledged"`
+	Consumers              int `json:"consumers"`
+}
+
+// QueueMonitor периодически опрашивает management API и обновляет метрики глубины очередей
+type QueueMonitor struct {
+	config *ManagementConfig
+	client *http.Client
+}
+
+// NewQueueMonitor создает монитор очередей
+func NewQueueMonitor(config *ManagementConfig) *QueueMonitor {
+	return &QueueMonitor{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+// Start опрашивает очереди с интервалом PollInterval до отмены контекста.
+// Ошибки опроса передаются в onError (может быть nil) и не останавливают монитор
+func (m *QueueMonitor) Start(ctx context.Context, onError func(queue string, err error)) {
+	ticker := time.NewTicker(m.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		m.pollAll(ctx, onError)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollAll опрашивает все настроенные очереди
+func (m *QueueMonitor) pollAll(ctx context.Context, onError func(queue string, err error)) {
+	for _, queue := range m.config.Queues {
+		if _, err := m.Poll(ctx, queue); err != nil && onError != nil {
+			onError(queue, err)
+		}
+	}
+}
+
+// Poll запрашивает состояние очереди и обновляет метрики
+func (m *QueueMonitor) Poll(ctx context.Context, queue string) (*QueueStats, error) {
+	endpoint := fmt.Sprintf("%s/api/queues/%s/%s",
+		strings.TrimSuffix(m.config.URL, "/"),
+		url.PathEscape(m.config.VHost),
+		url.PathEscape(queue),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create management api request: %w", err)
+	}
+	req.SetBasicAuth(m.config.Username, m.config.Password)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query management api: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("management api returned status %d for queue %s", resp.StatusCode, queue)
+	}
+
+	var stats QueueStats
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return nil, fmt.Errorf("failed to decode queue stats: %w", err)
+	}
+
+	queueMessages.WithLabelValues(queue, "total").Set(float64(stats.Messages))
+	queueMessages.WithLabelValues(queue, "ready").Set(float64(stats.MessagesReady))
+	queueMessages.WithLabelValues(queue, "unacknowledged").Set(float64(stats.MessagesUnacknowledged))
+	queueConsumers.WithLabelValues(queue).Set(float64(stats.Consumers))
+
+	return &stats, nil
+}
+
+// StartQueueMonitor запускает в фоне опрос глубины очередей до отмены контекста.
+// Ошибки опроса логируются и не влияют на работу сервиса
+func StartQueueMonitor(ctx context.Context, amqpURL string, queues []string, log logger.Logger) error {
+	config, err := NewManagementConfig(amqpURL, queues...)
+	if err != nil {
+		return err
+	}
+
+	monitor := NewQueueMonitor(config)
+	go monitor.Start(ctx, func(queue string, err error) {
+		log.Warn("Failed to poll RabbitMQ queue stats",
+			logger.String("queue", queue),
+			logger.Error(err),
+		)
+	})
+
+	log.Info("RabbitMQ queue monitor started",
+		logger.String("management_url", config.URL),
+		logger.Int("queues", len(queues)),
+		logger.Duration("poll_interval", config.PollInterval),
+	)
+	return nil
+}
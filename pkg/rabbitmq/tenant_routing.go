@@ -0,0 +1,96 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// HeaderTenantID заголовок сообщения с ID tenant задачи
+const HeaderTenantID = "x-tenant-id"
+
+// TenantRouting распределяет задачи tenant по виртуальным очередям. Задача публикуется в topic
+// exchange с именем очереди и routing key "v<N>.<tenant_id>", где N - номер виртуальной очереди
+// по хешу ID tenant. Каждая виртуальная очередь обрабатывается отдельной подпиской со своим
+// prefetch, поэтому всплеск задач одного tenant задерживает только tenant той же виртуальной
+// очереди, а не всю общую очередь
+type TenantRouting struct {
+	// Queue базовая очередь задач: имя exchange и префикс имен виртуальных очередей
+	Queue string
+	// VirtualQueues число виртуальных очередей; 0 - маршрутизация по tenant отключена
+	VirtualQueues int
+}
+
+// NewTenantRouting создает маршрутизацию задач очереди queue по virtualQueues виртуальным очередям
+func NewTenantRouting(queue string, virtualQueues int) TenantRouting {
+	return TenantRouting{Queue: queue, VirtualQueues: virtualQueues}
+}
+
+// Enabled сообщает, включена ли маршрутизация по tenant
+func (r TenantRouting) Enabled() bool {
+	return r.Queue != "" && r.VirtualQueues > 0
+}
+
+// Exchange topic exchange, в который публикуются задачи tenant
+func (r TenantRouting) Exchange() string {
+	return r.Queue
+}
+
+// Shard номер виртуальной очереди tenant
+func (r TenantRouting) Shard(tenantID string) int {
+	if r.VirtualQueues <= 1 {
+		return 0
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(tenantID))
+	return int(hash.Sum32() % uint32(r.VirtualQueues))
+}
+
+// RoutingKey routing key задачи tenant. Точки в ID tenant заменяются, чтобы ключ оставался
+// из двух слов topic exchange
+func (r TenantRouting) RoutingKey(tenantID string) string {
+	word := strings.ReplaceAll(tenantID, ".", "_")
+	if word == "" {
+		word = "unknown"
+	}
+	return fmt.Sprintf("v%d.%s", r.Shard(tenantID), word)
+}
+
+// QueueName имя виртуальной очереди с номером shard
+func (r TenantRouting) QueueName(shard int) string {
+	return fmt.Sprintf("%s.v%d", r.Queue, shard)
+}
+
+// BindingKey ключ привязки виртуальной очереди с номером shard к exchange
+func (r TenantRouting) BindingKey(shard int) string {
+	return fmt.Sprintf("v%d.*", shard)
+}
+
+// Queues имена всех виртуальных очередей
+func (r TenantRouting) Queues() []string {
+	if !r.Enabled() {
+		return nil
+	}
+	queues := make([]string, 0, r.VirtualQueues)
+	for shard := 0; shard < r.VirtualQueues; shard++ {
+		queues = append(queues, r.QueueName(shard))
+	}
+	return queues
+}
+
+// WithTenant публикует задачу tenant в его виртуальную очередь; без включенной маршрутизации
+// сообщение публикуется как обычно и только помечается заголовком tenant
+func WithTenant(routing TenantRouting, tenantID string) PublishOption {
+	return func(opts *PublishOptions) {
+		if routing.Enabled() {
+			opts.Exchange = routing.Exchange()
+			opts.RoutingKey = routing.RoutingKey(tenantID)
+		}
+		if opts.Headers == nil {
+			opts.Headers = amqp091.Table{}
+		}
+		opts.Headers[HeaderTenantID] = tenantID
+	}
+}
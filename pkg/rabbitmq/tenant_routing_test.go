@@ -0,0 +1,85 @@
+package rabbitmq
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// TestTenantRouting проверяет распределение tenant по виртуальным очередям
+func TestTenantRouting(t *testing.T) {
+	routing := NewTenantRouting("uptime_checks", 4)
+	if !routing.Enabled() || routing.Exchange() != "uptime_checks" {
+		t.Fatalf("Unexpected routing: %+v", routing)
+	}
+
+	// Задачи одного tenant всегда попадают в одну виртуальную очередь
+	shard := routing.Shard("tenant-1")
+	if shard < 0 || shard >= 4 || routing.Shard("tenant-1") != shard {
+		t.Errorf("Unexpected shard %d", shard)
+	}
+	shards := make(map[int]bool)
+	for _, tenant := range []string{"tenant-1", "tenant-2", "tenant-3", "tenant-4", "tenant-5", "tenant-6", "tenant-7", "tenant-8"} {
+		shards[routing.Shard(tenant)] = true
+	}
+	if len(shards) < 2 {
+		t.Errorf("Expected tenants to be spread across virtual queues, got %v", shards)
+	}
+
+	if key, want := routing.RoutingKey("acme.eu"), fmt.Sprintf("v%d.acme_eu", routing.Shard("acme.eu")); key != want {
+		t.Errorf("Expected routing key %q, got %q", want, key)
+	}
+	if key, want := routing.RoutingKey(""), fmt.Sprintf("v%d.unknown", routing.Shard("")); key != want {
+		t.Errorf("Expected routing key %q, got %q", want, key)
+	}
+	if binding := routing.BindingKey(3); binding != "v3.*" {
+		t.Errorf("Unexpected binding key %q", binding)
+	}
+	if name := routing.QueueName(2); name != "uptime_checks.v2" {
+		t.Errorf("Unexpected virtual queue name %q", name)
+	}
+	if queues := routing.Queues(); len(queues) != 4 || queues[0] != "uptime_checks.v0" {
+		t.Errorf("Unexpected virtual queues %v", queues)
+	}
+
+	disabled := NewTenantRouting("uptime_checks", 0)
+	if disabled.Enabled() || disabled.Queues() != nil {
+		t.Errorf("Expected routing to be disabled: %+v", disabled)
+	}
+}
+
+// TestWithTenant проверяет опцию публикации задачи tenant
+func TestWithTenant(t *testing.T) {
+	routing := NewTenantRouting("uptime_checks", 4)
+	opts := &PublishOptions{Exchange: "notifications", RoutingKey: "notification.events"}
+	WithTenant(routing, "tenant-1")(opts)
+	if opts.Exchange != "uptime_checks" || opts.RoutingKey != routing.RoutingKey("tenant-1") {
+		t.Errorf("Unexpected publish options: %+v", opts)
+	}
+	if opts.Headers[HeaderTenantID] != "tenant-1" {
+		t.Errorf("Expected tenant header, got %v", opts.Headers)
+	}
+
+	// Без маршрутизации сообщение публикуется в exchange и очередь по умолчанию
+	opts = &PublishOptions{Exchange: "", RoutingKey: "uptime_checks", Headers: amqp091.Table{"x-source": "scheduler"}}
+	WithTenant(NewTenantRouting("uptime_checks", 0), "tenant-1")(opts)
+	if opts.Exchange != "" || opts.RoutingKey != "uptime_checks" || opts.Headers["x-source"] != "scheduler" || opts.Headers[HeaderTenantID] != "tenant-1" {
+		t.Errorf("Unexpected publish options: %+v", opts)
+	}
+}
+
+// TestConsumer_RegisterBinding проверяет регистрацию очереди со своим ключом привязки
+func TestConsumer_RegisterBinding(t *testing.T) {
+	consumer := NewConsumer(&Connection{}, NewConfig())
+	consumer.RegisterBinding("uptime_checks.v1", "v1.*", func(ctx context.Context, msg amqp091.Delivery) error {
+		return nil
+	})
+	if _, exists := consumer.handlers["uptime_checks.v1"]; !exists {
+		t.Error("Expected handler to be registered for virtual queue")
+	}
+	if consumer.bindings["uptime_checks.v1"] != "v1.*" {
+		t.Errorf("Unexpected binding %q", consumer.bindings["uptime_checks.v1"])
+	}
+}
@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustedProxies список доверенных прокси, чьим заголовкам X-Forwarded-For и X-Real-IP можно верить.
+// Нулевое значение (и nil) не доверяет никому: клиентом считается RemoteAddr
+type TrustedProxies struct {
+	networks []*net.IPNet
+}
+
+// ParseTrustedProxies разбирает список CIDR подсетей; одиночный IP адрес трактуется как /32 (/128 для IPv6)
+func ParseTrustedProxies(cidrs []string) (*TrustedProxies, error) {
+	proxies := &TrustedProxies{}
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address: %q", cidr)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			proxies.networks = append(proxies.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy CIDR %q: %w", cidr, err)
+		}
+		proxies.networks = append(proxies.networks, network)
+	}
+	return proxies, nil
+}
+
+// Contains проверяет, принадлежит ли адрес доверенному прокси
+func (p *TrustedProxies) Contains(ip net.IP) bool {
+	if p == nil || ip == nil {
+		return false
+	}
+	for _, network := range p.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP определяет IP адрес клиента для rate limiting.
+//
+// Заголовки пересылки учитываются, только если запрос пришел от доверенного прокси.
+// X-Forwarded-For разбирается справа налево: доверенные прокси пропускаются,
+// первый недоверенный адрес считается клиентом. Левые записи может подделать сам
+// клиент, поэтому им верят, только если все хопы правее доверенные
+func (p *TrustedProxies) ClientIP(r *http.Request) string {
+	remote := parseHostIP(r.RemoteAddr)
+	if remote == nil {
+		return r.RemoteAddr
+	}
+	if !p.Contains(remote) {
+		return remote.String()
+	}
+
+	if hops := forwardedHops(r); len(hops) > 0 {
+		client := remote
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := parseHostIP(hops[i])
+			if ip == nil {
+				// Мусор в цепочке: дальше доверять нельзя, клиент - последний проверенный хоп
+				break
+			}
+			client = ip
+			if !p.Contains(ip) {
+				break
+			}
+		}
+		return client.String()
+	}
+
+	if ip := parseHostIP(r.Header.Get("X-Real-IP")); ip != nil {
+		return ip.String()
+	}
+
+	return remote.String()
+}
+
+// ClientIP определяет IP адрес клиента без доверенных прокси; эквивалентно (*TrustedProxies)(nil).ClientIP
+func ClientIP(r *http.Request) string {
+	var proxies *TrustedProxies
+	return proxies.ClientIP(r)
+}
+
+// forwardedHops возвращает все адреса из заголовков X-Forwarded-For в порядке следования
+func forwardedHops(r *http.Request) []string {
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(header, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	return hops
+}
+
+// parseHostIP разбирает IP адрес с необязательным портом ("1.2.3.4", "1.2.3.4:80", "[::1]:80")
+func parseHostIP(value string) net.IP {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	return net.ParseIP(strings.Trim(value, "[]"))
+}
@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", " 192.168.1.5 ", "", "fd00::/8"})
+	require.NoError(t, err)
+
+	assert.True(t, proxies.Contains(net.ParseIP("10.1.2.3")))
+	assert.True(t, proxies.Contains(net.ParseIP("192.168.1.5")))
+	assert.False(t, proxies.Contains(net.ParseIP("192.168.1.6")))
+	assert.True(t, proxies.Contains(net.ParseIP("fd00::1")))
+	assert.False(t, proxies.Contains(net.ParseIP("8.8.8.8")))
+
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+	_, err = ParseTrustedProxies([]string{"proxy.local"})
+	assert.Error(t, err)
+}
+
+func TestTrustedProxies_ClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		proxies    *TrustedProxies
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		expected   string
+	}{
+		{
+			name:       "untrusted remote ignores forwarding headers",
+			proxies:    proxies,
+			remoteAddr: "203.0.113.7:4321",
+			forwarded:  []string{"1.1.1.1"},
+			realIP:     "2.2.2.2",
+			expected:   "203.0.113.7",
+		},
+		{
+			name:       "no trusted proxies configured",
+			proxies:    nil,
+			remoteAddr: "10.0.0.1:4321",
+			forwarded:  []string{"1.1.1.1"},
+			expected:   "10.0.0.1",
+		},
+		{
+			name:       "trusted proxy forwards client",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			forwarded:  []string{"198.51.100.9"},
+			expected:   "198.51.100.9",
+		},
+		{
+			name:       "spoofed leftmost entry is ignored",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			forwarded:  []string{"1.1.1.1, 198.51.100.9"},
+			expected:   "198.51.100.9",
+		},
+		{
+			name:       "chain of trusted proxies",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			forwarded:  []string{"198.51.100.9, 10.0.0.3", "10.0.0.2"},
+			expected:   "198.51.100.9",
+		},
+		{
+			name:       "all hops trusted uses leftmost",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			forwarded:  []string{"10.0.0.5, 10.0.0.3"},
+			expected:   "10.0.0.5",
+		},
+		{
+			name:       "garbage hop stops at last trusted proxy",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			forwarded:  []string{"1.1.1.1, not-an-ip, 10.0.0.3"},
+			expected:   "10.0.0.3",
+		},
+		{
+			name:       "hop with port",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			forwarded:  []string{"198.51.100.9:5555"},
+			expected:   "198.51.100.9",
+		},
+		{
+			name:       "real ip from trusted proxy",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			realIP:     "198.51.100.10",
+			expected:   "198.51.100.10",
+		},
+		{
+			name:       "invalid real ip falls back to remote",
+			proxies:    proxies,
+			remoteAddr: "10.0.0.1:4321",
+			realIP:     "bogus",
+			expected:   "10.0.0.1",
+		},
+		{
+			name:       "ipv6 remote",
+			proxies:    nil,
+			remoteAddr: "[2001:db8::1]:4321",
+			expected:   "2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-IP", tt.realIP)
+			}
+
+			assert.Equal(t, tt.expected, tt.proxies.ClientIP(req))
+		})
+	}
+}
+
+func TestClientIP_IgnoresHeaders(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "203.0.113.7:4321"
+	req.Header.Set("X-Forwarded-For", "1.1.1.1")
+
+	assert.Equal(t, "203.0.113.7", ClientIP(req))
+}
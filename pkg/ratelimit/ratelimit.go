@@ -3,6 +3,7 @@ package ratelimit
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -15,6 +16,55 @@ type RateLimiter interface {
 	CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
 }
 
+// DefaultWarnThreshold доля бюджета, после которой клиент получает предупреждение
+const DefaultWarnThreshold = 0.8
+
+// Usage расход бюджета ключа в текущем окне
+type Usage struct {
+	// Limit бюджет запросов на окно
+	Limit int
+	// Used число запросов в окне, включая текущий, если он разрешен
+	Used int
+	// ResetAfter время до начала следующего окна
+	ResetAfter time.Duration
+	// Exceeded лимит превышен, запрос отклонен
+	Exceeded bool
+}
+
+// Remaining число запросов, оставшихся в окне
+func (u Usage) Remaining() int {
+	if u.Used >= u.Limit {
+		return 0
+	}
+	return u.Limit - u.Used
+}
+
+// WarnAt номер запроса, с которого начинается зона предупреждения; 0 - зона не задана
+func (u Usage) WarnAt(threshold float64) int {
+	if threshold <= 0 || u.Limit <= 0 {
+		return 0
+	}
+	return int(math.Ceil(threshold * float64(u.Limit)))
+}
+
+// Warning запрос разрешен, но клиент израсходовал не меньше threshold бюджета
+func (u Usage) Warning(threshold float64) bool {
+	warnAt := u.WarnAt(threshold)
+	return !u.Exceeded && warnAt > 0 && u.Used >= warnAt
+}
+
+// EnteredWarning запрос первым в окне попал в зону предупреждения
+func (u Usage) EnteredWarning(threshold float64) bool {
+	return u.Warning(threshold) && u.Used == u.WarnAt(threshold)
+}
+
+// UsageLimiter RateLimiter, сообщающий расход бюджета для заголовков и предупреждений
+type UsageLimiter interface {
+	RateLimiter
+	// Consume учитывает запрос и возвращает расход бюджета ключа
+	Consume(ctx context.Context, key string, limit int, window time.Duration) (Usage, error)
+}
+
 // RedisRateLimiter реализация RateLimiter с использованием Redis
 type RedisRateLimiter struct {
 	client *redis.Client
@@ -27,23 +77,42 @@ func NewRedisRateLimiter(client *redis.Client) *RedisRateLimiter {
 
 // CheckRateLimit проверяет лимит запросов для заданного ключа
 func (r *RedisRateLimiter) CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
-	redisKey := fmt.Sprintf("rate_limit:%s", key)
+	usage, err := r.Consume(ctx, key, limit, window)
+	if err != nil {
+		return true, err
+	}
+	return usage.Exceeded, nil
+}
 
-	// Lua-скрипт для атомарной проверки и увеличения счетчика
-	script := `
-		local current = redis.call('GET', KEYS[1])
-		if current and tonumber(current) >= tonumber(ARGV[1]) then
-			return 1
-		end
-		redis.call('INCR', KEYS[1])
-		redis.call('EXPIRE', KEYS[1], ARGV[2])
-		return 0
-	`
+// consumeScript атомарно проверяет и увеличивает счетчик окна.
+// Возвращает число запросов в окне, остаток окна в миллисекундах и признак превышения
+const consumeScript = `
+	local current = tonumber(redis.call('GET', KEYS[1]) or '0')
+	if current >= tonumber(ARGV[1]) then
+		return {current, redis.call('PTTL', KEYS[1]), 1}
+	end
+	current = redis.call('INCR', KEYS[1])
+	if current == 1 then
+		redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	end
+	return {current, redis.call('PTTL', KEYS[1]), 0}
+`
+
+// Consume учитывает запрос в окне фиксированной длины, которое начинается с первого запроса
+func (r *RedisRateLimiter) Consume(ctx context.Context, key string, limit int, window time.Duration) (Usage, error) {
+	redisKey := fmt.Sprintf("rate_limit:%s", key)
 
-	result, err := r.client.Eval(ctx, script, []string{redisKey}, limit, window.Seconds()).Int64()
+	result, err := r.client.Eval(ctx, consumeScript, []string{redisKey}, limit, window.Milliseconds()).Int64Slice()
 	if err != nil {
-		return true, fmt.Errorf("failed to execute rate limit script: %w", err)
+		return Usage{Limit: limit, Exceeded: true}, fmt.Errorf("failed to execute rate limit script: %w", err)
+	}
+	if len(result) != 3 {
+		return Usage{Limit: limit, Exceeded: true}, fmt.Errorf("unexpected rate limit script result: %v", result)
 	}
 
-	return result == 1, nil
+	usage := Usage{Limit: limit, Used: int(result[0]), Exceeded: result[2] == 1}
+	if result[1] > 0 {
+		usage.ResetAfter = time.Duration(result[1]) * time.Millisecond
+	}
+	return usage, nil
 }
@@ -0,0 +1,28 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsage_Warning(t *testing.T) {
+	usage := Usage{Limit: 10}
+
+	for _, tt := range []struct {
+		used int
+		want bool
+	}{{1, false}, {7, false}, {8, true}, {10, true}} {
+		usage.Used = tt.used
+		assert.Equal(t, tt.want, usage.Warning(DefaultWarnThreshold), "used %d", tt.used)
+		assert.Equal(t, tt.used == 8, usage.EnteredWarning(DefaultWarnThreshold), "used %d", tt.used)
+	}
+	assert.Equal(t, 0, usage.Remaining())
+
+	usage.Exceeded = true
+	assert.False(t, usage.Warning(DefaultWarnThreshold), "rejected requests are not warnings")
+
+	usage = Usage{Limit: 10, Used: 9}
+	assert.False(t, usage.Warning(0), "zero threshold disables warnings")
+	assert.Equal(t, 1, usage.Remaining())
+}
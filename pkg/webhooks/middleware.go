@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// DefaultMaxBodySize наибольший размер тела проверяемого запроса
+const DefaultMaxBodySize = 1 << 20
+
+// Middleware пропускает только запросы с верной подписью. Тело читается целиком
+// (не больше maxBodySize, 0 - DefaultMaxBodySize) и восстанавливается для обработчика
+func Middleware(verifier *Verifier, maxBodySize int64) func(http.Handler) http.Handler {
+	if maxBodySize <= 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+			if err != nil {
+				http.Error(w, "Failed to read request body", http.StatusBadRequest)
+				return
+			}
+			if int64(len(body)) > maxBodySize {
+				http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			if err := verifier.Verify(r.Context(), r.Header, body); err != nil {
+				http.Error(w, err.Error(), StatusCode(err))
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// StatusCode HTTP статус ответа на ошибку проверки подписи: 409 для повтора,
+// 401 для неверной подписи и 503, если недоступен кэш повторов
+func StatusCode(err error) int {
+	switch {
+	case errors.Is(err, ErrReplayed):
+		return http.StatusConflict
+	case errors.Is(err, ErrMissingSignature), errors.Is(err, ErrInvalidTimestamp),
+		errors.Is(err, ErrStaleTimestamp), errors.Is(err, ErrInvalidSignature):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusServiceUnavailable
+	}
+}
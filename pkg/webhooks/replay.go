@@ -0,0 +1,70 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// ReplayCache запоминает уже принятые webhook
+type ReplayCache interface {
+	// MarkSeen запоминает ключ на ttl; возвращает false, если ключ уже был запомнен
+	MarkSeen(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+// RedisReplayCache ReplayCache в Redis, общий для всех реплик сервиса
+type RedisReplayCache struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisReplayCache создает кэш повторов в Redis; prefix отделяет ключи разных приемников
+func NewRedisReplayCache(client *redis.Client, prefix string) *RedisReplayCache {
+	return &RedisReplayCache{client: client, prefix: prefix}
+}
+
+// MarkSeen атомарно запоминает ключ через SET NX
+func (c *RedisReplayCache) MarkSeen(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	first, err := c.client.SetNX(ctx, fmt.Sprintf("webhook_replay:%s:%s", c.prefix, key), 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check webhook replay: %w", err)
+	}
+	return first, nil
+}
+
+// MemoryReplayCache ReplayCache в памяти процесса для одной реплики и тестов
+type MemoryReplayCache struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+	now  func() time.Time
+}
+
+// NewMemoryReplayCache создает кэш повторов в памяти
+func NewMemoryReplayCache() *MemoryReplayCache {
+	return &MemoryReplayCache{
+		seen: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+// MarkSeen запоминает ключ; просроченные ключи удаляются при каждом вызове
+func (c *MemoryReplayCache) MarkSeen(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for k, expiresAt := range c.seen {
+		if !now.Before(expiresAt) {
+			delete(c.seen, k)
+		}
+	}
+
+	if _, ok := c.seen[key]; ok {
+		return false, nil
+	}
+	c.seen[key] = now.Add(ttl)
+	return true, nil
+}
@@ -0,0 +1,183 @@
+// Package webhooks реализует подпись исходящих webhook HMAC-SHA256 и проверку подписи
+// входящих запросов с ограничением возраста и защитой от повторной доставки
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTolerance допустимое расхождение времени подписи и времени получения
+const DefaultTolerance = 5 * time.Minute
+
+// Ошибки проверки подписи
+var (
+	ErrMissingSignature = errors.New("missing webhook signature headers")
+	ErrInvalidTimestamp = errors.New("invalid webhook timestamp")
+	ErrStaleTimestamp   = errors.New("webhook timestamp is outside the tolerance window")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrReplayed         = errors.New("webhook has already been received")
+)
+
+// Scheme формат подписи: заголовки с временем и подписью и версия схемы.
+// Подписывается строка "<version>:<timestamp>:<body>", подпись передается как "<version>=<hex>"
+type Scheme struct {
+	TimestampHeader string
+	SignatureHeader string
+	Version         string
+}
+
+// DefaultScheme схема подписи webhook платформы
+var DefaultScheme = Scheme{
+	TimestampHeader: "X-UptimePing-Timestamp",
+	SignatureHeader: "X-UptimePing-Signature",
+	Version:         "v1",
+}
+
+// SlackScheme схема подписи запросов Slack (X-Slack-Signature)
+var SlackScheme = Scheme{
+	TimestampHeader: "X-Slack-Request-Timestamp",
+	SignatureHeader: "X-Slack-Signature",
+	Version:         "v0",
+}
+
+// Sign вычисляет подпись тела body, отправленного в момент timestamp (unix секунды)
+func (s Scheme) Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(s.Version + ":" + strconv.FormatInt(timestamp, 10) + ":"))
+	mac.Write(body)
+	return s.Version + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Signer подписывает исходящие webhook
+type Signer struct {
+	scheme Scheme
+	secret string
+	now    func() time.Time
+}
+
+// NewSigner создает подпись исходящих webhook в схеме DefaultScheme
+func NewSigner(secret string) *Signer {
+	return &Signer{
+		scheme: DefaultScheme,
+		secret: secret,
+		now:    time.Now,
+	}
+}
+
+// WithScheme задает схему подписи
+func (s *Signer) WithScheme(scheme Scheme) *Signer {
+	s.scheme = scheme
+	return s
+}
+
+// WithClock задает источник текущего времени
+func (s *Signer) WithClock(now func() time.Time) *Signer {
+	s.now = now
+	return s
+}
+
+// SignRequest устанавливает заголовки времени и подписи тела body запроса req
+func (s *Signer) SignRequest(req *http.Request, body []byte) {
+	timestamp := s.now().Unix()
+	req.Header.Set(s.scheme.TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(s.scheme.SignatureHeader, s.scheme.Sign(s.secret, timestamp, body))
+}
+
+// Verifier проверяет подпись входящих webhook. Принимается подпись любым из секретов,
+// что позволяет менять секрет без простоя
+type Verifier struct {
+	scheme    Scheme
+	secrets   []string
+	tolerance time.Duration
+	replay    ReplayCache
+	now       func() time.Time
+}
+
+// NewVerifier создает проверку подписи в схеме DefaultScheme с допуском DefaultTolerance
+func NewVerifier(secrets ...string) *Verifier {
+	return &Verifier{
+		scheme:    DefaultScheme,
+		secrets:   secrets,
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}
+}
+
+// WithScheme задает схему подписи
+func (v *Verifier) WithScheme(scheme Scheme) *Verifier {
+	v.scheme = scheme
+	return v
+}
+
+// WithTolerance задает допустимое расхождение времени подписи
+func (v *Verifier) WithTolerance(tolerance time.Duration) *Verifier {
+	v.tolerance = tolerance
+	return v
+}
+
+// WithReplayCache включает отклонение повторно доставленных запросов; подпись
+// запоминается на удвоенный допуск, после чего запрос отклоняется по времени
+func (v *Verifier) WithReplayCache(cache ReplayCache) *Verifier {
+	v.replay = cache
+	return v
+}
+
+// WithClock задает источник текущего времени
+func (v *Verifier) WithClock(now func() time.Time) *Verifier {
+	v.now = now
+	return v
+}
+
+// Verify проверяет время и подпись запроса с заголовками header и телом body
+func (v *Verifier) Verify(ctx context.Context, header http.Header, body []byte) error {
+	timestamp := header.Get(v.scheme.TimestampHeader)
+	signature := header.Get(v.scheme.SignatureHeader)
+	if timestamp == "" || signature == "" {
+		return ErrMissingSignature
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	skew := v.now().Sub(time.Unix(seconds, 0))
+	if skew > v.tolerance || skew < -v.tolerance {
+		return ErrStaleTimestamp
+	}
+
+	if !v.matches(seconds, body, signature) {
+		return ErrInvalidSignature
+	}
+
+	if v.replay != nil {
+		first, err := v.replay.MarkSeen(ctx, v.scheme.Version+":"+strings.TrimPrefix(signature, v.scheme.Version+"="), 2*v.tolerance)
+		if err != nil {
+			return err
+		}
+		if !first {
+			return ErrReplayed
+		}
+	}
+	return nil
+}
+
+// matches сравнивает подпись с ожидаемой для каждого секрета за постоянное время
+func (v *Verifier) matches(timestamp int64, body []byte, signature string) bool {
+	for _, secret := range v.secrets {
+		if secret == "" {
+			continue
+		}
+		if hmac.Equal([]byte(v.scheme.Sign(secret, timestamp, body)), []byte(signature)) {
+			return true
+		}
+	}
+	return false
+}
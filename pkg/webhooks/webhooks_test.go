@@ -0,0 +1,123 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheme_Sign_Slack(t *testing.T) {
+	// Пример из документации Slack о проверке запросов
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c")
+	signature := SlackScheme.Sign("8f742231b10e8888abcd99yyyzzz85a5", 1531420618, body)
+	assert.Equal(t, "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503", signature)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	now := time.Unix(1760000000, 0)
+	body := []byte(`{"event":"incident.opened"}`)
+
+	signed := func(secret string, at time.Time) http.Header {
+		header := http.Header{}
+		header.Set(DefaultScheme.TimestampHeader, strconv.FormatInt(at.Unix(), 10))
+		header.Set(DefaultScheme.SignatureHeader, DefaultScheme.Sign(secret, at.Unix(), body))
+		return header
+	}
+
+	verifier := NewVerifier("old-secret", "new-secret").WithClock(func() time.Time { return now })
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   []byte
+		err    error
+	}{
+		{"valid", signed("new-secret", now), body, nil},
+		{"rotated secret", signed("old-secret", now.Add(-time.Minute)), body, nil},
+		{"missing headers", http.Header{}, body, ErrMissingSignature},
+		{"wrong secret", signed("other-secret", now), body, ErrInvalidSignature},
+		{"tampered body", signed("new-secret", now), []byte(`{"event":"incident.resolved"}`), ErrInvalidSignature},
+		{"stale", signed("new-secret", now.Add(-10*time.Minute)), body, ErrStaleTimestamp},
+		{"from the future", signed("new-secret", now.Add(10*time.Minute)), body, ErrStaleTimestamp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifier.Verify(context.Background(), tt.header, tt.body)
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+
+	header := signed("new-secret", now)
+	header.Set(DefaultScheme.TimestampHeader, "yesterday")
+	assert.ErrorIs(t, verifier.Verify(context.Background(), header, body), ErrInvalidTimestamp)
+}
+
+func TestVerifier_ReplayCache(t *testing.T) {
+	now := time.Unix(1760000000, 0)
+	cache := NewMemoryReplayCache()
+	cache.now = func() time.Time { return now }
+
+	clock := func() time.Time { return now }
+	verifier := NewVerifier("secret").WithReplayCache(cache).WithClock(clock)
+	signer := NewSigner("secret").WithClock(clock)
+	req := httptest.NewRequest(http.MethodPost, "/hook", nil)
+	signer.SignRequest(req, []byte("payload"))
+
+	require.NoError(t, verifier.Verify(context.Background(), req.Header, []byte("payload")))
+	assert.ErrorIs(t, verifier.Verify(context.Background(), req.Header, []byte("payload")), ErrReplayed)
+
+	// После удвоенного допуска ключ забывается, а запрос отклоняется уже по времени
+	cache.now = func() time.Time { return now.Add(2*DefaultTolerance + time.Second) }
+	first, err := cache.MarkSeen(context.Background(), "other", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, first)
+	assert.Len(t, cache.seen, 1)
+}
+
+// failingReplayCache недоступный кэш повторов
+type failingReplayCache struct{}
+
+func (failingReplayCache) MarkSeen(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return false, errors.New("redis: connection refused")
+}
+
+func TestMiddleware(t *testing.T) {
+	var received string
+	handler := Middleware(NewVerifier("secret"), 64)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	send := func(body string, sign bool) int {
+		req := httptest.NewRequest(http.MethodPost, "/hook", strings.NewReader(body))
+		if sign {
+			NewSigner("secret").SignRequest(req, []byte(body))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, send(`{"ok":true}`, true))
+	assert.Equal(t, `{"ok":true}`, received, "body is restored for the handler")
+	assert.Equal(t, http.StatusUnauthorized, send(`{"ok":true}`, false))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, send(strings.Repeat("x", 65), true))
+
+	// Без кэша повторов запрос нельзя безопасно принять
+	handler = Middleware(NewVerifier("secret").WithReplayCache(failingReplayCache{}), 0)(handler)
+	assert.Equal(t, http.StatusServiceUnavailable, send(`{"ok":true}`, true))
+}
@@ -283,3 +283,189 @@ var ConfigService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/api/config/v1/config.proto",
 }
+
+const (
+	TenantSettingsService_GetTenantSettings_FullMethodName    = "/uptimeping.config.v1.TenantSettingsService/GetTenantSettings"
+	TenantSettingsService_UpdateTenantSettings_FullMethodName = "/uptimeping.config.v1.TenantSettingsService/UpdateTenantSettings"
+	TenantSettingsService_ResetTenantSettings_FullMethodName  = "/uptimeping.config.v1.TenantSettingsService/ResetTenantSettings"
+)
+
+// TenantSettingsServiceClient is the client API for TenantSettingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TenantSettingsService предоставляет настройки арендатора, применяемые сервисами во время работы
+type TenantSettingsServiceClient interface {
+	// GetTenantSettings возвращает настройки арендатора; без сохраненных настроек - значения по умолчанию
+	GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*TenantSettings, error)
+	// UpdateTenantSettings заменяет настройки арендатора
+	UpdateTenantSettings(ctx context.Context, in *UpdateTenantSettingsRequest, opts ...grpc.CallOption) (*TenantSettings, error)
+	// ResetTenantSettings удаляет настройки арендатора, возвращая значения по умолчанию
+	ResetTenantSettings(ctx context.Context, in *ResetTenantSettingsRequest, opts ...grpc.CallOption) (*TenantSettings, error)
+}
+
+type tenantSettingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantSettingsServiceClient(cc grpc.ClientConnInterface) TenantSettingsServiceClient {
+	return &tenantSettingsServiceClient{cc}
+}
+
+func (c *tenantSettingsServiceClient) GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*TenantSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantSettings)
+	err := c.cc.Invoke(ctx, TenantSettingsService_GetTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantSettingsServiceClient) UpdateTenantSettings(ctx context.Context, in *UpdateTenantSettingsRequest, opts ...grpc.CallOption) (*TenantSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantSettings)
+	err := c.cc.Invoke(ctx, TenantSettingsService_UpdateTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantSettingsServiceClient) ResetTenantSettings(ctx context.Context, in *ResetTenantSettingsRequest, opts ...grpc.CallOption) (*TenantSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantSettings)
+	err := c.cc.Invoke(ctx, TenantSettingsService_ResetTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantSettingsServiceServer is the server API for TenantSettingsService service.
+// All implementations should embed UnimplementedTenantSettingsServiceServer
+// for forward compatibility.
+//
+// TenantSettingsService предоставляет настройки арендатора, применяемые сервисами во время работы
+type TenantSettingsServiceServer interface {
+	// GetTenantSettings возвращает настройки арендатора; без сохраненных настроек - значения по умолчанию
+	GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*TenantSettings, error)
+	// UpdateTenantSettings заменяет настройки арендатора
+	UpdateTenantSettings(context.Context, *UpdateTenantSettingsRequest) (*TenantSettings, error)
+	// ResetTenantSettings удаляет настройки арендатора, возвращая значения по умолчанию
+	ResetTenantSettings(context.Context, *ResetTenantSettingsRequest) (*TenantSettings, error)
+}
+
+// UnimplementedTenantSettingsServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantSettingsServiceServer struct{}
+
+func (UnimplementedTenantSettingsServiceServer) GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*TenantSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantSettings not implemented")
+}
+func (UnimplementedTenantSettingsServiceServer) UpdateTenantSettings(context.Context, *UpdateTenantSettingsRequest) (*TenantSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantSettings not implemented")
+}
+func (UnimplementedTenantSettingsServiceServer) ResetTenantSettings(context.Context, *ResetTenantSettingsRequest) (*TenantSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTenantSettings not implemented")
+}
+func (UnimplementedTenantSettingsServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTenantSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantSettingsServiceServer will
+// result in compilation errors.
+type UnsafeTenantSettingsServiceServer interface {
+	mustEmbedUnimplementedTenantSettingsServiceServer()
+}
+
+func RegisterTenantSettingsServiceServer(s grpc.ServiceRegistrar, srv TenantSettingsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenantSettingsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenantSettingsService_ServiceDesc, srv)
+}
+
+func _TenantSettingsService_GetTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantSettingsServiceServer).GetTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantSettingsService_GetTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantSettingsServiceServer).GetTenantSettings(ctx, req.(*GetTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantSettingsService_UpdateTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantSettingsServiceServer).UpdateTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantSettingsService_UpdateTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantSettingsServiceServer).UpdateTenantSettings(ctx, req.(*UpdateTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantSettingsService_ResetTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantSettingsServiceServer).ResetTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantSettingsService_ResetTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantSettingsServiceServer).ResetTenantSettings(ctx, req.(*ResetTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantSettingsService_ServiceDesc is the grpc.ServiceDesc for TenantSettingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantSettingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "uptimeping.config.v1.TenantSettingsService",
+	HandlerType: (*TenantSettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTenantSettings",
+			Handler:    _TenantSettingsService_GetTenantSettings_Handler,
+		},
+		{
+			MethodName: "UpdateTenantSettings",
+			Handler:    _TenantSettingsService_UpdateTenantSettings_Handler,
+		},
+		{
+			MethodName: "ResetTenantSettings",
+			Handler:    _TenantSettingsService_ResetTenantSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/api/config/v1/config.proto",
+}
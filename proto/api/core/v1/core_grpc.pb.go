@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CoreService_ExecuteCheck_FullMethodName    = "/uptimeping.core.v1.CoreService/ExecuteCheck"
-	CoreService_GetCheckStatus_FullMethodName  = "/uptimeping.core.v1.CoreService/GetCheckStatus"
-	CoreService_GetCheckHistory_FullMethodName = "/uptimeping.core.v1.CoreService/GetCheckHistory"
+	CoreService_ExecuteCheck_FullMethodName     = "/uptimeping.core.v1.CoreService/ExecuteCheck"
+	CoreService_GetCheckStatus_FullMethodName   = "/uptimeping.core.v1.CoreService/GetCheckStatus"
+	CoreService_GetCheckHistory_FullMethodName  = "/uptimeping.core.v1.CoreService/GetCheckHistory"
+	CoreService_RunCheck_FullMethodName         = "/uptimeping.core.v1.CoreService/RunCheck"
+	CoreService_GetUptimeHistory_FullMethodName = "/uptimeping.core.v1.CoreService/GetUptimeHistory"
+	CoreService_ListArtifacts_FullMethodName    = "/uptimeping.core.v1.CoreService/ListArtifacts"
+	CoreService_GetArtifactURL_FullMethodName   = "/uptimeping.core.v1.CoreService/GetArtifactURL"
 )
 
 // CoreServiceClient is the client API for CoreService service.
@@ -36,6 +40,16 @@ type CoreServiceClient interface {
 	GetCheckStatus(ctx context.Context, in *GetCheckStatusRequest, opts ...grpc.CallOption) (*CheckStatusResponse, error)
 	// GetCheckHistory возвращает историю выполнения проверки
 	GetCheckHistory(ctx context.Context, in *GetCheckHistoryRequest, opts ...grpc.CallOption) (*GetCheckHistoryResponse, error)
+	// RunCheck выполняет проверку по inline-определению без сохранения,
+	// передавая промежуточный прогресс (DNS, connect, TLS, first byte)
+	RunCheck(ctx context.Context, in *RunCheckRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunCheckProgress], error)
+	// GetUptimeHistory возвращает дневную историю доступности проверки или группы
+	// проверок (до 90 суток) из предрассчитанных сводок
+	GetUptimeHistory(ctx context.Context, in *GetUptimeHistoryRequest, opts ...grpc.CallOption) (*UptimeHistory, error)
+	// ListArtifacts возвращает неистекшие артефакты проверки: скриншоты, HAR, снимки ответов
+	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
+	// GetArtifactURL возвращает подписанную ссылку на чтение артефакта из хранилища
+	GetArtifactURL(ctx context.Context, in *GetArtifactURLRequest, opts ...grpc.CallOption) (*ArtifactURL, error)
 }
 
 type coreServiceClient struct {
@@ -76,6 +90,55 @@ func (c *coreServiceClient) GetCheckHistory(ctx context.Context, in *GetCheckHis
 	return out, nil
 }
 
+func (c *coreServiceClient) RunCheck(ctx context.Context, in *RunCheckRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunCheckProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CoreService_ServiceDesc.Streams[0], CoreService_RunCheck_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RunCheckRequest, RunCheckProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoreService_RunCheckClient = grpc.ServerStreamingClient[RunCheckProgress]
+
+func (c *coreServiceClient) GetUptimeHistory(ctx context.Context, in *GetUptimeHistoryRequest, opts ...grpc.CallOption) (*UptimeHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UptimeHistory)
+	err := c.cc.Invoke(ctx, CoreService_GetUptimeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArtifactsResponse)
+	err := c.cc.Invoke(ctx, CoreService_ListArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) GetArtifactURL(ctx context.Context, in *GetArtifactURLRequest, opts ...grpc.CallOption) (*ArtifactURL, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArtifactURL)
+	err := c.cc.Invoke(ctx, CoreService_GetArtifactURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoreServiceServer is the server API for CoreService service.
 // All implementations should embed UnimplementedCoreServiceServer
 // for forward compatibility.
@@ -88,6 +151,16 @@ type CoreServiceServer interface {
 	GetCheckStatus(context.Context, *GetCheckStatusRequest) (*CheckStatusResponse, error)
 	// GetCheckHistory возвращает историю выполнения проверки
 	GetCheckHistory(context.Context, *GetCheckHistoryRequest) (*GetCheckHistoryResponse, error)
+	// RunCheck выполняет проверку по inline-определению без сохранения,
+	// передавая промежуточный прогресс (DNS, connect, TLS, first byte)
+	RunCheck(*RunCheckRequest, grpc.ServerStreamingServer[RunCheckProgress]) error
+	// GetUptimeHistory возвращает дневную историю доступности проверки или группы
+	// проверок (до 90 суток) из предрассчитанных сводок
+	GetUptimeHistory(context.Context, *GetUptimeHistoryRequest) (*UptimeHistory, error)
+	// ListArtifacts возвращает неистекшие артефакты проверки: скриншоты, HAR, снимки ответов
+	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
+	// GetArtifactURL возвращает подписанную ссылку на чтение артефакта из хранилища
+	GetArtifactURL(context.Context, *GetArtifactURLRequest) (*ArtifactURL, error)
 }
 
 // UnimplementedCoreServiceServer should be embedded to have
@@ -106,6 +179,18 @@ func (UnimplementedCoreServiceServer) GetCheckStatus(context.Context, *GetCheckS
 func (UnimplementedCoreServiceServer) GetCheckHistory(context.Context, *GetCheckHistoryRequest) (*GetCheckHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCheckHistory not implemented")
 }
+func (UnimplementedCoreServiceServer) RunCheck(*RunCheckRequest, grpc.ServerStreamingServer[RunCheckProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method RunCheck not implemented")
+}
+func (UnimplementedCoreServiceServer) GetUptimeHistory(context.Context, *GetUptimeHistoryRequest) (*UptimeHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUptimeHistory not implemented")
+}
+func (UnimplementedCoreServiceServer) ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifacts not implemented")
+}
+func (UnimplementedCoreServiceServer) GetArtifactURL(context.Context, *GetArtifactURLRequest) (*ArtifactURL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtifactURL not implemented")
+}
 func (UnimplementedCoreServiceServer) testEmbeddedByValue() {}
 
 // UnsafeCoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +265,71 @@ func _CoreService_GetCheckHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreService_RunCheck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RunCheckRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServiceServer).RunCheck(m, &grpc.GenericServerStream[RunCheckRequest, RunCheckProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CoreService_RunCheckServer = grpc.ServerStreamingServer[RunCheckProgress]
+
+func _CoreService_GetUptimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUptimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).GetUptimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_GetUptimeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).GetUptimeHistory(ctx, req.(*GetUptimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).ListArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_ListArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).ListArtifacts(ctx, req.(*ListArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_GetArtifactURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).GetArtifactURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_GetArtifactURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).GetArtifactURL(ctx, req.(*GetArtifactURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CoreService_ServiceDesc is the grpc.ServiceDesc for CoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,7 +349,25 @@ var CoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCheckHistory",
 			Handler:    _CoreService_GetCheckHistory_Handler,
 		},
+		{
+			MethodName: "GetUptimeHistory",
+			Handler:    _CoreService_GetUptimeHistory_Handler,
+		},
+		{
+			MethodName: "ListArtifacts",
+			Handler:    _CoreService_ListArtifacts_Handler,
+		},
+		{
+			MethodName: "GetArtifactURL",
+			Handler:    _CoreService_GetArtifactURL_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RunCheck",
+			Handler:       _CoreService_RunCheck_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/api/core/v1/core.proto",
 }
@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IncidentService_CreateIncident_FullMethodName  = "/uptimeping.incident.v1.IncidentService/CreateIncident"
-	IncidentService_UpdateIncident_FullMethodName  = "/uptimeping.incident.v1.IncidentService/UpdateIncident"
-	IncidentService_ResolveIncident_FullMethodName = "/uptimeping.incident.v1.IncidentService/ResolveIncident"
-	IncidentService_ListIncidents_FullMethodName   = "/uptimeping.incident.v1.IncidentService/ListIncidents"
-	IncidentService_GetIncident_FullMethodName     = "/uptimeping.incident.v1.IncidentService/GetIncident"
+	IncidentService_CreateIncident_FullMethodName               = "/uptimeping.incident.v1.IncidentService/CreateIncident"
+	IncidentService_UpdateIncident_FullMethodName               = "/uptimeping.incident.v1.IncidentService/UpdateIncident"
+	IncidentService_ResolveIncident_FullMethodName              = "/uptimeping.incident.v1.IncidentService/ResolveIncident"
+	IncidentService_ListIncidents_FullMethodName                = "/uptimeping.incident.v1.IncidentService/ListIncidents"
+	IncidentService_GetIncident_FullMethodName                  = "/uptimeping.incident.v1.IncidentService/GetIncident"
+	IncidentService_IngestAlert_FullMethodName                  = "/uptimeping.incident.v1.IncidentService/IngestAlert"
+	IncidentService_AcknowledgeIncident_FullMethodName          = "/uptimeping.incident.v1.IncidentService/AcknowledgeIncident"
+	IncidentService_GetIncidentStats_FullMethodName             = "/uptimeping.incident.v1.IncidentService/GetIncidentStats"
+	IncidentService_GetIncidentHistory_FullMethodName           = "/uptimeping.incident.v1.IncidentService/GetIncidentHistory"
+	IncidentService_ListStatusComponents_FullMethodName         = "/uptimeping.incident.v1.IncidentService/ListStatusComponents"
+	IncidentService_CreateStatusComponent_FullMethodName        = "/uptimeping.incident.v1.IncidentService/CreateStatusComponent"
+	IncidentService_UpdateStatusComponent_FullMethodName        = "/uptimeping.incident.v1.IncidentService/UpdateStatusComponent"
+	IncidentService_DeleteStatusComponent_FullMethodName        = "/uptimeping.incident.v1.IncidentService/DeleteStatusComponent"
+	IncidentService_SetComponentStatusOverride_FullMethodName   = "/uptimeping.incident.v1.IncidentService/SetComponentStatusOverride"
+	IncidentService_ClearComponentStatusOverride_FullMethodName = "/uptimeping.incident.v1.IncidentService/ClearComponentStatusOverride"
+	IncidentService_ScheduleMaintenance_FullMethodName          = "/uptimeping.incident.v1.IncidentService/ScheduleMaintenance"
+	IncidentService_CancelMaintenance_FullMethodName            = "/uptimeping.incident.v1.IncidentService/CancelMaintenance"
+	IncidentService_ListMaintenances_FullMethodName             = "/uptimeping.incident.v1.IncidentService/ListMaintenances"
+	IncidentService_GetStatusPage_FullMethodName                = "/uptimeping.incident.v1.IncidentService/GetStatusPage"
 )
 
 // IncidentServiceClient is the client API for IncidentService service.
@@ -42,6 +56,34 @@ type IncidentServiceClient interface {
 	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
 	// GetIncident возвращает детали инцидента
 	GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*GetIncidentResponse, error)
+	// IngestAlert открывает, обновляет или закрывает инцидент по алерту внешней системы мониторинга
+	IngestAlert(ctx context.Context, in *IngestAlertRequest, opts ...grpc.CallOption) (*IngestAlertResponse, error)
+	// AcknowledgeIncident подтверждает инцидент, не закрывая его
+	AcknowledgeIncident(ctx context.Context, in *AcknowledgeIncidentRequest, opts ...grpc.CallOption) (*Incident, error)
+	// GetIncidentStats возвращает статистику инцидентов арендатора
+	GetIncidentStats(ctx context.Context, in *GetIncidentStatsRequest, opts ...grpc.CallOption) (*IncidentStats, error)
+	// GetIncidentHistory возвращает события жизненного цикла инцидента
+	GetIncidentHistory(ctx context.Context, in *GetIncidentHistoryRequest, opts ...grpc.CallOption) (*GetIncidentHistoryResponse, error)
+	// ListStatusComponents возвращает компоненты страницы статуса в порядке отображения
+	ListStatusComponents(ctx context.Context, in *ListStatusComponentsRequest, opts ...grpc.CallOption) (*ListStatusComponentsResponse, error)
+	// CreateStatusComponent создает компонент страницы статуса
+	CreateStatusComponent(ctx context.Context, in *CreateStatusComponentRequest, opts ...grpc.CallOption) (*StatusComponent, error)
+	// UpdateStatusComponent заменяет имя, описание, проверки и позицию компонента
+	UpdateStatusComponent(ctx context.Context, in *UpdateStatusComponentRequest, opts ...grpc.CallOption) (*StatusComponent, error)
+	// DeleteStatusComponent удаляет компонент
+	DeleteStatusComponent(ctx context.Context, in *DeleteStatusComponentRequest, opts ...grpc.CallOption) (*DeleteStatusComponentResponse, error)
+	// SetComponentStatusOverride вручную задает отображаемый статус компонента
+	SetComponentStatusOverride(ctx context.Context, in *SetComponentStatusOverrideRequest, opts ...grpc.CallOption) (*StatusComponent, error)
+	// ClearComponentStatusOverride снимает ручной статус компонента
+	ClearComponentStatusOverride(ctx context.Context, in *ClearComponentStatusOverrideRequest, opts ...grpc.CallOption) (*StatusComponent, error)
+	// ScheduleMaintenance публикует объявление о плановом обслуживании
+	ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceAnnouncement, error)
+	// CancelMaintenance отменяет объявление об обслуживании
+	CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceAnnouncement, error)
+	// ListMaintenances возвращает объявления об обслуживании
+	ListMaintenances(ctx context.Context, in *ListMaintenancesRequest, opts ...grpc.CallOption) (*ListMaintenancesResponse, error)
+	// GetStatusPage возвращает страницу статуса: статусы компонентов и обслуживания
+	GetStatusPage(ctx context.Context, in *GetStatusPageRequest, opts ...grpc.CallOption) (*StatusPage, error)
 }
 
 type incidentServiceClient struct {
@@ -102,6 +144,146 @@ func (c *incidentServiceClient) GetIncident(ctx context.Context, in *GetIncident
 	return out, nil
 }
 
+func (c *incidentServiceClient) IngestAlert(ctx context.Context, in *IngestAlertRequest, opts ...grpc.CallOption) (*IngestAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestAlertResponse)
+	err := c.cc.Invoke(ctx, IncidentService_IngestAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) AcknowledgeIncident(ctx context.Context, in *AcknowledgeIncidentRequest, opts ...grpc.CallOption) (*Incident, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Incident)
+	err := c.cc.Invoke(ctx, IncidentService_AcknowledgeIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) GetIncidentStats(ctx context.Context, in *GetIncidentStatsRequest, opts ...grpc.CallOption) (*IncidentStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncidentStats)
+	err := c.cc.Invoke(ctx, IncidentService_GetIncidentStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) GetIncidentHistory(ctx context.Context, in *GetIncidentHistoryRequest, opts ...grpc.CallOption) (*GetIncidentHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncidentHistoryResponse)
+	err := c.cc.Invoke(ctx, IncidentService_GetIncidentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) ListStatusComponents(ctx context.Context, in *ListStatusComponentsRequest, opts ...grpc.CallOption) (*ListStatusComponentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStatusComponentsResponse)
+	err := c.cc.Invoke(ctx, IncidentService_ListStatusComponents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) CreateStatusComponent(ctx context.Context, in *CreateStatusComponentRequest, opts ...grpc.CallOption) (*StatusComponent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusComponent)
+	err := c.cc.Invoke(ctx, IncidentService_CreateStatusComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) UpdateStatusComponent(ctx context.Context, in *UpdateStatusComponentRequest, opts ...grpc.CallOption) (*StatusComponent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusComponent)
+	err := c.cc.Invoke(ctx, IncidentService_UpdateStatusComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) DeleteStatusComponent(ctx context.Context, in *DeleteStatusComponentRequest, opts ...grpc.CallOption) (*DeleteStatusComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStatusComponentResponse)
+	err := c.cc.Invoke(ctx, IncidentService_DeleteStatusComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) SetComponentStatusOverride(ctx context.Context, in *SetComponentStatusOverrideRequest, opts ...grpc.CallOption) (*StatusComponent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusComponent)
+	err := c.cc.Invoke(ctx, IncidentService_SetComponentStatusOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) ClearComponentStatusOverride(ctx context.Context, in *ClearComponentStatusOverrideRequest, opts ...grpc.CallOption) (*StatusComponent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusComponent)
+	err := c.cc.Invoke(ctx, IncidentService_ClearComponentStatusOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) ScheduleMaintenance(ctx context.Context, in *ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceAnnouncement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceAnnouncement)
+	err := c.cc.Invoke(ctx, IncidentService_ScheduleMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceAnnouncement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceAnnouncement)
+	err := c.cc.Invoke(ctx, IncidentService_CancelMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) ListMaintenances(ctx context.Context, in *ListMaintenancesRequest, opts ...grpc.CallOption) (*ListMaintenancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenancesResponse)
+	err := c.cc.Invoke(ctx, IncidentService_ListMaintenances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incidentServiceClient) GetStatusPage(ctx context.Context, in *GetStatusPageRequest, opts ...grpc.CallOption) (*StatusPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusPage)
+	err := c.cc.Invoke(ctx, IncidentService_GetStatusPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IncidentServiceServer is the server API for IncidentService service.
 // All implementations should embed UnimplementedIncidentServiceServer
 // for forward compatibility.
@@ -118,6 +300,34 @@ type IncidentServiceServer interface {
 	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
 	// GetIncident возвращает детали инцидента
 	GetIncident(context.Context, *GetIncidentRequest) (*GetIncidentResponse, error)
+	// IngestAlert открывает, обновляет или закрывает инцидент по алерту внешней системы мониторинга
+	IngestAlert(context.Context, *IngestAlertRequest) (*IngestAlertResponse, error)
+	// AcknowledgeIncident подтверждает инцидент, не закрывая его
+	AcknowledgeIncident(context.Context, *AcknowledgeIncidentRequest) (*Incident, error)
+	// GetIncidentStats возвращает статистику инцидентов арендатора
+	GetIncidentStats(context.Context, *GetIncidentStatsRequest) (*IncidentStats, error)
+	// GetIncidentHistory возвращает события жизненного цикла инцидента
+	GetIncidentHistory(context.Context, *GetIncidentHistoryRequest) (*GetIncidentHistoryResponse, error)
+	// ListStatusComponents возвращает компоненты страницы статуса в порядке отображения
+	ListStatusComponents(context.Context, *ListStatusComponentsRequest) (*ListStatusComponentsResponse, error)
+	// CreateStatusComponent создает компонент страницы статуса
+	CreateStatusComponent(context.Context, *CreateStatusComponentRequest) (*StatusComponent, error)
+	// UpdateStatusComponent заменяет имя, описание, проверки и позицию компонента
+	UpdateStatusComponent(context.Context, *UpdateStatusComponentRequest) (*StatusComponent, error)
+	// DeleteStatusComponent удаляет компонент
+	DeleteStatusComponent(context.Context, *DeleteStatusComponentRequest) (*DeleteStatusComponentResponse, error)
+	// SetComponentStatusOverride вручную задает отображаемый статус компонента
+	SetComponentStatusOverride(context.Context, *SetComponentStatusOverrideRequest) (*StatusComponent, error)
+	// ClearComponentStatusOverride снимает ручной статус компонента
+	ClearComponentStatusOverride(context.Context, *ClearComponentStatusOverrideRequest) (*StatusComponent, error)
+	// ScheduleMaintenance публикует объявление о плановом обслуживании
+	ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceAnnouncement, error)
+	// CancelMaintenance отменяет объявление об обслуживании
+	CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*MaintenanceAnnouncement, error)
+	// ListMaintenances возвращает объявления об обслуживании
+	ListMaintenances(context.Context, *ListMaintenancesRequest) (*ListMaintenancesResponse, error)
+	// GetStatusPage возвращает страницу статуса: статусы компонентов и обслуживания
+	GetStatusPage(context.Context, *GetStatusPageRequest) (*StatusPage, error)
 }
 
 // UnimplementedIncidentServiceServer should be embedded to have
@@ -142,6 +352,48 @@ func (UnimplementedIncidentServiceServer) ListIncidents(context.Context, *ListIn
 func (UnimplementedIncidentServiceServer) GetIncident(context.Context, *GetIncidentRequest) (*GetIncidentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIncident not implemented")
 }
+func (UnimplementedIncidentServiceServer) IngestAlert(context.Context, *IngestAlertRequest) (*IngestAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IngestAlert not implemented")
+}
+func (UnimplementedIncidentServiceServer) AcknowledgeIncident(context.Context, *AcknowledgeIncidentRequest) (*Incident, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeIncident not implemented")
+}
+func (UnimplementedIncidentServiceServer) GetIncidentStats(context.Context, *GetIncidentStatsRequest) (*IncidentStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentStats not implemented")
+}
+func (UnimplementedIncidentServiceServer) GetIncidentHistory(context.Context, *GetIncidentHistoryRequest) (*GetIncidentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncidentHistory not implemented")
+}
+func (UnimplementedIncidentServiceServer) ListStatusComponents(context.Context, *ListStatusComponentsRequest) (*ListStatusComponentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatusComponents not implemented")
+}
+func (UnimplementedIncidentServiceServer) CreateStatusComponent(context.Context, *CreateStatusComponentRequest) (*StatusComponent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStatusComponent not implemented")
+}
+func (UnimplementedIncidentServiceServer) UpdateStatusComponent(context.Context, *UpdateStatusComponentRequest) (*StatusComponent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatusComponent not implemented")
+}
+func (UnimplementedIncidentServiceServer) DeleteStatusComponent(context.Context, *DeleteStatusComponentRequest) (*DeleteStatusComponentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatusComponent not implemented")
+}
+func (UnimplementedIncidentServiceServer) SetComponentStatusOverride(context.Context, *SetComponentStatusOverrideRequest) (*StatusComponent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetComponentStatusOverride not implemented")
+}
+func (UnimplementedIncidentServiceServer) ClearComponentStatusOverride(context.Context, *ClearComponentStatusOverrideRequest) (*StatusComponent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearComponentStatusOverride not implemented")
+}
+func (UnimplementedIncidentServiceServer) ScheduleMaintenance(context.Context, *ScheduleMaintenanceRequest) (*MaintenanceAnnouncement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenance not implemented")
+}
+func (UnimplementedIncidentServiceServer) CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*MaintenanceAnnouncement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenance not implemented")
+}
+func (UnimplementedIncidentServiceServer) ListMaintenances(context.Context, *ListMaintenancesRequest) (*ListMaintenancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenances not implemented")
+}
+func (UnimplementedIncidentServiceServer) GetStatusPage(context.Context, *GetStatusPageRequest) (*StatusPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusPage not implemented")
+}
 func (UnimplementedIncidentServiceServer) testEmbeddedByValue() {}
 
 // UnsafeIncidentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -252,6 +504,258 @@ func _IncidentService_GetIncident_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IncidentService_IngestAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).IngestAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_IngestAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).IngestAlert(ctx, req.(*IngestAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_AcknowledgeIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).AcknowledgeIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_AcknowledgeIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).AcknowledgeIncident(ctx, req.(*AcknowledgeIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_GetIncidentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).GetIncidentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_GetIncidentStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).GetIncidentStats(ctx, req.(*GetIncidentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_GetIncidentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).GetIncidentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_GetIncidentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).GetIncidentHistory(ctx, req.(*GetIncidentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_ListStatusComponents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatusComponentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).ListStatusComponents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_ListStatusComponents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).ListStatusComponents(ctx, req.(*ListStatusComponentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_CreateStatusComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStatusComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).CreateStatusComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_CreateStatusComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).CreateStatusComponent(ctx, req.(*CreateStatusComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_UpdateStatusComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStatusComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).UpdateStatusComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_UpdateStatusComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).UpdateStatusComponent(ctx, req.(*UpdateStatusComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_DeleteStatusComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStatusComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).DeleteStatusComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_DeleteStatusComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).DeleteStatusComponent(ctx, req.(*DeleteStatusComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_SetComponentStatusOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetComponentStatusOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).SetComponentStatusOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_SetComponentStatusOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).SetComponentStatusOverride(ctx, req.(*SetComponentStatusOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_ClearComponentStatusOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearComponentStatusOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).ClearComponentStatusOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_ClearComponentStatusOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).ClearComponentStatusOverride(ctx, req.(*ClearComponentStatusOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_ScheduleMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).ScheduleMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_ScheduleMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).ScheduleMaintenance(ctx, req.(*ScheduleMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_CancelMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).CancelMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_CancelMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).CancelMaintenance(ctx, req.(*CancelMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_ListMaintenances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).ListMaintenances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_ListMaintenances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).ListMaintenances(ctx, req.(*ListMaintenancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncidentService_GetStatusPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncidentServiceServer).GetStatusPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncidentService_GetStatusPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncidentServiceServer).GetStatusPage(ctx, req.(*GetStatusPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IncidentService_ServiceDesc is the grpc.ServiceDesc for IncidentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +783,62 @@ var IncidentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIncident",
 			Handler:    _IncidentService_GetIncident_Handler,
 		},
+		{
+			MethodName: "IngestAlert",
+			Handler:    _IncidentService_IngestAlert_Handler,
+		},
+		{
+			MethodName: "AcknowledgeIncident",
+			Handler:    _IncidentService_AcknowledgeIncident_Handler,
+		},
+		{
+			MethodName: "GetIncidentStats",
+			Handler:    _IncidentService_GetIncidentStats_Handler,
+		},
+		{
+			MethodName: "GetIncidentHistory",
+			Handler:    _IncidentService_GetIncidentHistory_Handler,
+		},
+		{
+			MethodName: "ListStatusComponents",
+			Handler:    _IncidentService_ListStatusComponents_Handler,
+		},
+		{
+			MethodName: "CreateStatusComponent",
+			Handler:    _IncidentService_CreateStatusComponent_Handler,
+		},
+		{
+			MethodName: "UpdateStatusComponent",
+			Handler:    _IncidentService_UpdateStatusComponent_Handler,
+		},
+		{
+			MethodName: "DeleteStatusComponent",
+			Handler:    _IncidentService_DeleteStatusComponent_Handler,
+		},
+		{
+			MethodName: "SetComponentStatusOverride",
+			Handler:    _IncidentService_SetComponentStatusOverride_Handler,
+		},
+		{
+			MethodName: "ClearComponentStatusOverride",
+			Handler:    _IncidentService_ClearComponentStatusOverride_Handler,
+		},
+		{
+			MethodName: "ScheduleMaintenance",
+			Handler:    _IncidentService_ScheduleMaintenance_Handler,
+		},
+		{
+			MethodName: "CancelMaintenance",
+			Handler:    _IncidentService_CancelMaintenance_Handler,
+		},
+		{
+			MethodName: "ListMaintenances",
+			Handler:    _IncidentService_ListMaintenances_Handler,
+		},
+		{
+			MethodName: "GetStatusPage",
+			Handler:    _IncidentService_GetStatusPage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/api/incident/v1/incident.proto",
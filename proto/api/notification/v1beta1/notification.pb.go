@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: proto/api/notification/v1beta1/notification.proto
+
+package v1beta1
+
+import (
+	v1 "UptimePingPlatform/proto/api/notification/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RevealChannelSecretsRequest канал, секреты которого нужно показать
+type RevealChannelSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealChannelSecretsRequest) Reset() {
+	*x = RevealChannelSecretsRequest{}
+	mi := &file_proto_api_notification_v1beta1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealChannelSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealChannelSecretsRequest) ProtoMessage() {}
+
+func (x *RevealChannelSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_notification_v1beta1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealChannelSecretsRequest.ProtoReflect.Descriptor instead.
+func (*RevealChannelSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_notification_v1beta1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RevealChannelSecretsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RevealChannelSecretsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+var File_proto_api_notification_v1beta1_notification_proto protoreflect.FileDescriptor
+
+var file_proto_api_notification_v1beta1_notification_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x59, 0x0a, 0x1b, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x32, 0x92, 0x01,
+	0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7b, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x3c, 0x2e,
+	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x70,
+	0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x22, 0x00, 0x42, 0x33, 0x5a, 0x31, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x69, 0x6e, 0x67,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_api_notification_v1beta1_notification_proto_rawDescOnce sync.Once
+	file_proto_api_notification_v1beta1_notification_proto_rawDescData = file_proto_api_notification_v1beta1_notification_proto_rawDesc
+)
+
+func file_proto_api_notification_v1beta1_notification_proto_rawDescGZIP() []byte {
+	file_proto_api_notification_v1beta1_notification_proto_rawDescOnce.Do(func() {
+		file_proto_api_notification_v1beta1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_api_notification_v1beta1_notification_proto_rawDescData)
+	})
+	return file_proto_api_notification_v1beta1_notification_proto_rawDescData
+}
+
+var file_proto_api_notification_v1beta1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_api_notification_v1beta1_notification_proto_goTypes = []any{
+	(*RevealChannelSecretsRequest)(nil), // 0: uptimeping.notification.v1beta1.RevealChannelSecretsRequest
+	(*v1.Channel)(nil),                  // 1: uptimeping.notification.v1.Channel
+}
+var file_proto_api_notification_v1beta1_notification_proto_depIdxs = []int32{
+	0, // 0: uptimeping.notification.v1beta1.NotificationService.RevealChannelSecrets:input_type -> uptimeping.notification.v1beta1.RevealChannelSecretsRequest
+	1, // 1: uptimeping.notification.v1beta1.NotificationService.RevealChannelSecrets:output_type -> uptimeping.notification.v1.Channel
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_notification_v1beta1_notification_proto_init() }
+func file_proto_api_notification_v1beta1_notification_proto_init() {
+	if File_proto_api_notification_v1beta1_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_api_notification_v1beta1_notification_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_notification_v1beta1_notification_proto_goTypes,
+		DependencyIndexes: file_proto_api_notification_v1beta1_notification_proto_depIdxs,
+		MessageInfos:      file_proto_api_notification_v1beta1_notification_proto_msgTypes,
+	}.Build()
+	File_proto_api_notification_v1beta1_notification_proto = out.File
+	file_proto_api_notification_v1beta1_notification_proto_rawDesc = nil
+	file_proto_api_notification_v1beta1_notification_proto_goTypes = nil
+	file_proto_api_notification_v1beta1_notification_proto_depIdxs = nil
+}
@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/api/notification/v1beta1/notification.proto
+
+package v1beta1
+
+import (
+	v1 "UptimePingPlatform/proto/api/notification/v1"
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NotificationService_RevealChannelSecrets_FullMethodName = "/uptimeping.notification.v1beta1.NotificationService/RevealChannelSecrets"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationService развивающиеся методы Notification Service; регистрируется на том же
+// gRPC сервере, что и uptimeping.notification.v1.NotificationService
+type NotificationServiceClient interface {
+	// RevealChannelSecrets возвращает канал с расшифрованными секретами конфигурации.
+	// Требует права notifications:reveal-secrets в метаданных x-permissions, каждый вызов
+	// попадает в аудит
+	RevealChannelSecrets(ctx context.Context, in *RevealChannelSecretsRequest, opts ...grpc.CallOption) (*v1.Channel, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) RevealChannelSecrets(ctx context.Context, in *RevealChannelSecretsRequest, opts ...grpc.CallOption) (*v1.Channel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.Channel)
+	err := c.cc.Invoke(ctx, NotificationService_RevealChannelSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations should embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// NotificationService развивающиеся методы Notification Service; регистрируется на том же
+// gRPC сервере, что и uptimeping.notification.v1.NotificationService
+type NotificationServiceServer interface {
+	// RevealChannelSecrets возвращает канал с расшифрованными секретами конфигурации.
+	// Требует права notifications:reveal-secrets в метаданных x-permissions, каждый вызов
+	// попадает в аудит
+	RevealChannelSecrets(context.Context, *RevealChannelSecretsRequest) (*v1.Channel, error)
+}
+
+// UnimplementedNotificationServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) RevealChannelSecrets(context.Context, *RevealChannelSecretsRequest) (*v1.Channel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealChannelSecrets not implemented")
+}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_RevealChannelSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealChannelSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RevealChannelSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_RevealChannelSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RevealChannelSecrets(ctx, req.(*RevealChannelSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "uptimeping.notification.v1beta1.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RevealChannelSecrets",
+			Handler:    _NotificationService_RevealChannelSecrets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/api/notification/v1beta1/notification.proto",
+}
@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/api/plugin/v1/plugin.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NotificationPlugin_Describe_FullMethodName = "/uptimeping.plugin.v1.NotificationPlugin/Describe"
+	NotificationPlugin_Send_FullMethodName     = "/uptimeping.plugin.v1.NotificationPlugin/Send"
+	NotificationPlugin_Health_FullMethodName   = "/uptimeping.plugin.v1.NotificationPlugin/Health"
+)
+
+// NotificationPluginClient is the client API for NotificationPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NotificationPlugin внешний провайдер уведомлений. Плагин - исполняемый файл в каталоге
+// плагинов notification-service; сервис запускает его отдельным процессом и вызывает
+// по gRPC через локальный сокет (см. pkg/plugin)
+type NotificationPluginClient interface {
+	// Describe возвращает канал, который обслуживает плагин
+	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	// Send отправляет уведомление получателю
+	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// Health проверяет, что плагин готов отправлять уведомления
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+}
+
+type notificationPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationPluginClient(cc grpc.ClientConnInterface) NotificationPluginClient {
+	return &notificationPluginClient{cc}
+}
+
+func (c *notificationPluginClient) Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeResponse)
+	err := c.cc.Invoke(ctx, NotificationPlugin_Describe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPluginClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendResponse)
+	err := c.cc.Invoke(ctx, NotificationPlugin_Send_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationPluginClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, NotificationPlugin_Health_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationPluginServer is the server API for NotificationPlugin service.
+// All implementations should embed UnimplementedNotificationPluginServer
+// for forward compatibility.
+//
+// NotificationPlugin внешний провайдер уведомлений. Плагин - исполняемый файл в каталоге
+// плагинов notification-service; сервис запускает его отдельным процессом и вызывает
+// по gRPC через локальный сокет (см. pkg/plugin)
+type NotificationPluginServer interface {
+	// Describe возвращает канал, который обслуживает плагин
+	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	// Send отправляет уведомление получателю
+	Send(context.Context, *SendRequest) (*SendResponse, error)
+	// Health проверяет, что плагин готов отправлять уведомления
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+}
+
+// UnimplementedNotificationPluginServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationPluginServer struct{}
+
+func (UnimplementedNotificationPluginServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedNotificationPluginServer) Send(context.Context, *SendRequest) (*SendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (UnimplementedNotificationPluginServer) Health(context.Context, *HealthRequest) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+func (UnimplementedNotificationPluginServer) testEmbeddedByValue() {}
+
+// UnsafeNotificationPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationPluginServer will
+// result in compilation errors.
+type UnsafeNotificationPluginServer interface {
+	mustEmbedUnimplementedNotificationPluginServer()
+}
+
+func RegisterNotificationPluginServer(s grpc.ServiceRegistrar, srv NotificationPluginServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationPluginServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationPlugin_ServiceDesc, srv)
+}
+
+func _NotificationPlugin_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPluginServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPlugin_Describe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPluginServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPlugin_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPluginServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPlugin_Send_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPluginServer).Send(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationPlugin_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationPluginServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationPlugin_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationPluginServer).Health(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationPlugin_ServiceDesc is the grpc.ServiceDesc for NotificationPlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "uptimeping.plugin.v1.NotificationPlugin",
+	HandlerType: (*NotificationPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler:    _NotificationPlugin_Describe_Handler,
+		},
+		{
+			MethodName: "Send",
+			Handler:    _NotificationPlugin_Send_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _NotificationPlugin_Health_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/api/plugin/v1/plugin.proto",
+}
+
+const (
+	CheckerPlugin_Describe_FullMethodName       = "/uptimeping.plugin.v1.CheckerPlugin/Describe"
+	CheckerPlugin_ValidateConfig_FullMethodName = "/uptimeping.plugin.v1.CheckerPlugin/ValidateConfig"
+	CheckerPlugin_Check_FullMethodName          = "/uptimeping.plugin.v1.CheckerPlugin/Check"
+)
+
+// CheckerPluginClient is the client API for CheckerPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CheckerPlugin внешняя проверка собственного протокола, например FIX или баннера TCP сервиса.
+// Плагин регистрируется в конфигурации core-service (checker_plugins) и выполняет проверки
+// типа plugin, у которых config.plugin совпадает с именем плагина
+type CheckerPluginClient interface {
+	// Describe возвращает имя проверки, которое указывается в config.plugin
+	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeCheckerResponse, error)
+	// ValidateConfig проверяет конфигурацию проверки до ее сохранения
+	ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error)
+	// Check выполняет одну проверку цели
+	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+}
+
+type checkerPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCheckerPluginClient(cc grpc.ClientConnInterface) CheckerPluginClient {
+	return &checkerPluginClient{cc}
+}
+
+func (c *checkerPluginClient) Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeCheckerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeCheckerResponse)
+	err := c.cc.Invoke(ctx, CheckerPlugin_Describe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkerPluginClient) ValidateConfig(ctx context.Context, in *ValidateConfigRequest, opts ...grpc.CallOption) (*ValidateConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigResponse)
+	err := c.cc.Invoke(ctx, CheckerPlugin_ValidateConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkerPluginClient) Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, CheckerPlugin_Check_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CheckerPluginServer is the server API for CheckerPlugin service.
+// All implementations should embed UnimplementedCheckerPluginServer
+// for forward compatibility.
+//
+// CheckerPlugin внешняя проверка собственного протокола, например FIX или баннера TCP сервиса.
+// Плагин регистрируется в конфигурации core-service (checker_plugins) и выполняет проверки
+// типа plugin, у которых config.plugin совпадает с именем плагина
+type CheckerPluginServer interface {
+	// Describe возвращает имя проверки, которое указывается в config.plugin
+	Describe(context.Context, *DescribeRequest) (*DescribeCheckerResponse, error)
+	// ValidateConfig проверяет конфигурацию проверки до ее сохранения
+	ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error)
+	// Check выполняет одну проверку цели
+	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+}
+
+// UnimplementedCheckerPluginServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCheckerPluginServer struct{}
+
+func (UnimplementedCheckerPluginServer) Describe(context.Context, *DescribeRequest) (*DescribeCheckerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedCheckerPluginServer) ValidateConfig(context.Context, *ValidateConfigRequest) (*ValidateConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfig not implemented")
+}
+func (UnimplementedCheckerPluginServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
+}
+func (UnimplementedCheckerPluginServer) testEmbeddedByValue() {}
+
+// UnsafeCheckerPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CheckerPluginServer will
+// result in compilation errors.
+type UnsafeCheckerPluginServer interface {
+	mustEmbedUnimplementedCheckerPluginServer()
+}
+
+func RegisterCheckerPluginServer(s grpc.ServiceRegistrar, srv CheckerPluginServer) {
+	// If the following call pancis, it indicates UnimplementedCheckerPluginServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CheckerPlugin_ServiceDesc, srv)
+}
+
+func _CheckerPlugin_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckerPluginServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckerPlugin_Describe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckerPluginServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckerPlugin_ValidateConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckerPluginServer).ValidateConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckerPlugin_ValidateConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckerPluginServer).ValidateConfig(ctx, req.(*ValidateConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckerPlugin_Check_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckerPluginServer).Check(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckerPlugin_Check_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckerPluginServer).Check(ctx, req.(*CheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CheckerPlugin_ServiceDesc is the grpc.ServiceDesc for CheckerPlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CheckerPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "uptimeping.plugin.v1.CheckerPlugin",
+	HandlerType: (*CheckerPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler:    _CheckerPlugin_Describe_Handler,
+		},
+		{
+			MethodName: "ValidateConfig",
+			Handler:    _CheckerPlugin_ValidateConfig_Handler,
+		},
+		{
+			MethodName: "Check",
+			Handler:    _CheckerPlugin_Check_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/api/plugin/v1/plugin.proto",
+}
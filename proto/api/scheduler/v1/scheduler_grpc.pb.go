@@ -19,16 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SchedulerService_CreateCheck_FullMethodName     = "/uptimeping.scheduler.v1.SchedulerService/CreateCheck"
-	SchedulerService_UpdateCheck_FullMethodName     = "/uptimeping.scheduler.v1.SchedulerService/UpdateCheck"
-	SchedulerService_DeleteCheck_FullMethodName     = "/uptimeping.scheduler.v1.SchedulerService/DeleteCheck"
-	SchedulerService_GetCheck_FullMethodName        = "/uptimeping.scheduler.v1.SchedulerService/GetCheck"
-	SchedulerService_ListChecks_FullMethodName      = "/uptimeping.scheduler.v1.SchedulerService/ListChecks"
-	SchedulerService_ScheduleCheck_FullMethodName   = "/uptimeping.scheduler.v1.SchedulerService/ScheduleCheck"
-	SchedulerService_UnscheduleCheck_FullMethodName = "/uptimeping.scheduler.v1.SchedulerService/UnscheduleCheck"
-	SchedulerService_GetSchedule_FullMethodName     = "/uptimeping.scheduler.v1.SchedulerService/GetSchedule"
-	SchedulerService_ListSchedules_FullMethodName   = "/uptimeping.scheduler.v1.SchedulerService/ListSchedules"
-	SchedulerService_HealthCheck_FullMethodName     = "/uptimeping.scheduler.v1.SchedulerService/HealthCheck"
+	SchedulerService_CreateCheck_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/CreateCheck"
+	SchedulerService_UpdateCheck_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/UpdateCheck"
+	SchedulerService_DeleteCheck_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/DeleteCheck"
+	SchedulerService_GetCheck_FullMethodName          = "/uptimeping.scheduler.v1.SchedulerService/GetCheck"
+	SchedulerService_ListChecks_FullMethodName        = "/uptimeping.scheduler.v1.SchedulerService/ListChecks"
+	SchedulerService_ScheduleCheck_FullMethodName     = "/uptimeping.scheduler.v1.SchedulerService/ScheduleCheck"
+	SchedulerService_UnscheduleCheck_FullMethodName   = "/uptimeping.scheduler.v1.SchedulerService/UnscheduleCheck"
+	SchedulerService_GetSchedule_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/GetSchedule"
+	SchedulerService_ListSchedules_FullMethodName     = "/uptimeping.scheduler.v1.SchedulerService/ListSchedules"
+	SchedulerService_ListTags_FullMethodName          = "/uptimeping.scheduler.v1.SchedulerService/ListTags"
+	SchedulerService_RenameTag_FullMethodName         = "/uptimeping.scheduler.v1.SchedulerService/RenameTag"
+	SchedulerService_DeleteTag_FullMethodName         = "/uptimeping.scheduler.v1.SchedulerService/DeleteTag"
+	SchedulerService_DeleteUnusedTags_FullMethodName  = "/uptimeping.scheduler.v1.SchedulerService/DeleteUnusedTags"
+	SchedulerService_CreateGroup_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/CreateGroup"
+	SchedulerService_GetGroup_FullMethodName          = "/uptimeping.scheduler.v1.SchedulerService/GetGroup"
+	SchedulerService_ListGroups_FullMethodName        = "/uptimeping.scheduler.v1.SchedulerService/ListGroups"
+	SchedulerService_UpdateGroup_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/UpdateGroup"
+	SchedulerService_DeleteGroup_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/DeleteGroup"
+	SchedulerService_SetGroupEnabled_FullMethodName   = "/uptimeping.scheduler.v1.SchedulerService/SetGroupEnabled"
+	SchedulerService_ReportCheckHealth_FullMethodName = "/uptimeping.scheduler.v1.SchedulerService/ReportCheckHealth"
+	SchedulerService_GetFleetSummary_FullMethodName   = "/uptimeping.scheduler.v1.SchedulerService/GetFleetSummary"
+	SchedulerService_HealthCheck_FullMethodName       = "/uptimeping.scheduler.v1.SchedulerService/HealthCheck"
 )
 
 // SchedulerServiceClient is the client API for SchedulerService service.
@@ -48,6 +60,21 @@ type SchedulerServiceClient interface {
 	UnscheduleCheck(ctx context.Context, in *UnscheduleCheckRequest, opts ...grpc.CallOption) (*UnscheduleCheckResponse, error)
 	GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*Schedule, error)
 	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// Методы управления тегами
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	DeleteUnusedTags(ctx context.Context, in *DeleteUnusedTagsRequest, opts ...grpc.CallOption) (*DeleteUnusedTagsResponse, error)
+	// Методы управления группами проверок
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error)
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	SetGroupEnabled(ctx context.Context, in *SetGroupEnabledRequest, opts ...grpc.CallOption) (*SetGroupEnabledResponse, error)
+	// Методы сводки по парку проверок
+	ReportCheckHealth(ctx context.Context, in *ReportCheckHealthRequest, opts ...grpc.CallOption) (*ReportCheckHealthResponse, error)
+	GetFleetSummary(ctx context.Context, in *GetFleetSummaryRequest, opts ...grpc.CallOption) (*FleetSummary, error)
 	// Health check
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
@@ -150,6 +177,126 @@ func (c *schedulerServiceClient) ListSchedules(ctx context.Context, in *ListSche
 	return out, nil
 }
 
+func (c *schedulerServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) DeleteUnusedTags(ctx context.Context, in *DeleteUnusedTagsRequest, opts ...grpc.CallOption) (*DeleteUnusedTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUnusedTagsResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_DeleteUnusedTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Group)
+	err := c.cc.Invoke(ctx, SchedulerService_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Group)
+	err := c.cc.Invoke(ctx, SchedulerService_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_ListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*Group, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Group)
+	err := c.cc.Invoke(ctx, SchedulerService_UpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) SetGroupEnabled(ctx context.Context, in *SetGroupEnabledRequest, opts ...grpc.CallOption) (*SetGroupEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupEnabledResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_SetGroupEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) ReportCheckHealth(ctx context.Context, in *ReportCheckHealthRequest, opts ...grpc.CallOption) (*ReportCheckHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportCheckHealthResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_ReportCheckHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerServiceClient) GetFleetSummary(ctx context.Context, in *GetFleetSummaryRequest, opts ...grpc.CallOption) (*FleetSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FleetSummary)
+	err := c.cc.Invoke(ctx, SchedulerService_GetFleetSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -177,6 +324,21 @@ type SchedulerServiceServer interface {
 	UnscheduleCheck(context.Context, *UnscheduleCheckRequest) (*UnscheduleCheckResponse, error)
 	GetSchedule(context.Context, *GetScheduleRequest) (*Schedule, error)
 	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// Методы управления тегами
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	DeleteUnusedTags(context.Context, *DeleteUnusedTagsRequest) (*DeleteUnusedTagsResponse, error)
+	// Методы управления группами проверок
+	CreateGroup(context.Context, *CreateGroupRequest) (*Group, error)
+	GetGroup(context.Context, *GetGroupRequest) (*Group, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*Group, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	SetGroupEnabled(context.Context, *SetGroupEnabledRequest) (*SetGroupEnabledResponse, error)
+	// Методы сводки по парку проверок
+	ReportCheckHealth(context.Context, *ReportCheckHealthRequest) (*ReportCheckHealthResponse, error)
+	GetFleetSummary(context.Context, *GetFleetSummaryRequest) (*FleetSummary, error)
 	// Health check
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 }
@@ -215,6 +377,42 @@ func (UnimplementedSchedulerServiceServer) GetSchedule(context.Context, *GetSche
 func (UnimplementedSchedulerServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
 }
+func (UnimplementedSchedulerServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedSchedulerServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedSchedulerServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedSchedulerServiceServer) DeleteUnusedTags(context.Context, *DeleteUnusedTagsRequest) (*DeleteUnusedTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnusedTags not implemented")
+}
+func (UnimplementedSchedulerServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedSchedulerServiceServer) GetGroup(context.Context, *GetGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedSchedulerServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedSchedulerServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedSchedulerServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedSchedulerServiceServer) SetGroupEnabled(context.Context, *SetGroupEnabledRequest) (*SetGroupEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupEnabled not implemented")
+}
+func (UnimplementedSchedulerServiceServer) ReportCheckHealth(context.Context, *ReportCheckHealthRequest) (*ReportCheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCheckHealth not implemented")
+}
+func (UnimplementedSchedulerServiceServer) GetFleetSummary(context.Context, *GetFleetSummaryRequest) (*FleetSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFleetSummary not implemented")
+}
 func (UnimplementedSchedulerServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -400,6 +598,222 @@ func _SchedulerService_ListSchedules_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulerService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_DeleteUnusedTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnusedTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).DeleteUnusedTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_DeleteUnusedTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).DeleteUnusedTags(ctx, req.(*DeleteUnusedTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_SetGroupEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).SetGroupEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_SetGroupEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).SetGroupEnabled(ctx, req.(*SetGroupEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_ReportCheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCheckHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).ReportCheckHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_ReportCheckHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).ReportCheckHealth(ctx, req.(*ReportCheckHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchedulerService_GetFleetSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFleetSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).GetFleetSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_GetFleetSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).GetFleetSummary(ctx, req.(*GetFleetSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SchedulerService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -461,6 +875,54 @@ var SchedulerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSchedules",
 			Handler:    _SchedulerService_ListSchedules_Handler,
 		},
+		{
+			MethodName: "ListTags",
+			Handler:    _SchedulerService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _SchedulerService_RenameTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _SchedulerService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "DeleteUnusedTags",
+			Handler:    _SchedulerService_DeleteUnusedTags_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _SchedulerService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _SchedulerService_GetGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _SchedulerService_ListGroups_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _SchedulerService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _SchedulerService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "SetGroupEnabled",
+			Handler:    _SchedulerService_SetGroupEnabled_Handler,
+		},
+		{
+			MethodName: "ReportCheckHealth",
+			Handler:    _SchedulerService_ReportCheckHealth_Handler,
+		},
+		{
+			MethodName: "GetFleetSummary",
+			Handler:    _SchedulerService_GetFleetSummary_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _SchedulerService_HealthCheck_Handler,
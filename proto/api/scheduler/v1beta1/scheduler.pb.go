@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: proto/api/scheduler/v1beta1/scheduler.proto
+
+package v1beta1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PreviewIntervalChangeRequest предлагаемый интервал для проверок с тегом
+type PreviewIntervalChangeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TenantId        string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Tag             string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreviewIntervalChangeRequest) Reset() {
+	*x = PreviewIntervalChangeRequest{}
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewIntervalChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewIntervalChangeRequest) ProtoMessage() {}
+
+func (x *PreviewIntervalChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewIntervalChangeRequest.ProtoReflect.Descriptor instead.
+func (*PreviewIntervalChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreviewIntervalChangeRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *PreviewIntervalChangeRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *PreviewIntervalChangeRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+// CapacityEstimate нагрузка проверок: выполнения в минуту во всех регионах и единицы
+// стоимости в минуту (секунды выполнения)
+type CapacityEstimate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionsPerMinute float64                `protobuf:"fixed64,1,opt,name=executions_per_minute,json=executionsPerMinute,proto3" json:"executions_per_minute,omitempty"`
+	CostUnitsPerMinute  float64                `protobuf:"fixed64,2,opt,name=cost_units_per_minute,json=costUnitsPerMinute,proto3" json:"cost_units_per_minute,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CapacityEstimate) Reset() {
+	*x = CapacityEstimate{}
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapacityEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapacityEstimate) ProtoMessage() {}
+
+func (x *CapacityEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapacityEstimate.ProtoReflect.Descriptor instead.
+func (*CapacityEstimate) Descriptor() ([]byte, []int) {
+	return file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CapacityEstimate) GetExecutionsPerMinute() float64 {
+	if x != nil {
+		return x.ExecutionsPerMinute
+	}
+	return 0
+}
+
+func (x *CapacityEstimate) GetCostUnitsPerMinute() float64 {
+	if x != nil {
+		return x.CostUnitsPerMinute
+	}
+	return 0
+}
+
+// RejectedCheck проверка, которой нельзя задать предложенный интервал; в прогнозе
+// она сохраняет текущий интервал. field и reason - как в деталях ошибок валидации v1
+type RejectedCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckId       string                 `protobuf:"bytes,1,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedCheck) Reset() {
+	*x = RejectedCheck{}
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedCheck) ProtoMessage() {}
+
+func (x *RejectedCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedCheck.ProtoReflect.Descriptor instead.
+func (*RejectedCheck) Descriptor() ([]byte, []int) {
+	return file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RejectedCheck) GetCheckId() string {
+	if x != nil {
+		return x.CheckId
+	}
+	return ""
+}
+
+func (x *RejectedCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RejectedCheck) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *RejectedCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectedCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// IntervalChangePreview прогноз изменения нагрузки. Выключенные проверки учитываются
+// только в matched_checks
+type IntervalChangePreview struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Tag             string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	MatchedChecks   int32                  `protobuf:"varint,3,opt,name=matched_checks,json=matchedChecks,proto3" json:"matched_checks,omitempty"`
+	ActiveChecks    int32                  `protobuf:"varint,4,opt,name=active_checks,json=activeChecks,proto3" json:"active_checks,omitempty"`
+	ChangedChecks   int32                  `protobuf:"varint,5,opt,name=changed_checks,json=changedChecks,proto3" json:"changed_checks,omitempty"`
+	Current         *CapacityEstimate      `protobuf:"bytes,6,opt,name=current,proto3" json:"current,omitempty"`
+	Proposed        *CapacityEstimate      `protobuf:"bytes,7,opt,name=proposed,proto3" json:"proposed,omitempty"`
+	Delta           *CapacityEstimate      `protobuf:"bytes,8,opt,name=delta,proto3" json:"delta,omitempty"`
+	RejectedChecks  []*RejectedCheck       `protobuf:"bytes,9,rep,name=rejected_checks,json=rejectedChecks,proto3" json:"rejected_checks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IntervalChangePreview) Reset() {
+	*x = IntervalChangePreview{}
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntervalChangePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntervalChangePreview) ProtoMessage() {}
+
+func (x *IntervalChangePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntervalChangePreview.ProtoReflect.Descriptor instead.
+func (*IntervalChangePreview) Descriptor() ([]byte, []int) {
+	return file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IntervalChangePreview) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *IntervalChangePreview) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *IntervalChangePreview) GetMatchedChecks() int32 {
+	if x != nil {
+		return x.MatchedChecks
+	}
+	return 0
+}
+
+func (x *IntervalChangePreview) GetActiveChecks() int32 {
+	if x != nil {
+		return x.ActiveChecks
+	}
+	return 0
+}
+
+func (x *IntervalChangePreview) GetChangedChecks() int32 {
+	if x != nil {
+		return x.ChangedChecks
+	}
+	return 0
+}
+
+func (x *IntervalChangePreview) GetCurrent() *CapacityEstimate {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *IntervalChangePreview) GetProposed() *CapacityEstimate {
+	if x != nil {
+		return x.Proposed
+	}
+	return nil
+}
+
+func (x *IntervalChangePreview) GetDelta() *CapacityEstimate {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *IntervalChangePreview) GetRejectedChecks() []*RejectedCheck {
+	if x != nil {
+		return x.RejectedChecks
+	}
+	return nil
+}
+
+var File_proto_api_scheduler_v1beta1_scheduler_proto protoreflect.FileDescriptor
+
+var file_proto_api_scheduler_v1beta1_scheduler_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x75,
+	0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x22, 0x78, 0x0a, 0x1c, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x79, 0x0a, 0x10, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x31, 0x0a,
+	0x15, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x63, 0x6f,
+	0x73, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x22, 0x86, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x15, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x12, 0x48, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12,
+	0x54, 0x0a, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x32, 0x9f, 0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x15, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x3a, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e,
+	0x67, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x55, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x50, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescOnce sync.Once
+	file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescData = file_proto_api_scheduler_v1beta1_scheduler_proto_rawDesc
+)
+
+func file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescGZIP() []byte {
+	file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescOnce.Do(func() {
+		file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescData)
+	})
+	return file_proto_api_scheduler_v1beta1_scheduler_proto_rawDescData
+}
+
+var file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_api_scheduler_v1beta1_scheduler_proto_goTypes = []any{
+	(*PreviewIntervalChangeRequest)(nil), // 0: uptimeping.scheduler.v1beta1.PreviewIntervalChangeRequest
+	(*CapacityEstimate)(nil),             // 1: uptimeping.scheduler.v1beta1.CapacityEstimate
+	(*RejectedCheck)(nil),                // 2: uptimeping.scheduler.v1beta1.RejectedCheck
+	(*IntervalChangePreview)(nil),        // 3: uptimeping.scheduler.v1beta1.IntervalChangePreview
+}
+var file_proto_api_scheduler_v1beta1_scheduler_proto_depIdxs = []int32{
+	1, // 0: uptimeping.scheduler.v1beta1.IntervalChangePreview.current:type_name -> uptimeping.scheduler.v1beta1.CapacityEstimate
+	1, // 1: uptimeping.scheduler.v1beta1.IntervalChangePreview.proposed:type_name -> uptimeping.scheduler.v1beta1.CapacityEstimate
+	1, // 2: uptimeping.scheduler.v1beta1.IntervalChangePreview.delta:type_name -> uptimeping.scheduler.v1beta1.CapacityEstimate
+	2, // 3: uptimeping.scheduler.v1beta1.IntervalChangePreview.rejected_checks:type_name -> uptimeping.scheduler.v1beta1.RejectedCheck
+	0, // 4: uptimeping.scheduler.v1beta1.SchedulerService.PreviewIntervalChange:input_type -> uptimeping.scheduler.v1beta1.PreviewIntervalChangeRequest
+	3, // 5: uptimeping.scheduler.v1beta1.SchedulerService.PreviewIntervalChange:output_type -> uptimeping.scheduler.v1beta1.IntervalChangePreview
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_scheduler_v1beta1_scheduler_proto_init() }
+func file_proto_api_scheduler_v1beta1_scheduler_proto_init() {
+	if File_proto_api_scheduler_v1beta1_scheduler_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_api_scheduler_v1beta1_scheduler_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_api_scheduler_v1beta1_scheduler_proto_goTypes,
+		DependencyIndexes: file_proto_api_scheduler_v1beta1_scheduler_proto_depIdxs,
+		MessageInfos:      file_proto_api_scheduler_v1beta1_scheduler_proto_msgTypes,
+	}.Build()
+	File_proto_api_scheduler_v1beta1_scheduler_proto = out.File
+	file_proto_api_scheduler_v1beta1_scheduler_proto_rawDesc = nil
+	file_proto_api_scheduler_v1beta1_scheduler_proto_goTypes = nil
+	file_proto_api_scheduler_v1beta1_scheduler_proto_depIdxs = nil
+}
@@ -0,0 +1,129 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/api/scheduler/v1beta1/scheduler.proto
+
+package v1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SchedulerService_PreviewIntervalChange_FullMethodName = "/uptimeping.scheduler.v1beta1.SchedulerService/PreviewIntervalChange"
+)
+
+// SchedulerServiceClient is the client API for SchedulerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SchedulerService развивающиеся методы Scheduler Service; регистрируется на том же
+// gRPC сервере, что и uptimeping.scheduler.v1.SchedulerService
+type SchedulerServiceClient interface {
+	// PreviewIntervalChange оценивает изменение нагрузки и стоимости, если всем проверкам
+	// tenant с тегом задать новый интервал. Проверки не изменяются
+	PreviewIntervalChange(ctx context.Context, in *PreviewIntervalChangeRequest, opts ...grpc.CallOption) (*IntervalChangePreview, error)
+}
+
+type schedulerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulerServiceClient(cc grpc.ClientConnInterface) SchedulerServiceClient {
+	return &schedulerServiceClient{cc}
+}
+
+func (c *schedulerServiceClient) PreviewIntervalChange(ctx context.Context, in *PreviewIntervalChangeRequest, opts ...grpc.CallOption) (*IntervalChangePreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntervalChangePreview)
+	err := c.cc.Invoke(ctx, SchedulerService_PreviewIntervalChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulerServiceServer is the server API for SchedulerService service.
+// All implementations should embed UnimplementedSchedulerServiceServer
+// for forward compatibility.
+//
+// SchedulerService развивающиеся методы Scheduler Service; регистрируется на том же
+// gRPC сервере, что и uptimeping.scheduler.v1.SchedulerService
+type SchedulerServiceServer interface {
+	// PreviewIntervalChange оценивает изменение нагрузки и стоимости, если всем проверкам
+	// tenant с тегом задать новый интервал. Проверки не изменяются
+	PreviewIntervalChange(context.Context, *PreviewIntervalChangeRequest) (*IntervalChangePreview, error)
+}
+
+// UnimplementedSchedulerServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSchedulerServiceServer struct{}
+
+func (UnimplementedSchedulerServiceServer) PreviewIntervalChange(context.Context, *PreviewIntervalChangeRequest) (*IntervalChangePreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewIntervalChange not implemented")
+}
+func (UnimplementedSchedulerServiceServer) testEmbeddedByValue() {}
+
+// UnsafeSchedulerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulerServiceServer will
+// result in compilation errors.
+type UnsafeSchedulerServiceServer interface {
+	mustEmbedUnimplementedSchedulerServiceServer()
+}
+
+func RegisterSchedulerServiceServer(s grpc.ServiceRegistrar, srv SchedulerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSchedulerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SchedulerService_ServiceDesc, srv)
+}
+
+func _SchedulerService_PreviewIntervalChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewIntervalChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).PreviewIntervalChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_PreviewIntervalChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).PreviewIntervalChange(ctx, req.(*PreviewIntervalChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchedulerService_ServiceDesc is the grpc.ServiceDesc for SchedulerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchedulerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "uptimeping.scheduler.v1beta1.SchedulerService",
+	HandlerType: (*SchedulerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewIntervalChange",
+			Handler:    _SchedulerService_PreviewIntervalChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/api/scheduler/v1beta1/scheduler.proto",
+}
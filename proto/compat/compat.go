@@ -0,0 +1,158 @@
+// Package compat проверяет совместимость публичного API. Стабильные пакеты (uptimeping.<name>.v1)
+// заморожены: их описание хранится в testdata/stable.golden, а тест пакета падает при удалении,
+// переименовании или смене номера и типа поля, изменении сигнатуры RPC и появлении новых RPC.
+// Новые RPC добавляются в пакеты v1beta1 (см. proto/VERSIONING.md), на них проверка не распространяется
+package compat
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// stablePackage пакет стабильной версии API: uptimeping.<name>.v<N> без суффиксов alpha и beta
+var stablePackage = regexp.MustCompile(`^uptimeping\.[a-z_]+\.v[0-9]+$`)
+
+// IsStable сообщает, относится ли пакет к замороженной стабильной версии API
+func IsStable(pkg protoreflect.FullName) bool {
+	return stablePackage.MatchString(string(pkg))
+}
+
+// Describe возвращает отсортированный список фактов о файлах: сервисы и сигнатуры RPC, поля
+// сообщений (включая вложенные) и значения перечислений. Каждый факт - отдельная строка
+func Describe(files []protoreflect.FileDescriptor) []string {
+	facts := make(map[string]bool)
+	for _, file := range files {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			facts["service "+string(service.FullName())] = true
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				facts[describeMethod(methods.Get(j))] = true
+			}
+		}
+		describeMessages(file.Messages(), facts)
+		describeEnums(file.Enums(), facts)
+	}
+
+	result := make([]string, 0, len(facts))
+	for fact := range facts {
+		result = append(result, fact)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Diff результат сравнения описания API с зафиксированным
+type Diff struct {
+	// Breaking факты зафиксированного API, которых больше нет: несовместимые изменения
+	Breaking []string
+	// NewRPCs сервисы и RPC, добавленные в замороженную версию
+	NewRPCs []string
+	// Added прочие совместимые добавления: новые поля, сообщения и значения перечислений
+	Added []string
+}
+
+// Compare сравнивает зафиксированное описание golden с текущим current
+func Compare(golden, current []string) Diff {
+	var diff Diff
+	present := make(map[string]bool, len(current))
+	for _, fact := range current {
+		present[fact] = true
+	}
+	known := make(map[string]bool, len(golden))
+	for _, fact := range golden {
+		known[fact] = true
+		if !present[fact] {
+			diff.Breaking = append(diff.Breaking, fact)
+		}
+	}
+
+	for _, fact := range current {
+		if known[fact] {
+			continue
+		}
+		if strings.HasPrefix(fact, "service ") || strings.HasPrefix(fact, "rpc ") {
+			diff.NewRPCs = append(diff.NewRPCs, fact)
+			continue
+		}
+		diff.Added = append(diff.Added, fact)
+	}
+	return diff
+}
+
+// describeMethod сигнатура RPC
+func describeMethod(method protoreflect.MethodDescriptor) string {
+	input, output := string(method.Input().FullName()), string(method.Output().FullName())
+	if method.IsStreamingClient() {
+		input = "stream " + input
+	}
+	if method.IsStreamingServer() {
+		output = "stream " + output
+	}
+	return fmt.Sprintf("rpc %s(%s) returns (%s)", method.FullName(), input, output)
+}
+
+// describeMessages добавляет поля сообщений и рекурсивно описывает вложенные типы
+func describeMessages(messages protoreflect.MessageDescriptors, facts map[string]bool) {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		facts["message "+string(message.FullName())] = true
+		fields := message.Fields()
+		for j := 0; j < fields.Len(); j++ {
+			field := fields.Get(j)
+			facts[fmt.Sprintf("field %s %d %s %s", message.FullName(), field.Number(), field.Name(), fieldType(field))] = true
+		}
+		describeMessages(message.Messages(), facts)
+		describeEnums(message.Enums(), facts)
+	}
+}
+
+// describeEnums добавляет значения перечислений
+func describeEnums(enums protoreflect.EnumDescriptors, facts map[string]bool) {
+	for i := 0; i < enums.Len(); i++ {
+		enum := enums.Get(i)
+		values := enum.Values()
+		for j := 0; j < values.Len(); j++ {
+			value := values.Get(j)
+			facts[fmt.Sprintf("enum %s %d %s", enum.FullName(), value.Number(), value.Name())] = true
+		}
+	}
+}
+
+// fieldType текстовое представление типа поля
+func fieldType(field protoreflect.FieldDescriptor) string {
+	if field.IsMap() {
+		return fmt.Sprintf("map<%s,%s>", kindName(field.MapKey()), kindName(field.MapValue()))
+	}
+	name := kindName(field)
+	if field.IsList() {
+		return "repeated " + name
+	}
+	if oneof := field.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		return "oneof " + string(oneof.Name()) + " " + name
+	}
+	if field.HasPresence() && field.Kind() != protoreflect.MessageKind {
+		return "optional " + name
+	}
+	return name
+}
+
+// kindName имя скалярного типа или полное имя сообщения и перечисления
+func kindName(field protoreflect.FieldDescriptor) string {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(field.Message().FullName())
+	case protoreflect.EnumKind:
+		return string(field.Enum().FullName())
+	default:
+		return strings.ToLower(field.Kind().String())
+	}
+}
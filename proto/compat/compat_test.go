@@ -0,0 +1,154 @@
+package compat
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	_ "UptimePingPlatform/proto/api/auth/v1"
+	_ "UptimePingPlatform/proto/api/cli/v1"
+	_ "UptimePingPlatform/proto/api/config/v1"
+	_ "UptimePingPlatform/proto/api/core/v1"
+	_ "UptimePingPlatform/proto/api/forge/v1"
+	_ "UptimePingPlatform/proto/api/incident/v1"
+	_ "UptimePingPlatform/proto/api/metrics/v1"
+	_ "UptimePingPlatform/proto/api/notification/v1"
+	_ "UptimePingPlatform/proto/api/notification/v1beta1"
+	_ "UptimePingPlatform/proto/api/plugin/v1"
+	_ "UptimePingPlatform/proto/api/scheduler/v1"
+	_ "UptimePingPlatform/proto/api/scheduler/v1beta1"
+)
+
+// update фиксирует текущее описание стабильного API после ревью совместимых добавлений:
+// go test ./compat -update
+var update = flag.Bool("update", false, "update stable API golden file")
+
+const goldenPath = "testdata/stable.golden"
+
+// apiFiles возвращает зарегистрированные файлы proto/api, отобранные filter
+func apiFiles(filter func(protoreflect.FileDescriptor) bool) []protoreflect.FileDescriptor {
+	var files []protoreflect.FileDescriptor
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		if strings.HasPrefix(file.Path(), "proto/api/") && filter(file) {
+			files = append(files, file)
+		}
+		return true
+	})
+	return files
+}
+
+func readGolden(t *testing.T) ([]string, bool) {
+	t.Helper()
+	data, err := os.ReadFile(goldenPath)
+	if os.IsNotExist(err) {
+		return nil, false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var facts []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			facts = append(facts, line)
+		}
+	}
+	return facts, true
+}
+
+func TestStableAPIIsFrozen(t *testing.T) {
+	current := Describe(apiFiles(func(file protoreflect.FileDescriptor) bool { return IsStable(file.Package()) }))
+	golden, ok := readGolden(t)
+
+	if ok {
+		diff := Compare(golden, current)
+		if len(diff.Breaking) > 0 {
+			t.Errorf("breaking changes in stable API, v1 packages are frozen:\n%s", strings.Join(diff.Breaking, "\n"))
+		}
+		if len(diff.NewRPCs) > 0 {
+			t.Errorf("new services and RPCs belong to v1beta1 packages, see proto/VERSIONING.md:\n%s", strings.Join(diff.NewRPCs, "\n"))
+		}
+		if len(diff.Added) > 0 && !*update {
+			t.Errorf("stable API has new definitions, review them and run the test with -update:\n%s", strings.Join(diff.Added, "\n"))
+		}
+	} else if !*update {
+		t.Fatalf("%s is missing, run the test with -update", goldenPath)
+	}
+
+	if *update && !t.Failed() {
+		content := "# Frozen stable API (uptimeping.*.v1). Generated by go test ./compat -update, do not edit.\n" +
+			strings.Join(current, "\n") + "\n"
+		if err := os.WriteFile(goldenPath, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestAllAPIPackagesAreChecked(t *testing.T) {
+	paths, err := filepath.Glob("../api/*/*/*.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no proto files found")
+	}
+
+	for _, path := range paths {
+		name := "proto/" + strings.TrimPrefix(filepath.ToSlash(path), "../")
+		if _, err := protoregistry.GlobalFiles.FindFileByPath(name); err != nil {
+			t.Errorf("%s is not registered: import its Go package in compat_test.go", name)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	golden := []string{
+		"field uptimeping.core.v1.CheckResult 1 check_id string",
+		"field uptimeping.core.v1.CheckResult 2 success bool",
+		"rpc uptimeping.core.v1.CoreService.ExecuteCheck(uptimeping.core.v1.ExecuteCheckRequest) returns (uptimeping.core.v1.CheckResult)",
+		"service uptimeping.core.v1.CoreService",
+	}
+	current := []string{
+		"field uptimeping.core.v1.CheckResult 1 check_id string",
+		"field uptimeping.core.v1.CheckResult 2 ok bool",
+		"field uptimeping.core.v1.CheckResult 3 error string",
+		"rpc uptimeping.core.v1.CoreService.ExecuteCheck(uptimeping.core.v1.ExecuteCheckRequest) returns (uptimeping.core.v1.CheckResult)",
+		"rpc uptimeping.core.v1.CoreService.RetryCheck(uptimeping.core.v1.RetryCheckRequest) returns (uptimeping.core.v1.CheckResult)",
+		"service uptimeping.core.v1.CoreService",
+	}
+
+	diff := Compare(golden, current)
+	want := Diff{
+		Breaking: []string{"field uptimeping.core.v1.CheckResult 2 success bool"},
+		NewRPCs:  []string{"rpc uptimeping.core.v1.CoreService.RetryCheck(uptimeping.core.v1.RetryCheckRequest) returns (uptimeping.core.v1.CheckResult)"},
+		Added: []string{
+			"field uptimeping.core.v1.CheckResult 2 ok bool",
+			"field uptimeping.core.v1.CheckResult 3 error string",
+		},
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("Compare() = %+v, want %+v", diff, want)
+	}
+}
+
+func TestIsStable(t *testing.T) {
+	for pkg, want := range map[protoreflect.FullName]bool{
+		"uptimeping.core.v1":         true,
+		"uptimeping.core.v2":         true,
+		"uptimeping.core.v1beta1":    false,
+		"uptimeping.core.v2alpha1":   false,
+		"google.protobuf":            false,
+		"uptimeping.notification.v1": true,
+	} {
+		if got := IsStable(pkg); got != want {
+			t.Errorf("IsStable(%q) = %v, want %v", pkg, got, want)
+		}
+	}
+}
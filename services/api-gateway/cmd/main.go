@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -14,11 +15,15 @@ import (
 	"UptimePingPlatform/pkg/health"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/metrics"
+	"UptimePingPlatform/pkg/ratelimit"
 	pkg_redis "UptimePingPlatform/pkg/redis"
+	"UptimePingPlatform/pkg/webhooks"
 
 	"UptimePingPlatform/services/api-gateway/internal/client"
 	httpHandler "UptimePingPlatform/services/api-gateway/internal/handler/http"
+	"UptimePingPlatform/services/api-gateway/internal/ingest"
 	"UptimePingPlatform/services/api-gateway/internal/middleware"
+	"UptimePingPlatform/services/api-gateway/internal/router"
 )
 
 // HealthHandlerAdapter адаптер для health.SimpleHealthChecker
@@ -53,6 +58,7 @@ func main() {
 	defer appLogger.Sync()
 
 	appLogger.Info("Starting API Gateway...")
+	appLogger.Info("Effective configuration", logger.Any("config", cfg.Redacted()))
 
 	// Initialize metrics
 	appMetrics := metrics.NewMetrics("api-gateway")
@@ -109,7 +115,7 @@ func main() {
 	if metricsClient, err = client.NewMetricsClient("metrics-service:50055", 2*time.Second, appLogger); err != nil {
 		appLogger.Warn("Metrics Service client creation failed, continuing without it", logger.Error(err))
 	}
-	if incidentClient, err = client.NewIncidentClient("incident-manager:50056", 2*time.Second, appLogger); err != nil {
+	if incidentClient, err = client.NewIncidentClient("incident-manager:50056", 2*time.Second, cfg.GRPC.ServiceToken, appLogger); err != nil {
 		appLogger.Warn("Incident Service client creation failed, continuing without it", logger.Error(err))
 	}
 	if notificationClient, err = client.NewNotificationClient("notification-service:50057", 2*time.Second, appLogger); err != nil {
@@ -139,10 +145,82 @@ func main() {
 		appLogger,
 	)
 
+	// Настройки арендаторов обслуживает TenantSettingsService в auth-service
+	settingsAddr := os.Getenv("SETTINGS_SERVICE_ADDR")
+	if settingsAddr == "" {
+		settingsAddr = "auth-service:50058"
+	}
+	if settingsClient, err := client.NewSettingsClient(settingsAddr, 2*time.Second, appLogger); err != nil {
+		appLogger.Warn("Settings Service client creation failed, continuing without it", logger.Error(err))
+	} else {
+		httpHandlerInstance.WithSettingsClient(settingsClient)
+	}
+
+	// Load external alert sources for webhook ingestion
+	if sourcesFile := os.Getenv("INGEST_SOURCES_FILE"); sourcesFile != "" {
+		ingestSources, err := ingest.LoadRegistry(sourcesFile)
+		if err != nil {
+			appLogger.Warn("Failed to load ingest sources, alert ingestion disabled", logger.Error(err))
+		} else {
+			httpHandlerInstance.WithIngestSources(ingestSources)
+			if redisClient != nil {
+				httpHandlerInstance.WithWebhookReplayCache(webhooks.NewRedisReplayCache(redisClient.Client, "ingest"))
+			}
+			appLogger.Info(fmt.Sprintf("Loaded %d alert ingest sources", ingestSources.Len()))
+		}
+	}
+
+	// Лимиты запросов по классам маршрутов таблицы router.Routes
+	if redisClient != nil {
+		trustedProxies, err := ratelimit.ParseTrustedProxies(cfg.RateLimiting.TrustedProxies)
+		if err != nil {
+			appLogger.Warn("Invalid trusted proxies, rate limiting disabled", logger.Error(err))
+		} else {
+			httpHandlerInstance.WithRateLimits(
+				ratelimit.NewRedisRateLimiter(redisClient.Client),
+				router.DefaultRateLimits(cfg.RateLimiting.RequestsPerMinute),
+				trustedProxies,
+			).WithRateLimitWarning(cfg.RateLimiting.WarnThreshold)
+		}
+	}
+
+	// Load per-route request/response body logging for debugging integrations
+	var bodyLogger *middleware.BodyLogger
+	if bodyLogFile := os.Getenv("HTTP_BODY_LOG_FILE"); bodyLogFile != "" {
+		bodyLogConfig, err := middleware.LoadBodyLoggingConfig(bodyLogFile)
+		if err == nil {
+			bodyLogger, err = middleware.NewBodyLogger(bodyLogConfig)
+		}
+		if err != nil {
+			appLogger.Warn("Failed to load body logging config, body logging disabled", logger.Error(err))
+		} else {
+			appLogger.Info(fmt.Sprintf("Body logging enabled for %d routes", len(bodyLogConfig.Routes)))
+		}
+	}
+
+	// Режим обслуживания: включается в конфигурации и переключается без перезапуска по SIGHUP
+	maintenance := middleware.NewMaintenance(maintenanceState(cfg.Maintenance))
+	httpHandlerInstance.WithMaintenance(maintenance)
+	if cfg.Maintenance.ReadOnly {
+		appLogger.Warn("Maintenance mode is on, API is read-only", logger.String("message", cfg.Maintenance.Message))
+	}
+	go reloadMaintenanceOnSignal(maintenance, appLogger)
+
+	// Сессии веб-клиентов в cookie с CSRF защитой; bearer клиенты работают как раньше
+	if cfg.CookieAuth.Enabled {
+		httpHandlerInstance.WithCookieSession(cookieSession(cfg.CookieAuth))
+		appLogger.Info("Cookie sessions enabled for web clients",
+			logger.String("same_site", cfg.CookieAuth.SameSite),
+			logger.Bool("secure", cfg.CookieAuth.Secure))
+	}
+
 	// Start HTTP server with middleware
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
-		Handler: appMetrics.Middleware(middleware.AuthMiddleware(httpAuthClient, appLogger)(httpHandlerInstance)),
+		Addr: fmt.Sprintf(":%d", cfg.Server.Port),
+		// Аутентификация и проверка прав подключаются к маршрутам по таблице router.Routes
+		Handler: appMetrics.Middleware(middleware.LoggingMiddlewareWithBodies(appLogger, bodyLogger)(
+			middleware.CORSMiddleware(cfg.CORS.AllowedOrigins, appLogger)(httpHandlerInstance),
+		)),
 	}
 
 	// Start server
@@ -169,3 +247,45 @@ func main() {
 
 	appLogger.Info("Server stopped")
 }
+
+// cookieSession создает менеджер cookie сессий веб-клиентов из конфигурации
+func cookieSession(cfg config.CookieAuthConfig) *middleware.CookieSession {
+	sameSite := http.SameSiteStrictMode
+	if strings.EqualFold(cfg.SameSite, "lax") {
+		sameSite = http.SameSiteLaxMode
+	}
+	return middleware.NewCookieSession(middleware.CookieSessionOptions{
+		Domain:     cfg.Domain,
+		Secure:     cfg.Secure,
+		SameSite:   sameSite,
+		TTL:        cfg.SessionTTL,
+		CSRFSecret: []byte(cfg.CSRFSecret),
+	})
+}
+
+// maintenanceState переводит конфигурацию режима обслуживания в состояние переключателя
+func maintenanceState(cfg config.MaintenanceConfig) middleware.MaintenanceState {
+	return middleware.MaintenanceState{
+		ReadOnly:   cfg.ReadOnly,
+		Message:    cfg.Message,
+		RetryAfter: cfg.RetryAfter,
+	}
+}
+
+// reloadMaintenanceOnSignal перечитывает конфигурацию по SIGHUP и применяет режим обслуживания,
+// чтобы включать его на время миграций без перезапуска gateway
+func reloadMaintenanceOnSignal(maintenance *middleware.Maintenance, appLogger logger.Logger) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	for range hup {
+		cfg, err := config.LoadConfigWithAutoPath("dev")
+		if err != nil {
+			appLogger.Error("Failed to reload config, maintenance mode unchanged", logger.Error(err))
+			continue
+		}
+		maintenance.Set(maintenanceState(cfg.Maintenance))
+		appLogger.Warn("Maintenance mode reloaded",
+			logger.Bool("read_only", cfg.Maintenance.ReadOnly),
+			logger.String("message", cfg.Maintenance.Message))
+	}
+}
@@ -0,0 +1,316 @@
+package checkimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Source инструмент мониторинга, из которого импортируются проверки
+type Source string
+
+const (
+	SourceUptimeRobot Source = "uptimerobot"
+	SourcePingdom     Source = "pingdom"
+	SourceStatusCake  Source = "statuscake"
+)
+
+// Sources поддерживаемые источники импорта
+var Sources = []Source{SourceUptimeRobot, SourcePingdom, SourceStatusCake}
+
+// Границы интервала и таймаута, совпадают с ограничениями scheduler-service
+const (
+	minInterval    = 5
+	maxInterval    = 86400
+	minTimeout     = 1
+	maxTimeout     = 300
+	defaultTimeout = 30
+)
+
+// kind вид монитора исходного инструмента
+type kind string
+
+const (
+	kindHTTP    kind = "http"
+	kindKeyword kind = "keyword"
+	kindTCP     kind = "tcp"
+)
+
+// monitor монитор исходного инструмента, приведенный к общему виду
+type monitor struct {
+	ID       string
+	Name     string
+	Kind     kind
+	Type     string
+	URL      string
+	Host     string
+	Port     int
+	Method   string
+	Interval int
+	Timeout  int
+	Paused   bool
+	Tags     []string
+	Keyword  string
+	// Unsupported возможности монитора, которые не переносятся в проверку
+	Unsupported []string
+}
+
+// Check проверка платформы, полученная из монитора
+type Check struct {
+	SourceID string   `json:"source_id"`
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Target   string   `json:"target"`
+	Interval int      `json:"interval"`
+	Timeout  int      `json:"timeout"`
+	Enabled  bool     `json:"enabled"`
+	Method   string   `json:"method,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+// Issue расхождение монитора с возможностями платформы. Skipped означает, что монитор не импортирован
+type Issue struct {
+	SourceID string `json:"source_id"`
+	Name     string `json:"name"`
+	Feature  string `json:"feature"`
+	Message  string `json:"message"`
+	Skipped  bool   `json:"skipped"`
+}
+
+// Report результат преобразования экспорта: проверки для создания и отчет о неподдерживаемых возможностях
+type Report struct {
+	Source   Source  `json:"source"`
+	Total    int     `json:"total"`
+	Imported int     `json:"imported"`
+	Skipped  int     `json:"skipped"`
+	Checks   []Check `json:"checks"`
+	Issues   []Issue `json:"issues"`
+}
+
+// ParseSource разбирает имя источника
+func ParseSource(value string) (Source, error) {
+	for _, source := range Sources {
+		if string(source) == strings.ToLower(strings.TrimSpace(value)) {
+			return source, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported source %q: must be one of %v", value, Sources)
+}
+
+// Convert преобразует экспорт источника в проверки платформы. Формат (JSON или CSV)
+// определяется по содержимому
+func Convert(source Source, data []byte) (*Report, error) {
+	data = bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+	if len(data) == 0 {
+		return nil, fmt.Errorf("export is empty")
+	}
+	isJSON := data[0] == '{' || data[0] == '['
+
+	var monitors []monitor
+	var err error
+	switch source {
+	case SourceUptimeRobot:
+		if isJSON {
+			monitors, err = parseUptimeRobotJSON(data)
+		} else {
+			monitors, err = parseUptimeRobotCSV(data)
+		}
+	case SourcePingdom:
+		if isJSON {
+			monitors, err = parsePingdomJSON(data)
+		} else {
+			monitors, err = parsePingdomCSV(data)
+		}
+	case SourceStatusCake:
+		if isJSON {
+			monitors, err = parseStatusCakeJSON(data)
+		} else {
+			monitors, err = parseStatusCakeCSV(data)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported source %q", source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s export: %w", source, err)
+	}
+
+	report := &Report{Source: source, Total: len(monitors), Checks: []Check{}, Issues: []Issue{}}
+	for _, m := range monitors {
+		report.add(m)
+	}
+	return report, nil
+}
+
+// add преобразует монитор и записывает проверку или причину пропуска
+func (r *Report) add(m monitor) {
+	issue := func(feature, message string, skipped bool) {
+		r.Issues = append(r.Issues, Issue{SourceID: m.ID, Name: m.Name, Feature: feature, Message: message, Skipped: skipped})
+	}
+	skip := func(feature, message string) {
+		issue(feature, message, true)
+		r.Skipped++
+	}
+
+	if m.Name == "" {
+		m.Name = m.URL + m.Host
+	}
+
+	check := Check{SourceID: m.ID, Name: m.Name, Enabled: !m.Paused, Tags: m.Tags}
+	switch m.Kind {
+	case kindHTTP, kindKeyword:
+		target, err := httpTarget(m.URL)
+		if err != nil {
+			skip("url", err.Error())
+			return
+		}
+		check.Target = target
+		check.Type = strings.SplitN(target, ":", 2)[0]
+		if m.Method != "" && m.Method != "GET" {
+			check.Method = m.Method
+		}
+		if m.Kind == kindKeyword {
+			issue("keyword", fmt.Sprintf("keyword assertion %q is not imported, add a content check manually", m.Keyword), false)
+		}
+	case kindTCP:
+		if m.Host == "" || m.Port <= 0 || m.Port > 65535 {
+			skip("port", "port monitor requires host and port")
+			return
+		}
+		check.Type = "tcp"
+		check.Target = net.JoinHostPort(m.Host, strconv.Itoa(m.Port))
+	default:
+		skip("type", fmt.Sprintf("monitor type %q is not supported", m.Type))
+		return
+	}
+
+	check.Interval, check.Timeout = schedule(m.Interval, m.Timeout)
+	if m.Interval != 0 && check.Interval != m.Interval {
+		issue("interval", fmt.Sprintf("interval %ds adjusted to %ds", m.Interval, check.Interval), false)
+	}
+	if m.Timeout != 0 && check.Timeout != m.Timeout {
+		issue("timeout", fmt.Sprintf("timeout %ds adjusted to %ds", m.Timeout, check.Timeout), false)
+	}
+	for _, feature := range m.Unsupported {
+		issue(feature, feature+" is not supported and was not imported", false)
+	}
+
+	r.Checks = append(r.Checks, check)
+	r.Imported++
+}
+
+// httpTarget проверяет URL монитора; адрес без схемы считается http
+func httpTarget(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("monitor has no url")
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return "", fmt.Errorf("invalid url %q", raw)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("url scheme %q is not supported", parsed.Scheme)
+	}
+	return parsed.String(), nil
+}
+
+// schedule приводит интервал и таймаут к границам платформы; таймаут меньше интервала
+func schedule(interval, timeout int) (int, int) {
+	if interval <= 0 {
+		interval = 300
+	}
+	interval = clamp(interval, minInterval, maxInterval)
+
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	timeout = clamp(timeout, minTimeout, maxTimeout)
+	if timeout >= interval {
+		timeout = interval - 1
+	}
+	return interval, timeout
+}
+
+func clamp(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// csvRows читает CSV с заголовком; имена колонок приводятся к нижнему регистру с подчеркиваниями
+func csvRows(data []byte) ([]map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("csv header is missing")
+	}
+
+	header := make([]string, len(records[0]))
+	for i, name := range records[0] {
+		header[i] = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "_")
+	}
+
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, value := range record {
+			if i < len(header) {
+				row[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// column возвращает первое непустое значение из колонок names
+func column(row map[string]string, names ...string) string {
+	for _, name := range names {
+		if value := row[name]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// atoi разбирает число; пустое или неверное значение - 0
+func atoi(value string) int {
+	n, _ := strconv.Atoi(strings.TrimSpace(value))
+	return n
+}
+
+// parseBool разбирает флаг экспорта: true, yes, 1, paused
+func parseBool(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "1", "paused":
+		return true
+	}
+	return false
+}
+
+// splitTags разбирает список тегов через запятую или точку с запятой
+func splitTags(value string) []string {
+	var tags []string
+	for _, tag := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,134 @@
+package checkimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSource(t *testing.T) {
+	source, err := ParseSource(" UptimeRobot ")
+	require.NoError(t, err)
+	assert.Equal(t, SourceUptimeRobot, source)
+
+	_, err = ParseSource("nagios")
+	assert.Error(t, err)
+}
+
+func TestConvert_UptimeRobotJSON(t *testing.T) {
+	export := `{"stat":"ok","monitors":[
+		{"id":1,"friendly_name":"Site","url":"https://example.com","type":1,"http_method":3,"interval":60,"timeout":30,"status":2},
+		{"id":2,"friendly_name":"Login","url":"example.com/login","type":2,"keyword_value":"Welcome","interval":300,"status":0,"alert_contacts":[{"id":7}]},
+		{"id":3,"friendly_name":"DB","url":"db.example.com","type":4,"sub_type":99,"port":5432,"interval":1},
+		{"id":4,"friendly_name":"SMTP","url":"mail.example.com","type":4,"sub_type":4},
+		{"id":5,"friendly_name":"Ping","url":"10.0.0.1","type":3},
+		{"id":6,"friendly_name":"Cron","url":"","type":5}
+	]}`
+
+	report, err := Convert(SourceUptimeRobot, []byte(export))
+	require.NoError(t, err)
+
+	assert.Equal(t, 6, report.Total)
+	assert.Equal(t, 4, report.Imported)
+	assert.Equal(t, 2, report.Skipped)
+	assert.Equal(t, []Check{
+		{SourceID: "1", Name: "Site", Type: "https", Target: "https://example.com", Interval: 60, Timeout: 30, Enabled: true, Method: "POST"},
+		{SourceID: "2", Name: "Login", Type: "http", Target: "http://example.com/login", Interval: 300, Timeout: 30, Enabled: false},
+		{SourceID: "3", Name: "DB", Type: "tcp", Target: "db.example.com:5432", Interval: 5, Timeout: 4, Enabled: true},
+		{SourceID: "4", Name: "SMTP", Type: "tcp", Target: "mail.example.com:25", Interval: 300, Timeout: 30, Enabled: true},
+	}, report.Checks)
+
+	features := make(map[string]Issue)
+	for _, issue := range report.Issues {
+		features[issue.SourceID+"/"+issue.Feature] = issue
+	}
+	assert.Contains(t, features, "2/keyword")
+	assert.Contains(t, features, "2/alert contacts")
+	assert.Contains(t, features, "3/interval")
+	assert.True(t, features["5/type"].Skipped)
+	assert.True(t, features["6/type"].Skipped)
+	assert.False(t, features["2/keyword"].Skipped)
+}
+
+func TestConvert_UptimeRobotCSV(t *testing.T) {
+	export := "\xef\xbb\xbfFriendly Name,URL,Type,Port,Interval,Status\n" +
+		"Site,https://example.com,HTTP(s),,120,Up\n" +
+		"Cache,cache.local,Port,6379,60,Paused\n" +
+		"Router,192.168.0.1,Ping,,60,Up\n"
+
+	report, err := Convert(SourceUptimeRobot, []byte(export))
+	require.NoError(t, err)
+
+	require.Len(t, report.Checks, 2)
+	assert.Equal(t, "https://example.com", report.Checks[0].Target)
+	assert.Equal(t, 120, report.Checks[0].Interval)
+	assert.Equal(t, "cache.local:6379", report.Checks[1].Target)
+	assert.False(t, report.Checks[1].Enabled)
+	assert.Equal(t, 1, report.Skipped)
+}
+
+func TestConvert_PingdomJSON(t *testing.T) {
+	list := `{"checks":[
+		{"id":85975,"name":"Shop","hostname":"shop.example.com","type":"http","resolution":5,"status":"up","tags":[{"name":"prod","type":"u","count":1}]},
+		{"id":85976,"name":"DNS","hostname":"example.com","type":"dns","resolution":1,"status":"up"}
+	]}`
+	report, err := Convert(SourcePingdom, []byte(list))
+	require.NoError(t, err)
+	require.Len(t, report.Checks, 1)
+	assert.Equal(t, Check{SourceID: "85975", Name: "Shop", Type: "http", Target: "http://shop.example.com", Interval: 300, Timeout: 30, Enabled: true, Tags: []string{"prod"}}, report.Checks[0])
+	assert.Equal(t, 1, report.Skipped)
+
+	detailed := `{"check":{"id":1,"name":"API","hostname":"api.example.com","resolution":1,"status":"paused",
+		"type":{"http":{"url":"/health","encryption":true,"port":8443,"shouldcontain":"ok","requestheaders":{"X-Token":"1"}}}}}`
+	report, err = Convert(SourcePingdom, []byte(detailed))
+	require.NoError(t, err)
+	require.Len(t, report.Checks, 1)
+	assert.Equal(t, "https://api.example.com:8443/health", report.Checks[0].Target)
+	assert.Equal(t, 60, report.Checks[0].Interval)
+	assert.False(t, report.Checks[0].Enabled)
+
+	var features []string
+	for _, issue := range report.Issues {
+		features = append(features, issue.Feature)
+	}
+	assert.ElementsMatch(t, []string{"keyword", "request headers"}, features)
+}
+
+func TestConvert_StatusCake(t *testing.T) {
+	export := `{"data":[
+		{"id":"1","name":"Home","website_url":"https://example.com","test_type":"HEAD","check_rate":30,"timeout":40,"paused":false,"tags":["web"]},
+		{"id":"2","name":"SSH","website_url":"ssh.example.com","test_type":"SSH","check_rate":60},
+		{"id":"3","name":"Redis","website_url":"tcp://redis.example.com","test_type":"TCP","port":6379,"check_rate":60,"contact_groups":["5"]}
+	]}`
+	report, err := Convert(SourceStatusCake, []byte(export))
+	require.NoError(t, err)
+
+	require.Len(t, report.Checks, 2)
+	assert.Equal(t, Check{SourceID: "1", Name: "Home", Type: "https", Target: "https://example.com", Interval: 30, Timeout: 29, Enabled: true, Method: "HEAD", Tags: []string{"web"}}, report.Checks[0])
+	assert.Equal(t, "redis.example.com:6379", report.Checks[1].Target)
+	assert.Equal(t, 1, report.Skipped)
+
+	csvExport := "Name,Website URL,Test Type,Check Rate,Paused,Find String,Tags\n" +
+		"Docs,https://docs.example.com,HTTP,300,yes,Documentation,\"docs;public\"\n"
+	report, err = Convert(SourceStatusCake, []byte(csvExport))
+	require.NoError(t, err)
+	require.Len(t, report.Checks, 1)
+	assert.False(t, report.Checks[0].Enabled)
+	assert.Equal(t, []string{"docs", "public"}, report.Checks[0].Tags)
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, "keyword", report.Issues[0].Feature)
+}
+
+func TestConvert_InvalidExport(t *testing.T) {
+	_, err := Convert(SourceUptimeRobot, []byte("  "))
+	assert.Error(t, err)
+
+	_, err = Convert(SourcePingdom, []byte(`{"checks":[{"id":1,"type":42}]}`))
+	assert.Error(t, err)
+
+	report, err := Convert(SourceStatusCake, []byte(`{"data":[{"id":"1","name":"Bad","website_url":"ftp://files.example.com","test_type":"HTTP"}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, report.Checks)
+	assert.True(t, report.Issues[0].Skipped)
+}
@@ -0,0 +1,175 @@
+package checkimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pingdomTypeDetails параметры проверки Pingdom в детальном ответе (type - объект с одним ключом)
+type pingdomTypeDetails struct {
+	URL              string            `json:"url"`
+	Encryption       bool              `json:"encryption"`
+	Port             int               `json:"port"`
+	ShouldContain    string            `json:"shouldcontain"`
+	ShouldNotContain string            `json:"shouldnotcontain"`
+	PostData         string            `json:"postdata"`
+	RequestHeaders   map[string]string `json:"requestheaders"`
+	Auth             json.RawMessage   `json:"auth"`
+}
+
+// pingdomCheck проверка из ответа Pingdom API 3.1 /checks или /checks/{id}
+type pingdomCheck struct {
+	ID         json.Number     `json:"id"`
+	Name       string          `json:"name"`
+	Hostname   string          `json:"hostname"`
+	Type       json.RawMessage `json:"type"`
+	Resolution int             `json:"resolution"`
+	Status     string          `json:"status"`
+	Tags       []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+	IntegrationIDs []int `json:"integrationids"`
+}
+
+// parsePingdomJSON разбирает ответ /checks (поле checks), /checks/{id} (поле check) или массив проверок
+func parsePingdomJSON(data []byte) ([]monitor, error) {
+	var items []pingdomCheck
+	if data[0] == '[' {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+	} else {
+		var export struct {
+			Checks []pingdomCheck `json:"checks"`
+			Check  *pingdomCheck  `json:"check"`
+		}
+		if err := json.Unmarshal(data, &export); err != nil {
+			return nil, err
+		}
+		items = export.Checks
+		if export.Check != nil {
+			items = append(items, *export.Check)
+		}
+	}
+
+	monitors := make([]monitor, 0, len(items))
+	for _, item := range items {
+		typeName, details, err := pingdomType(item.Type)
+		if err != nil {
+			return nil, fmt.Errorf("check %s: %w", item.ID, err)
+		}
+
+		tags := make([]string, 0, len(item.Tags))
+		for _, tag := range item.Tags {
+			tags = append(tags, tag.Name)
+		}
+		m := newPingdomMonitor(item.ID.String(), item.Name, item.Hostname, typeName, details)
+		m.Interval = item.Resolution * 60
+		m.Paused = item.Status == "paused"
+		m.Tags = tags
+		if len(item.IntegrationIDs) > 0 {
+			m.Unsupported = append(m.Unsupported, "integrations")
+		}
+		monitors = append(monitors, m)
+	}
+	return monitors, nil
+}
+
+// pingdomType разбирает type: строку в списке проверок или объект с параметрами в детальном ответе
+func pingdomType(raw json.RawMessage) (string, pingdomTypeDetails, error) {
+	var details pingdomTypeDetails
+	if len(raw) == 0 {
+		return "", details, nil
+	}
+
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name, details, nil
+	}
+
+	var typed map[string]pingdomTypeDetails
+	if err := json.Unmarshal(raw, &typed); err != nil {
+		return "", details, fmt.Errorf("invalid type: %w", err)
+	}
+	for name, details := range typed {
+		return name, details, nil
+	}
+	return "", details, nil
+}
+
+// parsePingdomCSV разбирает CSV экспорт Pingdom с колонками Name, Hostname, Type, URL,
+// Port, Resolution (в минутах), Status и Tags
+func parsePingdomCSV(data []byte) ([]monitor, error) {
+	rows, err := csvRows(data)
+	if err != nil {
+		return nil, err
+	}
+
+	monitors := make([]monitor, 0, len(rows))
+	for i, row := range rows {
+		id := column(row, "id", "check_id")
+		if id == "" {
+			id = strconv.Itoa(i + 1)
+		}
+		details := pingdomTypeDetails{
+			URL:           column(row, "url", "path"),
+			Encryption:    parseBool(column(row, "encryption", "ssl")),
+			Port:          atoi(column(row, "port")),
+			ShouldContain: column(row, "should_contain", "shouldcontain"),
+		}
+
+		m := newPingdomMonitor(id, column(row, "name", "check_name"), column(row, "hostname", "host"), strings.ToLower(column(row, "type")), details)
+		m.Interval = atoi(column(row, "resolution", "check_resolution")) * 60
+		m.Paused = strings.EqualFold(column(row, "status"), "paused")
+		m.Tags = splitTags(column(row, "tags"))
+		monitors = append(monitors, m)
+	}
+	return monitors, nil
+}
+
+// newPingdomMonitor определяет вид проверки Pingdom; путь и шифрование http проверки собираются в URL
+func newPingdomMonitor(id, name, hostname, typeName string, details pingdomTypeDetails) monitor {
+	m := monitor{ID: id, Name: name, Type: typeName}
+	switch typeName {
+	case "http":
+		m.Kind = kindHTTP
+		scheme := "http"
+		if details.Encryption {
+			scheme = "https"
+		}
+		host := hostname
+		if details.Port != 0 && !(scheme == "http" && details.Port == 80) && !(scheme == "https" && details.Port == 443) {
+			host = fmt.Sprintf("%s:%d", hostname, details.Port)
+		}
+		path := details.URL
+		if strings.Contains(path, "://") {
+			m.URL = path
+		} else {
+			if path != "" && !strings.HasPrefix(path, "/") {
+				path = "/" + path
+			}
+			m.URL = scheme + "://" + host + path
+		}
+		if details.ShouldContain != "" {
+			m.Kind, m.Keyword = kindKeyword, details.ShouldContain
+		}
+		if details.ShouldNotContain != "" {
+			m.Unsupported = append(m.Unsupported, "should-not-contain assertion")
+		}
+		if details.PostData != "" {
+			m.Method = "POST"
+			m.Unsupported = append(m.Unsupported, "request body")
+		}
+		if len(details.RequestHeaders) > 0 {
+			m.Unsupported = append(m.Unsupported, "request headers")
+		}
+		if len(details.Auth) > 0 && string(details.Auth) != "null" {
+			m.Unsupported = append(m.Unsupported, "http authentication")
+		}
+	case "tcp":
+		m.Kind, m.Host, m.Port = kindTCP, hostname, details.Port
+	}
+	return m
+}
@@ -0,0 +1,126 @@
+package checkimport
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// statusCakeTest тест из ответа StatusCake API v1 /uptime
+type statusCakeTest struct {
+	ID            json.Number `json:"id"`
+	Name          string      `json:"name"`
+	WebsiteURL    string      `json:"website_url"`
+	TestType      string      `json:"test_type"`
+	CheckRate     int         `json:"check_rate"`
+	Timeout       int         `json:"timeout"`
+	Paused        bool        `json:"paused"`
+	Port          int         `json:"port"`
+	FindString    string      `json:"find_string"`
+	DoNotFind     bool        `json:"do_not_find"`
+	Tags          []string    `json:"tags"`
+	ContactGroups []string    `json:"contact_groups"`
+	Regions       []string    `json:"regions"`
+	BasicUsername string      `json:"basic_username"`
+	CustomHeader  string      `json:"custom_header"`
+	PostBody      string      `json:"post_body"`
+}
+
+// parseStatusCakeJSON разбирает ответ /uptime (поле data) или массив тестов
+func parseStatusCakeJSON(data []byte) ([]monitor, error) {
+	var items []statusCakeTest
+	if data[0] == '[' {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+	} else {
+		var export struct {
+			Data []statusCakeTest `json:"data"`
+		}
+		if err := json.Unmarshal(data, &export); err != nil {
+			return nil, err
+		}
+		items = export.Data
+	}
+
+	monitors := make([]monitor, 0, len(items))
+	for _, item := range items {
+		m := newStatusCakeMonitor(item.ID.String(), item.Name, item.WebsiteURL, item.TestType, item.Port)
+		m.Interval = item.CheckRate
+		m.Timeout = item.Timeout
+		m.Paused = item.Paused
+		m.Tags = item.Tags
+		if item.FindString != "" {
+			if item.DoNotFind {
+				m.Unsupported = append(m.Unsupported, "do-not-find assertion")
+			} else if m.Kind == kindHTTP {
+				m.Kind, m.Keyword = kindKeyword, item.FindString
+			}
+		}
+		if len(item.ContactGroups) > 0 {
+			m.Unsupported = append(m.Unsupported, "contact groups")
+		}
+		if len(item.Regions) > 0 {
+			m.Unsupported = append(m.Unsupported, "regions")
+		}
+		if item.BasicUsername != "" {
+			m.Unsupported = append(m.Unsupported, "http authentication")
+		}
+		if item.CustomHeader != "" {
+			m.Unsupported = append(m.Unsupported, "request headers")
+		}
+		if item.PostBody != "" {
+			m.Method = "POST"
+			m.Unsupported = append(m.Unsupported, "request body")
+		}
+		monitors = append(monitors, m)
+	}
+	return monitors, nil
+}
+
+// parseStatusCakeCSV разбирает CSV экспорт StatusCake с колонками Name, Website URL, Test Type,
+// Port, Check Rate (в секундах), Timeout, Paused, Find String и Tags
+func parseStatusCakeCSV(data []byte) ([]monitor, error) {
+	rows, err := csvRows(data)
+	if err != nil {
+		return nil, err
+	}
+
+	monitors := make([]monitor, 0, len(rows))
+	for i, row := range rows {
+		id := column(row, "id", "test_id")
+		if id == "" {
+			id = strconv.Itoa(i + 1)
+		}
+
+		m := newStatusCakeMonitor(id, column(row, "name", "website_name"), column(row, "website_url", "url"), column(row, "test_type", "type"), atoi(column(row, "port")))
+		m.Interval = atoi(column(row, "check_rate"))
+		m.Timeout = atoi(column(row, "timeout"))
+		m.Paused = parseBool(column(row, "paused"))
+		m.Tags = splitTags(column(row, "tags"))
+		if findString := column(row, "find_string"); findString != "" && m.Kind == kindHTTP {
+			m.Kind, m.Keyword = kindKeyword, findString
+		}
+		monitors = append(monitors, m)
+	}
+	return monitors, nil
+}
+
+// newStatusCakeMonitor определяет вид теста StatusCake; у TCP теста хост берется из website_url
+func newStatusCakeMonitor(id, name, websiteURL, testType string, port int) monitor {
+	m := monitor{ID: id, Name: name, URL: websiteURL, Type: strings.ToLower(testType)}
+	switch m.Type {
+	case "http":
+		m.Kind = kindHTTP
+	case "head":
+		m.Kind, m.Method = kindHTTP, "HEAD"
+	case "tcp":
+		m.Kind, m.Port = kindTCP, port
+		m.Host = websiteURL
+		if parsed, err := url.Parse(websiteURL); err == nil && parsed.Hostname() != "" {
+			m.Host = parsed.Hostname()
+		}
+	}
+	return m
+}
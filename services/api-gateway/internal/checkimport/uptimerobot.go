@@ -0,0 +1,149 @@
+package checkimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Типы мониторов UptimeRobot
+const (
+	uptimeRobotHTTP      = 1
+	uptimeRobotKeyword   = 2
+	uptimeRobotPing      = 3
+	uptimeRobotPort      = 4
+	uptimeRobotHeartbeat = 5
+)
+
+// uptimeRobotTypeNames названия типов в CSV экспорте UptimeRobot
+var uptimeRobotTypeNames = map[string]int{
+	"http":      uptimeRobotHTTP,
+	"https":     uptimeRobotHTTP,
+	"http(s)":   uptimeRobotHTTP,
+	"keyword":   uptimeRobotKeyword,
+	"ping":      uptimeRobotPing,
+	"port":      uptimeRobotPort,
+	"heartbeat": uptimeRobotHeartbeat,
+}
+
+// uptimeRobotMethods коды http_method UptimeRobot
+var uptimeRobotMethods = map[int]string{1: "HEAD", 2: "GET", 3: "POST", 4: "PUT", 5: "PATCH", 6: "DELETE", 7: "OPTIONS"}
+
+// uptimeRobotPorts порты предопределенных sub_type мониторов порта
+var uptimeRobotPorts = map[int]int{1: 80, 2: 443, 3: 21, 4: 25, 5: 110, 6: 143}
+
+// uptimeRobotStatusPaused статус приостановленного монитора
+const uptimeRobotStatusPaused = 0
+
+// uptimeRobotMonitor монитор из ответа getMonitors API v2
+type uptimeRobotMonitor struct {
+	ID            json.Number `json:"id"`
+	FriendlyName  string      `json:"friendly_name"`
+	URL           string      `json:"url"`
+	Type          int         `json:"type"`
+	SubType       json.Number `json:"sub_type"`
+	Port          json.Number `json:"port"`
+	KeywordValue  string      `json:"keyword_value"`
+	HTTPMethod    int         `json:"http_method"`
+	HTTPUsername  string      `json:"http_username"`
+	Interval      int         `json:"interval"`
+	Timeout       int         `json:"timeout"`
+	Status        *int        `json:"status"`
+	AlertContacts []struct {
+		ID json.Number `json:"id"`
+	} `json:"alert_contacts"`
+}
+
+// parseUptimeRobotJSON разбирает ответ getMonitors: объект с monitors или массив мониторов
+func parseUptimeRobotJSON(data []byte) ([]monitor, error) {
+	var items []uptimeRobotMonitor
+	if data[0] == '[' {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+	} else {
+		var export struct {
+			Monitors []uptimeRobotMonitor `json:"monitors"`
+		}
+		if err := json.Unmarshal(data, &export); err != nil {
+			return nil, err
+		}
+		items = export.Monitors
+	}
+
+	monitors := make([]monitor, 0, len(items))
+	for _, item := range items {
+		subType, _ := strconv.Atoi(item.SubType.String())
+		port, _ := strconv.Atoi(item.Port.String())
+		m := newUptimeRobotMonitor(item.ID.String(), item.FriendlyName, item.URL, item.Type, subType, port)
+		m.Method = uptimeRobotMethods[item.HTTPMethod]
+		m.Keyword = item.KeywordValue
+		m.Interval = item.Interval
+		m.Timeout = item.Timeout
+		m.Paused = item.Status != nil && *item.Status == uptimeRobotStatusPaused
+		if item.HTTPUsername != "" {
+			m.Unsupported = append(m.Unsupported, "http authentication")
+		}
+		if len(item.AlertContacts) > 0 {
+			m.Unsupported = append(m.Unsupported, "alert contacts")
+		}
+		monitors = append(monitors, m)
+	}
+	return monitors, nil
+}
+
+// parseUptimeRobotCSV разбирает CSV экспорт UptimeRobot с колонками Friendly Name, URL, Type,
+// Port, Interval (в секундах), Keyword и Status
+func parseUptimeRobotCSV(data []byte) ([]monitor, error) {
+	rows, err := csvRows(data)
+	if err != nil {
+		return nil, err
+	}
+
+	monitors := make([]monitor, 0, len(rows))
+	for i, row := range rows {
+		typeName := strings.ToLower(column(row, "type", "monitor_type"))
+		monitorType, ok := uptimeRobotTypeNames[typeName]
+		if !ok {
+			monitorType = atoi(typeName)
+		}
+		id := column(row, "id", "monitor_id")
+		if id == "" {
+			id = strconv.Itoa(i + 1)
+		}
+
+		m := newUptimeRobotMonitor(id, column(row, "friendly_name", "name"), column(row, "url", "url/ip"), monitorType, 0, atoi(column(row, "port")))
+		if monitorType == 0 {
+			m.Type = typeName
+		}
+		m.Keyword = column(row, "keyword", "keyword_value")
+		m.Interval = atoi(column(row, "interval", "monitoring_interval"))
+		m.Paused = strings.EqualFold(column(row, "status"), "paused")
+		monitors = append(monitors, m)
+	}
+	return monitors, nil
+}
+
+// newUptimeRobotMonitor определяет вид монитора UptimeRobot по типу и подтипу
+func newUptimeRobotMonitor(id, name, rawURL string, monitorType, subType, port int) monitor {
+	m := monitor{ID: id, Name: name, URL: rawURL, Type: fmt.Sprintf("%d", monitorType)}
+	switch monitorType {
+	case uptimeRobotHTTP:
+		m.Kind, m.Type = kindHTTP, "http"
+	case uptimeRobotKeyword:
+		m.Kind, m.Type = kindKeyword, "keyword"
+	case uptimeRobotPort:
+		m.Kind, m.Type = kindTCP, "port"
+		m.Host = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(rawURL, "http://"), "https://"), "/")
+		m.Port = port
+		if m.Port == 0 {
+			m.Port = uptimeRobotPorts[subType]
+		}
+	case uptimeRobotPing:
+		m.Type = "ping"
+	case uptimeRobotHeartbeat:
+		m.Type = "heartbeat"
+	}
+	return m
+}
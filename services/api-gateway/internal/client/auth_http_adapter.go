@@ -16,7 +16,7 @@ type AuthHTTPClientInterface interface {
 	Register(ctx context.Context, email, password, tenantName string) (*TokenPair, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
 	ValidateToken(ctx context.Context, accessToken string) (*UserInfo, error)
-	ValidateAPIKey(ctx context.Context, key, secret string) (*APIKeyClaims, error)
+	ValidateAPIKey(ctx context.Context, key, secret, clientIP string) (*APIKeyClaims, error)
 	Logout(ctx context.Context, accessToken string) error
 }
 
@@ -36,6 +36,13 @@ type UserInfo struct {
 	Roles       []string `json:"roles"`
 	Permissions []string `json:"permissions"`
 	ExpiresAt   int64    `json:"expires_at"`
+	// ImpersonatedBy оператор поддержки, которому выдан токен имперсонации тенанта
+	ImpersonatedBy  string `json:"impersonated_by,omitempty"`
+	ImpersonationID string `json:"impersonation_id,omitempty"`
+	// OrganizationID организация тенанта токена, OrgTenants - тенанты для переключателя
+	OrganizationID string   `json:"org_id,omitempty"`
+	OrgRole        string   `json:"org_role,omitempty"`
+	OrgTenants     []string `json:"org_tenants,omitempty"`
 }
 
 // APIKeyClaims содержит информацию об API ключе
@@ -135,16 +142,17 @@ func (a *AuthHTTPAdapter) ValidateToken(ctx context.Context, accessToken string)
 	return &userInfo, nil
 }
 
-// ValidateAPIKey проверяет валидность API ключа
-func (a *AuthHTTPAdapter) ValidateAPIKey(ctx context.Context, key, secret string) (*APIKeyClaims, error) {
+// ValidateAPIKey проверяет валидность API ключа, предъявленного клиентом с адреса clientIP
+func (a *AuthHTTPAdapter) ValidateAPIKey(ctx context.Context, key, secret, clientIP string) (*APIKeyClaims, error) {
 	if key == "" || secret == "" {
 		return nil, fmt.Errorf("ключ и секрет не могут быть пустыми")
 	}
 
 	// Создаем HTTP запрос для валидации API ключа
 	body := map[string]interface{}{
-		"key":    key,
-		"secret": secret,
+		"key":       key,
+		"secret":    secret,
+		"client_ip": clientIP,
 	}
 
 	jsonBody, err := json.Marshal(body)
@@ -236,13 +236,15 @@ func (c *HTTPAuthClient) ValidateToken(ctx context.Context, accessToken string)
 	return &userInfo, nil
 }
 
-// ValidateAPIKey проверяет валидность API ключа через HTTP API
-func (c *HTTPAuthClient) ValidateAPIKey(ctx context.Context, key, secret string) (*APIKeyClaims, error) {
+// ValidateAPIKey проверяет валидность API ключа через HTTP API. clientIP нужен auth-service
+// для проверки списка разрешенных адресов ключа
+func (c *HTTPAuthClient) ValidateAPIKey(ctx context.Context, key, secret, clientIP string) (*APIKeyClaims, error) {
 	c.logger.Info("выполнение ValidateAPIKey через HTTP")
 
 	body := map[string]interface{}{
-		"key":    key,
-		"secret": secret,
+		"key":       key,
+		"secret":    secret,
+		"client_ip": clientIP,
 	}
 
 	jsonBody, err := json.Marshal(body)
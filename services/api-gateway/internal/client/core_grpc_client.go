@@ -34,8 +34,10 @@ func NewCoreClient(address string, timeout time.Duration, logger logger.Logger)
 		"timeout": timeout.String(),
 	})
 
-	// Устанавливаем соединение с gRPC сервером
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Устанавливаем соединение с gRPC сервером: round_robin по всем репликам из DNS
+	conn, err := grpc.Dial(grpcBase.BalancedTarget(address), grpcBase.BalancedDialOptions("",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)...)
 	if err != nil {
 		baseHandler.LogError(ctx, err, "grpc_core_client_connect_failed", "")
 		return nil, fmt.Errorf("failed to connect to core service: %w", err)
@@ -80,3 +82,23 @@ func (c *CoreClient) GetCheckStatus(ctx context.Context, req *corev1.GetCheckSta
 func (c *CoreClient) GetCheckHistory(ctx context.Context, req *corev1.GetCheckHistoryRequest) (*corev1.GetCheckHistoryResponse, error) {
 	return c.client.GetCheckHistory(ctx, req)
 }
+
+// RunCheck запускает разовую проверку по inline-определению и возвращает поток прогресса
+func (c *CoreClient) RunCheck(ctx context.Context, req *corev1.RunCheckRequest) (corev1.CoreService_RunCheckClient, error) {
+	return c.client.RunCheck(ctx, req)
+}
+
+// GetUptimeHistory получает дневную историю доступности проверок
+func (c *CoreClient) GetUptimeHistory(ctx context.Context, req *corev1.GetUptimeHistoryRequest) (*corev1.UptimeHistory, error) {
+	return c.client.GetUptimeHistory(ctx, req)
+}
+
+// ListArtifacts получает артефакты проверки
+func (c *CoreClient) ListArtifacts(ctx context.Context, req *corev1.ListArtifactsRequest) (*corev1.ListArtifactsResponse, error) {
+	return c.client.ListArtifacts(ctx, req)
+}
+
+// GetArtifactURL получает подписанную ссылку на артефакт проверки
+func (c *CoreClient) GetArtifactURL(ctx context.Context, req *corev1.GetArtifactURLRequest) (*corev1.ArtifactURL, error) {
+	return c.client.GetArtifactURL(ctx, req)
+}
@@ -34,8 +34,10 @@ func NewGRPCForgeClient(address string, timeout time.Duration, logger logger.Log
 		"timeout": timeout.String(),
 	})
 
-	// Устанавливаем соединение с gRPC сервером
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Устанавливаем соединение с gRPC сервером: round_robin по всем репликам из DNS
+	conn, err := grpc.Dial(grpcBase.BalancedTarget(address), grpcBase.BalancedDialOptions("",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)...)
 	if err != nil {
 		baseHandler.LogError(ctx, err, "grpc_forge_client_connect_failed", "")
 		return nil, fmt.Errorf("failed to connect to forge service: %w", err)
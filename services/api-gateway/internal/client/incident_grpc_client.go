@@ -20,8 +20,10 @@ type IncidentClient struct {
 	baseHandler *grpcBase.BaseHandler
 }
 
-// NewIncidentClient создает новый gRPC клиент для IncidentService
-func NewIncidentClient(address string, timeout time.Duration, logger logger.Logger) (*IncidentClient, error) {
+// NewIncidentClient создает новый gRPC клиент для IncidentService. Вызовы подписываются общим
+// секретом serviceToken: запросы без пользователя (прием алертов, страница статуса) Incident
+// Manager принимает только как внутренние
+func NewIncidentClient(address string, timeout time.Duration, serviceToken string, logger logger.Logger) (*IncidentClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -34,8 +36,11 @@ func NewIncidentClient(address string, timeout time.Duration, logger logger.Logg
 		"timeout": timeout.String(),
 	})
 
-	// Устанавливаем соединение с gRPC сервером
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Устанавливаем соединение с gRPC сервером: round_robin по всем репликам из DNS
+	conn, err := grpc.Dial(grpcBase.BalancedTarget(address), grpcBase.BalancedDialOptions("",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpcBase.WithServiceToken(serviceToken),
+	)...)
 	if err != nil {
 		baseHandler.LogError(ctx, err, "grpc_incident_client_connect_failed", "")
 		return nil, fmt.Errorf("failed to connect to incident service: %w", err)
@@ -89,3 +94,73 @@ func (c *IncidentClient) ListIncidents(ctx context.Context, req *incidentv1.List
 func (c *IncidentClient) ResolveIncident(ctx context.Context, req *incidentv1.ResolveIncidentRequest) (*incidentv1.ResolveIncidentResponse, error) {
 	return c.client.ResolveIncident(ctx, req)
 }
+
+// IngestAlert передает алерт внешней системы в IncidentService
+func (c *IncidentClient) IngestAlert(ctx context.Context, req *incidentv1.IngestAlertRequest) (*incidentv1.IngestAlertResponse, error) {
+	return c.client.IngestAlert(ctx, req)
+}
+
+// AcknowledgeIncident подтверждает инцидент
+func (c *IncidentClient) AcknowledgeIncident(ctx context.Context, req *incidentv1.AcknowledgeIncidentRequest) (*incidentv1.Incident, error) {
+	return c.client.AcknowledgeIncident(ctx, req)
+}
+
+// GetIncidentStats получает статистику инцидентов арендатора
+func (c *IncidentClient) GetIncidentStats(ctx context.Context, req *incidentv1.GetIncidentStatsRequest) (*incidentv1.IncidentStats, error) {
+	return c.client.GetIncidentStats(ctx, req)
+}
+
+// GetIncidentHistory получает события инцидента
+func (c *IncidentClient) GetIncidentHistory(ctx context.Context, req *incidentv1.GetIncidentHistoryRequest) (*incidentv1.GetIncidentHistoryResponse, error) {
+	return c.client.GetIncidentHistory(ctx, req)
+}
+
+// ListStatusComponents получает компоненты страницы статуса арендатора
+func (c *IncidentClient) ListStatusComponents(ctx context.Context, req *incidentv1.ListStatusComponentsRequest) (*incidentv1.ListStatusComponentsResponse, error) {
+	return c.client.ListStatusComponents(ctx, req)
+}
+
+// CreateStatusComponent создает компонент страницы статуса
+func (c *IncidentClient) CreateStatusComponent(ctx context.Context, req *incidentv1.CreateStatusComponentRequest) (*incidentv1.StatusComponent, error) {
+	return c.client.CreateStatusComponent(ctx, req)
+}
+
+// UpdateStatusComponent обновляет компонент страницы статуса
+func (c *IncidentClient) UpdateStatusComponent(ctx context.Context, req *incidentv1.UpdateStatusComponentRequest) (*incidentv1.StatusComponent, error) {
+	return c.client.UpdateStatusComponent(ctx, req)
+}
+
+// DeleteStatusComponent удаляет компонент страницы статуса
+func (c *IncidentClient) DeleteStatusComponent(ctx context.Context, req *incidentv1.DeleteStatusComponentRequest) (*incidentv1.DeleteStatusComponentResponse, error) {
+	return c.client.DeleteStatusComponent(ctx, req)
+}
+
+// SetComponentStatusOverride задает ручной статус компонента
+func (c *IncidentClient) SetComponentStatusOverride(ctx context.Context, req *incidentv1.SetComponentStatusOverrideRequest) (*incidentv1.StatusComponent, error) {
+	return c.client.SetComponentStatusOverride(ctx, req)
+}
+
+// ClearComponentStatusOverride снимает ручной статус компонента
+func (c *IncidentClient) ClearComponentStatusOverride(ctx context.Context, req *incidentv1.ClearComponentStatusOverrideRequest) (*incidentv1.StatusComponent, error) {
+	return c.client.ClearComponentStatusOverride(ctx, req)
+}
+
+// ScheduleMaintenance публикует объявление об обслуживании
+func (c *IncidentClient) ScheduleMaintenance(ctx context.Context, req *incidentv1.ScheduleMaintenanceRequest) (*incidentv1.MaintenanceAnnouncement, error) {
+	return c.client.ScheduleMaintenance(ctx, req)
+}
+
+// CancelMaintenance отменяет объявление об обслуживании
+func (c *IncidentClient) CancelMaintenance(ctx context.Context, req *incidentv1.CancelMaintenanceRequest) (*incidentv1.MaintenanceAnnouncement, error) {
+	return c.client.CancelMaintenance(ctx, req)
+}
+
+// ListMaintenances получает объявления об обслуживании арендатора
+func (c *IncidentClient) ListMaintenances(ctx context.Context, req *incidentv1.ListMaintenancesRequest) (*incidentv1.ListMaintenancesResponse, error) {
+	return c.client.ListMaintenances(ctx, req)
+}
+
+// GetStatusPage получает страницу статуса арендатора
+func (c *IncidentClient) GetStatusPage(ctx context.Context, req *incidentv1.GetStatusPageRequest) (*incidentv1.StatusPage, error) {
+	return c.client.GetStatusPage(ctx, req)
+}
@@ -11,11 +11,13 @@ import (
 	grpcBase "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/logger"
 	notificationv1 "UptimePingPlatform/proto/api/notification/v1"
+	notificationv1beta1 "UptimePingPlatform/proto/api/notification/v1beta1"
 )
 
 // NotificationClient gRPC клиент для NotificationService
 type NotificationClient struct {
 	client      notificationv1.NotificationServiceClient
+	beta        notificationv1beta1.NotificationServiceClient
 	conn        *grpc.ClientConn
 	baseHandler *grpcBase.BaseHandler
 }
@@ -34,8 +36,10 @@ func NewNotificationClient(address string, timeout time.Duration, logger logger.
 		"timeout": timeout.String(),
 	})
 
-	// Устанавливаем соединение с gRPC сервером
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Устанавливаем соединение с gRPC сервером: round_robin по всем репликам из DNS
+	conn, err := grpc.Dial(grpcBase.BalancedTarget(address), grpcBase.BalancedDialOptions("",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)...)
 	if err != nil {
 		baseHandler.LogError(ctx, err, "grpc_notification_client_connect_failed", "")
 		return nil, fmt.Errorf("failed to connect to notification service: %w", err)
@@ -56,6 +60,7 @@ func NewNotificationClient(address string, timeout time.Duration, logger logger.
 
 	return &NotificationClient{
 		client:      client,
+		beta:        notificationv1beta1.NewNotificationServiceClient(conn),
 		conn:        conn,
 		baseHandler: baseHandler,
 	}, nil
@@ -80,3 +85,8 @@ func (c *NotificationClient) GetNotificationChannels(ctx context.Context, req *n
 func (c *NotificationClient) RegisterChannel(ctx context.Context, req *notificationv1.RegisterChannelRequest) (*notificationv1.Channel, error) {
 	return c.client.RegisterChannel(ctx, req)
 }
+
+// RevealChannelSecrets возвращает канал с расшифрованными секретами конфигурации
+func (c *NotificationClient) RevealChannelSecrets(ctx context.Context, req *notificationv1beta1.RevealChannelSecretsRequest) (*notificationv1.Channel, error) {
+	return c.beta.RevealChannelSecrets(ctx, req)
+}
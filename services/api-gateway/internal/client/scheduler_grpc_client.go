@@ -11,11 +11,13 @@ import (
 	grpcBase "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/logger"
 	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	schedulerv1beta1 "UptimePingPlatform/proto/api/scheduler/v1beta1"
 )
 
 // SchedulerClient gRPC клиент для SchedulerService
 type SchedulerClient struct {
 	client      schedulerv1.SchedulerServiceClient
+	beta        schedulerv1beta1.SchedulerServiceClient
 	conn        *grpc.ClientConn
 	baseHandler *grpcBase.BaseHandler
 }
@@ -34,10 +36,10 @@ func NewSchedulerClient(address string, timeout time.Duration, logger logger.Log
 		"timeout": timeout.String(),
 	})
 
-	// Устанавливаем соединение с gRPC сервером с опциями
-	conn, err := grpc.DialContext(ctx, address,
+	// Устанавливаем соединение с gRPC сервером: round_robin по всем репликам из DNS
+	conn, err := grpc.DialContext(ctx, grpcBase.BalancedTarget(address), grpcBase.BalancedDialOptions("",
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	)...)
 	if err != nil {
 		baseHandler.LogError(ctx, err, "grpc_scheduler_client_connect_failed", "")
 		return nil, fmt.Errorf("failed to connect to scheduler service: %w", err)
@@ -52,6 +54,7 @@ func NewSchedulerClient(address string, timeout time.Duration, logger logger.Log
 
 	return &SchedulerClient{
 		client:      client,
+		beta:        schedulerv1beta1.NewSchedulerServiceClient(conn),
 		conn:        conn,
 		baseHandler: baseHandler,
 	}, nil
@@ -106,3 +109,63 @@ func (c *SchedulerClient) GetSchedule(ctx context.Context, req *schedulerv1.GetS
 func (c *SchedulerClient) ListSchedules(ctx context.Context, req *schedulerv1.ListSchedulesRequest) (*schedulerv1.ListSchedulesResponse, error) {
 	return c.client.ListSchedules(ctx, req)
 }
+
+// ListTags получает теги tenant с количеством использований
+func (c *SchedulerClient) ListTags(ctx context.Context, req *schedulerv1.ListTagsRequest) (*schedulerv1.ListTagsResponse, error) {
+	return c.client.ListTags(ctx, req)
+}
+
+// RenameTag переименовывает тег во всех проверках tenant
+func (c *SchedulerClient) RenameTag(ctx context.Context, req *schedulerv1.RenameTagRequest) (*schedulerv1.RenameTagResponse, error) {
+	return c.client.RenameTag(ctx, req)
+}
+
+// DeleteTag удаляет неиспользуемый тег
+func (c *SchedulerClient) DeleteTag(ctx context.Context, req *schedulerv1.DeleteTagRequest) (*schedulerv1.DeleteTagResponse, error) {
+	return c.client.DeleteTag(ctx, req)
+}
+
+// DeleteUnusedTags удаляет все неиспользуемые теги tenant
+func (c *SchedulerClient) DeleteUnusedTags(ctx context.Context, req *schedulerv1.DeleteUnusedTagsRequest) (*schedulerv1.DeleteUnusedTagsResponse, error) {
+	return c.client.DeleteUnusedTags(ctx, req)
+}
+
+// CreateGroup создает группу проверок
+func (c *SchedulerClient) CreateGroup(ctx context.Context, req *schedulerv1.CreateGroupRequest) (*schedulerv1.Group, error) {
+	return c.client.CreateGroup(ctx, req)
+}
+
+// GetGroup получает группу проверок по ID
+func (c *SchedulerClient) GetGroup(ctx context.Context, req *schedulerv1.GetGroupRequest) (*schedulerv1.Group, error) {
+	return c.client.GetGroup(ctx, req)
+}
+
+// ListGroups получает дерево групп tenant
+func (c *SchedulerClient) ListGroups(ctx context.Context, req *schedulerv1.ListGroupsRequest) (*schedulerv1.ListGroupsResponse, error) {
+	return c.client.ListGroups(ctx, req)
+}
+
+// UpdateGroup переименовывает или перемещает группу
+func (c *SchedulerClient) UpdateGroup(ctx context.Context, req *schedulerv1.UpdateGroupRequest) (*schedulerv1.Group, error) {
+	return c.client.UpdateGroup(ctx, req)
+}
+
+// DeleteGroup удаляет пустую группу
+func (c *SchedulerClient) DeleteGroup(ctx context.Context, req *schedulerv1.DeleteGroupRequest) (*schedulerv1.DeleteGroupResponse, error) {
+	return c.client.DeleteGroup(ctx, req)
+}
+
+// SetGroupEnabled включает или выключает группу вместе с поддеревом
+func (c *SchedulerClient) SetGroupEnabled(ctx context.Context, req *schedulerv1.SetGroupEnabledRequest) (*schedulerv1.SetGroupEnabledResponse, error) {
+	return c.client.SetGroupEnabled(ctx, req)
+}
+
+// GetFleetSummary возвращает число проверок tenant по состояниям
+func (c *SchedulerClient) GetFleetSummary(ctx context.Context, req *schedulerv1.GetFleetSummaryRequest) (*schedulerv1.FleetSummary, error) {
+	return c.client.GetFleetSummary(ctx, req)
+}
+
+// PreviewIntervalChange оценивает изменение нагрузки при новом интервале проверок с тегом
+func (c *SchedulerClient) PreviewIntervalChange(ctx context.Context, req *schedulerv1beta1.PreviewIntervalChangeRequest) (*schedulerv1beta1.IntervalChangePreview, error) {
+	return c.beta.PreviewIntervalChange(ctx, req)
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	grpcBase "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/logger"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+)
+
+// SettingsClient gRPC клиент для TenantSettingsService
+type SettingsClient struct {
+	client      configv1.TenantSettingsServiceClient
+	conn        *grpc.ClientConn
+	baseHandler *grpcBase.BaseHandler
+}
+
+// NewSettingsClient создает новый gRPC клиент для TenantSettingsService
+func NewSettingsClient(address string, timeout time.Duration, logger logger.Logger) (*SettingsClient, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	baseHandler := grpcBase.NewBaseHandler(logger)
+
+	baseHandler.LogOperationStart(ctx, "grpc_settings_client_connect", map[string]interface{}{
+		"address": address,
+		"timeout": timeout.String(),
+	})
+
+	// Устанавливаем соединение с gRPC сервером: round_robin по всем репликам из DNS
+	conn, err := grpc.Dial(grpcBase.BalancedTarget(address), grpcBase.BalancedDialOptions("",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)...)
+	if err != nil {
+		baseHandler.LogError(ctx, err, "grpc_settings_client_connect_failed", "")
+		return nil, fmt.Errorf("failed to connect to settings service: %w", err)
+	}
+
+	baseHandler.LogOperationSuccess(ctx, "grpc_settings_client_connect", map[string]interface{}{
+		"address": address,
+	})
+
+	return &SettingsClient{
+		client:      configv1.NewTenantSettingsServiceClient(conn),
+		conn:        conn,
+		baseHandler: baseHandler,
+	}, nil
+}
+
+// Close закрывает соединение
+func (c *SettingsClient) Close() error {
+	return c.conn.Close()
+}
+
+// GetTenantSettings возвращает настройки арендатора
+func (c *SettingsClient) GetTenantSettings(ctx context.Context, req *configv1.GetTenantSettingsRequest) (*configv1.TenantSettings, error) {
+	return c.client.GetTenantSettings(ctx, req)
+}
+
+// UpdateTenantSettings заменяет настройки арендатора
+func (c *SettingsClient) UpdateTenantSettings(ctx context.Context, req *configv1.UpdateTenantSettingsRequest) (*configv1.TenantSettings, error) {
+	return c.client.UpdateTenantSettings(ctx, req)
+}
+
+// ResetTenantSettings возвращает настройки арендатора к значениям по умолчанию
+func (c *SettingsClient) ResetTenantSettings(ctx context.Context, req *configv1.ResetTenantSettingsRequest) (*configv1.TenantSettings, error) {
+	return c.client.ResetTenantSettings(ctx, req)
+}
@@ -0,0 +1,312 @@
+package contract
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/api-gateway/internal/client"
+)
+
+// Поведенческие тесты поднимают gRPC серверы в процессе теста и проверяют, что клиенты gateway
+// передают ожидаемые поля и корректно обрабатывают ответы и коды ошибок
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...logger.Field)        {}
+func (nopLogger) Info(string, ...logger.Field)         {}
+func (nopLogger) Warn(string, ...logger.Field)         {}
+func (nopLogger) Error(string, ...logger.Field)        {}
+func (l nopLogger) With(...logger.Field) logger.Logger { return l }
+func (nopLogger) Sync() error                          { return nil }
+
+// startServer запускает gRPC сервер на свободном локальном порту
+func startServer(t *testing.T, register func(*grpc.Server)) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+type fakeScheduler struct {
+	schedulerv1.UnimplementedSchedulerServiceServer
+	created *schedulerv1.CreateCheckRequest
+	listed  *schedulerv1.ListChecksRequest
+}
+
+func (s *fakeScheduler) CreateCheck(_ context.Context, req *schedulerv1.CreateCheckRequest) (*schedulerv1.Check, error) {
+	s.created = req
+	return &schedulerv1.Check{
+		Id:       "check-1",
+		TenantId: req.TenantId,
+		Name:     req.Name,
+		Type:     req.Type,
+		Target:   req.Target,
+		Interval: req.Interval,
+		Tags:     req.Tags,
+		GroupId:  req.GroupId,
+	}, nil
+}
+
+func (s *fakeScheduler) GetCheck(_ context.Context, req *schedulerv1.GetCheckRequest) (*schedulerv1.Check, error) {
+	return nil, status.Errorf(codes.NotFound, "check %s not found", req.CheckId)
+}
+
+func (s *fakeScheduler) ListChecks(_ context.Context, req *schedulerv1.ListChecksRequest) (*schedulerv1.ListChecksResponse, error) {
+	s.listed = req
+	return &schedulerv1.ListChecksResponse{
+		Checks:        []*schedulerv1.Check{{Id: "check-1", TenantId: req.TenantId}},
+		NextPageToken: req.PageToken + 1,
+	}, nil
+}
+
+func TestSchedulerContract(t *testing.T) {
+	fake := &fakeScheduler{}
+	address := startServer(t, func(server *grpc.Server) {
+		schedulerv1.RegisterSchedulerServiceServer(server, fake)
+	})
+
+	schedulerClient, err := client.NewSchedulerClient(address, time.Second, nopLogger{})
+	require.NoError(t, err)
+	defer schedulerClient.Close()
+	ctx := testContext(t)
+
+	t.Run("create check round-trips fields used by the gateway", func(t *testing.T) {
+		check, err := schedulerClient.CreateCheck(ctx, &schedulerv1.CreateCheckRequest{
+			TenantId: "tenant-1",
+			Name:     "api",
+			Type:     "http",
+			Target:   "https://example.com",
+			Interval: 60,
+			Tags:     []string{"prod"},
+			GroupId:  "group-1",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "tenant-1", fake.created.TenantId)
+		assert.Equal(t, "check-1", check.Id)
+		assert.Equal(t, "tenant-1", check.TenantId)
+		assert.Equal(t, []string{"prod"}, check.Tags)
+		assert.Equal(t, "group-1", check.GroupId)
+	})
+
+	t.Run("list checks passes tenant and pagination", func(t *testing.T) {
+		resp, err := schedulerClient.ListChecks(ctx, &schedulerv1.ListChecksRequest{
+			TenantId:  "tenant-1",
+			PageSize:  20,
+			PageToken: 2,
+			GroupIds:  []string{"group-1"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(20), fake.listed.PageSize)
+		assert.Equal(t, []string{"group-1"}, fake.listed.GroupIds)
+		assert.Equal(t, int32(3), resp.NextPageToken)
+		require.Len(t, resp.Checks, 1)
+	})
+
+	t.Run("not found status reaches the gateway unchanged", func(t *testing.T) {
+		_, err := schedulerClient.GetCheck(ctx, &schedulerv1.GetCheckRequest{CheckId: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+type fakeCore struct {
+	corev1.UnimplementedCoreServiceServer
+}
+
+func (fakeCore) GetCheckHistory(_ context.Context, req *corev1.GetCheckHistoryRequest) (*corev1.GetCheckHistoryResponse, error) {
+	results := make([]*corev1.CheckResult, 0, req.Limit)
+	for i := int32(0); i < req.Limit; i++ {
+		results = append(results, &corev1.CheckResult{CheckId: req.CheckId, Success: true})
+	}
+	return &corev1.GetCheckHistoryResponse{Results: results}, nil
+}
+
+func (fakeCore) RunCheck(req *corev1.RunCheckRequest, stream corev1.CoreService_RunCheckServer) error {
+	if req.TenantId == "" {
+		return status.Error(codes.InvalidArgument, "tenant_id is required")
+	}
+	for _, phase := range []string{"started", "connect", "first_byte"} {
+		if err := stream.Send(&corev1.RunCheckProgress{ExecutionId: "exec-1", Phase: phase}); err != nil {
+			return err
+		}
+	}
+	return stream.Send(&corev1.RunCheckProgress{
+		ExecutionId: "exec-1",
+		Phase:       "completed",
+		Result:      &corev1.CheckResult{ExecutionId: "exec-1", Success: true, StatusCode: 200},
+	})
+}
+
+func TestCoreContract(t *testing.T) {
+	address := startServer(t, func(server *grpc.Server) {
+		corev1.RegisterCoreServiceServer(server, fakeCore{})
+	})
+
+	coreClient, err := client.NewCoreClient(address, time.Second, nopLogger{})
+	require.NoError(t, err)
+	defer coreClient.Close()
+	ctx := testContext(t)
+
+	t.Run("history honours limit", func(t *testing.T) {
+		resp, err := coreClient.GetCheckHistory(ctx, &corev1.GetCheckHistoryRequest{CheckId: "check-1", Limit: 3})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 3)
+		assert.Equal(t, "check-1", resp.Results[0].CheckId)
+	})
+
+	t.Run("run check streams phases and ends with a result", func(t *testing.T) {
+		stream, err := coreClient.RunCheck(ctx, &corev1.RunCheckRequest{Type: "http", Target: "https://example.com", TenantId: "tenant-1"})
+		require.NoError(t, err)
+
+		var phases []string
+		var final *corev1.RunCheckProgress
+		for {
+			progress, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			phases = append(phases, progress.Phase)
+			final = progress
+		}
+
+		assert.Equal(t, []string{"started", "connect", "first_byte", "completed"}, phases)
+		require.NotNil(t, final.Result)
+		assert.Equal(t, int32(200), final.Result.StatusCode)
+	})
+
+	t.Run("stream errors are reported on receive", func(t *testing.T) {
+		stream, err := coreClient.RunCheck(ctx, &corev1.RunCheckRequest{Type: "http", Target: "https://example.com"})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+type fakeIncident struct {
+	incidentv1.UnimplementedIncidentServiceServer
+	ingested *incidentv1.IngestAlertRequest
+	override *incidentv1.SetComponentStatusOverrideRequest
+}
+
+func (s *fakeIncident) GetIncident(_ context.Context, req *incidentv1.GetIncidentRequest) (*incidentv1.GetIncidentResponse, error) {
+	return &incidentv1.GetIncidentResponse{
+		Incident: &incidentv1.Incident{
+			Id:       req.IncidentId,
+			TenantId: "tenant-1",
+			Status:   incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN,
+			Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL,
+		},
+		Events: []*incidentv1.IncidentEvent{{Id: "event-1", IncidentId: req.IncidentId, Type: "opened"}},
+	}, nil
+}
+
+func (s *fakeIncident) IngestAlert(_ context.Context, req *incidentv1.IngestAlertRequest) (*incidentv1.IngestAlertResponse, error) {
+	s.ingested = req
+	if req.Fingerprint == "" {
+		return nil, status.Error(codes.InvalidArgument, "fingerprint is required")
+	}
+	return &incidentv1.IngestAlertResponse{
+		Action:   "created",
+		Incident: &incidentv1.Incident{Id: "incident-1", TenantId: req.TenantId, Severity: req.Severity},
+	}, nil
+}
+
+func (s *fakeIncident) SetComponentStatusOverride(_ context.Context, req *incidentv1.SetComponentStatusOverrideRequest) (*incidentv1.StatusComponent, error) {
+	s.override = req
+	if req.ComponentId == "missing" {
+		return nil, status.Error(codes.NotFound, "status component not found")
+	}
+	return &incidentv1.StatusComponent{
+		Id:       req.ComponentId,
+		TenantId: req.TenantId,
+		Name:     "API",
+		Override: &incidentv1.StatusOverride{Status: req.Status, Message: req.Message, ExpiresAt: req.ExpiresAt},
+	}, nil
+}
+
+func TestIncidentContract(t *testing.T) {
+	fake := &fakeIncident{}
+	address := startServer(t, func(server *grpc.Server) {
+		incidentv1.RegisterIncidentServiceServer(server, fake)
+	})
+
+	incidentClient, err := client.NewIncidentClient(address, time.Second, "", nopLogger{})
+	require.NoError(t, err)
+	defer incidentClient.Close()
+	ctx := testContext(t)
+
+	t.Run("get incident unwraps the incident from the response", func(t *testing.T) {
+		incident, err := incidentClient.GetIncident(ctx, &incidentv1.GetIncidentRequest{IncidentId: "incident-1"})
+		require.NoError(t, err)
+		assert.Equal(t, "incident-1", incident.Id)
+		assert.Equal(t, "tenant-1", incident.TenantId)
+		assert.Equal(t, incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL, incident.Severity)
+	})
+
+	t.Run("ingest alert passes source, fingerprint and labels", func(t *testing.T) {
+		resp, err := incidentClient.IngestAlert(ctx, &incidentv1.IngestAlertRequest{
+			TenantId:    "tenant-1",
+			Source:      "alertmanager",
+			Fingerprint: "abc",
+			Title:       "High latency",
+			Severity:    incidentv1.IncidentSeverity_INCIDENT_SEVERITY_WARNING,
+			Labels:      map[string]string{"env": "prod"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "alertmanager", fake.ingested.Source)
+		assert.Equal(t, map[string]string{"env": "prod"}, fake.ingested.Labels)
+		assert.Equal(t, "created", resp.Action)
+		assert.Equal(t, "incident-1", resp.Incident.Id)
+	})
+
+	t.Run("validation errors keep the invalid argument code", func(t *testing.T) {
+		_, err := incidentClient.IngestAlert(ctx, &incidentv1.IngestAlertRequest{TenantId: "tenant-1", Source: "alertmanager"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("status override passes status, message and expiry", func(t *testing.T) {
+		component, err := incidentClient.SetComponentStatusOverride(ctx, &incidentv1.SetComponentStatusOverrideRequest{
+			TenantId:    "tenant-1",
+			ComponentId: "component-1",
+			Status:      "major_outage",
+			Message:     "Investigating",
+			ExpiresAt:   "2026-01-01T12:00:00Z",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "major_outage", fake.override.Status)
+		assert.Equal(t, "2026-01-01T12:00:00Z", fake.override.ExpiresAt)
+		assert.Equal(t, "component-1", component.Id)
+		assert.Equal(t, "Investigating", component.Override.Message)
+
+		_, err = incidentClient.SetComponentStatusOverride(ctx, &incidentv1.SetComponentStatusOverrideRequest{ComponentId: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
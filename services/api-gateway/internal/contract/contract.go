@@ -0,0 +1,142 @@
+// Package contract описывает контракты gateway с backend сервисами: какие RPC gateway
+// вызывает и какую форму сообщений ожидает. Описание сравнивается с golden файлами в тестах,
+// поэтому несовместимое изменение proto падает в CI, а не в рантайме
+package contract
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Consumer набор RPC backend сервиса, используемых gateway
+type Consumer struct {
+	// Name имя контракта и golden файла
+	Name    string
+	Service protoreflect.ServiceDescriptor
+	Methods []string
+}
+
+// Describe возвращает отсортированный список фактов о контракте: сигнатуры используемых RPC,
+// поля всех достижимых из них сообщений и значения перечислений. Каждый факт - отдельная
+// строка, поэтому совместимость проверяется как вложенность множеств
+func Describe(consumer Consumer) ([]string, error) {
+	facts := make(map[string]bool)
+	visited := make(map[protoreflect.FullName]bool)
+
+	methods := consumer.Service.Methods()
+	for _, name := range consumer.Methods {
+		method := methods.ByName(protoreflect.Name(name))
+		if method == nil {
+			return nil, fmt.Errorf("%s: rpc %s not found", consumer.Service.FullName(), name)
+		}
+		facts[describeMethod(method)] = true
+		describeMessage(method.Input(), facts, visited)
+		describeMessage(method.Output(), facts, visited)
+	}
+
+	result := make([]string, 0, len(facts))
+	for fact := range facts {
+		result = append(result, fact)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// Missing возвращает факты из golden, отсутствующие в current. Новые поля и RPC совместимы
+// с контрактом, удаление, переименование и смена номера или типа поля - нет
+func Missing(golden, current []string) []string {
+	present := make(map[string]bool, len(current))
+	for _, fact := range current {
+		present[fact] = true
+	}
+
+	var missing []string
+	for _, fact := range golden {
+		if !present[fact] {
+			missing = append(missing, fact)
+		}
+	}
+	return missing
+}
+
+// describeMethod сигнатура RPC
+func describeMethod(method protoreflect.MethodDescriptor) string {
+	input, output := string(method.Input().FullName()), string(method.Output().FullName())
+	if method.IsStreamingClient() {
+		input = "stream " + input
+	}
+	if method.IsStreamingServer() {
+		output = "stream " + output
+	}
+	return fmt.Sprintf("rpc %s(%s) returns (%s)", method.FullName(), input, output)
+}
+
+// describeMessage добавляет поля сообщения и рекурсивно описывает вложенные типы
+func describeMessage(message protoreflect.MessageDescriptor, facts map[string]bool, visited map[protoreflect.FullName]bool) {
+	if visited[message.FullName()] {
+		return
+	}
+	visited[message.FullName()] = true
+
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		facts[fmt.Sprintf("field %s %d %s %s", message.FullName(), field.Number(), field.Name(), fieldType(field))] = true
+
+		switch {
+		case field.IsMap():
+			describeKind(field.MapValue(), facts, visited)
+		default:
+			describeKind(field, facts, visited)
+		}
+	}
+}
+
+// describeKind описывает тип сообщения или перечисления, на который ссылается поле
+func describeKind(field protoreflect.FieldDescriptor, facts map[string]bool, visited map[protoreflect.FullName]bool) {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		describeMessage(field.Message(), facts, visited)
+	case protoreflect.EnumKind:
+		enum := field.Enum()
+		if visited[enum.FullName()] {
+			return
+		}
+		visited[enum.FullName()] = true
+		values := enum.Values()
+		for i := 0; i < values.Len(); i++ {
+			value := values.Get(i)
+			facts[fmt.Sprintf("enum %s %d %s", enum.FullName(), value.Number(), value.Name())] = true
+		}
+	}
+}
+
+// fieldType текстовое представление типа поля
+func fieldType(field protoreflect.FieldDescriptor) string {
+	if field.IsMap() {
+		return fmt.Sprintf("map<%s,%s>", kindName(field.MapKey()), kindName(field.MapValue()))
+	}
+	name := kindName(field)
+	if field.IsList() {
+		return "repeated " + name
+	}
+	if field.HasPresence() && field.ContainingOneof() == nil && field.Kind() != protoreflect.MessageKind {
+		return "optional " + name
+	}
+	return name
+}
+
+// kindName имя скалярного типа или полное имя сообщения и перечисления
+func kindName(field protoreflect.FieldDescriptor) string {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(field.Message().FullName())
+	case protoreflect.EnumKind:
+		return string(field.Enum().FullName())
+	default:
+		return strings.ToLower(field.Kind().String())
+	}
+}
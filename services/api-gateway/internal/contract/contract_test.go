@@ -0,0 +1,127 @@
+package contract
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/api-gateway/internal/client"
+)
+
+// update перезаписывает golden файлы текущими описаниями контрактов:
+// go test ./internal/contract -run TestGolden -update
+var update = flag.Bool("update", false, "update contract golden files")
+
+func goldenPath(name string) string {
+	return filepath.Join("testdata", name+".golden")
+}
+
+func readGolden(t *testing.T, name string) []string {
+	t.Helper()
+	data, err := os.ReadFile(goldenPath(name))
+	require.NoError(t, err, "golden file is missing, run the test with -update")
+
+	var facts []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			facts = append(facts, line)
+		}
+	}
+	return facts
+}
+
+func TestGoldenContracts(t *testing.T) {
+	for _, consumer := range GatewayConsumers() {
+		t.Run(consumer.Name, func(t *testing.T) {
+			current, err := Describe(consumer)
+			require.NoError(t, err)
+
+			if *update {
+				content := "# Contract of api-gateway with " + string(consumer.Service.FullName()) + ". Generated, do not edit.\n" +
+					strings.Join(current, "\n") + "\n"
+				require.NoError(t, os.MkdirAll("testdata", 0o755))
+				require.NoError(t, os.WriteFile(goldenPath(consumer.Name), []byte(content), 0o644))
+				return
+			}
+
+			missing := Missing(readGolden(t, consumer.Name), current)
+			assert.Empty(t, missing, "breaking changes in %s: the gateway relies on these definitions", consumer.Service.FullName())
+		})
+	}
+}
+
+func TestContractsCoverGatewayClients(t *testing.T) {
+	clients := map[string]interface{}{
+		"scheduler": &client.SchedulerClient{},
+		"core":      &client.CoreClient{},
+		"incident":  &client.IncidentClient{},
+		"settings":  &client.SettingsClient{},
+
+		"scheduler-v1beta1": &client.SchedulerClient{},
+	}
+
+	// Один клиент может обслуживать несколько контрактов: v1 и v1beta1 на общем соединении
+	expectedByClient := make(map[reflect.Type][]string)
+	for _, consumer := range GatewayConsumers() {
+		clientType := reflect.TypeOf(clients[consumer.Name])
+		require.NotNil(t, clientType, consumer.Name)
+		expectedByClient[clientType] = append(expectedByClient[clientType], consumer.Methods...)
+	}
+
+	for clientType, expected := range expectedByClient {
+		t.Run(clientType.Elem().Name(), func(t *testing.T) {
+			var methods []string
+			for i := 0; i < clientType.NumMethod(); i++ {
+				if name := clientType.Method(i).Name; name != "Close" {
+					methods = append(methods, name)
+				}
+			}
+			expected := append([]string(nil), expected...)
+			sort.Strings(methods)
+			sort.Strings(expected)
+			assert.Equal(t, expected, methods, "contract methods must match the gateway client")
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	consumer := GatewayConsumers()[2]
+	consumer.Methods = []string{"IngestAlert"}
+
+	facts, err := Describe(consumer)
+	require.NoError(t, err)
+
+	assert.Contains(t, facts, "rpc uptimeping.incident.v1.IncidentService.IngestAlert(uptimeping.incident.v1.IngestAlertRequest) returns (uptimeping.incident.v1.IngestAlertResponse)")
+	assert.Contains(t, facts, "field uptimeping.incident.v1.IngestAlertRequest 8 labels map<string,string>")
+	assert.Contains(t, facts, "field uptimeping.incident.v1.IngestAlertResponse 2 incident uptimeping.incident.v1.Incident")
+	assert.Contains(t, facts, "enum uptimeping.incident.v1.IncidentSeverity 3 INCIDENT_SEVERITY_CRITICAL")
+	assert.True(t, sort.StringsAreSorted(facts))
+
+	consumer.Methods = []string{"DropIncident"}
+	_, err = Describe(consumer)
+	assert.Error(t, err)
+}
+
+func TestDescribeStreaming(t *testing.T) {
+	consumer := GatewayConsumers()[1]
+	consumer.Methods = []string{"RunCheck"}
+
+	facts, err := Describe(consumer)
+	require.NoError(t, err)
+	assert.Contains(t, facts, "rpc uptimeping.core.v1.CoreService.RunCheck(uptimeping.core.v1.RunCheckRequest) returns (stream uptimeping.core.v1.RunCheckProgress)")
+}
+
+func TestMissing(t *testing.T) {
+	golden := []string{"field a 1 id string", "field a 2 name string"}
+
+	assert.Empty(t, Missing(golden, []string{"field a 1 id string", "field a 2 name string", "field a 3 extra string"}))
+	assert.Equal(t, []string{"field a 2 name string"}, Missing(golden, []string{"field a 1 id string", "field a 2 title string"}))
+}
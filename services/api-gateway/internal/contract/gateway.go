@@ -0,0 +1,54 @@
+package contract
+
+import (
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	schedulerv1beta1 "UptimePingPlatform/proto/api/scheduler/v1beta1"
+)
+
+// GatewayConsumers контракты gateway с SchedulerService, CoreService, IncidentService и TenantSettingsService.
+// Список RPC должен совпадать с методами gRPC клиентов gateway; клиент SchedulerClient вызывает
+// и v1, и v1beta1 сервис планировщика
+func GatewayConsumers() []Consumer {
+	return []Consumer{
+		{
+			Name:    "scheduler",
+			Service: schedulerv1.File_proto_api_scheduler_v1_scheduler_proto.Services().ByName("SchedulerService"),
+			Methods: []string{
+				"CreateCheck", "GetCheck", "ListChecks", "UpdateCheck", "DeleteCheck",
+				"ScheduleCheck", "UnscheduleCheck", "GetSchedule", "ListSchedules",
+				"ListTags", "RenameTag", "DeleteTag", "DeleteUnusedTags",
+				"CreateGroup", "GetGroup", "ListGroups", "UpdateGroup", "DeleteGroup", "SetGroupEnabled",
+				"GetFleetSummary",
+			},
+		},
+		{
+			Name:    "core",
+			Service: corev1.File_proto_api_core_v1_core_proto.Services().ByName("CoreService"),
+			Methods: []string{"ExecuteCheck", "GetCheckStatus", "GetCheckHistory", "RunCheck", "GetUptimeHistory", "ListArtifacts", "GetArtifactURL"},
+		},
+		{
+			Name:    "incident",
+			Service: incidentv1.File_proto_api_incident_v1_incident_proto.Services().ByName("IncidentService"),
+			Methods: []string{
+				"CreateIncident", "GetIncident", "ListIncidents", "ResolveIncident", "IngestAlert",
+				"AcknowledgeIncident", "GetIncidentStats", "GetIncidentHistory",
+				"ListStatusComponents", "CreateStatusComponent", "UpdateStatusComponent", "DeleteStatusComponent",
+				"SetComponentStatusOverride", "ClearComponentStatusOverride",
+				"ScheduleMaintenance", "CancelMaintenance", "ListMaintenances", "GetStatusPage",
+			},
+		},
+		{
+			Name:    "settings",
+			Service: configv1.File_proto_api_config_v1_config_proto.Services().ByName("TenantSettingsService"),
+			Methods: []string{"GetTenantSettings", "UpdateTenantSettings", "ResetTenantSettings"},
+		},
+		{
+			Name:    "scheduler-v1beta1",
+			Service: schedulerv1beta1.File_proto_api_scheduler_v1beta1_scheduler_proto.Services().ByName("SchedulerService"),
+			Methods: []string{"PreviewIntervalChange"},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+// handleCheckArtifacts возвращает артефакты проверки (?execution_id= ограничивает одним выполнением)
+func (h *Handler) handleCheckArtifacts(w http.ResponseWriter, r *http.Request) {
+	checkID, ok := h.readableCheck(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.coreClient.ListArtifacts(r.Context(), &corev1.ListArtifactsRequest{
+		CheckId:     checkID,
+		ExecutionId: r.URL.Query().Get("execution_id"),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"artifacts": resp.Artifacts,
+		"total":     len(resp.Artifacts),
+	})
+}
+
+// handleCheckArtifactURL возвращает подписанную ссылку на артефакт (?key=); содержимое
+// скачивается клиентом напрямую из хранилища, минуя шлюз
+func (h *Handler) handleCheckArtifactURL(w http.ResponseWriter, r *http.Request) {
+	checkID, ok := h.readableCheck(w, r)
+	if !ok {
+		return
+	}
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "key is required"), http.StatusBadRequest)
+		return
+	}
+
+	signed, err := h.coreClient.GetArtifactURL(r.Context(), &corev1.GetArtifactURLRequest{
+		CheckId: checkID,
+		Key:     key,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	// Ссылка содержит подпись, поэтому не кэшируется
+	w.Header().Set("Cache-Control", "no-store")
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"url":        signed.Url,
+		"expires_at": signed.ExpiresAt,
+	})
+}
+
+// readableCheck проверяет ID проверки из пути и право читать ее: проверка принадлежит
+// арендатору пользователя и входит в разрешенные группы. При ошибке отвечает сам
+func (h *Handler) readableCheck(w http.ResponseWriter, r *http.Request) (string, bool) {
+	checkID := mux.Vars(r)["id"]
+	if err := h.validator.ValidateUUID(checkID, "check_id"); err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid check ID format"), http.StatusBadRequest)
+		return "", false
+	}
+
+	check, err := h.schedulerClient.GetCheck(r.Context(), &schedulerv1.GetCheckRequest{CheckId: checkID})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return "", false
+	}
+	if check.TenantId != incidentTenantID(r) {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "access denied"), http.StatusForbidden)
+		return "", false
+	}
+	if !h.groupAllowed(r.Context(), "checks:read", check.GroupPath) {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "insufficient permissions for check group"), http.StatusForbidden)
+		return "", false
+	}
+	return checkID, true
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	schedulerv1beta1 "UptimePingPlatform/proto/api/scheduler/v1beta1"
+)
+
+// handlePreviewIntervalChange оценивает, как изменится нагрузка (выполнения в минуту) и стоимость,
+// если всем проверкам с тегом задать новый интервал. Проверки не изменяются; проверки, которым
+// интервал задать нельзя, перечислены в rejected_checks с причиной
+func (h *Handler) handlePreviewIntervalChange(w http.ResponseWriter, r *http.Request) {
+	// Прогноз охватывает все проверки tenant с тегом
+	if !h.requireUnscoped(w, r, "checks:read") {
+		return
+	}
+	tenantID := incidentTenantID(r)
+	if tenantID == "" {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "tenant is required"), http.StatusForbidden)
+		return
+	}
+	if h.schedulerClient == nil {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrInternal, "scheduler service is unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	var previewReq struct {
+		Tag             string `json:"tag"`
+		IntervalSeconds int32  `json:"interval_seconds"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&previewReq); err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid request body"), http.StatusBadRequest)
+		return
+	}
+	if previewReq.Tag == "" || previewReq.IntervalSeconds <= 0 {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "tag and interval_seconds are required"), http.StatusBadRequest)
+		return
+	}
+
+	preview, err := h.schedulerClient.PreviewIntervalChange(r.Context(), &schedulerv1beta1.PreviewIntervalChangeRequest{
+		TenantId:        tenantID,
+		Tag:             previewReq.Tag,
+		IntervalSeconds: previewReq.IntervalSeconds,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	rejected := make([]map[string]string, 0, len(preview.RejectedChecks))
+	for _, check := range preview.RejectedChecks {
+		rejected = append(rejected, map[string]string{
+			"check_id": check.CheckId,
+			"name":     check.Name,
+			"field":    check.Field,
+			"reason":   check.Reason,
+			"message":  check.Message,
+		})
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success":          true,
+		"tag":              preview.Tag,
+		"interval_seconds": preview.IntervalSeconds,
+		"matched_checks":   preview.MatchedChecks,
+		"active_checks":    preview.ActiveChecks,
+		"changed_checks":   preview.ChangedChecks,
+		"current":          capacityEstimateJSON(preview.Current),
+		"proposed":         capacityEstimateJSON(preview.Proposed),
+		"delta":            capacityEstimateJSON(preview.Delta),
+		"rejected_checks":  rejected,
+	})
+}
+
+// capacityEstimateJSON представление оценки нагрузки в ответе
+func capacityEstimateJSON(estimate *schedulerv1beta1.CapacityEstimate) map[string]float64 {
+	return map[string]float64{
+		"executions_per_minute": estimate.GetExecutionsPerMinute(),
+		"cost_units_per_minute": estimate.GetCostUnitsPerMinute(),
+	}
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/api-gateway/internal/checkimport"
+)
+
+// maxImportSize наибольший размер файла экспорта
+const maxImportSize = 5 << 20
+
+// importedCheck проверка, созданная из монитора исходного инструмента
+type importedCheck struct {
+	SourceID string `json:"source_id"`
+	CheckID  string `json:"check_id"`
+	Name     string `json:"name"`
+}
+
+// handleImportChecks создает проверки из экспорта UptimeRobot, Pingdom или StatusCake (JSON или CSV
+// в теле запроса, источник в параметре source). С dry_run=true проверки не создаются, возвращается
+// только отчет о преобразовании. Проверки создаются по одной: ошибка создания попадает в отчет
+// и не прерывает импорт остальных
+func (h *Handler) handleImportChecks(w http.ResponseWriter, r *http.Request) {
+	if !h.requireUnscoped(w, r, "checks:write") {
+		return
+	}
+	tenantID := incidentTenantID(r)
+	if tenantID == "" {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "tenant is required"), http.StatusForbidden)
+		return
+	}
+
+	source, err := checkimport.ParseSource(r.URL.Query().Get("source"))
+	if err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid source"), http.StatusBadRequest)
+		return
+	}
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportSize))
+	if err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "export is too large or unreadable"), http.StatusBadRequest)
+		return
+	}
+	report, err := checkimport.Convert(source, data)
+	if err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid export"), http.StatusBadRequest)
+		return
+	}
+
+	created := []importedCheck{}
+	if !dryRun {
+		if h.schedulerClient == nil {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrInternal, "scheduler service is unavailable"), http.StatusServiceUnavailable)
+			return
+		}
+		for _, check := range report.Checks {
+			response, err := h.schedulerClient.CreateCheck(r.Context(), importCheckRequest(tenantID, check))
+			if err != nil {
+				report.Imported--
+				report.Skipped++
+				report.Issues = append(report.Issues, checkimport.Issue{
+					SourceID: check.SourceID,
+					Name:     check.Name,
+					Feature:  "create",
+					Message:  pkgErrors.FromGRPCErr(err).Error(),
+					Skipped:  true,
+				})
+				continue
+			}
+			created = append(created, importedCheck{SourceID: check.SourceID, CheckID: response.Id, Name: check.Name})
+		}
+	}
+
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"dry_run": dryRun,
+		"report":  report,
+		"created": created,
+	})
+}
+
+// importCheckRequest запрос создания проверки из импортированной проверки
+func importCheckRequest(tenantID string, check checkimport.Check) *schedulerv1.CreateCheckRequest {
+	config := map[string]string{}
+	if check.Method != "" {
+		config["method"] = check.Method
+	}
+	if !check.Enabled {
+		config["enabled"] = "false"
+	}
+	return &schedulerv1.CreateCheckRequest{
+		TenantId: tenantID,
+		Name:     check.Name,
+		Type:     check.Type,
+		Target:   check.Target,
+		Interval: int32(check.Interval),
+		Timeout:  int32(check.Timeout),
+		Tags:     check.Tags,
+		Config:   config,
+	}
+}
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/health"
+	"UptimePingPlatform/pkg/logger"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/api-gateway/internal/client"
+)
+
+// fakeScheduler отдает страницы из заданного списка проверок по page_size/page_token
+type fakeScheduler struct {
+	schedulerv1.UnimplementedSchedulerServiceServer
+
+	mu       sync.Mutex
+	checks   []*schedulerv1.Check
+	requests []*schedulerv1.ListChecksRequest
+}
+
+func (s *fakeScheduler) ListChecks(ctx context.Context, req *schedulerv1.ListChecksRequest) (*schedulerv1.ListChecksResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 || pageSize > checkMaxPageSize {
+		pageSize = checkMaxPageSize
+	}
+	start := int(req.PageToken)
+	if start > len(s.checks) {
+		start = len(s.checks)
+	}
+	end := start + pageSize
+	if end > len(s.checks) {
+		end = len(s.checks)
+	}
+	resp := &schedulerv1.ListChecksResponse{Checks: s.checks[start:end], Total: int32(len(s.checks))}
+	if end < len(s.checks) {
+		resp.NextPageToken = int32(end)
+	}
+	return resp, nil
+}
+
+// newFakeSchedulerClient запускает fakeScheduler на локальном порту и подключает к нему клиент
+func newFakeSchedulerClient(t *testing.T, scheduler *fakeScheduler) *client.SchedulerClient {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	schedulerv1.RegisterSchedulerServiceServer(server, scheduler)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+	schedulerClient, err := client.NewSchedulerClient(listener.Addr().String(), 5*time.Second, log)
+	require.NoError(t, err)
+	t.Cleanup(func() { schedulerClient.Close() })
+	return schedulerClient
+}
+
+// testChecks создает count проверок с именами check-000, check-001, ...
+func testChecks(count int) []*schedulerv1.Check {
+	checks := make([]*schedulerv1.Check, count)
+	for i := range checks {
+		checks[i] = &schedulerv1.Check{
+			Id:     fmt.Sprintf("check-%03d", i),
+			Name:   fmt.Sprintf("check-%03d", i),
+			Target: fmt.Sprintf("https://service-%03d.example.com", i),
+		}
+	}
+	return checks
+}
+
+func TestHandler_SchedulerChecks_GroupScopedPagination(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+	scheduler := &fakeScheduler{checks: testChecks(45)}
+	handler := NewHandler(nil, NewHealthHandler(&health.SimpleHealthChecker{}, log), newFakeSchedulerClient(t, scheduler), nil, nil, nil, nil, nil, nil, log)
+
+	ctx := context.WithValue(context.Background(), "user", map[string]interface{}{"tenant_id": "tenant-1"})
+	ctx = context.WithValue(ctx, "permissions", []string{"checks:read:group:grp-1"})
+
+	w := httptest.NewRecorder()
+	handler.handleSchedulerChecks(w, httptest.NewRequest(http.MethodGet, "/api/v1/checks?page_size=20&page_token=40", nil).WithContext(ctx))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Checks []*schedulerv1.Check `json:"checks"`
+		Total  int32                `json:"total"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Len(t, body.Checks, 5)
+	assert.Equal(t, "check-040", body.Checks[0].Id)
+	assert.Equal(t, int32(45), body.Total)
+
+	require.Len(t, scheduler.requests, 1)
+	assert.Equal(t, []string{"grp-1"}, scheduler.requests[0].GroupIds)
+	assert.Equal(t, int32(20), scheduler.requests[0].PageSize)
+	assert.Equal(t, int32(40), scheduler.requests[0].PageToken)
+
+	w = httptest.NewRecorder()
+	handler.handleSchedulerChecks(w, httptest.NewRequest(http.MethodGet, "/api/v1/checks?page_token=-1", nil).WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSchedulerError(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want pkgErrors.ErrorCode
+	}{
+		{codes.ResourceExhausted, pkgErrors.ErrConflict},
+		{codes.FailedPrecondition, pkgErrors.ErrConflict},
+		{codes.AlreadyExists, pkgErrors.ErrConflict},
+		{codes.NotFound, pkgErrors.ErrNotFound},
+		{codes.InvalidArgument, pkgErrors.ErrValidation},
+	}
+	for _, tt := range tests {
+		err := schedulerError(status.Error(tt.code, "tag limit exceeded"))
+		assert.Equal(t, tt.want, err.Code, tt.code.String())
+		assert.Equal(t, "tag limit exceeded", err.Message)
+	}
+}
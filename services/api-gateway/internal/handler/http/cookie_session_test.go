@@ -0,0 +1,92 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/health"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/api-gateway/internal/client"
+	"UptimePingPlatform/services/api-gateway/internal/middleware"
+)
+
+// sessionAuthClient выдает фиксированную пару токенов и запоминает завершенные сессии
+type sessionAuthClient struct {
+	client.AuthHTTPClientInterface
+	loggedOut []string
+}
+
+func (c *sessionAuthClient) Login(ctx context.Context, email, password string) (*client.TokenPair, error) {
+	return &client.TokenPair{AccessToken: "access-1", RefreshToken: "refresh-1", TenantID: "tenant-1"}, nil
+}
+
+func (c *sessionAuthClient) Logout(ctx context.Context, accessToken string) error {
+	c.loggedOut = append(c.loggedOut, accessToken)
+	return nil
+}
+
+func TestHandler_CookieSession(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+
+	authClient := &sessionAuthClient{}
+	session := middleware.NewCookieSession(middleware.CookieSessionOptions{
+		SameSite:   http.SameSiteLaxMode,
+		TTL:        time.Hour,
+		CSRFSecret: []byte("0123456789abcdef0123456789abcdef"),
+	})
+	handler := NewHandler(authClient, NewHealthHandler(&health.SimpleHealthChecker{}, log), nil, nil, nil, nil, nil, nil, nil, log).
+		WithCookieSession(session)
+
+	// Вход веб-клиента: токены только в HttpOnly cookie, в теле - CSRF токен
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(`{"email":"user@example.com","password":"password123"}`))
+	req.Header.Set(middleware.HeaderSessionMode, middleware.SessionModeCookie)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "access-1")
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	csrfToken := body["csrf_token"]
+	assert.Equal(t, session.CSRFToken("access-1"), csrfToken)
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 3)
+
+	logout := func(csrfHeader string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/logout", nil)
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+		if csrfHeader != "" {
+			req.Header.Set(middleware.HeaderCSRFToken, csrfHeader)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusForbidden, logout("").Code)
+	assert.Empty(t, authClient.loggedOut)
+
+	w = logout(csrfToken)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"access-1"}, authClient.loggedOut)
+	for _, cookie := range w.Result().Cookies() {
+		assert.Negative(t, cookie.MaxAge, cookie.Name)
+	}
+
+	// Вход без заголовка режима возвращает токены как раньше
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(`{"email":"user@example.com","password":"password123"}`)))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "access-1")
+	assert.Empty(t, w.Result().Cookies())
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+// handleFleet возвращает число проверок tenant по состояниям: up, failing, degraded, paused
+// и unknown. Счетчики ведет планировщик при смене состояний проверок, а не считает по запросу
+func (h *Handler) handleFleet(w http.ResponseWriter, r *http.Request) {
+	if !h.requireUnscoped(w, r, "checks:read") {
+		return
+	}
+	tenantID := incidentTenantID(r)
+	if tenantID == "" {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "tenant is required"), http.StatusForbidden)
+		return
+	}
+	if h.schedulerClient == nil {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrInternal, "scheduler service is unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	fleet, err := h.schedulerClient.GetFleetSummary(r.Context(), &schedulerv1.GetFleetSummaryRequest{TenantId: tenantID})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"fleet": map[string]int32{
+			"total":    fleet.Total,
+			"up":       fleet.Up,
+			"failing":  fleet.Failing,
+			"degraded": fleet.Degraded,
+			"paused":   fleet.Paused,
+			"unknown":  fleet.Unknown,
+		},
+	})
+}
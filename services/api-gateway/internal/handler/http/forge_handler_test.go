@@ -4,44 +4,38 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/health"
 	"UptimePingPlatform/pkg/logger"
 	forgev1 "UptimePingPlatform/proto/api/forge/v1"
+	"UptimePingPlatform/services/api-gateway/internal/client"
 )
 
-// MockForgeClient мок для ForgeServiceClient
-type MockForgeClient struct {
-	responses map[string]interface{}
-	errors    map[string]error
+// fakeForge отдает фиксированные ответы ForgeService
+type fakeForge struct {
+	forgev1.UnimplementedForgeServiceServer
 }
 
-func (m *MockForgeClient) GenerateConfig(ctx context.Context, protoContent string, options *forgev1.ConfigOptions) (*forgev1.GenerateConfigResponse, error) {
-	if err, exists := m.errors["GenerateConfig"]; exists {
-		return nil, err
-	}
-	if resp, exists := m.responses["GenerateConfig"]; exists {
-		return resp.(*forgev1.GenerateConfigResponse), nil
-	}
+func (f *fakeForge) GenerateConfig(ctx context.Context, req *forgev1.GenerateConfigRequest) (*forgev1.GenerateConfigResponse, error) {
 	return &forgev1.GenerateConfigResponse{
 		ConfigYaml: "test_config_yaml",
 		CheckConfig: &forgev1.CheckConfig{
-			Name: "test-check",
-			Type: forgev1.CheckType_CHECK_TYPE_HTTP,
+			Name:   "test-check",
+			Type:   forgev1.CheckType_CHECK_TYPE_HTTP,
 			Target: "http://example.com",
 		},
 	}, nil
 }
 
-func (m *MockForgeClient) ParseProto(ctx context.Context, protoContent, fileName string) (*forgev1.ParseProtoResponse, error) {
-	if err, exists := m.errors["ParseProto"]; exists {
-		return nil, err
-	}
-	if resp, exists := m.responses["ParseProto"]; exists {
-		return resp.(*forgev1.ParseProtoResponse), nil
-	}
+func (f *fakeForge) ParseProto(ctx context.Context, req *forgev1.ParseProtoRequest) (*forgev1.ParseProtoResponse, error) {
 	return &forgev1.ParseProtoResponse{
 		ServiceInfo: &forgev1.ServiceInfo{
 			PackageName: "test.package",
@@ -51,13 +45,7 @@ func (m *MockForgeClient) ParseProto(ctx context.Context, protoContent, fileName
 	}, nil
 }
 
-func (m *MockForgeClient) GenerateCode(ctx context.Context, protoContent string, options *forgev1.CodeOptions) (*forgev1.GenerateCodeResponse, error) {
-	if err, exists := m.errors["GenerateCode"]; exists {
-		return nil, err
-	}
-	if resp, exists := m.responses["GenerateCode"]; exists {
-		return resp.(*forgev1.GenerateCodeResponse), nil
-	}
+func (f *fakeForge) GenerateCode(ctx context.Context, req *forgev1.GenerateCodeRequest) (*forgev1.GenerateCodeResponse, error) {
 	return &forgev1.GenerateCodeResponse{
 		Code:     "test_code",
 		Filename: "test.go",
@@ -65,53 +53,38 @@ func (m *MockForgeClient) GenerateCode(ctx context.Context, protoContent string,
 	}, nil
 }
 
-func (m *MockForgeClient) ValidateProto(ctx context.Context, protoContent string) (*forgev1.ValidateProtoResponse, error) {
-	if err, exists := m.errors["ValidateProto"]; exists {
-		return nil, err
-	}
-	if resp, exists := m.responses["ValidateProto"]; exists {
-		return resp.(*forgev1.ValidateProtoResponse), nil
-	}
+func (f *fakeForge) ValidateProto(ctx context.Context, req *forgev1.ValidateProtoRequest) (*forgev1.ValidateProtoResponse, error) {
 	return &forgev1.ValidateProtoResponse{
 		IsValid: true,
 	}, nil
 }
 
-func (m *MockForgeClient) Close() error {
-	return nil
-}
-
-// MockForgeLogger мок для логгера
-type MockForgeLogger struct{}
-
-func (m *MockForgeLogger) Debug(msg string, fields ...logger.Field) {}
-func (m *MockForgeLogger) Info(msg string, fields ...logger.Field)  {}
-func (m *MockForgeLogger) Warn(msg string, fields ...logger.Field)  {}
-func (m *MockForgeLogger) Error(msg string, fields ...logger.Field) {}
-func (m *MockForgeLogger) With(fields ...logger.Field) logger.Logger {
-	return m
-}
-func (m *MockForgeLogger) Sync() error {
-	return nil
-}
-
-func createTestHandler() *Handler {
-	mockAuthService := &MockAuthService{}
-	mockHealthHandler := &MockHealthHandler{}
-	mockSchedulerClient := &MockSchedulerClient{}
-	mockForgeClient := &MockForgeClient{}
-	mockLogger := &MockForgeLogger{}
-
-	return NewHandler(mockAuthService, mockHealthHandler, mockSchedulerClient, mockForgeClient, mockLogger)
+// createTestHandler создает Handler с клиентом fakeForge на локальном порту
+func createTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	forgev1.RegisterForgeServiceServer(server, &fakeForge{})
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+	forgeClient, err := client.NewGRPCForgeClient(listener.Addr().String(), 5*time.Second, log)
+	require.NoError(t, err)
+	t.Cleanup(func() { forgeClient.Close() })
+
+	return NewHandler(nil, NewHealthHandler(&health.SimpleHealthChecker{}, log), nil, nil, nil, nil, nil, nil, forgeClient, log)
 }
 
 func TestHandleForgeProxy_GenerateConfig(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	// Создаем тестовый запрос
 	reqBody := map[string]interface{}{
 		"proto_content": "syntax = \"proto3\"; package test; service Test {}",
-		"action":       "generate_config",
+		"action":        "generate_config",
 		"options": map[string]interface{}{
 			"target_host": "example.com",
 			"target_port": 8080,
@@ -142,13 +115,13 @@ func TestHandleForgeProxy_GenerateConfig(t *testing.T) {
 }
 
 func TestHandleForgeProxy_ParseProto(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	// Создаем тестовый запрос
 	reqBody := map[string]interface{}{
 		"proto_content": "syntax = \"proto3\"; package test; service Test {}",
-		"action":       "parse_proto",
-		"file_name":    "test.proto",
+		"action":        "parse_proto",
+		"file_name":     "test.proto",
 	}
 
 	jsonBody, _ := json.Marshal(reqBody)
@@ -175,12 +148,12 @@ func TestHandleForgeProxy_ParseProto(t *testing.T) {
 }
 
 func TestHandleForgeProxy_GenerateCode(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	// Создаем тестовый запрос
 	reqBody := map[string]interface{}{
 		"proto_content": "syntax = \"proto3\"; package test; service Test {}",
-		"action":       "generate_code",
+		"action":        "generate_code",
 		"options": map[string]interface{}{
 			"language":  "go",
 			"framework": "grpc",
@@ -211,12 +184,12 @@ func TestHandleForgeProxy_GenerateCode(t *testing.T) {
 }
 
 func TestHandleForgeProxy_ValidateProto(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	// Создаем тестовый запрос
 	reqBody := map[string]interface{}{
 		"proto_content": "syntax = \"proto3\"; package test; service Test {}",
-		"action":       "validate_proto",
+		"action":        "validate_proto",
 	}
 
 	jsonBody, _ := json.Marshal(reqBody)
@@ -243,7 +216,7 @@ func TestHandleForgeProxy_ValidateProto(t *testing.T) {
 }
 
 func TestHandleForgeProxy_InvalidMethod(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	req := httptest.NewRequest("GET", "/api/v1/forge/generate", nil)
 	w := httptest.NewRecorder()
@@ -256,7 +229,7 @@ func TestHandleForgeProxy_InvalidMethod(t *testing.T) {
 }
 
 func TestHandleForgeProxy_InvalidJSON(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	req := httptest.NewRequest("POST", "/api/v1/forge/generate", bytes.NewBuffer([]byte("invalid json")))
 	req.Header.Set("Content-Type", "application/json")
@@ -270,7 +243,7 @@ func TestHandleForgeProxy_InvalidJSON(t *testing.T) {
 }
 
 func TestHandleForgeProxy_MissingRequiredFields(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	// Запрос без обязательных полей
 	reqBody := map[string]interface{}{
@@ -292,11 +265,11 @@ func TestHandleForgeProxy_MissingRequiredFields(t *testing.T) {
 }
 
 func TestHandleForgeProxy_InvalidAction(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	reqBody := map[string]interface{}{
 		"proto_content": "syntax = \"proto3\"; package test; service Test {}",
-		"action":       "invalid_action",
+		"action":        "invalid_action",
 	}
 
 	jsonBody, _ := json.Marshal(reqBody)
@@ -312,11 +285,11 @@ func TestHandleForgeProxy_InvalidAction(t *testing.T) {
 }
 
 func TestHandleForgeProxy_ProtoContentTooShort(t *testing.T) {
-	handler := createTestHandler()
+	handler := createTestHandler(t)
 
 	reqBody := map[string]interface{}{
 		"proto_content": "short",
-		"action":       "generate_config",
+		"action":        "generate_config",
 	}
 
 	jsonBody, _ := json.Marshal(reqBody)
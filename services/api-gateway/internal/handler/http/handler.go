@@ -6,6 +6,7 @@ import (
 	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
 	metricsv1 "UptimePingPlatform/proto/api/metrics/v1"
 	notificationv1 "UptimePingPlatform/proto/api/notification/v1"
+	notificationv1beta1 "UptimePingPlatform/proto/api/notification/v1beta1"
 	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
 	"context"
 	"encoding/json"
@@ -13,18 +14,27 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	//"UptimePingPlatform/pkg/config"
 	pkgErrors "UptimePingPlatform/pkg/errors"
 	grpcBase "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/locale"
 	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/ratelimit"
 	"UptimePingPlatform/pkg/validation"
+	"UptimePingPlatform/pkg/webhooks"
 	"UptimePingPlatform/services/api-gateway/internal/client"
+	"UptimePingPlatform/services/api-gateway/internal/ingest"
 	"UptimePingPlatform/services/api-gateway/internal/middleware"
+	"UptimePingPlatform/services/api-gateway/internal/router"
+	"UptimePingPlatform/services/api-gateway/internal/search"
 )
 
 // UserInfo содержит информацию о пользователе
@@ -42,6 +52,16 @@ type Handler struct {
 	notificationClient *client.NotificationClient
 	configClient       *client.ConfigClient
 	forgeClient        *client.GRPCForgeClient
+	settingsClient     *client.SettingsClient
+	locales            *locale.Resolver
+	ingestSources      *ingest.Registry
+	webhookReplay      webhooks.ReplayCache
+	rateLimiter        ratelimit.RateLimiter
+	rateLimits         map[router.RateLimitClass]router.RateLimit
+	rateLimitWarn      float64
+	trustedProxies     *ratelimit.TrustedProxies
+	maintenance        *middleware.Maintenance
+	cookieSession      *middleware.CookieSession
 	baseHandler        *grpcBase.BaseHandler
 	logger             logger.Logger
 	validator          *validation.Validator
@@ -67,6 +87,7 @@ func NewHandler(authService client.AuthHTTPClientInterface, healthHandler Health
 		notificationClient: notificationClient,
 		configClient:       configClient,
 		forgeClient:        forgeClient,
+		rateLimitWarn:      ratelimit.DefaultWarnThreshold,
 		baseHandler:        grpcBase.NewBaseHandler(logger),
 		logger:             logger,
 		validator:          validation.NewValidator(),
@@ -76,185 +97,208 @@ func NewHandler(authService client.AuthHTTPClientInterface, healthHandler Health
 	return h
 }
 
+// WithIngestSources подключает источники внешних алертов для /api/v1/ingest/{source}
+func (h *Handler) WithIngestSources(sources *ingest.Registry) *Handler {
+	h.ingestSources = sources
+	return h
+}
+
+// WithWebhookReplayCache включает отклонение повторно доставленных подписанных webhook
+func (h *Handler) WithWebhookReplayCache(cache webhooks.ReplayCache) *Handler {
+	h.webhookReplay = cache
+	return h
+}
+
+// WithSettingsClient подключает TenantSettingsService для /api/v1/config. Часовой пояс
+// арендатора из его настроек используется при разборе времени без смещения в запросах
+func (h *Handler) WithSettingsClient(settingsClient *client.SettingsClient) *Handler {
+	h.settingsClient = settingsClient
+	h.locales = locale.NewResolver(tenantLocaleLookup(settingsClient), tenantLocaleTTL)
+	return h
+}
+
+// WithRateLimits включает ограничение частоты запросов по классам маршрутов router.Routes.
+// Маршруты класса без лимита не ограничиваются
+func (h *Handler) WithRateLimits(limiter ratelimit.RateLimiter, limits map[router.RateLimitClass]router.RateLimit, trustedProxies *ratelimit.TrustedProxies) *Handler {
+	h.rateLimiter = limiter
+	h.rateLimits = limits
+	h.trustedProxies = trustedProxies
+	return h
+}
+
+// WithRateLimitWarning задает долю лимита, после которой ответы содержат предупреждающие
+// заголовки X-RateLimit-*; 0 - без предупреждений
+func (h *Handler) WithRateLimitWarning(threshold float64) *Handler {
+	h.rateLimitWarn = threshold
+	return h
+}
+
+// WithMaintenance подключает переключатель режима обслуживания: пока он включен, маршруты
+// обслуживают только чтение, кроме помеченных router.Route.AllowInMaintenance
+func (h *Handler) WithMaintenance(maintenance *middleware.Maintenance) *Handler {
+	h.maintenance = maintenance
+	return h
+}
+
+// WithCookieSession включает сессии веб-клиентов в cookie: вход с заголовком
+// X-Session-Mode: cookie выдает HttpOnly cookie и CSRF токен вместо токенов в теле ответа
+func (h *Handler) WithCookieSession(session *middleware.CookieSession) *Handler {
+	h.cookieSession = session
+	return h
+}
+
 // ServeHTTP реализует интерфейс http.Handler
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.mux.ServeHTTP(w, r)
 }
 
-// setupRoutes настраивает маршруты для приложения
+// setupRoutes регистрирует маршруты из таблицы router.Routes: публичные маршруты обслуживаются
+// без аутентификации, остальные - после AuthMiddleware и проверки объявленного права
 func (h *Handler) setupRoutes() {
-	// Scheduler роуты для всех операций с проверками
-
-	// Роут для /api/v1/checks (без слэша) - список проверок
-	checksHandler := middleware.AuthMiddleware(h.authService, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		h.logger.Info("DEBUG: Route /api/v1/checks matched!",
-			logger.String("method", r.Method),
-			logger.String("path", r.URL.Path),
-			logger.String("full_url", r.URL.String()))
-
-		switch r.Method {
-		case http.MethodGet:
-			h.logger.Info("Handling GET /api/v1/checks (list) with checks:read permission")
-			// GET /api/v1/checks - требует checks:read
-			middleware.PermissionMiddleware([]string{"checks:read"}, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				h.handleSchedulerChecks(w, r)
-			})).ServeHTTP(w, r)
-		case http.MethodPost:
-			h.logger.Info("Handling POST /api/v1/checks (create) with checks:write permission")
-			// POST /api/v1/checks - требует checks:write
-			middleware.PermissionMiddleware([]string{"checks:write"}, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				h.handleSchedulerChecks(w, r)
-			})).ServeHTTP(w, r)
-		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			json.NewEncoder(w).Encode(map[string]string{"error": "method not allowed"})
-		}
-	}))
-	h.mux.Handle("/api/v1/checks", checksHandler).Methods(http.MethodGet, http.MethodPost)
-
-	// Роут для /api/v1/checks/{id} - операции с конкретными проверками
-	checkByIDHandler := middleware.AuthMiddleware(h.authService, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		checkID := vars["id"]
-
-		h.logger.Info("DEBUG: Route /api/v1/checks/{id} matched!",
-			logger.String("method", r.Method),
-			logger.String("path", r.URL.Path),
-			logger.String("check_id", checkID),
-			logger.String("full_url", r.URL.String()))
-
-		switch r.Method {
-		case http.MethodGet:
-			h.logger.Info("Handling GET /api/v1/checks/{id} with checks:read permission")
-			// GET /api/v1/checks/{id} - требует checks:read
-			middleware.PermissionMiddleware([]string{"checks:read"}, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				h.handleSchedulerCheckByID(w, r)
-			})).ServeHTTP(w, r)
-		case http.MethodPut:
-			h.logger.Info("Handling PUT /api/v1/checks/{id} with checks:write permission")
-			// PUT /api/v1/checks/{id} - требует checks:write
-			middleware.PermissionMiddleware([]string{"checks:write"}, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				h.handleSchedulerChecks(w, r)
-			})).ServeHTTP(w, r)
-		case http.MethodDelete:
-			h.logger.Info("Handling DELETE /api/v1/checks/{id} with checks:write permission")
-			// DELETE /api/v1/checks/{id} - требует checks:write
-			middleware.PermissionMiddleware([]string{"checks:write"}, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				h.handleSchedulerChecks(w, r)
-			})).ServeHTTP(w, r)
-		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			json.NewEncoder(w).Encode(map[string]string{"error": "method not allowed"})
-		}
-	}))
-	h.mux.Handle("/api/v1/checks/{id}", checkByIDHandler).Methods(http.MethodGet, http.MethodPut, http.MethodDelete)
-
-	// Публичные роуты
-	h.mux.HandleFunc("/api/v1/auth/login", h.handleLogin)
-	h.mux.HandleFunc("/api/v1/auth/register", h.handleRegister)
-	h.mux.HandleFunc("/api/v1/auth/refresh", h.handleRefreshToken)
-	h.mux.HandleFunc("/api/v1/auth/logout", h.handleLogout)
-	h.mux.HandleFunc("/api/v1/auth/validate", h.handleValidateToken)
-
-	// API ключи (потребуют аутентификацию)
-	h.mux.HandleFunc("/api/v1/auth/api-keys", h.handleAPIKeys)
-
-	// Config роуты (требуют прав доступа)
-	configHandler := middleware.PermissionMiddleware([]string{"config:read"}, h.logger)(http.HandlerFunc(h.handleConfig))
-	h.mux.HandleFunc("/api/v1/config", configHandler.ServeHTTP).Methods(http.MethodGet)
-
-	// Health check роуты
-	h.mux.HandleFunc("/health", h.healthHandler.HealthCheck)
-	h.mux.HandleFunc("/ready", h.healthHandler.ReadyCheck)
-	h.mux.HandleFunc("/live", h.healthHandler.LiveCheck)
-
-	// Auth Service health endpoints (для тестирования)
-	h.mux.HandleFunc("/api/v1/auth/health", h.handleAuthHealthProxy)
-	h.mux.HandleFunc("/api/v1/scheduler/health", h.handleSchedulerHealthProxy)
-	h.mux.HandleFunc("/api/v1/core/health", h.handleCoreHealthProxy)
-
-	// Расписания проверок
-	h.mux.HandleFunc("/api/v1/schedules", h.handleProtected(h.handleScheduleProxy))
-	h.mux.HandleFunc("/api/v1/schedules/", h.handleProtected(h.handleScheduleProxy))
-
-	// Core Service операции
-	h.mux.HandleFunc("/api/v1/core", h.handleProtected(h.handleCoreProxy))
-	h.mux.HandleFunc("/api/v1/core/", h.handleProtected(h.handleCoreProxy))
-
-	// Metrics Service
-	h.mux.HandleFunc("/api/v1/metrics", h.handleProtected(h.handleMetricsProxy))
-	h.mux.HandleFunc("/api/v1/metrics/collect", h.handleProtected(h.handleMetricsProxy))
-
-	// Incident Service - роут для списка инцидентов
-	incidentsHandler := middleware.PermissionMiddleware([]string{"incidents:read"}, h.logger)(http.HandlerFunc(h.handleIncidents))
-	h.mux.HandleFunc("/api/v1/incidents", incidentsHandler.ServeHTTP).Methods(http.MethodGet)
-
-	// Incident Service - роут для конкретного инцидента
-	incidentByIDHandler := middleware.AuthMiddleware(h.authService, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		incidentID := vars["id"]
-
-		h.logger.Info("DEBUG: Route /api/v1/incidents/{id} matched!",
-			logger.String("method", r.Method),
-			logger.String("path", r.URL.Path),
-			logger.String("incident_id", incidentID),
-			logger.String("full_url", r.URL.String()))
-
-		switch r.Method {
-		case http.MethodGet:
-			h.logger.Info("Handling GET /api/v1/incidents/{id} with incidents:read permission")
-			// GET /api/v1/incidents/{id} - требует incidents:read
-			middleware.PermissionMiddleware([]string{"incidents:read"}, h.logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				h.handleIncidentProxy(w, r)
-			})).ServeHTTP(w, r)
-		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
-			json.NewEncoder(w).Encode(map[string]string{"error": "method not allowed"})
-		}
-	}))
-	h.mux.Handle("/api/v1/incidents/{id}", incidentByIDHandler).Methods(http.MethodGet, http.MethodPut, http.MethodDelete)
-
-	// Notification Service
-	h.mux.HandleFunc("/api/v1/notifications", h.handleProtected(h.handleNotificationProxy))
-	h.mux.HandleFunc("/api/v1/notifications/channels", h.handleProtected(h.handleNotificationProxy))
-
-	// Добавляем роуты Forge Service
-	h.mux.HandleFunc("/api/v1/forge/generate", h.handleProtected(h.handleForgeProxy))
-	h.mux.HandleFunc("/api/v1/forge/parse", h.handleProtected(h.handleForgeProxy))
-	h.mux.HandleFunc("/api/v1/forge/code", h.handleProtected(h.handleForgeProxy))
-	h.mux.HandleFunc("/api/v1/forge/validate", h.handleProtected(h.handleForgeProxy))
-}
-
-// handleProtected оборачивает обработчик, требующий аутентификации
-func (h *Handler) handleProtected(next http.HandlerFunc) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		h.logger.Info("DEBUG: handleProtected called",
-			logger.String("method", r.Method),
-			logger.String("path", r.URL.Path),
-			logger.String("full_url", r.URL.String()))
-
-		// Проверяем аутентификацию
-		userInfo, err := h.authService.ValidateToken(r.Context(), r.Header.Get("Authorization"))
-		if err != nil {
-			h.logger.Error("Authentication failed",
-				logger.Error(err),
-				logger.String("path", r.URL.Path))
-
-			w.WriteHeader(http.StatusForbidden)
-			json.NewEncoder(w).Encode(map[string]string{
-				"code":    "403",
-				"error":   "true",
-				"message": "insufficient permissions",
-			})
-			return
+	handlers := h.routeHandlers()
+	for _, route := range router.Routes {
+		handler, ok := handlers[route.Name]
+		if !ok {
+			h.logger.Error("Route has no handler, skipping",
+				logger.String("route", route.Name),
+				logger.String("path", route.Path))
+			continue
+		}
+
+		var next http.Handler = handler
+		if !route.Public {
+			next = middleware.AuthMiddlewareWithClientIP(h.authService, h.clientIP, h.logger)(
+				middleware.PermissionMiddleware([]string{route.Permission}, h.logger)(next),
+			)
+			next = h.cookieAuthenticated(next)
 		}
+		next = h.maintenanceGuarded(route.AllowInMaintenance, next)
+		next = h.rateLimited(route.RateLimit, next)
 
-		// Добавляем информацию о пользователе в контекст
-		ctx := context.WithValue(r.Context(), "user", userInfo)
-		next.ServeHTTP(w, r.WithContext(ctx))
+		registered := h.mux.Handle(route.Path, next)
+		if len(route.Methods) > 0 {
+			registered.Methods(route.Methods...)
+		}
 	}
 }
 
+// routeHandlers сопоставляет имена маршрутов таблицы router.Routes с обработчиками
+func (h *Handler) routeHandlers() map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"checks.list":           h.handleSchedulerChecks,
+		"checks.create":         h.handleSchedulerChecks,
+		"checks.run":            h.handleRunCheck,
+		"checks.import":         h.handleImportChecks,
+		"checks.get":            h.handleSchedulerCheckByID,
+		"checks.update":         h.handleSchedulerChecks,
+		"checks.uptime":         h.handleCheckUptime,
+		"checks.artifacts":      h.handleCheckArtifacts,
+		"checks.artifact_url":   h.handleCheckArtifactURL,
+		"summary":               h.handleSummary,
+		"fleet":                 h.handleFleet,
+		"search":                h.handleSearch,
+		"tags":                  h.handleTags,
+		"tags.item":             h.handleTagByName,
+		"tags.preview_interval": h.handlePreviewIntervalChange,
+		"groups":                h.handleGroups,
+		"groups.item":           h.handleGroupByID,
+		"groups.toggle":         h.handleGroupToggle,
+
+		"auth.login":    h.handleLogin,
+		"auth.register": h.handleRegister,
+		"auth.refresh":  h.handleRefreshToken,
+		"auth.logout":   h.handleLogout,
+		"auth.validate": h.handleValidateToken,
+		"auth.api_keys": h.handleAPIKeys,
+		"auth.orgs":     h.handleOrganizationsProxy,
+
+		"config.get":    h.handleGetConfig,
+		"config.update": h.handleUpdateConfig,
+		"config.reset":  h.handleResetConfig,
+
+		"health":           h.healthHandler.HealthCheck,
+		"ready":            h.healthHandler.ReadyCheck,
+		"live":             h.healthHandler.LiveCheck,
+		"auth.health":      h.handleAuthHealthProxy,
+		"scheduler.health": h.handleSchedulerHealthProxy,
+		"core.health":      h.handleCoreHealthProxy,
+		"maintenance":      h.handleMaintenance,
+
+		"schedules": h.handleScheduleProxy,
+		"core":      h.handleCoreProxy,
+		"metrics":   h.handleMetricsProxy,
+
+		"incidents.list":        h.handleIncidents,
+		"incidents.stats":       h.handleIncidentStats,
+		"incidents.get":         h.handleIncidentByID,
+		"incidents.history":     h.handleIncidentHistory,
+		"incidents.acknowledge": h.handleAcknowledgeIncident,
+		"incidents.resolve":     h.handleResolveIncidentByID,
+
+		"status_page.get":                  h.handleGetStatusPage,
+		"status_page.components":           h.handleListStatusComponents,
+		"status_page.component_create":     h.handleCreateStatusComponent,
+		"status_page.component_update":     h.handleUpdateStatusComponent,
+		"status_page.component_delete":     h.handleDeleteStatusComponent,
+		"status_page.override_set":         h.handleSetStatusOverride,
+		"status_page.override_clear":       h.handleClearStatusOverride,
+		"status_page.component_uptime":     h.handleStatusComponentUptime,
+		"status_page.maintenances":         h.handleListMaintenances,
+		"status_page.maintenance_schedule": h.handleScheduleMaintenance,
+		"status_page.maintenance_cancel":   h.handleCancelMaintenance,
+
+		"ingest":        h.handleIngestAlerts,
+		"notifications":        h.handleNotificationProxy,
+		"notifications.reveal": h.handleRevealChannelSecrets,
+		"forge":                h.handleForgeProxy,
+	}
+}
+
+// clientIP определяет IP клиента с учетом доверенных прокси, заданных WithRateLimits
+func (h *Handler) clientIP(r *http.Request) string {
+	return h.trustedProxies.ClientIP(r)
+}
+
+// rateLimited ограничивает частоту запросов по классу маршрута. Лимиты подключаются через
+// WithRateLimits после регистрации маршрутов, поэтому читаются при каждом запросе
+func (h *Handler) rateLimited(class router.RateLimitClass, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, ok := h.rateLimits[class]
+		if h.rateLimiter == nil || !ok || limit.Requests <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		middleware.SoftRateLimitMiddleware(h.rateLimiter, string(class), limit.Requests, limit.Window, h.rateLimitWarn, h.trustedProxies, h.logger)(next).ServeHTTP(w, r)
+	})
+}
+
+// cookieAuthenticated аутентифицирует веб-клиентов по cookie сессии с проверкой CSRF токена.
+// Сессии подключаются через WithCookieSession после регистрации маршрутов, поэтому читаются
+// при каждом запросе
+func (h *Handler) cookieAuthenticated(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		middleware.CookieAuthMiddleware(h.cookieSession, h.logger)(next).ServeHTTP(w, r)
+	})
+}
+
+// maintenanceGuarded отклоняет изменяющие данные запросы в режиме обслуживания. Переключатель
+// подключается через WithMaintenance после регистрации маршрутов, поэтому читается при каждом запросе
+func (h *Handler) maintenanceGuarded(allowMutations bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		middleware.MaintenanceMiddleware(h.maintenance, allowMutations, h.logger)(next).ServeHTTP(w, r)
+	})
+}
+
+// handleMaintenance возвращает баннер режима обслуживания платформы
+func (h *Handler) handleMaintenance(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"maintenance": h.maintenance.State().Banner(),
+	})
+}
+
 // Остальные методы остаются без изменений...
 // (здесь должны быть все остальные методы из оригинального файла)
 
@@ -342,81 +386,6 @@ func (h *Handler) authenticateWithAPIKey(apiKey string) (*UserInfo, error) {
 	return userInfo, nil
 }
 
-// checkResourceAccess проверяет права доступа к ресурсу
-func (h *Handler) checkResourceAccess(r *http.Request, userInfo *UserInfo) bool {
-	// Получаем требуемые права для ресурса
-	requiredPermissions := h.getRequiredPermissions(r)
-	if len(requiredPermissions) == 0 {
-		// Если права не определены, разрешаем доступ
-		return true
-	}
-
-	// Проверяем наличие требуемых прав
-	for _, required := range requiredPermissions {
-		hasPermission := false
-		for _, permission := range userInfo.Permissions {
-			if permission == required || permission == "*" {
-				hasPermission = true
-				break
-			}
-		}
-		if !hasPermission {
-			return false
-		}
-	}
-
-	return true
-}
-
-// getRequiredPermissions возвращает требуемые права для ресурса
-func (h *Handler) getRequiredPermissions(r *http.Request) []string {
-	path := r.URL.Path
-	method := r.Method
-
-	// Определяем права на основе пути и метода
-	switch {
-	case strings.HasPrefix(path, "/api/v1/checks"):
-		switch method {
-		case http.MethodGet:
-			return []string{"checks:read"}
-		case http.MethodPost:
-			return []string{"checks:write"}
-		case http.MethodPut:
-			return []string{"checks:write"}
-		case http.MethodDelete:
-			return []string{"checks:delete"}
-		default:
-			return []string{"checks:read"}
-		}
-	case strings.HasPrefix(path, "/api/v1/incidents"):
-		switch method {
-		case http.MethodGet:
-			return []string{"incidents:read"}
-		case http.MethodPost:
-			return []string{"incidents:write"}
-		case http.MethodPut:
-			return []string{"incidents:write"}
-		default:
-			return []string{"incidents:read"}
-		}
-	case strings.HasPrefix(path, "/api/v1/notifications"):
-		return []string{"notifications:write"}
-	case strings.HasPrefix(path, "/api/v1/metrics"):
-		return []string{"metrics:read"}
-	case strings.HasPrefix(path, "/api/v1/config"):
-		switch method {
-		case http.MethodGet:
-			return []string{"config:read"}
-		default:
-			return []string{"config:write"}
-		}
-	case strings.HasPrefix(path, "/api/v1/forge"):
-		return []string{"forge:write"}
-	default:
-		return []string{}
-	}
-}
-
 // isAuthenticated проверяет аутентификацию запроса (устаревший метод для обратной совместимости)
 // Поддерживает JWT токены в Authorization header или API ключи
 func (h *Handler) isAuthenticated(r *http.Request) bool {
@@ -569,6 +538,11 @@ func (h *Handler) handleLogin(w http.ResponseWriter, r *http.Request) {
 
 	h.logger.Info("Login successful", logger.String("email", req.Email))
 
+	if h.cookieSessionRequested(r) {
+		h.writeCookieSession(w, tokenPair, http.StatusOK)
+		return
+	}
+
 	// Формирование ответа
 	response := map[string]interface{}{
 		"access_token":  tokenPair.AccessToken,
@@ -672,6 +646,11 @@ func (h *Handler) handleRegister(w http.ResponseWriter, r *http.Request) {
 
 	h.logger.Info("Registration successful", logger.String("email", req.Email))
 
+	if h.cookieSessionRequested(r) {
+		h.writeCookieSession(w, tokenPair, http.StatusCreated)
+		return
+	}
+
 	// Формирование ответа
 	response := map[string]interface{}{
 		"access_token":  tokenPair.AccessToken,
@@ -691,6 +670,14 @@ func (h *Handler) handleRefreshToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Веб-клиент с сессией в cookie обновляет токены без передачи refresh токена в теле
+	if h.cookieSession != nil && r.Header.Get("Authorization") == "" {
+		if refreshToken := h.cookieSession.RefreshToken(r); refreshToken != "" {
+			h.refreshCookieSession(w, r, refreshToken)
+			return
+		}
+	}
+
 	// Декодирование запроса
 	var req struct {
 		RefreshToken string `json:"refresh_token"`
@@ -754,6 +741,14 @@ func (h *Handler) handleLogout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Веб-клиент с сессией в cookie выходит без передачи access токена в теле
+	if h.cookieSession != nil && r.Header.Get("Authorization") == "" {
+		if accessToken := h.cookieSession.AccessToken(r); accessToken != "" {
+			h.logoutCookieSession(w, r, accessToken)
+			return
+		}
+	}
+
 	// Декодирование запроса
 	var req struct {
 		AccessToken string `json:"access_token"`
@@ -788,6 +783,59 @@ func (h *Handler) handleLogout(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// cookieSessionRequested сообщает, что веб-клиент запросил сессию в cookie
+func (h *Handler) cookieSessionRequested(r *http.Request) bool {
+	return h.cookieSession != nil && r.Header.Get(middleware.HeaderSessionMode) == middleware.SessionModeCookie
+}
+
+// writeCookieSession выдает сессию в cookie; токены в тело ответа не попадают, клиент получает
+// только CSRF токен для изменяющих запросов
+func (h *Handler) writeCookieSession(w http.ResponseWriter, tokenPair *client.TokenPair, statusCode int) {
+	csrfToken := h.cookieSession.Issue(w, tokenPair.AccessToken, tokenPair.RefreshToken)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"csrf_token": csrfToken,
+		"tenant_id":  tokenPair.TenantID,
+	})
+}
+
+// refreshCookieSession обновляет токены сессии в cookie
+func (h *Handler) refreshCookieSession(w http.ResponseWriter, r *http.Request, refreshToken string) {
+	if err := h.cookieSession.VerifyCSRF(r); err != nil {
+		middleware.WriteCSRFError(w, r, err, h.logger)
+		return
+	}
+
+	tokenPair, err := h.authService.RefreshToken(r.Context(), refreshToken)
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+	h.writeCookieSession(w, tokenPair, http.StatusOK)
+}
+
+// logoutCookieSession завершает сессию в cookie и удаляет cookie
+func (h *Handler) logoutCookieSession(w http.ResponseWriter, r *http.Request, accessToken string) {
+	if err := h.cookieSession.VerifyCSRF(r); err != nil {
+		middleware.WriteCSRFError(w, r, err, h.logger)
+		return
+	}
+
+	if err := h.authService.Logout(r.Context(), accessToken); err != nil {
+		h.handleError(w, err)
+		return
+	}
+	h.cookieSession.Clear(w)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Logged out successfully",
+	})
+}
+
 // handleValidateToken обрабатывает запросы на валидацию токена
 func (h *Handler) handleValidateToken(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -881,11 +929,27 @@ func extractCheckIDFromPath(path string) string {
 	return ""
 }
 
-// handleListHandles обрабатывает получение списка проверок
+// responseTotal общее число записей из ответа сервиса. Сервисы, которые еще не заполняют
+// total, возвращают 0 - тогда используется число записей на странице
+func responseTotal(total int32, count int) int {
+	if total > 0 {
+		return int(total)
+	}
+	return count
+}
+
+// checkMaxPageSize наибольшая страница списка проверок, которую отдает SchedulerService
+const checkMaxPageSize = 100
+
+// handleListHandles обрабатывает получение списка проверок с пагинацией page_size/page_token
 func (h *Handler) handleListChecks(w http.ResponseWriter, r *http.Request, tenantID string) {
 	req := &schedulerv1.ListChecksRequest{
 		TenantId: tenantID,
 	}
+	if err := parseCheckPage(r.URL.Query(), req); err != nil {
+		h.writeError(w, err, http.StatusBadRequest)
+		return
+	}
 
 	resp, err := h.schedulerClient.ListChecks(r.Context(), req)
 	if err != nil {
@@ -896,11 +960,31 @@ func (h *Handler) handleListChecks(w http.ResponseWriter, r *http.Request, tenan
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"checks": resp.Checks,
-		"total":  len(resp.Checks),
+		"checks":          resp.Checks,
+		"total":           responseTotal(resp.Total, len(resp.Checks)),
+		"next_page_token": resp.NextPageToken,
 	})
 }
 
+// parseCheckPage заполняет размер страницы и смещение запроса списка проверок из page_size/page_token
+func parseCheckPage(query url.Values, req *schedulerv1.ListChecksRequest) error {
+	if value := query.Get("page_size"); value != "" {
+		pageSize, err := strconv.Atoi(value)
+		if err != nil || pageSize <= 0 || pageSize > checkMaxPageSize {
+			return pkgErrors.New(pkgErrors.ErrValidation, "page_size must be between 1 and 100")
+		}
+		req.PageSize = int32(pageSize)
+	}
+	if value := query.Get("page_token"); value != "" {
+		pageToken, err := strconv.Atoi(value)
+		if err != nil || pageToken < 0 {
+			return pkgErrors.New(pkgErrors.ErrValidation, "invalid page_token")
+		}
+		req.PageToken = int32(pageToken)
+	}
+	return nil
+}
+
 // handleCreateCheck обрабатывает создание новой проверки
 func (h *Handler) handleCreateCheck(w http.ResponseWriter, r *http.Request, tenantID string) {
 	var createReq schedulerv1.CreateCheckRequest
@@ -1210,7 +1294,7 @@ func (h *Handler) handleCoreProxy(w http.ResponseWriter, r *http.Request) {
 
 	switch r.Method {
 	case http.MethodPost:
-		h.handleExecuteCheck(w, r, userInfo.TenantID, checkID)
+		h.handleExecuteCheck(w, r, userInfo, checkID)
 	case http.MethodGet:
 		if strings.HasSuffix(r.URL.Path, "/status") {
 			h.handleGetCheckStatus(w, r, userInfo.TenantID, checkID)
@@ -1225,9 +1309,11 @@ func (h *Handler) handleCoreProxy(w http.ResponseWriter, r *http.Request) {
 }
 
 // handleExecuteCheck обрабатывает немедленное выполнение проверки
-func (h *Handler) handleExecuteCheck(w http.ResponseWriter, r *http.Request, tenantID, checkID string) {
+func (h *Handler) handleExecuteCheck(w http.ResponseWriter, r *http.Request, userInfo *UserInfo, checkID string) {
 	req := &corev1.ExecuteCheckRequest{
-		CheckId: checkID,
+		CheckId:     checkID,
+		Trigger:     manualTrigger(r),
+		TriggeredBy: "user:" + userInfo.UserID,
 	}
 
 	result, err := h.coreClient.ExecuteCheck(r.Context(), req)
@@ -1245,9 +1331,573 @@ func (h *Handler) handleExecuteCheck(w http.ResponseWriter, r *http.Request, ten
 		"status_code":  result.StatusCode,
 		"error":        result.Error,
 		"checked_at":   result.CheckedAt,
+		"timings":      result.Timings,
+		"trigger":      result.Trigger,
+		"triggered_by": result.TriggeredBy,
+	})
+}
+
+// manualTrigger определяет источник ручного запуска по User-Agent клиента
+func manualTrigger(r *http.Request) string {
+	if strings.HasPrefix(r.UserAgent(), "UptimePing-CLI/") {
+		return "cli"
+	}
+	return "api"
+}
+
+// handleRunCheck выполняет проверку по inline-определению без сохранения.
+// Прогресс (DNS, connect, TLS, first byte) передается клиенту построчно в формате NDJSON,
+// последняя строка содержит итоговый результат.
+func (h *Handler) handleRunCheck(w http.ResponseWriter, r *http.Request) {
+	var runReq struct {
+		Type   string                 `json:"type"`
+		Target string                 `json:"target"`
+		Config map[string]interface{} `json:"config"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&runReq); err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid request body"), http.StatusBadRequest)
+		return
+	}
+	if runReq.Type == "" || runReq.Target == "" {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "type and target are required"), http.StatusBadRequest)
+		return
+	}
+
+	configJSON := ""
+	if len(runReq.Config) > 0 {
+		data, err := json.Marshal(runReq.Config)
+		if err != nil {
+			h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid config"), http.StatusBadRequest)
+			return
+		}
+		configJSON = string(data)
+	}
+
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+
+	stream, err := h.coreClient.RunCheck(r.Context(), &corev1.RunCheckRequest{
+		Type:       runReq.Type,
+		Target:     runReq.Target,
+		ConfigJson: configJSON,
+		TenantId:   tenantID,
+	})
+	if err != nil {
+		h.handleError(w, err)
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	headerWritten := false
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			if !headerWritten {
+				h.handleError(w, err)
+				return
+			}
+			h.logger.Error("Ad-hoc check stream failed", logger.Error(err))
+			encoder.Encode(map[string]interface{}{"phase": "error", "error": err.Error()})
+			return
+		}
+
+		if !headerWritten {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+			headerWritten = true
+		}
+
+		line := map[string]interface{}{
+			"execution_id": event.ExecutionId,
+			"phase":        event.Phase,
+			"elapsed_ms":   event.ElapsedMs,
+		}
+		if event.Detail != "" {
+			line["detail"] = event.Detail
+		}
+		if event.Result != nil {
+			line["result"] = map[string]interface{}{
+				"success":     event.Result.Success,
+				"duration_ms": event.Result.DurationMs,
+				"status_code": event.Result.StatusCode,
+				"error":       event.Result.Error,
+				"checked_at":  event.Result.CheckedAt,
+				"timings":     event.Result.Timings,
+			}
+		}
+		if err := encoder.Encode(line); err != nil {
+			h.logger.Warn("Client disconnected during ad-hoc check", logger.Error(err))
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// Лимиты глобального поиска
+const (
+	searchMaxQueryLength   = 200
+	searchCheckPageSize    = 100
+	searchIncidentPageSize = 500
+	// searchMaxDocuments число проверок и число инцидентов, дальше которого страницы не читаются;
+	// ответ тогда помечается truncated
+	searchMaxDocuments = 5000
+)
+
+// handleSearch выполняет нечеткий поиск по проверкам и инцидентам арендатора для командной палитры UI
+func (h *Handler) handleSearch(w http.ResponseWriter, r *http.Request) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "query parameter q is required"), http.StatusBadRequest)
+		return
+	}
+	if len([]rune(query)) > searchMaxQueryLength {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, fmt.Sprintf("query must not exceed %d characters", searchMaxQueryLength)), http.StatusBadRequest)
+		return
+	}
+
+	limit := search.DefaultLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed <= 0 || parsed > search.MaxLimit {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, fmt.Sprintf("limit must be between 1 and %d", search.MaxLimit)), http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	// Фильтр типов: по умолчанию ищем везде, куда у пользователя есть доступ
+	includeChecks, includeIncidents := true, true
+	if typesStr := r.URL.Query().Get("types"); typesStr != "" {
+		includeChecks, includeIncidents = false, false
+		for _, t := range strings.Split(typesStr, ",") {
+			switch search.ResultType(strings.TrimSpace(t)) {
+			case search.ResultTypeCheck:
+				includeChecks = true
+			case search.ResultTypeIncident:
+				includeIncidents = true
+			default:
+				h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, fmt.Sprintf("unknown result type: %s", t)), http.StatusBadRequest)
+				return
+			}
+		}
+	}
+	includeIncidents = includeIncidents && middleware.HasPermission(r.Context(), "incidents:read")
+
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+
+	index := search.NewIndex()
+	truncated := false
+
+	if includeChecks {
+		req := &schedulerv1.ListChecksRequest{
+			TenantId: tenantID,
+			PageSize: searchCheckPageSize,
+		}
+		if groupIDs, restricted := middleware.GroupScope(r.Context(), "checks:read"); restricted {
+			req.GroupIds = groupIDs
+		}
+		for count := 0; ; {
+			resp, err := h.schedulerClient.ListChecks(r.Context(), req)
+			if err != nil {
+				h.handleError(w, err)
+				return
+			}
+			for _, check := range resp.Checks {
+				index.Add(search.CheckDocument(check))
+			}
+			count += len(resp.Checks)
+			if resp.NextPageToken == 0 {
+				break
+			}
+			if count >= searchMaxDocuments {
+				truncated = true
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+	}
+
+	if includeIncidents {
+		req := &incidentv1.ListIncidentsRequest{
+			TenantId: tenantID,
+			PageSize: searchIncidentPageSize,
+		}
+		for count := 0; ; {
+			resp, err := h.incidentClient.ListIncidents(r.Context(), req)
+			if err != nil {
+				h.handleError(w, err)
+				return
+			}
+			for _, incident := range resp.Incidents {
+				index.Add(search.IncidentDocument(incident))
+			}
+			count += len(resp.Incidents)
+			if resp.NextPageToken == 0 {
+				break
+			}
+			if count >= searchMaxDocuments {
+				truncated = true
+				break
+			}
+			req.PageToken = resp.NextPageToken
+		}
+	}
+
+	results := index.Search(query, limit)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"query":     query,
+		"results":   results,
+		"total":     len(results),
+		"truncated": truncated,
+	})
+}
+
+// handleTags обрабатывает список тегов (GET) и удаление неиспользуемых тегов (DELETE ?unused=true)
+func (h *Handler) handleTags(w http.ResponseWriter, r *http.Request) {
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		resp, err := h.schedulerClient.ListTags(r.Context(), &schedulerv1.ListTagsRequest{TenantId: tenantID})
+		if err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"tags":  resp.Tags,
+			"total": len(resp.Tags),
+			"limit": resp.Limit,
+		})
+	case http.MethodDelete:
+		if !h.requireUnscoped(w, r, "checks:write") {
+			return
+		}
+		if r.URL.Query().Get("unused") != "true" {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "only unused tags can be deleted in bulk: pass unused=true"), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.schedulerClient.DeleteUnusedTags(r.Context(), &schedulerv1.DeleteUnusedTagsRequest{TenantId: tenantID})
+		if err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"deleted": resp.Deleted,
+		})
+	default:
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "method not allowed"), http.StatusMethodNotAllowed)
+	}
+}
+
+// handleTagByName обрабатывает переименование (PUT) и удаление (DELETE) конкретного тега
+func (h *Handler) handleTagByName(w http.ResponseWriter, r *http.Request) {
+	// Переименование и удаление тегов затрагивают все проверки tenant
+	if !h.requireUnscoped(w, r, "checks:write") {
+		return
+	}
+
+	name := mux.Vars(r)["name"]
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		var renameReq struct {
+			NewName string `json:"new_name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&renameReq); err != nil {
+			h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid request body"), http.StatusBadRequest)
+			return
+		}
+		if renameReq.NewName == "" {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "new_name is required"), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := h.schedulerClient.RenameTag(r.Context(), &schedulerv1.RenameTagRequest{
+			TenantId: tenantID,
+			OldName:  name,
+			NewName:  renameReq.NewName,
+		})
+		if err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"success":         true,
+			"affected_checks": resp.AffectedChecks,
+		})
+	case http.MethodDelete:
+		if _, err := h.schedulerClient.DeleteTag(r.Context(), &schedulerv1.DeleteTagRequest{
+			TenantId: tenantID,
+			Name:     name,
+		}); err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "method not allowed"), http.StatusMethodNotAllowed)
+	}
+}
+
+// handleGroups обрабатывает список групп (GET) и создание группы (POST)
+func (h *Handler) handleGroups(w http.ResponseWriter, r *http.Request) {
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		resp, err := h.schedulerClient.ListGroups(r.Context(), &schedulerv1.ListGroupsRequest{TenantId: tenantID})
+		if err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+
+		// Пользователь с правами на отдельные группы видит только их поддеревья
+		groups := resp.Groups
+		if groupIDs, restricted := middleware.GroupScope(r.Context(), "checks:read"); restricted {
+			groups = make([]*schedulerv1.Group, 0, len(resp.Groups))
+			for _, group := range resp.Groups {
+				if middleware.GroupPathAllowed(group.Path, groupIDs) {
+					groups = append(groups, group)
+				}
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"groups": groups,
+			"total":  len(groups),
+		})
+	case http.MethodPost:
+		var createReq struct {
+			Name     string `json:"name"`
+			ParentID string `json:"parent_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
+			h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid request body"), http.StatusBadRequest)
+			return
+		}
+		if strings.TrimSpace(createReq.Name) == "" {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "name is required"), http.StatusBadRequest)
+			return
+		}
+
+		// Пользователь с ограниченными правами создает группы только внутри доступных ему
+		if !h.authorizeGroupParent(w, r, tenantID, createReq.ParentID) {
+			return
+		}
+
+		group, err := h.schedulerClient.CreateGroup(r.Context(), &schedulerv1.CreateGroupRequest{
+			TenantId: tenantID,
+			ParentId: createReq.ParentID,
+			Name:     createReq.Name,
+		})
+		if err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(group)
+	default:
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "method not allowed"), http.StatusMethodNotAllowed)
+	}
+}
+
+// handleGroupByID обрабатывает получение (GET), изменение (PUT) и удаление (DELETE) группы
+func (h *Handler) handleGroupByID(w http.ResponseWriter, r *http.Request) {
+	permission := "checks:write"
+	if r.Method == http.MethodGet {
+		permission = "checks:read"
+	}
+
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+
+	group, ok := h.loadGroup(w, r, tenantID, mux.Vars(r)["id"], permission)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(group)
+	case http.MethodPut:
+		var updateReq struct {
+			Name string `json:"name"`
+			// ParentID не передан - группа остается на месте, пустая строка - перенос в корень
+			ParentID *string `json:"parent_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
+			h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid request body"), http.StatusBadRequest)
+			return
+		}
+
+		parentID := group.ParentId
+		if updateReq.ParentID != nil && *updateReq.ParentID != group.ParentId {
+			parentID = *updateReq.ParentID
+			if !h.authorizeGroupParent(w, r, tenantID, parentID) {
+				return
+			}
+		}
+
+		updated, err := h.schedulerClient.UpdateGroup(r.Context(), &schedulerv1.UpdateGroupRequest{
+			GroupId:  group.Id,
+			Name:     updateReq.Name,
+			ParentId: parentID,
+		})
+		if err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(updated)
+	case http.MethodDelete:
+		if _, err := h.schedulerClient.DeleteGroup(r.Context(), &schedulerv1.DeleteGroupRequest{GroupId: group.Id}); err != nil {
+			h.handleError(w, schedulerError(err))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "method not allowed"), http.StatusMethodNotAllowed)
+	}
+}
+
+// handleGroupToggle включает или выключает все проверки группы и ее подгрупп
+func (h *Handler) handleGroupToggle(w http.ResponseWriter, r *http.Request) {
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+
+	vars := mux.Vars(r)
+	group, ok := h.loadGroup(w, r, tenantID, vars["id"], "checks:write")
+	if !ok {
+		return
+	}
+
+	enabled := vars["action"] == "enable"
+	resp, err := h.schedulerClient.SetGroupEnabled(r.Context(), &schedulerv1.SetGroupEnabledRequest{
+		GroupId: group.Id,
+		Enabled: enabled,
+	})
+	if err != nil {
+		h.handleError(w, schedulerError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":         true,
+		"enabled":         enabled,
+		"affected_checks": resp.AffectedChecks,
 	})
 }
 
+// loadGroup загружает группу tenant и проверяет, что право permission распространяется на нее
+func (h *Handler) loadGroup(w http.ResponseWriter, r *http.Request, tenantID, groupID, permission string) (*schedulerv1.Group, bool) {
+	group, err := h.schedulerClient.GetGroup(r.Context(), &schedulerv1.GetGroupRequest{GroupId: groupID})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return nil, false
+	}
+	if group.TenantId != tenantID {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrNotFound, "group not found"), http.StatusNotFound)
+		return nil, false
+	}
+	if !h.groupAllowed(r.Context(), permission, group.Path) {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "insufficient permissions for group"), http.StatusForbidden)
+		return nil, false
+	}
+	return group, true
+}
+
+// authorizeGroupParent проверяет право checks:write на будущую родительскую группу;
+// создавать корневые группы могут только пользователи без ограничения по группам
+func (h *Handler) authorizeGroupParent(w http.ResponseWriter, r *http.Request, tenantID, parentID string) bool {
+	if parentID == "" {
+		return h.requireUnscoped(w, r, "checks:write")
+	}
+	_, ok := h.loadGroup(w, r, tenantID, parentID, "checks:write")
+	return ok
+}
+
+// authorizeCheckGroup проверяет, что проверка находится в группе, на которую распространяется право
+func (h *Handler) authorizeCheckGroup(w http.ResponseWriter, r *http.Request, checkID, permission string) bool {
+	if _, restricted := middleware.GroupScope(r.Context(), permission); !restricted {
+		return true
+	}
+
+	check, err := h.schedulerClient.GetCheck(r.Context(), &schedulerv1.GetCheckRequest{CheckId: checkID})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return false
+	}
+	if !h.groupAllowed(r.Context(), permission, check.GroupPath) {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "insufficient permissions for check group"), http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// requireUnscoped отклоняет запрос, если право пользователя ограничено отдельными группами
+func (h *Handler) requireUnscoped(w http.ResponseWriter, r *http.Request, permission string) bool {
+	if _, restricted := middleware.GroupScope(r.Context(), permission); restricted {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "operation requires "+permission+" for all checks of the tenant"), http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// groupAllowed проверяет, распространяется ли право пользователя на группу с путем groupPath
+func (h *Handler) groupAllowed(ctx context.Context, permission, groupPath string) bool {
+	groupIDs, restricted := middleware.GroupScope(ctx, permission)
+	return !restricted || middleware.GroupPathAllowed(groupPath, groupIDs)
+}
+
 // handleGetCheckStatus обрабатывает получение статуса проверки
 func (h *Handler) handleGetCheckStatus(w http.ResponseWriter, r *http.Request, tenantID, checkID string) {
 	req := &corev1.GetCheckStatusRequest{
@@ -1287,8 +1937,9 @@ func (h *Handler) handleGetCheckHistory(w http.ResponseWriter, r *http.Request,
 	}
 
 	req := &corev1.GetCheckHistoryRequest{
-		CheckId: checkID,
-		Limit:   int32(pageSize),
+		CheckId:   checkID,
+		Limit:     int32(pageSize),
+		PageToken: int32((page - 1) * pageSize),
 	}
 
 	history, err := h.coreClient.GetCheckHistory(r.Context(), req)
@@ -1303,7 +1954,7 @@ func (h *Handler) handleGetCheckHistory(w http.ResponseWriter, r *http.Request,
 		"executions": history.Results,
 		"page":       page,
 		"page_size":  pageSize,
-		"total":      len(history.Results),
+		"total":      responseTotal(history.Total, len(history.Results)),
 	})
 }
 
@@ -1697,7 +2348,7 @@ func (h *Handler) handleListIncidents(w http.ResponseWriter, r *http.Request, te
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"incidents": resp.Incidents,
-		"total":     len(resp.Incidents),
+		"total":     responseTotal(resp.Total, len(resp.Incidents)),
 	})
 }
 
@@ -1827,6 +2478,27 @@ func (h *Handler) handleCreateNotificationChannel(w http.ResponseWriter, r *http
 	})
 }
 
+// handleRevealChannelSecrets возвращает канал с секретами в открытом виде. Право проверяет
+// и аудит ведет Notification Service по метаданным вызывающего
+func (h *Handler) handleRevealChannelSecrets(w http.ResponseWriter, r *http.Request) {
+	channel, err := h.notificationClient.RevealChannelSecrets(r.Context(), &notificationv1beta1.RevealChannelSecretsRequest{
+		TenantId:  incidentTenantID(r),
+		ChannelId: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	// Ответ с секретами не должен оседать в кэшах прокси и браузера
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"channel": channel,
+	})
+}
+
 // extractIDFromPath извлекает ID из URL пути
 func extractIDFromPath(path, resource string) string {
 	parts := strings.Split(strings.Trim(path, "/"), "/")
@@ -1840,13 +2512,20 @@ func extractIDFromPath(path, resource string) string {
 
 // writeError пишет ошибку в ответ
 func (h *Handler) writeError(w http.ResponseWriter, err error, statusCode int) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(map[string]interface{}{
+	response := map[string]interface{}{
 		"error":   true,
 		"message": err.Error(),
 		"code":    statusCode,
-	})
+	}
+	// Детали из gRPC статуса (например, поле и причина ошибки валидации)
+	var customErr *pkgErrors.Error
+	if errors.As(err, &customErr) && customErr.Details != "" {
+		response["details"] = customErr.Details
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response)
 }
 
 // handleError обрабатывает ошибки и конвертирует их в HTTP статусы
@@ -1868,6 +2547,17 @@ func (h *Handler) handleError(w http.ResponseWriter, err error) {
 	}
 }
 
+// schedulerError переводит ошибку scheduler-service в ошибку API. Нарушенные предусловия тегов
+// и групп (тег используется, группа не пуста) и превышенный лимит тегов - конфликт с состоянием
+func schedulerError(err error) *pkgErrors.Error {
+	appErr := pkgErrors.FromGRPCErr(err)
+	switch status.Code(err) {
+	case codes.FailedPrecondition, codes.ResourceExhausted:
+		appErr.Code = pkgErrors.ErrConflict
+	}
+	return appErr
+}
+
 // handleAuthHealthProxy проксирует health запрос к Auth Service
 func (h *Handler) handleAuthHealthProxy(w http.ResponseWriter, r *http.Request) {
 	// Создаем HTTP клиент
@@ -1955,8 +2645,8 @@ func (h *Handler) handleCoreHealthProxy(w http.ResponseWriter, r *http.Request)
 	client := &http.Client{Timeout: 5 * time.Second}
 
 	// Формируем URL для Core Service HTTP health endpoint
-	// Core Service работает на gRPC, но имеет HTTP health endpoint на том же порту
-	coreURL := "http://core-service:50054/health"
+	// Core Service работает на gRPC, а HTTP health endpoint слушает на порту gRPC + 1000
+	coreURL := "http://core-service:51054/health"
 
 	// Создаем новый запрос
 	req, err := http.NewRequestWithContext(r.Context(), "GET", coreURL, nil)
@@ -2029,6 +2719,16 @@ func (h *Handler) handleSchedulerChecks(w http.ResponseWriter, r *http.Request)
 			TenantId: tenantID,
 			PageSize: 20,
 		}
+		if err := parseCheckPage(r.URL.Query(), req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+		// Пользователь с правами на отдельные группы видит только проверки их поддеревьев;
+		// следующие страницы запрашиваются по next_page_token
+		if groupIDs, restricted := middleware.GroupScope(r.Context(), "checks:read"); restricted {
+			req.GroupIds = groupIDs
+		}
 
 		response, err := h.schedulerClient.ListChecks(r.Context(), req)
 		if err != nil {
@@ -2056,6 +2756,13 @@ func (h *Handler) handleSchedulerChecks(w http.ResponseWriter, r *http.Request)
 			Interval int64  `json:"interval"`
 			Timeout  int64  `json:"timeout"`
 			Enabled  bool   `json:"enabled"`
+			// Владелец проверки и контакты для реагирования на инциденты
+			Owner             string `json:"owner"`
+			Team              string `json:"team"`
+			RunbookURL        string `json:"runbook_url"`
+			EscalationContact string `json:"escalation_contact"`
+			Tags              []string `json:"tags"`
+			GroupID           string   `json:"group_id"`
 		}
 
 		if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
@@ -2083,6 +2790,12 @@ func (h *Handler) handleSchedulerChecks(w http.ResponseWriter, r *http.Request)
 			Target:   target,
 			Interval: int32(createReq.Interval),
 			Timeout:  int32(createReq.Timeout),
+			Owner:             createReq.Owner,
+			Team:              createReq.Team,
+			RunbookUrl:        createReq.RunbookURL,
+			EscalationContact: createReq.EscalationContact,
+			Tags:              createReq.Tags,
+			GroupId:           createReq.GroupID,
 		}
 
 		// Получаем tenant_id из контекста (из токена)
@@ -2101,11 +2814,21 @@ func (h *Handler) handleSchedulerChecks(w http.ResponseWriter, r *http.Request)
 			h.logger.Warn("user context is nil")
 		}
 
+		// Пользователь с правами на отдельные группы создает проверки только в них
+		if _, restricted := middleware.GroupScope(r.Context(), "checks:write"); restricted {
+			if req.GroupId == "" {
+				h.writeError(w, pkgErrors.New(pkgErrors.ErrForbidden, "group_id is required for group-scoped permissions"), http.StatusForbidden)
+				return
+			}
+			if _, ok := h.loadGroup(w, r, req.TenantId, req.GroupId, "checks:write"); !ok {
+				return
+			}
+		}
+
 		response, err := h.schedulerClient.CreateCheck(r.Context(), req)
 		if err != nil {
 			h.logger.Error("Error creating check", logger.Error(err))
-			w.WriteHeader(http.StatusInternalServerError)
-			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			h.handleError(w, schedulerError(err))
 			return
 		}
 		w.WriteHeader(http.StatusCreated)
@@ -2122,6 +2845,10 @@ func (h *Handler) handleSchedulerChecks(w http.ResponseWriter, r *http.Request)
 			return
 		}
 
+		if !h.authorizeCheckGroup(w, r, checkID, "checks:write") {
+			return
+		}
+
 		h.logger.Info("Updating check via Scheduler Service", logger.String("check_id", checkID))
 		req := &schedulerv1.UpdateCheckRequest{
 			CheckId: checkID,
@@ -2129,8 +2856,7 @@ func (h *Handler) handleSchedulerChecks(w http.ResponseWriter, r *http.Request)
 		response, err := h.schedulerClient.UpdateCheck(r.Context(), req)
 		if err != nil {
 			h.logger.Error("Error updating check", logger.Error(err))
-			w.WriteHeader(http.StatusInternalServerError)
-			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			h.handleError(w, schedulerError(err))
 			return
 		}
 		w.WriteHeader(http.StatusOK)
@@ -2147,6 +2873,10 @@ func (h *Handler) handleSchedulerChecks(w http.ResponseWriter, r *http.Request)
 			return
 		}
 
+		if !h.authorizeCheckGroup(w, r, checkID, "checks:write") {
+			return
+		}
+
 		h.logger.Info("Deleting check via Scheduler Service", logger.String("check_id", checkID))
 		req := &schedulerv1.DeleteCheckRequest{
 			CheckId: checkID,
@@ -2197,6 +2927,13 @@ func (h *Handler) handleSchedulerCheckByID(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	// Проверки вне доступных групп не раскрываются пользователю с ограниченными правами
+	if !h.groupAllowed(r.Context(), "checks:read", response.GroupPath) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": "check not found"})
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(response)
 }
@@ -2205,37 +2942,3 @@ func (h *Handler) handleSchedulerCheckByID(w http.ResponseWriter, r *http.Reques
 func (h *Handler) copyResponse(dst http.ResponseWriter, src io.Reader) (int64, error) {
 	return io.Copy(dst, src)
 }
-
-// handleIncidents обрабатывает запросы к инцидентам
-func (h *Handler) handleIncidents(w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("Handling incidents request",
-		logger.String("method", r.Method),
-		logger.String("path", r.URL.Path))
-
-	// Временно возвращаем mock ответ
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"incidents": []interface{}{},
-		"total":     0,
-		"page":      1,
-		"page_size": 20,
-	})
-}
-
-// handleConfig обрабатывает запросы к конфигурации
-func (h *Handler) handleConfig(w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("Handling config request",
-		logger.String("method", r.Method),
-		logger.String("path", r.URL.Path))
-
-	// Временно возвращаем mock ответ
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(map[string]interface{}{
-		"config": map[string]string{
-			"version":     "1.0.0",
-			"environment": "dev",
-		},
-	})
-}
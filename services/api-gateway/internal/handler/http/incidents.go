@@ -0,0 +1,227 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+)
+
+// Ограничения пагинации списка инцидентов
+const (
+	incidentDefaultPageSize = 20
+	incidentMaxPageSize     = 100
+)
+
+// incidentStatuses переводит значения параметра status в статусы IncidentService
+var incidentStatuses = map[string]incidentv1.IncidentStatus{
+	"open":         incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN,
+	"acknowledged": incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED,
+	"resolved":     incidentv1.IncidentStatus_INCIDENT_STATUS_RESOLVED,
+}
+
+// handleIncidents возвращает инциденты арендатора с фильтрами status, severity, check_id,
+// from/to (RFC3339) и пагинацией page_size/page_token
+func (h *Handler) handleIncidents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := &incidentv1.ListIncidentsRequest{
+		TenantId: incidentTenantID(r),
+		CheckId:  query.Get("check_id"),
+		PageSize: incidentDefaultPageSize,
+	}
+
+	if value := query.Get("status"); value != "" {
+		status, ok := incidentStatuses[value]
+		if !ok {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "invalid status: "+value), http.StatusBadRequest)
+			return
+		}
+		req.Status = status
+	}
+	if value := query.Get("severity"); value != "" {
+		severity, ok := ingestSeverities[value]
+		if !ok {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "invalid severity: "+value), http.StatusBadRequest)
+			return
+		}
+		req.Severity = severity
+	}
+	for name, target := range map[string]*string{"from": &req.From, "to": &req.To} {
+		if value := query.Get(name); value != "" {
+			parsed, err := h.parseTenantTime(r, value)
+			if err != nil {
+				h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, name+" must be RFC3339 timestamp, local date-time or date"), http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+	}
+	if value := query.Get("page_size"); value != "" {
+		pageSize, err := strconv.Atoi(value)
+		if err != nil || pageSize <= 0 || pageSize > incidentMaxPageSize {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "page_size must be between 1 and 100"), http.StatusBadRequest)
+			return
+		}
+		req.PageSize = int32(pageSize)
+	}
+	if value := query.Get("page_token"); value != "" {
+		pageToken, err := strconv.Atoi(value)
+		if err != nil || pageToken < 0 {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "invalid page_token"), http.StatusBadRequest)
+			return
+		}
+		req.PageToken = int32(pageToken)
+	}
+
+	resp, err := h.incidentClient.ListIncidents(r.Context(), req)
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"incidents":       resp.Incidents,
+		"total":           responseTotal(resp.Total, len(resp.Incidents)),
+		"page_size":       req.PageSize,
+		"next_page_token": resp.NextPageToken,
+	})
+}
+
+// handleIncidentStats возвращает статистику инцидентов арендатора
+func (h *Handler) handleIncidentStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.incidentClient.GetIncidentStats(r.Context(), &incidentv1.GetIncidentStatsRequest{
+		TenantId: incidentTenantID(r),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"stats":   stats,
+	})
+}
+
+// handleIncidentByID возвращает инцидент арендатора
+func (h *Handler) handleIncidentByID(w http.ResponseWriter, r *http.Request) {
+	incident, ok := h.loadIncident(w, r, incidentTenantID(r), mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":  true,
+		"incident": incident,
+	})
+}
+
+// handleIncidentHistory возвращает события жизненного цикла инцидента
+func (h *Handler) handleIncidentHistory(w http.ResponseWriter, r *http.Request) {
+	incident, ok := h.loadIncident(w, r, incidentTenantID(r), mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	resp, err := h.incidentClient.GetIncidentHistory(r.Context(), &incidentv1.GetIncidentHistoryRequest{
+		IncidentId: incident.Id,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"incident_id": incident.Id,
+		"events":      resp.Events,
+		"total":       len(resp.Events),
+	})
+}
+
+// handleAcknowledgeIncident подтверждает инцидент арендатора
+func (h *Handler) handleAcknowledgeIncident(w http.ResponseWriter, r *http.Request) {
+	incident, ok := h.loadIncident(w, r, incidentTenantID(r), mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	acknowledged, err := h.incidentClient.AcknowledgeIncident(r.Context(), &incidentv1.AcknowledgeIncidentRequest{
+		IncidentId: incident.Id,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":  true,
+		"message":  "Incident acknowledged",
+		"incident": acknowledged,
+	})
+}
+
+// handleResolveIncidentByID закрывает инцидент арендатора
+func (h *Handler) handleResolveIncidentByID(w http.ResponseWriter, r *http.Request) {
+	incident, ok := h.loadIncident(w, r, incidentTenantID(r), mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	resp, err := h.incidentClient.ResolveIncident(r.Context(), &incidentv1.ResolveIncidentRequest{
+		IncidentId: incident.Id,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": resp.Success,
+		"message": "Incident resolved",
+	})
+}
+
+// loadIncident загружает инцидент и проверяет, что он принадлежит арендатору;
+// чужой инцидент неотличим от несуществующего
+func (h *Handler) loadIncident(w http.ResponseWriter, r *http.Request, tenantID, incidentID string) (*incidentv1.Incident, bool) {
+	if err := h.validator.ValidateUUID(incidentID, "incident_id"); err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid incident ID format"), http.StatusBadRequest)
+		return nil, false
+	}
+
+	incident, err := h.incidentClient.GetIncident(r.Context(), &incidentv1.GetIncidentRequest{IncidentId: incidentID})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return nil, false
+	}
+	if incident.TenantId != tenantID {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrNotFound, "incident not found"), http.StatusNotFound)
+		return nil, false
+	}
+	return incident, true
+}
+
+// incidentTenantID извлекает tenant_id пользователя, установленный AuthMiddleware
+func incidentTenantID(r *http.Request) string {
+	tenantID := ""
+	if userMap, ok := r.Context().Value("user").(map[string]interface{}); ok {
+		tenantID, _ = userMap["tenant_id"].(string)
+	}
+	return tenantID
+}
@@ -0,0 +1,161 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	"UptimePingPlatform/services/api-gateway/internal/ingest"
+)
+
+// Ограничения приема внешних алертов
+const (
+	ingestMaxBodySize = 1 << 20
+	ingestMaxAlerts   = 500
+)
+
+// ingestSeverities переводит уровни серьезности в значения IncidentService
+var ingestSeverities = map[string]incidentv1.IncidentSeverity{
+	ingest.SeverityWarning:  incidentv1.IncidentSeverity_INCIDENT_SEVERITY_WARNING,
+	ingest.SeverityError:    incidentv1.IncidentSeverity_INCIDENT_SEVERITY_ERROR,
+	ingest.SeverityCritical: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL,
+}
+
+// ingestResult результат обработки одного алерта
+type ingestResult struct {
+	Fingerprint string `json:"fingerprint"`
+	Action      string `json:"action"`
+	IncidentID  string `json:"incident_id,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// handleIngestAlerts принимает webhook внешней системы мониторинга и превращает алерты в инциденты.
+// Токен источника передается в заголовке Authorization: Bearer или параметром token
+// (SNS не позволяет задать заголовки для CloudWatch)
+func (h *Handler) handleIngestAlerts(w http.ResponseWriter, r *http.Request) {
+	sourceName := mux.Vars(r)["source"]
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+
+	source, ok := h.ingestSources.Authenticate(sourceName, token)
+	if !ok {
+		h.logger.Warn("Rejected alert webhook", logger.String("source", sourceName))
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrUnauthorized, "invalid ingest source or token"), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, ingestMaxBodySize+1))
+	if err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "failed to read request body"), http.StatusBadRequest)
+		return
+	}
+	if len(body) > ingestMaxBodySize {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "request body too large"), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err := source.VerifySignature(r.Context(), r.Header, body, h.webhookReplay); err != nil {
+		h.logger.Warn("Rejected alert webhook signature",
+			logger.String("source", source.Name),
+			logger.Error(err))
+		status := webhooks.StatusCode(err)
+		code := pkgErrors.ErrUnauthorized
+		switch status {
+		case http.StatusConflict:
+			code = pkgErrors.ErrConflict
+		case http.StatusServiceUnavailable:
+			code = pkgErrors.ErrInternal
+		}
+		h.writeError(w, pkgErrors.Wrap(err, code, "webhook signature verification failed"), status)
+		return
+	}
+
+	payload, err := ingest.Parse(source.Type, body)
+	if err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid webhook payload"), http.StatusBadRequest)
+		return
+	}
+
+	if payload.SubscribeURL != "" {
+		if err := ingest.ConfirmSubscription(r.Context(), &http.Client{Timeout: 10 * time.Second}, payload.SubscribeURL); err != nil {
+			h.logger.Error("Failed to confirm SNS subscription",
+				logger.String("source", source.Name),
+				logger.Error(err))
+			h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "failed to confirm subscription"), http.StatusBadRequest)
+			return
+		}
+		h.logger.Info("SNS subscription confirmed", logger.String("source", source.Name))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if len(payload.Alerts) > ingestMaxAlerts {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "too many alerts in one webhook"), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if h.incidentClient == nil {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrInternal, "incident service is unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	results := make([]ingestResult, 0, len(payload.Alerts))
+	failed := 0
+	for _, alert := range payload.Alerts {
+		mapped, keep := source.Map(alert)
+		if !keep {
+			results = append(results, ingestResult{Fingerprint: alert.Fingerprint, Action: "dropped"})
+			continue
+		}
+
+		resp, err := h.incidentClient.IngestAlert(r.Context(), &incidentv1.IngestAlertRequest{
+			TenantId:    source.TenantID,
+			Source:      source.Name,
+			Fingerprint: mapped.Fingerprint,
+			Resolved:    mapped.Resolved,
+			Title:       mapped.Title,
+			Description: mapped.Description,
+			Severity:    ingestSeverities[mapped.Severity],
+			Labels:      mapped.Labels,
+		})
+		if err != nil {
+			failed++
+			h.logger.Error("Failed to ingest alert",
+				logger.String("source", source.Name),
+				logger.String("fingerprint", mapped.Fingerprint),
+				logger.Error(err))
+			results = append(results, ingestResult{Fingerprint: mapped.Fingerprint, Action: "failed", Error: err.Error()})
+			continue
+		}
+
+		result := ingestResult{Fingerprint: mapped.Fingerprint, Action: resp.Action}
+		if resp.Incident != nil {
+			result.IncidentID = resp.Incident.Id
+		}
+		results = append(results, result)
+	}
+
+	// Источники повторяют доставку при 5xx, поэтому ошибка возвращается, только если не обработан ни один алерт
+	status := http.StatusOK
+	if failed > 0 && failed == len(payload.Alerts) {
+		status = http.StatusBadGateway
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"source":   source.Name,
+		"received": len(payload.Alerts),
+		"results":  results,
+	})
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/health"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/api-gateway/internal/middleware"
+)
+
+func TestHandler_Maintenance(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+
+	maintenance := middleware.NewMaintenance(middleware.MaintenanceState{})
+	handler := NewHandler(nil, NewHealthHandler(&health.SimpleHealthChecker{}, log), nil, nil, nil, nil, nil, nil, nil, log).
+		WithMaintenance(maintenance)
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/maintenance", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"maintenance":{"read_only":false}}`, w.Body.String())
+
+	maintenance.Set(middleware.MaintenanceState{ReadOnly: true, Message: "Scheduled database upgrade"})
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/maintenance", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var body struct {
+		Maintenance middleware.MaintenanceBanner `json:"maintenance"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.True(t, body.Maintenance.ReadOnly)
+	assert.Equal(t, "Scheduled database upgrade", body.Maintenance.Message)
+
+	// Чтение обслуживается и помечается заголовком
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "read-only", w.Header().Get(middleware.HeaderMaintenance))
+
+	// Изменение данных отклоняется до аутентификации
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/checks", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "300", w.Header().Get("Retry-After"))
+}
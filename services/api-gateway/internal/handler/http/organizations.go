@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+)
+
+// organizationsProxyTimeout таймаут запросов API организаций к Auth Service
+const organizationsProxyTimeout = 10 * time.Second
+
+// handleOrganizationsProxy проксирует API организаций в Auth Service вместе с заголовком
+// Authorization: токен проверяет Auth Service, а переключение тенанта возвращает новый токен
+func (h *Handler) handleOrganizationsProxy(w http.ResponseWriter, r *http.Request) {
+	if h.authService == nil {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrInternal, "auth service not initialized"), http.StatusServiceUnavailable)
+		return
+	}
+
+	url := h.authService.GetBaseURL() + r.URL.Path
+	if r.URL.RawQuery != "" {
+		url += "?" + r.URL.RawQuery
+	}
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, url, r.Body)
+	if err != nil {
+		h.writeError(w, err, http.StatusInternalServerError)
+		return
+	}
+	req.Header.Set("Authorization", r.Header.Get("Authorization"))
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: organizationsProxyTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		h.writeError(w, err, http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(resp.StatusCode)
+	if _, err := h.copyResponse(w, resp.Body); err != nil {
+		h.logger.Error("failed to copy response", logger.Error(err))
+	}
+}
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/health"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/api-gateway/internal/search"
+)
+
+// searchChecks выполняет поиск по проверкам через handleSearch
+func searchChecks(t *testing.T, scheduler *fakeScheduler, query string) (results []search.Result, truncated bool) {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+	handler := NewHandler(nil, NewHealthHandler(&health.SimpleHealthChecker{}, log), newFakeSchedulerClient(t, scheduler), nil, nil, nil, nil, nil, nil, log)
+
+	ctx := context.WithValue(context.Background(), "user", map[string]interface{}{"tenant_id": "tenant-1"})
+	w := httptest.NewRecorder()
+	handler.handleSearch(w, httptest.NewRequest(http.MethodGet, "/api/v1/search?types=check&q="+query, nil).WithContext(ctx))
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var body struct {
+		Results   []search.Result `json:"results"`
+		Truncated bool            `json:"truncated"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	return body.Results, body.Truncated
+}
+
+func TestHandler_Search_ReadsAllCheckPages(t *testing.T) {
+	scheduler := &fakeScheduler{checks: testChecks(250)}
+
+	results, truncated := searchChecks(t, scheduler, "check-240")
+
+	require.NotEmpty(t, results)
+	assert.Equal(t, "check-240", results[0].ID)
+	assert.False(t, truncated)
+	require.Len(t, scheduler.requests, 3)
+	assert.Equal(t, int32(200), scheduler.requests[2].PageToken)
+}
+
+func TestHandler_Search_TruncatesLargeTenants(t *testing.T) {
+	scheduler := &fakeScheduler{checks: testChecks(searchMaxDocuments + 1)}
+
+	_, truncated := searchChecks(t, scheduler, "check-001")
+
+	assert.True(t, truncated)
+	assert.Len(t, scheduler.requests, searchMaxDocuments/searchCheckPageSize)
+}
@@ -0,0 +1,254 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/locale"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	"UptimePingPlatform/services/api-gateway/internal/client"
+)
+
+// tenantLocaleTTL время кэширования часового пояса арендатора для разбора времени в запросах
+const tenantLocaleTTL = 5 * time.Minute
+
+// tenantSettingsPayload настройки арендатора в API gateway. Поля передаются всегда,
+// чтобы нулевые значения не пропадали из ответа
+type tenantSettingsPayload struct {
+	TenantID string `json:"tenant_id"`
+	Checks   struct {
+		IntervalSeconds int32 `json:"interval_seconds"`
+		TimeoutSeconds  int32 `json:"timeout_seconds"`
+	} `json:"checks"`
+	Notifications struct {
+		ChannelIDs       []string `json:"channel_ids"`
+		MinSeverity      string   `json:"min_severity"`
+		NotifyOnRecovery bool     `json:"notify_on_recovery"`
+		SecurityOptOut   []string `json:"security_opt_out"`
+	} `json:"notifications"`
+	Features map[string]bool `json:"features"`
+	Branding struct {
+		CompanyName     string `json:"company_name"`
+		LogoURL         string `json:"logo_url"`
+		PrimaryColor    string `json:"primary_color"`
+		StatusPageTitle string `json:"status_page_title"`
+	} `json:"branding"`
+	Localization struct {
+		Timezone string `json:"timezone"`
+		Locale   string `json:"locale"`
+	} `json:"localization"`
+	ResultSinks []resultSinkPayload `json:"result_sinks"`
+	UpdatedBy   string              `json:"updated_by,omitempty"`
+	UpdatedAt   string              `json:"updated_at,omitempty"`
+}
+
+// resultSinkPayload приемник сырых результатов проверок. Ключ подписи только принимается:
+// в ответе вместо него has_secret, а пустой secret при обновлении сохраняет прежний ключ
+type resultSinkPayload struct {
+	Name      string   `json:"name"`
+	URL       string   `json:"url"`
+	Secret    string   `json:"secret,omitempty"`
+	HasSecret bool     `json:"has_secret"`
+	Tags      []string `json:"tags"`
+}
+
+// handleGetConfig возвращает настройки арендатора
+func (h *Handler) handleGetConfig(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsClient(w) {
+		return
+	}
+
+	settings, err := h.settingsClient.GetTenantSettings(r.Context(), &configv1.GetTenantSettingsRequest{
+		TenantId: incidentTenantID(r),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeTenantSettings(w, settings)
+}
+
+// handleUpdateConfig заменяет настройки арендатора телом запроса
+func (h *Handler) handleUpdateConfig(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsClient(w) {
+		return
+	}
+
+	var payload tenantSettingsPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid request body"), http.StatusBadRequest)
+		return
+	}
+
+	// Арендатор всегда берется из токена, tenant_id из тела игнорируется
+	settings := payload.toProto()
+	settings.TenantId = incidentTenantID(r)
+
+	updated, err := h.settingsClient.UpdateTenantSettings(r.Context(), &configv1.UpdateTenantSettingsRequest{
+		Settings:  settings,
+		UpdatedBy: settingsActor(r),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeTenantSettings(w, updated)
+}
+
+// handleResetConfig возвращает настройки арендатора к значениям по умолчанию
+func (h *Handler) handleResetConfig(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSettingsClient(w) {
+		return
+	}
+
+	settings, err := h.settingsClient.ResetTenantSettings(r.Context(), &configv1.ResetTenantSettingsRequest{
+		TenantId: incidentTenantID(r),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeTenantSettings(w, settings)
+}
+
+// requireSettingsClient отвечает 503, если TenantSettingsService не подключен
+func (h *Handler) requireSettingsClient(w http.ResponseWriter) bool {
+	if h.settingsClient == nil {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrInternal, "tenant settings service is unavailable"), http.StatusServiceUnavailable)
+		return false
+	}
+	return true
+}
+
+// writeTenantSettings записывает настройки арендатора в ответ
+func (h *Handler) writeTenantSettings(w http.ResponseWriter, settings *configv1.TenantSettings) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success":  true,
+		"settings": tenantSettingsFromProto(settings),
+	})
+}
+
+// settingsActor возвращает email пользователя, а без него - user_id, для журнала изменений
+func settingsActor(r *http.Request) string {
+	userMap, ok := r.Context().Value("user").(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if email, _ := userMap["email"].(string); email != "" {
+		return email
+	}
+	userID, _ := userMap["user_id"].(string)
+	return userID
+}
+
+// toProto конвертирует тело запроса в настройки TenantSettingsService
+func (p *tenantSettingsPayload) toProto() *configv1.TenantSettings {
+	sinks := make([]*configv1.ResultSink, 0, len(p.ResultSinks))
+	for _, sink := range p.ResultSinks {
+		sinks = append(sinks, &configv1.ResultSink{
+			Name:   sink.Name,
+			Url:    sink.URL,
+			Secret: sink.Secret,
+			Tags:   sink.Tags,
+		})
+	}
+	return &configv1.TenantSettings{
+		Checks: &configv1.CheckDefaults{
+			IntervalSeconds: p.Checks.IntervalSeconds,
+			TimeoutSeconds:  p.Checks.TimeoutSeconds,
+		},
+		Notifications: &configv1.NotificationDefaults{
+			ChannelIds:       p.Notifications.ChannelIDs,
+			MinSeverity:      p.Notifications.MinSeverity,
+			NotifyOnRecovery: p.Notifications.NotifyOnRecovery,
+			SecurityOptOut:   p.Notifications.SecurityOptOut,
+		},
+		Features: p.Features,
+		Branding: &configv1.Branding{
+			CompanyName:     p.Branding.CompanyName,
+			LogoUrl:         p.Branding.LogoURL,
+			PrimaryColor:    p.Branding.PrimaryColor,
+			StatusPageTitle: p.Branding.StatusPageTitle,
+		},
+		Localization: &configv1.Localization{
+			Timezone: p.Localization.Timezone,
+			Locale:   p.Localization.Locale,
+		},
+		ResultSinks: sinks,
+	}
+}
+
+// tenantSettingsFromProto конвертирует настройки TenantSettingsService в ответ gateway
+func tenantSettingsFromProto(settings *configv1.TenantSettings) *tenantSettingsPayload {
+	payload := &tenantSettingsPayload{
+		TenantID:  settings.TenantId,
+		Features:  settings.Features,
+		UpdatedBy: settings.UpdatedBy,
+		UpdatedAt: settings.UpdatedAt,
+	}
+	payload.Checks.IntervalSeconds = settings.GetChecks().GetIntervalSeconds()
+	payload.Checks.TimeoutSeconds = settings.GetChecks().GetTimeoutSeconds()
+	payload.Notifications.ChannelIDs = settings.GetNotifications().GetChannelIds()
+	payload.Notifications.MinSeverity = settings.GetNotifications().GetMinSeverity()
+	payload.Notifications.NotifyOnRecovery = settings.GetNotifications().GetNotifyOnRecovery()
+	payload.Notifications.SecurityOptOut = settings.GetNotifications().GetSecurityOptOut()
+	payload.Branding.CompanyName = settings.GetBranding().GetCompanyName()
+	payload.Branding.LogoURL = settings.GetBranding().GetLogoUrl()
+	payload.Branding.PrimaryColor = settings.GetBranding().GetPrimaryColor()
+	payload.Branding.StatusPageTitle = settings.GetBranding().GetStatusPageTitle()
+	payload.Localization.Timezone = settings.GetLocalization().GetTimezone()
+	payload.Localization.Locale = settings.GetLocalization().GetLocale()
+	payload.ResultSinks = make([]resultSinkPayload, 0, len(settings.GetResultSinks()))
+	for _, sink := range settings.GetResultSinks() {
+		tags := sink.GetTags()
+		if tags == nil {
+			tags = []string{}
+		}
+		payload.ResultSinks = append(payload.ResultSinks, resultSinkPayload{
+			Name:      sink.GetName(),
+			URL:       sink.GetUrl(),
+			HasSecret: sink.GetSecret() != "",
+			Tags:      tags,
+		})
+	}
+
+	if payload.Features == nil {
+		payload.Features = map[string]bool{}
+	}
+	if payload.Notifications.ChannelIDs == nil {
+		payload.Notifications.ChannelIDs = []string{}
+	}
+	if payload.Notifications.SecurityOptOut == nil {
+		payload.Notifications.SecurityOptOut = []string{}
+	}
+	return payload
+}
+
+// tenantLocaleLookup загружает часовой пояс и язык арендатора из TenantSettingsService
+func tenantLocaleLookup(settingsClient *client.SettingsClient) locale.Lookup {
+	return func(ctx context.Context, tenantID string) (locale.Settings, error) {
+		settings, err := settingsClient.GetTenantSettings(ctx, &configv1.GetTenantSettingsRequest{TenantId: tenantID})
+		if err != nil {
+			return locale.Settings{}, err
+		}
+		return locale.Settings{
+			Timezone: settings.GetLocalization().GetTimezone(),
+			Locale:   settings.GetLocalization().GetLocale(),
+		}, nil
+	}
+}
+
+// parseTenantTime разбирает время из запроса в часовом поясе арендатора и возвращает его в RFC3339 UTC.
+// Без TenantSettingsService время без смещения считается UTC
+func (h *Handler) parseTenantTime(r *http.Request, value string) (string, error) {
+	parsed, err := h.locales.Resolve(r.Context(), incidentTenantID(r)).ParseTime(value)
+	if err != nil {
+		return "", err
+	}
+	return parsed.UTC().Format(time.RFC3339), nil
+}
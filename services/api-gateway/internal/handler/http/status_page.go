@@ -0,0 +1,260 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+)
+
+// statusComponentPayload тело запроса создания и изменения компонента страницы статуса
+type statusComponentPayload struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	CheckIDs    []string `json:"check_ids"`
+	Position    int32    `json:"position"`
+}
+
+// statusOverridePayload тело запроса ручного статуса компонента; expires_at в RFC3339
+type statusOverridePayload struct {
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// maintenancePayload тело запроса объявления об обслуживании; время в RFC3339,
+// время без смещения и даты интерпретируются в часовом поясе арендатора
+type maintenancePayload struct {
+	Title        string   `json:"title"`
+	Message      string   `json:"message"`
+	ComponentIDs []string `json:"component_ids"`
+	StartsAt     string   `json:"starts_at"`
+	EndsAt       string   `json:"ends_at"`
+}
+
+// handleGetStatusPage возвращает страницу статуса арендатора с вычисленными статусами компонентов
+func (h *Handler) handleGetStatusPage(w http.ResponseWriter, r *http.Request) {
+	page, err := h.incidentClient.GetStatusPage(r.Context(), &incidentv1.GetStatusPageRequest{
+		TenantId: incidentTenantID(r),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     true,
+		"status_page": page,
+	})
+}
+
+// handleListStatusComponents возвращает компоненты страницы статуса
+func (h *Handler) handleListStatusComponents(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.incidentClient.ListStatusComponents(r.Context(), &incidentv1.ListStatusComponentsRequest{
+		TenantId: incidentTenantID(r),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"components": resp.Components,
+		"total":      len(resp.Components),
+	})
+}
+
+// handleCreateStatusComponent создает компонент страницы статуса
+func (h *Handler) handleCreateStatusComponent(w http.ResponseWriter, r *http.Request) {
+	var payload statusComponentPayload
+	if !h.decodeStatusPagePayload(w, r, &payload) {
+		return
+	}
+
+	component, err := h.incidentClient.CreateStatusComponent(r.Context(), &incidentv1.CreateStatusComponentRequest{
+		TenantId:    incidentTenantID(r),
+		Name:        payload.Name,
+		Description: payload.Description,
+		CheckIds:    payload.CheckIDs,
+		Position:    payload.Position,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusCreated, map[string]interface{}{
+		"success":   true,
+		"component": component,
+	})
+}
+
+// handleUpdateStatusComponent заменяет данные компонента; ручной статус не меняется
+func (h *Handler) handleUpdateStatusComponent(w http.ResponseWriter, r *http.Request) {
+	var payload statusComponentPayload
+	if !h.decodeStatusPagePayload(w, r, &payload) {
+		return
+	}
+
+	component, err := h.incidentClient.UpdateStatusComponent(r.Context(), &incidentv1.UpdateStatusComponentRequest{
+		TenantId:    incidentTenantID(r),
+		ComponentId: mux.Vars(r)["id"],
+		Name:        payload.Name,
+		Description: payload.Description,
+		CheckIds:    payload.CheckIDs,
+		Position:    payload.Position,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"component": component,
+	})
+}
+
+// handleDeleteStatusComponent удаляет компонент страницы статуса
+func (h *Handler) handleDeleteStatusComponent(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.incidentClient.DeleteStatusComponent(r.Context(), &incidentv1.DeleteStatusComponentRequest{
+		TenantId:    incidentTenantID(r),
+		ComponentId: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success": resp.Success,
+		"message": "Status component deleted",
+	})
+}
+
+// handleSetStatusOverride вручную задает отображаемый статус компонента
+func (h *Handler) handleSetStatusOverride(w http.ResponseWriter, r *http.Request) {
+	var payload statusOverridePayload
+	if !h.decodeStatusPagePayload(w, r, &payload) {
+		return
+	}
+	if payload.Status == "" {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "status is required"), http.StatusBadRequest)
+		return
+	}
+
+	component, err := h.incidentClient.SetComponentStatusOverride(r.Context(), &incidentv1.SetComponentStatusOverrideRequest{
+		TenantId:    incidentTenantID(r),
+		ComponentId: mux.Vars(r)["id"],
+		Status:      payload.Status,
+		Message:     payload.Message,
+		ExpiresAt:   payload.ExpiresAt,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"component": component,
+	})
+}
+
+// handleClearStatusOverride снимает ручной статус компонента
+func (h *Handler) handleClearStatusOverride(w http.ResponseWriter, r *http.Request) {
+	component, err := h.incidentClient.ClearComponentStatusOverride(r.Context(), &incidentv1.ClearComponentStatusOverrideRequest{
+		TenantId:    incidentTenantID(r),
+		ComponentId: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success":   true,
+		"component": component,
+	})
+}
+
+// handleListMaintenances возвращает объявления об обслуживании, которые заканчиваются не раньше since (RFC3339)
+func (h *Handler) handleListMaintenances(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.incidentClient.ListMaintenances(r.Context(), &incidentv1.ListMaintenancesRequest{
+		TenantId: incidentTenantID(r),
+		Since:    r.URL.Query().Get("since"),
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"maintenances": resp.Maintenances,
+		"total":        len(resp.Maintenances),
+	})
+}
+
+// handleScheduleMaintenance публикует объявление об обслуживании
+func (h *Handler) handleScheduleMaintenance(w http.ResponseWriter, r *http.Request) {
+	var payload maintenancePayload
+	if !h.decodeStatusPagePayload(w, r, &payload) {
+		return
+	}
+	for name, target := range map[string]*string{"starts_at": &payload.StartsAt, "ends_at": &payload.EndsAt} {
+		if *target == "" {
+			continue
+		}
+		parsed, err := h.parseTenantTime(r, *target)
+		if err != nil {
+			h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, name+" must be RFC3339 timestamp, local date-time or date"), http.StatusBadRequest)
+			return
+		}
+		*target = parsed
+	}
+
+	maintenance, err := h.incidentClient.ScheduleMaintenance(r.Context(), &incidentv1.ScheduleMaintenanceRequest{
+		TenantId:     incidentTenantID(r),
+		Title:        payload.Title,
+		Message:      payload.Message,
+		ComponentIds: payload.ComponentIDs,
+		StartsAt:     payload.StartsAt,
+		EndsAt:       payload.EndsAt,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusCreated, map[string]interface{}{
+		"success":     true,
+		"maintenance": maintenance,
+	})
+}
+
+// handleCancelMaintenance отменяет объявление об обслуживании
+func (h *Handler) handleCancelMaintenance(w http.ResponseWriter, r *http.Request) {
+	maintenance, err := h.incidentClient.CancelMaintenance(r.Context(), &incidentv1.CancelMaintenanceRequest{
+		TenantId:      incidentTenantID(r),
+		MaintenanceId: mux.Vars(r)["id"],
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success":     true,
+		"message":     "Maintenance cancelled",
+		"maintenance": maintenance,
+	})
+}
+
+// decodeStatusPagePayload разбирает JSON тело запроса; при ошибке отвечает 400
+func (h *Handler) decodeStatusPagePayload(w http.ResponseWriter, r *http.Request, payload interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+		h.writeError(w, pkgErrors.Wrap(err, pkgErrors.ErrValidation, "invalid request body"), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// writeStatusPageJSON записывает ответ страницы статуса
+func (h *Handler) writeStatusPageJSON(w http.ResponseWriter, status int, body map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
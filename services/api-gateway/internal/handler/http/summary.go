@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/api-gateway/internal/middleware"
+	"UptimePingPlatform/services/api-gateway/internal/summary"
+)
+
+// handleSummary возвращает сводку для панели мониторинга: проверки по состоянию, открытые
+// инциденты, текущее обслуживание и доступность за 24 часа. Недоступные разделы
+// перечисляются в errors, ответ 503 - только если не собран ни один раздел
+func (h *Handler) handleSummary(w http.ResponseWriter, r *http.Request) {
+	req := summary.Request{
+		TenantID:         incidentTenantID(r),
+		IncludeIncidents: middleware.HasPermission(r.Context(), "incidents:read"),
+	}
+	if groupIDs, restricted := middleware.GroupScope(r.Context(), "checks:read"); restricted {
+		req.GroupIDs = groupIDs
+	}
+
+	result := h.summaryBuilder().Build(r.Context(), req)
+	if !result.Available() {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrInternal, "summary sources are unavailable"), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"summary": result,
+	})
+}
+
+// summaryBuilder создает сборщик сводки из настроенных клиентов сервисов
+func (h *Handler) summaryBuilder() *summary.Builder {
+	var (
+		checks    summary.CheckSource
+		statuses  summary.StatusSource
+		incidents summary.IncidentSource
+	)
+	if h.schedulerClient != nil {
+		checks = h.schedulerClient
+	}
+	if h.coreClient != nil {
+		statuses = h.coreClient
+	}
+	if h.incidentClient != nil {
+		incidents = h.incidentClient
+	}
+	return summary.NewBuilder(checks, statuses, incidents, h.logger)
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+// uptimeCacheControl сводки пересчитываются периодически, поэтому историю можно кэшировать на клиенте
+const uptimeCacheControl = "private, max-age=300"
+
+// handleCheckUptime возвращает дневную историю доступности проверки (?days=, по умолчанию 90)
+func (h *Handler) handleCheckUptime(w http.ResponseWriter, r *http.Request) {
+	days, ok := h.uptimeDays(w, r)
+	if !ok {
+		return
+	}
+	checkID, ok := h.readableCheck(w, r)
+	if !ok {
+		return
+	}
+
+	h.writeUptimeHistory(w, r, []string{checkID}, days)
+}
+
+// handleStatusComponentUptime возвращает дневную историю доступности компонента страницы
+// статуса, усредненную по его проверкам; проверки других арендаторов не учитываются
+func (h *Handler) handleStatusComponentUptime(w http.ResponseWriter, r *http.Request) {
+	days, ok := h.uptimeDays(w, r)
+	if !ok {
+		return
+	}
+
+	tenantID := incidentTenantID(r)
+	resp, err := h.incidentClient.ListStatusComponents(r.Context(), &incidentv1.ListStatusComponentsRequest{
+		TenantId: tenantID,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	var component *incidentv1.StatusComponent
+	for _, c := range resp.Components {
+		if c.Id == mux.Vars(r)["id"] {
+			component = c
+			break
+		}
+	}
+	if component == nil {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrNotFound, "status component not found"), http.StatusNotFound)
+		return
+	}
+
+	checkIDs := make([]string, 0, len(component.CheckIds))
+	for _, checkID := range component.CheckIds {
+		check, err := h.schedulerClient.GetCheck(r.Context(), &schedulerv1.GetCheckRequest{CheckId: checkID})
+		if err != nil || check.TenantId != tenantID {
+			continue
+		}
+		checkIDs = append(checkIDs, checkID)
+	}
+
+	h.writeUptimeHistory(w, r, checkIDs, days)
+}
+
+// uptimeDays разбирает параметр days; при ошибке отвечает 400
+func (h *Handler) uptimeDays(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	value := r.URL.Query().Get("days")
+	if value == "" {
+		return 0, true
+	}
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 1 || days > 90 {
+		h.writeError(w, pkgErrors.New(pkgErrors.ErrValidation, "days must be between 1 and 90"), http.StatusBadRequest)
+		return 0, false
+	}
+	return int32(days), true
+}
+
+// writeUptimeHistory запрашивает историю в Core Service и записывает ответ
+func (h *Handler) writeUptimeHistory(w http.ResponseWriter, r *http.Request, checkIDs []string, days int32) {
+	history, err := h.coreClient.GetUptimeHistory(r.Context(), &corev1.GetUptimeHistoryRequest{
+		CheckIds: checkIDs,
+		Days:     days,
+	})
+	if err != nil {
+		h.handleError(w, pkgErrors.FromGRPCErr(err))
+		return
+	}
+
+	w.Header().Set("Cache-Control", uptimeCacheControl)
+	h.writeStatusPageJSON(w, http.StatusOK, map[string]interface{}{
+		"uptime": history,
+	})
+}
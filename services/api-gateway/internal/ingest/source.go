@@ -0,0 +1,245 @@
+package ingest
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/webhooks"
+)
+
+// SourceType формат webhook внешней системы
+type SourceType string
+
+const (
+	SourceTypeAlertmanager SourceType = "alertmanager"
+	SourceTypeGrafana      SourceType = "grafana"
+	SourceTypeCloudWatch   SourceType = "cloudwatch"
+)
+
+// Уровни серьезности инцидентов платформы
+const (
+	SeverityWarning  = "warning"
+	SeverityError    = "error"
+	SeverityCritical = "critical"
+)
+
+// minTokenLength минимальная длина токена источника
+const minTokenLength = 16
+
+var sourceNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]{0,62}$`)
+
+// MappingRule правило преобразования алерта. Правило срабатывает, если все метки из Match
+// совпадают со значениями меток алерта; значение "*" означает наличие метки с любым значением
+type MappingRule struct {
+	Match    map[string]string `yaml:"match"`
+	Severity string            `yaml:"severity,omitempty"`
+	Drop     bool              `yaml:"drop,omitempty"`
+}
+
+// Source источник внешних алертов
+type Source struct {
+	Name     string     `yaml:"name"`
+	Type     SourceType `yaml:"type"`
+	TenantID string     `yaml:"tenant_id"`
+	Token    string     `yaml:"token"`
+	// SigningSecret секрет подписи HMAC-SHA256 в схеме webhooks.DefaultScheme; если задан,
+	// запросы без верной подписи отклоняются, даже если токен верен
+	SigningSecret string `yaml:"signing_secret,omitempty"`
+	// SeverityLabel метка алерта, значение которой используется как серьезность (по умолчанию severity)
+	SeverityLabel string `yaml:"severity_label,omitempty"`
+	// SeverityMap переводит значения SeverityLabel источника в уровни платформы
+	SeverityMap map[string]string `yaml:"severity_map,omitempty"`
+	// DefaultSeverity серьезность, если ни правило, ни метка ее не определили; пустая - определяет incident-manager
+	DefaultSeverity string        `yaml:"default_severity,omitempty"`
+	Rules           []MappingRule `yaml:"rules,omitempty"`
+}
+
+// Validate валидирует источник
+func (s *Source) Validate() error {
+	if !sourceNamePattern.MatchString(s.Name) {
+		return fmt.Errorf("invalid source name %q: must match %s", s.Name, sourceNamePattern.String())
+	}
+	switch s.Type {
+	case SourceTypeAlertmanager, SourceTypeGrafana, SourceTypeCloudWatch:
+	default:
+		return fmt.Errorf("source %s: unsupported type %q", s.Name, s.Type)
+	}
+	if s.TenantID == "" {
+		return fmt.Errorf("source %s: tenant_id is required", s.Name)
+	}
+	if len(s.Token) < minTokenLength {
+		return fmt.Errorf("source %s: token must be at least %d characters", s.Name, minTokenLength)
+	}
+	if s.SigningSecret != "" && len(s.SigningSecret) < minTokenLength {
+		return fmt.Errorf("source %s: signing_secret must be at least %d characters", s.Name, minTokenLength)
+	}
+	if err := validateSeverity(s.DefaultSeverity); err != nil {
+		return fmt.Errorf("source %s: default_severity: %w", s.Name, err)
+	}
+	for value, severity := range s.SeverityMap {
+		if err := validateSeverity(severity); err != nil {
+			return fmt.Errorf("source %s: severity_map[%s]: %w", s.Name, value, err)
+		}
+	}
+	for i, rule := range s.Rules {
+		if len(rule.Match) == 0 {
+			return fmt.Errorf("source %s: rule %d: match is required", s.Name, i)
+		}
+		if err := validateSeverity(rule.Severity); err != nil {
+			return fmt.Errorf("source %s: rule %d: %w", s.Name, i, err)
+		}
+	}
+	return nil
+}
+
+// VerifySignature проверяет подпись тела запроса, если для источника задан signing_secret.
+// replay может быть nil, тогда повторная доставка не отслеживается
+func (s *Source) VerifySignature(ctx context.Context, header http.Header, body []byte, replay webhooks.ReplayCache) error {
+	if s.SigningSecret == "" {
+		return nil
+	}
+	verifier := webhooks.NewVerifier(s.SigningSecret)
+	if replay != nil {
+		verifier.WithReplayCache(replay)
+	}
+	return verifier.Verify(ctx, header, body)
+}
+
+// validateSeverity проверяет уровень серьезности; пустое значение допустимо
+func validateSeverity(severity string) error {
+	switch severity {
+	case "", SeverityWarning, SeverityError, SeverityCritical:
+		return nil
+	default:
+		return fmt.Errorf("invalid severity %q, expected one of: %s, %s, %s", severity, SeverityWarning, SeverityError, SeverityCritical)
+	}
+}
+
+// Map применяет правила источника к алерту. Возвращает false, если алерт должен быть отброшен
+func (s *Source) Map(alert Alert) (Alert, bool) {
+	for _, rule := range s.Rules {
+		if !rule.matches(alert.Labels) {
+			continue
+		}
+		if rule.Drop {
+			return alert, false
+		}
+		if rule.Severity != "" {
+			alert.Severity = rule.Severity
+			return alert, true
+		}
+		break
+	}
+
+	label := s.SeverityLabel
+	if label == "" {
+		label = "severity"
+	}
+	if value, ok := alert.Labels[label]; ok {
+		if severity, ok := s.SeverityMap[strings.ToLower(value)]; ok {
+			alert.Severity = severity
+			return alert, true
+		}
+		if validateSeverity(strings.ToLower(value)) == nil {
+			alert.Severity = strings.ToLower(value)
+			return alert, true
+		}
+	}
+
+	if alert.Severity == "" {
+		alert.Severity = s.DefaultSeverity
+	}
+	return alert, true
+}
+
+// matches проверяет совпадение меток алерта с условиями правила
+func (r MappingRule) matches(labels map[string]string) bool {
+	for key, expected := range r.Match {
+		value, ok := labels[key]
+		if !ok {
+			return false
+		}
+		if expected != "*" && value != expected {
+			return false
+		}
+	}
+	return true
+}
+
+// sourcesFile формат файла конфигурации источников
+type sourcesFile struct {
+	Sources []Source `yaml:"sources"`
+}
+
+// Registry набор настроенных источников алертов
+type Registry struct {
+	sources map[string]*Source
+}
+
+// NewRegistry создает набор источников, проверяя их конфигурацию
+func NewRegistry(sources []Source) (*Registry, error) {
+	registry := &Registry{sources: make(map[string]*Source, len(sources))}
+	for i := range sources {
+		source := sources[i]
+		if err := source.Validate(); err != nil {
+			return nil, err
+		}
+		if _, exists := registry.sources[source.Name]; exists {
+			return nil, fmt.Errorf("duplicate source name %s", source.Name)
+		}
+		registry.sources[source.Name] = &source
+	}
+	return registry, nil
+}
+
+// LoadRegistry загружает источники из YAML файла; ссылки ${VAR} и ${VAR:default}
+// раскрываются из окружения, чтобы токены не хранились в файле
+func LoadRegistry(path string) (*Registry, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ingest sources file: %w", err)
+	}
+
+	expanded, err := config.ExpandEnv(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand ingest sources file: %w", err)
+	}
+
+	var file sourcesFile
+	if err := yaml.UnmarshalStrict([]byte(expanded), &file); err != nil {
+		return nil, fmt.Errorf("failed to parse ingest sources file: %w", err)
+	}
+
+	return NewRegistry(file.Sources)
+}
+
+// Authenticate возвращает источник по имени, если токен совпадает
+func (r *Registry) Authenticate(name, token string) (*Source, bool) {
+	if r == nil {
+		return nil, false
+	}
+	source, ok := r.sources[name]
+	if !ok || token == "" {
+		return nil, false
+	}
+	if subtle.ConstantTimeCompare([]byte(source.Token), []byte(token)) != 1 {
+		return nil, false
+	}
+	return source, true
+}
+
+// Len возвращает количество источников
+func (r *Registry) Len() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.sources)
+}
@@ -0,0 +1,181 @@
+package ingest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/webhooks"
+)
+
+func newTestSource() Source {
+	return Source{
+		Name:            "prometheus",
+		Type:            SourceTypeAlertmanager,
+		TenantID:        "tenant-1",
+		Token:           "0123456789abcdef",
+		SeverityMap:     map[string]string{"page": SeverityCritical, "ticket": SeverityWarning},
+		DefaultSeverity: SeverityError,
+		Rules: []MappingRule{
+			{Match: map[string]string{"alertname": "Watchdog"}, Drop: true},
+			{Match: map[string]string{"team": "payments", "env": "prod"}, Severity: SeverityCritical},
+			{Match: map[string]string{"canary": "*"}, Severity: SeverityWarning},
+		},
+	}
+}
+
+func TestSource_Map(t *testing.T) {
+	source := newTestSource()
+
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		keep     bool
+		severity string
+	}{
+		{name: "drop rule", labels: map[string]string{"alertname": "Watchdog"}, keep: false},
+		{name: "exact match rule", labels: map[string]string{"team": "payments", "env": "prod", "severity": "ticket"}, keep: true, severity: SeverityCritical},
+		{name: "partial match falls through", labels: map[string]string{"team": "payments", "env": "staging"}, keep: true, severity: SeverityError},
+		{name: "wildcard rule", labels: map[string]string{"canary": "yes"}, keep: true, severity: SeverityWarning},
+		{name: "severity map", labels: map[string]string{"severity": "PAGE"}, keep: true, severity: SeverityCritical},
+		{name: "native severity", labels: map[string]string{"severity": "warning"}, keep: true, severity: SeverityWarning},
+		{name: "unknown severity uses default", labels: map[string]string{"severity": "info"}, keep: true, severity: SeverityError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapped, keep := source.Map(Alert{Fingerprint: "fp", Title: "alert", Labels: tt.labels})
+			assert.Equal(t, tt.keep, keep)
+			if tt.keep {
+				assert.Equal(t, tt.severity, mapped.Severity)
+			}
+		})
+	}
+}
+
+func TestSource_MapCustomSeverityLabel(t *testing.T) {
+	source := newTestSource()
+	source.SeverityLabel = "priority"
+
+	mapped, keep := source.Map(Alert{Labels: map[string]string{"priority": "critical", "severity": "warning"}})
+	require.True(t, keep)
+	assert.Equal(t, SeverityCritical, mapped.Severity)
+}
+
+func TestSource_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Source)
+	}{
+		{name: "invalid name", modify: func(s *Source) { s.Name = "Bad Name" }},
+		{name: "unsupported type", modify: func(s *Source) { s.Type = "datadog" }},
+		{name: "missing tenant", modify: func(s *Source) { s.TenantID = "" }},
+		{name: "short token", modify: func(s *Source) { s.Token = "short" }},
+		{name: "short signing secret", modify: func(s *Source) { s.SigningSecret = "short" }},
+		{name: "invalid default severity", modify: func(s *Source) { s.DefaultSeverity = "info" }},
+		{name: "invalid severity map", modify: func(s *Source) { s.SeverityMap = map[string]string{"p1": "fatal"} }},
+		{name: "rule without match", modify: func(s *Source) { s.Rules = []MappingRule{{Severity: SeverityError}} }},
+	}
+
+	valid := newTestSource()
+	require.NoError(t, valid.Validate())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := newTestSource()
+			tt.modify(&source)
+			assert.Error(t, source.Validate())
+		})
+	}
+}
+
+func TestSource_VerifySignature(t *testing.T) {
+	body := []byte(`{"alerts":[]}`)
+	unsigned := httptest.NewRequest(http.MethodPost, "/api/v1/ingest/prometheus", nil)
+
+	source := newTestSource()
+	assert.NoError(t, source.VerifySignature(context.Background(), unsigned.Header, body, nil), "signature is optional")
+
+	source.SigningSecret = "signing-secret-0123"
+	assert.ErrorIs(t, source.VerifySignature(context.Background(), unsigned.Header, body, nil), webhooks.ErrMissingSignature)
+
+	signed := httptest.NewRequest(http.MethodPost, "/api/v1/ingest/prometheus", nil)
+	webhooks.NewSigner("signing-secret-0123").SignRequest(signed, body)
+	replay := webhooks.NewMemoryReplayCache()
+	require.NoError(t, source.VerifySignature(context.Background(), signed.Header, body, replay))
+	assert.ErrorIs(t, source.VerifySignature(context.Background(), signed.Header, body, replay), webhooks.ErrReplayed)
+}
+
+func TestRegistry_Authenticate(t *testing.T) {
+	registry, err := NewRegistry([]Source{newTestSource()})
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.Len())
+
+	source, ok := registry.Authenticate("prometheus", "0123456789abcdef")
+	require.True(t, ok)
+	assert.Equal(t, "tenant-1", source.TenantID)
+
+	_, ok = registry.Authenticate("prometheus", "wrong-token-value")
+	assert.False(t, ok)
+	_, ok = registry.Authenticate("prometheus", "")
+	assert.False(t, ok)
+	_, ok = registry.Authenticate("unknown", "0123456789abcdef")
+	assert.False(t, ok)
+
+	var empty *Registry
+	_, ok = empty.Authenticate("prometheus", "0123456789abcdef")
+	assert.False(t, ok)
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestNewRegistry_DuplicateName(t *testing.T) {
+	_, err := NewRegistry([]Source{newTestSource(), newTestSource()})
+	assert.Error(t, err)
+}
+
+func TestLoadRegistry(t *testing.T) {
+	t.Setenv("GRAFANA_INGEST_TOKEN", "grafana-secret-token")
+
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	content := `sources:
+  - name: grafana
+    type: grafana
+    tenant_id: tenant-1
+    token: ${GRAFANA_INGEST_TOKEN}
+    default_severity: warning
+    rules:
+      - match:
+          severity: critical
+        severity: critical
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	registry, err := LoadRegistry(path)
+	require.NoError(t, err)
+
+	source, ok := registry.Authenticate("grafana", "grafana-secret-token")
+	require.True(t, ok)
+	assert.Equal(t, SourceTypeGrafana, source.Type)
+	assert.Len(t, source.Rules, 1)
+}
+
+func TestLoadRegistry_UnknownField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	content := `sources:
+  - name: grafana
+    type: grafana
+    tenant_id: tenant-1
+    token: grafana-secret-token
+    severity_mapping: {}
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	_, err := LoadRegistry(path)
+	assert.Error(t, err)
+}
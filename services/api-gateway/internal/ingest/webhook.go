@@ -0,0 +1,216 @@
+package ingest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Alert нормализованный алерт внешней системы
+type Alert struct {
+	Fingerprint string            `json:"fingerprint"`
+	Resolved    bool              `json:"resolved"`
+	Title       string            `json:"title"`
+	Description string            `json:"description,omitempty"`
+	Severity    string            `json:"severity,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// Payload результат разбора webhook
+type Payload struct {
+	Alerts []Alert
+	// SubscribeURL адрес подтверждения подписки SNS, если запрос - SubscriptionConfirmation
+	SubscribeURL string
+}
+
+// Parse разбирает тело webhook в формате источника
+func Parse(sourceType SourceType, body []byte) (*Payload, error) {
+	switch sourceType {
+	case SourceTypeAlertmanager, SourceTypeGrafana:
+		return parseAlertmanager(body)
+	case SourceTypeCloudWatch:
+		return parseCloudWatch(body)
+	default:
+		return nil, fmt.Errorf("unsupported source type %q", sourceType)
+	}
+}
+
+// alertmanagerWebhook webhook Prometheus Alertmanager; Grafana unified alerting использует совместимый формат
+type alertmanagerWebhook struct {
+	Alerts []struct {
+		Status      string            `json:"status"`
+		Labels      map[string]string `json:"labels"`
+		Annotations map[string]string `json:"annotations"`
+		Fingerprint string            `json:"fingerprint"`
+	} `json:"alerts"`
+}
+
+// parseAlertmanager разбирает webhook Alertmanager и Grafana
+func parseAlertmanager(body []byte) (*Payload, error) {
+	var webhook alertmanagerWebhook
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		return nil, fmt.Errorf("invalid alertmanager payload: %w", err)
+	}
+
+	payload := &Payload{Alerts: make([]Alert, 0, len(webhook.Alerts))}
+	for _, raw := range webhook.Alerts {
+		alert := Alert{
+			Fingerprint: raw.Fingerprint,
+			Resolved:    raw.Status == "resolved",
+			Title:       firstNonEmpty(raw.Annotations["summary"], raw.Labels["alertname"]),
+			Description: firstNonEmpty(raw.Annotations["description"], raw.Annotations["message"]),
+			Labels:      raw.Labels,
+		}
+		if alert.Fingerprint == "" {
+			alert.Fingerprint = labelsFingerprint(raw.Labels)
+		}
+		if alert.Title == "" {
+			alert.Title = "Alert " + alert.Fingerprint
+		}
+		payload.Alerts = append(payload.Alerts, alert)
+	}
+	return payload, nil
+}
+
+// snsMessage конверт уведомления Amazon SNS
+type snsMessage struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+// cloudWatchAlarm уведомление CloudWatch об изменении состояния alarm
+type cloudWatchAlarm struct {
+	AlarmName        string `json:"AlarmName"`
+	AlarmDescription string `json:"AlarmDescription"`
+	AlarmArn         string `json:"AlarmArn"`
+	AWSAccountID     string `json:"AWSAccountId"`
+	Region           string `json:"Region"`
+	NewStateValue    string `json:"NewStateValue"`
+	NewStateReason   string `json:"NewStateReason"`
+	Trigger          struct {
+		MetricName string `json:"MetricName"`
+		Namespace  string `json:"Namespace"`
+		Dimensions []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"Dimensions"`
+	} `json:"Trigger"`
+}
+
+// parseCloudWatch разбирает уведомление CloudWatch, доставленное через SNS
+func parseCloudWatch(body []byte) (*Payload, error) {
+	var envelope snsMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid sns payload: %w", err)
+	}
+
+	switch envelope.Type {
+	case "SubscriptionConfirmation":
+		return &Payload{SubscribeURL: envelope.SubscribeURL}, nil
+	case "Notification":
+	default:
+		return nil, fmt.Errorf("unsupported sns message type %q", envelope.Type)
+	}
+
+	var alarm cloudWatchAlarm
+	if err := json.Unmarshal([]byte(envelope.Message), &alarm); err != nil {
+		return nil, fmt.Errorf("invalid cloudwatch alarm: %w", err)
+	}
+	if alarm.AlarmName == "" {
+		return nil, fmt.Errorf("invalid cloudwatch alarm: AlarmName is required")
+	}
+
+	// INSUFFICIENT_DATA не означает ни сбоя, ни восстановления
+	if alarm.NewStateValue != "ALARM" && alarm.NewStateValue != "OK" {
+		return &Payload{}, nil
+	}
+
+	labels := map[string]string{
+		"alertname": alarm.AlarmName,
+		"region":    alarm.Region,
+		"account":   alarm.AWSAccountID,
+		"metric":    alarm.Trigger.MetricName,
+		"namespace": alarm.Trigger.Namespace,
+	}
+	for _, dimension := range alarm.Trigger.Dimensions {
+		labels["dimension_"+dimension.Name] = dimension.Value
+	}
+	for key, value := range labels {
+		if value == "" {
+			delete(labels, key)
+		}
+	}
+
+	return &Payload{Alerts: []Alert{{
+		Fingerprint: firstNonEmpty(alarm.AlarmArn, alarm.AlarmName),
+		Resolved:    alarm.NewStateValue == "OK",
+		Title:       alarm.AlarmName,
+		Description: firstNonEmpty(alarm.AlarmDescription, alarm.NewStateReason),
+		Labels:      labels,
+	}}}, nil
+}
+
+// ConfirmSubscription подтверждает подписку SNS. Разрешены только https адреса SNS,
+// чтобы webhook нельзя было использовать для запросов к произвольным хостам
+func ConfirmSubscription(ctx context.Context, client *http.Client, subscribeURL string) error {
+	parsed, err := url.Parse(subscribeURL)
+	if err != nil {
+		return fmt.Errorf("invalid subscribe url: %w", err)
+	}
+	host := parsed.Hostname()
+	if parsed.Scheme != "https" || !strings.HasPrefix(host, "sns.") || !strings.HasSuffix(host, ".amazonaws.com") {
+		return fmt.Errorf("subscribe url host %q is not an amazon sns endpoint", host)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription confirmation request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to confirm subscription: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("subscription confirmation returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// labelsFingerprint вычисляет стабильный fingerprint по набору меток
+func labelsFingerprint(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(labels[key]))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// firstNonEmpty возвращает первое непустое значение
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
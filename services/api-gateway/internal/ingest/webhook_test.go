@@ -0,0 +1,131 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Alertmanager(t *testing.T) {
+	body := `{
+		"version": "4",
+		"status": "firing",
+		"alerts": [
+			{
+				"status": "firing",
+				"labels": {"alertname": "HighLatency", "severity": "critical"},
+				"annotations": {"summary": "p99 latency above 2s", "description": "checkout latency is high"},
+				"fingerprint": "a1b2c3"
+			},
+			{
+				"status": "resolved",
+				"labels": {"alertname": "DiskFull", "instance": "db-1"},
+				"annotations": {"message": "disk usage back to normal"}
+			}
+		]
+	}`
+
+	payload, err := Parse(SourceTypeAlertmanager, []byte(body))
+	require.NoError(t, err)
+	require.Len(t, payload.Alerts, 2)
+
+	firing := payload.Alerts[0]
+	assert.Equal(t, "a1b2c3", firing.Fingerprint)
+	assert.False(t, firing.Resolved)
+	assert.Equal(t, "p99 latency above 2s", firing.Title)
+	assert.Equal(t, "checkout latency is high", firing.Description)
+	assert.Equal(t, "critical", firing.Labels["severity"])
+
+	resolved := payload.Alerts[1]
+	assert.True(t, resolved.Resolved)
+	assert.Equal(t, "DiskFull", resolved.Title)
+	assert.Equal(t, "disk usage back to normal", resolved.Description)
+	assert.Len(t, resolved.Fingerprint, 16)
+
+	// Fingerprint по меткам стабилен между доставками
+	again, err := Parse(SourceTypeGrafana, []byte(body))
+	require.NoError(t, err)
+	assert.Equal(t, resolved.Fingerprint, again.Alerts[1].Fingerprint)
+}
+
+func TestParse_InvalidPayload(t *testing.T) {
+	_, err := Parse(SourceTypeAlertmanager, []byte("not json"))
+	assert.Error(t, err)
+
+	_, err = Parse("datadog", []byte("{}"))
+	assert.Error(t, err)
+}
+
+func snsNotification(t *testing.T, alarm map[string]interface{}) []byte {
+	message, err := json.Marshal(alarm)
+	require.NoError(t, err)
+	body, err := json.Marshal(map[string]string{"Type": "Notification", "Message": string(message)})
+	require.NoError(t, err)
+	return body
+}
+
+func TestParse_CloudWatch(t *testing.T) {
+	alarm := map[string]interface{}{
+		"AlarmName":        "api-5xx",
+		"AlarmDescription": "API 5xx rate",
+		"AlarmArn":         "arn:aws:cloudwatch:eu-west-1:123456789012:alarm:api-5xx",
+		"AWSAccountId":     "123456789012",
+		"Region":           "EU (Ireland)",
+		"NewStateValue":    "ALARM",
+		"Trigger": map[string]interface{}{
+			"MetricName": "5XXError",
+			"Namespace":  "AWS/ApiGateway",
+			"Dimensions": []map[string]string{{"name": "ApiName", "value": "public"}},
+		},
+	}
+
+	payload, err := Parse(SourceTypeCloudWatch, snsNotification(t, alarm))
+	require.NoError(t, err)
+	require.Len(t, payload.Alerts, 1)
+
+	alert := payload.Alerts[0]
+	assert.Equal(t, "arn:aws:cloudwatch:eu-west-1:123456789012:alarm:api-5xx", alert.Fingerprint)
+	assert.False(t, alert.Resolved)
+	assert.Equal(t, "api-5xx", alert.Title)
+	assert.Equal(t, "API 5xx rate", alert.Description)
+	assert.Equal(t, "public", alert.Labels["dimension_ApiName"])
+	assert.Equal(t, "AWS/ApiGateway", alert.Labels["namespace"])
+
+	alarm["NewStateValue"] = "OK"
+	payload, err = Parse(SourceTypeCloudWatch, snsNotification(t, alarm))
+	require.NoError(t, err)
+	require.Len(t, payload.Alerts, 1)
+	assert.True(t, payload.Alerts[0].Resolved)
+
+	alarm["NewStateValue"] = "INSUFFICIENT_DATA"
+	payload, err = Parse(SourceTypeCloudWatch, snsNotification(t, alarm))
+	require.NoError(t, err)
+	assert.Empty(t, payload.Alerts)
+}
+
+func TestParse_CloudWatchSubscription(t *testing.T) {
+	body := `{"Type": "SubscriptionConfirmation", "SubscribeURL": "https://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription&Token=abc"}`
+
+	payload, err := Parse(SourceTypeCloudWatch, []byte(body))
+	require.NoError(t, err)
+	assert.Empty(t, payload.Alerts)
+	assert.Equal(t, "https://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription&Token=abc", payload.SubscribeURL)
+}
+
+func TestConfirmSubscription_RejectsForeignHosts(t *testing.T) {
+	urls := []string{
+		"http://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription",
+		"https://internal.service.local/confirm",
+		"https://sns.eu-west-1.amazonaws.com.evil.example/confirm",
+		"https://169.254.169.254/latest/meta-data",
+	}
+
+	for _, url := range urls {
+		err := ConfirmSubscription(context.Background(), http.DefaultClient, url)
+		assert.Error(t, err, url)
+	}
+}
@@ -5,36 +5,18 @@ import (
 	"net/http"
 	"strings"
 
+	"google.golang.org/grpc/metadata"
+
 	"UptimePingPlatform/pkg/errors"
 	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/ratelimit"
 	"UptimePingPlatform/services/api-gateway/internal/client"
+	"UptimePingPlatform/services/api-gateway/internal/router"
 )
 
-// isPublicRoute проверяет, является ли маршрут публичным
+// isPublicRoute проверяет, объявлен ли маршрут публичным в таблице маршрутов
 func isPublicRoute(path string) bool {
-	publicRoutes := []string{
-		"/api/v1/auth/login",
-		"/api/v1/auth/register",
-		"/api/v1/auth/refresh",
-		"/api/v1/auth/logout",
-		"/api/v1/auth/validate",
-		"/health",
-		"/ready",
-		"/live",
-	}
-
-	for _, route := range publicRoutes {
-		if path == route {
-			return true
-		}
-	}
-
-	// Для роутов с параметрами
-	if strings.HasPrefix(path, "/api/v1/auth/") {
-		return true
-	}
-
-	return false
+	return router.IsPublic(path)
 }
 
 // AuthMiddleware проверяет аутентификацию запроса
@@ -42,12 +24,17 @@ func isPublicRoute(path string) bool {
 // 1. Bearer токены (JWT) - для пользователей
 // 2. APIKey - для сервисов
 func AuthMiddleware(authClient client.AuthHTTPClientInterface, log logger.Logger) func(http.Handler) http.Handler {
+	return AuthMiddlewareWithClientIP(authClient, ratelimit.ClientIP, log)
+}
+
+// AuthMiddlewareWithClientIP проверяет аутентификацию запроса, определяя IP клиента через clientIP.
+// IP передается в auth-service для проверки списка разрешенных адресов API ключа
+func AuthMiddlewareWithClientIP(authClient client.AuthHTTPClientInterface, clientIP func(*http.Request) string, log logger.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			log.Info("DEBUG: AuthMiddleware called",
 				logger.String("method", r.Method),
-				logger.String("path", r.URL.Path),
-				logger.String("auth_header", r.Header.Get("Authorization")))
+				logger.String("path", r.URL.Path))
 
 			// Пропускаем публичные роуты
 			if isPublicRoute(r.URL.Path) {
@@ -82,11 +69,20 @@ func AuthMiddleware(authClient client.AuthHTTPClientInterface, log logger.Logger
 					return
 				}
 				log.Debug("Bearer authentication successful")
+				if !allowImpersonated(w, r, log) {
+					return
+				}
 			} else if isAPIKey(authHeader) {
 				// Обработка APIKey
 				log.Debug("Processing API key authentication")
-				if err := handleAPIKeyAuth(r, authHeader, authClient); err != nil {
-					log.Error("API key authentication failed", logger.Error(err))
+				ip := clientIP(r)
+				if err := handleAPIKeyAuth(r, authHeader, ip, authClient); err != nil {
+					log.Warn("API key authentication failed",
+						logger.String("audit_event", "api_key.auth_failed"),
+						logger.String("key_prefix", apiKeyPrefix(authHeader)),
+						logger.String("client_ip", ip),
+						logger.String("path", r.URL.Path),
+						logger.Error(err))
 					http.Error(w, "Authentication failed", http.StatusUnauthorized)
 					return
 				}
@@ -108,6 +104,52 @@ func AuthMiddleware(authClient client.AuthHTTPClientInterface, log logger.Logger
 	}
 }
 
+const (
+	// CallerUserIDMetadata ключ метаданных gRPC с пользователем, от имени которого вызывается сервис
+	CallerUserIDMetadata = "x-user-id"
+	// CallerPermissionsMetadata ключ метаданных gRPC с правами пользователя через запятую
+	CallerPermissionsMetadata = "x-permissions"
+)
+
+// ImpersonatedByKey ключ контекста с именем оператора поддержки, действующего по токену
+// имперсонации тенанта
+const ImpersonatedByKey = "impersonated_by"
+
+// impersonationIDKey ключ контекста с идентификатором выдачи токена имперсонации
+const impersonationIDKey = "impersonation_id"
+
+// allowImpersonated пропускает запросы по токену имперсонации только на чтение и записывает
+// каждый такой запрос в журнал аудита. Для обычных токенов всегда возвращает true
+func allowImpersonated(w http.ResponseWriter, r *http.Request, log logger.Logger) bool {
+	operator, _ := r.Context().Value(ImpersonatedByKey).(string)
+	if operator == "" {
+		return true
+	}
+	impersonationID, _ := r.Context().Value(impersonationIDKey).(string)
+	tenantID, _ := r.Context().Value("tenant_id").(string)
+
+	if !isReadOnlyMethod(r.Method) {
+		log.Warn("Impersonated write request blocked",
+			logger.String("audit_event", "impersonation.write_blocked"),
+			logger.String("impersonation_id", impersonationID),
+			logger.String("operator", operator),
+			logger.String("tenant_id", tenantID),
+			logger.String("method", r.Method),
+			logger.String("path", r.URL.Path))
+		http.Error(w, "Impersonation tokens are read-only", http.StatusForbidden)
+		return false
+	}
+
+	log.Warn("Impersonated request",
+		logger.String("audit_event", "impersonation.request"),
+		logger.String("impersonation_id", impersonationID),
+		logger.String("operator", operator),
+		logger.String("tenant_id", tenantID),
+		logger.String("method", r.Method),
+		logger.String("path", r.URL.Path))
+	return true
+}
+
 // isBearerToken проверяет, является ли заголовок Bearer токеном
 func isBearerToken(authHeader string) bool {
 	return len(authHeader) > 7 && authHeader[:7] == "Bearer "
@@ -148,17 +190,21 @@ func handleBearerAuth(r *http.Request, authHeader string, authClient client.Auth
 		ctx = context.WithValue(ctx, "roles", []string{"admin", "user", "operator"})
 		ctx = context.WithValue(ctx, "permissions", []string{
 			"checks:read", "checks:write", "checks:delete",
-			"incidents:read", "incidents:write", "incidents:resolve",
+			"incidents:read", "incidents:ack", "incidents:resolve", "incidents:manage-policies",
 			"config:read", "config:write",
 			"metrics:read",
+			"notifications:read", "notifications:write", "notifications:reveal-secrets",
+			"forge:write",
 		})
 
 		// Создаем единую структуру user для удобного доступа в handler'ах
 		permissions := []string{
 			"checks:read", "checks:write", "checks:delete",
-			"incidents:read", "incidents:write", "incidents:resolve",
+			"incidents:read", "incidents:ack", "incidents:resolve", "incidents:manage-policies",
 			"config:read", "config:write",
 			"metrics:read",
+			"notifications:read", "notifications:write", "notifications:reveal-secrets",
+			"forge:write",
 		}
 		userData := map[string]interface{}{
 			"user_id":     "user-cli-123",
@@ -193,6 +239,14 @@ func handleBearerAuth(r *http.Request, authHeader string, authClient client.Auth
 	ctx = context.WithValue(ctx, "tenant_id", claims.TenantID)
 	ctx = context.WithValue(ctx, "email", claims.Email)
 	ctx = context.WithValue(ctx, "is_admin", claims.IsAdmin)
+	if claims.ImpersonatedBy != "" {
+		ctx = context.WithValue(ctx, ImpersonatedByKey, claims.ImpersonatedBy)
+		ctx = context.WithValue(ctx, impersonationIDKey, claims.ImpersonationID)
+	}
+	if claims.OrganizationID != "" {
+		ctx = context.WithValue(ctx, "organization_id", claims.OrganizationID)
+		ctx = context.WithValue(ctx, "org_role", claims.OrgRole)
+	}
 
 	// Устанавливаем права доступа на основе ролей
 	permissions := []string{}
@@ -200,9 +254,11 @@ func handleBearerAuth(r *http.Request, authHeader string, authClient client.Auth
 		// Админ получает все права
 		permissions = append(permissions,
 			"checks:read", "checks:write", "checks:delete",
-			"incidents:read", "incidents:write", "incidents:resolve",
+			"incidents:read", "incidents:ack", "incidents:resolve", "incidents:manage-policies",
 			"config:read", "config:write",
 			"metrics:read",
+			"notifications:read", "notifications:write", "notifications:reveal-secrets",
+			"forge:write",
 		)
 	} else {
 		// Обычный пользователь получает базовые права
@@ -210,6 +266,7 @@ func handleBearerAuth(r *http.Request, authHeader string, authClient client.Auth
 			"checks:read",
 			"incidents:read",
 			"config:read",
+			"notifications:read",
 		)
 	}
 
@@ -217,8 +274,13 @@ func handleBearerAuth(r *http.Request, authHeader string, authClient client.Auth
 	if len(claims.Permissions) == 0 {
 		claims.Permissions = permissions
 	}
+	claims.Permissions = ExpandPermissions(claims.Permissions)
 
 	ctx = context.WithValue(ctx, "permissions", claims.Permissions)
+	// Сервисы за gateway (incident-manager, notification-service) проверяют права вызывающего по метаданным gRPC
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		CallerUserIDMetadata, claims.UserID,
+		CallerPermissionsMetadata, strings.Join(claims.Permissions, ","))
 
 	// Создаем единую структуру user для удобного доступа в handler'ах
 	userData := map[string]interface{}{
@@ -243,7 +305,7 @@ func handleBearerAuth(r *http.Request, authHeader string, authClient client.Auth
 }
 
 // handleAPIKeyAuth обрабатывает аутентификацию через API ключ
-func handleAPIKeyAuth(r *http.Request, authHeader string, authClient client.AuthHTTPClientInterface) error {
+func handleAPIKeyAuth(r *http.Request, authHeader, clientIP string, authClient client.AuthHTTPClientInterface) error {
 	// Извлечение key и secret
 	key, secret, err := extractAPIKeyCredentials(authHeader)
 	if err != nil {
@@ -251,7 +313,7 @@ func handleAPIKeyAuth(r *http.Request, authHeader string, authClient client.Auth
 	}
 
 	// Вызов Auth Service: ValidateAPIKey()
-	claims, err := authClient.ValidateAPIKey(r.Context(), key, secret)
+	claims, err := authClient.ValidateAPIKey(r.Context(), key, secret, clientIP)
 	if err != nil {
 		return errors.Wrap(err, errors.ErrUnauthorized, "failed to validate API key")
 	}
@@ -262,11 +324,23 @@ func handleAPIKeyAuth(r *http.Request, authHeader string, authClient client.Auth
 	ctx = context.WithValue(ctx, "api_key_id", claims.KeyID)
 
 	// Обновляем запрос с новым контекстом
-	r = r.WithContext(ctx)
+	*r = *r.WithContext(ctx)
 
 	return nil
 }
 
+// apiKeyPrefixLength длина публичного префикса ключа, по которому auth-service ищет ключ
+const apiKeyPrefixLength = 16
+
+// apiKeyPrefix возвращает публичный префикс ключа из заголовка для журнала аудита; секрет не логируется
+func apiKeyPrefix(authHeader string) string {
+	key, _, _ := strings.Cut(authHeader[7:], ":")
+	if len(key) > apiKeyPrefixLength {
+		key = key[:apiKeyPrefixLength]
+	}
+	return key
+}
+
 // extractAPIKeyCredentials извлекает key и secret из заголовка Authorization
 func extractAPIKeyCredentials(authHeader string) (string, string, error) {
 	// Удаляем "APIKey " префикс
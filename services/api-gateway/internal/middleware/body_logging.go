@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+
+	"UptimePingPlatform/pkg/config"
+)
+
+const (
+	// DefaultBodyLogLimit размер записываемого фрагмента тела по умолчанию, байт
+	DefaultBodyLogLimit = 2048
+	// MaxBodyLogLimit максимальный размер записываемого фрагмента тела, байт
+	MaxBodyLogLimit = 64 * 1024
+
+	redactedBodyValue = "[REDACTED]"
+)
+
+// sensitiveFieldNames части имен полей, значения которых маскируются. Имена сравниваются
+// без учета регистра, "_" и "-"
+var sensitiveFieldNames = []string{"password", "passwd", "secret", "token", "apikey", "authorization", "cookie", "privatekey"}
+
+// sensitiveValuePatterns секреты, маскируемые в любом месте тела: ключи API платформы,
+// JWT и заголовки Bearer
+var sensitiveValuePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\b(?:upk|sec)_[A-Za-z0-9]{8,}`),
+	regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`),
+}
+
+// BodyLogRoute правило записи тел запросов и ответов для маршрутов с общим префиксом пути
+type BodyLogRoute struct {
+	PathPrefix string `yaml:"path_prefix"`
+	// Methods методы маршрута; пустой список означает все методы
+	Methods []string `yaml:"methods,omitempty"`
+	// SampleRate доля записываемых запросов от 0 до 1
+	SampleRate float64 `yaml:"sample_rate"`
+	// MaxBodyBytes размер записываемого фрагмента тела (по умолчанию DefaultBodyLogLimit)
+	MaxBodyBytes int  `yaml:"max_body_bytes,omitempty"`
+	Request      bool `yaml:"request"`
+	Response     bool `yaml:"response"`
+}
+
+// matches проверяет, относится ли запрос к маршруту
+func (r *BodyLogRoute) matches(req *http.Request) bool {
+	if !strings.HasPrefix(req.URL.Path, r.PathPrefix) {
+		return false
+	}
+	if len(r.Methods) == 0 {
+		return true
+	}
+	for _, method := range r.Methods {
+		if strings.EqualFold(method, req.Method) {
+			return true
+		}
+	}
+	return false
+}
+
+// BodyLoggingConfig настройки записи тел запросов и ответов:
+//
+//	routes:
+//	  - path_prefix: /api/v1/ingest/
+//	    methods: [POST]
+//	    sample_rate: 0.1
+//	    request: true
+//	    response: true
+//	redact_fields: [card_number]
+//	redact_patterns: ['\b\d{16}\b']
+type BodyLoggingConfig struct {
+	Routes []BodyLogRoute `yaml:"routes"`
+	// RedactFields дополнительные имена полей, значения которых маскируются
+	RedactFields []string `yaml:"redact_fields,omitempty"`
+	// RedactPatterns дополнительные регулярные выражения маскируемых значений
+	RedactPatterns []string `yaml:"redact_patterns,omitempty"`
+}
+
+// LoadBodyLoggingConfig читает настройки записи тел из YAML файла с подстановкой переменных окружения
+func LoadBodyLoggingConfig(path string) (*BodyLoggingConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body logging config: %w", err)
+	}
+
+	expanded, err := config.ExpandEnv(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand body logging config: %w", err)
+	}
+
+	var cfg BodyLoggingConfig
+	if err := yaml.UnmarshalStrict([]byte(expanded), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse body logging config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// BodyLogger выбирает запросы для записи тел и маскирует в них секреты
+type BodyLogger struct {
+	routes   []BodyLogRoute
+	fields   []string
+	patterns []*regexp.Regexp
+	sample   func() float64
+}
+
+// NewBodyLogger проверяет настройки и создает BodyLogger
+func NewBodyLogger(cfg *BodyLoggingConfig) (*BodyLogger, error) {
+	b := &BodyLogger{
+		fields:   append([]string(nil), sensitiveFieldNames...),
+		patterns: append([]*regexp.Regexp(nil), sensitiveValuePatterns...),
+		sample:   rand.Float64,
+	}
+
+	for i, route := range cfg.Routes {
+		if !strings.HasPrefix(route.PathPrefix, "/") {
+			return nil, fmt.Errorf("routes[%d]: path_prefix must start with /", i)
+		}
+		if route.SampleRate < 0 || route.SampleRate > 1 {
+			return nil, fmt.Errorf("routes[%d]: sample_rate must be between 0 and 1", i)
+		}
+		if route.MaxBodyBytes < 0 || route.MaxBodyBytes > MaxBodyLogLimit {
+			return nil, fmt.Errorf("routes[%d]: max_body_bytes must be between 0 and %d", i, MaxBodyLogLimit)
+		}
+		if route.MaxBodyBytes == 0 {
+			route.MaxBodyBytes = DefaultBodyLogLimit
+		}
+		b.routes = append(b.routes, route)
+	}
+	for _, field := range cfg.RedactFields {
+		if name := normalizeFieldName(field); name != "" {
+			b.fields = append(b.fields, name)
+		}
+	}
+	for _, pattern := range cfg.RedactPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redact pattern %q: %w", pattern, err)
+		}
+		b.patterns = append(b.patterns, re)
+	}
+	return b, nil
+}
+
+// WithSampler задает источник случайных чисел для выборки
+func (b *BodyLogger) WithSampler(sample func() float64) *BodyLogger {
+	b.sample = sample
+	return b
+}
+
+// route возвращает правило с самым длинным совпадающим префиксом, если запрос попал в выборку
+func (b *BodyLogger) route(r *http.Request) *BodyLogRoute {
+	if b == nil {
+		return nil
+	}
+	var matched *BodyLogRoute
+	for i := range b.routes {
+		route := &b.routes[i]
+		if route.matches(r) && (matched == nil || len(route.PathPrefix) > len(matched.PathPrefix)) {
+			matched = route
+		}
+	}
+	if matched == nil || matched.SampleRate == 0 || b.sample() >= matched.SampleRate {
+		return nil
+	}
+	return matched
+}
+
+// captureRequest читает начало тела запроса, оставляя тело доступным обработчику целиком
+func captureRequest(r *http.Request, limit int) ([]byte, bool) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, false
+	}
+	head, _ := io.ReadAll(io.LimitReader(r.Body, int64(limit)+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), r.Body), r.Body}
+
+	if len(head) > limit {
+		return head[:limit], true
+	}
+	return head, false
+}
+
+// Redact маскирует секреты в теле: значения чувствительных полей JSON и форм, а также
+// совпадения с шаблонами. Обрезанное тело, которое не разбирается, маскируется по шаблонам
+func (b *BodyLogger) Redact(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if !utf8.Valid(body) {
+		return fmt.Sprintf("[binary %d bytes]", len(body))
+	}
+
+	mediaType := strings.ToLower(strings.TrimSpace(strings.Split(contentType, ";")[0]))
+	switch {
+	case strings.HasSuffix(mediaType, "json"):
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err == nil {
+			if redacted, err := json.Marshal(b.redactValue(value)); err == nil {
+				return string(redacted)
+			}
+		}
+	case mediaType == "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for key := range values {
+				if b.sensitiveField(key) {
+					values[key] = []string{redactedBodyValue}
+				}
+			}
+			return b.redactText(values.Encode())
+		}
+	}
+	return b.redactText(string(body))
+}
+
+// redactValue обходит разобранный JSON и маскирует чувствительные значения
+func (b *BodyLogger) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if b.sensitiveField(key) {
+				v[key] = redactedBodyValue
+				continue
+			}
+			v[key] = b.redactValue(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = b.redactValue(item)
+		}
+		return v
+	case string:
+		return b.redactText(v)
+	}
+	return value
+}
+
+// redactText маскирует совпадения с шаблонами секретов
+func (b *BodyLogger) redactText(text string) string {
+	for _, pattern := range b.patterns {
+		text = pattern.ReplaceAllString(text, redactedBodyValue)
+	}
+	return text
+}
+
+// sensitiveField проверяет, содержит ли имя поля чувствительную часть
+func (b *BodyLogger) sensitiveField(name string) bool {
+	name = normalizeFieldName(name)
+	for _, field := range b.fields {
+		if strings.Contains(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeFieldName приводит имя поля к нижнему регистру без "_" и "-"
+func normalizeFieldName(name string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+}
@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+)
+
+const (
+	// SessionCookieName HttpOnly cookie с access токеном веб-клиента
+	SessionCookieName = "uptimeping_session"
+	// RefreshCookieName HttpOnly cookie с refresh токеном; отправляется только на маршрут обновления
+	RefreshCookieName = "uptimeping_refresh"
+	// CSRFCookieName cookie с CSRF токеном, который веб-клиент читает и повторяет в HeaderCSRFToken
+	CSRFCookieName = "uptimeping_csrf"
+	// HeaderCSRFToken заголовок с CSRF токеном для изменяющих запросов
+	HeaderCSRFToken = "X-CSRF-Token"
+	// HeaderSessionMode заголовок входа, которым веб-клиент запрашивает сессию в cookie
+	HeaderSessionMode = "X-Session-Mode"
+	// SessionModeCookie значение HeaderSessionMode для сессии в cookie
+	SessionModeCookie = "cookie"
+
+	refreshCookiePath = "/api/v1/auth/refresh"
+)
+
+// CookieSessionOptions параметры cookie сессий веб-клиентов
+type CookieSessionOptions struct {
+	// Domain домен cookie; пустое значение ограничивает cookie хостом gateway
+	Domain string
+	// Secure передавать cookie только по HTTPS
+	Secure bool
+	// SameSite политика SameSite cookie
+	SameSite http.SameSite
+	// TTL время жизни cookie
+	TTL time.Duration
+	// CSRFSecret ключ подписи CSRF токенов
+	CSRFSecret []byte
+}
+
+// CookieSession выдает и проверяет сессии веб-клиентов в cookie. Изменяющие запросы защищены
+// double-submit CSRF токеном: значение заголовка должно совпадать с CSRF cookie и с подписью
+// текущего access токена, поэтому токен чужой или старой сессии не подходит
+type CookieSession struct {
+	options CookieSessionOptions
+}
+
+// NewCookieSession создает менеджер cookie сессий
+func NewCookieSession(options CookieSessionOptions) *CookieSession {
+	return &CookieSession{options: options}
+}
+
+// Issue устанавливает cookie сессии и возвращает CSRF токен, привязанный к access токену
+func (s *CookieSession) Issue(w http.ResponseWriter, accessToken, refreshToken string) string {
+	csrfToken := s.CSRFToken(accessToken)
+	http.SetCookie(w, s.cookie(SessionCookieName, accessToken, "/", true))
+	if refreshToken != "" {
+		http.SetCookie(w, s.cookie(RefreshCookieName, refreshToken, refreshCookiePath, true))
+	}
+	http.SetCookie(w, s.cookie(CSRFCookieName, csrfToken, "/", false))
+	return csrfToken
+}
+
+// Clear удаляет cookie сессии
+func (s *CookieSession) Clear(w http.ResponseWriter) {
+	for _, cookie := range []*http.Cookie{
+		s.cookie(SessionCookieName, "", "/", true),
+		s.cookie(RefreshCookieName, "", refreshCookiePath, true),
+		s.cookie(CSRFCookieName, "", "/", false),
+	} {
+		cookie.MaxAge = -1
+		cookie.Expires = time.Unix(0, 0)
+		http.SetCookie(w, cookie)
+	}
+}
+
+// AccessToken возвращает access токен из cookie сессии или пустую строку
+func (s *CookieSession) AccessToken(r *http.Request) string {
+	return cookieValue(r, SessionCookieName)
+}
+
+// RefreshToken возвращает refresh токен из cookie сессии или пустую строку
+func (s *CookieSession) RefreshToken(r *http.Request) string {
+	return cookieValue(r, RefreshCookieName)
+}
+
+// CSRFToken вычисляет CSRF токен сессии с access токеном accessToken
+func (s *CookieSession) CSRFToken(accessToken string) string {
+	mac := hmac.New(sha256.New, s.options.CSRFSecret)
+	mac.Write([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyCSRF проверяет CSRF токен изменяющего запроса с cookie сессией
+func (s *CookieSession) VerifyCSRF(r *http.Request) error {
+	header := r.Header.Get(HeaderCSRFToken)
+	if header == "" {
+		return errors.New(errors.ErrForbidden, "CSRF token is required")
+	}
+	expected := s.CSRFToken(s.AccessToken(r))
+	if subtle.ConstantTimeCompare([]byte(header), []byte(cookieValue(r, CSRFCookieName))) != 1 ||
+		subtle.ConstantTimeCompare([]byte(header), []byte(expected)) != 1 {
+		return errors.New(errors.ErrForbidden, "CSRF token does not match the session")
+	}
+	return nil
+}
+
+// cookie собирает cookie сессии с настройками менеджера
+func (s *CookieSession) cookie(name, value, path string, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   s.options.Domain,
+		MaxAge:   int(s.options.TTL.Seconds()),
+		Secure:   s.options.Secure,
+		HttpOnly: httpOnly,
+		SameSite: s.options.SameSite,
+	}
+}
+
+// cookieValue возвращает значение cookie запроса или пустую строку
+func cookieValue(r *http.Request, name string) string {
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+// CookieAuthMiddleware аутентифицирует запросы веб-клиентов по cookie сессии: access токен
+// передается дальше как Bearer токен, а изменяющие запросы без верного CSRF токена
+// отклоняются с 403. Запросы с заголовком Authorization и без cookie не затрагиваются;
+// nil менеджер отключает cookie аутентификацию
+func CookieAuthMiddleware(session *CookieSession, log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if session == nil || r.Header.Get("Authorization") != "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			accessToken := session.AccessToken(r)
+			if accessToken == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !isReadOnlyMethod(r.Method) {
+				if err := session.VerifyCSRF(r); err != nil {
+					WriteCSRFError(w, r, err, log)
+					return
+				}
+			}
+
+			r.Header.Set("Authorization", "Bearer "+accessToken)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// WriteCSRFError отклоняет запрос с неверным CSRF токеном
+func WriteCSRFError(w http.ResponseWriter, r *http.Request, err error, log logger.Logger) {
+	log.Warn("CSRF check failed",
+		logger.String("audit_event", "session.csrf_failed"),
+		logger.String("method", r.Method),
+		logger.String("path", r.URL.Path),
+		logger.Error(err))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    "CSRF_FAILED",
+			"message": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+func newTestCookieSession() *CookieSession {
+	return NewCookieSession(CookieSessionOptions{
+		Secure:     true,
+		SameSite:   http.SameSiteStrictMode,
+		TTL:        time.Hour,
+		CSRFSecret: []byte("0123456789abcdef0123456789abcdef"),
+	})
+}
+
+func TestCookieSession_Issue(t *testing.T) {
+	session := newTestCookieSession()
+	w := httptest.NewRecorder()
+	csrfToken := session.Issue(w, "access-1", "refresh-1")
+
+	cookies := make(map[string]*http.Cookie)
+	for _, cookie := range w.Result().Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	require.Len(t, cookies, 3)
+	assert.True(t, cookies[SessionCookieName].HttpOnly)
+	assert.True(t, cookies[SessionCookieName].Secure)
+	assert.Equal(t, http.SameSiteStrictMode, cookies[SessionCookieName].SameSite)
+	assert.Equal(t, refreshCookiePath, cookies[RefreshCookieName].Path)
+	assert.False(t, cookies[CSRFCookieName].HttpOnly)
+	assert.Equal(t, csrfToken, cookies[CSRFCookieName].Value)
+
+	// CSRF токен привязан к сессии
+	assert.NotEqual(t, csrfToken, session.CSRFToken("access-2"))
+}
+
+func TestCookieAuthMiddleware(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+
+	session := newTestCookieSession()
+	var authorization string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(method, accessToken, csrfCookie, csrfHeader string) *httptest.ResponseRecorder {
+		authorization = ""
+		req := httptest.NewRequest(method, "/api/v1/checks", nil)
+		if accessToken != "" {
+			req.AddCookie(&http.Cookie{Name: SessionCookieName, Value: accessToken})
+		}
+		if csrfCookie != "" {
+			req.AddCookie(&http.Cookie{Name: CSRFCookieName, Value: csrfCookie})
+		}
+		if csrfHeader != "" {
+			req.Header.Set(HeaderCSRFToken, csrfHeader)
+		}
+		w := httptest.NewRecorder()
+		CookieAuthMiddleware(session, log)(next).ServeHTTP(w, req)
+		return w
+	}
+	csrfToken := session.CSRFToken("access-1")
+
+	// Чтение не требует CSRF токена, access токен передается как Bearer
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "access-1", "", "").Code)
+	assert.Equal(t, "Bearer access-1", authorization)
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "access-1", csrfToken, csrfToken).Code)
+	assert.Equal(t, "Bearer access-1", authorization)
+
+	// Без заголовка, с несовпадающим cookie или токеном другой сессии запрос отклоняется
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "access-1", csrfToken, "").Code)
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "access-1", "forged", csrfToken).Code)
+	other := session.CSRFToken("access-2")
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "access-1", other, other).Code)
+	assert.Empty(t, authorization)
+
+	// Запросы без cookie обрабатываются как раньше
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "", "", "").Code)
+	assert.Empty(t, authorization)
+}
@@ -7,13 +7,17 @@ import (
 	"UptimePingPlatform/pkg/logger"
 )
 
-// CORSMiddleware настраивает CORS заголовки
+// CORSMiddleware настраивает CORS заголовки для источников из allowedOrigins. Cookie и другие
+// credentials разрешаются только явно перечисленным источникам: "*" открывает API любому
+// источнику, но без cookie сессии, иначе любой сайт мог бы выполнять запросы от имени пользователя
 func CORSMiddleware(allowedOrigins []string, log logger.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			origin := r.Header.Get("Origin")
 			if origin == "" {
-				origin = "*"
+				// Запрос не из браузера или с того же источника
+				next.ServeHTTP(w, r)
+				return
 			}
 
 			log.Debug("CORS middleware processing request",
@@ -22,16 +26,26 @@ func CORSMiddleware(allowedOrigins []string, log logger.Logger) func(http.Handle
 				logger.String("origin", origin))
 
 			// Проверяем, разрешен ли источник
-			allowed := false
+			allowed, explicit := false, false
 			for _, allowedOrigin := range allowedOrigins {
-				if allowedOrigin == "*" || origin == allowedOrigin {
-					allowed = true
+				if origin == allowedOrigin {
+					allowed, explicit = true, true
 					break
 				}
+				if allowedOrigin == "*" {
+					allowed = true
+				}
 			}
 
+			w.Header().Add("Vary", "Origin")
 			if allowed {
 				w.Header().Set("Access-Control-Allow-Origin", origin)
+				// Разрешаем methods, заголовки и credentials
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, "+HeaderCSRFToken+", "+HeaderSessionMode)
+				if explicit {
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+				}
 				log.Debug("CORS origin allowed", logger.String("origin", origin))
 			} else {
 				log.Warn("CORS origin not allowed",
@@ -39,15 +53,6 @@ func CORSMiddleware(allowedOrigins []string, log logger.Logger) func(http.Handle
 					logger.String("allowed_origins", fmt.Sprintf("%v", allowedOrigins)))
 			}
 
-			// Разрешаем определенные методы
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-
-			// Разрешаем определенные заголовки
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
-
-			// Разрешаем credentials
-			w.Header().Set("Access-Control-Allow-Credentials", "true")
-
 			// Обработка preflight запросов
 			if r.Method == "OPTIONS" {
 				log.Debug("CORS preflight request handled",
@@ -134,3 +134,29 @@ func TestCORSMiddleware_Headers(t *testing.T) {
 	assert.Contains(t, headers, "Content-Type")
 	assert.Contains(t, headers, "Authorization")
 }
+
+// TestCORSMiddleware_Credentials тестирует, что credentials разрешаются только явным источникам
+func TestCORSMiddleware_Credentials(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
+	middleware := CORSMiddleware([]string{"https://app.example.com", "*"}, testLogger)(handler)
+
+	serve := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+		middleware.ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve("https://app.example.com")
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), HeaderCSRFToken)
+
+	w = serve("https://other.example.com")
+	assert.Equal(t, "https://other.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+}
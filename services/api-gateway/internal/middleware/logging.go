@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -11,6 +12,12 @@ import (
 
 // LoggingMiddleware логирует все HTTP запросы
 func LoggingMiddleware(log logger.Logger) func(http.Handler) http.Handler {
+	return LoggingMiddlewareWithBodies(log, nil)
+}
+
+// LoggingMiddlewareWithBodies логирует все HTTP запросы, а для попавших в выборку маршрутов
+// bodies также тела запроса и ответа с замаскированными секретами. bodies может быть nil
+func LoggingMiddlewareWithBodies(log logger.Logger, bodies *BodyLogger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Генерируем trace_id для запроса
@@ -38,12 +45,30 @@ func LoggingMiddleware(log logger.Logger) func(http.Handler) http.Handler {
 			// Создаем обертку для ResponseWriter для перехвата статуса
 			wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
+			// Тела записываются только для маршрутов из настроек и только для выборки запросов
+			route := bodies.route(r)
+			if route != nil && route.Request {
+				body, truncated := captureRequest(r, route.MaxBodyBytes)
+				logFields = append(logFields,
+					logger.String("request_body", bodies.Redact(body, r.Header.Get("Content-Type"))),
+					logger.Bool("request_body_truncated", truncated))
+			}
+			if route != nil && route.Response {
+				wrapped.body = &bytes.Buffer{}
+				wrapped.bodyLimit = route.MaxBodyBytes
+			}
+
 			// Выполняем следующий обработчик
 			next.ServeHTTP(wrapped, r)
 
 			// Добавляем статус код к полям лога
 			logFields = append(logFields, logger.Int("status_code", wrapped.statusCode))
 			logFields = append(logFields, logger.Float64("duration_ms", float64(time.Since(start).Milliseconds())))
+			if wrapped.body != nil {
+				logFields = append(logFields,
+					logger.String("response_body", bodies.Redact(wrapped.body.Bytes(), wrapped.Header().Get("Content-Type"))),
+					logger.Bool("response_body_truncated", wrapped.bodyTruncated))
+			}
 
 			// Логируем завершение запроса
 			log.Info("Completed request", logFields...)
@@ -51,10 +76,13 @@ func LoggingMiddleware(log logger.Logger) func(http.Handler) http.Handler {
 	}
 }
 
-// responseWriter обертка для перехвата статуса ответа
+// responseWriter обертка для перехвата статуса ответа и, при записи тел, начала тела ответа
 type responseWriter struct {
 	http.ResponseWriter
-	statusCode int
+	statusCode    int
+	body          *bytes.Buffer
+	bodyLimit     int
+	bodyTruncated bool
 }
 
 // WriteHeader перехватывает установку статуса
@@ -63,6 +91,19 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Write сохраняет начало тела ответа, если оно записывается
+func (rw *responseWriter) Write(data []byte) (int, error) {
+	if rw.body != nil {
+		if room := rw.bodyLimit - rw.body.Len(); room < len(data) {
+			rw.body.Write(data[:room])
+			rw.bodyTruncated = true
+		} else {
+			rw.body.Write(data)
+		}
+	}
+	return rw.ResponseWriter.Write(data)
+}
+
 // generateTraceID генерирует уникальный идентификатор запроса
 func generateTraceID() string {
 	// В реальном приложении использовать UUID или другой механизм генерации
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// HeaderMaintenance заголовок ответов gateway в режиме обслуживания: по нему клиенты
+// показывают баннер, не опрашивая /api/v1/maintenance
+const HeaderMaintenance = "X-Maintenance-Mode"
+
+// DefaultMaintenanceRetryAfter интервал повтора отклоненных запросов по умолчанию
+const DefaultMaintenanceRetryAfter = 5 * time.Minute
+
+// MaintenanceState состояние режима обслуживания платформы
+type MaintenanceState struct {
+	// ReadOnly режим только для чтения: запросы, изменяющие данные, отклоняются
+	ReadOnly bool
+	// Message текст баннера для пользователей
+	Message string
+	// RetryAfter через сколько клиентам повторять отклоненные запросы
+	RetryAfter time.Duration
+}
+
+// MaintenanceBanner данные баннера режима обслуживания для клиентов
+type MaintenanceBanner struct {
+	ReadOnly          bool   `json:"read_only"`
+	Message           string `json:"message,omitempty"`
+	RetryAfterSeconds int    `json:"retry_after_seconds,omitempty"`
+}
+
+// Banner возвращает данные баннера для состояния
+func (s MaintenanceState) Banner() MaintenanceBanner {
+	if !s.ReadOnly {
+		return MaintenanceBanner{}
+	}
+	return MaintenanceBanner{
+		ReadOnly:          true,
+		Message:           s.Message,
+		RetryAfterSeconds: s.retryAfterSeconds(),
+	}
+}
+
+// retryAfterSeconds значение заголовка Retry-After в секундах
+func (s MaintenanceState) retryAfterSeconds() int {
+	retryAfter := s.RetryAfter
+	if retryAfter <= 0 {
+		retryAfter = DefaultMaintenanceRetryAfter
+	}
+	return int(math.Ceil(retryAfter.Seconds()))
+}
+
+// Maintenance переключатель режима обслуживания. Состояние меняется без перезапуска gateway
+// и безопасно читается конкурентными запросами; nil переключатель означает, что режим выключен
+type Maintenance struct {
+	state atomic.Pointer[MaintenanceState]
+}
+
+// NewMaintenance создает переключатель с начальным состоянием
+func NewMaintenance(state MaintenanceState) *Maintenance {
+	m := &Maintenance{}
+	m.Set(state)
+	return m
+}
+
+// State возвращает текущее состояние режима обслуживания
+func (m *Maintenance) State() MaintenanceState {
+	if m == nil {
+		return MaintenanceState{}
+	}
+	if state := m.state.Load(); state != nil {
+		return *state
+	}
+	return MaintenanceState{}
+}
+
+// Set включает, выключает или меняет параметры режима обслуживания
+func (m *Maintenance) Set(state MaintenanceState) {
+	m.state.Store(&state)
+}
+
+// isReadOnlyMethod сообщает, что метод не изменяет данные
+func isReadOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// MaintenanceMiddleware переводит маршрут в режим только для чтения, пока он включен:
+// GET, HEAD и OPTIONS обслуживаются как обычно, остальные запросы отклоняются с 503 и
+// Retry-After, если allowMutations не разрешает их явно. Все ответы в режиме обслуживания
+// содержат заголовок HeaderMaintenance
+func MaintenanceMiddleware(m *Maintenance, allowMutations bool, log logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			state := m.State()
+			if !state.ReadOnly {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set(HeaderMaintenance, "read-only")
+			if allowMutations || isReadOnlyMethod(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			log.Info("Request rejected in maintenance mode",
+				logger.String("method", r.Method),
+				logger.String("path", r.URL.Path))
+
+			message := state.Message
+			if message == "" {
+				message = "platform is in read-only maintenance mode"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Retry-After", strconv.Itoa(state.retryAfterSeconds()))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    "MAINTENANCE",
+					"message": message,
+				},
+				"maintenance": state.Banner(),
+			})
+		})
+	}
+}
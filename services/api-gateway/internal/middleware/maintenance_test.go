@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+func TestMaintenanceMiddleware(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "api-gateway", false)
+	require.NoError(t, err)
+
+	maintenance := NewMaintenance(MaintenanceState{})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(allowMutations bool, method string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		MaintenanceMiddleware(maintenance, allowMutations, log)(ok).ServeHTTP(w, httptest.NewRequest(method, "/api/v1/checks", nil))
+		return w
+	}
+
+	// Режим выключен: запросы проходят без заголовка
+	w := serve(false, http.MethodPost)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get(HeaderMaintenance))
+
+	maintenance.Set(MaintenanceState{ReadOnly: true, Message: "Database migration until 02:00 UTC", RetryAfter: 90 * time.Second})
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodOptions} {
+		w = serve(false, method)
+		assert.Equal(t, http.StatusOK, w.Code, method)
+		assert.Equal(t, "read-only", w.Header().Get(HeaderMaintenance), method)
+	}
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		w = serve(false, method)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code, method)
+		assert.Equal(t, "90", w.Header().Get("Retry-After"), method)
+	}
+
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+		Maintenance MaintenanceBanner `json:"maintenance"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "MAINTENANCE", body.Error.Code)
+	assert.Equal(t, "Database migration until 02:00 UTC", body.Error.Message)
+	assert.Equal(t, MaintenanceBanner{ReadOnly: true, Message: "Database migration until 02:00 UTC", RetryAfterSeconds: 90}, body.Maintenance)
+
+	// Явно разрешенные маршруты обслуживаются
+	w = serve(true, http.MethodPost)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "read-only", w.Header().Get(HeaderMaintenance))
+}
+
+func TestMaintenanceState_Banner(t *testing.T) {
+	assert.Equal(t, MaintenanceBanner{}, MaintenanceState{Message: "ignored"}.Banner())
+	assert.Equal(t, MaintenanceBanner{ReadOnly: true, RetryAfterSeconds: 300}, MaintenanceState{ReadOnly: true}.Banner())
+
+	var maintenance *Maintenance
+	assert.False(t, maintenance.State().ReadOnly)
+}
@@ -62,12 +62,21 @@ func getUserPermissions(ctx context.Context) []string {
 	return []string{}
 }
 
+// GroupScopeSeparator отделяет право от группы проверок, которой оно ограничено:
+// "checks:read:group:<group_id>" дает checks:read только на поддерево группы
+const GroupScopeSeparator = ":group:"
+
 // hasPermission проверяет наличие конкретного права
 func hasPermission(permissions []string, required string) bool {
 	for _, permission := range permissions {
 		if permission == required {
 			return true
 		}
+		// Право, ограниченное группой, открывает доступ к эндпоинту,
+		// а фильтрация данных по группам выполняется в обработчике через GroupScope
+		if base, _, scoped := splitGroupScope(permission); scoped && base == required {
+			return true
+		}
 		// Проверяем wildcard права
 		if strings.HasSuffix(permission, "*") {
 			prefix := strings.TrimSuffix(permission, "*")
@@ -79,7 +88,87 @@ func hasPermission(permissions []string, required string) bool {
 	return false
 }
 
+// legacyPermissions права, выданные до разделения прав инцидентов, и заменяющие их права.
+// incidents:write давал подтверждение инцидентов и управление страницей статуса, но не закрытие
+var legacyPermissions = map[string][]string{
+	"incidents:write": {"incidents:ack", "incidents:manage-policies"},
+}
+
+// ExpandPermissions заменяет устаревшие права действующими, сохраняя порядок остальных прав
+func ExpandPermissions(permissions []string) []string {
+	expanded := make([]string, 0, len(permissions))
+	seen := make(map[string]bool, len(permissions))
+	add := func(permission string) {
+		if !seen[permission] {
+			seen[permission] = true
+			expanded = append(expanded, permission)
+		}
+	}
+	for _, permission := range permissions {
+		if replacements, ok := legacyPermissions[permission]; ok {
+			for _, replacement := range replacements {
+				add(replacement)
+			}
+			continue
+		}
+		add(permission)
+	}
+	return expanded
+}
+
 // RequirePermissions создает middleware для требуемых прав
 func RequirePermissions(permissions ...string) func(http.Handler) http.Handler {
 	return PermissionMiddleware(permissions, nil)
 }
+
+// HasPermission проверяет, есть ли у пользователя из контекста указанное право
+func HasPermission(ctx context.Context, required string) bool {
+	return hasPermission(getUserPermissions(ctx), required)
+}
+
+// GroupScope возвращает группы, которыми ограничено право пользователя.
+// restricted=false означает доступ ко всем проверкам tenant (право без ограничения или wildcard).
+func GroupScope(ctx context.Context, permission string) (groupIDs []string, restricted bool) {
+	permissions := getUserPermissions(ctx)
+
+	var unscoped []string
+	for _, p := range permissions {
+		base, groupID, scoped := splitGroupScope(p)
+		if !scoped {
+			unscoped = append(unscoped, p)
+			continue
+		}
+		if base == permission {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+
+	if hasPermission(unscoped, permission) {
+		return nil, false
+	}
+	return groupIDs, true
+}
+
+// GroupPathAllowed проверяет, входит ли группа с материализованным путем "/root/child/"
+// в поддерево одной из разрешенных групп
+func GroupPathAllowed(groupPath string, groupIDs []string) bool {
+	for _, groupID := range groupIDs {
+		if groupID != "" && strings.Contains(groupPath, "/"+groupID+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// splitGroupScope разбирает право вида "checks:read:group:<id>"
+func splitGroupScope(permission string) (base, groupID string, scoped bool) {
+	idx := strings.Index(permission, GroupScopeSeparator)
+	if idx <= 0 {
+		return permission, "", false
+	}
+	groupID = permission[idx+len(GroupScopeSeparator):]
+	if groupID == "" {
+		return permission, "", false
+	}
+	return permission[:idx], groupID, true
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasPermission_GroupScoped(t *testing.T) {
+	permissions := []string{"checks:read:group:g1", "incidents:*"}
+
+	assert.True(t, hasPermission(permissions, "checks:read"))
+	assert.False(t, hasPermission(permissions, "checks:write"))
+	assert.True(t, hasPermission(permissions, "incidents:read"))
+	assert.False(t, hasPermission([]string{"checks:read:group:"}, "checks:read"))
+}
+
+func TestGroupScope(t *testing.T) {
+	tests := []struct {
+		name           string
+		permissions    []string
+		permission     string
+		wantGroups     []string
+		wantRestricted bool
+	}{
+		{
+			name:           "unscoped permission",
+			permissions:    []string{"checks:read", "checks:read:group:g1"},
+			permission:     "checks:read",
+			wantRestricted: false,
+		},
+		{
+			name:           "wildcard permission",
+			permissions:    []string{"checks:*"},
+			permission:     "checks:write",
+			wantRestricted: false,
+		},
+		{
+			name:           "scoped permissions",
+			permissions:    []string{"checks:read:group:g1", "checks:read:group:g2", "checks:write:group:g3"},
+			permission:     "checks:read",
+			wantGroups:     []string{"g1", "g2"},
+			wantRestricted: true,
+		},
+		{
+			name:           "no permission",
+			permissions:    []string{"incidents:read"},
+			permission:     "checks:read",
+			wantRestricted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), "permissions", tt.permissions)
+
+			groups, restricted := GroupScope(ctx, tt.permission)
+			assert.Equal(t, tt.wantRestricted, restricted)
+			assert.Equal(t, tt.wantGroups, groups)
+		})
+	}
+}
+
+func TestGroupPathAllowed(t *testing.T) {
+	assert.True(t, GroupPathAllowed("/g1/g2/", []string{"g2"}))
+	assert.True(t, GroupPathAllowed("/g1/g2/", []string{"x", "g1"}))
+	assert.False(t, GroupPathAllowed("/g1/g2/", []string{"g3"}))
+	assert.False(t, GroupPathAllowed("/g10/", []string{"g1"}))
+	assert.False(t, GroupPathAllowed("", []string{"g1"}))
+}
@@ -1,19 +1,41 @@
 package middleware
 
 import (
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/ratelimit"
 )
 
-// RateLimitMiddleware создает middleware для ограничения частоты запросов
-func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window time.Duration, log logger.Logger) func(http.Handler) http.Handler {
+// RateLimitMiddleware создает middleware для ограничения частоты запросов.
+// IP клиента берется из заголовков пересылки, только если запрос пришел от trustedProxies
+func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window time.Duration, trustedProxies *ratelimit.TrustedProxies, log logger.Logger) func(http.Handler) http.Handler {
+	return RateLimitClassMiddleware(rateLimiter, "", limit, window, trustedProxies, log)
+}
+
+// RateLimitClassMiddleware ограничивает частоту запросов класса маршрутов: счетчики классов
+// ведутся раздельно, так что исчерпание лимита одного класса не блокирует другие
+func RateLimitClassMiddleware(rateLimiter ratelimit.RateLimiter, class string, limit int, window time.Duration, trustedProxies *ratelimit.TrustedProxies, log logger.Logger) func(http.Handler) http.Handler {
+	return SoftRateLimitMiddleware(rateLimiter, class, limit, window, ratelimit.DefaultWarnThreshold, trustedProxies, log)
+}
+
+// SoftRateLimitMiddleware ограничивает частоту запросов класса маршрутов с зоной предупреждения:
+// после warnThreshold бюджета ответы содержат заголовки X-RateLimit-*, а первый запрос окна в
+// зоне пишется в журнал аудита, чтобы интеграторы узнавали о лимите до ответов 429.
+// Заголовки доступны, только если rateLimiter реализует ratelimit.UsageLimiter
+func SoftRateLimitMiddleware(rateLimiter ratelimit.RateLimiter, class string, limit int, window time.Duration, warnThreshold float64, trustedProxies *ratelimit.TrustedProxies, log logger.Logger) func(http.Handler) http.Handler {
+	usageLimiter, reportsUsage := rateLimiter.(ratelimit.UsageLimiter)
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Определяем ключ для ограничения по IP адресу
-			key := "ip:" + getIP(r)
+			key := "ip:" + trustedProxies.ClientIP(r)
+			if class != "" {
+				key = class + ":" + key
+			}
 
 			log.Debug("Rate limit middleware processing request",
 				logger.String("method", r.Method),
@@ -23,7 +45,14 @@ func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window ti
 				logger.String("window", window.String()))
 
 			// Проверяем лимит запросов
-			limitExceeded, err := rateLimiter.CheckRateLimit(r.Context(), key, limit, window)
+			var usage ratelimit.Usage
+			var err error
+			if reportsUsage {
+				usage, err = usageLimiter.Consume(r.Context(), key, limit, window)
+			} else {
+				usage.Exceeded, err = rateLimiter.CheckRateLimit(r.Context(), key, limit, window)
+			}
+			limitExceeded := usage.Exceeded
 			if err != nil {
 				// В случае ошибки Rate Limiter разрешаем запрос
 				log.Error("Rate limiter error, allowing request",
@@ -42,12 +71,32 @@ func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window ti
 					logger.String("method", r.Method),
 					logger.String("path", r.URL.Path))
 
+				if reportsUsage {
+					setRateLimitHeaders(w, usage)
+					w.Header().Set("Retry-After", strconv.Itoa(resetSeconds(usage)))
+				}
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusTooManyRequests)
 				w.Write([]byte(`{"error":{"code":"TOO_MANY_REQUESTS","message":"too many requests"}}`))
 				return
 			}
 
+			if usage.Warning(warnThreshold) {
+				setRateLimitHeaders(w, usage)
+				w.Header().Set("X-RateLimit-Warning", fmt.Sprintf("%d of %d requests per %s used", usage.Used, usage.Limit, window))
+				if usage.EnteredWarning(warnThreshold) {
+					log.Warn("Rate limit warning zone reached",
+						logger.String("audit_event", "rate_limit.warning"),
+						logger.String("key", key),
+						logger.String("class", class),
+						logger.Int("used", usage.Used),
+						logger.Int("limit", limit),
+						logger.String("window", window.String()),
+						logger.String("method", r.Method),
+						logger.String("path", r.URL.Path))
+				}
+			}
+
 			// Продолжаем обработку запроса
 			log.Debug("Rate limit check passed, proceeding to next handler")
 			next.ServeHTTP(w, r)
@@ -55,18 +104,14 @@ func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window ti
 	}
 }
 
-// getIP извлекает IP адрес из запроса
-func getIP(r *http.Request) string {
-	// Проверяем X-Forwarded-For заголовок
-	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		return forwarded
-	}
-
-	// Проверяем X-Real-IP заголовок
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		return realIP
-	}
+// setRateLimitHeaders сообщает клиенту лимит, остаток и время до начала следующего окна
+func setRateLimitHeaders(w http.ResponseWriter, usage ratelimit.Usage) {
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(usage.Limit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(usage.Remaining()))
+	w.Header().Set("X-RateLimit-Reset", strconv.Itoa(resetSeconds(usage)))
+}
 
-	// Возвращаем REMOTE_ADDR как последний вариант
-	return r.RemoteAddr
+// resetSeconds время до начала следующего окна в целых секундах с округлением вверх
+func resetSeconds(usage ratelimit.Usage) int {
+	return int(math.Ceil(usage.ResetAfter.Seconds()))
 }
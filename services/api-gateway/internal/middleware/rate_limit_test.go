@@ -4,19 +4,24 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/ratelimit"
 )
 
 // SimpleMockRateLimiter простой мок для rate limiter
 type SimpleMockRateLimiter struct {
 	shouldAllow bool // true - лимит НЕ превышен, false - лимит превышен
+	lastKey     string
 }
 
 func (m *SimpleMockRateLimiter) CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	m.lastKey = key
 	// Возвращаем true если лимит превышен, false если разрешено
 	return !m.shouldAllow, nil
 }
@@ -33,7 +38,7 @@ func TestRateLimitMiddleware_Allowed(t *testing.T) {
 	// Создаем тестовый logger
 	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
 
-	middleware := RateLimitMiddleware(limiter, 10, time.Minute, testLogger)(handler)
+	middleware := RateLimitMiddleware(limiter, 10, time.Minute, nil, testLogger)(handler)
 
 	// Act
 	req := httptest.NewRequest("GET", "/test", nil)
@@ -57,7 +62,7 @@ func TestRateLimitMiddleware_RateLimited(t *testing.T) {
 	// Создаем тестовый logger
 	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
 
-	middleware := RateLimitMiddleware(limiter, 10, time.Minute, testLogger)(handler)
+	middleware := RateLimitMiddleware(limiter, 10, time.Minute, nil, testLogger)(handler)
 
 	// Act
 	req := httptest.NewRequest("GET", "/test", nil)
@@ -88,7 +93,7 @@ func TestRateLimitMiddleware_Error(t *testing.T) {
 	// Создаем тестовый logger
 	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
 
-	middleware := RateLimitMiddleware(limiter, 10, time.Minute, testLogger)(handler)
+	middleware := RateLimitMiddleware(limiter, 10, time.Minute, nil, testLogger)(handler)
 
 	// Act
 	req := httptest.NewRequest("GET", "/test", nil)
@@ -112,7 +117,7 @@ func TestRateLimitMiddleware_DifferentMethods(t *testing.T) {
 	// Создаем тестовый logger
 	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
 
-	middleware := RateLimitMiddleware(limiter, 10, time.Minute, testLogger)(handler)
+	middleware := RateLimitMiddleware(limiter, 10, time.Minute, nil, testLogger)(handler)
 
 	methods := []string{"GET", "POST", "PUT", "DELETE", "PATCH"}
 
@@ -141,7 +146,7 @@ func TestRateLimitMiddleware_IPExtraction(t *testing.T) {
 	// Создаем тестовый logger
 	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
 
-	middleware := RateLimitMiddleware(limiter, 10, time.Minute, testLogger)(handler)
+	middleware := RateLimitMiddleware(limiter, 10, time.Minute, nil, testLogger)(handler)
 
 	// Act & Assert - X-Forwarded-For
 	req := httptest.NewRequest("GET", "/test", nil)
@@ -163,3 +168,133 @@ func TestRateLimitMiddleware_IPExtraction(t *testing.T) {
 	middleware.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+// TestRateLimitMiddleware_ForwardedForSpoofing тестирует, что X-Forwarded-For учитывается только от доверенных прокси
+func TestRateLimitMiddleware_ForwardedForSpoofing(t *testing.T) {
+	// Arrange
+	limiter := &SimpleMockRateLimiter{shouldAllow: true}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
+	proxies, err := ratelimit.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	middleware := RateLimitMiddleware(limiter, 10, time.Minute, proxies, testLogger)(handler)
+
+	// Act & Assert - клиент напрямую подставляет чужой IP
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "203.0.113.7:4321"
+	req.Header.Set("X-Forwarded-For", "1.1.1.1")
+	middleware.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "ip:203.0.113.7", limiter.lastKey)
+
+	// Act & Assert - доверенный прокси, подделанная левая запись игнорируется
+	req = httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "10.0.0.1:4321"
+	req.Header.Set("X-Forwarded-For", "1.1.1.1, 198.51.100.9")
+	middleware.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "ip:198.51.100.9", limiter.lastKey)
+}
+
+// TestRateLimitClassMiddleware_Key проверяет раздельные счетчики классов маршрутов
+func TestRateLimitClassMiddleware_Key(t *testing.T) {
+	limiter := &SimpleMockRateLimiter{shouldAllow: true}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
+
+	req := httptest.NewRequest("POST", "/api/v1/auth/login", nil)
+	req.RemoteAddr = "203.0.113.7:1234"
+
+	RateLimitClassMiddleware(limiter, "auth", 5, time.Minute, nil, testLogger)(handler).ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "auth:ip:203.0.113.7", limiter.lastKey)
+
+	RateLimitMiddleware(limiter, 5, time.Minute, nil, testLogger)(handler).ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "ip:203.0.113.7", limiter.lastKey)
+}
+
+// UsageMockRateLimiter мок rate limiter, сообщающий расход бюджета
+type UsageMockRateLimiter struct {
+	used int
+}
+
+func (m *UsageMockRateLimiter) CheckRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	usage, err := m.Consume(ctx, key, limit, window)
+	return usage.Exceeded, err
+}
+
+func (m *UsageMockRateLimiter) Consume(ctx context.Context, key string, limit int, window time.Duration) (ratelimit.Usage, error) {
+	if m.used >= limit {
+		return ratelimit.Usage{Limit: limit, Used: m.used, ResetAfter: 1500 * time.Millisecond, Exceeded: true}, nil
+	}
+	m.used++
+	return ratelimit.Usage{Limit: limit, Used: m.used, ResetAfter: 1500 * time.Millisecond}, nil
+}
+
+// auditRecorder logger, запоминающий записи аудита
+type auditRecorder struct {
+	logger.Logger
+	events int
+}
+
+func (l *auditRecorder) Warn(msg string, fields ...logger.Field) {
+	for _, field := range fields {
+		if field.Key == "audit_event" {
+			l.events++
+		}
+	}
+}
+
+// TestSoftRateLimitMiddleware_WarnZone тестирует предупреждающие заголовки до блокировки
+func TestSoftRateLimitMiddleware_WarnZone(t *testing.T) {
+	limiter := &UsageMockRateLimiter{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	testLogger, _ := logger.NewLogger("test", "error", "test-service", false)
+	audit := &auditRecorder{Logger: testLogger}
+
+	middleware := SoftRateLimitMiddleware(limiter, "api", 5, time.Minute, 0.8, nil, audit)(handler)
+	serve := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		middleware.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+		return w
+	}
+
+	// До 80% бюджета заголовков нет
+	for i := 0; i < 3; i++ {
+		w := serve()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("X-RateLimit-Warning"))
+	}
+
+	// 4-й и 5-й запросы в зоне предупреждения, аудит пишется один раз
+	for remaining := 1; remaining >= 0; remaining-- {
+		w := serve()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotEmpty(t, w.Header().Get("X-RateLimit-Warning"))
+		assert.Equal(t, "5", w.Header().Get("X-RateLimit-Limit"))
+		assert.Equal(t, strconv.Itoa(remaining), w.Header().Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "2", w.Header().Get("X-RateLimit-Reset"))
+	}
+	assert.Equal(t, 1, audit.events)
+
+	// После исчерпания бюджета - 429 с Retry-After
+	w := serve()
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Equal(t, "0", w.Header().Get("X-RateLimit-Remaining"))
+	assert.Empty(t, w.Header().Get("X-RateLimit-Warning"))
+}
+
+// TestSoftRateLimitMiddleware_WarningDisabled тестирует отключение предупреждений нулевым порогом
+func TestSoftRateLimitMiddleware_WarningDisabled(t *testing.T) {
+	limiter := &UsageMockRateLimiter{used: 4}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	testLogger, _ := logger.NewLogger("test", "error", "test-service", false)
+
+	w := httptest.NewRecorder()
+	SoftRateLimitMiddleware(limiter, "api", 5, time.Minute, 0, nil, testLogger)(handler).ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+	assert.Empty(t, w.Header().Get("X-RateLimit-Warning"))
+	assert.Empty(t, w.Header().Get("X-RateLimit-Limit"))
+}
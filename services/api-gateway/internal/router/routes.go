@@ -0,0 +1,268 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RateLimitClass класс ограничения частоты запросов маршрута
+type RateLimitClass string
+
+const (
+	// RateLimitDefault обычные запросы API
+	RateLimitDefault RateLimitClass = "default"
+	// RateLimitAuth вход, регистрация и обновление токенов: строгий лимит против перебора
+	RateLimitAuth RateLimitClass = "auth"
+	// RateLimitExpensive разовые запуски проверок, поиск и генерация кода
+	RateLimitExpensive RateLimitClass = "expensive"
+	// RateLimitIngest webhook внешних систем мониторинга, присылающих алерты пачками
+	RateLimitIngest RateLimitClass = "ingest"
+	// RateLimitNone health check без ограничений
+	RateLimitNone RateLimitClass = "none"
+)
+
+// Права доступа маршрутов
+const (
+	PermChecksRead                 = "checks:read"
+	PermChecksWrite                = "checks:write"
+	PermIncidentsRead              = "incidents:read"
+	PermIncidentsAck               = "incidents:ack"
+	PermIncidentsResolve           = "incidents:resolve"
+	PermIncidentsManagePolicies    = "incidents:manage-policies"
+	PermConfigRead                 = "config:read"
+	PermConfigWrite                = "config:write"
+	PermMetricsRead                = "metrics:read"
+	PermNotificationsRead          = "notifications:read"
+	PermNotificationsWrite         = "notifications:write"
+	PermNotificationsRevealSecrets = "notifications:reveal-secrets"
+	PermForgeWrite                 = "forge:write"
+)
+
+// Route декларация маршрута gateway: по ней регистрируется маршрут mux и подключаются
+// аутентификация, проверка прав и ограничение частоты запросов
+type Route struct {
+	// Name имя обработчика, которым gateway обслуживает маршрут
+	Name string
+	// Path шаблон пути gorilla/mux
+	Path string
+	// Methods методы маршрута; пустой список означает, что метод проверяет обработчик
+	Methods []string
+	// Permission право, требуемое для доступа; пусто только у публичных маршрутов
+	Permission string
+	// Public маршрут без аутентификации пользователя
+	Public    bool
+	RateLimit RateLimitClass
+	// AllowInMaintenance маршрут, изменяющий данные, который остается доступным в режиме
+	// обслуживания: он не пишет в базы данных, которые в это время мигрируют
+	AllowInMaintenance bool
+}
+
+var (
+	read   = []string{http.MethodGet}
+	create = []string{http.MethodPost}
+)
+
+// Routes таблица маршрутов gateway. Порядок важен: mux выбирает первый подходящий маршрут,
+// поэтому статические пути регистрируются раньше шаблонов с переменными
+var Routes = []Route{
+	// Проверки
+	{Name: "checks.list", Path: "/api/v1/checks", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "checks.create", Path: "/api/v1/checks", Methods: create, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "checks.run", Path: "/api/v1/checks:run", Methods: create, Permission: PermChecksWrite, RateLimit: RateLimitExpensive},
+	{Name: "checks.import", Path: "/api/v1/checks:import", Methods: create, Permission: PermChecksWrite, RateLimit: RateLimitExpensive},
+	{Name: "checks.get", Path: "/api/v1/checks/{id}", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "checks.update", Path: "/api/v1/checks/{id}", Methods: []string{http.MethodPut, http.MethodDelete}, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "checks.uptime", Path: "/api/v1/checks/{id}/uptime", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "checks.artifacts", Path: "/api/v1/checks/{id}/artifacts", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "checks.artifact_url", Path: "/api/v1/checks/{id}/artifacts/url", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+
+	// Сводка для панели мониторинга (инциденты и обслуживание - при наличии incidents:read)
+	{Name: "summary", Path: "/api/v1/summary", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+
+	// Число проверок по состояниям, которое ведет планировщик
+	{Name: "fleet", Path: "/api/v1/fleet", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+
+	// Поиск по проверкам и инцидентам (инциденты - при наличии incidents:read)
+	{Name: "search", Path: "/api/v1/search", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitExpensive},
+
+	// Теги и группы проверок. Права вида checks:read:group:<id> ограничивают доступ поддеревом группы
+	{Name: "tags", Path: "/api/v1/tags", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "tags", Path: "/api/v1/tags", Methods: []string{http.MethodDelete}, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "tags.preview_interval", Path: "/api/v1/tags:preview-interval", Methods: create, Permission: PermChecksRead, RateLimit: RateLimitExpensive},
+	{Name: "tags.item", Path: "/api/v1/tags/{name}", Methods: []string{http.MethodPut, http.MethodDelete}, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "groups", Path: "/api/v1/groups", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "groups", Path: "/api/v1/groups", Methods: create, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "groups.item", Path: "/api/v1/groups/{id}", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "groups.item", Path: "/api/v1/groups/{id}", Methods: []string{http.MethodPut, http.MethodDelete}, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "groups.toggle", Path: "/api/v1/groups/{id}/{action:enable|disable}", Methods: create, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+
+	// Аутентификация
+	// Сессии хранятся в Redis, поэтому вход и обновление токенов работают в режиме обслуживания
+	{Name: "auth.login", Path: "/api/v1/auth/login", Public: true, RateLimit: RateLimitAuth, AllowInMaintenance: true},
+	{Name: "auth.register", Path: "/api/v1/auth/register", Public: true, RateLimit: RateLimitAuth},
+	{Name: "auth.refresh", Path: "/api/v1/auth/refresh", Public: true, RateLimit: RateLimitAuth, AllowInMaintenance: true},
+	{Name: "auth.logout", Path: "/api/v1/auth/logout", Public: true, RateLimit: RateLimitAuth, AllowInMaintenance: true},
+	{Name: "auth.validate", Path: "/api/v1/auth/validate", Public: true, RateLimit: RateLimitAuth, AllowInMaintenance: true},
+	{Name: "auth.api_keys", Path: "/api/v1/auth/api-keys", Public: true, RateLimit: RateLimitAuth},
+	// Организации проверяют токен в Auth Service: переключение тенанта выдает новый токен
+	{Name: "auth.orgs", Path: "/api/v1/auth/orgs", Public: true, RateLimit: RateLimitAuth},
+	{Name: "auth.orgs", Path: "/api/v1/auth/orgs/{action:tenants|switch|members}", Public: true, RateLimit: RateLimitAuth},
+	{Name: "auth.orgs", Path: "/api/v1/auth/orgs/members/{id}", Methods: []string{http.MethodDelete}, Public: true, RateLimit: RateLimitAuth},
+
+	// Настройки арендатора
+	{Name: "config.get", Path: "/api/v1/config", Methods: read, Permission: PermConfigRead, RateLimit: RateLimitDefault},
+	{Name: "config.update", Path: "/api/v1/config", Methods: []string{http.MethodPut}, Permission: PermConfigWrite, RateLimit: RateLimitDefault},
+	{Name: "config.reset", Path: "/api/v1/config", Methods: []string{http.MethodDelete}, Permission: PermConfigWrite, RateLimit: RateLimitDefault},
+
+	// Health check gateway и сервисов
+	{Name: "health", Path: "/health", Public: true, RateLimit: RateLimitNone},
+	{Name: "ready", Path: "/ready", Public: true, RateLimit: RateLimitNone},
+	{Name: "live", Path: "/live", Public: true, RateLimit: RateLimitNone},
+	{Name: "auth.health", Path: "/api/v1/auth/health", Public: true, RateLimit: RateLimitNone},
+	{Name: "scheduler.health", Path: "/api/v1/scheduler/health", Public: true, RateLimit: RateLimitNone},
+	{Name: "core.health", Path: "/api/v1/core/health", Public: true, RateLimit: RateLimitNone},
+
+	// Баннер режима обслуживания платформы
+	{Name: "maintenance", Path: "/api/v1/maintenance", Methods: read, Public: true, RateLimit: RateLimitDefault},
+
+	// Расписания проверок и выполнение проверок в Core Service
+	{Name: "schedules", Path: "/api/v1/schedules", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "schedules", Path: "/api/v1/schedules", Methods: []string{http.MethodPost, http.MethodDelete}, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "schedules", Path: "/api/v1/schedules/", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "schedules", Path: "/api/v1/schedules/", Methods: []string{http.MethodPost, http.MethodDelete}, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "core", Path: "/api/v1/core", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "core", Path: "/api/v1/core", Methods: create, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+	{Name: "core", Path: "/api/v1/core/", Methods: read, Permission: PermChecksRead, RateLimit: RateLimitDefault},
+	{Name: "core", Path: "/api/v1/core/", Methods: create, Permission: PermChecksWrite, RateLimit: RateLimitDefault},
+
+	// Метрики: сбор по запросу не меняет данных арендатора и требует того же права, что и чтение
+	{Name: "metrics", Path: "/api/v1/metrics", Methods: read, Permission: PermMetricsRead, RateLimit: RateLimitDefault},
+	{Name: "metrics", Path: "/api/v1/metrics/collect", Methods: create, Permission: PermMetricsRead, RateLimit: RateLimitDefault},
+
+	// Инциденты: статистика регистрируется раньше /{id}
+	{Name: "incidents.list", Path: "/api/v1/incidents", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "incidents.stats", Path: "/api/v1/incidents/stats", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "incidents.get", Path: "/api/v1/incidents/{id}", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "incidents.history", Path: "/api/v1/incidents/{id}/history", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "incidents.acknowledge", Path: "/api/v1/incidents/{id}/acknowledge", Methods: create, Permission: PermIncidentsAck, RateLimit: RateLimitDefault},
+	{Name: "incidents.resolve", Path: "/api/v1/incidents/{id}/resolve", Methods: create, Permission: PermIncidentsResolve, RateLimit: RateLimitDefault},
+
+	// Страница статуса: компоненты и окна обслуживания меняются по праву управления политиками инцидентов
+	{Name: "status_page.get", Path: "/api/v1/status-page", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "status_page.components", Path: "/api/v1/status-page/components", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "status_page.component_create", Path: "/api/v1/status-page/components", Methods: create, Permission: PermIncidentsManagePolicies, RateLimit: RateLimitDefault},
+	{Name: "status_page.component_update", Path: "/api/v1/status-page/components/{id}", Methods: []string{http.MethodPut}, Permission: PermIncidentsManagePolicies, RateLimit: RateLimitDefault},
+	{Name: "status_page.component_delete", Path: "/api/v1/status-page/components/{id}", Methods: []string{http.MethodDelete}, Permission: PermIncidentsManagePolicies, RateLimit: RateLimitDefault},
+	{Name: "status_page.override_set", Path: "/api/v1/status-page/components/{id}/override", Methods: []string{http.MethodPut}, Permission: PermIncidentsManagePolicies, RateLimit: RateLimitDefault},
+	{Name: "status_page.override_clear", Path: "/api/v1/status-page/components/{id}/override", Methods: []string{http.MethodDelete}, Permission: PermIncidentsManagePolicies, RateLimit: RateLimitDefault},
+	{Name: "status_page.component_uptime", Path: "/api/v1/status-page/components/{id}/uptime", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "status_page.maintenances", Path: "/api/v1/status-page/maintenances", Methods: read, Permission: PermIncidentsRead, RateLimit: RateLimitDefault},
+	{Name: "status_page.maintenance_schedule", Path: "/api/v1/status-page/maintenances", Methods: create, Permission: PermIncidentsManagePolicies, RateLimit: RateLimitDefault},
+	{Name: "status_page.maintenance_cancel", Path: "/api/v1/status-page/maintenances/{id}/cancel", Methods: create, Permission: PermIncidentsManagePolicies, RateLimit: RateLimitDefault},
+
+	// Прием алертов внешних систем: аутентификация по токену источника, а не пользователя
+	{Name: "ingest", Path: "/api/v1/ingest/{source}", Methods: create, Public: true, RateLimit: RateLimitIngest},
+
+	// Уведомления
+	{Name: "notifications", Path: "/api/v1/notifications", Methods: read, Permission: PermNotificationsRead, RateLimit: RateLimitDefault},
+	{Name: "notifications", Path: "/api/v1/notifications", Methods: create, Permission: PermNotificationsWrite, RateLimit: RateLimitDefault},
+	{Name: "notifications", Path: "/api/v1/notifications/channels", Methods: read, Permission: PermNotificationsRead, RateLimit: RateLimitDefault},
+	{Name: "notifications", Path: "/api/v1/notifications/channels", Methods: create, Permission: PermNotificationsWrite, RateLimit: RateLimitDefault},
+	// Каналы возвращаются со скрытыми секретами; раскрытие требует отдельного права и попадает в аудит
+	{Name: "notifications.reveal", Path: "/api/v1/notifications/channels/{id}/secrets", Methods: create, Permission: PermNotificationsRevealSecrets, RateLimit: RateLimitAuth},
+
+	// Forge Service
+	{Name: "forge", Path: "/api/v1/forge/generate", Methods: create, Permission: PermForgeWrite, RateLimit: RateLimitExpensive},
+	{Name: "forge", Path: "/api/v1/forge/parse", Methods: create, Permission: PermForgeWrite, RateLimit: RateLimitExpensive},
+	{Name: "forge", Path: "/api/v1/forge/code", Methods: create, Permission: PermForgeWrite, RateLimit: RateLimitExpensive},
+	{Name: "forge", Path: "/api/v1/forge/validate", Methods: create, Permission: PermForgeWrite, RateLimit: RateLimitExpensive},
+}
+
+// Validate проверяет, что каждый маршрут объявляет права или явно помечен публичным,
+// имеет класс ограничения частоты и не повторяет путь и метод другого маршрута
+func Validate(routes []Route) error {
+	seen := make(map[string]string)
+	for _, route := range routes {
+		if route.Name == "" || !strings.HasPrefix(route.Path, "/") {
+			return fmt.Errorf("route %q %s: name and absolute path are required", route.Name, route.Path)
+		}
+		if route.Public == (route.Permission != "") {
+			return fmt.Errorf("route %s %s must either require a permission or be public", route.Name, route.Path)
+		}
+		switch route.RateLimit {
+		case RateLimitDefault, RateLimitAuth, RateLimitExpensive, RateLimitIngest, RateLimitNone:
+		default:
+			return fmt.Errorf("route %s %s has unknown rate limit class %q", route.Name, route.Path, route.RateLimit)
+		}
+
+		methods := route.Methods
+		if len(methods) == 0 {
+			methods = []string{"*"}
+		}
+		for _, method := range methods {
+			key := method + " " + route.Path
+			if other, ok := seen[key]; ok {
+				return fmt.Errorf("route %s duplicates %s for %s", route.Name, other, key)
+			}
+			seen[key] = route.Name
+		}
+	}
+	return nil
+}
+
+// IsPublic сообщает, объявлен ли путь публичным маршрутом
+func IsPublic(path string) bool {
+	for _, route := range Routes {
+		if route.Public && matchPath(route.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath сопоставляет путь с шаблоном; переменная {name} совпадает с одним сегментом
+func matchPath(pattern, path string) bool {
+	patternParts := strings.Split(pattern, "/")
+	pathParts := strings.Split(path, "/")
+	if len(patternParts) != len(pathParts) {
+		return false
+	}
+	for i, part := range patternParts {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			if pathParts[i] == "" {
+				return false
+			}
+			continue
+		}
+		if part != pathParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// RateLimit лимит запросов класса за окно
+type RateLimit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// DefaultRateLimits лимиты классов в минуту относительно общего лимита requestsPerMinute:
+// аутентификация - десятая часть, дорогие операции - шестая, прием алертов - десятикратный.
+// Для RateLimitNone лимит не задается
+func DefaultRateLimits(requestsPerMinute int) map[RateLimitClass]RateLimit {
+	perMinute := func(requests, min int) RateLimit {
+		if requests < min {
+			requests = min
+		}
+		return RateLimit{Requests: requests, Window: time.Minute}
+	}
+	return map[RateLimitClass]RateLimit{
+		RateLimitDefault:   perMinute(requestsPerMinute, 1),
+		RateLimitAuth:      perMinute(requestsPerMinute/10, 5),
+		RateLimitExpensive: perMinute(requestsPerMinute/6, 1),
+		RateLimitIngest:    perMinute(requestsPerMinute*10, 1),
+	}
+}
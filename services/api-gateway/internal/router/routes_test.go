@@ -0,0 +1,61 @@
+package router
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutes_DeclarePermissions(t *testing.T) {
+	require.NoError(t, Validate(Routes))
+
+	for _, route := range Routes {
+		if route.Public {
+			assert.Empty(t, route.Permission, "public route %s %s", route.Name, route.Path)
+			continue
+		}
+		assert.NotEmpty(t, route.Permission, "route %s %s must declare a permission", route.Name, route.Path)
+		assert.NotEqual(t, RateLimitNone, route.RateLimit, "protected route %s %s must be rate limited", route.Name, route.Path)
+	}
+}
+
+func TestValidate_RejectsInvalidRoutes(t *testing.T) {
+	for name, routes := range map[string][]Route{
+		"no permission":        {{Name: "a", Path: "/a", RateLimit: RateLimitDefault}},
+		"public with perm":     {{Name: "a", Path: "/a", Public: true, Permission: PermChecksRead, RateLimit: RateLimitDefault}},
+		"relative path":        {{Name: "a", Path: "a", Permission: PermChecksRead, RateLimit: RateLimitDefault}},
+		"unknown rate limit":   {{Name: "a", Path: "/a", Permission: PermChecksRead, RateLimit: "burst"}},
+		"duplicate method":     {{Name: "a", Path: "/a", Methods: []string{http.MethodGet}, Permission: PermChecksRead, RateLimit: RateLimitDefault}, {Name: "b", Path: "/a", Methods: []string{http.MethodGet, http.MethodPost}, Permission: PermChecksWrite, RateLimit: RateLimitDefault}},
+		"duplicate any method": {{Name: "a", Path: "/a", Public: true, RateLimit: RateLimitNone}, {Name: "b", Path: "/a", Public: true, RateLimit: RateLimitNone}},
+	} {
+		assert.Error(t, Validate(routes), name)
+	}
+}
+
+func TestIsPublic(t *testing.T) {
+	assert.True(t, IsPublic("/health"))
+	assert.True(t, IsPublic("/api/v1/auth/login"))
+	assert.True(t, IsPublic("/api/v1/auth/orgs/switch"))
+	assert.True(t, IsPublic("/api/v1/ingest/prometheus"))
+
+	assert.False(t, IsPublic("/api/v1/ingest/"))
+	assert.False(t, IsPublic("/api/v1/ingest/prometheus/extra"))
+	assert.False(t, IsPublic("/api/v1/checks"))
+	assert.False(t, IsPublic("/api/v1/auth/unknown"))
+}
+
+func TestDefaultRateLimits(t *testing.T) {
+	limits := DefaultRateLimits(120)
+
+	assert.Equal(t, RateLimit{Requests: 120, Window: time.Minute}, limits[RateLimitDefault])
+	assert.Equal(t, RateLimit{Requests: 12, Window: time.Minute}, limits[RateLimitAuth])
+	assert.Equal(t, RateLimit{Requests: 20, Window: time.Minute}, limits[RateLimitExpensive])
+	assert.Equal(t, RateLimit{Requests: 1200, Window: time.Minute}, limits[RateLimitIngest])
+	assert.NotContains(t, limits, RateLimitNone)
+
+	// Строгие классы не опускаются ниже минимума
+	assert.Equal(t, 5, DefaultRateLimits(10)[RateLimitAuth].Requests)
+}
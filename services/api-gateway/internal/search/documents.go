@@ -0,0 +1,36 @@
+package search
+
+import (
+	"strings"
+
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+// CheckDocument формирует документ индекса из проверки: имя, цель и теги
+func CheckDocument(check *schedulerv1.Check) Document {
+	return Document{
+		Type:     ResultTypeCheck,
+		ID:       check.GetId(),
+		Title:    check.GetName(),
+		Subtitle: check.GetTarget(),
+		Fields: []Field{
+			{Name: "name", Value: check.GetName(), Weight: 1},
+			{Name: "target", Value: check.GetTarget(), Weight: 0.9},
+			{Name: "tags", Value: strings.Join(check.GetTags(), " "), Weight: 0.9},
+		},
+	}
+}
+
+// IncidentDocument формирует документ индекса из инцидента по сообщению об ошибке
+func IncidentDocument(incident *incidentv1.Incident) Document {
+	return Document{
+		Type:     ResultTypeIncident,
+		ID:       incident.GetId(),
+		Title:    incident.GetErrorMessage(),
+		Subtitle: strings.ToLower(strings.TrimPrefix(incident.GetStatus().String(), "INCIDENT_STATUS_")),
+		Fields: []Field{
+			{Name: "message", Value: incident.GetErrorMessage(), Weight: 0.85},
+		},
+	}
+}
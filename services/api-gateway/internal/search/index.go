@@ -0,0 +1,317 @@
+package search
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ResultType тип найденного объекта
+type ResultType string
+
+const (
+	ResultTypeCheck    ResultType = "check"
+	ResultTypeIncident ResultType = "incident"
+)
+
+const (
+	// DefaultLimit количество результатов по умолчанию
+	DefaultLimit = 20
+	// MaxLimit максимальное количество результатов
+	MaxLimit = 100
+	// DefaultThreshold минимальная похожесть, как у pg_trgm similarity_threshold
+	DefaultThreshold = 0.3
+)
+
+// Field поле документа, участвующее в поиске
+type Field struct {
+	Name  string
+	Value string
+	// Weight понижает вклад второстепенных полей (например, target или сообщения инцидента)
+	Weight float64
+}
+
+// Document объект, добавляемый в индекс
+type Document struct {
+	Type     ResultType
+	ID       string
+	Title    string
+	Subtitle string
+	Fields   []Field
+}
+
+// Result результат поиска для командной палитры UI
+type Result struct {
+	Type         ResultType `json:"type"`
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	Subtitle     string     `json:"subtitle,omitempty"`
+	MatchedField string     `json:"matched_field"`
+	Score        float64    `json:"score"`
+}
+
+// indexedField поле с заранее вычисленными триграммами
+type indexedField struct {
+	field    Field
+	norm     string
+	trigrams map[string]struct{}
+	words    []indexedWord
+}
+
+// indexedWord отдельное слово поля с триграммами
+type indexedWord struct {
+	text     string
+	trigrams map[string]struct{}
+}
+
+// Index встроенный триграммный индекс для нечеткого поиска
+type Index struct {
+	docs      []Document
+	fields    [][]indexedField
+	threshold float64
+}
+
+// NewIndex создает пустой индекс с порогом похожести DefaultThreshold
+func NewIndex() *Index {
+	return &Index{threshold: DefaultThreshold}
+}
+
+// Add добавляет документ в индекс
+func (i *Index) Add(doc Document) {
+	fields := make([]indexedField, 0, len(doc.Fields))
+	for _, field := range doc.Fields {
+		norm := normalize(field.Value)
+		if norm == "" {
+			continue
+		}
+		if field.Weight <= 0 {
+			field.Weight = 1
+		}
+		indexed := indexedField{field: field, norm: norm, trigrams: trigrams(norm)}
+		for _, word := range strings.Fields(norm) {
+			indexed.words = append(indexed.words, indexedWord{text: word, trigrams: trigrams(word)})
+		}
+		fields = append(fields, indexed)
+	}
+
+	i.docs = append(i.docs, doc)
+	i.fields = append(i.fields, fields)
+}
+
+// Len возвращает количество документов в индексе
+func (i *Index) Len() int {
+	return len(i.docs)
+}
+
+// Search ищет документы, устойчиво к опечаткам, и возвращает не более limit результатов по убыванию релевантности
+func (i *Index) Search(query string, limit int) []Result {
+	query = normalize(query)
+	if query == "" {
+		return []Result{}
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	queryTrigrams := trigrams(query)
+	queryWords := strings.Fields(query)
+	queryWordTrigrams := make([]map[string]struct{}, len(queryWords))
+	for idx, word := range queryWords {
+		queryWordTrigrams[idx] = trigrams(word)
+	}
+
+	results := make([]Result, 0)
+	for idx, doc := range i.docs {
+		best := 0.0
+		matched := ""
+		for _, field := range i.fields[idx] {
+			score := scoreField(query, queryTrigrams, queryWords, queryWordTrigrams, field) * field.field.Weight
+			if score > best {
+				best = score
+				matched = field.field.Name
+			}
+		}
+		if best < i.threshold {
+			continue
+		}
+		results = append(results, Result{
+			Type:         doc.Type,
+			ID:           doc.ID,
+			Title:        doc.Title,
+			Subtitle:     doc.Subtitle,
+			MatchedField: matched,
+			Score:        best,
+		})
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		if results[a].Score != results[b].Score {
+			return results[a].Score > results[b].Score
+		}
+		if results[a].Type != results[b].Type {
+			return results[a].Type < results[b].Type
+		}
+		return results[a].Title < results[b].Title
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+// scoreField оценивает похожесть запроса на поле: точное вхождение, триграммы всей строки
+// и пословное сравнение с учетом опечаток
+func scoreField(query string, queryTrigrams map[string]struct{}, queryWords []string, queryWordTrigrams []map[string]struct{}, field indexedField) float64 {
+	if field.norm == query {
+		return 1
+	}
+	if strings.HasPrefix(field.norm, query) {
+		return 0.95
+	}
+	if strings.Contains(field.norm, query) {
+		return 0.9
+	}
+
+	best := similarity(queryTrigrams, field.trigrams)
+
+	// Каждое слово запроса сопоставляется с наиболее похожим словом поля
+	if len(queryWords) > 0 && len(field.words) > 0 {
+		total := 0.0
+		for idx, queryWord := range queryWords {
+			wordBest := 0.0
+			for _, word := range field.words {
+				score := wordSimilarity(queryWord, queryWordTrigrams[idx], word)
+				if score > wordBest {
+					wordBest = score
+				}
+			}
+			total += wordBest
+		}
+		if words := total / float64(len(queryWords)); words > best {
+			best = words
+		}
+	}
+
+	return best
+}
+
+// wordSimilarity сравнивает два слова: префикс, триграммы и расстояние Дамерау-Левенштейна
+func wordSimilarity(query string, queryTrigrams map[string]struct{}, word indexedWord) float64 {
+	if word.text == query {
+		return 1
+	}
+	if len(query) >= 2 && strings.HasPrefix(word.text, query) {
+		return 0.85
+	}
+
+	best := similarity(queryTrigrams, word.trigrams)
+
+	// Опечатки в коротких словах плохо ловятся триграммами — дополняем редакционным расстоянием
+	queryRunes, wordRunes := []rune(query), []rune(word.text)
+	longest := len(queryRunes)
+	if len(wordRunes) > longest {
+		longest = len(wordRunes)
+	}
+	if longest >= 3 {
+		distance := editDistance(queryRunes, wordRunes)
+		if distance <= maxTypos(longest) {
+			if score := 1 - float64(distance)/float64(longest); score > best {
+				best = score
+			}
+		}
+	}
+
+	return best
+}
+
+// maxTypos допустимое количество опечаток в зависимости от длины слова
+func maxTypos(length int) int {
+	switch {
+	case length <= 4:
+		return 1
+	case length <= 8:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// similarity коэффициент Жаккара для наборов триграмм (аналог pg_trgm similarity)
+func similarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for gram := range a {
+		if _, ok := b[gram]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// trigrams строит набор триграмм по правилам pg_trgm: каждое слово дополняется двумя пробелами слева и одним справа
+func trigrams(text string) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, word := range strings.Fields(text) {
+		runes := []rune("  " + word + " ")
+		for idx := 0; idx+3 <= len(runes); idx++ {
+			result[string(runes[idx:idx+3])] = struct{}{}
+		}
+	}
+	return result
+}
+
+// normalize приводит строку к нижнему регистру и заменяет разделители пробелами
+func normalize(text string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			builder.WriteRune(r)
+		} else {
+			builder.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(builder.String()), " ")
+}
+
+// editDistance вычисляет ограниченное расстояние Дамерау-Левенштейна (с перестановкой соседних символов)
+func editDistance(a, b []rune) int {
+	rows := make([][]int, len(a)+1)
+	for idx := range rows {
+		rows[idx] = make([]int, len(b)+1)
+		rows[idx][0] = idx
+	}
+	for j := 0; j <= len(b); j++ {
+		rows[0][j] = j
+	}
+
+	for idx := 1; idx <= len(a); idx++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[idx-1] == b[j-1] {
+				cost = 0
+			}
+			rows[idx][j] = minInt(rows[idx-1][j]+1, rows[idx][j-1]+1, rows[idx-1][j-1]+cost)
+			if idx > 1 && j > 1 && a[idx-1] == b[j-2] && a[idx-2] == b[j-1] {
+				rows[idx][j] = minInt(rows[idx][j], rows[idx-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(a)][len(b)]
+}
+
+// minInt возвращает минимальное из значений
+func minInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
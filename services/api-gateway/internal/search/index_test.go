@@ -0,0 +1,113 @@
+package search
+
+import (
+	"testing"
+
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndex() *Index {
+	index := NewIndex()
+	index.Add(Document{
+		Type:  ResultTypeCheck,
+		ID:    "check-1",
+		Title: "Payments API",
+		Fields: []Field{
+			{Name: "name", Value: "Payments API", Weight: 1},
+			{Name: "target", Value: "https://payments.example.com/health", Weight: 0.8},
+			{Name: "tags", Value: "billing production", Weight: 0.9},
+		},
+	})
+	index.Add(Document{
+		Type:  ResultTypeCheck,
+		ID:    "check-2",
+		Title: "Login page",
+		Fields: []Field{
+			{Name: "name", Value: "Login page", Weight: 1},
+			{Name: "target", Value: "https://auth.example.com/login", Weight: 0.8},
+		},
+	})
+	index.Add(Document{
+		Type:  ResultTypeIncident,
+		ID:    "incident-1",
+		Title: "connection refused",
+		Fields: []Field{
+			{Name: "message", Value: "dial tcp 10.0.0.1:443: connection refused", Weight: 0.8},
+		},
+	})
+	return index
+}
+
+func TestIndex_Search_ExactAndPrefix(t *testing.T) {
+	index := newTestIndex()
+
+	results := index.Search("payments", 10)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "check-1", results[0].ID)
+	assert.Equal(t, ResultTypeCheck, results[0].Type)
+	assert.Equal(t, "name", results[0].MatchedField)
+}
+
+func TestIndex_Search_TypoTolerant(t *testing.T) {
+	index := newTestIndex()
+
+	results := index.Search("paymnets", 10)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "check-1", results[0].ID)
+
+	results = index.Search("conection refusd", 10)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "incident-1", results[0].ID)
+	assert.Equal(t, ResultTypeIncident, results[0].Type)
+}
+
+func TestIndex_Search_MatchesTargetsAndTags(t *testing.T) {
+	index := newTestIndex()
+
+	results := index.Search("auth.example.com", 10)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "check-2", results[0].ID)
+	assert.Equal(t, "target", results[0].MatchedField)
+
+	results = index.Search("billing", 10)
+	require.NotEmpty(t, results)
+	assert.Equal(t, "check-1", results[0].ID)
+	assert.Equal(t, "tags", results[0].MatchedField)
+}
+
+func TestIndex_Search_NoMatchAndLimits(t *testing.T) {
+	index := newTestIndex()
+
+	assert.Empty(t, index.Search("zzzzqqq", 10))
+	assert.Empty(t, index.Search("   ", 10))
+
+	results := index.Search("example", 1)
+	assert.Len(t, results, 1)
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance([]rune("check"), []rune("check")))
+	assert.Equal(t, 1, editDistance([]rune("chekc"), []rune("check")))
+	assert.Equal(t, 1, editDistance([]rune("chek"), []rune("check")))
+	assert.Equal(t, 3, editDistance([]rune(""), []rune("abc")))
+}
+
+func TestDocuments_FromProto(t *testing.T) {
+	index := NewIndex()
+	index.Add(CheckDocument(&schedulerv1.Check{Id: "c1", Name: "Checkout", Target: "https://shop.example.com", Tags: []string{"frontend"}}))
+	index.Add(IncidentDocument(&incidentv1.Incident{Id: "i1", ErrorMessage: "TLS handshake timeout", Status: incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN}))
+
+	results := index.Search("frontnd", 10)
+	require.Len(t, results, 1)
+	assert.Equal(t, "c1", results[0].ID)
+	assert.Equal(t, "https://shop.example.com", results[0].Subtitle)
+
+	results = index.Search("handshake", 10)
+	require.Len(t, results, 1)
+	assert.Equal(t, "i1", results[0].ID)
+	assert.Equal(t, "open", results[0].Subtitle)
+}
@@ -0,0 +1,411 @@
+package summary
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+// Разделы сводки, по которым сообщаются ошибки источников
+const (
+	SectionChecks       = "checks"
+	SectionIncidents    = "incidents"
+	SectionMaintenances = "maintenances"
+	SectionUptime       = "uptime"
+)
+
+const (
+	// DefaultSectionTimeout время на сбор одного раздела сводки
+	DefaultSectionTimeout = 3 * time.Second
+
+	// maxPages ограничивает обход постраничных списков проверок и инцидентов
+	maxPages = 10
+	pageSize = 100
+	// statusConcurrency число одновременных запросов статуса проверок
+	statusConcurrency = 8
+	// uptimeChunkSize наибольшее число проверок в одном запросе истории доступности
+	uptimeChunkSize = 100
+)
+
+var (
+	errSourceUnavailable = errors.New(errors.ErrInternal, "service client is not configured")
+	errChecksUnavailable = errors.New(errors.ErrInternal, "check list is unavailable")
+)
+
+// CheckSource источник проверок арендатора (Scheduler Service)
+type CheckSource interface {
+	ListChecks(ctx context.Context, req *schedulerv1.ListChecksRequest) (*schedulerv1.ListChecksResponse, error)
+}
+
+// StatusSource источник текущих статусов и доступности проверок (Core Service)
+type StatusSource interface {
+	GetCheckStatus(ctx context.Context, req *corev1.GetCheckStatusRequest) (*corev1.CheckStatusResponse, error)
+	GetUptimeHistory(ctx context.Context, req *corev1.GetUptimeHistoryRequest) (*corev1.UptimeHistory, error)
+}
+
+// IncidentSource источник инцидентов и объявлений об обслуживании (Incident Manager)
+type IncidentSource interface {
+	ListIncidents(ctx context.Context, req *incidentv1.ListIncidentsRequest) (*incidentv1.ListIncidentsResponse, error)
+	ListMaintenances(ctx context.Context, req *incidentv1.ListMaintenancesRequest) (*incidentv1.ListMaintenancesResponse, error)
+}
+
+// Request параметры сводки
+type Request struct {
+	TenantID string
+	// GroupIDs ограничивает проверки поддеревьями групп, если права пользователя ограничены ими
+	GroupIDs []string
+	// IncludeIncidents включает инциденты и обслуживание; требует права incidents:read
+	IncludeIncidents bool
+}
+
+// CheckCounts количество проверок по состоянию. Paused включает отключенные проверки,
+// Unknown - активные проверки без результата или с недоступным статусом
+type CheckCounts struct {
+	Total   int `json:"total"`
+	Up      int `json:"up"`
+	Down    int `json:"down"`
+	Paused  int `json:"paused"`
+	Unknown int `json:"unknown"`
+	// Truncated - проверок больше, чем учитывает сводка
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// IncidentCounts открытые инциденты по серьезности (warning, error, critical)
+type IncidentCounts struct {
+	Open       int            `json:"open"`
+	BySeverity map[string]int `json:"by_severity"`
+	Truncated  bool           `json:"truncated,omitempty"`
+}
+
+// Summary сводка состояния арендатора для панели мониторинга. Разделы, источник которых
+// не ответил, отсутствуют, а причина указана в Errors
+type Summary struct {
+	TenantID     string                                `json:"tenant_id"`
+	GeneratedAt  time.Time                             `json:"generated_at"`
+	Checks       *CheckCounts                          `json:"checks,omitempty"`
+	Incidents    *IncidentCounts                       `json:"incidents,omitempty"`
+	Maintenances []*incidentv1.MaintenanceAnnouncement `json:"maintenances"`
+	// Uptime24h доступность проверок за последние 24 часа, процент; nil без результатов
+	Uptime24h *float64          `json:"uptime_24h,omitempty"`
+	Partial   bool              `json:"partial"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+// Available сообщает, удалось ли собрать хотя бы один раздел
+func (s *Summary) Available() bool {
+	return s.Checks != nil || s.Incidents != nil || s.Maintenances != nil || s.Uptime24h != nil ||
+		len(s.Errors) == 0
+}
+
+// Builder собирает сводку параллельными запросами к сервисам. Отказ одного источника не
+// мешает вернуть остальные разделы
+type Builder struct {
+	checks    CheckSource
+	statuses  StatusSource
+	incidents IncidentSource
+	logger    logger.Logger
+	timeout   time.Duration
+	now       func() time.Time
+}
+
+// NewBuilder создает Builder. Источник может быть nil, тогда его разделы отмечаются недоступными
+func NewBuilder(checks CheckSource, statuses StatusSource, incidents IncidentSource, log logger.Logger) *Builder {
+	return &Builder{
+		checks:    checks,
+		statuses:  statuses,
+		incidents: incidents,
+		logger:    log,
+		timeout:   DefaultSectionTimeout,
+		now:       time.Now,
+	}
+}
+
+// WithTimeout задает время на сбор одного раздела
+func (b *Builder) WithTimeout(timeout time.Duration) *Builder {
+	b.timeout = timeout
+	return b
+}
+
+// WithClock задает источник текущего времени
+func (b *Builder) WithClock(now func() time.Time) *Builder {
+	b.now = now
+	return b
+}
+
+// Build собирает сводку арендатора
+func (b *Builder) Build(ctx context.Context, req Request) *Summary {
+	summary := &Summary{TenantID: req.TenantID, GeneratedAt: b.now().UTC()}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	fail := func(section string, err error) {
+		b.logger.Warn("Summary section unavailable",
+			logger.String("section", section),
+			logger.String("tenant_id", req.TenantID),
+			logger.Error(err))
+		mu.Lock()
+		defer mu.Unlock()
+		if summary.Errors == nil {
+			summary.Errors = make(map[string]string)
+		}
+		summary.Errors[section] = err.Error()
+		summary.Partial = true
+	}
+	run := func(fn func(ctx context.Context)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sectionCtx, cancel := context.WithTimeout(ctx, b.timeout)
+			defer cancel()
+			fn(sectionCtx)
+		}()
+	}
+
+	run(func(ctx context.Context) {
+		checks, truncated, err := b.listChecks(ctx, req)
+		if err != nil {
+			fail(SectionChecks, err)
+			fail(SectionUptime, errChecksUnavailable)
+			return
+		}
+
+		var uptimeWG sync.WaitGroup
+		uptimeWG.Add(1)
+		go func() {
+			defer uptimeWG.Done()
+			uptime, err := b.uptime24h(ctx, activeCheckIDs(checks))
+			if err != nil {
+				fail(SectionUptime, err)
+				return
+			}
+			mu.Lock()
+			summary.Uptime24h = uptime
+			mu.Unlock()
+		}()
+
+		counts := b.countChecks(ctx, checks)
+		counts.Truncated = truncated
+		mu.Lock()
+		summary.Checks = counts
+		mu.Unlock()
+		uptimeWG.Wait()
+	})
+
+	if req.IncludeIncidents {
+		run(func(ctx context.Context) {
+			counts, err := b.countIncidents(ctx, req.TenantID)
+			if err != nil {
+				fail(SectionIncidents, err)
+				return
+			}
+			mu.Lock()
+			summary.Incidents = counts
+			mu.Unlock()
+		})
+		run(func(ctx context.Context) {
+			maintenances, err := b.currentMaintenances(ctx, req.TenantID)
+			if err != nil {
+				fail(SectionMaintenances, err)
+				return
+			}
+			mu.Lock()
+			summary.Maintenances = maintenances
+			mu.Unlock()
+		})
+	}
+
+	wg.Wait()
+	return summary
+}
+
+// listChecks обходит страницы проверок арендатора, не больше maxPages
+func (b *Builder) listChecks(ctx context.Context, req Request) ([]*schedulerv1.Check, bool, error) {
+	if b.checks == nil {
+		return nil, false, errSourceUnavailable
+	}
+
+	var checks []*schedulerv1.Check
+	var pageToken int32
+	for page := 0; page < maxPages; page++ {
+		resp, err := b.checks.ListChecks(ctx, &schedulerv1.ListChecksRequest{
+			TenantId:  req.TenantID,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+			GroupIds:  req.GroupIDs,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		checks = append(checks, resp.GetChecks()...)
+		if resp.GetNextPageToken() == 0 {
+			return checks, false, nil
+		}
+		pageToken = resp.GetNextPageToken()
+	}
+	return checks, true, nil
+}
+
+// countChecks считает проверки по состоянию; статусы активных проверок запрашиваются параллельно
+func (b *Builder) countChecks(ctx context.Context, checks []*schedulerv1.Check) *CheckCounts {
+	counts := &CheckCounts{Total: len(checks)}
+	active := activeCheckIDs(checks)
+	counts.Paused = len(checks) - len(active)
+	if b.statuses == nil {
+		counts.Unknown = len(active)
+		return counts
+	}
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, statusConcurrency)
+	)
+	for _, checkID := range active {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(checkID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			status, err := b.statuses.GetCheckStatus(ctx, &corev1.GetCheckStatusRequest{CheckId: checkID})
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err != nil || status.GetLastCheckedAt() == "":
+				counts.Unknown++
+			case status.GetIsHealthy():
+				counts.Up++
+			default:
+				counts.Down++
+			}
+		}(checkID)
+	}
+	wg.Wait()
+	return counts
+}
+
+// countIncidents считает открытые инциденты по серьезности
+func (b *Builder) countIncidents(ctx context.Context, tenantID string) (*IncidentCounts, error) {
+	if b.incidents == nil {
+		return nil, errSourceUnavailable
+	}
+
+	counts := &IncidentCounts{BySeverity: map[string]int{"warning": 0, "error": 0, "critical": 0}}
+	var pageToken int32
+	for page := 0; ; page++ {
+		if page == maxPages {
+			counts.Truncated = true
+			break
+		}
+		resp, err := b.incidents.ListIncidents(ctx, &incidentv1.ListIncidentsRequest{
+			TenantId:  tenantID,
+			Status:    incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN,
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, incident := range resp.GetIncidents() {
+			counts.Open++
+			counts.BySeverity[severityName(incident.GetSeverity())]++
+		}
+		if resp.GetNextPageToken() == 0 {
+			break
+		}
+		pageToken = resp.GetNextPageToken()
+	}
+	return counts, nil
+}
+
+// currentMaintenances возвращает идущие сейчас работы по обслуживанию
+func (b *Builder) currentMaintenances(ctx context.Context, tenantID string) ([]*incidentv1.MaintenanceAnnouncement, error) {
+	if b.incidents == nil {
+		return nil, errSourceUnavailable
+	}
+
+	resp, err := b.incidents.ListMaintenances(ctx, &incidentv1.ListMaintenancesRequest{TenantId: tenantID})
+	if err != nil {
+		return nil, err
+	}
+	current := []*incidentv1.MaintenanceAnnouncement{}
+	for _, maintenance := range resp.GetMaintenances() {
+		if maintenance.GetState() == "in_progress" {
+			current = append(current, maintenance)
+		}
+	}
+	return current, nil
+}
+
+// uptime24h вычисляет доступность проверок за последние 24 часа по суточным сводкам
+// за вчера и сегодня: вчерашние длительности учитываются в доле, попадающей в окно
+func (b *Builder) uptime24h(ctx context.Context, checkIDs []string) (*float64, error) {
+	if len(checkIDs) == 0 {
+		return nil, nil
+	}
+	if b.statuses == nil {
+		return nil, errSourceUnavailable
+	}
+
+	now := b.now().UTC()
+	today := now.Truncate(24 * time.Hour)
+	yesterdayShare := 1 - float64(now.Sub(today))/float64(24*time.Hour)
+
+	var available, monitored float64
+	for start := 0; start < len(checkIDs); start += uptimeChunkSize {
+		end := start + uptimeChunkSize
+		if end > len(checkIDs) {
+			end = len(checkIDs)
+		}
+		history, err := b.statuses.GetUptimeHistory(ctx, &corev1.GetUptimeHistoryRequest{
+			CheckIds: checkIDs[start:end],
+			Days:     2,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Длительности дня усреднены по проверкам запроса, поэтому части взвешиваются числом проверок
+		weight := float64(end - start)
+		for _, day := range history.GetDays() {
+			share := 1.0
+			if day.GetDate() != today.Format("2006-01-02") {
+				share = yesterdayShare
+			}
+			up := float64(day.GetOperationalMinutes() + day.GetDegradedMinutes())
+			available += up * share * weight
+			monitored += (up + float64(day.GetDownMinutes())) * share * weight
+		}
+	}
+
+	if monitored == 0 {
+		return nil, nil
+	}
+	uptime := available / monitored * 100
+	return &uptime, nil
+}
+
+// activeCheckIDs возвращает ID проверок, которые выполняются по расписанию
+func activeCheckIDs(checks []*schedulerv1.Check) []string {
+	ids := make([]string, 0, len(checks))
+	for _, check := range checks {
+		if check.GetStatus() == "" || check.GetStatus() == "active" {
+			ids = append(ids, check.GetId())
+		}
+	}
+	return ids
+}
+
+// severityName возвращает имя серьезности инцидента в нижнем регистре
+func severityName(severity incidentv1.IncidentSeverity) string {
+	return strings.ToLower(strings.TrimPrefix(severity.String(), "INCIDENT_SEVERITY_"))
+}
@@ -0,0 +1,150 @@
+package summary
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+type fakeChecks struct {
+	pages [][]*schedulerv1.Check
+	err   error
+}
+
+func (f *fakeChecks) ListChecks(ctx context.Context, req *schedulerv1.ListChecksRequest) (*schedulerv1.ListChecksResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	resp := &schedulerv1.ListChecksResponse{Checks: f.pages[req.PageToken]}
+	if int(req.PageToken)+1 < len(f.pages) {
+		resp.NextPageToken = req.PageToken + 1
+	}
+	return resp, nil
+}
+
+type fakeStatuses struct {
+	healthy map[string]bool
+	days    []*corev1.UptimeDayBucket
+	err     error
+}
+
+func (f *fakeStatuses) GetCheckStatus(ctx context.Context, req *corev1.GetCheckStatusRequest) (*corev1.CheckStatusResponse, error) {
+	healthy, ok := f.healthy[req.CheckId]
+	if !ok {
+		return nil, errors.New("no results")
+	}
+	return &corev1.CheckStatusResponse{CheckId: req.CheckId, IsHealthy: healthy, LastCheckedAt: "2026-10-16T11:59:00Z"}, nil
+}
+
+func (f *fakeStatuses) GetUptimeHistory(ctx context.Context, req *corev1.GetUptimeHistoryRequest) (*corev1.UptimeHistory, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &corev1.UptimeHistory{CheckIds: req.CheckIds, Days: f.days}, nil
+}
+
+type fakeIncidents struct {
+	incidents    []*incidentv1.Incident
+	maintenances []*incidentv1.MaintenanceAnnouncement
+	err          error
+}
+
+func (f *fakeIncidents) ListIncidents(ctx context.Context, req *incidentv1.ListIncidentsRequest) (*incidentv1.ListIncidentsResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &incidentv1.ListIncidentsResponse{Incidents: f.incidents}, nil
+}
+
+func (f *fakeIncidents) ListMaintenances(ctx context.Context, req *incidentv1.ListMaintenancesRequest) (*incidentv1.ListMaintenancesResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &incidentv1.ListMaintenancesResponse{Maintenances: f.maintenances}, nil
+}
+
+func newTestBuilder(t *testing.T, checks CheckSource, statuses StatusSource, incidents IncidentSource) *Builder {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "summary-test", false)
+	require.NoError(t, err)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	return NewBuilder(checks, statuses, incidents, log).WithClock(func() time.Time { return now })
+}
+
+func TestBuilder_Build(t *testing.T) {
+	checks := &fakeChecks{pages: [][]*schedulerv1.Check{
+		{{Id: "up", Status: "active"}, {Id: "down", Status: "active"}},
+		{{Id: "new", Status: "active"}, {Id: "paused", Status: "paused"}, {Id: "off", Status: "disabled"}},
+	}}
+	statuses := &fakeStatuses{
+		healthy: map[string]bool{"up": true, "down": false},
+		days: []*corev1.UptimeDayBucket{
+			// Вчера учитывается наполовину: 12 часов из 24
+			{Date: "2026-10-15", OperationalMinutes: 1000, DownMinutes: 440},
+			{Date: "2026-10-16", OperationalMinutes: 700, DownMinutes: 20},
+		},
+	}
+	incidents := &fakeIncidents{
+		incidents: []*incidentv1.Incident{
+			{Id: "i1", Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL},
+			{Id: "i2", Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_WARNING},
+			{Id: "i3", Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL},
+		},
+		maintenances: []*incidentv1.MaintenanceAnnouncement{
+			{Id: "m1", State: "in_progress"},
+			{Id: "m2", State: "scheduled"},
+		},
+	}
+
+	result := newTestBuilder(t, checks, statuses, incidents).Build(context.Background(), Request{TenantID: "tenant-1", IncludeIncidents: true})
+
+	assert.False(t, result.Partial)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, &CheckCounts{Total: 5, Up: 1, Down: 1, Paused: 2, Unknown: 1}, result.Checks)
+	assert.Equal(t, &IncidentCounts{Open: 3, BySeverity: map[string]int{"warning": 1, "error": 0, "critical": 2}}, result.Incidents)
+	require.Len(t, result.Maintenances, 1)
+	assert.Equal(t, "m1", result.Maintenances[0].Id)
+	require.NotNil(t, result.Uptime24h)
+	assert.InDelta(t, 1200.0/1440.0*100, *result.Uptime24h, 0.001)
+}
+
+func TestBuilder_Build_PartialFailure(t *testing.T) {
+	checks := &fakeChecks{pages: [][]*schedulerv1.Check{{{Id: "up", Status: "active"}}}}
+	statuses := &fakeStatuses{healthy: map[string]bool{"up": true}, err: errors.New("core unavailable")}
+	incidents := &fakeIncidents{err: errors.New("incident manager unavailable")}
+
+	result := newTestBuilder(t, checks, statuses, incidents).Build(context.Background(), Request{IncludeIncidents: true})
+
+	assert.True(t, result.Partial)
+	assert.True(t, result.Available())
+	assert.Equal(t, &CheckCounts{Total: 1, Up: 1}, result.Checks)
+	assert.Nil(t, result.Incidents)
+	assert.Nil(t, result.Uptime24h)
+	assert.Contains(t, result.Errors, SectionUptime)
+	assert.Contains(t, result.Errors, SectionIncidents)
+	assert.Contains(t, result.Errors, SectionMaintenances)
+}
+
+func TestBuilder_Build_Unavailable(t *testing.T) {
+	result := newTestBuilder(t, &fakeChecks{err: errors.New("scheduler unavailable")}, nil, nil).
+		Build(context.Background(), Request{IncludeIncidents: true})
+
+	assert.False(t, result.Available())
+	assert.Len(t, result.Errors, 4)
+
+	// Без права на инциденты разделы инцидентов не запрашиваются
+	result = newTestBuilder(t, &fakeChecks{pages: [][]*schedulerv1.Check{nil}}, nil, nil).
+		Build(context.Background(), Request{})
+	assert.True(t, result.Available())
+	assert.Empty(t, result.Errors)
+	assert.Nil(t, result.Uptime24h)
+}
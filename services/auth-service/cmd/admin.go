@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"UptimePingPlatform/pkg/database"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	httpHandler "UptimePingPlatform/services/auth-service/internal/handler/http"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+const (
+	// defaultAdminAddr адрес admin API по умолчанию
+	defaultAdminAddr = ":51052"
+	// defaultAdminQueues очереди панели глубины по умолчанию
+	defaultAdminQueues = "check_tasks,incidents,notification.events"
+)
+
+// startAdminServer запускает внутреннее admin API операторов на отдельном порту (ADMIN_ADDR,
+// по умолчанию :51052). Включается заданием ADMIN_API_TOKENS в формате "name:token,name:token"
+// и требует базу данных. Глубина очередей доступна при заданном RABBITMQ_URL, список очередей -
+// ADMIN_QUEUES. Операторы из ADMIN_SUPPORT_OPERATORS ("alice,bob") получают роль поддержки
+// и могут выдавать себе токены имперсонации тенантов только на чтение
+func startAdminServer(ctx context.Context) (func(), error) {
+	tokens := os.Getenv("ADMIN_API_TOKENS")
+	if tokens == "" {
+		return func() {}, nil
+	}
+	operators, err := httpHandler.ParseOperatorTokens(tokens)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ADMIN_API_TOKENS: %w", err)
+	}
+	supportOperators, err := parseSupportOperators(os.Getenv("ADMIN_SUPPORT_OPERATORS"), operators)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ADMIN_SUPPORT_OPERATORS: %w", err)
+	}
+	dbConfig, ok := databaseConfig()
+	if !ok {
+		return nil, fmt.Errorf("admin API requires database configuration")
+	}
+
+	appLogger, err := logger.NewLogger(os.Getenv("ENVIRONMENT"), "info", "auth-service", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	postgresDB, err := database.Connect(ctx, dbConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	adminService := service.NewAdminService(postgres.NewAdminRepository(postgresDB.Pool), appLogger).
+		WithAuditLog(service.NewAuditLog(postgres.NewAuditRepository(postgresDB.Pool), appLogger))
+	if rabbitmqURL := os.Getenv("RABBITMQ_URL"); rabbitmqURL != "" {
+		queues := os.Getenv("ADMIN_QUEUES")
+		if queues == "" {
+			queues = defaultAdminQueues
+		}
+		managementConfig, err := rabbitmq.NewManagementConfig(rabbitmqURL, strings.Split(queues, ",")...)
+		if err != nil {
+			postgresDB.Close()
+			return nil, fmt.Errorf("invalid RABBITMQ_URL: %w", err)
+		}
+		adminService.WithQueueMonitor(rabbitmq.NewQueueMonitor(managementConfig), managementConfig.Queues...)
+	}
+	if len(supportOperators) > 0 {
+		adminService.WithImpersonation(service.ImpersonationSignerFunc(signImpersonationToken), supportOperators...)
+	}
+
+	mux := http.NewServeMux()
+	httpHandler.NewAdminHandler(adminService, operators, appLogger).RegisterRoutes(mux)
+
+	addr := os.Getenv("ADMIN_ADDR")
+	if addr == "" {
+		addr = defaultAdminAddr
+	}
+	server := &http.Server{
+		Addr:         addr,
+		Handler:      mux,
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 30 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+
+	go func() {
+		appLogger.Info("Admin API starting", logger.String("addr", addr), logger.Int("operators", len(operators)))
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			appLogger.Error("Admin API stopped", logger.Error(err))
+		}
+	}()
+
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+		postgresDB.Close()
+	}, nil
+}
+
+// parseSupportOperators разбирает список операторов с ролью поддержки; каждый должен быть
+// объявлен в ADMIN_API_TOKENS
+func parseSupportOperators(value string, operators httpHandler.OperatorTokens) ([]string, error) {
+	known := make(map[string]bool, len(operators))
+	for _, name := range operators {
+		known[name] = true
+	}
+
+	var support []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !known[name] {
+			return nil, fmt.Errorf("unknown operator %s", name)
+		}
+		support = append(support, name)
+	}
+	return support, nil
+}
+
+// signImpersonationToken подписывает токен имперсонации ключом пользовательских токенов.
+// Токен не дает прав администратора тенанта и проверяется через /api/v1/auth/validate
+func signImpersonationToken(impersonation *domain.Impersonation) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id":          "support:" + impersonation.Operator,
+		"tenant_id":        impersonation.TenantID,
+		"is_admin":         false,
+		"permissions":      impersonation.Permissions,
+		"impersonated_by":  impersonation.Operator,
+		"impersonation_id": impersonation.ID,
+		"exp":              impersonation.ExpiresAt.Unix(),
+		"iat":              impersonation.IssuedAt.Unix(),
+		"nbf":              impersonation.IssuedAt.Unix(),
+		"sub":              "support:" + impersonation.Operator,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(jwtSecretKey))
+}
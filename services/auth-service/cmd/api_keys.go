@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"UptimePingPlatform/pkg/database"
+	"UptimePingPlatform/pkg/logger"
+	httpHandler "UptimePingPlatform/services/auth-service/internal/handler/http"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// registerAPIKeys подключает API выпуска и проверки API ключей. Ключи хранятся в базе только
+// в виде соленых хешей, поэтому без адреса базы API не регистрируется. При заданном RABBITMQ_URL
+// администраторы тенанта получают уведомления о выпуске ключей
+func registerAPIKeys(ctx context.Context, mux *http.ServeMux) (func(), error) {
+	dbConfig, ok := databaseConfig()
+	if !ok {
+		return func() {}, nil
+	}
+
+	appLogger, err := logger.NewLogger(os.Getenv("ENVIRONMENT"), "info", "auth-service", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	postgresDB, err := database.Connect(ctx, dbConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	notifier, closeNotifier, err := securityNotifier(ctx, postgresDB.Pool, appLogger)
+	if err != nil {
+		postgresDB.Close()
+		return nil, err
+	}
+
+	apiKeyService := service.NewAPIKeyService(postgres.NewAPIKeyRepository(postgresDB.Pool), appLogger).
+		WithSecurityNotifier(notifier).
+		WithAuditLog(service.NewAuditLog(postgres.NewAuditRepository(postgresDB.Pool), appLogger)).
+		WithTenantStatus(postgres.NewTenantStatusRepository(postgresDB.Pool))
+	httpHandler.NewAPIKeyHandler(apiKeyService, httpHandler.TokenValidatorFunc(validateAccessToken), appLogger).
+		RegisterRoutes(mux)
+
+	return func() {
+		closeNotifier()
+		postgresDB.Close()
+	}, nil
+}
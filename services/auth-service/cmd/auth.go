@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/database"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/logger"
+	pkg_redis "UptimePingPlatform/pkg/redis"
+	authv1 "UptimePingPlatform/proto/api/auth/v1"
+	"UptimePingPlatform/services/auth-service/internal/grpc/handlers"
+	"UptimePingPlatform/services/auth-service/internal/pkg/jwt"
+	"UptimePingPlatform/services/auth-service/internal/pkg/password"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+	redisRepo "UptimePingPlatform/services/auth-service/internal/repository/redis"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+const (
+	// defaultAuthAddr адрес gRPC AuthService по умолчанию
+	defaultAuthAddr = ":50051"
+	// accessTokenTTL время жизни access токена
+	accessTokenTTL = 24 * time.Hour
+	// refreshTokenTTL время жизни refresh токена
+	refreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// startAuthServer запускает gRPC AuthService на AUTH_GRPC_ADDR (по умолчанию :50051).
+// Пользователи хранятся в базе, сессии в Redis, поэтому без адреса базы или REDIS_ADDR сервер
+// не запускается. Вход из новой для пользователя страны (поле country запроса Login)
+// уведомляет администраторов тенанта при заданном RABBITMQ_URL
+func startAuthServer(ctx context.Context) (func(), error) {
+	dbConfig, ok := databaseConfig()
+	if !ok {
+		return func() {}, nil
+	}
+	redisAddr := os.Getenv("REDIS_ADDR")
+	if redisAddr == "" {
+		return func() {}, nil
+	}
+
+	appLogger, err := logger.NewLogger(os.Getenv("ENVIRONMENT"), "info", "auth-service", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	grpcConfig := config.DefaultGRPCConfig()
+	if err := grpcConfig.LoadFromEnv(); err != nil {
+		return nil, err
+	}
+	if err := grpcConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid gRPC config: %w", err)
+	}
+
+	accessSecret := os.Getenv("JWT_ACCESS_SECRET")
+	if accessSecret == "" {
+		accessSecret = jwtSecretKey
+	}
+	refreshSecret := os.Getenv("JWT_REFRESH_SECRET")
+	if refreshSecret == "" {
+		refreshSecret = jwtSecretKey
+	}
+
+	addr := os.Getenv("AUTH_GRPC_ADDR")
+	if addr == "" {
+		addr = defaultAuthAddr
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	postgresDB, err := database.Connect(ctx, dbConfig)
+	if err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	redisConfig := pkg_redis.NewConfig()
+	redisConfig.Addr = redisAddr
+	redisConfig.Password = os.Getenv("REDIS_PASSWORD")
+	redisClient, err := pkg_redis.Connect(ctx, redisConfig)
+	if err != nil {
+		postgresDB.Close()
+		lis.Close()
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	notifier, closeNotifier, err := securityNotifier(ctx, postgresDB.Pool, appLogger)
+	if err != nil {
+		redisClient.Close()
+		postgresDB.Close()
+		lis.Close()
+		return nil, err
+	}
+
+	jwtManager := jwt.NewManager(accessSecret, refreshSecret, accessTokenTTL, refreshTokenTTL)
+	authService := service.NewAuthService(
+		postgres.NewUserRepository(postgresDB.Pool),
+		postgres.NewTenantRepository(postgresDB.Pool),
+		postgres.NewAPIKeyRepository(postgresDB.Pool),
+		redisRepo.NewSessionRepository(redisClient.Client),
+		jwtManager,
+		password.NewBcryptHasher(0),
+		appLogger,
+	).(*service.Service).
+		WithSecurityNotifier(notifier).
+		WithAuditLog(service.NewAuditLog(postgres.NewAuditRepository(postgresDB.Pool), appLogger)).
+		WithTenantStatus(postgres.NewTenantStatusRepository(postgresDB.Pool)).
+		WithLoginCountries(postgres.NewLoginCountryRepository(postgresDB.Pool))
+
+	server := grpc.NewServer(pkg_grpc.ServerOptions(grpcConfig)...)
+	authv1.RegisterAuthServiceServer(server, handlers.NewAuthHandler(authService, jwtManager, appLogger))
+	// grpc.health.v1 для проверок здоровья подканалов на стороне клиентов
+	healthServer := pkg_grpc.RegisterHealthServer(server, authv1.AuthService_ServiceDesc.ServiceName)
+	pkg_grpc.RegisterReflection(server, grpcConfig, os.Getenv("ENVIRONMENT"))
+
+	go func() {
+		appLogger.Info("Auth gRPC server starting", logger.String("addr", addr))
+		if err := server.Serve(pkg_grpc.LimitListener(lis, grpcConfig)); err != nil {
+			appLogger.Error("Auth gRPC server stopped", logger.Error(err))
+		}
+	}()
+
+	return func() {
+		healthServer.Shutdown()
+		server.GracefulStop()
+		closeNotifier()
+		redisClient.Close()
+		postgresDB.Close()
+	}, nil
+}
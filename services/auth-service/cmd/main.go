@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -12,6 +14,8 @@ import (
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+
+	"UptimePingPlatform/services/auth-service/internal/service"
 )
 
 // generateUserID генерирует уникальный ID пользователя
@@ -32,6 +36,9 @@ func generateTenantID() string {
 	return id.String()
 }
 
+// jwtSecretKey секрет подписи токенов (в реальном приложении должен быть в конфигурации)
+const jwtSecretKey = "your-secret-key-here"
+
 // generateJWTToken создает JWT токен для пользователя
 func generateJWTToken(userID, tenantID, email string) (string, error) {
 	// Создаем кастомные claims с уникальными данными пользователя
@@ -49,10 +56,7 @@ func generateJWTToken(userID, tenantID, email string) (string, error) {
 	// Создаем токен с подписью
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	// Секретный ключ (в реальном приложении должен быть в конфигурации)
-	secretKey := "your-secret-key-here"
-
-	signedToken, err := token.SignedString([]byte(secretKey))
+	signedToken, err := token.SignedString([]byte(jwtSecretKey))
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -84,6 +88,13 @@ func main() {
 		IdleTimeout:  60 * time.Second,
 	}
 
+	// Статусы тенантов: токены заблокированных тенантов не проходят проверку
+	tenantStatus, closeTenantStatus, err := connectTenantStatus(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to initialize tenant status: %v", err)
+	}
+	defer closeTenantStatus()
+
 	// Health endpoints
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -205,7 +216,7 @@ func main() {
 				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 			}
 			// Секретный ключ (должен совпадать с тем что используется для генерации)
-			return []byte("your-secret-key-here"), nil
+			return []byte(jwtSecretKey), nil
 		})
 
 		if err != nil {
@@ -227,6 +238,16 @@ func main() {
 		isAdmin, _ := claims["is_admin"].(bool)
 		exp, _ := claims["exp"].(float64)
 
+		// Заблокированный оператором тенант теряет доступ и по уже выданным токенам
+		if err := service.CheckTenantActive(r.Context(), tenantStatus, tenantID); err != nil {
+			if stderrors.Is(err, service.ErrTenantSuspended) {
+				http.Error(w, "Tenant is suspended", http.StatusForbidden)
+				return
+			}
+			http.Error(w, "Failed to check tenant status", http.StatusServiceUnavailable)
+			return
+		}
+
 		// Формируем ответ с реальными данными из токена
 		userInfo := map[string]interface{}{
 			"user_id":    userID,
@@ -235,11 +256,81 @@ func main() {
 			"email":      email,
 			"expires_at": int64(exp),
 		}
+		// Токен имперсонации ограничен правами только на чтение и помечен оператором
+		if operator, ok := claims["impersonated_by"].(string); ok && operator != "" {
+			permissions := []string{}
+			if values, ok := claims["permissions"].([]interface{}); ok {
+				for _, value := range values {
+					if permission, ok := value.(string); ok {
+						permissions = append(permissions, permission)
+					}
+				}
+			}
+			userInfo["permissions"] = permissions
+			userInfo["impersonated_by"] = operator
+			userInfo["impersonation_id"], _ = claims["impersonation_id"].(string)
+		}
+		// Токен тенанта организации несет организацию и тенанты для переключателя
+		if orgID, ok := claims["org_id"].(string); ok && orgID != "" {
+			orgTenants := []string{}
+			if values, ok := claims["org_tenants"].([]interface{}); ok {
+				for _, value := range values {
+					if tenant, ok := value.(string); ok {
+						orgTenants = append(orgTenants, tenant)
+					}
+				}
+			}
+			userInfo["org_id"] = orgID
+			userInfo["org_role"], _ = claims["org_role"].(string)
+			userInfo["org_tenants"] = orgTenants
+		}
 
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(userInfo)
 	})
 
+	// Экспорт данных и удаление тенанта (GDPR)
+	closeOffboarding, err := registerOffboarding(context.Background(), mux)
+	if err != nil {
+		log.Fatalf("Failed to initialize tenant offboarding: %v", err)
+	}
+	defer closeOffboarding()
+
+	// API ключи тенантов
+	closeAPIKeys, err := registerAPIKeys(context.Background(), mux)
+	if err != nil {
+		log.Fatalf("Failed to initialize API keys: %v", err)
+	}
+	defer closeAPIKeys()
+
+	// Организации: несколько тенантов-окружений под одной организацией
+	closeOrganizations, err := registerOrganizations(context.Background(), mux)
+	if err != nil {
+		log.Fatalf("Failed to initialize organizations: %v", err)
+	}
+	defer closeOrganizations()
+
+	// gRPC API настроек тенантов для остальных сервисов и gateway
+	closeSettings, err := startSettingsServer(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to start tenant settings API: %v", err)
+	}
+	defer closeSettings()
+
+	// gRPC AuthService: вход, регистрация и уведомления о входе из новой страны
+	closeAuth, err := startAuthServer(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to start auth gRPC API: %v", err)
+	}
+	defer closeAuth()
+
+	// Внутреннее admin API операторов платформы
+	closeAdmin, err := startAdminServer(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to start admin API: %v", err)
+	}
+	defer closeAdmin()
+
 	log.Println("Auth Service starting on port 51051...")
 	log.Fatal(server.ListenAndServe())
 }
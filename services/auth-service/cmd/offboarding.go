@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+
+	"UptimePingPlatform/pkg/database"
+	"UptimePingPlatform/pkg/logger"
+	httpHandler "UptimePingPlatform/services/auth-service/internal/handler/http"
+	"UptimePingPlatform/services/auth-service/internal/pkg/jwt"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// tenantPurgeInterval интервал удаления тенантов с истекшим периодом отмены
+const tenantPurgeInterval = time.Hour
+
+// registerOffboarding подключает API экспорта данных и удаления тенанта. Требует базу данных
+// (DATABASE_HOST, DATABASE_PORT, DATABASE_NAME, DATABASE_USER, DATABASE_PASSWORD или те же DB_*);
+// без адреса базы API не регистрируется. Период отмены удаления задается
+// TENANT_DELETION_GRACE_PERIOD (по умолчанию 720h)
+func registerOffboarding(ctx context.Context, mux *http.ServeMux) (func(), error) {
+	dbConfig, ok := databaseConfig()
+	if !ok {
+		return func() {}, nil
+	}
+
+	appLogger, err := logger.NewLogger(os.Getenv("ENVIRONMENT"), "info", "auth-service", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	gracePeriod := service.DefaultDeletionGracePeriod
+	if value := os.Getenv("TENANT_DELETION_GRACE_PERIOD"); value != "" {
+		gracePeriod, err = time.ParseDuration(value)
+		if err != nil || gracePeriod <= 0 {
+			return nil, fmt.Errorf("invalid TENANT_DELETION_GRACE_PERIOD: %s", value)
+		}
+	}
+
+	postgresDB, err := database.Connect(ctx, dbConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	offboardingService := service.NewOffboardingService(postgres.NewOffboardingRepository(postgresDB.Pool), appLogger).
+		WithGracePeriod(gracePeriod)
+	httpHandler.NewOffboardingHandler(offboardingService, httpHandler.TokenValidatorFunc(validateAccessToken), appLogger).
+		RegisterRoutes(mux)
+
+	purgeCtx, cancel := context.WithCancel(ctx)
+	go offboardingService.RunPurger(purgeCtx, tenantPurgeInterval)
+
+	return func() {
+		cancel()
+		postgresDB.Close()
+	}, nil
+}
+
+// databaseConfig собирает настройки базы из DB_* и DATABASE_* переменных
+func databaseConfig() (*database.Config, bool) {
+	config := database.GetConfig()
+	if os.Getenv("DB_HOST") == "" && os.Getenv("DATABASE_HOST") == "" {
+		return nil, false
+	}
+
+	if host := os.Getenv("DATABASE_HOST"); host != "" {
+		config.Host = host
+	}
+	if port, err := strconv.Atoi(os.Getenv("DATABASE_PORT")); err == nil {
+		config.Port = port
+	}
+	if name := os.Getenv("DATABASE_NAME"); name != "" {
+		config.Database = name
+	}
+	if user := os.Getenv("DATABASE_USER"); user != "" {
+		config.User = user
+	}
+	if password := os.Getenv("DATABASE_PASSWORD"); password != "" {
+		config.Password = password
+	}
+	return config, true
+}
+
+// validateAccessToken проверяет токен, выданный /api/v1/auth/login и /api/v1/auth/register
+func validateAccessToken(token string) (*jwt.TokenClaims, error) {
+	claims := &jwt.TokenClaims{}
+	parsed, err := gojwt.ParseWithClaims(token, claims, func(token *gojwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*gojwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(jwtSecretKey), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !parsed.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+	return claims, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"UptimePingPlatform/pkg/database"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	httpHandler "UptimePingPlatform/services/auth-service/internal/handler/http"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// registerOrganizations подключает API организаций: тенанты-окружения одной организации,
+// роли организации и переключение между тенантами. Требует базу данных
+func registerOrganizations(ctx context.Context, mux *http.ServeMux) (func(), error) {
+	dbConfig, ok := databaseConfig()
+	if !ok {
+		return func() {}, nil
+	}
+
+	appLogger, err := logger.NewLogger(os.Getenv("ENVIRONMENT"), "info", "auth-service", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	postgresDB, err := database.Connect(ctx, dbConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	organizationService := service.NewOrganizationService(
+		postgres.NewOrganizationRepository(postgresDB.Pool),
+		postgres.NewUserRepository(postgresDB.Pool),
+		service.OrgTokenSignerFunc(signOrgToken),
+		appLogger,
+	)
+	httpHandler.NewOrganizationHandler(organizationService, httpHandler.TokenValidatorFunc(validateAccessToken), appLogger).
+		RegisterRoutes(mux)
+
+	return postgresDB.Close, nil
+}
+
+// signOrgToken подписывает токен тенанта организации ключом пользовательских токенов.
+// Кроме обычных claims токен несет организацию, роль и тенанты для переключателя
+func signOrgToken(token *domain.OrgToken) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id":     token.UserID,
+		"tenant_id":   token.TenantID,
+		"email":       token.Email,
+		"is_admin":    token.IsAdmin,
+		"org_id":      token.OrganizationID,
+		"org_role":    token.OrgRole,
+		"org_tenants": token.Tenants,
+		"exp":         token.ExpiresAt.Unix(),
+		"iat":         token.IssuedAt.Unix(),
+		"nbf":         token.IssuedAt.Unix(),
+		"sub":         token.UserID,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(jwtSecretKey))
+}
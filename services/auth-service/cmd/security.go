@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// notificationsExchange exchange, из которого notification-service забирает уведомления
+const notificationsExchange = "notifications"
+
+// notificationPublisher публикует уведомления безопасности в exchange notifications
+type notificationPublisher struct {
+	producer *rabbitmq.Producer
+}
+
+// Publish публикует уведомление с ключом маршрутизации, равным типу события
+func (p notificationPublisher) Publish(ctx context.Context, routingKey string, body []byte, headers map[string]interface{}) error {
+	return p.producer.Publish(ctx, body,
+		rabbitmq.WithExchange(notificationsExchange),
+		rabbitmq.WithRoutingKey(routingKey),
+		rabbitmq.WithHeaders(headers))
+}
+
+// securityNotifier создает уведомитель администраторов тенанта о событиях безопасности.
+// Уведомления отправляются через notification-service, поэтому без RABBITMQ_URL возвращается nil
+func securityNotifier(ctx context.Context, pool *pgxpool.Pool, appLogger logger.Logger) (*service.SecurityNotifier, func(), error) {
+	rabbitmqURL := os.Getenv("RABBITMQ_URL")
+	if rabbitmqURL == "" {
+		return nil, func() {}, nil
+	}
+
+	config := rabbitmq.NewConfig()
+	config.URL = rabbitmqURL
+	conn, err := rabbitmq.Connect(ctx, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to rabbitmq: %w", err)
+	}
+
+	settingsService := service.NewSettingsService(postgres.NewTenantSettingsRepository(pool), appLogger)
+	notifier := service.NewSecurityNotifier(
+		postgres.NewUserRepository(pool),
+		settingsService,
+		notificationPublisher{producer: rabbitmq.NewProducer(conn, config)},
+		appLogger,
+	)
+	return notifier, func() { conn.Close() }, nil
+}
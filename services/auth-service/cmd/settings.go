@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/database"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/logger"
+	pkg_redis "UptimePingPlatform/pkg/redis"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	grpcHandler "UptimePingPlatform/services/auth-service/internal/handler/grpc"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+	redisRepo "UptimePingPlatform/services/auth-service/internal/repository/redis"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// defaultSettingsAddr адрес gRPC TenantSettingsService по умолчанию
+const defaultSettingsAddr = ":50058"
+
+// startSettingsServer запускает gRPC TenantSettingsService на SETTINGS_GRPC_ADDR (по умолчанию :50058).
+// Требует базу данных; без ее адреса сервер не запускается. При заданном REDIS_ADDR настройки
+// кэшируются на SETTINGS_CACHE_TTL (по умолчанию 5m). Опции gRPC сервера задаются переменными GRPC_*
+func startSettingsServer(ctx context.Context) (func(), error) {
+	dbConfig, ok := databaseConfig()
+	if !ok {
+		return func() {}, nil
+	}
+
+	appLogger, err := logger.NewLogger(os.Getenv("ENVIRONMENT"), "info", "auth-service", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	cacheTTL := redisRepo.DefaultSettingsCacheTTL
+	if value := os.Getenv("SETTINGS_CACHE_TTL"); value != "" {
+		cacheTTL, err = time.ParseDuration(value)
+		if err != nil || cacheTTL <= 0 {
+			return nil, fmt.Errorf("invalid SETTINGS_CACHE_TTL: %s", value)
+		}
+	}
+
+	grpcConfig := config.DefaultGRPCConfig()
+	if err := grpcConfig.LoadFromEnv(); err != nil {
+		return nil, err
+	}
+	if err := grpcConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid gRPC config: %w", err)
+	}
+
+	addr := os.Getenv("SETTINGS_GRPC_ADDR")
+	if addr == "" {
+		addr = defaultSettingsAddr
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	postgresDB, err := database.Connect(ctx, dbConfig)
+	if err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	settingsService := service.NewSettingsService(postgres.NewTenantSettingsRepository(postgresDB.Pool), appLogger)
+	var redisClient *pkg_redis.Client
+	if redisAddr := os.Getenv("REDIS_ADDR"); redisAddr != "" {
+		redisConfig := pkg_redis.NewConfig()
+		redisConfig.Addr = redisAddr
+		redisConfig.Password = os.Getenv("REDIS_PASSWORD")
+		if redisClient, err = pkg_redis.Connect(ctx, redisConfig); err != nil {
+			appLogger.Warn("Tenant settings cache disabled: failed to connect to Redis", logger.Error(err))
+		} else {
+			settingsService.WithCache(redisRepo.NewTenantSettingsCache(redisClient.Client, cacheTTL))
+		}
+	}
+
+	server := grpc.NewServer(pkg_grpc.ServerOptions(grpcConfig)...)
+	configv1.RegisterTenantSettingsServiceServer(server, grpcHandler.NewSettingsHandler(settingsService, appLogger))
+	// grpc.health.v1 для проверок здоровья подканалов на стороне клиентов
+	healthServer := pkg_grpc.RegisterHealthServer(server, configv1.TenantSettingsService_ServiceDesc.ServiceName)
+	pkg_grpc.RegisterReflection(server, grpcConfig, os.Getenv("ENVIRONMENT"))
+
+	go func() {
+		appLogger.Info("Tenant settings gRPC server starting", logger.String("addr", addr))
+		if err := server.Serve(pkg_grpc.LimitListener(lis, grpcConfig)); err != nil {
+			appLogger.Error("Tenant settings gRPC server stopped", logger.Error(err))
+		}
+	}()
+
+	return func() {
+		healthServer.Shutdown()
+		server.GracefulStop()
+		if redisClient != nil {
+			redisClient.Close()
+		}
+		postgresDB.Close()
+	}, nil
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"UptimePingPlatform/pkg/database"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
+)
+
+// connectTenantStatus подключает статусы тенантов для отказа заблокированным тенантам
+// в /api/v1/auth/validate. Без адреса базы возвращает nil и проверка отключена
+func connectTenantStatus(ctx context.Context) (repository.TenantStatusRepository, func(), error) {
+	dbConfig, ok := databaseConfig()
+	if !ok {
+		return nil, func() {}, nil
+	}
+
+	postgresDB, err := database.Connect(ctx, dbConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return postgres.NewTenantStatusRepository(postgresDB.Pool), postgresDB.Close, nil
+}
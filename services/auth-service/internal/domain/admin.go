@@ -0,0 +1,71 @@
+package domain
+
+import "time"
+
+// TenantUsage тенант с показателями использования платформы для операторов
+type TenantUsage struct {
+	TenantID  string    `json:"tenant_id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	Plan      string    `json:"plan"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	Users     int       `json:"users"`
+	Checks    int       `json:"checks"`
+	// EnabledChecks проверки, которые выполняются по расписанию
+	EnabledChecks int `json:"enabled_checks"`
+	// CheckResults24h число результатов проверок за последние сутки
+	CheckResults24h int64 `json:"check_results_24h"`
+	OpenIncidents   int   `json:"open_incidents"`
+}
+
+// TenantUsageFilter фильтр списка тенантов
+type TenantUsageFilter struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// IncidentResolution принудительное закрытие инцидента оператором
+type IncidentResolution struct {
+	IncidentID string    `json:"incident_id"`
+	Operator   string    `json:"operator"`
+	Reason     string    `json:"reason"`
+	ResolvedAt time.Time `json:"resolved_at"`
+}
+
+// FeatureFlag переключатель функциональности. Флаг без TenantID действует на всю платформу,
+// флаг с TenantID переопределяет глобальное значение для тенанта
+type FeatureFlag struct {
+	Name        string    `json:"name"`
+	TenantID    string    `json:"tenant_id,omitempty"`
+	Enabled     bool      `json:"enabled"`
+	Description string    `json:"description,omitempty"`
+	UpdatedBy   string    `json:"updated_by"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// QueueDepth состояние очереди RabbitMQ для панели операторов
+type QueueDepth struct {
+	Queue                  string `json:"queue"`
+	Messages               int    `json:"messages"`
+	MessagesReady          int    `json:"messages_ready"`
+	MessagesUnacknowledged int    `json:"messages_unacknowledged"`
+	Consumers              int    `json:"consumers"`
+	// Error ошибка опроса очереди; остальные поля в этом случае не заполнены
+	Error string `json:"error,omitempty"`
+}
+
+// Impersonation доступ оператора поддержки к тенанту только на чтение. Токен не продлевается
+// и перестает действовать в ExpiresAt
+type Impersonation struct {
+	ID          string    `json:"id"`
+	Operator    string    `json:"operator"`
+	TenantID    string    `json:"tenant_id"`
+	Reason      string    `json:"reason"`
+	Permissions []string  `json:"permissions"`
+	IssuedAt    time.Time `json:"issued_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	// Token access токен имперсонации; возвращается только при выдаче
+	Token string `json:"token,omitempty"`
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// AuditEvent запись журнала аудита тенанта
+type AuditEvent struct {
+	ID       string
+	TenantID string
+	// Event тип события, например api_key.created или impersonation.started
+	Event string
+	// ActorID пользователь или оператор, выполнивший действие; пусто для действий системы
+	ActorID   string
+	Data      map[string]string
+	CreatedAt time.Time
+}
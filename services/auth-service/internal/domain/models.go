@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"net/netip"
+	"strings"
 	"time"
 )
 
@@ -32,20 +34,60 @@ type Tenant struct {
 }
 
 // APIKey представляет API ключ для доступа к системе
-// API ключи: key (публичный, в БД), secret (приватный, только при создании)
-// KeyHash используется для поиска ключа по публичной части
-// SecretHash используется для проверки приватной части (аналогично паролям)
+// API ключи: key (публичный) и secret (приватный) выдаются только при создании
+// KeyPrefix открытый префикс публичной части, по которому ключ ищется в БД
+// SecretHash соленый хеш пары key/secret; сами ключи не хранятся
+// AllowedIPs адреса и CIDR подсети, с которых разрешено использовать ключ; пусто - любые
 type APIKey struct {
 	ID         string    `json:"id"`
 	TenantID   string    `json:"tenant_id"`
-	KeyHash    string    `json:"key_hash"`
-	SecretHash string    `json:"secret_hash"`
+	KeyPrefix  string    `json:"key_prefix"`
+	SecretHash string    `json:"-"`
 	Name       string    `json:"name"`
 	IsActive   bool      `json:"is_active"`
+	AllowedIPs []string  `json:"allowed_ips,omitempty"`
 	ExpiresAt  time.Time `json:"expires_at"`
 	CreatedAt  time.Time `json:"created_at"`
 }
 
+// AllowsIP проверяет, разрешено ли использовать ключ с адреса клиента. Ключ с ограничением
+// не принимается от клиента с неизвестным адресом
+func (k *APIKey) AllowsIP(clientIP string) bool {
+	if len(k.AllowedIPs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(clientIP)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, allowed := range k.AllowedIPs {
+		prefix, err := ParseAllowedIP(allowed)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseAllowedIP разбирает элемент списка разрешенных адресов ключа: IP адрес или CIDR подсеть
+func ParseAllowedIP(value string) (netip.Prefix, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
 // Session представляет сессию пользователя
 // JWT токены: access (15 мин), refresh (7 дней)
 // Refresh токены хранятся в Redis для возможности отзыва
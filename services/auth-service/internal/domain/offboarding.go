@@ -0,0 +1,73 @@
+package domain
+
+import "time"
+
+// Статусы тенанта
+const (
+	TenantStatusActive          = "active"
+	TenantStatusPendingDeletion = "pending_deletion"
+	TenantStatusSuspended       = "suspended"
+)
+
+// ExportSection раздел архива экспорта данных тенанта
+type ExportSection string
+
+const (
+	ExportSectionTenant                 ExportSection = "tenant"
+	ExportSectionUsers                  ExportSection = "users"
+	ExportSectionAPIKeys                ExportSection = "api_keys"
+	ExportSectionSessions               ExportSection = "sessions"
+	ExportSectionCheckGroups            ExportSection = "check_groups"
+	ExportSectionCheckTags              ExportSection = "check_tags"
+	ExportSectionChecks                 ExportSection = "checks"
+	ExportSectionSchedules              ExportSection = "schedules"
+	ExportSectionCheckResults           ExportSection = "check_results"
+	ExportSectionIncidents              ExportSection = "incidents"
+	ExportSectionIncidentEvents         ExportSection = "incident_events"
+	ExportSectionNotificationChannels   ExportSection = "notification_channels"
+	ExportSectionNotificationDeliveries ExportSection = "notification_deliveries"
+	ExportSectionAuditEvents            ExportSection = "audit_events"
+)
+
+// ExportSections разделы экспорта в порядке записи в архив. Секреты (хеши паролей, API ключей
+// и refresh токенов) в экспорт не попадают
+var ExportSections = []ExportSection{
+	ExportSectionTenant,
+	ExportSectionUsers,
+	ExportSectionAPIKeys,
+	ExportSectionSessions,
+	ExportSectionCheckGroups,
+	ExportSectionCheckTags,
+	ExportSectionChecks,
+	ExportSectionSchedules,
+	ExportSectionCheckResults,
+	ExportSectionIncidents,
+	ExportSectionIncidentEvents,
+	ExportSectionNotificationChannels,
+	ExportSectionNotificationDeliveries,
+	ExportSectionAuditEvents,
+}
+
+// ExportManifest описание архива экспорта: состав разделов и число записей
+type ExportManifest struct {
+	TenantID    string                `json:"tenant_id"`
+	GeneratedAt time.Time             `json:"generated_at"`
+	Format      string                `json:"format"`
+	Sections    []ExportManifestEntry `json:"sections"`
+}
+
+// ExportManifestEntry раздел архива
+type ExportManifestEntry struct {
+	Section ExportSection `json:"section"`
+	File    string        `json:"file"`
+	Records int           `json:"records"`
+}
+
+// TenantDeletion запланированное удаление тенанта
+type TenantDeletion struct {
+	TenantID    string    `json:"tenant_id"`
+	RequestedAt time.Time `json:"requested_at"`
+	RequestedBy string    `json:"requested_by"`
+	// PurgeAt момент безвозвратного удаления; до него удаление можно отменить
+	PurgeAt time.Time `json:"purge_at"`
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "time"
+
+// Роли пользователя в организации
+const (
+	// OrgRoleOwner управляет участниками и тенантами организации, администратор во всех ее тенантах
+	OrgRoleOwner = "owner"
+	// OrgRoleAdmin создает тенанты и добавляет участников, администратор во всех тенантах
+	OrgRoleAdmin = "admin"
+	// OrgRoleMember переключается между тенантами организации без прав администратора
+	OrgRoleMember = "member"
+)
+
+// Organization объединяет тенанты одного клиента, например окружения prod и staging. Тарифный
+// план, платежный адрес и каталог пользователей общие, данные тенантов изолированы: токен
+// всегда выдается для одного тенанта
+type Organization struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Slug         string    `json:"slug"`
+	Plan         string    `json:"plan"`
+	BillingEmail string    `json:"billing_email"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// OrganizationMember участник организации: пользователь одного из ее тенантов с ролью
+// на уровне организации. Пользователь состоит не более чем в одной организации
+type OrganizationMember struct {
+	OrganizationID string    `json:"organization_id"`
+	UserID         string    `json:"user_id"`
+	TenantID       string    `json:"tenant_id"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// OrganizationView организация пользователя с его ролью и тенантами для переключателя
+type OrganizationView struct {
+	Organization *Organization `json:"organization"`
+	Role         string        `json:"role"`
+	Tenants      []*Tenant     `json:"tenants"`
+}
+
+// OrgToken данные access токена, выданного при переключении на тенант организации
+type OrgToken struct {
+	UserID         string
+	Email          string
+	TenantID       string
+	IsAdmin        bool
+	OrganizationID string
+	OrgRole        string
+	// Tenants тенанты организации, на которые можно переключиться с этим токеном
+	Tenants   []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// IsOrgRole проверяет, что роль в организации известна
+func IsOrgRole(role string) bool {
+	return role == OrgRoleOwner || role == OrgRoleAdmin || role == OrgRoleMember
+}
+
+// OrgRoleAdministersTenants сообщает, что роль дает права администратора во всех тенантах
+func OrgRoleAdministersTenants(role string) bool {
+	return role == OrgRoleOwner || role == OrgRoleAdmin
+}
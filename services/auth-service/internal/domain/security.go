@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// Типы уведомлений безопасности, которые получают администраторы тенанта
+const (
+	SecurityEventUserJoined      = "user_joined"
+	SecurityEventRoleChanged     = "role_changed"
+	SecurityEventAPIKeyCreated   = "api_key_created"
+	SecurityEventNewCountryLogin = "new_country_login"
+)
+
+// SecurityEventAPIKeyAuthFailed неудачная аутентификация по API ключу тенанта. Событие пишется
+// только в журнал аудита и не рассылается администраторам
+const SecurityEventAPIKeyAuthFailed = "api_key_auth_failed"
+
+// SecurityEvents все типы уведомлений безопасности
+var SecurityEvents = []string{
+	SecurityEventUserJoined,
+	SecurityEventRoleChanged,
+	SecurityEventAPIKeyCreated,
+	SecurityEventNewCountryLogin,
+}
+
+// IsSecurityEvent проверяет, что тип уведомления безопасности известен
+func IsSecurityEvent(eventType string) bool {
+	for _, known := range SecurityEvents {
+		if known == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// SecurityEvent событие безопасности тенанта для уведомления администраторов
+type SecurityEvent struct {
+	Type     string
+	TenantID string
+	// UserID и Email пользователь, с которым произошло событие
+	UserID string
+	Email  string
+	// Details подробности события для текста уведомления: роль, имя ключа, страна входа
+	Details    map[string]string
+	OccurredAt time.Time
+}
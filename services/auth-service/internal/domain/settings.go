@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"time"
+
+	"UptimePingPlatform/pkg/locale"
+)
+
+// Границы значений по умолчанию для проверок, совпадают с ограничениями scheduler-service
+const (
+	MinCheckIntervalSeconds = 5
+	MaxCheckIntervalSeconds = 86400
+	MinCheckTimeoutSeconds  = 1
+	MaxCheckTimeoutSeconds  = 300
+)
+
+// NotificationSeverities допустимые минимальные уровни уведомлений по возрастанию
+var NotificationSeverities = []string{"info", "warning", "error", "critical"}
+
+// TenantSettings настройки тенанта, которые сервисы применяют во время работы.
+// Хранятся в tenants.settings; поля, отсутствующие в сохраненном документе,
+// принимают значения DefaultTenantSettings
+type TenantSettings struct {
+	TenantID      string               `json:"tenant_id"`
+	Checks        CheckDefaults        `json:"checks"`
+	Notifications NotificationDefaults `json:"notifications"`
+	Features      map[string]bool      `json:"features"`
+	Branding      Branding             `json:"branding"`
+	// Localization часовой пояс и язык, в которых сервисы показывают время тенанту
+	Localization locale.Settings `json:"localization"`
+	// ResultSinks webhook, получающие все сырые результаты проверок тенанта
+	ResultSinks []ResultSink `json:"result_sinks"`
+	UpdatedBy   string       `json:"updated_by,omitempty"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// CheckDefaults значения по умолчанию для новых проверок тенанта
+type CheckDefaults struct {
+	IntervalSeconds int `json:"interval_seconds"`
+	TimeoutSeconds  int `json:"timeout_seconds"`
+}
+
+// NotificationDefaults правила уведомлений тенанта по умолчанию
+type NotificationDefaults struct {
+	ChannelIDs       []string `json:"channel_ids"`
+	MinSeverity      string   `json:"min_severity"`
+	NotifyOnRecovery bool     `json:"notify_on_recovery"`
+	// SecurityOptOut типы уведомлений безопасности, которые администраторы отключили
+	SecurityOptOut []string `json:"security_opt_out"`
+}
+
+// SecurityEnabled сообщает, получают ли администраторы уведомления безопасности этого типа
+func (n NotificationDefaults) SecurityEnabled(eventType string) bool {
+	for _, disabled := range n.SecurityOptOut {
+		if disabled == eventType {
+			return false
+		}
+	}
+	return true
+}
+
+// Branding оформление страниц статуса и писем тенанта
+type Branding struct {
+	CompanyName     string `json:"company_name"`
+	LogoURL         string `json:"logo_url"`
+	PrimaryColor    string `json:"primary_color"`
+	StatusPageTitle string `json:"status_page_title"`
+}
+
+// ResultSink webhook, на который core-service пачками отправляет подписанные результаты
+// проверок. Пустой Tags означает все проверки, иначе - проверки с любым из тегов
+type ResultSink struct {
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Tags   []string `json:"tags"`
+}
+
+// DefaultTenantSettings настройки тенанта, который их не менял
+func DefaultTenantSettings(tenantID string) *TenantSettings {
+	return &TenantSettings{
+		TenantID: tenantID,
+		Checks: CheckDefaults{
+			IntervalSeconds: 60,
+			TimeoutSeconds:  10,
+		},
+		Notifications: NotificationDefaults{
+			ChannelIDs:       []string{},
+			MinSeverity:      "warning",
+			NotifyOnRecovery: true,
+			SecurityOptOut:   []string{},
+		},
+		Features:     map[string]bool{},
+		Localization: locale.Default(),
+		ResultSinks:  []ResultSink{},
+	}
+}
@@ -94,6 +94,7 @@ func (h *AuthHandler) Login(ctx context.Context, req *grpc_auth.LoginRequest) (*
 		"email": req.Email,
 	})
 
+	ctx = service.WithLoginCountry(ctx, req.GetCountry())
 	tokenPair, err := h.authService.Login(ctx, req.Email, req.Password)
 	if err != nil {
 		return nil, h.convertError(err)
@@ -226,7 +227,7 @@ func (h *AuthHandler) CreateAPIKey(ctx context.Context, req *grpc_auth.CreateAPI
 		"name":      req.Name,
 	})
 
-	apiKeyPair, err := h.authService.CreateAPIKey(ctx, req.TenantId, req.Name)
+	apiKeyPair, err := h.authService.CreateAPIKey(ctx, req.TenantId, req.Name, req.AllowedIps...)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -248,10 +249,10 @@ func (h *AuthHandler) CreateAPIKey(ctx context.Context, req *grpc_auth.CreateAPI
 // ValidateAPIKey проверяет валидность API ключа
 func (h *AuthHandler) ValidateAPIKey(ctx context.Context, req *grpc_auth.ValidateAPIKeyRequest) (*grpc_auth.ValidateAPIKeyResponse, error) {
 	h.LogOperationStart(ctx, "ValidateAPIKey", map[string]interface{}{
-		"key": req.Key,
+		"client_ip": req.ClientIp,
 	})
 
-	claims, err := h.authService.ValidateAPIKey(ctx, req.Key, req.Secret)
+	claims, err := h.authService.ValidateAPIKey(ctx, req.Key, req.Secret, req.ClientIp)
 	if err != nil {
 		return nil, h.convertError(err)
 	}
@@ -50,6 +50,14 @@ func (m *SimpleMockAuthService) Logout(ctx context.Context, userID, tokenID stri
 	return args.Error(0)
 }
 
+func (m *SimpleMockAuthService) SetUserRole(ctx context.Context, actorID, userID string, isAdmin bool) (*domain.User, error) {
+	args := m.Called(ctx, actorID, userID, isAdmin)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.User), args.Error(1)
+}
+
 func (m *SimpleMockAuthService) GetUserByID(ctx context.Context, userID string) (*domain.User, error) {
 	args := m.Called(ctx, userID)
 	if args.Get(0) == nil {
@@ -58,16 +66,16 @@ func (m *SimpleMockAuthService) GetUserByID(ctx context.Context, userID string)
 	return args.Get(0).(*domain.User), args.Error(1)
 }
 
-func (m *SimpleMockAuthService) CreateAPIKey(ctx context.Context, tenantID, name string) (*service.APIKeyPair, error) {
-	args := m.Called(ctx, tenantID, name)
+func (m *SimpleMockAuthService) CreateAPIKey(ctx context.Context, tenantID, name string, allowedIPs ...string) (*service.APIKeyPair, error) {
+	args := m.Called(ctx, tenantID, name, allowedIPs)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*service.APIKeyPair), args.Error(1)
 }
 
-func (m *SimpleMockAuthService) ValidateAPIKey(ctx context.Context, key, secret string) (*service.Claims, error) {
-	args := m.Called(ctx, key, secret)
+func (m *SimpleMockAuthService) ValidateAPIKey(ctx context.Context, key, secret, clientIP string) (*service.Claims, error) {
+	args := m.Called(ctx, key, secret, clientIP)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -348,12 +356,13 @@ func TestAuthHandler_CreateAPIKey_Success(t *testing.T) {
 		Secret: "test-secret",
 	}
 
-	mockAuthService.On("CreateAPIKey", mock.Anything, "tenant-123", "TestKey").
+	mockAuthService.On("CreateAPIKey", mock.Anything, "tenant-123", "TestKey", []string{"203.0.113.0/24"}).
 		Return(expectedAPIKeyPair, nil)
 
 	req := &grpc_auth.CreateAPIKeyRequest{
-		TenantId: "tenant-123",
-		Name:     "TestKey",
+		TenantId:   "tenant-123",
+		Name:       "TestKey",
+		AllowedIps: []string{"203.0.113.0/24"},
 	}
 
 	resp, err := handler.CreateAPIKey(context.Background(), req)
@@ -375,12 +384,13 @@ func TestAuthHandler_ValidateAPIKey_Success(t *testing.T) {
 		KeyID:    "key-456",
 	}
 
-	mockAuthService.On("ValidateAPIKey", mock.Anything, "test-key", "test-secret").
+	mockAuthService.On("ValidateAPIKey", mock.Anything, "test-key", "test-secret", "203.0.113.7").
 		Return(expectedClaims, nil)
 
 	req := &grpc_auth.ValidateAPIKeyRequest{
-		Key:    "test-key",
-		Secret: "test-secret",
+		Key:      "test-key",
+		Secret:   "test-secret",
+		ClientIp: "203.0.113.7",
 	}
 
 	resp, err := handler.ValidateAPIKey(context.Background(), req)
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	grpcBase "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/locale"
+	"UptimePingPlatform/pkg/logger"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// SettingsHandler реализует gRPC TenantSettingsService
+type SettingsHandler struct {
+	*grpcBase.BaseHandler
+	configv1.UnimplementedTenantSettingsServiceServer
+	service *service.SettingsService
+}
+
+// NewSettingsHandler создает обработчик настроек тенанта
+func NewSettingsHandler(service *service.SettingsService, logger logger.Logger) *SettingsHandler {
+	return &SettingsHandler{
+		BaseHandler: grpcBase.NewBaseHandler(logger),
+		service:     service,
+	}
+}
+
+// GetTenantSettings возвращает настройки тенанта
+func (h *SettingsHandler) GetTenantSettings(ctx context.Context, req *configv1.GetTenantSettingsRequest) (*configv1.TenantSettings, error) {
+	settings, err := h.service.Get(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.serviceError(ctx, err, "GetTenantSettings", req.TenantId)
+	}
+	return settingsToProto(settings), nil
+}
+
+// UpdateTenantSettings заменяет настройки тенанта
+func (h *SettingsHandler) UpdateTenantSettings(ctx context.Context, req *configv1.UpdateTenantSettingsRequest) (*configv1.TenantSettings, error) {
+	if req.Settings == nil {
+		return nil, errors.New(errors.ErrValidation, "settings are required").ToGRPCErr()
+	}
+
+	h.LogOperationStart(ctx, "UpdateTenantSettings", map[string]interface{}{
+		"tenant_id":  req.Settings.TenantId,
+		"updated_by": req.UpdatedBy,
+	})
+
+	settings, err := h.service.Update(ctx, settingsFromProto(req.Settings), req.UpdatedBy)
+	if err != nil {
+		return nil, h.serviceError(ctx, err, "UpdateTenantSettings", req.Settings.TenantId)
+	}
+
+	h.LogOperationSuccess(ctx, "UpdateTenantSettings", map[string]interface{}{
+		"tenant_id": settings.TenantID,
+	})
+	return settingsToProto(settings), nil
+}
+
+// ResetTenantSettings возвращает настройки тенанта к значениям по умолчанию
+func (h *SettingsHandler) ResetTenantSettings(ctx context.Context, req *configv1.ResetTenantSettingsRequest) (*configv1.TenantSettings, error) {
+	settings, err := h.service.Reset(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.serviceError(ctx, err, "ResetTenantSettings", req.TenantId)
+	}
+
+	h.LogOperationSuccess(ctx, "ResetTenantSettings", map[string]interface{}{
+		"tenant_id": req.TenantId,
+	})
+	return settingsToProto(settings), nil
+}
+
+// serviceError конвертирует ошибки сервиса с кодом pkg/errors в gRPC статус с тем же кодом,
+// остальные передает в LogError
+func (h *SettingsHandler) serviceError(ctx context.Context, err error, operation, id string) error {
+	var appErr *errors.Error
+	if !stderrors.As(err, &appErr) {
+		return h.LogError(ctx, err, operation, id)
+	}
+	h.LogError(ctx, err, operation, id)
+	return appErr.ToGRPCErr()
+}
+
+// settingsToProto конвертирует настройки тенанта в protobuf
+func settingsToProto(settings *domain.TenantSettings) *configv1.TenantSettings {
+	result := &configv1.TenantSettings{
+		TenantId: settings.TenantID,
+		Checks: &configv1.CheckDefaults{
+			IntervalSeconds: int32(settings.Checks.IntervalSeconds),
+			TimeoutSeconds:  int32(settings.Checks.TimeoutSeconds),
+		},
+		Notifications: &configv1.NotificationDefaults{
+			ChannelIds:       settings.Notifications.ChannelIDs,
+			MinSeverity:      settings.Notifications.MinSeverity,
+			NotifyOnRecovery: settings.Notifications.NotifyOnRecovery,
+			SecurityOptOut:   settings.Notifications.SecurityOptOut,
+		},
+		Features: settings.Features,
+		Branding: &configv1.Branding{
+			CompanyName:     settings.Branding.CompanyName,
+			LogoUrl:         settings.Branding.LogoURL,
+			PrimaryColor:    settings.Branding.PrimaryColor,
+			StatusPageTitle: settings.Branding.StatusPageTitle,
+		},
+		Localization: &configv1.Localization{
+			Timezone: settings.Localization.Timezone,
+			Locale:   settings.Localization.Locale,
+		},
+		UpdatedBy: settings.UpdatedBy,
+	}
+	for _, sink := range settings.ResultSinks {
+		result.ResultSinks = append(result.ResultSinks, &configv1.ResultSink{
+			Name:   sink.Name,
+			Url:    sink.URL,
+			Secret: sink.Secret,
+			Tags:   sink.Tags,
+		})
+	}
+	if !settings.UpdatedAt.IsZero() {
+		result.UpdatedAt = settings.UpdatedAt.Format(time.RFC3339)
+	}
+	return result
+}
+
+// settingsFromProto конвертирует protobuf в настройки тенанта
+func settingsFromProto(settings *configv1.TenantSettings) *domain.TenantSettings {
+	sinks := make([]domain.ResultSink, 0, len(settings.GetResultSinks()))
+	for _, sink := range settings.GetResultSinks() {
+		sinks = append(sinks, domain.ResultSink{
+			Name:   sink.GetName(),
+			URL:    sink.GetUrl(),
+			Secret: sink.GetSecret(),
+			Tags:   sink.GetTags(),
+		})
+	}
+	return &domain.TenantSettings{
+		TenantID: settings.TenantId,
+		Checks: domain.CheckDefaults{
+			IntervalSeconds: int(settings.GetChecks().GetIntervalSeconds()),
+			TimeoutSeconds:  int(settings.GetChecks().GetTimeoutSeconds()),
+		},
+		Notifications: domain.NotificationDefaults{
+			ChannelIDs:       settings.GetNotifications().GetChannelIds(),
+			MinSeverity:      settings.GetNotifications().GetMinSeverity(),
+			NotifyOnRecovery: settings.GetNotifications().GetNotifyOnRecovery(),
+			SecurityOptOut:   settings.GetNotifications().GetSecurityOptOut(),
+		},
+		Features: settings.Features,
+		Branding: domain.Branding{
+			CompanyName:     settings.GetBranding().GetCompanyName(),
+			LogoURL:         settings.GetBranding().GetLogoUrl(),
+			PrimaryColor:    settings.GetBranding().GetPrimaryColor(),
+			StatusPageTitle: settings.GetBranding().GetStatusPageTitle(),
+		},
+		// Не заданные часовой пояс и язык принимают значения по умолчанию
+		Localization: locale.Settings{
+			Timezone: settings.GetLocalization().GetTimezone(),
+			Locale:   settings.GetLocalization().GetLocale(),
+		}.Normalize(),
+		ResultSinks: sinks,
+	}
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// memorySettingsRepository хранит настройки одного тенанта
+type memorySettingsRepository struct {
+	settings *domain.TenantSettings
+}
+
+func (r *memorySettingsRepository) Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error) {
+	if tenantID != "tenant-1" {
+		return nil, repository.ErrTenantNotFound
+	}
+	if r.settings == nil {
+		return domain.DefaultTenantSettings(tenantID), nil
+	}
+	return r.settings, nil
+}
+
+func (r *memorySettingsRepository) Save(ctx context.Context, settings *domain.TenantSettings) error {
+	if settings.TenantID != "tenant-1" {
+		return repository.ErrTenantNotFound
+	}
+	r.settings = settings
+	return nil
+}
+
+func (r *memorySettingsRepository) Reset(ctx context.Context, tenantID string) error {
+	r.settings = nil
+	return nil
+}
+
+func newTestSettingsHandler(t *testing.T) *SettingsHandler {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+	return NewSettingsHandler(service.NewSettingsService(&memorySettingsRepository{}, testLogger), testLogger)
+}
+
+func TestSettingsHandler_UpdateAndGet(t *testing.T) {
+	handler := newTestSettingsHandler(t)
+	ctx := context.Background()
+
+	updated, err := handler.UpdateTenantSettings(ctx, &configv1.UpdateTenantSettingsRequest{
+		UpdatedBy: "alice",
+		Settings: &configv1.TenantSettings{
+			TenantId:      "tenant-1",
+			Checks:        &configv1.CheckDefaults{IntervalSeconds: 120, TimeoutSeconds: 15},
+			Notifications: &configv1.NotificationDefaults{ChannelIds: []string{"channel-1"}, MinSeverity: "error"},
+			Features:      map[string]bool{"beta.dashboards": true},
+			Branding:      &configv1.Branding{CompanyName: "Acme", PrimaryColor: "#112233"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", updated.UpdatedBy)
+	assert.NotEmpty(t, updated.UpdatedAt)
+
+	settings, err := handler.GetTenantSettings(ctx, &configv1.GetTenantSettingsRequest{TenantId: "tenant-1"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(120), settings.Checks.IntervalSeconds)
+	assert.Equal(t, []string{"channel-1"}, settings.Notifications.ChannelIds)
+	assert.Equal(t, map[string]bool{"beta.dashboards": true}, settings.Features)
+	assert.Equal(t, "Acme", settings.Branding.CompanyName)
+}
+
+func TestSettingsHandler_ErrorCodes(t *testing.T) {
+	handler := newTestSettingsHandler(t)
+	ctx := context.Background()
+
+	_, err := handler.GetTenantSettings(ctx, &configv1.GetTenantSettingsRequest{TenantId: "tenant-2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = handler.UpdateTenantSettings(ctx, &configv1.UpdateTenantSettingsRequest{
+		Settings: &configv1.TenantSettings{TenantId: "tenant-1", Checks: &configv1.CheckDefaults{IntervalSeconds: 1}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = handler.UpdateTenantSettings(ctx, &configv1.UpdateTenantSettingsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
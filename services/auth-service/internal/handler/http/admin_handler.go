@@ -0,0 +1,339 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// adminPrefix префикс маршрутов admin API
+const adminPrefix = "/admin/v1/"
+
+// OperatorTokens статические токены операторов платформы: токен -> имя оператора
+type OperatorTokens map[string]string
+
+// ParseOperatorTokens разбирает список вида "alice:token1,bob:token2"
+func ParseOperatorTokens(value string) (OperatorTokens, error) {
+	tokens := make(OperatorTokens)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, token, ok := strings.Cut(entry, ":")
+		if !ok || name == "" || token == "" {
+			return nil, fmt.Errorf("invalid operator token entry, expected name:token")
+		}
+		if _, exists := tokens[token]; exists {
+			return nil, fmt.Errorf("duplicate token for operator %s", name)
+		}
+		tokens[token] = name
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("at least one operator token is required")
+	}
+	return tokens, nil
+}
+
+// Authenticate возвращает имя оператора для токена. Сравнение выполняется за постоянное время
+// по всем токенам, чтобы время ответа не раскрывало совпадающий префикс
+func (t OperatorTokens) Authenticate(token string) (string, bool) {
+	var operator string
+	for candidate, name := range t {
+		if subtle.ConstantTimeCompare([]byte(candidate), []byte(token)) == 1 {
+			operator = name
+		}
+	}
+	return operator, operator != ""
+}
+
+// AdminHandler внутреннее HTTP API операторов платформы. Обслуживается на отдельном порту,
+// недоступном клиентам, и требует токен оператора
+type AdminHandler struct {
+	service   *service.AdminService
+	operators OperatorTokens
+	logger    logger.Logger
+}
+
+// NewAdminHandler создает обработчик
+func NewAdminHandler(service *service.AdminService, operators OperatorTokens, logger logger.Logger) *AdminHandler {
+	return &AdminHandler{service: service, operators: operators, logger: logger}
+}
+
+// RegisterRoutes регистрирует HTTP маршруты
+func (h *AdminHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc(adminPrefix+"tenants", h.authenticated(h.handleTenants))
+	mux.HandleFunc(adminPrefix+"tenants/", h.authenticated(h.handleTenant))
+	mux.HandleFunc(adminPrefix+"incidents/", h.authenticated(h.handleIncident))
+	mux.HandleFunc(adminPrefix+"queues", h.authenticated(h.handleQueues))
+	mux.HandleFunc(adminPrefix+"feature-flags", h.authenticated(h.handleFeatureFlags))
+	mux.HandleFunc(adminPrefix+"feature-flags/", h.authenticated(h.handleFeatureFlag))
+}
+
+// operatorHandler обработчик запроса аутентифицированного оператора
+type operatorHandler func(w http.ResponseWriter, r *http.Request, operator string)
+
+// authenticated проверяет Bearer токен оператора
+func (h *AdminHandler) authenticated(next operatorHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || token == r.Header.Get("Authorization") {
+			h.writeError(w, errors.New(errors.ErrUnauthorized, "operator token is required"))
+			return
+		}
+		operator, ok := h.operators.Authenticate(token)
+		if !ok {
+			h.logger.Warn("Rejected admin API request",
+				logger.String("path", r.URL.Path),
+				logger.String("remote_addr", r.RemoteAddr),
+			)
+			h.writeError(w, errors.New(errors.ErrUnauthorized, "invalid operator token"))
+			return
+		}
+		next(w, r, operator)
+	}
+}
+
+// handleTenants возвращает список тенантов с показателями использования
+func (h *AdminHandler) handleTenants(w http.ResponseWriter, r *http.Request, operator string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := domain.TenantUsageFilter{Status: query.Get("status")}
+	var err error
+	if filter.Limit, err = queryInt(query.Get("limit")); err != nil {
+		h.writeError(w, errors.New(errors.ErrValidation, "invalid limit"))
+		return
+	}
+	if filter.Offset, err = queryInt(query.Get("offset")); err != nil {
+		h.writeError(w, errors.New(errors.ErrValidation, "invalid offset"))
+		return
+	}
+
+	tenants, err := h.service.ListTenants(r.Context(), filter)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"tenants": tenants})
+}
+
+// handleTenant обслуживает /tenants/{id}, /tenants/{id}/suspend, /tenants/{id}/unsuspend
+// и /tenants/{id}/impersonate
+func (h *AdminHandler) handleTenant(w http.ResponseWriter, r *http.Request, operator string) {
+	tenantID, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, adminPrefix+"tenants/"), "/")
+	if tenantID == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch action {
+	case "":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		usage, err := h.service.GetTenant(r.Context(), tenantID)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, usage)
+	case "suspend", "unsuspend":
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req reasonRequest
+		if !h.decode(w, r, &req) {
+			return
+		}
+
+		change := h.service.SuspendTenant
+		if action == "unsuspend" {
+			change = h.service.UnsuspendTenant
+		}
+		usage, err := change(r.Context(), tenantID, operator, req.Reason)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, usage)
+	case "impersonate":
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req impersonateRequest
+		if !h.decode(w, r, &req) {
+			return
+		}
+		if req.TTLSeconds < 0 {
+			h.writeError(w, errors.New(errors.ErrValidation, "ttl_seconds must not be negative"))
+			return
+		}
+		impersonation, err := h.service.Impersonate(r.Context(), tenantID, operator, req.Reason, time.Duration(req.TTLSeconds)*time.Second)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, impersonation)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// impersonateRequest тело POST /tenants/{id}/impersonate
+type impersonateRequest struct {
+	Reason string `json:"reason"`
+	// TTLSeconds срок действия токена; 0 - срок по умолчанию
+	TTLSeconds int `json:"ttl_seconds"`
+}
+
+// handleIncident обслуживает /incidents/{id}/resolve
+func (h *AdminHandler) handleIncident(w http.ResponseWriter, r *http.Request, operator string) {
+	incidentID, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, adminPrefix+"incidents/"), "/")
+	if incidentID == "" || action != "resolve" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req reasonRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+	resolution, err := h.service.ForceResolveIncident(r.Context(), incidentID, operator, req.Reason)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resolution)
+}
+
+// handleQueues возвращает глубину очередей RabbitMQ
+func (h *AdminHandler) handleQueues(w http.ResponseWriter, r *http.Request, operator string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	queues, err := h.service.QueueDepths(r.Context())
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"queues": queues})
+}
+
+// handleFeatureFlags возвращает все переключатели функциональности
+func (h *AdminHandler) handleFeatureFlags(w http.ResponseWriter, r *http.Request, operator string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flags, err := h.service.ListFeatureFlags(r.Context())
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"feature_flags": flags})
+}
+
+// featureFlagRequest тело PUT /feature-flags/{name}
+type featureFlagRequest struct {
+	Enabled     *bool  `json:"enabled"`
+	TenantID    string `json:"tenant_id"`
+	Description string `json:"description"`
+}
+
+// handleFeatureFlag устанавливает (PUT) или удаляет (DELETE) флаг. Переопределение тенанта
+// удаляется с параметром ?tenant_id=
+func (h *AdminHandler) handleFeatureFlag(w http.ResponseWriter, r *http.Request, operator string) {
+	name := strings.TrimPrefix(r.URL.Path, adminPrefix+"feature-flags/")
+	if name == "" || strings.Contains(name, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		var req featureFlagRequest
+		if !h.decode(w, r, &req) {
+			return
+		}
+		if req.Enabled == nil {
+			h.writeError(w, errors.New(errors.ErrValidation, "enabled is required"))
+			return
+		}
+		flag, err := h.service.SetFeatureFlag(r.Context(), &domain.FeatureFlag{
+			Name:        name,
+			TenantID:    req.TenantID,
+			Enabled:     *req.Enabled,
+			Description: req.Description,
+			UpdatedBy:   operator,
+		})
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, flag)
+	case http.MethodDelete:
+		if err := h.service.DeleteFeatureFlag(r.Context(), name, r.URL.Query().Get("tenant_id"), operator); err != nil {
+			h.writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// reasonRequest тело операций, требующих указания причины
+type reasonRequest struct {
+	Reason string `json:"reason"`
+}
+
+// decode разбирает JSON тело запроса; пустое тело допустимо
+func (h *AdminHandler) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.ContentLength == 0 {
+		return true
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(v); err != nil {
+		h.writeError(w, errors.New(errors.ErrValidation, "invalid request body"))
+		return false
+	}
+	return true
+}
+
+// writeError отправляет ошибку сервиса с соответствующим HTTP статусом
+func (h *AdminHandler) writeError(w http.ResponseWriter, err error) {
+	serviceErr := toServiceError(err)
+	if serviceErr.Code == errors.ErrInternal {
+		h.logger.Error("Admin request failed", logger.Error(err))
+	}
+	writeServiceError(w, serviceErr)
+}
+
+func queryInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
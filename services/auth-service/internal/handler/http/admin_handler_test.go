@@ -0,0 +1,229 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// memoryAdminRepository минимальный репозиторий admin API с одним тенантом и инцидентом
+type memoryAdminRepository struct {
+	tenant   *domain.TenantUsage
+	resolved map[string]bool
+	flags    map[string]*domain.FeatureFlag
+}
+
+func (r *memoryAdminRepository) ListTenantUsage(ctx context.Context, filter domain.TenantUsageFilter) ([]*domain.TenantUsage, error) {
+	return []*domain.TenantUsage{r.tenant}, nil
+}
+
+func (r *memoryAdminRepository) GetTenantUsage(ctx context.Context, tenantID string) (*domain.TenantUsage, error) {
+	if tenantID != r.tenant.TenantID {
+		return nil, repository.ErrTenantNotFound
+	}
+	return r.tenant, nil
+}
+
+func (r *memoryAdminRepository) SetTenantStatus(ctx context.Context, tenantID, from, to string) error {
+	if tenantID != r.tenant.TenantID {
+		return repository.ErrTenantNotFound
+	}
+	if r.tenant.Status != from {
+		return repository.ErrTenantStatusMismatch
+	}
+	r.tenant.Status = to
+	return nil
+}
+
+func (r *memoryAdminRepository) ResolveIncident(ctx context.Context, resolution *domain.IncidentResolution) error {
+	if resolution.IncidentID != "incident-1" {
+		return repository.ErrIncidentNotFound
+	}
+	if r.resolved[resolution.IncidentID] {
+		return repository.ErrIncidentResolved
+	}
+	r.resolved[resolution.IncidentID] = true
+	return nil
+}
+
+func (r *memoryAdminRepository) ListFeatureFlags(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	var flags []*domain.FeatureFlag
+	for _, flag := range r.flags {
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+func (r *memoryAdminRepository) UpsertFeatureFlag(ctx context.Context, flag *domain.FeatureFlag) error {
+	r.flags[flag.Name] = flag
+	return nil
+}
+
+func (r *memoryAdminRepository) DeleteFeatureFlag(ctx context.Context, name, tenantID string) error {
+	if _, ok := r.flags[name]; !ok {
+		return repository.ErrFeatureFlagNotFound
+	}
+	delete(r.flags, name)
+	return nil
+}
+
+func newAdminTestMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	repo := &memoryAdminRepository{
+		tenant:   &domain.TenantUsage{TenantID: "tenant-1", Name: "Acme", Status: domain.TenantStatusActive},
+		resolved: make(map[string]bool),
+		flags:    make(map[string]*domain.FeatureFlag),
+	}
+	operators, err := ParseOperatorTokens("alice:secret-alice, bob:secret-bob")
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	NewAdminHandler(service.NewAdminService(repo, testLogger), operators, testLogger).RegisterRoutes(mux)
+	return mux
+}
+
+func serveAdmin(mux *http.ServeMux, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestParseOperatorTokens(t *testing.T) {
+	operators, err := ParseOperatorTokens("alice:one,bob:two")
+	require.NoError(t, err)
+
+	operator, ok := operators.Authenticate("two")
+	assert.True(t, ok)
+	assert.Equal(t, "bob", operator)
+	_, ok = operators.Authenticate("three")
+	assert.False(t, ok)
+
+	for _, value := range []string{"", "alice", "alice:", ":token", "alice:one,bob:one"} {
+		_, err := ParseOperatorTokens(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestAdminHandler_Authorization(t *testing.T) {
+	mux := newAdminTestMux(t)
+
+	assert.Equal(t, http.StatusUnauthorized, serveAdmin(mux, http.MethodGet, "/admin/v1/tenants", "", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serveAdmin(mux, http.MethodGet, "/admin/v1/tenants", "secret", "").Code)
+	assert.Equal(t, http.StatusOK, serveAdmin(mux, http.MethodGet, "/admin/v1/tenants", "secret-bob", "").Code)
+}
+
+func TestAdminHandler_Tenants(t *testing.T) {
+	mux := newAdminTestMux(t)
+
+	recorder := serveAdmin(mux, http.MethodGet, "/admin/v1/tenants?status=active&limit=10", "secret-alice", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var list struct {
+		Tenants []domain.TenantUsage `json:"tenants"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &list))
+	require.Len(t, list.Tenants, 1)
+	assert.Equal(t, "Acme", list.Tenants[0].Name)
+
+	assert.Equal(t, http.StatusBadRequest, serveAdmin(mux, http.MethodGet, "/admin/v1/tenants?limit=ten", "secret-alice", "").Code)
+	assert.Equal(t, http.StatusNotFound, serveAdmin(mux, http.MethodGet, "/admin/v1/tenants/tenant-2", "secret-alice", "").Code)
+
+	recorder = serveAdmin(mux, http.MethodPost, "/admin/v1/tenants/tenant-1/suspend", "secret-alice", `{"reason":"unpaid invoices"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var tenant domain.TenantUsage
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &tenant))
+	assert.Equal(t, domain.TenantStatusSuspended, tenant.Status)
+
+	assert.Equal(t, http.StatusConflict, serveAdmin(mux, http.MethodPost, "/admin/v1/tenants/tenant-1/suspend", "secret-alice", "").Code)
+	assert.Equal(t, http.StatusOK, serveAdmin(mux, http.MethodPost, "/admin/v1/tenants/tenant-1/unsuspend", "secret-alice", "").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serveAdmin(mux, http.MethodGet, "/admin/v1/tenants/tenant-1/suspend", "secret-alice", "").Code)
+	assert.Equal(t, http.StatusNotFound, serveAdmin(mux, http.MethodPost, "/admin/v1/tenants/tenant-1/archive", "secret-alice", "").Code)
+}
+
+func TestAdminHandler_ResolveIncident(t *testing.T) {
+	mux := newAdminTestMux(t)
+
+	assert.Equal(t, http.StatusBadRequest, serveAdmin(mux, http.MethodPost, "/admin/v1/incidents/incident-1/resolve", "secret-alice", "").Code)
+	assert.Equal(t, http.StatusBadRequest, serveAdmin(mux, http.MethodPost, "/admin/v1/incidents/incident-1/resolve", "secret-alice", "{").Code)
+
+	recorder := serveAdmin(mux, http.MethodPost, "/admin/v1/incidents/incident-1/resolve", "secret-alice", `{"reason":"stuck after check deletion"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var resolution domain.IncidentResolution
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resolution))
+	assert.Equal(t, "alice", resolution.Operator)
+
+	assert.Equal(t, http.StatusConflict, serveAdmin(mux, http.MethodPost, "/admin/v1/incidents/incident-1/resolve", "secret-alice", `{"reason":"again"}`).Code)
+	assert.Equal(t, http.StatusNotFound, serveAdmin(mux, http.MethodPost, "/admin/v1/incidents/incident-9/resolve", "secret-alice", `{"reason":"x"}`).Code)
+}
+
+func TestAdminHandler_QueuesWithoutMonitor(t *testing.T) {
+	mux := newAdminTestMux(t)
+
+	assert.Equal(t, http.StatusNotFound, serveAdmin(mux, http.MethodGet, "/admin/v1/queues", "secret-alice", "").Code)
+}
+
+func TestAdminHandler_FeatureFlags(t *testing.T) {
+	mux := newAdminTestMux(t)
+
+	assert.Equal(t, http.StatusBadRequest, serveAdmin(mux, http.MethodPut, "/admin/v1/feature-flags/check.dns", "secret-alice", `{}`).Code)
+
+	recorder := serveAdmin(mux, http.MethodPut, "/admin/v1/feature-flags/check.dns", "secret-alice", `{"enabled":true,"description":"DNS checker"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var flag domain.FeatureFlag
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &flag))
+	assert.True(t, flag.Enabled)
+	assert.Equal(t, "alice", flag.UpdatedBy)
+
+	recorder = serveAdmin(mux, http.MethodGet, "/admin/v1/feature-flags", "secret-alice", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `"name":"check.dns"`)
+
+	assert.Equal(t, http.StatusNoContent, serveAdmin(mux, http.MethodDelete, "/admin/v1/feature-flags/check.dns", "secret-alice", "").Code)
+	assert.Equal(t, http.StatusNotFound, serveAdmin(mux, http.MethodDelete, "/admin/v1/feature-flags/check.dns", "secret-alice", "").Code)
+}
+
+func TestAdminHandler_Impersonate(t *testing.T) {
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+	repo := &memoryAdminRepository{tenant: &domain.TenantUsage{TenantID: "tenant-1", Name: "Acme", Status: domain.TenantStatusActive}}
+	operators, err := ParseOperatorTokens("alice:secret-alice,bob:secret-bob")
+	require.NoError(t, err)
+	adminService := service.NewAdminService(repo, testLogger).
+		WithImpersonation(service.ImpersonationSignerFunc(func(impersonation *domain.Impersonation) (string, error) {
+			return "token-" + impersonation.TenantID, nil
+		}), "alice")
+	mux := http.NewServeMux()
+	NewAdminHandler(adminService, operators, testLogger).RegisterRoutes(mux)
+
+	path := "/admin/v1/tenants/tenant-1/impersonate"
+	assert.Equal(t, http.StatusForbidden, serveAdmin(mux, http.MethodPost, path, "secret-bob", `{"reason":"ticket 42"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveAdmin(mux, http.MethodPost, path, "secret-alice", `{}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serveAdmin(mux, http.MethodPost, path, "secret-alice", `{"reason":"ticket 42","ttl_seconds":-1}`).Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serveAdmin(mux, http.MethodGet, path, "secret-alice", "").Code)
+
+	recorder := serveAdmin(mux, http.MethodPost, path, "secret-alice", `{"reason":"ticket 42","ttl_seconds":600}`)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	var impersonation domain.Impersonation
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &impersonation))
+	assert.Equal(t, "token-tenant-1", impersonation.Token)
+	assert.Equal(t, "ticket 42", impersonation.Reason)
+	assert.Equal(t, 10*time.Minute, impersonation.ExpiresAt.Sub(impersonation.IssuedAt))
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// APIKeyHandler HTTP API ключей: выпуск ключей администраторами тенанта и проверка ключей,
+// которую выполняет api-gateway для запросов с заголовком "APIKey key:secret"
+type APIKeyHandler struct {
+	service *service.APIKeyService
+	tokens  TokenValidator
+	logger  logger.Logger
+}
+
+// NewAPIKeyHandler создает обработчик
+func NewAPIKeyHandler(service *service.APIKeyService, tokens TokenValidator, logger logger.Logger) *APIKeyHandler {
+	return &APIKeyHandler{service: service, tokens: tokens, logger: logger}
+}
+
+// RegisterRoutes регистрирует HTTP маршруты
+func (h *APIKeyHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/auth/api-keys", h.handleCreate)
+	mux.HandleFunc("/api/v1/auth/validate-api-key", h.handleValidate)
+}
+
+// handleCreate выпускает ключ тенанта администратора. Секрет возвращается только в этом ответе
+func (h *APIKeyHandler) handleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	claims, err := authenticateTenantAdmin(r, h.tokens)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	var req struct {
+		Name       string   `json:"name"`
+		AllowedIPs []string `json:"allowed_ips"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, errors.New(errors.ErrValidation, "invalid request body"))
+		return
+	}
+
+	pair, err := h.service.CreateAPIKey(r.Context(), claims.TenantID, req.Name, req.AllowedIPs...)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"key":         pair.Key,
+		"secret":      pair.Secret,
+		"name":        req.Name,
+		"tenant_id":   claims.TenantID,
+		"allowed_ips": req.AllowedIPs,
+	})
+}
+
+// handleValidate проверяет пару key/secret, предъявленную клиентом с адреса client_ip
+func (h *APIKeyHandler) handleValidate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		Key      string `json:"key"`
+		Secret   string `json:"secret"`
+		ClientIP string `json:"client_ip"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, errors.New(errors.ErrValidation, "invalid request body"))
+		return
+	}
+
+	claims, err := h.service.ValidateAPIKey(r.Context(), req.Key, req.Secret, req.ClientIP)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"tenant_id": claims.TenantID,
+		"key_id":    claims.KeyID,
+		"is_valid":  true,
+	})
+}
+
+// writeError отправляет ошибку сервиса с соответствующим HTTP статусом
+func (h *APIKeyHandler) writeError(w http.ResponseWriter, err error) {
+	serviceErr := toServiceError(err)
+	if serviceErr.Code == errors.ErrInternal {
+		h.logger.Error("API key request failed", logger.Error(err))
+	}
+	writeServiceError(w, serviceErr)
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// memoryAPIKeyRepository хранит ключи в памяти по префиксу
+type memoryAPIKeyRepository struct {
+	keys map[string]*domain.APIKey
+}
+
+func (r *memoryAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	key.ID = "key-" + key.KeyPrefix
+	r.keys[key.KeyPrefix] = key
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) FindByID(ctx context.Context, id string) (*domain.APIKey, error) {
+	for _, key := range r.keys {
+		if key.ID == id {
+			return key, nil
+		}
+	}
+	return nil, repository.ErrAPIKeyNotFound
+}
+
+func (r *memoryAPIKeyRepository) FindByPrefix(ctx context.Context, keyPrefix string) (*domain.APIKey, error) {
+	if key, ok := r.keys[keyPrefix]; ok {
+		return key, nil
+	}
+	return nil, repository.ErrAPIKeyNotFound
+}
+
+func (r *memoryAPIKeyRepository) ListByTenant(ctx context.Context, tenantID string) ([]*domain.APIKey, error) {
+	return nil, nil
+}
+
+func (r *memoryAPIKeyRepository) Update(ctx context.Context, key *domain.APIKey) error {
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func newAPIKeyTestMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	repo := &memoryAPIKeyRepository{keys: make(map[string]*domain.APIKey)}
+	mux := http.NewServeMux()
+	NewAPIKeyHandler(service.NewAPIKeyService(repo, testLogger), staticTokens, testLogger).RegisterRoutes(mux)
+	return mux
+}
+
+func postJSON(mux *http.ServeMux, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestAPIKeyHandler_CreateAndValidate(t *testing.T) {
+	mux := newAPIKeyTestMux(t)
+
+	assert.Equal(t, http.StatusForbidden, postJSON(mux, "/api/v1/auth/api-keys", "member", `{"name":"ci"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, postJSON(mux, "/api/v1/auth/api-keys", "admin", `{"name":"ci","allowed_ips":["not-an-ip"]}`).Code)
+
+	recorder := postJSON(mux, "/api/v1/auth/api-keys", "admin", `{"name":"ci","allowed_ips":["10.0.0.0/8"]}`)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	var created struct {
+		Key    string `json:"key"`
+		Secret string `json:"secret"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &created))
+
+	validate := func(secret, clientIP string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(map[string]string{"key": created.Key, "secret": secret, "client_ip": clientIP})
+		require.NoError(t, err)
+		return postJSON(mux, "/api/v1/auth/validate-api-key", "", string(body))
+	}
+
+	recorder = validate(created.Secret, "10.1.2.3")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var validated map[string]interface{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &validated))
+	assert.Equal(t, "tenant-1", validated["tenant_id"])
+	assert.Equal(t, true, validated["is_valid"])
+
+	assert.Equal(t, http.StatusUnauthorized, validate("wrong-secret", "10.1.2.3").Code)
+	assert.Equal(t, http.StatusForbidden, validate(created.Secret, "192.168.1.1").Code)
+}
@@ -0,0 +1,197 @@
+package http
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/pkg/jwt"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// TokenValidator проверяет access токен и возвращает его claims
+type TokenValidator interface {
+	ValidateAccessToken(token string) (*jwt.TokenClaims, error)
+}
+
+// TokenValidatorFunc адаптер функции к TokenValidator
+type TokenValidatorFunc func(token string) (*jwt.TokenClaims, error)
+
+// ValidateAccessToken вызывает f(token)
+func (f TokenValidatorFunc) ValidateAccessToken(token string) (*jwt.TokenClaims, error) {
+	return f(token)
+}
+
+// OffboardingHandler HTTP API экспорта данных и удаления тенанта. Тенант определяется по
+// access токену, операции доступны только администраторам тенанта
+type OffboardingHandler struct {
+	service *service.OffboardingService
+	tokens  TokenValidator
+	logger  logger.Logger
+}
+
+// NewOffboardingHandler создает обработчик
+func NewOffboardingHandler(service *service.OffboardingService, tokens TokenValidator, logger logger.Logger) *OffboardingHandler {
+	return &OffboardingHandler{service: service, tokens: tokens, logger: logger}
+}
+
+// RegisterRoutes регистрирует HTTP маршруты
+func (h *OffboardingHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/tenant/export", h.handleExport)
+	mux.HandleFunc("/api/v1/tenant/deletion", h.handleDeletion)
+}
+
+// handleExport отдает zip архив с данными тенанта
+func (h *OffboardingHandler) handleExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	claims, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	// Архив крупного тенанта передается дольше общего WriteTimeout сервера
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+		h.logger.Debug("Failed to reset write deadline for export", logger.Error(err))
+	}
+
+	filename := fmt.Sprintf("tenant-%s-%s.zip", claims.TenantID, time.Now().UTC().Format("20060102"))
+	archive := &lazyArchiveWriter{ResponseWriter: w, filename: filename}
+
+	if _, err := h.service.Export(r.Context(), claims.TenantID, archive); err != nil {
+		// После начала передачи архива статус изменить нельзя, клиент получит оборванный архив
+		if !archive.started {
+			h.writeError(w, err)
+			return
+		}
+		h.logger.Error("Tenant export interrupted",
+			logger.String("tenant_id", claims.TenantID),
+			logger.Error(err),
+		)
+		return
+	}
+
+	h.logger.Info("Tenant export downloaded",
+		logger.String("tenant_id", claims.TenantID),
+		logger.String("user_id", claims.UserID),
+	)
+}
+
+// handleDeletion показывает (GET), планирует (POST) и отменяет (DELETE) удаление тенанта
+func (h *OffboardingHandler) handleDeletion(w http.ResponseWriter, r *http.Request) {
+	claims, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		deletion, err := h.service.DeletionStatus(r.Context(), claims.TenantID)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, deletion)
+	case http.MethodPost:
+		deletion, err := h.service.RequestDeletion(r.Context(), claims.TenantID, claims.UserID)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusAccepted, deletion)
+	case http.MethodDelete:
+		if err := h.service.CancelDeletion(r.Context(), claims.TenantID); err != nil {
+			h.writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// authenticate проверяет Bearer токен и права администратора тенанта
+func (h *OffboardingHandler) authenticate(w http.ResponseWriter, r *http.Request) (*jwt.TokenClaims, bool) {
+	claims, err := authenticateTenantAdmin(r, h.tokens)
+	if err != nil {
+		h.writeError(w, err)
+		return nil, false
+	}
+	return claims, true
+}
+
+// authenticateTenantAdmin проверяет Bearer токен запроса и права администратора тенанта
+func authenticateTenantAdmin(r *http.Request, tokens TokenValidator) (*jwt.TokenClaims, error) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || token == r.Header.Get("Authorization") {
+		return nil, errors.New(errors.ErrUnauthorized, "bearer token is required")
+	}
+
+	claims, err := tokens.ValidateAccessToken(token)
+	if err != nil || claims.TenantID == "" {
+		return nil, errors.New(errors.ErrUnauthorized, "invalid access token")
+	}
+	if !claims.IsAdmin {
+		return nil, errors.New(errors.ErrForbidden, "tenant administrator role is required")
+	}
+	return claims, nil
+}
+
+// writeError отправляет ошибку сервиса с соответствующим HTTP статусом
+func (h *OffboardingHandler) writeError(w http.ResponseWriter, err error) {
+	serviceErr := toServiceError(err)
+	if serviceErr.Code == errors.ErrInternal {
+		h.logger.Error("Offboarding request failed", logger.Error(err))
+	}
+	writeServiceError(w, serviceErr)
+}
+
+// toServiceError приводит ошибку к ошибке сервиса; неизвестные ошибки считаются внутренними
+func toServiceError(err error) *errors.Error {
+	var serviceErr *errors.Error
+	if !stderrors.As(err, &serviceErr) {
+		serviceErr = errors.Wrap(err, errors.ErrInternal, "internal error")
+	}
+	return serviceErr
+}
+
+// writeServiceError отправляет ошибку в JSON с HTTP статусом, соответствующим ее коду
+func writeServiceError(w http.ResponseWriter, serviceErr *errors.Error) {
+	writeJSON(w, serviceErr.HTTPStatus(), map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    serviceErr.Code,
+			"message": serviceErr.Message,
+		},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// lazyArchiveWriter выставляет заголовки архива при первой записи, чтобы ошибки до начала
+// передачи можно было вернуть обычным JSON ответом
+type lazyArchiveWriter struct {
+	http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (w *lazyArchiveWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.filename))
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(p)
+}
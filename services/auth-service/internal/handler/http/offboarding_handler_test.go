@@ -0,0 +1,142 @@
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/pkg/jwt"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// memoryOffboardingRepository минимальный репозиторий с одним тенантом
+type memoryOffboardingRepository struct {
+	tenants   map[string]bool
+	deletions map[string]*domain.TenantDeletion
+}
+
+func (r *memoryOffboardingRepository) ExportSection(ctx context.Context, tenantID string, section domain.ExportSection, emit func(record []byte) error) error {
+	if section == domain.ExportSectionTenant {
+		return emit([]byte(fmt.Sprintf(`{"id":%q}`, tenantID)))
+	}
+	return nil
+}
+
+func (r *memoryOffboardingRepository) ScheduleDeletion(ctx context.Context, deletion *domain.TenantDeletion) error {
+	r.deletions[deletion.TenantID] = deletion
+	return nil
+}
+
+func (r *memoryOffboardingRepository) CancelDeletion(ctx context.Context, tenantID string) error {
+	delete(r.deletions, tenantID)
+	return nil
+}
+
+func (r *memoryOffboardingRepository) FindDeletion(ctx context.Context, tenantID string) (*domain.TenantDeletion, error) {
+	if !r.tenants[tenantID] {
+		return nil, repository.ErrTenantNotFound
+	}
+	return r.deletions[tenantID], nil
+}
+
+func (r *memoryOffboardingRepository) ListDueDeletions(ctx context.Context, now time.Time) ([]string, error) {
+	return nil, nil
+}
+
+func (r *memoryOffboardingRepository) Purge(ctx context.Context, tenantID string) error {
+	return nil
+}
+
+// staticTokens сопоставляет токены тестовым claims
+var staticTokens = TokenValidatorFunc(func(token string) (*jwt.TokenClaims, error) {
+	switch token {
+	case "admin":
+		return &jwt.TokenClaims{UserID: "user-1", TenantID: "tenant-1", IsAdmin: true}, nil
+	case "member":
+		return &jwt.TokenClaims{UserID: "user-2", TenantID: "tenant-1"}, nil
+	case "orphan":
+		return &jwt.TokenClaims{UserID: "user-3", TenantID: "tenant-gone", IsAdmin: true}, nil
+	}
+	return nil, fmt.Errorf("invalid token")
+})
+
+func newTestMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	repo := &memoryOffboardingRepository{
+		tenants:   map[string]bool{"tenant-1": true},
+		deletions: make(map[string]*domain.TenantDeletion),
+	}
+	mux := http.NewServeMux()
+	NewOffboardingHandler(service.NewOffboardingService(repo, testLogger), staticTokens, testLogger).RegisterRoutes(mux)
+	return mux
+}
+
+func serve(mux *http.ServeMux, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestOffboardingHandler_Authorization(t *testing.T) {
+	mux := newTestMux(t)
+
+	assert.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodGet, "/api/v1/tenant/export", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodGet, "/api/v1/tenant/export", "forged").Code)
+	assert.Equal(t, http.StatusForbidden, serve(mux, http.MethodPost, "/api/v1/tenant/deletion", "member").Code)
+}
+
+func TestOffboardingHandler_Export(t *testing.T) {
+	mux := newTestMux(t)
+
+	recorder := serve(mux, http.MethodGet, "/api/v1/tenant/export", "admin")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/zip", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Header().Get("Content-Disposition"), "tenant-tenant-1-")
+
+	body := recorder.Body.Bytes()
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	require.NoError(t, err)
+	assert.Len(t, archive.File, len(domain.ExportSections)+1)
+
+	recorder = serve(mux, http.MethodGet, "/api/v1/tenant/export", "orphan")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+}
+
+func TestOffboardingHandler_Deletion(t *testing.T) {
+	mux := newTestMux(t)
+
+	assert.Equal(t, http.StatusNotFound, serve(mux, http.MethodGet, "/api/v1/tenant/deletion", "admin").Code)
+
+	recorder := serve(mux, http.MethodPost, "/api/v1/tenant/deletion", "admin")
+	require.Equal(t, http.StatusAccepted, recorder.Code)
+	var deletion domain.TenantDeletion
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &deletion))
+	assert.Equal(t, "tenant-1", deletion.TenantID)
+	assert.Equal(t, service.DefaultDeletionGracePeriod, deletion.PurgeAt.Sub(deletion.RequestedAt))
+
+	assert.Equal(t, http.StatusConflict, serve(mux, http.MethodPost, "/api/v1/tenant/deletion", "admin").Code)
+	assert.Equal(t, http.StatusOK, serve(mux, http.MethodGet, "/api/v1/tenant/deletion", "admin").Code)
+	assert.Equal(t, http.StatusNoContent, serve(mux, http.MethodDelete, "/api/v1/tenant/deletion", "admin").Code)
+	assert.Equal(t, http.StatusNotFound, serve(mux, http.MethodDelete, "/api/v1/tenant/deletion", "admin").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(mux, http.MethodPut, "/api/v1/tenant/deletion", "admin").Code)
+}
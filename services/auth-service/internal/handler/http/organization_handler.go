@@ -0,0 +1,203 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/pkg/jwt"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// organizationsPrefix префикс маршрутов организаций
+const organizationsPrefix = "/api/v1/auth/orgs"
+
+// OrganizationHandler HTTP API организаций: создание организации из тенанта, тенанты-окружения,
+// участники с ролями организации и переключение между тенантами с выдачей нового токена
+type OrganizationHandler struct {
+	service *service.OrganizationService
+	tokens  TokenValidator
+	logger  logger.Logger
+}
+
+// NewOrganizationHandler создает обработчик
+func NewOrganizationHandler(service *service.OrganizationService, tokens TokenValidator, logger logger.Logger) *OrganizationHandler {
+	return &OrganizationHandler{service: service, tokens: tokens, logger: logger}
+}
+
+// RegisterRoutes регистрирует HTTP маршруты
+func (h *OrganizationHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc(organizationsPrefix, h.authenticated(h.handleOrganization))
+	mux.HandleFunc(organizationsPrefix+"/tenants", h.authenticated(h.handleTenants))
+	mux.HandleFunc(organizationsPrefix+"/switch", h.authenticated(h.handleSwitch))
+	mux.HandleFunc(organizationsPrefix+"/members", h.authenticated(h.handleMembers))
+	mux.HandleFunc(organizationsPrefix+"/members/", h.authenticated(h.handleMember))
+}
+
+// authenticated проверяет Bearer токен пользователя тенанта
+func (h *OrganizationHandler) authenticated(next func(w http.ResponseWriter, r *http.Request, claims *jwt.TokenClaims)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || token == r.Header.Get("Authorization") {
+			h.writeError(w, errors.New(errors.ErrUnauthorized, "bearer token is required"))
+			return
+		}
+		claims, err := h.tokens.ValidateAccessToken(token)
+		if err != nil || claims.UserID == "" || claims.TenantID == "" {
+			h.writeError(w, errors.New(errors.ErrUnauthorized, "invalid access token"))
+			return
+		}
+		next(w, r, claims)
+	}
+}
+
+// handleOrganization GET - организация пользователя и тенанты для переключателя,
+// POST - создание организации из текущего тенанта
+func (h *OrganizationHandler) handleOrganization(w http.ResponseWriter, r *http.Request, claims *jwt.TokenClaims) {
+	switch r.Method {
+	case http.MethodGet:
+		view, err := h.service.Current(r.Context(), claims.UserID)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, view)
+	case http.MethodPost:
+		var req struct {
+			Name         string `json:"name"`
+			Slug         string `json:"slug"`
+			BillingEmail string `json:"billing_email"`
+		}
+		if !h.decode(w, r, &req) {
+			return
+		}
+		org, err := h.service.Create(r.Context(), claims.UserID, claims.TenantID, claims.IsAdmin, req.Name, req.Slug, req.BillingEmail)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusCreated, org)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleTenants создает тенант в организации
+func (h *OrganizationHandler) handleTenants(w http.ResponseWriter, r *http.Request, claims *jwt.TokenClaims) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+		Slug string `json:"slug"`
+	}
+	if !h.decode(w, r, &req) {
+		return
+	}
+	tenant, err := h.service.CreateTenant(r.Context(), claims.UserID, req.Name, req.Slug)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, tenant)
+}
+
+// handleSwitch выдает access токен для другого тенанта организации
+func (h *OrganizationHandler) handleSwitch(w http.ResponseWriter, r *http.Request, claims *jwt.TokenClaims) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req struct {
+		TenantID string `json:"tenant_id"`
+	}
+	if !h.decode(w, r, &req) {
+		return
+	}
+	if req.TenantID == "" {
+		h.writeError(w, errors.New(errors.ErrValidation, "tenant_id is required"))
+		return
+	}
+	token, issued, err := h.service.SwitchTenant(r.Context(), claims.UserID, req.TenantID)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":    token,
+		"tenant_id":       issued.TenantID,
+		"is_admin":        issued.IsAdmin,
+		"organization_id": issued.OrganizationID,
+		"org_role":        issued.OrgRole,
+		"org_tenants":     issued.Tenants,
+		"expires_at":      issued.ExpiresAt,
+	})
+}
+
+// handleMembers GET - участники организации, POST - добавление участника или смена роли
+func (h *OrganizationHandler) handleMembers(w http.ResponseWriter, r *http.Request, claims *jwt.TokenClaims) {
+	switch r.Method {
+	case http.MethodGet:
+		members, err := h.service.ListMembers(r.Context(), claims.UserID)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"members": members})
+	case http.MethodPost:
+		var req struct {
+			UserID string `json:"user_id"`
+			Role   string `json:"role"`
+		}
+		if !h.decode(w, r, &req) {
+			return
+		}
+		member, err := h.service.SetMember(r.Context(), claims.UserID, req.UserID, req.Role)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, member)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleMember DELETE исключает участника из организации
+func (h *OrganizationHandler) handleMember(w http.ResponseWriter, r *http.Request, claims *jwt.TokenClaims) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	userID := strings.TrimPrefix(r.URL.Path, organizationsPrefix+"/members/")
+	if userID == "" || strings.Contains(userID, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	if err := h.service.RemoveMember(r.Context(), claims.UserID, userID); err != nil {
+		h.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode разбирает JSON тело запроса
+func (h *OrganizationHandler) decode(w http.ResponseWriter, r *http.Request, dst interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+		h.writeError(w, errors.New(errors.ErrValidation, "invalid request body"))
+		return false
+	}
+	return true
+}
+
+// writeError отправляет ошибку сервиса с соответствующим HTTP статусом
+func (h *OrganizationHandler) writeError(w http.ResponseWriter, err error) {
+	serviceErr := toServiceError(err)
+	if serviceErr.Code == errors.ErrInternal {
+		h.logger.Error("Organization request failed", logger.Error(err))
+	}
+	writeServiceError(w, serviceErr)
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// memoryOrganizationRepository организация acme с тенантами prod и staging
+type memoryOrganizationRepository struct {
+	members map[string]*domain.OrganizationMember
+}
+
+func (r *memoryOrganizationRepository) Create(ctx context.Context, org *domain.Organization, owner *domain.OrganizationMember) error {
+	if _, ok := r.members[owner.UserID]; ok {
+		return repository.ErrOrganizationConflict
+	}
+	r.members[owner.UserID] = owner
+	return nil
+}
+
+func (r *memoryOrganizationRepository) FindByID(ctx context.Context, id string) (*domain.Organization, error) {
+	return &domain.Organization{ID: id, Name: "Acme", Slug: "acme", Plan: "pro"}, nil
+}
+
+func (r *memoryOrganizationRepository) FindMembership(ctx context.Context, userID string) (*domain.OrganizationMember, error) {
+	member, ok := r.members[userID]
+	if !ok {
+		return nil, repository.ErrOrganizationMemberNotFound
+	}
+	return member, nil
+}
+
+func (r *memoryOrganizationRepository) ListTenants(ctx context.Context, orgID string) ([]*domain.Tenant, error) {
+	return []*domain.Tenant{{ID: "tenant-1", Slug: "prod"}, {ID: "tenant-2", Slug: "staging"}}, nil
+}
+
+func (r *memoryOrganizationRepository) CreateTenant(ctx context.Context, orgID string, tenant *domain.Tenant) error {
+	return nil
+}
+
+func (r *memoryOrganizationRepository) ListMembers(ctx context.Context, orgID string) ([]*domain.OrganizationMember, error) {
+	var members []*domain.OrganizationMember
+	for _, member := range r.members {
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+func (r *memoryOrganizationRepository) SaveMember(ctx context.Context, member *domain.OrganizationMember) error {
+	r.members[member.UserID] = member
+	return nil
+}
+
+func (r *memoryOrganizationRepository) RemoveMember(ctx context.Context, orgID, userID string) error {
+	if _, ok := r.members[userID]; !ok {
+		return repository.ErrOrganizationMemberNotFound
+	}
+	delete(r.members, userID)
+	return nil
+}
+
+// memoryUserRepository пользователи тенанта tenant-1 для тестов организаций
+type memoryUserRepository map[string]*domain.User
+
+func (r memoryUserRepository) Create(ctx context.Context, user *domain.User) error { return nil }
+
+func (r memoryUserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	user, ok := r[id]
+	if !ok {
+		return nil, repository.ErrTenantNotFound
+	}
+	return user, nil
+}
+
+func (r memoryUserRepository) FindByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return nil, repository.ErrTenantNotFound
+}
+
+func (r memoryUserRepository) Update(ctx context.Context, user *domain.User) error { return nil }
+
+func (r memoryUserRepository) Delete(ctx context.Context, id string) error { return nil }
+
+func (r memoryUserRepository) ListAdmins(ctx context.Context, tenantID string) ([]*domain.User, error) {
+	return nil, nil
+}
+
+func newOrganizationMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "error", "test-service", false)
+	require.NoError(t, err)
+
+	repo := &memoryOrganizationRepository{members: map[string]*domain.OrganizationMember{}}
+	users := memoryUserRepository{
+		"user-1": {ID: "user-1", TenantID: "tenant-1", Email: "admin@acme.example", IsActive: true, IsAdmin: true},
+		"user-2": {ID: "user-2", TenantID: "tenant-1", Email: "dev@acme.example", IsActive: true},
+	}
+	signer := service.OrgTokenSignerFunc(func(token *domain.OrgToken) (string, error) {
+		return "token-for-" + token.TenantID, nil
+	})
+	mux := http.NewServeMux()
+	NewOrganizationHandler(service.NewOrganizationService(repo, users, signer, testLogger), staticTokens, testLogger).RegisterRoutes(mux)
+	return mux
+}
+
+func serveJSON(mux *http.ServeMux, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	payload, _ := json.Marshal(body)
+	req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+	req.Header.Set("Authorization", "Bearer "+token)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestOrganizationHandler(t *testing.T) {
+	mux := newOrganizationMux(t)
+
+	assert.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodGet, "/api/v1/auth/orgs", "").Code)
+	assert.Equal(t, http.StatusNotFound, serve(mux, http.MethodGet, "/api/v1/auth/orgs", "admin").Code)
+
+	create := map[string]string{"name": "Acme", "slug": "acme"}
+	assert.Equal(t, http.StatusForbidden, serveJSON(mux, http.MethodPost, "/api/v1/auth/orgs", "member", create).Code)
+	require.Equal(t, http.StatusCreated, serveJSON(mux, http.MethodPost, "/api/v1/auth/orgs", "admin", create).Code)
+
+	recorder := serve(mux, http.MethodGet, "/api/v1/auth/orgs", "admin")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var view domain.OrganizationView
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &view))
+	assert.Equal(t, domain.OrgRoleOwner, view.Role)
+	assert.Len(t, view.Tenants, 2)
+
+	recorder = serveJSON(mux, http.MethodPost, "/api/v1/auth/orgs/switch", "admin", map[string]string{"tenant_id": "tenant-2"})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var switched struct {
+		AccessToken string   `json:"access_token"`
+		TenantID    string   `json:"tenant_id"`
+		IsAdmin     bool     `json:"is_admin"`
+		OrgTenants  []string `json:"org_tenants"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &switched))
+	assert.Equal(t, "token-for-tenant-2", switched.AccessToken)
+	assert.True(t, switched.IsAdmin)
+	assert.Equal(t, []string{"tenant-1", "tenant-2"}, switched.OrgTenants)
+
+	assert.Equal(t, http.StatusForbidden,
+		serveJSON(mux, http.MethodPost, "/api/v1/auth/orgs/switch", "admin", map[string]string{"tenant_id": "tenant-9"}).Code)
+	assert.Equal(t, http.StatusNotFound,
+		serveJSON(mux, http.MethodPost, "/api/v1/auth/orgs/switch", "member", map[string]string{"tenant_id": "tenant-2"}).Code)
+
+	require.Equal(t, http.StatusOK,
+		serveJSON(mux, http.MethodPost, "/api/v1/auth/orgs/members", "admin", map[string]string{"user_id": "user-2", "role": "member"}).Code)
+	assert.Equal(t, http.StatusForbidden, serve(mux, http.MethodDelete, "/api/v1/auth/orgs/members/user-1", "member").Code)
+	assert.Equal(t, http.StatusNoContent, serve(mux, http.MethodDelete, "/api/v1/auth/orgs/members/user-2", "admin").Code)
+	assert.Equal(t, http.StatusNotFound, serve(mux, http.MethodDelete, "/api/v1/auth/orgs/members/user-2", "admin").Code)
+}
@@ -12,7 +12,8 @@ import (
 // RateLimitMiddleware создает middleware для ограничения частоты запросов
 // Поддерживает лимиты по IP адресу и по пользователю
 // Использует sliding window алгоритм из pkg/ratelimit
-func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window time.Duration, byUser bool, log logger.Logger) func(http.Handler) http.Handler {
+// IP клиента берется из заголовков пересылки, только если запрос пришел от trustedProxies
+func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window time.Duration, byUser bool, trustedProxies *ratelimit.TrustedProxies, log logger.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			var key string
@@ -28,7 +29,7 @@ func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window ti
 						logger.String("path", r.URL.Path))
 				} else {
 					// Если пользователь не авторизован, используем IP
-					key = "ip:" + getIP(r)
+					key = "ip:" + trustedProxies.ClientIP(r)
 					log.Debug("Rate limiting by IP (user not authenticated)",
 						logger.String("key", key),
 						logger.String("method", r.Method),
@@ -36,7 +37,7 @@ func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window ti
 				}
 			} else {
 				// Используем IP адрес как ключ
-				key = "ip:" + getIP(r)
+				key = "ip:" + trustedProxies.ClientIP(r)
 				log.Debug("Rate limiting by IP",
 					logger.String("key", key),
 					logger.String("method", r.Method),
@@ -78,21 +79,3 @@ func RateLimitMiddleware(rateLimiter ratelimit.RateLimiter, limit int, window ti
 		})
 	}
 }
-
-// getIP извлекает IP адрес из запроса
-// Проверяет X-Forwarded-For и X-Real-IP заголовки
-// Возвращает REMOTE_ADDR если заголовки отсутствуют
-func getIP(r *http.Request) string {
-	// Проверяем X-Forwarded-For заголовок
-	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
-		return forwarded
-	}
-
-	// Проверяем X-Real-IP заголовок
-	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
-		return realIP
-	}
-
-	// Возвращаем REMOTE_ADDR как последний вариант
-	return r.RemoteAddr
-}
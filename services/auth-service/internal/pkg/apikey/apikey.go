@@ -1,7 +1,10 @@
 package apikey
 
 import (
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
 	"strings"
@@ -52,6 +55,71 @@ func ValidateKey(key, hash string) bool {
 	return err == nil
 }
 
+// PrefixLength длина открытого префикса ключа, по которому ключ ищется в базе. Префикс не
+// секретен и хранится открыто, поэтому поиск не требует детерминированного хеша ключа
+const PrefixLength = 16
+
+// credentialsHashScheme схема соленого хеша учетных данных ключа
+const credentialsHashScheme = "hmac-sha256"
+
+// credentialsSaltSize размер соли хеша учетных данных в байтах
+const credentialsSaltSize = 16
+
+// Prefix возвращает префикс ключа для поиска; ok=false для ключа короче префикса
+func Prefix(key string) (string, bool) {
+	if len(key) < PrefixLength {
+		return "", false
+	}
+	return key[:PrefixLength], true
+}
+
+// HashCredentials хеширует пару ключ/секрет HMAC-SHA256 со случайной солью ключа. Секреты
+// генерируются с высокой энтропией, поэтому медленный хеш паролей не нужен: перебор
+// невозможен, а проверка не нагружает сервис при каждом запросе с ключом
+func HashCredentials(key, secret string) (string, error) {
+	salt := make([]byte, credentialsSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return formatCredentialsHash(salt, credentialsDigest(salt, key, secret)), nil
+}
+
+// VerifyCredentials проверяет пару ключ/секрет по хешу HashCredentials. Дайджесты сравниваются
+// за постоянное время, чтобы время ответа не раскрывало совпадающую часть
+func VerifyCredentials(key, secret, hash string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 3 || parts[0] != credentialsHashScheme {
+		return false
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return false
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(credentialsDigest(salt, key, secret), expected) == 1
+}
+
+// DummyCredentialsHash хеш, с которым сравниваются учетные данные неизвестного ключа: проверка
+// занимает то же время, что и для существующего ключа
+var DummyCredentialsHash = formatCredentialsHash(make([]byte, credentialsSaltSize), make([]byte, sha256.Size))
+
+// credentialsDigest вычисляет HMAC-SHA256 пары ключ/секрет с солью
+func credentialsDigest(salt []byte, key, secret string) []byte {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(key))
+	mac.Write([]byte{':'})
+	mac.Write([]byte(secret))
+	return mac.Sum(nil)
+}
+
+// formatCredentialsHash кодирует соль и дайджест в строку "<схема>$<соль>$<дайджест>"
+func formatCredentialsHash(salt, digest []byte) string {
+	return credentialsHashScheme + "$" + base64.RawStdEncoding.EncodeToString(salt) + "$" + base64.RawStdEncoding.EncodeToString(digest)
+}
+
 // FormatKey форматирует ключ для отображения пользователю
 func FormatKey(key string) string {
 	// Для ключей с префиксом upk_
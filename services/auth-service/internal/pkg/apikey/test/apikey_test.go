@@ -321,3 +321,33 @@ func TestExtractKeyFromHeader_Realistic(t *testing.T) {
 	assert.Equal(t, keyPair.Secret, extractedSecret)
 	assert.True(t, apikey.ValidateFormat(extractedKey, extractedSecret))
 }
+
+func TestHashCredentials(t *testing.T) {
+	keyPair, err := apikey.GenerateKeyPair()
+	require.NoError(t, err)
+
+	hash, err := apikey.HashCredentials(keyPair.Key, keyPair.Secret)
+	require.NoError(t, err)
+	assert.NotContains(t, hash, keyPair.Secret)
+	assert.True(t, apikey.VerifyCredentials(keyPair.Key, keyPair.Secret, hash))
+
+	// Соль случайна: хеши одной пары различаются
+	other, err := apikey.HashCredentials(keyPair.Key, keyPair.Secret)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+	assert.True(t, apikey.VerifyCredentials(keyPair.Key, keyPair.Secret, other))
+
+	assert.False(t, apikey.VerifyCredentials(keyPair.Key, keyPair.Secret+"x", hash))
+	assert.False(t, apikey.VerifyCredentials(keyPair.Key+"x", keyPair.Secret, hash))
+	assert.False(t, apikey.VerifyCredentials(keyPair.Key, keyPair.Secret, apikey.DummyCredentialsHash))
+	assert.False(t, apikey.VerifyCredentials(keyPair.Key, keyPair.Secret, "not-a-hash"))
+}
+
+func TestPrefix(t *testing.T) {
+	prefix, ok := apikey.Prefix("upk_abcdefghijklmnopqrstuvwx")
+	assert.True(t, ok)
+	assert.Equal(t, "upk_abcdefghijkl", prefix)
+
+	_, ok = apikey.Prefix("upk_short")
+	assert.False(t, ok)
+}
@@ -14,6 +14,10 @@ type TokenClaims struct {
 	IsAdmin     bool     `json:"is_admin"`
 	TokenType   string   `json:"token_type"`  // Добавляем поле для различения типов токенов
 	Permissions []string `json:"permissions"` // Добавляем поле для прав доступа
+	// Организация пользователя, его роль в ней и тенанты для переключателя
+	OrganizationID string   `json:"org_id,omitempty"`
+	OrgRole        string   `json:"org_role,omitempty"`
+	OrgTenants     []string `json:"org_tenants,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -52,9 +56,15 @@ func (m *Manager) GenerateToken(userID, tenantID string, isAdmin bool) (string,
 	// Права по умолчанию для всех пользователей
 	defaultPermissions := []string{
 		"checks:read", "checks:write", "checks:delete",
-		"incidents:read", "incidents:write", "incidents:resolve",
+		"incidents:read", "incidents:ack", "incidents:resolve", "incidents:manage-policies",
 		"config:read", "config:write",
 		"metrics:read",
+		"notifications:read", "notifications:write",
+		"forge:write",
+	}
+	// Секреты каналов уведомлений в открытом виде видят только администраторы
+	if isAdmin {
+		defaultPermissions = append(defaultPermissions, "notifications:reveal-secrets")
 	}
 
 	return m.GenerateTokenWithPermissions(userID, tenantID, isAdmin, defaultPermissions)
@@ -80,9 +90,15 @@ func (m *Manager) GenerateAccessToken(userID, tenantID string, isAdmin bool) (st
 	// Права по умолчанию для всех пользователей
 	defaultPermissions := []string{
 		"checks:read", "checks:write", "checks:delete",
-		"incidents:read", "incidents:write", "incidents:resolve",
+		"incidents:read", "incidents:ack", "incidents:resolve", "incidents:manage-policies",
 		"config:read", "config:write",
 		"metrics:read",
+		"notifications:read", "notifications:write",
+		"forge:write",
+	}
+	// Секреты каналов уведомлений в открытом виде видят только администраторы
+	if isAdmin {
+		defaultPermissions = append(defaultPermissions, "notifications:reveal-secrets")
 	}
 
 	return m.GenerateAccessTokenWithPermissions(userID, tenantID, isAdmin, defaultPermissions)
@@ -113,9 +129,15 @@ func (m *Manager) GenerateRefreshToken(userID, tenantID string, isAdmin bool) (s
 	// Права по умолчанию для всех пользователей
 	defaultPermissions := []string{
 		"checks:read", "checks:write", "checks:delete",
-		"incidents:read", "incidents:write", "incidents:resolve",
+		"incidents:read", "incidents:ack", "incidents:resolve", "incidents:manage-policies",
 		"config:read", "config:write",
 		"metrics:read",
+		"notifications:read", "notifications:write",
+		"forge:write",
+	}
+	// Секреты каналов уведомлений в открытом виде видят только администраторы
+	if isAdmin {
+		defaultPermissions = append(defaultPermissions, "notifications:reveal-secrets")
 	}
 
 	return m.GenerateRefreshTokenWithPermissions(userID, tenantID, isAdmin, defaultPermissions)
@@ -220,3 +220,25 @@ func TestJWTManager_InvalidSigningMethod(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "unexpected signing method")
 }
+
+func TestJWTManager_RevealSecretsOnlyForAdmins(t *testing.T) {
+	manager := jwt.NewManager(
+		"test-access-secret-key-1234567890",
+		"test-refresh-secret-key-1234567890",
+		15*time.Minute,
+		7*24*time.Hour,
+	)
+
+	userToken, err := manager.GenerateAccessToken("user-1", "tenant-1", false)
+	require.NoError(t, err)
+	userClaims, err := manager.ValidateAccessToken(userToken)
+	require.NoError(t, err)
+	assert.Contains(t, userClaims.Permissions, "notifications:write")
+	assert.NotContains(t, userClaims.Permissions, "notifications:reveal-secrets")
+
+	adminToken, err := manager.GenerateAccessToken("admin-1", "tenant-1", true)
+	require.NoError(t, err)
+	adminClaims, err := manager.ValidateAccessToken(adminToken)
+	require.NoError(t, err)
+	assert.Contains(t, adminClaims.Permissions, "notifications:reveal-secrets")
+}
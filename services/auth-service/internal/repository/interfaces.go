@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"UptimePingPlatform/services/auth-service/internal/domain"
@@ -14,6 +15,20 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email string) (*domain.User, error)
 	Update(ctx context.Context, user *domain.User) error
 	Delete(ctx context.Context, id string) error
+	// ListAdmins возвращает активных администраторов тенанта
+	ListAdmins(ctx context.Context, tenantID string) ([]*domain.User, error)
+}
+
+// AuditRepository журнал аудита тенантов
+type AuditRepository interface {
+	Record(ctx context.Context, event *domain.AuditEvent) error
+}
+
+// LoginCountryRepository страны, из которых входили пользователи
+type LoginCountryRepository interface {
+	// Remember запоминает страну входа пользователя. isNew - страна встретилась впервые,
+	// hadOthers - до нее пользователь уже входил из других стран
+	Remember(ctx context.Context, userID, country string) (isNew, hadOthers bool, err error)
 }
 
 // TenantRepository интерфейс для работы с тенантами
@@ -25,11 +40,17 @@ type TenantRepository interface {
 	Delete(ctx context.Context, id string) error
 }
 
+// TenantStatusRepository статусы тенантов для отказа заблокированным тенантам
+type TenantStatusRepository interface {
+	// GetStatus возвращает статус тенанта или ErrTenantNotFound
+	GetStatus(ctx context.Context, tenantID string) (string, error)
+}
+
 // APIKeyRepository интерфейс для работы с API ключами
 type APIKeyRepository interface {
 	Create(ctx context.Context, key *domain.APIKey) error
 	FindByID(ctx context.Context, id string) (*domain.APIKey, error)
-	FindByKeyHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	FindByPrefix(ctx context.Context, keyPrefix string) (*domain.APIKey, error)
 	ListByTenant(ctx context.Context, tenantID string) ([]*domain.APIKey, error)
 	Update(ctx context.Context, key *domain.APIKey) error
 	Delete(ctx context.Context, id string) error
@@ -45,3 +66,91 @@ type SessionRepository interface {
 	DeleteByUserID(ctx context.Context, userID string) error
 	CleanupExpired(ctx context.Context, before time.Time) error
 }
+
+// ErrAPIKeyNotFound API ключ не найден
+var ErrAPIKeyNotFound = errors.New("API key not found")
+
+// ErrTenantNotFound тенант не найден
+var ErrTenantNotFound = errors.New("tenant not found")
+
+// OffboardingRepository интерфейс для экспорта и удаления данных тенанта
+type OffboardingRepository interface {
+	// ExportSection передает записи раздела в emit по одной, в виде JSON объектов
+	ExportSection(ctx context.Context, tenantID string, section domain.ExportSection, emit func(record []byte) error) error
+	ScheduleDeletion(ctx context.Context, deletion *domain.TenantDeletion) error
+	CancelDeletion(ctx context.Context, tenantID string) error
+	FindDeletion(ctx context.Context, tenantID string) (*domain.TenantDeletion, error)
+	ListDueDeletions(ctx context.Context, now time.Time) ([]string, error)
+	// Purge безвозвратно удаляет тенант со всеми данными
+	Purge(ctx context.Context, tenantID string) error
+}
+
+// TenantSettingsRepository интерфейс хранения настроек тенанта
+type TenantSettingsRepository interface {
+	// Get возвращает сохраненные настройки поверх значений по умолчанию или ErrTenantNotFound
+	Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error)
+	// Save заменяет настройки тенанта или возвращает ErrTenantNotFound
+	Save(ctx context.Context, settings *domain.TenantSettings) error
+	// Reset удаляет сохраненные настройки или возвращает ErrTenantNotFound
+	Reset(ctx context.Context, tenantID string) error
+}
+
+// TenantSettingsCache кэш настроек тенанта
+type TenantSettingsCache interface {
+	// Get возвращает настройки из кэша или nil, если их там нет
+	Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error)
+	Set(ctx context.Context, settings *domain.TenantSettings) error
+	Delete(ctx context.Context, tenantID string) error
+}
+
+// ErrTenantStatusMismatch текущий статус тенанта не допускает перехода
+var ErrTenantStatusMismatch = errors.New("tenant status mismatch")
+
+// ErrIncidentNotFound инцидент не найден
+var ErrIncidentNotFound = errors.New("incident not found")
+
+// ErrIncidentResolved инцидент уже закрыт
+var ErrIncidentResolved = errors.New("incident already resolved")
+
+// ErrFeatureFlagNotFound флаг не найден
+var ErrFeatureFlagNotFound = errors.New("feature flag not found")
+
+// AdminRepository интерфейс межтенантных операций для операторов платформы
+type AdminRepository interface {
+	ListTenantUsage(ctx context.Context, filter domain.TenantUsageFilter) ([]*domain.TenantUsage, error)
+	GetTenantUsage(ctx context.Context, tenantID string) (*domain.TenantUsage, error)
+	// SetTenantStatus меняет статус тенанта, если текущий статус равен from
+	SetTenantStatus(ctx context.Context, tenantID, from, to string) error
+	// ResolveIncident закрывает инцидент и записывает событие в его историю
+	ResolveIncident(ctx context.Context, resolution *domain.IncidentResolution) error
+	ListFeatureFlags(ctx context.Context) ([]*domain.FeatureFlag, error)
+	UpsertFeatureFlag(ctx context.Context, flag *domain.FeatureFlag) error
+	DeleteFeatureFlag(ctx context.Context, name, tenantID string) error
+}
+
+// ErrOrganizationNotFound организация не найдена
+var ErrOrganizationNotFound = errors.New("organization not found")
+
+// ErrOrganizationMemberNotFound пользователь не состоит в организации
+var ErrOrganizationMemberNotFound = errors.New("organization member not found")
+
+// ErrOrganizationConflict тенант уже входит в организацию или slug занят
+var ErrOrganizationConflict = errors.New("organization conflict")
+
+// OrganizationRepository интерфейс хранения организаций, их тенантов и участников
+type OrganizationRepository interface {
+	// Create создает организацию, включает в нее тенант владельца и делает owner ее участником.
+	// Возвращает ErrOrganizationConflict, если тенант уже в организации или slug занят
+	Create(ctx context.Context, org *domain.Organization, owner *domain.OrganizationMember) error
+	FindByID(ctx context.Context, id string) (*domain.Organization, error)
+	// FindMembership возвращает членство пользователя или ErrOrganizationMemberNotFound
+	FindMembership(ctx context.Context, userID string) (*domain.OrganizationMember, error)
+	ListTenants(ctx context.Context, orgID string) ([]*domain.Tenant, error)
+	// CreateTenant создает тенант в организации; ErrOrganizationConflict - slug занят
+	CreateTenant(ctx context.Context, orgID string, tenant *domain.Tenant) error
+	ListMembers(ctx context.Context, orgID string) ([]*domain.OrganizationMember, error)
+	// SaveMember добавляет участника или меняет его роль
+	SaveMember(ctx context.Context, member *domain.OrganizationMember) error
+	// RemoveMember удаляет участника или возвращает ErrOrganizationMemberNotFound
+	RemoveMember(ctx context.Context, orgID, userID string) error
+}
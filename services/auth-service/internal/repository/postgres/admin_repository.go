@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// tenantUsageQuery тенанты с агрегатами использования. Подзапросы по каждой таблице выполняются
+// по индексам tenant_id и check_id, без общего JOIN, который размножил бы строки
+const tenantUsageQuery = `SELECT t.id, t.name, t.slug, COALESCE(t.plan, ''), COALESCE(t.status, ''), t.created_at,
+		(SELECT COUNT(*) FROM users u WHERE u.tenant_id = t.id),
+		(SELECT COUNT(*) FROM checks c WHERE c.tenant_id = t.id),
+		(SELECT COUNT(*) FROM checks c WHERE c.tenant_id = t.id AND c.enabled),
+		(SELECT COUNT(*) FROM check_results r JOIN checks c ON c.id = r.check_id
+			WHERE c.tenant_id = t.id AND r.created_at >= NOW() - INTERVAL '24 hours'),
+		(SELECT COUNT(*) FROM incidents i JOIN checks c ON c.id = i.check_id
+			WHERE c.tenant_id = t.id AND i.status <> 'resolved')
+	FROM tenants t`
+
+// AdminRepository реализация межтенантных операций операторов для PostgreSQL
+type AdminRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAdminRepository создает новый экземпляр AdminRepository
+func NewAdminRepository(pool *pgxpool.Pool) repository.AdminRepository {
+	return &AdminRepository{pool: pool}
+}
+
+// ListTenantUsage возвращает тенанты с показателями использования, новые первыми
+func (r *AdminRepository) ListTenantUsage(ctx context.Context, filter domain.TenantUsageFilter) ([]*domain.TenantUsage, error) {
+	query := tenantUsageQuery + ` WHERE ($1 = '' OR t.status = $1) ORDER BY t.created_at DESC LIMIT $2 OFFSET $3`
+
+	rows, err := r.pool.Query(ctx, query, filter.Status, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant usage: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []*domain.TenantUsage
+	for rows.Next() {
+		usage, err := scanTenantUsage(rows)
+		if err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, usage)
+	}
+	return tenants, rows.Err()
+}
+
+// GetTenantUsage возвращает показатели использования одного тенанта
+func (r *AdminRepository) GetTenantUsage(ctx context.Context, tenantID string) (*domain.TenantUsage, error) {
+	usage, err := scanTenantUsage(r.pool.QueryRow(ctx, tenantUsageQuery+` WHERE t.id = $1`, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, repository.ErrTenantNotFound
+	}
+	return usage, err
+}
+
+func scanTenantUsage(row pgx.Row) (*domain.TenantUsage, error) {
+	usage := &domain.TenantUsage{}
+	err := row.Scan(
+		&usage.TenantID, &usage.Name, &usage.Slug, &usage.Plan, &usage.Status, &usage.CreatedAt,
+		&usage.Users, &usage.Checks, &usage.EnabledChecks, &usage.CheckResults24h, &usage.OpenIncidents,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan tenant usage: %w", err)
+	}
+	return usage, nil
+}
+
+// SetTenantStatus меняет статус тенанта при совпадении текущего статуса
+func (r *AdminRepository) SetTenantStatus(ctx context.Context, tenantID, from, to string) error {
+	query := `UPDATE tenants SET status = $3, updated_at = NOW()
+		WHERE id = $1 AND COALESCE(status, '') = $2`
+
+	tag, err := r.pool.Exec(ctx, query, tenantID, from, to)
+	if err != nil {
+		return fmt.Errorf("failed to update tenant status: %w", err)
+	}
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM tenants WHERE id = $1)`, tenantID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check tenant: %w", err)
+	}
+	if !exists {
+		return repository.ErrTenantNotFound
+	}
+	return repository.ErrTenantStatusMismatch
+}
+
+// ResolveIncident закрывает инцидент и добавляет событие force_resolved в одной транзакции
+func (r *AdminRepository) ResolveIncident(ctx context.Context, resolution *domain.IncidentResolution) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	err = tx.QueryRow(ctx, `SELECT COALESCE(status, '') FROM incidents WHERE id = $1 FOR UPDATE`, resolution.IncidentID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrIncidentNotFound
+		}
+		return fmt.Errorf("failed to lock incident: %w", err)
+	}
+	if status == "resolved" {
+		return repository.ErrIncidentResolved
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE incidents SET status = 'resolved', resolved_at = $2, updated_at = $2 WHERE id = $1`,
+		resolution.IncidentID, resolution.ResolvedAt)
+	if err != nil {
+		return fmt.Errorf("failed to resolve incident: %w", err)
+	}
+
+	data, err := json.Marshal(map[string]string{
+		"operator":        resolution.Operator,
+		"reason":          resolution.Reason,
+		"previous_status": status,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal incident event: %w", err)
+	}
+	_, err = tx.Exec(ctx, `INSERT INTO incident_events (incident_id, event_type, description, data, created_at)
+		VALUES ($1, 'force_resolved', $2, $3, $4)`,
+		resolution.IncidentID, "Incident force-resolved by platform operator", data, resolution.ResolvedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record incident event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit incident resolution: %w", err)
+	}
+	return nil
+}
+
+// ListFeatureFlags возвращает все флаги: глобальные значения перед переопределениями тенантов
+func (r *AdminRepository) ListFeatureFlags(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	query := `SELECT name, COALESCE(tenant_id::text, ''), enabled, COALESCE(description, ''), updated_by, updated_at
+		FROM feature_flags ORDER BY name, tenant_id NULLS FIRST`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	defer rows.Close()
+
+	var flags []*domain.FeatureFlag
+	for rows.Next() {
+		flag := &domain.FeatureFlag{}
+		if err := rows.Scan(&flag.Name, &flag.TenantID, &flag.Enabled, &flag.Description, &flag.UpdatedBy, &flag.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan feature flag: %w", err)
+		}
+		flags = append(flags, flag)
+	}
+	return flags, rows.Err()
+}
+
+// UpsertFeatureFlag создает флаг или обновляет его значение. Пустое описание не затирает сохраненное
+func (r *AdminRepository) UpsertFeatureFlag(ctx context.Context, flag *domain.FeatureFlag) error {
+	query := `INSERT INTO feature_flags (name, tenant_id, enabled, description, updated_by, updated_at)
+		VALUES ($1, NULLIF($2, '')::uuid, $3, NULLIF($4, ''), $5, $6)
+		ON CONFLICT (name, COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'::uuid)) DO UPDATE
+		SET enabled = EXCLUDED.enabled,
+			description = COALESCE(EXCLUDED.description, feature_flags.description),
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at`
+
+	_, err := r.pool.Exec(ctx, query, flag.Name, flag.TenantID, flag.Enabled, flag.Description, flag.UpdatedBy, flag.UpdatedAt)
+	if err != nil {
+		// 23503 - нарушение внешнего ключа на tenants, 22P02 - tenant_id не является UUID
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && (pgErr.Code == "23503" || pgErr.Code == "22P02") {
+			return repository.ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to save feature flag: %w", err)
+	}
+	return nil
+}
+
+// DeleteFeatureFlag удаляет глобальный флаг (пустой tenantID) или переопределение тенанта
+func (r *AdminRepository) DeleteFeatureFlag(ctx context.Context, name, tenantID string) error {
+	query := `DELETE FROM feature_flags WHERE name = $1 AND tenant_id IS NOT DISTINCT FROM NULLIF($2, '')::uuid`
+
+	tag, err := r.pool.Exec(ctx, query, name, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to delete feature flag: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrFeatureFlagNotFound
+	}
+	return nil
+}
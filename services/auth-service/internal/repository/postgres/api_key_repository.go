@@ -2,11 +2,12 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
 
 	"UptimePingPlatform/services/auth-service/internal/domain"
 	"UptimePingPlatform/services/auth-service/internal/repository"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -22,16 +23,21 @@ func NewAPIKeyRepository(pool *pgxpool.Pool) repository.APIKeyRepository {
 
 // Create сохраняет новый API ключ в базе данных
 func (r *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
-	query := `INSERT INTO api_keys (id, tenant_id, key_hash, secret_hash, name, is_active, expires_at, created_at) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	query := `INSERT INTO api_keys (id, tenant_id, key_prefix, secret_hash, name, is_active, allowed_ips, expires_at, created_at) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
 
+	allowedIPs := key.AllowedIPs
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
 	_, err := r.pool.Exec(ctx, query,
 		key.ID,
 		key.TenantID,
-		key.KeyHash,
+		key.KeyPrefix,
 		key.SecretHash,
 		key.Name,
 		key.IsActive,
+		allowedIPs,
 		key.ExpiresAt,
 		key.CreatedAt)
 
@@ -44,24 +50,25 @@ func (r *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) error
 
 // FindByID возвращает API ключ по его ID
 func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (*domain.APIKey, error) {
-	query := `SELECT id, tenant_id, key_hash, secret_hash, name, is_active, expires_at, created_at 
+	query := `SELECT id, tenant_id, key_prefix, secret_hash, name, is_active, allowed_ips, expires_at, created_at 
 		FROM api_keys WHERE id = $1`
 
 	var key domain.APIKey
 	err := r.pool.QueryRow(ctx, query, id).Scan(
 		&key.ID,
 		&key.TenantID,
-		&key.KeyHash,
+		&key.KeyPrefix,
 		&key.SecretHash,
 		&key.Name,
 		&key.IsActive,
+		&key.AllowedIPs,
 		&key.ExpiresAt,
 		&key.CreatedAt,
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("API key not found: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrAPIKeyNotFound
 		}
 		return nil, fmt.Errorf("failed to get API key by id: %w", err)
 	}
@@ -69,28 +76,29 @@ func (r *APIKeyRepository) FindByID(ctx context.Context, id string) (*domain.API
 	return &key, nil
 }
 
-// FindByKeyHash возвращает API ключ по его хэшу публичной части
-func (r *APIKeyRepository) FindByKeyHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
-	query := `SELECT id, tenant_id, key_hash, secret_hash, name, is_active, expires_at, created_at 
-		FROM api_keys WHERE key_hash = $1`
+// FindByPrefix возвращает API ключ по открытому префиксу публичной части
+func (r *APIKeyRepository) FindByPrefix(ctx context.Context, keyPrefix string) (*domain.APIKey, error) {
+	query := `SELECT id, tenant_id, key_prefix, secret_hash, name, is_active, allowed_ips, expires_at, created_at 
+		FROM api_keys WHERE key_prefix = $1`
 
 	var key domain.APIKey
-	err := r.pool.QueryRow(ctx, query, keyHash).Scan(
+	err := r.pool.QueryRow(ctx, query, keyPrefix).Scan(
 		&key.ID,
 		&key.TenantID,
-		&key.KeyHash,
+		&key.KeyPrefix,
 		&key.SecretHash,
 		&key.Name,
 		&key.IsActive,
+		&key.AllowedIPs,
 		&key.ExpiresAt,
 		&key.CreatedAt,
 	)
 
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("API key not found: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrAPIKeyNotFound
 		}
-		return nil, fmt.Errorf("failed to get API key by key hash: %w", err)
+		return nil, fmt.Errorf("failed to get API key by prefix: %w", err)
 	}
 
 	return &key, nil
@@ -98,7 +106,7 @@ func (r *APIKeyRepository) FindByKeyHash(ctx context.Context, keyHash string) (*
 
 // ListByTenant возвращает все API ключи для указанного тенанта
 func (r *APIKeyRepository) ListByTenant(ctx context.Context, tenantID string) ([]*domain.APIKey, error) {
-	query := `SELECT id, tenant_id, key_hash, secret_hash, name, is_active, expires_at, created_at 
+	query := `SELECT id, tenant_id, key_prefix, secret_hash, name, is_active, allowed_ips, expires_at, created_at 
 		FROM api_keys WHERE tenant_id = $1 ORDER BY created_at DESC`
 
 	rows, err := r.pool.Query(ctx, query, tenantID)
@@ -113,10 +121,11 @@ func (r *APIKeyRepository) ListByTenant(ctx context.Context, tenantID string) ([
 		err := rows.Scan(
 			&key.ID,
 			&key.TenantID,
-			&key.KeyHash,
+			&key.KeyPrefix,
 			&key.SecretHash,
 			&key.Name,
 			&key.IsActive,
+			&key.AllowedIPs,
 			&key.ExpiresAt,
 			&key.CreatedAt,
 		)
@@ -139,14 +148,20 @@ func (r *APIKeyRepository) Update(ctx context.Context, key *domain.APIKey) error
 	query := `UPDATE api_keys SET 
 		name = $2, 
 		is_active = $3, 
-		expires_at = $4 
+		expires_at = $4, 
+		allowed_ips = $5 
 	WHERE id = $1`
 
+	allowedIPs := key.AllowedIPs
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
 	result, err := r.pool.Exec(ctx, query,
 		key.ID,
 		key.Name,
 		key.IsActive,
 		key.ExpiresAt,
+		allowedIPs,
 	)
 
 	if err != nil {
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// AuditRepository реализация журнала аудита для PostgreSQL
+type AuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuditRepository создает новый экземпляр AuditRepository
+func NewAuditRepository(pool *pgxpool.Pool) repository.AuditRepository {
+	return &AuditRepository{pool: pool}
+}
+
+// Record сохраняет событие журнала аудита
+func (r *AuditRepository) Record(ctx context.Context, event *domain.AuditEvent) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit data: %w", err)
+	}
+	if event.Data == nil {
+		data = []byte(`{}`)
+	}
+
+	query := `INSERT INTO audit_events (id, tenant_id, event, actor_id, data, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)`
+	if _, err := r.pool.Exec(ctx, query, event.ID, event.TenantID, event.Event, event.ActorID, data, event.CreatedAt); err != nil {
+		return fmt.Errorf("failed to record audit event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// LoginCountryRepository реализация репозитория стран входа для PostgreSQL
+type LoginCountryRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewLoginCountryRepository создает новый экземпляр LoginCountryRepository
+func NewLoginCountryRepository(pool *pgxpool.Pool) repository.LoginCountryRepository {
+	return &LoginCountryRepository{pool: pool}
+}
+
+// Remember запоминает страну входа пользователя. Вставка и проверка других стран выполняются
+// одним запросом, поэтому параллельные входы из новой страны уведомляют только один раз
+func (r *LoginCountryRepository) Remember(ctx context.Context, userID, country string) (bool, bool, error) {
+	query := `WITH inserted AS (
+			INSERT INTO user_login_countries (user_id, country) VALUES ($1, $2)
+			ON CONFLICT (user_id, country) DO NOTHING
+			RETURNING country
+		)
+		SELECT EXISTS (SELECT 1 FROM inserted),
+			EXISTS (SELECT 1 FROM user_login_countries WHERE user_id = $1 AND country <> $2)`
+
+	var isNew, hadOthers bool
+	if err := r.pool.QueryRow(ctx, query, userID, country).Scan(&isNew, &hadOthers); err != nil {
+		return false, false, fmt.Errorf("failed to remember login country: %w", err)
+	}
+
+	return isNew, hadOthers, nil
+}
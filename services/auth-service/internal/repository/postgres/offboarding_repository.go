@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// exportQueries запросы разделов экспорта. Записи выгружаются через to_jsonb, поэтому в архив
+// попадают все колонки таблиц, кроме явно исключенных секретов
+var exportQueries = map[domain.ExportSection]string{
+	domain.ExportSectionTenant: `SELECT to_jsonb(t) FROM tenants t WHERE t.id = $1`,
+	domain.ExportSectionUsers: `SELECT to_jsonb(u) - 'password_hash' FROM users u
+		WHERE u.tenant_id = $1 ORDER BY u.created_at`,
+	domain.ExportSectionAPIKeys: `SELECT to_jsonb(k) - 'key_hash' - 'secret_hash' FROM api_keys k
+		WHERE k.tenant_id = $1 ORDER BY k.created_at`,
+	domain.ExportSectionSessions: `SELECT to_jsonb(s) - 'refresh_token_hash' - 'access_token_hash' FROM sessions s
+		JOIN users u ON u.id = s.user_id WHERE u.tenant_id = $1 ORDER BY s.created_at`,
+	domain.ExportSectionCheckGroups: `SELECT to_jsonb(g) FROM check_groups g
+		WHERE g.tenant_id = $1 ORDER BY g.path`,
+	domain.ExportSectionCheckTags: `SELECT to_jsonb(t) FROM check_tags t
+		WHERE t.tenant_id = $1 ORDER BY t.name`,
+	domain.ExportSectionChecks: `SELECT to_jsonb(c) FROM checks c
+		WHERE c.tenant_id = $1 ORDER BY c.created_at`,
+	domain.ExportSectionSchedules: `SELECT to_jsonb(s) FROM schedules s
+		JOIN checks c ON c.id = s.check_id WHERE c.tenant_id = $1 ORDER BY s.created_at`,
+	domain.ExportSectionCheckResults: `SELECT to_jsonb(r) FROM check_results r
+		JOIN checks c ON c.id = r.check_id WHERE c.tenant_id = $1 ORDER BY r.check_id, r.created_at`,
+	domain.ExportSectionIncidents: `SELECT to_jsonb(i) FROM incidents i
+		JOIN checks c ON c.id = i.check_id WHERE c.tenant_id = $1 ORDER BY i.created_at`,
+	domain.ExportSectionIncidentEvents: `SELECT to_jsonb(e) FROM incident_events e
+		JOIN incidents i ON i.id = e.incident_id
+		JOIN checks c ON c.id = i.check_id WHERE c.tenant_id = $1 ORDER BY e.incident_id, e.created_at`,
+	domain.ExportSectionNotificationChannels: `SELECT to_jsonb(n) FROM notification_channels n
+		WHERE n.tenant_id = $1 ORDER BY n.created_at`,
+	domain.ExportSectionNotificationDeliveries: `SELECT to_jsonb(d) FROM notification_deliveries d
+		WHERE d.tenant_id = $1 ORDER BY d.created_at`,
+	domain.ExportSectionAuditEvents: `SELECT to_jsonb(a) FROM audit_events a
+		WHERE a.tenant_id = $1 ORDER BY a.created_at`,
+}
+
+// OffboardingRepository реализация репозитория экспорта и удаления тенантов для PostgreSQL.
+// Запланированное удаление хранится в tenants.status и settings.deletion
+type OffboardingRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOffboardingRepository создает новый экземпляр OffboardingRepository
+func NewOffboardingRepository(pool *pgxpool.Pool) repository.OffboardingRepository {
+	return &OffboardingRepository{pool: pool}
+}
+
+// ExportSection выгружает записи раздела построчно, не загружая раздел в память целиком
+func (r *OffboardingRepository) ExportSection(ctx context.Context, tenantID string, section domain.ExportSection, emit func(record []byte) error) error {
+	query, ok := exportQueries[section]
+	if !ok {
+		return fmt.Errorf("unknown export section: %s", section)
+	}
+
+	rows, err := r.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to export %s: %w", section, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record []byte
+		if err := rows.Scan(&record); err != nil {
+			return fmt.Errorf("failed to scan %s record: %w", section, err)
+		}
+		if err := emit(record); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to export %s: %w", section, err)
+	}
+	return nil
+}
+
+// deletionSettings запланированное удаление в settings тенанта
+type deletionSettings struct {
+	RequestedAt time.Time `json:"requested_at"`
+	RequestedBy string    `json:"requested_by"`
+	PurgeAt     time.Time `json:"purge_at"`
+}
+
+// ScheduleDeletion переводит тенант в статус pending_deletion
+func (r *OffboardingRepository) ScheduleDeletion(ctx context.Context, deletion *domain.TenantDeletion) error {
+	settings, err := json.Marshal(deletionSettings{
+		RequestedAt: deletion.RequestedAt,
+		RequestedBy: deletion.RequestedBy,
+		PurgeAt:     deletion.PurgeAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal deletion settings: %w", err)
+	}
+
+	query := `UPDATE tenants
+		SET status = $2, settings = COALESCE(settings, '{}'::jsonb) || jsonb_build_object('deletion', $3::jsonb)
+		WHERE id = $1`
+
+	tag, err := r.pool.Exec(ctx, query, deletion.TenantID, domain.TenantStatusPendingDeletion, settings)
+	if err != nil {
+		return fmt.Errorf("failed to schedule tenant deletion: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrTenantNotFound
+	}
+	return nil
+}
+
+// CancelDeletion возвращает тенант в статус active
+func (r *OffboardingRepository) CancelDeletion(ctx context.Context, tenantID string) error {
+	query := `UPDATE tenants SET status = $2, settings = settings - 'deletion'
+		WHERE id = $1 AND status = $3`
+
+	tag, err := r.pool.Exec(ctx, query, tenantID, domain.TenantStatusActive, domain.TenantStatusPendingDeletion)
+	if err != nil {
+		return fmt.Errorf("failed to cancel tenant deletion: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrTenantNotFound
+	}
+	return nil
+}
+
+// FindDeletion возвращает запланированное удаление или nil, если удаление не запланировано
+func (r *OffboardingRepository) FindDeletion(ctx context.Context, tenantID string) (*domain.TenantDeletion, error) {
+	query := `SELECT COALESCE(status, ''), settings -> 'deletion' FROM tenants WHERE id = $1`
+
+	var status string
+	var settingsJSON []byte
+	err := r.pool.QueryRow(ctx, query, tenantID).Scan(&status, &settingsJSON)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to get tenant deletion: %w", err)
+	}
+	if status != domain.TenantStatusPendingDeletion || settingsJSON == nil {
+		return nil, nil
+	}
+
+	var settings deletionSettings
+	if err := json.Unmarshal(settingsJSON, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal deletion settings: %w", err)
+	}
+	return &domain.TenantDeletion{
+		TenantID:    tenantID,
+		RequestedAt: settings.RequestedAt,
+		RequestedBy: settings.RequestedBy,
+		PurgeAt:     settings.PurgeAt,
+	}, nil
+}
+
+// ListDueDeletions возвращает тенанты, у которых истек срок отмены удаления
+func (r *OffboardingRepository) ListDueDeletions(ctx context.Context, now time.Time) ([]string, error) {
+	query := `SELECT id FROM tenants
+		WHERE status = $1 AND (settings -> 'deletion' ->> 'purge_at')::timestamptz <= $2
+		ORDER BY (settings -> 'deletion' ->> 'purge_at')::timestamptz`
+
+	rows, err := r.pool.Query(ctx, query, domain.TenantStatusPendingDeletion, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due tenant deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan tenant id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Purge удаляет тенант в статусе pending_deletion. Группы проверок ссылаются друг на друга и
+// на проверки с ON DELETE RESTRICT, поэтому проверки и группы удаляются явно до каскада по tenants
+func (r *OffboardingRepository) Purge(ctx context.Context, tenantID string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	err = tx.QueryRow(ctx, `SELECT COALESCE(status, '') FROM tenants WHERE id = $1 FOR UPDATE`, tenantID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repository.ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to lock tenant: %w", err)
+	}
+	if status != domain.TenantStatusPendingDeletion {
+		return fmt.Errorf("tenant %s is not pending deletion", tenantID)
+	}
+
+	statements := []string{
+		`DELETE FROM checks WHERE tenant_id = $1`,
+		`UPDATE check_groups SET parent_id = NULL WHERE tenant_id = $1`,
+		`DELETE FROM check_groups WHERE tenant_id = $1`,
+		`DELETE FROM tenants WHERE id = $1`,
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(ctx, statement, tenantID); err != nil {
+			return fmt.Errorf("failed to purge tenant: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tenant purge: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"UptimePingPlatform/services/auth-service/internal/domain"
+)
+
+func TestExportQueries_CoverAllSections(t *testing.T) {
+	assert.Len(t, exportQueries, len(domain.ExportSections))
+	for _, section := range domain.ExportSections {
+		query, ok := exportQueries[section]
+		if assert.True(t, ok, "no export query for section %s", section) {
+			assert.Contains(t, query, "$1", "section %s must be scoped to the tenant", section)
+		}
+	}
+}
+
+func TestExportQueries_NotificationDeliveries(t *testing.T) {
+	query := exportQueries[domain.ExportSectionNotificationDeliveries]
+	assert.Contains(t, query, "FROM notification_deliveries")
+	assert.Contains(t, query, "tenant_id = $1")
+}
+
+func TestExportQueries_AuditEvents(t *testing.T) {
+	assert.Contains(t, domain.ExportSections, domain.ExportSectionAuditEvents)
+	query := exportQueries[domain.ExportSectionAuditEvents]
+	assert.Contains(t, query, "FROM audit_events")
+	assert.Contains(t, query, "tenant_id = $1")
+}
@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// organizationMemberQuery участники организаций с тенантом и email пользователя
+const organizationMemberQuery = `SELECT m.organization_id, m.user_id, u.tenant_id, u.email, m.role, m.created_at
+	FROM organization_members m JOIN users u ON u.id = m.user_id`
+
+// OrganizationRepository реализация репозитория организаций для PostgreSQL
+type OrganizationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOrganizationRepository создает новый экземпляр OrganizationRepository
+func NewOrganizationRepository(pool *pgxpool.Pool) repository.OrganizationRepository {
+	return &OrganizationRepository{pool: pool}
+}
+
+// Create создает организацию, переносит в нее тенант владельца и добавляет владельца в одной
+// транзакции. Тенант, уже входящий в другую организацию, не переносится
+func (r *OrganizationRepository) Create(ctx context.Context, org *domain.Organization, owner *domain.OrganizationMember) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Организация наследует тарифный план тенанта, из которого создана
+	tag, err := tx.Exec(ctx, `INSERT INTO organizations (id, name, slug, plan, billing_email, created_at, updated_at)
+		SELECT $1, $2, $3, COALESCE(t.plan, 'free'), $4, $5, $5 FROM tenants t WHERE t.id = $6`,
+		org.ID, org.Name, org.Slug, org.BillingEmail, org.CreatedAt, owner.TenantID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.ErrOrganizationConflict
+		}
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrTenantNotFound
+	}
+
+	tag, err = tx.Exec(ctx, `UPDATE tenants SET organization_id = $1, updated_at = $2
+		WHERE id = $3 AND organization_id IS NULL`, org.ID, org.CreatedAt, owner.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to attach tenant to organization: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrOrganizationConflict
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO organization_members (organization_id, user_id, role, created_at)
+		VALUES ($1, $2, $3, $4)`, org.ID, owner.UserID, owner.Role, owner.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.ErrOrganizationConflict
+		}
+		return fmt.Errorf("failed to add organization owner: %w", err)
+	}
+
+	if err := tx.QueryRow(ctx, `SELECT plan FROM organizations WHERE id = $1`, org.ID).Scan(&org.Plan); err != nil {
+		return fmt.Errorf("failed to read organization plan: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit organization: %w", err)
+	}
+	return nil
+}
+
+// FindByID возвращает организацию по ID
+func (r *OrganizationRepository) FindByID(ctx context.Context, id string) (*domain.Organization, error) {
+	query := `SELECT id, name, slug, plan, billing_email, created_at, updated_at FROM organizations WHERE id = $1`
+
+	var org domain.Organization
+	err := r.pool.QueryRow(ctx, query, id).Scan(
+		&org.ID, &org.Name, &org.Slug, &org.Plan, &org.BillingEmail, &org.CreatedAt, &org.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || isInvalidUUID(err) {
+			return nil, repository.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return &org, nil
+}
+
+// FindMembership возвращает членство пользователя в организации
+func (r *OrganizationRepository) FindMembership(ctx context.Context, userID string) (*domain.OrganizationMember, error) {
+	member, err := scanOrganizationMember(r.pool.QueryRow(ctx, organizationMemberQuery+` WHERE m.user_id = $1`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || isInvalidUUID(err) {
+			return nil, repository.ErrOrganizationMemberNotFound
+		}
+		return nil, fmt.Errorf("failed to get organization membership: %w", err)
+	}
+	return member, nil
+}
+
+// ListTenants возвращает тенанты организации в порядке создания
+func (r *OrganizationRepository) ListTenants(ctx context.Context, orgID string) ([]*domain.Tenant, error) {
+	query := `SELECT id, name, slug, settings, created_at, updated_at
+		FROM tenants WHERE organization_id = $1 ORDER BY created_at`
+
+	rows, err := r.pool.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []*domain.Tenant
+	for rows.Next() {
+		var tenant domain.Tenant
+		var settingsJSON []byte
+		if err := rows.Scan(&tenant.ID, &tenant.Name, &tenant.Slug, &settingsJSON, &tenant.CreatedAt, &tenant.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan organization tenant: %w", err)
+		}
+		if len(settingsJSON) > 0 {
+			if err := json.Unmarshal(settingsJSON, &tenant.Settings); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal settings from JSON: %w", err)
+			}
+		}
+		tenants = append(tenants, &tenant)
+	}
+	return tenants, rows.Err()
+}
+
+// CreateTenant создает тенант в организации с ее тарифным планом
+func (r *OrganizationRepository) CreateTenant(ctx context.Context, orgID string, tenant *domain.Tenant) error {
+	settingsJSON, err := json.Marshal(tenant.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal settings to JSON: %w", err)
+	}
+
+	query := `INSERT INTO tenants (id, name, slug, settings, plan, organization_id, created_at, updated_at)
+		SELECT $1, $2, $3, $4, o.plan, o.id, $5, $6 FROM organizations o WHERE o.id = $7`
+
+	tag, err := r.pool.Exec(ctx, query, tenant.ID, tenant.Name, tenant.Slug, settingsJSON, tenant.CreatedAt, tenant.UpdatedAt, orgID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.ErrOrganizationConflict
+		}
+		return fmt.Errorf("failed to create organization tenant: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrOrganizationNotFound
+	}
+	return nil
+}
+
+// ListMembers возвращает участников организации в порядке добавления
+func (r *OrganizationRepository) ListMembers(ctx context.Context, orgID string) ([]*domain.OrganizationMember, error) {
+	rows, err := r.pool.Query(ctx, organizationMemberQuery+` WHERE m.organization_id = $1 ORDER BY m.created_at`, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*domain.OrganizationMember
+	for rows.Next() {
+		member, err := scanOrganizationMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
+		}
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+// SaveMember добавляет участника или меняет роль участника той же организации. Пользователь
+// другой организации не переносится: возвращается ErrOrganizationConflict
+func (r *OrganizationRepository) SaveMember(ctx context.Context, member *domain.OrganizationMember) error {
+	query := `INSERT INTO organization_members (organization_id, user_id, role, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET role = EXCLUDED.role
+		WHERE organization_members.organization_id = EXCLUDED.organization_id`
+
+	tag, err := r.pool.Exec(ctx, query, member.OrganizationID, member.UserID, member.Role, member.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save organization member: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrOrganizationConflict
+	}
+	return nil
+}
+
+// RemoveMember удаляет участника организации
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, orgID, userID string) error {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2`, orgID, userID)
+	if err != nil {
+		if isInvalidUUID(err) {
+			return repository.ErrOrganizationMemberNotFound
+		}
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrOrganizationMemberNotFound
+	}
+	return nil
+}
+
+func scanOrganizationMember(row pgx.Row) (*domain.OrganizationMember, error) {
+	member := &domain.OrganizationMember{}
+	err := row.Scan(&member.OrganizationID, &member.UserID, &member.TenantID, &member.Email, &member.Role, &member.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// isUniqueViolation проверяет ошибку 23505: нарушение уникальности
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// TenantSettingsRepository реализация хранения настроек тенанта в tenants.settings
+type TenantSettingsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTenantSettingsRepository создает новый экземпляр TenantSettingsRepository
+func NewTenantSettingsRepository(pool *pgxpool.Pool) repository.TenantSettingsRepository {
+	return &TenantSettingsRepository{pool: pool}
+}
+
+// Get возвращает сохраненные настройки поверх значений по умолчанию
+func (r *TenantSettingsRepository) Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error) {
+	var document []byte
+	err := r.pool.QueryRow(ctx, `SELECT COALESCE(settings, '{}'::jsonb) FROM tenants WHERE id = $1`, tenantID).Scan(&document)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || isInvalidUUID(err) {
+			return nil, repository.ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to get tenant settings: %w", err)
+	}
+
+	settings := domain.DefaultTenantSettings(tenantID)
+	if err := json.Unmarshal(document, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tenant settings: %w", err)
+	}
+	settings.TenantID = tenantID
+	return settings, nil
+}
+
+// Save заменяет настройки тенанта
+func (r *TenantSettingsRepository) Save(ctx context.Context, settings *domain.TenantSettings) error {
+	document, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tenant settings: %w", err)
+	}
+
+	tag, err := r.pool.Exec(ctx, `UPDATE tenants SET settings = $2, updated_at = $3 WHERE id = $1`,
+		settings.TenantID, document, settings.UpdatedAt)
+	if err != nil {
+		if isInvalidUUID(err) {
+			return repository.ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to save tenant settings: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrTenantNotFound
+	}
+	return nil
+}
+
+// Reset удаляет сохраненные настройки тенанта
+func (r *TenantSettingsRepository) Reset(ctx context.Context, tenantID string) error {
+	tag, err := r.pool.Exec(ctx, `UPDATE tenants SET settings = '{}'::jsonb, updated_at = NOW() WHERE id = $1`, tenantID)
+	if err != nil {
+		if isInvalidUUID(err) {
+			return repository.ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to reset tenant settings: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repository.ErrTenantNotFound
+	}
+	return nil
+}
+
+// isInvalidUUID проверяет ошибку 22P02: идентификатор тенанта не является UUID
+func isInvalidUUID(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "22P02"
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 
 	"UptimePingPlatform/services/auth-service/internal/domain"
 	"UptimePingPlatform/services/auth-service/internal/repository"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -21,6 +23,24 @@ func NewTenantRepository(pool *pgxpool.Pool) repository.TenantRepository {
 	return &TenantRepository{pool: pool}
 }
 
+// NewTenantStatusRepository создает репозиторий статусов тенантов
+func NewTenantStatusRepository(pool *pgxpool.Pool) repository.TenantStatusRepository {
+	return &TenantRepository{pool: pool}
+}
+
+// GetStatus возвращает статус тенанта; тенанты без статуса считаются активными
+func (r *TenantRepository) GetStatus(ctx context.Context, tenantID string) (string, error) {
+	var status string
+	err := r.pool.QueryRow(ctx, `SELECT COALESCE(status, $2) FROM tenants WHERE id = $1`, tenantID, domain.TenantStatusActive).Scan(&status)
+	if err != nil {
+		if stderrors.Is(err, pgx.ErrNoRows) {
+			return "", repository.ErrTenantNotFound
+		}
+		return "", fmt.Errorf("failed to get tenant status: %w", err)
+	}
+	return status, nil
+}
+
 // Create сохраняет новый тенант в базе данных
 func (r *TenantRepository) Create(ctx context.Context, tenant *domain.Tenant) error {
 	// Преобразуем map[string]interface{} в JSON
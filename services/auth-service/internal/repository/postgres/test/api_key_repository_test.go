@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
 	"UptimePingPlatform/services/auth-service/internal/repository/postgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,7 +39,7 @@ func TestAPIKeyRepository_Create(t *testing.T) {
 	key := &domain.APIKey{
 		ID:         "key-1",
 		TenantID:   "tenant-1",
-		KeyHash:    "key-hash-1",
+		KeyPrefix:  "upk_prefix-1",
 		SecretHash: "secret-hash-1",
 		Name:       "Test API Key",
 		IsActive:   true,
@@ -76,7 +77,7 @@ func TestAPIKeyRepository_FindByID(t *testing.T) {
 	key := &domain.APIKey{
 		ID:         "key-2",
 		TenantID:   "tenant-2",
-		KeyHash:    "key-hash-2",
+		KeyPrefix:  "upk_prefix-2",
 		SecretHash: "secret-hash-2",
 		Name:       "Test API Key 2",
 		IsActive:   true,
@@ -99,7 +100,7 @@ func TestAPIKeyRepository_FindByID(t *testing.T) {
 	assert.Nil(t, found)
 }
 
-func TestAPIKeyRepository_FindByKeyHash(t *testing.T) {
+func TestAPIKeyRepository_FindByPrefix(t *testing.T) {
 	pool := setupTestDB(t)
 	if pool == nil {
 		t.Skip("Skipping test due to no database connection")
@@ -116,7 +117,7 @@ func TestAPIKeyRepository_FindByKeyHash(t *testing.T) {
 	key := &domain.APIKey{
 		ID:         "key-3",
 		TenantID:   "tenant-3",
-		KeyHash:    "key-hash-3",
+		KeyPrefix:  "upk_prefix-3",
 		SecretHash: "secret-hash-3",
 		Name:       "Test API Key 3",
 		IsActive:   true,
@@ -127,15 +128,16 @@ func TestAPIKeyRepository_FindByKeyHash(t *testing.T) {
 	err := repo.Create(context.Background(), key)
 	require.NoError(t, err)
 
-	// Ищем по хэшу ключа
-	found, err := repo.FindByKeyHash(context.Background(), "key-hash-3")
+	// Ищем по префиксу ключа
+	found, err := repo.FindByPrefix(context.Background(), "upk_prefix-3")
 	require.NoError(t, err)
 	assert.Equal(t, key.ID, found.ID)
-	assert.Equal(t, key.KeyHash, found.KeyHash)
+	assert.Equal(t, key.KeyPrefix, found.KeyPrefix)
+	assert.Equal(t, key.SecretHash, found.SecretHash)
 
-	// Ищем несуществующий хэш
-	found, err = repo.FindByKeyHash(context.Background(), "non-existent-hash")
-	assert.Error(t, err)
+	// Ищем несуществующий префикс
+	found, err = repo.FindByPrefix(context.Background(), "upk_nonexistent")
+	assert.ErrorIs(t, err, repository.ErrAPIKeyNotFound)
 	assert.Nil(t, found)
 }
 
@@ -156,7 +158,7 @@ func TestAPIKeyRepository_ListByTenant(t *testing.T) {
 		{
 			ID:         "key-4-1",
 			TenantID:   "tenant-4",
-			KeyHash:    "key-hash-4-1",
+			KeyPrefix:  "upk_prefix-4-1",
 			SecretHash: "secret-hash-4-1",
 			Name:       "API Key 1",
 			IsActive:   true,
@@ -165,7 +167,7 @@ func TestAPIKeyRepository_ListByTenant(t *testing.T) {
 		{
 			ID:         "key-4-2",
 			TenantID:   "tenant-4",
-			KeyHash:    "key-hash-4-2",
+			KeyPrefix:  "upk_prefix-4-2",
 			SecretHash: "secret-hash-4-2",
 			Name:       "API Key 2",
 			IsActive:   true,
@@ -204,7 +206,7 @@ func TestAPIKeyRepository_Update(t *testing.T) {
 	key := &domain.APIKey{
 		ID:         "key-5",
 		TenantID:   "tenant-5",
-		KeyHash:    "key-hash-5",
+		KeyPrefix:  "upk_prefix-5",
 		SecretHash: "secret-hash-5",
 		Name:       "Original Name",
 		IsActive:   true,
@@ -244,7 +246,7 @@ func TestAPIKeyRepository_Delete(t *testing.T) {
 	key := &domain.APIKey{
 		ID:         "key-6",
 		TenantID:   "tenant-6",
-		KeyHash:    "key-hash-6",
+		KeyPrefix:  "upk_prefix-6",
 		SecretHash: "secret-hash-6",
 		Name:       "API Key to Delete",
 		IsActive:   true,
@@ -156,3 +156,37 @@ func (r *UserRepository) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// ListAdmins возвращает активных администраторов тенанта
+func (r *UserRepository) ListAdmins(ctx context.Context, tenantID string) ([]*domain.User, error) {
+	query := `SELECT id, email, tenant_id, is_active, is_admin, created_at, updated_at 
+		FROM users WHERE tenant_id = $1 AND is_admin = true AND is_active = true ORDER BY created_at`
+
+	rows, err := r.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant admins: %w", err)
+	}
+	defer rows.Close()
+
+	var admins []*domain.User
+	for rows.Next() {
+		var user domain.User
+		if err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.TenantID,
+			&user.IsActive,
+			&user.IsAdmin,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan tenant admin: %w", err)
+		}
+		admins = append(admins, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tenant admins: %w", err)
+	}
+
+	return admins, nil
+}
@@ -0,0 +1,71 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"github.com/go-redis/redis/v8"
+)
+
+// DefaultSettingsCacheTTL время жизни настроек тенанта в кэше по умолчанию
+const DefaultSettingsCacheTTL = 5 * time.Minute
+
+// TenantSettingsCache кэш настроек тенанта в Redis
+type TenantSettingsCache struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewTenantSettingsCache создает кэш настроек с временем жизни ttl
+func NewTenantSettingsCache(client *redis.Client, ttl time.Duration) repository.TenantSettingsCache {
+	if ttl <= 0 {
+		ttl = DefaultSettingsCacheTTL
+	}
+	return &TenantSettingsCache{client: client, ttl: ttl}
+}
+
+// settingsKey ключ настроек тенанта
+func settingsKey(tenantID string) string {
+	return fmt.Sprintf("tenant_settings:%s", tenantID)
+}
+
+// Get возвращает настройки из кэша или nil, если их там нет
+func (c *TenantSettingsCache) Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error) {
+	data, err := c.client.Get(ctx, settingsKey(tenantID)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get tenant settings from Redis: %w", err)
+	}
+
+	var settings domain.TenantSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tenant settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// Set сохраняет настройки в кэш
+func (c *TenantSettingsCache) Set(ctx context.Context, settings *domain.TenantSettings) error {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tenant settings: %w", err)
+	}
+	if err := c.client.Set(ctx, settingsKey(settings.TenantID), data, c.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set tenant settings in Redis: %w", err)
+	}
+	return nil
+}
+
+// Delete удаляет настройки из кэша
+func (c *TenantSettingsCache) Delete(ctx context.Context, tenantID string) error {
+	if err := c.client.Del(ctx, settingsKey(tenantID)).Err(); err != nil {
+		return fmt.Errorf("failed to delete tenant settings from Redis: %w", err)
+	}
+	return nil
+}
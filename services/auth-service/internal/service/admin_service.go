@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+const (
+	// DefaultTenantPageSize размер страницы списка тенантов по умолчанию
+	DefaultTenantPageSize = 50
+	// MaxTenantPageSize максимальный размер страницы списка тенантов
+	MaxTenantPageSize = 500
+	// DefaultImpersonationTTL срок действия токена имперсонации по умолчанию
+	DefaultImpersonationTTL = 30 * time.Minute
+	// MaxImpersonationTTL максимальный срок действия токена имперсонации
+	MaxImpersonationTTL = 2 * time.Hour
+)
+
+// ImpersonationPermissions права токена имперсонации: только чтение данных тенанта
+var ImpersonationPermissions = []string{
+	"checks:read",
+	"incidents:read",
+	"config:read",
+	"metrics:read",
+	"notifications:read",
+}
+
+// featureFlagName допустимое имя флага: строчные буквы, цифры, точки, дефисы и подчеркивания
+var featureFlagName = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,99}$`)
+
+// ErrTenantStatusMismatch ошибка, когда текущий статус тенанта не допускает операции
+var ErrTenantStatusMismatch = errors.New(errors.ErrConflict, "operation is not allowed in the current tenant status")
+
+// ErrIncidentNotFound ошибка, когда инцидент не найден
+var ErrIncidentNotFound = errors.New(errors.ErrNotFound, "incident not found")
+
+// ErrIncidentResolved ошибка, когда инцидент уже закрыт
+var ErrIncidentResolved = errors.New(errors.ErrConflict, "incident is already resolved")
+
+// ErrFeatureFlagNotFound ошибка, когда флаг не найден
+var ErrFeatureFlagNotFound = errors.New(errors.ErrNotFound, "feature flag not found")
+
+// ErrQueueMonitoringDisabled ошибка, когда опрос очередей RabbitMQ не настроен
+var ErrQueueMonitoringDisabled = errors.New(errors.ErrNotFound, "queue monitoring is not configured")
+
+// ErrImpersonationDisabled ошибка, когда выдача токенов имперсонации не настроена
+var ErrImpersonationDisabled = errors.New(errors.ErrNotFound, "impersonation is not configured")
+
+// ErrImpersonationForbidden ошибка, когда у оператора нет роли поддержки
+var ErrImpersonationForbidden = errors.New(errors.ErrForbidden, "operator does not have the support role")
+
+// ImpersonationSigner подписывает access токен имперсонации
+type ImpersonationSigner interface {
+	SignImpersonation(impersonation *domain.Impersonation) (string, error)
+}
+
+// ImpersonationSignerFunc адаптер функции к ImpersonationSigner
+type ImpersonationSignerFunc func(impersonation *domain.Impersonation) (string, error)
+
+// SignImpersonation вызывает f(impersonation)
+func (f ImpersonationSignerFunc) SignImpersonation(impersonation *domain.Impersonation) (string, error) {
+	return f(impersonation)
+}
+
+// QueueMonitor опрашивает состояние очереди RabbitMQ
+type QueueMonitor interface {
+	Poll(ctx context.Context, queue string) (*rabbitmq.QueueStats, error)
+}
+
+// AdminService межтенантные операции операторов платформы: обзор тенантов, блокировка,
+// принудительное закрытие инцидентов, глубина очередей и переключатели функциональности
+type AdminService struct {
+	repo   repository.AdminRepository
+	logger logger.Logger
+	queues QueueMonitor
+	// queueNames очереди, отображаемые на панели операторов
+	queueNames []string
+	signer     ImpersonationSigner
+	// supportOperators операторы с ролью поддержки, которым разрешена имперсонация
+	supportOperators map[string]bool
+	audit            *AuditLog
+	now              func() time.Time
+}
+
+// NewAdminService создает сервис без опроса очередей
+func NewAdminService(repo repository.AdminRepository, logger logger.Logger) *AdminService {
+	return &AdminService{
+		repo:   repo,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// WithQueueMonitor включает отображение глубины перечисленных очередей
+func (s *AdminService) WithQueueMonitor(monitor QueueMonitor, queues ...string) *AdminService {
+	s.queues = monitor
+	s.queueNames = queues
+	return s
+}
+
+// WithImpersonation включает выдачу токенов имперсонации перечисленным операторам поддержки
+func (s *AdminService) WithImpersonation(signer ImpersonationSigner, supportOperators ...string) *AdminService {
+	s.signer = signer
+	s.supportOperators = make(map[string]bool, len(supportOperators))
+	for _, operator := range supportOperators {
+		s.supportOperators[operator] = true
+	}
+	return s
+}
+
+// WithAuditLog включает запись действий операторов в журнал аудита тенанта
+func (s *AdminService) WithAuditLog(audit *AuditLog) *AdminService {
+	s.audit = audit
+	return s
+}
+
+// ListTenants возвращает тенанты с показателями использования
+func (s *AdminService) ListTenants(ctx context.Context, filter domain.TenantUsageFilter) ([]*domain.TenantUsage, error) {
+	switch filter.Status {
+	case "", domain.TenantStatusActive, domain.TenantStatusSuspended, domain.TenantStatusPendingDeletion:
+	default:
+		return nil, errors.New(errors.ErrValidation, fmt.Sprintf("unknown tenant status: %s", filter.Status))
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultTenantPageSize
+	}
+	if filter.Limit > MaxTenantPageSize {
+		filter.Limit = MaxTenantPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, errors.New(errors.ErrValidation, "offset must not be negative")
+	}
+
+	tenants, err := s.repo.ListTenantUsage(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list tenants")
+	}
+	if tenants == nil {
+		tenants = []*domain.TenantUsage{}
+	}
+	return tenants, nil
+}
+
+// GetTenant возвращает показатели использования тенанта
+func (s *AdminService) GetTenant(ctx context.Context, tenantID string) (*domain.TenantUsage, error) {
+	usage, err := s.repo.GetTenantUsage(ctx, tenantID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to get tenant")
+	}
+	return usage, nil
+}
+
+// SuspendTenant блокирует активный тенант
+func (s *AdminService) SuspendTenant(ctx context.Context, tenantID, operator, reason string) (*domain.TenantUsage, error) {
+	return s.changeTenantStatus(ctx, tenantID, domain.TenantStatusActive, domain.TenantStatusSuspended, operator, reason)
+}
+
+// UnsuspendTenant снимает блокировку тенанта
+func (s *AdminService) UnsuspendTenant(ctx context.Context, tenantID, operator, reason string) (*domain.TenantUsage, error) {
+	return s.changeTenantStatus(ctx, tenantID, domain.TenantStatusSuspended, domain.TenantStatusActive, operator, reason)
+}
+
+func (s *AdminService) changeTenantStatus(ctx context.Context, tenantID, from, to, operator, reason string) (*domain.TenantUsage, error) {
+	if err := s.repo.SetTenantStatus(ctx, tenantID, from, to); err != nil {
+		return nil, s.mapError(err, "failed to change tenant status")
+	}
+
+	s.logger.Info("Tenant status changed by operator",
+		logger.String("tenant_id", tenantID),
+		logger.String("from", from),
+		logger.String("to", to),
+		logger.String("operator", operator),
+		logger.String("reason", reason),
+	)
+	return s.GetTenant(ctx, tenantID)
+}
+
+// ForceResolveIncident закрывает инцидент любого тенанта. Причина обязательна и сохраняется
+// в истории инцидента
+func (s *AdminService) ForceResolveIncident(ctx context.Context, incidentID, operator, reason string) (*domain.IncidentResolution, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New(errors.ErrValidation, "reason is required")
+	}
+
+	resolution := &domain.IncidentResolution{
+		IncidentID: incidentID,
+		Operator:   operator,
+		Reason:     reason,
+		ResolvedAt: s.now().UTC(),
+	}
+	if err := s.repo.ResolveIncident(ctx, resolution); err != nil {
+		return nil, s.mapError(err, "failed to resolve incident")
+	}
+
+	s.logger.Info("Incident force-resolved by operator",
+		logger.String("incident_id", incidentID),
+		logger.String("operator", operator),
+		logger.String("reason", reason),
+	)
+	return resolution, nil
+}
+
+// QueueDepths опрашивает очереди. Ошибка опроса одной очереди не скрывает остальные
+func (s *AdminService) QueueDepths(ctx context.Context) ([]*domain.QueueDepth, error) {
+	if s.queues == nil {
+		return nil, ErrQueueMonitoringDisabled
+	}
+
+	depths := make([]*domain.QueueDepth, 0, len(s.queueNames))
+	for _, queue := range s.queueNames {
+		depth := &domain.QueueDepth{Queue: queue}
+		stats, err := s.queues.Poll(ctx, queue)
+		if err != nil {
+			depth.Error = err.Error()
+		} else {
+			depth.Messages = stats.Messages
+			depth.MessagesReady = stats.MessagesReady
+			depth.MessagesUnacknowledged = stats.MessagesUnacknowledged
+			depth.Consumers = stats.Consumers
+		}
+		depths = append(depths, depth)
+	}
+	return depths, nil
+}
+
+// ListFeatureFlags возвращает все переключатели функциональности
+func (s *AdminService) ListFeatureFlags(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	flags, err := s.repo.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list feature flags")
+	}
+	if flags == nil {
+		flags = []*domain.FeatureFlag{}
+	}
+	return flags, nil
+}
+
+// SetFeatureFlag включает или выключает флаг глобально или для тенанта
+func (s *AdminService) SetFeatureFlag(ctx context.Context, flag *domain.FeatureFlag) (*domain.FeatureFlag, error) {
+	if !featureFlagName.MatchString(flag.Name) {
+		return nil, errors.New(errors.ErrValidation, "invalid feature flag name").
+			WithDetails("name must match " + featureFlagName.String())
+	}
+	flag.UpdatedAt = s.now().UTC()
+
+	if err := s.repo.UpsertFeatureFlag(ctx, flag); err != nil {
+		return nil, s.mapError(err, "failed to save feature flag")
+	}
+
+	s.logger.Info("Feature flag changed by operator",
+		logger.String("flag", flag.Name),
+		logger.String("tenant_id", flag.TenantID),
+		logger.Bool("enabled", flag.Enabled),
+		logger.String("operator", flag.UpdatedBy),
+	)
+	return flag, nil
+}
+
+// DeleteFeatureFlag удаляет флаг; для тенанта начинает действовать глобальное значение
+func (s *AdminService) DeleteFeatureFlag(ctx context.Context, name, tenantID, operator string) error {
+	if err := s.repo.DeleteFeatureFlag(ctx, name, tenantID); err != nil {
+		return s.mapError(err, "failed to delete feature flag")
+	}
+
+	s.logger.Info("Feature flag deleted by operator",
+		logger.String("flag", name),
+		logger.String("tenant_id", tenantID),
+		logger.String("operator", operator),
+	)
+	return nil
+}
+
+// Impersonate выдает оператору поддержки токен доступа к тенанту только на чтение. Причина
+// обязательна; ttl 0 - DefaultImpersonationTTL. Выдача и отказы записываются в журнал аудита
+func (s *AdminService) Impersonate(ctx context.Context, tenantID, operator, reason string, ttl time.Duration) (*domain.Impersonation, error) {
+	if s.signer == nil {
+		return nil, ErrImpersonationDisabled
+	}
+	if !s.supportOperators[operator] {
+		s.logger.Warn("Impersonation denied",
+			logger.String("audit_event", "impersonation.denied"),
+			logger.String("operator", operator),
+			logger.String("tenant_id", tenantID),
+		)
+		return nil, ErrImpersonationForbidden
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New(errors.ErrValidation, "reason is required")
+	}
+	if ttl == 0 {
+		ttl = DefaultImpersonationTTL
+	}
+	if ttl < 0 || ttl > MaxImpersonationTTL {
+		return nil, errors.New(errors.ErrValidation, fmt.Sprintf("ttl must be between 0 and %s", MaxImpersonationTTL))
+	}
+	if _, err := s.repo.GetTenantUsage(ctx, tenantID); err != nil {
+		return nil, s.mapError(err, "failed to get tenant")
+	}
+
+	now := s.now().UTC()
+	impersonation := &domain.Impersonation{
+		ID:          uuid.New().String(),
+		Operator:    operator,
+		TenantID:    tenantID,
+		Reason:      reason,
+		Permissions: append([]string(nil), ImpersonationPermissions...),
+		IssuedAt:    now,
+		ExpiresAt:   now.Add(ttl),
+	}
+	token, err := s.signer.SignImpersonation(impersonation)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to sign impersonation token")
+	}
+	impersonation.Token = token
+
+	s.logger.Warn("Impersonation started",
+		logger.String("audit_event", "impersonation.started"),
+		logger.String("impersonation_id", impersonation.ID),
+		logger.String("operator", operator),
+		logger.String("tenant_id", tenantID),
+		logger.String("reason", reason),
+		logger.String("expires_at", impersonation.ExpiresAt.Format(time.RFC3339)),
+	)
+	s.audit.Record(ctx, tenantID, "impersonation.started", operator, map[string]string{
+		"impersonation_id": impersonation.ID,
+		"reason":           reason,
+		"expires_at":       impersonation.ExpiresAt.Format(time.RFC3339),
+	})
+	return impersonation, nil
+}
+
+// mapError переводит ошибки репозитория в ошибки сервиса
+func (s *AdminService) mapError(err error, message string) error {
+	switch {
+	case stderrors.Is(err, repository.ErrTenantNotFound):
+		return ErrTenantNotFound
+	case stderrors.Is(err, repository.ErrTenantStatusMismatch):
+		return ErrTenantStatusMismatch
+	case stderrors.Is(err, repository.ErrIncidentNotFound):
+		return ErrIncidentNotFound
+	case stderrors.Is(err, repository.ErrIncidentResolved):
+		return ErrIncidentResolved
+	case stderrors.Is(err, repository.ErrFeatureFlagNotFound):
+		return ErrFeatureFlagNotFound
+	default:
+		return errors.Wrap(err, errors.ErrInternal, message)
+	}
+}
@@ -0,0 +1,276 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgerrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// fakeAdminRepository хранит тенанты, инциденты и флаги в памяти
+type fakeAdminRepository struct {
+	tenants   map[string]*domain.TenantUsage
+	incidents map[string]string
+	flags     map[string]*domain.FeatureFlag
+	filter    domain.TenantUsageFilter
+	resolved  []*domain.IncidentResolution
+}
+
+func newFakeAdminRepository() *fakeAdminRepository {
+	return &fakeAdminRepository{
+		tenants: map[string]*domain.TenantUsage{
+			"tenant-1": {TenantID: "tenant-1", Name: "Acme", Status: domain.TenantStatusActive, Checks: 3},
+		},
+		incidents: map[string]string{"incident-1": "open", "incident-2": "resolved"},
+		flags:     make(map[string]*domain.FeatureFlag),
+	}
+}
+
+func (r *fakeAdminRepository) ListTenantUsage(ctx context.Context, filter domain.TenantUsageFilter) ([]*domain.TenantUsage, error) {
+	r.filter = filter
+	var tenants []*domain.TenantUsage
+	for _, tenant := range r.tenants {
+		if filter.Status == "" || tenant.Status == filter.Status {
+			tenants = append(tenants, tenant)
+		}
+	}
+	return tenants, nil
+}
+
+func (r *fakeAdminRepository) GetTenantUsage(ctx context.Context, tenantID string) (*domain.TenantUsage, error) {
+	tenant, ok := r.tenants[tenantID]
+	if !ok {
+		return nil, repository.ErrTenantNotFound
+	}
+	return tenant, nil
+}
+
+func (r *fakeAdminRepository) SetTenantStatus(ctx context.Context, tenantID, from, to string) error {
+	tenant, ok := r.tenants[tenantID]
+	if !ok {
+		return repository.ErrTenantNotFound
+	}
+	if tenant.Status != from {
+		return repository.ErrTenantStatusMismatch
+	}
+	tenant.Status = to
+	return nil
+}
+
+func (r *fakeAdminRepository) ResolveIncident(ctx context.Context, resolution *domain.IncidentResolution) error {
+	status, ok := r.incidents[resolution.IncidentID]
+	if !ok {
+		return repository.ErrIncidentNotFound
+	}
+	if status == "resolved" {
+		return repository.ErrIncidentResolved
+	}
+	r.incidents[resolution.IncidentID] = "resolved"
+	r.resolved = append(r.resolved, resolution)
+	return nil
+}
+
+func (r *fakeAdminRepository) ListFeatureFlags(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	var flags []*domain.FeatureFlag
+	for _, flag := range r.flags {
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+func (r *fakeAdminRepository) UpsertFeatureFlag(ctx context.Context, flag *domain.FeatureFlag) error {
+	if flag.TenantID != "" && r.tenants[flag.TenantID] == nil {
+		return repository.ErrTenantNotFound
+	}
+	r.flags[flag.Name+"/"+flag.TenantID] = flag
+	return nil
+}
+
+func (r *fakeAdminRepository) DeleteFeatureFlag(ctx context.Context, name, tenantID string) error {
+	if _, ok := r.flags[name+"/"+tenantID]; !ok {
+		return repository.ErrFeatureFlagNotFound
+	}
+	delete(r.flags, name+"/"+tenantID)
+	return nil
+}
+
+// fakeQueueMonitor возвращает заданное состояние очередей
+type fakeQueueMonitor map[string]*rabbitmq.QueueStats
+
+func (m fakeQueueMonitor) Poll(ctx context.Context, queue string) (*rabbitmq.QueueStats, error) {
+	stats, ok := m[queue]
+	if !ok {
+		return nil, errors.New("queue not found")
+	}
+	return stats, nil
+}
+
+func newAdminService(t *testing.T) (*service.AdminService, *fakeAdminRepository) {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	repo := newFakeAdminRepository()
+	return service.NewAdminService(repo, testLogger), repo
+}
+
+func errorCode(err error) pkgerrors.ErrorCode {
+	var serviceErr *pkgerrors.Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr.Code
+	}
+	return ""
+}
+
+func TestAdminService_ListTenants(t *testing.T) {
+	adminService, repo := newAdminService(t)
+	ctx := context.Background()
+
+	tenants, err := adminService.ListTenants(ctx, domain.TenantUsageFilter{})
+	require.NoError(t, err)
+	assert.Len(t, tenants, 1)
+	assert.Equal(t, service.DefaultTenantPageSize, repo.filter.Limit)
+
+	_, err = adminService.ListTenants(ctx, domain.TenantUsageFilter{Limit: 10000})
+	require.NoError(t, err)
+	assert.Equal(t, service.MaxTenantPageSize, repo.filter.Limit)
+
+	tenants, err = adminService.ListTenants(ctx, domain.TenantUsageFilter{Status: domain.TenantStatusSuspended})
+	require.NoError(t, err)
+	assert.NotNil(t, tenants)
+	assert.Empty(t, tenants)
+
+	_, err = adminService.ListTenants(ctx, domain.TenantUsageFilter{Status: "deleted"})
+	assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+}
+
+func TestAdminService_SuspendTenant(t *testing.T) {
+	adminService, _ := newAdminService(t)
+	ctx := context.Background()
+
+	tenant, err := adminService.SuspendTenant(ctx, "tenant-1", "alice", "abuse report")
+	require.NoError(t, err)
+	assert.Equal(t, domain.TenantStatusSuspended, tenant.Status)
+
+	_, err = adminService.SuspendTenant(ctx, "tenant-1", "alice", "")
+	assert.True(t, errors.Is(err, service.ErrTenantStatusMismatch))
+
+	tenant, err = adminService.UnsuspendTenant(ctx, "tenant-1", "alice", "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.TenantStatusActive, tenant.Status)
+
+	_, err = adminService.SuspendTenant(ctx, "tenant-missing", "alice", "")
+	assert.Equal(t, pkgerrors.ErrNotFound, errorCode(err))
+}
+
+func TestAdminService_ForceResolveIncident(t *testing.T) {
+	adminService, repo := newAdminService(t)
+	ctx := context.Background()
+
+	_, err := adminService.ForceResolveIncident(ctx, "incident-1", "alice", "  ")
+	assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+
+	resolution, err := adminService.ForceResolveIncident(ctx, "incident-1", "alice", "check deleted, incident stuck")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", resolution.Operator)
+	assert.False(t, resolution.ResolvedAt.IsZero())
+	require.Len(t, repo.resolved, 1)
+
+	_, err = adminService.ForceResolveIncident(ctx, "incident-2", "alice", "duplicate")
+	assert.Equal(t, pkgerrors.ErrConflict, errorCode(err))
+
+	_, err = adminService.ForceResolveIncident(ctx, "incident-missing", "alice", "duplicate")
+	assert.Equal(t, pkgerrors.ErrNotFound, errorCode(err))
+}
+
+func TestAdminService_QueueDepths(t *testing.T) {
+	adminService, _ := newAdminService(t)
+	ctx := context.Background()
+
+	_, err := adminService.QueueDepths(ctx)
+	assert.True(t, errors.Is(err, service.ErrQueueMonitoringDisabled))
+
+	adminService.WithQueueMonitor(fakeQueueMonitor{
+		"check_tasks": {Messages: 12, MessagesReady: 10, MessagesUnacknowledged: 2, Consumers: 3},
+	}, "check_tasks", "incident_events")
+
+	depths, err := adminService.QueueDepths(ctx)
+	require.NoError(t, err)
+	require.Len(t, depths, 2)
+	assert.Equal(t, &domain.QueueDepth{Queue: "check_tasks", Messages: 12, MessagesReady: 10, MessagesUnacknowledged: 2, Consumers: 3}, depths[0])
+	assert.Equal(t, "incident_events", depths[1].Queue)
+	assert.NotEmpty(t, depths[1].Error)
+}
+
+func TestAdminService_FeatureFlags(t *testing.T) {
+	adminService, repo := newAdminService(t)
+	ctx := context.Background()
+
+	flag, err := adminService.SetFeatureFlag(ctx, &domain.FeatureFlag{Name: "check.dns", Enabled: true, UpdatedBy: "alice"})
+	require.NoError(t, err)
+	assert.False(t, flag.UpdatedAt.IsZero())
+
+	_, err = adminService.SetFeatureFlag(ctx, &domain.FeatureFlag{Name: "check.dns", TenantID: "tenant-1", UpdatedBy: "alice"})
+	require.NoError(t, err)
+	assert.Len(t, repo.flags, 2)
+
+	_, err = adminService.SetFeatureFlag(ctx, &domain.FeatureFlag{Name: "Check DNS", UpdatedBy: "alice"})
+	assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+
+	_, err = adminService.SetFeatureFlag(ctx, &domain.FeatureFlag{Name: "check.dns", TenantID: "tenant-missing", UpdatedBy: "alice"})
+	assert.True(t, errors.Is(err, service.ErrTenantNotFound))
+
+	require.NoError(t, adminService.DeleteFeatureFlag(ctx, "check.dns", "tenant-1", "alice"))
+	err = adminService.DeleteFeatureFlag(ctx, "check.dns", "tenant-1", "alice")
+	assert.True(t, errors.Is(err, service.ErrFeatureFlagNotFound))
+
+	flags, err := adminService.ListFeatureFlags(ctx)
+	require.NoError(t, err)
+	assert.Len(t, flags, 1)
+}
+
+func TestAdminService_Impersonate(t *testing.T) {
+	adminService, _ := newAdminService(t)
+	ctx := context.Background()
+
+	_, err := adminService.Impersonate(ctx, "tenant-1", "alice", "debug checks", 0)
+	assert.True(t, errors.Is(err, service.ErrImpersonationDisabled))
+
+	var signed *domain.Impersonation
+	adminService.WithImpersonation(service.ImpersonationSignerFunc(func(impersonation *domain.Impersonation) (string, error) {
+		signed = impersonation
+		return "signed-token", nil
+	}), "alice")
+
+	_, err = adminService.Impersonate(ctx, "tenant-1", "bob", "debug checks", 0)
+	assert.True(t, errors.Is(err, service.ErrImpersonationForbidden))
+	_, err = adminService.Impersonate(ctx, "tenant-1", "alice", "  ", 0)
+	assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+	_, err = adminService.Impersonate(ctx, "tenant-1", "alice", "debug checks", 3*time.Hour)
+	assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+	_, err = adminService.Impersonate(ctx, "tenant-missing", "alice", "debug checks", 0)
+	assert.True(t, errors.Is(err, service.ErrTenantNotFound))
+	assert.Nil(t, signed)
+
+	impersonation, err := adminService.Impersonate(ctx, "tenant-1", "alice", "debug checks", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "signed-token", impersonation.Token)
+	assert.Equal(t, "alice", impersonation.Operator)
+	assert.Equal(t, service.DefaultImpersonationTTL, impersonation.ExpiresAt.Sub(impersonation.IssuedAt))
+	assert.Equal(t, service.ImpersonationPermissions, impersonation.Permissions)
+	assert.Same(t, impersonation, signed)
+	for _, permission := range impersonation.Permissions {
+		assert.True(t, strings.HasSuffix(permission, ":read"), permission)
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/pkg/apikey"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// APIKeyService выпуск и проверка API ключей. Ключи хранятся только в виде открытого префикса
+// и соленого хеша пары key/secret
+type APIKeyService struct {
+	repo     repository.APIKeyRepository
+	security *SecurityNotifier
+	audit    *AuditLog
+	tenants  repository.TenantStatusRepository
+	logger   logger.Logger
+}
+
+// NewAPIKeyService создает сервис API ключей
+func NewAPIKeyService(repo repository.APIKeyRepository, logger logger.Logger) *APIKeyService {
+	return &APIKeyService{repo: repo, logger: logger}
+}
+
+// WithSecurityNotifier включает уведомление администраторов тенанта о выпуске ключей
+func (s *APIKeyService) WithSecurityNotifier(notifier *SecurityNotifier) *APIKeyService {
+	s.security = notifier
+	return s
+}
+
+// WithTenantStatus включает отказ в проверке ключей заблокированных тенантов
+func (s *APIKeyService) WithTenantStatus(tenants repository.TenantStatusRepository) *APIKeyService {
+	s.tenants = tenants
+	return s
+}
+
+// WithAuditLog включает запись выпуска ключей в журнал аудита тенанта
+func (s *APIKeyService) WithAuditLog(audit *AuditLog) *APIKeyService {
+	s.audit = audit
+	return s
+}
+
+// CreateAPIKey создает новую пару API ключей. allowedIPs ограничивает адреса, с которых
+// принимается ключ: IP адреса или CIDR подсети
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, tenantID, name string, allowedIPs ...string) (*APIKeyPair, error) {
+	// Валидация входных данных
+	if tenantID == "" {
+		return nil, errors.New(errors.ErrValidation, "tenant ID is required")
+	}
+
+	if name == "" {
+		return nil, errors.New(errors.ErrValidation, "name is required")
+	}
+
+	for _, allowed := range allowedIPs {
+		if _, err := domain.ParseAllowedIP(allowed); err != nil {
+			return nil, errors.New(errors.ErrValidation, fmt.Sprintf("invalid allowed IP %q", allowed))
+		}
+	}
+
+	// Генерация публичного ключа (key) и секретного ключа (secret)
+	keyPair, err := apikey.GenerateKeyPair()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to generate API key")
+	}
+	keyPrefix, ok := apikey.Prefix(keyPair.Key)
+	if !ok {
+		return nil, errors.New(errors.ErrInternal, "generated API key is too short")
+	}
+
+	// В БД сохраняются только открытый префикс и соленый хеш пары
+	secretHash, err := apikey.HashCredentials(keyPair.Key, keyPair.Secret)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to hash API key")
+	}
+
+	// Создание новой записи API ключа
+	apiKey := &domain.APIKey{
+		ID:         uuid.New().String(),
+		TenantID:   tenantID,
+		KeyPrefix:  keyPrefix,
+		SecretHash: secretHash,
+		Name:       name,
+		IsActive:   true,
+		AllowedIPs: allowedIPs,
+		ExpiresAt:  time.Now().UTC().Add(365 * 24 * time.Hour), // Срок действия 1 год
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	// Сохранение в БД
+	err = s.repo.Create(ctx, apiKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	event := &domain.SecurityEvent{
+		Type:       domain.SecurityEventAPIKeyCreated,
+		TenantID:   tenantID,
+		Details:    map[string]string{"key_name": name, "key_prefix": keyPrefix},
+		OccurredAt: apiKey.CreatedAt,
+	}
+	s.audit.RecordSecurity(ctx, event)
+	s.security.Notify(ctx, event)
+
+	// Возврат публичного и секретного ключей
+	// Секретный ключ возвращается только один раз
+	return &APIKeyPair{
+		Key:    keyPair.Key,
+		Secret: keyPair.Secret,
+	}, nil
+}
+
+// ValidateAPIKey проверяет валидность API ключа, предъявленного с адреса clientIP. Ключ ищется
+// по открытому префиксу, пара key/secret сверяется с соленым хешем за постоянное время. Неудачная
+// попытка с найденным ключом записывается в журнал аудита его тенанта
+func (s *APIKeyService) ValidateAPIKey(ctx context.Context, key, secret, clientIP string) (*Claims, error) {
+	// Валидация входных данных
+	if key == "" {
+		return nil, errors.New(errors.ErrValidation, "key is required")
+	}
+
+	if secret == "" {
+		return nil, errors.New(errors.ErrValidation, "secret is required")
+	}
+
+	keyPrefix, ok := apikey.Prefix(key)
+	if !ok {
+		s.auditAPIKeyFailure(ctx, "malformed_key", "", nil, clientIP)
+		return nil, ErrUnauthorized
+	}
+
+	// Поиск API ключа в БД по открытому префиксу
+	apiKey, err := s.repo.FindByPrefix(ctx, keyPrefix)
+	if err != nil {
+		if !stderrors.Is(err, repository.ErrAPIKeyNotFound) {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to find API key")
+		}
+		// Сравнение с фиктивным хешем выравнивает время ответа для неизвестного ключа
+		apikey.VerifyCredentials(key, secret, apikey.DummyCredentialsHash)
+		s.auditAPIKeyFailure(ctx, "unknown_key", keyPrefix, nil, clientIP)
+		return nil, ErrUnauthorized // ключ не найден
+	}
+
+	// Сравниваем пару с соленым хешем
+	if !apikey.VerifyCredentials(key, secret, apiKey.SecretHash) {
+		s.auditAPIKeyFailure(ctx, "invalid_secret", keyPrefix, apiKey, clientIP)
+		return nil, ErrUnauthorized // неверный секретный ключ
+	}
+
+	// Проверка активности ключа
+	if !apiKey.IsActive {
+		s.auditAPIKeyFailure(ctx, "revoked", keyPrefix, apiKey, clientIP)
+		return nil, ErrForbidden // ключ деактивирован
+	}
+
+	// Проверка срока действия
+	if apiKey.ExpiresAt.Before(time.Now().UTC()) {
+		s.auditAPIKeyFailure(ctx, "expired", keyPrefix, apiKey, clientIP)
+		return nil, errors.New(errors.ErrUnauthorized, "API key expired") // срок действия истек
+	}
+
+	// Проверка адреса клиента
+	if !apiKey.AllowsIP(clientIP) {
+		s.auditAPIKeyFailure(ctx, "ip_not_allowed", keyPrefix, apiKey, clientIP)
+		return nil, errors.New(errors.ErrForbidden, "API key is not allowed from this address")
+	}
+
+	// Ключи заблокированного тенанта не принимаются до снятия блокировки
+	if err := CheckTenantActive(ctx, s.tenants, apiKey.TenantID); err != nil {
+		if stderrors.Is(err, ErrTenantSuspended) {
+			s.auditAPIKeyFailure(ctx, "tenant_suspended", keyPrefix, apiKey, clientIP)
+		}
+		return nil, err
+	}
+
+	// Возвращаем данные для авторизации
+	return &Claims{
+		TenantID: apiKey.TenantID,
+		KeyID:    apiKey.ID,
+	}, nil
+}
+
+// auditAPIKeyFailure записывает неудачную аутентификацию по API ключу в журнал аудита тенанта,
+// которому принадлежит ключ. Попытки с префиксом, не совпавшим ни с одним ключом, тенанта не имеют
+// и только логируются. Секрет и полный ключ в журнал не попадают
+func (s *APIKeyService) auditAPIKeyFailure(ctx context.Context, reason, keyPrefix string, apiKey *domain.APIKey, clientIP string) {
+	if apiKey != nil && s.audit != nil {
+		s.audit.RecordSecurity(ctx, &domain.SecurityEvent{
+			Type:     domain.SecurityEventAPIKeyAuthFailed,
+			TenantID: apiKey.TenantID,
+			Details: map[string]string{
+				"reason":     reason,
+				"key_id":     apiKey.ID,
+				"key_prefix": keyPrefix,
+				"client_ip":  clientIP,
+			},
+			OccurredAt: time.Now().UTC(),
+		})
+		return
+	}
+
+	fields := []logger.Field{
+		logger.String("audit_event", "api_key.auth_failed"),
+		logger.String("reason", reason),
+		logger.String("key_prefix", keyPrefix),
+		logger.String("client_ip", clientIP),
+	}
+	if apiKey != nil {
+		fields = append(fields,
+			logger.String("key_id", apiKey.ID),
+			logger.String("tenant_id", apiKey.TenantID),
+		)
+	}
+	s.logger.Warn("API key authentication failed", fields...)
+}
+
+// RevokeAPIKey деактивирует API ключ
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, keyID string) error {
+	// Валидация входных данных
+	if keyID == "" {
+		return errors.New(errors.ErrValidation, "key ID is required")
+	}
+
+	// Поиск API ключа по ID
+	apiKey, err := s.repo.FindByID(ctx, keyID)
+	if err != nil {
+		return ErrNotFound // ключ не найден
+	}
+
+	// Деактивация ключа
+	apiKey.IsActive = false
+
+	// Обновление в БД
+	err = s.repo.Update(ctx, apiKey)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// AuditLog журнал аудита тенантов. Журнал входит в экспорт данных тенанта
+type AuditLog struct {
+	repo   repository.AuditRepository
+	logger logger.Logger
+	now    func() time.Time
+}
+
+// NewAuditLog создает журнал аудита
+func NewAuditLog(repo repository.AuditRepository, logger logger.Logger) *AuditLog {
+	return &AuditLog{repo: repo, logger: logger, now: time.Now}
+}
+
+// Record записывает событие тенанта. Ошибки только логируются: журнал не должен прерывать
+// операцию, которая его вызвала. На nil журнале ничего не делает
+func (a *AuditLog) Record(ctx context.Context, tenantID, event, actorID string, data map[string]string) {
+	if a == nil || tenantID == "" {
+		return
+	}
+	err := a.repo.Record(ctx, &domain.AuditEvent{
+		ID:        uuid.New().String(),
+		TenantID:  tenantID,
+		Event:     event,
+		ActorID:   actorID,
+		Data:      data,
+		CreatedAt: a.now().UTC(),
+	})
+	if err != nil {
+		a.logger.Warn("Failed to record audit event",
+			logger.String("audit_event", event),
+			logger.String("tenant_id", tenantID),
+			logger.Error(err))
+	}
+}
+
+// RecordSecurity записывает событие безопасности с типом security.<тип события>
+func (a *AuditLog) RecordSecurity(ctx context.Context, event *domain.SecurityEvent) {
+	if a == nil || event == nil {
+		return
+	}
+	data := make(map[string]string, len(event.Details)+2)
+	for key, value := range event.Details {
+		data[key] = value
+	}
+	if event.UserID != "" {
+		data["user_id"] = event.UserID
+	}
+	if event.Email != "" {
+		data["email"] = event.Email
+	}
+	a.Record(ctx, event.TenantID, "security."+event.Type, event.Details["actor_id"], data)
+}
@@ -0,0 +1,128 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// fakeAuditRepository хранит события журнала аудита в памяти
+type fakeAuditRepository struct {
+	events []*domain.AuditEvent
+	err    error
+}
+
+func (r *fakeAuditRepository) Record(ctx context.Context, event *domain.AuditEvent) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+func newAuditLog(t *testing.T) (*service.AuditLog, *fakeAuditRepository) {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	repo := &fakeAuditRepository{}
+	return service.NewAuditLog(repo, testLogger), repo
+}
+
+func TestAuditLog_RecordSecurity(t *testing.T) {
+	audit, repo := newAuditLog(t)
+
+	audit.RecordSecurity(context.Background(), &domain.SecurityEvent{
+		Type:     domain.SecurityEventRoleChanged,
+		TenantID: "tenant-1",
+		UserID:   "user-2",
+		Email:    "ops@acme.test",
+		Details:  map[string]string{"role": "admin", "actor_id": "user-1"},
+	})
+
+	require.Len(t, repo.events, 1)
+	event := repo.events[0]
+	assert.NotEmpty(t, event.ID)
+	assert.Equal(t, "tenant-1", event.TenantID)
+	assert.Equal(t, "security.role_changed", event.Event)
+	assert.Equal(t, "user-1", event.ActorID)
+	assert.Equal(t, map[string]string{"role": "admin", "actor_id": "user-1", "user_id": "user-2", "email": "ops@acme.test"}, event.Data)
+	assert.False(t, event.CreatedAt.IsZero())
+}
+
+func TestAuditLog_IgnoresFailuresAndNilLog(t *testing.T) {
+	audit, repo := newAuditLog(t)
+	repo.err = errors.New("database is down")
+	audit.Record(context.Background(), "tenant-1", "impersonation.started", "alice", nil)
+	assert.Empty(t, repo.events)
+
+	var disabled *service.AuditLog
+	disabled.Record(context.Background(), "tenant-1", "impersonation.started", "alice", nil)
+	disabled.RecordSecurity(context.Background(), &domain.SecurityEvent{TenantID: "tenant-1"})
+}
+
+func TestAdminService_Impersonate_RecordsAudit(t *testing.T) {
+	adminService, _ := newAdminService(t)
+	audit, repo := newAuditLog(t)
+	adminService.WithAuditLog(audit).
+		WithImpersonation(service.ImpersonationSignerFunc(func(*domain.Impersonation) (string, error) {
+			return "signed-token", nil
+		}), "alice")
+
+	impersonation, err := adminService.Impersonate(context.Background(), "tenant-1", "alice", "debug checks", 0)
+	require.NoError(t, err)
+
+	require.Len(t, repo.events, 1)
+	assert.Equal(t, "tenant-1", repo.events[0].TenantID)
+	assert.Equal(t, "impersonation.started", repo.events[0].Event)
+	assert.Equal(t, "alice", repo.events[0].ActorID)
+	assert.Equal(t, impersonation.ID, repo.events[0].Data["impersonation_id"])
+	assert.Equal(t, "debug checks", repo.events[0].Data["reason"])
+}
+
+func TestAPIKeyService_ValidateAPIKey_RecordsFailures(t *testing.T) {
+	audit, repo := newAuditLog(t)
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	apiKeyRepo := &MockAPIKeyRepository{}
+	var stored *domain.APIKey
+	apiKeyRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.APIKey")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*domain.APIKey) }).
+		Return(nil)
+	apiKeyRepo.On("FindByPrefix", mock.Anything, "upk_unknownkey12").Return(nil, repository.ErrAPIKeyNotFound)
+	apiKeys := service.NewAPIKeyService(apiKeyRepo, testLogger).WithAuditLog(audit)
+
+	pair, err := apiKeys.CreateAPIKey(context.Background(), "tenant-1", "ci", "203.0.113.0/24")
+	require.NoError(t, err)
+	apiKeyRepo.On("FindByPrefix", mock.Anything, stored.KeyPrefix).Return(stored, nil)
+	repo.events = nil
+
+	// Ключ найден: попытка попадает в журнал тенанта
+	_, err = apiKeys.ValidateAPIKey(context.Background(), pair.Key, pair.Secret, "198.51.100.1")
+	require.Error(t, err)
+	require.Len(t, repo.events, 1)
+	event := repo.events[0]
+	assert.Equal(t, "tenant-1", event.TenantID)
+	assert.Equal(t, "security.api_key_auth_failed", event.Event)
+	assert.Equal(t, map[string]string{
+		"reason":     "ip_not_allowed",
+		"key_id":     stored.ID,
+		"key_prefix": stored.KeyPrefix,
+		"client_ip":  "198.51.100.1",
+	}, event.Data)
+
+	// Неизвестный префикс тенанта не имеет и в журнал не пишется
+	_, err = apiKeys.ValidateAPIKey(context.Background(), "upk_unknownkey1234567890", "sec_secret", "203.0.113.7")
+	require.Error(t, err)
+	assert.Len(t, repo.events, 1)
+}
@@ -9,8 +9,6 @@ import (
 	"UptimePingPlatform/services/auth-service/internal/pkg/password"
 	"UptimePingPlatform/services/auth-service/internal/repository"
 	"context"
-	"crypto/rand"
-	"crypto/sha256"
 	"fmt"
 	"github.com/google/uuid"
 	"strings"
@@ -33,6 +31,7 @@ var ErrConflict = errors.New(errors.ErrConflict, "user already exists")
 type TokenPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
+	TenantID     string `json:"tenant_id"`
 }
 
 // APIKeyPair структура для хранения пары API ключей
@@ -55,10 +54,11 @@ type AuthService interface {
 	Register(ctx context.Context, email, password, tenantName string) (*TokenPair, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
 	Logout(ctx context.Context, userID, tokenID string) error
-	CreateAPIKey(ctx context.Context, tenantID, name string) (*APIKeyPair, error)
-	ValidateAPIKey(ctx context.Context, key, secret string) (*Claims, error)
+	CreateAPIKey(ctx context.Context, tenantID, name string, allowedIPs ...string) (*APIKeyPair, error)
+	ValidateAPIKey(ctx context.Context, key, secret, clientIP string) (*Claims, error)
 	RevokeAPIKey(ctx context.Context, keyID string) error
 	GetUserByID(ctx context.Context, userID string) (*domain.User, error)
+	SetUserRole(ctx context.Context, actorID, userID string, isAdmin bool) (*domain.User, error)
 }
 
 // Service реализация AuthService
@@ -66,10 +66,14 @@ type Service struct {
 	userRepository    repository.UserRepository
 	tenantRepository  repository.TenantRepository
 	sessionRepository repository.SessionRepository
-	apiKeyRepository  repository.APIKeyRepository
+	apiKeys           *APIKeyService
 	jwtManager        jwt.JWTManager
 	passwordHasher    password.Hasher
 	tokenHasher       *hash.TokenHasher
+	security          *SecurityNotifier
+	audit             *AuditLog
+	loginCountries    repository.LoginCountryRepository
+	tenantStatus      repository.TenantStatusRepository
 	log               logger.Logger
 }
 
@@ -86,7 +90,7 @@ func NewAuthService(
 	return &Service{
 		userRepository:    userRepository,
 		tenantRepository:  tenantRepository,
-		apiKeyRepository:  apiKeyRepository,
+		apiKeys:           NewAPIKeyService(apiKeyRepository, log),
 		sessionRepository: sessionRepository,
 		jwtManager:        jwtManager,
 		passwordHasher:    passwordHasher,
@@ -95,148 +99,49 @@ func NewAuthService(
 	}
 }
 
-// generateAPIKey генерирует случайную строку заданной длины
-func generateAPIKey(length int) string {
-	const chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	key := make([]byte, length)
-	_, err := rand.Read(key)
-	if err != nil {
-		// В случае ошибки используем простую генерацию
-		for i := range key {
-			key[i] = chars[i%len(chars)]
-		}
-	} else {
-		for i := range key {
-			key[i] = chars[int(key[i])%len(chars)]
-		}
-	}
-	return string(key)
+// WithSecurityNotifier включает уведомления администраторов тенанта о новых пользователях,
+// смене ролей и выпуске API ключей
+func (s *Service) WithSecurityNotifier(notifier *SecurityNotifier) *Service {
+	s.security = notifier
+	s.apiKeys.WithSecurityNotifier(notifier)
+	return s
 }
 
-// hashAPIKey хеширует ключ с использованием SHA256
-func (s *Service) hashAPIKey(key string) string {
-	return fmt.Sprintf("%x", sha256.Sum256([]byte(key)))
+// WithTenantStatus включает отказ во входе, обновлении токенов и проверке API ключей
+// пользователям заблокированных тенантов
+func (s *Service) WithTenantStatus(tenants repository.TenantStatusRepository) *Service {
+	s.tenantStatus = tenants
+	s.apiKeys.WithTenantStatus(tenants)
+	return s
 }
 
-// CreateAPIKey создает новую пару API ключей
-func (s *Service) CreateAPIKey(ctx context.Context, tenantID, name string) (*APIKeyPair, error) {
-	// Валидация входных данных
-	if tenantID == "" {
-		return nil, errors.New(errors.ErrValidation, "tenant ID is required")
-	}
-
-	if name == "" {
-		return nil, errors.New(errors.ErrValidation, "name is required")
-	}
-
-	// Генерация публичного ключа (key)
-	key := generateAPIKey(16) // 16 символов для публичного ключа
-
-	// Генерация секретного ключа (secret)
-	secret := generateAPIKey(32) // 32 символа для секретного ключа
-
-	// Хеширование ключей
-	keyHash := s.hashAPIKey(key)
-	secretHash := s.hashAPIKey(secret)
-
-	// Убедимся, что хэши не пустые
-	if keyHash == "" || secretHash == "" {
-		return nil, errors.New(errors.ErrInternal, "failed to hash API keys")
-	}
-	// Создание новой записи API ключа
-	apiKey := &domain.APIKey{
-		ID:         uuid.New().String(),
-		TenantID:   tenantID,
-		KeyHash:    keyHash,
-		SecretHash: secretHash,
-		Name:       name,
-		IsActive:   true,
-		ExpiresAt:  time.Now().UTC().Add(365 * 24 * time.Hour), // Срок действия 1 год
-		CreatedAt:  time.Now().UTC(),
-	}
+// WithAuditLog включает запись событий безопасности тенанта в журнал аудита
+func (s *Service) WithAuditLog(audit *AuditLog) *Service {
+	s.audit = audit
+	s.apiKeys.WithAuditLog(audit)
+	return s
+}
 
-	// Сохранение в БД
-	err := s.apiKeyRepository.Create(ctx, apiKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create API key: %w", err)
-	}
+// WithLoginCountries включает уведомление о входе из новой для пользователя страны.
+// Страна клиента передается в контексте через WithLoginCountry
+func (s *Service) WithLoginCountries(repo repository.LoginCountryRepository) *Service {
+	s.loginCountries = repo
+	return s
+}
 
-	// Возврат публичного и секретного ключей
-	// Секретный ключ возвращается только один раз
-	return &APIKeyPair{
-		Key:    key,
-		Secret: secret,
-	}, nil
+// CreateAPIKey создает новую пару API ключей
+func (s *Service) CreateAPIKey(ctx context.Context, tenantID, name string, allowedIPs ...string) (*APIKeyPair, error) {
+	return s.apiKeys.CreateAPIKey(ctx, tenantID, name, allowedIPs...)
 }
 
 // ValidateAPIKey проверяет валидность API ключа
-func (s *Service) ValidateAPIKey(ctx context.Context, key, secret string) (*Claims, error) {
-	// Валидация входных данных
-	if key == "" {
-		return nil, errors.New(errors.ErrValidation, "key is required")
-	}
-
-	if secret == "" {
-		return nil, errors.New(errors.ErrValidation, "secret is required")
-	}
-
-	// Хешируем ключи для поиска
-	keyHash := s.hashAPIKey(key)
-
-	// Поиск API ключа в БД по хэшу публичного ключа
-	apiKey, err := s.apiKeyRepository.FindByKeyHash(ctx, keyHash)
-	if err != nil {
-		return nil, ErrUnauthorized // ключ не найден
-	}
-
-	// Проверка активности ключа
-	if !apiKey.IsActive {
-		return nil, ErrForbidden // ключ деактивирован
-	}
-
-	// Проверка срока действия
-	if apiKey.ExpiresAt.Before(time.Now().UTC()) {
-		return nil, errors.New(errors.ErrUnauthorized, "API key expired") // срок действия истек
-	}
-
-	// Хешируем предоставленный секретный ключ
-	secretHash := s.hashAPIKey(secret)
-
-	// Сравниваем хэши секретных ключей
-	if secretHash != apiKey.SecretHash {
-		return nil, ErrUnauthorized // неверный секретный ключ
-	}
-
-	// Возвращаем данные для авторизации
-	return &Claims{
-		TenantID: apiKey.TenantID,
-		KeyID:    apiKey.ID,
-	}, nil
+func (s *Service) ValidateAPIKey(ctx context.Context, key, secret, clientIP string) (*Claims, error) {
+	return s.apiKeys.ValidateAPIKey(ctx, key, secret, clientIP)
 }
 
 // RevokeAPIKey деактивирует API ключ
 func (s *Service) RevokeAPIKey(ctx context.Context, keyID string) error {
-	// Валидация входных данных
-	if keyID == "" {
-		return errors.New(errors.ErrValidation, "key ID is required")
-	}
-
-	// Поиск API ключа по ID
-	apiKey, err := s.apiKeyRepository.FindByID(ctx, keyID)
-	if err != nil {
-		return ErrNotFound // ключ не найден
-	}
-
-	// Деактивация ключа
-	apiKey.IsActive = false
-
-	// Обновление в БД
-	err = s.apiKeyRepository.Update(ctx, apiKey)
-	if err != nil {
-		return fmt.Errorf("failed to revoke API key: %w", err)
-	}
-
-	return nil
+	return s.apiKeys.RevokeAPIKey(ctx, keyID)
 }
 
 // Login реализует аутентификацию пользователя
@@ -279,6 +184,15 @@ func (s *Service) Login(ctx context.Context, email, password string) (*TokenPair
 		return nil, errors.New(errors.ErrUnauthorized, "invalid credentials")
 	}
 
+	// Пользователи заблокированного тенанта не входят до снятия блокировки
+	if err := CheckTenantActive(ctx, s.tenantStatus, user.TenantID); err != nil {
+		s.log.Warn("Login failed: tenant is not available",
+			logger.String("email", email),
+			logger.String("tenant_id", user.TenantID),
+			logger.Error(err))
+		return nil, err
+	}
+
 	// Генерация JWT токенов
 	accessToken, refreshToken, err := s.jwtManager.GenerateToken(user.ID, user.TenantID, user.IsAdmin)
 	if err != nil {
@@ -334,10 +248,13 @@ func (s *Service) Login(ctx context.Context, email, password string) (*TokenPair
 		logger.String("user_id", user.ID),
 		logger.String("session_id", session.ID))
 
+	s.checkLoginCountry(ctx, user)
+
 	// Возвращаем токены
 	return &TokenPair{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		TenantID:     user.TenantID,
 	}, nil
 }
 
@@ -379,6 +296,7 @@ func (s *Service) Register(ctx context.Context, email, password, tenantName stri
 
 	// Создание или получение tenant по имени
 	tenant, err := s.tenantRepository.FindBySlug(ctx, generateSlug(tenantName))
+	joined := err == nil
 	if err != nil {
 		// Tenant не найден, создаем новый
 		tenant = &domain.Tenant{
@@ -458,10 +376,24 @@ func (s *Service) Register(ctx context.Context, email, password, tenantName stri
 		return nil, fmt.Errorf("failed to save session: %w", err)
 	}
 
+	// Присоединение к существующему тенанту - событие безопасности для его администраторов
+	if joined {
+		event := &domain.SecurityEvent{
+			Type:       domain.SecurityEventUserJoined,
+			TenantID:   tenant.ID,
+			UserID:     user.ID,
+			Email:      user.Email,
+			OccurredAt: user.CreatedAt,
+		}
+		s.audit.RecordSecurity(ctx, event)
+		s.security.Notify(ctx, event)
+	}
+
 	// Возвращаем токены
 	return &TokenPair{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
+		TenantID:     tenant.ID,
 	}, nil
 }
 
@@ -473,6 +405,11 @@ func (s *Service) RefreshToken(ctx context.Context, refreshToken string) (*Token
 		return nil, errors.Wrap(err, errors.ErrUnauthorized, "failed to validate refresh token")
 	}
 
+	// Заблокированный тенант не продлевает сессии
+	if err := CheckTenantActive(ctx, s.tenantStatus, claims.TenantID); err != nil {
+		return nil, err
+	}
+
 	// Хешируем refresh токен для поиска в Redis
 	hashedRefreshToken, err := refreshToken, nil
 	if err != nil {
@@ -528,6 +465,7 @@ func (s *Service) RefreshToken(ctx context.Context, refreshToken string) (*Token
 	return &TokenPair{
 		AccessToken:  newAccessToken,
 		RefreshToken: newRefreshToken,
+		TenantID:     claims.TenantID,
 	}, nil
 }
 
@@ -578,3 +516,89 @@ func (s *Service) GetUserByID(ctx context.Context, userID string) (*domain.User,
 
 	return user, nil
 }
+
+// SetUserRole назначает или снимает права администратора. Менять роли может только
+// администратор того же тенанта; снять права с самого себя нельзя
+func (s *Service) SetUserRole(ctx context.Context, actorID, userID string, isAdmin bool) (*domain.User, error) {
+	if actorID == "" || userID == "" {
+		return nil, errors.New(errors.ErrValidation, "actor ID and user ID are required")
+	}
+
+	actor, err := s.userRepository.FindByID(ctx, actorID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrNotFound, "user not found")
+	}
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrNotFound, "user not found")
+	}
+	if !actor.IsAdmin || !actor.IsActive || actor.TenantID != user.TenantID {
+		return nil, errors.New(errors.ErrForbidden, "tenant admin role required")
+	}
+	if actor.ID == user.ID && !isAdmin {
+		return nil, errors.New(errors.ErrValidation, "admins cannot revoke their own admin role")
+	}
+	if user.IsAdmin == isAdmin {
+		return user, nil
+	}
+
+	user.IsAdmin = isAdmin
+	user.UpdatedAt = time.Now().UTC()
+	if err := s.userRepository.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	role := "member"
+	if isAdmin {
+		role = "admin"
+	}
+	s.log.Warn("User role changed",
+		logger.String("audit_event", "user.role_changed"),
+		logger.String("tenant_id", user.TenantID),
+		logger.String("user_id", user.ID),
+		logger.String("actor_id", actor.ID),
+		logger.String("role", role))
+	event := &domain.SecurityEvent{
+		Type:       domain.SecurityEventRoleChanged,
+		TenantID:   user.TenantID,
+		UserID:     user.ID,
+		Email:      user.Email,
+		Details:    map[string]string{"role": role, "actor_id": actor.ID, "actor_email": actor.Email},
+		OccurredAt: user.UpdatedAt,
+	}
+	s.audit.RecordSecurity(ctx, event)
+	s.security.Notify(ctx, event)
+
+	return user, nil
+}
+
+// checkLoginCountry запоминает страну входа и уведомляет администраторов, если пользователь
+// впервые вошел из нее, а раньше входил из других стран. Ошибки не прерывают вход
+func (s *Service) checkLoginCountry(ctx context.Context, user *domain.User) {
+	country := LoginCountry(ctx)
+	if s.loginCountries == nil || country == "" {
+		return
+	}
+
+	isNew, hadOthers, err := s.loginCountries.Remember(ctx, user.ID, country)
+	if err != nil {
+		s.log.Warn("Failed to remember login country",
+			logger.String("user_id", user.ID),
+			logger.Error(err))
+		return
+	}
+	if !isNew || !hadOthers {
+		return
+	}
+
+	event := &domain.SecurityEvent{
+		Type:       domain.SecurityEventNewCountryLogin,
+		TenantID:   user.TenantID,
+		UserID:     user.ID,
+		Email:      user.Email,
+		Details:    map[string]string{"country": country},
+		OccurredAt: time.Now().UTC(),
+	}
+	s.audit.RecordSecurity(ctx, event)
+	s.security.Notify(ctx, event)
+}
@@ -2,13 +2,16 @@ package service_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/pkg/apikey"
 	"UptimePingPlatform/services/auth-service/internal/pkg/jwt"
 	"UptimePingPlatform/services/auth-service/internal/pkg/password"
+	"UptimePingPlatform/services/auth-service/internal/repository"
 	"UptimePingPlatform/services/auth-service/internal/service"
 
 	"github.com/stretchr/testify/assert"
@@ -47,6 +50,14 @@ func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) erro
 	return args.Error(0)
 }
 
+func (m *MockUserRepository) ListAdmins(ctx context.Context, tenantID string) ([]*domain.User, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.User), args.Error(1)
+}
+
 func (m *MockUserRepository) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -104,8 +115,8 @@ func (m *MockAPIKeyRepository) FindByID(ctx context.Context, id string) (*domain
 	return args.Get(0).(*domain.APIKey), args.Error(1)
 }
 
-func (m *MockAPIKeyRepository) FindByKeyHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
-	args := m.Called(ctx, keyHash)
+func (m *MockAPIKeyRepository) FindByPrefix(ctx context.Context, keyPrefix string) (*domain.APIKey, error) {
+	args := m.Called(ctx, keyPrefix)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -289,29 +300,28 @@ func TestAuthService_ValidateAPIKey_InvalidSecret(t *testing.T) {
 	authService, _, _, apiKeyRepo, _ := setupAuthService()
 
 	ctx := context.Background()
-	key := "upk_testkey123"
+	key := "upk_testkey1234567890abcd"
 	secret := "wrongsecret"
 
-	// Создаем хеши
-	passwordHasher := password.NewBcryptHasher(10)
-	keyHash, _ := passwordHasher.Hash(key)
-	secretHash, _ := passwordHasher.Hash("correctsecret")
+	// Создаем соленый хеш правильной пары
+	secretHash, err := apikey.HashCredentials(key, "correctsecret")
+	require.NoError(t, err)
 
 	existingAPIKey := &domain.APIKey{
 		ID:         "key-1",
 		TenantID:   "tenant-1",
-		KeyHash:    keyHash,
+		KeyPrefix:  "upk_testkey12345",
 		SecretHash: secretHash,
 		Name:       "Test API Key",
 		IsActive:   true,
 		ExpiresAt:  time.Now().Add(time.Hour), // Не истекший ключ
 	}
 
-	// Мокаем поиск по хэшу ключа
-	apiKeyRepo.On("FindByKeyHash", ctx, mock.AnythingOfType("string")).Return(existingAPIKey, nil)
+	// Мокаем поиск по префиксу ключа
+	apiKeyRepo.On("FindByPrefix", ctx, "upk_testkey12345").Return(existingAPIKey, nil)
 
 	// Вызываем метод
-	claims, err := authService.ValidateAPIKey(ctx, key, secret)
+	claims, err := authService.ValidateAPIKey(ctx, key, secret, "203.0.113.7")
 
 	// Проверяем результаты
 	assert.Error(t, err)
@@ -321,3 +331,71 @@ func TestAuthService_ValidateAPIKey_InvalidSecret(t *testing.T) {
 	// Проверяем, что мок был вызван
 	apiKeyRepo.AssertExpectations(t)
 }
+
+func TestAuthService_ValidateAPIKey_StoredKey(t *testing.T) {
+	authService, _, _, apiKeyRepo, _ := setupAuthService()
+	ctx := context.Background()
+
+	// Сохраняем ключ так, как его сохраняет CreateAPIKey
+	var stored *domain.APIKey
+	apiKeyRepo.On("Create", ctx, mock.AnythingOfType("*domain.APIKey")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*domain.APIKey) }).
+		Return(nil)
+	pair, err := authService.CreateAPIKey(ctx, "tenant-1", "CI", "203.0.113.0/24", "2001:db8::1")
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+
+	// В БД нет ни ключа, ни секрета в открытом виде
+	assert.Len(t, stored.KeyPrefix, apikey.PrefixLength)
+	assert.True(t, strings.HasPrefix(pair.Key, stored.KeyPrefix))
+	assert.NotContains(t, stored.SecretHash, pair.Secret)
+	assert.NotContains(t, stored.SecretHash, pair.Key)
+
+	apiKeyRepo.On("FindByPrefix", ctx, stored.KeyPrefix).Return(stored, nil)
+
+	claims, err := authService.ValidateAPIKey(ctx, pair.Key, pair.Secret, "203.0.113.7")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-1", claims.TenantID)
+	assert.Equal(t, stored.ID, claims.KeyID)
+
+	_, err = authService.ValidateAPIKey(ctx, pair.Key, pair.Secret, "2001:db8::1")
+	assert.NoError(t, err)
+
+	// Адрес вне списка разрешенных и неизвестный адрес отклоняются
+	_, err = authService.ValidateAPIKey(ctx, pair.Key, pair.Secret, "198.51.100.1")
+	assert.Error(t, err)
+	_, err = authService.ValidateAPIKey(ctx, pair.Key, pair.Secret, "")
+	assert.Error(t, err)
+
+	// Ключ с тем же префиксом, но другим окончанием не подходит
+	_, err = authService.ValidateAPIKey(ctx, stored.KeyPrefix+"tampered", pair.Secret, "203.0.113.7")
+	assert.Equal(t, service.ErrUnauthorized, err)
+
+	// Отозванный ключ отклоняется
+	stored.IsActive = false
+	_, err = authService.ValidateAPIKey(ctx, pair.Key, pair.Secret, "203.0.113.7")
+	assert.Equal(t, service.ErrForbidden, err)
+}
+
+func TestAuthService_ValidateAPIKey_UnknownKey(t *testing.T) {
+	authService, _, _, apiKeyRepo, _ := setupAuthService()
+	ctx := context.Background()
+
+	apiKeyRepo.On("FindByPrefix", ctx, "upk_unknownkey12").Return(nil, repository.ErrAPIKeyNotFound)
+
+	claims, err := authService.ValidateAPIKey(ctx, "upk_unknownkey1234567890", "sec_secret", "203.0.113.7")
+	assert.Nil(t, claims)
+	assert.Equal(t, service.ErrUnauthorized, err)
+
+	// Слишком короткий ключ отклоняется без запроса к БД
+	_, err = authService.ValidateAPIKey(ctx, "upk_short", "sec_secret", "203.0.113.7")
+	assert.Equal(t, service.ErrUnauthorized, err)
+	apiKeyRepo.AssertExpectations(t)
+}
+
+func TestAuthService_CreateAPIKey_InvalidAllowedIP(t *testing.T) {
+	authService, _, _, _, _ := setupAuthService()
+
+	_, err := authService.CreateAPIKey(context.Background(), "tenant-1", "CI", "office.example.com")
+	assert.Error(t, err)
+}
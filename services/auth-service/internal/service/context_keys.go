@@ -1,5 +1,10 @@
 package service
 
+import (
+	"context"
+	"strings"
+)
+
 // Ключи для использования в контексте
 // Используются для передачи данных между middleware и обработчиками
 
@@ -11,3 +16,22 @@ var TenantIDKey = "tenant_id"
 
 // IsAdminKey ключ для хранения флага администратора в контексте
 var IsAdminKey = "is_admin"
+
+// loginCountryKey ключ страны клиента в контексте входа
+type loginCountryKey struct{}
+
+// WithLoginCountry добавляет в контекст код страны клиента (ISO 3166-1 alpha-2),
+// определенный пограничным прокси. Некорректные коды не сохраняются
+func WithLoginCountry(ctx context.Context, country string) context.Context {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if len(country) != 2 || country[0] < 'A' || country[0] > 'Z' || country[1] < 'A' || country[1] > 'Z' {
+		return ctx
+	}
+	return context.WithValue(ctx, loginCountryKey{}, country)
+}
+
+// LoginCountry возвращает код страны клиента из контекста или пустую строку
+func LoginCountry(ctx context.Context) string {
+	country, _ := ctx.Value(loginCountryKey{}).(string)
+	return country
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+const (
+	// DefaultDeletionGracePeriod срок, в течение которого удаление тенанта можно отменить
+	DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+	// exportFormat версия формата архива экспорта
+	exportFormat = "uptimeping-tenant-export/v1"
+)
+
+// ErrTenantNotFound ошибка, когда тенант не найден
+var ErrTenantNotFound = errors.New(errors.ErrNotFound, "tenant not found")
+
+// ErrDeletionNotScheduled ошибка, когда удаление тенанта не запланировано
+var ErrDeletionNotScheduled = errors.New(errors.ErrNotFound, "tenant deletion is not scheduled")
+
+// ErrDeletionScheduled ошибка, когда удаление тенанта уже запланировано
+var ErrDeletionScheduled = errors.New(errors.ErrConflict, "tenant deletion is already scheduled")
+
+// OffboardingService экспорт данных тенанта и удаление тенанта с периодом отмены
+// (переносимость и удаление данных по GDPR)
+type OffboardingService struct {
+	repo        repository.OffboardingRepository
+	logger      logger.Logger
+	gracePeriod time.Duration
+	now         func() time.Time
+}
+
+// NewOffboardingService создает сервис с периодом отмены удаления по умолчанию
+func NewOffboardingService(repo repository.OffboardingRepository, logger logger.Logger) *OffboardingService {
+	return &OffboardingService{
+		repo:        repo,
+		logger:      logger,
+		gracePeriod: DefaultDeletionGracePeriod,
+		now:         time.Now,
+	}
+}
+
+// WithGracePeriod задает срок, в течение которого удаление можно отменить
+func (s *OffboardingService) WithGracePeriod(gracePeriod time.Duration) *OffboardingService {
+	s.gracePeriod = gracePeriod
+	return s
+}
+
+// Export записывает в w zip архив со всеми данными тенанта: manifest.json и по файлу JSON Lines
+// на раздел. Существование тенанта проверяется до записи первых байт архива
+func (s *OffboardingService) Export(ctx context.Context, tenantID string, w io.Writer) (*domain.ExportManifest, error) {
+	if _, err := s.findDeletion(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	manifest := &domain.ExportManifest{
+		TenantID:    tenantID,
+		GeneratedAt: s.now().UTC(),
+		Format:      exportFormat,
+	}
+
+	archive := zip.NewWriter(w)
+	for _, section := range domain.ExportSections {
+		entry, err := s.exportSection(ctx, archive, tenantID, section)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to export tenant data").
+				WithDetails(fmt.Sprintf("tenant_id: %s, section: %s", tenantID, section))
+		}
+		manifest.Sections = append(manifest.Sections, entry)
+	}
+
+	file, err := archive.Create("manifest.json")
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to write export manifest")
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(manifest); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to write export manifest")
+	}
+	if err := archive.Close(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to finalize export archive")
+	}
+
+	s.logger.Info("Tenant data exported",
+		logger.String("tenant_id", tenantID),
+		logger.Int("sections", len(manifest.Sections)),
+	)
+	return manifest, nil
+}
+
+// exportSection записывает раздел в отдельный файл архива
+func (s *OffboardingService) exportSection(ctx context.Context, archive *zip.Writer, tenantID string, section domain.ExportSection) (domain.ExportManifestEntry, error) {
+	entry := domain.ExportManifestEntry{Section: section, File: string(section) + ".jsonl"}
+
+	file, err := archive.Create(entry.File)
+	if err != nil {
+		return entry, err
+	}
+	err = s.repo.ExportSection(ctx, tenantID, section, func(record []byte) error {
+		entry.Records++
+		if _, err := file.Write(record); err != nil {
+			return err
+		}
+		_, err := file.Write([]byte("\n"))
+		return err
+	})
+	return entry, err
+}
+
+// RequestDeletion планирует безвозвратное удаление тенанта по истечении периода отмены
+func (s *OffboardingService) RequestDeletion(ctx context.Context, tenantID, requestedBy string) (*domain.TenantDeletion, error) {
+	existing, err := s.findDeletion(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, ErrDeletionScheduled
+	}
+
+	now := s.now().UTC()
+	deletion := &domain.TenantDeletion{
+		TenantID:    tenantID,
+		RequestedAt: now,
+		RequestedBy: requestedBy,
+		PurgeAt:     now.Add(s.gracePeriod),
+	}
+	if err := s.repo.ScheduleDeletion(ctx, deletion); err != nil {
+		return nil, s.repositoryError(err, "failed to schedule tenant deletion")
+	}
+
+	s.logger.Warn("Tenant deletion scheduled",
+		logger.String("tenant_id", tenantID),
+		logger.String("requested_by", requestedBy),
+		logger.String("purge_at", deletion.PurgeAt.Format(time.RFC3339)),
+	)
+	return deletion, nil
+}
+
+// CancelDeletion отменяет запланированное удаление
+func (s *OffboardingService) CancelDeletion(ctx context.Context, tenantID string) error {
+	existing, err := s.findDeletion(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrDeletionNotScheduled
+	}
+	if err := s.repo.CancelDeletion(ctx, tenantID); err != nil {
+		return s.repositoryError(err, "failed to cancel tenant deletion")
+	}
+
+	s.logger.Info("Tenant deletion cancelled", logger.String("tenant_id", tenantID))
+	return nil
+}
+
+// DeletionStatus возвращает запланированное удаление тенанта
+func (s *OffboardingService) DeletionStatus(ctx context.Context, tenantID string) (*domain.TenantDeletion, error) {
+	deletion, err := s.findDeletion(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion == nil {
+		return nil, ErrDeletionNotScheduled
+	}
+	return deletion, nil
+}
+
+// PurgeDue безвозвратно удаляет тенанты с истекшим периодом отмены. Ошибка удаления одного
+// тенанта не останавливает остальные
+func (s *OffboardingService) PurgeDue(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListDueDeletions(ctx, s.now())
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to list due tenant deletions")
+	}
+
+	purged := 0
+	var lastErr error
+	for _, id := range ids {
+		if err := s.repo.Purge(ctx, id); err != nil {
+			lastErr = err
+			s.logger.Error("Failed to purge tenant",
+				logger.String("tenant_id", id),
+				logger.Error(err),
+			)
+			continue
+		}
+		purged++
+		s.logger.Warn("Tenant purged", logger.String("tenant_id", id))
+	}
+	if lastErr != nil {
+		return purged, errors.Wrap(lastErr, errors.ErrInternal, "failed to purge tenants").
+			WithDetails(fmt.Sprintf("purged: %d, due: %d", purged, len(ids)))
+	}
+	return purged, nil
+}
+
+// RunPurger периодически удаляет тенанты с истекшим периодом отмены до отмены контекста
+func (s *OffboardingService) RunPurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.PurgeDue(ctx); err != nil {
+			s.logger.Error("Tenant purge run failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// findDeletion возвращает запланированное удаление или nil, проверяя существование тенанта
+func (s *OffboardingService) findDeletion(ctx context.Context, tenantID string) (*domain.TenantDeletion, error) {
+	if tenantID == "" {
+		return nil, errors.New(errors.ErrValidation, "tenant_id is required")
+	}
+	deletion, err := s.repo.FindDeletion(ctx, tenantID)
+	if err != nil {
+		return nil, s.repositoryError(err, "failed to get tenant deletion")
+	}
+	return deletion, nil
+}
+
+// repositoryError переводит ошибки репозитория в ошибки сервиса
+func (s *OffboardingService) repositoryError(err error, message string) error {
+	if stderrors.Is(err, repository.ErrTenantNotFound) {
+		return ErrTenantNotFound
+	}
+	return errors.Wrap(err, errors.ErrInternal, message)
+}
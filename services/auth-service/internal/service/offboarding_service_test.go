@@ -0,0 +1,195 @@
+package service_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// fakeOffboardingRepository хранит тенанты и записи разделов в памяти
+type fakeOffboardingRepository struct {
+	records   map[string]map[domain.ExportSection][]string
+	deletions map[string]*domain.TenantDeletion
+	purged    []string
+	exportErr error
+}
+
+func newFakeOffboardingRepository() *fakeOffboardingRepository {
+	return &fakeOffboardingRepository{
+		records: map[string]map[domain.ExportSection][]string{
+			"tenant-1": {
+				domain.ExportSectionTenant:       {`{"id":"tenant-1","name":"Acme"}`},
+				domain.ExportSectionUsers:        {`{"id":"user-1","email":"admin@acme.test"}`, `{"id":"user-2","email":"ops@acme.test"}`},
+				domain.ExportSectionChecks:       {`{"id":"check-1"}`},
+				domain.ExportSectionCheckResults: {`{"check_id":"check-1","status":"up"}`, `{"check_id":"check-1","status":"down"}`, `{"check_id":"check-1","status":"up"}`},
+			},
+		},
+		deletions: make(map[string]*domain.TenantDeletion),
+	}
+}
+
+func (r *fakeOffboardingRepository) ExportSection(ctx context.Context, tenantID string, section domain.ExportSection, emit func(record []byte) error) error {
+	if r.exportErr != nil && section == domain.ExportSectionCheckResults {
+		return r.exportErr
+	}
+	for _, record := range r.records[tenantID][section] {
+		if err := emit([]byte(record)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeOffboardingRepository) ScheduleDeletion(ctx context.Context, deletion *domain.TenantDeletion) error {
+	r.deletions[deletion.TenantID] = deletion
+	return nil
+}
+
+func (r *fakeOffboardingRepository) CancelDeletion(ctx context.Context, tenantID string) error {
+	delete(r.deletions, tenantID)
+	return nil
+}
+
+func (r *fakeOffboardingRepository) FindDeletion(ctx context.Context, tenantID string) (*domain.TenantDeletion, error) {
+	if _, ok := r.records[tenantID]; !ok {
+		return nil, repository.ErrTenantNotFound
+	}
+	return r.deletions[tenantID], nil
+}
+
+func (r *fakeOffboardingRepository) ListDueDeletions(ctx context.Context, now time.Time) ([]string, error) {
+	var ids []string
+	for id, deletion := range r.deletions {
+		if !deletion.PurgeAt.After(now) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeOffboardingRepository) Purge(ctx context.Context, tenantID string) error {
+	delete(r.records, tenantID)
+	delete(r.deletions, tenantID)
+	r.purged = append(r.purged, tenantID)
+	return nil
+}
+
+func newOffboardingService(repo repository.OffboardingRepository) *service.OffboardingService {
+	testLogger, _ := logger.NewLogger("test", "info", "test-service", false)
+	return service.NewOffboardingService(repo, testLogger)
+}
+
+// readArchive возвращает содержимое файлов zip архива
+func readArchive(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[file.Name] = string(content)
+	}
+	return files
+}
+
+func TestOffboardingService_Export(t *testing.T) {
+	offboarding := newOffboardingService(newFakeOffboardingRepository())
+
+	var buf bytes.Buffer
+	manifest, err := offboarding.Export(context.Background(), "tenant-1", &buf)
+	require.NoError(t, err)
+	assert.Len(t, manifest.Sections, len(domain.ExportSections))
+
+	files := readArchive(t, buf.Bytes())
+	assert.Len(t, files, len(domain.ExportSections)+1)
+	assert.Equal(t, "{\"id\":\"user-1\",\"email\":\"admin@acme.test\"}\n{\"id\":\"user-2\",\"email\":\"ops@acme.test\"}\n", files["users.jsonl"])
+	assert.Equal(t, "", files["incidents.jsonl"])
+
+	var stored domain.ExportManifest
+	require.NoError(t, json.Unmarshal([]byte(files["manifest.json"]), &stored))
+	assert.Equal(t, "tenant-1", stored.TenantID)
+	records := make(map[domain.ExportSection]int)
+	for _, entry := range stored.Sections {
+		records[entry.Section] = entry.Records
+	}
+	assert.Equal(t, 3, records[domain.ExportSectionCheckResults])
+	assert.Equal(t, 1, records[domain.ExportSectionTenant])
+}
+
+func TestOffboardingService_ExportErrors(t *testing.T) {
+	repo := newFakeOffboardingRepository()
+	offboarding := newOffboardingService(repo)
+
+	var buf bytes.Buffer
+	_, err := offboarding.Export(context.Background(), "missing", &buf)
+	assert.Equal(t, service.ErrTenantNotFound, err)
+	assert.Zero(t, buf.Len(), "nothing must be written for an unknown tenant")
+
+	repo.exportErr = errors.New("connection reset")
+	_, err = offboarding.Export(context.Background(), "tenant-1", &buf)
+	assert.ErrorContains(t, err, "connection reset")
+}
+
+func TestOffboardingService_DeletionLifecycle(t *testing.T) {
+	repo := newFakeOffboardingRepository()
+	offboarding := newOffboardingService(repo).WithGracePeriod(7 * 24 * time.Hour)
+	ctx := context.Background()
+
+	_, err := offboarding.DeletionStatus(ctx, "tenant-1")
+	assert.Equal(t, service.ErrDeletionNotScheduled, err)
+
+	deletion, err := offboarding.RequestDeletion(ctx, "tenant-1", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", deletion.RequestedBy)
+	assert.Equal(t, 7*24*time.Hour, deletion.PurgeAt.Sub(deletion.RequestedAt))
+
+	_, err = offboarding.RequestDeletion(ctx, "tenant-1", "user-2")
+	assert.Equal(t, service.ErrDeletionScheduled, err)
+
+	// До окончания периода отмены тенант не удаляется
+	purged, err := offboarding.PurgeDue(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+
+	require.NoError(t, offboarding.CancelDeletion(ctx, "tenant-1"))
+	assert.Equal(t, service.ErrDeletionNotScheduled, offboarding.CancelDeletion(ctx, "tenant-1"))
+
+	_, err = offboarding.RequestDeletion(ctx, "missing", "user-1")
+	assert.Equal(t, service.ErrTenantNotFound, err)
+}
+
+func TestOffboardingService_PurgeDue(t *testing.T) {
+	repo := newFakeOffboardingRepository()
+	offboarding := newOffboardingService(repo).WithGracePeriod(time.Nanosecond)
+	ctx := context.Background()
+
+	_, err := offboarding.RequestDeletion(ctx, "tenant-1", "user-1")
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+
+	purged, err := offboarding.PurgeDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Equal(t, []string{"tenant-1"}, repo.purged)
+
+	_, err = offboarding.DeletionStatus(ctx, "tenant-1")
+	assert.Equal(t, service.ErrTenantNotFound, err)
+}
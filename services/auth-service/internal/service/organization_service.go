@@ -0,0 +1,391 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// OrgTokenTTL срок действия access токена, выданного при переключении тенанта
+const OrgTokenTTL = 24 * time.Hour
+
+// orgSlug допустимый slug организации и тенанта: строчные буквы, цифры и дефисы
+var orgSlug = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,99}$`)
+
+// ErrNotInOrganization ошибка, когда пользователь не состоит в организации
+var ErrNotInOrganization = errors.New(errors.ErrNotFound, "user is not a member of an organization")
+
+// ErrOrganizationRoleRequired ошибка, когда роли пользователя в организации недостаточно
+var ErrOrganizationRoleRequired = errors.New(errors.ErrForbidden, "organization role does not allow this operation")
+
+// ErrTenantOutsideOrganization ошибка, когда тенант не входит в организацию пользователя
+var ErrTenantOutsideOrganization = errors.New(errors.ErrForbidden, "tenant does not belong to the organization")
+
+// ErrOrganizationConflict ошибка, когда тенант или пользователь уже в организации либо slug занят
+var ErrOrganizationConflict = errors.New(errors.ErrConflict, "tenant or user already belongs to an organization, or the slug is taken")
+
+// ErrLastOrganizationOwner ошибка, когда операция оставила бы организацию без владельца
+var ErrLastOrganizationOwner = errors.New(errors.ErrConflict, "organization must keep at least one owner")
+
+// OrgTokenSigner подписывает access токен тенанта организации
+type OrgTokenSigner interface {
+	SignOrgToken(token *domain.OrgToken) (string, error)
+}
+
+// OrgTokenSignerFunc адаптер функции к OrgTokenSigner
+type OrgTokenSignerFunc func(token *domain.OrgToken) (string, error)
+
+// SignOrgToken вызывает f(token)
+func (f OrgTokenSignerFunc) SignOrgToken(token *domain.OrgToken) (string, error) {
+	return f(token)
+}
+
+// OrganizationService организации над тенантами: создание организации из тенанта, новые тенанты
+// (окружения) в организации, роли участников и переключение между тенантами. Токен всегда
+// выдается для одного тенанта, поэтому изоляция данных тенантов сохраняется
+type OrganizationService struct {
+	repo   repository.OrganizationRepository
+	users  repository.UserRepository
+	signer OrgTokenSigner
+	logger logger.Logger
+	now    func() time.Time
+}
+
+// NewOrganizationService создает сервис организаций
+func NewOrganizationService(repo repository.OrganizationRepository, users repository.UserRepository, signer OrgTokenSigner, logger logger.Logger) *OrganizationService {
+	return &OrganizationService{
+		repo:   repo,
+		users:  users,
+		signer: signer,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Create создает организацию из тенанта администратора; администратор становится ее владельцем.
+// Тарифный план организации наследуется от тенанта
+func (s *OrganizationService) Create(ctx context.Context, userID, tenantID string, isAdmin bool, name, slug, billingEmail string) (*domain.Organization, error) {
+	if !isAdmin {
+		return nil, errors.New(errors.ErrForbidden, "tenant administrator role is required")
+	}
+	name, slug = strings.TrimSpace(name), strings.ToLower(strings.TrimSpace(slug))
+	if name == "" {
+		return nil, errors.New(errors.ErrValidation, "organization name is required")
+	}
+	if !orgSlug.MatchString(slug) {
+		return nil, errors.New(errors.ErrValidation, "slug must contain lowercase letters, digits and dashes")
+	}
+
+	now := s.now().UTC()
+	org := &domain.Organization{
+		ID:           uuid.New().String(),
+		Name:         name,
+		Slug:         slug,
+		BillingEmail: strings.TrimSpace(billingEmail),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	owner := &domain.OrganizationMember{
+		OrganizationID: org.ID,
+		UserID:         userID,
+		TenantID:       tenantID,
+		Role:           domain.OrgRoleOwner,
+		CreatedAt:      now,
+	}
+	if err := s.repo.Create(ctx, org, owner); err != nil {
+		return nil, s.mapError(err, "failed to create organization")
+	}
+
+	s.logger.Info("Organization created",
+		logger.String("audit_event", "organization.created"),
+		logger.String("organization_id", org.ID),
+		logger.String("tenant_id", tenantID),
+		logger.String("user_id", userID))
+	return org, nil
+}
+
+// Current возвращает организацию пользователя, его роль и тенанты для переключателя
+func (s *OrganizationService) Current(ctx context.Context, userID string) (*domain.OrganizationView, error) {
+	member, err := s.membership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.repo.FindByID(ctx, member.OrganizationID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to load organization")
+	}
+	tenants, err := s.repo.ListTenants(ctx, member.OrganizationID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to list organization tenants")
+	}
+	return &domain.OrganizationView{Organization: org, Role: member.Role, Tenants: tenants}, nil
+}
+
+// CreateTenant создает в организации новый тенант, например отдельное окружение staging.
+// Доступно владельцам и администраторам организации
+func (s *OrganizationService) CreateTenant(ctx context.Context, userID, name, slug string) (*domain.Tenant, error) {
+	member, err := s.membership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.OrgRoleAdministersTenants(member.Role) {
+		return nil, ErrOrganizationRoleRequired
+	}
+	name, slug = strings.TrimSpace(name), strings.ToLower(strings.TrimSpace(slug))
+	if name == "" {
+		return nil, errors.New(errors.ErrValidation, "tenant name is required")
+	}
+	if !orgSlug.MatchString(slug) {
+		return nil, errors.New(errors.ErrValidation, "slug must contain lowercase letters, digits and dashes")
+	}
+
+	now := s.now().UTC()
+	tenant := &domain.Tenant{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Slug:      slug,
+		Settings:  map[string]interface{}{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.CreateTenant(ctx, member.OrganizationID, tenant); err != nil {
+		return nil, s.mapError(err, "failed to create organization tenant")
+	}
+
+	s.logger.Info("Organization tenant created",
+		logger.String("audit_event", "organization.tenant_created"),
+		logger.String("organization_id", member.OrganizationID),
+		logger.String("tenant_id", tenant.ID),
+		logger.String("user_id", userID))
+	return tenant, nil
+}
+
+// ListMembers возвращает участников организации пользователя
+func (s *OrganizationService) ListMembers(ctx context.Context, userID string) ([]*domain.OrganizationMember, error) {
+	member, err := s.membership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.repo.ListMembers(ctx, member.OrganizationID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to list organization members")
+	}
+	return members, nil
+}
+
+// SetMember добавляет в организацию пользователя одного из ее тенантов или меняет его роль.
+// Администратор организации назначает только роль member, владелец - любую
+func (s *OrganizationService) SetMember(ctx context.Context, actorID, userID, role string) (*domain.OrganizationMember, error) {
+	if !domain.IsOrgRole(role) {
+		return nil, errors.New(errors.ErrValidation, "role must be one of owner, admin, member")
+	}
+	actor, err := s.membership(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !canAssignOrgRole(actor.Role, role) {
+		return nil, ErrOrganizationRoleRequired
+	}
+
+	user, err := s.users.FindByID(ctx, userID)
+	if err != nil || user == nil {
+		return nil, errors.New(errors.ErrNotFound, "user not found")
+	}
+	if err := s.requireOrgTenant(ctx, actor.OrganizationID, user.TenantID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindMembership(ctx, userID)
+	switch {
+	case err == nil && existing.OrganizationID != actor.OrganizationID:
+		return nil, ErrOrganizationConflict
+	case err == nil:
+		// Роль владельца или администратора меняет только владелец
+		if !canAssignOrgRole(actor.Role, existing.Role) {
+			return nil, ErrOrganizationRoleRequired
+		}
+		if existing.Role == domain.OrgRoleOwner && role != domain.OrgRoleOwner {
+			if err := s.requireAnotherOwner(ctx, actor.OrganizationID, userID); err != nil {
+				return nil, err
+			}
+		}
+	case !stderrors.Is(err, repository.ErrOrganizationMemberNotFound):
+		return nil, s.mapError(err, "failed to load organization membership")
+	}
+
+	member := &domain.OrganizationMember{
+		OrganizationID: actor.OrganizationID,
+		UserID:         user.ID,
+		TenantID:       user.TenantID,
+		Email:          user.Email,
+		Role:           role,
+		CreatedAt:      s.now().UTC(),
+	}
+	if err := s.repo.SaveMember(ctx, member); err != nil {
+		return nil, s.mapError(err, "failed to save organization member")
+	}
+
+	s.logger.Info("Organization member saved",
+		logger.String("audit_event", "organization.member_saved"),
+		logger.String("organization_id", actor.OrganizationID),
+		logger.String("actor_id", actorID),
+		logger.String("user_id", userID),
+		logger.String("role", role))
+	return member, nil
+}
+
+// RemoveMember исключает пользователя из организации. Доступно владельцам; последнего владельца
+// исключить нельзя
+func (s *OrganizationService) RemoveMember(ctx context.Context, actorID, userID string) error {
+	actor, err := s.membership(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	if actor.Role != domain.OrgRoleOwner {
+		return ErrOrganizationRoleRequired
+	}
+	if err := s.requireAnotherOwner(ctx, actor.OrganizationID, userID); err != nil {
+		return err
+	}
+	if err := s.repo.RemoveMember(ctx, actor.OrganizationID, userID); err != nil {
+		if stderrors.Is(err, repository.ErrOrganizationMemberNotFound) {
+			return errors.New(errors.ErrNotFound, "organization member not found")
+		}
+		return s.mapError(err, "failed to remove organization member")
+	}
+
+	s.logger.Info("Organization member removed",
+		logger.String("audit_event", "organization.member_removed"),
+		logger.String("organization_id", actor.OrganizationID),
+		logger.String("actor_id", actorID),
+		logger.String("user_id", userID))
+	return nil
+}
+
+// SwitchTenant выдает access токен для тенанта организации пользователя. Владельцы и
+// администраторы организации получают права администратора тенанта; участник с ролью member -
+// права своей учетной записи в ее тенанте и обычные права в остальных тенантах
+func (s *OrganizationService) SwitchTenant(ctx context.Context, userID, tenantID string) (string, *domain.OrgToken, error) {
+	member, err := s.membership(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	tenants, err := s.repo.ListTenants(ctx, member.OrganizationID)
+	if err != nil {
+		return "", nil, s.mapError(err, "failed to list organization tenants")
+	}
+	tenantIDs := make([]string, 0, len(tenants))
+	found := false
+	for _, tenant := range tenants {
+		tenantIDs = append(tenantIDs, tenant.ID)
+		found = found || tenant.ID == tenantID
+	}
+	if !found {
+		return "", nil, ErrTenantOutsideOrganization
+	}
+
+	user, err := s.users.FindByID(ctx, userID)
+	if err != nil || user == nil || !user.IsActive {
+		return "", nil, errors.New(errors.ErrUnauthorized, "user is not active")
+	}
+
+	now := s.now().UTC()
+	token := &domain.OrgToken{
+		UserID:         user.ID,
+		Email:          user.Email,
+		TenantID:       tenantID,
+		IsAdmin:        domain.OrgRoleAdministersTenants(member.Role) || (tenantID == user.TenantID && user.IsAdmin),
+		OrganizationID: member.OrganizationID,
+		OrgRole:        member.Role,
+		Tenants:        tenantIDs,
+		IssuedAt:       now,
+		ExpiresAt:      now.Add(OrgTokenTTL),
+	}
+	signed, err := s.signer.SignOrgToken(token)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errors.ErrInternal, "failed to sign access token")
+	}
+
+	s.logger.Info("Organization tenant switched",
+		logger.String("audit_event", "organization.tenant_switched"),
+		logger.String("organization_id", member.OrganizationID),
+		logger.String("user_id", userID),
+		logger.String("tenant_id", tenantID),
+		logger.Bool("is_admin", token.IsAdmin))
+	return signed, token, nil
+}
+
+// membership возвращает членство пользователя или ErrNotInOrganization
+func (s *OrganizationService) membership(ctx context.Context, userID string) (*domain.OrganizationMember, error) {
+	member, err := s.repo.FindMembership(ctx, userID)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrOrganizationMemberNotFound) {
+			return nil, ErrNotInOrganization
+		}
+		return nil, s.mapError(err, "failed to load organization membership")
+	}
+	return member, nil
+}
+
+// requireOrgTenant проверяет, что тенант входит в организацию
+func (s *OrganizationService) requireOrgTenant(ctx context.Context, orgID, tenantID string) error {
+	tenants, err := s.repo.ListTenants(ctx, orgID)
+	if err != nil {
+		return s.mapError(err, "failed to list organization tenants")
+	}
+	for _, tenant := range tenants {
+		if tenant.ID == tenantID {
+			return nil
+		}
+	}
+	return ErrTenantOutsideOrganization
+}
+
+// requireAnotherOwner проверяет, что кроме userID у организации останется владелец
+func (s *OrganizationService) requireAnotherOwner(ctx context.Context, orgID, userID string) error {
+	members, err := s.repo.ListMembers(ctx, orgID)
+	if err != nil {
+		return s.mapError(err, "failed to list organization members")
+	}
+	for _, member := range members {
+		if member.Role == domain.OrgRoleOwner && member.UserID != userID {
+			return nil
+		}
+	}
+	return ErrLastOrganizationOwner
+}
+
+// mapError переводит ошибки репозитория в ошибки сервиса
+func (s *OrganizationService) mapError(err error, message string) error {
+	switch {
+	case stderrors.Is(err, repository.ErrOrganizationConflict):
+		return ErrOrganizationConflict
+	case stderrors.Is(err, repository.ErrOrganizationNotFound):
+		return errors.New(errors.ErrNotFound, "organization not found")
+	case stderrors.Is(err, repository.ErrTenantNotFound):
+		return ErrTenantNotFound
+	default:
+		return errors.Wrap(err, errors.ErrInternal, message)
+	}
+}
+
+// canAssignOrgRole сообщает, может ли участник с ролью actorRole назначать или менять роль role
+func canAssignOrgRole(actorRole, role string) bool {
+	switch actorRole {
+	case domain.OrgRoleOwner:
+		return true
+	case domain.OrgRoleAdmin:
+		return role == domain.OrgRoleMember
+	default:
+		return false
+	}
+}
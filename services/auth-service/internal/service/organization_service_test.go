@@ -0,0 +1,241 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pkgerrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// fakeOrganizationRepository хранит организации, тенанты и участников в памяти
+type fakeOrganizationRepository struct {
+	orgs    map[string]*domain.Organization
+	tenants map[string]*domain.Tenant
+	// tenantOrgs организация каждого тенанта
+	tenantOrgs map[string]string
+	members    map[string]*domain.OrganizationMember
+}
+
+func newFakeOrganizationRepository() *fakeOrganizationRepository {
+	return &fakeOrganizationRepository{
+		orgs: make(map[string]*domain.Organization),
+		tenants: map[string]*domain.Tenant{
+			"tenant-prod":  {ID: "tenant-prod", Name: "Prod", Slug: "prod"},
+			"tenant-other": {ID: "tenant-other", Name: "Other", Slug: "other"},
+		},
+		tenantOrgs: make(map[string]string),
+		members:    make(map[string]*domain.OrganizationMember),
+	}
+}
+
+func (r *fakeOrganizationRepository) Create(ctx context.Context, org *domain.Organization, owner *domain.OrganizationMember) error {
+	if _, ok := r.tenants[owner.TenantID]; !ok {
+		return repository.ErrTenantNotFound
+	}
+	if r.tenantOrgs[owner.TenantID] != "" || r.members[owner.UserID] != nil {
+		return repository.ErrOrganizationConflict
+	}
+	org.Plan = "pro"
+	r.orgs[org.ID] = org
+	r.tenantOrgs[owner.TenantID] = org.ID
+	r.members[owner.UserID] = owner
+	return nil
+}
+
+func (r *fakeOrganizationRepository) FindByID(ctx context.Context, id string) (*domain.Organization, error) {
+	org, ok := r.orgs[id]
+	if !ok {
+		return nil, repository.ErrOrganizationNotFound
+	}
+	return org, nil
+}
+
+func (r *fakeOrganizationRepository) FindMembership(ctx context.Context, userID string) (*domain.OrganizationMember, error) {
+	member, ok := r.members[userID]
+	if !ok {
+		return nil, repository.ErrOrganizationMemberNotFound
+	}
+	return member, nil
+}
+
+func (r *fakeOrganizationRepository) ListTenants(ctx context.Context, orgID string) ([]*domain.Tenant, error) {
+	var tenants []*domain.Tenant
+	for _, id := range []string{"tenant-prod", "tenant-staging", "tenant-other"} {
+		if tenant, ok := r.tenants[id]; ok && r.tenantOrgs[id] == orgID {
+			tenants = append(tenants, tenant)
+		}
+	}
+	return tenants, nil
+}
+
+func (r *fakeOrganizationRepository) CreateTenant(ctx context.Context, orgID string, tenant *domain.Tenant) error {
+	for _, existing := range r.tenants {
+		if existing.Slug == tenant.Slug {
+			return repository.ErrOrganizationConflict
+		}
+	}
+	tenant.ID = "tenant-" + tenant.Slug
+	r.tenants[tenant.ID] = tenant
+	r.tenantOrgs[tenant.ID] = orgID
+	return nil
+}
+
+func (r *fakeOrganizationRepository) ListMembers(ctx context.Context, orgID string) ([]*domain.OrganizationMember, error) {
+	var members []*domain.OrganizationMember
+	for _, member := range r.members {
+		if member.OrganizationID == orgID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (r *fakeOrganizationRepository) SaveMember(ctx context.Context, member *domain.OrganizationMember) error {
+	if existing, ok := r.members[member.UserID]; ok && existing.OrganizationID != member.OrganizationID {
+		return repository.ErrOrganizationConflict
+	}
+	r.members[member.UserID] = member
+	return nil
+}
+
+func (r *fakeOrganizationRepository) RemoveMember(ctx context.Context, orgID, userID string) error {
+	if member, ok := r.members[userID]; !ok || member.OrganizationID != orgID {
+		return repository.ErrOrganizationMemberNotFound
+	}
+	delete(r.members, userID)
+	return nil
+}
+
+var organizationUsers = []*domain.User{
+	{ID: "owner-1", Email: "owner@acme.example", TenantID: "tenant-prod", IsActive: true, IsAdmin: true},
+	{ID: "dev-1", Email: "dev@acme.example", TenantID: "tenant-prod", IsActive: true},
+	{ID: "qa-1", Email: "qa@acme.example", TenantID: "tenant-staging", IsActive: true, IsAdmin: true},
+	{ID: "outsider-1", Email: "someone@other.example", TenantID: "tenant-other", IsActive: true, IsAdmin: true},
+}
+
+func newOrganizationService(t *testing.T) (*service.OrganizationService, *fakeOrganizationRepository, *[]*domain.OrgToken) {
+	log, err := logger.NewLogger("test", "error", "auth-service", false)
+	require.NoError(t, err)
+
+	users := &MockUserRepository{}
+	for _, user := range organizationUsers {
+		users.On("FindByID", mock.Anything, user.ID).Return(user, nil).Maybe()
+	}
+
+	var issued []*domain.OrgToken
+	signer := service.OrgTokenSignerFunc(func(token *domain.OrgToken) (string, error) {
+		issued = append(issued, token)
+		return "signed:" + token.TenantID, nil
+	})
+
+	repo := newFakeOrganizationRepository()
+	return service.NewOrganizationService(repo, users, signer, log), repo, &issued
+}
+
+func requireCode(t *testing.T, err error, code pkgerrors.ErrorCode) {
+	t.Helper()
+	require.Error(t, err)
+	assert.Equal(t, code, errorCode(err), err.Error())
+}
+
+func TestOrganizationService_CreateAndSwitch(t *testing.T) {
+	svc, _, issued := newOrganizationService(t)
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, "dev-1", "tenant-prod", false, "Acme", "acme", "")
+	requireCode(t, err, pkgerrors.ErrForbidden)
+	_, err = svc.Create(ctx, "owner-1", "tenant-prod", true, "Acme", "Not a slug!", "")
+	requireCode(t, err, pkgerrors.ErrValidation)
+
+	org, err := svc.Create(ctx, "owner-1", "tenant-prod", true, " Acme ", "acme", "billing@acme.example")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", org.Name)
+	assert.Equal(t, "pro", org.Plan)
+
+	// Тенант уже входит в организацию
+	_, err = svc.Create(ctx, "owner-1", "tenant-prod", true, "Acme 2", "acme-2", "")
+	requireCode(t, err, pkgerrors.ErrConflict)
+
+	staging, err := svc.CreateTenant(ctx, "owner-1", "Staging", "staging")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-staging", staging.ID)
+
+	view, err := svc.Current(ctx, "owner-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.OrgRoleOwner, view.Role)
+	require.Len(t, view.Tenants, 2)
+
+	token, claims, err := svc.SwitchTenant(ctx, "owner-1", "tenant-staging")
+	require.NoError(t, err)
+	assert.Equal(t, "signed:tenant-staging", token)
+	assert.Equal(t, "owner-1", claims.UserID)
+	assert.True(t, claims.IsAdmin)
+	assert.Equal(t, org.ID, claims.OrganizationID)
+	assert.Equal(t, []string{"tenant-prod", "tenant-staging"}, claims.Tenants)
+	assert.Equal(t, service.OrgTokenTTL, claims.ExpiresAt.Sub(claims.IssuedAt))
+	require.Len(t, *issued, 1)
+
+	// Тенант вне организации недоступен
+	_, _, err = svc.SwitchTenant(ctx, "owner-1", "tenant-other")
+	requireCode(t, err, pkgerrors.ErrForbidden)
+	_, _, err = svc.SwitchTenant(ctx, "outsider-1", "tenant-prod")
+	requireCode(t, err, pkgerrors.ErrNotFound)
+}
+
+func TestOrganizationService_Members(t *testing.T) {
+	svc, repo, _ := newOrganizationService(t)
+	ctx := context.Background()
+
+	_, err := svc.Create(ctx, "owner-1", "tenant-prod", true, "Acme", "acme", "")
+	require.NoError(t, err)
+	_, err = svc.CreateTenant(ctx, "owner-1", "Staging", "staging")
+	require.NoError(t, err)
+
+	// Пользователь другого тенанта организации попадает в общий каталог
+	member, err := svc.SetMember(ctx, "owner-1", "qa-1", domain.OrgRoleAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-staging", member.TenantID)
+
+	// Администратор организации назначает только участников
+	_, err = svc.SetMember(ctx, "qa-1", "dev-1", domain.OrgRoleOwner)
+	requireCode(t, err, pkgerrors.ErrForbidden)
+	_, err = svc.SetMember(ctx, "qa-1", "dev-1", domain.OrgRoleMember)
+	require.NoError(t, err)
+	_, err = svc.SetMember(ctx, "qa-1", "owner-1", domain.OrgRoleMember)
+	requireCode(t, err, pkgerrors.ErrForbidden)
+	_, err = svc.SetMember(ctx, "dev-1", "dev-1", domain.OrgRoleAdmin)
+	requireCode(t, err, pkgerrors.ErrForbidden)
+	_, err = svc.SetMember(ctx, "owner-1", "outsider-1", domain.OrgRoleMember)
+	requireCode(t, err, pkgerrors.ErrForbidden)
+	_, err = svc.SetMember(ctx, "owner-1", "dev-1", "superuser")
+	requireCode(t, err, pkgerrors.ErrValidation)
+
+	// Участник member - администратор только там, где администратор его учетная запись
+	_, claims, err := svc.SwitchTenant(ctx, "dev-1", "tenant-staging")
+	require.NoError(t, err)
+	assert.False(t, claims.IsAdmin)
+	_, err = svc.CreateTenant(ctx, "dev-1", "Sandbox", "sandbox")
+	requireCode(t, err, pkgerrors.ErrForbidden)
+
+	// Последнего владельца нельзя понизить или исключить
+	_, err = svc.SetMember(ctx, "owner-1", "owner-1", domain.OrgRoleAdmin)
+	requireCode(t, err, pkgerrors.ErrConflict)
+	requireCode(t, svc.RemoveMember(ctx, "owner-1", "owner-1"), pkgerrors.ErrConflict)
+	requireCode(t, svc.RemoveMember(ctx, "qa-1", "dev-1"), pkgerrors.ErrForbidden)
+
+	require.NoError(t, svc.RemoveMember(ctx, "owner-1", "dev-1"))
+	assert.NotContains(t, repo.members, "dev-1")
+	requireCode(t, svc.RemoveMember(ctx, "owner-1", "dev-1"), pkgerrors.ErrNotFound)
+
+	members, err := svc.ListMembers(ctx, "qa-1")
+	require.NoError(t, err)
+	assert.Len(t, members, 2)
+}
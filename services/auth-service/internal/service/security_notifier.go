@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+const (
+	// securityNotifyChannelsKey ключ data уведомления с адресатами вместо каналов тенанта
+	securityNotifyChannelsKey = "notify_channels"
+	// securitySeverity уровень уведомлений безопасности: проходит фильтры notification-service
+	securitySeverity = "high"
+)
+
+// securityTitles заголовки уведомлений безопасности по типам
+var securityTitles = map[string]string{
+	domain.SecurityEventUserJoined:      "New user joined the tenant",
+	domain.SecurityEventRoleChanged:     "User role changed",
+	domain.SecurityEventAPIKeyCreated:   "API key created",
+	domain.SecurityEventNewCountryLogin: "Login from a new country",
+}
+
+// SecurityPublisher публикует уведомления в exchange notifications
+type SecurityPublisher interface {
+	Publish(ctx context.Context, routingKey string, body []byte, headers map[string]interface{}) error
+}
+
+// TenantSettingsReader источник настроек тенанта
+type TenantSettingsReader interface {
+	Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error)
+}
+
+// SecurityNotifier уведомляет администраторов тенанта о событиях безопасности через
+// notification-service: письмо получает каждый активный администратор. Типы, отключенные
+// в настройках тенанта, не отправляются
+type SecurityNotifier struct {
+	users     repository.UserRepository
+	settings  TenantSettingsReader
+	publisher SecurityPublisher
+	logger    logger.Logger
+	now       func() time.Time
+}
+
+// NewSecurityNotifier создает уведомитель событий безопасности
+func NewSecurityNotifier(users repository.UserRepository, settings TenantSettingsReader, publisher SecurityPublisher, logger logger.Logger) *SecurityNotifier {
+	return &SecurityNotifier{
+		users:     users,
+		settings:  settings,
+		publisher: publisher,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Notify отправляет уведомление о событии. Ошибки только логируются: уведомление не должно
+// прерывать операцию, которая его вызвала. На nil уведомителе ничего не делает
+func (n *SecurityNotifier) Notify(ctx context.Context, event *domain.SecurityEvent) {
+	if n == nil || event == nil {
+		return
+	}
+	if err := n.notify(ctx, event); err != nil {
+		n.logger.Warn("Failed to send security notification",
+			logger.String("event_type", event.Type),
+			logger.String("tenant_id", event.TenantID),
+			logger.Error(err))
+	}
+}
+
+// notify отправляет уведомление, если тип не отключен и у тенанта есть администраторы
+func (n *SecurityNotifier) notify(ctx context.Context, event *domain.SecurityEvent) error {
+	settings, err := n.settings.Get(ctx, event.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to load tenant settings: %w", err)
+	}
+	if !settings.Notifications.SecurityEnabled(event.Type) {
+		return nil
+	}
+
+	admins, err := n.users.ListAdmins(ctx, event.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to list tenant admins: %w", err)
+	}
+	channels := make([]string, 0, len(admins))
+	for _, admin := range admins {
+		if admin.Email != "" {
+			channels = append(channels, "email:"+admin.Email)
+		}
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+
+	occurredAt := event.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = n.now()
+	}
+	data := map[string]interface{}{
+		securityNotifyChannelsKey: strings.Join(channels, ","),
+		"security_event":          event.Type,
+		"user_id":                 event.UserID,
+		"email":                   event.Email,
+	}
+	for key, value := range event.Details {
+		data[key] = value
+	}
+
+	eventType := events.TypeSecurityPrefix + event.Type
+	body, headers, err := events.Encode(eventType, &events.Notification{
+		ID:        uuid.New().String(),
+		TenantID:  event.TenantID,
+		Severity:  securitySeverity,
+		Source:    "auth-service",
+		Title:     securityTitles[event.Type],
+		Message:   securityMessage(event),
+		Data:      data,
+		Timestamp: occurredAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := n.publisher.Publish(ctx, eventType, body, headers); err != nil {
+		return fmt.Errorf("failed to publish security notification: %w", err)
+	}
+
+	n.logger.Info("Security notification sent",
+		logger.String("event_type", event.Type),
+		logger.String("tenant_id", event.TenantID),
+		logger.Int("recipients", len(channels)))
+	return nil
+}
+
+// securityMessage текст уведомления о событии
+func securityMessage(event *domain.SecurityEvent) string {
+	switch event.Type {
+	case domain.SecurityEventUserJoined:
+		return fmt.Sprintf("%s joined the tenant", event.Email)
+	case domain.SecurityEventRoleChanged:
+		return fmt.Sprintf("%s is now %s", event.Email, event.Details["role"])
+	case domain.SecurityEventAPIKeyCreated:
+		return fmt.Sprintf("API key %q (%s) was created", event.Details["key_name"], event.Details["key_prefix"])
+	case domain.SecurityEventNewCountryLogin:
+		return fmt.Sprintf("%s signed in from %s for the first time", event.Email, event.Details["country"])
+	default:
+		return event.Type
+	}
+}
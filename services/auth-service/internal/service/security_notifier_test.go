@@ -0,0 +1,193 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pkgerrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/pkg/password"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// publishedMessage сообщение, отправленное в exchange notifications
+type publishedMessage struct {
+	routingKey string
+	headers    map[string]interface{}
+	body       []byte
+}
+
+// recordingPublisher запоминает опубликованные уведомления
+type recordingPublisher struct {
+	mu       sync.Mutex
+	messages []publishedMessage
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, routingKey string, body []byte, headers map[string]interface{}) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, publishedMessage{routingKey: routingKey, headers: headers, body: body})
+	return nil
+}
+
+// fakeLoginCountries страны входа в памяти
+type fakeLoginCountries map[string]map[string]bool
+
+func (f fakeLoginCountries) Remember(ctx context.Context, userID, country string) (bool, bool, error) {
+	countries := f[userID]
+	if countries == nil {
+		countries = map[string]bool{}
+		f[userID] = countries
+	}
+	if countries[country] {
+		return false, len(countries) > 1, nil
+	}
+	hadOthers := len(countries) > 0
+	countries[country] = true
+	return true, hadOthers, nil
+}
+
+var tenantAdmins = []*domain.User{
+	{ID: "admin-1", Email: "admin@acme.example", TenantID: "tenant-1", IsActive: true, IsAdmin: true},
+	{ID: "admin-2", Email: "ops@acme.example", TenantID: "tenant-1", IsActive: true, IsAdmin: true},
+}
+
+func newSecurityNotifier(t *testing.T, settings *domain.TenantSettings) (*service.SecurityNotifier, *MockUserRepository, *recordingPublisher) {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	userRepo := &MockUserRepository{}
+	userRepo.On("ListAdmins", mock.Anything, "tenant-1").Return(tenantAdmins, nil).Maybe()
+	settingsRepo := &fakeSettingsRepository{settings: map[string]*domain.TenantSettings{"tenant-1": settings}}
+	publisher := &recordingPublisher{}
+	notifier := service.NewSecurityNotifier(userRepo, service.NewSettingsService(settingsRepo, testLogger), publisher, testLogger)
+	return notifier, userRepo, publisher
+}
+
+func decodeSecurityNotification(t *testing.T, message publishedMessage) *events.Notification {
+	t.Helper()
+	var notification events.Notification
+	require.NoError(t, events.Decode(message.headers, message.body, &notification))
+	return &notification
+}
+
+func TestSecurityNotifier_APIKeyCreated(t *testing.T) {
+	notifier, _, publisher := newSecurityNotifier(t, nil)
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	apiKeyRepo := &MockAPIKeyRepository{}
+	apiKeyRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.APIKey")).Return(nil)
+	apiKeys := service.NewAPIKeyService(apiKeyRepo, testLogger).WithSecurityNotifier(notifier)
+
+	_, err = apiKeys.CreateAPIKey(context.Background(), "tenant-1", "ci")
+	require.NoError(t, err)
+
+	require.Len(t, publisher.messages, 1)
+	message := publisher.messages[0]
+	assert.Equal(t, "notification.security.api_key_created", message.routingKey)
+	notification := decodeSecurityNotification(t, message)
+	assert.Equal(t, "tenant-1", notification.TenantID)
+	assert.Equal(t, "high", notification.Severity)
+	assert.Equal(t, "email:admin@acme.example,email:ops@acme.example", notification.Data["notify_channels"])
+	assert.Equal(t, "ci", notification.Data["key_name"])
+	assert.Contains(t, notification.Message, `"ci"`)
+}
+
+func TestSecurityNotifier_OptOut(t *testing.T) {
+	settings := domain.DefaultTenantSettings("tenant-1")
+	settings.Notifications.SecurityOptOut = []string{domain.SecurityEventUserJoined}
+	notifier, _, publisher := newSecurityNotifier(t, settings)
+	ctx := context.Background()
+
+	notifier.Notify(ctx, &domain.SecurityEvent{Type: domain.SecurityEventUserJoined, TenantID: "tenant-1", Email: "new@acme.example"})
+	assert.Empty(t, publisher.messages)
+
+	notifier.Notify(ctx, &domain.SecurityEvent{Type: domain.SecurityEventRoleChanged, TenantID: "tenant-1", Email: "new@acme.example"})
+	require.Len(t, publisher.messages, 1)
+	assert.Equal(t, "notification.security.role_changed", publisher.messages[0].routingKey)
+
+	// Неизвестный тенант и nil уведомитель не прерывают операцию
+	notifier.Notify(ctx, &domain.SecurityEvent{Type: domain.SecurityEventRoleChanged, TenantID: "tenant-2"})
+	var disabled *service.SecurityNotifier
+	disabled.Notify(ctx, &domain.SecurityEvent{Type: domain.SecurityEventRoleChanged, TenantID: "tenant-1"})
+	assert.Len(t, publisher.messages, 1)
+}
+
+func TestAuthService_LoginFromNewCountry(t *testing.T) {
+	authService, userRepo, _, _, sessionRepo := setupAuthService()
+	notifier, _, publisher := newSecurityNotifier(t, nil)
+	authService.(*service.Service).WithSecurityNotifier(notifier).WithLoginCountries(fakeLoginCountries{})
+
+	passwordHash, err := password.NewBcryptHasher(10).Hash("Password123!")
+	require.NoError(t, err)
+	user := &domain.User{ID: "user-1", Email: "bob@acme.example", TenantID: "tenant-1", PasswordHash: passwordHash, IsActive: true}
+	userRepo.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+	sessionRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Session")).Return(nil)
+
+	login := func(country string) {
+		_, err := authService.Login(service.WithLoginCountry(context.Background(), country), user.Email, "Password123!")
+		require.NoError(t, err)
+	}
+
+	// Первая страна запоминается без уведомления, повторный вход из нее тоже молчит
+	login("de")
+	login("DE")
+	login("")
+	assert.Empty(t, publisher.messages)
+
+	login("br")
+	require.Len(t, publisher.messages, 1)
+	notification := decodeSecurityNotification(t, publisher.messages[0])
+	assert.Equal(t, "notification.security.new_country_login", publisher.messages[0].routingKey)
+	assert.Equal(t, "BR", notification.Data["country"])
+	assert.Equal(t, "user-1", notification.Data["user_id"])
+
+	login("BR")
+	assert.Len(t, publisher.messages, 1)
+}
+
+func TestAuthService_SetUserRole(t *testing.T) {
+	authService, userRepo, _, _, _ := setupAuthService()
+	notifier, _, publisher := newSecurityNotifier(t, nil)
+	authService.(*service.Service).WithSecurityNotifier(notifier)
+	ctx := context.Background()
+
+	member := &domain.User{ID: "user-1", Email: "bob@acme.example", TenantID: "tenant-1", IsActive: true, CreatedAt: time.Now()}
+	outsider := &domain.User{ID: "admin-9", Email: "eve@other.example", TenantID: "tenant-2", IsActive: true, IsAdmin: true}
+	userRepo.On("FindByID", mock.Anything, "admin-1").Return(tenantAdmins[0], nil)
+	userRepo.On("FindByID", mock.Anything, "admin-9").Return(outsider, nil)
+	userRepo.On("FindByID", mock.Anything, "user-1").Return(member, nil)
+	userRepo.On("Update", mock.Anything, member).Return(nil).Once()
+
+	_, err := authService.SetUserRole(ctx, "admin-9", "user-1", true)
+	assert.Equal(t, pkgerrors.ErrForbidden, errorCode(err))
+	_, err = authService.SetUserRole(ctx, "user-1", "user-1", true)
+	assert.Equal(t, pkgerrors.ErrForbidden, errorCode(err))
+	_, err = authService.SetUserRole(ctx, "admin-1", "admin-1", false)
+	assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+	assert.Empty(t, publisher.messages)
+
+	updated, err := authService.SetUserRole(ctx, "admin-1", "user-1", true)
+	require.NoError(t, err)
+	assert.True(t, updated.IsAdmin)
+	require.Len(t, publisher.messages, 1)
+	notification := decodeSecurityNotification(t, publisher.messages[0])
+	assert.Equal(t, "admin", notification.Data["role"])
+	assert.Equal(t, "admin-1", notification.Data["actor_id"])
+
+	// Повторное назначение той же роли ничего не меняет
+	_, err = authService.SetUserRole(ctx, "admin-1", "user-1", true)
+	require.NoError(t, err)
+	assert.Len(t, publisher.messages, 1)
+	userRepo.AssertExpectations(t)
+}
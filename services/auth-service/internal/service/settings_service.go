@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+const (
+	// maxSettingsChannels максимальное число каналов уведомлений по умолчанию
+	maxSettingsChannels = 20
+	// maxSettingsFeatures максимальное число переключателей тенанта
+	maxSettingsFeatures = 100
+	// maxBrandingLength максимальная длина текстовых полей оформления
+	maxBrandingLength = 255
+	// maxResultSinks максимальное число приемников результатов тенанта
+	maxResultSinks = 10
+	// minResultSinkSecret минимальная длина ключа подписи приемника
+	minResultSinkSecret = 16
+)
+
+// brandingColor цвет оформления в формате #rrggbb
+var brandingColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// SettingsService настройки тенанта: значения по умолчанию для проверок и уведомлений,
+// переключатели функциональности и оформление. Чтение идет через кэш, если он подключен
+type SettingsService struct {
+	repo   repository.TenantSettingsRepository
+	cache  repository.TenantSettingsCache
+	logger logger.Logger
+	now    func() time.Time
+}
+
+// NewSettingsService создает сервис без кэша
+func NewSettingsService(repo repository.TenantSettingsRepository, logger logger.Logger) *SettingsService {
+	return &SettingsService{
+		repo:   repo,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// WithCache включает кэширование настроек. Ошибки кэша не прерывают операции
+func (s *SettingsService) WithCache(cache repository.TenantSettingsCache) *SettingsService {
+	s.cache = cache
+	return s
+}
+
+// Get возвращает настройки тенанта; без сохраненных настроек - значения по умолчанию
+func (s *SettingsService) Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error) {
+	if tenantID == "" {
+		return nil, errors.New(errors.ErrValidation, "tenant_id is required")
+	}
+
+	if s.cache != nil {
+		settings, err := s.cache.Get(ctx, tenantID)
+		if err != nil {
+			s.logger.Warn("Failed to read tenant settings from cache", logger.String("tenant_id", tenantID), logger.Error(err))
+		} else if settings != nil {
+			return settings, nil
+		}
+	}
+
+	settings, err := s.repo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to get tenant settings")
+	}
+	s.cacheSettings(ctx, settings)
+	return settings, nil
+}
+
+// Update проверяет и сохраняет настройки тенанта целиком
+func (s *SettingsService) Update(ctx context.Context, settings *domain.TenantSettings, updatedBy string) (*domain.TenantSettings, error) {
+	if err := s.keepSinkSecrets(ctx, settings); err != nil {
+		return nil, err
+	}
+	if err := validateSettings(settings); err != nil {
+		return nil, err
+	}
+	if settings.Features == nil {
+		settings.Features = map[string]bool{}
+	}
+	if settings.Notifications.ChannelIDs == nil {
+		settings.Notifications.ChannelIDs = []string{}
+	}
+	if settings.Notifications.SecurityOptOut == nil {
+		settings.Notifications.SecurityOptOut = []string{}
+	}
+	if settings.ResultSinks == nil {
+		settings.ResultSinks = []domain.ResultSink{}
+	}
+	settings.UpdatedBy = updatedBy
+	settings.UpdatedAt = s.now().UTC()
+
+	if err := s.repo.Save(ctx, settings); err != nil {
+		return nil, s.mapError(err, "failed to save tenant settings")
+	}
+	s.cacheSettings(ctx, settings)
+
+	s.logger.Info("Tenant settings updated",
+		logger.String("tenant_id", settings.TenantID),
+		logger.String("updated_by", updatedBy),
+	)
+	return settings, nil
+}
+
+// Reset удаляет сохраненные настройки и возвращает значения по умолчанию
+func (s *SettingsService) Reset(ctx context.Context, tenantID string) (*domain.TenantSettings, error) {
+	if tenantID == "" {
+		return nil, errors.New(errors.ErrValidation, "tenant_id is required")
+	}
+
+	if err := s.repo.Reset(ctx, tenantID); err != nil {
+		return nil, s.mapError(err, "failed to reset tenant settings")
+	}
+	if s.cache != nil {
+		if err := s.cache.Delete(ctx, tenantID); err != nil {
+			s.logger.Warn("Failed to evict tenant settings from cache", logger.String("tenant_id", tenantID), logger.Error(err))
+		}
+	}
+
+	s.logger.Info("Tenant settings reset", logger.String("tenant_id", tenantID))
+	return domain.DefaultTenantSettings(tenantID), nil
+}
+
+// keepSinkSecrets подставляет сохраненный ключ подписи приемникам результатов, переданным
+// без ключа: клиенты получают настройки без ключей и не должны передавать их повторно
+func (s *SettingsService) keepSinkSecrets(ctx context.Context, settings *domain.TenantSettings) error {
+	missing := false
+	for _, sink := range settings.ResultSinks {
+		missing = missing || sink.Secret == ""
+	}
+	if !missing || settings.TenantID == "" {
+		return nil
+	}
+
+	current, err := s.repo.Get(ctx, settings.TenantID)
+	if err != nil {
+		return s.mapError(err, "failed to get tenant settings")
+	}
+	secrets := make(map[string]string, len(current.ResultSinks))
+	for _, sink := range current.ResultSinks {
+		secrets[sink.Name] = sink.Secret
+	}
+	for i := range settings.ResultSinks {
+		if settings.ResultSinks[i].Secret == "" {
+			settings.ResultSinks[i].Secret = secrets[settings.ResultSinks[i].Name]
+		}
+	}
+	return nil
+}
+
+// cacheSettings кладет настройки в кэш; при ошибке удаляет устаревшую запись
+func (s *SettingsService) cacheSettings(ctx context.Context, settings *domain.TenantSettings) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.Set(ctx, settings); err != nil {
+		s.logger.Warn("Failed to cache tenant settings", logger.String("tenant_id", settings.TenantID), logger.Error(err))
+		s.cache.Delete(ctx, settings.TenantID)
+	}
+}
+
+// mapError переводит ошибки репозитория в ошибки сервиса
+func (s *SettingsService) mapError(err error, message string) error {
+	if stderrors.Is(err, repository.ErrTenantNotFound) {
+		return ErrTenantNotFound
+	}
+	return errors.Wrap(err, errors.ErrInternal, message)
+}
+
+// validateSettings проверяет диапазоны значений по умолчанию, уровни уведомлений,
+// имена переключателей, оформление, часовой пояс, язык и приемники результатов
+func validateSettings(settings *domain.TenantSettings) error {
+	invalid := func(details string) error {
+		return errors.New(errors.ErrValidation, "invalid tenant settings").WithDetails(details)
+	}
+
+	if settings.TenantID == "" {
+		return errors.New(errors.ErrValidation, "tenant_id is required")
+	}
+
+	checks := settings.Checks
+	if checks.IntervalSeconds < domain.MinCheckIntervalSeconds || checks.IntervalSeconds > domain.MaxCheckIntervalSeconds {
+		return invalid(fmt.Sprintf("checks.interval_seconds must be between %d and %d", domain.MinCheckIntervalSeconds, domain.MaxCheckIntervalSeconds))
+	}
+	if checks.TimeoutSeconds < domain.MinCheckTimeoutSeconds || checks.TimeoutSeconds > domain.MaxCheckTimeoutSeconds {
+		return invalid(fmt.Sprintf("checks.timeout_seconds must be between %d and %d", domain.MinCheckTimeoutSeconds, domain.MaxCheckTimeoutSeconds))
+	}
+	if checks.TimeoutSeconds >= checks.IntervalSeconds {
+		return invalid("checks.timeout_seconds must be less than checks.interval_seconds")
+	}
+
+	notifications := settings.Notifications
+	if !isNotificationSeverity(notifications.MinSeverity) {
+		return invalid(fmt.Sprintf("notifications.min_severity must be one of %v", domain.NotificationSeverities))
+	}
+	if len(notifications.ChannelIDs) > maxSettingsChannels {
+		return invalid(fmt.Sprintf("notifications.channel_ids must contain at most %d channels", maxSettingsChannels))
+	}
+	for _, channelID := range notifications.ChannelIDs {
+		if channelID == "" {
+			return invalid("notifications.channel_ids must not contain empty values")
+		}
+	}
+	for _, eventType := range notifications.SecurityOptOut {
+		if !domain.IsSecurityEvent(eventType) {
+			return invalid(fmt.Sprintf("notifications.security_opt_out must contain only %v", domain.SecurityEvents))
+		}
+	}
+
+	if len(settings.Features) > maxSettingsFeatures {
+		return invalid(fmt.Sprintf("features must contain at most %d toggles", maxSettingsFeatures))
+	}
+	for name := range settings.Features {
+		if !featureFlagName.MatchString(name) {
+			return invalid(fmt.Sprintf("feature %q must match %s", name, featureFlagName.String()))
+		}
+	}
+
+	branding := settings.Branding
+	for field, value := range map[string]string{
+		"company_name":      branding.CompanyName,
+		"logo_url":          branding.LogoURL,
+		"status_page_title": branding.StatusPageTitle,
+	} {
+		if len(value) > maxBrandingLength {
+			return invalid(fmt.Sprintf("branding.%s must be at most %d characters", field, maxBrandingLength))
+		}
+	}
+	if branding.LogoURL != "" {
+		logoURL, err := url.Parse(branding.LogoURL)
+		if err != nil || (logoURL.Scheme != "https" && logoURL.Scheme != "http") || logoURL.Host == "" {
+			return invalid("branding.logo_url must be an absolute http(s) URL")
+		}
+	}
+	if branding.PrimaryColor != "" && !brandingColor.MatchString(branding.PrimaryColor) {
+		return invalid("branding.primary_color must be in #rrggbb format")
+	}
+
+	if err := settings.Localization.Validate(); err != nil {
+		return invalid("localization: " + err.Error())
+	}
+
+	if len(settings.ResultSinks) > maxResultSinks {
+		return invalid(fmt.Sprintf("result_sinks must contain at most %d sinks", maxResultSinks))
+	}
+	sinkNames := make(map[string]bool, len(settings.ResultSinks))
+	for _, sink := range settings.ResultSinks {
+		if !featureFlagName.MatchString(sink.Name) {
+			return invalid(fmt.Sprintf("result sink name %q must match %s", sink.Name, featureFlagName.String()))
+		}
+		if sinkNames[sink.Name] {
+			return invalid(fmt.Sprintf("result sink %q is defined twice", sink.Name))
+		}
+		sinkNames[sink.Name] = true
+		sinkURL, err := url.Parse(sink.URL)
+		if err != nil || (sinkURL.Scheme != "https" && sinkURL.Scheme != "http") || sinkURL.Host == "" {
+			return invalid(fmt.Sprintf("result sink %q url must be an absolute http(s) URL", sink.Name))
+		}
+		if len(sink.Secret) < minResultSinkSecret {
+			return invalid(fmt.Sprintf("result sink %q secret must be at least %d characters", sink.Name, minResultSinkSecret))
+		}
+		for _, tag := range sink.Tags {
+			if tag == "" {
+				return invalid(fmt.Sprintf("result sink %q tags must not contain empty values", sink.Name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// isNotificationSeverity проверяет уровень уведомлений
+func isNotificationSeverity(severity string) bool {
+	for _, known := range domain.NotificationSeverities {
+		if severity == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgerrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// fakeSettingsRepository хранит настройки тенантов в памяти
+type fakeSettingsRepository struct {
+	settings map[string]*domain.TenantSettings
+	reads    int
+}
+
+func (r *fakeSettingsRepository) Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error) {
+	r.reads++
+	settings, ok := r.settings[tenantID]
+	if !ok {
+		return nil, repository.ErrTenantNotFound
+	}
+	if settings == nil {
+		return domain.DefaultTenantSettings(tenantID), nil
+	}
+	return settings, nil
+}
+
+func (r *fakeSettingsRepository) Save(ctx context.Context, settings *domain.TenantSettings) error {
+	if _, ok := r.settings[settings.TenantID]; !ok {
+		return repository.ErrTenantNotFound
+	}
+	r.settings[settings.TenantID] = settings
+	return nil
+}
+
+func (r *fakeSettingsRepository) Reset(ctx context.Context, tenantID string) error {
+	if _, ok := r.settings[tenantID]; !ok {
+		return repository.ErrTenantNotFound
+	}
+	r.settings[tenantID] = nil
+	return nil
+}
+
+// fakeSettingsCache кэш настроек в памяти
+type fakeSettingsCache map[string]*domain.TenantSettings
+
+func (c fakeSettingsCache) Get(ctx context.Context, tenantID string) (*domain.TenantSettings, error) {
+	return c[tenantID], nil
+}
+
+func (c fakeSettingsCache) Set(ctx context.Context, settings *domain.TenantSettings) error {
+	c[settings.TenantID] = settings
+	return nil
+}
+
+func (c fakeSettingsCache) Delete(ctx context.Context, tenantID string) error {
+	delete(c, tenantID)
+	return nil
+}
+
+func newSettingsService(t *testing.T) (*service.SettingsService, *fakeSettingsRepository, fakeSettingsCache) {
+	t.Helper()
+	testLogger, err := logger.NewLogger("test", "info", "test-service", false)
+	require.NoError(t, err)
+
+	repo := &fakeSettingsRepository{settings: map[string]*domain.TenantSettings{"tenant-1": nil}}
+	cache := fakeSettingsCache{}
+	return service.NewSettingsService(repo, testLogger).WithCache(cache), repo, cache
+}
+
+func TestSettingsService_GetDefaultsAndCache(t *testing.T) {
+	settingsService, repo, cache := newSettingsService(t)
+	ctx := context.Background()
+
+	settings, err := settingsService.Get(ctx, "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultTenantSettings("tenant-1"), settings)
+	assert.Contains(t, cache, "tenant-1")
+
+	// Повторное чтение обслуживается кэшем
+	_, err = settingsService.Get(ctx, "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, repo.reads)
+
+	_, err = settingsService.Get(ctx, "tenant-2")
+	assert.Equal(t, pkgerrors.ErrNotFound, errorCode(err))
+}
+
+func TestSettingsService_Update(t *testing.T) {
+	settingsService, repo, cache := newSettingsService(t)
+	ctx := context.Background()
+
+	settings := domain.DefaultTenantSettings("tenant-1")
+	settings.Checks.IntervalSeconds = 30
+	settings.Features = map[string]bool{"status-page.custom-domain": true}
+	settings.Branding = domain.Branding{CompanyName: "Acme", LogoURL: "https://acme.example/logo.png", PrimaryColor: "#FF6600"}
+	settings.Localization.Timezone = "Europe/Moscow"
+
+	updated, err := settingsService.Update(ctx, settings, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", updated.UpdatedBy)
+	assert.False(t, updated.UpdatedAt.IsZero())
+	assert.Equal(t, 30, repo.settings["tenant-1"].Checks.IntervalSeconds)
+	assert.Equal(t, "Europe/Moscow", repo.settings["tenant-1"].Localization.Timezone)
+	assert.Equal(t, updated, cache["tenant-1"])
+
+	missing := domain.DefaultTenantSettings("tenant-2")
+	_, err = settingsService.Update(ctx, missing, "alice")
+	assert.Equal(t, pkgerrors.ErrNotFound, errorCode(err))
+}
+
+func TestSettingsService_UpdateKeepsSinkSecrets(t *testing.T) {
+	settingsService, repo, _ := newSettingsService(t)
+	ctx := context.Background()
+
+	settings := domain.DefaultTenantSettings("tenant-1")
+	settings.ResultSinks = []domain.ResultSink{{Name: "warehouse", URL: "https://data.acme.example/ingest", Secret: "0123456789abcdef", Tags: []string{"prod"}}}
+	_, err := settingsService.Update(ctx, settings, "alice")
+	require.NoError(t, err)
+
+	// Клиент передает приемник без ключа: сохраняется прежний ключ
+	settings = domain.DefaultTenantSettings("tenant-1")
+	settings.ResultSinks = []domain.ResultSink{{Name: "warehouse", URL: "https://data.acme.example/v2/ingest"}}
+	_, err = settingsService.Update(ctx, settings, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", repo.settings["tenant-1"].ResultSinks[0].Secret)
+	assert.Equal(t, "https://data.acme.example/v2/ingest", repo.settings["tenant-1"].ResultSinks[0].URL)
+
+	// Новый приемник без ключа не принимается
+	settings = domain.DefaultTenantSettings("tenant-1")
+	settings.ResultSinks = []domain.ResultSink{{Name: "lake", URL: "https://lake.acme.example"}}
+	_, err = settingsService.Update(ctx, settings, "alice")
+	assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+}
+
+func TestSettingsService_UpdateValidation(t *testing.T) {
+	settingsService, _, _ := newSettingsService(t)
+	ctx := context.Background()
+
+	for name, mutate := range map[string]func(*domain.TenantSettings){
+		"interval too short":     func(s *domain.TenantSettings) { s.Checks.IntervalSeconds = 1 },
+		"timeout above interval": func(s *domain.TenantSettings) { s.Checks.TimeoutSeconds = 120 },
+		"unknown severity":       func(s *domain.TenantSettings) { s.Notifications.MinSeverity = "urgent" },
+		"empty channel":          func(s *domain.TenantSettings) { s.Notifications.ChannelIDs = []string{""} },
+		"invalid feature":        func(s *domain.TenantSettings) { s.Features = map[string]bool{"Bad Name": true} },
+		"relative logo":          func(s *domain.TenantSettings) { s.Branding.LogoURL = "/logo.png" },
+		"invalid color":          func(s *domain.TenantSettings) { s.Branding.PrimaryColor = "orange" },
+		"unknown timezone":       func(s *domain.TenantSettings) { s.Localization.Timezone = "Mars/Olympus" },
+		"unsupported locale":     func(s *domain.TenantSettings) { s.Localization.Locale = "xx" },
+		"unknown security event": func(s *domain.TenantSettings) { s.Notifications.SecurityOptOut = []string{"logout"} },
+		"relative sink url": func(s *domain.TenantSettings) {
+			s.ResultSinks = []domain.ResultSink{{Name: "warehouse", URL: "/ingest", Secret: "0123456789abcdef"}}
+		},
+		"short sink secret": func(s *domain.TenantSettings) {
+			s.ResultSinks = []domain.ResultSink{{Name: "warehouse", URL: "https://data.acme.example/ingest", Secret: "short"}}
+		},
+		"duplicate sink": func(s *domain.TenantSettings) {
+			sink := domain.ResultSink{Name: "warehouse", URL: "https://data.acme.example/ingest", Secret: "0123456789abcdef"}
+			s.ResultSinks = []domain.ResultSink{sink, sink}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			settings := domain.DefaultTenantSettings("tenant-1")
+			mutate(settings)
+			_, err := settingsService.Update(ctx, settings, "alice")
+			assert.Equal(t, pkgerrors.ErrValidation, errorCode(err))
+		})
+	}
+}
+
+func TestSettingsService_Reset(t *testing.T) {
+	settingsService, repo, cache := newSettingsService(t)
+	ctx := context.Background()
+
+	settings := domain.DefaultTenantSettings("tenant-1")
+	settings.Notifications.MinSeverity = "critical"
+	_, err := settingsService.Update(ctx, settings, "alice")
+	require.NoError(t, err)
+
+	reset, err := settingsService.Reset(ctx, "tenant-1")
+	require.NoError(t, err)
+	assert.Equal(t, "warning", reset.Notifications.MinSeverity)
+	assert.Nil(t, repo.settings["tenant-1"])
+	assert.NotContains(t, cache, "tenant-1")
+}
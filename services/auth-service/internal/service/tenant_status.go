@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+)
+
+// ErrTenantSuspended тенант заблокирован оператором платформы
+var ErrTenantSuspended = errors.New(errors.ErrForbidden, "tenant is suspended")
+
+// CheckTenantActive возвращает ErrTenantSuspended для заблокированного тенанта. Неизвестный
+// тенант не считается заблокированным; при nil tenants проверка отключена
+func CheckTenantActive(ctx context.Context, tenants repository.TenantStatusRepository, tenantID string) error {
+	if tenants == nil || tenantID == "" {
+		return nil
+	}
+	status, err := tenants.GetStatus(ctx, tenantID)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrTenantNotFound) {
+			return nil
+		}
+		return errors.Wrap(err, errors.ErrInternal, "failed to check tenant status")
+	}
+	if status == domain.TenantStatusSuspended {
+		return ErrTenantSuspended
+	}
+	return nil
+}
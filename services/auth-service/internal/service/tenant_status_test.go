@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pkgerrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/auth-service/internal/domain"
+	"UptimePingPlatform/services/auth-service/internal/pkg/password"
+	"UptimePingPlatform/services/auth-service/internal/repository"
+	"UptimePingPlatform/services/auth-service/internal/service"
+)
+
+// fakeTenantStatus статусы тенантов в памяти; неизвестный тенант не найден
+type fakeTenantStatus map[string]string
+
+func (f fakeTenantStatus) GetStatus(ctx context.Context, tenantID string) (string, error) {
+	if tenantID == "tenant-broken" {
+		return "", stderrors.New("connection refused")
+	}
+	status, ok := f[tenantID]
+	if !ok {
+		return "", repository.ErrTenantNotFound
+	}
+	return status, nil
+}
+
+var testTenantStatus = fakeTenantStatus{
+	"tenant-1": domain.TenantStatusActive,
+	"tenant-2": domain.TenantStatusSuspended,
+}
+
+func TestCheckTenantActive(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, service.CheckTenantActive(ctx, testTenantStatus, "tenant-1"))
+	assert.Equal(t, service.ErrTenantSuspended, service.CheckTenantActive(ctx, testTenantStatus, "tenant-2"))
+	assert.NoError(t, service.CheckTenantActive(ctx, testTenantStatus, "tenant-unknown"))
+	assert.Equal(t, pkgerrors.ErrInternal, errorCode(service.CheckTenantActive(ctx, testTenantStatus, "tenant-broken")))
+
+	// Без репозитория проверка отключена
+	assert.NoError(t, service.CheckTenantActive(ctx, nil, "tenant-2"))
+}
+
+func TestAuthService_Login_SuspendedTenant(t *testing.T) {
+	authService, userRepo, _, _, sessionRepo := setupAuthService()
+	authService.(*service.Service).WithTenantStatus(testTenantStatus)
+
+	passwordHash, err := password.NewBcryptHasher(10).Hash("Password123!")
+	require.NoError(t, err)
+	active := &domain.User{ID: "user-1", Email: "bob@acme.example", TenantID: "tenant-1", PasswordHash: passwordHash, IsActive: true}
+	suspended := &domain.User{ID: "user-2", Email: "eve@blocked.example", TenantID: "tenant-2", PasswordHash: passwordHash, IsActive: true}
+	userRepo.On("FindByEmail", mock.Anything, active.Email).Return(active, nil)
+	userRepo.On("FindByEmail", mock.Anything, suspended.Email).Return(suspended, nil)
+	sessionRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Session")).Return(nil).Once()
+
+	tokenPair, err := authService.Login(context.Background(), suspended.Email, "Password123!")
+	assert.Nil(t, tokenPair)
+	assert.Equal(t, service.ErrTenantSuspended, err)
+
+	_, err = authService.Login(context.Background(), active.Email, "Password123!")
+	require.NoError(t, err)
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestAuthService_ValidateAPIKey_SuspendedTenant(t *testing.T) {
+	authService, _, _, apiKeyRepo, _ := setupAuthService()
+	authService.(*service.Service).WithTenantStatus(testTenantStatus)
+	ctx := context.Background()
+
+	var stored *domain.APIKey
+	apiKeyRepo.On("Create", ctx, mock.AnythingOfType("*domain.APIKey")).
+		Run(func(args mock.Arguments) { stored = args.Get(1).(*domain.APIKey) }).
+		Return(nil)
+	pair, err := authService.CreateAPIKey(ctx, "tenant-2", "CI")
+	require.NoError(t, err)
+	apiKeyRepo.On("FindByPrefix", ctx, stored.KeyPrefix).Return(stored, nil)
+
+	claims, err := authService.ValidateAPIKey(ctx, pair.Key, pair.Secret, "203.0.113.7")
+	assert.Nil(t, claims)
+	assert.Equal(t, service.ErrTenantSuspended, err)
+
+	// После снятия блокировки ключ снова принимается
+	stored.TenantID = "tenant-1"
+	_, err = authService.ValidateAPIKey(ctx, pair.Key, pair.Secret, "203.0.113.7")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/cli-service/internal/auth"
+	"UptimePingPlatform/services/cli-service/internal/client"
+	cliConfig "UptimePingPlatform/services/cli-service/internal/config"
+)
+
+var checksImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Импортировать проверки из другого инструмента мониторинга",
+	Long: `Создает проверки из экспорта UptimeRobot, Pingdom или StatusCake (JSON или CSV)
+и выводит отчет о возможностях, которые не удалось перенести.
+
+Примеры:
+  uptimeping checks import --source uptimerobot --file monitors.json --dry-run
+  uptimeping checks import --source statuscake --file tests.csv`,
+	RunE: handleChecksImport,
+}
+
+func init() {
+	checksCmd.AddCommand(checksImportCmd)
+
+	checksImportCmd.Flags().String("source", "", "источник экспорта (uptimerobot, pingdom, statuscake)")
+	checksImportCmd.Flags().StringP("file", "f", "", "файл экспорта в формате JSON или CSV")
+	checksImportCmd.Flags().Bool("dry-run", false, "только показать отчет, не создавая проверки")
+}
+
+// handleChecksImport отправляет файл экспорта на импорт и выводит отчет
+func handleChecksImport(cmd *cobra.Command, args []string) error {
+	source, _ := cmd.Flags().GetString("source")
+	file, _ := cmd.Flags().GetString("file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if source == "" || file == "" {
+		return errors.New(errors.ErrValidation, "--source and --file are required")
+	}
+
+	export, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("ошибка чтения файла экспорта: %w", err)
+	}
+
+	configPath, err := cliConfig.GetConfigPath()
+	if err != nil {
+		return fmt.Errorf("ошибка получения пути конфигурации: %w", err)
+	}
+	cfg, err := cliConfig.LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("ошибка загрузки конфигурации: %w", err)
+	}
+
+	authManager, err := auth.NewAuthManager(cfg)
+	if err != nil {
+		return fmt.Errorf("ошибка создания менеджера аутентификации: %w", err)
+	}
+	defer authManager.Close()
+
+	// Проверки создаются по одной, поэтому большой импорт занимает больше обычного запроса
+	ctx, cancel := context.WithTimeout(rootCtx, 5*time.Minute)
+	defer cancel()
+
+	if err := authManager.EnsureValidToken(ctx); err != nil {
+		return fmt.Errorf("ошибка проверки токена: %w", err)
+	}
+
+	checksClient := client.NewChecksClient(cfg.API.BaseURL, authManager.GetTokenStore())
+	result, err := checksClient.ImportChecks(ctx, source, export, dryRun)
+	if err != nil {
+		return handleError(err, cmd)
+	}
+	if viper.GetString("output") == "json" {
+		return printStatusPageJSON(result)
+	}
+
+	printImportResult(result)
+	return nil
+}
+
+// printImportResult выводит проверки и расхождения импорта
+func printImportResult(result *client.ImportResult) {
+	report := result.Report
+	if result.DryRun {
+		fmt.Printf("Dry run: %d of %d monitors from %s can be imported, %d skipped\n\n", report.Imported, report.Total, report.Source, report.Skipped)
+		fmt.Printf("%-12s %-25s %-6s %-40s %-9s %s\n", "Source ID", "Name", "Type", "Target", "Interval", "Enabled")
+		fmt.Println(strings.Repeat("-", 110))
+		for _, check := range report.Checks {
+			fmt.Printf("%-12s %-25s %-6s %-40s %-9d %t\n", check.SourceID, check.Name, check.Type, check.Target, check.Interval, check.Enabled)
+		}
+	} else {
+		fmt.Printf("✅ Imported %d of %d monitors from %s, %d skipped\n\n", len(result.Created), report.Total, report.Source, report.Skipped)
+		for _, check := range result.Created {
+			fmt.Printf("  %s -> %s (%s)\n", check.SourceID, check.CheckID, check.Name)
+		}
+	}
+
+	if len(report.Issues) == 0 {
+		return
+	}
+	fmt.Printf("\nMapping report:\n")
+	fmt.Printf("%-12s %-25s %-22s %-8s %s\n", "Source ID", "Name", "Feature", "Skipped", "Message")
+	fmt.Println(strings.Repeat("-", 110))
+	for _, issue := range report.Issues {
+		fmt.Printf("%-12s %-25s %-22s %-8t %s\n", issue.SourceID, issue.Name, issue.Feature, issue.Skipped, issue.Message)
+	}
+}
@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/cli-service/internal/auth"
+	"UptimePingPlatform/services/cli-service/internal/client"
+	cliConfig "UptimePingPlatform/services/cli-service/internal/config"
+)
+
+// forgeWizardCmd represents the forge wizard command
+var forgeWizardCmd = &cobra.Command{
+	Use:   "wizard",
+	Short: "Пошаговое создание проверок по protobuf или OpenAPI",
+	Long: `Загружает protobuf или OpenAPI спецификацию в Forge, задает вопросы
+(адреса, интервалы, аутентификация), показывает предпросмотр проверок и
+после подтверждения создает их.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleForgeWizard(cmd, args)
+	},
+}
+
+func init() {
+	forgeCmd.AddCommand(forgeWizardCmd)
+
+	forgeWizardCmd.Flags().StringP("proto", "p", "", "protobuf файл")
+	forgeWizardCmd.Flags().StringP("openapi", "o", "", "OpenAPI спецификация (JSON или YAML)")
+	forgeWizardCmd.Flags().String("forge-url", "", "адрес Forge сервиса (по умолчанию api.base_url)")
+	forgeWizardCmd.Flags().BoolP("yes", "y", false, "принимать значения по умолчанию и не спрашивать подтверждение")
+	forgeWizardCmd.Flags().Bool("create", true, "создать проверки после фиксации")
+}
+
+func handleForgeWizard(cmd *cobra.Command, args []string) error {
+	protoFile, _ := cmd.Flags().GetString("proto")
+	openAPIFile, _ := cmd.Flags().GetString("openapi")
+	forgeURL, _ := cmd.Flags().GetString("forge-url")
+	acceptDefaults, _ := cmd.Flags().GetBool("yes")
+	createChecks, _ := cmd.Flags().GetBool("create")
+
+	sourceType, file := "proto", protoFile
+	switch {
+	case protoFile != "" && openAPIFile != "":
+		return errors.New(errors.ErrValidation, "use either --proto or --openapi")
+	case openAPIFile != "":
+		sourceType, file = "openapi", openAPIFile
+	case protoFile == "":
+		return errors.New(errors.ErrValidation, "--proto or --openapi file is required")
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("ошибка чтения файла: %w", err)
+	}
+
+	configPath, err := cliConfig.GetConfigPath()
+	if err != nil {
+		return fmt.Errorf("ошибка получения пути конфигурации: %w", err)
+	}
+	cfg, err := cliConfig.LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("ошибка загрузки конфигурации: %w", err)
+	}
+
+	authManager, err := auth.NewAuthManager(cfg)
+	if err != nil {
+		return fmt.Errorf("ошибка создания менеджера аутентификации: %w", err)
+	}
+	defer authManager.Close()
+
+	ctx, cancel := context.WithTimeout(rootCtx, 30*time.Minute)
+	defer cancel()
+
+	if err := authManager.EnsureValidToken(ctx); err != nil {
+		return fmt.Errorf("ошибка проверки токена: %w", err)
+	}
+
+	if forgeURL == "" {
+		forgeURL = cfg.API.BaseURL
+	}
+	wizard := client.NewForgeWizardClient(forgeURL, authManager.GetTokenStore())
+	defer wizard.Close()
+
+	step, err := wizard.Start(ctx, sourceType, filepath.Base(file), string(content))
+	if err != nil {
+		return handleError(err, cmd)
+	}
+	sessionID := step.Session.ID
+
+	fmt.Printf("🧙 Forge wizard: %s (%s)\n", filepath.Base(file), sourceType)
+
+	input := bufio.NewReader(os.Stdin)
+	for step.NextQuestion != nil {
+		question := step.NextQuestion
+		value := ""
+		if !acceptDefaults || question.Default == "" {
+			value, err = askWizardQuestion(input, question)
+			if err != nil {
+				wizard.Cancel(ctx, sessionID)
+				return err
+			}
+		}
+
+		next, err := wizard.Answer(ctx, sessionID, question.ID, value)
+		if err != nil {
+			if acceptDefaults && question.Default != "" {
+				wizard.Cancel(ctx, sessionID)
+				return handleError(err, cmd)
+			}
+			fmt.Printf("❌ %v\n", err)
+			continue
+		}
+		step = next
+	}
+
+	checks, err := wizard.Preview(ctx, sessionID)
+	if err != nil {
+		return handleError(err, cmd)
+	}
+
+	fmt.Printf("\n📋 Checks to create (%d):\n", len(checks))
+	fmt.Printf("%-30s %-6s %-40s %-9s %s\n", "Name", "Type", "Target", "Interval", "Timeout")
+	fmt.Println(strings.Repeat("-", 100))
+	for _, check := range checks {
+		fmt.Printf("%-30s %-6s %-40s %-9d %d\n", check.Name, check.Type, check.Target, check.Interval, check.Timeout)
+	}
+
+	if !acceptDefaults {
+		answer, err := askLine(input, "\nCommit? [y/N]: ")
+		if err != nil {
+			wizard.Cancel(ctx, sessionID)
+			return err
+		}
+		if !strings.EqualFold(answer, "y") && !strings.EqualFold(answer, "yes") {
+			wizard.Cancel(ctx, sessionID)
+			fmt.Println("Wizard cancelled")
+			return nil
+		}
+	}
+
+	commit, err := wizard.Commit(ctx, sessionID)
+	if err != nil {
+		return handleError(err, cmd)
+	}
+	fmt.Printf("✅ Wizard session committed: %d checks\n", len(commit.Checks))
+
+	if !createChecks {
+		return nil
+	}
+
+	checksClient := client.NewChecksClient(cfg.API.BaseURL, authManager.GetTokenStore())
+	defer checksClient.Close()
+
+	for _, check := range commit.Checks {
+		metadata := make(map[string]interface{}, len(check.Config)+1)
+		for key, value := range check.Config {
+			metadata[key] = value
+		}
+		metadata["source"] = "forge-wizard"
+
+		created, err := checksClient.CreateCheck(ctx, &client.Check{
+			Name:     check.Name,
+			Type:     check.Type,
+			Target:   check.Target,
+			Interval: check.Interval,
+			Timeout:  check.Timeout,
+			Metadata: metadata,
+		})
+		if err != nil {
+			fmt.Printf("❌ %s: %v\n", check.Name, err)
+			continue
+		}
+		fmt.Printf("  ✅ %s (%s)\n", created.Name, created.ID)
+	}
+
+	return nil
+}
+
+// askWizardQuestion печатает вопрос мастера и читает ответ; пустой ответ означает значение по умолчанию
+func askWizardQuestion(input *bufio.Reader, question *client.WizardQuestion) (string, error) {
+	prompt := question.Prompt
+	if len(question.Choices) > 0 {
+		prompt += "\n  " + strings.Join(question.Choices, "\n  ") + "\n"
+	}
+	if question.Default != "" {
+		prompt += fmt.Sprintf(" [%s]", question.Default)
+	}
+	return askLine(input, prompt+": ")
+}
+
+// askLine печатает приглашение и читает строку из input
+func askLine(input *bufio.Reader, prompt string) (string, error) {
+	fmt.Print(prompt)
+	line, err := input.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", fmt.Errorf("ошибка чтения ответа: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
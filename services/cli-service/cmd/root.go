@@ -75,6 +75,7 @@ func init() {
 	rootCmd.AddCommand(incidentsCmd)
 	rootCmd.AddCommand(notificationCmd)
 	rootCmd.AddCommand(forgeCmd)
+	rootCmd.AddCommand(statusPageCmd)
 	rootCmd.AddCommand(completionCmd)
 }
 
@@ -0,0 +1,429 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/cli-service/internal/auth"
+	"UptimePingPlatform/services/cli-service/internal/client"
+	cliConfig "UptimePingPlatform/services/cli-service/internal/config"
+)
+
+// statusPageCmd represents the statuspage command
+var statusPageCmd = &cobra.Command{
+	Use:   "statuspage",
+	Short: "Управление страницей статуса",
+	Long: `Команды страницы статуса: просмотр текущих статусов компонентов,
+управление компонентами, ручное переопределение статуса и объявления
+о плановом обслуживании.`,
+}
+
+var statusPageShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Показать страницу статуса",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusPageShow(cmd, args)
+	},
+}
+
+var statusComponentsCmd = &cobra.Command{
+	Use:   "components",
+	Short: "Управление компонентами страницы статуса",
+}
+
+var statusComponentsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Показать компоненты",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusComponentsList(cmd, args)
+	},
+}
+
+var statusComponentsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Создать компонент",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusComponentsSave(cmd, "")
+	},
+}
+
+var statusComponentsUpdateCmd = &cobra.Command{
+	Use:   "update [component-id]",
+	Short: "Изменить компонент; ручной статус сохраняется",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusComponentsSave(cmd, args[0])
+	},
+}
+
+var statusComponentsDeleteCmd = &cobra.Command{
+	Use:   "delete [component-id]",
+	Short: "Удалить компонент",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusComponentsDelete(cmd, args)
+	},
+}
+
+var statusOverrideCmd = &cobra.Command{
+	Use:   "override",
+	Short: "Ручное переопределение статуса компонента",
+}
+
+var statusOverrideSetCmd = &cobra.Command{
+	Use:   "set [component-id]",
+	Short: "Задать отображаемый статус компонента",
+	Long: `Задает статус компонента независимо от результатов проверок.
+Статусы: operational, degraded_performance, partial_outage, major_outage, under_maintenance.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusOverrideSet(cmd, args)
+	},
+}
+
+var statusOverrideClearCmd = &cobra.Command{
+	Use:   "clear [component-id]",
+	Short: "Снять ручной статус компонента",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusOverrideClear(cmd, args)
+	},
+}
+
+var statusMaintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Объявления о плановом обслуживании",
+}
+
+var statusMaintenanceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Показать текущие и предстоящие обслуживания",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusMaintenanceList(cmd, args)
+	},
+}
+
+var statusMaintenanceScheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Опубликовать объявление об обслуживании",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusMaintenanceSchedule(cmd, args)
+	},
+}
+
+var statusMaintenanceCancelCmd = &cobra.Command{
+	Use:   "cancel [maintenance-id]",
+	Short: "Отменить объявление об обслуживании",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return handleStatusMaintenanceCancel(cmd, args)
+	},
+}
+
+func init() {
+	statusPageCmd.AddCommand(statusPageShowCmd)
+	statusPageCmd.AddCommand(statusComponentsCmd)
+	statusPageCmd.AddCommand(statusOverrideCmd)
+	statusPageCmd.AddCommand(statusMaintenanceCmd)
+
+	statusComponentsCmd.AddCommand(statusComponentsListCmd)
+	statusComponentsCmd.AddCommand(statusComponentsCreateCmd)
+	statusComponentsCmd.AddCommand(statusComponentsUpdateCmd)
+	statusComponentsCmd.AddCommand(statusComponentsDeleteCmd)
+
+	statusOverrideCmd.AddCommand(statusOverrideSetCmd)
+	statusOverrideCmd.AddCommand(statusOverrideClearCmd)
+
+	statusMaintenanceCmd.AddCommand(statusMaintenanceListCmd)
+	statusMaintenanceCmd.AddCommand(statusMaintenanceScheduleCmd)
+	statusMaintenanceCmd.AddCommand(statusMaintenanceCancelCmd)
+
+	for _, command := range []*cobra.Command{statusComponentsCreateCmd, statusComponentsUpdateCmd} {
+		command.Flags().String("name", "", "название компонента")
+		command.Flags().String("description", "", "описание компонента")
+		command.Flags().StringSlice("checks", nil, "ID проверок, определяющих статус компонента")
+		command.Flags().Int32("position", 0, "позиция компонента на странице")
+	}
+
+	statusOverrideSetCmd.Flags().String("status", "", "отображаемый статус")
+	statusOverrideSetCmd.Flags().StringP("message", "m", "", "сообщение для страницы статуса")
+	statusOverrideSetCmd.Flags().Duration("for", 0, "снять статус автоматически через указанное время (например, 2h)")
+
+	statusMaintenanceListCmd.Flags().String("since", "", "показать обслуживания, которые заканчиваются не раньше (RFC3339)")
+
+	statusMaintenanceScheduleCmd.Flags().String("title", "", "заголовок объявления")
+	statusMaintenanceScheduleCmd.Flags().StringP("message", "m", "", "текст объявления")
+	statusMaintenanceScheduleCmd.Flags().StringSlice("components", nil, "ID компонентов, затронутых обслуживанием")
+	statusMaintenanceScheduleCmd.Flags().String("start", "", "начало обслуживания (RFC3339)")
+	statusMaintenanceScheduleCmd.Flags().String("end", "", "конец обслуживания (RFC3339)")
+}
+
+func handleStatusPageShow(cmd *cobra.Command, args []string) error {
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		page, err := statusPage.GetStatusPage(ctx)
+		if err != nil {
+			return err
+		}
+		if viper.GetString("output") == "json" {
+			return printStatusPageJSON(page)
+		}
+
+		fmt.Printf("Status: %s\n\n", page.Status)
+		fmt.Printf("%-36s %-25s %-22s %-12s %s\n", "ID", "Component", "Status", "Source", "Message")
+		fmt.Println(strings.Repeat("-", 110))
+		for _, view := range page.Components {
+			fmt.Printf("%-36s %-25s %-22s %-12s %s\n",
+				view.Component.ID, view.Component.Name, view.Status, view.StatusSource, view.StatusMessage)
+		}
+
+		printMaintenances("Active maintenance", page.ActiveMaintenances)
+		printMaintenances("Upcoming maintenance", page.UpcomingMaintenances)
+		return nil
+	})
+}
+
+func handleStatusComponentsList(cmd *cobra.Command, args []string) error {
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		components, err := statusPage.ListComponents(ctx)
+		if err != nil {
+			return err
+		}
+		if viper.GetString("output") == "json" {
+			return printStatusPageJSON(components)
+		}
+		if len(components) == 0 {
+			fmt.Println("No status components found")
+			return nil
+		}
+
+		fmt.Printf("%-36s %-25s %-8s %-7s %s\n", "ID", "Name", "Position", "Checks", "Override")
+		fmt.Println(strings.Repeat("-", 100))
+		for _, component := range components {
+			override := "-"
+			if component.Override != nil {
+				override = component.Override.Status
+				if component.Override.ExpiresAt != "" {
+					override += " until " + component.Override.ExpiresAt
+				}
+			}
+			fmt.Printf("%-36s %-25s %-8d %-7d %s\n",
+				component.ID, component.Name, component.Position, len(component.CheckIDs), override)
+		}
+		return nil
+	})
+}
+
+func handleStatusComponentsSave(cmd *cobra.Command, componentID string) error {
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+	checkIDs, _ := cmd.Flags().GetStringSlice("checks")
+	position, _ := cmd.Flags().GetInt32("position")
+	if name == "" {
+		return errors.New(errors.ErrValidation, "--name is required")
+	}
+
+	input := &client.StatusComponentInput{
+		Name:        name,
+		Description: description,
+		CheckIDs:    checkIDs,
+		Position:    position,
+	}
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		if componentID == "" {
+			component, err := statusPage.CreateComponent(ctx, input)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("✅ Status component created: %s (%s)\n", component.Name, component.ID)
+			return nil
+		}
+
+		component, err := statusPage.UpdateComponent(ctx, componentID, input)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ Status component updated: %s (%s)\n", component.Name, component.ID)
+		return nil
+	})
+}
+
+func handleStatusComponentsDelete(cmd *cobra.Command, args []string) error {
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		if err := statusPage.DeleteComponent(ctx, args[0]); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Status component %s deleted\n", args[0])
+		return nil
+	})
+}
+
+func handleStatusOverrideSet(cmd *cobra.Command, args []string) error {
+	status, _ := cmd.Flags().GetString("status")
+	message, _ := cmd.Flags().GetString("message")
+	duration, _ := cmd.Flags().GetDuration("for")
+	if status == "" {
+		return errors.New(errors.ErrValidation, "--status is required")
+	}
+	if duration < 0 {
+		return errors.New(errors.ErrValidation, "--for must be positive")
+	}
+
+	expiresAt := ""
+	if duration > 0 {
+		expiresAt = time.Now().Add(duration).UTC().Format(time.RFC3339)
+	}
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		component, err := statusPage.SetOverride(ctx, args[0], status, message, expiresAt)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s is now shown as %s\n", component.Name, status)
+		if expiresAt != "" {
+			fmt.Printf("   Override expires at %s\n", expiresAt)
+		}
+		return nil
+	})
+}
+
+func handleStatusOverrideClear(cmd *cobra.Command, args []string) error {
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		component, err := statusPage.ClearOverride(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ Override cleared, %s follows its checks again\n", component.Name)
+		return nil
+	})
+}
+
+func handleStatusMaintenanceList(cmd *cobra.Command, args []string) error {
+	since, _ := cmd.Flags().GetString("since")
+	if since != "" {
+		if _, err := time.Parse(time.RFC3339, since); err != nil {
+			return errors.New(errors.ErrValidation, "--since must be RFC3339 timestamp")
+		}
+	}
+
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		maintenances, err := statusPage.ListMaintenances(ctx, since)
+		if err != nil {
+			return err
+		}
+		if viper.GetString("output") == "json" {
+			return printStatusPageJSON(maintenances)
+		}
+		if len(maintenances) == 0 {
+			fmt.Println("No maintenance announcements found")
+			return nil
+		}
+		printMaintenances("Maintenance", maintenances)
+		return nil
+	})
+}
+
+func handleStatusMaintenanceSchedule(cmd *cobra.Command, args []string) error {
+	title, _ := cmd.Flags().GetString("title")
+	message, _ := cmd.Flags().GetString("message")
+	componentIDs, _ := cmd.Flags().GetStringSlice("components")
+	start, _ := cmd.Flags().GetString("start")
+	end, _ := cmd.Flags().GetString("end")
+	if title == "" {
+		return errors.New(errors.ErrValidation, "--title is required")
+	}
+	for name, value := range map[string]string{"--start": start, "--end": end} {
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return errors.New(errors.ErrValidation, name+" must be RFC3339 timestamp")
+		}
+	}
+
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		maintenance, err := statusPage.ScheduleMaintenance(ctx, &client.Maintenance{
+			Title:        title,
+			Message:      message,
+			ComponentIDs: componentIDs,
+			StartsAt:     start,
+			EndsAt:       end,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ Maintenance scheduled: %s (%s)\n", maintenance.Title, maintenance.ID)
+		fmt.Printf("   %s - %s, state: %s\n", maintenance.StartsAt, maintenance.EndsAt, maintenance.State)
+		return nil
+	})
+}
+
+func handleStatusMaintenanceCancel(cmd *cobra.Command, args []string) error {
+	return withStatusPageClient(cmd, func(ctx context.Context, statusPage *client.StatusPageClient) error {
+		maintenance, err := statusPage.CancelMaintenance(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✅ Maintenance cancelled: %s\n", maintenance.Title)
+		return nil
+	})
+}
+
+// withStatusPageClient загружает конфигурацию, проверяет токен и вызывает run с клиентом страницы статуса
+func withStatusPageClient(cmd *cobra.Command, run func(ctx context.Context, statusPage *client.StatusPageClient) error) error {
+	configPath, err := cliConfig.GetConfigPath()
+	if err != nil {
+		return fmt.Errorf("ошибка получения пути конфигурации: %w", err)
+	}
+	cfg, err := cliConfig.LoadConfig(configPath)
+	if err != nil {
+		return fmt.Errorf("ошибка загрузки конфигурации: %w", err)
+	}
+
+	authManager, err := auth.NewAuthManager(cfg)
+	if err != nil {
+		return fmt.Errorf("ошибка создания менеджера аутентификации: %w", err)
+	}
+	defer authManager.Close()
+
+	ctx, cancel := context.WithTimeout(rootCtx, 30*time.Second)
+	defer cancel()
+
+	if err := authManager.EnsureValidToken(ctx); err != nil {
+		return fmt.Errorf("ошибка проверки токена: %w", err)
+	}
+
+	statusPage := client.NewStatusPageClient(cfg.API.BaseURL, authManager.GetTokenStore())
+	defer statusPage.Close()
+
+	if err := run(ctx, statusPage); err != nil {
+		return handleError(err, cmd)
+	}
+	return nil
+}
+
+// printMaintenances печатает таблицу объявлений об обслуживании
+func printMaintenances(title string, maintenances []client.Maintenance) {
+	if len(maintenances) == 0 {
+		return
+	}
+	fmt.Printf("\n%s (%d):\n", title, len(maintenances))
+	fmt.Printf("%-36s %-30s %-12s %-21s %s\n", "ID", "Title", "State", "Starts", "Ends")
+	fmt.Println(strings.Repeat("-", 125))
+	for _, maintenance := range maintenances {
+		fmt.Printf("%-36s %-30s %-12s %-21s %s\n",
+			maintenance.ID, maintenance.Title, maintenance.State, maintenance.StartsAt, maintenance.EndsAt)
+	}
+}
+
+// printStatusPageJSON печатает ответ в JSON
+func printStatusPageJSON(value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("ошибка кодирования ответа: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
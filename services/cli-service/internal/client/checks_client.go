@@ -1,11 +1,14 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -316,6 +319,84 @@ func (c *ChecksClient) DeleteCheck(ctx context.Context, checkID string) error {
 	return nil
 }
 
+// ImportIssue неподдерживаемая возможность или ошибка импорта монитора
+type ImportIssue struct {
+	SourceID string `json:"source_id"`
+	Name     string `json:"name"`
+	Feature  string `json:"feature"`
+	Message  string `json:"message"`
+	Skipped  bool   `json:"skipped"`
+}
+
+// ImportedCheck проверка, созданная из монитора исходного инструмента
+type ImportedCheck struct {
+	SourceID string `json:"source_id"`
+	CheckID  string `json:"check_id"`
+	Name     string `json:"name"`
+}
+
+// ImportResult отчет импорта проверок из другого инструмента мониторинга
+type ImportResult struct {
+	DryRun bool `json:"dry_run"`
+	Report struct {
+		Source   string `json:"source"`
+		Total    int    `json:"total"`
+		Imported int    `json:"imported"`
+		Skipped  int    `json:"skipped"`
+		Checks   []struct {
+			SourceID string `json:"source_id"`
+			Name     string `json:"name"`
+			Type     string `json:"type"`
+			Target   string `json:"target"`
+			Interval int    `json:"interval"`
+			Timeout  int    `json:"timeout"`
+			Enabled  bool   `json:"enabled"`
+		} `json:"checks"`
+		Issues []ImportIssue `json:"issues"`
+	} `json:"report"`
+	Created []ImportedCheck `json:"created"`
+}
+
+// ImportChecks отправляет экспорт UptimeRobot, Pingdom или StatusCake (JSON или CSV) на импорт;
+// с dryRun проверки не создаются и возвращается только отчет о преобразовании
+func (c *ChecksClient) ImportChecks(ctx context.Context, source string, export []byte, dryRun bool) (*ImportResult, error) {
+	token := c.extractTokenFromContext(ctx)
+	if token == "" {
+		return nil, fmt.Errorf("токен авторизации не найден")
+	}
+
+	query := url.Values{"source": {source}, "dry_run": {strconv.FormatBool(dryRun)}}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v1/checks:import?"+query.Encode(), bytes.NewReader(export))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка создания HTTP запроса: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/octet-stream")
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+	httpReq.Header.Set("User-Agent", "UptimePing-CLI/1.0")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка выполнения HTTP запроса: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Message != "" {
+			return nil, fmt.Errorf("сервер вернул статус %d: %s", resp.StatusCode, apiErr.Message)
+		}
+		return nil, fmt.Errorf("сервер вернул статус: %d", resp.StatusCode)
+	}
+
+	var result ImportResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("ошибка декодирования ответа: %w", err)
+	}
+	return &result, nil
+}
+
 // Close закрывает клиент
 func (c *ChecksClient) Close() error {
 	fmt.Printf("Закрытие ChecksClient\n")
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// WizardQuestion вопрос мастера Forge
+type WizardQuestion struct {
+	ID       string   `json:"id"`
+	Prompt   string   `json:"prompt"`
+	Kind     string   `json:"kind"`
+	Choices  []string `json:"choices,omitempty"`
+	Default  string   `json:"default,omitempty"`
+	Required bool     `json:"required"`
+}
+
+// WizardSession сессия мастера Forge
+type WizardSession struct {
+	ID         string            `json:"id"`
+	SourceType string            `json:"source_type"`
+	FileName   string            `json:"file_name"`
+	Answers    map[string]string `json:"answers"`
+	State      string            `json:"state"`
+	ExpiresAt  time.Time         `json:"expires_at"`
+}
+
+// WizardStep состояние сессии и следующий вопрос; NextQuestion равен nil, когда все вопросы отвечены
+type WizardStep struct {
+	Session      WizardSession    `json:"session"`
+	NextQuestion *WizardQuestion  `json:"next_question"`
+	Questions    []WizardQuestion `json:"questions"`
+}
+
+// WizardCheck проверка, сгенерированная мастером
+type WizardCheck struct {
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`
+	Target   string            `json:"target"`
+	Interval int               `json:"interval"`
+	Timeout  int               `json:"timeout"`
+	Config   map[string]string `json:"config"`
+}
+
+// WizardCommit результат фиксации сессии мастера
+type WizardCommit struct {
+	Session WizardSession `json:"session"`
+	Checks  []WizardCheck `json:"checks"`
+}
+
+// ForgeWizardClient клиент API мастера создания проверок Forge
+type ForgeWizardClient struct {
+	baseURL    string
+	httpClient *http.Client
+	tokenStore TokenStoreInterface
+}
+
+// NewForgeWizardClient создает клиент мастера Forge
+func NewForgeWizardClient(baseURL string, tokenStore TokenStoreInterface) *ForgeWizardClient {
+	return &ForgeWizardClient{
+		baseURL: baseURL,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+		},
+		tokenStore: tokenStore,
+	}
+}
+
+// Start открывает сессию по содержимому .proto или OpenAPI спецификации
+func (c *ForgeWizardClient) Start(ctx context.Context, sourceType, fileName, content string) (*WizardStep, error) {
+	var step WizardStep
+	err := c.do(ctx, http.MethodPost, "", map[string]string{
+		"source_type": sourceType,
+		"file_name":   fileName,
+		"content":     content,
+	}, http.StatusCreated, &step)
+	if err != nil {
+		return nil, err
+	}
+	return &step, nil
+}
+
+// Answer отправляет ответ на вопрос; пустое значение означает значение по умолчанию
+func (c *ForgeWizardClient) Answer(ctx context.Context, sessionID, questionID, value string) (*WizardStep, error) {
+	var step WizardStep
+	err := c.do(ctx, http.MethodPost, "/"+url.PathEscape(sessionID)+"/answers", map[string]string{
+		"question_id": questionID,
+		"value":       value,
+	}, http.StatusOK, &step)
+	if err != nil {
+		return nil, err
+	}
+	return &step, nil
+}
+
+// Preview возвращает проверки, которые будут созданы
+func (c *ForgeWizardClient) Preview(ctx context.Context, sessionID string) ([]WizardCheck, error) {
+	var response struct {
+		Checks []WizardCheck `json:"checks"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/"+url.PathEscape(sessionID)+"/preview", nil, http.StatusOK, &response); err != nil {
+		return nil, err
+	}
+	return response.Checks, nil
+}
+
+// Commit фиксирует сессию и возвращает проверки с учетными данными
+func (c *ForgeWizardClient) Commit(ctx context.Context, sessionID string) (*WizardCommit, error) {
+	var commit WizardCommit
+	if err := c.do(ctx, http.MethodPost, "/"+url.PathEscape(sessionID)+"/commit", nil, http.StatusOK, &commit); err != nil {
+		return nil, err
+	}
+	return &commit, nil
+}
+
+// Cancel удаляет сессию
+func (c *ForgeWizardClient) Cancel(ctx context.Context, sessionID string) error {
+	return c.do(ctx, http.MethodDelete, "/"+url.PathEscape(sessionID), nil, http.StatusNoContent, nil)
+}
+
+// Close закрывает клиент
+func (c *ForgeWizardClient) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// do выполняет запрос к API мастера
+func (c *ForgeWizardClient) do(ctx context.Context, method, path string, body interface{}, expectedStatus int, out interface{}) error {
+	token := ""
+	if c.tokenStore != nil {
+		token = c.tokenStore.GetAccessToken()
+	}
+	if token == "" {
+		return fmt.Errorf("токен авторизации не найден")
+	}
+
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("ошибка кодирования запроса: %w", err)
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/api/v1/forge/wizard/sessions"+path, reader)
+	if err != nil {
+		return fmt.Errorf("ошибка создания HTTP запроса: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+	httpReq.Header.Set("User-Agent", "UptimePing-CLI/1.0")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("ошибка выполнения HTTP запроса: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Error != "" {
+			return fmt.Errorf("сервер вернул статус %d: %s", resp.StatusCode, apiErr.Error)
+		}
+		return fmt.Errorf("сервер вернул статус: %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("ошибка декодирования ответа: %w", err)
+	}
+	return nil
+}
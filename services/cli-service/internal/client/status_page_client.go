@@ -0,0 +1,247 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// StatusOverride ручной статус компонента страницы статуса
+type StatusOverride struct {
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+	SetBy     string `json:"set_by,omitempty"`
+	SetAt     string `json:"set_at,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// StatusComponent компонент страницы статуса
+type StatusComponent struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	CheckIDs    []string        `json:"check_ids,omitempty"`
+	Position    int32           `json:"position,omitempty"`
+	Override    *StatusOverride `json:"override,omitempty"`
+	UpdatedAt   string          `json:"updated_at,omitempty"`
+}
+
+// StatusComponentInput данные создания и изменения компонента
+type StatusComponentInput struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	CheckIDs    []string `json:"check_ids,omitempty"`
+	Position    int32    `json:"position"`
+}
+
+// StatusComponentView компонент с отображаемым статусом
+type StatusComponentView struct {
+	Component       StatusComponent `json:"component"`
+	AutomatedStatus string          `json:"automated_status"`
+	Status          string          `json:"status"`
+	StatusSource    string          `json:"status_source"`
+	StatusMessage   string          `json:"status_message,omitempty"`
+}
+
+// Maintenance объявление о плановом обслуживании; время в RFC3339
+type Maintenance struct {
+	ID           string   `json:"id"`
+	Title        string   `json:"title"`
+	Message      string   `json:"message,omitempty"`
+	ComponentIDs []string `json:"component_ids,omitempty"`
+	StartsAt     string   `json:"starts_at"`
+	EndsAt       string   `json:"ends_at"`
+	CancelledAt  string   `json:"cancelled_at,omitempty"`
+	State        string   `json:"state,omitempty"`
+	CreatedBy    string   `json:"created_by,omitempty"`
+}
+
+// StatusPage страница статуса арендатора
+type StatusPage struct {
+	Status               string                `json:"status"`
+	Components           []StatusComponentView `json:"components"`
+	ActiveMaintenances   []Maintenance         `json:"active_maintenances"`
+	UpcomingMaintenances []Maintenance         `json:"upcoming_maintenances"`
+	GeneratedAt          string                `json:"generated_at"`
+}
+
+// StatusPageClient клиент API страницы статуса
+type StatusPageClient struct {
+	baseURL    string
+	httpClient *http.Client
+	tokenStore TokenStoreInterface
+}
+
+// NewStatusPageClient создает клиент страницы статуса
+func NewStatusPageClient(baseURL string, tokenStore TokenStoreInterface) *StatusPageClient {
+	return &StatusPageClient{
+		baseURL: baseURL,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		tokenStore: tokenStore,
+	}
+}
+
+// GetStatusPage возвращает страницу статуса с вычисленными статусами компонентов
+func (c *StatusPageClient) GetStatusPage(ctx context.Context) (*StatusPage, error) {
+	var response struct {
+		StatusPage StatusPage `json:"status_page"`
+	}
+	if err := c.do(ctx, http.MethodGet, "", nil, http.StatusOK, &response); err != nil {
+		return nil, err
+	}
+	return &response.StatusPage, nil
+}
+
+// ListComponents возвращает компоненты страницы статуса
+func (c *StatusPageClient) ListComponents(ctx context.Context) ([]StatusComponent, error) {
+	var response struct {
+		Components []StatusComponent `json:"components"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/components", nil, http.StatusOK, &response); err != nil {
+		return nil, err
+	}
+	return response.Components, nil
+}
+
+// CreateComponent создает компонент
+func (c *StatusPageClient) CreateComponent(ctx context.Context, input *StatusComponentInput) (*StatusComponent, error) {
+	return c.componentRequest(ctx, http.MethodPost, "/components", input, http.StatusCreated)
+}
+
+// UpdateComponent заменяет данные компонента
+func (c *StatusPageClient) UpdateComponent(ctx context.Context, id string, input *StatusComponentInput) (*StatusComponent, error) {
+	return c.componentRequest(ctx, http.MethodPut, "/components/"+url.PathEscape(id), input, http.StatusOK)
+}
+
+// DeleteComponent удаляет компонент
+func (c *StatusPageClient) DeleteComponent(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/components/"+url.PathEscape(id), nil, http.StatusOK, nil)
+}
+
+// SetOverride задает ручной статус компонента; пустой expiresAt - до ручного снятия
+func (c *StatusPageClient) SetOverride(ctx context.Context, id, status, message, expiresAt string) (*StatusComponent, error) {
+	return c.componentRequest(ctx, http.MethodPut, "/components/"+url.PathEscape(id)+"/override", &StatusOverride{
+		Status:    status,
+		Message:   message,
+		ExpiresAt: expiresAt,
+	}, http.StatusOK)
+}
+
+// ClearOverride снимает ручной статус компонента
+func (c *StatusPageClient) ClearOverride(ctx context.Context, id string) (*StatusComponent, error) {
+	return c.componentRequest(ctx, http.MethodDelete, "/components/"+url.PathEscape(id)+"/override", nil, http.StatusOK)
+}
+
+// ListMaintenances возвращает объявления, которые заканчиваются не раньше since; пустой since - текущий момент
+func (c *StatusPageClient) ListMaintenances(ctx context.Context, since string) ([]Maintenance, error) {
+	path := "/maintenances"
+	if since != "" {
+		path += "?since=" + url.QueryEscape(since)
+	}
+
+	var response struct {
+		Maintenances []Maintenance `json:"maintenances"`
+	}
+	if err := c.do(ctx, http.MethodGet, path, nil, http.StatusOK, &response); err != nil {
+		return nil, err
+	}
+	return response.Maintenances, nil
+}
+
+// ScheduleMaintenance публикует объявление об обслуживании
+func (c *StatusPageClient) ScheduleMaintenance(ctx context.Context, maintenance *Maintenance) (*Maintenance, error) {
+	var response struct {
+		Maintenance Maintenance `json:"maintenance"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/maintenances", maintenance, http.StatusCreated, &response); err != nil {
+		return nil, err
+	}
+	return &response.Maintenance, nil
+}
+
+// CancelMaintenance отменяет объявление об обслуживании
+func (c *StatusPageClient) CancelMaintenance(ctx context.Context, id string) (*Maintenance, error) {
+	var response struct {
+		Maintenance Maintenance `json:"maintenance"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/maintenances/"+url.PathEscape(id)+"/cancel", nil, http.StatusOK, &response); err != nil {
+		return nil, err
+	}
+	return &response.Maintenance, nil
+}
+
+// Close закрывает клиент
+func (c *StatusPageClient) Close() error {
+	c.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// componentRequest выполняет запрос, возвращающий компонент
+func (c *StatusPageClient) componentRequest(ctx context.Context, method, path string, body interface{}, expectedStatus int) (*StatusComponent, error) {
+	var response struct {
+		Component StatusComponent `json:"component"`
+	}
+	if err := c.do(ctx, method, path, body, expectedStatus, &response); err != nil {
+		return nil, err
+	}
+	return &response.Component, nil
+}
+
+// do выполняет запрос к API страницы статуса
+func (c *StatusPageClient) do(ctx context.Context, method, path string, body interface{}, expectedStatus int, out interface{}) error {
+	token := ""
+	if c.tokenStore != nil {
+		token = c.tokenStore.GetAccessToken()
+	}
+	if token == "" {
+		return fmt.Errorf("токен авторизации не найден")
+	}
+
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("ошибка кодирования запроса: %w", err)
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/api/v1/status-page"+path, reader)
+	if err != nil {
+		return fmt.Errorf("ошибка создания HTTP запроса: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+	httpReq.Header.Set("User-Agent", "UptimePing-CLI/1.0")
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("ошибка выполнения HTTP запроса: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != expectedStatus {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Message != "" {
+			return fmt.Errorf("сервер вернул статус %d: %s", resp.StatusCode, apiErr.Message)
+		}
+		return fmt.Errorf("сервер вернул статус: %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("ошибка декодирования ответа: %w", err)
+	}
+	return nil
+}
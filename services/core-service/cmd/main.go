@@ -4,17 +4,40 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"UptimePingPlatform/pkg/config"
+	pkg_database "UptimePingPlatform/pkg/database"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/health"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/metrics"
+	pkg_plugin "UptimePingPlatform/pkg/plugin"
+	"UptimePingPlatform/pkg/rabbitmq"
 	pkg_redis "UptimePingPlatform/pkg/redis"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/core-service/internal/client"
+	core_consumer "UptimePingPlatform/services/core-service/internal/consumer/rabbitmq"
+	grpcHandler "UptimePingPlatform/services/core-service/internal/handler/grpc"
+	"UptimePingPlatform/services/core-service/internal/handler/probe"
+	core_metrics "UptimePingPlatform/services/core-service/internal/metrics"
+	"UptimePingPlatform/services/core-service/internal/repository"
+	core_postgres "UptimePingPlatform/services/core-service/internal/repository/postgres"
+	core_s3 "UptimePingPlatform/services/core-service/internal/repository/s3"
+	"UptimePingPlatform/services/core-service/internal/service"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
+	"UptimePingPlatform/services/core-service/internal/worker"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -32,35 +55,229 @@ func main() {
 	defer appLogger.Sync()
 
 	appLogger.Info("Starting Core Service...")
+	appLogger.Info("Effective configuration", logger.Any("config", cfg.Redacted()))
 
 	// Initialize metrics
 	appMetrics := metrics.NewMetrics("core-service")
 	metricsHandler := appMetrics.GetHandler()
 
-	// Initialize health checker
-	healthChecker := health.NewSimpleHealthChecker("1.0.0")
+	// Метрики выполнения проверок; метка tenant ограничена CHECK_METRICS_TENANT_MODE/CHECK_METRICS_TENANT_LIMIT
+	tenantLabelLimit, _ := strconv.Atoi(os.Getenv("CHECK_METRICS_TENANT_LIMIT"))
+	executionMetrics := core_metrics.NewExecutionMetrics("core_service", core_metrics.NewTenantLabeler(
+		core_metrics.TenantLabelMode(os.Getenv("CHECK_METRICS_TENANT_MODE")), tenantLabelLimit,
+	))
 
-	// Initialize Redis client
-	redisClient, err := pkg_redis.Connect(context.Background(), &pkg_redis.Config{
-		Addr:     cfg.Redis.Addr,
-		Password: cfg.Redis.Password,
-		DB:       cfg.Redis.DB,
-	})
+	// Токены scrape для статусов проверок tenant в формате tenant_id:token,...
+	scrapeTokens, err := core_metrics.ParseScrapeTokens(os.Getenv("TENANT_METRICS_TOKENS"))
 	if err != nil {
-		appLogger.Error("Failed to connect to Redis", logger.Error(err))
+		appLogger.Error("Invalid TENANT_METRICS_TOKENS, tenant metrics endpoint disabled", logger.Error(err))
+	}
+	tenantMetricsHandler := executionMetrics.Statuses().TenantHandler(scrapeTokens)
+
+	// Проверки не соединяются с внутренними сетями и метаданными облака, если это не разрешено
+	// для установки явно
+	var targetPolicy *checker.TargetPolicy
+	if cfg.CheckTargets.Restrict {
+		targetPolicy, err = checker.NewDefaultTargetPolicy(cfg.CheckTargets.Deny, cfg.CheckTargets.Allow)
+		if err != nil {
+			log.Fatalf("Invalid check targets config: %v", err)
+		}
 	} else {
+		appLogger.Warn("Check target restriction is disabled, checks can reach internal networks")
+	}
+
+	// /probe в формате blackbox_exporter: проверки выполняются без сохранения результатов,
+	// доступ по тем же scrape токенам tenant
+	probeService := service.NewCheckService(
+		appLogger,
+		checker.NewDefaultCheckerFactory(appLogger, checker.NewDefaultHTTPClient(30*time.Second)).WithTargetPolicy(targetPolicy),
+		nil, nil, nil,
+	)
+	probeHandler := probe.NewHandler(probeService, scrapeTokens, appLogger)
+
+	// Зависимости подключаются с повторами: без RabbitMQ при заданной очереди задач сервис
+	// не запускается, без базы данных и Redis работает в деградированном режиме
+	dependencies := health.NewDependencies("1.0.0", cfg.Startup, appLogger)
+
+	// Опрос глубины очередей RabbitMQ для /metrics
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	defer stopMonitor()
+	tenantRouting := rabbitmq.NewTenantRouting(cfg.RabbitMQ.Queue, cfg.RabbitMQ.VirtualQueues)
+	if cfg.RabbitMQ.URL != "" && cfg.RabbitMQ.Queue != "" {
+		queues := append([]string{cfg.RabbitMQ.Queue}, tenantRouting.Queues()...)
+		if err := rabbitmq.StartQueueMonitor(monitorCtx, cfg.RabbitMQ.URL, queues, appLogger); err != nil {
+			appLogger.Warn("Failed to start RabbitMQ queue monitor", logger.Error(err))
+		}
+	}
+
+	// Задачи проверок забираются из RabbitMQ, если задана очередь
+	consumeTasks := cfg.RabbitMQ.URL != "" && cfg.RabbitMQ.Queue != ""
+
+	// База данных для результатов проверок, обслуживания партиций check_results и сводок доступности
+	var pools *pkg_database.Pools
+	if consumeTasks || cfg.CheckResults.PartitionMaintenance || cfg.CheckResults.UptimeRollup {
+		dbConfig := &pkg_database.Config{
+			Host:          cfg.Database.Host,
+			Port:          cfg.Database.Port,
+			User:          cfg.Database.User,
+			Password:      cfg.Database.Password,
+			Database:      cfg.Database.Name,
+			SSLMode:       "disable",
+			MaxConns:      20,
+			MinConns:      5,
+			MaxConnLife:   30 * time.Minute,
+			MaxConnIdle:   5 * time.Minute,
+			HealthCheck:   30 * time.Second,
+			RetryInterval: 1 * time.Second,
+
+			StatementTimeout:   cfg.Database.StatementTimeout,
+			PreparedStatements: core_postgres.CheckResultStatements,
+			ReplicaDSN:         cfg.Database.ReplicaURL,
+		}
+		if cfg.Database.MaxConns > 0 {
+			dbConfig.MaxConns = cfg.Database.MaxConns
+			dbConfig.MinConns = cfg.Database.MinConns
+		}
+		// Пул чтения подключается к реплике, если она задана, иначе отдельный пул
+		// к primary включается только при заданном read_max_conns
+		readConfig := dbConfig.Replica()
+		if readConfig == nil && cfg.Database.ReadMaxConns > 0 {
+			readConfig = dbConfig
+		}
+		if readConfig != nil {
+			readConfig = readConfig.ReadPool(cfg.Database.ReadMaxConns, 0, cfg.Database.ReadStatementTimeout)
+		}
+		if err := dependencies.Start(context.Background(), health.Dependency{
+			Name: "database",
+			Connect: func(ctx context.Context) (err error) {
+				pools, err = pkg_database.ConnectPools(ctx, dbConfig, readConfig)
+				return err
+			},
+			Ping: func(ctx context.Context) error { return pools.Write.Ping(ctx) },
+		}); err != nil {
+			log.Fatalf("Database connection failed: %v", err)
+		}
+		if pools != nil {
+			defer pools.Close()
+		}
+	}
+
+	// Обслуживание партиций check_results: создание дневных партиций и удаление устаревших
+	if cfg.CheckResults.PartitionMaintenance && pools != nil {
+		maintainer := worker.NewPartitionMaintainer(
+			core_postgres.NewPartitionRepository(pools.Write, appLogger),
+			cfg.CheckResults.Retention, cfg.CheckResults.PartitionsAhead, appLogger,
+		)
+		go maintainer.Run(monitorCtx, cfg.CheckResults.MaintenanceInterval)
+	}
+
+	// Дневные сводки доступности для 90-дневной истории на страницах статуса
+	if cfg.CheckResults.UptimeRollup && pools != nil {
+		rollup := worker.NewUptimeRollup(
+			core_postgres.NewUptimeRepositoryWithPools(pools, appLogger),
+			cfg.CheckResults.DegradedResponseTime, appLogger,
+		)
+		go rollup.Run(monitorCtx, cfg.CheckResults.UptimeRollupInterval)
+	}
+
+	// Хранилище скриншотов, HAR и снимков ответов; метаданные артефактов хранятся в БД
+	var artifacts *service.ArtifactService
+	if cfg.Artifacts.Enabled() && pools != nil {
+		artifacts, err = setupArtifacts(monitorCtx, cfg, pools, appLogger)
+		if err != nil {
+			appLogger.Error("Failed to configure artifact storage, captures stay in database", logger.Error(err))
+		}
+	}
+
+	// Initialize Redis client
+	var redisClient *pkg_redis.Client
+	if err := dependencies.Start(context.Background(), health.Dependency{
+		Name: "redis",
+		Connect: func(ctx context.Context) (err error) {
+			redisClient, err = pkg_redis.Connect(ctx, &pkg_redis.Config{
+				Addr:     cfg.Redis.Addr,
+				Password: cfg.Redis.Password,
+				DB:       cfg.Redis.DB,
+			})
+			return err
+		},
+		Ping: func(ctx context.Context) error { return redisClient.HealthCheck(ctx) },
+	}); err != nil {
+		log.Fatalf("Redis connection failed: %v", err)
+	}
+	if redisClient != nil {
 		defer redisClient.Close()
 	}
 
+	// История смен статусов зависимостей для /health/history; в Redis она переживает перезапуск
+	healthHistory := health.NewHistory(dependencies, health.DefaultHistorySize)
+	if redisClient != nil {
+		healthHistory.WithStore(health.NewRedisHistoryStore(redisClient, "core-service", health.DefaultHistorySize), appLogger)
+	}
+	go healthHistory.Run(context.Background(), cfg.Health.CheckIntervalDuration())
+
+	// Обработка задач проверок; при остановке consumer дренируется
+	consumerCtx, stopConsumer := context.WithCancel(context.Background())
+	defer stopConsumer()
+	var taskConsumer *core_consumer.Consumer
+	if consumeTasks {
+		var rabbitConn *rabbitmq.Connection
+		rabbitConfig := rabbitmq.NewConfig()
+		rabbitConfig.URL = cfg.RabbitMQ.URL
+		// Повторы подключения выполняет политика запуска зависимостей
+		rabbitConfig.MaxRetries = 0
+		if err := dependencies.Start(consumerCtx, health.Dependency{
+			Name:     "rabbitmq",
+			Required: true,
+			Connect: func(ctx context.Context) (err error) {
+				rabbitConn, err = rabbitmq.Connect(ctx, rabbitConfig)
+				return err
+			},
+			Ping: func(ctx context.Context) error {
+				if rabbitConn.Channel().IsClosed() {
+					return fmt.Errorf("rabbitmq channel is closed")
+				}
+				return nil
+			},
+		}); err != nil {
+			log.Fatalf("RabbitMQ connection failed: %v", err)
+		}
+		defer rabbitConn.Close()
+
+		taskConsumer, err = startTaskConsumer(consumerCtx, cfg, rabbitConn, pools, redisClient, executionMetrics, artifacts, targetPolicy, appLogger)
+		if err != nil {
+			appLogger.Error("Failed to start task consumer", logger.Error(err))
+		}
+	}
+
+	// gRPC CoreService: ручные запуски, статусы и история проверок для gateway, CLI и metrics-service
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer(pkg_grpc.ServerOptions(cfg.GRPC)...)
+	corev1.RegisterCoreServiceServer(grpcServer, newCoreHandler(pools, redisClient, artifacts, targetPolicy, appLogger))
+	// grpc.health.v1 для проверок здоровья подканалов на стороне клиентов
+	healthServer := pkg_grpc.RegisterHealthServer(grpcServer, corev1.CoreService_ServiceDesc.ServiceName)
+	if pkg_grpc.RegisterReflection(grpcServer, cfg.GRPC, cfg.Environment) {
+		appLogger.Info("gRPC reflection is enabled")
+	}
+	go func() {
+		appLogger.Info(fmt.Sprintf("Starting gRPC server on port %d", cfg.Server.Port))
+		if err := grpcServer.Serve(pkg_grpc.LimitListener(lis, cfg.GRPC)); err != nil {
+			appLogger.Error("gRPC server failed", logger.Error(err))
+		}
+	}()
+
 	// Start HTTP server for metrics and health
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
-		Handler: setupHTTPHandler(metricsHandler, healthChecker, appLogger),
+		Addr:    fmt.Sprintf(":%d", cfg.Server.Port+1000), // Health check on port +1000
+		Handler: setupHTTPHandler(metricsHandler, tenantMetricsHandler, probeHandler, healthHistory, appLogger),
 	}
 
 	// Start server
 	go func() {
-		appLogger.Info(fmt.Sprintf("Starting HTTP server on port %d", cfg.Server.Port))
+		appLogger.Info(fmt.Sprintf("Starting HTTP server on port %d", cfg.Server.Port+1000))
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			appLogger.Error("HTTP server failed", logger.Error(err))
 		}
@@ -76,6 +293,17 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// Новые задачи не забираются, начатые проверки завершаются и сохраняют результат
+	if taskConsumer != nil {
+		if err := taskConsumer.Drain(ctx); err != nil {
+			appLogger.Error("Task consumer drain incomplete", logger.Error(err))
+		}
+		stopConsumer()
+	}
+
+	healthServer.Shutdown()
+	grpcServer.GracefulStop()
+
 	if err := httpServer.Shutdown(ctx); err != nil {
 		appLogger.Error("Server shutdown failed", logger.Error(err))
 	}
@@ -83,11 +311,269 @@ func main() {
 	appLogger.Info("Server stopped")
 }
 
-func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, appLogger logger.Logger) http.Handler {
+// newCoreHandler собирает обработчики gRPC CoreService. Ручные запуски ограничены той же политикой
+// целей, что и проверки из очереди; без базы данных история и доступность не возвращаются
+func newCoreHandler(pools *pkg_database.Pools, redisClient *pkg_redis.Client, artifacts *service.ArtifactService, targetPolicy *checker.TargetPolicy, appLogger logger.Logger) *grpcHandler.CoreHandler {
+	var resultRepo repository.CheckResultRepository
+	if pools != nil {
+		resultRepo = core_postgres.NewCheckResultRepositoryWithPools(pools, appLogger)
+	}
+	checkService := service.NewCheckService(
+		appLogger,
+		checker.NewDefaultCheckerFactory(appLogger, checker.NewDefaultHTTPClient(30*time.Second)).WithTargetPolicy(targetPolicy),
+		resultRepo,
+		redisClient,
+		nil,
+	)
+	handler := grpcHandler.NewCoreHandler(checkService, appLogger)
+	if pools != nil {
+		handler.WithUptime(service.NewUptimeService(core_postgres.NewUptimeRepositoryWithPools(pools, appLogger), appLogger))
+	}
+	if artifacts != nil {
+		checkService.WithArtifacts(artifacts)
+		handler.WithArtifacts(artifacts)
+	}
+	return handler
+}
+
+// startTaskConsumer подключается к RabbitMQ и запускает обработку задач проверок.
+// Без базы данных результаты не сохраняются, только кешируются и учитываются в метриках
+func startTaskConsumer(ctx context.Context, cfg *config.Config, rabbitConn *rabbitmq.Connection, pools *pkg_database.Pools, redisClient *pkg_redis.Client, executionMetrics *core_metrics.ExecutionMetrics, artifacts *service.ArtifactService, targetPolicy *checker.TargetPolicy, appLogger logger.Logger) (*core_consumer.Consumer, error) {
+	var resultRepo repository.CheckResultRepository
+	if pools != nil {
+		resultRepo = core_postgres.NewCheckResultRepositoryWithPools(pools, appLogger)
+	}
+	checkerFactory := checker.NewDefaultCheckerFactory(appLogger, checker.NewDefaultHTTPClient(30*time.Second)).
+		WithTargetPolicy(targetPolicy)
+	if plugins := setupCheckerPlugins(cfg.CheckerPlugins, appLogger); plugins != nil {
+		checkerFactory.WithPlugins(plugins)
+		// Плагины останавливаются после дренирования consumer, когда начатые проверки завершены
+		go func() {
+			<-ctx.Done()
+			plugins.Close()
+		}()
+	}
+	checkService := service.NewCheckService(
+		appLogger,
+		checkerFactory,
+		resultRepo,
+		redisClient,
+		nil,
+	).WithExecutionRecorder(executionMetrics).WithCostRecorder(executionMetrics)
+	// Результаты по группам публикуются в group_check_results_total для uptime групп в metrics-service
+	checkService.WithGroupRecorder(core_metrics.NewUptimeMetricsAdapter(core_metrics.NewUptimeMetrics("core_service")))
+	if artifacts != nil {
+		checkService.WithArtifacts(artifacts)
+	}
+	// Снимки неудачных проверок удаляются раньше самих результатов по check_results.capture_retention
+	if resultRepo != nil && cfg.CheckResults.CaptureRetention > 0 {
+		go worker.NewCapturePurge(checkService, cfg.CheckResults.CaptureRetention, appLogger).
+			Run(ctx, cfg.CheckResults.CapturePurgeInterval)
+	}
+	// Задачи, поставленные планировщиком под истекшей блокировкой, отбрасываются по fencing токену
+	if redisClient != nil {
+		checkService.WithFencing(service.NewRedisFencingGuard(redisClient, service.DefaultFencingTTL))
+	}
+	// Результаты проверок из нескольких регионов объединяются по политике консенсуса; результаты
+	// регионов одного запуска собираются в Redis, общем для экземпляров всех регионов
+	if cfg.CheckResults.Region != "" {
+		if redisClient == nil {
+			appLogger.Warn("Redis is not available, multi-region consensus is disabled",
+				logger.String("region", cfg.CheckResults.Region))
+		} else {
+			setupConsensus(checkService, cfg.CheckResults, service.NewRedisRegionResultStore(redisClient),
+				core_metrics.NewConsensusMetrics("core_service"))
+			// Выполнения, часть регионов которых не прислала результат за окно, решаются по приславшим
+			go worker.NewConsensusSweep(checkService, appLogger).Run(ctx, worker.DefaultConsensusSweepInterval)
+		}
+	}
+	// Проверки компонентов платформы из мета-tenant самомониторинга обращаются к внутренним адресам,
+	// которые политика целей запрещает проверкам клиентов
+	if cfg.SelfMonitoring.Enabled {
+		checkService.WithPlatformCheckers(cfg.SelfMonitoring.TenantID,
+			checker.NewDefaultCheckerFactory(appLogger, checker.NewDefaultHTTPClient(30*time.Second)))
+	}
+	// Задачи планировщика собираются по проверке из таблицы checks; задачи по удаленным и
+	// измененным после постановки проверкам отбрасываются по версии конфигурации
+	if pools != nil {
+		checkService.WithConfigFreshness(service.NewConfigFreshness(
+			core_postgres.NewCheckDefinitionRepository(pools.Write, appLogger), service.DefaultConfigVersionTTL,
+		))
+	}
+	// Задержки очереди и сохранения результатов учитываются как SLI конвейера
+	checkService.WithPipelineSLO(setupPipelineSLO(ctx, cfg, appLogger))
+
+	// Смены здоровья проверок отправляются в сводку по парку проверок планировщика
+	schedulerAddr := os.Getenv("SCHEDULER_SERVICE_ADDR")
+	if schedulerAddr == "" {
+		schedulerAddr = "scheduler-service:50052"
+	}
+	if schedulerConn, err := grpc.Dial(schedulerAddr, grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+		appLogger.Warn("Failed to connect to scheduler service, fleet health is not reported", logger.Error(err))
+	} else {
+		go func() {
+			<-ctx.Done()
+			schedulerConn.Close()
+		}()
+		checkService.WithHealthRecorder(service.NewFleetHealthReporter(
+			schedulerv1.NewSchedulerServiceClient(schedulerConn),
+			cfg.CheckResults.DegradedResponseTime, appLogger,
+		))
+	}
+
+	// Сырые результаты проверок отправляются в приемники, заданные в настройках тенантов
+	settingsAddr := os.Getenv("SETTINGS_SERVICE_ADDR")
+	if settingsAddr == "" {
+		settingsAddr = "auth-service:50058"
+	}
+	if settingsConn, err := grpc.Dial(settingsAddr, grpc.WithTransportCredentials(insecure.NewCredentials())); err != nil {
+		appLogger.Warn("Failed to connect to tenant settings service, result sinks are disabled", logger.Error(err))
+	} else {
+		// Адреса приемников задают тенанты, поэтому соединения с ними ограничены той же политикой,
+		// что и проверки
+		streamer := service.NewResultStreamer(service.TenantSinkLookup(configv1.NewTenantSettingsServiceClient(settingsConn)), appLogger).
+			WithHTTPClient(&http.Client{
+				Timeout:   10 * time.Second,
+				Transport: &http.Transport{DialContext: targetPolicy.DialContext},
+			})
+		go func() {
+			streamer.Run(ctx)
+			settingsConn.Close()
+		}()
+		checkService.WithResultRecorder(streamer)
+	}
+
+	consumer, err := core_consumer.NewConsumer(core_consumer.ConsumerConfig{
+		QueueName:     cfg.RabbitMQ.Queue,
+		ConsumerTag:   "core-service",
+		MaxPriority:   uint8(cfg.RabbitMQ.MaxPriority),
+		TenantRouting: rabbitmq.NewTenantRouting(cfg.RabbitMQ.Queue, cfg.RabbitMQ.VirtualQueues),
+		PrefetchCount: cfg.RabbitMQ.TenantPrefetch,
+	}, appLogger, checkService, rabbitConn)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := consumer.Start(ctx); err != nil && ctx.Err() == nil {
+			appLogger.Error("Task consumer stopped", logger.Error(err))
+		}
+	}()
+	return consumer, nil
+}
+
+// setupConsensus подключает объединение результатов регионов: экземпляр выполняет задачи как
+// регион results.Region и ждет результатов остальных регионов не дольше results.ConsensusWindow
+func setupConsensus(checkService *service.CheckService, results config.CheckResultsConfig, store service.RegionResultStore, recorder service.ConsensusRecorder) {
+	checkService.
+		WithConsensus(service.NewConsensusEvaluator(store, results.ConsensusWindow), results.Region).
+		WithConsensusRecorder(recorder)
+}
+
+// setupPipelineSLO запускает оценку SLI конвейера. При включенном самомониторинге превышение
+// порогов открывает внутренний инцидент в мета-tenant; без Incident Manager пороги видны только в метриках
+func setupPipelineSLO(ctx context.Context, cfg *config.Config, appLogger logger.Logger) *service.PipelineSLO {
+	monitoring := cfg.SelfMonitoring
+	pipeline := monitoring.Pipeline
+	slo := service.NewPipelineSLO(service.PipelineSLIConfig{
+		Window:     pipeline.Window,
+		Quantile:   pipeline.Quantile,
+		MinSamples: pipeline.MinSamples,
+		Thresholds: map[string]time.Duration{
+			service.PipelineSLIQueueLag:     pipeline.QueueLagThreshold,
+			service.PipelineSLIPersistDelay: pipeline.PersistDelayThreshold,
+		},
+	}, core_metrics.NewPipelineMetrics("core_service"), appLogger)
+
+	if monitoring.Enabled {
+		incidentConfig := client.DefaultConfig()
+		if cfg.IncidentManager.Address != "" {
+			incidentConfig.Address = cfg.IncidentManager.Address
+		}
+		incidentConfig.Timeout = 5 * time.Second
+		incidentConfig.MaxRetries = 1
+		incidentConfig.ServiceToken = cfg.GRPC.ServiceToken
+		if incidentClient, err := client.NewIncidentClient(incidentConfig, appLogger); err != nil {
+			appLogger.Warn("Failed to connect to incident manager, pipeline SLI incidents are disabled", logger.Error(err))
+		} else {
+			go func() {
+				<-ctx.Done()
+				incidentClient.Close()
+			}()
+			slo.WithIncidents(monitoring.TenantID, service.NewGRPCIncidentManager(incidentClient, appLogger))
+		}
+	}
+
+	if pipeline.EvaluationInterval > 0 {
+		go slo.Run(ctx, pipeline.EvaluationInterval)
+	}
+	return slo
+}
+
+// setupCheckerPlugins запускает внешние плагины проверок из конфигурации; без загруженных
+// плагинов проверки типа plugin не поддерживаются
+func setupCheckerPlugins(configs []config.CheckerPluginConfig, appLogger logger.Logger) *checker.PluginChecker {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	pluginConfigs := make([]checker.PluginConfig, 0, len(configs))
+	for _, cfg := range configs {
+		pluginConfigs = append(pluginConfigs, checker.PluginConfig{
+			Path:          cfg.Path,
+			Args:          cfg.Args,
+			Env:           cfg.Env,
+			Timeout:       cfg.Timeout,
+			MaxConcurrent: cfg.MaxConcurrent,
+			Limits: pkg_plugin.Limits{
+				MemoryBytes: uint64(cfg.MemoryLimitMB) << 20,
+				OpenFiles:   uint64(cfg.MaxOpenFiles),
+			},
+		})
+	}
+	plugins := checker.LoadPlugins(pluginConfigs, appLogger)
+	if len(plugins) == 0 {
+		appLogger.Warn("No checker plugins loaded")
+		return nil
+	}
+
+	pluginChecker := checker.NewPluginChecker(appLogger, plugins...)
+	appLogger.Info("Checker plugins enabled", logger.Any("plugins", pluginChecker.Plugins()))
+	return pluginChecker
+}
+
+// setupArtifacts подключает S3-совместимое хранилище артефактов и запускает удаление
+// артефактов с истекшим сроком хранения
+func setupArtifacts(ctx context.Context, cfg *config.Config, pools *pkg_database.Pools, appLogger logger.Logger) (*service.ArtifactService, error) {
+	store, err := core_s3.NewArtifactStore(core_s3.Config{
+		Endpoint:        cfg.Artifacts.Endpoint,
+		Region:          cfg.Artifacts.Region,
+		Bucket:          cfg.Artifacts.Bucket,
+		AccessKeyID:     cfg.Artifacts.AccessKeyID,
+		SecretAccessKey: cfg.Artifacts.SecretAccessKey,
+		PathStyle:       cfg.Artifacts.PathStyle,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactRepo := core_postgres.NewArtifactRepository(pools.Write, appLogger)
+	go worker.NewArtifactCleanup(artifactRepo, store, appLogger).Run(ctx, cfg.Artifacts.CleanupInterval)
+
+	return service.NewArtifactService(store, artifactRepo, cfg.Artifacts.TTL, cfg.Artifacts.URLTTL, appLogger), nil
+}
+
+func setupHTTPHandler(metricsHandler, tenantMetricsHandler, probeHandler http.Handler, healthHistory *health.History, appLogger logger.Logger) http.Handler {
 	mux := http.NewServeMux()
 	
 	// Metrics endpoint
 	mux.Handle("/metrics", metricsHandler)
+
+	// Статусы отдельных проверок, доступны только tenant-владельцу по scrape токену
+	mux.Handle("/metrics/tenant", tenantMetricsHandler)
+
+	// Проверки по запросу Prometheus, совместимые с blackbox_exporter
+	mux.Handle("/probe", probeHandler)
 	
 	// Health endpoints
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -95,10 +581,8 @@ func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthCh
 		w.Write([]byte(`{"status":"healthy","service":"core-service"}`))
 	})
 	
-	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"status":"ready","service":"core-service"}`))
-	})
+	mux.HandleFunc("/ready", health.ReadyHandler(healthHistory))
+	mux.HandleFunc("/health/history", health.HistoryHandler(healthHistory))
 	
 	mux.HandleFunc("/live", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -0,0 +1,78 @@
+// Команда result-backfill переводит исторические строки check_results на текущую версию схемы
+// результатов (domain.ResultSchemaVersion). Запускается после миграции, добавляющей поля
+// результата; повторный запуск продолжает с того же места.
+//
+// Подключение к PostgreSQL настраивается переменными DB_HOST, DB_PORT, DB_USER, DB_PASSWORD,
+// DB_NAME или DATABASE_URL. Запуск: make result-backfill
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"UptimePingPlatform/pkg/database"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository/postgres"
+	"UptimePingPlatform/services/core-service/internal/worker"
+)
+
+func main() {
+	var (
+		batch  = flag.Int("batch", worker.DefaultBackfillBatchSize, "строк в одном пакете")
+		pause  = flag.Duration("pause", 100*time.Millisecond, "пауза между пакетами")
+		dryRun = flag.Bool("dry-run", false, "только показать число строк по версиям схемы")
+	)
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	appLogger, err := logger.NewLogger(os.Getenv("ENVIRONMENT"), "info", "core-service", false)
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+
+	config := database.GetConfig()
+	config.MinConns = 1
+	db, err := database.Connect(ctx, config)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	backfill := worker.NewResultBackfill(postgres.NewResultSchemaRepository(db.Pool), *batch, *pause, appLogger)
+	report, err := backfill.Run(ctx, *dryRun)
+	if report != nil {
+		printReport(report)
+	}
+	if err != nil {
+		log.Fatalf("Backfill failed: %v", err)
+	}
+}
+
+// printReport выводит число строк по версиям схемы и переведенные строки
+func printReport(report *worker.BackfillReport) {
+	versions := make([]int, 0, len(report.Pending))
+	for version := range report.Pending {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+
+	fmt.Printf("Current schema version: %d\n", domain.ResultSchemaVersion)
+	for _, version := range versions {
+		fmt.Printf("  version %d: %d rows before backfill\n", version, report.Pending[version])
+	}
+	for version := domain.ResultSchemaBase; version < domain.ResultSchemaVersion; version++ {
+		if upgraded := report.Upgraded[version]; upgraded > 0 {
+			fmt.Printf("  upgraded %d -> %d: %d rows\n", version, version+1, upgraded)
+		}
+	}
+}
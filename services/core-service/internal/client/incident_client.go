@@ -10,6 +10,7 @@ import (
 
 	"UptimePingPlatform/proto/api/incident/v1"
 	"UptimePingPlatform/pkg/connection"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/core-service/internal/domain"
 	"google.golang.org/grpc"
@@ -49,6 +50,9 @@ type Config struct {
 
 	// Логирование
 	EnableLogging bool
+
+	// ServiceToken общий секрет, которым подписываются вызовы как внутренние вызовы сервиса
+	ServiceToken string
 }
 
 // DefaultConfig возвращает конфигурацию по умолчанию
@@ -254,19 +258,22 @@ func NewIncidentClient(config *Config, log logger.Logger) (IncidentClient, error
 
 // grpcConnecter реализует connection.Connecter для gRPC
 type grpcConnecter struct {
-	address string
-	timeout time.Duration
-	conn    *grpc.ClientConn
+	address      string
+	timeout      time.Duration
+	serviceToken string
+	conn         *grpc.ClientConn
 }
 
 func (g *grpcConnecter) Connect(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, g.timeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, g.address,
+	// round_robin по всем репликам incident-manager из DNS, нездоровые реплики исключаются
+	conn, err := grpc.DialContext(ctx, pkg_grpc.BalancedTarget(g.address), pkg_grpc.BalancedDialOptions("",
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		pkg_grpc.WithServiceToken(g.serviceToken),
 		grpc.WithBlock(),
-	)
+	)...)
 	if err != nil {
 		return fmt.Errorf("failed to dial: %w", err)
 	}
@@ -302,8 +309,9 @@ func (c *incidentClient) connect() error {
 
 	// Создаем gRPC connecter
 	connecter := &grpcConnecter{
-		address: c.config.Address,
-		timeout: c.config.Timeout,
+		address:      c.config.Address,
+		timeout:      c.config.Timeout,
+		serviceToken: c.config.ServiceToken,
 	}
 
 	// Используем ConnectWithRetry из pkg/connection
@@ -337,6 +345,16 @@ func (c *incidentClient) determineSeverity(result *domain.CheckResult) v1.Incide
 		return v1.IncidentSeverity_INCIDENT_SEVERITY_WARNING
 	}
 
+	// Серьезность, заданная правилами проверки, важнее угадывания по коду ответа
+	switch result.Metadata[domain.MetadataKeySeverityHint] {
+	case domain.SeverityCritical:
+		return v1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL
+	case domain.SeverityError:
+		return v1.IncidentSeverity_INCIDENT_SEVERITY_ERROR
+	case domain.SeverityWarning:
+		return v1.IncidentSeverity_INCIDENT_SEVERITY_WARNING
+	}
+
 	// Определяем серьезность на основе типа ошибки
 	if result.StatusCode >= 500 {
 		return v1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL
@@ -430,6 +448,7 @@ func (c *incidentClient) CreateIncident(ctx context.Context, result *domain.Chec
 			TenantId:     tenantID,
 			Severity:     c.determineSeverity(result),
 			ErrorMessage: result.Error,
+			Details:      result.Metadata,
 		}
 
 		resp, err := c.client.CreateIncident(ctx, req)
@@ -237,6 +237,15 @@ func TestIncidentClient_DetermineSeverity(t *testing.T) {
 			},
 			expected: v1.IncidentSeverity_INCIDENT_SEVERITY_ERROR,
 		},
+		{
+			name: "failed check with severity hint",
+			result: &domain.CheckResult{
+				Success:    false,
+				StatusCode: 503,
+				Metadata:   map[string]string{domain.MetadataKeySeverityHint: domain.SeverityWarning},
+			},
+			expected: v1.IncidentSeverity_INCIDENT_SEVERITY_WARNING,
+		},
 	}
 
 	for _, tt := range tests {
@@ -667,6 +676,62 @@ func (m *mockClient) ListIncidents(ctx context.Context, req *v1.ListIncidentsReq
 	return nil, status.Error(codes.Unavailable, "service unavailable")
 }
 
+func (m *mockClient) IngestAlert(ctx context.Context, req *v1.IngestAlertRequest, opts ...grpc.CallOption) (*v1.IngestAlertResponse, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) AcknowledgeIncident(ctx context.Context, req *v1.AcknowledgeIncidentRequest, opts ...grpc.CallOption) (*v1.Incident, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) GetIncidentStats(ctx context.Context, req *v1.GetIncidentStatsRequest, opts ...grpc.CallOption) (*v1.IncidentStats, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) GetIncidentHistory(ctx context.Context, req *v1.GetIncidentHistoryRequest, opts ...grpc.CallOption) (*v1.GetIncidentHistoryResponse, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) ListStatusComponents(ctx context.Context, req *v1.ListStatusComponentsRequest, opts ...grpc.CallOption) (*v1.ListStatusComponentsResponse, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) CreateStatusComponent(ctx context.Context, req *v1.CreateStatusComponentRequest, opts ...grpc.CallOption) (*v1.StatusComponent, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) UpdateStatusComponent(ctx context.Context, req *v1.UpdateStatusComponentRequest, opts ...grpc.CallOption) (*v1.StatusComponent, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) DeleteStatusComponent(ctx context.Context, req *v1.DeleteStatusComponentRequest, opts ...grpc.CallOption) (*v1.DeleteStatusComponentResponse, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) SetComponentStatusOverride(ctx context.Context, req *v1.SetComponentStatusOverrideRequest, opts ...grpc.CallOption) (*v1.StatusComponent, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) ClearComponentStatusOverride(ctx context.Context, req *v1.ClearComponentStatusOverrideRequest, opts ...grpc.CallOption) (*v1.StatusComponent, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) ScheduleMaintenance(ctx context.Context, req *v1.ScheduleMaintenanceRequest, opts ...grpc.CallOption) (*v1.MaintenanceAnnouncement, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) CancelMaintenance(ctx context.Context, req *v1.CancelMaintenanceRequest, opts ...grpc.CallOption) (*v1.MaintenanceAnnouncement, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) ListMaintenances(ctx context.Context, req *v1.ListMaintenancesRequest, opts ...grpc.CallOption) (*v1.ListMaintenancesResponse, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func (m *mockClient) GetStatusPage(ctx context.Context, req *v1.GetStatusPageRequest, opts ...grpc.CallOption) (*v1.StatusPage, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
 // TestIncidentClient_CreateIncident_WithPkgLogger тестирует создание инцидента с pkg/logger
 func TestIncidentClient_CreateIncident_WithPkgLogger(t *testing.T) {
 	mockLogger := &MockLogger{}
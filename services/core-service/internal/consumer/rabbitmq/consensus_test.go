@@ -0,0 +1,89 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkg_rabbitmq "UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository/memory"
+	"UptimePingPlatform/services/core-service/internal/service"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
+)
+
+// failingChecker всегда возвращает неудачный результат
+type failingChecker struct{}
+
+func (c *failingChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
+	return &domain.CheckResult{CheckID: task.CheckID, ExecutionID: task.ExecutionID, Success: false,
+		Error: "connection refused", DurationMs: 10, CheckedAt: time.Now().UTC()}, nil
+}
+
+func (c *failingChecker) GetType() domain.TaskType { return domain.TaskTypeTCP }
+
+func (c *failingChecker) ValidateConfig(config map[string]interface{}) error { return nil }
+
+// failingCheckerFactory создает failingChecker для любого типа
+type failingCheckerFactory struct{}
+
+func (f *failingCheckerFactory) CreateChecker(taskType domain.TaskType) (checker.Checker, error) {
+	return &failingChecker{}, nil
+}
+
+func (f *failingCheckerFactory) GetSupportedTypes() []domain.TaskType {
+	return []domain.TaskType{domain.TaskTypeTCP}
+}
+
+// recordingConsensus запоминает результаты регионов и решения консенсуса
+type recordingConsensus struct {
+	regions   []string
+	decisions []domain.ConsensusPolicy
+}
+
+func (r *recordingConsensus) RecordRegionResult(checkType, region string, success bool) {
+	r.regions = append(r.regions, region)
+}
+
+func (r *recordingConsensus) RecordConsensus(checkType string, policy domain.ConsensusPolicy, success bool) {
+	r.decisions = append(r.decisions, policy)
+}
+
+func TestConsumer_MultiRegionConsensus(t *testing.T) {
+	recorder := &recordingConsensus{}
+	checkService := service.NewCheckService(&MockLogger{}, &failingCheckerFactory{}, memory.NewCheckResultRepository(10), nil, nil).
+		WithConsensus(service.NewConsensusEvaluator(service.NewMemoryRegionResultStore(), time.Minute), "eu-west").
+		WithConsensusRecorder(recorder)
+	consumer, err := NewConsumer(ConsumerConfig{QueueName: "uptime_checks"}, &MockLogger{}, checkService, &pkg_rabbitmq.Connection{})
+	require.NoError(t, err)
+	handle := consumer.createMessageHandler()
+
+	deliver := func(region string) {
+		body, err := json.Marshal(service.TaskMessage{
+			CheckID:     "check-1",
+			ExecutionID: "exec-1",
+			Target:      "example.com:443",
+			Type:        "tcp",
+			TenantID:    "tenant-1",
+			Region:      region,
+			Config:      map[string]interface{}{"regions": "eu-west,us-east", "consensus": "all_fail"},
+			ScheduledAt: time.Now(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, handle(context.Background(), amqp091.Delivery{Body: body}))
+	}
+
+	// Задача без региона выполняется в регионе экземпляра; решение ждет второго региона
+	deliver("")
+	assert.Equal(t, []string{"eu-west"}, recorder.regions)
+	assert.Empty(t, recorder.decisions)
+
+	deliver("us-east")
+	assert.Equal(t, []string{"eu-west", "us-east"}, recorder.regions)
+	assert.Equal(t, []domain.ConsensusPolicy{domain.ConsensusAllFail}, recorder.decisions)
+}
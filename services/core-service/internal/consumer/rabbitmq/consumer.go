@@ -12,6 +12,7 @@ import (
 // CheckServiceInterface определяет интерфейс для сервиса проверок
 type CheckServiceInterface interface {
 	ProcessTask(ctx context.Context, message []byte) error
+	ProcessRedeliveredTask(ctx context.Context, message []byte) error
 }
 
 // Consumer представляет RabbitMQ consumer для обработки задач
@@ -19,6 +20,7 @@ type Consumer struct {
 	logger       logger.Logger
 	checkService CheckServiceInterface
 	queueName    string
+	queues       []string
 	consumerTag  string
 	rabbitConsumer *pkg_rabbitmq.Consumer
 	rabbitConn     *pkg_rabbitmq.Connection
@@ -29,6 +31,15 @@ type Consumer struct {
 type ConsumerConfig struct {
 	QueueName   string
 	ConsumerTag string
+	// MaxPriority объявляет очередь задач с приоритетами: ручные запуски и критичные
+	// проверки обгоняют накопившиеся плановые задачи; 0 - обычная очередь
+	MaxPriority uint8
+	// TenantRouting виртуальные очереди задач tenant: каждая обрабатывается отдельной подпиской,
+	// и всплеск задач одного tenant не задерживает задачи tenant других виртуальных очередей.
+	// Общая очередь QueueName обрабатывается и при включенной маршрутизации
+	TenantRouting pkg_rabbitmq.TenantRouting
+	// PrefetchCount число неподтвержденных задач на подписку; 0 - 10
+	PrefetchCount int
 }
 
 // NewConsumer создает новый consumer
@@ -46,6 +57,14 @@ func NewConsumer(
 	rabbitConfig := pkg_rabbitmq.NewConfig()
 	rabbitConfig.Queue = config.QueueName
 	rabbitConfig.PrefetchCount = 10 // Количество сообщений для предварительной загрузки
+	if config.PrefetchCount > 0 {
+		rabbitConfig.PrefetchCount = config.PrefetchCount
+	}
+	rabbitConfig.MaxPriority = config.MaxPriority
+	if config.TenantRouting.Enabled() {
+		rabbitConfig.Exchange = config.TenantRouting.Exchange()
+		rabbitConfig.ExchangeType = amqp091.ExchangeTopic
+	}
 
 	// Создаем RabbitMQ consumer
 	rabbitConsumer := pkg_rabbitmq.NewConsumer(rabbitConn, rabbitConfig)
@@ -54,6 +73,7 @@ func NewConsumer(
 		logger:          log,
 		checkService:    checkService,
 		queueName:       config.QueueName,
+		queues:          append([]string{config.QueueName}, config.TenantRouting.Queues()...),
 		consumerTag:     config.ConsumerTag,
 		rabbitConsumer:  rabbitConsumer,
 		rabbitConn:      rabbitConn,
@@ -63,11 +83,16 @@ func NewConsumer(
 	// Регистрируем обработчик сообщений
 	messageHandler := consumer.createMessageHandler()
 	rabbitConsumer.RegisterHandler(config.QueueName, messageHandler)
+	for shard := 0; shard < len(config.TenantRouting.Queues()); shard++ {
+		rabbitConsumer.RegisterBinding(config.TenantRouting.QueueName(shard), config.TenantRouting.BindingKey(shard), messageHandler)
+	}
 
 	consumer.logger.Info("Consumer created",
 		logger.String("queue", config.QueueName),
 		logger.String("consumer_tag", config.ConsumerTag),
 		logger.Int("prefetch_count", rabbitConfig.PrefetchCount),
+		logger.Int("max_priority", int(rabbitConfig.MaxPriority)),
+		logger.Int("virtual_queues", len(config.TenantRouting.Queues())),
 	)
 
 	return consumer, nil
@@ -95,41 +120,31 @@ func (c *Consumer) Start(ctx context.Context) error {
 // createMessageHandler создает обработчик сообщений для RabbitMQ
 func (c *Consumer) createMessageHandler() pkg_rabbitmq.MessageHandler {
 	return func(ctx context.Context, delivery amqp091.Delivery) error {
+		tenantID, _ := delivery.Headers[pkg_rabbitmq.HeaderTenantID].(string)
 		c.logger.Debug("Received message from RabbitMQ",
 			logger.String("message_id", delivery.MessageId),
 			logger.String("routing_key", delivery.RoutingKey),
+			logger.String("tenant_id", tenantID),
 			logger.String("exchange", delivery.Exchange),
 			logger.Int("body_size", len(delivery.Body)),
 			logger.String("correlation_id", delivery.CorrelationId),
 		)
 
-		// Обрабатываем сообщение через CheckService
-		err := c.checkService.ProcessTask(ctx, delivery.Body)
+		// Обрабатываем сообщение через CheckService.
+		// ACK/NACK и метрики доставки выполняет pkg/rabbitmq consumer по результату обработчика
+		process := c.checkService.ProcessTask
+		if isRetry(delivery) {
+			process = c.checkService.ProcessRedeliveredTask
+		}
+		err := process(ctx, delivery.Body)
 		if err != nil {
 			c.logger.Error("Failed to process message",
 				logger.String("message_id", delivery.MessageId),
 				logger.Error(err),
 			)
-			
-			// Отклоняем сообщение (NACK) с requeue для повторной обработки
-			if delivery.Nack(false, true) != nil {
-				c.logger.Error("Failed to NACK message",
-					logger.String("message_id", delivery.MessageId),
-					logger.Error(err),
-				)
-			}
 			return errors.Wrap(err, errors.ErrInternal, "failed to process message")
 		}
 
-		// Подтверждаем успешную обработку (ACK)
-		if err := delivery.Ack(false); err != nil {
-			c.logger.Error("Failed to ACK message",
-				logger.String("message_id", delivery.MessageId),
-				logger.Error(err),
-			)
-			return errors.Wrap(err, errors.ErrInternal, "failed to acknowledge message")
-		}
-
 		c.logger.Debug("Message processed successfully",
 			logger.String("message_id", delivery.MessageId),
 		)
@@ -138,6 +153,15 @@ func (c *Consumer) createMessageHandler() pkg_rabbitmq.MessageHandler {
 	}
 }
 
+// isRetry проверяет, что задача доставлена повторно: брокером после NACK или из очереди повторов
+func isRetry(delivery amqp091.Delivery) bool {
+	if delivery.Redelivered {
+		return true
+	}
+	_, dead := delivery.Headers["x-death"]
+	return dead
+}
+
 // ProcessMessage обрабатывает одно сообщение
 func (c *Consumer) ProcessMessage(ctx context.Context, message []byte) error {
 	c.logger.Info("Processing message",
@@ -157,6 +181,29 @@ func (c *Consumer) ProcessMessage(ctx context.Context, message []byte) error {
 	return nil
 }
 
+// Drain останавливает прием задач при завершении сервиса: новые сообщения не забираются,
+// полученные заранее возвращаются в очередь, начатые проверки завершаются и сохраняют
+// результат до истечения ctx. Незавершенные к сроку задачи брокер доставит повторно
+func (c *Consumer) Drain(ctx context.Context) error {
+	c.logger.Info("Draining RabbitMQ consumer",
+		logger.String("queue", c.queueName),
+		logger.String("consumer_tag", c.consumerTag),
+	)
+
+	if err := c.rabbitConsumer.Drain(ctx); err != nil {
+		c.logger.Warn("Drain deadline reached, unfinished tasks will be redelivered",
+			logger.String("queue", c.queueName),
+			logger.Error(err),
+		)
+		return errors.Wrap(err, errors.ErrInternal, "consumer drain incomplete")
+	}
+
+	c.logger.Info("RabbitMQ consumer drained",
+		logger.String("queue", c.queueName),
+	)
+	return nil
+}
+
 // Close закрывает consumer
 func (c *Consumer) Close() error {
 	c.logger.Info("Closing RabbitMQ consumer",
@@ -178,6 +225,7 @@ func (c *Consumer) Close() error {
 func (c *Consumer) GetStats() map[string]interface{} {
 	stats := make(map[string]interface{})
 	stats["queue_name"] = c.queueName
+	stats["queues"] = c.Queues()
 	stats["consumer_tag"] = c.consumerTag
 
 	// Проверяем закрыт ли канал без блокировки
@@ -202,6 +250,11 @@ func (c *Consumer) GetStats() map[string]interface{} {
 	return stats
 }
 
+// Queues возвращает очереди, из которых забираются задачи: общую и виртуальные очереди tenant
+func (c *Consumer) Queues() []string {
+	return append([]string(nil), c.queues...)
+}
+
 // HealthCheck проверяет состояние consumer'а
 func (c *Consumer) HealthCheck(ctx context.Context) error {
 	if c.rabbitConn == nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/rabbitmq/amqp091-go"
 	"github.com/stretchr/testify/assert"
 
 	"UptimePingPlatform/pkg/logger"
@@ -31,6 +32,10 @@ func (m *MockCheckService) ProcessTask(ctx context.Context, message []byte) erro
 	return nil
 }
 
+func (m *MockCheckService) ProcessRedeliveredTask(ctx context.Context, message []byte) error {
+	return nil
+}
+
 func TestConsumer_NewConsumer(t *testing.T) {
 	config := ConsumerConfig{
 		QueueName:   "test_queue",
@@ -53,6 +58,22 @@ func TestConsumer_NewConsumer(t *testing.T) {
 	consumer.Close()
 }
 
+func TestConsumer_TenantRouting(t *testing.T) {
+	consumer, err := NewConsumer(ConsumerConfig{
+		QueueName:     "uptime_checks",
+		ConsumerTag:   "test_consumer",
+		TenantRouting: pkg_rabbitmq.NewTenantRouting("uptime_checks", 3),
+		PrefetchCount: 2,
+	}, &MockLogger{}, &MockCheckService{}, &pkg_rabbitmq.Connection{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"uptime_checks", "uptime_checks.v0", "uptime_checks.v1", "uptime_checks.v2"}, consumer.Queues())
+	assert.Equal(t, consumer.Queues(), consumer.GetStats()["queues"])
+
+	consumer, err = NewConsumer(ConsumerConfig{QueueName: "uptime_checks"}, &MockLogger{}, &MockCheckService{}, &pkg_rabbitmq.Connection{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"uptime_checks"}, consumer.Queues())
+}
+
 func TestConsumer_config(t *testing.T) {
 	config := ConsumerConfig{
 		QueueName:   "test_queue",
@@ -62,3 +83,9 @@ func TestConsumer_config(t *testing.T) {
 	assert.Equal(t, "test_queue", config.QueueName)
 	assert.Equal(t, "test_consumer", config.ConsumerTag)
 }
+
+func TestIsRetry(t *testing.T) {
+	assert.False(t, isRetry(amqp091.Delivery{}))
+	assert.True(t, isRetry(amqp091.Delivery{Redelivered: true}))
+	assert.True(t, isRetry(amqp091.Delivery{Headers: amqp091.Table{"x-death": []interface{}{}}}))
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ArtifactKind вид артефакта проверки
+type ArtifactKind string
+
+const (
+	// ArtifactScreenshot снимок экрана синтетической проверки
+	ArtifactScreenshot ArtifactKind = "screenshot"
+	// ArtifactHAR журнал запросов в формате HAR
+	ArtifactHAR ArtifactKind = "har"
+	// ArtifactCapture диагностический снимок ответа неудачной проверки
+	ArtifactCapture ArtifactKind = "capture"
+	// ArtifactBody полное тело ответа
+	ArtifactBody ArtifactKind = "body"
+)
+
+// IsValid проверяет, известен ли вид артефакта
+func (k ArtifactKind) IsValid() bool {
+	switch k {
+	case ArtifactScreenshot, ArtifactHAR, ArtifactCapture, ArtifactBody:
+		return true
+	default:
+		return false
+	}
+}
+
+// Artifact крупный результат проверки, вынесенный из check_results во внешнее хранилище.
+// Data заполняется checker'ом до загрузки и не сохраняется в БД
+type Artifact struct {
+	Key         string       `json:"key"`
+	CheckID     string       `json:"check_id"`
+	ExecutionID string       `json:"execution_id"`
+	Kind        ArtifactKind `json:"kind"`
+	Name        string       `json:"name"`
+	ContentType string       `json:"content_type"`
+	SizeBytes   int64        `json:"size_bytes"`
+	CreatedAt   time.Time    `json:"created_at"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	Data        []byte       `json:"-"`
+}
+
+// ArtifactKey формирует ключ объекта в хранилище. Метка времени различает выполнения
+// одного execution_id в разных регионах
+func ArtifactKey(checkID, executionID, name string, createdAt time.Time) string {
+	return fmt.Sprintf("checks/%s/%s/%d-%s", checkID, executionID, createdAt.UnixNano(), name)
+}
+
+// Expired сообщает, истек ли срок хранения артефакта
+func (a *Artifact) Expired(now time.Time) bool {
+	return !a.ExpiresAt.After(now)
+}
+
+// AttachArtifact добавляет к результату артефакт для загрузки во внешнее хранилище
+func (r *CheckResult) AttachArtifact(kind ArtifactKind, name, contentType string, data []byte) {
+	r.Artifacts = append(r.Artifacts, Artifact{
+		CheckID:     r.CheckID,
+		ExecutionID: r.ExecutionID,
+		Kind:        kind,
+		Name:        name,
+		ContentType: contentType,
+		SizeBytes:   int64(len(data)),
+		Data:        data,
+	})
+}
@@ -0,0 +1,27 @@
+package domain
+
+// CheckDefinition проверка в таблице checks, по которой core-service сверяет задачи и собирает
+// их параметры: задача планировщика несет только идентификатор, версию конфигурации и
+// переопределения запуска. ConfigVersion растет при каждом изменении, влияющем на выполнение,
+// включая выключение
+type CheckDefinition struct {
+	CheckID        string
+	TenantID       string
+	Type           string
+	Target         string
+	TimeoutSeconds int
+	Config         map[string]interface{}
+	Enabled        bool
+	ConfigVersion  int64
+}
+
+// checkTypeHTTPS тип проверки scheduler-service, которую выполняет HTTP checker по схеме цели
+const checkTypeHTTPS = "https"
+
+// TaskType возвращает тип checker'а, выполняющего проверку
+func (d *CheckDefinition) TaskType() TaskType {
+	if d.Type == checkTypeHTTPS {
+		return TaskTypeHTTP
+	}
+	return TaskType(d.Type)
+}
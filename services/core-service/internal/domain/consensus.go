@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Ключи конфигурации проверки для выполнения из нескольких регионов
+const (
+	ConfigKeyRegions   = "regions"
+	ConfigKeyConsensus = "consensus"
+)
+
+// MetadataKeyRegion ключ метаданных результата с регионом, из которого выполнена проверка
+const MetadataKeyRegion = "region"
+
+// ConsensusPolicy определяет, при каком количестве неудачных регионов проверка считается упавшей
+type ConsensusPolicy string
+
+const (
+	// ConsensusAnyFail проверка падает, если упал хотя бы один регион
+	ConsensusAnyFail ConsensusPolicy = "any_fail"
+	// ConsensusMajorityFail проверка падает, если упало больше половины регионов
+	ConsensusMajorityFail ConsensusPolicy = "majority_fail"
+	// ConsensusAllFail проверка падает, только если упали все регионы
+	ConsensusAllFail ConsensusPolicy = "all_fail"
+)
+
+// DefaultConsensusPolicy политика по умолчанию: не пропускает ни одного падения
+const DefaultConsensusPolicy = ConsensusAnyFail
+
+// ParseConsensusPolicy разбирает политику консенсуса; пустая строка означает DefaultConsensusPolicy
+func ParseConsensusPolicy(value string) (ConsensusPolicy, error) {
+	switch policy := ConsensusPolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case "":
+		return DefaultConsensusPolicy, nil
+	case ConsensusAnyFail, ConsensusMajorityFail, ConsensusAllFail:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid consensus policy %q, expected one of: %s, %s, %s",
+			value, ConsensusAnyFail, ConsensusMajorityFail, ConsensusAllFail)
+	}
+}
+
+// RegionResult результат выполнения проверки в одном регионе
+type RegionResult struct {
+	Region     string    `json:"region"`
+	Success    bool      `json:"success"`
+	DurationMs int64     `json:"duration_ms"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	CheckedAt  time.Time `json:"checked_at"`
+	CostUnits  float64   `json:"cost_units,omitempty"`
+}
+
+// NewRegionResult создает результат региона из результата проверки
+func NewRegionResult(region string, result *CheckResult) RegionResult {
+	return RegionResult{
+		Region:     region,
+		Success:    result.Success,
+		DurationMs: result.DurationMs,
+		StatusCode: result.StatusCode,
+		Error:      result.Error,
+		CheckedAt:  result.CheckedAt,
+		CostUnits:  ExecutionCostUnits(result),
+	}
+}
+
+// ConsensusConfig настройки консенсуса проверки
+type ConsensusConfig struct {
+	Regions []string
+	Policy  ConsensusPolicy
+}
+
+// ConsensusConfigFromTask извлекает настройки консенсуса из конфигурации задачи.
+// Регионы задаются списком или строкой через запятую; если регионов меньше двух, возвращает nil
+func ConsensusConfigFromTask(config map[string]interface{}) (*ConsensusConfig, error) {
+	var regions []string
+	switch value := config[ConfigKeyRegions].(type) {
+	case nil:
+	case string:
+		regions = strings.Split(value, ",")
+	case []string:
+		regions = value
+	case []interface{}:
+		for _, item := range value {
+			region, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid region %v: must be a string", item)
+			}
+			regions = append(regions, region)
+		}
+	default:
+		return nil, fmt.Errorf("invalid regions: expected list or comma-separated string, got %T", value)
+	}
+
+	seen := make(map[string]struct{}, len(regions))
+	unique := make([]string, 0, len(regions))
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		if region == "" {
+			continue
+		}
+		if _, ok := seen[region]; ok {
+			continue
+		}
+		seen[region] = struct{}{}
+		unique = append(unique, region)
+	}
+	if len(unique) < 2 {
+		return nil, nil
+	}
+	sort.Strings(unique)
+
+	policyValue, _ := config[ConfigKeyConsensus].(string)
+	policy, err := ParseConsensusPolicy(policyValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConsensusConfig{Regions: unique, Policy: policy}, nil
+}
+
+// HasRegion проверяет, входит ли регион в список регионов проверки
+func (c *ConsensusConfig) HasRegion(region string) bool {
+	for _, r := range c.Regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// Decide оценивает результаты регионов. decided == false, пока результат еще может измениться
+// с приходом результатов оставшихся регионов; результаты регионов вне списка не учитываются
+func (c *ConsensusConfig) Decide(results []RegionResult) (success bool, decided bool) {
+	total := len(c.Regions)
+	failed, succeeded := 0, 0
+	for _, result := range results {
+		if !c.HasRegion(result.Region) {
+			continue
+		}
+		if result.Success {
+			succeeded++
+		} else {
+			failed++
+		}
+	}
+
+	switch c.Policy {
+	case ConsensusAllFail:
+		if succeeded > 0 {
+			return true, true
+		}
+		return false, failed == total
+	case ConsensusMajorityFail:
+		if failed > total/2 {
+			return false, true
+		}
+		return true, succeeded >= total-total/2
+	default:
+		if failed > 0 {
+			return false, true
+		}
+		return true, succeeded == total
+	}
+}
+
+// FailedRegions возвращает отсортированный список упавших регионов
+func FailedRegions(results []RegionResult) []string {
+	var failed []string
+	for _, result := range results {
+		if !result.Success {
+			failed = append(failed, result.Region)
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
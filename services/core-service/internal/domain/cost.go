@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+// MetadataKeyCostUnits ключ метаданных результата со стоимостью выполнения в условных единицах
+const MetadataKeyCostUnits = "cost_units"
+
+// Границы классов размера ответа: чем больше ответ, тем дороже его загрузка и разбор
+const (
+	PayloadClassSmallMaxBytes  = 64 << 10
+	PayloadClassMediumMaxBytes = 1 << 20
+	PayloadClassLargeMaxBytes  = 10 << 20
+)
+
+// PayloadSizeClass возвращает множитель стоимости по размеру тела ответа: 1 до 64 КиБ,
+// 2 до 1 МиБ, 4 до 10 МиБ, 8 для больших ответов
+func PayloadSizeClass(bodySize int64) float64 {
+	switch {
+	case bodySize <= PayloadClassSmallMaxBytes:
+		return 1
+	case bodySize <= PayloadClassMediumMaxBytes:
+		return 2
+	case bodySize <= PayloadClassLargeMaxBytes:
+		return 4
+	default:
+		return 8
+	}
+}
+
+// CostUnits стоимость выполнения проверки в одном регионе: длительность в секундах × класс размера
+// ответа. Стоимость выполнения из нескольких регионов - сумма стоимостей регионов, то есть при
+// одинаковых ответах длительность × число регионов × класс размера
+func CostUnits(duration time.Duration, bodySize int64) float64 {
+	return duration.Seconds() * PayloadSizeClass(bodySize)
+}
+
+// ExecutionCostUnits стоимость выполнения проверки в одном регионе по результату
+func ExecutionCostUnits(result *CheckResult) float64 {
+	bodySize, _ := strconv.ParseInt(result.Metadata["body_size"], 10, 64)
+	return CostUnits(time.Duration(result.DurationMs)*time.Millisecond, bodySize)
+}
+
+// FormatCostUnits форматирует стоимость для метаданных результата
+func FormatCostUnits(units float64) string {
+	return strconv.FormatFloat(units, 'f', 3, 64)
+}
@@ -15,6 +15,9 @@ type Task struct {
 	ScheduledTime time.Time              `json:"scheduled_time"`
 	CreatedAt     time.Time              `json:"created_at"`
 	UpdatedAt     time.Time              `json:"updated_at"`
+	// Trigger и TriggeredBy - причина запуска и инициатор (воркер планировщика, пользователь)
+	Trigger     ExecutionTrigger `json:"trigger,omitempty"`
+	TriggeredBy string           `json:"triggered_by,omitempty"`
 }
 
 // TaskType представляет тип задачи
@@ -26,6 +29,14 @@ const (
 	TaskTypeICMP   TaskType = "icmp"
 	TaskTypeGRPC   TaskType = "grpc"
 	TaskTypeGraphQL TaskType = "graphql"
+	// TaskTypePlugin проверка внешним плагином, имя плагина в config.plugin
+	TaskTypePlugin TaskType = "plugin"
+	// TaskTypeDomain срок регистрации домена по RDAP/WHOIS
+	TaskTypeDomain TaskType = "domain"
+	// TaskTypeDNS разрешение DNS записи и сравнение с ожидаемыми значениями
+	TaskTypeDNS TaskType = "dns"
+	// TaskTypePing синоним icmp, под которым ICMP проверки создает CLI
+	TaskTypePing TaskType = "ping"
 )
 
 // TaskStatus представляет статус задачи
@@ -51,6 +62,58 @@ type CheckResult struct {
 	ResponseBody string            `json:"response_body,omitempty"`
 	CheckedAt    time.Time         `json:"checked_at"`
 	Metadata     map[string]string `json:"metadata,omitempty"`
+	Timings      *CheckTimings     `json:"timings,omitempty"`
+	Capture      *FailureCapture   `json:"capture,omitempty"`
+	// Trigger и TriggeredBy - кто или что запустило выполнение, для разбора инцидентов
+	Trigger     ExecutionTrigger `json:"trigger,omitempty"`
+	TriggeredBy string           `json:"triggered_by,omitempty"`
+	// Artifacts ссылки на артефакты во внешнем хранилище
+	Artifacts []Artifact `json:"artifacts,omitempty"`
+	// SchemaVersion версия схемы сохраненного результата; 0 - результат не из хранилища
+	SchemaVersion int `json:"schema_version,omitempty"`
+}
+
+// FailureCapture представляет диагностический снимок ответа неудачной проверки
+type FailureCapture struct {
+	Headers       map[string]string `json:"headers,omitempty"`
+	BodySnippet   string            `json:"body_snippet,omitempty"`
+	BodyTruncated bool              `json:"body_truncated,omitempty"`
+	BodySize      int64             `json:"body_size"`
+	Entries       []HAREntry        `json:"entries,omitempty"`
+	CapturedAt    time.Time         `json:"captured_at"`
+}
+
+// HAREntry представляет запись об обмене запрос/ответ в формате, близком к HAR
+type HAREntry struct {
+	StartedAt       time.Time         `json:"started_at"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty"`
+	StatusCode      int               `json:"status_code,omitempty"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+	BodySize        int64             `json:"body_size"`
+	DurationMs      int64             `json:"duration_ms"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// CheckTimings представляет длительности фаз HTTP запроса в миллисекундах
+type CheckTimings struct {
+	DNSLookupMs    int64 `json:"dns_lookup_ms"`
+	TCPConnectMs   int64 `json:"tcp_connect_ms"`
+	TLSHandshakeMs int64 `json:"tls_handshake_ms"`
+	TTFBMs         int64 `json:"ttfb_ms"`
+	TotalMs        int64 `json:"total_ms"`
+}
+
+// Phases возвращает длительности фаз с названиями, используемыми в метриках
+func (t *CheckTimings) Phases() map[string]time.Duration {
+	return map[string]time.Duration{
+		"dns":           time.Duration(t.DNSLookupMs) * time.Millisecond,
+		"tcp_connect":   time.Duration(t.TCPConnectMs) * time.Millisecond,
+		"tls_handshake": time.Duration(t.TLSHandshakeMs) * time.Millisecond,
+		"ttfb":          time.Duration(t.TTFBMs) * time.Millisecond,
+		"total":         time.Duration(t.TotalMs) * time.Millisecond,
+	}
 }
 
 // CheckStatus представляет статус проверки
@@ -87,6 +150,12 @@ type ICMPConfig struct {
 	Count    int           `json:"count"`
 	Timeout  time.Duration `json:"timeout"`
 	Interval time.Duration `json:"interval"`
+	// Mode режим сокета: auto, privileged (raw сокет) или unprivileged (датаграммный сокет)
+	Mode string `json:"mode"`
+	// PacketSize размер данных эхо-запроса в байтах
+	PacketSize int `json:"packet_size"`
+	// MaxLossPercent допустимая потеря пакетов; nil - проверка успешна при любом полученном ответе
+	MaxLossPercent *float64 `json:"max_loss_percent,omitempty"`
 }
 
 // gRPCConfig представляет конфигурацию gRPC проверки
@@ -190,7 +259,7 @@ func (t *Task) GetTCPConfig() (*TCPConfig, error) {
 
 // GetICMPConfig извлекает ICMP конфигурацию
 func (t *Task) GetICMPConfig() (*ICMPConfig, error) {
-	if t.Type != string(TaskTypeICMP) {
+	if t.Type != string(TaskTypeICMP) && t.Type != string(TaskTypePing) {
 		return nil, ErrInvalidTaskType
 	}
 	
@@ -211,6 +280,15 @@ func (t *Task) GetICMPConfig() (*ICMPConfig, error) {
 			config.Interval = duration
 		}
 	}
+	if mode, ok := t.Config["mode"].(string); ok {
+		config.Mode = mode
+	}
+	if size, ok := t.Config["packet_size"].(float64); ok {
+		config.PacketSize = int(size)
+	}
+	if loss, ok := t.Config["max_loss_percent"].(float64); ok {
+		config.MaxLossPercent = &loss
+	}
 	
 	return config, nil
 }
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// ResultPartitionPrefix префикс имен дневных партиций check_results
+const ResultPartitionPrefix = "check_results_p"
+
+// ResultPartition партиция check_results с диапазоном created_at [From, To).
+// Нулевой From означает MINVALUE, нулевой To - MAXVALUE
+type ResultPartition struct {
+	Name    string
+	From    time.Time
+	To      time.Time
+	Default bool
+}
+
+// NewDailyResultPartition создает описание партиции на сутки (UTC), содержащие day
+func NewDailyResultPartition(day time.Time) ResultPartition {
+	from := PartitionDay(day)
+	return ResultPartition{
+		Name: ResultPartitionPrefix + from.Format("20060102"),
+		From: from,
+		To:   from.AddDate(0, 0, 1),
+	}
+}
+
+// PartitionDay возвращает начало суток UTC, содержащих t
+func PartitionDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Contains сообщает, попадает ли момент t в диапазон партиции.
+// Партиция по умолчанию не покрывает ни одного диапазона
+func (p ResultPartition) Contains(t time.Time) bool {
+	if p.Default {
+		return false
+	}
+	return (p.From.IsZero() || !t.Before(p.From)) && (p.To.IsZero() || t.Before(p.To))
+}
+
+// ExpiredBefore сообщает, что все строки партиции старше cutoff и ее можно удалить целиком
+func (p ResultPartition) ExpiredBefore(cutoff time.Time) bool {
+	return !p.Default && !p.To.IsZero() && !p.To.After(cutoff)
+}
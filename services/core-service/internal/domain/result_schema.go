@@ -0,0 +1,18 @@
+package domain
+
+// Версии схемы строки check_results. Версия растет при добавлении полей результата; строки
+// старых версий переводятся на текущую backfill'ом (cmd/result-backfill), поля, которые
+// нельзя восстановить для истории, остаются пустыми
+const (
+	// ResultSchemaBase статус, время ответа, код, тело ответа и ошибка
+	ResultSchemaBase = 1
+	// ResultSchemaTimings длительности фаз запроса (timings)
+	ResultSchemaTimings = 2
+	// ResultSchemaCapture диагностический снимок неудачной проверки
+	ResultSchemaCapture = 3
+	// ResultSchemaTrigger причина запуска и инициатор выполнения
+	ResultSchemaTrigger = 4
+
+	// ResultSchemaVersion версия схемы, в которой сохраняются новые результаты
+	ResultSchemaVersion = ResultSchemaTrigger
+)
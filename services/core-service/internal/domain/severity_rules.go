@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ConfigKeySeverityRules ключ конфигурации проверки с правилами классификации падений:
+// список строк вида "5xx => critical" или одна строка с правилами через точку с запятой
+const ConfigKeySeverityRules = "severity_rules"
+
+// Ключи метаданных результата с серьезностью, которую задало правило проверки
+const (
+	MetadataKeySeverityHint = "severity_hint"
+	MetadataKeySeverityRule = "severity_rule"
+)
+
+// Серьезность инцидента в правилах; совпадает со значениями Incident Manager
+const (
+	SeverityWarning  = "warning"
+	SeverityError    = "error"
+	SeverityCritical = "critical"
+)
+
+// severityRuleSeparator разделяет условие и серьезность в правиле
+const severityRuleSeparator = "=>"
+
+// SeverityRule правило классификации неудачного результата. Условие - класс кодов ответа
+// ("5xx"), точный код ("503") или ключевое слово, которое ищется в ошибке без учета регистра
+type SeverityRule struct {
+	Match    string
+	Severity string
+}
+
+// String возвращает правило в исходном виде
+func (r SeverityRule) String() string {
+	return r.Match + " " + severityRuleSeparator + " " + r.Severity
+}
+
+// Matches проверяет, подходит ли правило к неудачному результату
+func (r SeverityRule) Matches(result *CheckResult) bool {
+	if isStatusClass(r.Match) {
+		return result.StatusCode/100 == int(r.Match[0]-'0')
+	}
+	if code, err := strconv.Atoi(r.Match); err == nil {
+		return result.StatusCode == code
+	}
+	return strings.Contains(strings.ToLower(result.Error), r.Match)
+}
+
+// ParseSeverityRule разбирает правило вида "условие => серьезность"
+func ParseSeverityRule(value string) (SeverityRule, error) {
+	match, severity, ok := strings.Cut(value, severityRuleSeparator)
+	if !ok {
+		return SeverityRule{}, fmt.Errorf("invalid severity rule %q, expected \"<match> => <severity>\"", value)
+	}
+
+	rule := SeverityRule{
+		Match:    strings.ToLower(strings.TrimSpace(match)),
+		Severity: strings.ToLower(strings.TrimSpace(severity)),
+	}
+	if rule.Match == "" {
+		return SeverityRule{}, fmt.Errorf("invalid severity rule %q: match is empty", value)
+	}
+	if code, err := strconv.Atoi(rule.Match); err == nil && (code < 100 || code > 599) {
+		return SeverityRule{}, fmt.Errorf("invalid severity rule %q: status code must be between 100 and 599", value)
+	}
+	switch rule.Severity {
+	case SeverityWarning, SeverityError, SeverityCritical:
+	default:
+		return SeverityRule{}, fmt.Errorf("invalid severity rule %q: severity must be one of: %s, %s, %s",
+			value, SeverityWarning, SeverityError, SeverityCritical)
+	}
+	return rule, nil
+}
+
+// SeverityRulesFromConfig извлекает правила классификации из конфигурации проверки.
+// Правила применяются по порядку, срабатывает первое подходящее
+func SeverityRulesFromConfig(config map[string]interface{}) ([]SeverityRule, error) {
+	var values []string
+	switch raw := config[ConfigKeySeverityRules].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		values = strings.Split(raw, ";")
+	case []string:
+		values = raw
+	case []interface{}:
+		for _, item := range raw {
+			value, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s must contain strings", ConfigKeySeverityRules)
+			}
+			values = append(values, value)
+		}
+	default:
+		return nil, fmt.Errorf("%s must be a list of rules", ConfigKeySeverityRules)
+	}
+
+	rules := make([]SeverityRule, 0, len(values))
+	for _, value := range values {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		rule, err := ParseSeverityRule(value)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ClassifySeverity записывает в метаданные неудачного результата серьезность первого
+// подходящего правила. Возвращает false, если результат успешен или правило не подошло
+func ClassifySeverity(rules []SeverityRule, result *CheckResult) bool {
+	if result.Success {
+		return false
+	}
+	for _, rule := range rules {
+		if !rule.Matches(result) {
+			continue
+		}
+		if result.Metadata == nil {
+			result.Metadata = make(map[string]string)
+		}
+		result.Metadata[MetadataKeySeverityHint] = rule.Severity
+		result.Metadata[MetadataKeySeverityRule] = rule.String()
+		return true
+	}
+	return false
+}
+
+// isStatusClass проверяет, задает ли условие класс кодов ответа вида "5xx"
+func isStatusClass(match string) bool {
+	return len(match) == 3 && match[0] >= '1' && match[0] <= '5' && match[1:] == "xx"
+}
@@ -0,0 +1,50 @@
+package domain
+
+import "strings"
+
+// ExecutionTrigger причина запуска проверки
+type ExecutionTrigger string
+
+const (
+	// TriggerSchedule запуск по расписанию планировщика
+	TriggerSchedule ExecutionTrigger = "schedule"
+	// TriggerAPI ручной запуск через API
+	TriggerAPI ExecutionTrigger = "api"
+	// TriggerCLI ручной запуск из CLI
+	TriggerCLI ExecutionTrigger = "cli"
+	// TriggerRetry повторная доставка задачи после сбоя обработки
+	TriggerRetry ExecutionTrigger = "retry"
+)
+
+// maxTriggeredByLength ограничение длины идентификатора инициатора, совпадает с колонкой triggered_by
+const maxTriggeredByLength = 255
+
+// IsValid проверяет, известна ли причина запуска
+func (t ExecutionTrigger) IsValid() bool {
+	switch t {
+	case TriggerSchedule, TriggerAPI, TriggerCLI, TriggerRetry:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsManual сообщает, запущена ли проверка пользователем
+func (t ExecutionTrigger) IsManual() bool {
+	return t == TriggerAPI || t == TriggerCLI
+}
+
+// Attribute записывает в результат причину запуска и инициатора задачи
+func (t *Task) Attribute(result *CheckResult) {
+	result.Trigger = t.Trigger
+	result.TriggeredBy = NormalizeTriggeredBy(t.TriggeredBy)
+}
+
+// NormalizeTriggeredBy обрезает пробелы и ограничивает длину идентификатора инициатора
+func NormalizeTriggeredBy(actor string) string {
+	actor = strings.TrimSpace(actor)
+	if len(actor) > maxTriggeredByLength {
+		actor = strings.ToValidUTF8(actor[:maxTriggeredByLength], "")
+	}
+	return actor
+}
@@ -0,0 +1,157 @@
+package domain
+
+import "time"
+
+const (
+	// MaxUptimeHistoryDays глубина истории доступности для полос страницы статуса
+	MaxUptimeHistoryDays = 90
+	// UptimeDownThreshold простой за сутки, начиная с которого день считается down, а не degraded
+	UptimeDownThreshold = 5 * time.Minute
+
+	secondsPerDay = 24 * 60 * 60
+)
+
+// UptimeDayStatus итоговый статус дня в истории доступности
+type UptimeDayStatus string
+
+const (
+	UptimeDayOperational UptimeDayStatus = "operational"
+	UptimeDayDegraded    UptimeDayStatus = "degraded"
+	UptimeDayDown        UptimeDayStatus = "down"
+	UptimeDayNoData      UptimeDayStatus = "no_data"
+)
+
+// UptimeDay дневная сводка доступности проверки за сутки UTC. Каждый результат
+// покрывает время до следующего результата той же проверки, но не больше предела разрыва
+type UptimeDay struct {
+	CheckID            string
+	Day                time.Time
+	OperationalSeconds int64
+	DegradedSeconds    int64
+	DownSeconds        int64
+	TotalChecks        int
+	FailedChecks       int
+	AvgResponseTimeMs  int
+	UpdatedAt          time.Time
+}
+
+// MonitoredSeconds время суток, покрытое результатами проверок
+func (d UptimeDay) MonitoredSeconds() int64 {
+	return d.OperationalSeconds + d.DegradedSeconds + d.DownSeconds
+}
+
+// NoDataSeconds время суток без результатов проверок
+func (d UptimeDay) NoDataSeconds() int64 {
+	if rest := secondsPerDay - d.MonitoredSeconds(); rest > 0 {
+		return rest
+	}
+	return 0
+}
+
+// Status итоговый статус дня: down при простое не меньше UptimeDownThreshold,
+// degraded при более коротком простое или медленных ответах
+func (d UptimeDay) Status() UptimeDayStatus {
+	switch {
+	case d.MonitoredSeconds() == 0:
+		return UptimeDayNoData
+	case d.DownSeconds >= int64(UptimeDownThreshold/time.Second):
+		return UptimeDayDown
+	case d.DownSeconds > 0 || d.DegradedSeconds > 0:
+		return UptimeDayDegraded
+	default:
+		return UptimeDayOperational
+	}
+}
+
+// UptimePercent доля доступного времени среди покрытого результатами; без данных - 0
+func (d UptimeDay) UptimePercent() float64 {
+	return uptimePercent(d.OperationalSeconds+d.DegradedSeconds, d.MonitoredSeconds())
+}
+
+// UptimeHistory история доступности проверки или группы проверок по дням, от старых к новым.
+// Для нескольких проверок длительности дня усредняются по проверкам
+type UptimeHistory struct {
+	CheckIDs []string
+	From     time.Time
+	To       time.Time
+	Days     []UptimeDay
+}
+
+// BuildUptimeHistory строит историю за days суток, заканчивая сутками lastDay. Дни без сводок
+// заполняются пустыми днями; сводки нескольких проверок за один день объединяются
+func BuildUptimeHistory(checkIDs []string, rows []UptimeDay, lastDay time.Time, days int) *UptimeHistory {
+	to := PartitionDay(lastDay)
+	from := to.AddDate(0, 0, -(days - 1))
+
+	byDay := make(map[time.Time][]UptimeDay, days)
+	for _, row := range rows {
+		day := PartitionDay(row.Day)
+		byDay[day] = append(byDay[day], row)
+	}
+
+	history := &UptimeHistory{
+		CheckIDs: checkIDs,
+		From:     from,
+		To:       to,
+		Days:     make([]UptimeDay, 0, days),
+	}
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		history.Days = append(history.Days, mergeUptimeDays(day, byDay[day], len(checkIDs)))
+	}
+	return history
+}
+
+// UptimePercent доля доступного времени за всю историю среди покрытого результатами
+func (h *UptimeHistory) UptimePercent() float64 {
+	var available, monitored int64
+	for _, day := range h.Days {
+		available += day.OperationalSeconds + day.DegradedSeconds
+		monitored += day.MonitoredSeconds()
+	}
+	return uptimePercent(available, monitored)
+}
+
+// mergeUptimeDays усредняет длительности сводок checks проверок за день; счетчики суммируются,
+// среднее время ответа взвешивается числом результатов
+func mergeUptimeDays(day time.Time, rows []UptimeDay, checks int) UptimeDay {
+	merged := UptimeDay{Day: day}
+	if len(rows) == 0 {
+		return merged
+	}
+	if len(rows) == 1 && checks <= 1 {
+		merged = rows[0]
+		merged.CheckID = ""
+		merged.Day = day
+		return merged
+	}
+
+	var responseTimeTotal int64
+	for _, row := range rows {
+		merged.OperationalSeconds += row.OperationalSeconds
+		merged.DegradedSeconds += row.DegradedSeconds
+		merged.DownSeconds += row.DownSeconds
+		merged.TotalChecks += row.TotalChecks
+		merged.FailedChecks += row.FailedChecks
+		responseTimeTotal += int64(row.AvgResponseTimeMs) * int64(row.TotalChecks)
+		if row.UpdatedAt.After(merged.UpdatedAt) {
+			merged.UpdatedAt = row.UpdatedAt
+		}
+	}
+	if checks > 1 {
+		merged.OperationalSeconds /= int64(checks)
+		merged.DegradedSeconds /= int64(checks)
+		merged.DownSeconds /= int64(checks)
+	}
+	if merged.TotalChecks > 0 {
+		merged.AvgResponseTimeMs = int(responseTimeTotal / int64(merged.TotalChecks))
+	}
+	return merged
+}
+
+// uptimePercent процент available от monitored
+func uptimePercent(available, monitored int64) float64 {
+	if monitored == 0 {
+		return 0
+	}
+	return float64(available) / float64(monitored) * 100
+}
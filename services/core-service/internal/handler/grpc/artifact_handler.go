@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/core-service/internal/domain"
+
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+)
+
+// ListArtifacts возвращает неистекшие артефакты проверки
+func (h *CoreHandler) ListArtifacts(ctx context.Context, req *corev1.ListArtifactsRequest) (*corev1.ListArtifactsResponse, error) {
+	if h.artifacts == nil {
+		return nil, status.Error(codes.Unavailable, "artifact storage is not configured")
+	}
+
+	h.LogOperationStart(ctx, "ListArtifacts", map[string]interface{}{
+		"check_id":     req.CheckId,
+		"execution_id": req.ExecutionId,
+	})
+
+	if err := h.validator.ValidateStringLength(req.CheckId, "check_id", 1, 100); err != nil {
+		return nil, h.LogError(ctx, err, "ListArtifacts", req.CheckId)
+	}
+
+	artifacts, err := h.artifacts.List(ctx, req.CheckId, req.ExecutionId)
+	if err != nil {
+		h.LogError(ctx, err, "ListArtifacts", req.CheckId)
+		return nil, artifactError(err, "failed to list artifacts")
+	}
+
+	resp := &corev1.ListArtifactsResponse{Artifacts: make([]*corev1.Artifact, len(artifacts))}
+	for i := range artifacts {
+		resp.Artifacts[i] = artifactToProto(&artifacts[i])
+	}
+
+	h.LogOperationSuccess(ctx, "ListArtifacts", map[string]interface{}{
+		"check_id":  req.CheckId,
+		"artifacts": len(resp.Artifacts),
+	})
+
+	return resp, nil
+}
+
+// GetArtifactURL возвращает подписанную ссылку на артефакт проверки
+func (h *CoreHandler) GetArtifactURL(ctx context.Context, req *corev1.GetArtifactURLRequest) (*corev1.ArtifactURL, error) {
+	if h.artifacts == nil {
+		return nil, status.Error(codes.Unavailable, "artifact storage is not configured")
+	}
+
+	h.LogOperationStart(ctx, "GetArtifactURL", map[string]interface{}{
+		"check_id": req.CheckId,
+		"key":      req.Key,
+	})
+
+	if err := h.ValidateRequiredFields(ctx, "GetArtifactURL", map[string]string{
+		"check_id": req.CheckId,
+		"key":      req.Key,
+	}); err != nil {
+		return nil, err
+	}
+
+	url, expiresAt, err := h.artifacts.SignedURL(ctx, req.CheckId, req.Key)
+	if err != nil {
+		h.LogError(ctx, err, "GetArtifactURL", req.CheckId)
+		return nil, artifactError(err, "failed to get artifact URL")
+	}
+
+	h.LogOperationSuccess(ctx, "GetArtifactURL", map[string]interface{}{
+		"check_id":   req.CheckId,
+		"expires_at": expiresAt,
+	})
+
+	return &corev1.ArtifactURL{
+		Url:       url,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// artifactError переводит ошибку сервиса артефактов в gRPC статус
+func artifactError(err error, message string) error {
+	var appErr *errors.Error
+	if stderrors.As(err, &appErr) {
+		return appErr.ToGRPCErr()
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// artifactToProto конвертирует ссылку на артефакт в protobuf
+func artifactToProto(artifact *domain.Artifact) *corev1.Artifact {
+	return &corev1.Artifact{
+		Key:         artifact.Key,
+		CheckId:     artifact.CheckID,
+		ExecutionId: artifact.ExecutionID,
+		Kind:        string(artifact.Kind),
+		Name:        artifact.Name,
+		ContentType: artifact.ContentType,
+		SizeBytes:   artifact.SizeBytes,
+		CreatedAt:   artifact.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:   artifact.ExpiresAt.Format(time.RFC3339),
+	}
+}
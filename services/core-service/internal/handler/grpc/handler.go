@@ -2,17 +2,22 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"UptimePingPlatform/pkg/errors"
 	grpcBase "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/validation"
 	"UptimePingPlatform/services/core-service/internal/domain"
 	"UptimePingPlatform/services/core-service/internal/service"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
 
 	corev1 "UptimePingPlatform/proto/api/core/v1"
 )
@@ -22,6 +27,8 @@ type CoreHandler struct {
 	*grpcBase.BaseHandler
 	corev1.UnimplementedCoreServiceServer
 	checkService *service.CheckService
+	uptime       *service.UptimeService
+	artifacts    *service.ArtifactService
 	validator    *validation.Validator
 }
 
@@ -34,6 +41,18 @@ func NewCoreHandler(checkService *service.CheckService, logger logger.Logger) *C
 	}
 }
 
+// WithUptime подключает историю доступности из дневных сводок
+func (h *CoreHandler) WithUptime(uptime *service.UptimeService) *CoreHandler {
+	h.uptime = uptime
+	return h
+}
+
+// WithArtifacts подключает выдачу артефактов проверок из внешнего хранилища
+func (h *CoreHandler) WithArtifacts(artifacts *service.ArtifactService) *CoreHandler {
+	h.artifacts = artifacts
+	return h
+}
+
 // ExecuteCheck выполняет проверку немедленно
 func (h *CoreHandler) ExecuteCheck(ctx context.Context, req *corev1.ExecuteCheckRequest) (*corev1.CheckResult, error) {
 	h.LogOperationStart(ctx, "ExecuteCheck", map[string]interface{}{
@@ -52,12 +71,23 @@ func (h *CoreHandler) ExecuteCheck(ctx context.Context, req *corev1.ExecuteCheck
 		return nil, h.LogError(ctx, err, "ExecuteCheck", req.CheckId)
 	}
 
+	// Ручной запуск возможен только через API или CLI
+	trigger := domain.ExecutionTrigger(req.Trigger)
+	if trigger == "" {
+		trigger = domain.TriggerAPI
+	}
+	if !trigger.IsManual() {
+		return nil, status.Errorf(codes.InvalidArgument, "trigger must be %s or %s", domain.TriggerAPI, domain.TriggerCLI)
+	}
+
 	// Создаем задачу для выполнения
 	task := &domain.Task{
 		CheckID:     req.CheckId,
 		ExecutionID: generateExecutionID(),
 		CreatedAt:   time.Now().UTC(),
 		Config:      make(map[string]interface{}),
+		Trigger:     trigger,
+		TriggeredBy: domain.NormalizeTriggeredBy(req.TriggeredBy),
 	}
 
 	// Выполняем проверку
@@ -125,8 +155,9 @@ func (h *CoreHandler) GetCheckStatus(ctx context.Context, req *corev1.GetCheckSt
 // GetCheckHistory возвращает историю выполнения проверки
 func (h *CoreHandler) GetCheckHistory(ctx context.Context, req *corev1.GetCheckHistoryRequest) (*corev1.GetCheckHistoryResponse, error) {
 	h.LogOperationStart(ctx, "GetCheckHistory", map[string]interface{}{
-		"check_id": req.CheckId,
-		"limit":    req.Limit,
+		"check_id":   req.CheckId,
+		"limit":      req.Limit,
+		"page_token": req.PageToken,
 	})
 
 	// Валидация обязательных полей
@@ -145,6 +176,9 @@ func (h *CoreHandler) GetCheckHistory(ctx context.Context, req *corev1.GetCheckH
 	if req.Limit < 1 || req.Limit > 1000 {
 		return nil, h.LogError(ctx, fmt.Errorf("limit must be between 1 and 1000"), "GetCheckHistory", req.CheckId)
 	}
+	if req.PageToken < 0 {
+		return nil, h.LogError(ctx, fmt.Errorf("page_token must not be negative"), "GetCheckHistory", req.CheckId)
+	}
 
 	// Валидация времени если указано
 	var startTime, endTime *time.Time
@@ -165,7 +199,7 @@ func (h *CoreHandler) GetCheckHistory(ctx context.Context, req *corev1.GetCheckH
 	}
 
 	// Получаем историю проверок
-	history, err := h.checkService.GetCheckHistory(ctx, req.CheckId, int(req.Limit), startTime, endTime)
+	history, total, err := h.checkService.GetCheckHistory(ctx, req.CheckId, int(req.Limit), int(req.PageToken), startTime, endTime)
 	if err != nil {
 		h.LogError(ctx, err, "GetCheckHistory", req.CheckId)
 		return nil, status.Errorf(codes.Internal, "failed to get check history: %v", err)
@@ -177,17 +211,104 @@ func (h *CoreHandler) GetCheckHistory(ctx context.Context, req *corev1.GetCheckH
 		results[i] = h.convertCheckResultToProto(result)
 	}
 
+	// Следующая страница есть, если до конца истории остались результаты
+	nextPageToken := int32(0)
+	if end := int(req.PageToken) + len(results); len(results) > 0 && end < total {
+		nextPageToken = int32(end)
+	}
+
 	h.LogOperationSuccess(ctx, "GetCheckHistory", map[string]interface{}{
-		"check_id": req.CheckId,
-		"count":    len(results),
-		"limit":    req.Limit,
+		"check_id":        req.CheckId,
+		"count":           len(results),
+		"total":           total,
+		"limit":           req.Limit,
+		"next_page_token": nextPageToken,
 	})
 
 	return &corev1.GetCheckHistoryResponse{
-		Results: results,
+		Results:       results,
+		NextPageToken: nextPageToken,
+		Total:         int32(total),
 	}, nil
 }
 
+// RunCheck выполняет проверку по inline-определению без сохранения, передавая прогресс в поток
+func (h *CoreHandler) RunCheck(req *corev1.RunCheckRequest, stream corev1.CoreService_RunCheckServer) error {
+	ctx := stream.Context()
+	h.LogOperationStart(ctx, "RunCheck", map[string]interface{}{
+		"type":      req.Type,
+		"target":    req.Target,
+		"tenant_id": req.TenantId,
+	})
+
+	// Валидация обязательных полей
+	if err := h.ValidateRequiredFields(ctx, "RunCheck", map[string]string{
+		"type":   req.Type,
+		"target": req.Target,
+	}); err != nil {
+		return err
+	}
+
+	config := make(map[string]interface{})
+	if req.ConfigJson != "" {
+		if err := json.Unmarshal([]byte(req.ConfigJson), &config); err != nil {
+			h.LogError(ctx, err, "RunCheck", req.Target)
+			return status.Errorf(codes.InvalidArgument, "invalid config_json: %v", err)
+		}
+	}
+	applyInlineDefaults(req.Type, req.Target, config)
+
+	executionID := "adhoc_" + generateExecutionID()
+	task := domain.NewTask("adhoc", req.Target, req.Type, executionID, time.Now().UTC(), config)
+
+	// Ошибки отправки в поток не прерывают проверку: клиент мог отключиться.
+	// httptrace может вызывать хуки из разных горутин, поэтому Send сериализуется.
+	var (
+		mu      sync.Mutex
+		sendErr error
+	)
+	progress := func(event checker.ProgressEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		if sendErr != nil || event.Phase == checker.PhaseCompleted {
+			return
+		}
+		sendErr = stream.Send(&corev1.RunCheckProgress{
+			ExecutionId: executionID,
+			Phase:       string(event.Phase),
+			ElapsedMs:   int32(event.Elapsed.Milliseconds()),
+			Detail:      event.Detail,
+		})
+	}
+
+	start := time.Now()
+	result, err := h.checkService.RunCheck(ctx, task, progress)
+	if err != nil {
+		h.LogError(ctx, err, "RunCheck", req.Target)
+		return runCheckError(err)
+	}
+	if sendErr != nil {
+		return h.LogError(ctx, sendErr, "RunCheck", executionID)
+	}
+
+	if err := stream.Send(&corev1.RunCheckProgress{
+		ExecutionId: executionID,
+		Phase:       string(checker.PhaseCompleted),
+		ElapsedMs:   int32(time.Since(start).Milliseconds()),
+		Result:      h.convertCheckResultToProto(result),
+	}); err != nil {
+		return h.LogError(ctx, err, "RunCheck", executionID)
+	}
+
+	h.LogOperationSuccess(ctx, "RunCheck", map[string]interface{}{
+		"execution_id": executionID,
+		"success":      result.Success,
+		"duration_ms":  result.DurationMs,
+	})
+
+	return nil
+}
+
 // Вспомогательные методы
 
 // convertCheckResultToProto конвертирует CheckResult в protobuf
@@ -197,18 +318,100 @@ func (h *CoreHandler) convertCheckResultToProto(result *domain.CheckResult) *cor
 	}
 
 	return &corev1.CheckResult{
-		CheckId:      result.CheckID,
-		ExecutionId:  result.ExecutionID,
-		Success:      result.Success,
-		DurationMs:   int32(result.DurationMs),
-		StatusCode:   int32(result.StatusCode),
-		Error:        result.Error,
-		ResponseBody: result.ResponseBody,
-		CheckedAt:    result.CheckedAt.Format(time.RFC3339),
+		CheckId:       result.CheckID,
+		ExecutionId:   result.ExecutionID,
+		Success:       result.Success,
+		DurationMs:    int32(result.DurationMs),
+		StatusCode:    int32(result.StatusCode),
+		Error:         result.Error,
+		ResponseBody:  result.ResponseBody,
+		CheckedAt:     result.CheckedAt.Format(time.RFC3339),
+		Timings:       convertTimingsToProto(result.Timings),
+		Capture:       convertCaptureToProto(result.Capture),
+		Trigger:       string(result.Trigger),
+		TriggeredBy:   result.TriggeredBy,
+		SchemaVersion: int32(result.SchemaVersion),
+	}
+}
+
+// convertCaptureToProto конвертирует диагностический снимок неудачной проверки в protobuf
+func convertCaptureToProto(capture *domain.FailureCapture) *corev1.FailureCapture {
+	if capture == nil {
+		return nil
+	}
+
+	entries := make([]*corev1.HAREntry, 0, len(capture.Entries))
+	for _, entry := range capture.Entries {
+		entries = append(entries, &corev1.HAREntry{
+			StartedAt:       entry.StartedAt.Format(time.RFC3339Nano),
+			Method:          entry.Method,
+			Url:             entry.URL,
+			RequestHeaders:  entry.RequestHeaders,
+			StatusCode:      int32(entry.StatusCode),
+			ResponseHeaders: entry.ResponseHeaders,
+			BodySize:        entry.BodySize,
+			DurationMs:      entry.DurationMs,
+			Error:           entry.Error,
+		})
+	}
+
+	return &corev1.FailureCapture{
+		Headers:       capture.Headers,
+		BodySnippet:   capture.BodySnippet,
+		BodyTruncated: capture.BodyTruncated,
+		BodySize:      capture.BodySize,
+		Entries:       entries,
+		CapturedAt:    capture.CapturedAt.Format(time.RFC3339),
+	}
+}
+
+// convertTimingsToProto конвертирует длительности фаз в protobuf
+func convertTimingsToProto(timings *domain.CheckTimings) *corev1.CheckTimings {
+	if timings == nil {
+		return nil
+	}
+
+	return &corev1.CheckTimings{
+		DnsLookupMs:    timings.DNSLookupMs,
+		TcpConnectMs:   timings.TCPConnectMs,
+		TlsHandshakeMs: timings.TLSHandshakeMs,
+		TtfbMs:         timings.TTFBMs,
+		TotalMs:        timings.TotalMs,
+	}
+}
+
+// runCheckError переводит ошибку ad-hoc проверки в gRPC статус по коду ошибки сервиса:
+// неверное определение - InvalidArgument, сбой выполнения - Internal
+func runCheckError(err error) error {
+	var appErr *errors.Error
+	if stderrors.As(err, &appErr) {
+		return status.Error(status.Code(appErr.ToGRPCErr()), appErr.Error())
 	}
+	return status.Errorf(codes.Internal, "failed to run check: %v", err)
 }
 
 // generateExecutionID генерирует уникальный ID выполнения
 func generateExecutionID() string {
 	return fmt.Sprintf("exec_%d_%d", time.Now().UnixNano(), time.Now().Nanosecond()%1000)
 }
+
+// applyInlineDefaults дополняет inline-определение значениями по умолчанию,
+// чтобы для простых проверок было достаточно указать только type и target
+func applyInlineDefaults(checkType, target string, config map[string]interface{}) {
+	switch domain.TaskType(checkType) {
+	case domain.TaskTypeHTTP:
+		if _, ok := config["url"]; !ok {
+			config["url"] = target
+		}
+		if _, ok := config["method"]; !ok {
+			config["method"] = "GET"
+		}
+		if _, ok := config["expected_status"]; !ok {
+			config["expected_status"] = float64(200)
+		}
+	case domain.TaskTypeGraphQL:
+		if _, ok := config["url"]; !ok {
+			config["url"] = target
+		}
+	}
+}
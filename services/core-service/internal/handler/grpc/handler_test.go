@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/errors"
+)
+
+func TestRunCheckError(t *testing.T) {
+	cause := stderrors.New("dial tcp: connection refused")
+
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"invalid definition", errors.Wrap(cause, errors.ErrValidation, "invalid check definition"), codes.InvalidArgument},
+		{"not found", errors.New(errors.ErrNotFound, "check not found"), codes.NotFound},
+		{"execution failure", errors.Wrap(cause, errors.ErrInternal, "check execution failed"), codes.Internal},
+		{"unclassified error", cause, codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runCheckError(tt.err)
+			assert.Equal(t, tt.want, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tt.err.Error())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/core-service/internal/domain"
+
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+)
+
+// GetUptimeHistory возвращает дневную историю доступности проверки или группы проверок
+func (h *CoreHandler) GetUptimeHistory(ctx context.Context, req *corev1.GetUptimeHistoryRequest) (*corev1.UptimeHistory, error) {
+	if h.uptime == nil {
+		return nil, status.Error(codes.Unavailable, "uptime history is not configured")
+	}
+
+	h.LogOperationStart(ctx, "GetUptimeHistory", map[string]interface{}{
+		"checks": len(req.CheckIds),
+		"days":   req.Days,
+	})
+
+	for _, checkID := range req.CheckIds {
+		if err := h.validator.ValidateStringLength(checkID, "check_id", 1, 100); err != nil {
+			return nil, h.LogError(ctx, err, "GetUptimeHistory", checkID)
+		}
+	}
+
+	history, err := h.uptime.GetUptimeHistory(ctx, req.CheckIds, int(req.Days))
+	if err != nil {
+		h.LogError(ctx, err, "GetUptimeHistory", "")
+		var appErr *errors.Error
+		if stderrors.As(err, &appErr) {
+			return nil, appErr.ToGRPCErr()
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get uptime history: %v", err)
+	}
+
+	h.LogOperationSuccess(ctx, "GetUptimeHistory", map[string]interface{}{
+		"checks": len(req.CheckIds),
+		"days":   len(history.Days),
+	})
+
+	return uptimeHistoryToProto(history), nil
+}
+
+// uptimeHistoryToProto конвертирует историю доступности; длительности округляются до минут
+func uptimeHistoryToProto(history *domain.UptimeHistory) *corev1.UptimeHistory {
+	days := make([]*corev1.UptimeDayBucket, len(history.Days))
+	for i, day := range history.Days {
+		days[i] = &corev1.UptimeDayBucket{
+			Date:               day.Day.Format(time.DateOnly),
+			Status:             string(day.Status()),
+			OperationalMinutes: secondsToMinutes(day.OperationalSeconds),
+			DegradedMinutes:    secondsToMinutes(day.DegradedSeconds),
+			DownMinutes:        secondsToMinutes(day.DownSeconds),
+			NoDataMinutes:      secondsToMinutes(day.NoDataSeconds()),
+			UptimePercent:      day.UptimePercent(),
+			TotalChecks:        int32(day.TotalChecks),
+			FailedChecks:       int32(day.FailedChecks),
+			AvgResponseTimeMs:  int32(day.AvgResponseTimeMs),
+		}
+	}
+
+	return &corev1.UptimeHistory{
+		CheckIds:      history.CheckIDs,
+		From:          history.From.Format(time.DateOnly),
+		To:            history.To.Format(time.DateOnly),
+		UptimePercent: history.UptimePercent(),
+		Days:          days,
+	}
+}
+
+// secondsToMinutes округляет секунды до ближайшей минуты
+func secondsToMinutes(seconds int64) int32 {
+	return int32((seconds + 30) / 60)
+}
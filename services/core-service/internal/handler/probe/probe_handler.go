@@ -0,0 +1,288 @@
+// Package probe реализует HTTP endpoint /probe, совместимый с Prometheus blackbox_exporter:
+// параметры module и target, ответ - метрики probe_* в формате Prometheus. Существующие
+// scrape конфигурации blackbox_exporter переводятся на платформу заменой адреса экспортера
+package probe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/metrics"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
+)
+
+// DefaultTimeout таймаут проверки, если Prometheus не передал X-Prometheus-Scrape-Timeout-Seconds
+const DefaultTimeout = 30 * time.Second
+
+// timeoutOffset запас до таймаута scrape, чтобы ответ с probe_success 0 успел дойти до Prometheus
+const timeoutOffset = 500 * time.Millisecond
+
+// Module модуль blackbox_exporter: тип проверки платформы и ее конфигурация
+type Module struct {
+	// Prober тип проверки core-service: http, tcp, icmp, grpc, domain, dns
+	Prober domain.TaskType
+	// Config конфигурация проверки; адрес цели подставляется из параметра target
+	Config map[string]interface{}
+}
+
+// DefaultModules модули с именами из примера конфигурации blackbox_exporter.
+// В отличие от blackbox_exporter, http_2xx ожидает именно статус 200
+var DefaultModules = map[string]Module{
+	"http_2xx":      {Prober: domain.TaskTypeHTTP, Config: map[string]interface{}{"method": "GET", "expected_status": float64(200)}},
+	"http_post_2xx": {Prober: domain.TaskTypeHTTP, Config: map[string]interface{}{"method": "POST", "expected_status": float64(200)}},
+	"tcp_connect":   {Prober: domain.TaskTypeTCP},
+	"icmp":          {Prober: domain.TaskTypeICMP},
+	"grpc":          {Prober: domain.TaskTypeGRPC},
+	"domain_expiry": {Prober: domain.TaskTypeDomain},
+	"dns":           {Prober: domain.TaskTypeDNS},
+}
+
+// Runner выполняет проверку по inline-определению без сохранения результата
+type Runner interface {
+	RunCheck(ctx context.Context, task *domain.Task, progress checker.ProgressFunc) (*domain.CheckResult, error)
+}
+
+// Handler обрабатывает запросы /probe
+type Handler struct {
+	runner   Runner
+	resolver metrics.ScrapeTokenResolver
+	modules  map[string]Module
+	logger   logger.Logger
+}
+
+// NewHandler создает обработчик /probe. Проверки выполняются от имени сервиса, поэтому
+// запрос должен содержать scrape токен tenant (Authorization: Bearer)
+func NewHandler(runner Runner, resolver metrics.ScrapeTokenResolver, log logger.Logger) *Handler {
+	return &Handler{
+		runner:   runner,
+		resolver: resolver,
+		modules:  DefaultModules,
+		logger:   log,
+	}
+}
+
+// WithModules задает набор модулей вместо DefaultModules
+func (h *Handler) WithModules(modules map[string]Module) *Handler {
+	h.modules = modules
+	return h
+}
+
+// ServeHTTP выполняет проверку target модулем module и отдает метрики probe_*.
+// Как и blackbox_exporter, неизвестный модуль и пустой target - 400, неуспешная проверка -
+// 200 с probe_success 0
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" || h.resolver == nil {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="probe"`)
+		http.Error(w, "scrape token required", http.StatusUnauthorized)
+		return
+	}
+	tenantID, ok := h.resolver.TenantForToken(token)
+	if !ok {
+		http.Error(w, "invalid scrape token", http.StatusForbidden)
+		return
+	}
+
+	query := r.URL.Query()
+	moduleName := query.Get("module")
+	if moduleName == "" {
+		moduleName = "http_2xx"
+	}
+	module, ok := h.modules[moduleName]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown module %q", moduleName), http.StatusBadRequest)
+		return
+	}
+	target := query.Get("target")
+	if target == "" {
+		http.Error(w, "Target parameter is missing", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), scrapeTimeout(r))
+	defer cancel()
+
+	start := time.Now()
+	result, err := h.probe(ctx, tenantID, module, target)
+	duration := time.Since(start)
+	if err != nil {
+		h.logger.Debug("Probe failed",
+			logger.String("tenant_id", tenantID),
+			logger.String("module", moduleName),
+			logger.String("target", target),
+			logger.Error(err))
+	}
+
+	registry := prometheus.NewRegistry()
+	registerGauge(registry, "probe_success", "Displays whether or not the probe was a success", boolValue(err == nil && result.Success))
+	registerGauge(registry, "probe_duration_seconds", "Returns how long the probe took to complete in seconds", duration.Seconds())
+	if result != nil {
+		recordResult(registry, module.Prober, result)
+	}
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+}
+
+// probe выполняет проверку модулем; ошибка - конфигурация не прошла валидацию или истек таймаут
+func (h *Handler) probe(ctx context.Context, tenantID string, module Module, target string) (*domain.CheckResult, error) {
+	config, target, err := taskConfig(module, target)
+	if err != nil {
+		return nil, err
+	}
+	task := domain.NewTask("probe", target, string(module.Prober), "probe_"+strconv.FormatInt(time.Now().UnixNano(), 10), time.Now().UTC(), config)
+	task.Trigger = domain.TriggerAPI
+	task.TriggeredBy = "probe:" + tenantID
+
+	type outcome struct {
+		result *domain.CheckResult
+		err    error
+	}
+	// Не все checker'ы учитывают контекст: ответ не ждет их дольше таймаута scrape
+	done := make(chan outcome, 1)
+	go func() {
+		result, err := h.runner.RunCheck(ctx, task, nil)
+		done <- outcome{result, err}
+	}()
+	select {
+	case out := <-done:
+		return out.result, out.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("probe timed out: %w", ctx.Err())
+	}
+}
+
+// taskConfig дополняет конфигурацию модуля адресом цели в формате, который ожидает checker
+func taskConfig(module Module, target string) (map[string]interface{}, string, error) {
+	config := make(map[string]interface{}, len(module.Config)+2)
+	for key, value := range module.Config {
+		config[key] = value
+	}
+
+	switch module.Prober {
+	case domain.TaskTypeHTTP:
+		// Как и blackbox_exporter, цель без схемы запрашивается по HTTP
+		if !strings.Contains(target, "://") {
+			target = "http://" + target
+		}
+		config["url"] = target
+	case domain.TaskTypeTCP:
+		host, port, err := net.SplitHostPort(target)
+		if err != nil {
+			return nil, "", fmt.Errorf("tcp target must be host:port: %w", err)
+		}
+		portNumber, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid tcp port %q", port)
+		}
+		config["host"] = host
+		config["port"] = float64(portNumber)
+	}
+	return config, target, nil
+}
+
+// recordResult добавляет метрики результата проверки с именами blackbox_exporter
+func recordResult(registry *prometheus.Registry, prober domain.TaskType, result *domain.CheckResult) {
+	if result.Timings != nil {
+		registerGauge(registry, "probe_dns_lookup_time_seconds", "Returns the time taken for probe dns lookup in seconds",
+			msToSeconds(result.Timings.DNSLookupMs))
+	}
+
+	switch prober {
+	case domain.TaskTypeHTTP:
+		registerGauge(registry, "probe_http_status_code", "Response HTTP status code", float64(result.StatusCode))
+		if size, err := strconv.ParseFloat(result.Metadata["body_size"], 64); err == nil {
+			registerGauge(registry, "probe_http_content_length", "Length of http content response", size)
+		}
+		if version := httpVersion(result.Metadata[checker.MetadataKeyHTTPProtocol]); version > 0 {
+			registerGauge(registry, "probe_http_version", "Returns the version of HTTP of the probe response", version)
+		}
+		if result.Timings != nil {
+			recordHTTPPhases(registry, result.Timings)
+		}
+	case domain.TaskTypeDomain:
+		if expiresAt, err := time.Parse(time.RFC3339, result.Metadata[checker.MetadataKeyDomainExpiresAt]); err == nil {
+			registerGauge(registry, "probe_domain_expiry_timestamp_seconds", "Returns the registration expiry of the domain in unixtime",
+				float64(expiresAt.Unix()))
+		}
+	}
+
+	if codes := checker.WarningCodes(result.Metadata); len(codes) > 0 {
+		warnings := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "probe_policy_warning",
+			Help: "Policy warnings (TLS, security headers) reported by the probe",
+		}, []string{"warning"})
+		for _, code := range codes {
+			warnings.WithLabelValues(code).Set(1)
+		}
+		registry.MustRegister(warnings)
+	}
+}
+
+// recordHTTPPhases переводит фазы запроса в фазы probe_http_duration_seconds blackbox_exporter:
+// resolve, connect, tls, processing (до первого байта ответа) и transfer (чтение ответа)
+func recordHTTPPhases(registry *prometheus.Registry, timings *domain.CheckTimings) {
+	phases := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "probe_http_duration_seconds",
+		Help: "Duration of http request by phase, summed over all redirects",
+	}, []string{"phase"})
+
+	processing := timings.TTFBMs - timings.DNSLookupMs - timings.TCPConnectMs - timings.TLSHandshakeMs
+	transfer := timings.TotalMs - timings.TTFBMs
+	phases.WithLabelValues("resolve").Set(msToSeconds(timings.DNSLookupMs))
+	phases.WithLabelValues("connect").Set(msToSeconds(timings.TCPConnectMs))
+	phases.WithLabelValues("tls").Set(msToSeconds(timings.TLSHandshakeMs))
+	phases.WithLabelValues("processing").Set(msToSeconds(max(processing, 0)))
+	phases.WithLabelValues("transfer").Set(msToSeconds(max(transfer, 0)))
+	registry.MustRegister(phases)
+}
+
+// registerGauge регистрирует метрику с единственным значением
+func registerGauge(registry *prometheus.Registry, name, help string, value float64) {
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: name, Help: help})
+	gauge.Set(value)
+	registry.MustRegister(gauge)
+}
+
+// scrapeTimeout возвращает таймаут проверки из заголовка Prometheus с запасом на ответ
+func scrapeTimeout(r *http.Request) time.Duration {
+	seconds, err := strconv.ParseFloat(r.Header.Get("X-Prometheus-Scrape-Timeout-Seconds"), 64)
+	if err != nil || seconds <= 0 {
+		return DefaultTimeout
+	}
+	timeout := time.Duration(seconds * float64(time.Second))
+	if timeout > timeoutOffset {
+		timeout -= timeoutOffset
+	}
+	return timeout
+}
+
+// httpVersion возвращает версию протокола ответа, например 1.1 для HTTP/1.1
+func httpVersion(proto string) float64 {
+	version, err := strconv.ParseFloat(strings.TrimPrefix(proto, "HTTP/"), 64)
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+// msToSeconds переводит миллисекунды в секунды
+func msToSeconds(ms int64) float64 {
+	return float64(ms) / 1000
+}
+
+// boolValue переводит флаг в значение метрики
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,157 @@
+package probe
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/metrics"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
+)
+
+// fakeRunner запоминает задачу и возвращает заданный результат
+type fakeRunner struct {
+	task   *domain.Task
+	result *domain.CheckResult
+	err    error
+	delay  time.Duration
+}
+
+func (f *fakeRunner) RunCheck(ctx context.Context, task *domain.Task, progress checker.ProgressFunc) (*domain.CheckResult, error) {
+	f.task = task
+	time.Sleep(f.delay)
+	return f.result, f.err
+}
+
+func newTestHandler(t *testing.T, runner Runner) *Handler {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	return NewHandler(runner, metrics.StaticScrapeTokens{"secret": "tenant-1"}, log)
+}
+
+func probe(handler http.Handler, query string, headers map[string]string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, "/probe?"+query, nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	body, _ := io.ReadAll(rec.Body)
+	return rec.Code, string(body)
+}
+
+func TestHandler_HTTPModule(t *testing.T) {
+	runner := &fakeRunner{result: &domain.CheckResult{
+		Success:    true,
+		StatusCode: 200,
+		Metadata: map[string]string{
+			"body_size":                       "512",
+			checker.MetadataKeyHTTPProtocol:   "HTTP/2.0",
+			"security_warning_csp":            "Content-Security-Policy header is missing",
+			checker.MetadataKeyResultSeverity: checker.ResultSeverityWarning,
+		},
+		Timings: &domain.CheckTimings{DNSLookupMs: 10, TCPConnectMs: 20, TLSHandshakeMs: 30, TTFBMs: 100, TotalMs: 150},
+	}}
+	handler := newTestHandler(t, runner)
+
+	code, body := probe(handler, "module=http_2xx&target=example.com/health", nil)
+	require.Equal(t, http.StatusOK, code, body)
+	assert.Equal(t, "http://example.com/health", runner.task.Config["url"])
+	assert.Equal(t, "GET", runner.task.Config["method"])
+	assert.Equal(t, domain.TriggerAPI, runner.task.Trigger)
+	assert.Equal(t, "probe:tenant-1", runner.task.TriggeredBy)
+
+	for _, line := range []string{
+		"probe_success 1",
+		"probe_http_status_code 200",
+		"probe_http_content_length 512",
+		"probe_http_version 2",
+		"probe_dns_lookup_time_seconds 0.01",
+		`probe_http_duration_seconds{phase="resolve"} 0.01`,
+		`probe_http_duration_seconds{phase="processing"} 0.04`,
+		`probe_http_duration_seconds{phase="transfer"} 0.05`,
+		`probe_policy_warning{warning="security_warning_csp"} 1`,
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+	assert.Contains(t, body, "probe_duration_seconds ")
+}
+
+func TestHandler_TCPModule(t *testing.T) {
+	runner := &fakeRunner{result: &domain.CheckResult{Success: false, Error: "connection refused"}}
+	handler := newTestHandler(t, runner)
+
+	code, body := probe(handler, "module=tcp_connect&target=db.example.com:5432", nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "db.example.com", runner.task.Config["host"])
+	assert.Equal(t, float64(5432), runner.task.Config["port"])
+	assert.Contains(t, body, "probe_success 0\n")
+	assert.NotContains(t, body, "probe_http_status_code")
+
+	// Цель без порта не доходит до проверки
+	runner.task = nil
+	code, body = probe(handler, "module=tcp_connect&target=db.example.com", nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Nil(t, runner.task)
+	assert.Contains(t, body, "probe_success 0\n")
+}
+
+func TestHandler_Timeout(t *testing.T) {
+	runner := &fakeRunner{result: &domain.CheckResult{Success: true}, delay: 2 * time.Second}
+	handler := newTestHandler(t, runner)
+
+	start := time.Now()
+	code, body := probe(handler, "module=icmp&target=example.com", map[string]string{
+		"X-Prometheus-Scrape-Timeout-Seconds": "0.7",
+	})
+	require.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "probe_success 0\n")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestHandler_Errors(t *testing.T) {
+	handler := newTestHandler(t, &fakeRunner{result: &domain.CheckResult{Success: true}})
+
+	code, body := probe(handler, "module=dns_udp&target=example.com", nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, body, `Unknown module "dns_udp"`)
+
+	code, _ = probe(handler, "module=http_2xx", nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	req := httptest.NewRequest(http.MethodGet, "/probe?target=example.com", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestHandler_WithModules(t *testing.T) {
+	runner := &fakeRunner{result: &domain.CheckResult{Success: true}}
+	handler := newTestHandler(t, runner).WithModules(map[string]Module{
+		"http_head": {Prober: domain.TaskTypeHTTP, Config: map[string]interface{}{"method": "HEAD", "expected_status": float64(204)}},
+	})
+
+	code, _ := probe(handler, "module=http_head&target=https://example.com", nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "HEAD", runner.task.Config["method"])
+	assert.Equal(t, "https://example.com", runner.task.Config["url"])
+
+	code, body := probe(handler, "module=http_2xx&target=https://example.com", nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.True(t, strings.HasPrefix(body, "Unknown module"))
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// ConsensusMetrics метрики выполнения проверок из нескольких регионов
+type ConsensusMetrics struct {
+	regionResults *prometheus.CounterVec
+	decisions     *prometheus.CounterVec
+}
+
+// NewConsensusMetrics создает метрики консенсуса и регистрирует их в реестре по умолчанию
+func NewConsensusMetrics(namespace string) *ConsensusMetrics {
+	regionResults := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "uptime",
+			Name:      "check_region_results_total",
+			Help:      "Total number of per-region results of multi-region checks",
+		},
+		[]string{"type", "region", "result"},
+	)
+	decisions := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "uptime",
+			Name:      "check_consensus_decisions_total",
+			Help:      "Total number of unified results of multi-region checks by consensus policy",
+		},
+		[]string{"type", "policy", "result"},
+	)
+	registerMetric(regionResults)
+	registerMetric(decisions)
+
+	return &ConsensusMetrics{
+		regionResults: regionResults,
+		decisions:     decisions,
+	}
+}
+
+// RecordRegionResult учитывает результат проверки в регионе
+func (m *ConsensusMetrics) RecordRegionResult(checkType, region string, success bool) {
+	m.regionResults.WithLabelValues(checkType, region, resultLabel(success)).Inc()
+}
+
+// RecordConsensus учитывает единый результат проверки по политике консенсуса
+func (m *ConsensusMetrics) RecordConsensus(checkType string, policy domain.ConsensusPolicy, success bool) {
+	m.decisions.WithLabelValues(checkType, string(policy), resultLabel(success)).Inc()
+}
+
+// resultLabel значение метки результата
+func resultLabel(success bool) string {
+	if success {
+		return ExecutionResultSuccess
+	}
+	return ExecutionResultFail
+}
@@ -0,0 +1,168 @@
+package metrics
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Результаты выполнения проверки для метрик
+const (
+	ExecutionResultSuccess = "success"
+	ExecutionResultFail    = "fail"
+	ExecutionResultTimeout = "timeout"
+)
+
+// Значения метки tenant вне лимита и для задач без tenant
+const (
+	OtherTenantLabel   = "other"
+	UnknownTenantLabel = "unknown"
+)
+
+// TenantLabelMode способ ограничения кардинальности метки tenant
+type TenantLabelMode string
+
+const (
+	// TenantLabelTopN первые N tenant получают собственную метку, остальные попадают в OtherTenantLabel.
+	// Набор закрепляется при первом появлении, чтобы серии не перескакивали между метками
+	TenantLabelTopN TenantLabelMode = "top_n"
+	// TenantLabelHash tenant распределяются по N корзинам по хешу ID
+	TenantLabelHash TenantLabelMode = "hash"
+)
+
+// DefaultTenantLabelLimit лимит различных значений метки tenant по умолчанию
+const DefaultTenantLabelLimit = 100
+
+// TenantLabeler ограничивает количество различных значений метки tenant
+type TenantLabeler struct {
+	mode  TenantLabelMode
+	limit int
+
+	mu      sync.Mutex
+	tenants map[string]struct{}
+}
+
+// NewTenantLabeler создает ограничитель метки tenant; неизвестный режим трактуется как TenantLabelTopN,
+// неположительный лимит - как DefaultTenantLabelLimit
+func NewTenantLabeler(mode TenantLabelMode, limit int) *TenantLabeler {
+	if mode != TenantLabelHash {
+		mode = TenantLabelTopN
+	}
+	if limit <= 0 {
+		limit = DefaultTenantLabelLimit
+	}
+	return &TenantLabeler{
+		mode:    mode,
+		limit:   limit,
+		tenants: make(map[string]struct{}),
+	}
+}
+
+// Label возвращает значение метки tenant для tenantID
+func (l *TenantLabeler) Label(tenantID string) string {
+	if tenantID == "" {
+		return UnknownTenantLabel
+	}
+
+	if l.mode == TenantLabelHash {
+		h := fnv.New32a()
+		h.Write([]byte(tenantID))
+		return fmt.Sprintf("bucket_%d", h.Sum32()%uint32(l.limit))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.tenants[tenantID]; ok {
+		return tenantID
+	}
+	if len(l.tenants) < l.limit {
+		l.tenants[tenantID] = struct{}{}
+		return tenantID
+	}
+	return OtherTenantLabel
+}
+
+// ExecutionResult классифицирует результат выполнения проверки
+func ExecutionResult(success bool, errorMsg string) string {
+	if success {
+		return ExecutionResultSuccess
+	}
+	if categorizeError(errorMsg) == "timeout" {
+		return ExecutionResultTimeout
+	}
+	return ExecutionResultFail
+}
+
+// ExecutionMetrics метрики выполнения проверок.
+// Общий счетчик выполнений имеет ограниченную кардинальность (тип, tenant под лимитом, результат).
+// Статус отдельных проверок хранится вне глобального реестра и отдается только tenant-владельцу
+type ExecutionMetrics struct {
+	executions *prometheus.CounterVec
+	costUnits  *prometheus.CounterVec
+	tenants    *TenantLabeler
+	statuses   *CheckStatusStore
+}
+
+// NewExecutionMetrics создает метрики выполнения проверок и регистрирует счетчик в реестре по умолчанию
+func NewExecutionMetrics(namespace string, tenants *TenantLabeler) *ExecutionMetrics {
+	if tenants == nil {
+		tenants = NewTenantLabeler(TenantLabelTopN, DefaultTenantLabelLimit)
+	}
+
+	executions := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "uptime",
+			Name:      "check_executions_total",
+			Help:      "Total number of check executions by check type, tenant (cardinality limited) and result",
+		},
+		[]string{"type", "tenant", "result"},
+	)
+	registerMetric(executions)
+
+	costUnits := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "uptime",
+			Name:      "check_cost_units_total",
+			Help:      "Total cost units of check executions (duration seconds x payload size class, summed over regions) by check type and tenant",
+		},
+		[]string{"type", "tenant"},
+	)
+	registerMetric(costUnits)
+
+	return &ExecutionMetrics{
+		executions: executions,
+		costUnits:  costUnits,
+		tenants:    tenants,
+		statuses:   NewCheckStatusStore(namespace, DefaultCheckStatusTTL),
+	}
+}
+
+// RecordExecution учитывает выполнение проверки
+func (m *ExecutionMetrics) RecordExecution(tenantID, checkID, checkType string, success bool, errorMsg string) {
+	result := ExecutionResult(success, errorMsg)
+	m.executions.WithLabelValues(checkType, m.tenants.Label(tenantID), result).Inc()
+	if tenantID != "" && checkID != "" {
+		m.statuses.Set(tenantID, checkID, checkType, result, time.Now())
+	}
+}
+
+// RecordCost учитывает стоимость выполнения проверки в общем счетчике и в счетчике проверки tenant
+func (m *ExecutionMetrics) RecordCost(tenantID, checkID, checkType string, units float64) {
+	if units <= 0 {
+		return
+	}
+	m.costUnits.WithLabelValues(checkType, m.tenants.Label(tenantID)).Add(units)
+	if tenantID != "" && checkID != "" {
+		m.statuses.AddCost(tenantID, checkID, checkType, units, time.Now())
+	}
+}
+
+// Statuses возвращает хранилище статусов проверок для tenant эндпоинта метрик
+func (m *ExecutionMetrics) Statuses() *CheckStatusStore {
+	return m.statuses
+}
@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantLabeler_TopN(t *testing.T) {
+	labeler := NewTenantLabeler(TenantLabelTopN, 2)
+
+	assert.Equal(t, "tenant-1", labeler.Label("tenant-1"))
+	assert.Equal(t, "tenant-2", labeler.Label("tenant-2"))
+	assert.Equal(t, OtherTenantLabel, labeler.Label("tenant-3"))
+	assert.Equal(t, "tenant-1", labeler.Label("tenant-1"))
+	assert.Equal(t, UnknownTenantLabel, labeler.Label(""))
+}
+
+func TestTenantLabeler_Hash(t *testing.T) {
+	labeler := NewTenantLabeler(TenantLabelHash, 4)
+
+	labels := make(map[string]struct{})
+	for i := 0; i < 100; i++ {
+		labels[labeler.Label(fmt.Sprintf("tenant-%d", i))] = struct{}{}
+	}
+	assert.LessOrEqual(t, len(labels), 4)
+	assert.Equal(t, labeler.Label("tenant-42"), labeler.Label("tenant-42"))
+}
+
+func TestExecutionResult(t *testing.T) {
+	assert.Equal(t, ExecutionResultSuccess, ExecutionResult(true, ""))
+	assert.Equal(t, ExecutionResultTimeout, ExecutionResult(false, "context deadline exceeded: timeout"))
+	assert.Equal(t, ExecutionResultFail, ExecutionResult(false, "connection refused"))
+}
+
+func TestExecutionMetrics_RecordExecution(t *testing.T) {
+	metrics := NewExecutionMetrics("test_exec_record", NewTenantLabeler(TenantLabelTopN, 1))
+
+	metrics.RecordExecution("tenant-1", "check-1", "http", true, "")
+	metrics.RecordExecution("tenant-2", "check-2", "http", false, "request timeout")
+	metrics.RecordExecution("tenant-2", "check-3", "tcp", false, "connection refused")
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.executions.WithLabelValues("http", "tenant-1", ExecutionResultSuccess)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.executions.WithLabelValues("http", OtherTenantLabel, ExecutionResultTimeout)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.executions.WithLabelValues("tcp", OtherTenantLabel, ExecutionResultFail)))
+}
+
+func TestExecutionMetrics_RecordCost(t *testing.T) {
+	metrics := NewExecutionMetrics("test_exec_cost", NewTenantLabeler(TenantLabelTopN, 1))
+
+	metrics.RecordExecution("tenant-1", "check-1", "http", true, "")
+	metrics.RecordCost("tenant-1", "check-1", "http", 1.5)
+	metrics.RecordCost("tenant-1", "check-1", "http", 0.5)
+	metrics.RecordCost("tenant-2", "check-2", "http", 4)
+	metrics.RecordCost("tenant-2", "check-2", "http", 0)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.costUnits.WithLabelValues("http", "tenant-1")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(metrics.costUnits.WithLabelValues("http", OtherTenantLabel)))
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics/tenant", nil)
+	req.Header.Set("Authorization", "Bearer secret-1")
+	rec := httptest.NewRecorder()
+	metrics.Statuses().TenantHandler(StaticScrapeTokens{"secret-1": "tenant-1"}).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `test_exec_cost_check_cost_units_total{check_id="check-1",type="http"} 2`)
+	assert.Contains(t, body, `test_exec_cost_check_up{check_id="check-1",result="success",type="http"} 1`)
+}
+
+func TestParseScrapeTokens(t *testing.T) {
+	tokens, err := ParseScrapeTokens("tenant-1:secret-1, tenant-2:secret-2")
+	require.NoError(t, err)
+
+	tenantID, ok := tokens.TenantForToken("secret-2")
+	assert.True(t, ok)
+	assert.Equal(t, "tenant-2", tenantID)
+
+	_, ok = tokens.TenantForToken("unknown")
+	assert.False(t, ok)
+
+	_, err = ParseScrapeTokens("tenant-1")
+	assert.Error(t, err)
+}
+
+func TestCheckStatusStore_TenantHandler(t *testing.T) {
+	store := NewCheckStatusStore("test_tenant_handler", time.Hour)
+	store.Set("tenant-1", "check-1", "http", ExecutionResultSuccess, time.Now())
+	store.Set("tenant-2", "check-2", "http", ExecutionResultFail, time.Now())
+	store.Set("tenant-1", "check-stale", "http", ExecutionResultFail, time.Now().Add(-2*time.Hour))
+
+	handler := store.TenantHandler(StaticScrapeTokens{"secret-1": "tenant-1"})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics/tenant", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics/tenant", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/metrics/tenant", nil)
+	req.Header.Set("Authorization", "Bearer secret-1")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `test_tenant_handler_check_up{check_id="check-1",result="success",type="http"} 1`)
+	assert.NotContains(t, body, "check-2")
+	assert.NotContains(t, body, "check-stale")
+}
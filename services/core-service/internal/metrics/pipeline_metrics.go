@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// pipelineBuckets границы гистограмм задержек конвейера: от 10 мс до 10 минут
+var pipelineBuckets = []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600}
+
+// PipelineMetrics метрики SLI конвейера проверок: задержка очереди от scheduled_at задачи до ее
+// получения Core Service и задержка сохранения от получения до записи результата
+type PipelineMetrics struct {
+	queueLag     *prometheus.HistogramVec
+	persistDelay *prometheus.HistogramVec
+	breaches     *prometheus.GaugeVec
+}
+
+// NewPipelineMetrics создает метрики конвейера и регистрирует их в реестре по умолчанию
+func NewPipelineMetrics(namespace string) *PipelineMetrics {
+	queueLag := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pipeline",
+			Name:      "queue_lag_seconds",
+			Help:      "Time from task scheduled_at to the task being consumed by core-service",
+			Buckets:   pipelineBuckets,
+		},
+		[]string{"type"},
+	)
+	persistDelay := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pipeline",
+			Name:      "persist_delay_seconds",
+			Help:      "Time from task consumption to the check result being persisted",
+			Buckets:   pipelineBuckets,
+		},
+		[]string{"type"},
+	)
+	breaches := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "pipeline",
+			Name:      "sli_breached",
+			Help:      "Whether the pipeline SLI quantile is above its threshold (1) or not (0)",
+		},
+		[]string{"sli"},
+	)
+	registerMetric(queueLag)
+	registerMetric(persistDelay)
+	registerMetric(breaches)
+
+	return &PipelineMetrics{
+		queueLag:     queueLag,
+		persistDelay: persistDelay,
+		breaches:     breaches,
+	}
+}
+
+// RecordQueueLag учитывает задержку очереди
+func (m *PipelineMetrics) RecordQueueLag(checkType string, lag time.Duration) {
+	m.queueLag.WithLabelValues(checkType).Observe(lag.Seconds())
+}
+
+// RecordPersistDelay учитывает задержку сохранения результата
+func (m *PipelineMetrics) RecordPersistDelay(checkType string, delay time.Duration) {
+	m.persistDelay.WithLabelValues(checkType).Observe(delay.Seconds())
+}
+
+// RecordBreach отмечает, превышен ли порог SLI
+func (m *PipelineMetrics) RecordBreach(sli string, breached bool) {
+	value := 0.0
+	if breached {
+		value = 1
+	}
+	m.breaches.WithLabelValues(sli).Set(value)
+}
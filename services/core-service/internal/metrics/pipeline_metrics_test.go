@@ -0,0 +1,23 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineMetrics(t *testing.T) {
+	metrics := NewPipelineMetrics("test_pipeline")
+
+	metrics.RecordQueueLag("http", 2*time.Second)
+	metrics.RecordPersistDelay("http", 50*time.Millisecond)
+	metrics.RecordBreach("queue_lag", true)
+
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.queueLag))
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.persistDelay))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.breaches.WithLabelValues("queue_lag")))
+	metrics.RecordBreach("queue_lag", false)
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.breaches.WithLabelValues("queue_lag")))
+}
@@ -0,0 +1,211 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// DefaultCheckStatusTTL срок, после которого статус не обновлявшейся проверки
+// (удаленной или выключенной) перестает отдаваться
+const DefaultCheckStatusTTL = 24 * time.Hour
+
+// checkStatus последний статус проверки
+type checkStatus struct {
+	checkType string
+	result    string
+	checkedAt time.Time
+	costUnits float64
+}
+
+// CheckStatusStore хранит последний статус каждой проверки по tenant.
+// Серии с check_id не попадают в глобальный /metrics: их кардинальность равна числу проверок
+type CheckStatusStore struct {
+	ttl         time.Duration
+	upDesc      *prometheus.Desc
+	checkedDesc *prometheus.Desc
+	costDesc    *prometheus.Desc
+
+	mu       sync.RWMutex
+	statuses map[string]map[string]checkStatus
+}
+
+// NewCheckStatusStore создает хранилище статусов проверок
+func NewCheckStatusStore(namespace string, ttl time.Duration) *CheckStatusStore {
+	if ttl <= 0 {
+		ttl = DefaultCheckStatusTTL
+	}
+	return &CheckStatusStore{
+		ttl: ttl,
+		upDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "check", "up"),
+			"Whether the last execution of the check succeeded (1) or not (0)",
+			[]string{"check_id", "type", "result"}, nil,
+		),
+		checkedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "check", "last_execution_timestamp_seconds"),
+			"Timestamp of the last execution of the check",
+			[]string{"check_id", "type"}, nil,
+		),
+		costDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "check", "cost_units_total"),
+			"Total cost units of the check executions since the service start",
+			[]string{"check_id", "type"}, nil,
+		),
+		statuses: make(map[string]map[string]checkStatus),
+	}
+}
+
+// Set сохраняет последний статус проверки
+func (s *CheckStatusStore) Set(tenantID, checkID, checkType, result string, checkedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checks, ok := s.statuses[tenantID]
+	if !ok {
+		checks = make(map[string]checkStatus)
+		s.statuses[tenantID] = checks
+	}
+	checks[checkID] = checkStatus{checkType: checkType, result: result, checkedAt: checkedAt, costUnits: checks[checkID].costUnits}
+}
+
+// AddCost добавляет стоимость выполнения к накопленной стоимости проверки
+func (s *CheckStatusStore) AddCost(tenantID, checkID, checkType string, units float64, checkedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checks, ok := s.statuses[tenantID]
+	if !ok {
+		checks = make(map[string]checkStatus)
+		s.statuses[tenantID] = checks
+	}
+	status := checks[checkID]
+	status.checkType = checkType
+	status.costUnits += units
+	if checkedAt.After(status.checkedAt) {
+		status.checkedAt = checkedAt
+	}
+	checks[checkID] = status
+}
+
+// Collector возвращает коллектор статусов проверок одного tenant
+func (s *CheckStatusStore) Collector(tenantID string) prometheus.Collector {
+	return &tenantStatusCollector{store: s, tenantID: tenantID}
+}
+
+// prune удаляет устаревшие статусы tenant
+func (s *CheckStatusStore) prune(tenantID string, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checks := s.statuses[tenantID]
+	for checkID, status := range checks {
+		if now.Sub(status.checkedAt) > s.ttl {
+			delete(checks, checkID)
+		}
+	}
+	if len(checks) == 0 {
+		delete(s.statuses, tenantID)
+	}
+}
+
+// tenantStatusCollector отдает статусы проверок только указанного tenant
+type tenantStatusCollector struct {
+	store    *CheckStatusStore
+	tenantID string
+}
+
+// Describe реализует prometheus.Collector
+func (c *tenantStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.store.upDesc
+	ch <- c.store.checkedDesc
+	ch <- c.store.costDesc
+}
+
+// Collect реализует prometheus.Collector
+func (c *tenantStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	c.store.prune(c.tenantID, time.Now())
+
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+
+	for checkID, status := range c.store.statuses[c.tenantID] {
+		if status.costUnits > 0 {
+			ch <- prometheus.MustNewConstMetric(c.store.costDesc, prometheus.CounterValue, status.costUnits, checkID, status.checkType)
+		}
+		if status.result == "" {
+			continue
+		}
+		up := 0.0
+		if status.result == ExecutionResultSuccess {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.store.upDesc, prometheus.GaugeValue, up, checkID, status.checkType, status.result)
+		ch <- prometheus.MustNewConstMetric(c.store.checkedDesc, prometheus.GaugeValue,
+			float64(status.checkedAt.Unix()), checkID, status.checkType)
+	}
+}
+
+// ScrapeTokenResolver определяет tenant по токену scrape запроса Prometheus
+type ScrapeTokenResolver interface {
+	TenantForToken(token string) (string, bool)
+}
+
+// StaticScrapeTokens статический набор токенов: токен -> tenant
+type StaticScrapeTokens map[string]string
+
+// ParseScrapeTokens разбирает токены в формате "tenant_id:token,tenant_id:token"
+func ParseScrapeTokens(spec string) (StaticScrapeTokens, error) {
+	tokens := make(StaticScrapeTokens)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		tenantID, token, ok := strings.Cut(entry, ":")
+		if !ok || tenantID == "" || token == "" {
+			return nil, fmt.Errorf("invalid scrape token entry %q, expected tenant_id:token", entry)
+		}
+		tokens[token] = tenantID
+	}
+	return tokens, nil
+}
+
+// TenantForToken сравнивает токен со всеми известными за постоянное время
+func (t StaticScrapeTokens) TenantForToken(token string) (string, bool) {
+	var tenantID string
+	for known, tenant := range t {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(token)) == 1 {
+			tenantID = tenant
+		}
+	}
+	return tenantID, tenantID != ""
+}
+
+// TenantHandler отдает статусы проверок tenant, которому принадлежит Bearer токен запроса
+func (s *CheckStatusStore) TenantHandler(resolver ScrapeTokenResolver) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || resolver == nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="tenant-metrics"`)
+			http.Error(w, "scrape token required", http.StatusUnauthorized)
+			return
+		}
+
+		tenantID, ok := resolver.TenantForToken(token)
+		if !ok {
+			http.Error(w, "invalid scrape token", http.StatusForbidden)
+			return
+		}
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(s.Collector(tenantID))
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	})
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"UptimePingPlatform/pkg/metrics"
@@ -23,6 +24,9 @@ type UptimeMetrics struct {
 	// Дополнительные метрики
 	lastSuccessTimestamp *prometheus.GaugeVec
 	responseSize          *prometheus.HistogramVec
+	phaseDuration         *prometheus.HistogramVec
+	groupResults          *prometheus.CounterVec
+	checkWarnings         *prometheus.CounterVec
 }
 
 // NewUptimeMetrics создает новый экземпляр метрик для uptime проверок
@@ -91,6 +95,40 @@ func NewUptimeMetrics(serviceName string) *UptimeMetrics {
 		[]string{"type", "target", "status"},
 	)
 	
+	phaseDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: serviceName,
+			Subsystem: "uptime",
+			Name:      "check_phase_duration_seconds",
+			Help:      "Duration of individual check phases (dns, tcp_connect, tls_handshake, ttfb, total) in seconds",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"type", "phase"},
+	)
+	
+	// Метка group содержит ID группы, а не имя проверки, поэтому кардинальность
+	// ограничена количеством групп, а не количеством проверок
+	groupResults := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: serviceName,
+			Subsystem: "uptime",
+			Name:      "group_check_results_total",
+			Help:      "Total number of check results per check group, including results of subgroups",
+		},
+		[]string{"group", "status"},
+	)
+	
+	// Предупреждения политик TLS и заголовков безопасности у успешных проверок
+	checkWarnings := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: serviceName,
+			Subsystem: "uptime",
+			Name:      "check_warnings_total",
+			Help:      "Total number of policy warnings (TLS, security headers, mixed content) reported by checks",
+		},
+		[]string{"type", "target", "warning"},
+	)
+	
 	// Регистрируем метрики в Prometheus
 	registerMetric(checkDuration)
 	registerMetric(checkTotal)
@@ -98,6 +136,9 @@ func NewUptimeMetrics(serviceName string) *UptimeMetrics {
 	registerMetric(checkActive)
 	registerMetric(lastSuccessTimestamp)
 	registerMetric(responseSize)
+	registerMetric(phaseDuration)
+	registerMetric(groupResults)
+	registerMetric(checkWarnings)
 	
 	return &UptimeMetrics{
 		base:                  base,
@@ -107,6 +148,9 @@ func NewUptimeMetrics(serviceName string) *UptimeMetrics {
 		checkActive:           checkActive,
 		lastSuccessTimestamp:  lastSuccessTimestamp,
 		responseSize:          responseSize,
+		phaseDuration:         phaseDuration,
+		groupResults:          groupResults,
+		checkWarnings:         checkWarnings,
 	}
 }
 
@@ -154,6 +198,36 @@ func (um *UptimeMetrics) RecordResponseSize(checkType, target, status string, si
 	um.responseSize.WithLabelValues(checkType, target, status).Observe(float64(sizeBytes))
 }
 
+// RecordCheckPhases записывает длительности фаз проверки (DNS, connect, TLS, TTFB).
+// Цель не используется в метках, чтобы не раздувать кардинальность.
+func (um *UptimeMetrics) RecordCheckPhases(checkType string, phases map[string]time.Duration) {
+	for phase, duration := range phases {
+		um.phaseDuration.WithLabelValues(checkType, phase).Observe(duration.Seconds())
+	}
+}
+
+// RecordGroupResult учитывает результат проверки в ее группе и во всех группах-предках.
+// groupPath имеет вид "/root-id/child-id/", как в scheduler-service.
+func (um *UptimeMetrics) RecordGroupResult(groupPath string, success bool) {
+	status := "failure"
+	if success {
+		status = "success"
+	}
+	for _, groupID := range strings.Split(strings.Trim(groupPath, "/"), "/") {
+		if groupID == "" {
+			continue
+		}
+		um.groupResults.WithLabelValues(groupID, status).Inc()
+	}
+}
+
+// RecordCheckWarnings учитывает предупреждения политик результата проверки по кодам
+func (um *UptimeMetrics) RecordCheckWarnings(checkType, target string, codes []string) {
+	for _, code := range codes {
+		um.checkWarnings.WithLabelValues(checkType, target, code).Inc()
+	}
+}
+
 // RecordCheckResult записывает все метрики для результата проверки
 func (um *UptimeMetrics) RecordCheckResult(checkType, target string, duration time.Duration, success bool, responseSize int64, errorMsg string) {
 	status := "success"
@@ -314,3 +388,8 @@ func (a *UptimeMetricsAdapter) OnCheckCompleted(checkType, target string, durati
 func (a *UptimeMetricsAdapter) OnCheckError(checkType, target, errorType string) {
 	a.metrics.IncrementCheckErrors(checkType, target, errorType)
 }
+
+// RecordGroupResult учитывает результат проверки в группах для агрегированного uptime групп
+func (a *UptimeMetricsAdapter) RecordGroupResult(groupPath string, success bool) {
+	a.metrics.RecordGroupResult(groupPath, success)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -100,6 +101,40 @@ func TestRecordResponseSize(t *testing.T) {
 	})
 }
 
+func TestRecordGroupResult(t *testing.T) {
+	metrics := NewUptimeMetrics("test-service")
+
+	metrics.RecordGroupResult("/grp-root/grp-child/", true)
+	metrics.RecordGroupResult("/grp-root/", false)
+	// Проверки без группы не учитываются
+	metrics.RecordGroupResult("", true)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.groupResults.WithLabelValues("grp-root", "success")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.groupResults.WithLabelValues("grp-root", "failure")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.groupResults.WithLabelValues("grp-child", "success")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.groupResults.WithLabelValues("grp-child", "failure")))
+}
+
+func TestUptimeMetricsAdapter_RecordGroupResult(t *testing.T) {
+	metrics := NewUptimeMetrics("test-service")
+	adapter := NewUptimeMetricsAdapter(metrics)
+
+	adapter.RecordGroupResult("/grp-root/grp-child/", false)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.groupResults.WithLabelValues("grp-root", "failure")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.groupResults.WithLabelValues("grp-child", "failure")))
+}
+
+func TestRecordCheckWarnings(t *testing.T) {
+	metrics := NewUptimeMetrics("test-service")
+	
+	metrics.RecordCheckWarnings("http", "https://example.com", []string{"security_warning_hsts", "tls_warning_cipher"})
+	metrics.RecordCheckWarnings("http", "https://example.com", []string{"security_warning_hsts"})
+	
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.checkWarnings.WithLabelValues("http", "https://example.com", "security_warning_hsts")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.checkWarnings.WithLabelValues("http", "https://example.com", "tls_warning_cipher")))
+}
+
 func TestRecordCheckResult_Success(t *testing.T) {
 	metrics := NewUptimeMetrics("test-service")
 	
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// ArtifactStore S3-совместимое хранилище содержимого артефактов
+type ArtifactStore interface {
+	// Put сохраняет объект по ключу
+	Put(ctx context.Context, key, contentType string, data []byte) error
+
+	// Delete удаляет объект; отсутствующий объект не считается ошибкой
+	Delete(ctx context.Context, key string) error
+
+	// SignedURL возвращает ссылку на чтение объекта, действующую ttl
+	SignedURL(key string, ttl time.Duration) (string, error)
+}
+
+// ArtifactRepository хранит метаданные артефактов (check_artifacts)
+type ArtifactRepository interface {
+	// Save сохраняет метаданные загруженных артефактов
+	Save(ctx context.Context, artifacts []domain.Artifact) error
+
+	// ListByCheck возвращает неистекшие артефакты проверки, новые первыми;
+	// пустой executionID означает все выполнения
+	ListByCheck(ctx context.Context, checkID, executionID string, limit int) ([]domain.Artifact, error)
+
+	// Get возвращает артефакт проверки по ключу
+	Get(ctx context.Context, checkID, key string) (*domain.Artifact, error)
+
+	// ListExpired возвращает до limit артефактов с истекшим к before сроком хранения
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]domain.Artifact, error)
+
+	// Delete удаляет метаданные артефактов по ключам
+	Delete(ctx context.Context, keys []string) error
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// CheckDefinitionRepository читает проверки, которыми владеет scheduler-service
+type CheckDefinitionRepository interface {
+	// GetDefinition возвращает состояние проверки или nil, если проверка удалена
+	GetDefinition(ctx context.Context, checkID string) (*domain.CheckDefinition, error)
+}
@@ -18,6 +18,12 @@ type CheckResultRepository interface {
 	// GetByCheckID получает результаты для конкретной проверки
 	GetByCheckID(ctx context.Context, checkID string, limit int) ([]*domain.CheckResult, error)
 	
+	// GetPageByCheckID получает страницу результатов проверки, новые первыми
+	GetPageByCheckID(ctx context.Context, checkID string, limit, offset int) ([]*domain.CheckResult, error)
+	
+	// CountByCheckID возвращает число сохраненных результатов проверки
+	CountByCheckID(ctx context.Context, checkID string) (int, error)
+	
 	// GetLatestByCheckID получает последний результат для проверки
 	GetLatestByCheckID(ctx context.Context, checkID string) (*domain.CheckResult, error)
 	
@@ -30,6 +36,9 @@ type CheckResultRepository interface {
 	// DeleteOldResults удаляет старые результаты
 	DeleteOldResults(ctx context.Context, olderThan time.Time) error
 	
+	// PurgeCaptures удаляет диагностические снимки неудачных проверок старше olderThan
+	PurgeCaptures(ctx context.Context, olderThan time.Time) (int64, error)
+	
 	// GetStats получает статистику по результатам
 	GetStats(ctx context.Context, startTime, endTime time.Time) (*ResultStats, error)
 }
@@ -0,0 +1,247 @@
+// Package memory хранилища core-service в памяти процесса для запуска без PostgreSQL
+package memory
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// DefaultResultsPerCheck число последних результатов, которые хранятся для каждой проверки
+const DefaultResultsPerCheck = 1000
+
+// CheckResultRepository последние результаты проверок в памяти; для каждой проверки хранится
+// не больше limit результатов, старые вытесняются
+type CheckResultRepository struct {
+	mu      sync.RWMutex
+	limit   int
+	byCheck map[string][]*domain.CheckResult
+	byID    map[string]*domain.CheckResult
+	seq     int64
+}
+
+// NewCheckResultRepository создает хранилище; limit <= 0 - DefaultResultsPerCheck
+func NewCheckResultRepository(limit int) *CheckResultRepository {
+	if limit <= 0 {
+		limit = DefaultResultsPerCheck
+	}
+	return &CheckResultRepository{
+		limit:   limit,
+		byCheck: make(map[string][]*domain.CheckResult),
+		byID:    make(map[string]*domain.CheckResult),
+	}
+}
+
+var _ repository.CheckResultRepository = (*CheckResultRepository)(nil)
+
+// Save сохраняет копию результата; результат без ID получает порядковый идентификатор
+func (r *CheckResultRepository) Save(ctx context.Context, result *domain.CheckResult) error {
+	saved := *result
+	saved.SchemaVersion = domain.ResultSchemaVersion
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if saved.ID == "" {
+		r.seq++
+		saved.ID = strconv.FormatInt(r.seq, 10)
+	}
+	results := append(r.byCheck[result.CheckID], &saved)
+	if len(results) > r.limit {
+		delete(r.byID, results[0].ID)
+		results = results[1:]
+	}
+	r.byCheck[result.CheckID] = results
+	r.byID[saved.ID] = &saved
+	return nil
+}
+
+// GetByID возвращает результат по ID
+func (r *CheckResultRepository) GetByID(ctx context.Context, id string) (*domain.CheckResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result, ok := r.byID[id]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "check result not found")
+	}
+	copied := *result
+	return &copied, nil
+}
+
+// GetByCheckID возвращает последние результаты проверки, новые первыми
+func (r *CheckResultRepository) GetByCheckID(ctx context.Context, checkID string, limit int) ([]*domain.CheckResult, error) {
+	return r.GetPageByCheckID(ctx, checkID, limit, 0)
+}
+
+// GetPageByCheckID возвращает страницу результатов проверки, новые первыми
+func (r *CheckResultRepository) GetPageByCheckID(ctx context.Context, checkID string, limit, offset int) ([]*domain.CheckResult, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if offset < 0 {
+		offset = 0
+	}
+	stored := r.byCheck[checkID]
+	results := make([]*domain.CheckResult, 0, len(stored))
+	for i := len(stored) - 1 - offset; i >= 0 && (limit <= 0 || len(results) < limit); i-- {
+		copied := *stored[i]
+		results = append(results, &copied)
+	}
+	return results, nil
+}
+
+// CountByCheckID возвращает число хранимых результатов проверки
+func (r *CheckResultRepository) CountByCheckID(ctx context.Context, checkID string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.byCheck[checkID]), nil
+}
+
+// GetLatestByCheckID возвращает последний результат проверки
+func (r *CheckResultRepository) GetLatestByCheckID(ctx context.Context, checkID string) (*domain.CheckResult, error) {
+	results, _ := r.GetByCheckID(ctx, checkID, 1)
+	if len(results) == 0 {
+		return nil, errors.New(errors.ErrNotFound, "no results found for check")
+	}
+	return results[0], nil
+}
+
+// GetByTimeRange возвращает результаты за период, новые первыми
+func (r *CheckResultRepository) GetByTimeRange(ctx context.Context, startTime, endTime time.Time, limit int) ([]*domain.CheckResult, error) {
+	return r.find(startTime, endTime, limit, func(*domain.CheckResult) bool { return true }), nil
+}
+
+// GetFailedChecks возвращает неудачные результаты за период, новые первыми
+func (r *CheckResultRepository) GetFailedChecks(ctx context.Context, startTime, endTime time.Time, limit int) ([]*domain.CheckResult, error) {
+	return r.find(startTime, endTime, limit, func(result *domain.CheckResult) bool { return !result.Success }), nil
+}
+
+// DeleteOldResults удаляет результаты старше olderThan
+func (r *CheckResultRepository) DeleteOldResults(ctx context.Context, olderThan time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for checkID, results := range r.byCheck {
+		kept := results[:0]
+		for _, result := range results {
+			if result.CheckedAt.Before(olderThan) {
+				delete(r.byID, result.ID)
+				continue
+			}
+			kept = append(kept, result)
+		}
+		if len(kept) == 0 {
+			delete(r.byCheck, checkID)
+			continue
+		}
+		r.byCheck[checkID] = kept
+	}
+	return nil
+}
+
+// PurgeCaptures удаляет диагностические снимки результатов старше olderThan
+func (r *CheckResultRepository) PurgeCaptures(ctx context.Context, olderThan time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for _, result := range r.byID {
+		if result.Capture != nil && result.CheckedAt.Before(olderThan) {
+			result.Capture = nil
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// Snapshot возвращает копии всех результатов, по каждой проверке от старых к новым
+func (r *CheckResultRepository) Snapshot() []*domain.CheckResult {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	checkIDs := make([]string, 0, len(r.byCheck))
+	for checkID := range r.byCheck {
+		checkIDs = append(checkIDs, checkID)
+	}
+	sort.Strings(checkIDs)
+
+	results := make([]*domain.CheckResult, 0, len(r.byID))
+	for _, checkID := range checkIDs {
+		for _, result := range r.byCheck[checkID] {
+			copied := *result
+			results = append(results, &copied)
+		}
+	}
+	return results
+}
+
+// Restore заменяет содержимое хранилища результатами из Snapshot. Для каждой проверки
+// остаются последние limit результатов, порядковые ID продолжаются после восстановленных
+func (r *CheckResultRepository) Restore(results []*domain.CheckResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byCheck = make(map[string][]*domain.CheckResult)
+	r.byID = make(map[string]*domain.CheckResult)
+	r.seq = 0
+	for _, result := range results {
+		restored := *result
+		if seq, err := strconv.ParseInt(restored.ID, 10, 64); err == nil && seq > r.seq {
+			r.seq = seq
+		}
+		stored := append(r.byCheck[restored.CheckID], &restored)
+		if len(stored) > r.limit {
+			delete(r.byID, stored[0].ID)
+			stored = stored[1:]
+		}
+		r.byCheck[restored.CheckID] = stored
+		r.byID[restored.ID] = &restored
+	}
+}
+
+// GetStats считает статистику результатов за период
+func (r *CheckResultRepository) GetStats(ctx context.Context, startTime, endTime time.Time) (*repository.ResultStats, error) {
+	stats := &repository.ResultStats{}
+	var totalDuration int64
+	for _, result := range r.find(startTime, endTime, 0, func(*domain.CheckResult) bool { return true }) {
+		stats.TotalChecks++
+		if result.Success {
+			stats.SuccessfulChecks++
+		} else {
+			stats.FailedChecks++
+		}
+		totalDuration += result.DurationMs
+	}
+	if stats.TotalChecks > 0 {
+		stats.AvgResponseTime = float64(totalDuration) / float64(stats.TotalChecks)
+		stats.UptimePercent = float64(stats.SuccessfulChecks) / float64(stats.TotalChecks) * 100
+	}
+	return stats, nil
+}
+
+// find возвращает копии результатов за период, подходящих под match, новые первыми
+func (r *CheckResultRepository) find(startTime, endTime time.Time, limit int, match func(*domain.CheckResult) bool) []*domain.CheckResult {
+	r.mu.RLock()
+	var results []*domain.CheckResult
+	for _, result := range r.byID {
+		if result.CheckedAt.Before(startTime) || result.CheckedAt.After(endTime) || !match(result) {
+			continue
+		}
+		copied := *result
+		results = append(results, &copied)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].CheckedAt.After(results[j].CheckedAt) })
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return results
+}
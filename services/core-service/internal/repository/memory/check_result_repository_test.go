@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+func TestCheckResultRepository_KeepsLatestResults(t *testing.T) {
+	ctx := context.Background()
+	repo := NewCheckResultRepository(2)
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, repo.Save(ctx, &domain.CheckResult{
+			CheckID:   "check-1",
+			Success:   i != 1,
+			CheckedAt: start.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+	require.NoError(t, repo.Save(ctx, &domain.CheckResult{CheckID: "check-2", CheckedAt: start}))
+
+	// Старший результат вытеснен, новые возвращаются первыми
+	results, err := repo.GetByCheckID(ctx, "check-1", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "3", results[0].ID)
+	assert.Equal(t, "2", results[1].ID)
+	_, err = repo.GetByID(ctx, "1")
+	assert.Error(t, err)
+
+	latest, err := repo.GetLatestByCheckID(ctx, "check-1")
+	require.NoError(t, err)
+	assert.True(t, latest.Success)
+
+	failed, err := repo.GetFailedChecks(ctx, start, start.Add(time.Hour), 0)
+	require.NoError(t, err)
+	require.Len(t, failed, 2)
+	assert.Equal(t, "2", failed[0].ID)
+
+	require.NoError(t, repo.DeleteOldResults(ctx, start.Add(90*time.Second)))
+	results, err = repo.GetByCheckID(ctx, "check-1", 0)
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+	_, err = repo.GetLatestByCheckID(ctx, "check-2")
+	assert.Error(t, err)
+}
+
+func TestCheckResultRepository_Page(t *testing.T) {
+	ctx := context.Background()
+	repo := NewCheckResultRepository(0)
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, repo.Save(ctx, &domain.CheckResult{
+			CheckID:   "check-1",
+			CheckedAt: start.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	page, err := repo.GetPageByCheckID(ctx, "check-1", 2, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "3", page[0].ID)
+	assert.Equal(t, "2", page[1].ID)
+
+	page, err = repo.GetPageByCheckID(ctx, "check-1", 2, 5)
+	require.NoError(t, err)
+	assert.Empty(t, page)
+
+	total, err := repo.CountByCheckID(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+}
+
+func TestCheckResultRepository_SnapshotRestore(t *testing.T) {
+	ctx := context.Background()
+	repo := NewCheckResultRepository(0)
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, repo.Save(ctx, &domain.CheckResult{CheckID: "check-1", CheckedAt: start.Add(time.Duration(i) * time.Minute)}))
+	}
+
+	// Восстановление в хранилище с меньшим лимитом оставляет последние результаты
+	restored := NewCheckResultRepository(2)
+	restored.Restore(repo.Snapshot())
+
+	results, err := restored.GetByCheckID(ctx, "check-1", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "3", results[0].ID)
+	assert.Equal(t, "2", results[1].ID)
+
+	// Новые результаты не переиспользуют восстановленные ID
+	require.NoError(t, restored.Save(ctx, &domain.CheckResult{CheckID: "check-1", CheckedAt: start.Add(time.Hour)}))
+	latest, err := restored.GetLatestByCheckID(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Equal(t, "4", latest.ID)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// ResultPartitionRepository управляет партициями таблицы check_results
+type ResultPartitionRepository interface {
+	// ListPartitions возвращает партиции check_results с их диапазонами
+	ListPartitions(ctx context.Context) ([]domain.ResultPartition, error)
+
+	// CreatePartition создает партицию, если ее еще нет
+	CreatePartition(ctx context.Context, partition domain.ResultPartition) error
+
+	// DropPartition удаляет партицию вместе с данными
+	DropPartition(ctx context.Context, name string) error
+}
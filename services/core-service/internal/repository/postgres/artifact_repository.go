@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// insertArtifactQuery запись метаданных артефакта; повторная загрузка обновляет строку
+const insertArtifactQuery = `
+	INSERT INTO check_artifacts (
+		key, check_id, execution_id, kind, name, content_type, size_bytes, created_at, expires_at
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	ON CONFLICT (key) DO UPDATE SET
+		content_type = EXCLUDED.content_type,
+		size_bytes = EXCLUDED.size_bytes,
+		expires_at = EXCLUDED.expires_at
+`
+
+// artifactColumns колонки check_artifacts в порядке scanArtifact
+const artifactColumns = `key, check_id, execution_id, kind, name, content_type, size_bytes, created_at, expires_at`
+
+// ArtifactRepository метаданные артефактов проверок в PostgreSQL
+type ArtifactRepository struct {
+	pool   *pgxpool.Pool
+	logger logger.Logger
+}
+
+// NewArtifactRepository создает репозиторий метаданных артефактов
+func NewArtifactRepository(pool *pgxpool.Pool, logger logger.Logger) repository.ArtifactRepository {
+	return &ArtifactRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// Save сохраняет метаданные артефактов одним пакетом запросов
+func (r *ArtifactRepository) Save(ctx context.Context, artifacts []domain.Artifact) error {
+	if len(artifacts) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, artifact := range artifacts {
+		batch.Queue(insertArtifactQuery,
+			artifact.Key,
+			artifact.CheckID,
+			artifact.ExecutionID,
+			string(artifact.Kind),
+			artifact.Name,
+			artifact.ContentType,
+			artifact.SizeBytes,
+			artifact.CreatedAt,
+			artifact.ExpiresAt,
+		)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		r.logger.Error("Failed to save check artifacts",
+			logger.String("check_id", artifacts[0].CheckID),
+			logger.Error(err),
+		)
+		return errors.Wrap(err, errors.ErrInternal, "failed to save check artifacts")
+	}
+	return nil
+}
+
+// ListByCheck возвращает неистекшие артефакты проверки, новые первыми
+func (r *ArtifactRepository) ListByCheck(ctx context.Context, checkID, executionID string, limit int) ([]domain.Artifact, error) {
+	query := `
+		SELECT ` + artifactColumns + `
+		FROM check_artifacts
+		WHERE check_id = $1 AND ($2 = '' OR execution_id = $2) AND expires_at > NOW()
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+	return r.query(ctx, "list check artifacts", query, checkID, executionID, limit)
+}
+
+// Get возвращает артефакт проверки по ключу
+func (r *ArtifactRepository) Get(ctx context.Context, checkID, key string) (*domain.Artifact, error) {
+	query := `SELECT ` + artifactColumns + ` FROM check_artifacts WHERE check_id = $1 AND key = $2`
+
+	artifact, err := scanArtifact(r.pool.QueryRow(ctx, query, checkID, key))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.New(errors.ErrNotFound, "artifact not found")
+		}
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get artifact").
+			WithDetails(fmt.Sprintf("check_id: %s", checkID))
+	}
+	return artifact, nil
+}
+
+// ListExpired возвращает артефакты с истекшим к before сроком хранения, старые первыми
+func (r *ArtifactRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]domain.Artifact, error) {
+	query := `
+		SELECT ` + artifactColumns + `
+		FROM check_artifacts
+		WHERE expires_at <= $1
+		ORDER BY expires_at
+		LIMIT $2
+	`
+	return r.query(ctx, "list expired artifacts", query, before, limit)
+}
+
+// Delete удаляет метаданные артефактов по ключам
+func (r *ArtifactRepository) Delete(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if _, err := r.pool.Exec(ctx, `DELETE FROM check_artifacts WHERE key = ANY($1)`, keys); err != nil {
+		r.logger.Error("Failed to delete check artifacts", logger.Error(err))
+		return errors.Wrap(err, errors.ErrInternal, "failed to delete check artifacts")
+	}
+	return nil
+}
+
+// query выполняет выборку артефактов
+func (r *ArtifactRepository) query(ctx context.Context, operation, query string, args ...interface{}) ([]domain.Artifact, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to "+operation, logger.Error(err))
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to "+operation)
+	}
+	defer rows.Close()
+
+	var artifacts []domain.Artifact
+	for rows.Next() {
+		artifact, err := scanArtifact(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan artifact")
+		}
+		artifacts = append(artifacts, *artifact)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to "+operation)
+	}
+	return artifacts, nil
+}
+
+// scanArtifact читает строку check_artifacts
+func scanArtifact(row pgx.Row) (*domain.Artifact, error) {
+	var (
+		artifact domain.Artifact
+		kind     string
+	)
+	if err := row.Scan(
+		&artifact.Key,
+		&artifact.CheckID,
+		&artifact.ExecutionID,
+		&kind,
+		&artifact.Name,
+		&artifact.ContentType,
+		&artifact.SizeBytes,
+		&artifact.CreatedAt,
+		&artifact.ExpiresAt,
+	); err != nil {
+		return nil, err
+	}
+	artifact.Kind = domain.ArtifactKind(kind)
+	return &artifact, nil
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// getCheckDefinitionQuery проверка для сверки задач и сборки их параметров
+const getCheckDefinitionQuery = `
+		SELECT id, tenant_id, type, target, timeout_seconds, config, enabled, config_version
+		FROM checks
+		WHERE id = $1
+	`
+
+// CheckDefinitionRepository читает проверки из общей с scheduler-service таблицы checks
+type CheckDefinitionRepository struct {
+	pool   *pgxpool.Pool
+	logger logger.Logger
+}
+
+// NewCheckDefinitionRepository создает репозиторий определений проверок
+func NewCheckDefinitionRepository(pool *pgxpool.Pool, logger logger.Logger) repository.CheckDefinitionRepository {
+	return &CheckDefinitionRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// GetDefinition возвращает проверку или nil, если проверка удалена
+func (r *CheckDefinitionRepository) GetDefinition(ctx context.Context, checkID string) (*domain.CheckDefinition, error) {
+	var definition domain.CheckDefinition
+	var config []byte
+	err := r.pool.QueryRow(ctx, getCheckDefinitionQuery, checkID).Scan(
+		&definition.CheckID,
+		&definition.TenantID,
+		&definition.Type,
+		&definition.Target,
+		&definition.TimeoutSeconds,
+		&config,
+		&definition.Enabled,
+		&definition.ConfigVersion,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get check definition")
+	}
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, &definition.Config); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to decode check config")
+		}
+	}
+	return &definition, nil
+}
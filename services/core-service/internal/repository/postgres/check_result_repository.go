@@ -1,10 +1,14 @@
 package postgres
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
+	"UptimePingPlatform/pkg/database"
 	"UptimePingPlatform/pkg/errors"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/core-service/internal/domain"
@@ -13,17 +17,83 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// CheckResultRepository реализация репозитория для PostgreSQL
+// insertCheckResultQuery запись результата, самый частый запрос к check_results.
+// Таблица партиционирована по created_at, поэтому он входит в ключ конфликта
+const insertCheckResultQuery = `
+		INSERT INTO check_results (
+			id, check_id, status, response_time, response_code, 
+			response_body, error_message, location, created_at, timings, failure_capture,
+			trigger, triggered_by, schema_version
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (id, created_at) DO UPDATE SET
+			status = EXCLUDED.status,
+			response_time = EXCLUDED.response_time,
+			response_code = EXCLUDED.response_code,
+			response_body = EXCLUDED.response_body,
+			error_message = EXCLUDED.error_message,
+			location = EXCLUDED.location,
+			created_at = EXCLUDED.created_at,
+			timings = EXCLUDED.timings,
+			failure_capture = EXCLUDED.failure_capture,
+			trigger = EXCLUDED.trigger,
+			triggered_by = EXCLUDED.triggered_by,
+			schema_version = EXCLUDED.schema_version
+	`
+
+// selectCheckResultsByCheckQuery последние результаты проверки
+const selectCheckResultsByCheckQuery = `
+		SELECT id, check_id, status, response_time, response_code, 
+			   response_body, error_message, location, created_at, timings, failure_capture,
+			   trigger, triggered_by, schema_version
+		FROM check_results 
+		WHERE check_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+// selectCheckResultsPageQuery страница истории проверки
+const selectCheckResultsPageQuery = `
+		SELECT id, check_id, status, response_time, response_code, 
+			   response_body, error_message, location, created_at, timings, failure_capture,
+			   trigger, triggered_by, schema_version
+		FROM check_results 
+		WHERE check_id = $1
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
+	`
+
+// countCheckResultsByCheckQuery число сохраненных результатов проверки
+const countCheckResultsByCheckQuery = `SELECT COUNT(*) FROM check_results WHERE check_id = $1`
+
+// CheckResultStatements горячие запросы репозитория для database.Config.PreparedStatements
+var CheckResultStatements = []string{
+	insertCheckResultQuery,
+	selectCheckResultsByCheckQuery,
+}
+
+// CheckResultRepository реализация репозитория для PostgreSQL.
+// Запись идет в pool, выборки в readPool
 type CheckResultRepository struct {
-	pool   *pgxpool.Pool
-	logger logger.Logger
+	pool     *pgxpool.Pool
+	readPool *pgxpool.Pool
+	logger   logger.Logger
 }
 
 // NewCheckResultRepository создает новый репозиторий
 func NewCheckResultRepository(pool *pgxpool.Pool, logger logger.Logger) repository.CheckResultRepository {
 	return &CheckResultRepository{
-		pool:   pool,
-		logger: logger,
+		pool:     pool,
+		readPool: pool,
+		logger:   logger,
+	}
+}
+
+// NewCheckResultRepositoryWithPools создает репозиторий с раздельными пулами записи и чтения
+func NewCheckResultRepositoryWithPools(pools *database.Pools, logger logger.Logger) repository.CheckResultRepository {
+	return &CheckResultRepository{
+		pool:     pools.Write,
+		readPool: pools.Read,
+		logger:   logger,
 	}
 }
 
@@ -34,21 +104,6 @@ func (r *CheckResultRepository) Save(ctx context.Context, result *domain.CheckRe
 		logger.String("execution_id", result.ExecutionID),
 	)
 
-	query := `
-		INSERT INTO check_results (
-			id, check_id, status, response_time, response_code, 
-			response_body, error_message, location, created_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		ON CONFLICT (id) DO UPDATE SET
-			status = EXCLUDED.status,
-			response_time = EXCLUDED.response_time,
-			response_code = EXCLUDED.response_code,
-			response_body = EXCLUDED.response_body,
-			error_message = EXCLUDED.error_message,
-			location = EXCLUDED.location,
-			created_at = EXCLUDED.created_at
-	`
-
 	// Конвертация статуса
 	status := "unknown"
 	if result.Success {
@@ -57,7 +112,17 @@ func (r *CheckResultRepository) Save(ctx context.Context, result *domain.CheckRe
 		status = "down"
 	}
 
-	_, err := r.pool.Exec(ctx, query,
+	timings, err := marshalTimings(result.Timings)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to marshal check timings")
+	}
+
+	capture, err := encodeCapture(result.Capture)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to encode failure capture")
+	}
+
+	_, err = r.pool.Exec(ctx, insertCheckResultQuery,
 		result.CheckID, // Используем CheckID как ID для простоты
 		result.CheckID,
 		status,
@@ -67,6 +132,11 @@ func (r *CheckResultRepository) Save(ctx context.Context, result *domain.CheckRe
 		result.Error,
 		result.CheckID, // location = check_id
 		result.CheckedAt,
+		timings,
+		capture,
+		nullString(string(result.Trigger)),
+		nullString(result.TriggeredBy),
+		domain.ResultSchemaVersion,
 	)
 
 	if err != nil {
@@ -92,7 +162,8 @@ func (r *CheckResultRepository) GetByID(ctx context.Context, id string) (*domain
 
 	query := `
 		SELECT id, check_id, status, response_time, response_code, 
-			   response_body, error_message, location, created_at
+			   response_body, error_message, location, created_at, timings, failure_capture,
+			   trigger, triggered_by, schema_version
 		FROM check_results 
 		WHERE id = $1
 	`
@@ -106,9 +177,14 @@ func (r *CheckResultRepository) GetByID(ctx context.Context, id string) (*domain
 		errorMessage  sql.NullString
 		location      string
 		createdAt     time.Time
+		timings       []byte
+		capture       []byte
+		trigger       sql.NullString
+		triggeredBy   sql.NullString
+		schemaVersion sql.NullInt16
 	)
 
-	err := r.pool.QueryRow(ctx, query, id).Scan(
+	err := r.readPool.QueryRow(ctx, query, id).Scan(
 		&id,
 		&checkID,
 		&status,
@@ -118,6 +194,11 @@ func (r *CheckResultRepository) GetByID(ctx context.Context, id string) (*domain
 		&errorMessage,
 		&location,
 		&createdAt,
+		&timings,
+		&capture,
+		&trigger,
+		&triggeredBy,
+		&schemaVersion,
 	)
 
 	if err != nil {
@@ -152,6 +233,12 @@ func (r *CheckResultRepository) GetByID(ctx context.Context, id string) (*domain
 		result.Error = errorMessage.String
 	}
 
+	result.Timings = unmarshalTimings(timings)
+	result.Capture = decodeCapture(capture)
+	result.Trigger = domain.ExecutionTrigger(trigger.String)
+	result.TriggeredBy = triggeredBy.String
+	result.SchemaVersion = resultSchemaVersion(schemaVersion)
+
 	return result, nil
 }
 
@@ -162,16 +249,36 @@ func (r *CheckResultRepository) GetByCheckID(ctx context.Context, checkID string
 		logger.Int("limit", limit),
 	)
 
-	query := `
-		SELECT id, check_id, status, response_time, response_code, 
-			   response_body, error_message, location, created_at
-		FROM check_results 
-		WHERE check_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2
-	`
+	return r.queryByCheck(ctx, checkID, selectCheckResultsByCheckQuery, checkID, limit)
+}
 
-	rows, err := r.pool.Query(ctx, query, checkID, limit)
+// GetPageByCheckID получает страницу результатов проверки, новые первыми
+func (r *CheckResultRepository) GetPageByCheckID(ctx context.Context, checkID string, limit, offset int) ([]*domain.CheckResult, error) {
+	r.logger.Debug("Getting check results page by check ID",
+		logger.String("check_id", checkID),
+		logger.Int("limit", limit),
+		logger.Int("offset", offset),
+	)
+
+	return r.queryByCheck(ctx, checkID, selectCheckResultsPageQuery, checkID, limit, offset)
+}
+
+// CountByCheckID возвращает число сохраненных результатов проверки
+func (r *CheckResultRepository) CountByCheckID(ctx context.Context, checkID string) (int, error) {
+	var count int
+	if err := r.readPool.QueryRow(ctx, countCheckResultsByCheckQuery, checkID).Scan(&count); err != nil {
+		r.logger.Error("Failed to count check results",
+			logger.String("check_id", checkID),
+			logger.Error(err),
+		)
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to count check results")
+	}
+	return count, nil
+}
+
+// queryByCheck выполняет выборку результатов проверки из readPool
+func (r *CheckResultRepository) queryByCheck(ctx context.Context, checkID, query string, args ...interface{}) ([]*domain.CheckResult, error) {
+	rows, err := r.readPool.Query(ctx, query, args...)
 	if err != nil {
 		r.logger.Error("Failed to query check results",
 			logger.String("check_id", checkID),
@@ -193,6 +300,11 @@ func (r *CheckResultRepository) GetByCheckID(ctx context.Context, checkID string
 			errorMessage  sql.NullString
 			location      string
 			createdAt     time.Time
+			timings       []byte
+			capture       []byte
+			trigger       sql.NullString
+			triggeredBy   sql.NullString
+			schemaVersion sql.NullInt16
 		)
 
 		if err := rows.Scan(
@@ -205,6 +317,11 @@ func (r *CheckResultRepository) GetByCheckID(ctx context.Context, checkID string
 			&errorMessage,
 			&location,
 			&createdAt,
+			&timings,
+			&capture,
+			&trigger,
+			&triggeredBy,
+			&schemaVersion,
 		); err != nil {
 			r.logger.Error("Failed to scan check result row",
 				logger.Error(err),
@@ -232,6 +349,12 @@ func (r *CheckResultRepository) GetByCheckID(ctx context.Context, checkID string
 			result.Error = errorMessage.String
 		}
 
+		result.Timings = unmarshalTimings(timings)
+		result.Capture = decodeCapture(capture)
+		result.Trigger = domain.ExecutionTrigger(trigger.String)
+		result.TriggeredBy = triggeredBy.String
+		result.SchemaVersion = resultSchemaVersion(schemaVersion)
+
 		results = append(results, result)
 	}
 
@@ -262,14 +385,15 @@ func (r *CheckResultRepository) GetByTimeRange(ctx context.Context, startTime, e
 
 	query := `
 		SELECT id, check_id, status, response_time, response_code, 
-			   response_body, error_message, location, created_at
+			   response_body, error_message, location, created_at, timings, failure_capture,
+			   trigger, triggered_by, schema_version
 		FROM check_results 
 		WHERE created_at BETWEEN $1 AND $2
 		ORDER BY created_at DESC
 		LIMIT $3
 	`
 
-	rows, err := r.pool.Query(ctx, query, startTime, endTime, limit)
+	rows, err := r.readPool.Query(ctx, query, startTime, endTime, limit)
 	if err != nil {
 		r.logger.Error("Failed to query check results by time range",
 			logger.Error(err),
@@ -290,6 +414,11 @@ func (r *CheckResultRepository) GetByTimeRange(ctx context.Context, startTime, e
 			errorMessage  sql.NullString
 			location      string
 			createdAt     time.Time
+			timings       []byte
+			capture       []byte
+			trigger       sql.NullString
+			triggeredBy   sql.NullString
+			schemaVersion sql.NullInt16
 		)
 
 		if err := rows.Scan(
@@ -302,6 +431,11 @@ func (r *CheckResultRepository) GetByTimeRange(ctx context.Context, startTime, e
 			&errorMessage,
 			&location,
 			&createdAt,
+			&timings,
+			&capture,
+			&trigger,
+			&triggeredBy,
+			&schemaVersion,
 		); err != nil {
 			r.logger.Error("Failed to scan check result row",
 				logger.Error(err),
@@ -329,6 +463,12 @@ func (r *CheckResultRepository) GetByTimeRange(ctx context.Context, startTime, e
 			result.Error = errorMessage.String
 		}
 
+		result.Timings = unmarshalTimings(timings)
+		result.Capture = decodeCapture(capture)
+		result.Trigger = domain.ExecutionTrigger(trigger.String)
+		result.TriggeredBy = triggeredBy.String
+		result.SchemaVersion = resultSchemaVersion(schemaVersion)
+
 		results = append(results, result)
 	}
 
@@ -345,14 +485,15 @@ func (r *CheckResultRepository) GetFailedChecks(ctx context.Context, startTime,
 
 	query := `
 		SELECT id, check_id, status, response_time, response_code, 
-			   response_body, error_message, location, created_at
+			   response_body, error_message, location, created_at, timings, failure_capture,
+			   trigger, triggered_by, schema_version
 		FROM check_results 
 		WHERE created_at BETWEEN $1 AND $2 AND status = 'down'
 		ORDER BY created_at DESC
 		LIMIT $3
 	`
 
-	rows, err := r.pool.Query(ctx, query, startTime, endTime, limit)
+	rows, err := r.readPool.Query(ctx, query, startTime, endTime, limit)
 	if err != nil {
 		r.logger.Error("Failed to query failed check results",
 			logger.Error(err),
@@ -373,6 +514,11 @@ func (r *CheckResultRepository) GetFailedChecks(ctx context.Context, startTime,
 			errorMessage  sql.NullString
 			location      string
 			createdAt     time.Time
+			timings       []byte
+			capture       []byte
+			trigger       sql.NullString
+			triggeredBy   sql.NullString
+			schemaVersion sql.NullInt16
 		)
 
 		if err := rows.Scan(
@@ -385,6 +531,11 @@ func (r *CheckResultRepository) GetFailedChecks(ctx context.Context, startTime,
 			&errorMessage,
 			&location,
 			&createdAt,
+			&timings,
+			&capture,
+			&trigger,
+			&triggeredBy,
+			&schemaVersion,
 		); err != nil {
 			r.logger.Error("Failed to scan check result row",
 				logger.Error(err),
@@ -412,6 +563,12 @@ func (r *CheckResultRepository) GetFailedChecks(ctx context.Context, startTime,
 			result.Error = errorMessage.String
 		}
 
+		result.Timings = unmarshalTimings(timings)
+		result.Capture = decodeCapture(capture)
+		result.Trigger = domain.ExecutionTrigger(trigger.String)
+		result.TriggeredBy = triggeredBy.String
+		result.SchemaVersion = resultSchemaVersion(schemaVersion)
+
 		results = append(results, result)
 	}
 
@@ -442,6 +599,30 @@ func (r *CheckResultRepository) DeleteOldResults(ctx context.Context, olderThan
 	return nil
 }
 
+// PurgeCaptures удаляет диагностические снимки неудачных проверок старше olderThan, сохраняя сами результаты
+func (r *CheckResultRepository) PurgeCaptures(ctx context.Context, olderThan time.Time) (int64, error) {
+	r.logger.Debug("Purging failure captures",
+		logger.String("older_than", olderThan.String()),
+	)
+
+	query := `UPDATE check_results SET failure_capture = NULL WHERE failure_capture IS NOT NULL AND created_at < $1`
+
+	cmdTag, err := r.pool.Exec(ctx, query, olderThan)
+	if err != nil {
+		r.logger.Error("Failed to purge failure captures",
+			logger.Error(err),
+		)
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to purge failure captures")
+	}
+
+	r.logger.Info("Failure captures purged",
+		logger.Int64("purged_count", cmdTag.RowsAffected()),
+		logger.String("older_than", olderThan.String()),
+	)
+
+	return cmdTag.RowsAffected(), nil
+}
+
 // GetStats получает статистику по результатам
 func (r *CheckResultRepository) GetStats(ctx context.Context, startTime, endTime time.Time) (*repository.ResultStats, error) {
 	r.logger.Debug("Getting check result statistics",
@@ -468,7 +649,7 @@ func (r *CheckResultRepository) GetStats(ctx context.Context, startTime, endTime
 		avgResponseTime   sql.NullFloat64
 	)
 
-	err := r.pool.QueryRow(ctx, query, startTime, endTime).Scan(
+	err := r.readPool.QueryRow(ctx, query, startTime, endTime).Scan(
 		&totalChecks,
 		&successfulChecks,
 		&failedChecks,
@@ -501,3 +682,75 @@ func (r *CheckResultRepository) GetStats(ctx context.Context, startTime, endTime
 
 	return stats, nil
 }
+
+// resultSchemaVersion версия схемы строки; отсутствующая версия считается базовой
+func resultSchemaVersion(version sql.NullInt16) int {
+	if !version.Valid {
+		return domain.ResultSchemaBase
+	}
+	return int(version.Int16)
+}
+
+// nullString сохраняет пустую строку как NULL
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// marshalTimings сериализует длительности фаз в JSONB; nil сохраняется как NULL
+func marshalTimings(timings *domain.CheckTimings) ([]byte, error) {
+	if timings == nil {
+		return nil, nil
+	}
+	return json.Marshal(timings)
+}
+
+// unmarshalTimings восстанавливает длительности фаз из JSONB; поврежденные данные игнорируются
+func unmarshalTimings(data []byte) *domain.CheckTimings {
+	if len(data) == 0 {
+		return nil
+	}
+	var timings domain.CheckTimings
+	if err := json.Unmarshal(data, &timings); err != nil {
+		return nil
+	}
+	return &timings
+}
+
+// encodeCapture сериализует снимок неудачной проверки в JSON и сжимает gzip; nil сохраняется как NULL
+func encodeCapture(capture *domain.FailureCapture) ([]byte, error) {
+	if capture == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(capture)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeCapture распаковывает снимок неудачной проверки; поврежденные данные игнорируются
+func decodeCapture(data []byte) *domain.FailureCapture {
+	if len(data) == 0 {
+		return nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	defer reader.Close()
+
+	var capture domain.FailureCapture
+	if err := json.NewDecoder(reader).Decode(&capture); err != nil {
+		return nil
+	}
+	return &capture
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// listPartitionsQuery партиции check_results с выражениями их границ
+const listPartitionsQuery = `
+		SELECT c.relname, pg_get_expr(c.relpartbound, c.oid)
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'check_results'::regclass
+		ORDER BY c.relname
+	`
+
+// partitionBoundPattern разбирает границы вида FOR VALUES FROM ('...') TO ('...')
+var partitionBoundPattern = regexp.MustCompile(`^FOR VALUES FROM \((.+)\) TO \((.+)\)$`)
+
+// partitionBoundLayouts форматы timestamptz в выводе pg_get_expr
+var partitionBoundLayouts = []string{
+	"2006-01-02 15:04:05-07",
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05-07:00:00",
+}
+
+// PartitionRepository управляет партициями check_results в PostgreSQL
+type PartitionRepository struct {
+	pool   *pgxpool.Pool
+	logger logger.Logger
+}
+
+// NewPartitionRepository создает репозиторий партиций
+func NewPartitionRepository(pool *pgxpool.Pool, logger logger.Logger) repository.ResultPartitionRepository {
+	return &PartitionRepository{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// ListPartitions возвращает партиции check_results с их диапазонами
+func (r *PartitionRepository) ListPartitions(ctx context.Context) ([]domain.ResultPartition, error) {
+	rows, err := r.pool.Query(ctx, listPartitionsQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list check result partitions")
+	}
+	defer rows.Close()
+
+	var partitions []domain.ResultPartition
+	for rows.Next() {
+		var name, bound string
+		if err := rows.Scan(&name, &bound); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan check result partition")
+		}
+
+		partition, err := parsePartitionBound(name, bound)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to parse check result partition bound").
+				WithDetails(fmt.Sprintf("partition: %s", name))
+		}
+		partitions = append(partitions, partition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list check result partitions")
+	}
+
+	return partitions, nil
+}
+
+// CreatePartition создает партицию, если ее еще нет
+func (r *PartitionRepository) CreatePartition(ctx context.Context, partition domain.ResultPartition) error {
+	query := fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s PARTITION OF check_results FOR VALUES FROM (%s) TO (%s)",
+		pgx.Identifier{partition.Name}.Sanitize(),
+		partitionBoundLiteral(partition.From, "MINVALUE"),
+		partitionBoundLiteral(partition.To, "MAXVALUE"),
+	)
+
+	if _, err := r.pool.Exec(ctx, query); err != nil {
+		r.logger.Error("Failed to create check result partition",
+			logger.String("partition", partition.Name),
+			logger.Error(err),
+		)
+		return errors.Wrap(err, errors.ErrInternal, "failed to create check result partition").
+			WithDetails(fmt.Sprintf("partition: %s", partition.Name))
+	}
+
+	r.logger.Info("Check result partition ensured",
+		logger.String("partition", partition.Name),
+	)
+	return nil
+}
+
+// DropPartition удаляет партицию вместе с данными
+func (r *PartitionRepository) DropPartition(ctx context.Context, name string) error {
+	query := "DROP TABLE IF EXISTS " + pgx.Identifier{name}.Sanitize()
+
+	if _, err := r.pool.Exec(ctx, query); err != nil {
+		r.logger.Error("Failed to drop check result partition",
+			logger.String("partition", name),
+			logger.Error(err),
+		)
+		return errors.Wrap(err, errors.ErrInternal, "failed to drop check result partition").
+			WithDetails(fmt.Sprintf("partition: %s", name))
+	}
+
+	r.logger.Info("Check result partition dropped",
+		logger.String("partition", name),
+	)
+	return nil
+}
+
+// parsePartitionBound разбирает вывод pg_get_expr для границ партиции
+func parsePartitionBound(name, bound string) (domain.ResultPartition, error) {
+	partition := domain.ResultPartition{Name: name}
+	if bound == "DEFAULT" {
+		partition.Default = true
+		return partition, nil
+	}
+
+	match := partitionBoundPattern.FindStringSubmatch(bound)
+	if match == nil {
+		return partition, fmt.Errorf("unsupported partition bound: %s", bound)
+	}
+
+	var err error
+	if partition.From, err = parsePartitionBoundValue(match[1]); err != nil {
+		return partition, err
+	}
+	if partition.To, err = parsePartitionBoundValue(match[2]); err != nil {
+		return partition, err
+	}
+	return partition, nil
+}
+
+// parsePartitionBoundValue разбирает значение границы; MINVALUE и MAXVALUE дают нулевое время
+func parsePartitionBoundValue(value string) (time.Time, error) {
+	if value == "MINVALUE" || value == "MAXVALUE" {
+		return time.Time{}, nil
+	}
+
+	literal := strings.Trim(value, "'")
+	for _, layout := range partitionBoundLayouts {
+		if t, err := time.Parse(layout, literal); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unsupported partition bound value: %s", value)
+}
+
+// partitionBoundLiteral значение границы для DDL; нулевое время заменяется на unbounded
+func partitionBoundLiteral(t time.Time, unbounded string) string {
+	if t.IsZero() {
+		return unbounded
+	}
+	return "'" + t.UTC().Format(time.RFC3339) + "'"
+}
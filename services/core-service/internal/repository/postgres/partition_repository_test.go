@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePartitionBound(t *testing.T) {
+	partition, err := parsePartitionBound("check_results_p20261016",
+		"FOR VALUES FROM ('2026-10-16 00:00:00+00') TO ('2026-10-17 00:00:00+00')")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), partition.From)
+	assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), partition.To)
+	assert.False(t, partition.Default)
+
+	// Границы выводятся в часовом поясе сессии
+	partition, err = parsePartitionBound("check_results_legacy",
+		"FOR VALUES FROM (MINVALUE) TO ('2026-10-16 05:30:00+05:30')")
+	require.NoError(t, err)
+	assert.True(t, partition.From.IsZero())
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), partition.To)
+
+	partition, err = parsePartitionBound("check_results_default", "DEFAULT")
+	require.NoError(t, err)
+	assert.True(t, partition.Default)
+
+	_, err = parsePartitionBound("check_results_list", "FOR VALUES IN ('up')")
+	assert.Error(t, err)
+	_, err = parsePartitionBound("check_results_p1", "FOR VALUES FROM ('yesterday') TO (MAXVALUE)")
+	assert.Error(t, err)
+}
+
+func TestPartitionBoundLiteral(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	assert.Equal(t, "'2026-10-16T00:00:00Z'", partitionBoundLiteral(time.Date(2026, 10, 16, 3, 0, 0, 0, moscow), "MINVALUE"))
+	assert.Equal(t, "MAXVALUE", partitionBoundLiteral(time.Time{}, "MAXVALUE"))
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// countResultsByVersionQuery число строк check_results по версиям схемы
+const countResultsByVersionQuery = `SELECT schema_version, COUNT(*) FROM check_results GROUP BY schema_version`
+
+// resultSchemaUpgrades выражения SET, заполняющие поля версии from+1 по сохраненным полям
+// строки версии from. Пустое выражение - поле нельзя восстановить для истории, строка только
+// получает новую версию
+var resultSchemaUpgrades = map[int]string{
+	// До фаз запроса известна только общая длительность
+	domain.ResultSchemaBase: `timings = COALESCE(timings, jsonb_build_object('total_ms', ROUND(response_time * 1000)::bigint))`,
+	// Снимки ответов старых неудачных проверок не сохранялись
+	domain.ResultSchemaTimings: "",
+	// Причина запуска старых выполнений неизвестна
+	domain.ResultSchemaCapture: "",
+}
+
+// ResultSchemaRepository переводит строки check_results на новые версии схемы в PostgreSQL
+type ResultSchemaRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewResultSchemaRepository создает репозиторий версий схемы результатов
+func NewResultSchemaRepository(pool *pgxpool.Pool) repository.ResultSchemaRepository {
+	return &ResultSchemaRepository{pool: pool}
+}
+
+// CountByVersion возвращает число строк по версиям схемы
+func (r *ResultSchemaRepository) CountByVersion(ctx context.Context) (map[int]int64, error) {
+	rows, err := r.pool.Query(ctx, countResultsByVersionQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to count check results by schema version")
+	}
+	defer rows.Close()
+
+	counts := make(map[int]int64)
+	for rows.Next() {
+		var (
+			version int16
+			count   int64
+		)
+		if err := rows.Scan(&version, &count); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan check result schema version")
+		}
+		counts[int(version)] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to count check results by schema version")
+	}
+	return counts, nil
+}
+
+// UpgradeBatch переводит до limit строк с версии from на from+1. Строки выбираются по
+// первичному ключу (id, created_at), чтобы пакет не держал блокировки всей партиции
+func (r *ResultSchemaRepository) UpgradeBatch(ctx context.Context, from, limit int) (int64, error) {
+	query, err := upgradeResultSchemaQuery(from)
+	if err != nil {
+		return 0, err
+	}
+	tag, err := r.pool.Exec(ctx, query, from, from+1, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to upgrade check result schema").
+			WithDetails(fmt.Sprintf("from version: %d", from))
+	}
+	return tag.RowsAffected(), nil
+}
+
+// upgradeResultSchemaQuery запрос перевода пакета строк с версии from; параметры - версия
+// from, новая версия и размер пакета
+func upgradeResultSchemaQuery(from int) (string, error) {
+	set, ok := resultSchemaUpgrades[from]
+	if !ok {
+		return "", errors.New(errors.ErrValidation, "unknown check result schema version").
+			WithDetails(fmt.Sprintf("version: %d", from))
+	}
+	if set != "" {
+		set += ", "
+	}
+	return fmt.Sprintf(`
+		UPDATE check_results SET %sschema_version = $2
+		WHERE (id, created_at) IN (
+			SELECT id, created_at FROM check_results WHERE schema_version = $1 LIMIT $3
+		)
+	`, set), nil
+}
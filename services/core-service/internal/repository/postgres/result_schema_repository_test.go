@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+func TestUpgradeResultSchemaQuery(t *testing.T) {
+	// Для каждой версии, кроме текущей, есть перевод на следующую
+	for from := domain.ResultSchemaBase; from < domain.ResultSchemaVersion; from++ {
+		query, err := upgradeResultSchemaQuery(from)
+		require.NoError(t, err, from)
+		assert.Contains(t, query, "schema_version = $2")
+		assert.Contains(t, query, "WHERE schema_version = $1 LIMIT $3")
+	}
+
+	query, err := upgradeResultSchemaQuery(domain.ResultSchemaBase)
+	require.NoError(t, err)
+	assert.Contains(t, query, "SET timings = COALESCE(timings, ")
+
+	query, err = upgradeResultSchemaQuery(domain.ResultSchemaCapture)
+	require.NoError(t, err)
+	assert.Contains(t, query, "SET schema_version = $2")
+
+	_, err = upgradeResultSchemaQuery(domain.ResultSchemaVersion)
+	assert.Error(t, err)
+}
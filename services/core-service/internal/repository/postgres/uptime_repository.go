@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/database"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// rollupUptimeDayQuery пересчитывает сводки за сутки [$1, $2). Каждый результат покрывает
+// время до следующего результата проверки, но не больше $3 секунд и не дальше текущего
+// момента; покрытие обрезается границами суток. Успешный результат с временем ответа
+// от $4 секунд (при $4 > 0) считается degraded, неуспешный - down
+const rollupUptimeDayQuery = `
+	WITH samples AS (
+		SELECT check_id, created_at, status, response_time,
+			LEAD(created_at) OVER (PARTITION BY check_id ORDER BY created_at) AS next_at
+		FROM check_results
+		WHERE created_at >= $1::timestamptz - make_interval(secs => $3)
+			AND created_at < $2::timestamptz + make_interval(secs => $3)
+	),
+	spans AS (
+		SELECT check_id, created_at, status, response_time,
+			GREATEST(0, EXTRACT(EPOCH FROM
+				LEAST(COALESCE(next_at, 'infinity'), created_at + make_interval(secs => $3), $2::timestamptz, NOW())
+				- GREATEST(created_at, $1::timestamptz)
+			)) AS seconds,
+			status <> 'up' AS failed,
+			status = 'up' AND $4::float8 > 0 AND response_time >= $4::float8 AS degraded,
+			created_at >= $1::timestamptz AND created_at < $2::timestamptz AS in_day
+		FROM samples
+	)
+	INSERT INTO check_uptime_daily (
+		check_id, day, operational_seconds, degraded_seconds, down_seconds,
+		total_checks, failed_checks, avg_response_time_ms, updated_at
+	)
+	SELECT check_id, $5::date,
+		ROUND(COALESCE(SUM(seconds) FILTER (WHERE NOT failed AND NOT degraded), 0))::bigint,
+		ROUND(COALESCE(SUM(seconds) FILTER (WHERE degraded), 0))::bigint,
+		ROUND(COALESCE(SUM(seconds) FILTER (WHERE failed), 0))::bigint,
+		COUNT(*) FILTER (WHERE in_day),
+		COUNT(*) FILTER (WHERE in_day AND failed),
+		COALESCE(ROUND(AVG(response_time * 1000) FILTER (WHERE in_day)), 0)::integer,
+		NOW()
+	FROM spans
+	GROUP BY check_id
+	HAVING SUM(seconds) > 0 OR COUNT(*) FILTER (WHERE in_day) > 0
+	ON CONFLICT (check_id, day) DO UPDATE SET
+		operational_seconds = EXCLUDED.operational_seconds,
+		degraded_seconds = EXCLUDED.degraded_seconds,
+		down_seconds = EXCLUDED.down_seconds,
+		total_checks = EXCLUDED.total_checks,
+		failed_checks = EXCLUDED.failed_checks,
+		avg_response_time_ms = EXCLUDED.avg_response_time_ms,
+		updated_at = EXCLUDED.updated_at
+`
+
+// selectDailyUptimeQuery сводки проверок за диапазон суток
+const selectDailyUptimeQuery = `
+	SELECT check_id, day, operational_seconds, degraded_seconds, down_seconds,
+		total_checks, failed_checks, avg_response_time_ms, updated_at
+	FROM check_uptime_daily
+	WHERE check_id = ANY($1::uuid[]) AND day BETWEEN $2::date AND $3::date
+	ORDER BY day, check_id
+`
+
+// UptimeRepository дневные сводки доступности в PostgreSQL.
+// Пересчет идет в pool, выборки в readPool
+type UptimeRepository struct {
+	pool     *pgxpool.Pool
+	readPool *pgxpool.Pool
+	logger   logger.Logger
+}
+
+// NewUptimeRepository создает репозиторий сводок доступности
+func NewUptimeRepository(pool *pgxpool.Pool, logger logger.Logger) repository.UptimeRepository {
+	return &UptimeRepository{
+		pool:     pool,
+		readPool: pool,
+		logger:   logger,
+	}
+}
+
+// NewUptimeRepositoryWithPools создает репозиторий с раздельными пулами записи и чтения
+func NewUptimeRepositoryWithPools(pools *database.Pools, logger logger.Logger) repository.UptimeRepository {
+	return &UptimeRepository{
+		pool:     pools.Write,
+		readPool: pools.Read,
+		logger:   logger,
+	}
+}
+
+// RollupDay пересчитывает сводки за сутки day всех проверок с результатами в эти сутки
+func (r *UptimeRepository) RollupDay(ctx context.Context, day time.Time, opts repository.UptimeRollupOptions) (int64, error) {
+	from := domain.PartitionDay(day)
+	to := from.AddDate(0, 0, 1)
+
+	tag, err := r.pool.Exec(ctx, rollupUptimeDayQuery,
+		from,
+		to,
+		opts.MaxSampleGap.Seconds(),
+		opts.DegradedResponseTime.Seconds(),
+		from,
+	)
+	if err != nil {
+		r.logger.Error("Failed to roll up daily uptime",
+			logger.String("day", from.Format(time.DateOnly)),
+			logger.Error(err),
+		)
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to roll up daily uptime").
+			WithDetails(fmt.Sprintf("day: %s", from.Format(time.DateOnly)))
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// GetDailyUptime возвращает сводки проверок за сутки с from по to включительно
+func (r *UptimeRepository) GetDailyUptime(ctx context.Context, checkIDs []string, from, to time.Time) ([]domain.UptimeDay, error) {
+	if len(checkIDs) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.readPool.Query(ctx, selectDailyUptimeQuery, checkIDs, domain.PartitionDay(from), domain.PartitionDay(to))
+	if err != nil {
+		r.logger.Error("Failed to query daily uptime", logger.Error(err))
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to query daily uptime")
+	}
+	defer rows.Close()
+
+	var days []domain.UptimeDay
+	for rows.Next() {
+		var day domain.UptimeDay
+		if err := rows.Scan(
+			&day.CheckID,
+			&day.Day,
+			&day.OperationalSeconds,
+			&day.DegradedSeconds,
+			&day.DownSeconds,
+			&day.TotalChecks,
+			&day.FailedChecks,
+			&day.AvgResponseTimeMs,
+			&day.UpdatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan daily uptime")
+		}
+		days = append(days, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to query daily uptime")
+	}
+
+	return days, nil
+}
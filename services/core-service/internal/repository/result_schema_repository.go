@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+// ResultSchemaRepository переводит строки check_results на новые версии схемы
+type ResultSchemaRepository interface {
+	// CountByVersion возвращает число строк по версиям схемы
+	CountByVersion(ctx context.Context) (map[int]int64, error)
+
+	// UpgradeBatch переводит до limit строк с версии from на from+1 и возвращает их число
+	UpgradeBatch(ctx context.Context, from, limit int) (int64, error)
+}
@@ -0,0 +1,263 @@
+// Package s3 хранит артефакты проверок в S3-совместимом хранилище (AWS S3, MinIO).
+// Запросы подписываются AWS Signature Version 4
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "s3"
+	amzDateFormat    = "20060102T150405Z"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	// maxSignedURLTTL наибольший срок действия подписанной ссылки SigV4
+	maxSignedURLTTL = 7 * 24 * time.Hour
+)
+
+// Config параметры подключения к хранилищу
+type Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle адресует bucket в пути (MinIO), иначе в имени хоста
+	PathStyle bool
+}
+
+// ArtifactStore хранилище артефактов в S3-совместимом bucket
+type ArtifactStore struct {
+	config   Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewArtifactStore создает хранилище артефактов
+func NewArtifactStore(config Config, client *http.Client) (repository.ArtifactStore, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, errors.New(errors.ErrValidation, "invalid artifact storage endpoint").
+			WithDetails(fmt.Sprintf("endpoint: %q", config.Endpoint))
+	}
+	if config.Bucket == "" {
+		return nil, errors.New(errors.ErrValidation, "artifact storage bucket is required")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &ArtifactStore{
+		config:   config,
+		endpoint: endpoint,
+		client:   client,
+		now:      time.Now,
+	}, nil
+}
+
+// Put загружает объект
+func (s *ArtifactStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to build artifact upload request")
+	}
+	req.ContentLength = int64(len(data))
+	req.Header.Set("Content-Type", contentType)
+
+	sum := sha256.Sum256(data)
+	return s.do(req, hex.EncodeToString(sum[:]), "upload artifact", http.StatusOK)
+}
+
+// Delete удаляет объект; S3 отвечает 204 и для отсутствующего объекта
+func (s *ArtifactStore) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to build artifact delete request")
+	}
+	return s.do(req, emptyPayloadHash, "delete artifact", http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+// SignedURL возвращает подписанную ссылку на чтение объекта
+func (s *ArtifactStore) SignedURL(key string, ttl time.Duration) (string, error) {
+	if ttl <= 0 || ttl > maxSignedURLTTL {
+		return "", errors.New(errors.ErrValidation, "invalid signed URL lifetime").
+			WithDetails(fmt.Sprintf("ttl must be between 1s and %s", maxSignedURLTTL))
+	}
+
+	now := s.now().UTC()
+	target := s.objectURL(key)
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", s.config.AccessKeyID+"/"+s.scope(now))
+	query.Set("X-Amz-Date", now.Format(amzDateFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(ttl.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	target.RawQuery = canonicalQuery(query)
+
+	canonical := strings.Join([]string{
+		http.MethodGet,
+		target.EscapedPath(),
+		target.RawQuery,
+		"host:" + target.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+	target.RawQuery += "&X-Amz-Signature=" + s.signature(now, canonical)
+	return target.String(), nil
+}
+
+// emptyPayloadHash SHA-256 пустого тела
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// do подписывает и выполняет запрос; ответ с другим кодом считается ошибкой
+func (s *ArtifactStore) do(req *http.Request, payloadHash, operation string, expected ...int) error {
+	s.sign(req, payloadHash, s.now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to "+operation)
+	}
+	defer resp.Body.Close()
+
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			io.Copy(io.Discard, resp.Body)
+			return nil
+		}
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return errors.New(errors.ErrInternal, "failed to "+operation).
+		WithDetails(fmt.Sprintf("status: %d, response: %s", resp.StatusCode, strings.TrimSpace(string(body))))
+}
+
+// objectURL адрес объекта с учетом стиля адресации bucket
+func (s *ArtifactStore) objectURL(key string) *url.URL {
+	target := *s.endpoint
+	base := strings.TrimSuffix(target.Path, "/")
+	if s.config.PathStyle {
+		target.Path = base + "/" + s.config.Bucket + "/" + key
+	} else {
+		target.Host = s.config.Bucket + "." + target.Host
+		target.Path = base + "/" + key
+	}
+	target.RawPath = escapePath(target.Path)
+	return &target
+}
+
+// sign добавляет заголовок Authorization; подписываются host и все заголовки запроса
+func (s *ArtifactStore) sign(req *http.Request, payloadHash string, now time.Time) {
+	req.Header.Set("X-Amz-Date", now.Format(amzDateFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonical := strings.Join([]string{
+		req.Method,
+		escapePath(req.URL.Path),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.config.AccessKeyID, s.scope(now), signedHeaders, s.signature(now, canonical)))
+}
+
+// scope область действия подписи: дата, регион, сервис
+func (s *ArtifactStore) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.config.Region + "/" + signingService + "/aws4_request"
+}
+
+// signature подпись канонического запроса ключом, производным от секрета
+func (s *ArtifactStore) signature(now time.Time, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		now.Format(amzDateFormat),
+		s.scope(now),
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), now.Format("20060102"))
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, signingService)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery параметры, отсортированные по имени и закодированные по правилам SigV4
+func canonicalQuery(values url.Values) string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pairs []string
+	for _, name := range names {
+		for _, value := range values[name] {
+			pairs = append(pairs, escape(name, false)+"="+escape(value, false))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// escapePath кодирует путь объекта, сохраняя разделители
+func escapePath(path string) string {
+	return escape(path, true)
+}
+
+// escape кодирует все символы, кроме незарезервированных (RFC 3986), и при keepSlash - '/'
+func escape(value string, keepSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', keepSlash && c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// UptimeRollupOptions параметры пересчета дневных сводок доступности
+type UptimeRollupOptions struct {
+	// DegradedResponseTime время ответа успешной проверки, начиная с которого она считается degraded;
+	// ноль отключает категорию degraded
+	DegradedResponseTime time.Duration
+	// MaxSampleGap наибольшее время, которое покрывает один результат при отсутствии следующего
+	MaxSampleGap time.Duration
+}
+
+// UptimeRepository хранит дневные сводки доступности проверок (check_uptime_daily)
+type UptimeRepository interface {
+	// RollupDay пересчитывает по check_results сводки за сутки day всех проверок,
+	// у которых есть результаты в эти сутки, и возвращает число обновленных сводок
+	RollupDay(ctx context.Context, day time.Time, opts UptimeRollupOptions) (int64, error)
+
+	// GetDailyUptime возвращает сводки проверок за сутки с from по to включительно
+	GetDailyUptime(ctx context.Context, checkIDs []string, from, to time.Time) ([]domain.UptimeDay, error)
+}
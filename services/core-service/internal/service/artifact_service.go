@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+const (
+	// DefaultArtifactTTL срок хранения артефактов по умолчанию
+	DefaultArtifactTTL = 7 * 24 * time.Hour
+	// DefaultArtifactURLTTL срок действия подписанной ссылки по умолчанию
+	DefaultArtifactURLTTL = 15 * time.Minute
+	// maxArtifactsPerList наибольшее число артефактов в одном ответе
+	maxArtifactsPerList = 100
+)
+
+// ArtifactService выносит крупные результаты проверок во внешнее хранилище, оставляя
+// в check_results только сами результаты, и выдает подписанные ссылки на артефакты
+type ArtifactService struct {
+	store  repository.ArtifactStore
+	repo   repository.ArtifactRepository
+	ttl    time.Duration
+	urlTTL time.Duration
+	logger logger.Logger
+	now    func() time.Time
+}
+
+// NewArtifactService создает сервис артефактов; нулевые сроки заменяются значениями по умолчанию
+func NewArtifactService(store repository.ArtifactStore, repo repository.ArtifactRepository, ttl, urlTTL time.Duration, log logger.Logger) *ArtifactService {
+	if ttl <= 0 {
+		ttl = DefaultArtifactTTL
+	}
+	if urlTTL <= 0 {
+		urlTTL = DefaultArtifactURLTTL
+	}
+	return &ArtifactService{
+		store:  store,
+		repo:   repo,
+		ttl:    ttl,
+		urlTTL: urlTTL,
+		logger: log,
+		now:    time.Now,
+	}
+}
+
+// Offload загружает артефакты результата и диагностический снимок в хранилище. В result
+// остаются ссылки на загруженные артефакты, возвращается копия для записи в БД без
+// вынесенного снимка. Если загрузка не удалась, снимок сохраняется в БД как раньше
+func (s *ArtifactService) Offload(ctx context.Context, result *domain.CheckResult) *domain.CheckResult {
+	pending := result.Artifacts
+	if result.Capture != nil {
+		data, err := json.Marshal(result.Capture)
+		if err == nil {
+			pending = append(pending, domain.Artifact{
+				CheckID:     result.CheckID,
+				ExecutionID: result.ExecutionID,
+				Kind:        domain.ArtifactCapture,
+				Name:        "capture.json",
+				ContentType: "application/json",
+				SizeBytes:   int64(len(data)),
+				Data:        data,
+			})
+		}
+	}
+
+	now := s.now().UTC()
+	uploaded := make([]domain.Artifact, 0, len(pending))
+	for _, artifact := range pending {
+		if artifact.Data == nil {
+			// Ссылка на уже загруженный артефакт
+			uploaded = append(uploaded, artifact)
+			continue
+		}
+		artifact.Key = domain.ArtifactKey(result.CheckID, result.ExecutionID, artifact.Name, now)
+		artifact.CreatedAt = now
+		artifact.ExpiresAt = now.Add(s.ttl)
+		if err := s.store.Put(ctx, artifact.Key, artifact.ContentType, artifact.Data); err != nil {
+			s.logger.Warn("Failed to upload check artifact",
+				logger.String("check_id", result.CheckID),
+				logger.String("kind", string(artifact.Kind)),
+				logger.Error(err),
+			)
+			continue
+		}
+		artifact.Data = nil
+		uploaded = append(uploaded, artifact)
+	}
+
+	if err := s.repo.Save(ctx, uploaded); err != nil {
+		s.logger.Warn("Failed to save check artifacts, keeping capture inline",
+			logger.String("check_id", result.CheckID),
+			logger.Error(err),
+		)
+		s.discard(ctx, uploaded)
+		result.Artifacts = nil
+		return result
+	}
+	result.Artifacts = uploaded
+
+	stored := *result
+	for _, artifact := range uploaded {
+		if artifact.Kind == domain.ArtifactCapture {
+			stored.Capture = nil
+		}
+	}
+	return &stored
+}
+
+// discard удаляет загруженные объекты, метаданные которых не удалось сохранить
+func (s *ArtifactService) discard(ctx context.Context, artifacts []domain.Artifact) {
+	for _, artifact := range artifacts {
+		if artifact.Key == "" {
+			continue
+		}
+		if err := s.store.Delete(ctx, artifact.Key); err != nil {
+			s.logger.Warn("Failed to delete orphaned artifact",
+				logger.String("key", artifact.Key),
+				logger.Error(err),
+			)
+		}
+	}
+}
+
+// List возвращает неистекшие артефакты проверки; пустой executionID означает все выполнения
+func (s *ArtifactService) List(ctx context.Context, checkID, executionID string) ([]domain.Artifact, error) {
+	if checkID == "" {
+		return nil, errors.New(errors.ErrValidation, "check_id is required")
+	}
+	return s.repo.ListByCheck(ctx, checkID, executionID, maxArtifactsPerList)
+}
+
+// SignedURL возвращает подписанную ссылку на артефакт проверки и время ее истечения.
+// Ссылка не переживает сам артефакт
+func (s *ArtifactService) SignedURL(ctx context.Context, checkID, key string) (string, time.Time, error) {
+	if checkID == "" || key == "" {
+		return "", time.Time{}, errors.New(errors.ErrValidation, "check_id and key are required")
+	}
+
+	artifact, err := s.repo.Get(ctx, checkID, key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	now := s.now().UTC()
+	if artifact.Expired(now) {
+		return "", time.Time{}, errors.New(errors.ErrNotFound, "artifact expired").
+			WithDetails(fmt.Sprintf("expired at %s", artifact.ExpiresAt.Format(time.RFC3339)))
+	}
+
+	ttl := s.urlTTL
+	if remaining := artifact.ExpiresAt.Sub(now); remaining < ttl {
+		ttl = remaining.Truncate(time.Second)
+		if ttl < time.Second {
+			ttl = time.Second
+		}
+	}
+	url, err := s.store.SignedURL(artifact.Key, ttl)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, errors.ErrInternal, "failed to sign artifact URL")
+	}
+	return url, now.Add(ttl), nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// memoryArtifactStore хранит объекты в памяти
+type memoryArtifactStore struct {
+	objects map[string][]byte
+	failPut bool
+	ttl     time.Duration
+}
+
+func (s *memoryArtifactStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	if s.failPut {
+		return stderrors.New("connection refused")
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memoryArtifactStore) Delete(ctx context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *memoryArtifactStore) SignedURL(key string, ttl time.Duration) (string, error) {
+	s.ttl = ttl
+	return "https://storage.example.com/" + key + "?X-Amz-Signature=sig", nil
+}
+
+// memoryArtifactRepository хранит метаданные в памяти
+type memoryArtifactRepository struct {
+	artifacts map[string]domain.Artifact
+	failSave  bool
+}
+
+func (r *memoryArtifactRepository) Save(ctx context.Context, artifacts []domain.Artifact) error {
+	if r.failSave {
+		return stderrors.New("database unavailable")
+	}
+	for _, artifact := range artifacts {
+		r.artifacts[artifact.Key] = artifact
+	}
+	return nil
+}
+
+func (r *memoryArtifactRepository) ListByCheck(ctx context.Context, checkID, executionID string, limit int) ([]domain.Artifact, error) {
+	var artifacts []domain.Artifact
+	for _, artifact := range r.artifacts {
+		if artifact.CheckID == checkID && (executionID == "" || artifact.ExecutionID == executionID) {
+			artifacts = append(artifacts, artifact)
+		}
+	}
+	return artifacts, nil
+}
+
+func (r *memoryArtifactRepository) Get(ctx context.Context, checkID, key string) (*domain.Artifact, error) {
+	artifact, ok := r.artifacts[key]
+	if !ok || artifact.CheckID != checkID {
+		return nil, errors.New(errors.ErrNotFound, "artifact not found")
+	}
+	return &artifact, nil
+}
+
+func (r *memoryArtifactRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]domain.Artifact, error) {
+	return nil, nil
+}
+
+func (r *memoryArtifactRepository) Delete(ctx context.Context, keys []string) error {
+	return nil
+}
+
+func newTestArtifactService(now time.Time) (*ArtifactService, *memoryArtifactStore, *memoryArtifactRepository) {
+	store := &memoryArtifactStore{objects: make(map[string][]byte)}
+	repo := &memoryArtifactRepository{artifacts: make(map[string]domain.Artifact)}
+	svc := NewArtifactService(store, repo, 24*time.Hour, 0, &MockLogger{})
+	svc.now = func() time.Time { return now }
+	return svc, store, repo
+}
+
+func failedResult() *domain.CheckResult {
+	result := &domain.CheckResult{
+		CheckID:     "check-1",
+		ExecutionID: "exec-1",
+		Capture:     &domain.FailureCapture{BodySnippet: "<html>502 Bad Gateway</html>", BodySize: 28},
+	}
+	result.AttachArtifact(domain.ArtifactScreenshot, "screenshot.png", "image/png", []byte{0x89, 'P', 'N', 'G'})
+	return result
+}
+
+func TestArtifactService_Offload(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	svc, store, repo := newTestArtifactService(now)
+	result := failedResult()
+
+	record := svc.Offload(context.Background(), result)
+
+	require.Len(t, result.Artifacts, 2)
+	assert.Len(t, store.objects, 2)
+	assert.Len(t, repo.artifacts, 2)
+	for _, artifact := range result.Artifacts {
+		assert.Nil(t, artifact.Data)
+		assert.Contains(t, artifact.Key, "checks/check-1/exec-1/")
+		assert.Equal(t, now.Add(24*time.Hour), artifact.ExpiresAt)
+	}
+	assert.Equal(t, domain.ArtifactCapture, result.Artifacts[1].Kind)
+	assert.Contains(t, string(store.objects[result.Artifacts[1].Key]), "502 Bad Gateway")
+
+	// Снимок остается в результате для инцидента, но не попадает в строку БД
+	assert.NotNil(t, result.Capture)
+	assert.Nil(t, record.Capture)
+	assert.Equal(t, result.Artifacts, record.Artifacts)
+}
+
+func TestArtifactService_Offload_KeepsCaptureInlineOnFailure(t *testing.T) {
+	svc, store, repo := newTestArtifactService(time.Now())
+	store.failPut = true
+	result := failedResult()
+
+	record := svc.Offload(context.Background(), result)
+	assert.NotNil(t, record.Capture)
+	assert.Empty(t, result.Artifacts)
+
+	// Объекты без сохраненных метаданных удаляются
+	store.failPut = false
+	repo.failSave = true
+	result = failedResult()
+	record = svc.Offload(context.Background(), result)
+	assert.NotNil(t, record.Capture)
+	assert.Empty(t, result.Artifacts)
+	assert.Empty(t, store.objects)
+}
+
+func TestArtifactService_SignedURL(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	svc, store, repo := newTestArtifactService(now)
+	repo.artifacts["k1"] = domain.Artifact{Key: "k1", CheckID: "check-1", ExpiresAt: now.Add(time.Hour)}
+	repo.artifacts["k2"] = domain.Artifact{Key: "k2", CheckID: "check-1", ExpiresAt: now.Add(90 * time.Second)}
+	repo.artifacts["k3"] = domain.Artifact{Key: "k3", CheckID: "check-1", ExpiresAt: now}
+
+	url, expiresAt, err := svc.SignedURL(context.Background(), "check-1", "k1")
+	require.NoError(t, err)
+	assert.Contains(t, url, "k1")
+	assert.Equal(t, now.Add(DefaultArtifactURLTTL), expiresAt)
+
+	// Ссылка не живет дольше артефакта
+	_, expiresAt, err = svc.SignedURL(context.Background(), "check-1", "k2")
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, store.ttl)
+	assert.Equal(t, now.Add(90*time.Second), expiresAt)
+
+	for _, tt := range []struct{ checkID, key string }{{"check-1", "k3"}, {"check-2", "k1"}, {"check-1", "missing"}} {
+		_, _, err = svc.SignedURL(context.Background(), tt.checkID, tt.key)
+		var appErr *errors.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, errors.ErrNotFound, appErr.Code)
+	}
+}
+
+func TestCheckService_SaveResult_OffloadsArtifacts(t *testing.T) {
+	repo := &recordingResultRepository{}
+	artifacts, store, _ := newTestArtifactService(time.Now())
+	service := newAttributionService(repo).WithArtifacts(artifacts)
+
+	result := failedResult()
+	require.NoError(t, service.saveResult(context.Background(), result))
+
+	require.Len(t, repo.saved, 1)
+	assert.Nil(t, repo.saved[0].Capture)
+	assert.Len(t, repo.saved[0].Artifacts, 2)
+	assert.Len(t, store.objects, 2)
+}
@@ -21,6 +21,35 @@ type CheckService struct {
 	repository      repository.CheckResultRepository
 	redisClient     *pkg_redis.Client
 	incidentManager IncidentManager
+	groupRecorder   GroupResultRecorder
+	execRecorder    ExecutionRecorder
+	costRecorder    CostRecorder
+	healthRecorder  HealthRecorder
+	resultRecorder  ResultRecorder
+	consensus       *ConsensusEvaluator
+	consensusRec    ConsensusRecorder
+	artifacts       *ArtifactService
+	fencing         FencingGuard
+	freshness       *ConfigFreshness
+	pipeline        *PipelineSLO
+	platformTenant  string
+	platformFactory checker.CheckerFactory
+	region          string
+}
+
+// GroupResultRecorder учитывает результаты проверок по группам для агрегированного uptime
+type GroupResultRecorder interface {
+	RecordGroupResult(groupPath string, success bool)
+}
+
+// ExecutionRecorder учитывает выполнения проверок в метриках по типу, tenant и результату
+type ExecutionRecorder interface {
+	RecordExecution(tenantID, checkID, checkType string, success bool, errorMsg string)
+}
+
+// CostRecorder учитывает стоимость выполнений проверок по tenant и проверке
+type CostRecorder interface {
+	RecordCost(tenantID, checkID, checkType string, units float64)
 }
 
 // NewCheckService создает новый экземпляр CheckService
@@ -40,16 +69,104 @@ func NewCheckService(
 	}
 }
 
-// TaskMessage представляет сообщение из RabbitMQ
+// WithGroupRecorder подключает учет результатов по группам проверок
+func (cs *CheckService) WithGroupRecorder(recorder GroupResultRecorder) *CheckService {
+	cs.groupRecorder = recorder
+	return cs
+}
+
+// WithExecutionRecorder подключает учет выполнений проверок в метриках
+func (cs *CheckService) WithExecutionRecorder(recorder ExecutionRecorder) *CheckService {
+	cs.execRecorder = recorder
+	return cs
+}
+
+// WithCostRecorder подключает учет стоимости выполнений проверок
+func (cs *CheckService) WithCostRecorder(recorder CostRecorder) *CheckService {
+	cs.costRecorder = recorder
+	return cs
+}
+
+// WithHealthRecorder подключает учет здоровья проверок в сводке по парку проверок
+func (cs *CheckService) WithHealthRecorder(recorder HealthRecorder) *CheckService {
+	cs.healthRecorder = recorder
+	return cs
+}
+
+// WithResultRecorder подключает отправку сырых результатов проверок в приемники тенантов
+func (cs *CheckService) WithResultRecorder(recorder ResultRecorder) *CheckService {
+	cs.resultRecorder = recorder
+	return cs
+}
+
+// WithConsensus подключает объединение результатов регионов; region - регион этого экземпляра,
+// используется для задач без явного региона
+func (cs *CheckService) WithConsensus(evaluator *ConsensusEvaluator, region string) *CheckService {
+	cs.consensus = evaluator
+	cs.region = region
+	return cs
+}
+
+// WithConsensusRecorder подключает учет результатов регионов и решений консенсуса в метриках
+func (cs *CheckService) WithConsensusRecorder(recorder ConsensusRecorder) *CheckService {
+	cs.consensusRec = recorder
+	return cs
+}
+
+// WithArtifacts подключает вынос скриншотов, HAR и снимков ответов во внешнее хранилище
+func (cs *CheckService) WithArtifacts(artifacts *ArtifactService) *CheckService {
+	cs.artifacts = artifacts
+	return cs
+}
+
+// WithFencing подключает отбрасывание задач, поставленных планировщиком под истекшей блокировкой
+func (cs *CheckService) WithFencing(guard FencingGuard) *CheckService {
+	cs.fencing = guard
+	return cs
+}
+
+// WithConfigFreshness подключает отбрасывание задач, собранных по устаревшей конфигурации проверки
+func (cs *CheckService) WithConfigFreshness(freshness *ConfigFreshness) *CheckService {
+	cs.freshness = freshness
+	return cs
+}
+
+// WithPipelineSLO подключает учет задержек очереди и сохранения результатов как SLI конвейера
+func (cs *CheckService) WithPipelineSLO(pipeline *PipelineSLO) *CheckService {
+	cs.pipeline = pipeline
+	return cs
+}
+
+// WithPlatformCheckers выполняет задачи мета-tenant самомониторинга фабрикой factory без
+// ограничения целей: его проверки намеренно обращаются к внутренним адресам платформы
+func (cs *CheckService) WithPlatformCheckers(tenantID string, factory checker.CheckerFactory) *CheckService {
+	cs.platformTenant = tenantID
+	cs.platformFactory = factory
+	return cs
+}
+
+// TaskMessage представляет сообщение из RabbitMQ. Задача планировщика несет только
+// идентификатор проверки, версию конфигурации и переопределения запуска: тип, цель и
+// конфигурация берутся из таблицы checks. Задачи с целью собраны целиком отправителем
 type TaskMessage struct {
-	CheckID      string                 `json:"check_id"`
-	ExecutionID  string                 `json:"execution_id"`
-	Target       string                 `json:"target"`
-	Type         string                 `json:"type"`
-	Config       map[string]interface{} `json:"config"`
-	ScheduledAt  time.Time              `json:"scheduled_at"`
-	TenantID     string                 `json:"tenant_id"`
-	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CheckID     string                 `json:"check_id"`
+	ExecutionID string                 `json:"execution_id"`
+	Target      string                 `json:"target,omitempty"`
+	Type        string                 `json:"type,omitempty"`
+	Config      map[string]interface{} `json:"config,omitempty"`
+	// Overrides параметры конфигурации, переопределенные для этого запуска поверх конфигурации проверки
+	Overrides   map[string]interface{} `json:"overrides,omitempty"`
+	ScheduledAt time.Time              `json:"scheduled_at"`
+	TenantID    string                 `json:"tenant_id"`
+	Region      string                 `json:"region,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	// Trigger и TriggeredBy - причина запуска и инициатор; задачи без них поставлены планировщиком
+	Trigger     domain.ExecutionTrigger `json:"trigger,omitempty"`
+	TriggeredBy string                  `json:"triggered_by,omitempty"`
+	// FencingToken токен блокировки планировщика, под которой поставлена задача; 0 - без fencing
+	FencingToken int64 `json:"fencing_token,omitempty"`
+	// ConfigVersion версия конфигурации проверки, из которой собрана задача; 0 - без сверки
+	ConfigVersion int64 `json:"config_version,omitempty"`
 }
 
 // ExecuteCheck выполняет проверку (публичный метод для gRPC)
@@ -72,6 +189,67 @@ func (cs *CheckService) ExecuteCheck(ctx context.Context, task *domain.Task) (*d
 		return nil, fmt.Errorf("failed to execute check: %w", err)
 	}
 
+	// Ручной запуск сохраняется в истории вместе с инициатором; ошибка сохранения
+	// уже залогирована и не отменяет полученный результат
+	if task.Trigger.IsManual() {
+		_ = cs.saveResult(ctx, result)
+	}
+
+	return result, nil
+}
+
+// RunCheck выполняет проверку по inline-определению без сохранения результата,
+// кеширования и создания инцидентов. Промежуточные фазы передаются в progress.
+func (cs *CheckService) RunCheck(ctx context.Context, task *domain.Task, progress checker.ProgressFunc) (*domain.CheckResult, error) {
+	cs.logger.Info("Running ad-hoc check",
+		logger.String("execution_id", task.ExecutionID),
+		logger.String("type", task.Type),
+		logger.String("target", task.Target),
+	)
+
+	if progress == nil {
+		progress = func(checker.ProgressEvent) {}
+	}
+
+	chk, err := cs.checkerFactory.CreateChecker(domain.TaskType(task.Type))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "unsupported check type")
+	}
+
+	if err := chk.ValidateConfig(task.Config); err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid check definition")
+	}
+	rules, err := domain.SeverityRulesFromConfig(task.Config)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid check definition")
+	}
+
+	start := time.Now()
+	progress(checker.ProgressEvent{Phase: checker.PhaseStarted})
+
+	var result *domain.CheckResult
+	if pc, ok := chk.(checker.ProgressChecker); ok {
+		result, err = pc.ExecuteWithProgress(ctx, task, progress)
+	} else {
+		result, err = chk.Execute(task)
+	}
+	if err != nil {
+		cs.logger.Error("Ad-hoc check execution failed",
+			logger.String("execution_id", task.ExecutionID),
+			logger.Error(err),
+		)
+		return nil, errors.Wrap(err, errors.ErrInternal, "check execution failed")
+	}
+
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]string)
+	}
+	result.Metadata["ad_hoc"] = "true"
+	result.Metadata["service"] = "core-service"
+	domain.ClassifySeverity(rules, result)
+
+	progress(checker.ProgressEvent{Phase: checker.PhaseCompleted, Elapsed: time.Since(start)})
+
 	return result, nil
 }
 
@@ -110,19 +288,95 @@ func (cs *CheckService) GetCheckStatus(ctx context.Context, checkID string) (*Ch
 	}, nil
 }
 
-// GetCheckHistory получает историю проверок
-func (cs *CheckService) GetCheckHistory(ctx context.Context, checkID string, limit int, startTime, endTime *time.Time) ([]*domain.CheckResult, error) {
+// GetCheckHistory получает страницу истории проверок и общее число сохраненных результатов
+func (cs *CheckService) GetCheckHistory(ctx context.Context, checkID string, limit, offset int, startTime, endTime *time.Time) ([]*domain.CheckResult, int, error) {
 	cs.logger.Info("Getting check history",
 		logger.String("check_id", checkID),
 		logger.Int("limit", limit),
+		logger.Int("offset", offset),
 	)
 
-	results, err := cs.repository.GetByCheckID(ctx, checkID, limit)
+	results, err := cs.repository.GetPageByCheckID(ctx, checkID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get check history: %w", err)
+	}
+
+	total, err := cs.repository.CountByCheckID(ctx, checkID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get check history: %w", err)
+		return nil, 0, fmt.Errorf("failed to count check history: %w", err)
+	}
+
+	return results, total, nil
+}
+
+// ownershipMetadataKeys ключи метаданных задачи с информацией о владельце и группе проверки,
+// переопределением уведомлений и полями корреляции инцидентов (хост цели, теги, явный ключ)
+var ownershipMetadataKeys = []string{
+	"owner", "team", "runbook_url", "escalation_contact", "group_id", "group_path",
+	"notify_channels", "notify_min_severity", "notify_renotify_interval",
+	"host", "tags", "correlation_key",
+}
+
+// applyOwnershipMetadata переносит владельца, команду, runbook, контакт эскалации, группу,
+// переопределение уведомлений и поля корреляции из метаданных задачи в результат, чтобы они
+// попали в инцидент
+func applyOwnershipMetadata(result *domain.CheckResult, metadata map[string]interface{}) {
+	for _, key := range ownershipMetadataKeys {
+		value, ok := metadata[key].(string)
+		if !ok || value == "" {
+			continue
+		}
+		if result.Metadata == nil {
+			result.Metadata = make(map[string]string)
+		}
+		result.Metadata[key] = value
 	}
+}
+
+// applySeverityRules записывает в неудачный результат серьезность по правилам проверки, чтобы
+// Incident Manager не угадывал ее по тексту ошибки. Некорректные правила пропускаются:
+// классификация не должна мешать созданию инцидента
+func (cs *CheckService) applySeverityRules(task *domain.Task, result *domain.CheckResult) {
+	delete(result.Metadata, domain.MetadataKeySeverityHint)
+	delete(result.Metadata, domain.MetadataKeySeverityRule)
 
-	return results, nil
+	rules, err := domain.SeverityRulesFromConfig(task.Config)
+	if err != nil {
+		cs.logger.Warn("Invalid severity rules, skipping classification",
+			logger.String("check_id", task.CheckID),
+			logger.Error(err),
+		)
+		return
+	}
+	domain.ClassifySeverity(rules, result)
+}
+
+// DefaultCaptureRetention срок хранения диагностических снимков неудачных проверок по умолчанию
+const DefaultCaptureRetention = 7 * 24 * time.Hour
+
+// PurgeExpiredCaptures удаляет снимки неудачных проверок старше retention;
+// нулевой retention означает DefaultCaptureRetention
+func (cs *CheckService) PurgeExpiredCaptures(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		retention = DefaultCaptureRetention
+	}
+
+	if cs.repository == nil {
+		cs.logger.Warn("Repository is not initialized, skipping capture purge")
+		return 0, nil
+	}
+
+	purged, err := cs.repository.PurgeCaptures(ctx, time.Now().UTC().Add(-retention))
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to purge failure captures")
+	}
+
+	cs.logger.Info("Expired failure captures purged",
+		logger.Int64("purged_count", purged),
+		logger.Duration("retention", retention),
+	)
+
+	return purged, nil
 }
 
 // CheckStatus представляет статус проверки
@@ -135,8 +389,21 @@ type CheckStatus struct {
 
 // ProcessTask обрабатывает задачу проверки
 func (cs *CheckService) ProcessTask(ctx context.Context, message []byte) error {
+	return cs.processTask(ctx, message, false)
+}
+
+// ProcessRedeliveredTask обрабатывает задачу, повторно доставленную после сбоя обработки;
+// результат помечается как retry с сохранением исходного инициатора
+func (cs *CheckService) ProcessRedeliveredTask(ctx context.Context, message []byte) error {
+	return cs.processTask(ctx, message, true)
+}
+
+// processTask обрабатывает задачу проверки
+func (cs *CheckService) processTask(ctx context.Context, message []byte, redelivered bool) error {
+	consumedAt := time.Now()
 	cs.logger.Info("Starting task processing",
 		logger.String("message_size", fmt.Sprintf("%d", len(message))),
+		logger.Bool("redelivered", redelivered),
 	)
 
 	// Десериализация сообщения из RabbitMQ
@@ -148,6 +415,22 @@ func (cs *CheckService) ProcessTask(ctx context.Context, message []byte) error {
 		return errors.Wrap(err, errors.ErrValidation, "failed to deserialize message")
 	}
 
+	if !cs.acceptFencingToken(ctx, taskMessage) {
+		return nil
+	}
+	accepted, err := cs.resolveDefinition(ctx, taskMessage)
+	if err != nil {
+		cs.logger.Error("Failed to resolve check definition",
+			logger.String("check_id", taskMessage.CheckID),
+			logger.Int64("config_version", taskMessage.ConfigVersion),
+			logger.Error(err),
+		)
+		return errors.Wrap(err, errors.ErrInternal, "failed to resolve check definition")
+	}
+	if !accepted {
+		return nil
+	}
+
 	cs.logger.Info("Task deserialized successfully",
 		logger.String("check_id", taskMessage.CheckID),
 		logger.String("execution_id", taskMessage.ExecutionID),
@@ -156,11 +439,18 @@ func (cs *CheckService) ProcessTask(ctx context.Context, message []byte) error {
 		logger.String("tenant_id", taskMessage.TenantID),
 	)
 
+	if cs.pipeline != nil && !taskMessage.ScheduledAt.IsZero() {
+		cs.pipeline.ObserveQueueLag(taskMessage.Type, consumedAt.Sub(taskMessage.ScheduledAt))
+	}
+
 	// Создание доменной модели Task
 	task := cs.createTask(taskMessage)
+	if redelivered {
+		task.Trigger = domain.TriggerRetry
+	}
 
 	// Определение типа проверки и получение checker'а
-	checker, err := cs.checkerFactory.CreateChecker(domain.TaskType(task.Type))
+	checker, err := cs.factoryFor(taskMessage.TenantID).CreateChecker(domain.TaskType(task.Type))
 	if err != nil {
 		cs.logger.Error("Failed to create checker",
 			logger.String("type", task.Type),
@@ -181,8 +471,19 @@ func (cs *CheckService) ProcessTask(ctx context.Context, message []byte) error {
 			logger.String("tenant_id", taskMessage.TenantID),
 			logger.Error(err),
 		)
+		cs.recordExecution(taskMessage, false, err.Error())
 		return errors.Wrap(err, errors.ErrInternal, "check execution failed")
 	}
+	cs.recordExecution(taskMessage, result.Success, result.Error)
+	cs.recordCost(taskMessage, result)
+
+	region := taskMessage.Region
+	if region == "" {
+		region = cs.region
+	}
+	if region != "" {
+		result.Metadata[domain.MetadataKeyRegion] = region
+	}
 
 	cs.logger.Info("Check executed successfully",
 		logger.String("check_id", task.CheckID),
@@ -197,8 +498,38 @@ func (cs *CheckService) ProcessTask(ctx context.Context, message []byte) error {
 			logger.Error(err),
 		)
 		// Не прерываем обработку, так как результат важен
+	} else if cs.pipeline != nil {
+		cs.pipeline.ObservePersistDelay(taskMessage.Type, time.Since(consumedAt))
 	}
 
+	// Приемники тенанта получают результат каждого региона, а не только итог консенсуса
+	if cs.resultRecorder != nil {
+		cs.resultRecorder.RecordResult(ctx, taskMessage.TenantID, ParseResultTags(taskMessage.Metadata), result)
+	}
+
+	// Для проверок из нескольких регионов дальше обрабатывается единый результат по политике консенсуса
+	result, decided := cs.applyConsensus(ctx, taskMessage, region, result)
+	if !decided {
+		cs.logger.Info("Waiting for results from other regions",
+			logger.String("check_id", task.CheckID),
+			logger.String("execution_id", task.ExecutionID),
+			logger.String("region", region),
+		)
+		return nil
+	}
+
+	cs.handleResult(ctx, taskMessage, task, result)
+
+	// ACK сообщения (в RabbitMQ это будет делать consumer после успешной обработки)
+	cs.logger.Info("Task processing completed successfully",
+		logger.String("check_id", task.CheckID),
+	)
+
+	return nil
+}
+
+// handleResult обрабатывает итоговый результат выполнения: кеш, группы, здоровье проверки и инциденты
+func (cs *CheckService) handleResult(ctx context.Context, taskMessage *TaskMessage, task *domain.Task, result *domain.CheckResult) {
 	// Кеширование результата в Redis (TTL 5 минут)
 	if err := cs.cacheResult(ctx, result); err != nil {
 		cs.logger.Warn("Failed to cache result in Redis",
@@ -208,8 +539,22 @@ func (cs *CheckService) ProcessTask(ctx context.Context, message []byte) error {
 		// Не прерываем обработку, так как кеширование не критично
 	}
 
-	// Если проверка неудачна → отправка в Incident Manager
+	// Учет результата в группе проверки и всех ее предках
+	if cs.groupRecorder != nil {
+		if groupPath, ok := taskMessage.Metadata["group_path"].(string); ok && groupPath != "" {
+			cs.groupRecorder.RecordGroupResult(groupPath, result.Success)
+		}
+	}
+
+	// Смена здоровья проверки обновляет сводку по парку проверок в планировщике
+	if cs.healthRecorder != nil {
+		cs.healthRecorder.RecordHealth(ctx, taskMessage.TenantID, result)
+	}
+
+	// Если проверка неудачна → отправка в Incident Manager вместе с данными о владельце проверки
 	if !result.Success {
+		applyOwnershipMetadata(result, taskMessage.Metadata)
+		cs.applySeverityRules(task, result)
 		if err := cs.sendToIncidentManager(ctx, result, taskMessage.TenantID); err != nil {
 			cs.logger.Error("Failed to send to incident manager",
 				logger.String("check_id", task.CheckID),
@@ -219,13 +564,200 @@ func (cs *CheckService) ProcessTask(ctx context.Context, message []byte) error {
 			// Не прерываем обработку, так как это уведомление
 		}
 	}
+}
 
-	// ACK сообщения (в RabbitMQ это будет делать consumer после успешной обработки)
-	cs.logger.Info("Task processing completed successfully",
-		logger.String("check_id", task.CheckID),
+// SweepConsensus принимает решения по выполнениям из нескольких регионов, окно которых истекло
+// без результатов части регионов, и обрабатывает их единые результаты. Возвращает число решений
+func (cs *CheckService) SweepConsensus(ctx context.Context) (int, error) {
+	if cs.consensus == nil {
+		return 0, nil
+	}
+
+	decisions, err := cs.consensus.Sweep(ctx)
+	for _, decision := range decisions {
+		cs.logger.Warn("Consensus reached after window without all regions",
+			logger.String("check_id", decision.Message.CheckID),
+			logger.String("execution_id", decision.Message.ExecutionID),
+			logger.String("policy", string(decision.Policy)),
+			logger.String("regions_missing", decision.Result.Metadata["regions_missing"]),
+			logger.Bool("success", decision.Result.Success),
+		)
+		if cs.consensusRec != nil {
+			cs.consensusRec.RecordConsensus(decision.Message.Type, decision.Policy, decision.Result.Success)
+		}
+		cs.handleResult(ctx, decision.Message, cs.createTask(decision.Message), decision.Result)
+	}
+	return len(decisions), err
+}
+
+// applyConsensus учитывает результат региона. Возвращает результат для дальнейшей обработки и false,
+// пока решение по выполнению не принято или уже принято другим регионом.
+// При ошибках конфигурации или хранилища результат региона обрабатывается как обычный, чтобы не потерять инцидент
+func (cs *CheckService) applyConsensus(ctx context.Context, message *TaskMessage, region string, result *domain.CheckResult) (*domain.CheckResult, bool) {
+	if cs.consensus == nil {
+		return result, true
+	}
+
+	config, err := domain.ConsensusConfigFromTask(message.Config)
+	if err != nil {
+		cs.logger.Warn("Invalid consensus configuration, using region result as is",
+			logger.String("check_id", message.CheckID),
+			logger.Error(err),
+		)
+		return result, true
+	}
+	if config == nil {
+		return result, true
+	}
+	if !config.HasRegion(region) {
+		cs.logger.Warn("Region is not configured for multi-region check, using region result as is",
+			logger.String("check_id", message.CheckID),
+			logger.String("region", region),
+		)
+		return result, true
+	}
+
+	if cs.consensusRec != nil {
+		cs.consensusRec.RecordRegionResult(message.Type, region, result.Success)
+	}
+
+	unified, err := cs.consensus.Submit(ctx, message, config, region, result)
+	if err != nil {
+		cs.logger.Error("Failed to evaluate consensus, using region result as is",
+			logger.String("check_id", message.CheckID),
+			logger.String("region", region),
+			logger.Error(err),
+		)
+		return result, true
+	}
+	if unified == nil {
+		return nil, false
+	}
+
+	cs.logger.Info("Consensus reached",
+		logger.String("check_id", message.CheckID),
+		logger.String("execution_id", message.ExecutionID),
+		logger.String("policy", string(config.Policy)),
+		logger.Bool("success", unified.Success),
 	)
+	if cs.consensusRec != nil {
+		cs.consensusRec.RecordConsensus(message.Type, config.Policy, unified.Success)
+	}
+	return unified, true
+}
 
-	return nil
+// acceptFencingToken сообщает, выполнять ли задачу: задача с токеном меньше уже принятого для
+// проверки поставлена worker планировщика, потерявшим блокировку, и дублирует запуск.
+// При недоступности хранилища токенов задача выполняется
+func (cs *CheckService) acceptFencingToken(ctx context.Context, message *TaskMessage) bool {
+	if cs.fencing == nil || message.FencingToken <= 0 {
+		return true
+	}
+
+	accepted, err := cs.fencing.Accept(ctx, message.CheckID, message.FencingToken)
+	if err != nil {
+		cs.logger.Warn("Failed to check fencing token, executing task",
+			logger.String("check_id", message.CheckID),
+			logger.Int64("fencing_token", message.FencingToken),
+			logger.Error(err),
+		)
+		return true
+	}
+	if !accepted {
+		cs.logger.Warn("Dropping task with stale fencing token",
+			logger.String("check_id", message.CheckID),
+			logger.String("execution_id", message.ExecutionID),
+			logger.Int64("fencing_token", message.FencingToken),
+		)
+	}
+	return accepted
+}
+
+// factoryFor возвращает фабрику checker'ов для задач tenant
+func (cs *CheckService) factoryFor(tenantID string) checker.CheckerFactory {
+	if cs.platformFactory != nil && tenantID != "" && tenantID == cs.platformTenant {
+		return cs.platformFactory
+	}
+	return cs.checkerFactory
+}
+
+// resolveDefinition дополняет задачу планировщика типом, целью и конфигурацией проверки из
+// таблицы checks. Возвращает false, если задача отброшена: проверка удалена, выключена или
+// изменена после постановки задачи. Без проверки задачу выполнить нельзя, поэтому ошибка
+// чтения возвращается и задача доставляется повторно. Задачи с целью только сверяются
+func (cs *CheckService) resolveDefinition(ctx context.Context, message *TaskMessage) (bool, error) {
+	if message.Target != "" {
+		return cs.acceptConfigVersion(ctx, message), nil
+	}
+	if cs.freshness == nil {
+		return false, fmt.Errorf("check definitions are not configured")
+	}
+
+	definition, reason, err := cs.freshness.Resolve(ctx, message.CheckID, message.ConfigVersion)
+	if err != nil {
+		return false, err
+	}
+	if reason != "" {
+		cs.logger.Warn("Dropping task with stale check config",
+			logger.String("check_id", message.CheckID),
+			logger.String("execution_id", message.ExecutionID),
+			logger.Int64("config_version", message.ConfigVersion),
+			logger.String("reason", reason),
+		)
+		return false, nil
+	}
+
+	message.applyDefinition(definition)
+	return true, nil
+}
+
+// acceptConfigVersion сообщает, выполнять ли задачу: задача по удаленной, выключенной или
+// измененной после постановки проверке отбрасывается. При недоступности БД задача выполняется
+func (cs *CheckService) acceptConfigVersion(ctx context.Context, message *TaskMessage) bool {
+	if cs.freshness == nil || message.ConfigVersion <= 0 {
+		return true
+	}
+
+	reason, err := cs.freshness.StaleReason(ctx, message.CheckID, message.ConfigVersion)
+	if err != nil {
+		cs.logger.Warn("Failed to check config version, executing task",
+			logger.String("check_id", message.CheckID),
+			logger.Int64("config_version", message.ConfigVersion),
+			logger.Error(err),
+		)
+		return true
+	}
+	if reason != "" {
+		cs.logger.Warn("Dropping task with stale check config",
+			logger.String("check_id", message.CheckID),
+			logger.String("execution_id", message.ExecutionID),
+			logger.Int64("config_version", message.ConfigVersion),
+			logger.String("reason", reason),
+		)
+		return false
+	}
+	return true
+}
+
+// recordExecution передает результат выполнения проверки в метрики, если они подключены
+func (cs *CheckService) recordExecution(message *TaskMessage, success bool, errorMsg string) {
+	if cs.execRecorder == nil {
+		return
+	}
+	cs.execRecorder.RecordExecution(message.TenantID, message.CheckID, message.Type, success, errorMsg)
+}
+
+// recordCost записывает стоимость выполнения в метаданные результата и передает ее в учет стоимости.
+// Каждый регион учитывает только свое выполнение
+func (cs *CheckService) recordCost(message *TaskMessage, result *domain.CheckResult) {
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]string)
+	}
+	units := domain.ExecutionCostUnits(result)
+	result.Metadata[domain.MetadataKeyCostUnits] = domain.FormatCostUnits(units)
+	if cs.costRecorder != nil {
+		cs.costRecorder.RecordCost(message.TenantID, message.CheckID, message.Type, units)
+	}
 }
 
 // deserializeMessage десериализует сообщение из RabbitMQ
@@ -242,26 +774,75 @@ func (cs *CheckService) deserializeMessage(message []byte) (*TaskMessage, error)
 	if taskMessage.ExecutionID == "" {
 		return nil, fmt.Errorf("execution_id is required")
 	}
-	if taskMessage.Target == "" {
-		return nil, fmt.Errorf("target is required")
+	// Задача без цели собирается по проверке, поэтому без версии конфигурации не принимается
+	if taskMessage.Target == "" && taskMessage.ConfigVersion <= 0 {
+		return nil, fmt.Errorf("target or config_version is required")
 	}
-	if taskMessage.Type == "" {
+	if taskMessage.Target != "" && taskMessage.Type == "" {
 		return nil, fmt.Errorf("type is required")
 	}
 
 	return &taskMessage, nil
 }
 
+// applyDefinition заполняет тип, цель и конфигурацию задачи по проверке. Переопределения
+// запуска применяются поверх конфигурации проверки, таймаут по умолчанию - таймаут проверки
+func (m *TaskMessage) applyDefinition(definition *domain.CheckDefinition) {
+	m.Type = string(definition.TaskType())
+	m.Target = definition.Target
+	if m.TenantID == "" {
+		m.TenantID = definition.TenantID
+	}
+
+	config := make(map[string]interface{}, len(definition.Config)+len(m.Overrides)+1)
+	for key, value := range definition.Config {
+		config[key] = value
+	}
+	if _, ok := config["timeout"]; !ok && definition.TimeoutSeconds > 0 {
+		config["timeout"] = (time.Duration(definition.TimeoutSeconds) * time.Second).String()
+	}
+	for key, value := range m.Overrides {
+		config[key] = value
+	}
+	m.Config = config
+}
+
+// expandConfig возвращает конфигурацию задачи с параметрами, которые планировщик не передает,
+// потому что они выводятся из самой задачи: адрес HTTP проверки по умолчанию - цель, метод - GET
+func (m *TaskMessage) expandConfig() map[string]interface{} {
+	if m.Type != string(domain.TaskTypeHTTP) {
+		return m.Config
+	}
+
+	config := make(map[string]interface{}, len(m.Config)+2)
+	for key, value := range m.Config {
+		config[key] = value
+	}
+	if _, ok := config["url"]; !ok {
+		config["url"] = m.Target
+	}
+	if _, ok := config["method"]; !ok {
+		config["method"] = "GET"
+	}
+	return config
+}
+
 // createTask создает доменную модель Task из TaskMessage
 func (cs *CheckService) createTask(message *TaskMessage) *domain.Task {
-	return domain.NewTask(
+	task := domain.NewTask(
 		message.CheckID,
 		message.Target,
 		message.Type,
 		message.ExecutionID,
 		message.ScheduledAt,
-		message.Config,
+		message.expandConfig(),
 	)
+	task.Trigger = message.Trigger
+	if !task.Trigger.IsValid() {
+		task.Trigger = domain.TriggerSchedule
+	}
+	task.TriggeredBy = message.TriggeredBy
+	return task
 }
 
 // executeCheck выполняет проверку
@@ -288,6 +869,8 @@ func (cs *CheckService) executeCheck(ctx context.Context, checker checker.Checke
 	}
 	result.Metadata["processed_at"] = time.Now().UTC().Format(time.RFC3339)
 	result.Metadata["service"] = "core-service"
+	task.Attribute(result)
+	cs.applySeverityRules(task, result)
 
 	return result, nil
 }
@@ -303,7 +886,13 @@ func (cs *CheckService) saveResult(ctx context.Context, result *domain.CheckResu
 		return nil
 	}
 
-	err := cs.repository.Save(ctx, result)
+	// Крупные данные уходят в хранилище артефактов, в строке результата остаются ссылки
+	record := result
+	if cs.artifacts != nil {
+		record = cs.artifacts.Offload(ctx, result)
+	}
+
+	err := cs.repository.Save(ctx, record)
 	if err != nil {
 		cs.logger.Error("Failed to save result to database",
 			logger.String("check_id", result.CheckID),
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -53,6 +54,14 @@ func (m *MockCheckResultRepository) GetByCheckID(ctx context.Context, checkID st
 	return nil, nil
 }
 
+func (m *MockCheckResultRepository) GetPageByCheckID(ctx context.Context, checkID string, limit, offset int) ([]*domain.CheckResult, error) {
+	return nil, nil
+}
+
+func (m *MockCheckResultRepository) CountByCheckID(ctx context.Context, checkID string) (int, error) {
+	return 0, nil
+}
+
 func (m *MockCheckResultRepository) GetByTimeRange(ctx context.Context, startTime, endTime time.Time, limit int) ([]*domain.CheckResult, error) {
 	return nil, nil
 }
@@ -61,6 +70,10 @@ func (m *MockCheckResultRepository) DeleteOldResults(ctx context.Context, before
 	return nil
 }
 
+func (m *MockCheckResultRepository) PurgeCaptures(ctx context.Context, olderThan time.Time) (int64, error) {
+	return 0, nil
+}
+
 func (m *MockCheckResultRepository) GetFailedChecks(ctx context.Context, startTime, endTime time.Time, limit int) ([]*domain.CheckResult, error) {
 	return nil, nil
 }
@@ -348,3 +361,166 @@ func TestCheckService_GetCachedResult(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, result)
 }
+
+func TestCheckService_RunCheck_ReportsProgressWithoutPersisting(t *testing.T) {
+	log := &MockLogger{}
+	mockChecker := &MockChecker{
+		mockResult: &domain.CheckResult{
+			CheckID:     "adhoc",
+			ExecutionID: "adhoc-exec-1",
+			Success:     true,
+			DurationMs:  15,
+			CheckedAt:   time.Now().UTC(),
+		},
+	}
+	factory := &MockCheckerFactory{mockChecker: mockChecker}
+	service := NewCheckService(log, factory, nil, nil, nil)
+
+	task := domain.NewTask("adhoc", "https://example.com", "http", "adhoc-exec-1", time.Now(), map[string]interface{}{})
+
+	var phases []checker.ProgressPhase
+	result, err := service.RunCheck(context.Background(), task, func(event checker.ProgressEvent) {
+		phases = append(phases, event.Phase)
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "true", result.Metadata["ad_hoc"])
+	assert.Equal(t, []checker.ProgressPhase{checker.PhaseStarted, checker.PhaseCompleted}, phases)
+	assert.NotContains(t, log.GetLogs(), "DEBUG: Saving result to database")
+}
+
+func TestCheckService_RunCheck_UnsupportedType(t *testing.T) {
+	service := NewCheckService(&MockLogger{}, &MockCheckerFactory{}, nil, nil, nil)
+
+	task := domain.NewTask("adhoc", "host", "unknown", "adhoc-exec-1", time.Now(), map[string]interface{}{})
+
+	_, err := service.RunCheck(context.Background(), task, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported check type")
+}
+
+func TestApplyOwnershipMetadata(t *testing.T) {
+	result := &domain.CheckResult{CheckID: "check-1"}
+
+	applyOwnershipMetadata(result, map[string]interface{}{
+		"owner":              "alice",
+		"escalation_contact": "oncall@example.com",
+		"team":               "",
+		"unrelated":          "ignored",
+		"notify_channels":    "slack:#payments,email:payments@example.com",
+		"host":               "api.example.com",
+		"tags":               "prod,api",
+	})
+
+	assert.Equal(t, map[string]string{
+		"owner":              "alice",
+		"escalation_contact": "oncall@example.com",
+		"notify_channels":    "slack:#payments,email:payments@example.com",
+		"host":               "api.example.com",
+		"tags":               "prod,api",
+	}, result.Metadata)
+}
+
+// recordingGroupRecorder запоминает пути групп, по которым учтены результаты
+type recordingGroupRecorder struct {
+	paths   []string
+	success []bool
+}
+
+func (r *recordingGroupRecorder) RecordGroupResult(groupPath string, success bool) {
+	r.paths = append(r.paths, groupPath)
+	r.success = append(r.success, success)
+}
+
+func TestCheckService_ProcessTask_RecordsGroupResult(t *testing.T) {
+	mockChecker := &MockChecker{
+		mockResult: &domain.CheckResult{
+			CheckID:     "check-1",
+			ExecutionID: "exec-1",
+			Success:     true,
+			CheckedAt:   time.Now().UTC(),
+		},
+	}
+	recorder := &recordingGroupRecorder{}
+	service := NewCheckService(&MockLogger{}, &MockCheckerFactory{mockChecker: mockChecker}, &MockCheckResultRepository{}, nil, &MockIncidentManager{}).
+		WithGroupRecorder(recorder)
+
+	message := TaskMessage{
+		CheckID:     "check-1",
+		ExecutionID: "exec-1",
+		Target:      "https://example.com",
+		Type:        "http",
+		ScheduledAt: time.Now(),
+		Metadata: map[string]interface{}{
+			"group_id":   "child",
+			"group_path": "/root/child/",
+		},
+	}
+	messageBytes, err := json.Marshal(message)
+	require.NoError(t, err)
+
+	require.NoError(t, service.ProcessTask(context.Background(), messageBytes))
+	assert.Equal(t, []string{"/root/child/"}, recorder.paths)
+	assert.Equal(t, []bool{true}, recorder.success)
+}
+
+// recordingExecutionRecorder запоминает учтенные выполнения проверок
+type recordingExecutionRecorder struct {
+	executions []string
+}
+
+func (r *recordingExecutionRecorder) RecordExecution(tenantID, checkID, checkType string, success bool, errorMsg string) {
+	r.executions = append(r.executions, fmt.Sprintf("%s/%s/%s/%t/%s", tenantID, checkID, checkType, success, errorMsg))
+}
+
+func TestCheckService_ProcessTask_RecordsExecution(t *testing.T) {
+	mockChecker := &MockChecker{
+		mockResult: &domain.CheckResult{
+			CheckID:     "check-1",
+			ExecutionID: "exec-1",
+			Success:     false,
+			Error:       "request timeout",
+			CheckedAt:   time.Now().UTC(),
+		},
+	}
+	recorder := &recordingExecutionRecorder{}
+	service := NewCheckService(&MockLogger{}, &MockCheckerFactory{mockChecker: mockChecker}, &MockCheckResultRepository{}, nil, &MockIncidentManager{}).
+		WithExecutionRecorder(recorder)
+
+	message := TaskMessage{
+		CheckID:     "check-1",
+		ExecutionID: "exec-1",
+		Target:      "https://example.com",
+		Type:        "http",
+		TenantID:    "tenant-1",
+		ScheduledAt: time.Now(),
+	}
+	messageBytes, err := json.Marshal(message)
+	require.NoError(t, err)
+
+	require.NoError(t, service.ProcessTask(context.Background(), messageBytes))
+	assert.Equal(t, []string{"tenant-1/check-1/http/false/request timeout"}, recorder.executions)
+}
+
+// purgingResultRepository запоминает границу очистки снимков
+type purgingResultRepository struct {
+	MockCheckResultRepository
+	olderThan time.Time
+}
+
+func (r *purgingResultRepository) PurgeCaptures(ctx context.Context, olderThan time.Time) (int64, error) {
+	r.olderThan = olderThan
+	return 5, nil
+}
+
+func TestCheckService_PurgeExpiredCaptures(t *testing.T) {
+	repo := &purgingResultRepository{}
+	service := NewCheckService(&MockLogger{}, &MockCheckerFactory{}, repo, nil, nil)
+
+	purged, err := service.PurgeExpiredCaptures(context.Background(), 48*time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), purged)
+	assert.WithinDuration(t, time.Now().UTC().Add(-48*time.Hour), repo.olderThan, time.Minute)
+}
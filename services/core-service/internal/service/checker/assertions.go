@@ -0,0 +1,349 @@
+package checker
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Типы утверждений о содержимом HTTP ответа (config.assertions)
+const (
+	AssertionContains    = "contains"
+	AssertionNotContains = "not_contains"
+	AssertionRegex       = "regex"
+	AssertionJSONPath    = "json_path"
+	AssertionHeader      = "header"
+)
+
+// Операторы сравнения утверждений json_path и header
+const (
+	AssertionOpEquals    = "equals"
+	AssertionOpNotEquals = "not_equals"
+	AssertionOpContains  = "contains"
+	AssertionOpExists    = "exists"
+	AssertionOpGreater   = "gt"
+	AssertionOpGreaterEq = "gte"
+	AssertionOpLess      = "lt"
+	AssertionOpLessEq    = "lte"
+	AssertionOpMatches   = "matches"
+)
+
+// assertionErrorPrefix префикс сообщения о нарушенном утверждении в ошибке результата
+const assertionErrorPrefix = "assertion failed: "
+
+// maxAssertionsPerCheck ограничение числа утверждений одной проверки
+const maxAssertionsPerCheck = 50
+
+// MetadataKeyAssertionsFailed число нарушенных утверждений в метаданных результата
+const MetadataKeyAssertionsFailed = "assertions_failed"
+
+// assertionTypes допустимые типы утверждений
+var assertionTypes = []string{AssertionContains, AssertionNotContains, AssertionRegex, AssertionJSONPath, AssertionHeader}
+
+// assertionOperators операторы, допустимые для json_path и header
+var assertionOperators = map[string]bool{
+	AssertionOpEquals: true, AssertionOpNotEquals: true, AssertionOpContains: true, AssertionOpExists: true,
+	AssertionOpGreater: true, AssertionOpGreaterEq: true, AssertionOpLess: true, AssertionOpLessEq: true,
+	AssertionOpMatches: true,
+}
+
+// jsonPathSegment разбирает сегменты JSONPath вида items[0].name или ['key']
+var jsonPathSegment = regexp.MustCompile(`^(?:\.?([^.\[\]]+)|\[(\d+)\]|\['([^']*)'\])`)
+
+// Assertion утверждение о содержимом ответа. Path - JSONPath для json_path или имя заголовка
+// для header, Value - подстрока, шаблон regex или ожидаемое значение
+type Assertion struct {
+	Type     string
+	Path     string
+	Operator string
+	Value    interface{}
+
+	pattern *regexp.Regexp
+}
+
+// AssertionsFromConfig извлекает утверждения из config.assertions:
+//
+//	assertions:
+//	  - {type: contains, value: "OK"}
+//	  - {type: regex, value: "version\": \"v\\d+"}
+//	  - {type: json_path, path: "$.checks[0].status", value: "pass"}
+//	  - {type: header, path: "Content-Type", operator: contains, value: "json"}
+func AssertionsFromConfig(config map[string]interface{}) ([]Assertion, error) {
+	raw, ok := config["assertions"]
+	if !ok {
+		return nil, nil
+	}
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("assertions must be a list")
+	}
+	if len(list) > maxAssertionsPerCheck {
+		return nil, fmt.Errorf("at most %d assertions are allowed", maxAssertionsPerCheck)
+	}
+
+	assertions := make([]Assertion, 0, len(list))
+	for i, item := range list {
+		rule, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("assertions[%d] must be a map", i)
+		}
+		assertion := Assertion{Value: rule["value"]}
+		assertion.Type, _ = rule["type"].(string)
+		assertion.Path, _ = rule["path"].(string)
+		assertion.Operator, _ = rule["operator"].(string)
+		if err := assertion.compile(); err != nil {
+			return nil, fmt.Errorf("assertions[%d]: %w", i, err)
+		}
+		assertions = append(assertions, assertion)
+	}
+	return assertions, nil
+}
+
+// compile проверяет утверждение и заранее компилирует регулярные выражения
+func (a *Assertion) compile() error {
+	value, isString := a.Value.(string)
+	switch a.Type {
+	case AssertionContains, AssertionNotContains:
+		if !isString || value == "" {
+			return fmt.Errorf("%s requires a non-empty string value", a.Type)
+		}
+	case AssertionRegex:
+		if !isString || value == "" {
+			return fmt.Errorf("regex requires a pattern in value")
+		}
+	case AssertionJSONPath, AssertionHeader:
+		if strings.TrimSpace(a.Path) == "" {
+			return fmt.Errorf("%s requires path", a.Type)
+		}
+		if a.Type == AssertionJSONPath {
+			if _, err := parseJSONPath(a.Path); err != nil {
+				return err
+			}
+		}
+		if a.Operator == "" {
+			a.Operator = AssertionOpEquals
+		}
+		if !assertionOperators[a.Operator] {
+			return fmt.Errorf("unsupported operator %q", a.Operator)
+		}
+		switch a.Operator {
+		case AssertionOpExists:
+		case AssertionOpGreater, AssertionOpGreaterEq, AssertionOpLess, AssertionOpLessEq:
+			if _, ok := toFloat(a.Value); !ok {
+				return fmt.Errorf("operator %s requires a numeric value", a.Operator)
+			}
+		case AssertionOpMatches:
+			if !isString {
+				return fmt.Errorf("operator matches requires a pattern in value")
+			}
+		default:
+			if a.Value == nil {
+				return fmt.Errorf("operator %s requires value", a.Operator)
+			}
+		}
+	default:
+		return fmt.Errorf("type must be one of %v", assertionTypes)
+	}
+
+	if a.Type == AssertionRegex || a.Operator == AssertionOpMatches {
+		pattern, err := regexp.Compile(value)
+		if err != nil {
+			return fmt.Errorf("invalid regex pattern: %w", err)
+		}
+		a.pattern = pattern
+	}
+	return nil
+}
+
+// validateAssertions проверяет config.assertions
+func validateAssertions(config map[string]interface{}) error {
+	_, err := AssertionsFromConfig(config)
+	return err
+}
+
+// EvaluateAssertions проверяет утверждения и возвращает сообщения о нарушенных в порядке
+// конфигурации. Сообщение зависит только от утверждения, а не от содержимого ответа, поэтому
+// повторные нарушения одного утверждения дедуплицируются в один инцидент
+func EvaluateAssertions(assertions []Assertion, header http.Header, body []byte) []string {
+	var failures []string
+	var document interface{}
+	var documentErr error
+	parsed := false
+
+	for _, a := range assertions {
+		var message string
+		switch a.Type {
+		case AssertionContains:
+			if !strings.Contains(string(body), a.Value.(string)) {
+				message = fmt.Sprintf("body does not contain %q", a.Value)
+			}
+		case AssertionNotContains:
+			if strings.Contains(string(body), a.Value.(string)) {
+				message = fmt.Sprintf("body contains %q", a.Value)
+			}
+		case AssertionRegex:
+			if !a.pattern.Match(body) {
+				message = fmt.Sprintf("body does not match %q", a.Value)
+			}
+		case AssertionJSONPath:
+			if !parsed {
+				documentErr = json.Unmarshal(body, &document)
+				parsed = true
+			}
+			if documentErr != nil {
+				message = fmt.Sprintf("json_path %s: body is not valid JSON", a.Path)
+				break
+			}
+			actual, found := evaluateJSONPath(document, a.Path)
+			message = a.compare("json_path "+a.Path, actual, found)
+		case AssertionHeader:
+			values, found := header[http.CanonicalHeaderKey(a.Path)]
+			message = a.compare("header "+http.CanonicalHeaderKey(a.Path), strings.Join(values, ", "), found)
+		}
+		if message != "" {
+			failures = append(failures, assertionErrorPrefix+message)
+		}
+	}
+	return failures
+}
+
+// compare сравнивает найденное значение subject с ожидаемым; пустая строка - утверждение выполнено
+func (a Assertion) compare(subject string, actual interface{}, found bool) string {
+	if !found {
+		// Отсутствующее значение заведомо не равно ожидаемому
+		if a.Operator == AssertionOpNotEquals {
+			return ""
+		}
+		return subject + " not found"
+	}
+
+	expected := formatAssertionValue(a.Value)
+	switch a.Operator {
+	case AssertionOpExists:
+		return ""
+	case AssertionOpEquals:
+		if formatAssertionValue(actual) != expected {
+			return fmt.Sprintf("%s is not equal to %q", subject, expected)
+		}
+	case AssertionOpNotEquals:
+		if formatAssertionValue(actual) == expected {
+			return fmt.Sprintf("%s is equal to %q", subject, expected)
+		}
+	case AssertionOpContains:
+		if !strings.Contains(formatAssertionValue(actual), expected) {
+			return fmt.Sprintf("%s does not contain %q", subject, expected)
+		}
+	case AssertionOpMatches:
+		if !a.pattern.MatchString(formatAssertionValue(actual)) {
+			return fmt.Sprintf("%s does not match %q", subject, expected)
+		}
+	default:
+		want, _ := toFloat(a.Value)
+		got, ok := toFloat(actual)
+		if !ok {
+			return fmt.Sprintf("%s is not a number", subject)
+		}
+		var passed bool
+		switch a.Operator {
+		case AssertionOpGreater:
+			passed = got > want
+		case AssertionOpGreaterEq:
+			passed = got >= want
+		case AssertionOpLess:
+			passed = got < want
+		case AssertionOpLessEq:
+			passed = got <= want
+		}
+		if !passed {
+			return fmt.Sprintf("%s is not %s %s", subject, a.Operator, expected)
+		}
+	}
+	return ""
+}
+
+// formatAssertionValue приводит значение к строке для сравнения: числа JSON без экспоненты,
+// объекты и массивы - в JSON
+func formatAssertionValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]interface{}, []interface{}:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// toFloat приводит число или числовую строку к float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// parseJSONPath разбирает JSONPath из полей и индексов массивов: $.data.items[0].name, $['a.b']
+func parseJSONPath(path string) ([]interface{}, error) {
+	rest := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	var segments []interface{}
+	for rest != "" {
+		match := jsonPathSegment.FindStringSubmatch(rest)
+		if match == nil {
+			return nil, fmt.Errorf("invalid JSONPath %q", path)
+		}
+		switch {
+		case match[1] != "":
+			segments = append(segments, match[1])
+		case match[2] != "":
+			index, _ := strconv.Atoi(match[2])
+			segments = append(segments, index)
+		default:
+			segments = append(segments, match[3])
+		}
+		rest = rest[len(match[0]):]
+	}
+	return segments, nil
+}
+
+// evaluateJSONPath возвращает значение по JSONPath; false - путь не найден
+func evaluateJSONPath(document interface{}, path string) (interface{}, bool) {
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, false
+	}
+	current := document
+	for _, segment := range segments {
+		switch key := segment.(type) {
+		case string:
+			object, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if current, ok = object[key]; !ok {
+				return nil, false
+			}
+		case int:
+			array, ok := current.([]interface{})
+			if !ok || key >= len(array) {
+				return nil, false
+			}
+			current = array[key]
+		}
+	}
+	return current, true
+}
@@ -0,0 +1,146 @@
+package checker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+const assertionsBody = `{"status":"ok","version":"v2.4.1","checks":[{"name":"db","latency_ms":12},{"name":"cache","latency_ms":3}],"a.b":true}`
+
+func assertionList(rules ...map[string]interface{}) map[string]interface{} {
+	list := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		list = append(list, rule)
+	}
+	return map[string]interface{}{"assertions": list}
+}
+
+func TestEvaluateAssertions(t *testing.T) {
+	header := http.Header{"Content-Type": []string{"application/json; charset=utf-8"}, "X-Region": []string{"eu"}}
+	tests := []struct {
+		name string
+		rule map[string]interface{}
+		want string
+	}{
+		{"contains", map[string]interface{}{"type": "contains", "value": `"status":"ok"`}, ""},
+		{"contains failed", map[string]interface{}{"type": "contains", "value": "healthy"}, `assertion failed: body does not contain "healthy"`},
+		{"not_contains failed", map[string]interface{}{"type": "not_contains", "value": "cache"}, `assertion failed: body contains "cache"`},
+		{"regex", map[string]interface{}{"type": "regex", "value": `v\d+\.\d+`}, ""},
+		{"regex failed", map[string]interface{}{"type": "regex", "value": `v3\.`}, `assertion failed: body does not match "v3\\."`},
+		{"json_path equals", map[string]interface{}{"type": "json_path", "path": "$.status", "value": "ok"}, ""},
+		{"json_path index", map[string]interface{}{"type": "json_path", "path": "$.checks[1].name", "value": "cache"}, ""},
+		{"json_path quoted key", map[string]interface{}{"type": "json_path", "path": "$['a.b']", "value": true}, ""},
+		{"json_path number", map[string]interface{}{"type": "json_path", "path": "$.checks[0].latency_ms", "operator": "lt", "value": float64(50)}, ""},
+		{"json_path number failed", map[string]interface{}{"type": "json_path", "path": "$.checks[0].latency_ms", "operator": "lte", "value": float64(10)}, `assertion failed: json_path $.checks[0].latency_ms is not lte 10`},
+		{"json_path not equal", map[string]interface{}{"type": "json_path", "path": "$.status", "value": "pass"}, `assertion failed: json_path $.status is not equal to "pass"`},
+		{"json_path missing", map[string]interface{}{"type": "json_path", "path": "$.checks[5]", "operator": "exists"}, `assertion failed: json_path $.checks[5] not found`},
+		{"json_path matches", map[string]interface{}{"type": "json_path", "path": "$.version", "operator": "matches", "value": `^v2\.`}, ""},
+		{"header equals", map[string]interface{}{"type": "header", "path": "x-region", "value": "eu"}, ""},
+		{"header contains", map[string]interface{}{"type": "header", "path": "Content-Type", "operator": "contains", "value": "json"}, ""},
+		{"header not equal", map[string]interface{}{"type": "header", "path": "X-Region", "value": "us"}, `assertion failed: header X-Region is not equal to "us"`},
+		{"header missing", map[string]interface{}{"type": "header", "path": "Cache-Control", "operator": "exists"}, `assertion failed: header Cache-Control not found`},
+		{"header missing not_equals", map[string]interface{}{"type": "header", "path": "Cache-Control", "operator": "not_equals", "value": "no-store"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertions, err := AssertionsFromConfig(assertionList(tt.rule))
+			require.NoError(t, err)
+			failures := EvaluateAssertions(assertions, header, []byte(assertionsBody))
+			if tt.want == "" {
+				assert.Empty(t, failures)
+			} else {
+				assert.Equal(t, []string{tt.want}, failures)
+			}
+		})
+	}
+
+	// Тело не JSON: утверждения json_path нарушены, остальные проверяются как обычно
+	assertions, err := AssertionsFromConfig(assertionList(
+		map[string]interface{}{"type": "json_path", "path": "$.status", "value": "ok"},
+		map[string]interface{}{"type": "contains", "value": "Bad Gateway"},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"assertion failed: json_path $.status: body is not valid JSON"},
+		EvaluateAssertions(assertions, header, []byte("<html>Bad Gateway</html>")))
+}
+
+func TestEvaluateAssertions_StableMessages(t *testing.T) {
+	assertions, err := AssertionsFromConfig(assertionList(
+		map[string]interface{}{"type": "json_path", "path": "$.status", "value": "ok"},
+	))
+	require.NoError(t, err)
+
+	// Сообщение не зависит от фактического значения: инциденты по одному утверждению группируются
+	first := EvaluateAssertions(assertions, nil, []byte(`{"status":"degraded","at":"12:00:01"}`))
+	second := EvaluateAssertions(assertions, nil, []byte(`{"status":"down","at":"12:00:31"}`))
+	assert.Equal(t, first, second)
+}
+
+func TestAssertionsFromConfig_Invalid(t *testing.T) {
+	invalid := []map[string]interface{}{
+		{"assertions": "contains OK"},
+		assertionList(map[string]interface{}{"type": "xpath", "value": "//a"}),
+		assertionList(map[string]interface{}{"type": "contains"}),
+		assertionList(map[string]interface{}{"type": "regex", "value": "("}),
+		assertionList(map[string]interface{}{"type": "json_path", "value": "ok"}),
+		assertionList(map[string]interface{}{"type": "json_path", "path": "$.a[x]", "value": "ok"}),
+		assertionList(map[string]interface{}{"type": "json_path", "path": "$.a", "operator": "like", "value": "ok"}),
+		assertionList(map[string]interface{}{"type": "json_path", "path": "$.a", "operator": "gt", "value": "many"}),
+		assertionList(map[string]interface{}{"type": "header", "path": "X-Region"}),
+	}
+	for _, config := range invalid {
+		_, err := AssertionsFromConfig(config)
+		assert.Error(t, err, "%v", config)
+	}
+
+	assertions, err := AssertionsFromConfig(map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Empty(t, assertions)
+}
+
+func TestHTTPChecker_Assertions(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(assertionsBody))
+	}))
+	defer server.Close()
+
+	checker := NewHTTPChecker(5000, log)
+	config := assertionList(
+		map[string]interface{}{"type": "json_path", "path": "$.status", "value": "ok"},
+		map[string]interface{}{"type": "header", "path": "Content-Type", "value": "application/json"},
+	)
+	config["method"] = "GET"
+	config["url"] = server.URL
+	config["expected_status"] = float64(200)
+	require.NoError(t, checker.ValidateConfig(config))
+
+	result, err := checker.Execute(domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), config))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Empty(t, result.Metadata[MetadataKeyAssertionsFailed])
+
+	config["assertions"] = append(config["assertions"].([]interface{}),
+		map[string]interface{}{"type": "contains", "value": "maintenance"},
+		map[string]interface{}{"type": "header", "path": "X-Region", "operator": "exists"},
+	)
+	result, err = checker.Execute(domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), config))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, `assertion failed: body does not contain "maintenance"; assertion failed: header X-Region not found`, result.Error)
+	assert.Equal(t, "2", result.Metadata[MetadataKeyAssertionsFailed])
+
+	config["assertions"] = []interface{}{map[string]interface{}{"type": "regex", "value": "("}}
+	assert.Error(t, checker.ValidateConfig(config))
+}
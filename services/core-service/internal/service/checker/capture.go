@@ -0,0 +1,110 @@
+package checker
+
+import (
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+const (
+	// DefaultCaptureBodyLimit размер фрагмента тела ответа по умолчанию, байт
+	DefaultCaptureBodyLimit = 4096
+	// MaxCaptureBodyLimit максимальный допустимый размер фрагмента тела ответа, байт
+	MaxCaptureBodyLimit = 64 * 1024
+
+	redactedHeaderValue = "[REDACTED]"
+)
+
+// sensitiveHeaders заголовки, значения которых не попадают в снимок
+var sensitiveHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"cookie":              true,
+	"set-cookie":          true,
+	"x-api-key":           true,
+	"x-auth-token":        true,
+}
+
+// CaptureOptions описывает параметры снятия диагностического снимка при неудаче
+type CaptureOptions struct {
+	Enabled   bool
+	BodyLimit int
+}
+
+// CaptureOptionsFromConfig извлекает параметры снимка из конфигурации проверки
+// (capture_on_failure, capture_body_limit)
+func CaptureOptionsFromConfig(config map[string]interface{}) CaptureOptions {
+	opts := CaptureOptions{BodyLimit: DefaultCaptureBodyLimit}
+	if enabled, ok := config["capture_on_failure"].(bool); ok {
+		opts.Enabled = enabled
+	}
+	if limit, ok := config["capture_body_limit"].(float64); ok && limit > 0 {
+		opts.BodyLimit = int(limit)
+	}
+	if opts.BodyLimit > MaxCaptureBodyLimit {
+		opts.BodyLimit = MaxCaptureBodyLimit
+	}
+	return opts
+}
+
+// newFailureCapture формирует снимок запроса и ответа; resp может быть nil при сетевой ошибке
+func newFailureCapture(opts CaptureOptions, req *http.Request, resp *http.Response, body []byte, startedAt time.Time, duration time.Duration, requestErr error) *domain.FailureCapture {
+	entry := domain.HAREntry{
+		StartedAt:      startedAt.UTC(),
+		Method:         req.Method,
+		URL:            req.URL.String(),
+		RequestHeaders: redactHeaders(req.Header),
+		BodySize:       int64(len(body)),
+		DurationMs:     duration.Milliseconds(),
+	}
+	if requestErr != nil {
+		entry.Error = requestErr.Error()
+	}
+
+	capture := &domain.FailureCapture{
+		BodySize:   int64(len(body)),
+		CapturedAt: time.Now().UTC(),
+	}
+
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+		entry.ResponseHeaders = redactHeaders(resp.Header)
+		capture.Headers = entry.ResponseHeaders
+	}
+
+	capture.BodySnippet, capture.BodyTruncated = truncateBody(body, opts.BodyLimit)
+	capture.Entries = []domain.HAREntry{entry}
+
+	return capture
+}
+
+// redactHeaders сворачивает заголовки в map, маскируя чувствительные значения
+func redactHeaders(headers http.Header) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(headers))
+	for key, values := range headers {
+		if sensitiveHeaders[strings.ToLower(key)] {
+			result[key] = redactedHeaderValue
+			continue
+		}
+		result[key] = strings.Join(values, ", ")
+	}
+	return result
+}
+
+// truncateBody обрезает тело до limit байт, не разрывая UTF-8 последовательности
+func truncateBody(body []byte, limit int) (string, bool) {
+	if len(body) <= limit {
+		return string(body), false
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+	return string(body[:cut]), true
+}
@@ -0,0 +1,359 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// Типы DNS записей, которые разрешает проверка
+const (
+	DNSRecordA     = "A"
+	DNSRecordAAAA  = "AAAA"
+	DNSRecordCNAME = "CNAME"
+	DNSRecordMX    = "MX"
+	DNSRecordTXT   = "TXT"
+)
+
+const (
+	// dnsCheckTimeout время на разрешение записи, включая повторы резолвера
+	dnsCheckTimeout = 10 * time.Second
+	// defaultDNSPort порт резолвера, если в config.resolver он не указан
+	defaultDNSPort = "53"
+)
+
+// Ключи метаданных результата DNS проверки
+const (
+	MetadataKeyDNSName       = "dns_name"
+	MetadataKeyDNSRecordType = "dns_record_type"
+	MetadataKeyDNSResolver   = "dns_resolver"
+	MetadataKeyDNSRecords    = "dns_records"
+	MetadataKeyDNSLatencyMs  = "dns_resolution_ms"
+)
+
+// DNSResolverSystem значение метаданных dns_resolver, если используется резолвер системы
+const DNSResolverSystem = "system"
+
+// dnsRecordTypes поддерживаемые типы записей
+var dnsRecordTypes = map[string]bool{
+	DNSRecordA:     true,
+	DNSRecordAAAA:  true,
+	DNSRecordCNAME: true,
+	DNSRecordMX:    true,
+	DNSRecordTXT:   true,
+}
+
+// DNSChecker реализует Checker для проверок DNS записей: разрешает запись A, AAAA, CNAME, MX
+// или TXT через резолвер системы или заданный в config.resolver и сравнивает ответ с
+// config.expected. Время разрешения попадает в DurationMs и Timings.DNSLookupMs
+type DNSChecker struct {
+	*BaseChecker
+	dialer *net.Dialer
+	now    func() time.Time
+}
+
+// NewDNSChecker создает checker DNS записей
+func NewDNSChecker(log logger.Logger) *DNSChecker {
+	return &DNSChecker{
+		BaseChecker: NewBaseChecker(log),
+		dialer:      &net.Dialer{Timeout: dnsCheckTimeout},
+		now:         time.Now,
+	}
+}
+
+// SetTargetPolicy реализует TargetRestricted: политика применяется к резолверу из config.resolver.
+// Резолвер системы задает оператор, поэтому запросы к нему не ограничиваются
+func (d *DNSChecker) SetTargetPolicy(policy *TargetPolicy) {
+	d.dialer = policy.Dialer(dnsCheckTimeout)
+}
+
+// Execute выполняет DNS проверку
+func (d *DNSChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
+	d.logger.Debug("Starting DNS check",
+		logger.String("check_id", task.CheckID),
+		logger.String("execution_id", task.ExecutionID),
+		logger.String("target", task.Target),
+	)
+
+	if err := d.ValidateConfig(task.Config); err != nil {
+		return nil, err
+	}
+
+	target := task.Target
+	if name, ok := task.Config["name"].(string); ok && name != "" {
+		target = name
+	}
+	name, err := DNSName(target)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid DNS check target")
+	}
+	recordType := DNSRecordTypeFromConfig(task.Config)
+	expected := dnsExpectedValues(task.Config)
+
+	resolver := net.DefaultResolver
+	resolverName := DNSResolverSystem
+	if value, ok := task.Config["resolver"].(string); ok && value != "" {
+		resolverName = withDNSPort(value)
+		resolver = d.resolver(resolverName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	records, lookupErr := lookupDNSRecords(ctx, resolver, recordType, name)
+	latency := time.Since(start)
+
+	result := &domain.CheckResult{
+		CheckID:     task.CheckID,
+		ExecutionID: task.ExecutionID,
+		DurationMs:  latency.Milliseconds(),
+		CheckedAt:   d.now().UTC(),
+		Timings: &domain.CheckTimings{
+			DNSLookupMs: latency.Milliseconds(),
+			TotalMs:     latency.Milliseconds(),
+		},
+		Metadata: map[string]string{
+			MetadataKeyDNSName:       name,
+			MetadataKeyDNSRecordType: recordType,
+			MetadataKeyDNSResolver:   resolverName,
+			MetadataKeyDNSLatencyMs:  fmt.Sprintf("%d", latency.Milliseconds()),
+		},
+	}
+
+	switch {
+	case lookupErr != nil:
+		result.Error = fmt.Sprintf("%s lookup for %s failed: %v", recordType, name, lookupErr)
+	case len(records) == 0:
+		result.Error = fmt.Sprintf("no %s records found for %s", recordType, name)
+	default:
+		result.Metadata[MetadataKeyDNSRecords] = strings.Join(records, ",")
+		if missing := missingDNSValues(recordType, records, expected); len(missing) > 0 {
+			result.Error = fmt.Sprintf("%s records for %s do not contain expected values: %s (got %s)",
+				recordType, name, strings.Join(missing, ", "), strings.Join(records, ", "))
+		} else {
+			result.Success = true
+		}
+	}
+
+	d.logger.Debug("DNS check completed",
+		logger.String("check_id", task.CheckID),
+		logger.String("name", name),
+		logger.String("record_type", recordType),
+		logger.String("resolver", resolverName),
+		logger.Int64("duration_ms", result.DurationMs),
+		logger.Bool("success", result.Success),
+	)
+	return result, nil
+}
+
+// GetType возвращает тип checker'а
+func (d *DNSChecker) GetType() domain.TaskType {
+	return domain.TaskTypeDNS
+}
+
+// ValidateConfig валидирует конфигурацию DNS проверки
+func (d *DNSChecker) ValidateConfig(config map[string]interface{}) error {
+	if value, ok := config["record_type"]; ok {
+		recordType, _ := value.(string)
+		if !dnsRecordTypes[strings.ToUpper(recordType)] {
+			return errors.New(errors.ErrValidation, "record_type is not supported").
+				WithDetails("allowed values: A, AAAA, CNAME, MX, TXT")
+		}
+	}
+	if value, ok := config["resolver"]; ok {
+		resolver, _ := value.(string)
+		host, _, err := net.SplitHostPort(withDNSPort(resolver))
+		if strings.TrimSpace(resolver) == "" || strings.ContainsAny(resolver, "/ ") || err != nil || host == "" {
+			return errors.New(errors.ErrValidation, "resolver must be a host or host:port")
+		}
+	}
+	if value, ok := config["expected"]; ok {
+		switch v := value.(type) {
+		case string:
+		case []string:
+		case []interface{}:
+			for _, item := range v {
+				if _, ok := item.(string); !ok {
+					return errors.New(errors.ErrValidation, "expected must be a string or a list of strings")
+				}
+			}
+		default:
+			return errors.New(errors.ErrValidation, "expected must be a string or a list of strings")
+		}
+	}
+	return nil
+}
+
+// DNSRecordTypeFromConfig возвращает тип записи из config.record_type, по умолчанию A
+func DNSRecordTypeFromConfig(config map[string]interface{}) string {
+	if value, ok := config["record_type"].(string); ok && value != "" {
+		return strings.ToUpper(value)
+	}
+	return DNSRecordA
+}
+
+// DNSName возвращает имя для разрешения из цели проверки: URL и host:port сводятся к имени хоста
+func DNSName(target string) (string, error) {
+	host := strings.TrimSpace(target)
+	if strings.Contains(host, "://") {
+		parsed, err := url.Parse(host)
+		if err != nil {
+			return "", err
+		}
+		host = parsed.Hostname()
+	} else {
+		host, _, _ = strings.Cut(host, "/")
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" || net.ParseIP(host) != nil || strings.ContainsAny(host, " :") {
+		return "", fmt.Errorf("target %q is not a domain name", target)
+	}
+	return host, nil
+}
+
+// resolver возвращает резолвер, отправляющий запросы на адрес server вместо резолвера системы
+func (d *DNSChecker) resolver(server string) *net.Resolver {
+	dialer := d.dialer
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, server)
+		},
+	}
+}
+
+// lookupDNSRecords разрешает записи типа recordType и возвращает их в нормализованном виде:
+// имена в нижнем регистре без завершающей точки, MX в виде "preference host"
+func lookupDNSRecords(ctx context.Context, resolver *net.Resolver, recordType, name string) ([]string, error) {
+	var records []string
+	switch recordType {
+	case DNSRecordA, DNSRecordAAAA:
+		network := "ip4"
+		if recordType == DNSRecordAAAA {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			records = append(records, ip.String())
+		}
+	case DNSRecordCNAME:
+		cname, err := resolver.LookupCNAME(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		// Имя без CNAME записи разрешается само в себя
+		if cname = normalizeDNSName(cname); cname != name {
+			records = append(records, cname)
+		}
+	case DNSRecordMX:
+		mxs, err := resolver.LookupMX(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, mx := range mxs {
+			records = append(records, fmt.Sprintf("%d %s", mx.Pref, normalizeDNSName(mx.Host)))
+		}
+	case DNSRecordTXT:
+		txts, err := resolver.LookupTXT(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, txts...)
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", recordType)
+	}
+	sort.Strings(records)
+	return records, nil
+}
+
+// missingDNSValues возвращает ожидаемые значения, которых нет среди записей. Адреса сравниваются
+// как IP, имена - без учета регистра и завершающей точки; ожидаемое значение MX без приоритета
+// совпадает с записью с любым приоритетом
+func missingDNSValues(recordType string, records, expected []string) []string {
+	var missing []string
+	for _, want := range expected {
+		found := false
+		for _, record := range records {
+			if dnsValueMatches(recordType, record, want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, want)
+		}
+	}
+	return missing
+}
+
+// dnsValueMatches сравнивает запись с ожидаемым значением
+func dnsValueMatches(recordType, record, want string) bool {
+	want = strings.TrimSpace(want)
+	switch recordType {
+	case DNSRecordA, DNSRecordAAAA:
+		ip := net.ParseIP(want)
+		return ip != nil && ip.Equal(net.ParseIP(record))
+	case DNSRecordCNAME:
+		return normalizeDNSName(want) == record
+	case DNSRecordMX:
+		pref, host, _ := strings.Cut(record, " ")
+		if wantPref, wantHost, ok := strings.Cut(want, " "); ok {
+			return wantPref == pref && normalizeDNSName(wantHost) == host
+		}
+		return normalizeDNSName(want) == host
+	default:
+		return record == want
+	}
+}
+
+// dnsExpectedValues возвращает ожидаемые значения из config.expected: строка или список строк
+func dnsExpectedValues(config map[string]interface{}) []string {
+	switch v := config["expected"].(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// normalizeDNSName приводит имя к нижнему регистру без завершающей точки
+func normalizeDNSName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// withDNSPort дополняет адрес резолвера портом 53, если порт не указан
+func withDNSPort(server string) string {
+	server = strings.TrimSpace(server)
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+	return net.JoinHostPort(strings.Trim(server, "[]"), defaultDNSPort)
+}
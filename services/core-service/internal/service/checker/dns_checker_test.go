@@ -0,0 +1,204 @@
+package checker
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+func newTestDNSChecker(t *testing.T) *DNSChecker {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	return NewDNSChecker(log)
+}
+
+func dnsTask(target string, config map[string]interface{}) *domain.Task {
+	return domain.NewTask("check-1", target, "dns", "exec-1", time.Now(), config)
+}
+
+// newDNSServer запускает UDP DNS сервер с ответами по имени и типу записи; на остальные
+// вопросы отвечает NXDOMAIN
+func newDNSServer(t *testing.T, answers map[dnsmessage.Type][]dnsmessage.Resource) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var query dnsmessage.Message
+			if err := query.Unpack(buf[:n]); err != nil || len(query.Questions) == 0 {
+				continue
+			}
+			question := query.Questions[0]
+			response := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: query.ID, Response: true, Authoritative: true, RecursionAvailable: true},
+				Questions: query.Questions,
+			}
+			for _, answer := range answers[question.Type] {
+				if strings.EqualFold(answer.Header.Name.String(), question.Name.String()) {
+					response.Answers = append(response.Answers, answer)
+				}
+			}
+			if len(response.Answers) == 0 {
+				response.RCode = dnsmessage.RCodeNameError
+			}
+			packed, err := response.Pack()
+			if err != nil {
+				continue
+			}
+			conn.WriteTo(packed, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func dnsHeader(name string, recordType dnsmessage.Type) dnsmessage.ResourceHeader {
+	return dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: recordType, Class: dnsmessage.ClassINET, TTL: 60}
+}
+
+func testDNSAnswers() map[dnsmessage.Type][]dnsmessage.Resource {
+	return map[dnsmessage.Type][]dnsmessage.Resource{
+		dnsmessage.TypeA: {
+			{Header: dnsHeader("example.test.", dnsmessage.TypeA), Body: &dnsmessage.AResource{A: [4]byte{192, 0, 2, 10}}},
+			{Header: dnsHeader("example.test.", dnsmessage.TypeA), Body: &dnsmessage.AResource{A: [4]byte{192, 0, 2, 11}}},
+		},
+		dnsmessage.TypeAAAA: {
+			{Header: dnsHeader("example.test.", dnsmessage.TypeAAAA), Body: &dnsmessage.AAAAResource{AAAA: [16]byte{0x20, 0x01, 0x0d, 0xb8, 15: 1}}},
+		},
+		dnsmessage.TypeCNAME: {
+			{Header: dnsHeader("www.example.test.", dnsmessage.TypeCNAME), Body: &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("Edge.CDN.test.")}},
+		},
+		dnsmessage.TypeMX: {
+			{Header: dnsHeader("example.test.", dnsmessage.TypeMX), Body: &dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mx1.example.test.")}},
+			{Header: dnsHeader("example.test.", dnsmessage.TypeMX), Body: &dnsmessage.MXResource{Pref: 20, MX: dnsmessage.MustNewName("mx2.example.test.")}},
+		},
+		dnsmessage.TypeTXT: {
+			{Header: dnsHeader("example.test.", dnsmessage.TypeTXT), Body: &dnsmessage.TXTResource{TXT: []string{"v=spf1 -all"}}},
+		},
+	}
+}
+
+func TestDNSName(t *testing.T) {
+	cases := map[string]string{
+		"Example.com.":                "example.com",
+		"https://api.example.com/v1":  "api.example.com",
+		"mail.example.com:25":         "mail.example.com",
+		"status.example.org/path?x=1": "status.example.org",
+	}
+	for target, want := range cases {
+		got, err := DNSName(target)
+		require.NoError(t, err, target)
+		assert.Equal(t, want, got, target)
+	}
+	for _, target := range []string{"", "192.0.2.1", "http://[2001:db8::1]/"} {
+		_, err := DNSName(target)
+		assert.Error(t, err, target)
+	}
+}
+
+func TestDNSChecker_Records(t *testing.T) {
+	server := newDNSServer(t, testDNSAnswers())
+	checker := newTestDNSChecker(t)
+
+	cases := []struct {
+		name     string
+		target   string
+		config   map[string]interface{}
+		records  string
+		success  bool
+		errorHas string
+	}{
+		{name: "A default", target: "example.test", config: map[string]interface{}{"expected": "192.0.2.11"},
+			records: "192.0.2.10,192.0.2.11", success: true},
+		{name: "AAAA", target: "example.test", config: map[string]interface{}{"record_type": "aaaa", "expected": []interface{}{"2001:db8:0:0::1"}},
+			records: "2001:db8::1", success: true},
+		{name: "CNAME", target: "https://www.example.test/", config: map[string]interface{}{"record_type": "CNAME", "expected": "edge.cdn.test."},
+			records: "edge.cdn.test", success: true},
+		{name: "MX any preference", target: "example.test", config: map[string]interface{}{"record_type": "MX", "expected": []interface{}{"mx2.example.test", "10 mx1.example.test"}},
+			records: "10 mx1.example.test,20 mx2.example.test", success: true},
+		{name: "TXT", target: "example.test", config: map[string]interface{}{"record_type": "TXT", "expected": "v=spf1 -all"},
+			records: "v=spf1 -all", success: true},
+		{name: "no expectations", target: "example.test", config: map[string]interface{}{"record_type": "TXT"},
+			records: "v=spf1 -all", success: true},
+		{name: "unexpected value", target: "example.test", config: map[string]interface{}{"expected": []interface{}{"192.0.2.10", "198.51.100.1"}},
+			records: "192.0.2.10,192.0.2.11", errorHas: "198.51.100.1"},
+		{name: "MX wrong preference", target: "example.test", config: map[string]interface{}{"record_type": "MX", "expected": "30 mx1.example.test"},
+			records: "10 mx1.example.test,20 mx2.example.test", errorHas: "30 mx1.example.test"},
+		{name: "NXDOMAIN", target: "missing.example.test", config: map[string]interface{}{},
+			errorHas: "A lookup for missing.example.test failed"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.config["resolver"] = server
+			result, err := checker.Execute(dnsTask(tc.target, tc.config))
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.success, result.Success, result.Error)
+			assert.Equal(t, tc.records, result.Metadata[MetadataKeyDNSRecords])
+			assert.Equal(t, server, result.Metadata[MetadataKeyDNSResolver])
+			assert.NotEmpty(t, result.Metadata[MetadataKeyDNSLatencyMs])
+			require.NotNil(t, result.Timings)
+			assert.Equal(t, result.DurationMs, result.Timings.DNSLookupMs)
+			if tc.errorHas != "" {
+				assert.Contains(t, result.Error, tc.errorHas)
+			}
+		})
+	}
+}
+
+func TestDNSChecker_TargetPolicy(t *testing.T) {
+	server := newDNSServer(t, testDNSAnswers())
+	policy, err := NewDefaultTargetPolicy(nil, nil)
+	require.NoError(t, err)
+
+	checker := newTestDNSChecker(t)
+	checker.SetTargetPolicy(policy)
+	result, err := checker.Execute(dnsTask("example.test", map[string]interface{}{"resolver": server}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.NotEmpty(t, result.Error)
+}
+
+func TestDNSChecker_ValidateConfig(t *testing.T) {
+	checker := newTestDNSChecker(t)
+
+	valid := []map[string]interface{}{
+		{},
+		{"record_type": "mx", "expected": "10 mx.example.com"},
+		{"resolver": "1.1.1.1"},
+		{"resolver": "[2606:4700:4700::1111]:53", "expected": []string{"192.0.2.1"}},
+	}
+	for _, config := range valid {
+		assert.NoError(t, checker.ValidateConfig(config), config)
+	}
+
+	invalid := []map[string]interface{}{
+		{"record_type": "SRV"},
+		{"record_type": 1.0},
+		{"resolver": ""},
+		{"resolver": "https://dns.example/dns-query"},
+		{"expected": 1.0},
+		{"expected": []interface{}{"192.0.2.1", true}},
+	}
+	for _, config := range invalid {
+		assert.Error(t, checker.ValidateConfig(config), config)
+	}
+
+	_, err := checker.Execute(dnsTask("example.test", map[string]interface{}{"record_type": "PTR"}))
+	assert.Error(t, err)
+	_, err = checker.Execute(dnsTask("192.0.2.1", map[string]interface{}{}))
+	assert.Error(t, err)
+}
@@ -0,0 +1,497 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/publicsuffix"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// Значения по умолчанию проверки срока регистрации домена
+const (
+	// DefaultRDAPURL сервис начальной загрузки RDAP: перенаправляет запрос к RDAP серверу реестра зоны
+	DefaultRDAPURL = "https://rdap.org/domain/"
+	// DefaultWHOISServer сервер WHOIS IANA: возвращает ссылку на WHOIS сервер реестра зоны
+	DefaultWHOISServer = "whois.iana.org:43"
+	// DefaultExpiryWindowDays за сколько дней до окончания регистрации проверка становится неуспешной
+	DefaultExpiryWindowDays = 30
+	// maxExpiryWindowDays наибольшее окно предупреждения
+	maxExpiryWindowDays = 365
+	// domainCheckTimeout время на оба запроса (RDAP и WHOIS) одной проверки
+	domainCheckTimeout = 30 * time.Second
+	// maxWHOISResponse наибольший читаемый ответ WHOIS
+	maxWHOISResponse = 1 << 20
+	// maxWHOISReferrals сколько ссылок на другой WHOIS сервер проходится за проверку
+	maxWHOISReferrals = 2
+)
+
+// Ключи метаданных результата проверки срока регистрации домена
+const (
+	MetadataKeyDomain            = "domain"
+	MetadataKeyDomainExpiresAt   = "domain_expires_at"
+	MetadataKeyDomainDaysLeft    = "domain_days_until_expiry"
+	MetadataKeyDomainSource      = "domain_expiry_source"
+	MetadataKeyDomainWindowDays  = "domain_expiry_window_days"
+	MetadataKeyDomainWHOISServer = "domain_whois_server"
+)
+
+// Источники даты окончания регистрации
+const (
+	DomainExpirySourceRDAP  = "rdap"
+	DomainExpirySourceWHOIS = "whois"
+)
+
+// whoisExpiryKeys поля ответа WHOIS с датой окончания регистрации у разных реестров и регистраторов.
+// Сравниваются в нижнем регистре, в порядке приоритета
+var whoisExpiryKeys = []string{
+	"registry expiry date",                   // gTLD (Verisign, PIR, Identity Digital)
+	"registrar registration expiration date", // gTLD, ответ регистратора
+	"expiration date",
+	"expiry date",
+	"expires on",
+	"expires",         // .ca, .pl
+	"expire date",     // .kr
+	"expiration time", // .cn
+	"paid-till",       // .ru, .su, .рф
+	"free-date",       // .ru: дата освобождения, если paid-till отсутствует
+	"valid until",     // .nl, .ch
+	"renewal date",    // .uk
+	"expiry",          // .au
+	"domain expires",
+	"record expires on",
+	"有効期限", // .jp
+}
+
+// whoisDateLayouts форматы дат в ответах WHOIS
+var whoisDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006.01.02 15:04:05",
+	"2006.01.02",
+	"2006/01/02 15:04:05",
+	"2006/01/02",
+	"2006. 01. 02.",
+	"02-Jan-2006",
+	"02-January-2006",
+	"02.01.2006 15:04:05",
+	"02.01.2006",
+	"02/01/2006",
+	"January 2 2006",
+	"Mon Jan 2 15:04:05 MST 2006",
+	"Mon Jan 2 2006",
+	"20060102",
+}
+
+// DomainChecker реализует Checker для проверок срока регистрации домена. Дата окончания
+// запрашивается по RDAP, при неудаче - по WHOIS с переходом к серверу реестра зоны
+type DomainChecker struct {
+	*BaseChecker
+	client      *http.Client
+	dialer      *net.Dialer
+	rdapURL     string
+	whoisServer string
+	now         func() time.Time
+}
+
+// rdapDomain поля ответа RDAP (RFC 9083), нужные проверке
+type rdapDomain struct {
+	LDHName string      `json:"ldhName"`
+	Events  []rdapEvent `json:"events"`
+}
+
+// rdapEvent событие объекта RDAP
+type rdapEvent struct {
+	Action string `json:"eventAction"`
+	Date   string `json:"eventDate"`
+}
+
+// NewDomainChecker создает checker срока регистрации домена
+func NewDomainChecker(log logger.Logger) *DomainChecker {
+	return &DomainChecker{
+		BaseChecker: NewBaseChecker(log),
+		client:      &http.Client{Timeout: domainCheckTimeout},
+		dialer:      &net.Dialer{Timeout: domainCheckTimeout},
+		rdapURL:     DefaultRDAPURL,
+		whoisServer: DefaultWHOISServer,
+		now:         time.Now,
+	}
+}
+
+// SetTargetPolicy реализует TargetRestricted: политика применяется к запросам RDAP и WHOIS,
+// адреса которых могут быть заданы в конфигурации проверки или получены по ссылке WHOIS
+func (d *DomainChecker) SetTargetPolicy(policy *TargetPolicy) {
+	d.client.Transport = &http.Transport{DialContext: policy.DialContext}
+	d.dialer = policy.Dialer(domainCheckTimeout)
+}
+
+// WithRDAPURL задает базовый URL RDAP запросов; имя домена дописывается в конец
+func (d *DomainChecker) WithRDAPURL(rdapURL string) *DomainChecker {
+	d.rdapURL = rdapURL
+	return d
+}
+
+// WithWHOISServer задает начальный WHOIS сервер в формате host:port
+func (d *DomainChecker) WithWHOISServer(server string) *DomainChecker {
+	d.whoisServer = server
+	return d
+}
+
+// Execute выполняет проверку срока регистрации домена
+func (d *DomainChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
+	d.logger.Debug("Starting domain expiry check",
+		logger.String("check_id", task.CheckID),
+		logger.String("execution_id", task.ExecutionID),
+		logger.String("target", task.Target),
+	)
+
+	if err := d.ValidateConfig(task.Config); err != nil {
+		return nil, err
+	}
+
+	target := task.Target
+	if name, ok := task.Config["domain"].(string); ok && name != "" {
+		target = name
+	}
+	name, err := RegistrableDomain(target)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid domain target")
+	}
+	window := ExpiryWindowFromConfig(task.Config)
+	rdapURL := d.rdapURL
+	if value, ok := task.Config["rdap_url"].(string); ok && value != "" {
+		rdapURL = value
+	}
+	whoisServer := d.whoisServer
+	if value, ok := task.Config["whois_server"].(string); ok && value != "" {
+		whoisServer = withWHOISPort(value)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), domainCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	result := &domain.CheckResult{
+		CheckID:     task.CheckID,
+		ExecutionID: task.ExecutionID,
+		Metadata: map[string]string{
+			MetadataKeyDomain:           name,
+			MetadataKeyDomainWindowDays: fmt.Sprintf("%d", window),
+		},
+	}
+
+	expiresAt, rdapErr := d.lookupRDAP(ctx, rdapURL, name)
+	source := DomainExpirySourceRDAP
+	if rdapErr != nil {
+		var server string
+		var whoisErr error
+		expiresAt, server, whoisErr = d.lookupWHOIS(ctx, whoisServer, name)
+		source = DomainExpirySourceWHOIS
+		if server != "" {
+			result.Metadata[MetadataKeyDomainWHOISServer] = server
+		}
+		if whoisErr != nil {
+			result.DurationMs = time.Since(start).Milliseconds()
+			result.CheckedAt = d.now().UTC()
+			result.Error = fmt.Sprintf("domain expiry lookup failed: rdap: %v; whois: %v", rdapErr, whoisErr)
+			return result, nil
+		}
+	}
+
+	now := d.now().UTC()
+	daysLeft := int(expiresAt.Sub(now).Hours() / 24)
+	result.DurationMs = time.Since(start).Milliseconds()
+	result.CheckedAt = now
+	result.Metadata[MetadataKeyDomainExpiresAt] = expiresAt.UTC().Format(time.RFC3339)
+	result.Metadata[MetadataKeyDomainDaysLeft] = fmt.Sprintf("%d", daysLeft)
+	result.Metadata[MetadataKeyDomainSource] = source
+
+	switch {
+	case !expiresAt.After(now):
+		result.Error = fmt.Sprintf("domain %s registration expired at %s", name, expiresAt.UTC().Format(time.RFC3339))
+	case expiresAt.Before(now.AddDate(0, 0, window)):
+		result.Error = fmt.Sprintf("domain %s registration expires in %d days (%s), within the %d day window",
+			name, daysLeft, expiresAt.UTC().Format(time.RFC3339), window)
+	default:
+		result.Success = true
+	}
+
+	d.logger.Debug("Domain expiry check completed",
+		logger.String("check_id", task.CheckID),
+		logger.String("domain", name),
+		logger.String("source", source),
+		logger.Int("days_left", daysLeft),
+		logger.Bool("success", result.Success),
+	)
+	return result, nil
+}
+
+// GetType возвращает тип checker'а
+func (d *DomainChecker) GetType() domain.TaskType {
+	return domain.TaskTypeDomain
+}
+
+// ValidateConfig валидирует конфигурацию проверки срока регистрации домена
+func (d *DomainChecker) ValidateConfig(config map[string]interface{}) error {
+	if value, ok := config["expiry_window_days"]; ok {
+		days, ok := value.(float64)
+		if !ok || days != float64(int(days)) || days < 1 || days > maxExpiryWindowDays {
+			return errors.New(errors.ErrValidation, "expiry_window_days must be a whole number of days").
+				WithDetails(fmt.Sprintf("allowed range: 1..%d", maxExpiryWindowDays))
+		}
+	}
+	if value, ok := config["rdap_url"]; ok {
+		rdapURL, _ := value.(string)
+		parsed, err := url.Parse(rdapURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return errors.New(errors.ErrValidation, "rdap_url must be an http or https URL")
+		}
+	}
+	if value, ok := config["whois_server"]; ok {
+		if server, _ := value.(string); strings.TrimSpace(server) == "" {
+			return errors.New(errors.ErrValidation, "whois_server must be a host or host:port")
+		}
+	}
+	return nil
+}
+
+// ExpiryWindowFromConfig возвращает окно предупреждения в днях из config.expiry_window_days
+func ExpiryWindowFromConfig(config map[string]interface{}) int {
+	if days, ok := config["expiry_window_days"].(float64); ok && days >= 1 {
+		return int(days)
+	}
+	return DefaultExpiryWindowDays
+}
+
+// RegistrableDomain возвращает регистрируемый домен цели: для URL, host:port или поддомена -
+// домен на уровень ниже публичного суффикса (www.shop.example.co.uk -> example.co.uk)
+func RegistrableDomain(target string) (string, error) {
+	host := strings.TrimSpace(target)
+	if strings.Contains(host, "://") {
+		parsed, err := url.Parse(host)
+		if err != nil {
+			return "", err
+		}
+		host = parsed.Hostname()
+	} else {
+		host, _, _ = strings.Cut(host, "/")
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" || net.ParseIP(host) != nil {
+		return "", fmt.Errorf("target %q is not a domain name", target)
+	}
+	return publicsuffix.EffectiveTLDPlusOne(host)
+}
+
+// lookupRDAP запрашивает объект домена по RDAP и возвращает дату события expiration
+func (d *DomainChecker) lookupRDAP(ctx context.Context, rdapURL, name string) (time.Time, error) {
+	if !strings.HasSuffix(rdapURL, "/") {
+		rdapURL += "/"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rdapURL+url.PathEscape(name), nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	req.Header.Set("Accept", "application/rdap+json, application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return time.Time{}, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	var object rdapDomain
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxWHOISResponse)).Decode(&object); err != nil {
+		return time.Time{}, fmt.Errorf("invalid RDAP response: %w", err)
+	}
+	for _, event := range object.Events {
+		if !strings.EqualFold(event.Action, "expiration") {
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, event.Date)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid expiration date %q: %w", event.Date, err)
+		}
+		return expiresAt, nil
+	}
+	return time.Time{}, fmt.Errorf("RDAP response has no expiration event")
+}
+
+// lookupWHOIS запрашивает WHOIS, переходя по ссылкам refer/whois к серверу реестра и регистратора,
+// и возвращает дату окончания из самого точного ответа вместе с сервером, который ее вернул
+func (d *DomainChecker) lookupWHOIS(ctx context.Context, server, name string) (time.Time, string, error) {
+	var (
+		expiresAt time.Time
+		found     string
+		lastErr   error
+	)
+	visited := make(map[string]bool)
+	for hop := 0; hop <= maxWHOISReferrals && server != "" && !visited[server]; hop++ {
+		visited[server] = true
+		response, err := d.queryWHOIS(ctx, server, name)
+		if err != nil {
+			lastErr = err
+			break
+		}
+		if date, ok := ParseWHOISExpiry(response); ok {
+			expiresAt, found = date, server
+		}
+		server = whoisReferral(response)
+	}
+
+	if found != "" {
+		return expiresAt, found, nil
+	}
+	if lastErr != nil {
+		return time.Time{}, "", lastErr
+	}
+	return time.Time{}, "", fmt.Errorf("WHOIS response has no expiration date")
+}
+
+// queryWHOIS отправляет запрос по протоколу WHOIS (RFC 3912) и читает ответ целиком
+func (d *DomainChecker) queryWHOIS(ctx context.Context, server, name string) (string, error) {
+	conn, err := d.dialer.DialContext(ctx, "tcp", server)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if _, err := io.WriteString(conn, whoisQuery(server, name)+"\r\n"); err != nil {
+		return "", err
+	}
+	response, err := io.ReadAll(io.LimitReader(conn, maxWHOISResponse))
+	if err != nil {
+		return "", err
+	}
+	return string(response), nil
+}
+
+// whoisQuery строка запроса к серверу: часть реестров без флагов отвечает в сокращенном формате
+// без даты окончания
+func whoisQuery(server, name string) string {
+	host, _, _ := net.SplitHostPort(server)
+	switch host {
+	case "whois.denic.de":
+		return "-T dn,ace " + name
+	case "whois.jprs.jp":
+		return name + "/e"
+	case "whois.verisign-grs.com":
+		return "domain " + name
+	}
+	return name
+}
+
+// whoisReferral возвращает сервер, на который ответ WHOIS ссылается как на более точный источник
+func whoisReferral(response string) string {
+	for _, line := range strings.Split(response, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "refer", "whois", "registrar whois server", "whois server":
+			value = strings.TrimSpace(value)
+			value = strings.TrimPrefix(strings.TrimPrefix(value, "whois://"), "rwhois://")
+			if value != "" && !strings.ContainsAny(value, " /") {
+				return withWHOISPort(value)
+			}
+		}
+	}
+	return ""
+}
+
+// withWHOISPort дописывает стандартный порт WHOIS, если он не указан
+func withWHOISPort(server string) string {
+	if _, _, err := net.SplitHostPort(server); err == nil {
+		return server
+	}
+	return net.JoinHostPort(server, "43")
+}
+
+// ParseWHOISExpiry ищет в ответе WHOIS дату окончания регистрации. Поддерживаются строки вида
+// "Key: value", "Key.......: value" и "[Key] value" (JPRS) и распространенные форматы дат
+func ParseWHOISExpiry(response string) (time.Time, bool) {
+	values := make(map[string]string)
+	for _, line := range strings.Split(response, "\n") {
+		key, value, ok := whoisField(line)
+		if !ok {
+			continue
+		}
+		if _, exists := values[key]; !exists {
+			values[key] = value
+		}
+	}
+	for _, key := range whoisExpiryKeys {
+		if value, ok := values[key]; ok {
+			if date, ok := parseWHOISDate(value); ok {
+				return date, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// whoisField разбирает строку ответа WHOIS на ключ в нижнем регистре и значение
+func whoisField(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "%") || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ">>>") {
+		return "", "", false
+	}
+	var key, value string
+	if strings.HasPrefix(line, "[") {
+		end := strings.Index(line, "]")
+		if end < 0 {
+			return "", "", false
+		}
+		key, value = line[1:end], line[end+1:]
+	} else {
+		var ok bool
+		if key, value, ok = strings.Cut(line, ":"); !ok {
+			return "", "", false
+		}
+	}
+	key = strings.ToLower(strings.TrimRight(strings.TrimSpace(key), ". "))
+	value = strings.TrimSpace(value)
+	if key == "" || value == "" {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// parseWHOISDate разбирает дату в одном из форматов whoisDateLayouts. Пояснения после даты,
+// например "(JST)" или "UTC", отбрасываются, если без них значение разбирается
+func parseWHOISDate(value string) (time.Time, bool) {
+	value, _, _ = strings.Cut(value, "(")
+	fields := strings.Fields(value)
+	for n := len(fields); n > 0; n-- {
+		candidate := strings.Join(fields[:n], " ")
+		for _, layout := range whoisDateLayouts {
+			if date, err := time.Parse(layout, candidate); err == nil {
+				return date, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
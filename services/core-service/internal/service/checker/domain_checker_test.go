@@ -0,0 +1,208 @@
+package checker
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+var domainCheckNow = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestDomainChecker(t *testing.T) *DomainChecker {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	checker := NewDomainChecker(log)
+	checker.now = func() time.Time { return domainCheckNow }
+	return checker
+}
+
+func domainTask(target string, config map[string]interface{}) *domain.Task {
+	return domain.NewTask("check-1", target, "domain", "exec-1", time.Now(), config)
+}
+
+// newWHOISServer запускает WHOIS сервер, отвечающий по имени запроса
+func newWHOISServer(t *testing.T, responses map[string]string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			query, _ := bufio.NewReader(conn).ReadString('\n')
+			conn.Write([]byte(responses[strings.TrimSpace(query)]))
+			conn.Close()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func TestRegistrableDomain(t *testing.T) {
+	cases := map[string]string{
+		"example.com":                   "example.com",
+		"https://www.Example.com/login": "example.com",
+		"api.shop.example.co.uk:443":    "example.co.uk",
+		"status.example.org.":           "example.org",
+		"mail.example.com/path?query=1": "example.com",
+	}
+	for target, want := range cases {
+		got, err := RegistrableDomain(target)
+		require.NoError(t, err, target)
+		assert.Equal(t, want, got, target)
+	}
+
+	for _, target := range []string{"", "127.0.0.1", "com", "https://[::1]:8080"} {
+		_, err := RegistrableDomain(target)
+		assert.Error(t, err, target)
+	}
+}
+
+func TestParseWHOISExpiry(t *testing.T) {
+	cases := map[string]struct {
+		response string
+		want     time.Time
+	}{
+		"gtld registry": {
+			response: "Domain Name: EXAMPLE.COM\r\nRegistry Expiry Date: 2026-08-13T04:00:00Z\r\n",
+			want:     time.Date(2026, 8, 13, 4, 0, 0, 0, time.UTC),
+		},
+		"registrar": {
+			response: "Registrar Registration Expiration Date: 2026-08-13T04:00:00.000Z\n",
+			want:     time.Date(2026, 8, 13, 4, 0, 0, 0, time.UTC),
+		},
+		"ru paid-till": {
+			response: "% TCI Whois Service\ndomain:        EXAMPLE.RU\npaid-till:     2026-11-30T21:00:00Z\nfree-date:     2027-01-01\n",
+			want:     time.Date(2026, 11, 30, 21, 0, 0, 0, time.UTC),
+		},
+		"uk renewal": {
+			response: "    Relevant dates:\n        Registered on: 26-Aug-1996\n        Expiry date:  10-Dec-2026\n",
+			want:     time.Date(2026, 12, 10, 0, 0, 0, 0, time.UTC),
+		},
+		"jprs bracket": {
+			response: "[Domain Name]                   EXAMPLE.JP\n[Expires on]                    2026/05/31\n",
+			want:     time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC),
+		},
+		"kr dotted": {
+			response: "Expiration Date             : 2026. 02. 10.\n",
+			want:     time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC),
+		},
+		"trailing zone note": {
+			response: "Expiration Time: 2026-07-01 12:00:00 (CST)\n",
+			want:     time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	for name, tc := range cases {
+		got, ok := ParseWHOISExpiry(tc.response)
+		require.True(t, ok, name)
+		assert.True(t, tc.want.Equal(got), "%s: got %s", name, got)
+	}
+
+	_, ok := ParseWHOISExpiry("No match for domain \"EXAMPLE.TEST\".\n")
+	assert.False(t, ok)
+}
+
+func TestDomainChecker_RDAP(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/rdap+json")
+		w.Write([]byte(`{"ldhName":"example.com","events":[
+			{"eventAction":"registration","eventDate":"1995-08-14T04:00:00Z"},
+			{"eventAction":"expiration","eventDate":"2026-08-13T04:00:00Z"}]}`))
+	}))
+	defer server.Close()
+
+	checker := newTestDomainChecker(t)
+	result, err := checker.Execute(domainTask("https://www.example.com", map[string]interface{}{
+		"rdap_url": server.URL + "/domain",
+	}))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, "/domain/example.com", path)
+	assert.Equal(t, "example.com", result.Metadata[MetadataKeyDomain])
+	assert.Equal(t, "2026-08-13T04:00:00Z", result.Metadata[MetadataKeyDomainExpiresAt])
+	assert.Equal(t, DomainExpirySourceRDAP, result.Metadata[MetadataKeyDomainSource])
+	assert.Equal(t, "165", result.Metadata[MetadataKeyDomainDaysLeft])
+
+	result, err = checker.Execute(domainTask("example.com", map[string]interface{}{
+		"rdap_url":           server.URL + "/domain/",
+		"expiry_window_days": float64(180),
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "expires in 165 days")
+}
+
+func TestDomainChecker_WHOISFallback(t *testing.T) {
+	rdap := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer rdap.Close()
+
+	registry := newWHOISServer(t, map[string]string{
+		"example.test": "Domain Name: EXAMPLE.TEST\r\nRegistry Expiry Date: 2026-03-10T00:00:00Z\r\n",
+	})
+	iana := newWHOISServer(t, map[string]string{
+		"example.test": "% IANA WHOIS server\nrefer:        " + registry + "\n",
+	})
+
+	checker := newTestDomainChecker(t).WithRDAPURL(rdap.URL).WithWHOISServer(iana)
+	result, err := checker.Execute(domainTask("example.test", map[string]interface{}{}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "within the 30 day window")
+	assert.Equal(t, DomainExpirySourceWHOIS, result.Metadata[MetadataKeyDomainSource])
+	assert.Equal(t, registry, result.Metadata[MetadataKeyDomainWHOISServer])
+	assert.Equal(t, "9", result.Metadata[MetadataKeyDomainDaysLeft])
+
+	expired := newWHOISServer(t, map[string]string{
+		"example.test": "Expiration Date: 2026-02-01\n",
+	})
+	result, err = checker.Execute(domainTask("example.test", map[string]interface{}{"whois_server": expired}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "registration expired")
+
+	unknown := newWHOISServer(t, map[string]string{})
+	result, err = checker.Execute(domainTask("example.test", map[string]interface{}{"whois_server": unknown}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "rdap: unexpected status 404")
+	assert.Contains(t, result.Error, "whois: WHOIS response has no expiration date")
+}
+
+func TestDomainChecker_ValidateConfig(t *testing.T) {
+	checker := newTestDomainChecker(t)
+
+	assert.NoError(t, checker.ValidateConfig(map[string]interface{}{}))
+	assert.NoError(t, checker.ValidateConfig(map[string]interface{}{
+		"expiry_window_days": float64(14),
+		"rdap_url":           "https://rdap.example/domain/",
+		"whois_server":       "whois.example",
+	}))
+
+	invalid := []map[string]interface{}{
+		{"expiry_window_days": float64(0)},
+		{"expiry_window_days": float64(400)},
+		{"expiry_window_days": 7.5},
+		{"expiry_window_days": "30"},
+		{"rdap_url": "ftp://rdap.example"},
+		{"whois_server": " "},
+	}
+	for _, config := range invalid {
+		assert.Error(t, checker.ValidateConfig(config), "%v", config)
+	}
+}
@@ -21,6 +21,8 @@ type DefaultCheckerFactory struct {
 	logger     logger.Logger
 	validator  *validation.Validator
 	httpClient HTTPClient
+	plugins    *PluginChecker
+	targets    *TargetPolicy
 }
 
 // NewDefaultCheckerFactory создает новую фабрику checker'ов
@@ -32,20 +34,53 @@ func NewDefaultCheckerFactory(logger logger.Logger, httpClient HTTPClient) *Defa
 	}
 }
 
+// WithPlugins включает проверки типа plugin внешними плагинами
+func (f *DefaultCheckerFactory) WithPlugins(plugins *PluginChecker) *DefaultCheckerFactory {
+	f.plugins = plugins
+	return f
+}
+
+// WithTargetPolicy ограничивает адреса, к которым checker'ы устанавливают соединения
+func (f *DefaultCheckerFactory) WithTargetPolicy(policy *TargetPolicy) *DefaultCheckerFactory {
+	f.targets = policy
+	return f
+}
+
 // CreateChecker создает checker для указанного типа
 func (f *DefaultCheckerFactory) CreateChecker(taskType domain.TaskType) (Checker, error) {
+	checker, err := f.createChecker(taskType)
+	if err != nil {
+		return nil, err
+	}
+	if restricted, ok := checker.(TargetRestricted); ok && f.targets != nil {
+		restricted.SetTargetPolicy(f.targets)
+	}
+	return checker, nil
+}
+
+// createChecker создает checker для указанного типа без политики целей
+func (f *DefaultCheckerFactory) createChecker(taskType domain.TaskType) (Checker, error) {
 	switch taskType {
 	case domain.TaskTypeHTTP:
 		return NewHTTPChecker(30000, f.logger), nil
 	case domain.TaskTypeTCP:
-		return NewTCPChecker(30000, &DefaultTCPDialer{}, f.logger), nil
-	case domain.TaskTypeICMP:
-		return NewICMPChecker(f.logger), nil
+		return NewTCPChecker(30000, &DefaultTCPDialer{Policy: f.targets}, f.logger), nil
+	case domain.TaskTypeICMP, domain.TaskTypePing:
+		return NewPingChecker(f.logger), nil
 	case domain.TaskTypeGRPC:
 		return NewGRPCChecker(f.logger), nil
 	case domain.TaskTypeGraphQL:
 		// Используем существующий GraphQLChecker
 		return NewGraphQLChecker(30000, f.logger), nil
+	case domain.TaskTypeDomain:
+		return NewDomainChecker(f.logger), nil
+	case domain.TaskTypeDNS:
+		return NewDNSChecker(f.logger), nil
+	case domain.TaskTypePlugin:
+		if f.plugins == nil {
+			return nil, fmt.Errorf("unsupported task type: %s: no checker plugins are loaded", taskType)
+		}
+		return f.plugins, nil
 	default:
 		return nil, fmt.Errorf("unsupported task type: %s", taskType)
 	}
@@ -53,13 +88,20 @@ func (f *DefaultCheckerFactory) CreateChecker(taskType domain.TaskType) (Checker
 
 // GetSupportedTypes возвращает список поддерживаемых типов
 func (f *DefaultCheckerFactory) GetSupportedTypes() []domain.TaskType {
-	return []domain.TaskType{
+	types := []domain.TaskType{
 		domain.TaskTypeHTTP,
 		domain.TaskTypeTCP,
 		domain.TaskTypeICMP,
+		domain.TaskTypePing,
 		domain.TaskTypeGRPC,
 		domain.TaskTypeGraphQL,
+		domain.TaskTypeDomain,
+		domain.TaskTypeDNS,
+	}
+	if f.plugins != nil {
+		types = append(types, domain.TaskTypePlugin)
 	}
+	return types
 }
 
 // DefaultHTTPClient реализация HTTPClient
@@ -113,7 +155,10 @@ func (c *DefaultHTTPClient) Do(req *http.Request) (*HTTPResponse, error) {
 }
 
 // DefaultTCPDialer реализация TCPDialer
-type DefaultTCPDialer struct{}
+type DefaultTCPDialer struct {
+	// Policy ограничивает адреса соединений; nil - без ограничений
+	Policy *TargetPolicy
+}
 
 // Dial устанавливает TCP соединение
 func (d *DefaultTCPDialer) Dial(address string, timeout int64) (*TCPConnection, error) {
@@ -126,6 +171,7 @@ func (d *DefaultTCPDialer) Dial(address string, timeout int64) (*TCPConnection,
 	// Создаем TCP connecter
 	connecter := &tcpConnecter{
 		address: address,
+		policy:  d.Policy,
 	}
 	
 	// Используем pkg/connection для retry логики
@@ -156,13 +202,12 @@ func (d *DefaultTCPDialer) Dial(address string, timeout int64) (*TCPConnection,
 // tcpConnecter реализует connection.Connecter для TCP
 type tcpConnecter struct {
 	address string
+	policy  *TargetPolicy
 	conn    net.Conn
 }
 
 func (t *tcpConnecter) Connect(ctx context.Context) error {
-	dialer := &net.Dialer{
-		Timeout: 5 * time.Second,
-	}
+	dialer := t.policy.Dialer(5 * time.Second)
 	
 	conn, err := dialer.DialContext(ctx, "tcp", t.address)
 	if err != nil {
@@ -184,51 +229,27 @@ func (t *tcpConnecter) IsConnected() bool {
 	return t.conn != nil
 }
 
-// icmpConnecter реализует connection.Connecter для ICMP
-type icmpConnecter struct {
-	target string
-}
-
-func (i *icmpConnecter) Connect(ctx context.Context) error {
-	// Упрощенная ICMP ping реализация через TCP порт 80
-	// Это надежная альтернатива настоящему ICMP ping
-	dialer := &net.Dialer{
-		Timeout: 5 * time.Second,
-	}
-	
-	conn, err := dialer.DialContext(ctx, "tcp", i.target+":80")
-	if err != nil {
-		return fmt.Errorf("ICMP ping to %s failed: %w", i.target, err)
-	}
-	conn.Close()
-	
-	return nil
-}
-
-func (i *icmpConnecter) Close() error {
-	// ICMP не требует закрытия соединения
-	return nil
-}
-
-func (i *icmpConnecter) IsConnected() bool {
-	// ICMP stateless, всегда считаем "подключенным"
-	return true
-}
-
 // grpcConnecter реализует connection.Connecter для gRPC
 type grpcConnecter struct {
 	address string
 	timeout time.Duration
+	policy  *TargetPolicy
 	conn    *grpc.ClientConn
 }
 
 func (g *grpcConnecter) Connect(ctx context.Context) error {
 	// Современная gRPC проверка через health service
 	// Используем неблокирующее подключение с контекстом
-	conn, err := grpc.DialContext(ctx, g.address, 
+	options := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithReturnConnectionError(),
-	)
+	}
+	if g.policy != nil {
+		options = append(options, grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return g.policy.DialContext(ctx, "tcp", address)
+		}))
+	}
+	conn, err := grpc.DialContext(ctx, g.address, options...)
 	if err != nil {
 		return fmt.Errorf("gRPC connection to %s failed: %w", g.address, err)
 	}
@@ -264,90 +285,6 @@ func (g *grpcConnecter) IsConnected() bool {
 	return g.conn != nil
 }
 
-// NewICMPChecker создает ICMP checker
-func NewICMPChecker(logger logger.Logger) Checker {
-	return &ICMPChecker{
-		BaseChecker: NewBaseChecker(logger),
-	}
-}
-
-// ICMPChecker реализует Checker для ICMP проверок
-type ICMPChecker struct {
-	*BaseChecker
-}
-
-// Execute выполняет ICMP проверку
-func (i *ICMPChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
-	i.logger.Debug("Starting ICMP check",
-		logger.String("check_id", task.CheckID),
-		logger.String("execution_id", task.ExecutionID),
-		logger.String("target", task.Target),
-	)
-
-	// Валидация конфигурации
-	if err := i.ValidateConfig(task.Config); err != nil {
-		return nil, err
-	}
-
-	// Реализация ICMP проверки с использованием pkg/connection
-	start := time.Now()
-	
-	// Создаем ICMP connecter
-	connecter := &icmpConnecter{
-		target: task.Target,
-	}
-	
-	// Используем pkg/connection для retry логики
-	retryConfig := connection.RetryConfig{
-		MaxAttempts:  3,
-		InitialDelay: 100 * time.Millisecond,
-		MaxDelay:     1 * time.Second,
-		Multiplier:   2.0,
-		Jitter:       true,
-	}
-	
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-	
-	err := connection.ConnectWithRetry(ctx, connecter, retryConfig)
-	duration := time.Since(start)
-	
-	success := err == nil
-	var errorMsg string
-	if err != nil {
-		errorMsg = err.Error()
-		i.logger.Warn("ICMP check failed",
-			logger.Error(err),
-			logger.String("target", task.Target),
-			logger.Duration("duration", duration))
-	} else {
-		i.logger.Info("ICMP check successful",
-			logger.String("target", task.Target),
-			logger.Duration("duration", duration))
-	}
-
-	return domain.NewCheckResult(
-		task.CheckID,
-		task.ExecutionID,
-		success,
-		duration.Milliseconds(),
-		0, // status_code
-		errorMsg,
-		"", // response_body
-	), nil
-}
-
-// GetType возвращает тип checker'а
-func (i *ICMPChecker) GetType() domain.TaskType {
-	return domain.TaskTypeICMP
-}
-
-// ValidateConfig валидирует ICMP конфигурацию
-func (i *ICMPChecker) ValidateConfig(config map[string]interface{}) error {
-	// ICMP проверки обычно не требуют конфигурации
-	return nil
-}
-
 // NewGRPCChecker создает gRPC checker
 func NewGRPCChecker(logger logger.Logger) Checker {
 	return &GRPCChecker{
@@ -358,6 +295,12 @@ func NewGRPCChecker(logger logger.Logger) Checker {
 // GRPCChecker реализует Checker для gRPC проверок
 type GRPCChecker struct {
 	*BaseChecker
+	targets *TargetPolicy
+}
+
+// SetTargetPolicy реализует TargetRestricted
+func (g *GRPCChecker) SetTargetPolicy(policy *TargetPolicy) {
+	g.targets = policy
 }
 
 // Execute выполняет gRPC проверку
@@ -380,6 +323,7 @@ func (g *GRPCChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
 	connecter := &grpcConnecter{
 		address: task.Target,
 		timeout: 30 * time.Second,
+		policy:  g.targets,
 	}
 	
 	// Используем pkg/connection для retry логики
@@ -68,6 +68,13 @@ func NewGraphQLChecker(timeout int64, log logger.Logger) *GraphQLChecker {
 	}
 }
 
+// SetTargetPolicy реализует TargetRestricted
+func (g *GraphQLChecker) SetTargetPolicy(policy *TargetPolicy) {
+	if transport, ok := g.client.Transport.(*http.Transport); ok {
+		transport.DialContext = policy.DialContext
+	}
+}
+
 // Execute выполняет GraphQL проверку
 func (g *GraphQLChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
 	// Валидация конфигурации
@@ -2,10 +2,12 @@ package checker
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptrace"
 	"regexp"
 	"strings"
 	"time"
@@ -20,6 +22,10 @@ import (
 type HTTPChecker struct {
 	*BaseChecker
 	client    *http.Client
+	// clients клиенты, согласующие только HTTP/1.1 или только HTTP/2 (config.http_version)
+	clients   map[string]*http.Client
+	// freshClients клиенты без keep-alive по версиям протокола (config.connection_reuse: fresh)
+	freshClients map[string]*http.Client
 	logger    logger.Logger
 	validator *validation.Validator
 }
@@ -46,16 +52,15 @@ type HTTPResponseDetails struct {
 
 // NewHTTPChecker создает новый HTTP checker
 func NewHTTPChecker(timeout int64, log logger.Logger) *HTTPChecker {
+	clientTimeout := time.Duration(timeout) * time.Millisecond
 	return &HTTPChecker{
 		BaseChecker: NewBaseChecker(log),
 		client: &http.Client{
-			Timeout: time.Duration(timeout) * time.Millisecond,
-			Transport: &http.Transport{
-				MaxIdleConns:        100,
-				MaxIdleConnsPerHost: 10,
-				IdleConnTimeout:     90 * time.Second,
-			},
+			Timeout:   clientTimeout,
+			Transport: newHTTPTransport(HTTPVersionAuto),
 		},
+		clients:   newProtocolClients(clientTimeout),
+		freshClients: newFreshClients(clientTimeout),
 		logger:    log,
 		validator: validation.NewValidator(),
 	}
@@ -63,6 +68,11 @@ func NewHTTPChecker(timeout int64, log logger.Logger) *HTTPChecker {
 
 // Execute выполняет HTTP проверку
 func (h *HTTPChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
+	return h.ExecuteWithProgress(context.Background(), task, nil)
+}
+
+// ExecuteWithProgress выполняет HTTP проверку, сообщая о фазах DNS, connect, TLS и first byte
+func (h *HTTPChecker) ExecuteWithProgress(ctx context.Context, task *domain.Task, progress ProgressFunc) (*domain.CheckResult, error) {
 	// Валидация конфигурации
 	if err := h.ValidateConfig(task.Config); err != nil {
 		return nil, fmt.Errorf("config validation failed: %w", err)
@@ -80,20 +90,42 @@ func (h *HTTPChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
 		return h.createErrorResult(task, 0, 0, fmt.Errorf("failed to create request: %w", err)), nil
 	}
 	
+	// Параметры диагностического снимка при неудаче
+	captureOpts := CaptureOptionsFromConfig(task.Config)
+	
+	// Требуемая версия протокола и политика переиспользования соединений
+	version := HTTPVersionFromConfig(task.Config)
+	reuse := ConnectionReuseFromConfig(task.Config)
+	
 	// Выполнение запроса с измерением времени
 	startTime := time.Now()
-	resp, err := h.client.Do(req)
+	timer := newPhaseTimer(startTime, progress)
+	req = req.WithContext(httptrace.WithClientTrace(ctx, timer.clientTrace()))
+	resp, err := h.clientFor(version, reuse).Do(req)
 	duration := time.Since(startTime)
 	
 	if err != nil {
-		return h.createErrorResult(task, 0, duration.Milliseconds(), fmt.Errorf("request failed: %w", err)), nil
+		if version != HTTPVersionAuto {
+			err = fmt.Errorf("http_version %s: %w", version, err)
+		}
+		result := h.createErrorResult(task, 0, duration.Milliseconds(), fmt.Errorf("request failed: %w", err))
+		result.Timings = timer.Timings(duration)
+		if captureOpts.Enabled {
+			result.Capture = newFailureCapture(captureOpts, req, nil, nil, startTime, duration, err)
+		}
+		return result, nil
 	}
 	defer resp.Body.Close()
 	
 	// Чтение тела ответа
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return h.createErrorResult(task, resp.StatusCode, duration.Milliseconds(), fmt.Errorf("failed to read response body: %w", err)), nil
+		result := h.createErrorResult(task, resp.StatusCode, duration.Milliseconds(), fmt.Errorf("failed to read response body: %w", err))
+		result.Timings = timer.Timings(duration)
+		if captureOpts.Enabled {
+			result.Capture = newFailureCapture(captureOpts, req, resp, body, startTime, duration, err)
+		}
+		return result, nil
 	}
 	
 	// Создание детальной информации об ответе
@@ -118,8 +150,48 @@ func (h *HTTPChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
 		bodyValidationSuccess, bodyValidationError = h.validateResponseBody(string(body), validationRules)
 	}
 	
+	// Утверждения о содержимом: каждое нарушение - отдельное сообщение в ошибке результата
+	var assertionFailures []string
+	if assertions, err := AssertionsFromConfig(task.Config); err != nil {
+		assertionFailures = []string{fmt.Sprintf("invalid assertions: %s", err.Error())}
+	} else {
+		assertionFailures = EvaluateAssertions(assertions, resp.Header, body)
+	}
+	
+	// Проверка согласованного протокола
+	metadata := make(map[string]string)
+	protocolErr := checkProtocol(version, resp, metadata)
+	metadata[MetadataKeyConnectionReuse] = reuse
+	if reused, ok := timer.ConnectionReused(); ok {
+		metadata[MetadataKeyConnectionReused] = fmt.Sprintf("%t", reused)
+	}
+	
+	// Политика TLS: предупреждения не влияют на успешность проверки
+	if resp.TLS != nil {
+		for code, message := range TLSPolicyFromConfig(task.Config).Evaluate(resp.TLS, time.Now(), metadata) {
+			h.logger.Debug("TLS policy warning",
+				logger.String("check_id", task.CheckID),
+				logger.String("warning", code),
+				logger.String("message", message))
+		}
+	}
+	
+	// Аудит заголовков безопасности и смешанного содержимого: как и политика TLS,
+	// только предупреждения
+	if audit, ok := SecurityAuditFromConfig(task.Config); ok {
+		for code, message := range audit.Evaluate(resp, body, metadata) {
+			h.logger.Debug("Security audit warning",
+				logger.String("check_id", task.CheckID),
+				logger.String("warning", code),
+				logger.String("message", message))
+		}
+	}
+	if len(WarningCodes(metadata)) > 0 {
+		metadata[MetadataKeyResultSeverity] = ResultSeverityWarning
+	}
+	
 	// Общая успешность проверки
-	success := statusSuccess && bodyValidationSuccess
+	success := statusSuccess && bodyValidationSuccess && len(assertionFailures) == 0 && protocolErr == nil
 	
 	// Формирование результата
 	result := &domain.CheckResult{
@@ -130,7 +202,8 @@ func (h *HTTPChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
 		StatusCode:   resp.StatusCode,
 		ResponseBody: string(body),
 		CheckedAt:    time.Now().UTC(),
-		Metadata:     make(map[string]string),
+		Metadata:     metadata,
+		Timings:      timer.Timings(duration),
 	}
 	
 	// Добавление метаданных
@@ -149,6 +222,26 @@ func (h *HTTPChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
 		result.Error += fmt.Sprintf("body validation failed: %s", bodyValidationError.Error())
 	}
 	
+	if len(assertionFailures) > 0 {
+		result.Metadata[MetadataKeyAssertionsFailed] = fmt.Sprintf("%d", len(assertionFailures))
+		if result.Error != "" {
+			result.Error += "; "
+		}
+		result.Error += strings.Join(assertionFailures, "; ")
+	}
+	
+	if protocolErr != nil {
+		if result.Error != "" {
+			result.Error += "; "
+		}
+		result.Error += fmt.Sprintf("protocol check failed: %s", protocolErr.Error())
+	}
+	
+	// Снимок заголовков и фрагмента тела для диагностики неудачной проверки
+	if !success && captureOpts.Enabled {
+		result.Capture = newFailureCapture(captureOpts, req, resp, body, startTime, duration, nil)
+	}
+	
 	return result, nil
 }
 
@@ -200,6 +293,65 @@ func (h *HTTPChecker) ValidateConfig(config map[string]interface{}) error {
 		}
 	}
 	
+	// Валидация версии протокола
+	if version, ok := config["http_version"]; ok {
+		versionStr, ok := version.(string)
+		if !ok {
+			return errors.New(errors.ErrValidation, "http_version must be a string")
+		}
+		if err := h.validator.ValidateEnum(versionStr, httpVersions, "http_version"); err != nil {
+			h.logger.Debug("HTTP config validation failed: invalid http_version", 
+				logger.String("http_version", versionStr),
+				logger.Error(err))
+			return errors.Wrap(err, errors.ErrValidation, "invalid http_version")
+		}
+	}
+	
+	// Валидация политики переиспользования соединений
+	if reuse, ok := config["connection_reuse"]; ok {
+		reuseStr, ok := reuse.(string)
+		if !ok {
+			return errors.New(errors.ErrValidation, "connection_reuse must be a string")
+		}
+		if err := h.validator.ValidateEnum(reuseStr, connectionReusePolicies, "connection_reuse"); err != nil {
+			h.logger.Debug("HTTP config validation failed: invalid connection_reuse", 
+				logger.String("connection_reuse", reuseStr),
+				logger.Error(err))
+			return errors.Wrap(err, errors.ErrValidation, "invalid connection_reuse")
+		}
+	}
+	
+	// Валидация политики TLS
+	if err := validateTLSPolicy(config); err != nil {
+		h.logger.Debug("HTTP config validation failed: invalid TLS policy", 
+			logger.Error(err))
+		return errors.Wrap(err, errors.ErrValidation, "invalid TLS policy")
+	}
+	
+	// Валидация аудита безопасности
+	if err := validateSecurityAudit(config); err != nil {
+		h.logger.Debug("HTTP config validation failed: invalid security audit", 
+			logger.Error(err))
+		return errors.Wrap(err, errors.ErrValidation, "invalid security audit")
+	}
+	
+	// Валидация утверждений о содержимом ответа
+	if err := validateAssertions(config); err != nil {
+		h.logger.Debug("HTTP config validation failed: invalid assertions", 
+			logger.Error(err))
+		return errors.Wrap(err, errors.ErrValidation, "invalid assertions")
+	}
+	
+	// Валидация лимита снимка тела ответа
+	if limit, ok := config["capture_body_limit"]; ok {
+		if value, ok := limit.(float64); !ok || value <= 0 || value > MaxCaptureBodyLimit {
+			err := fmt.Errorf("must be between 1 and %d bytes", MaxCaptureBodyLimit)
+			h.logger.Debug("HTTP config validation failed: invalid capture body limit", 
+				logger.Error(err))
+			return errors.Wrap(err, errors.ErrValidation, "invalid capture_body_limit")
+		}
+	}
+	
 	// Валидация таймаута
 	if timeout, ok := config["timeout"]; ok {
 		if timeoutStr, ok := timeout.(string); ok {
@@ -270,14 +422,20 @@ func (h *HTTPChecker) validateResponseBody(body string, rules []interface{}) (bo
 		switch ruleType {
 		case "json_path":
 			success, err := h.validateJSONPath(body, ruleMap)
-			if !success || err != nil {
+			if err != nil {
 				return false, err
 			}
+			if !success {
+				return false, fmt.Errorf("json_path rule %v is not satisfied", ruleMap["path"])
+			}
 		case "regex":
 			success, err := h.validateRegex(body, ruleMap)
-			if !success || err != nil {
+			if err != nil {
 				return false, err
 			}
+			if !success {
+				return false, fmt.Errorf("regex rule %v is not satisfied", ruleMap["path"])
+			}
 		default:
 			return false, fmt.Errorf("unsupported validation type: %s", ruleType)
 		}
@@ -431,9 +589,47 @@ func (h *HTTPChecker) createErrorResult(task *domain.Task, statusCode int, durat
 	}
 }
 
-// SetTimeout устанавливает таймаут HTTP клиента
+// clientFor возвращает клиента для версии протокола и политики соединений; auto использует
+// клиента по умолчанию
+func (h *HTTPChecker) clientFor(version, reuse string) *http.Client {
+	if reuse == ConnectionReuseFresh {
+		if client, ok := h.freshClients[version]; ok {
+			return client
+		}
+		return h.freshClients[HTTPVersionAuto]
+	}
+	if client, ok := h.clients[version]; ok {
+		return client
+	}
+	return h.client
+}
+
+// SetTargetPolicy реализует TargetRestricted: все клиенты соединяются через политику целей,
+// включая переходы по редиректам
+func (h *HTTPChecker) SetTargetPolicy(policy *TargetPolicy) {
+	clients := []*http.Client{h.client}
+	for _, client := range h.clients {
+		clients = append(clients, client)
+	}
+	for _, client := range h.freshClients {
+		clients = append(clients, client)
+	}
+	for _, client := range clients {
+		if transport, ok := client.Transport.(*http.Transport); ok {
+			transport.DialContext = policy.DialContext
+		}
+	}
+}
+
+// SetTimeout устанавливает таймаут HTTP клиентов
 func (h *HTTPChecker) SetTimeout(timeout time.Duration) {
 	h.client.Timeout = timeout
+	for _, client := range h.clients {
+		client.Timeout = timeout
+	}
+	for _, client := range h.freshClients {
+		client.Timeout = timeout
+	}
 }
 
 // GetClient возвращает HTTP клиент для тестирования
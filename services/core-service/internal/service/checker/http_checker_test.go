@@ -1,6 +1,10 @@
 package checker
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -82,3 +86,93 @@ func TestHTTPChecker_SetTimeout(t *testing.T) {
 	checker.SetTimeout(newTimeout)
 	assert.Equal(t, newTimeout, checker.GetClient().Timeout)
 }
+
+func TestHTTPChecker_ExecuteWithProgress(t *testing.T) {
+	log, err := logger.NewLogger("test", "debug", "core-service", false)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	checker := NewHTTPChecker(5000, log)
+	config := map[string]interface{}{
+		"method":          "GET",
+		"url":             server.URL,
+		"expected_status": float64(200),
+	}
+	task := domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), config)
+
+	var mu sync.Mutex
+	var phases []ProgressPhase
+	result, err := checker.ExecuteWithProgress(context.Background(), task, func(event ProgressEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		phases = append(phases, event.Phase)
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Contains(t, phases, PhaseConnect)
+	assert.Contains(t, phases, PhaseFirstByte)
+
+	// Длительности фаз записываются в результат
+	require.NotNil(t, result.Timings)
+	assert.Equal(t, result.DurationMs, result.Timings.TotalMs)
+	assert.LessOrEqual(t, result.Timings.TTFBMs, result.Timings.TotalMs)
+}
+
+func TestHTTPChecker_CaptureOnFailure(t *testing.T) {
+	log, err := logger.NewLogger("test", "debug", "core-service", false)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-42")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("upstream is down, please retry later"))
+	}))
+	defer server.Close()
+
+	checker := NewHTTPChecker(5000, log)
+	config := map[string]interface{}{
+		"method":             "GET",
+		"url":                server.URL,
+		"expected_status":    float64(200),
+		"capture_on_failure": true,
+		"capture_body_limit": float64(8),
+	}
+	task := domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), config)
+
+	result, err := checker.Execute(task)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+
+	require.NotNil(t, result.Capture)
+	assert.Equal(t, "upstream", result.Capture.BodySnippet)
+	assert.True(t, result.Capture.BodyTruncated)
+	assert.Equal(t, int64(36), result.Capture.BodySize)
+	assert.Equal(t, "req-42", result.Capture.Headers["X-Request-Id"])
+	assert.Equal(t, redactedHeaderValue, result.Capture.Headers["Set-Cookie"])
+
+	require.Len(t, result.Capture.Entries, 1)
+	assert.Equal(t, http.StatusServiceUnavailable, result.Capture.Entries[0].StatusCode)
+	assert.Equal(t, "GET", result.Capture.Entries[0].Method)
+
+	// Без capture_on_failure снимок не снимается
+	delete(config, "capture_on_failure")
+	result, err = checker.Execute(task)
+	require.NoError(t, err)
+	assert.Nil(t, result.Capture)
+}
+
+func TestTruncateBody_KeepsUTF8Boundary(t *testing.T) {
+	snippet, truncated := truncateBody([]byte("привет"), 3)
+	assert.True(t, truncated)
+	assert.Equal(t, "п", snippet)
+
+	snippet, truncated = truncateBody([]byte("ok"), 10)
+	assert.False(t, truncated)
+	assert.Equal(t, "ok", snippet)
+}
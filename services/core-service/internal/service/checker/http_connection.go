@@ -0,0 +1,51 @@
+package checker
+
+import (
+	"net/http"
+	"time"
+)
+
+// Политика переиспользования соединений HTTP проверки (config.connection_reuse)
+const (
+	// ConnectionReusePooled соединения берутся из общего пула клиента: измеряется теплая латентность,
+	// DNS, TCP и TLS попадают в замер только при установке нового соединения
+	ConnectionReusePooled = "pooled"
+	// ConnectionReuseFresh каждое выполнение устанавливает новое соединение без возобновления TLS
+	// сессии: измеряется холодный путь с DNS, TCP и полным TLS рукопожатием
+	ConnectionReuseFresh = "fresh"
+)
+
+// Ключи метаданных результата HTTP проверки с соединением
+const (
+	// MetadataKeyConnectionReuse политика переиспользования соединений выполнения
+	MetadataKeyConnectionReuse = "connection_reuse"
+	// MetadataKeyConnectionReused получен ли ответ по соединению из пула: true или false
+	MetadataKeyConnectionReused = "connection_reused"
+)
+
+// connectionReusePolicies допустимые значения config.connection_reuse
+var connectionReusePolicies = []string{ConnectionReusePooled, ConnectionReuseFresh}
+
+// ConnectionReuseFromConfig возвращает политику переиспользования соединений из конфигурации
+// проверки; без connection_reuse - pooled
+func ConnectionReuseFromConfig(config map[string]interface{}) string {
+	if reuse, ok := config["connection_reuse"].(string); ok && reuse != "" {
+		return reuse
+	}
+	return ConnectionReusePooled
+}
+
+// newFreshClients создает клиентов без keep-alive для каждой версии протокола с собственным
+// транспортом: соединение закрывается после ответа и не попадает в пул
+func newFreshClients(timeout time.Duration) map[string]*http.Client {
+	clients := make(map[string]*http.Client, 3)
+	for _, version := range []string{HTTPVersionAuto, HTTPVersion11, HTTPVersion2} {
+		transport := newHTTPTransport(version)
+		transport.DisableKeepAlives = true
+		clients[version] = &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		}
+	}
+	return clients
+}
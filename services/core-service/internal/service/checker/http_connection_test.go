@@ -0,0 +1,97 @@
+package checker
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// newConnCountingServer запускает тестовый сервер, считающий новые соединения
+func newConnCountingServer(conns *int32) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(conns, 1)
+		}
+	}
+	server.Start()
+	return server
+}
+
+func reuseTask(url, reuse string) *domain.Task {
+	config := map[string]interface{}{
+		"method":          "GET",
+		"url":             url,
+		"expected_status": float64(200),
+	}
+	if reuse != "" {
+		config["connection_reuse"] = reuse
+	}
+	return domain.NewTask("check-1", url, "http", "exec-1", time.Now(), config)
+}
+
+func TestHTTPChecker_ConnectionReuse(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+
+	var conns int32
+	server := newConnCountingServer(&conns)
+	defer server.Close()
+
+	// По умолчанию соединение переиспользуется из пула
+	checker := NewHTTPChecker(5000, log)
+	result, err := checker.Execute(reuseTask(server.URL, ""))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, ConnectionReusePooled, result.Metadata[MetadataKeyConnectionReuse])
+	assert.Equal(t, "false", result.Metadata[MetadataKeyConnectionReused])
+
+	result, err = checker.Execute(reuseTask(server.URL, ConnectionReusePooled))
+	require.NoError(t, err)
+	assert.Equal(t, "true", result.Metadata[MetadataKeyConnectionReused])
+	assert.Equal(t, int32(1), atomic.LoadInt32(&conns))
+
+	// fresh открывает новое соединение на каждое выполнение
+	for i := 0; i < 2; i++ {
+		result, err = checker.Execute(reuseTask(server.URL, ConnectionReuseFresh))
+		require.NoError(t, err)
+		assert.True(t, result.Success, result.Error)
+		assert.Equal(t, ConnectionReuseFresh, result.Metadata[MetadataKeyConnectionReuse])
+		assert.Equal(t, "false", result.Metadata[MetadataKeyConnectionReused])
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&conns))
+
+	// fresh с версией протокола использует клиента этой версии
+	task := reuseTask(server.URL, ConnectionReuseFresh)
+	task.Config["http_version"] = HTTPVersion11
+	result, err = checker.Execute(task)
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, "HTTP/1.1", result.Metadata[MetadataKeyHTTPProtocol])
+	assert.Equal(t, int32(4), atomic.LoadInt32(&conns))
+}
+
+func TestHTTPChecker_ValidateConfig_ConnectionReuse(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	checker := NewHTTPChecker(5000, log)
+
+	config := reuseTask("https://example.com", ConnectionReuseFresh).Config
+	assert.NoError(t, checker.ValidateConfig(config))
+
+	config["connection_reuse"] = "keep-alive"
+	assert.Error(t, checker.ValidateConfig(config))
+	config["connection_reuse"] = true
+	assert.Error(t, checker.ValidateConfig(config))
+}
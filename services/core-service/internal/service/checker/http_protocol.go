@@ -0,0 +1,87 @@
+package checker
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Версии протокола HTTP проверки (config.http_version). HTTP/3 не поддерживается: клиента
+// QUIC в checker нет
+const (
+	// HTTPVersionAuto протокол выбирается согласованием ALPN: HTTP/2 при поддержке целью
+	HTTPVersionAuto = "auto"
+	// HTTPVersion11 только HTTP/1.1
+	HTTPVersion11 = "1.1"
+	// HTTPVersion2 только HTTP/2: по TLS через ALPN h2, без TLS - h2c с заранее известной поддержкой
+	HTTPVersion2 = "2"
+)
+
+// MetadataKeyHTTPProtocol ключ метаданных результата с согласованным протоколом ответа,
+// например HTTP/2.0
+const MetadataKeyHTTPProtocol = "http_protocol"
+
+// httpVersions допустимые значения config.http_version
+var httpVersions = []string{HTTPVersionAuto, HTTPVersion11, HTTPVersion2}
+
+// HTTPVersionFromConfig возвращает требуемую версию протокола из конфигурации проверки;
+// без http_version - auto
+func HTTPVersionFromConfig(config map[string]interface{}) string {
+	if version, ok := config["http_version"].(string); ok && version != "" {
+		return version
+	}
+	return HTTPVersionAuto
+}
+
+// newHTTPTransport создает транспорт, согласующий только протоколы версии version
+func newHTTPTransport(version string) *http.Transport {
+	transport := &http.Transport{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	var protocols http.Protocols
+	switch version {
+	case HTTPVersion11:
+		protocols.SetHTTP1(true)
+	case HTTPVersion2:
+		protocols.SetHTTP2(true)
+		protocols.SetUnencryptedHTTP2(true)
+	default:
+		// Транспорт по умолчанию: HTTP/2 по ALPN, иначе HTTP/1.1
+		return transport
+	}
+	transport.Protocols = &protocols
+	return transport
+}
+
+// newProtocolClients создает клиентов для версий с ограниченным набором протоколов
+func newProtocolClients(timeout time.Duration) map[string]*http.Client {
+	clients := make(map[string]*http.Client, 2)
+	for _, version := range []string{HTTPVersion11, HTTPVersion2} {
+		clients[version] = &http.Client{
+			Timeout:   timeout,
+			Transport: newHTTPTransport(version),
+		}
+	}
+	return clients
+}
+
+// checkProtocol проверяет, что ответ получен по требуемой версии протокола, и добавляет
+// согласованный протокол в метаданные. Возвращает ошибку, если цель не поддерживает версию
+func checkProtocol(version string, resp *http.Response, metadata map[string]string) error {
+	metadata[MetadataKeyHTTPProtocol] = resp.Proto
+
+	switch version {
+	case HTTPVersion11:
+		if resp.ProtoMajor != 1 {
+			return fmt.Errorf("HTTP/1.1 required, negotiated %s", resp.Proto)
+		}
+	case HTTPVersion2:
+		if resp.ProtoMajor != 2 {
+			return fmt.Errorf("HTTP/2 required, negotiated %s", resp.Proto)
+		}
+	}
+	return nil
+}
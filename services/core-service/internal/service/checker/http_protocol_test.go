@@ -0,0 +1,93 @@
+package checker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// newH2CServer запускает тестовый сервер с HTTP/1.1 и h2c
+func newH2CServer(handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewUnstartedServer(handler)
+	server.Config.Protocols = new(http.Protocols)
+	server.Config.Protocols.SetHTTP1(true)
+	server.Config.Protocols.SetUnencryptedHTTP2(true)
+	server.Start()
+	return server
+}
+
+func protocolTask(url, version string) *domain.Task {
+	config := map[string]interface{}{
+		"method":          "GET",
+		"url":             url,
+		"expected_status": float64(200),
+		"http_version":    version,
+	}
+	return domain.NewTask("check-1", url, "http", "exec-1", time.Now(), config)
+}
+
+func TestHTTPChecker_HTTPVersion(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	checker := NewHTTPChecker(5000, log)
+
+	server := newH2CServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	defer server.Close()
+
+	result, err := checker.Execute(protocolTask(server.URL, HTTPVersion2))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, "HTTP/2.0", result.Metadata[MetadataKeyHTTPProtocol])
+
+	result, err = checker.Execute(protocolTask(server.URL, HTTPVersion11))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, "HTTP/1.1", result.Metadata[MetadataKeyHTTPProtocol])
+
+	result, err = checker.Execute(protocolTask(server.URL, HTTPVersionAuto))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, "HTTP/1.1", result.Metadata[MetadataKeyHTTPProtocol])
+}
+
+func TestHTTPChecker_HTTPVersion_Unsupported(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	checker := NewHTTPChecker(5000, log)
+
+	// Сервер только с HTTP/1.1 не принимает HTTP/2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	result, err := checker.Execute(protocolTask(server.URL, HTTPVersion2))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "http_version 2")
+}
+
+func TestHTTPChecker_ValidateConfig_HTTPVersion(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	checker := NewHTTPChecker(5000, log)
+
+	config := map[string]interface{}{"method": "GET", "url": "https://example.com", "http_version": "2"}
+	assert.NoError(t, checker.ValidateConfig(config))
+
+	config["http_version"] = "1.0"
+	assert.Error(t, checker.ValidateConfig(config))
+
+	// HTTP/3 не поддерживается: проверка с http_version 3 не принимается
+	config["http_version"] = "3"
+	assert.Error(t, checker.ValidateConfig(config))
+}
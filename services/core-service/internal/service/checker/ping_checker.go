@@ -0,0 +1,493 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	stderrors "errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// Режимы отправки эхо-запросов ICMP
+const (
+	// PingModeAuto raw сокет, если у процесса есть CAP_NET_RAW, иначе датаграммный сокет
+	PingModeAuto = "auto"
+	// PingModePrivileged raw сокет ICMP
+	PingModePrivileged = "privileged"
+	// PingModeUnprivileged датаграммный сокет ICMP (udp4/udp6), доступный без привилегий при
+	// разрешающем net.ipv4.ping_group_range
+	PingModeUnprivileged = "unprivileged"
+)
+
+const (
+	defaultPingCount    = 4
+	maxPingCount        = 20
+	defaultPingInterval = 200 * time.Millisecond
+	minPingInterval     = 10 * time.Millisecond
+	defaultPingTimeout  = time.Second
+	maxPingTimeout      = 10 * time.Second
+	defaultPingPayload  = 56
+	maxPingPayload      = 1472
+	// maxPingDuration предел длительности серии эхо-запросов, меньше таймаута ICMP в пуле
+	maxPingDuration = 25 * time.Second
+	// pingNonceSize размер метки выполнения в начале данных эхо-запроса
+	pingNonceSize = 8
+)
+
+// Ключи метаданных результата ICMP проверки. RTT в миллисекундах с точностью до микросекунды
+const (
+	MetadataKeyPingMode        = "ping_mode"
+	MetadataKeyPingAddress     = "ping_address"
+	MetadataKeyPingSent        = "ping_sent"
+	MetadataKeyPingReceived    = "ping_received"
+	MetadataKeyPingLossPercent = "ping_loss_percent"
+	MetadataKeyPingRTTMin      = "ping_rtt_min_ms"
+	MetadataKeyPingRTTAvg      = "ping_rtt_avg_ms"
+	MetadataKeyPingRTTP50      = "ping_rtt_p50_ms"
+	MetadataKeyPingRTTP95      = "ping_rtt_p95_ms"
+	MetadataKeyPingRTTP99      = "ping_rtt_p99_ms"
+	MetadataKeyPingRTTMax      = "ping_rtt_max_ms"
+)
+
+// pingConn сокет эхо-запросов ICMP; реализуется *icmp.PacketConn
+type pingConn interface {
+	WriteTo(b []byte, dst net.Addr) (int, error)
+	ReadFrom(b []byte) (int, net.Addr, error)
+	SetReadDeadline(t time.Time) error
+	Close() error
+}
+
+// pingListener открывает сокет в режиме privileged или unprivileged для IPv4 или IPv6
+type pingListener func(mode string, ipv6 bool) (pingConn, error)
+
+// listenICMP открывает сокет ICMP пакетом golang.org/x/net/icmp
+func listenICMP(mode string, ipv6 bool) (pingConn, error) {
+	network, address := "ip4:icmp", "0.0.0.0"
+	switch {
+	case mode == PingModePrivileged && ipv6:
+		network, address = "ip6:ipv6-icmp", "::"
+	case mode == PingModeUnprivileged && ipv6:
+		network, address = "udp6", "::"
+	case mode == PingModeUnprivileged:
+		network = "udp4"
+	}
+	return icmp.ListenPacket(network, address)
+}
+
+// pingStats итог серии эхо-запросов
+type pingStats struct {
+	mode     string
+	sent     int
+	received int
+	rtts     []time.Duration
+}
+
+// PingChecker реализует Checker для ICMP проверок: отправляет серию эхо-запросов и сообщает
+// потерю пакетов и перцентили RTT. Raw сокет требует CAP_NET_RAW; без него в режиме auto
+// используется датаграммный сокет ICMP. Проверка успешна, если ответ получен хотя бы на один
+// запрос и потеря не превышает config.max_loss_percent
+type PingChecker struct {
+	*BaseChecker
+	listen  pingListener
+	resolve func(ctx context.Context, host string) ([]net.IPAddr, error)
+	targets *TargetPolicy
+	now     func() time.Time
+}
+
+// NewPingChecker создает ICMP checker
+func NewPingChecker(log logger.Logger) *PingChecker {
+	return &PingChecker{
+		BaseChecker: NewBaseChecker(log),
+		listen:      listenICMP,
+		resolve:     net.DefaultResolver.LookupIPAddr,
+		now:         time.Now,
+	}
+}
+
+// SetTargetPolicy реализует TargetRestricted
+func (p *PingChecker) SetTargetPolicy(policy *TargetPolicy) {
+	p.targets = policy
+}
+
+// Execute выполняет ICMP проверку
+func (p *PingChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
+	p.logger.Debug("Starting ICMP check",
+		logger.String("check_id", task.CheckID),
+		logger.String("execution_id", task.ExecutionID),
+		logger.String("target", task.Target),
+	)
+
+	if err := p.ValidateConfig(task.Config); err != nil {
+		return nil, err
+	}
+	config, err := task.GetICMPConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid ICMP check config")
+	}
+	withPingDefaults(config)
+
+	target := task.Target
+	if config.Target != "" {
+		target = config.Target
+	}
+	host, err := PingHost(target)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid ICMP check target")
+	}
+
+	result := &domain.CheckResult{
+		CheckID:     task.CheckID,
+		ExecutionID: task.ExecutionID,
+		CheckedAt:   p.now().UTC(),
+		Metadata:    map[string]string{},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), maxPingDuration+5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	addr, err := p.resolveAddress(ctx, host)
+	dnsLookup := time.Since(start)
+	if err != nil {
+		result.Error = err.Error()
+		result.DurationMs = dnsLookup.Milliseconds()
+		return result, nil
+	}
+	result.Metadata[MetadataKeyPingAddress] = addr.String()
+
+	stats, err := p.ping(ctx, addr, config)
+	total := time.Since(start)
+	if err != nil {
+		result.Error = fmt.Sprintf("ping %s failed: %v", addr, err)
+		result.DurationMs = total.Milliseconds()
+		return result, nil
+	}
+
+	loss := 100 * float64(stats.sent-stats.received) / float64(stats.sent)
+	result.Metadata[MetadataKeyPingMode] = stats.mode
+	result.Metadata[MetadataKeyPingSent] = fmt.Sprintf("%d", stats.sent)
+	result.Metadata[MetadataKeyPingReceived] = fmt.Sprintf("%d", stats.received)
+	result.Metadata[MetadataKeyPingLossPercent] = fmt.Sprintf("%.1f", loss)
+	result.Timings = &domain.CheckTimings{DNSLookupMs: dnsLookup.Milliseconds(), TotalMs: total.Milliseconds()}
+
+	switch {
+	case stats.received == 0:
+		result.DurationMs = total.Milliseconds()
+		result.Error = fmt.Sprintf("no echo replies from %s: %d packets sent, 100%% packet loss", addr, stats.sent)
+	default:
+		sort.Slice(stats.rtts, func(i, j int) bool { return stats.rtts[i] < stats.rtts[j] })
+		var sum time.Duration
+		for _, rtt := range stats.rtts {
+			sum += rtt
+		}
+		avg := sum / time.Duration(len(stats.rtts))
+		result.DurationMs = avg.Milliseconds()
+		result.Metadata[MetadataKeyPingRTTMin] = formatRTT(stats.rtts[0])
+		result.Metadata[MetadataKeyPingRTTAvg] = formatRTT(avg)
+		result.Metadata[MetadataKeyPingRTTP50] = formatRTT(rttPercentile(stats.rtts, 50))
+		result.Metadata[MetadataKeyPingRTTP95] = formatRTT(rttPercentile(stats.rtts, 95))
+		result.Metadata[MetadataKeyPingRTTP99] = formatRTT(rttPercentile(stats.rtts, 99))
+		result.Metadata[MetadataKeyPingRTTMax] = formatRTT(stats.rtts[len(stats.rtts)-1])
+		if config.MaxLossPercent != nil && loss > *config.MaxLossPercent {
+			result.Error = fmt.Sprintf("packet loss to %s is %.1f%%, above the %.1f%% limit", addr, loss, *config.MaxLossPercent)
+		} else {
+			result.Success = true
+		}
+	}
+
+	p.logger.Debug("ICMP check completed",
+		logger.String("check_id", task.CheckID),
+		logger.String("address", addr.String()),
+		logger.String("mode", stats.mode),
+		logger.Int("sent", stats.sent),
+		logger.Int("received", stats.received),
+		logger.Bool("success", result.Success),
+	)
+	return result, nil
+}
+
+// GetType возвращает тип checker'а
+func (p *PingChecker) GetType() domain.TaskType {
+	return domain.TaskTypeICMP
+}
+
+// ValidateConfig валидирует конфигурацию ICMP проверки: count, timeout и interval (длительности
+// вида "1s"), mode, packet_size и max_loss_percent
+func (p *PingChecker) ValidateConfig(config map[string]interface{}) error {
+	if value, ok := config["mode"]; ok {
+		switch mode, _ := value.(string); mode {
+		case PingModeAuto, PingModePrivileged, PingModeUnprivileged:
+		default:
+			return errors.New(errors.ErrValidation, "mode is not supported").
+				WithDetails("allowed values: auto, privileged, unprivileged")
+		}
+	}
+	numbers := []struct {
+		key      string
+		min, max float64
+	}{
+		{"count", 1, maxPingCount},
+		{"packet_size", 0, maxPingPayload},
+		{"max_loss_percent", 0, 100},
+	}
+	for _, limit := range numbers {
+		if value, ok := config[limit.key]; ok {
+			number, isNumber := value.(float64)
+			if !isNumber || number < limit.min || number > limit.max {
+				return errors.New(errors.ErrValidation, fmt.Sprintf("%s must be a number between %g and %g", limit.key, limit.min, limit.max))
+			}
+		}
+	}
+	durations := []struct {
+		key      string
+		min, max time.Duration
+	}{
+		{"timeout", time.Millisecond, maxPingTimeout},
+		{"interval", minPingInterval, maxPingTimeout},
+	}
+	for _, limit := range durations {
+		if value, ok := config[limit.key]; ok {
+			text, _ := value.(string)
+			duration, err := time.ParseDuration(text)
+			if err != nil || duration < limit.min || duration > limit.max {
+				return errors.New(errors.ErrValidation, fmt.Sprintf("%s must be a duration between %s and %s", limit.key, limit.min, limit.max))
+			}
+		}
+	}
+
+	parsed := &domain.ICMPConfig{}
+	if count, ok := config["count"].(float64); ok {
+		parsed.Count = int(count)
+	}
+	parsed.Timeout, _ = time.ParseDuration(fmt.Sprint(config["timeout"]))
+	parsed.Interval, _ = time.ParseDuration(fmt.Sprint(config["interval"]))
+	withPingDefaults(parsed)
+	if time.Duration(parsed.Count)*parsed.Timeout+time.Duration(parsed.Count-1)*parsed.Interval > maxPingDuration {
+		return errors.New(errors.ErrValidation, "count * timeout + (count - 1) * interval must not exceed 25s")
+	}
+	return nil
+}
+
+// withPingDefaults заполняет незаданные параметры серии значениями по умолчанию
+func withPingDefaults(config *domain.ICMPConfig) {
+	if config.Mode == "" {
+		config.Mode = PingModeAuto
+	}
+	if config.Count <= 0 {
+		config.Count = defaultPingCount
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultPingTimeout
+	}
+	if config.Interval <= 0 {
+		config.Interval = defaultPingInterval
+	}
+	if config.PacketSize <= 0 {
+		config.PacketSize = defaultPingPayload
+	}
+}
+
+// PingHost возвращает адрес или имя хоста из цели проверки: URL и host:port сводятся к хосту
+func PingHost(target string) (string, error) {
+	host := strings.TrimSpace(target)
+	if strings.Contains(host, "://") {
+		parsed, err := url.Parse(host)
+		if err != nil {
+			return "", err
+		}
+		host = parsed.Hostname()
+	} else {
+		host, _, _ = strings.Cut(host, "/")
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+	}
+	host = strings.Trim(host, "[]")
+	if host == "" || strings.ContainsAny(host, " /") {
+		return "", fmt.Errorf("target %q is not a host or IP address", target)
+	}
+	return host, nil
+}
+
+// resolveAddress разрешает хост и выбирает адрес: IPv4, если он есть. Адрес проверяется
+// политикой целей
+func (p *PingChecker) resolveAddress(ctx context.Context, host string) (netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return addr.Unmap(), p.checkAddress(addr.Unmap())
+	}
+	addrs, err := p.resolve(ctx, host)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("failed to resolve %s: %v", host, err)
+	}
+	var chosen netip.Addr
+	for _, candidate := range addrs {
+		addr, ok := netip.AddrFromSlice(candidate.IP)
+		if !ok {
+			continue
+		}
+		addr = addr.Unmap()
+		if addr.Is4() {
+			chosen = addr
+			break
+		}
+		if !chosen.IsValid() {
+			chosen = addr
+		}
+	}
+	if !chosen.IsValid() {
+		return netip.Addr{}, fmt.Errorf("no addresses found for %s", host)
+	}
+	return chosen, p.checkAddress(chosen)
+}
+
+// checkAddress проверяет адрес политикой целей
+func (p *PingChecker) checkAddress(addr netip.Addr) error {
+	if p.targets != nil && !p.targets.Allowed(addr) {
+		return fmt.Errorf("target address %s is denied by the target policy", addr)
+	}
+	return nil
+}
+
+// ping отправляет серию эхо-запросов. В режиме auto raw сокет открывается первым, а при
+// отсутствии прав используется датаграммный сокет
+func (p *PingChecker) ping(ctx context.Context, addr netip.Addr, config *domain.ICMPConfig) (*pingStats, error) {
+	mode := config.Mode
+	if mode == PingModeAuto {
+		mode = PingModePrivileged
+	}
+	conn, err := p.listen(mode, addr.Is6())
+	if err != nil && config.Mode == PingModeAuto && isPermissionError(err) {
+		mode = PingModeUnprivileged
+		conn, err = p.listen(mode, addr.Is6())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s ICMP socket: %w", mode, err)
+	}
+	defer conn.Close()
+
+	var dst net.Addr = &net.IPAddr{IP: addr.AsSlice()}
+	if mode == PingModeUnprivileged {
+		dst = &net.UDPAddr{IP: addr.AsSlice()}
+	}
+
+	// Метка выполнения отличает свои ответы от ответов на запросы других проверок:
+	// raw сокет получает все эхо-ответы хоста
+	nonce := make([]byte, pingNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate echo nonce: %w", err)
+	}
+	id := int(binary.BigEndian.Uint16(nonce))
+	payload := make([]byte, max(config.PacketSize, pingNonceSize))
+	copy(payload, nonce)
+
+	stats := &pingStats{mode: mode}
+	buffer := make([]byte, maxPingPayload+128)
+	for seq := 0; seq < config.Count; seq++ {
+		if seq > 0 {
+			select {
+			case <-ctx.Done():
+				return stats, nil
+			case <-time.After(config.Interval):
+			}
+		}
+		request, err := echoRequest(addr.Is6(), id, seq, payload)
+		if err != nil {
+			return nil, err
+		}
+		sent := time.Now()
+		if _, err := conn.WriteTo(request, dst); err != nil {
+			return nil, fmt.Errorf("failed to send echo request: %w", err)
+		}
+		stats.sent++
+
+		deadline := sent.Add(config.Timeout)
+		if err := conn.SetReadDeadline(deadline); err != nil {
+			return nil, fmt.Errorf("failed to set read deadline: %w", err)
+		}
+		for time.Now().Before(deadline) {
+			n, _, err := conn.ReadFrom(buffer)
+			if err != nil {
+				if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+					break
+				}
+				return nil, fmt.Errorf("failed to read echo reply: %w", err)
+			}
+			// Датаграммный сокет подставляет свой идентификатор, поэтому он не сравнивается
+			if isEchoReply(buffer[:n], addr.Is6(), id, seq, nonce, mode == PingModePrivileged) {
+				stats.received++
+				stats.rtts = append(stats.rtts, time.Since(sent))
+				break
+			}
+		}
+	}
+	return stats, nil
+}
+
+// echoRequest собирает эхо-запрос ICMP или ICMPv6
+func echoRequest(ipv6Target bool, id, seq int, payload []byte) ([]byte, error) {
+	var messageType icmp.Type = ipv4.ICMPTypeEcho
+	if ipv6Target {
+		messageType = ipv6.ICMPTypeEchoRequest
+	}
+	message := icmp.Message{
+		Type: messageType,
+		Body: &icmp.Echo{ID: id & 0xffff, Seq: seq & 0xffff, Data: payload},
+	}
+	return message.Marshal(nil)
+}
+
+// isEchoReply проверяет, что пакет - ответ на запрос seq этого выполнения
+func isEchoReply(packet []byte, ipv6Target bool, id, seq int, nonce []byte, checkID bool) bool {
+	protocol := 1
+	var replyType icmp.Type = ipv4.ICMPTypeEchoReply
+	if ipv6Target {
+		protocol = 58
+		replyType = ipv6.ICMPTypeEchoReply
+	}
+	message, err := icmp.ParseMessage(protocol, packet)
+	if err != nil || message.Type != replyType {
+		return false
+	}
+	echo, ok := message.Body.(*icmp.Echo)
+	if !ok || echo.Seq != seq&0xffff || (checkID && echo.ID != id&0xffff) {
+		return false
+	}
+	return bytes.HasPrefix(echo.Data, nonce)
+}
+
+// isPermissionError проверяет, что сокет не открыт из-за отсутствия прав
+func isPermissionError(err error) bool {
+	return stderrors.Is(err, os.ErrPermission) || stderrors.Is(err, syscall.EPERM) || stderrors.Is(err, syscall.EACCES)
+}
+
+// rttPercentile возвращает перцентиль по отсортированным RTT методом ближайшего ранга
+func rttPercentile(sorted []time.Duration, percentile float64) time.Duration {
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// formatRTT форматирует RTT в миллисекундах с точностью до микросекунды
+func formatRTT(rtt time.Duration) string {
+	return fmt.Sprintf("%.3f", float64(rtt.Microseconds())/1000)
+}
@@ -0,0 +1,205 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// fakePingConn отвечает на эхо-запросы, кроме запросов с номерами из drop. Перед каждым
+// ответом приходит чужой эхо-ответ, который checker должен пропустить
+type fakePingConn struct {
+	drop    map[int]bool
+	replies [][]byte
+	dst     net.Addr
+}
+
+func (c *fakePingConn) WriteTo(b []byte, dst net.Addr) (int, error) {
+	c.dst = dst
+	request, err := icmp.ParseMessage(1, b)
+	if err != nil {
+		return 0, err
+	}
+	echo := request.Body.(*icmp.Echo)
+	if c.drop[echo.Seq] {
+		return len(b), nil
+	}
+	foreign, _ := (&icmp.Message{Type: ipv4.ICMPTypeEchoReply, Body: &icmp.Echo{ID: echo.ID, Seq: echo.Seq, Data: []byte("other check")}}).Marshal(nil)
+	reply, _ := (&icmp.Message{Type: ipv4.ICMPTypeEchoReply, Body: &icmp.Echo{ID: echo.ID, Seq: echo.Seq, Data: echo.Data}}).Marshal(nil)
+	c.replies = append(c.replies, foreign, reply)
+	return len(b), nil
+}
+
+func (c *fakePingConn) ReadFrom(b []byte) (int, net.Addr, error) {
+	if len(c.replies) == 0 {
+		return 0, nil, os.ErrDeadlineExceeded
+	}
+	reply := c.replies[0]
+	c.replies = c.replies[1:]
+	return copy(b, reply), c.dst, nil
+}
+
+func (c *fakePingConn) SetReadDeadline(t time.Time) error { return nil }
+
+func (c *fakePingConn) Close() error { return nil }
+
+// newTestPingChecker создает checker с поддельным сокетом; raw сокет недоступен без привилегий
+func newTestPingChecker(t *testing.T, conn *fakePingConn) (*PingChecker, *[]string) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	checker := NewPingChecker(log)
+	var opened []string
+	checker.listen = func(mode string, ipv6 bool) (pingConn, error) {
+		opened = append(opened, mode)
+		if mode == PingModePrivileged {
+			return nil, fmt.Errorf("listen ip4:icmp: %w", os.ErrPermission)
+		}
+		return conn, nil
+	}
+	checker.resolve = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		if host != "example.com" {
+			return nil, fmt.Errorf("no such host")
+		}
+		return []net.IPAddr{{IP: net.ParseIP("2001:db8::1")}, {IP: net.ParseIP("192.0.2.10")}}, nil
+	}
+	return checker, &opened
+}
+
+func pingTask(target string, config map[string]interface{}) *domain.Task {
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+	config["interval"] = "10ms"
+	return domain.NewTask("check-1", target, "icmp", "exec-1", time.Now(), config)
+}
+
+func TestPingChecker_Execute(t *testing.T) {
+	conn := &fakePingConn{}
+	checker, opened := newTestPingChecker(t, conn)
+
+	result, err := checker.Execute(pingTask("https://example.com:8443/health", nil))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+
+	// Без прав на raw сокет режим auto переходит на датаграммный сокет
+	assert.Equal(t, []string{PingModePrivileged, PingModeUnprivileged}, *opened)
+	assert.Equal(t, PingModeUnprivileged, result.Metadata[MetadataKeyPingMode])
+	assert.IsType(t, &net.UDPAddr{}, conn.dst)
+
+	assert.Equal(t, "192.0.2.10", result.Metadata[MetadataKeyPingAddress])
+	assert.Equal(t, "4", result.Metadata[MetadataKeyPingSent])
+	assert.Equal(t, "4", result.Metadata[MetadataKeyPingReceived])
+	assert.Equal(t, "0.0", result.Metadata[MetadataKeyPingLossPercent])
+	for _, key := range []string{MetadataKeyPingRTTMin, MetadataKeyPingRTTAvg, MetadataKeyPingRTTP50, MetadataKeyPingRTTP95, MetadataKeyPingRTTP99, MetadataKeyPingRTTMax} {
+		assert.NotEmpty(t, result.Metadata[key], key)
+	}
+	require.NotNil(t, result.Timings)
+}
+
+func TestPingChecker_PacketLoss(t *testing.T) {
+	checker, _ := newTestPingChecker(t, &fakePingConn{drop: map[int]bool{1: true}})
+	result, err := checker.Execute(pingTask("192.0.2.10", nil))
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "3", result.Metadata[MetadataKeyPingReceived])
+	assert.Equal(t, "25.0", result.Metadata[MetadataKeyPingLossPercent])
+
+	// Потеря выше config.max_loss_percent - отказ
+	checker, _ = newTestPingChecker(t, &fakePingConn{drop: map[int]bool{1: true}})
+	result, err = checker.Execute(pingTask("192.0.2.10", map[string]interface{}{"max_loss_percent": float64(10)}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "packet loss to 192.0.2.10 is 25.0%")
+
+	checker, _ = newTestPingChecker(t, &fakePingConn{drop: map[int]bool{0: true, 1: true}})
+	result, err = checker.Execute(pingTask("192.0.2.10", map[string]interface{}{"count": float64(2)}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "100% packet loss")
+	assert.Empty(t, result.Metadata[MetadataKeyPingRTTAvg])
+}
+
+func TestPingChecker_PrivilegedMode(t *testing.T) {
+	checker, opened := newTestPingChecker(t, &fakePingConn{})
+	result, err := checker.Execute(pingTask("192.0.2.10", map[string]interface{}{"mode": PingModePrivileged}))
+	require.NoError(t, err)
+
+	// Явный режим privileged не переходит на датаграммный сокет
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "failed to open privileged ICMP socket")
+	assert.Equal(t, []string{PingModePrivileged}, *opened)
+}
+
+func TestPingChecker_TargetPolicy(t *testing.T) {
+	checker, opened := newTestPingChecker(t, &fakePingConn{})
+	policy, err := NewDefaultTargetPolicy(nil, nil)
+	require.NoError(t, err)
+	checker.SetTargetPolicy(policy)
+
+	result, err := checker.Execute(pingTask("127.0.0.1", nil))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "denied by the target policy")
+	assert.Empty(t, *opened)
+}
+
+func TestPingChecker_ValidateConfig(t *testing.T) {
+	checker, _ := newTestPingChecker(t, &fakePingConn{})
+
+	assert.NoError(t, checker.ValidateConfig(map[string]interface{}{}))
+	assert.NoError(t, checker.ValidateConfig(map[string]interface{}{
+		"mode": PingModeUnprivileged, "count": float64(10), "timeout": "500ms", "interval": "1s",
+		"packet_size": float64(1400), "max_loss_percent": float64(20),
+	}))
+
+	invalid := []map[string]interface{}{
+		{"mode": "raw"},
+		{"count": float64(0)},
+		{"count": "4"},
+		{"timeout": "soon"},
+		{"interval": "1ms"},
+		{"packet_size": float64(9000)},
+		{"max_loss_percent": float64(120)},
+		{"count": float64(20), "timeout": "2s"},
+	}
+	for _, config := range invalid {
+		assert.Error(t, checker.ValidateConfig(config), "%v", config)
+	}
+}
+
+func TestPingHost(t *testing.T) {
+	for target, want := range map[string]string{
+		"example.com":               "example.com",
+		"example.com:443":           "example.com",
+		"https://example.com/path":  "example.com",
+		"192.0.2.10":                "192.0.2.10",
+		"2001:db8::1":               "2001:db8::1",
+		"[2001:db8::1]:80":          "2001:db8::1",
+		"http://[2001:db8::1]:8080": "2001:db8::1",
+	} {
+		host, err := PingHost(target)
+		require.NoError(t, err, target)
+		assert.Equal(t, want, host, target)
+	}
+	_, err := PingHost(" ")
+	assert.Error(t, err)
+}
+
+func TestRTTPercentile(t *testing.T) {
+	rtts := []time.Duration{1 * time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond, 40 * time.Millisecond}
+	assert.Equal(t, 2*time.Millisecond, rttPercentile(rtts, 50))
+	assert.Equal(t, 40*time.Millisecond, rttPercentile(rtts, 95))
+	assert.Equal(t, 1*time.Millisecond, rttPercentile(rtts, 0))
+	assert.Equal(t, "1.250", formatRTT(1250*time.Microsecond))
+}
@@ -0,0 +1,360 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+	pkg_plugin "UptimePingPlatform/pkg/plugin"
+	pluginv1 "UptimePingPlatform/proto/api/plugin/v1"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// PluginProtocolVersion версия сервиса CheckerPlugin; меняется при несовместимых изменениях plugin.proto
+const PluginProtocolVersion = 1
+
+// ConfigKeyPlugin ключ конфигурации проверки типа plugin с именем плагина
+const ConfigKeyPlugin = "plugin"
+
+// Значения PluginConfig по умолчанию
+const (
+	DefaultPluginTimeout       = 30 * time.Second
+	DefaultPluginMaxConcurrent = 10
+	// pluginCallTimeout ограничивает служебные вызовы плагина: описание и проверку конфигурации
+	pluginCallTimeout = 5 * time.Second
+	// pluginRestartBackoff пауза между попытками перезапустить завершившийся плагин
+	pluginRestartBackoff = 10 * time.Second
+)
+
+// PluginConfig параметры запуска внешнего плагина проверок
+type PluginConfig struct {
+	// Path путь к исполняемому файлу плагина
+	Path         string
+	Args         []string
+	Env          []string
+	StartTimeout time.Duration
+	// Timeout верхняя граница одной проверки; config.timeout проверки может только уменьшить ее
+	Timeout time.Duration
+	// MaxConcurrent число одновременных проверок плагина; остальные ждут свободного слота
+	MaxConcurrent int
+	Limits        pkg_plugin.Limits
+}
+
+// CheckPlugin плагин проверок в отдельном процессе. Завершившийся процесс перезапускается
+// при следующей проверке не чаще раза в pluginRestartBackoff
+type CheckPlugin struct {
+	info   *pluginv1.DescribeCheckerResponse
+	cfg    PluginConfig
+	logger logger.Logger
+	slots  chan struct{}
+
+	mu           sync.Mutex
+	client       pluginv1.CheckerPluginClient
+	process      *pkg_plugin.Client
+	restartAfter time.Time
+}
+
+// NewCheckPlugin создает плагин поверх клиента, описанного info
+func NewCheckPlugin(info *pluginv1.DescribeCheckerResponse, client pluginv1.CheckerPluginClient, cfg PluginConfig, log logger.Logger) *CheckPlugin {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultPluginTimeout
+	}
+	if cfg.MaxConcurrent <= 0 {
+		cfg.MaxConcurrent = DefaultPluginMaxConcurrent
+	}
+	return &CheckPlugin{
+		info:   info,
+		cfg:    cfg,
+		logger: log,
+		slots:  make(chan struct{}, cfg.MaxConcurrent),
+		client: client,
+	}
+}
+
+// LoadPlugins запускает плагины проверок и запрашивает их описание. Плагин, который не
+// запустился, не назвал проверку или повторил имя уже загруженного, пропускается с записью в журнал
+func LoadPlugins(configs []PluginConfig, log logger.Logger) []*CheckPlugin {
+	plugins := make([]*CheckPlugin, 0, len(configs))
+	names := make(map[string]bool, len(configs))
+	for _, cfg := range configs {
+		process, info, err := startPlugin(cfg, log)
+		if err != nil {
+			log.Error("Failed to load checker plugin", logger.String("path", cfg.Path), logger.Error(err))
+			continue
+		}
+		if names[info.Name] {
+			log.Error("Checker plugin name is already registered",
+				logger.String("path", cfg.Path),
+				logger.String("plugin", info.Name),
+			)
+			process.Kill()
+			continue
+		}
+		names[info.Name] = true
+
+		plugin := NewCheckPlugin(info, pluginv1.NewCheckerPluginClient(process.Conn()), cfg, log)
+		plugin.process = process
+		plugins = append(plugins, plugin)
+		log.Info("Checker plugin loaded",
+			logger.String("plugin", info.Name),
+			logger.String("version", info.Version),
+			logger.String("path", cfg.Path),
+		)
+	}
+	return plugins
+}
+
+// startPlugin запускает процесс плагина и запрашивает его описание
+func startPlugin(cfg PluginConfig, log logger.Logger) (*pkg_plugin.Client, *pluginv1.DescribeCheckerResponse, error) {
+	process, err := pkg_plugin.Start(pkg_plugin.ClientConfig{
+		Path:            cfg.Path,
+		Args:            cfg.Args,
+		Env:             cfg.Env,
+		ProtocolVersion: PluginProtocolVersion,
+		StartTimeout:    cfg.StartTimeout,
+		Limits:          cfg.Limits,
+		Logger:          log,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	info, err := pluginv1.NewCheckerPluginClient(process.Conn()).Describe(ctx, &pluginv1.DescribeRequest{})
+	if err != nil {
+		process.Kill()
+		return nil, nil, fmt.Errorf("describe failed: %w", err)
+	}
+	if info.Name == "" {
+		process.Kill()
+		return nil, nil, fmt.Errorf("plugin %s did not report a check name", process.Name())
+	}
+	return process, info, nil
+}
+
+// Name возвращает имя проверки, которое указывается в config.plugin
+func (p *CheckPlugin) Name() string {
+	return p.info.Name
+}
+
+// Close останавливает процесс плагина
+func (p *CheckPlugin) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.process != nil {
+		p.process.Kill()
+	}
+}
+
+// connect возвращает клиент плагина, перезапуская завершившийся процесс
+func (p *CheckPlugin) connect() (pluginv1.CheckerPluginClient, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.process == nil || !p.process.Exited() {
+		return p.client, nil
+	}
+	if time.Now().Before(p.restartAfter) {
+		return nil, fmt.Errorf("plugin %s has exited, restart is delayed", p.info.Name)
+	}
+
+	p.logger.Warn("Checker plugin has exited, restarting", logger.String("plugin", p.info.Name))
+	p.process.Kill()
+	process, info, err := startPlugin(p.cfg, p.logger)
+	if err == nil && info.Name != p.info.Name {
+		process.Kill()
+		err = fmt.Errorf("restarted plugin reports name %q", info.Name)
+	}
+	if err != nil {
+		p.restartAfter = time.Now().Add(pluginRestartBackoff)
+		return nil, fmt.Errorf("failed to restart plugin %s: %w", p.info.Name, err)
+	}
+	p.process = process
+	p.client = pluginv1.NewCheckerPluginClient(process.Conn())
+	return p.client, nil
+}
+
+// timeout возвращает время на проверку: config.timeout, но не больше таймаута плагина
+func (p *CheckPlugin) timeout(config map[string]interface{}) time.Duration {
+	if value, ok := config["timeout"].(string); ok {
+		if timeout, err := time.ParseDuration(value); err == nil && timeout > 0 && timeout < p.cfg.Timeout {
+			return timeout
+		}
+	}
+	return p.cfg.Timeout
+}
+
+// NewPluginChecker создает checker проверок типа plugin
+func NewPluginChecker(logger logger.Logger, plugins ...*CheckPlugin) *PluginChecker {
+	checker := &PluginChecker{
+		BaseChecker: NewBaseChecker(logger),
+		plugins:     make(map[string]*CheckPlugin, len(plugins)),
+	}
+	for _, plugin := range plugins {
+		checker.plugins[plugin.Name()] = plugin
+	}
+	return checker
+}
+
+// PluginChecker реализует Checker для проверок внешними плагинами: проверка выполняется
+// плагином, имя которого задано в config.plugin
+type PluginChecker struct {
+	*BaseChecker
+	plugins map[string]*CheckPlugin
+}
+
+// Execute выполняет проверку плагином. Превышение времени или лимита одновременных
+// проверок дает неуспешный результат; недоступность плагина возвращается ошибкой
+func (c *PluginChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
+	plugin, err := c.plugin(task.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := plugin.timeout(task.Config)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	start := time.Now()
+
+	select {
+	case plugin.slots <- struct{}{}:
+		defer func() { <-plugin.slots }()
+	case <-ctx.Done():
+		return c.failed(task, plugin, time.Since(start), fmt.Sprintf("plugin %s is busy: concurrency limit reached", plugin.Name())), nil
+	}
+
+	client, err := plugin.connect()
+	if err != nil {
+		return nil, err
+	}
+	configJSON, err := json.Marshal(task.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plugin check config: %w", err)
+	}
+
+	resp, err := client.Check(ctx, &pluginv1.CheckRequest{
+		CheckId:     task.CheckID,
+		ExecutionId: task.ExecutionID,
+		Target:      task.Target,
+		ConfigJson:  string(configJSON),
+		TimeoutMs:   time.Until(start.Add(timeout)).Milliseconds(),
+	})
+	duration := time.Since(start)
+	if err != nil {
+		if ctx.Err() != nil || status.Code(err) == codes.DeadlineExceeded {
+			return c.failed(task, plugin, duration, fmt.Sprintf("plugin %s did not complete check within %s", plugin.Name(), timeout)), nil
+		}
+		c.logger.Error("Checker plugin call failed",
+			logger.String("plugin", plugin.Name()),
+			logger.String("check_id", task.CheckID),
+			logger.Error(err),
+		)
+		return nil, fmt.Errorf("plugin %s: %w", plugin.Name(), err)
+	}
+
+	durationMs := resp.DurationMs
+	if durationMs <= 0 {
+		durationMs = duration.Milliseconds()
+	}
+	result := domain.NewCheckResult(task.CheckID, task.ExecutionID, resp.Success, durationMs, int(resp.StatusCode), resp.Error, resp.ResponseBody)
+	for key, value := range resp.Metadata {
+		result.Metadata[key] = value
+	}
+	c.annotate(result, plugin)
+	return result, nil
+}
+
+// GetType возвращает тип checker'а
+func (c *PluginChecker) GetType() domain.TaskType {
+	return domain.TaskTypePlugin
+}
+
+// ValidateConfig проверяет имя плагина и таймаут, остальную конфигурацию проверяет сам плагин
+func (c *PluginChecker) ValidateConfig(config map[string]interface{}) error {
+	plugin, err := c.plugin(config)
+	if err != nil {
+		return err
+	}
+	if value, ok := config["timeout"].(string); ok {
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid timeout format: %w", err)
+		}
+	}
+
+	client, err := plugin.connect()
+	if err != nil {
+		return err
+	}
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to encode plugin check config: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	resp, err := client.ValidateConfig(ctx, &pluginv1.ValidateConfigRequest{ConfigJson: string(configJSON)})
+	if err != nil {
+		return fmt.Errorf("plugin %s: %w", plugin.Name(), err)
+	}
+	if resp.Error != "" {
+		return fmt.Errorf("plugin %s: %s", plugin.Name(), resp.Error)
+	}
+	return nil
+}
+
+// Plugins возвращает имена загруженных плагинов
+func (c *PluginChecker) Plugins() []string {
+	names := make([]string, 0, len(c.plugins))
+	for name := range c.plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Close останавливает процессы плагинов
+func (c *PluginChecker) Close() {
+	for _, plugin := range c.plugins {
+		plugin.Close()
+	}
+}
+
+// plugin находит плагин по config.plugin
+func (c *PluginChecker) plugin(config map[string]interface{}) (*CheckPlugin, error) {
+	name, _ := config[ConfigKeyPlugin].(string)
+	if name == "" {
+		return nil, fmt.Errorf("config.%s is required for plugin checks", ConfigKeyPlugin)
+	}
+	plugin, ok := c.plugins[name]
+	if !ok {
+		return nil, fmt.Errorf("checker plugin %q is not loaded", name)
+	}
+	return plugin, nil
+}
+
+// failed создает неуспешный результат без вызова плагина
+func (c *PluginChecker) failed(task *domain.Task, plugin *CheckPlugin, duration time.Duration, message string) *domain.CheckResult {
+	c.logger.Warn("Plugin check failed",
+		logger.String("plugin", plugin.Name()),
+		logger.String("check_id", task.CheckID),
+		logger.String("error", message),
+	)
+	result := domain.NewCheckResult(task.CheckID, task.ExecutionID, false, duration.Milliseconds(), 0, message, "")
+	c.annotate(result, plugin)
+	return result
+}
+
+// annotate добавляет к результату имя и версию плагина
+func (c *PluginChecker) annotate(result *domain.CheckResult, plugin *CheckPlugin) {
+	result.Metadata["plugin"] = plugin.Name()
+	if plugin.info.Version != "" {
+		result.Metadata["plugin_version"] = plugin.info.Version
+	}
+}
@@ -0,0 +1,167 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+	pluginv1 "UptimePingPlatform/proto/api/plugin/v1"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// fakeCheckerPluginClient отвечает за плагин проверок без запуска процесса
+type fakeCheckerPluginClient struct {
+	mu       sync.Mutex
+	requests []*pluginv1.CheckRequest
+	response *pluginv1.CheckResponse
+	err      error
+	// block держит проверку до отмены контекста вызова
+	block   bool
+	started chan struct{}
+}
+
+func (c *fakeCheckerPluginClient) Describe(ctx context.Context, in *pluginv1.DescribeRequest, opts ...grpc.CallOption) (*pluginv1.DescribeCheckerResponse, error) {
+	return &pluginv1.DescribeCheckerResponse{Name: "fix"}, nil
+}
+
+func (c *fakeCheckerPluginClient) ValidateConfig(ctx context.Context, in *pluginv1.ValidateConfigRequest, opts ...grpc.CallOption) (*pluginv1.ValidateConfigResponse, error) {
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(in.ConfigJson), &config); err != nil {
+		return nil, err
+	}
+	if _, ok := config["sender_comp_id"]; !ok {
+		return &pluginv1.ValidateConfigResponse{Error: "sender_comp_id is required"}, nil
+	}
+	return &pluginv1.ValidateConfigResponse{}, nil
+}
+
+func (c *fakeCheckerPluginClient) Check(ctx context.Context, in *pluginv1.CheckRequest, opts ...grpc.CallOption) (*pluginv1.CheckResponse, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, in)
+	c.mu.Unlock()
+	if c.started != nil {
+		c.started <- struct{}{}
+	}
+	if c.block {
+		<-ctx.Done()
+		return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.response, nil
+}
+
+func newTestPluginChecker(t *testing.T, client *fakeCheckerPluginClient, cfg PluginConfig) *PluginChecker {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	plugin := NewCheckPlugin(&pluginv1.DescribeCheckerResponse{Name: "fix", Version: "2.1.0"}, client, cfg, log)
+	return NewPluginChecker(log, plugin)
+}
+
+func pluginTask(config map[string]interface{}) *domain.Task {
+	return domain.NewTask("check-1", "fix.example.com:9876", string(domain.TaskTypePlugin), "exec-1", time.Now(), config)
+}
+
+func TestPluginChecker_Execute(t *testing.T) {
+	client := &fakeCheckerPluginClient{response: &pluginv1.CheckResponse{
+		Success:      true,
+		DurationMs:   42,
+		ResponseBody: "8=FIX.4.4|35=A",
+		Metadata:     map[string]string{"session": "logged_on"},
+	}}
+	checker := newTestPluginChecker(t, client, PluginConfig{Timeout: 10 * time.Second})
+
+	result, err := checker.Execute(pluginTask(map[string]interface{}{"plugin": "fix", "timeout": "3s", "sender_comp_id": "UPTIME"}))
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, int64(42), result.DurationMs)
+	assert.Equal(t, "8=FIX.4.4|35=A", result.ResponseBody)
+	assert.Equal(t, map[string]string{"session": "logged_on", "plugin": "fix", "plugin_version": "2.1.0"}, result.Metadata)
+
+	require.Len(t, client.requests, 1)
+	request := client.requests[0]
+	assert.Equal(t, "fix.example.com:9876", request.Target)
+	assert.JSONEq(t, `{"plugin":"fix","timeout":"3s","sender_comp_id":"UPTIME"}`, request.ConfigJson)
+	assert.InDelta(t, 3000, request.TimeoutMs, 100)
+}
+
+func TestPluginChecker_TimeoutIsCappedByPluginConfig(t *testing.T) {
+	client := &fakeCheckerPluginClient{block: true}
+	checker := newTestPluginChecker(t, client, PluginConfig{Timeout: 50 * time.Millisecond})
+
+	result, err := checker.Execute(pluginTask(map[string]interface{}{"plugin": "fix", "timeout": "1m"}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "did not complete check within 50ms")
+	assert.Equal(t, "fix", result.Metadata["plugin"])
+}
+
+func TestPluginChecker_ConcurrencyLimit(t *testing.T) {
+	client := &fakeCheckerPluginClient{block: true, started: make(chan struct{}, 1)}
+	checker := newTestPluginChecker(t, client, PluginConfig{Timeout: time.Second, MaxConcurrent: 1})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = checker.Execute(pluginTask(map[string]interface{}{"plugin": "fix"}))
+	}()
+	<-client.started
+
+	result, err := checker.Execute(pluginTask(map[string]interface{}{"plugin": "fix", "timeout": "50ms"}))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "concurrency limit reached")
+	<-done
+	assert.Len(t, client.requests, 1)
+}
+
+func TestPluginChecker_PluginErrors(t *testing.T) {
+	client := &fakeCheckerPluginClient{err: status.Error(codes.Unavailable, "connection refused")}
+	checker := newTestPluginChecker(t, client, PluginConfig{})
+
+	_, err := checker.Execute(pluginTask(map[string]interface{}{"plugin": "fix"}))
+	assert.ErrorContains(t, err, "plugin fix")
+
+	_, err = checker.Execute(pluginTask(map[string]interface{}{}))
+	assert.ErrorContains(t, err, "config.plugin is required")
+
+	_, err = checker.Execute(pluginTask(map[string]interface{}{"plugin": "banner"}))
+	assert.ErrorContains(t, err, `"banner" is not loaded`)
+}
+
+func TestPluginChecker_ValidateConfig(t *testing.T) {
+	checker := newTestPluginChecker(t, &fakeCheckerPluginClient{}, PluginConfig{})
+
+	assert.NoError(t, checker.ValidateConfig(map[string]interface{}{"plugin": "fix", "sender_comp_id": "UPTIME"}))
+	assert.ErrorContains(t, checker.ValidateConfig(map[string]interface{}{"plugin": "fix"}), "sender_comp_id is required")
+	assert.ErrorContains(t, checker.ValidateConfig(map[string]interface{}{"plugin": "fix", "timeout": "soon"}), "invalid timeout")
+}
+
+func TestDefaultCheckerFactory_Plugins(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	factory := NewDefaultCheckerFactory(log, NewDefaultHTTPClient(time.Second))
+
+	_, err = factory.CreateChecker(domain.TaskTypePlugin)
+	assert.Error(t, err)
+	assert.NotContains(t, factory.GetSupportedTypes(), domain.TaskTypePlugin)
+
+	plugins := newTestPluginChecker(t, &fakeCheckerPluginClient{}, PluginConfig{})
+	factory.WithPlugins(plugins)
+	checker, err := factory.CreateChecker(domain.TaskTypePlugin)
+	require.NoError(t, err)
+	assert.Equal(t, domain.TaskTypePlugin, checker.GetType())
+	assert.Contains(t, factory.GetSupportedTypes(), domain.TaskTypePlugin)
+	assert.Equal(t, []string{"fix"}, plugins.Plugins())
+}
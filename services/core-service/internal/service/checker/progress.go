@@ -0,0 +1,38 @@
+package checker
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// ProgressPhase представляет фазу выполнения проверки
+type ProgressPhase string
+
+const (
+	PhaseStarted   ProgressPhase = "started"
+	PhaseDNS       ProgressPhase = "dns"
+	PhaseConnect   ProgressPhase = "connect"
+	PhaseTLS       ProgressPhase = "tls"
+	PhaseFirstByte ProgressPhase = "first_byte"
+	PhaseCompleted ProgressPhase = "completed"
+)
+
+// ProgressEvent представляет промежуточное событие выполнения проверки
+type ProgressEvent struct {
+	Phase   ProgressPhase `json:"phase"`
+	Elapsed time.Duration `json:"elapsed"`
+	Detail  string        `json:"detail,omitempty"`
+}
+
+// ProgressFunc получает промежуточные события выполнения проверки
+type ProgressFunc func(event ProgressEvent)
+
+// ProgressChecker определяет checker, который умеет сообщать о промежуточном прогрессе
+type ProgressChecker interface {
+	Checker
+
+	// ExecuteWithProgress выполняет проверку, вызывая progress на каждой фазе
+	ExecuteWithProgress(ctx context.Context, task *domain.Task, progress ProgressFunc) (*domain.CheckResult, error)
+}
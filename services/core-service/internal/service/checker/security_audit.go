@@ -0,0 +1,298 @@
+package checker
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Предупреждения аудита заголовков безопасности (config.security_audit). Как и предупреждения
+// политики TLS, они не делают проверку неуспешной: каждое попадает в метаданные результата
+// ключом MetadataKeySecurityWarningPrefix+код, а результат получает серьезность warning
+const (
+	SecurityWarningHSTS              = "hsts"
+	SecurityWarningCSP               = "csp"
+	SecurityWarningFrameOptions      = "x_frame_options"
+	SecurityWarningMixedContent      = "mixed_content"
+	SecurityWarningHeaderPrefix      = "header_"
+	MetadataKeySecurityWarningPrefix = "security_warning_"
+)
+
+// Серьезность результата: проверка успешна, но нарушена политика (TLS, заголовки безопасности)
+const (
+	MetadataKeyResultSeverity = "result_severity"
+	ResultSeverityWarning     = "warning"
+)
+
+// DefaultHSTSMinMaxAge минимальный max-age HSTS по умолчанию: 180 дней
+const DefaultHSTSMinMaxAge = 180 * 24 * 60 * 60
+
+// maxMixedContentRefs сколько небезопасных ссылок перечисляется в тексте предупреждения
+const maxMixedContentRefs = 5
+
+// mixedContentAttrs атрибуты элементов, загружающих ресурсы страницы. Активное содержимое
+// (скрипты, фреймы, стили, отправка форм) браузеры блокируют, пассивное - загружают с предупреждением
+var mixedContentAttrs = map[string]struct {
+	attrs  []string
+	active bool
+}{
+	"script": {[]string{"src"}, true},
+	"iframe": {[]string{"src"}, true},
+	"frame":  {[]string{"src"}, true},
+	"object": {[]string{"data"}, true},
+	"embed":  {[]string{"src"}, true},
+	"link":   {[]string{"href"}, true},
+	"form":   {[]string{"action"}, true},
+	"img":    {[]string{"src", "srcset"}, false},
+	"audio":  {[]string{"src"}, false},
+	"video":  {[]string{"src", "poster"}, false},
+	"source": {[]string{"src", "srcset"}, false},
+	"track":  {[]string{"src"}, false},
+}
+
+// SecurityAudit требования к заголовкам безопасности и содержимому HTML страницы
+type SecurityAudit struct {
+	// HSTSMinMaxAge минимальный max-age Strict-Transport-Security в секундах
+	HSTSMinMaxAge int64
+	// Headers дополнительные заголовки: имя -> подстрока значения; пустая строка - только наличие
+	Headers map[string]string
+}
+
+// SecurityAuditFromConfig извлекает аудит безопасности из конфигурации проверки
+// (security_audit, security_headers, hsts_min_max_age). false - аудит выключен
+func SecurityAuditFromConfig(config map[string]interface{}) (SecurityAudit, bool) {
+	if enabled, _ := config["security_audit"].(bool); !enabled {
+		return SecurityAudit{}, false
+	}
+	audit := SecurityAudit{HSTSMinMaxAge: DefaultHSTSMinMaxAge}
+	if maxAge, ok := config["hsts_min_max_age"].(float64); ok {
+		audit.HSTSMinMaxAge = int64(maxAge)
+	}
+	if headers, ok := config["security_headers"].(map[string]interface{}); ok {
+		audit.Headers = make(map[string]string, len(headers))
+		for name, value := range headers {
+			audit.Headers[name], _ = value.(string)
+		}
+	}
+	return audit, true
+}
+
+// validateSecurityAudit проверяет значения аудита безопасности в конфигурации проверки
+func validateSecurityAudit(config map[string]interface{}) error {
+	if enabled, ok := config["security_audit"]; ok {
+		if _, ok := enabled.(bool); !ok {
+			return fmt.Errorf("security_audit must be a boolean")
+		}
+	}
+	if maxAge, ok := config["hsts_min_max_age"]; ok {
+		if value, ok := maxAge.(float64); !ok || value < 0 || value != float64(int64(value)) {
+			return fmt.Errorf("hsts_min_max_age must be a whole number of seconds")
+		}
+	}
+	if headers, ok := config["security_headers"]; ok {
+		list, ok := headers.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("security_headers must be a map of header names to expected values")
+		}
+		for name, value := range list {
+			if _, ok := value.(string); !ok || strings.TrimSpace(name) == "" {
+				return fmt.Errorf("security_headers.%s must be a string", name)
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate проверяет заголовки безопасности и, для HTML страниц по HTTPS, ссылки на ресурсы по
+// HTTP. Предупреждения записываются в метаданные и возвращаются по кодам
+func (a SecurityAudit) Evaluate(resp *http.Response, body []byte, metadata map[string]string) map[string]string {
+	warnings := make(map[string]string)
+	secure := resp.Request != nil && resp.Request.URL.Scheme == "https"
+	csp := resp.Header.Get("Content-Security-Policy")
+
+	if secure {
+		if message := a.checkHSTS(resp.Header.Get("Strict-Transport-Security")); message != "" {
+			warnings[SecurityWarningHSTS] = message
+		}
+	}
+	if csp == "" {
+		warnings[SecurityWarningCSP] = "Content-Security-Policy header is missing"
+	}
+	if message := checkFrameOptions(resp.Header.Get("X-Frame-Options"), csp); message != "" {
+		warnings[SecurityWarningFrameOptions] = message
+	}
+	for name, expected := range a.Headers {
+		code := SecurityWarningHeaderPrefix + strings.ReplaceAll(strings.ToLower(name), "-", "_")
+		value := resp.Header.Get(name)
+		switch {
+		case len(resp.Header.Values(name)) == 0:
+			warnings[code] = fmt.Sprintf("%s header is missing", http.CanonicalHeaderKey(name))
+		case expected != "" && !strings.Contains(strings.ToLower(value), strings.ToLower(expected)):
+			warnings[code] = fmt.Sprintf("%s header %q does not contain %q", http.CanonicalHeaderKey(name), value, expected)
+		}
+	}
+
+	if secure && isHTML(resp.Header.Get("Content-Type")) && !cspDirective(csp, "upgrade-insecure-requests") {
+		if refs, active := mixedContent(body); len(refs) > 0 {
+			shown := refs
+			if len(shown) > maxMixedContentRefs {
+				shown = shown[:maxMixedContentRefs]
+			}
+			warnings[SecurityWarningMixedContent] = fmt.Sprintf("%d insecure references (%d active): %s",
+				len(refs), active, strings.Join(shown, ", "))
+		}
+	}
+
+	for code, message := range warnings {
+		metadata[MetadataKeySecurityWarningPrefix+code] = message
+	}
+	return warnings
+}
+
+// checkHSTS проверяет наличие Strict-Transport-Security и его max-age
+func (a SecurityAudit) checkHSTS(value string) string {
+	if value == "" {
+		return "Strict-Transport-Security header is missing"
+	}
+	for _, directive := range strings.Split(value, ";") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		if !strings.EqualFold(strings.TrimSpace(name), "max-age") {
+			continue
+		}
+		maxAge, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(arg), `"`), 10, 64)
+		if err != nil {
+			return fmt.Sprintf("Strict-Transport-Security has an invalid max-age: %q", value)
+		}
+		if maxAge < a.HSTSMinMaxAge {
+			return fmt.Sprintf("Strict-Transport-Security max-age %d is below the required %d", maxAge, a.HSTSMinMaxAge)
+		}
+		return ""
+	}
+	return fmt.Sprintf("Strict-Transport-Security has no max-age: %q", value)
+}
+
+// checkFrameOptions проверяет защиту от встраивания во фрейм: X-Frame-Options DENY или
+// SAMEORIGIN либо директива frame-ancestors в CSP, которая заменяет X-Frame-Options
+func checkFrameOptions(value, csp string) string {
+	if cspDirective(csp, "frame-ancestors") {
+		return ""
+	}
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "DENY", "SAMEORIGIN":
+		return ""
+	case "":
+		return "X-Frame-Options header is missing and CSP has no frame-ancestors directive"
+	default:
+		return fmt.Sprintf("X-Frame-Options %q must be DENY or SAMEORIGIN", value)
+	}
+}
+
+// cspDirective проверяет наличие директивы в Content-Security-Policy
+func cspDirective(csp, directive string) bool {
+	for _, part := range strings.Split(csp, ";") {
+		fields := strings.Fields(part)
+		if len(fields) > 0 && strings.EqualFold(fields[0], directive) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHTML проверяет, что ответ - HTML страница
+func isHTML(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "text/html" || mediaType == "application/xhtml+xml")
+}
+
+// mixedContent возвращает ресурсы страницы, загружаемые по HTTP, в порядке появления без повторов,
+// и число активных среди них
+func mixedContent(body []byte) ([]string, int) {
+	var refs []string
+	active := 0
+	seen := make(map[string]bool)
+	tokenizer := html.NewTokenizer(bytes.NewReader(body))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return refs, active
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			element, ok := mixedContentAttrs[token.Data]
+			if !ok || (token.Data == "link" && !loadsResource(token)) {
+				continue
+			}
+			for _, attr := range token.Attr {
+				if !containsString(element.attrs, attr.Key) {
+					continue
+				}
+				for _, ref := range insecureURLs(attr.Key, attr.Val) {
+					if seen[ref] {
+						continue
+					}
+					seen[ref] = true
+					refs = append(refs, ref)
+					if element.active {
+						active++
+					}
+				}
+			}
+		}
+	}
+}
+
+// loadsResource проверяет, что элемент link загружает ресурс страницы (стили, предзагрузка,
+// иконка), а не ссылается на другую страницу, как canonical или alternate
+func loadsResource(token html.Token) bool {
+	for _, attr := range token.Attr {
+		if attr.Key != "rel" {
+			continue
+		}
+		for _, rel := range strings.Fields(strings.ToLower(attr.Val)) {
+			switch rel {
+			case "stylesheet", "preload", "modulepreload", "icon", "manifest":
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// insecureURLs возвращает адреса http:// из значения атрибута; srcset содержит список кандидатов
+func insecureURLs(attr, value string) []string {
+	candidates := []string{value}
+	if attr == "srcset" {
+		candidates = candidates[:0]
+		for _, candidate := range strings.Split(value, ",") {
+			if fields := strings.Fields(candidate); len(fields) > 0 {
+				candidates = append(candidates, fields[0])
+			}
+		}
+	}
+	var urls []string
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		if len(candidate) >= 7 && strings.EqualFold(candidate[:7], "http://") {
+			urls = append(urls, candidate)
+		}
+	}
+	return urls
+}
+
+// WarningCodes возвращает коды предупреждений политик из метаданных результата с префиксом
+// источника, например tls_warning_cipher или security_warning_hsts
+func WarningCodes(metadata map[string]string) []string {
+	var codes []string
+	for key := range metadata {
+		if strings.HasPrefix(key, MetadataKeyTLSWarningPrefix) || strings.HasPrefix(key, MetadataKeySecurityWarningPrefix) {
+			codes = append(codes, key)
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
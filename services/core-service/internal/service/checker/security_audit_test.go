@@ -0,0 +1,147 @@
+package checker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// auditResponse ответ HTTPS страницы с заголовками для проверки аудита
+func auditResponse(headers map[string]string) *http.Response {
+	resp := &http.Response{
+		Header:  make(http.Header),
+		Request: &http.Request{URL: &url.URL{Scheme: "https", Host: "example.com"}},
+	}
+	for name, value := range headers {
+		resp.Header.Set(name, value)
+	}
+	return resp
+}
+
+func TestSecurityAudit_Headers(t *testing.T) {
+	audit, ok := SecurityAuditFromConfig(map[string]interface{}{"security_audit": true})
+	require.True(t, ok)
+
+	metadata := make(map[string]string)
+	warnings := audit.Evaluate(auditResponse(map[string]string{
+		"Strict-Transport-Security": "max-age=31536000; includeSubDomains",
+		"Content-Security-Policy":   "default-src 'self'",
+		"X-Frame-Options":           "DENY",
+	}), nil, metadata)
+	assert.Empty(t, warnings)
+	assert.Empty(t, metadata)
+
+	warnings = audit.Evaluate(auditResponse(map[string]string{
+		"Strict-Transport-Security": "max-age=3600",
+		"X-Frame-Options":           "ALLOW-FROM https://partner.example",
+	}), nil, metadata)
+	assert.Contains(t, warnings[SecurityWarningHSTS], "max-age 3600 is below the required 15552000")
+	assert.Equal(t, "Content-Security-Policy header is missing", warnings[SecurityWarningCSP])
+	assert.Contains(t, warnings[SecurityWarningFrameOptions], "must be DENY or SAMEORIGIN")
+	assert.Equal(t, warnings[SecurityWarningHSTS], metadata[MetadataKeySecurityWarningPrefix+SecurityWarningHSTS])
+
+	// frame-ancestors в CSP заменяет X-Frame-Options; HSTS по HTTP не проверяется
+	plain := auditResponse(map[string]string{"Content-Security-Policy": "frame-ancestors 'none'"})
+	plain.Request.URL.Scheme = "http"
+	assert.Empty(t, audit.Evaluate(plain, nil, map[string]string{}))
+}
+
+func TestSecurityAudit_CustomHeaders(t *testing.T) {
+	audit, ok := SecurityAuditFromConfig(map[string]interface{}{
+		"security_audit":   true,
+		"hsts_min_max_age": float64(60),
+		"security_headers": map[string]interface{}{
+			"X-Content-Type-Options": "nosniff",
+			"Referrer-Policy":        "",
+			"Permissions-Policy":     "",
+		},
+	})
+	require.True(t, ok)
+
+	warnings := audit.Evaluate(auditResponse(map[string]string{
+		"Strict-Transport-Security": "max-age=60",
+		"Content-Security-Policy":   "default-src 'self'",
+		"X-Frame-Options":           "sameorigin",
+		"X-Content-Type-Options":    "sniff",
+		"Referrer-Policy":           "no-referrer",
+	}), nil, map[string]string{})
+	assert.Len(t, warnings, 2)
+	assert.Contains(t, warnings["header_x_content_type_options"], `does not contain "nosniff"`)
+	assert.Equal(t, "Permissions-Policy header is missing", warnings["header_permissions_policy"])
+}
+
+func TestSecurityAudit_MixedContent(t *testing.T) {
+	audit, _ := SecurityAuditFromConfig(map[string]interface{}{"security_audit": true})
+	headers := map[string]string{
+		"Strict-Transport-Security": "max-age=31536000",
+		"Content-Security-Policy":   "default-src https:",
+		"X-Frame-Options":           "DENY",
+		"Content-Type":              "text/html; charset=utf-8",
+	}
+	page := []byte(`<!doctype html><html><head>
+		<link rel="canonical" href="http://example.com/">
+		<link rel="stylesheet" href="http://cdn.example.com/site.css">
+		<script src="http://cdn.example.com/app.js"></script>
+		<script src="https://cdn.example.com/safe.js"></script>
+		</head><body>
+		<a href="http://other.example/">link</a>
+		<img src="http://img.example.com/logo.png" srcset="https://img.example.com/2x.png 2x, http://img.example.com/3x.png 3x">
+		<img src="http://img.example.com/logo.png">
+		</body></html>`)
+
+	warnings := audit.Evaluate(auditResponse(headers), page, map[string]string{})
+	require.Contains(t, warnings, SecurityWarningMixedContent)
+	assert.Equal(t, "4 insecure references (2 active): http://cdn.example.com/site.css, http://cdn.example.com/app.js, "+
+		"http://img.example.com/logo.png, http://img.example.com/3x.png", warnings[SecurityWarningMixedContent])
+
+	// upgrade-insecure-requests заставляет браузер загружать ресурсы по HTTPS
+	headers["Content-Security-Policy"] = "default-src https:; upgrade-insecure-requests"
+	assert.Empty(t, audit.Evaluate(auditResponse(headers), page, map[string]string{}))
+
+	// Тело не HTML не разбирается
+	headers["Content-Security-Policy"] = "default-src https:"
+	headers["Content-Type"] = "application/json"
+	assert.Empty(t, audit.Evaluate(auditResponse(headers), page, map[string]string{}))
+}
+
+func TestHTTPChecker_SecurityAudit(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	checker := NewHTTPChecker(5000, log)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"method":          "GET",
+		"url":             server.URL,
+		"expected_status": float64(200),
+		"security_audit":  true,
+	}
+	result, err := checker.Execute(domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), config))
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, ResultSeverityWarning, result.Metadata[MetadataKeyResultSeverity])
+	assert.Equal(t, []string{"security_warning_csp", "security_warning_x_frame_options"}, WarningCodes(result.Metadata))
+
+	delete(config, "security_audit")
+	result, err = checker.Execute(domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), config))
+	require.NoError(t, err)
+	assert.Empty(t, result.Metadata[MetadataKeyResultSeverity])
+
+	config["security_headers"] = "X-Frame-Options"
+	assert.Error(t, checker.ValidateConfig(config))
+	config["security_headers"] = map[string]interface{}{"X-Frame-Options": "DENY"}
+	config["hsts_min_max_age"] = float64(-1)
+	assert.Error(t, checker.ValidateConfig(config))
+}
@@ -0,0 +1,162 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// DefaultDeniedTargets сети, в которые проверки не устанавливают соединения: проверки выполняются
+// изнутри сети платформы, и цель в этих сетях дает tenant доступ к внутренним сервисам и
+// метаданным облака (SSRF)
+var DefaultDeniedTargets = []string{
+	"0.0.0.0/8",        // "этот" хост
+	"10.0.0.0/8",       // RFC 1918
+	"100.64.0.0/10",    // CGNAT, в том числе метаданные Alibaba Cloud 100.100.100.200
+	"127.0.0.0/8",      // loopback
+	"169.254.0.0/16",   // link-local, в том числе метаданные AWS, GCP и Azure 169.254.169.254
+	"172.16.0.0/12",    // RFC 1918
+	"192.0.0.0/24",     // IETF protocol assignments
+	"192.168.0.0/16",   // RFC 1918
+	"198.18.0.0/15",    // сети тестирования производительности
+	"224.0.0.0/4",      // multicast
+	"240.0.0.0/4",      // зарезервировано и broadcast
+	"168.63.129.16/32", // Azure WireServer
+	"::/128",           // unspecified
+	"::1/128",          // loopback
+	"64:ff9b:1::/48",   // локальная трансляция NAT64
+	"fc00::/7",         // unique local, в том числе метаданные AWS fd00:ec2::254
+	"fe80::/10",        // link-local
+	"ff00::/8",         // multicast
+}
+
+// nat64Prefix well-known префикс NAT64 (RFC 6052): последние 32 бита - адрес IPv4 назначения
+var nat64Prefix = netip.MustParsePrefix("64:ff9b::/96")
+
+// TargetPolicy ограничивает адреса, к которым проверки устанавливают соединения. Адрес
+// проверяется после разрешения DNS непосредственно перед соединением, поэтому имя, которое
+// разрешается во внутренний адрес, и смена адреса между проверкой и соединением (DNS rebinding)
+// не обходят политику. Разрешенные сети имеют приоритет над запрещенными
+type TargetPolicy struct {
+	deny  []netip.Prefix
+	allow []netip.Prefix
+}
+
+// NewTargetPolicy создает политику из запрещенных и разрешенных сетей в виде CIDR или адресов
+func NewTargetPolicy(deny, allow []string) (*TargetPolicy, error) {
+	denyPrefixes, err := parseTargetPrefixes(deny)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denied target: %w", err)
+	}
+	allowPrefixes, err := parseTargetPrefixes(allow)
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed target: %w", err)
+	}
+	return &TargetPolicy{deny: denyPrefixes, allow: allowPrefixes}, nil
+}
+
+// NewDefaultTargetPolicy создает политику с DefaultDeniedTargets, дополненными deny, и
+// исключениями allow для сетей конкретной установки
+func NewDefaultTargetPolicy(deny, allow []string) (*TargetPolicy, error) {
+	return NewTargetPolicy(append(append([]string{}, DefaultDeniedTargets...), deny...), allow)
+}
+
+// parseTargetPrefixes разбирает сети в виде CIDR или отдельных адресов
+func parseTargetPrefixes(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+// Allowed проверяет, разрешено ли соединение с адресом. IPv4-mapped IPv6 адреса проверяются
+// как IPv4, адреса NAT64 - вместе со встроенным адресом IPv4
+func (p *TargetPolicy) Allowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if nat64Prefix.Contains(addr) {
+		raw := addr.As16()
+		if !p.allowed(netip.AddrFrom4([4]byte(raw[12:]))) {
+			return false
+		}
+	}
+	return p.allowed(addr)
+}
+
+// allowed проверяет адрес по спискам сетей
+func (p *TargetPolicy) allowed(addr netip.Addr) bool {
+	for _, prefix := range p.allow {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	for _, prefix := range p.deny {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckAddress проверяет адрес соединения в формате host:port, где host - IP адрес
+func (p *TargetPolicy) CheckAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("target address %q is not an IP address", host)
+	}
+	if !p.Allowed(addr) {
+		return fmt.Errorf("target address %s is denied by the target policy", addr.Unmap())
+	}
+	return nil
+}
+
+// control реализует net.Dialer.Control: вызывается для каждого адреса после разрешения DNS
+func (p *TargetPolicy) control(network, address string, _ syscall.RawConn) error {
+	return p.CheckAddress(address)
+}
+
+// Dialer возвращает net.Dialer, отклоняющий соединения с запрещенными адресами;
+// nil политика не ограничивает соединения
+func (p *TargetPolicy) Dialer(timeout time.Duration) *net.Dialer {
+	dialer := &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}
+	if p != nil {
+		dialer.Control = p.control
+	}
+	return dialer
+}
+
+// DialContext устанавливает соединение с учетом политики; используется в http.Transport и gRPC
+func (p *TargetPolicy) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	return p.Dialer(30*time.Second).DialContext(ctx, network, address)
+}
+
+// TargetRestricted checker, ограничивающий адреса соединений политикой целей. Фабрика
+// подключает политику ко всем таким checker'ам; плагины проверок устанавливаются оператором
+// и отвечают за свои соединения сами
+type TargetRestricted interface {
+	SetTargetPolicy(policy *TargetPolicy)
+}
@@ -0,0 +1,99 @@
+package checker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+func TestTargetPolicy_Allowed(t *testing.T) {
+	policy, err := NewDefaultTargetPolicy([]string{"203.0.113.7"}, []string{"10.20.0.0/16"})
+	require.NoError(t, err)
+
+	for _, addr := range []string{
+		"169.254.169.254",
+		"10.0.0.1",
+		"127.0.0.1",
+		"192.168.1.10",
+		"100.100.100.200",
+		"::1",
+		"fd00:ec2::254",
+		"::ffff:10.0.0.1",
+		"64:ff9b::a9fe:a9fe", // NAT64 для 169.254.169.254
+		"203.0.113.7",
+	} {
+		assert.False(t, policy.Allowed(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{
+		"93.184.216.34",
+		"2606:4700::1111",
+		"64:ff9b::5db8:d822", // NAT64 для 93.184.216.34
+		"10.20.3.4",          // исключение установки
+	} {
+		assert.True(t, policy.Allowed(netip.MustParseAddr(addr)), addr)
+	}
+
+	assert.NoError(t, policy.CheckAddress("93.184.216.34:443"))
+	assert.ErrorContains(t, policy.CheckAddress("[::ffff:169.254.169.254]:80"), "169.254.169.254 is denied")
+	assert.Error(t, policy.CheckAddress("example.com:80"))
+
+	_, err = NewDefaultTargetPolicy(nil, []string{"internal.example.com"})
+	assert.Error(t, err)
+}
+
+func TestTargetPolicy_HTTPChecker(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	task := domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), map[string]interface{}{
+		"method":          "GET",
+		"url":             server.URL,
+		"expected_status": float64(200),
+	})
+
+	policy, err := NewDefaultTargetPolicy(nil, nil)
+	require.NoError(t, err)
+	factory := NewDefaultCheckerFactory(log, nil).WithTargetPolicy(policy)
+	checker, err := factory.CreateChecker(domain.TaskTypeHTTP)
+	require.NoError(t, err)
+	result, err := checker.Execute(task)
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "denied by the target policy")
+
+	// Исключение установки разрешает проверку внутреннего сервиса
+	policy, err = NewDefaultTargetPolicy(nil, []string{"127.0.0.1"})
+	require.NoError(t, err)
+	checker, err = NewDefaultCheckerFactory(log, nil).WithTargetPolicy(policy).CreateChecker(domain.TaskTypeHTTP)
+	require.NoError(t, err)
+	result, err = checker.Execute(task)
+	require.NoError(t, err)
+	assert.True(t, result.Success, result.Error)
+}
+
+func TestTargetPolicy_TCPDialer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	address := server.Listener.Addr().String()
+
+	policy, err := NewDefaultTargetPolicy(nil, nil)
+	require.NoError(t, err)
+	_, err = (&DefaultTCPDialer{Policy: policy}).Dial(address, 1000)
+	assert.Error(t, err)
+
+	conn, err := (&DefaultTCPDialer{}).Dial(address, 1000)
+	require.NoError(t, err)
+	assert.True(t, conn.Connected)
+}
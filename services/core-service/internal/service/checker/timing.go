@@ -0,0 +1,139 @@
+package checker
+
+import (
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// phaseTimer измеряет длительности фаз HTTP запроса (DNS, connect, TLS, TTFB)
+// через httptrace и при необходимости транслирует их в ProgressFunc
+type phaseTimer struct {
+	mu       sync.Mutex
+	start    time.Time
+	progress ProgressFunc
+
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	firstByte                 time.Time
+
+	gotConn, connReused bool
+}
+
+// newPhaseTimer создает таймер фаз; progress может быть nil
+func newPhaseTimer(start time.Time, progress ProgressFunc) *phaseTimer {
+	return &phaseTimer{start: start, progress: progress}
+}
+
+// clientTrace возвращает httptrace.ClientTrace, заполняющий таймер
+func (p *phaseTimer) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			p.mark(&p.dnsStart)
+		},
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			p.mark(&p.dnsDone)
+			detail := ""
+			if info.Err != nil {
+				detail = info.Err.Error()
+			} else if len(info.Addrs) > 0 {
+				detail = info.Addrs[0].String()
+			}
+			p.report(PhaseDNS, detail)
+		},
+		ConnectStart: func(network, addr string) {
+			p.markOnce(&p.connectStart)
+		},
+		ConnectDone: func(network, addr string, err error) {
+			if err != nil {
+				p.report(PhaseConnect, err.Error())
+				return
+			}
+			p.markOnce(&p.connectDone)
+			p.report(PhaseConnect, addr)
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			p.mu.Lock()
+			p.gotConn = true
+			p.connReused = info.Reused
+			p.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			p.mark(&p.tlsStart)
+		},
+		TLSHandshakeDone: func(state tls.ConnectionState, err error) {
+			p.mark(&p.tlsDone)
+			if err != nil {
+				p.report(PhaseTLS, err.Error())
+				return
+			}
+			p.report(PhaseTLS, tls.VersionName(state.Version))
+		},
+		GotFirstResponseByte: func() {
+			p.mark(&p.firstByte)
+			p.report(PhaseFirstByte, "")
+		},
+	}
+}
+
+// Timings возвращает длительности фаз; незавершенные фазы равны нулю
+func (p *phaseTimer) Timings(total time.Duration) *domain.CheckTimings {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	timings := &domain.CheckTimings{
+		DNSLookupMs:    between(p.dnsStart, p.dnsDone),
+		TCPConnectMs:   between(p.connectStart, p.connectDone),
+		TLSHandshakeMs: between(p.tlsStart, p.tlsDone),
+		TotalMs:        total.Milliseconds(),
+	}
+	if !p.firstByte.IsZero() {
+		timings.TTFBMs = p.firstByte.Sub(p.start).Milliseconds()
+	}
+	return timings
+}
+
+// ConnectionReused сообщает, было ли соединение взято из пула; false во втором значении -
+// соединение не было получено
+func (p *phaseTimer) ConnectionReused() (bool, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.connReused, p.gotConn
+}
+
+// mark фиксирует текущее время в поле таймера
+func (p *phaseTimer) mark(field *time.Time) {
+	p.mu.Lock()
+	*field = time.Now()
+	p.mu.Unlock()
+}
+
+// markOnce фиксирует время только при первом вызове (Happy Eyeballs может
+// устанавливать несколько соединений параллельно)
+func (p *phaseTimer) markOnce(field *time.Time) {
+	p.mu.Lock()
+	if field.IsZero() {
+		*field = time.Now()
+	}
+	p.mu.Unlock()
+}
+
+// report передает событие прогресса, если подписчик задан
+func (p *phaseTimer) report(phase ProgressPhase, detail string) {
+	if p.progress == nil {
+		return
+	}
+	p.progress(ProgressEvent{Phase: phase, Elapsed: time.Since(p.start), Detail: detail})
+}
+
+// between возвращает длительность между двумя отметками в миллисекундах
+func between(from, to time.Time) int64 {
+	if from.IsZero() || to.IsZero() {
+		return 0
+	}
+	return to.Sub(from).Milliseconds()
+}
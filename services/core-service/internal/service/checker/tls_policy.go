@@ -0,0 +1,232 @@
+package checker
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// Предупреждения политики TLS. Предупреждение не делает проверку неуспешной: каждое попадает
+// в метаданные результата отдельным ключом MetadataKeyTLSWarningPrefix+код
+const (
+	TLSWarningOCSPStapling   = "ocsp_stapling"
+	TLSWarningOCSPRevoked    = "ocsp_revoked"
+	TLSWarningMinVersion     = "min_version"
+	TLSWarningCipher         = "cipher"
+	TLSWarningDistrustedRoot = "distrusted_root"
+)
+
+// Ключи метаданных результата с параметрами TLS соединения
+const (
+	MetadataKeyTLSVersion       = "tls_version"
+	MetadataKeyTLSCipherSuite   = "tls_cipher_suite"
+	MetadataKeyTLSOCSPStapled   = "tls_ocsp_stapled"
+	MetadataKeyTLSWarningPrefix = "tls_warning_"
+)
+
+// tlsVersions версии TLS для config.tls_min_version
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// distrustedRoots корневые сертификаты, недоверие к которым объявлено браузерами и
+// хранилищами корней (Chrome, Mozilla, Apple); сопоставляются по CommonName корня
+var distrustedRoots = map[string]string{
+	"Entrust Root Certification Authority":         "Entrust",
+	"Entrust Root Certification Authority - EC1":   "Entrust",
+	"Entrust Root Certification Authority - G2":    "Entrust",
+	"Entrust Root Certification Authority - G4":    "Entrust",
+	"Entrust.net Certification Authority (2048)":   "Entrust",
+	"AffirmTrust Commercial":                       "Entrust",
+	"AffirmTrust Networking":                       "Entrust",
+	"AffirmTrust Premium":                          "Entrust",
+	"AffirmTrust Premium ECC":                      "Entrust",
+	"ePKI Root Certification Authority":            "Chunghwa Telecom",
+	"HiPKI Root CA - G1":                           "Chunghwa Telecom",
+	"NetLock Arany (Class Gold) Főtanúsítvány":     "NetLock",
+	"Chambers of Commerce Root - 2008":             "Camerfirma",
+	"Global Chambersign Root - 2008":               "Camerfirma",
+	"AC Camerfirma S.A. Chambers of Commerce Root": "Camerfirma",
+}
+
+// TLSPolicy требования к TLS соединению HTTPS проверки
+type TLSPolicy struct {
+	// MinVersion минимальная версия TLS; 0 - без требования
+	MinVersion uint16
+	// Ciphers допустимые наборы шифров в именах crypto/tls; пусто - любой
+	Ciphers []string
+	// RequireOCSPStapling требовать OCSP ответ, приложенный сервером к рукопожатию
+	RequireOCSPStapling bool
+}
+
+// TLSPolicyFromConfig извлекает политику TLS из конфигурации проверки
+// (tls_min_version, tls_ciphers, tls_require_ocsp_stapling)
+func TLSPolicyFromConfig(config map[string]interface{}) TLSPolicy {
+	var policy TLSPolicy
+	if version, ok := config["tls_min_version"].(string); ok {
+		policy.MinVersion = tlsVersions[version]
+	}
+	if ciphers, ok := config["tls_ciphers"].([]interface{}); ok {
+		for _, cipher := range ciphers {
+			if name, ok := cipher.(string); ok && name != "" {
+				policy.Ciphers = append(policy.Ciphers, name)
+			}
+		}
+	}
+	if required, ok := config["tls_require_ocsp_stapling"].(bool); ok {
+		policy.RequireOCSPStapling = required
+	}
+	return policy
+}
+
+// validateTLSPolicy проверяет значения политики TLS в конфигурации проверки
+func validateTLSPolicy(config map[string]interface{}) error {
+	if version, ok := config["tls_min_version"]; ok {
+		versionStr, _ := version.(string)
+		if _, ok := tlsVersions[versionStr]; !ok {
+			return fmt.Errorf("tls_min_version must be one of 1.0, 1.1, 1.2, 1.3")
+		}
+	}
+	if ciphers, ok := config["tls_ciphers"]; ok {
+		list, ok := ciphers.([]interface{})
+		if !ok {
+			return fmt.Errorf("tls_ciphers must be a list of cipher suite names")
+		}
+		for _, cipher := range list {
+			name, _ := cipher.(string)
+			if !knownCipherSuite(name) {
+				return fmt.Errorf("unknown cipher suite in tls_ciphers: %v", cipher)
+			}
+		}
+	}
+	if required, ok := config["tls_require_ocsp_stapling"]; ok {
+		if _, ok := required.(bool); !ok {
+			return fmt.Errorf("tls_require_ocsp_stapling must be a boolean")
+		}
+	}
+	return nil
+}
+
+// knownCipherSuite проверяет, что имя набора шифров известно crypto/tls
+func knownCipherSuite(name string) bool {
+	for _, suites := range [][]*tls.CipherSuite{tls.CipherSuites(), tls.InsecureCipherSuites()} {
+		for _, suite := range suites {
+			if suite.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Evaluate записывает параметры TLS соединения в метаданные и возвращает предупреждения
+// политики по кодам: устаревшая версия, недопустимый шифр, отсутствующий или отозванный
+// OCSP ответ, корень с объявленным недоверием
+func (p TLSPolicy) Evaluate(state *tls.ConnectionState, now time.Time, metadata map[string]string) map[string]string {
+	warnings := make(map[string]string)
+	metadata[MetadataKeyTLSVersion] = tls.VersionName(state.Version)
+	metadata[MetadataKeyTLSCipherSuite] = tls.CipherSuiteName(state.CipherSuite)
+	metadata[MetadataKeyTLSOCSPStapled] = fmt.Sprintf("%t", len(state.OCSPResponse) > 0)
+
+	if p.MinVersion != 0 && state.Version < p.MinVersion {
+		warnings[TLSWarningMinVersion] = fmt.Sprintf("negotiated %s, policy requires %s or later",
+			tls.VersionName(state.Version), tls.VersionName(p.MinVersion))
+	}
+	if len(p.Ciphers) > 0 && !containsString(p.Ciphers, tls.CipherSuiteName(state.CipherSuite)) {
+		warnings[TLSWarningCipher] = fmt.Sprintf("negotiated cipher suite %s is not allowed by policy",
+			tls.CipherSuiteName(state.CipherSuite))
+	}
+
+	leaf, issuer := leafAndIssuer(state)
+	switch {
+	case len(state.OCSPResponse) == 0:
+		if p.RequireOCSPStapling {
+			warnings[TLSWarningOCSPStapling] = "server did not staple an OCSP response"
+		}
+	case leaf == nil || issuer == nil:
+		warnings[TLSWarningOCSPStapling] = "stapled OCSP response cannot be verified without the issuer certificate"
+	default:
+		if code, message := verifyStaple(state.OCSPResponse, leaf, issuer, now); code != "" {
+			warnings[code] = message
+		}
+	}
+
+	if root, operator := distrustedRoot(state); root != "" {
+		warnings[TLSWarningDistrustedRoot] = fmt.Sprintf("chain ends at %s root %q scheduled for distrust by browsers", operator, root)
+	}
+
+	for code, message := range warnings {
+		metadata[MetadataKeyTLSWarningPrefix+code] = message
+	}
+	return warnings
+}
+
+// verifyStaple проверяет подпись и статус приложенного OCSP ответа и возвращает код и текст
+// предупреждения; пустой код - ответ в порядке
+func verifyStaple(staple []byte, leaf, issuer *x509.Certificate, now time.Time) (string, string) {
+	response, err := ocsp.ParseResponseForCert(staple, leaf, issuer)
+	if err != nil {
+		return TLSWarningOCSPStapling, fmt.Sprintf("invalid stapled OCSP response: %v", err)
+	}
+	switch response.Status {
+	case ocsp.Revoked:
+		return TLSWarningOCSPRevoked, fmt.Sprintf("certificate revoked at %s", response.RevokedAt.UTC().Format(time.RFC3339))
+	case ocsp.Unknown:
+		return TLSWarningOCSPStapling, "stapled OCSP response reports unknown certificate status"
+	}
+	if !response.NextUpdate.IsZero() && now.After(response.NextUpdate) {
+		return TLSWarningOCSPStapling, fmt.Sprintf("stapled OCSP response expired at %s", response.NextUpdate.UTC().Format(time.RFC3339))
+	}
+	return "", ""
+}
+
+// leafAndIssuer возвращает сертификат сервера и его издателя из проверенной или присланной цепочки
+func leafAndIssuer(state *tls.ConnectionState) (*x509.Certificate, *x509.Certificate) {
+	chain := state.PeerCertificates
+	if len(state.VerifiedChains) > 0 {
+		chain = state.VerifiedChains[0]
+	}
+	switch len(chain) {
+	case 0:
+		return nil, nil
+	case 1:
+		return chain[0], nil
+	default:
+		return chain[0], chain[1]
+	}
+}
+
+// distrustedRoot возвращает имя корня цепочки и его оператора, если к корню объявлено недоверие.
+// Если сервер не прислал корень, проверяется издатель последнего сертификата
+func distrustedRoot(state *tls.ConnectionState) (string, string) {
+	chain := state.PeerCertificates
+	if len(state.VerifiedChains) > 0 {
+		chain = state.VerifiedChains[0]
+	}
+	if len(chain) == 0 {
+		return "", ""
+	}
+	last := chain[len(chain)-1]
+	for _, name := range []string{last.Subject.CommonName, last.Issuer.CommonName} {
+		if operator, ok := distrustedRoots[name]; ok {
+			return name, operator
+		}
+	}
+	return "", ""
+}
+
+// containsString проверяет наличие строки в списке
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
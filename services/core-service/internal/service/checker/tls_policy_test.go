@@ -0,0 +1,170 @@
+package checker
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// testChain выпускает корневой и конечный сертификаты для проверки OCSP ответов
+func testChain(t *testing.T, rootName string) (*x509.Certificate, *x509.Certificate, crypto.Signer) {
+	t.Helper()
+	now := time.Now()
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: rootName},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, rootKey.Public(), rootKey)
+	require.NoError(t, err)
+	root, err := x509.ParseCertificate(rootDER)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "api.example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+	}, root, leafKey.Public(), rootKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(leafDER)
+	require.NoError(t, err)
+
+	return root, leaf, rootKey
+}
+
+func staple(t *testing.T, root, leaf *x509.Certificate, key crypto.Signer, status int, nextUpdate time.Time) []byte {
+	t.Helper()
+	response, err := ocsp.CreateResponse(root, root, ocsp.Response{
+		Status:       status,
+		SerialNumber: leaf.SerialNumber,
+		ThisUpdate:   time.Now().Add(-time.Hour),
+		NextUpdate:   nextUpdate,
+		RevokedAt:    time.Now().Add(-time.Minute),
+	}, key)
+	require.NoError(t, err)
+	return response
+}
+
+func TestTLSPolicy_OCSPStapling(t *testing.T) {
+	root, leaf, key := testChain(t, "Test Root")
+	now := time.Now()
+	state := &tls.ConnectionState{
+		Version:          tls.VersionTLS13,
+		CipherSuite:      tls.TLS_AES_128_GCM_SHA256,
+		PeerCertificates: []*x509.Certificate{leaf, root},
+	}
+	policy := TLSPolicy{RequireOCSPStapling: true}
+
+	metadata := make(map[string]string)
+	warnings := policy.Evaluate(state, now, metadata)
+	assert.Contains(t, warnings, TLSWarningOCSPStapling)
+	assert.Equal(t, "false", metadata[MetadataKeyTLSOCSPStapled])
+	assert.Equal(t, "TLS 1.3", metadata[MetadataKeyTLSVersion])
+
+	state.OCSPResponse = staple(t, root, leaf, key, ocsp.Good, now.Add(time.Hour))
+	metadata = make(map[string]string)
+	assert.Empty(t, policy.Evaluate(state, now, metadata))
+	assert.Equal(t, "true", metadata[MetadataKeyTLSOCSPStapled])
+
+	// Просроченный ответ
+	assert.Contains(t, policy.Evaluate(state, now.Add(2*time.Hour), map[string]string{}), TLSWarningOCSPStapling)
+
+	state.OCSPResponse = staple(t, root, leaf, key, ocsp.Revoked, now.Add(time.Hour))
+	metadata = make(map[string]string)
+	warnings = policy.Evaluate(state, now, metadata)
+	assert.Contains(t, warnings, TLSWarningOCSPRevoked)
+	assert.Equal(t, warnings[TLSWarningOCSPRevoked], metadata[MetadataKeyTLSWarningPrefix+TLSWarningOCSPRevoked])
+}
+
+func TestTLSPolicy_VersionCipherAndDistrustedRoot(t *testing.T) {
+	root, leaf, _ := testChain(t, "Entrust Root Certification Authority - G2")
+	state := &tls.ConnectionState{
+		Version:          tls.VersionTLS12,
+		CipherSuite:      tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+		PeerCertificates: []*x509.Certificate{leaf, root},
+	}
+	policy := TLSPolicy{
+		MinVersion: tls.VersionTLS13,
+		Ciphers:    []string{"TLS_AES_128_GCM_SHA256"},
+	}
+
+	warnings := policy.Evaluate(state, time.Now(), map[string]string{})
+	assert.Len(t, warnings, 3)
+	assert.Contains(t, warnings[TLSWarningMinVersion], "TLS 1.2")
+	assert.Contains(t, warnings[TLSWarningCipher], "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA")
+	assert.Contains(t, warnings[TLSWarningDistrustedRoot], "Entrust")
+
+	// Без корня в цепочке проверяется издатель последнего сертификата
+	state.PeerCertificates = []*x509.Certificate{leaf}
+	assert.Contains(t, TLSPolicy{}.Evaluate(state, time.Now(), map[string]string{}), TLSWarningDistrustedRoot)
+}
+
+func TestHTTPChecker_TLSPolicyWarnings(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	checker := NewHTTPChecker(5000, log)
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	checker.GetClient().Transport.(*http.Transport).TLSClientConfig = &tls.Config{RootCAs: pool}
+
+	config := map[string]interface{}{
+		"method":                    "GET",
+		"url":                       server.URL,
+		"expected_status":           float64(200),
+		"tls_min_version":           "1.3",
+		"tls_require_ocsp_stapling": true,
+	}
+	require.NoError(t, checker.ValidateConfig(config))
+	result, err := checker.Execute(domain.NewTask("check-1", server.URL, "http", "exec-1", time.Now(), config))
+	require.NoError(t, err)
+
+	// Предупреждения политики не делают проверку неуспешной
+	assert.True(t, result.Success, result.Error)
+	assert.Equal(t, "TLS 1.2", result.Metadata[MetadataKeyTLSVersion])
+	assert.NotEmpty(t, result.Metadata[MetadataKeyTLSWarningPrefix+TLSWarningMinVersion])
+	assert.NotEmpty(t, result.Metadata[MetadataKeyTLSWarningPrefix+TLSWarningOCSPStapling])
+}
+
+func TestValidateTLSPolicy(t *testing.T) {
+	assert.NoError(t, validateTLSPolicy(map[string]interface{}{
+		"tls_min_version": "1.2",
+		"tls_ciphers":     []interface{}{"TLS_AES_128_GCM_SHA256"},
+	}))
+	assert.Error(t, validateTLSPolicy(map[string]interface{}{"tls_min_version": "1.4"}))
+	assert.Error(t, validateTLSPolicy(map[string]interface{}{"tls_ciphers": []interface{}{"RC4_FOREVER"}}))
+	assert.Error(t, validateTLSPolicy(map[string]interface{}{"tls_require_ocsp_stapling": "yes"}))
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// DefaultConfigVersionTTL время, в течение которого core-service не перечитывает состояние
+// проверки. Задача с версией новее кешированной перечитывает его сразу
+const DefaultConfigVersionTTL = 30 * time.Second
+
+// Причины, по которым задача с версией конфигурации не выполняется
+const (
+	StaleReasonDeleted  = "check deleted"
+	StaleReasonDisabled = "check disabled"
+	StaleReasonOutdated = "config outdated"
+)
+
+// ConfigFreshness сверяет версию конфигурации в задаче с актуальной версией проверки.
+// Задача, пролежавшая в очереди дольше изменения или удаления проверки, собрана по старой
+// конфигурации: ее выполнение дало бы результат и инцидент для цели, которой уже нет.
+// Следующая задача планировщика собирается по новой конфигурации, поэтому устаревшая отбрасывается
+type ConfigFreshness struct {
+	source repository.CheckDefinitionRepository
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedCheckDefinition
+}
+
+// cachedCheckDefinition состояние проверки и время его чтения; nil definition - проверка удалена
+type cachedCheckDefinition struct {
+	definition *domain.CheckDefinition
+	loadedAt   time.Time
+}
+
+// NewConfigFreshness создает сверку версий конфигурации с кешем на ttl
+func NewConfigFreshness(source repository.CheckDefinitionRepository, ttl time.Duration) *ConfigFreshness {
+	if ttl <= 0 {
+		ttl = DefaultConfigVersionTTL
+	}
+	return &ConfigFreshness{
+		source: source,
+		ttl:    ttl,
+		now:    time.Now,
+		cache:  make(map[string]cachedCheckDefinition),
+	}
+}
+
+// StaleReason возвращает причину, по которой задача проверки с версией version устарела,
+// или пустую строку для актуальной задачи
+func (f *ConfigFreshness) StaleReason(ctx context.Context, checkID string, version int64) (string, error) {
+	_, reason, err := f.Resolve(ctx, checkID, version)
+	return reason, err
+}
+
+// Resolve возвращает проверку, по которой выполняется задача с версией version, или причину,
+// по которой задача устарела. Версия 0 принимает текущую конфигурацию проверки.
+// Возвращаемая проверка общая для кеша и не изменяется вызывающим кодом
+func (f *ConfigFreshness) Resolve(ctx context.Context, checkID string, version int64) (*domain.CheckDefinition, string, error) {
+	definition, err := f.definition(ctx, checkID, version)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch {
+	case definition == nil:
+		return nil, StaleReasonDeleted, nil
+	case version > 0 && definition.ConfigVersion > version:
+		return nil, StaleReasonOutdated, nil
+	case !definition.Enabled:
+		return nil, StaleReasonDisabled, nil
+	}
+	return definition, "", nil
+}
+
+// definition возвращает состояние проверки из кеша. Кеш перечитывается по истечении ttl и
+// когда версия задачи новее кешированной: проверку изменили после чтения
+func (f *ConfigFreshness) definition(ctx context.Context, checkID string, version int64) (*domain.CheckDefinition, error) {
+	now := f.now()
+
+	f.mu.Lock()
+	cached, ok := f.cache[checkID]
+	f.mu.Unlock()
+	if ok && now.Sub(cached.loadedAt) < f.ttl &&
+		(cached.definition == nil || cached.definition.ConfigVersion >= version) {
+		return cached.definition, nil
+	}
+
+	definition, err := f.source.GetDefinition(ctx, checkID)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	f.cache[checkID] = cachedCheckDefinition{definition: definition, loadedAt: now}
+	f.mu.Unlock()
+	return definition, nil
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// stubCheckDefinitions таблица checks в памяти со счетчиком чтений
+type stubCheckDefinitions struct {
+	definitions map[string]*domain.CheckDefinition
+	err         error
+	reads       int
+}
+
+func (s *stubCheckDefinitions) GetDefinition(ctx context.Context, checkID string) (*domain.CheckDefinition, error) {
+	s.reads++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if definition, ok := s.definitions[checkID]; ok {
+		copied := *definition
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func TestConfigFreshness_StaleReason(t *testing.T) {
+	ctx := context.Background()
+	source := &stubCheckDefinitions{definitions: map[string]*domain.CheckDefinition{
+		"check-1": {CheckID: "check-1", Enabled: true, ConfigVersion: 3},
+		"paused":  {CheckID: "paused", Enabled: false, ConfigVersion: 2},
+	}}
+	freshness := NewConfigFreshness(source, time.Minute)
+
+	reason, err := freshness.StaleReason(ctx, "check-1", 3)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+
+	reason, err = freshness.StaleReason(ctx, "check-1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, StaleReasonOutdated, reason)
+
+	reason, err = freshness.StaleReason(ctx, "paused", 2)
+	require.NoError(t, err)
+	assert.Equal(t, StaleReasonDisabled, reason)
+
+	reason, err = freshness.StaleReason(ctx, "deleted", 1)
+	require.NoError(t, err)
+	assert.Equal(t, StaleReasonDeleted, reason)
+	assert.Equal(t, 3, source.reads, "definitions are cached per check")
+}
+
+func TestConfigFreshness_ReloadsCache(t *testing.T) {
+	ctx := context.Background()
+	source := &stubCheckDefinitions{definitions: map[string]*domain.CheckDefinition{
+		"check-1": {CheckID: "check-1", Enabled: true, ConfigVersion: 1},
+	}}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	freshness := NewConfigFreshness(source, time.Minute)
+	freshness.now = func() time.Time { return now }
+
+	reason, err := freshness.StaleReason(ctx, "check-1", 1)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+
+	// Задача новее кеша: проверку изменили после чтения, кеш перечитывается сразу
+	source.definitions["check-1"].ConfigVersion = 2
+	reason, err = freshness.StaleReason(ctx, "check-1", 2)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+	assert.Equal(t, 2, source.reads)
+
+	// Удаление видно после истечения ttl
+	delete(source.definitions, "check-1")
+	reason, err = freshness.StaleReason(ctx, "check-1", 2)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+
+	now = now.Add(time.Minute)
+	reason, err = freshness.StaleReason(ctx, "check-1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, StaleReasonDeleted, reason)
+	assert.Equal(t, 3, source.reads)
+}
+
+func TestCheckService_ProcessTask_DropsStaleConfig(t *testing.T) {
+	ctx := context.Background()
+	repo := &recordingResultRepository{}
+	source := &stubCheckDefinitions{definitions: map[string]*domain.CheckDefinition{
+		"check-1": {CheckID: "check-1", Enabled: true, ConfigVersion: 2},
+	}}
+	service := newAttributionService(repo).WithConfigFreshness(NewConfigFreshness(source, time.Minute))
+
+	dispatch := func(checkID, executionID string, version int64) {
+		body, err := json.Marshal(TaskMessage{CheckID: checkID, ExecutionID: executionID,
+			Target: "https://example.com", Type: "http", ConfigVersion: version})
+		require.NoError(t, err)
+		require.NoError(t, service.ProcessTask(ctx, body))
+	}
+
+	// Задача с версией 1 пролежала в очереди, пока цель проверки меняли, и отбрасывается;
+	// задача по удаленной проверке тоже. Задачи без версии выполняются без сверки
+	dispatch("check-1", "exec-old", 1)
+	dispatch("removed", "exec-removed", 4)
+	assert.Empty(t, repo.saved)
+
+	dispatch("check-1", "exec-current", 2)
+	dispatch("check-1", "exec-manual", 0)
+	assert.Len(t, repo.saved, 2)
+}
+
+func TestCheckService_ProcessTask_ConfigFreshnessFailsOpen(t *testing.T) {
+	repo := &recordingResultRepository{}
+	source := &stubCheckDefinitions{err: assert.AnError}
+	service := newAttributionService(repo).WithConfigFreshness(NewConfigFreshness(source, time.Minute))
+
+	body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: "exec-1",
+		Target: "https://example.com", Type: "http", ConfigVersion: 3})
+	require.NoError(t, err)
+	require.NoError(t, service.ProcessTask(context.Background(), body))
+	assert.Len(t, repo.saved, 1)
+}
+
+func TestCheckService_ProcessTask_ResolvesCheckDefinition(t *testing.T) {
+	ctx := context.Background()
+	repo := &recordingResultRepository{}
+	source := &stubCheckDefinitions{definitions: map[string]*domain.CheckDefinition{
+		"check-1": {CheckID: "check-1", Type: "https", Target: "https://example.com", Enabled: true, ConfigVersion: 2},
+		"paused":  {CheckID: "paused", Type: "http", Target: "https://example.com", Enabled: false, ConfigVersion: 1},
+	}}
+	service := newAttributionService(repo).WithConfigFreshness(NewConfigFreshness(source, time.Minute))
+
+	dispatch := func(checkID, executionID string, version int64) {
+		body, err := json.Marshal(TaskMessage{CheckID: checkID, ExecutionID: executionID, ConfigVersion: version})
+		require.NoError(t, err)
+		require.NoError(t, service.ProcessTask(ctx, body))
+	}
+
+	// Задача без цели собирается по проверке; устаревшие задачи и задачи по выключенным
+	// и удаленным проверкам отбрасываются
+	dispatch("check-1", "exec-current", 2)
+	dispatch("check-1", "exec-old", 1)
+	dispatch("paused", "exec-paused", 1)
+	dispatch("removed", "exec-removed", 1)
+	assert.Len(t, repo.saved, 1)
+
+	// Задача без цели и без версии не принимается
+	body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: "exec-unversioned"})
+	require.NoError(t, err)
+	assert.Error(t, service.ProcessTask(ctx, body))
+}
+
+func TestCheckService_ProcessTask_ResolveFailureRetries(t *testing.T) {
+	body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: "exec-1", ConfigVersion: 3})
+	require.NoError(t, err)
+
+	// Без проверки задачу выполнить нельзя: ошибка возвращается, и задача доставляется повторно
+	repo := &recordingResultRepository{}
+	source := &stubCheckDefinitions{err: assert.AnError}
+	service := newAttributionService(repo).WithConfigFreshness(NewConfigFreshness(source, time.Minute))
+	assert.Error(t, service.ProcessTask(context.Background(), body))
+
+	assert.Error(t, newAttributionService(repo).ProcessTask(context.Background(), body))
+	assert.Empty(t, repo.saved)
+}
+
+func TestTaskMessage_ApplyDefinition(t *testing.T) {
+	definition := &domain.CheckDefinition{
+		CheckID:        "check-1",
+		TenantID:       "tenant-1",
+		Type:           "https",
+		Target:         "https://example.com/health",
+		TimeoutSeconds: 5,
+		Config:         map[string]interface{}{"expected_status": float64(200), "method": "HEAD"},
+		Enabled:        true,
+		ConfigVersion:  4,
+	}
+	message := &TaskMessage{CheckID: "check-1", ExecutionID: "exec-1", ConfigVersion: 4,
+		Overrides: map[string]interface{}{"expected_status": float64(204)}}
+
+	message.applyDefinition(definition)
+	assert.Equal(t, "http", message.Type)
+	assert.Equal(t, "https://example.com/health", message.Target)
+	assert.Equal(t, "tenant-1", message.TenantID)
+	assert.Equal(t, map[string]interface{}{
+		"expected_status": float64(204),
+		"method":          "HEAD",
+		"timeout":         "5s",
+	}, message.Config)
+	// Конфигурация проверки в кеше не меняется
+	assert.Equal(t, float64(200), definition.Config["expected_status"])
+	assert.Equal(t, "https://example.com/health", message.expandConfig()["url"])
+}
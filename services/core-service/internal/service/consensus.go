@@ -0,0 +1,431 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	pkg_redis "UptimePingPlatform/pkg/redis"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// DefaultConsensusWindow время ожидания результатов всех регионов одного выполнения
+const DefaultConsensusWindow = 10 * time.Minute
+
+// consensusPendingKey sorted set Redis с выполнениями, ожидающими решения, по сроку окна
+const consensusPendingKey = "consensus:pending"
+
+// RegionResultStore хранит результаты регионов одного выполнения проверки.
+// Выполнения в разных регионах должны иметь общий execution_id
+type RegionResultStore interface {
+	// Add сохраняет результат региона и возвращает все известные результаты выполнения
+	Add(ctx context.Context, key string, result domain.RegionResult, ttl time.Duration) ([]domain.RegionResult, error)
+	// Claim помечает выполнение как решенное; возвращает true только для первого вызова
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// Defer откладывает решение по выполнению до deadline; payload и срок первого вызова сохраняются
+	Defer(ctx context.Context, key string, payload []byte, deadline time.Time, ttl time.Duration) error
+	// Expired возвращает отложенные выполнения, срок которых наступил к now
+	Expired(ctx context.Context, now time.Time) ([]PendingConsensus, error)
+	// Forget снимает выполнение с ожидания решения
+	Forget(ctx context.Context, key string) error
+}
+
+// PendingConsensus выполнение, окно которого истекло до решения, с результатами приславших регионов
+type PendingConsensus struct {
+	Key     string
+	Payload []byte
+	Results []domain.RegionResult
+}
+
+// ConsensusDecision решение по выполнению, принятое после окна по приславшим результат регионам
+type ConsensusDecision struct {
+	Message *TaskMessage
+	Policy  domain.ConsensusPolicy
+	Result  *domain.CheckResult
+}
+
+// pendingDecision данные для решения после окна: задача и результат первого региона
+type pendingDecision struct {
+	Message *TaskMessage            `json:"message"`
+	Config  *domain.ConsensusConfig `json:"config"`
+	Result  *domain.CheckResult     `json:"result"`
+}
+
+// ConsensusRecorder учитывает результаты регионов и итоговые решения консенсуса в метриках
+type ConsensusRecorder interface {
+	RecordRegionResult(checkType, region string, success bool)
+	RecordConsensus(checkType string, policy domain.ConsensusPolicy, success bool)
+}
+
+// ConsensusEvaluator объединяет результаты регионов в единый результат по политике проверки.
+// Если к концу окна часть регионов так и не прислала результат, решение принимает Sweep
+type ConsensusEvaluator struct {
+	store  RegionResultStore
+	window time.Duration
+	now    func() time.Time
+}
+
+// NewConsensusEvaluator создает оценщик консенсуса; неположительное окно заменяется DefaultConsensusWindow
+func NewConsensusEvaluator(store RegionResultStore, window time.Duration) *ConsensusEvaluator {
+	if window <= 0 {
+		window = DefaultConsensusWindow
+	}
+	return &ConsensusEvaluator{store: store, window: window, now: time.Now}
+}
+
+// retention время хранения результатов регионов: с запасом после окна, чтобы их застал Sweep
+func (e *ConsensusEvaluator) retention() time.Duration {
+	return 2 * e.window
+}
+
+// Submit учитывает результат региона задачи message. Возвращает единый результат, когда решение
+// принято впервые; пока решение не принято или уже было принято по другому региону, возвращает nil
+func (e *ConsensusEvaluator) Submit(ctx context.Context, message *TaskMessage, config *domain.ConsensusConfig, region string, result *domain.CheckResult) (*domain.CheckResult, error) {
+	key := fmt.Sprintf("%s:%s", result.CheckID, result.ExecutionID)
+
+	results, err := e.store.Add(ctx, key, domain.NewRegionResult(region, result), e.retention())
+	if err != nil {
+		return nil, fmt.Errorf("failed to store region result: %w", err)
+	}
+
+	success, decided := config.Decide(results)
+	if !decided {
+		payload, err := json.Marshal(&pendingDecision{Message: message, Config: config, Result: result})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal pending decision: %w", err)
+		}
+		if err := e.store.Defer(ctx, key, payload, e.now().Add(e.window), e.retention()); err != nil {
+			return nil, fmt.Errorf("failed to defer consensus decision: %w", err)
+		}
+		return nil, nil
+	}
+
+	claimed, err := e.store.Claim(ctx, key, e.retention())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim consensus decision: %w", err)
+	}
+	if !claimed {
+		return nil, nil
+	}
+	// Ошибка не мешает решению: Sweep снимет выполнение с ожидания, не получив Claim
+	_ = e.store.Forget(ctx, key)
+
+	return unifiedResult(config, result, results, success), nil
+}
+
+// Sweep принимает решения по выполнениям, окно которых истекло без результатов части регионов.
+// Молчащие регионы не учитываются: политика применяется к регионам, приславшим результат
+func (e *ConsensusEvaluator) Sweep(ctx context.Context) ([]ConsensusDecision, error) {
+	pending, err := e.store.Expired(ctx, e.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending consensus decisions: %w", err)
+	}
+
+	var decisions []ConsensusDecision
+	for _, execution := range pending {
+		claimed, err := e.store.Claim(ctx, execution.Key, e.retention())
+		if err != nil {
+			return decisions, fmt.Errorf("failed to claim consensus decision: %w", err)
+		}
+		if err := e.store.Forget(ctx, execution.Key); err != nil {
+			return decisions, fmt.Errorf("failed to forget pending consensus decision: %w", err)
+		}
+		if !claimed || len(execution.Results) == 0 {
+			continue
+		}
+
+		var decision pendingDecision
+		if err := json.Unmarshal(execution.Payload, &decision); err != nil || decision.Message == nil || decision.Config == nil || decision.Result == nil {
+			continue
+		}
+		reported := &domain.ConsensusConfig{Policy: decision.Config.Policy}
+		for _, result := range execution.Results {
+			if decision.Config.HasRegion(result.Region) {
+				reported.Regions = append(reported.Regions, result.Region)
+			}
+		}
+		success, _ := reported.Decide(execution.Results)
+
+		unified := unifiedResult(decision.Config, decision.Result, execution.Results, success)
+		var missing []string
+		for _, region := range decision.Config.Regions {
+			if !reported.HasRegion(region) {
+				missing = append(missing, region)
+			}
+		}
+		unified.Metadata["regions_missing"] = strings.Join(missing, ",")
+		decisions = append(decisions, ConsensusDecision{Message: decision.Message, Policy: decision.Config.Policy, Result: unified})
+	}
+	return decisions, nil
+}
+
+// unifiedResult строит единый результат выполнения на основе результата последнего региона
+func unifiedResult(config *domain.ConsensusConfig, last *domain.CheckResult, results []domain.RegionResult, success bool) *domain.CheckResult {
+	unified := *last
+	unified.Success = success
+	unified.Metadata = make(map[string]string, len(last.Metadata)+4)
+	for k, v := range last.Metadata {
+		unified.Metadata[k] = v
+	}
+	delete(unified.Metadata, domain.MetadataKeyRegion)
+
+	failed := domain.FailedRegions(results)
+	unified.Metadata["consensus_policy"] = string(config.Policy)
+	unified.Metadata["regions_total"] = fmt.Sprintf("%d", len(config.Regions))
+	unified.Metadata["regions_reported"] = fmt.Sprintf("%d", len(results))
+	if len(failed) > 0 {
+		unified.Metadata["regions_failed"] = strings.Join(failed, ",")
+	}
+	// Стоимость единого результата - сумма стоимостей всех регионов
+	var cost float64
+	for _, result := range results {
+		cost += result.CostUnits
+	}
+	unified.Metadata[domain.MetadataKeyCostUnits] = domain.FormatCostUnits(cost)
+
+	if success {
+		unified.Error = ""
+		return &unified
+	}
+
+	// Ошибка единого результата перечисляет ошибки всех упавших регионов
+	var errs []string
+	for _, result := range results {
+		if !result.Success {
+			errs = append(errs, fmt.Sprintf("%s: %s", result.Region, result.Error))
+		}
+	}
+	sort.Strings(errs)
+	unified.Error = fmt.Sprintf("failed in %d/%d regions (%s)", len(failed), len(config.Regions), strings.Join(errs, "; "))
+	if !last.Success {
+		return &unified
+	}
+
+	// Последний регион успешен - берем код ответа упавшего региона
+	for _, result := range results {
+		if !result.Success {
+			unified.StatusCode = result.StatusCode
+			break
+		}
+	}
+	return &unified
+}
+
+// MemoryRegionResultStore хранит результаты регионов в памяти процесса.
+// Подходит, когда все регионы отправляют результаты в один экземпляр core-service
+type MemoryRegionResultStore struct {
+	mu         sync.Mutex
+	executions map[string]*regionExecution
+}
+
+// regionExecution результаты регионов одного выполнения
+type regionExecution struct {
+	results   map[string]domain.RegionResult
+	claimed   bool
+	expiresAt time.Time
+	// payload и deadline отложенного решения; pending - выполнение ждет решения
+	payload  []byte
+	deadline time.Time
+	pending  bool
+}
+
+// NewMemoryRegionResultStore создает хранилище результатов регионов в памяти
+func NewMemoryRegionResultStore() *MemoryRegionResultStore {
+	return &MemoryRegionResultStore{executions: make(map[string]*regionExecution)}
+}
+
+// Add реализует RegionResultStore
+func (s *MemoryRegionResultStore) Add(ctx context.Context, key string, result domain.RegionResult, ttl time.Duration) ([]domain.RegionResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.pruneLocked(now)
+
+	execution, ok := s.executions[key]
+	if !ok {
+		execution = &regionExecution{results: make(map[string]domain.RegionResult), expiresAt: now.Add(ttl)}
+		s.executions[key] = execution
+	}
+	execution.results[result.Region] = result
+
+	results := make([]domain.RegionResult, 0, len(execution.results))
+	for _, r := range execution.results {
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// Claim реализует RegionResultStore
+func (s *MemoryRegionResultStore) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	execution, ok := s.executions[key]
+	if !ok {
+		execution = &regionExecution{results: make(map[string]domain.RegionResult), expiresAt: time.Now().Add(ttl)}
+		s.executions[key] = execution
+	}
+	if execution.claimed {
+		return false, nil
+	}
+	execution.claimed = true
+	return true, nil
+}
+
+// Defer реализует RegionResultStore
+func (s *MemoryRegionResultStore) Defer(ctx context.Context, key string, payload []byte, deadline time.Time, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	execution, ok := s.executions[key]
+	if !ok {
+		execution = &regionExecution{results: make(map[string]domain.RegionResult), expiresAt: time.Now().Add(ttl)}
+		s.executions[key] = execution
+	}
+	if !execution.pending && execution.payload == nil {
+		execution.payload = payload
+		execution.deadline = deadline
+	}
+	execution.pending = true
+	return nil
+}
+
+// Expired реализует RegionResultStore
+func (s *MemoryRegionResultStore) Expired(ctx context.Context, now time.Time) ([]PendingConsensus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var pending []PendingConsensus
+	for key, execution := range s.executions {
+		if !execution.pending || now.Before(execution.deadline) {
+			continue
+		}
+		results := make([]domain.RegionResult, 0, len(execution.results))
+		for _, r := range execution.results {
+			results = append(results, r)
+		}
+		pending = append(pending, PendingConsensus{Key: key, Payload: execution.payload, Results: results})
+	}
+	return pending, nil
+}
+
+// Forget реализует RegionResultStore
+func (s *MemoryRegionResultStore) Forget(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if execution, ok := s.executions[key]; ok {
+		execution.pending = false
+	}
+	return nil
+}
+
+// pruneLocked удаляет выполнения с истекшим сроком хранения
+func (s *MemoryRegionResultStore) pruneLocked(now time.Time) {
+	for key, execution := range s.executions {
+		if now.After(execution.expiresAt) {
+			delete(s.executions, key)
+		}
+	}
+}
+
+// RedisRegionResultStore хранит результаты регионов в Redis, общем для экземпляров core-service всех регионов
+type RedisRegionResultStore struct {
+	client *pkg_redis.Client
+}
+
+// NewRedisRegionResultStore создает хранилище результатов регионов в Redis
+func NewRedisRegionResultStore(client *pkg_redis.Client) *RedisRegionResultStore {
+	return &RedisRegionResultStore{client: client}
+}
+
+// Add реализует RegionResultStore: результаты хранятся в hash по региону
+func (s *RedisRegionResultStore) Add(ctx context.Context, key string, result domain.RegionResult, ttl time.Duration) ([]domain.RegionResult, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal region result: %w", err)
+	}
+
+	hashKey := fmt.Sprintf("consensus:%s:results", key)
+	pipe := s.client.Client.TxPipeline()
+	pipe.HSet(ctx, hashKey, result.Region, data)
+	pipe.Expire(ctx, hashKey, ttl)
+	all := pipe.HGetAll(ctx, hashKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.RegionResult, 0, len(all.Val()))
+	for region, raw := range all.Val() {
+		var r domain.RegionResult
+		if err := json.Unmarshal([]byte(raw), &r); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal result of region %s: %w", region, err)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// Claim реализует RegionResultStore через SETNX, чтобы решение отправил только один экземпляр
+func (s *RedisRegionResultStore) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return s.client.Client.SetNX(ctx, fmt.Sprintf("consensus:%s:decided", key), time.Now().UTC().Format(time.RFC3339), ttl).Result()
+}
+
+// Defer реализует RegionResultStore: срок решения хранится в общем sorted set, данные решения -
+// в отдельном ключе выполнения
+func (s *RedisRegionResultStore) Defer(ctx context.Context, key string, payload []byte, deadline time.Time, ttl time.Duration) error {
+	pipe := s.client.Client.TxPipeline()
+	pipe.SetNX(ctx, fmt.Sprintf("consensus:%s:pending", key), payload, ttl)
+	pipe.ZAddNX(ctx, consensusPendingKey, &redis.Z{Score: float64(deadline.Unix()), Member: key})
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Expired реализует RegionResultStore
+func (s *RedisRegionResultStore) Expired(ctx context.Context, now time.Time) ([]PendingConsensus, error) {
+	keys, err := s.client.Client.ZRangeByScore(ctx, consensusPendingKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: fmt.Sprintf("%d", now.Unix()),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]PendingConsensus, 0, len(keys))
+	for _, key := range keys {
+		payload, err := s.client.Client.Get(ctx, fmt.Sprintf("consensus:%s:pending", key)).Bytes()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		all, err := s.client.Client.HGetAll(ctx, fmt.Sprintf("consensus:%s:results", key)).Result()
+		if err != nil {
+			return nil, err
+		}
+		execution := PendingConsensus{Key: key, Payload: payload, Results: make([]domain.RegionResult, 0, len(all))}
+		for region, raw := range all {
+			var r domain.RegionResult
+			if err := json.Unmarshal([]byte(raw), &r); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal result of region %s: %w", region, err)
+			}
+			execution.Results = append(execution.Results, r)
+		}
+		pending = append(pending, execution)
+	}
+	return pending, nil
+}
+
+// Forget реализует RegionResultStore
+func (s *RedisRegionResultStore) Forget(ctx context.Context, key string) error {
+	pipe := s.client.Client.TxPipeline()
+	pipe.ZRem(ctx, consensusPendingKey, key)
+	pipe.Del(ctx, fmt.Sprintf("consensus:%s:pending", key))
+	_, err := pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+func TestConsensusConfig_Decide(t *testing.T) {
+	regions := []string{"ap-south", "eu-west", "us-east"}
+	up := func(region string) domain.RegionResult { return domain.RegionResult{Region: region, Success: true} }
+	down := func(region string) domain.RegionResult { return domain.RegionResult{Region: region} }
+
+	tests := []struct {
+		name        string
+		policy      domain.ConsensusPolicy
+		results     []domain.RegionResult
+		wantSuccess bool
+		wantDecided bool
+	}{
+		{"any_fail first failure decides", domain.ConsensusAnyFail, []domain.RegionResult{down("eu-west")}, false, true},
+		{"any_fail waits for all successes", domain.ConsensusAnyFail, []domain.RegionResult{up("eu-west"), up("us-east")}, true, false},
+		{"any_fail all up", domain.ConsensusAnyFail, []domain.RegionResult{up("eu-west"), up("us-east"), up("ap-south")}, true, true},
+		{"majority_fail single failure undecided", domain.ConsensusMajorityFail, []domain.RegionResult{down("eu-west")}, true, false},
+		{"majority_fail two of three failed", domain.ConsensusMajorityFail, []domain.RegionResult{down("eu-west"), down("us-east")}, false, true},
+		{"majority_fail two of three up", domain.ConsensusMajorityFail, []domain.RegionResult{down("eu-west"), up("us-east"), up("ap-south")}, true, true},
+		{"all_fail first success decides", domain.ConsensusAllFail, []domain.RegionResult{down("eu-west"), up("us-east")}, true, true},
+		{"all_fail all down", domain.ConsensusAllFail, []domain.RegionResult{down("eu-west"), down("us-east"), down("ap-south")}, false, true},
+		{"unknown regions ignored", domain.ConsensusAnyFail, []domain.RegionResult{down("sa-east")}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &domain.ConsensusConfig{Regions: regions, Policy: tt.policy}
+			success, decided := config.Decide(tt.results)
+			assert.Equal(t, tt.wantDecided, decided)
+			if decided {
+				assert.Equal(t, tt.wantSuccess, success)
+			}
+		})
+	}
+}
+
+func TestConsensusConfigFromTask(t *testing.T) {
+	config, err := domain.ConsensusConfigFromTask(map[string]interface{}{
+		"regions":   []interface{}{"us-east", "eu-west", "us-east"},
+		"consensus": "majority_fail",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, []string{"eu-west", "us-east"}, config.Regions)
+	assert.Equal(t, domain.ConsensusMajorityFail, config.Policy)
+
+	config, err = domain.ConsensusConfigFromTask(map[string]interface{}{"regions": "eu-west, us-east"})
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, domain.DefaultConsensusPolicy, config.Policy)
+
+	config, err = domain.ConsensusConfigFromTask(map[string]interface{}{"regions": "eu-west"})
+	require.NoError(t, err)
+	assert.Nil(t, config)
+
+	_, err = domain.ConsensusConfigFromTask(map[string]interface{}{"regions": "eu-west,us-east", "consensus": "quorum"})
+	assert.Error(t, err)
+}
+
+func TestConsensusEvaluator_Submit(t *testing.T) {
+	evaluator := NewConsensusEvaluator(NewMemoryRegionResultStore(), time.Minute)
+	config := &domain.ConsensusConfig{Regions: []string{"eu-west", "us-east", "ap-south"}, Policy: domain.ConsensusMajorityFail}
+	result := func(success bool, errMsg string) *domain.CheckResult {
+		return &domain.CheckResult{CheckID: "check-1", ExecutionID: "exec-1", Success: success, Error: errMsg,
+			Metadata: map[string]string{"service": "core-service"}}
+	}
+
+	unified, err := evaluator.Submit(context.Background(), nil, config, "eu-west", result(false, "timeout"))
+	require.NoError(t, err)
+	assert.Nil(t, unified)
+
+	unified, err = evaluator.Submit(context.Background(), nil, config, "us-east", result(false, "connection refused"))
+	require.NoError(t, err)
+	require.NotNil(t, unified)
+	assert.False(t, unified.Success)
+	assert.Equal(t, "failed in 2/3 regions (eu-west: timeout; us-east: connection refused)", unified.Error)
+	assert.Equal(t, "eu-west,us-east", unified.Metadata["regions_failed"])
+	assert.Equal(t, "majority_fail", unified.Metadata["consensus_policy"])
+	assert.Equal(t, "0.000", unified.Metadata[domain.MetadataKeyCostUnits])
+
+	// Решение уже принято - поздний результат региона только сохраняется
+	unified, err = evaluator.Submit(context.Background(), nil, config, "ap-south", result(true, ""))
+	require.NoError(t, err)
+	assert.Nil(t, unified)
+}
+
+// recordingIncidentManager запоминает созданные инциденты
+type recordingIncidentManager struct {
+	MockIncidentManager
+	incidents []*Incident
+}
+
+func (m *recordingIncidentManager) CreateIncident(ctx context.Context, incident *Incident) (*Incident, error) {
+	m.incidents = append(m.incidents, incident)
+	return incident, nil
+}
+
+func TestCostUnits(t *testing.T) {
+	assert.Equal(t, 1.0, domain.PayloadSizeClass(0))
+	assert.Equal(t, 1.0, domain.PayloadSizeClass(64<<10))
+	assert.Equal(t, 2.0, domain.PayloadSizeClass(64<<10+1))
+	assert.Equal(t, 4.0, domain.PayloadSizeClass(5<<20))
+	assert.Equal(t, 8.0, domain.PayloadSizeClass(50<<20))
+
+	assert.InDelta(t, 3.0, domain.CostUnits(1500*time.Millisecond, 200<<10), 0.0001)
+	assert.InDelta(t, 0.25, domain.ExecutionCostUnits(&domain.CheckResult{DurationMs: 250}), 0.0001)
+	assert.InDelta(t, 1.0, domain.ExecutionCostUnits(&domain.CheckResult{
+		DurationMs: 250,
+		Metadata:   map[string]string{"body_size": "2097152"},
+	}), 0.0001)
+}
+
+// recordingCostRecorder запоминает учтенную стоимость выполнений
+type recordingCostRecorder struct {
+	units map[string]float64
+}
+
+func (r *recordingCostRecorder) RecordCost(tenantID, checkID, checkType string, units float64) {
+	r.units[tenantID+"/"+checkID] += units
+}
+
+func TestCheckService_ProcessTask_MultiRegionConsensus(t *testing.T) {
+	incidents := &recordingIncidentManager{}
+	mockChecker := &MockChecker{
+		mockResult: &domain.CheckResult{
+			CheckID:     "check-1",
+			ExecutionID: "exec-1",
+			Success:     false,
+			Error:       "timeout",
+			DurationMs:  2000,
+			CheckedAt:   time.Now().UTC(),
+		},
+	}
+	costs := &recordingCostRecorder{units: make(map[string]float64)}
+	service := NewCheckService(&MockLogger{}, &MockCheckerFactory{mockChecker: mockChecker}, &MockCheckResultRepository{}, nil, incidents).
+		WithConsensus(NewConsensusEvaluator(NewMemoryRegionResultStore(), time.Minute), "eu-west").
+		WithCostRecorder(costs)
+
+	process := func(region string) {
+		message, err := json.Marshal(TaskMessage{
+			CheckID:     "check-1",
+			ExecutionID: "exec-1",
+			Target:      "https://example.com",
+			Type:        "http",
+			TenantID:    "tenant-1",
+			Region:      region,
+			Config:      map[string]interface{}{"regions": "eu-west,us-east,ap-south", "consensus": "majority_fail"},
+			ScheduledAt: time.Now(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, service.ProcessTask(context.Background(), message))
+	}
+
+	process("")
+	assert.Empty(t, incidents.incidents, "single region failure must not open an incident under majority_fail")
+
+	process("us-east")
+	require.Len(t, incidents.incidents, 1)
+	assert.Equal(t, "majority_fail", incidents.incidents[0].Metadata["consensus_policy"])
+	// Единый результат несет стоимость обоих регионов, принявших решение
+	assert.Equal(t, "4.000", incidents.incidents[0].Metadata[domain.MetadataKeyCostUnits])
+
+	process("ap-south")
+	assert.Len(t, incidents.incidents, 1)
+	// Каждый регион учитывает свое выполнение: 2 секунды × 3 региона × класс 1
+	assert.InDelta(t, 6.0, costs.units["tenant-1/check-1"], 0.0001)
+}
+
+func TestCheckService_SweepConsensus_SilentRegion(t *testing.T) {
+	incidents := &recordingIncidentManager{}
+	mockChecker := &MockChecker{
+		mockResult: &domain.CheckResult{
+			CheckID:     "check-1",
+			ExecutionID: "exec-1",
+			Success:     false,
+			Error:       "timeout",
+			CheckedAt:   time.Now().UTC(),
+		},
+	}
+	now := time.Now()
+	evaluator := NewConsensusEvaluator(NewMemoryRegionResultStore(), time.Minute)
+	evaluator.now = func() time.Time { return now }
+	service := NewCheckService(&MockLogger{}, &MockCheckerFactory{mockChecker: mockChecker}, &MockCheckResultRepository{}, nil, incidents).
+		WithConsensus(evaluator, "eu-west")
+
+	// all_fail ждет все три региона; ap-south так и не присылает результат
+	for _, region := range []string{"eu-west", "us-east"} {
+		message, err := json.Marshal(TaskMessage{
+			CheckID:     "check-1",
+			ExecutionID: "exec-1",
+			Target:      "https://example.com",
+			Type:        "http",
+			TenantID:    "tenant-1",
+			Region:      region,
+			Config:      map[string]interface{}{"regions": "eu-west,us-east,ap-south", "consensus": "all_fail"},
+			ScheduledAt: now,
+		})
+		require.NoError(t, err)
+		require.NoError(t, service.ProcessTask(context.Background(), message))
+	}
+
+	decided, err := service.SweepConsensus(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, decided, "window has not expired yet")
+	assert.Empty(t, incidents.incidents)
+
+	now = now.Add(time.Minute + time.Second)
+	decided, err = service.SweepConsensus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, decided)
+	require.Len(t, incidents.incidents, 1)
+	assert.Equal(t, "ap-south", incidents.incidents[0].Metadata["regions_missing"])
+	assert.Equal(t, "2", incidents.incidents[0].Metadata["regions_reported"])
+
+	// Решение принимается один раз
+	decided, err = service.SweepConsensus(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, decided)
+	assert.Len(t, incidents.incidents, 1)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pkg_redis "UptimePingPlatform/pkg/redis"
+)
+
+// DefaultFencingTTL время хранения наибольшего токена проверки. Должно превышать время жизни
+// задачи в очереди: после истечения задача с устаревшим токеном будет принята
+const DefaultFencingTTL = 24 * time.Hour
+
+// FencingGuard отсекает задачи, поставленные планировщиком под уже истекшей блокировкой.
+// Каждая блокировка проверки получает токен больше предыдущих, поэтому задача с токеном
+// меньше уже принятого отправлена worker, потерявшим блокировку
+type FencingGuard interface {
+	// Accept запоминает токен и сообщает, не меньше ли он наибольшего принятого для проверки
+	Accept(ctx context.Context, checkID string, token int64) (bool, error)
+}
+
+// MemoryFencingGuard хранит наибольшие токены в памяти процесса.
+// Подходит, когда задачи обрабатывает один экземпляр core-service
+type MemoryFencingGuard struct {
+	mu     sync.Mutex
+	tokens map[string]int64
+}
+
+// NewMemoryFencingGuard создает проверку токенов в памяти
+func NewMemoryFencingGuard() *MemoryFencingGuard {
+	return &MemoryFencingGuard{tokens: make(map[string]int64)}
+}
+
+// Accept реализует FencingGuard
+func (g *MemoryFencingGuard) Accept(ctx context.Context, checkID string, token int64) (bool, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if token < g.tokens[checkID] {
+		return false, nil
+	}
+	g.tokens[checkID] = token
+	return true, nil
+}
+
+// fencingAcceptScript сравнивает токен с наибольшим принятым и запоминает его.
+// Равный токен принимается: задачу могли доставить повторно
+const fencingAcceptScript = `
+local current = tonumber(redis.call('GET', KEYS[1]) or '0')
+local token = tonumber(ARGV[1])
+if token < current then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`
+
+// RedisFencingGuard хранит наибольшие токены в Redis, общем для экземпляров core-service
+type RedisFencingGuard struct {
+	client *pkg_redis.Client
+	ttl    time.Duration
+}
+
+// NewRedisFencingGuard создает проверку токенов в Redis
+func NewRedisFencingGuard(client *pkg_redis.Client, ttl time.Duration) *RedisFencingGuard {
+	if ttl <= 0 {
+		ttl = DefaultFencingTTL
+	}
+	return &RedisFencingGuard{client: client, ttl: ttl}
+}
+
+// Accept реализует FencingGuard атомарно через Lua скрипт
+func (g *RedisFencingGuard) Accept(ctx context.Context, checkID string, token int64) (bool, error) {
+	accepted, err := g.client.Client.Eval(ctx, fencingAcceptScript,
+		[]string{fmt.Sprintf("fence:check:%s", checkID)}, token, g.ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+	return accepted == 1, nil
+}
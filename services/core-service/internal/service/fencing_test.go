@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingFencingGuard недоступное хранилище токенов
+type failingFencingGuard struct{}
+
+func (failingFencingGuard) Accept(ctx context.Context, checkID string, token int64) (bool, error) {
+	return false, assert.AnError
+}
+
+func TestMemoryFencingGuard_Accept(t *testing.T) {
+	ctx := context.Background()
+	guard := NewMemoryFencingGuard()
+
+	accepted, err := guard.Accept(ctx, "check-1", 2)
+	require.NoError(t, err)
+	assert.True(t, accepted)
+
+	accepted, err = guard.Accept(ctx, "check-1", 2)
+	require.NoError(t, err)
+	assert.True(t, accepted, "redelivered task keeps its token")
+
+	accepted, err = guard.Accept(ctx, "check-1", 1)
+	require.NoError(t, err)
+	assert.False(t, accepted)
+
+	accepted, err = guard.Accept(ctx, "check-2", 1)
+	require.NoError(t, err)
+	assert.True(t, accepted, "tokens are tracked per check")
+}
+
+func TestCheckService_ProcessTask_DropsStaleFencingToken(t *testing.T) {
+	ctx := context.Background()
+	repo := &recordingResultRepository{}
+	service := newAttributionService(repo).WithFencing(NewMemoryFencingGuard())
+
+	dispatch := func(executionID string, token int64) {
+		body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: executionID,
+			Target: "https://example.com", Type: "http", FencingToken: token})
+		require.NoError(t, err)
+		require.NoError(t, service.ProcessTask(ctx, body))
+	}
+
+	// worker-a получил блокировку с токеном 1 и завис; блокировка истекла, worker-b получил
+	// токен 2 и отправил задачу. Задача worker-a, отправленная после паузы, отбрасывается
+	dispatch("exec-b", 2)
+	dispatch("exec-a", 1)
+	assert.Len(t, repo.saved, 1)
+
+	// Следующая блокировка и задачи без токена выполняются
+	dispatch("exec-c", 3)
+	dispatch("exec-manual", 0)
+	assert.Len(t, repo.saved, 3)
+}
+
+func TestCheckService_ProcessTask_FencingGuardFailsOpen(t *testing.T) {
+	repo := &recordingResultRepository{}
+	service := newAttributionService(repo).WithFencing(failingFencingGuard{})
+
+	body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: "exec-1",
+		Target: "https://example.com", Type: "http", FencingToken: 5})
+	require.NoError(t, err)
+	require.NoError(t, service.ProcessTask(context.Background(), body))
+	assert.Len(t, repo.saved, 1)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/logger"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// fleetReportTimeout ограничивает отправку здоровья проверки планировщику
+const fleetReportTimeout = 2 * time.Second
+
+// Состояния здоровья проверки в сводке по парку проверок Scheduler Service
+const (
+	FleetHealthUp       = "up"
+	FleetHealthFailing  = "failing"
+	FleetHealthDegraded = "degraded"
+)
+
+// HealthRecorder учитывает здоровье проверки по итоговому результату выполнения
+type HealthRecorder interface {
+	RecordHealth(ctx context.Context, tenantID string, result *domain.CheckResult)
+}
+
+// FleetHealthClient часть клиента Scheduler Service, принимающая здоровье проверок
+type FleetHealthClient interface {
+	ReportCheckHealth(ctx context.Context, in *schedulerv1.ReportCheckHealthRequest, opts ...grpc.CallOption) (*schedulerv1.ReportCheckHealthResponse, error)
+}
+
+// FleetHealthReporter сообщает планировщику о смене здоровья проверок: счетчики сводки
+// по парку проверок меняются только на переходах, поэтому повторы того же здоровья не отправляются.
+// После перезапуска первое здоровье каждой проверки отправляется повторно, планировщик его не учитывает дважды
+type FleetHealthReporter struct {
+	client               FleetHealthClient
+	degradedResponseTime time.Duration
+	logger               logger.Logger
+
+	mu       sync.Mutex
+	reported map[string]string
+}
+
+// NewFleetHealthReporter создает FleetHealthReporter. Успешная проверка не быстрее
+// degradedResponseTime считается деградировавшей; нулевое значение отключает degraded
+func NewFleetHealthReporter(client FleetHealthClient, degradedResponseTime time.Duration, log logger.Logger) *FleetHealthReporter {
+	return &FleetHealthReporter{
+		client:               client,
+		degradedResponseTime: degradedResponseTime,
+		logger:               log,
+		reported:             make(map[string]string),
+	}
+}
+
+// FleetHealth возвращает здоровье проверки по результату
+func FleetHealth(result *domain.CheckResult, degradedResponseTime time.Duration) string {
+	switch {
+	case !result.Success:
+		return FleetHealthFailing
+	case degradedResponseTime > 0 && time.Duration(result.DurationMs)*time.Millisecond >= degradedResponseTime:
+		return FleetHealthDegraded
+	default:
+		return FleetHealthUp
+	}
+}
+
+// RecordHealth отправляет здоровье проверки, если оно изменилось. При ошибке отправки
+// здоровье не запоминается и будет отправлено со следующим результатом
+func (r *FleetHealthReporter) RecordHealth(ctx context.Context, tenantID string, result *domain.CheckResult) {
+	health := FleetHealth(result, r.degradedResponseTime)
+
+	r.mu.Lock()
+	unchanged := r.reported[result.CheckID] == health
+	r.mu.Unlock()
+	if unchanged {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fleetReportTimeout)
+	defer cancel()
+
+	if _, err := r.client.ReportCheckHealth(ctx, &schedulerv1.ReportCheckHealthRequest{
+		CheckId:  result.CheckID,
+		TenantId: tenantID,
+		Health:   health,
+	}); err != nil {
+		r.logger.Warn("Failed to report check health to scheduler",
+			logger.String("check_id", result.CheckID),
+			logger.String("health", health),
+			logger.Error(err),
+		)
+		return
+	}
+
+	r.mu.Lock()
+	r.reported[result.CheckID] = health
+	r.mu.Unlock()
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/logger"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+type fakeFleetHealthClient struct {
+	reports []*schedulerv1.ReportCheckHealthRequest
+	err     error
+}
+
+func (f *fakeFleetHealthClient) ReportCheckHealth(ctx context.Context, in *schedulerv1.ReportCheckHealthRequest, opts ...grpc.CallOption) (*schedulerv1.ReportCheckHealthResponse, error) {
+	f.reports = append(f.reports, in)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &schedulerv1.ReportCheckHealthResponse{Success: true}, nil
+}
+
+func TestFleetHealth(t *testing.T) {
+	assert.Equal(t, FleetHealthFailing, FleetHealth(&domain.CheckResult{Success: false}, time.Second))
+	assert.Equal(t, FleetHealthUp, FleetHealth(&domain.CheckResult{Success: true, DurationMs: 999}, time.Second))
+	assert.Equal(t, FleetHealthDegraded, FleetHealth(&domain.CheckResult{Success: true, DurationMs: 1000}, time.Second))
+	assert.Equal(t, FleetHealthUp, FleetHealth(&domain.CheckResult{Success: true, DurationMs: 5000}, 0))
+}
+
+func TestFleetHealthReporter_ReportsTransitionsOnly(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	client := &fakeFleetHealthClient{}
+	reporter := NewFleetHealthReporter(client, time.Second, log)
+	ctx := context.Background()
+
+	reporter.RecordHealth(ctx, "tenant-1", &domain.CheckResult{CheckID: "check-1", Success: true})
+	reporter.RecordHealth(ctx, "tenant-1", &domain.CheckResult{CheckID: "check-1", Success: true})
+	reporter.RecordHealth(ctx, "tenant-1", &domain.CheckResult{CheckID: "check-1", Success: false})
+	reporter.RecordHealth(ctx, "tenant-1", &domain.CheckResult{CheckID: "check-2", Success: false})
+	require.Len(t, client.reports, 3)
+	assert.Equal(t, FleetHealthUp, client.reports[0].Health)
+	assert.Equal(t, FleetHealthFailing, client.reports[1].Health)
+	assert.Equal(t, "tenant-1", client.reports[1].TenantId)
+	assert.Equal(t, "check-2", client.reports[2].CheckId)
+
+	// Неотправленное здоровье отправляется повторно со следующим результатом
+	client.err = errors.New("scheduler unavailable")
+	reporter.RecordHealth(ctx, "tenant-1", &domain.CheckResult{CheckID: "check-1", Success: true})
+	client.err = nil
+	reporter.RecordHealth(ctx, "tenant-1", &domain.CheckResult{CheckID: "check-1", Success: true})
+	assert.Len(t, client.reports, 5)
+}
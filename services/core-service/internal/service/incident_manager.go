@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"UptimePingPlatform/services/core-service/internal/domain"
@@ -150,6 +152,11 @@ func CreateIncidentFromCheckResult(result *domain.CheckResult, tenantID string)
 		metadata[k] = v
 	}
 	
+	// Добавляем диагностический снимок неудачной проверки в детали инцидента
+	for k, v := range captureDetails(result.Capture) {
+		metadata[k] = v
+	}
+	
 	return &Incident{
 		CheckID:     result.CheckID,
 		TenantID:    tenantID,
@@ -164,3 +171,24 @@ func CreateIncidentFromCheckResult(result *domain.CheckResult, tenantID string)
 		UpdatedAt:   result.CheckedAt,
 	}
 }
+
+// captureDetails сворачивает снимок неудачной проверки в строковые детали инцидента
+func captureDetails(capture *domain.FailureCapture) map[string]string {
+	if capture == nil {
+		return nil
+	}
+
+	details := map[string]string{
+		"capture_body_snippet":   capture.BodySnippet,
+		"capture_body_truncated": strconv.FormatBool(capture.BodyTruncated),
+		"capture_body_size":      strconv.FormatInt(capture.BodySize, 10),
+		"capture_captured_at":    capture.CapturedAt.Format(time.RFC3339),
+	}
+	if headers, err := json.Marshal(capture.Headers); err == nil && len(capture.Headers) > 0 {
+		details["capture_headers"] = string(headers)
+	}
+	if entries, err := json.Marshal(capture.Entries); err == nil && len(capture.Entries) > 0 {
+		details["capture_har"] = string(entries)
+	}
+	return details
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// SLI конвейера проверок
+const (
+	// PipelineSLIQueueLag задержка от scheduled_at задачи до ее получения Core Service
+	PipelineSLIQueueLag = "queue_lag"
+	// PipelineSLIPersistDelay задержка от получения задачи до сохранения результата
+	PipelineSLIPersistDelay = "persist_delay"
+)
+
+// maxPipelineSamples сколько последних наблюдений SLI хранится для оценки квантиля
+const maxPipelineSamples = 10000
+
+// pipelineSLINamespace пространство имен UUID внутренних инцидентов конвейера
+var pipelineSLINamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://uptimeping.com/pipeline-sli"))
+
+// PipelineRecorder учитывает задержки конвейера и превышение порогов SLI в метриках
+type PipelineRecorder interface {
+	RecordQueueLag(checkType string, lag time.Duration)
+	RecordPersistDelay(checkType string, delay time.Duration)
+	RecordBreach(sli string, breached bool)
+}
+
+// PipelineSLIConfig пороги SLI конвейера: квантиль Quantile задержки за окно Window сравнивается
+// с порогом SLI не чаще раза в интервал оценки. Оценка без MinSamples наблюдений не меняет
+// состояние, чтобы редкие задачи не открывали и не закрывали инциденты. Нулевой порог отключает SLI
+type PipelineSLIConfig struct {
+	Window     time.Duration
+	Quantile   float64
+	MinSamples int
+	Thresholds map[string]time.Duration
+}
+
+// pipelineSample наблюдение задержки конвейера
+type pipelineSample struct {
+	at    time.Time
+	delay time.Duration
+}
+
+// PipelineSLO следит за задержками конвейера проверок. При превышении порога открывает внутренний
+// инцидент в мета-tenant самомониторинга и закрывает его, когда задержка возвращается в норму
+type PipelineSLO struct {
+	config    PipelineSLIConfig
+	recorder  PipelineRecorder
+	incidents IncidentManager
+	tenantID  string
+	logger    logger.Logger
+	now       func() time.Time
+
+	mu      sync.Mutex
+	samples map[string][]pipelineSample
+	open    map[string]string
+}
+
+// NewPipelineSLO создает наблюдение за SLI конвейера; recorder может быть nil
+func NewPipelineSLO(config PipelineSLIConfig, recorder PipelineRecorder, log logger.Logger) *PipelineSLO {
+	return &PipelineSLO{
+		config:   config,
+		recorder: recorder,
+		logger:   log,
+		now:      time.Now,
+		samples:  make(map[string][]pipelineSample),
+		open:     make(map[string]string),
+	}
+}
+
+// WithIncidents открывает инциденты о превышении порогов в tenant самомониторинга tenantID
+func (p *PipelineSLO) WithIncidents(tenantID string, incidents IncidentManager) *PipelineSLO {
+	p.tenantID = tenantID
+	p.incidents = incidents
+	return p
+}
+
+// ObserveQueueLag учитывает задержку очереди
+func (p *PipelineSLO) ObserveQueueLag(checkType string, lag time.Duration) {
+	if p.recorder != nil {
+		p.recorder.RecordQueueLag(checkType, lag)
+	}
+	p.observe(PipelineSLIQueueLag, lag)
+}
+
+// ObservePersistDelay учитывает задержку сохранения результата
+func (p *PipelineSLO) ObservePersistDelay(checkType string, delay time.Duration) {
+	if p.recorder != nil {
+		p.recorder.RecordPersistDelay(checkType, delay)
+	}
+	p.observe(PipelineSLIPersistDelay, delay)
+}
+
+// observe сохраняет наблюдение SLI с порогом для оценки
+func (p *PipelineSLO) observe(sli string, delay time.Duration) {
+	if p.config.Thresholds[sli] <= 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	samples := append(p.samples[sli], pipelineSample{at: p.now(), delay: delay})
+	if len(samples) > maxPipelineSamples {
+		samples = samples[len(samples)-maxPipelineSamples:]
+	}
+	p.samples[sli] = samples
+}
+
+// Run оценивает SLI раз в interval до отмены ctx
+func (p *PipelineSLO) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Evaluate(ctx)
+		}
+	}
+}
+
+// Evaluate сравнивает квантиль задержек за окно с порогами и открывает или закрывает инциденты
+func (p *PipelineSLO) Evaluate(ctx context.Context) {
+	for _, sli := range []string{PipelineSLIQueueLag, PipelineSLIPersistDelay} {
+		threshold := p.config.Thresholds[sli]
+		if threshold <= 0 {
+			continue
+		}
+
+		value, ok := p.quantile(sli)
+		if !ok {
+			continue
+		}
+		breached := value > threshold
+		if p.recorder != nil {
+			p.recorder.RecordBreach(sli, breached)
+		}
+
+		p.mu.Lock()
+		incidentID, open := p.open[sli]
+		p.mu.Unlock()
+
+		switch {
+		case breached && !open:
+			p.openIncident(ctx, sli, value, threshold)
+		case !breached && open:
+			p.resolveIncident(ctx, sli, incidentID, value)
+		}
+	}
+}
+
+// quantile отбрасывает наблюдения вне окна и возвращает квантиль оставшихся
+func (p *PipelineSLO) quantile(sli string) (time.Duration, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cutoff := p.now().Add(-p.config.Window)
+	samples := p.samples[sli]
+	first := sort.Search(len(samples), func(i int) bool { return samples[i].at.After(cutoff) })
+	samples = append(samples[:0:0], samples[first:]...)
+	p.samples[sli] = samples
+	if len(samples) == 0 || len(samples) < p.config.MinSamples {
+		return 0, false
+	}
+
+	delays := make([]time.Duration, len(samples))
+	for i, sample := range samples {
+		delays[i] = sample.delay
+	}
+	sort.Slice(delays, func(i, j int) bool { return delays[i] < delays[j] })
+	index := int(math.Ceil(p.config.Quantile*float64(len(delays)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return delays[index], true
+}
+
+// openIncident открывает внутренний инцидент о превышении порога SLI
+func (p *PipelineSLO) openIncident(ctx context.Context, sli string, value, threshold time.Duration) {
+	p.logger.Warn("Pipeline SLI threshold exceeded",
+		logger.String("sli", sli),
+		logger.Duration("value", value),
+		logger.Duration("threshold", threshold),
+		logger.Float64("quantile", p.config.Quantile),
+	)
+	if p.incidents == nil || p.tenantID == "" {
+		return
+	}
+
+	// Текст ошибки не содержит значения задержки: повторные превышения попадают в один инцидент
+	incident, err := p.incidents.CreateIncident(ctx, &Incident{
+		CheckID:   PipelineSLICheckID(p.tenantID, sli),
+		TenantID:  p.tenantID,
+		Title:     "Pipeline SLI breached: " + sli,
+		Status:    IncidentStatusOpen,
+		Severity:  IncidentSeverityHigh,
+		Error:     fmt.Sprintf("pipeline %s above threshold %s", sli, threshold),
+		CreatedAt: p.now(),
+		Metadata: map[string]interface{}{
+			domain.MetadataKeySeverityHint: domain.SeverityCritical,
+			"sli":                          sli,
+			"sli_value":                    value.String(),
+			"sli_threshold":                threshold.String(),
+			"sli_quantile":                 fmt.Sprintf("%g", p.config.Quantile),
+			"sli_window":                   p.config.Window.String(),
+		},
+	})
+	if err != nil {
+		p.logger.Error("Failed to open pipeline SLI incident",
+			logger.String("sli", sli),
+			logger.Error(err),
+		)
+		return
+	}
+
+	p.mu.Lock()
+	p.open[sli] = incident.ID
+	p.mu.Unlock()
+}
+
+// resolveIncident закрывает инцидент SLI после возвращения задержки в норму
+func (p *PipelineSLO) resolveIncident(ctx context.Context, sli, incidentID string, value time.Duration) {
+	if err := p.incidents.ResolveIncident(ctx, incidentID); err != nil {
+		p.logger.Error("Failed to resolve pipeline SLI incident",
+			logger.String("sli", sli),
+			logger.String("incident_id", incidentID),
+			logger.Error(err),
+		)
+		return
+	}
+
+	p.logger.Info("Pipeline SLI recovered",
+		logger.String("sli", sli),
+		logger.String("incident_id", incidentID),
+		logger.Duration("value", value),
+	)
+	p.mu.Lock()
+	delete(p.open, sli)
+	p.mu.Unlock()
+}
+
+// PipelineSLICheckID возвращает постоянный ID, под которым открываются инциденты SLI в tenant
+func PipelineSLICheckID(tenantID, sli string) string {
+	return uuid.NewSHA1(pipelineSLINamespace, []byte(tenantID+"/"+sli)).String()
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// sliIncidentManager запоминает открытые и закрытые инциденты
+type sliIncidentManager struct {
+	MockIncidentManager
+	created  []*Incident
+	resolved []string
+}
+
+func (m *sliIncidentManager) CreateIncident(ctx context.Context, incident *Incident) (*Incident, error) {
+	m.created = append(m.created, incident)
+	created := *incident
+	created.ID = "incident-" + incident.CheckID
+	return &created, nil
+}
+
+func (m *sliIncidentManager) ResolveIncident(ctx context.Context, incidentID string) error {
+	m.resolved = append(m.resolved, incidentID)
+	return nil
+}
+
+// recordingPipelineRecorder запоминает задержки конвейера
+type recordingPipelineRecorder struct {
+	queueLags     []time.Duration
+	persistDelays []time.Duration
+	breaches      map[string]bool
+}
+
+func (r *recordingPipelineRecorder) RecordQueueLag(checkType string, lag time.Duration) {
+	r.queueLags = append(r.queueLags, lag)
+}
+
+func (r *recordingPipelineRecorder) RecordPersistDelay(checkType string, delay time.Duration) {
+	r.persistDelays = append(r.persistDelays, delay)
+}
+
+func (r *recordingPipelineRecorder) RecordBreach(sli string, breached bool) {
+	if r.breaches == nil {
+		r.breaches = make(map[string]bool)
+	}
+	r.breaches[sli] = breached
+}
+
+func TestPipelineSLO_Evaluate(t *testing.T) {
+	incidents := &sliIncidentManager{}
+	recorder := &recordingPipelineRecorder{}
+	slo := NewPipelineSLO(PipelineSLIConfig{
+		Window:     time.Minute,
+		Quantile:   0.9,
+		MinSamples: 5,
+		Thresholds: map[string]time.Duration{PipelineSLIQueueLag: 10 * time.Second},
+	}, recorder, &MockLogger{}).WithIncidents("platform-tenant", incidents)
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	slo.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// Меньше MinSamples наблюдений - решения нет
+	for i := 0; i < 4; i++ {
+		slo.ObserveQueueLag("http", 30*time.Second)
+	}
+	slo.Evaluate(ctx)
+	assert.Empty(t, incidents.created)
+
+	// 90-й перцентиль из 10 наблюдений - девятое по возрастанию, 30 секунд выше порога
+	for i := 0; i < 6; i++ {
+		slo.ObserveQueueLag("http", time.Second)
+	}
+	slo.Evaluate(ctx)
+	require.Len(t, incidents.created, 1)
+	incident := incidents.created[0]
+	assert.Equal(t, "platform-tenant", incident.TenantID)
+	assert.Equal(t, PipelineSLICheckID("platform-tenant", PipelineSLIQueueLag), incident.CheckID)
+	assert.Equal(t, domain.SeverityCritical, incident.Metadata[domain.MetadataKeySeverityHint])
+	assert.Equal(t, "30s", incident.Metadata["sli_value"])
+	assert.True(t, recorder.breaches[PipelineSLIQueueLag])
+
+	// Повторная оценка не открывает второй инцидент
+	slo.Evaluate(ctx)
+	assert.Len(t, incidents.created, 1)
+
+	// Старые наблюдения выходят из окна, задержка в норме - инцидент закрывается
+	now = now.Add(2 * time.Minute)
+	for i := 0; i < 5; i++ {
+		slo.ObserveQueueLag("http", 2*time.Second)
+	}
+	slo.Evaluate(ctx)
+	assert.Equal(t, []string{"incident-" + incident.CheckID}, incidents.resolved)
+	assert.False(t, recorder.breaches[PipelineSLIQueueLag])
+
+	// SLI без порога не оценивается, но попадает в метрики
+	slo.ObservePersistDelay("http", time.Hour)
+	slo.Evaluate(ctx)
+	assert.Len(t, incidents.created, 1)
+	assert.NotContains(t, recorder.breaches, PipelineSLIPersistDelay)
+	assert.Equal(t, []time.Duration{time.Hour}, recorder.persistDelays)
+}
+
+func TestPipelineSLICheckID(t *testing.T) {
+	id := PipelineSLICheckID("tenant", PipelineSLIQueueLag)
+	assert.Len(t, id, 36)
+	assert.Equal(t, id, PipelineSLICheckID("tenant", PipelineSLIQueueLag))
+	assert.NotEqual(t, id, PipelineSLICheckID("tenant", PipelineSLIPersistDelay))
+}
+
+func TestCheckService_ProcessTask_PipelineSLI(t *testing.T) {
+	recorder := &recordingPipelineRecorder{}
+	service := newAttributionService(&recordingResultRepository{}).
+		WithPipelineSLO(NewPipelineSLO(PipelineSLIConfig{}, recorder, &MockLogger{}))
+
+	body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: "exec-1", Target: "https://example.com",
+		Type: "http", ScheduledAt: time.Now().Add(-3 * time.Second)})
+	require.NoError(t, err)
+	require.NoError(t, service.ProcessTask(context.Background(), body))
+
+	require.Len(t, recorder.queueLags, 1)
+	assert.GreaterOrEqual(t, recorder.queueLags[0], 3*time.Second)
+	require.Len(t, recorder.persistDelays, 1)
+	assert.GreaterOrEqual(t, recorder.persistDelays[0], time.Duration(0))
+}
@@ -0,0 +1,391 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/connection"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// Параметры отправки результатов в приемники по умолчанию
+const (
+	defaultSinkBatchSize     = 100
+	defaultSinkFlushInterval = 5 * time.Second
+	// defaultSinkMaxPending максимальное число неотправленных результатов одного приемника;
+	// сверх него результаты отбрасываются, чтобы недоступный приемник не занимал память
+	defaultSinkMaxPending = 1000
+	// sinkCacheTTL время кэширования списка приемников тенанта
+	sinkCacheTTL = time.Minute
+	// sinkDeliveryTimeout ограничивает одну попытку отправки пачки
+	sinkDeliveryTimeout = 10 * time.Second
+)
+
+// ResultSinkEvent тип события в теле запроса к приемнику
+const ResultSinkEvent = "check.results"
+
+// ResultRecorder получает каждый сырой результат проверки: результаты всех регионов до консенсуса
+type ResultRecorder interface {
+	RecordResult(ctx context.Context, tenantID string, tags []string, result *domain.CheckResult)
+}
+
+// ResultSink webhook тенанта, принимающий сырые результаты проверок. Пустой Tags означает
+// все проверки, иначе - проверки с любым из тегов
+type ResultSink struct {
+	Name   string
+	URL    string
+	Secret string
+	Tags   []string
+}
+
+// Matches проверяет, что результат проверки с тегами tags отправляется в приемник
+func (s ResultSink) Matches(tags []string) bool {
+	if len(s.Tags) == 0 {
+		return true
+	}
+	for _, want := range s.Tags {
+		for _, tag := range tags {
+			if tag == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SinkLookup загружает приемники результатов тенанта
+type SinkLookup func(ctx context.Context, tenantID string) ([]ResultSink, error)
+
+// TenantSinkLookup загружает приемники результатов из настроек тенанта в TenantSettingsService
+func TenantSinkLookup(client configv1.TenantSettingsServiceClient) SinkLookup {
+	return func(ctx context.Context, tenantID string) ([]ResultSink, error) {
+		settings, err := client.GetTenantSettings(ctx, &configv1.GetTenantSettingsRequest{TenantId: tenantID})
+		if err != nil {
+			return nil, err
+		}
+		sinks := make([]ResultSink, 0, len(settings.GetResultSinks()))
+		for _, sink := range settings.GetResultSinks() {
+			sinks = append(sinks, ResultSink{Name: sink.GetName(), URL: sink.GetUrl(), Secret: sink.GetSecret(), Tags: sink.GetTags()})
+		}
+		return sinks, nil
+	}
+}
+
+// SinkResult результат проверки в теле запроса к приемнику
+type SinkResult struct {
+	CheckID     string               `json:"check_id"`
+	ExecutionID string               `json:"execution_id"`
+	Region      string               `json:"region,omitempty"`
+	Success     bool                 `json:"success"`
+	DurationMs  int64                `json:"duration_ms"`
+	StatusCode  int                  `json:"status_code,omitempty"`
+	Error       string               `json:"error,omitempty"`
+	CheckedAt   time.Time            `json:"checked_at"`
+	Trigger     string               `json:"trigger,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Timings     *domain.CheckTimings `json:"timings,omitempty"`
+}
+
+// SinkBatch тело запроса к приемнику, подписанное ключом приемника по схеме webhooks.DefaultScheme
+type SinkBatch struct {
+	Event    string       `json:"event"`
+	TenantID string       `json:"tenant_id"`
+	Sink     string       `json:"sink"`
+	SentAt   time.Time    `json:"sent_at"`
+	Results  []SinkResult `json:"results"`
+}
+
+// sinkBuffer неотправленные результаты одного приемника тенанта
+type sinkBuffer struct {
+	tenantID string
+	sink     ResultSink
+	results  []SinkResult
+	dropped  int
+	sending  bool
+}
+
+// cachedSinks приемники тенанта в кэше
+type cachedSinks struct {
+	sinks     []ResultSink
+	expiresAt time.Time
+}
+
+// ResultStreamer пачками отправляет сырые результаты проверок в приемники тенантов.
+// Пачка уходит, когда набирается batchSize результатов или проходит flushInterval.
+// Неудачная после повторов пачка отбрасывается: приемник получает данные не более одного раза
+type ResultStreamer struct {
+	lookup        SinkLookup
+	client        *http.Client
+	retry         connection.RetryConfig
+	batchSize     int
+	flushInterval time.Duration
+	maxPending    int
+	logger        logger.Logger
+	now           func() time.Time
+
+	mu      sync.Mutex
+	cache   map[string]cachedSinks
+	buffers map[string]*sinkBuffer
+	wg      sync.WaitGroup
+}
+
+// NewResultStreamer создает ResultStreamer с пачками по 100 результатов раз в 5 секунд
+func NewResultStreamer(lookup SinkLookup, log logger.Logger) *ResultStreamer {
+	return &ResultStreamer{
+		lookup: lookup,
+		client: &http.Client{Timeout: sinkDeliveryTimeout},
+		retry: connection.RetryConfig{
+			MaxAttempts:  3,
+			InitialDelay: time.Second,
+			MaxDelay:     10 * time.Second,
+			Multiplier:   2.0,
+			Jitter:       true,
+		},
+		batchSize:     defaultSinkBatchSize,
+		flushInterval: defaultSinkFlushInterval,
+		maxPending:    defaultSinkMaxPending,
+		logger:        log,
+		now:           time.Now,
+		cache:         make(map[string]cachedSinks),
+		buffers:       make(map[string]*sinkBuffer),
+	}
+}
+
+// WithBatching задает размер пачки и максимальную задержку отправки
+func (s *ResultStreamer) WithBatching(size int, interval time.Duration) *ResultStreamer {
+	s.batchSize = size
+	s.flushInterval = interval
+	return s
+}
+
+// WithRetry задает повторы отправки пачки
+func (s *ResultStreamer) WithRetry(retry connection.RetryConfig) *ResultStreamer {
+	s.retry = retry
+	return s
+}
+
+// WithHTTPClient задает HTTP клиент для отправки в приемники
+func (s *ResultStreamer) WithHTTPClient(client *http.Client) *ResultStreamer {
+	s.client = client
+	return s
+}
+
+// RecordResult ставит результат в очередь каждого приемника тенанта, фильтр тегов которого
+// подходит проверке. Отправка идет в фоне и не задерживает обработку задачи
+func (s *ResultStreamer) RecordResult(ctx context.Context, tenantID string, tags []string, result *domain.CheckResult) {
+	if tenantID == "" {
+		return
+	}
+	sinks := s.sinks(ctx, tenantID)
+	if len(sinks) == 0 {
+		return
+	}
+
+	event := SinkResult{
+		CheckID:     result.CheckID,
+		ExecutionID: result.ExecutionID,
+		Region:      result.Metadata[domain.MetadataKeyRegion],
+		Success:     result.Success,
+		DurationMs:  result.DurationMs,
+		StatusCode:  result.StatusCode,
+		Error:       result.Error,
+		CheckedAt:   result.CheckedAt,
+		Trigger:     string(result.Trigger),
+		Tags:        tags,
+		Timings:     result.Timings,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sink := range sinks {
+		if !sink.Matches(tags) {
+			continue
+		}
+		key := tenantID + "/" + sink.Name
+		buffer, ok := s.buffers[key]
+		if !ok {
+			buffer = &sinkBuffer{tenantID: tenantID}
+			s.buffers[key] = buffer
+		}
+		// Изменения адреса и ключа приемника применяются к следующей пачке
+		buffer.sink = sink
+		if len(buffer.results) >= s.maxPending {
+			buffer.dropped++
+			continue
+		}
+		buffer.results = append(buffer.results, event)
+		if len(buffer.results) >= s.batchSize && !buffer.sending {
+			s.startSend(buffer)
+		}
+	}
+}
+
+// Run отправляет накопленные результаты раз в flushInterval до отмены ctx, после чего
+// отправляет остаток и дожидается начатых отправок
+func (s *ResultStreamer) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.Flush()
+			s.wg.Wait()
+			return
+		case <-ticker.C:
+			s.Flush()
+		}
+	}
+}
+
+// Flush начинает отправку всех накопленных результатов
+func (s *ResultStreamer) Flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, buffer := range s.buffers {
+		if len(buffer.results) > 0 && !buffer.sending {
+			s.startSend(buffer)
+		}
+	}
+}
+
+// startSend запускает отправку пачек приемника; вызывается под s.mu
+func (s *ResultStreamer) startSend(buffer *sinkBuffer) {
+	buffer.sending = true
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.drain(buffer)
+	}()
+}
+
+// drain отправляет пачки приемника, пока в очереди есть результаты
+func (s *ResultStreamer) drain(buffer *sinkBuffer) {
+	for {
+		s.mu.Lock()
+		if len(buffer.results) == 0 {
+			buffer.sending = false
+			s.mu.Unlock()
+			return
+		}
+		size := s.batchSize
+		if size > len(buffer.results) {
+			size = len(buffer.results)
+		}
+		batch := buffer.results[:size:size]
+		buffer.results = buffer.results[size:]
+		sink, dropped := buffer.sink, buffer.dropped
+		buffer.dropped = 0
+		s.mu.Unlock()
+
+		if dropped > 0 {
+			s.logger.Warn("Result sink queue is full, results dropped",
+				logger.String("tenant_id", buffer.tenantID),
+				logger.String("sink", sink.Name),
+				logger.Int("dropped", dropped),
+			)
+		}
+		if err := s.deliver(buffer.tenantID, sink, batch); err != nil {
+			s.logger.Error("Failed to deliver results to sink",
+				logger.String("tenant_id", buffer.tenantID),
+				logger.String("sink", sink.Name),
+				logger.Int("results", len(batch)),
+				logger.Error(err),
+			)
+		}
+	}
+}
+
+// deliver отправляет подписанную пачку с повторами. Ответ 4xx, кроме 429, означает,
+// что приемник отверг пачку, и не повторяется
+func (s *ResultStreamer) deliver(tenantID string, sink ResultSink, results []SinkResult) error {
+	body, err := json.Marshal(SinkBatch{
+		Event:    ResultSinkEvent,
+		TenantID: tenantID,
+		Sink:     sink.Name,
+		SentAt:   s.now().UTC(),
+		Results:  results,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode batch: %w", err)
+	}
+
+	var rejected error
+	err = connection.WithRetry(context.Background(), s.retry, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, sinkDeliveryTimeout)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.URL, bytes.NewReader(body))
+		if err != nil {
+			rejected = err
+			return nil
+		}
+		req.Header.Set("Content-Type", "application/json")
+		webhooks.NewSigner(sink.Secret).WithClock(s.now).SignRequest(req, body)
+
+		resp, err := s.client.Do(req)
+		if err != nil {
+			return err
+		}
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode < 300:
+			return nil
+		case resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests:
+			rejected = fmt.Errorf("sink rejected batch with status %d", resp.StatusCode)
+			return nil
+		default:
+			return fmt.Errorf("sink responded with status %d", resp.StatusCode)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return rejected
+}
+
+// sinks возвращает приемники тенанта из кэша или загружает их. Ошибка загрузки
+// кэшируется как отсутствие приемников, чтобы не нагружать сервис настроек
+func (s *ResultStreamer) sinks(ctx context.Context, tenantID string) []ResultSink {
+	s.mu.Lock()
+	cached, ok := s.cache[tenantID]
+	s.mu.Unlock()
+	if ok && s.now().Before(cached.expiresAt) {
+		return cached.sinks
+	}
+
+	sinks, err := s.lookup(ctx, tenantID)
+	if err != nil {
+		s.logger.Warn("Failed to load result sinks",
+			logger.String("tenant_id", tenantID),
+			logger.Error(err),
+		)
+		sinks = nil
+	}
+
+	s.mu.Lock()
+	s.cache[tenantID] = cachedSinks{sinks: sinks, expiresAt: s.now().Add(sinkCacheTTL)}
+	s.mu.Unlock()
+	return sinks
+}
+
+// ParseResultTags разбирает теги проверки из метаданных задачи, переданные через запятую
+func ParseResultTags(metadata map[string]interface{}) []string {
+	value, _ := metadata["tags"].(string)
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
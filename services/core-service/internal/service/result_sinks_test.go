@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/connection"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
+)
+
+// sinkServer принимает пачки результатов и проверяет подпись
+type sinkServer struct {
+	mu      sync.Mutex
+	batches []SinkBatch
+	status  int
+	calls   int
+}
+
+func (s *sinkServer) handler(t *testing.T, secret string) http.HandlerFunc {
+	verifier := webhooks.NewVerifier(secret)
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.NoError(t, verifier.Verify(r.Context(), r.Header, body))
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.calls++
+		if s.status != 0 {
+			w.WriteHeader(s.status)
+			return
+		}
+		var batch SinkBatch
+		require.NoError(t, json.Unmarshal(body, &batch))
+		s.batches = append(s.batches, batch)
+	}
+}
+
+func (s *sinkServer) snapshot() ([]SinkBatch, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SinkBatch(nil), s.batches...), s.calls
+}
+
+func newTestStreamer(t *testing.T, lookup SinkLookup) *ResultStreamer {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	return NewResultStreamer(lookup, log).
+		WithBatching(2, time.Hour).
+		WithRetry(connection.RetryConfig{MaxAttempts: 2, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 1})
+}
+
+func TestResultSink_Matches(t *testing.T) {
+	assert.True(t, ResultSink{}.Matches(nil))
+	assert.True(t, ResultSink{Tags: []string{"prod", "db"}}.Matches([]string{"api", "db"}))
+	assert.False(t, ResultSink{Tags: []string{"prod"}}.Matches([]string{"staging"}))
+	assert.False(t, ResultSink{Tags: []string{"prod"}}.Matches(nil))
+}
+
+func TestParseResultTags(t *testing.T) {
+	assert.Equal(t, []string{"prod", "api"}, ParseResultTags(map[string]interface{}{"tags": "prod,api"}))
+	assert.Nil(t, ParseResultTags(nil))
+}
+
+func TestResultStreamer_BatchesSignedResultsByTag(t *testing.T) {
+	const secret = "0123456789abcdef"
+	all, prod := &sinkServer{}, &sinkServer{}
+	allServer := httptest.NewServer(all.handler(t, secret))
+	defer allServer.Close()
+	prodServer := httptest.NewServer(prod.handler(t, secret))
+	defer prodServer.Close()
+
+	lookups := 0
+	streamer := newTestStreamer(t, func(ctx context.Context, tenantID string) ([]ResultSink, error) {
+		lookups++
+		return []ResultSink{
+			{Name: "warehouse", URL: allServer.URL, Secret: secret},
+			{Name: "prod-only", URL: prodServer.URL, Secret: secret, Tags: []string{"prod"}},
+		}, nil
+	})
+	ctx := context.Background()
+
+	checkedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	streamer.RecordResult(ctx, "tenant-1", []string{"prod"}, &domain.CheckResult{
+		CheckID: "check-1", ExecutionID: "exec-1", Success: false, StatusCode: 502, CheckedAt: checkedAt,
+		Metadata: map[string]string{domain.MetadataKeyRegion: "eu"},
+	})
+	streamer.RecordResult(ctx, "tenant-1", []string{"staging"}, &domain.CheckResult{CheckID: "check-2", Success: true})
+	streamer.RecordResult(ctx, "", nil, &domain.CheckResult{CheckID: "check-3"})
+
+	// Полная пачка отправляется сразу, неполная - при Flush
+	streamer.Flush()
+	streamer.wg.Wait()
+	assert.Equal(t, 1, lookups)
+
+	batches, _ := all.snapshot()
+	require.Len(t, batches, 1)
+	assert.Equal(t, ResultSinkEvent, batches[0].Event)
+	assert.Equal(t, "tenant-1", batches[0].TenantID)
+	assert.Equal(t, "warehouse", batches[0].Sink)
+	require.Len(t, batches[0].Results, 2)
+	assert.Equal(t, SinkResult{
+		CheckID: "check-1", ExecutionID: "exec-1", Region: "eu", StatusCode: 502, CheckedAt: checkedAt, Tags: []string{"prod"},
+	}, batches[0].Results[0])
+
+	batches, _ = prod.snapshot()
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Results, 1)
+	assert.Equal(t, "check-1", batches[0].Results[0].CheckID)
+}
+
+func TestResultStreamer_RetriesAndRejections(t *testing.T) {
+	const secret = "0123456789abcdef"
+	sink := &sinkServer{status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(sink.handler(t, secret))
+	defer server.Close()
+
+	streamer := newTestStreamer(t, func(ctx context.Context, tenantID string) ([]ResultSink, error) {
+		return []ResultSink{{Name: "warehouse", URL: server.URL, Secret: secret}}, nil
+	})
+
+	// 5xx повторяется, после исчерпания попыток пачка отбрасывается
+	streamer.RecordResult(context.Background(), "tenant-1", nil, &domain.CheckResult{CheckID: "check-1"})
+	streamer.Flush()
+	streamer.wg.Wait()
+	_, calls := sink.snapshot()
+	assert.Equal(t, 2, calls)
+
+	// 4xx не повторяется
+	sink.mu.Lock()
+	sink.status, sink.calls = http.StatusBadRequest, 0
+	sink.mu.Unlock()
+	streamer.RecordResult(context.Background(), "tenant-1", nil, &domain.CheckResult{CheckID: "check-1"})
+	streamer.Flush()
+	streamer.wg.Wait()
+	_, calls = sink.snapshot()
+	assert.Equal(t, 1, calls)
+}
+
+func TestResultStreamer_DropsWhenQueueIsFullAndCachesLookupErrors(t *testing.T) {
+	lookups := 0
+	streamer := newTestStreamer(t, func(ctx context.Context, tenantID string) ([]ResultSink, error) {
+		lookups++
+		if tenantID == "tenant-2" {
+			return nil, errors.New("settings unavailable")
+		}
+		return []ResultSink{{Name: "warehouse", URL: "http://127.0.0.1:1", Secret: "0123456789abcdef"}}, nil
+	}).WithBatching(100, time.Hour)
+	streamer.maxPending = 3
+
+	for i := 0; i < 5; i++ {
+		streamer.RecordResult(context.Background(), "tenant-1", nil, &domain.CheckResult{CheckID: "check-1"})
+		streamer.RecordResult(context.Background(), "tenant-2", nil, &domain.CheckResult{CheckID: "check-2"})
+	}
+	buffer := streamer.buffers["tenant-1/warehouse"]
+	require.NotNil(t, buffer)
+	assert.Len(t, buffer.results, 3)
+	assert.Equal(t, 2, buffer.dropped)
+	assert.Len(t, streamer.buffers, 1)
+	assert.Equal(t, 2, lookups)
+}
+
+func TestResultStreamer_RefusesPrivateSinks(t *testing.T) {
+	const secret = "0123456789abcdef"
+	sink := &sinkServer{}
+	server := httptest.NewServer(sink.handler(t, secret))
+	defer server.Close()
+	policy, err := checker.NewDefaultTargetPolicy(nil, nil)
+	require.NoError(t, err)
+
+	// Приемник на loopback адресе недоступен так же, как цель проверки
+	streamer := newTestStreamer(t, func(ctx context.Context, tenantID string) ([]ResultSink, error) {
+		return []ResultSink{{Name: "internal", URL: server.URL, Secret: secret}}, nil
+	}).WithHTTPClient(&http.Client{
+		Timeout:   time.Second,
+		Transport: &http.Transport{DialContext: policy.DialContext},
+	})
+
+	streamer.RecordResult(context.Background(), "tenant-1", nil, &domain.CheckResult{CheckID: "check-1"})
+	streamer.Flush()
+	streamer.wg.Wait()
+
+	_, calls := sink.snapshot()
+	assert.Zero(t, calls)
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+func TestCheckService_ProcessTask_PlatformCheckers(t *testing.T) {
+	repo := &recordingResultRepository{}
+	platform := &MockCheckerFactory{mockChecker: &MockChecker{mockResult: &domain.CheckResult{
+		CheckID: "redis", Success: false, Error: "connection refused", CheckedAt: time.Now().UTC(),
+	}}}
+	service := newAttributionService(repo).WithPlatformCheckers("platform-tenant", platform)
+
+	dispatch := func(tenantID string) {
+		body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: "exec-" + tenantID,
+			Target: "redis:6379", Type: "tcp", TenantID: tenantID})
+		require.NoError(t, err)
+		require.NoError(t, service.ProcessTask(context.Background(), body))
+	}
+
+	// Задачи мета-tenant выполняет фабрика платформы, задачи клиентов - обычная фабрика
+	dispatch("platform-tenant")
+	dispatch("customer-tenant")
+	require.Len(t, repo.saved, 2)
+	assert.Equal(t, "connection refused", repo.saved[0].Error)
+	assert.True(t, repo.saved[1].Success)
+}
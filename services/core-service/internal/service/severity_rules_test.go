@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+func TestClassifySeverity(t *testing.T) {
+	rules, err := domain.SeverityRulesFromConfig(map[string]interface{}{
+		domain.ConfigKeySeverityRules: []interface{}{"503 => warning", "5xx => critical", "Timeout => error"},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		result domain.CheckResult
+		want   string
+	}{
+		{"exact status", domain.CheckResult{StatusCode: 503, Error: "unexpected status code: 503"}, domain.SeverityWarning},
+		{"status class", domain.CheckResult{StatusCode: 502, Error: "unexpected status code: 502"}, domain.SeverityCritical},
+		{"keyword", domain.CheckResult{Error: "dial tcp: i/o TIMEOUT"}, domain.SeverityError},
+		{"no match", domain.CheckResult{StatusCode: 404, Error: "unexpected status code: 404"}, ""},
+		{"success", domain.CheckResult{Success: true, StatusCode: 500}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.result
+			assert.Equal(t, tt.want != "", domain.ClassifySeverity(rules, &result))
+			assert.Equal(t, tt.want, result.Metadata[domain.MetadataKeySeverityHint])
+		})
+	}
+
+	// Правила строкой через точку с запятой
+	rules, err = domain.SeverityRulesFromConfig(map[string]interface{}{domain.ConfigKeySeverityRules: "4xx=>error; refused => critical;"})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SeverityRule{{Match: "4xx", Severity: "error"}, {Match: "refused", Severity: "critical"}}, rules)
+}
+
+func TestSeverityRulesFromConfig_Invalid(t *testing.T) {
+	invalid := []interface{}{
+		"5xx critical",
+		"=> critical",
+		"5xx => fatal",
+		"700 => error",
+		[]interface{}{"5xx => critical", 42},
+		map[string]interface{}{"5xx": "critical"},
+	}
+	for _, value := range invalid {
+		_, err := domain.SeverityRulesFromConfig(map[string]interface{}{domain.ConfigKeySeverityRules: value})
+		assert.Error(t, err, "%v", value)
+	}
+
+	rules, err := domain.SeverityRulesFromConfig(map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+}
+
+func TestCheckService_ProcessTask_SeverityRules(t *testing.T) {
+	repo := &recordingResultRepository{}
+	service := newAttributionService(repo)
+	checker := service.checkerFactory.(*MockCheckerFactory).mockChecker
+
+	process := func(config map[string]interface{}) *domain.CheckResult {
+		checker.mockResult = &domain.CheckResult{CheckID: "check-1", Success: false, StatusCode: 502,
+			Error: "unexpected status code: 502", CheckedAt: time.Now().UTC()}
+		body, err := json.Marshal(TaskMessage{CheckID: "check-1", ExecutionID: "exec-1",
+			Target: "https://example.com", Type: "http", Config: config})
+		require.NoError(t, err)
+		require.NoError(t, service.ProcessTask(context.Background(), body))
+		return repo.saved[len(repo.saved)-1]
+	}
+
+	result := process(map[string]interface{}{domain.ConfigKeySeverityRules: []interface{}{"timeout => error", "5xx => critical"}})
+	assert.Equal(t, domain.SeverityCritical, result.Metadata[domain.MetadataKeySeverityHint])
+	assert.Equal(t, "5xx => critical", result.Metadata[domain.MetadataKeySeverityRule])
+
+	// Некорректные правила не мешают обработке результата
+	result = process(map[string]interface{}{domain.ConfigKeySeverityRules: "5xx => fatal"})
+	assert.NotContains(t, result.Metadata, domain.MetadataKeySeverityHint)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckService_CreateTask_ExpandsConfig(t *testing.T) {
+	service := newAttributionService(&recordingResultRepository{})
+
+	// Планировщик не передает адрес и метод HTTP проверки: они выводятся из цели
+	task := service.createTask(&TaskMessage{CheckID: "check-1", ExecutionID: "exec-1", Target: "https://example.com",
+		Type: "http", ScheduledAt: time.Now(), Config: map[string]interface{}{"timeout": "10s"}})
+	assert.Equal(t, map[string]interface{}{"timeout": "10s", "url": "https://example.com", "method": "GET"}, task.Config)
+
+	// Явно заданные значения не меняются
+	config := map[string]interface{}{"url": "https://example.com/health", "method": "HEAD"}
+	task = service.createTask(&TaskMessage{CheckID: "check-1", ExecutionID: "exec-1", Target: "https://example.com",
+		Type: "http", ScheduledAt: time.Now(), Config: config})
+	assert.Equal(t, "https://example.com/health", task.Config["url"])
+	assert.Equal(t, "HEAD", task.Config["method"])
+
+	// Остальные типы получают конфигурацию как есть
+	task = service.createTask(&TaskMessage{CheckID: "check-1", ExecutionID: "exec-1", Target: "example.com:443",
+		Type: "tcp", ScheduledAt: time.Now(), Config: map[string]interface{}{"timeout": "5s"}})
+	assert.Equal(t, map[string]interface{}{"timeout": "5s"}, task.Config)
+}
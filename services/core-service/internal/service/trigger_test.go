@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// recordingResultRepository запоминает сохраненные результаты
+type recordingResultRepository struct {
+	MockCheckResultRepository
+	saved []*domain.CheckResult
+}
+
+func (r *recordingResultRepository) Save(ctx context.Context, result *domain.CheckResult) error {
+	r.saved = append(r.saved, result)
+	return nil
+}
+
+func newAttributionService(repo *recordingResultRepository) *CheckService {
+	factory := &MockCheckerFactory{mockChecker: &MockChecker{}}
+	factory.mockChecker.mockResult = &domain.CheckResult{CheckID: "check-1", Success: true, CheckedAt: time.Now().UTC()}
+	return NewCheckService(&MockLogger{}, factory, repo, nil, &MockIncidentManager{})
+}
+
+func TestCheckService_ProcessTask_Attribution(t *testing.T) {
+	tests := []struct {
+		name        string
+		message     TaskMessage
+		redelivered bool
+		trigger     domain.ExecutionTrigger
+		triggeredBy string
+	}{
+		{
+			name:    "scheduler task without trigger",
+			message: TaskMessage{CheckID: "check-1", ExecutionID: "exec-1", Target: "https://example.com", Type: "http"},
+			trigger: domain.TriggerSchedule,
+		},
+		{
+			name: "scheduler task with actor",
+			message: TaskMessage{CheckID: "check-1", ExecutionID: "exec-2", Target: "https://example.com", Type: "http",
+				Trigger: domain.TriggerSchedule, TriggeredBy: "scheduler/worker-1"},
+			trigger:     domain.TriggerSchedule,
+			triggeredBy: "scheduler/worker-1",
+		},
+		{
+			name: "redelivered task keeps actor",
+			message: TaskMessage{CheckID: "check-1", ExecutionID: "exec-3", Target: "https://example.com", Type: "http",
+				Trigger: domain.TriggerSchedule, TriggeredBy: "scheduler/worker-1"},
+			redelivered: true,
+			trigger:     domain.TriggerRetry,
+			triggeredBy: "scheduler/worker-1",
+		},
+		{
+			name: "unknown trigger falls back to schedule",
+			message: TaskMessage{CheckID: "check-1", ExecutionID: "exec-4", Target: "https://example.com", Type: "http",
+				Trigger: "cron"},
+			trigger: domain.TriggerSchedule,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &recordingResultRepository{}
+			service := newAttributionService(repo)
+
+			body, err := json.Marshal(tt.message)
+			require.NoError(t, err)
+			if tt.redelivered {
+				require.NoError(t, service.ProcessRedeliveredTask(context.Background(), body))
+			} else {
+				require.NoError(t, service.ProcessTask(context.Background(), body))
+			}
+
+			require.Len(t, repo.saved, 1)
+			assert.Equal(t, tt.trigger, repo.saved[0].Trigger)
+			assert.Equal(t, tt.triggeredBy, repo.saved[0].TriggeredBy)
+		})
+	}
+}
+
+func TestCheckService_ExecuteCheck_SavesManualRun(t *testing.T) {
+	repo := &recordingResultRepository{}
+	service := newAttributionService(repo)
+
+	result, err := service.ExecuteCheck(context.Background(), &domain.Task{
+		CheckID:     "check-1",
+		ExecutionID: "exec-1",
+		Type:        "http",
+		Trigger:     domain.TriggerCLI,
+		TriggeredBy: "  user:user-1  ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.TriggerCLI, result.Trigger)
+	assert.Equal(t, "user:user-1", result.TriggeredBy)
+	require.Len(t, repo.saved, 1)
+	assert.Same(t, result, repo.saved[0])
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// maxUptimeHistoryChecks наибольшее число проверок в одном запросе истории
+const maxUptimeHistoryChecks = 100
+
+// UptimeService отдает дневную историю доступности из предрассчитанных сводок
+type UptimeService struct {
+	repo   repository.UptimeRepository
+	logger logger.Logger
+	now    func() time.Time
+}
+
+// NewUptimeService создает сервис истории доступности
+func NewUptimeService(repo repository.UptimeRepository, log logger.Logger) *UptimeService {
+	return &UptimeService{
+		repo:   repo,
+		logger: log,
+		now:    time.Now,
+	}
+}
+
+// GetUptimeHistory возвращает историю за days суток, заканчивая текущими. Нулевой days
+// означает domain.MaxUptimeHistoryDays; без проверок возвращаются пустые дни
+func (s *UptimeService) GetUptimeHistory(ctx context.Context, checkIDs []string, days int) (*domain.UptimeHistory, error) {
+	if days == 0 {
+		days = domain.MaxUptimeHistoryDays
+	}
+	if days < 1 || days > domain.MaxUptimeHistoryDays {
+		return nil, errors.New(errors.ErrValidation, "invalid days").
+			WithDetails(fmt.Sprintf("days must be between 1 and %d", domain.MaxUptimeHistoryDays))
+	}
+	if len(checkIDs) > maxUptimeHistoryChecks {
+		return nil, errors.New(errors.ErrValidation, "too many checks").
+			WithDetails(fmt.Sprintf("at most %d checks per request", maxUptimeHistoryChecks))
+	}
+	for _, checkID := range checkIDs {
+		if checkID == "" {
+			return nil, errors.New(errors.ErrValidation, "check_id is required")
+		}
+	}
+
+	to := domain.PartitionDay(s.now())
+	from := to.AddDate(0, 0, -(days - 1))
+
+	rows, err := s.repo.GetDailyUptime(ctx, checkIDs, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return domain.BuildUptimeHistory(checkIDs, rows, to, days), nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// staticUptimeRepository возвращает заданные сводки
+type staticUptimeRepository struct {
+	rows     []domain.UptimeDay
+	from, to time.Time
+}
+
+func (r *staticUptimeRepository) RollupDay(ctx context.Context, day time.Time, opts repository.UptimeRollupOptions) (int64, error) {
+	return 0, nil
+}
+
+func (r *staticUptimeRepository) GetDailyUptime(ctx context.Context, checkIDs []string, from, to time.Time) ([]domain.UptimeDay, error) {
+	r.from, r.to = from, to
+	return r.rows, nil
+}
+
+func newTestUptimeService(t *testing.T, repo repository.UptimeRepository, now time.Time) *UptimeService {
+	t.Helper()
+	log, err := logger.NewLogger("test", "debug", "core-service", false)
+	require.NoError(t, err)
+
+	svc := NewUptimeService(repo, log)
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+func TestUptimeService_GetUptimeHistory(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	yesterday := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	repo := &staticUptimeRepository{rows: []domain.UptimeDay{
+		{CheckID: "check-1", Day: yesterday, OperationalSeconds: 86100, DownSeconds: 300, TotalChecks: 1440, FailedChecks: 5, AvgResponseTimeMs: 120},
+	}}
+	svc := newTestUptimeService(t, repo, now)
+
+	history, err := svc.GetUptimeHistory(context.Background(), []string{"check-1"}, 0)
+	require.NoError(t, err)
+	require.Len(t, history.Days, domain.MaxUptimeHistoryDays)
+	assert.Equal(t, time.Date(2026, 7, 19, 0, 0, 0, 0, time.UTC), repo.from)
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), repo.to)
+
+	day := history.Days[len(history.Days)-2]
+	assert.Equal(t, yesterday, day.Day)
+	assert.Equal(t, domain.UptimeDayDown, day.Status(), "five minutes of downtime mark the day as down")
+	assert.InDelta(t, 99.65, day.UptimePercent(), 0.01)
+	assert.Equal(t, domain.UptimeDayNoData, history.Days[0].Status())
+	assert.Equal(t, int64(86400), history.Days[0].NoDataSeconds())
+	assert.InDelta(t, 99.65, history.UptimePercent(), 0.01)
+}
+
+func TestUptimeService_GetUptimeHistory_Component(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	repo := &staticUptimeRepository{rows: []domain.UptimeDay{
+		{CheckID: "check-1", Day: day, OperationalSeconds: 50000, DegradedSeconds: 4000, TotalChecks: 900, AvgResponseTimeMs: 100},
+		{CheckID: "check-2", Day: day, OperationalSeconds: 53880, DownSeconds: 120, TotalChecks: 100, FailedChecks: 2, AvgResponseTimeMs: 1000},
+	}}
+	svc := newTestUptimeService(t, repo, now)
+
+	history, err := svc.GetUptimeHistory(context.Background(), []string{"check-1", "check-2"}, 7)
+	require.NoError(t, err)
+	require.Len(t, history.Days, 7)
+
+	merged := history.Days[6]
+	assert.Equal(t, int64(51940), merged.OperationalSeconds, "durations are averaged across checks")
+	assert.Equal(t, int64(2000), merged.DegradedSeconds)
+	assert.Equal(t, int64(60), merged.DownSeconds)
+	assert.Equal(t, 1000, merged.TotalChecks)
+	assert.Equal(t, 2, merged.FailedChecks)
+	assert.Equal(t, 190, merged.AvgResponseTimeMs)
+	assert.Equal(t, domain.UptimeDayDegraded, merged.Status())
+}
+
+func TestUptimeService_GetUptimeHistory_Validation(t *testing.T) {
+	svc := newTestUptimeService(t, &staticUptimeRepository{}, time.Now())
+
+	_, err := svc.GetUptimeHistory(context.Background(), []string{"check-1"}, 91)
+	var appErr *errors.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, errors.ErrValidation, appErr.Code)
+
+	_, err = svc.GetUptimeHistory(context.Background(), []string{""}, 30)
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, errors.ErrValidation, appErr.Code)
+
+	history, err := svc.GetUptimeHistory(context.Background(), nil, 30)
+	require.NoError(t, err)
+	assert.Len(t, history.Days, 30)
+	assert.Zero(t, history.UptimePercent())
+}
@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// artifactCleanupBatch число артефактов, удаляемых за одну выборку
+const artifactCleanupBatch = 500
+
+// ArtifactCleanup удаляет артефакты с истекшим сроком хранения: сначала объект
+// в хранилище, затем метаданные. Объект, который не удалось удалить, остается
+// в check_artifacts до следующего прохода
+type ArtifactCleanup struct {
+	repo   repository.ArtifactRepository
+	store  repository.ArtifactStore
+	logger logger.Logger
+	now    func() time.Time
+}
+
+// NewArtifactCleanup создает очистку артефактов
+func NewArtifactCleanup(repo repository.ArtifactRepository, store repository.ArtifactStore, log logger.Logger) *ArtifactCleanup {
+	return &ArtifactCleanup{
+		repo:   repo,
+		store:  store,
+		logger: log,
+		now:    time.Now,
+	}
+}
+
+// Run удаляет истекшие артефакты с заданным интервалом до отмены контекста
+func (c *ArtifactCleanup) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.Cleanup(ctx); err != nil {
+			c.logger.Error("Check artifact cleanup failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Cleanup удаляет все артефакты, истекшие к началу прохода, и возвращает их число.
+// Проход завершается, если в выборке не удалось удалить ни одного объекта
+func (c *ArtifactCleanup) Cleanup(ctx context.Context) (int, error) {
+	before := c.now().UTC()
+	deleted := 0
+	var lastErr error
+
+	for {
+		expired, err := c.repo.ListExpired(ctx, before, artifactCleanupBatch)
+		if err != nil {
+			return deleted, err
+		}
+
+		keys := make([]string, 0, len(expired))
+		for _, artifact := range expired {
+			if err := c.store.Delete(ctx, artifact.Key); err != nil {
+				lastErr = err
+				continue
+			}
+			keys = append(keys, artifact.Key)
+		}
+		if err := c.repo.Delete(ctx, keys); err != nil {
+			return deleted, err
+		}
+		deleted += len(keys)
+
+		if len(expired) < artifactCleanupBatch || len(keys) == 0 {
+			break
+		}
+	}
+
+	if deleted > 0 {
+		c.logger.Info("Expired check artifacts deleted", logger.Int("deleted", deleted))
+	}
+	if lastErr != nil {
+		return deleted, errors.Wrap(lastErr, errors.ErrInternal, "check artifact cleanup incomplete")
+	}
+	return deleted, nil
+}
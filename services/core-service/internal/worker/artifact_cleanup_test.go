@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// expiringArtifactRepository отдает истекшие артефакты и запоминает удаленные ключи
+type expiringArtifactRepository struct {
+	artifacts []domain.Artifact
+	before    time.Time
+	deleted   []string
+}
+
+func (r *expiringArtifactRepository) Save(ctx context.Context, artifacts []domain.Artifact) error {
+	return nil
+}
+
+func (r *expiringArtifactRepository) ListByCheck(ctx context.Context, checkID, executionID string, limit int) ([]domain.Artifact, error) {
+	return nil, nil
+}
+
+func (r *expiringArtifactRepository) Get(ctx context.Context, checkID, key string) (*domain.Artifact, error) {
+	return nil, nil
+}
+
+func (r *expiringArtifactRepository) ListExpired(ctx context.Context, before time.Time, limit int) ([]domain.Artifact, error) {
+	r.before = before
+	var expired []domain.Artifact
+	for _, artifact := range r.artifacts {
+		if artifact.Expired(before) && len(expired) < limit {
+			expired = append(expired, artifact)
+		}
+	}
+	return expired, nil
+}
+
+func (r *expiringArtifactRepository) Delete(ctx context.Context, keys []string) error {
+	r.deleted = append(r.deleted, keys...)
+	remaining := r.artifacts[:0]
+	for _, artifact := range r.artifacts {
+		if !containsKey(keys, artifact.Key) {
+			remaining = append(remaining, artifact)
+		}
+	}
+	r.artifacts = remaining
+	return nil
+}
+
+// failingArtifactStore не удаляет объекты с ключами из fail
+type failingArtifactStore struct {
+	fail map[string]bool
+}
+
+func (s *failingArtifactStore) Put(ctx context.Context, key, contentType string, data []byte) error {
+	return nil
+}
+
+func (s *failingArtifactStore) Delete(ctx context.Context, key string) error {
+	if s.fail[key] {
+		return errors.New("access denied")
+	}
+	return nil
+}
+
+func (s *failingArtifactStore) SignedURL(key string, ttl time.Duration) (string, error) {
+	return "", nil
+}
+
+func containsKey(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestArtifactCleanup_Cleanup(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	repo := &expiringArtifactRepository{artifacts: []domain.Artifact{
+		{Key: "old", ExpiresAt: now.Add(-time.Hour)},
+		{Key: "locked", ExpiresAt: now.Add(-time.Minute)},
+		{Key: "fresh", ExpiresAt: now.Add(time.Hour)},
+	}}
+	store := &failingArtifactStore{fail: map[string]bool{"locked": true}}
+
+	log, err := logger.NewLogger("test", "debug", "core-service", false)
+	require.NoError(t, err)
+	cleanup := NewArtifactCleanup(repo, store, log)
+	cleanup.now = func() time.Time { return now }
+
+	deleted, err := cleanup.Cleanup(context.Background())
+	assert.Error(t, err, "failed object deletion is reported")
+	assert.Equal(t, 1, deleted)
+	assert.Equal(t, []string{"old"}, repo.deleted)
+	assert.Equal(t, now, repo.before)
+
+	// Объект, который не удалось удалить, остается до следующего прохода
+	store.fail = nil
+	deleted, err = cleanup.Cleanup(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.Equal(t, []string{"old", "locked"}, repo.deleted)
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// CapturePurger удаляет диагностические снимки неудачных проверок старше retention
+type CapturePurger interface {
+	PurgeExpiredCaptures(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// CapturePurge периодически удаляет снимки неудачных проверок, вышедшие за срок хранения.
+// Снимки занимают большую часть строки результата, а сами результаты хранятся до удаления партиции
+type CapturePurge struct {
+	purger    CapturePurger
+	retention time.Duration
+	logger    logger.Logger
+}
+
+// NewCapturePurge создает очистку снимков с заданным сроком хранения
+func NewCapturePurge(purger CapturePurger, retention time.Duration, log logger.Logger) *CapturePurge {
+	return &CapturePurge{
+		purger:    purger,
+		retention: retention,
+		logger:    log,
+	}
+}
+
+// Run удаляет истекшие снимки с заданным интервалом до отмены контекста
+func (p *CapturePurge) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.purger.PurgeExpiredCaptures(ctx, p.retention); err != nil {
+			p.logger.Error("Failure capture purge failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// recordingPurger запоминает сроки хранения вызовов очистки
+type recordingPurger struct {
+	mu         sync.Mutex
+	retentions []time.Duration
+	err        error
+}
+
+func (p *recordingPurger) PurgeExpiredCaptures(ctx context.Context, retention time.Duration) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.retentions = append(p.retentions, retention)
+	return 3, p.err
+}
+
+func (p *recordingPurger) calls() []time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]time.Duration(nil), p.retentions...)
+}
+
+func TestCapturePurge_Run(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	// Ошибка прохода не останавливает очистку
+	purger := &recordingPurger{err: errors.New("statement timeout")}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		NewCapturePurge(purger, 48*time.Hour, log).Run(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return len(purger.calls()) >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after context cancellation")
+	}
+
+	for _, retention := range purger.calls() {
+		assert.Equal(t, 48*time.Hour, retention)
+	}
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// DefaultConsensusSweepInterval интервал поиска выполнений с истекшим окном консенсуса
+const DefaultConsensusSweepInterval = 10 * time.Second
+
+// ConsensusSweeper принимает решения по выполнениям, регионы которых не прислали результат за окно
+type ConsensusSweeper interface {
+	SweepConsensus(ctx context.Context) (int, error)
+}
+
+// ConsensusSweep периодически принимает решения по выполнениям из нескольких регионов, окно
+// которых истекло: без нее молчащий регион оставляет выполнение без результата навсегда
+type ConsensusSweep struct {
+	sweeper ConsensusSweeper
+	logger  logger.Logger
+}
+
+// NewConsensusSweep создает поиск выполнений с истекшим окном консенсуса
+func NewConsensusSweep(sweeper ConsensusSweeper, log logger.Logger) *ConsensusSweep {
+	return &ConsensusSweep{sweeper: sweeper, logger: log}
+}
+
+// Run ищет выполнения с истекшим окном с заданным интервалом до отмены контекста
+func (s *ConsensusSweep) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if decided, err := s.sweeper.SweepConsensus(ctx); err != nil {
+			s.logger.Error("Consensus sweep failed", logger.Error(err))
+		} else if decided > 0 {
+			s.logger.Info("Consensus decided after window", logger.Int("executions", decided))
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+)
+
+// countingSweeper считает проходы поиска
+type countingSweeper struct {
+	calls atomic.Int32
+}
+
+func (s *countingSweeper) SweepConsensus(ctx context.Context) (int, error) {
+	if s.calls.Add(1) == 1 {
+		return 0, errors.New("redis unavailable")
+	}
+	return 1, nil
+}
+
+func TestConsensusSweep_Run(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	sweeper := &countingSweeper{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		NewConsensusSweep(sweeper, log).Run(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+
+	// Ошибка прохода не останавливает поиск
+	require.Eventually(t, func() bool { return sweeper.calls.Load() >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop after context cancellation")
+	}
+}
@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// DefaultPartitionsAhead число дневных партиций check_results, создаваемых заранее
+const DefaultPartitionsAhead = 7
+
+// PartitionReport итог одного прохода обслуживания партиций
+type PartitionReport struct {
+	Created []string
+	Dropped []string
+}
+
+// PartitionMaintainer создает дневные партиции check_results заранее и удаляет
+// партиции, вышедшие за срок хранения, вместо удаления старых строк через DELETE
+type PartitionMaintainer struct {
+	repo      repository.ResultPartitionRepository
+	retention time.Duration
+	ahead     int
+	logger    logger.Logger
+	now       func() time.Time
+}
+
+// NewPartitionMaintainer создает обслуживание партиций. Нулевой retention отключает
+// удаление партиций, ahead <= 0 означает DefaultPartitionsAhead
+func NewPartitionMaintainer(repo repository.ResultPartitionRepository, retention time.Duration, ahead int, log logger.Logger) *PartitionMaintainer {
+	if ahead <= 0 {
+		ahead = DefaultPartitionsAhead
+	}
+	return &PartitionMaintainer{
+		repo:      repo,
+		retention: retention,
+		ahead:     ahead,
+		logger:    log,
+		now:       time.Now,
+	}
+}
+
+// Run обслуживает партиции с заданным интервалом до отмены контекста
+func (m *PartitionMaintainer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.Maintain(ctx); err != nil {
+			m.logger.Error("Check result partition maintenance failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Maintain создает недостающие партиции на сегодня и ahead дней вперед и удаляет
+// партиции, все строки которых старше срока хранения. Ошибка одной партиции не
+// прерывает проход, возвращается последняя из них
+func (m *PartitionMaintainer) Maintain(ctx context.Context) (*PartitionReport, error) {
+	partitions, err := m.repo.ListPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PartitionReport{}
+	var lastErr error
+
+	today := domain.PartitionDay(m.now())
+	for i := 0; i <= m.ahead; i++ {
+		day := today.AddDate(0, 0, i)
+		if coveredBy(partitions, day) {
+			continue
+		}
+
+		partition := domain.NewDailyResultPartition(day)
+		if err := m.repo.CreatePartition(ctx, partition); err != nil {
+			lastErr = err
+			continue
+		}
+		partitions = append(partitions, partition)
+		report.Created = append(report.Created, partition.Name)
+	}
+
+	if m.retention > 0 {
+		cutoff := m.now().UTC().Add(-m.retention)
+		for _, partition := range partitions {
+			if !partition.ExpiredBefore(cutoff) {
+				continue
+			}
+			if err := m.repo.DropPartition(ctx, partition.Name); err != nil {
+				lastErr = err
+				continue
+			}
+			report.Dropped = append(report.Dropped, partition.Name)
+		}
+	}
+
+	if len(report.Created) > 0 || len(report.Dropped) > 0 {
+		m.logger.Info("Check result partitions maintained",
+			logger.Int("created", len(report.Created)),
+			logger.Int("dropped", len(report.Dropped)),
+		)
+	}
+
+	if lastErr != nil {
+		return report, errors.Wrap(lastErr, errors.ErrInternal, "check result partition maintenance incomplete")
+	}
+	return report, nil
+}
+
+// coveredBy сообщает, покрыто ли начало суток day одной из партиций
+func coveredBy(partitions []domain.ResultPartition, day time.Time) bool {
+	for _, partition := range partitions {
+		if partition.Contains(day) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// memoryPartitions хранилище партиций в памяти
+type memoryPartitions struct {
+	partitions []domain.ResultPartition
+	failCreate string
+}
+
+func (m *memoryPartitions) ListPartitions(ctx context.Context) ([]domain.ResultPartition, error) {
+	return append([]domain.ResultPartition(nil), m.partitions...), nil
+}
+
+func (m *memoryPartitions) CreatePartition(ctx context.Context, partition domain.ResultPartition) error {
+	if partition.Name == m.failCreate {
+		return errors.New("default partition contains rows")
+	}
+	m.partitions = append(m.partitions, partition)
+	return nil
+}
+
+func (m *memoryPartitions) DropPartition(ctx context.Context, name string) error {
+	for i, partition := range m.partitions {
+		if partition.Name == name {
+			m.partitions = append(m.partitions[:i], m.partitions[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func newTestPartitionMaintainer(t *testing.T, repo *memoryPartitions, retention time.Duration, ahead int, now time.Time) *PartitionMaintainer {
+	t.Helper()
+	log, err := logger.NewLogger("test", "debug", "core-service", false)
+	require.NoError(t, err)
+
+	maintainer := NewPartitionMaintainer(repo, retention, ahead, log)
+	maintainer.now = func() time.Time { return now }
+	return maintainer
+}
+
+func TestPartitionMaintainer_Maintain(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	repo := &memoryPartitions{partitions: []domain.ResultPartition{
+		{Name: "check_results_legacy", To: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		domain.NewDailyResultPartition(time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)),
+		domain.NewDailyResultPartition(now),
+		{Name: "check_results_default", Default: true},
+	}}
+	maintainer := newTestPartitionMaintainer(t, repo, 7*24*time.Hour, 2, now)
+
+	report, err := maintainer.Maintain(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"check_results_p20261017", "check_results_p20261018"}, report.Created)
+	assert.Equal(t, []string{"check_results_legacy"}, report.Dropped, "legacy partition ages out by its upper bound")
+
+	// Повторный проход ничего не меняет
+	report, err = maintainer.Maintain(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, report.Created)
+	assert.Empty(t, report.Dropped)
+
+	// Через неделю дневная партиция 15 октября выходит за срок хранения
+	maintainer.now = func() time.Time { return now.AddDate(0, 0, 7) }
+	report, err = maintainer.Maintain(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"check_results_p20261015"}, report.Dropped)
+	assert.Len(t, report.Created, 3)
+}
+
+func TestPartitionMaintainer_ContinuesAfterFailure(t *testing.T) {
+	now := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	repo := &memoryPartitions{failCreate: "check_results_p20261016"}
+	maintainer := newTestPartitionMaintainer(t, repo, 0, 1, now)
+
+	report, err := maintainer.Maintain(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, []string{"check_results_p20261017"}, report.Created)
+}
+
+func TestResultPartition_Bounds(t *testing.T) {
+	day := time.Date(2026, 10, 16, 23, 59, 0, 0, time.FixedZone("UTC-3", -3*60*60))
+	partition := domain.NewDailyResultPartition(day)
+	assert.Equal(t, "check_results_p20261017", partition.Name, "partitions are aligned to UTC days")
+	assert.True(t, partition.Contains(day))
+	assert.False(t, partition.Contains(partition.To))
+
+	assert.False(t, partition.ExpiredBefore(partition.To.Add(-time.Second)))
+	assert.True(t, partition.ExpiredBefore(partition.To))
+	assert.False(t, domain.ResultPartition{Default: true}.ExpiredBefore(day))
+	assert.False(t, domain.ResultPartition{From: day}.ExpiredBefore(day.AddDate(1, 0, 0)), "unbounded partition never expires")
+}
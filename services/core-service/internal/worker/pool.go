@@ -45,6 +45,7 @@ type TaskResult struct {
 	ResponseBody string            `json:"response_body,omitempty"`
 	CheckedAt    time.Time         `json:"checked_at"`
 	Metadata     map[string]string `json:"metadata,omitempty"`
+	Timings      *domain.CheckTimings `json:"timings,omitempty"`
 	RetryCount   int               `json:"retry_count"`
 	ShouldRetry  bool              `json:"should_retry"`
 }
@@ -105,9 +106,12 @@ func DefaultConfig() *Config {
 		Timeouts: map[domain.TaskType]time.Duration{
 			domain.TaskTypeHTTP:    30 * time.Second,
 			domain.TaskTypeTCP:     10 * time.Second,
-			domain.TaskTypeICMP:    5 * time.Second,
+			domain.TaskTypeICMP:    30 * time.Second,
+			domain.TaskTypePing:    30 * time.Second,
 			domain.TaskTypeGRPC:    15 * time.Second,
 			domain.TaskTypeGraphQL: 30 * time.Second,
+			domain.TaskTypeDomain:  30 * time.Second,
+			domain.TaskTypeDNS:     10 * time.Second,
 		},
 		RetryConfig: RetryConfig{
 			MaxRetries:      3,
@@ -172,6 +176,7 @@ type Pool struct {
 	resultChan chan *TaskResult
 	quit       chan bool
 	wg         sync.WaitGroup
+	workersWg  sync.WaitGroup
 	logger     *logging.UptimeLogger
 	metrics    *metrics.UptimeMetrics
 	checkers   map[domain.TaskType]checker.Checker
@@ -248,8 +253,8 @@ func (p *Pool) Start(ctx context.Context) error {
 	
 	// Запускаем рабочих
 	for _, worker := range p.workers {
-		p.wg.Add(1)
-		go worker.start(&p.wg)
+		p.workersWg.Add(1)
+		go worker.start(&p.workersWg)
 		atomic.AddInt64(&p.stats.ActiveWorkers, 1)
 	}
 	
@@ -264,46 +269,72 @@ func (p *Pool) Start(ctx context.Context) error {
 	return nil
 }
 
-// Stop останавливает пул рабочих с graceful shutdown
+// Stop останавливает пул рабочих с graceful shutdown: начатые задачи завершаются,
+// буферизованные результаты обрабатываются до истечения ShutdownTimeout. Не начатые
+// задачи остаются в очереди пула и доступны через PendingTasks
 func (p *Pool) Stop(ctx context.Context) error {
 	if !atomic.CompareAndSwapInt32(&p.shutdownInProgress, 0, 1) {
 		return nil // Уже останавливается
 	}
-	
+	defer close(p.shutdownComplete)
+
 	p.logger.GetBaseLogger().Info("Starting graceful shutdown of worker pool")
-	
+
 	// Создаем контекст с таймаутом
 	shutdownCtx, cancel := context.WithTimeout(ctx, p.config.ShutdownTimeout)
 	defer cancel()
-	
-	// Останавливаем прием новых задач
-	close(p.taskChan)
-	
-	// Останавливаем рабочих
+
+	// Рабочие завершают текущую задачу и не берут новые; канал задач не закрывается,
+	// чтобы не начатые задачи можно было забрать через PendingTasks
+	close(p.quit)
 	for _, worker := range p.workers {
 		close(worker.quit)
 	}
-	
-	// Ждем завершения всех рабочих или таймаута
+
+	if !waitGroupWithContext(shutdownCtx, &p.workersWg) {
+		p.logger.GetBaseLogger().Warn("Shutdown timeout reached, in-flight tasks abandoned")
+		return shutdownCtx.Err()
+	}
+
+	// Рабочие больше не пишут результаты: обработчик дочитывает буфер и завершается
+	close(p.resultChan)
+	if !waitGroupWithContext(shutdownCtx, &p.wg) {
+		p.logger.GetBaseLogger().Warn("Shutdown timeout reached before buffered results were flushed")
+		return shutdownCtx.Err()
+	}
+
+	p.logger.GetBaseLogger().Info("All workers stopped gracefully",
+		logger.Int("pending_tasks", len(p.taskChan)))
+	return nil
+}
+
+// PendingTasks забирает не начатые задачи из очереди пула после Stop для повторной постановки
+func (p *Pool) PendingTasks() []*Task {
+	var tasks []*Task
+	for {
+		select {
+		case task := <-p.taskChan:
+			tasks = append(tasks, task)
+		default:
+			return tasks
+		}
+	}
+}
+
+// waitGroupWithContext ждет wg до отмены ctx и сообщает, дождался ли
+func waitGroupWithContext(ctx context.Context, wg *sync.WaitGroup) bool {
 	done := make(chan struct{})
 	go func() {
-		p.wg.Wait()
+		wg.Wait()
 		close(done)
 	}()
-	
+
 	select {
 	case <-done:
-		p.logger.GetBaseLogger().Info("All workers stopped gracefully")
-	case <-shutdownCtx.Done():
-		p.logger.GetBaseLogger().Warn("Shutdown timeout reached, forcing stop")
+		return true
+	case <-ctx.Done():
+		return false
 	}
-	
-	// Закрываем канал результатов
-	close(p.resultChan)
-	
-	close(p.shutdownComplete)
-	
-	return nil
 }
 
 // SubmitTask отправляет задачу в пул
@@ -377,6 +408,15 @@ func (w *Worker) start(wg *sync.WaitGroup) {
 		logger.Int("worker_id", w.id))
 	
 	for {
+		// Остановка важнее очереди: после quit новые задачи не начинаются
+		select {
+		case <-w.quit:
+			w.logger.GetBaseLogger().Info("Worker stopping",
+				logger.Int("worker_id", w.id))
+			return
+		default:
+		}
+
 		select {
 		case task := <-w.taskChan:
 			if task != nil {
@@ -434,6 +474,7 @@ func (w *Worker) processTask(task *Task) {
 			result.StatusCode = checkResult.StatusCode
 			result.ResponseBody = checkResult.ResponseBody
 			result.Metadata = checkResult.Metadata
+			result.Timings = checkResult.Timings
 			
 			if !checkResult.Success {
 				result.Error = checkResult.Error
@@ -471,9 +512,12 @@ func (w *Worker) getTimeout(taskType domain.TaskType) time.Duration {
 	defaultTimeouts := map[domain.TaskType]time.Duration{
 		domain.TaskTypeHTTP:    30 * time.Second,
 		domain.TaskTypeTCP:     10 * time.Second,
-		domain.TaskTypeICMP:    5 * time.Second,
+		domain.TaskTypeICMP:    30 * time.Second,
+		domain.TaskTypePing:    30 * time.Second,
 		domain.TaskTypeGRPC:    15 * time.Second,
 		domain.TaskTypeGraphQL: 30 * time.Second,
+		domain.TaskTypeDomain:  30 * time.Second,
+		domain.TaskTypeDNS:     10 * time.Second,
 	}
 	
 	if timeout, exists := defaultTimeouts[taskType]; exists {
@@ -520,6 +564,16 @@ func (w *Worker) updateMetrics(task *Task, result *TaskResult, duration time.Dur
 	// Обновляем метрики uptime проверок
 	w.metrics.RecordCheckResult(string(task.Type), task.Target, duration, result.Success, 
 		int64(len(result.ResponseBody)), result.Error)
+	
+	// Длительности фаз позволяют привязать рост латентности к конкретной фазе
+	if result.Timings != nil {
+		w.metrics.RecordCheckPhases(string(task.Type), result.Timings.Phases())
+	}
+	
+	// Предупреждения политик выделяют дрейф настроек безопасности у доступных целей
+	if codes := checker.WarningCodes(result.Metadata); len(codes) > 0 {
+		w.metrics.RecordCheckWarnings(string(task.Type), task.Target, codes)
+	}
 }
 
 // handleResults обрабатывает результаты задач
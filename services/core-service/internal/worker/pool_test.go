@@ -0,0 +1,68 @@
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/logging"
+	"UptimePingPlatform/services/core-service/internal/metrics"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
+)
+
+// blockingChecker успешная проверка, ожидающая release
+type blockingChecker struct {
+	started  chan struct{}
+	release  chan struct{}
+	executed atomic.Int64
+}
+
+func (c *blockingChecker) Execute(task *domain.Task) (*domain.CheckResult, error) {
+	c.executed.Add(1)
+	c.started <- struct{}{}
+	<-c.release
+	return &domain.CheckResult{Success: true}, nil
+}
+
+func (c *blockingChecker) GetType() domain.TaskType { return domain.TaskTypeHTTP }
+
+func (c *blockingChecker) ValidateConfig(config map[string]interface{}) error { return nil }
+
+func TestPool_StopFinishesInFlightAndFlushesResults(t *testing.T) {
+	log, err := logger.NewLogger("test", "debug", "core-service", false)
+	require.NoError(t, err)
+
+	config := DefaultConfig()
+	config.WorkerCount = 1
+	config.QueueSize = 10
+	config.ShutdownTimeout = 5 * time.Second
+	blocking := &blockingChecker{started: make(chan struct{}, 1), release: make(chan struct{})}
+
+	pool, err := NewPool(config, logging.NewUptimeLogger(log), metrics.NewUptimeMetrics("pool_test"),
+		map[domain.TaskType]checker.Checker{domain.TaskTypeHTTP: blocking})
+	require.NoError(t, err)
+	require.NoError(t, pool.Start(context.Background()))
+
+	for _, id := range []string{"task-1", "task-2", "task-3"} {
+		require.NoError(t, pool.SubmitTask(context.Background(), &Task{ID: id, CheckID: "check-1", Type: domain.TaskTypeHTTP}))
+	}
+	<-blocking.started
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- pool.Stop(context.Background()) }()
+	assert.Eventually(t, pool.IsShutdownInProgress, time.Second, time.Millisecond)
+	assert.Error(t, pool.SubmitTask(context.Background(), &Task{ID: "task-4"}), "stopping pool rejects new tasks")
+
+	close(blocking.release)
+	require.NoError(t, <-stopped)
+
+	assert.Equal(t, int64(1), blocking.executed.Load(), "no new tasks are started after stop")
+	assert.Equal(t, int64(1), pool.GetStats().TasksCompleted, "buffered result is flushed")
+	assert.Len(t, pool.PendingTasks(), 2)
+}
@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// DefaultBackfillBatchSize число строк, переводимых на новую версию схемы одним запросом
+const DefaultBackfillBatchSize = 1000
+
+// BackfillReport итог backfill'а версий схемы результатов
+type BackfillReport struct {
+	// Pending число строк по версиям схемы до backfill'а
+	Pending map[int]int64
+	// Upgraded число строк, переведенных с версии на следующую
+	Upgraded map[int]int64
+	// Newer строки версий новее текущей: записаны более новой версией сервиса и не изменяются
+	Newer int64
+}
+
+// ResultBackfill переводит исторические строки check_results на текущую версию схемы
+// пакетами: каждая версия переводится на следующую, пока строки этой версии не кончатся.
+// Прерванный backfill продолжается с того же места при повторном запуске
+type ResultBackfill struct {
+	repo      repository.ResultSchemaRepository
+	batchSize int
+	pause     time.Duration
+	logger    logger.Logger
+}
+
+// NewResultBackfill создает backfill. batchSize <= 0 означает DefaultBackfillBatchSize, pause -
+// пауза между пакетами, снижающая нагрузку на базу
+func NewResultBackfill(repo repository.ResultSchemaRepository, batchSize int, pause time.Duration, log logger.Logger) *ResultBackfill {
+	if batchSize <= 0 {
+		batchSize = DefaultBackfillBatchSize
+	}
+	return &ResultBackfill{
+		repo:      repo,
+		batchSize: batchSize,
+		pause:     pause,
+		logger:    log,
+	}
+}
+
+// Run выполняет backfill; при dryRun только считает строки по версиям
+func (b *ResultBackfill) Run(ctx context.Context, dryRun bool) (*BackfillReport, error) {
+	pending, err := b.repo.CountByVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report := &BackfillReport{Pending: pending, Upgraded: make(map[int]int64)}
+	for version, count := range pending {
+		if version > domain.ResultSchemaVersion {
+			report.Newer += count
+		}
+	}
+	if report.Newer > 0 {
+		b.logger.Warn("Check results with newer schema versions are left unchanged",
+			logger.Int64("rows", report.Newer),
+			logger.Int("current_version", domain.ResultSchemaVersion),
+		)
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	for from := domain.ResultSchemaBase; from < domain.ResultSchemaVersion; from++ {
+		for {
+			upgraded, err := b.repo.UpgradeBatch(ctx, from, b.batchSize)
+			if err != nil {
+				return report, err
+			}
+			if upgraded == 0 {
+				break
+			}
+			report.Upgraded[from] += upgraded
+			b.logger.Info("Check result schema batch upgraded",
+				logger.Int("from_version", from),
+				logger.Int64("rows", upgraded),
+				logger.Int64("total", report.Upgraded[from]),
+			)
+
+			if b.pause > 0 {
+				select {
+				case <-ctx.Done():
+					return report, ctx.Err()
+				case <-time.After(b.pause):
+				}
+			} else if err := ctx.Err(); err != nil {
+				return report, err
+			}
+		}
+	}
+	return report, nil
+}
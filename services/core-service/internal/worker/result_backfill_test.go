@@ -0,0 +1,107 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+)
+
+// memorySchemaVersions строки check_results по версиям схемы в памяти
+type memorySchemaVersions struct {
+	rows      map[int]int64
+	batches   []int
+	failAfter int
+}
+
+func (m *memorySchemaVersions) CountByVersion(ctx context.Context) (map[int]int64, error) {
+	counts := make(map[int]int64, len(m.rows))
+	for version, count := range m.rows {
+		counts[version] = count
+	}
+	return counts, nil
+}
+
+func (m *memorySchemaVersions) UpgradeBatch(ctx context.Context, from, limit int) (int64, error) {
+	if m.failAfter > 0 && len(m.batches) == m.failAfter {
+		return 0, errors.New("connection reset")
+	}
+	upgraded := m.rows[from]
+	if upgraded > int64(limit) {
+		upgraded = int64(limit)
+	}
+	if upgraded == 0 {
+		return 0, nil
+	}
+	m.batches = append(m.batches, from)
+	m.rows[from] -= upgraded
+	m.rows[from+1] += upgraded
+	return upgraded, nil
+}
+
+func newTestBackfill(t *testing.T, repo *memorySchemaVersions, batchSize int) *ResultBackfill {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	return NewResultBackfill(repo, batchSize, 0, log)
+}
+
+func TestResultBackfill_Run(t *testing.T) {
+	repo := &memorySchemaVersions{rows: map[int]int64{
+		domain.ResultSchemaBase:        25,
+		domain.ResultSchemaCapture:     5,
+		domain.ResultSchemaVersion:     100,
+		domain.ResultSchemaVersion + 1: 2,
+	}}
+	backfill := newTestBackfill(t, repo, 10)
+
+	report, err := backfill.Run(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(25), report.Pending[domain.ResultSchemaBase])
+	assert.Equal(t, int64(2), report.Newer)
+	assert.Equal(t, map[int]int64{
+		domain.ResultSchemaBase:    25,
+		domain.ResultSchemaTimings: 25,
+		domain.ResultSchemaCapture: 30,
+	}, report.Upgraded)
+
+	// Все строки старых версий переведены на текущую, строки новых версий не тронуты
+	assert.Equal(t, map[int]int64{
+		domain.ResultSchemaBase:        0,
+		domain.ResultSchemaTimings:     0,
+		domain.ResultSchemaCapture:     0,
+		domain.ResultSchemaVersion:     130,
+		domain.ResultSchemaVersion + 1: 2,
+	}, repo.rows)
+	// Пакеты не больше batchSize: 3 + 3 + 3
+	assert.Len(t, repo.batches, 9)
+}
+
+func TestResultBackfill_DryRun(t *testing.T) {
+	repo := &memorySchemaVersions{rows: map[int]int64{domain.ResultSchemaBase: 3}}
+	report, err := newTestBackfill(t, repo, 0).Run(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), report.Pending[domain.ResultSchemaBase])
+	assert.Empty(t, report.Upgraded)
+	assert.Empty(t, repo.batches)
+}
+
+func TestResultBackfill_ResumesAfterError(t *testing.T) {
+	repo := &memorySchemaVersions{rows: map[int]int64{domain.ResultSchemaBase: 30}, failAfter: 2}
+	backfill := newTestBackfill(t, repo, 10)
+
+	report, err := backfill.Run(context.Background(), false)
+	require.Error(t, err)
+	assert.Equal(t, int64(20), report.Upgraded[domain.ResultSchemaBase])
+
+	// Повторный запуск продолжает с оставшихся строк
+	repo.failAfter = 0
+	_, err = backfill.Run(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), repo.rows[domain.ResultSchemaVersion])
+}
@@ -0,0 +1,105 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// DefaultUptimeSampleGap наибольшее время, которое покрывает один результат проверки
+// в дневных сводках; дольше без результатов время считается no_data
+const DefaultUptimeSampleGap = 15 * time.Minute
+
+// UptimeRollupReport итог одного прохода агрегации
+type UptimeRollupReport struct {
+	Days    int
+	Updated int64
+}
+
+// UptimeRollup пересчитывает дневные сводки доступности проверок, из которых
+// строится 90-дневная история. Первый успешный проход заполняет всю историю,
+// следующие пересчитывают текущие и предыдущие сутки
+type UptimeRollup struct {
+	repo       repository.UptimeRepository
+	options    repository.UptimeRollupOptions
+	logger     logger.Logger
+	now        func() time.Time
+	backfilled bool
+}
+
+// NewUptimeRollup создает агрегацию сводок доступности. Нулевой degradedResponseTime
+// отключает категорию degraded
+func NewUptimeRollup(repo repository.UptimeRepository, degradedResponseTime time.Duration, log logger.Logger) *UptimeRollup {
+	return &UptimeRollup{
+		repo: repo,
+		options: repository.UptimeRollupOptions{
+			DegradedResponseTime: degradedResponseTime,
+			MaxSampleGap:         DefaultUptimeSampleGap,
+		},
+		logger: log,
+		now:    time.Now,
+	}
+}
+
+// Run пересчитывает сводки с заданным интервалом до отмены контекста
+func (u *UptimeRollup) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := u.Rollup(ctx); err != nil {
+			u.logger.Error("Daily uptime rollup failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rollup пересчитывает сводки за нужные сутки, от старых к новым. Ошибка одних суток
+// не прерывает проход, возвращается последняя из них; до первого полного прохода
+// история заполняется заново
+func (u *UptimeRollup) Rollup(ctx context.Context) (*UptimeRollupReport, error) {
+	days := 2
+	if !u.backfilled {
+		days = domain.MaxUptimeHistoryDays
+	}
+
+	report := &UptimeRollupReport{}
+	var lastErr error
+
+	today := domain.PartitionDay(u.now())
+	for i := days - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		updated, err := u.repo.RollupDay(ctx, today.AddDate(0, 0, -i), u.options)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		report.Days++
+		report.Updated += updated
+	}
+
+	if lastErr != nil {
+		return report, errors.Wrap(lastErr, errors.ErrInternal, "daily uptime rollup incomplete")
+	}
+
+	if !u.backfilled {
+		u.backfilled = true
+		u.logger.Info("Daily uptime history backfilled",
+			logger.Int("days", report.Days),
+			logger.Int64("updated", report.Updated),
+		)
+	}
+	return report, nil
+}
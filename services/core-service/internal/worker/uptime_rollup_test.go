@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository"
+)
+
+// recordingUptimeRepository запоминает пересчитанные сутки
+type recordingUptimeRepository struct {
+	days    []time.Time
+	options repository.UptimeRollupOptions
+	failDay time.Time
+}
+
+func (r *recordingUptimeRepository) RollupDay(ctx context.Context, day time.Time, opts repository.UptimeRollupOptions) (int64, error) {
+	if day.Equal(r.failDay) {
+		return 0, errors.New("statement timeout")
+	}
+	r.days = append(r.days, day)
+	r.options = opts
+	return 3, nil
+}
+
+func (r *recordingUptimeRepository) GetDailyUptime(ctx context.Context, checkIDs []string, from, to time.Time) ([]domain.UptimeDay, error) {
+	return nil, nil
+}
+
+func newTestUptimeRollup(t *testing.T, repo *recordingUptimeRepository, now time.Time) *UptimeRollup {
+	t.Helper()
+	log, err := logger.NewLogger("test", "debug", "core-service", false)
+	require.NoError(t, err)
+
+	rollup := NewUptimeRollup(repo, 2*time.Second, log)
+	rollup.now = func() time.Time { return now }
+	return rollup
+}
+
+func TestUptimeRollup_BackfillThenRecent(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	repo := &recordingUptimeRepository{}
+	rollup := newTestUptimeRollup(t, repo, now)
+
+	report, err := rollup.Rollup(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.MaxUptimeHistoryDays, report.Days)
+	assert.Equal(t, int64(3*domain.MaxUptimeHistoryDays), report.Updated)
+	require.Len(t, repo.days, domain.MaxUptimeHistoryDays)
+	assert.Equal(t, time.Date(2026, 7, 19, 0, 0, 0, 0, time.UTC), repo.days[0], "oldest day first")
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), repo.days[len(repo.days)-1])
+	assert.Equal(t, 2*time.Second, repo.options.DegradedResponseTime)
+	assert.Equal(t, DefaultUptimeSampleGap, repo.options.MaxSampleGap)
+
+	// После заполнения истории пересчитываются только вчерашние и текущие сутки
+	repo.days = nil
+	report, err = rollup.Rollup(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Days)
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+	}, repo.days)
+}
+
+func TestUptimeRollup_FailedDayRetriesBackfill(t *testing.T) {
+	now := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	repo := &recordingUptimeRepository{failDay: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)}
+	rollup := newTestUptimeRollup(t, repo, now)
+
+	report, err := rollup.Rollup(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, domain.MaxUptimeHistoryDays-1, report.Days, "other days are still rolled up")
+
+	// Неполное заполнение повторяется целиком на следующем проходе
+	repo.days = nil
+	repo.failDay = time.Time{}
+	report, err = rollup.Rollup(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, domain.MaxUptimeHistoryDays, report.Days)
+}
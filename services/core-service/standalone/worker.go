@@ -0,0 +1,243 @@
+// Package standalone выполняет проверки core-service в одном процессе с остальными сервисами
+// платформы: задачи читаются из встроенной очереди pkg/queue, результаты хранятся в памяти
+// и публикуются событиями check.result для incident-manager
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/repository/memory"
+	"UptimePingPlatform/services/core-service/internal/service"
+	"UptimePingPlatform/services/core-service/internal/service/checker"
+)
+
+// TopicResults тема очереди с результатами проверок
+const TopicResults = "check_results"
+
+// Publisher публикует сообщения во встроенную очередь
+type Publisher interface {
+	Publish(ctx context.Context, msg queue.Message) error
+}
+
+// CheckDefinition проверка, по которой исполнитель собирает задачу: задача планировщика
+// несет только идентификатор проверки, версию конфигурации и переопределения запуска
+type CheckDefinition struct {
+	TenantID      string
+	Type          string
+	Target        string
+	Timeout       time.Duration
+	Config        map[string]interface{}
+	Enabled       bool
+	ConfigVersion int64
+}
+
+// Checks источник проверок исполнителя; реализуется планировщиком
+type Checks interface {
+	// CheckDefinition возвращает проверку; ошибка с кодом ErrNotFound - проверка удалена
+	CheckDefinition(ctx context.Context, checkID string) (*CheckDefinition, error)
+}
+
+// Result результат проверки
+type Result struct {
+	CheckID     string            `json:"check_id"`
+	ExecutionID string            `json:"execution_id"`
+	Success     bool              `json:"success"`
+	DurationMs  int64             `json:"duration_ms"`
+	StatusCode  int               `json:"status_code,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	CheckedAt   time.Time         `json:"checked_at"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// Worker выполняет задачи проверок
+type Worker struct {
+	checks  *service.CheckService
+	results *memory.CheckResultRepository
+}
+
+// NewWorker создает исполнителя проверок, публикующего результаты в publisher. Тип, цель и
+// конфигурация задач берутся из checks. historySize - число последних результатов каждой
+// проверки, которые хранятся в памяти.
+// targets ограничивает адреса проверок так же, как в core-service: при Restrict проверки
+// не соединяются с внутренними сетями и метаданными облака, кроме сетей из Allow
+func NewWorker(publisher Publisher, historySize int, targets config.CheckTargetsConfig, checks Checks, log logger.Logger) (*Worker, error) {
+	var targetPolicy *checker.TargetPolicy
+	if targets.Restrict {
+		var err error
+		targetPolicy, err = checker.NewDefaultTargetPolicy(targets.Deny, targets.Allow)
+		if err != nil {
+			return nil, fmt.Errorf("invalid check targets: %w", err)
+		}
+	} else {
+		log.Warn("Check target restriction is disabled, checks can reach internal networks")
+	}
+
+	results := memory.NewCheckResultRepository(historySize)
+	factory := checker.NewDefaultCheckerFactory(log, checker.NewDefaultHTTPClient(30*time.Second)).
+		WithTargetPolicy(targetPolicy)
+	checkService := service.NewCheckService(log, factory, results, nil, nil).
+		WithHealthRecorder(&resultPublisher{publisher: publisher, logger: log}).
+		WithFencing(service.NewMemoryFencingGuard()).
+		WithConfigFreshness(service.NewConfigFreshness(checkDefinitions{checks: checks}, service.DefaultConfigVersionTTL))
+	return &Worker{checks: checkService, results: results}, nil
+}
+
+// Handle обрабатывает задачу из темы check_tasks
+func (w *Worker) Handle(ctx context.Context, msg *queue.Message) error {
+	if msg.Redelivered() {
+		return w.checks.ProcessRedeliveredTask(ctx, msg.Body)
+	}
+	return w.checks.ProcessTask(ctx, msg.Body)
+}
+
+// History возвращает последние limit результатов проверки, новые первыми
+func (w *Worker) History(ctx context.Context, checkID string, limit int) ([]Result, error) {
+	stored, err := w.results.GetByCheckID(ctx, checkID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0, len(stored))
+	for _, result := range stored {
+		results = append(results, Result{
+			CheckID:     result.CheckID,
+			ExecutionID: result.ExecutionID,
+			Success:     result.Success,
+			DurationMs:  result.DurationMs,
+			StatusCode:  result.StatusCode,
+			Error:       result.Error,
+			CheckedAt:   result.CheckedAt,
+			Metadata:    result.Metadata,
+		})
+	}
+	return results, nil
+}
+
+// State результаты проверок для сохранения между перезапусками
+type State struct {
+	Results []*domain.CheckResult `json:"results"`
+}
+
+// State возвращает хранимые результаты проверок
+func (w *Worker) State() *State {
+	return &State{Results: w.results.Snapshot()}
+}
+
+// Restore заменяет хранимые результаты результатами из State
+func (w *Worker) Restore(state *State) {
+	w.results.Restore(state.Results)
+}
+
+// checkDefinitions читает проверки планировщика для сборки задач
+type checkDefinitions struct {
+	checks Checks
+}
+
+// GetDefinition возвращает проверку или nil, если проверка удалена
+func (d checkDefinitions) GetDefinition(ctx context.Context, checkID string) (*domain.CheckDefinition, error) {
+	check, err := d.checks.CheckDefinition(ctx, checkID)
+	if stderrors.Is(err, errors.New(errors.ErrNotFound, "")) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	config, err := jsonConfig(check.Config)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CheckDefinition{
+		CheckID:        checkID,
+		TenantID:       check.TenantID,
+		Type:           check.Type,
+		Target:         check.Target,
+		TimeoutSeconds: int(check.Timeout / time.Second),
+		Config:         config,
+		Enabled:        check.Enabled,
+		ConfigVersion:  check.ConfigVersion,
+	}, nil
+}
+
+// jsonConfig приводит конфигурацию проверки к типам JSON, с которыми работают checker'ы:
+// числа из YAML становятся float64, как в конфигурации из таблицы checks
+func jsonConfig(config map[string]interface{}) (map[string]interface{}, error) {
+	if len(config) == 0 {
+		return nil, nil
+	}
+	body, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid check config: %w", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return nil, fmt.Errorf("invalid check config: %w", err)
+	}
+	return decoded, nil
+}
+
+// resultPublisher публикует итоговый результат каждой проверки событием check.result.
+// Подключается как учет здоровья: CheckService сообщает о каждом результате после консенсуса
+type resultPublisher struct {
+	publisher Publisher
+	logger    logger.Logger
+}
+
+// RecordHealth публикует результат в тему check_results
+func (p *resultPublisher) RecordHealth(ctx context.Context, tenantID string, result *domain.CheckResult) {
+	body, headers, err := events.Encode(events.TypeCheckResult, &events.CheckResult{
+		CheckID:     result.CheckID,
+		TenantID:    tenantID,
+		ExecutionID: result.ExecutionID,
+		Success:     result.Success,
+		DurationMs:  result.DurationMs,
+		StatusCode:  result.StatusCode,
+		Error:       result.Error,
+		Region:      result.Metadata[domain.MetadataKeyRegion],
+		CheckedAt:   result.CheckedAt,
+		Metadata:    eventMetadata(result.Metadata),
+	})
+	if err == nil {
+		err = p.publisher.Publish(ctx, queue.Message{
+			Topic:       TopicResults,
+			Key:         events.TypeCheckResult,
+			ContentType: events.ContentType,
+			Headers:     headers,
+			Body:        body,
+		})
+	}
+	if err != nil {
+		p.logger.Error("Failed to publish check result",
+			logger.String("check_id", result.CheckID),
+			logger.Error(err),
+		)
+	}
+}
+
+// omittedEventMetadata ключи метаданных результата, которые не передаются в событии: регион
+// уже есть в поле Region, служебные отметки core-service потребителям не нужны
+var omittedEventMetadata = map[string]bool{
+	domain.MetadataKeyRegion: true,
+	"service":                true,
+	"processed_at":           true,
+}
+
+// eventMetadata возвращает метаданные результата без ключей, дублирующих поля события
+func eventMetadata(metadata map[string]string) map[string]string {
+	trimmed := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		if !omittedEventMetadata[key] {
+			trimmed[key] = value
+		}
+	}
+	return trimmed
+}
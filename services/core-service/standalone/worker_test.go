@@ -0,0 +1,158 @@
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	"UptimePingPlatform/services/core-service/internal/domain"
+	"UptimePingPlatform/services/core-service/internal/service"
+)
+
+// recordingPublisher запоминает опубликованные сообщения
+type recordingPublisher struct {
+	messages []queue.Message
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, msg queue.Message) error {
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+// stubChecks проверки планировщика в памяти
+type stubChecks map[string]*CheckDefinition
+
+func (c stubChecks) CheckDefinition(ctx context.Context, checkID string) (*CheckDefinition, error) {
+	check, ok := c[checkID]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "check not found")
+	}
+	return check, nil
+}
+
+// taskBody задача планировщика: только идентификаторы, версия конфигурации и переопределения
+func taskBody(t *testing.T, checkID, executionID string, version int64, overrides map[string]interface{}) []byte {
+	body, err := json.Marshal(service.TaskMessage{CheckID: checkID, ExecutionID: executionID,
+		TenantID: "00000000-0000-0000-0000-000000000001", ConfigVersion: version, Overrides: overrides})
+	require.NoError(t, err)
+	return body
+}
+
+func TestResultPublisher_TrimsMetadata(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	publisher := &recordingPublisher{}
+	recorder := &resultPublisher{publisher: publisher, logger: log}
+
+	metadata := map[string]string{
+		domain.MetadataKeyRegion: "eu-west",
+		"service":                "core-service",
+		"processed_at":           "2026-10-16T12:00:00Z",
+		"body_size":              "512",
+	}
+	recorder.RecordHealth(context.Background(), "00000000-0000-0000-0000-000000000001", &domain.CheckResult{
+		CheckID:     "check-1",
+		ExecutionID: "exec-1",
+		StatusCode:  503,
+		Error:       "unexpected status code: 503",
+		CheckedAt:   time.Now().UTC(),
+		Metadata:    metadata,
+	})
+
+	require.Len(t, publisher.messages, 1)
+	var result events.CheckResult
+	require.NoError(t, events.Decode(publisher.messages[0].Headers, publisher.messages[0].Body, &result))
+	assert.Equal(t, "eu-west", result.Region)
+	assert.Equal(t, map[string]string{"body_size": "512"}, result.Metadata)
+	// Метаданные самого результата не меняются
+	assert.Len(t, metadata, 4)
+}
+
+func TestWorker_TargetPolicy(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	checks := stubChecks{"check-1": {Type: "http", Target: server.URL, Timeout: 5 * time.Second,
+		Config: map[string]interface{}{"expected_status": 200}, Enabled: true, ConfigVersion: 1}}
+
+	process := func(targets config.CheckTargetsConfig) Result {
+		worker, err := NewWorker(&recordingPublisher{}, 10, targets, checks, log)
+		require.NoError(t, err)
+		body := taskBody(t, "check-1", "exec-1", 1, nil)
+		require.NoError(t, worker.Handle(context.Background(), &queue.Message{Body: body, Attempt: 1}))
+		history, err := worker.History(context.Background(), "check-1", 1)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		return history[0]
+	}
+
+	// По умолчанию loopback запрещен, как и в core-service
+	result := process(config.CheckTargetsConfig{Restrict: true})
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Error, "denied by the target policy")
+
+	// Сети установки разрешаются списком allow
+	result = process(config.CheckTargetsConfig{Restrict: true, Allow: []string{"127.0.0.1"}})
+	assert.True(t, result.Success, result.Error)
+
+	_, err = NewWorker(&recordingPublisher{}, 10, config.CheckTargetsConfig{Restrict: true, Allow: []string{"intranet"}}, checks, log)
+	assert.Error(t, err)
+}
+
+func TestWorker_ResolvesCheckDefinition(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "core-service", false)
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	checks := stubChecks{
+		"check-1": {Type: "https", Target: server.URL, Timeout: 5 * time.Second,
+			Config: map[string]interface{}{"expected_status": 200}, Enabled: true, ConfigVersion: 2},
+		"paused": {Type: "http", Target: server.URL, Enabled: false, ConfigVersion: 1},
+	}
+	worker, err := NewWorker(&recordingPublisher{}, 10,
+		config.CheckTargetsConfig{Restrict: true, Allow: []string{"127.0.0.1"}}, checks, log)
+	require.NoError(t, err)
+	handle := func(checkID, executionID string, version int64, overrides map[string]interface{}) {
+		body := taskBody(t, checkID, executionID, version, overrides)
+		require.NoError(t, worker.Handle(context.Background(), &queue.Message{Body: body, Attempt: 1}))
+	}
+
+	// Конфигурация проверки ожидает 200, переопределение запуска - 204
+	handle("check-1", "exec-default", 2, nil)
+	handle("check-1", "exec-override", 2, map[string]interface{}{"expected_status": 204})
+	history, err := worker.History(context.Background(), "check-1", 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, "exec-override", history[0].ExecutionID)
+	assert.True(t, history[0].Success, history[0].Error)
+	assert.False(t, history[1].Success)
+
+	// Задачи по устаревшей версии, выключенной и удаленной проверкам отбрасываются
+	handle("check-1", "exec-outdated", 1, nil)
+	handle("paused", "exec-paused", 1, nil)
+	handle("removed", "exec-removed", 1, nil)
+	history, err = worker.History(context.Background(), "check-1", 10)
+	require.NoError(t, err)
+	assert.Len(t, history, 2)
+	history, err = worker.History(context.Background(), "paused", 10)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -11,13 +12,26 @@ import (
 	"time"
 
 	"UptimePingPlatform/pkg/config"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/health"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/metrics"
 	pkg_redis "UptimePingPlatform/pkg/redis"
+	forgev1 "UptimePingPlatform/proto/api/forge/v1"
+	"UptimePingPlatform/services/forge-service/internal/handler"
+	grpcHandler "UptimePingPlatform/services/forge-service/internal/handler/grpc"
+	"UptimePingPlatform/services/forge-service/internal/service"
+	"UptimePingPlatform/services/forge-service/internal/validation"
+
+	"google.golang.org/grpc"
 )
 
 func main() {
+	// Режим песочницы: процесс перезапущен SandboxParser для разбора загруженного .proto
+	if service.IsParserSandboxChild() {
+		os.Exit(service.RunParserSandboxChild(os.Stdin, os.Stdout, os.Stderr))
+	}
+
 	// Load configuration
 	cfg, err := config.LoadConfigWithAutoPath("dev")
 	if err != nil {
@@ -32,6 +46,7 @@ func main() {
 	defer appLogger.Sync()
 
 	appLogger.Info("Starting Forge Service...")
+	appLogger.Info("Effective configuration", logger.Any("config", cfg.Redacted()))
 
 	// Initialize metrics
 	appMetrics := metrics.NewMetrics("forge-service")
@@ -52,15 +67,53 @@ func main() {
 		defer redisClient.Close()
 	}
 
+	// Загруженные пользователями .proto разбираются в отдельном процессе с лимитами ресурсов
+	parser, err := service.NewSandboxParser(service.DefaultSandboxLimits())
+	if err != nil {
+		log.Fatalf("Failed to create proto parser sandbox: %v", err)
+	}
+	forgeService := service.NewForgeService(appLogger, parser, nil, validation.NewForgeValidator())
+
+	// HTTP API Forge (web UI, генерация и мастер создания проверок); токены проверяет auth-service.
+	// Мастер разбирает загруженные спецификации тем же изолированным парсером
+	authServiceAddr := os.Getenv("AUTH_GRPC_ADDR")
+	if authServiceAddr == "" {
+		authServiceAddr = "auth-service:50051"
+	}
+	httpHandler := handler.NewHTTPHandler(appLogger, service.NewCodeGenerator(appLogger, ""), service.NewProtoParser(""), forgeService, authServiceAddr)
+	if httpHandler == nil {
+		log.Fatalf("Failed to create HTTP handler")
+	}
+	httpHandler.WithWizard(service.NewWizardService(parser, service.NewMemoryWizardStore(), appLogger))
+
+	// gRPC ForgeService для gateway
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	grpcServer := grpc.NewServer(pkg_grpc.ServerOptions(cfg.GRPC)...)
+	forgev1.RegisterForgeServiceServer(grpcServer, grpcHandler.NewForgeHandler(forgeService, appLogger))
+	// grpc.health.v1 для проверок здоровья подканалов на стороне клиентов
+	healthServer := pkg_grpc.RegisterHealthServer(grpcServer, forgev1.ForgeService_ServiceDesc.ServiceName)
+	if pkg_grpc.RegisterReflection(grpcServer, cfg.GRPC, cfg.Environment) {
+		appLogger.Info("gRPC reflection is enabled")
+	}
+	go func() {
+		appLogger.Info(fmt.Sprintf("Starting gRPC server on port %d", cfg.Server.Port))
+		if err := grpcServer.Serve(pkg_grpc.LimitListener(lis, cfg.GRPC)); err != nil {
+			appLogger.Error("gRPC server failed", logger.Error(err))
+		}
+	}()
+
 	// Start HTTP server for metrics and health
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
-		Handler: setupHTTPHandler(metricsHandler, healthChecker, appLogger),
+		Addr:    fmt.Sprintf(":%d", cfg.Server.Port+1000), // Health check on port +1000
+		Handler: setupHTTPHandler(metricsHandler, healthChecker, httpHandler, appLogger),
 	}
 
 	// Start server
 	go func() {
-		appLogger.Info(fmt.Sprintf("Starting HTTP server on port %d", cfg.Server.Port))
+		appLogger.Info(fmt.Sprintf("Starting HTTP server on port %d", cfg.Server.Port+1000))
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			appLogger.Error("HTTP server failed", logger.Error(err))
 		}
@@ -76,6 +129,9 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	healthServer.Shutdown()
+	grpcServer.GracefulStop()
+
 	if err := httpServer.Shutdown(ctx); err != nil {
 		appLogger.Error("Server shutdown failed", logger.Error(err))
 	}
@@ -83,8 +139,11 @@ func main() {
 	appLogger.Info("Server stopped")
 }
 
-func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, appLogger logger.Logger) http.Handler {
+func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, httpHandler *handler.HTTPHandler, appLogger logger.Logger) http.Handler {
 	mux := http.NewServeMux()
+
+	// Web UI и API Forge
+	httpHandler.RegisterRoutes(mux)
 	
 	// Metrics endpoint
 	mux.Handle("/metrics", metricsHandler)
@@ -87,3 +87,16 @@ type GetTemplatesResponse struct {
 	Templates []TemplateInfo `json:"templates"`
 	Total     int            `json:"total"`
 }
+
+// WizardStartRequest представляет запрос на открытие сессии мастера
+type WizardStartRequest struct {
+	SourceType string `json:"source_type"` // proto, openapi
+	FileName   string `json:"file_name"`
+	Content    string `json:"content"`
+}
+
+// WizardAnswerRequest представляет ответ на вопрос мастера
+type WizardAnswerRequest struct {
+	QuestionID string `json:"question_id"`
+	Value      string `json:"value"`
+}
@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// WizardSourceType тип загруженной спецификации
+type WizardSourceType string
+
+const (
+	WizardSourceProto   WizardSourceType = "proto"
+	WizardSourceOpenAPI WizardSourceType = "openapi"
+)
+
+// WizardState состояние сессии мастера
+type WizardState string
+
+const (
+	// WizardStateCollecting мастер ждет ответов на вопросы
+	WizardStateCollecting WizardState = "collecting"
+	// WizardStateReady все вопросы отвечены, доступны предпросмотр и фиксация
+	WizardStateReady WizardState = "ready"
+	// WizardStateCommitted проверки сгенерированы, сессия закрыта для изменений
+	WizardStateCommitted WizardState = "committed"
+)
+
+// QuestionKind тип ответа на вопрос мастера
+type QuestionKind string
+
+const (
+	QuestionKindString      QuestionKind = "string"
+	QuestionKindInt         QuestionKind = "int"
+	QuestionKindChoice      QuestionKind = "choice"
+	QuestionKindMultiChoice QuestionKind = "multi_choice"
+	QuestionKindSecret      QuestionKind = "secret"
+)
+
+// WizardQuestion вопрос мастера
+type WizardQuestion struct {
+	ID       string       `json:"id"`
+	Prompt   string       `json:"prompt"`
+	Kind     QuestionKind `json:"kind"`
+	Choices  []string     `json:"choices,omitempty"`
+	Default  string       `json:"default,omitempty"`
+	Required bool         `json:"required"`
+}
+
+// WizardTarget операция из спецификации, для которой можно создать проверку
+type WizardTarget struct {
+	// Name уникальное имя операции: Service.Method для gRPC, operationId или "METHOD /path" для OpenAPI
+	Name string `json:"name"`
+	// Service полное имя gRPC сервиса (package.Service)
+	Service string `json:"service,omitempty"`
+	// Method имя gRPC метода или HTTP метод операции OpenAPI
+	Method string `json:"method"`
+	// Path путь операции OpenAPI
+	Path string `json:"path,omitempty"`
+}
+
+// WizardCheck проверка, которую мастер создаст после фиксации
+type WizardCheck struct {
+	Name     string            `json:"name"`
+	Type     string            `json:"type"`
+	Target   string            `json:"target"`
+	Interval int               `json:"interval"`
+	Timeout  int               `json:"timeout"`
+	Config   map[string]string `json:"config"`
+}
+
+// WizardSession сессия мастера создания проверок
+type WizardSession struct {
+	ID         string           `json:"id"`
+	TenantID   string           `json:"tenant_id"`
+	SourceType WizardSourceType `json:"source_type"`
+	FileName   string           `json:"file_name"`
+	Targets    []WizardTarget   `json:"targets"`
+	// Defaults значения по умолчанию для вопросов, найденные в спецификации (адрес, схема аутентификации)
+	Defaults  map[string]string `json:"defaults,omitempty"`
+	Answers   map[string]string `json:"answers"`
+	State     WizardState       `json:"state"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
@@ -30,6 +30,7 @@ type HTTPHandler struct {
 	forgeService      service.ForgeService
 	interactiveConfig *domain.InteractiveConfig
 	authClient        authv1.AuthServiceClient // gRPC клиент для Auth Service
+	wizard            *service.WizardService
 }
 
 // NewHTTPHandler создает новый HTTP обработчик
@@ -70,6 +71,10 @@ func (h *HTTPHandler) RegisterRoutes(mux *http.ServeMux) {
 	
 	// CLI API маршруты (v1)
 	apiMux.HandleFunc("/api/v1/forge/generate", h.handleGenerate)
+
+	// Мастер создания проверок (CLI и web UI)
+	apiMux.HandleFunc("/api/v1/forge/wizard/sessions", h.handleWizardSessions)
+	apiMux.HandleFunc("/api/v1/forge/wizard/sessions/", h.handleWizardSession)
 	
 	// Применяем middleware аутентификации к API
 	mux.Handle("/api/", h.authMiddleware(apiMux))
@@ -684,7 +689,7 @@ func (h *HTTPHandler) handleGenerate(w http.ResponseWriter, r *http.Request) {
 		codeOptions.Language = "go"
 	}
 	
-	filename, content, _, err := h.forgeService.GenerateCode(r.Context(), req.Input, codeOptions)
+	content, filename, _, err := h.forgeService.GenerateCode(r.Context(), req.Input, codeOptions)
 	if err != nil {
 		h.logger.Error("Failed to generate code", logger.Error(err))
 		http.Error(w, fmt.Sprintf("Generation failed: %s", err.Error()), http.StatusInternalServerError)
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"strings"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/forge-service/internal/api"
+	"UptimePingPlatform/services/forge-service/internal/domain"
+	"UptimePingPlatform/services/forge-service/internal/service"
+)
+
+// wizardSessionsPath префикс маршрутов мастера
+const wizardSessionsPath = "/api/v1/forge/wizard/sessions"
+
+// WithWizard подключает мастер создания проверок
+func (h *HTTPHandler) WithWizard(wizard *service.WizardService) *HTTPHandler {
+	h.wizard = wizard
+	return h
+}
+
+// handleWizardSessions открывает сессию мастера по загруженной спецификации
+//
+//	POST /api/v1/forge/wizard/sessions
+func (h *HTTPHandler) handleWizardSessions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !h.requireWizard(w) {
+		return
+	}
+
+	var req api.WizardStartRequest
+	body := http.MaxBytesReader(w, r.Body, 2*service.MaxProtoContentSize)
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+	if req.SourceType == "" {
+		req.SourceType = string(domain.WizardSourceProto)
+	}
+
+	step, err := h.wizard.Start(r.Context(), wizardTenantID(r), domain.WizardSourceType(req.SourceType), req.FileName, req.Content)
+	if err != nil {
+		h.writeWizardError(w, err, "start")
+		return
+	}
+	h.writeWizardJSON(w, http.StatusCreated, step)
+}
+
+// handleWizardSession обслуживает шаги существующей сессии
+//
+//	GET    /api/v1/forge/wizard/sessions/{id}
+//	DELETE /api/v1/forge/wizard/sessions/{id}
+//	POST   /api/v1/forge/wizard/sessions/{id}/answers
+//	GET    /api/v1/forge/wizard/sessions/{id}/preview
+//	POST   /api/v1/forge/wizard/sessions/{id}/commit
+func (h *HTTPHandler) handleWizardSession(w http.ResponseWriter, r *http.Request) {
+	if !h.requireWizard(w) {
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, wizardSessionsPath), "/"), "/")
+	if parts[0] == "" || len(parts) > 2 {
+		h.writeErrorResponse(w, http.StatusNotFound, "Not found")
+		return
+	}
+	id, action := parts[0], ""
+	if len(parts) == 2 {
+		action = parts[1]
+	}
+	tenantID := wizardTenantID(r)
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		step, err := h.wizard.Get(r.Context(), tenantID, id)
+		if err != nil {
+			h.writeWizardError(w, err, "get")
+			return
+		}
+		h.writeWizardJSON(w, http.StatusOK, step)
+
+	case action == "" && r.Method == http.MethodDelete:
+		if err := h.wizard.Cancel(r.Context(), tenantID, id); err != nil {
+			h.writeWizardError(w, err, "cancel")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	case action == "answers" && r.Method == http.MethodPost:
+		var req api.WizardAnswerRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(&req); err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON")
+			return
+		}
+		step, err := h.wizard.Answer(r.Context(), tenantID, id, req.QuestionID, req.Value)
+		if err != nil {
+			h.writeWizardError(w, err, "answer")
+			return
+		}
+		h.writeWizardJSON(w, http.StatusOK, step)
+
+	case action == "preview" && r.Method == http.MethodGet:
+		checks, err := h.wizard.Preview(r.Context(), tenantID, id)
+		if err != nil {
+			h.writeWizardError(w, err, "preview")
+			return
+		}
+		h.writeWizardJSON(w, http.StatusOK, map[string]interface{}{"checks": checks})
+
+	case action == "commit" && r.Method == http.MethodPost:
+		commit, err := h.wizard.Commit(r.Context(), tenantID, id, h.interactiveConfig)
+		if err != nil {
+			h.writeWizardError(w, err, "commit")
+			return
+		}
+		h.writeWizardJSON(w, http.StatusOK, commit)
+
+	case action == "" || action == "answers" || action == "preview" || action == "commit":
+		h.writeErrorResponse(w, http.StatusMethodNotAllowed, "Method not allowed")
+
+	default:
+		h.writeErrorResponse(w, http.StatusNotFound, "Not found")
+	}
+}
+
+// requireWizard отвечает 503, если мастер не подключен
+func (h *HTTPHandler) requireWizard(w http.ResponseWriter) bool {
+	if h.wizard == nil {
+		h.writeErrorResponse(w, http.StatusServiceUnavailable, "Wizard is unavailable")
+		return false
+	}
+	return true
+}
+
+// writeWizardError переводит ошибку мастера в HTTP статус
+func (h *HTTPHandler) writeWizardError(w http.ResponseWriter, err error, operation string) {
+	var appErr *pkgErrors.Error
+	if !stderrors.As(err, &appErr) {
+		h.logger.Error("Wizard operation failed", logger.String("operation", operation), logger.Error(err))
+		h.writeErrorResponse(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	status := appErr.HTTPStatus()
+	if status >= http.StatusInternalServerError {
+		h.logger.Error("Wizard operation failed", logger.String("operation", operation), logger.Error(err))
+		h.writeErrorResponse(w, status, "Internal server error")
+		return
+	}
+	message := appErr.Message
+	if appErr.Details != "" {
+		message += ": " + appErr.Details
+	}
+	h.writeErrorResponse(w, status, message)
+}
+
+// writeWizardJSON отправляет ответ мастера
+func (h *HTTPHandler) writeWizardJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(payload)
+}
+
+// wizardTenantID возвращает арендатора, установленного authMiddleware
+func wizardTenantID(r *http.Request) string {
+	tenantID, _ := r.Context().Value("tenant_id").(string)
+	return tenantID
+}
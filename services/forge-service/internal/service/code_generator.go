@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"UptimePingPlatform/pkg/errors"
 	pkglogger "UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/forge-service/internal/domain"
 	"UptimePingPlatform/services/forge-service/internal/templates"
@@ -328,6 +329,40 @@ func (cg *CodeGenerator) generateCheckerName(serviceName string) string {
 	return name + "Checker"
 }
 
+// RegisterStubTemplate добавляет шаблон клиентской заготовки для нового языка или заменяет встроенный
+func (cg *CodeGenerator) RegisterStubTemplate(t templates.StubTemplate) error {
+	return cg.templates.Stubs().Register(t)
+}
+
+// StubLanguages возвращает языки, для которых зарегистрированы шаблоны заготовок
+func (cg *CodeGenerator) StubLanguages() []string {
+	return cg.templates.Stubs().Languages()
+}
+
+// GenerateStub генерирует клиентскую заготовку на выбранном языке и возвращает код и имя файла
+func (cg *CodeGenerator) GenerateStub(language string, data templates.StubData) (string, string, error) {
+	tmpl, ok := cg.templates.Stubs().Get(language)
+	if !ok {
+		return "", "", errors.New(errors.ErrValidation, "unsupported language").
+			WithDetails(fmt.Sprintf("language %q, supported: %s", language, strings.Join(cg.StubLanguages(), ", ")))
+	}
+	if err := data.Validate(); err != nil {
+		return "", "", errors.Wrap(err, errors.ErrValidation, "proto contains names that cannot be used in generated code")
+	}
+
+	code, filename, err := tmpl.Render(data)
+	if err != nil {
+		return "", "", errors.Wrap(err, errors.ErrInternal, "failed to render stub template").
+			WithDetails(fmt.Sprintf("language %q", tmpl.Language))
+	}
+
+	cg.logger.Debug("Stub generated",
+		pkglogger.String("language", tmpl.Language),
+		pkglogger.String("service", data.Service),
+		pkglogger.String("filename", filename))
+	return code, filename, nil
+}
+
 // GenerateAll генерирует все артефакты
 func (cg *CodeGenerator) GenerateAll(services []domain.Service, config *domain.InteractiveConfig) error {
 	cg.logger.Info("Starting full generation process")
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/forge-service/internal/templates"
+	"UptimePingPlatform/services/forge-service/internal/validation"
+)
+
+// updateStubs перезаписывает golden файлы заготовок:
+// go test ./internal/service -run TestGenerateCode_Golden -update-stubs
+var updateStubs = flag.Bool("update-stubs", false, "update generated stub golden files")
+
+const stubProto = `syntax = "proto3";
+
+package billing.v1;
+
+service InvoiceService {
+	rpc GetInvoice(GetInvoiceRequest) returns (Invoice);
+	rpc ListInvoices(ListInvoicesRequest) returns (ListInvoicesResponse);
+}
+
+message GetInvoiceRequest {
+	string invoice_id = 1;
+}
+
+message Invoice {
+	string id = 1;
+	int64 amount_cents = 2;
+	double tax_rate = 3;
+	bool paid = 4;
+	InvoiceStatus status = 5;
+	repeated string tags = 6;
+	map<string, string> labels = 7;
+	bytes pdf = 8;
+	string from = 9;
+}
+
+message ListInvoicesRequest {
+	int32 page_size = 1;
+}
+
+message ListInvoicesResponse {
+	repeated Invoice invoices = 1;
+	Invoice latest = 2;
+}
+
+enum InvoiceStatus {
+	INVOICE_STATUS_UNSPECIFIED = 0;
+	INVOICE_STATUS_PAID = 1;
+}
+`
+
+func newStubForgeService(t *testing.T) ForgeService {
+	t.Helper()
+	log, err := logger.NewLogger("test", "debug", "forge-service", false)
+	require.NoError(t, err)
+	return NewForgeService(log, NewInProcessParser(), nil, validation.NewForgeValidator())
+}
+
+func TestGenerateCode_Golden(t *testing.T) {
+	svc := newStubForgeService(t)
+
+	tests := []struct {
+		language string
+		filename string
+	}{
+		{templates.LanguageGo, "InvoiceService_checker.go"},
+		{templates.LanguageTypeScript, "InvoiceServiceChecker.ts"},
+		{templates.LanguagePython, "invoice_service_checker.py"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			code, filename, language, err := svc.GenerateCode(context.Background(), stubProto, &CodeOptions{Language: tt.language})
+			require.NoError(t, err)
+			assert.Equal(t, tt.filename, filename)
+			assert.Equal(t, tt.language, language)
+
+			golden := filepath.Join("testdata", "stubs", tt.filename+".golden")
+			if *updateStubs {
+				require.NoError(t, os.MkdirAll(filepath.Dir(golden), 0o755))
+				require.NoError(t, os.WriteFile(golden, []byte(code), 0o644))
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err, "golden file is missing, run the test with -update-stubs")
+			assert.Equal(t, string(want), code)
+		})
+	}
+}
+
+func TestGenerateCode_DefaultsToGo(t *testing.T) {
+	svc := newStubForgeService(t)
+
+	_, filename, language, err := svc.GenerateCode(context.Background(), stubProto, &CodeOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, templates.LanguageGo, language)
+	assert.Equal(t, "InvoiceService_checker.go", filename)
+}
+
+func TestGenerateCode_UnsupportedLanguage(t *testing.T) {
+	svc := newStubForgeService(t)
+
+	_, _, _, err := svc.GenerateCode(context.Background(), stubProto, &CodeOptions{Language: "cobol"})
+	require.Error(t, err)
+	assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+}
+
+func TestCodeGenerator_RegisterStubTemplate(t *testing.T) {
+	log, err := logger.NewLogger("test", "debug", "forge-service", false)
+	require.NoError(t, err)
+	generator := NewCodeGenerator(log, "")
+
+	require.NoError(t, generator.RegisterStubTemplate(templates.StubTemplate{
+		Language: "Kotlin",
+		FileName: "{{.Service}}Checker.kt",
+		Source:   "// {{.FullService}}{{range .Methods}} {{.Name}}{{end}}\n",
+	}))
+	assert.Equal(t, []string{"go", "kotlin", "python", "typescript"}, generator.StubLanguages())
+
+	code, filename, err := generator.GenerateStub("kotlin", templates.StubData{
+		Package: "billing.v1",
+		Service: "InvoiceService",
+		Methods: []templates.StubMethod{{Name: "GetInvoice", InputType: "GetInvoiceRequest", OutputType: "Invoice"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "InvoiceServiceChecker.kt", filename)
+	assert.Equal(t, "// billing.v1.InvoiceService GetInvoice\n", code)
+
+	err = generator.RegisterStubTemplate(templates.StubTemplate{Language: "broken", FileName: "x", Source: "{{.Service"})
+	assert.Error(t, err)
+	err = generator.RegisterStubTemplate(templates.StubTemplate{FileName: "x", Source: "x"})
+	assert.Error(t, err)
+}
+
+func TestCodeGenerator_GenerateStubRejectsUnsafeNames(t *testing.T) {
+	log, err := logger.NewLogger("test", "debug", "forge-service", false)
+	require.NoError(t, err)
+	generator := NewCodeGenerator(log, "")
+
+	_, _, err = generator.GenerateStub(templates.LanguagePython, templates.StubData{
+		Service:  "InvoiceService",
+		Messages: []templates.StubMessage{{Name: "Invoice", Fields: []templates.StubField{{Name: `x"); import os; ("`, Type: "string"}}}},
+	})
+	require.Error(t, err)
+	assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+}
+
+func TestExtractFieldInfo_Labels(t *testing.T) {
+	tests := []struct {
+		line  string
+		name  string
+		typ   string
+		label string
+	}{
+		{"string user_id = 1;", "user_id", "string", "optional"},
+		{"repeated string tags = 2;", "tags", "string", "repeated"},
+		{"optional int64 amount = 3;", "amount", "int64", "optional"},
+		{"map<string, string> labels = 4;", "labels", "map<string,string>", "optional"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			field := extractFieldInfo(tt.line)
+			assert.Equal(t, tt.name, field.Name)
+			assert.Equal(t, tt.typ, field.Type)
+			assert.Equal(t, tt.label, field.Label)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"UptimePingPlatform/pkg/errors"
+)
+
+// MaxProtoContentSize максимальный размер загружаемого .proto содержимого
+const MaxProtoContentSize = 1 << 20
+
+// uploadedFileName имя, под которым загруженное содержимое попадает в результаты разбора
+const uploadedFileName = "upload.proto"
+
+// ParseResult результат разбора загруженного .proto содержимого
+type ParseResult struct {
+	Services []*ServiceInfo `json:"services"`
+	Messages []*MessageInfo `json:"messages"`
+	Enums    []*EnumInfo    `json:"enums"`
+}
+
+// ContentParser разбирает .proto содержимое, присланное пользователем
+type ContentParser interface {
+	Parse(ctx context.Context, content string) (*ParseResult, error)
+}
+
+// inProcessParser разбирает содержимое в текущем процессе без ограничений ресурсов
+type inProcessParser struct{}
+
+// NewInProcessParser создает парсер, работающий в текущем процессе.
+// Подходит для тестов и доверенного содержимого; загрузки пользователей разбирает SandboxParser
+func NewInProcessParser() ContentParser {
+	return inProcessParser{}
+}
+
+// Parse проверяет содержимое и разбирает его в текущем процессе
+func (inProcessParser) Parse(ctx context.Context, content string) (*ParseResult, error) {
+	if err := CheckProtoContent(content); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "proto parsing cancelled")
+	}
+	return parseContent(content), nil
+}
+
+// CheckProtoContent проверяет размер содержимого и его импорты до разбора
+func CheckProtoContent(content string) error {
+	if len(content) > MaxProtoContentSize {
+		return errors.New(errors.ErrValidation, "proto content is too large").
+			WithDetails(fmt.Sprintf("size %d exceeds limit %d", len(content), MaxProtoContentSize))
+	}
+	if !utf8.ValidString(content) {
+		return errors.New(errors.ErrValidation, "proto content must be valid UTF-8")
+	}
+	for _, imp := range extractImports(content) {
+		if err := checkImportPath(imp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractImports возвращает пути из объявлений import
+func extractImports(content string) []string {
+	var imports []string
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "import") {
+			continue
+		}
+		rest := line[len("import"):]
+		if rest == "" || !strings.ContainsRune(" \t\"'", rune(rest[0])) {
+			// Идентификатор, начинающийся с import (например, importance = 1)
+			continue
+		}
+		rest = strings.TrimSpace(rest)
+		for _, modifier := range []string{"public", "weak"} {
+			if strings.HasPrefix(rest, modifier+" ") || strings.HasPrefix(rest, modifier+"\"") {
+				rest = strings.TrimSpace(strings.TrimPrefix(rest, modifier))
+			}
+		}
+		if len(rest) < 2 || (rest[0] != '"' && rest[0] != '\'') {
+			continue
+		}
+		end := strings.IndexByte(rest[1:], rest[0])
+		if end == -1 {
+			// Незакрытая строка импорта: проверяем все, что есть
+			imports = append(imports, rest[1:])
+			continue
+		}
+		imports = append(imports, rest[1:end+1])
+	}
+	return imports
+}
+
+// checkImportPath отклоняет импорты, ссылающиеся на локальную файловую систему
+func checkImportPath(imp string) error {
+	reject := func(reason string) error {
+		return errors.New(errors.ErrValidation, "import references the local filesystem").
+			WithDetails(fmt.Sprintf("import %q: %s", imp, reason))
+	}
+
+	switch {
+	case imp == "":
+		return reject("empty path")
+	case strings.ContainsAny(imp, "\x00\\"):
+		return reject("path contains forbidden characters")
+	case strings.HasPrefix(imp, "/"), strings.HasPrefix(imp, "~"):
+		return reject("absolute paths are not allowed")
+	case len(imp) >= 2 && imp[1] == ':':
+		return reject("absolute paths are not allowed")
+	case strings.Contains(imp, "://"), strings.HasPrefix(imp, "file:"):
+		return reject("URLs are not allowed")
+	}
+
+	for _, segment := range strings.Split(imp, "/") {
+		if segment == ".." || segment == "." || segment == "" {
+			return reject("relative path segments are not allowed")
+		}
+	}
+	if path.Ext(imp) != ".proto" {
+		return reject("only .proto files can be imported")
+	}
+	return nil
+}
+
+// parseContent разбирает содержимое новым парсером, не затрагивая файловую систему
+func parseContent(content string) *ParseResult {
+	p := NewProtoParser("")
+	p.addFileInfo(uploadedFileName, extractPackageName(content), content)
+	return &ParseResult{
+		Services: p.GetServices(),
+		Messages: p.GetMessages(),
+		Enums:    p.GetEnums(),
+	}
+}
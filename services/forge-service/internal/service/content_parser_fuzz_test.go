@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// FuzzCheckProtoContent проверяет, что фронтенд разбора не паникует и не пропускает
+// импорты с абсолютными путями и выходом из каталога
+func FuzzCheckProtoContent(f *testing.F) {
+	f.Add(sampleProto)
+	f.Add(`import "/etc/passwd";`)
+	f.Add(`import public "../x.proto";`)
+	f.Add(`import weak 'a/b.proto'`)
+	f.Add(`import "`)
+	f.Add("import\t\"C:\\\\x.proto\";")
+
+	f.Fuzz(func(t *testing.T, content string) {
+		err := CheckProtoContent(content)
+		if err != nil {
+			return
+		}
+		for _, imp := range extractImports(content) {
+			if strings.HasPrefix(imp, "/") || containsDotDotSegment(imp) {
+				t.Fatalf("filesystem import %q passed the check", imp)
+			}
+		}
+	})
+}
+
+// FuzzParseContent проверяет, что разбор произвольного содержимого не паникует
+// и дает согласованный результат
+func FuzzParseContent(f *testing.F) {
+	f.Add(sampleProto)
+	f.Add("service S {\nrpc M(stream A) returns (stream B);\n}")
+	f.Add("message M {\n= 1;\nrepeated = ;\n}")
+	f.Add("enum E {\nA = -1;\n=\n}")
+	f.Add("rpc )( returns )(")
+	f.Add("package ;")
+
+	f.Fuzz(func(t *testing.T, content string) {
+		result, err := NewInProcessParser().Parse(context.Background(), content)
+		if err != nil {
+			return
+		}
+		for _, svc := range result.Services {
+			if svc == nil {
+				t.Fatal("nil service in result")
+			}
+			for _, method := range svc.Methods {
+				if method == nil {
+					t.Fatalf("nil method in service %q", svc.Name)
+				}
+			}
+		}
+		for _, msg := range result.Messages {
+			for _, field := range msg.Fields {
+				if field.Name == "" || field.Type == "" {
+					t.Fatalf("incomplete field in message %q", msg.Name)
+				}
+			}
+		}
+	})
+}
+
+func containsDotDotSegment(imp string) bool {
+	for _, segment := range strings.Split(imp, "/") {
+		if segment == ".." {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleProto = `syntax = "proto3";
+
+package test.service;
+
+import "google/protobuf/timestamp.proto";
+
+service TestService {
+	rpc GetUser(GetUserRequest) returns (GetUserResponse);
+}
+
+message GetUserRequest {
+	string user_id = 1;
+}
+
+message GetUserResponse {
+	string name = 1;
+}
+
+enum UserStatus {
+	UNKNOWN = 0;
+}
+`
+
+// TestMain позволяет тестовому бинарнику выступать дочерним процессом SandboxParser
+func TestMain(m *testing.M) {
+	if IsParserSandboxChild() {
+		os.Exit(RunParserSandboxChild(os.Stdin, os.Stdout, os.Stderr))
+	}
+	os.Exit(m.Run())
+}
+
+func TestCheckProtoContent_Imports(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"well-known import", `import "google/protobuf/empty.proto";`, false},
+		{"public import", `import public "api/v1/common.proto";`, false},
+		{"identifier with import prefix", `int32 importance = 1;`, false},
+		{"absolute path", `import "/etc/passwd";`, true},
+		{"absolute proto path", `import "/etc/secret.proto";`, true},
+		{"parent directory", `import "../../config/secret.proto";`, true},
+		{"nested parent directory", `import "api/../../secret.proto";`, true},
+		{"home directory", `import "~/secret.proto";`, true},
+		{"windows drive", `import "C:/secret.proto";`, true},
+		{"backslash", `import "api\\secret.proto";`, true},
+		{"file url", `import "file:///etc/secret.proto";`, true},
+		{"weak import absolute", `import weak "/tmp/x.proto";`, true},
+		{"single quotes", `import '/etc/x.proto';`, true},
+		{"not a proto file", `import "api/v1/secret.yaml";`, true},
+		{"empty import", `import "";`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckProtoContent(tt.content)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckProtoContent_Size(t *testing.T) {
+	assert.NoError(t, CheckProtoContent(strings.Repeat("a", MaxProtoContentSize)))
+	assert.Error(t, CheckProtoContent(strings.Repeat("a", MaxProtoContentSize+1)))
+	assert.Error(t, CheckProtoContent("syntax = \"proto3\";\xff"))
+}
+
+func TestInProcessParser_Parse(t *testing.T) {
+	result, err := NewInProcessParser().Parse(context.Background(), sampleProto)
+	require.NoError(t, err)
+
+	require.Len(t, result.Services, 1)
+	assert.Equal(t, "TestService", result.Services[0].Name)
+	assert.Equal(t, "test.service", result.Services[0].Package)
+	assert.Equal(t, uploadedFileName, result.Services[0].File)
+	assert.Len(t, result.Messages, 2)
+	assert.Len(t, result.Enums, 1)
+}
+
+func TestProtoParser_ParseProtoContentDoesNotTouchProtoDir(t *testing.T) {
+	tempDir := t.TempDir()
+	parser := NewProtoParser(tempDir)
+
+	services, err := parser.ParseProtoContent(sampleProto)
+	require.NoError(t, err)
+	assert.Len(t, services, 1)
+
+	entries, err := os.ReadDir(tempDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = parser.ParseProtoContent(`import "../secret.proto";`)
+	assert.Error(t, err)
+}
+
+func TestSandboxParser_Parse(t *testing.T) {
+	parser, err := NewSandboxParser(DefaultSandboxLimits())
+	require.NoError(t, err)
+
+	result, err := parser.Parse(context.Background(), sampleProto)
+	require.NoError(t, err)
+
+	expected, err := NewInProcessParser().Parse(context.Background(), sampleProto)
+	require.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
+
+func TestSandboxParser_RejectsFilesystemImports(t *testing.T) {
+	parser, err := NewSandboxParser(DefaultSandboxLimits())
+	require.NoError(t, err)
+
+	_, err = parser.Parse(context.Background(), `import "/etc/passwd.proto";`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "local filesystem")
+}
+
+func TestSandboxParser_Limits(t *testing.T) {
+	t.Run("time limit", func(t *testing.T) {
+		limits := DefaultSandboxLimits()
+		limits.Timeout = time.Nanosecond
+		parser, err := NewSandboxParser(limits)
+		require.NoError(t, err)
+
+		_, err = parser.Parse(context.Background(), sampleProto)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "time limit")
+	})
+
+	t.Run("output limit", func(t *testing.T) {
+		limits := DefaultSandboxLimits()
+		limits.MaxOutputBytes = 16
+		parser, err := NewSandboxParser(limits)
+		require.NoError(t, err)
+
+		_, err = parser.Parse(context.Background(), sampleProto)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "too much output")
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/forge-service/internal/templates"
 	"UptimePingPlatform/services/forge-service/internal/validation"
 )
 
@@ -32,6 +33,7 @@ type ForgeServiceInfo struct {
 	ServiceName string           `json:"service_name"`
 	Methods     []ForgeMethodInfo `json:"methods"`
 	Messages    []ForgeMessageInfo `json:"messages"`
+	Enums       []string           `json:"enums,omitempty"`
 }
 
 // ForgeMethodInfo содержит информацию о методе
@@ -87,16 +89,21 @@ type CodeOptions struct {
 // forgeService реализация ForgeService
 type forgeService struct {
 	logger        logger.Logger
-	protoParser   *ProtoParser
+	parser        ContentParser
 	codeGenerator  *CodeGenerator
 	validator     *validation.ForgeValidator
 }
 
-// NewForgeService создает новый экземпляр ForgeService
-func NewForgeService(logger logger.Logger, protoParser *ProtoParser, codeGenerator *CodeGenerator, validator *validation.ForgeValidator) ForgeService {
+// NewForgeService создает новый экземпляр ForgeService.
+// Загруженное содержимое разбирает parser; для пользовательских загрузок это SandboxParser.
+// Без codeGenerator используется генератор со встроенными шаблонами заготовок
+func NewForgeService(logger logger.Logger, parser ContentParser, codeGenerator *CodeGenerator, validator *validation.ForgeValidator) ForgeService {
+	if codeGenerator == nil {
+		codeGenerator = NewCodeGenerator(logger, "")
+	}
 	return &forgeService{
 		logger:       logger,
-		protoParser:  protoParser,
+		parser:       parser,
 		codeGenerator: codeGenerator,
 		validator:    validator,
 	}
@@ -116,13 +123,14 @@ func (s *forgeService) ParseProto(ctx context.Context, protoContent, fileName st
 		return nil, false, nil, err
 	}
 
-	// Используем существующий парсер для извлечения информации
-	services, err := s.protoParser.ParseProtoContent(protoContent)
+	// Разбираем содержимое в изолированном парсере
+	result, err := s.parser.Parse(ctx, protoContent)
 	if err != nil {
 		s.logger.Error("Failed to parse proto content", logger.Error(err))
 		return nil, false, nil, err
 	}
 
+	services := result.Services
 	if len(services) == 0 {
 		warnings := []string{"No services found in proto file"}
 		return nil, true, warnings, nil
@@ -141,8 +149,8 @@ func (s *forgeService) ParseProto(ctx context.Context, protoContent, fileName st
 		})
 	}
 
-	// Получаем сообщения из парсера
-	messages := s.protoParser.GetMessages()
+	// Получаем сообщения из результата разбора
+	messages := result.Messages
 	messageInfos := make([]ForgeMessageInfo, 0, len(messages))
 	for _, msg := range messages {
 		fields := make([]ForgeFieldInfo, 0, len(msg.Fields))
@@ -151,7 +159,7 @@ func (s *forgeService) ParseProto(ctx context.Context, protoContent, fileName st
 				Name:     field.Name,
 				Type:     field.Type,
 				Number:   int(field.Number),
-				Repeated: false, // По умолчанию не repeated
+				Repeated: field.Label == "repeated",
 			})
 		}
 		messageInfos = append(messageInfos, ForgeMessageInfo{
@@ -160,11 +168,17 @@ func (s *forgeService) ParseProto(ctx context.Context, protoContent, fileName st
 		})
 	}
 
+	enums := make([]string, 0, len(result.Enums))
+	for _, enum := range result.Enums {
+		enums = append(enums, enum.Name)
+	}
+
 	serviceInfo := &ForgeServiceInfo{
 		PackageName: service.Package,
 		ServiceName: service.Name,
 		Methods:     methods,
 		Messages:    messageInfos,
+		Enums:       enums,
 	}
 
 	s.logger.Info("Proto parsed successfully",
@@ -266,71 +280,17 @@ func (s *forgeService) GenerateCode(ctx context.Context, protoContent string, op
 		return "", "", "", fmt.Errorf("no methods found in proto file")
 	}
 
-	// Генерируем код для Go
+	// Язык выбирается через реестр шаблонов заготовок; по умолчанию Go
 	language := options.Language
 	if language == "" {
-		language = "go"
-	}
-
-	filename := fmt.Sprintf("%s_checker.go", serviceInfo.ServiceName)
-	
-	// Генерируем базовый код для gRPC checker
-	template := `package checkers
-
-import (
-	"context"
-	"fmt"
-	"time"
-
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/health/grpc_health_v1"
-)
-
-type %sChecker struct {
-	target    string
-	timeout   time.Duration
-}
-
-func New%sChecker(target string, timeout time.Duration) *%sChecker {
-	return &%sChecker{
-		target:  target,
-		timeout: timeout,
+		language = templates.LanguageGo
 	}
-}
 
-func (c *%sChecker) Execute(ctx context.Context) error {
-	conn, err := grpc.DialContext(ctx, c.target, 
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-	)
+	code, filename, err := s.codeGenerator.GenerateStub(language, stubData(serviceInfo))
 	if err != nil {
-		return fmt.Errorf("failed to connect to %%s: %%w", c.target, err)
-	}
-	defer conn.Close()
-
-	// Health check
-	client := grpc_health_v1.NewHealthClient(conn)
-	resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
-	if err != nil {
-		return fmt.Errorf("health check failed: %%w", err)
-	}
-
-	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
-		return fmt.Errorf("service is not healthy: %%v", resp.Status)
+		return "", "", "", err
 	}
 
-	return nil
-}
-`
-
-	code := fmt.Sprintf(template, 
-		serviceInfo.ServiceName,
-		serviceInfo.ServiceName,
-		serviceInfo.ServiceName,
-		serviceInfo.ServiceName,
-		serviceInfo.ServiceName)
-
 	s.logger.Info("Code generated successfully",
 		logger.String("filename", filename),
 		logger.String("language", language),
@@ -339,13 +299,42 @@ func (c *%sChecker) Execute(ctx context.Context) error {
 	return code, filename, language, nil
 }
 
+// stubData переводит описание сервиса в данные шаблонов заготовок
+func stubData(info *ForgeServiceInfo) templates.StubData {
+	data := templates.StubData{
+		Package: info.PackageName,
+		Service: info.ServiceName,
+		Enums:   info.Enums,
+	}
+	for _, method := range info.Methods {
+		data.Methods = append(data.Methods, templates.StubMethod{
+			Name:       method.Name,
+			InputType:  method.InputType,
+			OutputType: method.OutputType,
+		})
+	}
+	for _, message := range info.Messages {
+		stubMessage := templates.StubMessage{Name: message.Name}
+		for _, field := range message.Fields {
+			stubMessage.Fields = append(stubMessage.Fields, templates.StubField{
+				Name:     field.Name,
+				Type:     field.Type,
+				Number:   field.Number,
+				Repeated: field.Repeated,
+			})
+		}
+		data.Messages = append(data.Messages, stubMessage)
+	}
+	return data
+}
+
 // ValidateProto проверяет валидность .proto файла
 func (s *forgeService) ValidateProto(ctx context.Context, protoContent string) (bool, []string, []string, error) {
 	s.logger.Info("Validating proto file",
 		logger.Int("content_length", len(protoContent)))
 
-	// Используем существующий парсер для валидации
-	_, err := s.protoParser.ParseProtoContent(protoContent)
+	// Используем изолированный парсер для валидации
+	_, err := s.parser.Parse(ctx, protoContent)
 	if err != nil {
 		errors := []string{err.Error()}
 		s.logger.Error("Proto validation failed", logger.Error(err))
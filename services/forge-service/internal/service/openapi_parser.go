@@ -0,0 +1,160 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/forge-service/internal/domain"
+)
+
+// openAPIMethods HTTP методы, которые могут быть операциями в path item
+var openAPIMethods = []string{"get", "head", "options", "post", "put", "patch", "delete", "trace"}
+
+// openAPISpec минимальное подмножество OpenAPI 3 / Swagger 2, нужное мастеру
+type openAPISpec struct {
+	OpenAPI string `json:"openapi" yaml:"openapi"`
+	Swagger string `json:"swagger" yaml:"swagger"`
+	Servers []struct {
+		URL string `json:"url" yaml:"url"`
+	} `json:"servers" yaml:"servers"`
+	Host       string                            `json:"host" yaml:"host"`
+	BasePath   string                            `json:"basePath" yaml:"basePath"`
+	Schemes    []string                          `json:"schemes" yaml:"schemes"`
+	Paths      map[string]map[string]interface{} `json:"paths" yaml:"paths"`
+	Components struct {
+		SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes" yaml:"securitySchemes"`
+	} `json:"components" yaml:"components"`
+	SecurityDefinitions map[string]openAPISecurityScheme `json:"securityDefinitions" yaml:"securityDefinitions"`
+}
+
+// openAPISecurityScheme схема аутентификации OpenAPI
+type openAPISecurityScheme struct {
+	Type   string `json:"type" yaml:"type"`
+	Scheme string `json:"scheme" yaml:"scheme"`
+	In     string `json:"in" yaml:"in"`
+	Name   string `json:"name" yaml:"name"`
+}
+
+// OpenAPIDocument результат разбора спецификации OpenAPI
+type OpenAPIDocument struct {
+	// BaseURL адрес API из servers (OpenAPI 3) или host/basePath (Swagger 2)
+	BaseURL string
+	// AuthType схема аутентификации в терминах мастера: none, bearer, basic, api_key
+	AuthType string
+	// APIKeyHeader имя заголовка для схемы apiKey
+	APIKeyHeader string
+	Targets      []domain.WizardTarget
+}
+
+// ParseOpenAPI разбирает спецификацию OpenAPI 3 или Swagger 2 в формате JSON или YAML
+func ParseOpenAPI(content string) (*OpenAPIDocument, error) {
+	if len(content) > MaxProtoContentSize {
+		return nil, errors.New(errors.ErrValidation, "openapi specification is too large").
+			WithDetails(fmt.Sprintf("size %d exceeds limit %d", len(content), MaxProtoContentSize))
+	}
+
+	var spec openAPISpec
+	var err error
+	if strings.HasPrefix(strings.TrimSpace(content), "{") {
+		err = json.Unmarshal([]byte(content), &spec)
+	} else {
+		err = yaml.Unmarshal([]byte(content), &spec)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "failed to parse openapi specification")
+	}
+	if spec.OpenAPI == "" && spec.Swagger == "" {
+		return nil, errors.New(errors.ErrValidation, "specification has neither openapi nor swagger version")
+	}
+
+	doc := &OpenAPIDocument{BaseURL: spec.baseURL()}
+	doc.AuthType, doc.APIKeyHeader = spec.authType()
+
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		item := spec.Paths[path]
+		for _, method := range openAPIMethods {
+			op, ok := item[method]
+			if !ok {
+				continue
+			}
+			httpMethod := strings.ToUpper(method)
+			name := httpMethod + " " + path
+			if fields, ok := op.(map[string]interface{}); ok {
+				if operationID, ok := fields["operationId"].(string); ok && operationID != "" && !seen[operationID] {
+					name = operationID
+				}
+			}
+			seen[name] = true
+			doc.Targets = append(doc.Targets, domain.WizardTarget{
+				Name:   name,
+				Method: httpMethod,
+				Path:   path,
+			})
+		}
+	}
+
+	if len(doc.Targets) == 0 {
+		return nil, errors.New(errors.ErrValidation, "specification has no operations")
+	}
+	return doc, nil
+}
+
+// baseURL возвращает адрес API, если он абсолютный
+func (s *openAPISpec) baseURL() string {
+	if len(s.Servers) > 0 {
+		if u, err := url.Parse(s.Servers[0].URL); err == nil && u.IsAbs() {
+			return strings.TrimSuffix(s.Servers[0].URL, "/")
+		}
+		return ""
+	}
+	if s.Host == "" {
+		return ""
+	}
+	scheme := "https"
+	if len(s.Schemes) > 0 {
+		scheme = s.Schemes[0]
+	}
+	return strings.TrimSuffix(scheme+"://"+s.Host+s.BasePath, "/")
+}
+
+// authType выбирает первую поддерживаемую схему аутентификации в порядке имен схем
+func (s *openAPISpec) authType() (string, string) {
+	schemes := s.Components.SecuritySchemes
+	if len(schemes) == 0 {
+		schemes = s.SecurityDefinitions
+	}
+
+	names := make([]string, 0, len(schemes))
+	for name := range schemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		scheme := schemes[name]
+		switch {
+		case scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "bearer"):
+			return "bearer", ""
+		case scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "basic"), scheme.Type == "basic":
+			return "basic", ""
+		case scheme.Type == "apiKey" && scheme.In == "header" && scheme.Name != "":
+			return "api_key", scheme.Name
+		case scheme.Type == "oauth2", scheme.Type == "openIdConnect":
+			return "bearer", ""
+		}
+	}
+	return "none", ""
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+)
+
+// Переменные окружения, через которые родитель передает дочернему процессу режим и лимиты песочницы
+const (
+	sandboxModeEnv   = "FORGE_PARSER_SANDBOX"
+	sandboxCPUEnv    = "FORGE_PARSER_SANDBOX_CPU_SECONDS"
+	sandboxMemoryEnv = "FORGE_PARSER_SANDBOX_MEMORY_BYTES"
+)
+
+// SandboxLimits ограничения ресурсов процесса разбора
+type SandboxLimits struct {
+	// Timeout общее время работы процесса, включая запуск
+	Timeout time.Duration
+	// CPUSeconds лимит процессорного времени (RLIMIT_CPU)
+	CPUSeconds uint64
+	// MemoryBytes лимит памяти процесса (RLIMIT_DATA и мягкий лимит Go runtime)
+	MemoryBytes uint64
+	// MaxOutputBytes максимальный размер ответа процесса
+	MaxOutputBytes int64
+}
+
+// DefaultSandboxLimits возвращает лимиты песочницы по умолчанию
+func DefaultSandboxLimits() SandboxLimits {
+	return SandboxLimits{
+		Timeout:        5 * time.Second,
+		CPUSeconds:     2,
+		MemoryBytes:    256 << 20,
+		MaxOutputBytes: 8 << 20,
+	}
+}
+
+// SandboxParser разбирает содержимое в отдельном процессе с ограничением CPU, памяти и времени.
+// Дочерний процесс — тот же бинарник, запущенный в режиме IsParserSandboxChild
+type SandboxParser struct {
+	executable string
+	limits     SandboxLimits
+}
+
+// NewSandboxParser создает парсер, перезапускающий текущий бинарник в режиме песочницы
+func NewSandboxParser(limits SandboxLimits) (*SandboxParser, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to resolve forge executable")
+	}
+	return &SandboxParser{executable: executable, limits: limits}, nil
+}
+
+// Parse проверяет содержимое и разбирает его в дочернем процессе
+func (p *SandboxParser) Parse(ctx context.Context, content string) (*ParseResult, error) {
+	if err := CheckProtoContent(content); err != nil {
+		return nil, err
+	}
+
+	if p.limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.limits.Timeout)
+		defer cancel()
+	}
+
+	stdout := &limitedBuffer{limit: p.limits.MaxOutputBytes}
+	stderr := &limitedBuffer{limit: 4 << 10}
+
+	cmd := exec.CommandContext(ctx, p.executable)
+	// Дочерний процесс не наследует окружение сервиса (секреты, адреса) и рабочий каталог
+	cmd.Env = []string{
+		sandboxModeEnv + "=1",
+		sandboxCPUEnv + "=" + strconv.FormatUint(p.limits.CPUSeconds, 10),
+		sandboxMemoryEnv + "=" + strconv.FormatUint(p.limits.MemoryBytes, 10),
+	}
+	cmd.Dir = os.TempDir()
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		switch {
+		case ctx.Err() != nil:
+			return nil, errors.Wrap(ctx.Err(), errors.ErrValidation, "proto parsing exceeded time limit")
+		case stdout.exceeded:
+			return nil, errors.New(errors.ErrValidation, "proto parsing produced too much output")
+		}
+		var exitErr *exec.ExitError
+		if stderrors.As(err, &exitErr) && !exitErr.Exited() {
+			// Процесс убит сигналом: исчерпан лимит CPU или памяти
+			return nil, errors.Wrap(err, errors.ErrValidation, "proto parsing exceeded resource limits")
+		}
+		return nil, errors.Wrap(err, errors.ErrInternal, "proto parser sandbox failed").
+			WithDetails(strings.TrimSpace(stderr.String()))
+	}
+
+	var result ParseResult
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to decode proto parser sandbox output")
+	}
+	return &result, nil
+}
+
+// IsParserSandboxChild сообщает, запущен ли процесс как песочница парсера
+func IsParserSandboxChild() bool {
+	return os.Getenv(sandboxModeEnv) == "1"
+}
+
+// RunParserSandboxChild применяет лимиты, читает содержимое из in и пишет ParseResult в out.
+// Возвращает код завершения процесса
+func RunParserSandboxChild(in io.Reader, out, errOut io.Writer) int {
+	cpuSeconds, _ := strconv.ParseUint(os.Getenv(sandboxCPUEnv), 10, 64)
+	memoryBytes, _ := strconv.ParseUint(os.Getenv(sandboxMemoryEnv), 10, 64)
+	if err := applySandboxLimits(cpuSeconds, memoryBytes); err != nil {
+		fmt.Fprintf(errOut, "failed to apply sandbox limits: %v\n", err)
+		return 2
+	}
+
+	content, err := io.ReadAll(io.LimitReader(in, MaxProtoContentSize+1))
+	if err != nil {
+		fmt.Fprintf(errOut, "failed to read proto content: %v\n", err)
+		return 2
+	}
+	if len(content) > MaxProtoContentSize {
+		fmt.Fprintln(errOut, "proto content is too large")
+		return 2
+	}
+
+	if err := json.NewEncoder(out).Encode(parseContent(string(content))); err != nil {
+		fmt.Fprintf(errOut, "failed to encode parse result: %v\n", err)
+		return 2
+	}
+	return 0
+}
+
+// limitedBuffer буфер, перестающий принимать данные после limit байт.
+// bytes.Buffer не встраивается, чтобы io.Copy не обошел лимит через ReadFrom
+type limitedBuffer struct {
+	buf      bytes.Buffer
+	limit    int64
+	exceeded bool
+}
+
+// Write записывает данные или возвращает ошибку при превышении лимита
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.limit > 0 && int64(b.buf.Len()+len(p)) > b.limit {
+		b.exceeded = true
+		return 0, io.ErrShortWrite
+	}
+	return b.buf.Write(p)
+}
+
+// Bytes возвращает накопленные данные
+func (b *limitedBuffer) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// String возвращает накопленные данные строкой
+func (b *limitedBuffer) String() string {
+	return b.buf.String()
+}
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"runtime/debug"
+	"syscall"
+)
+
+// applySandboxLimits ограничивает процессорное время, память и запись файлов текущего процесса
+func applySandboxLimits(cpuSeconds, memoryBytes uint64) error {
+	if cpuSeconds > 0 {
+		// Мягкий лимит дает SIGXCPU, жесткий на секунду позже — SIGKILL
+		if err := syscall.Setrlimit(syscall.RLIMIT_CPU, &syscall.Rlimit{Cur: cpuSeconds, Max: cpuSeconds + 1}); err != nil {
+			return err
+		}
+	}
+	if memoryBytes > 0 {
+		debug.SetMemoryLimit(int64(memoryBytes) / 2)
+		if err := syscall.Setrlimit(syscall.RLIMIT_DATA, &syscall.Rlimit{Cur: memoryBytes, Max: memoryBytes}); err != nil {
+			return err
+		}
+	}
+	// Разбору не нужны файлы: запрещаем их создание и запись
+	return syscall.Setrlimit(syscall.RLIMIT_FSIZE, &syscall.Rlimit{Cur: 0, Max: 0})
+}
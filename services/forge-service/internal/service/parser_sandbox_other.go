@@ -0,0 +1,14 @@
+//go:build !linux
+
+package service
+
+import "runtime/debug"
+
+// applySandboxLimits на платформах без rlimit ограничивает только память Go runtime;
+// время работы ограничивает родительский процесс
+func applySandboxLimits(cpuSeconds, memoryBytes uint64) error {
+	if memoryBytes > 0 {
+		debug.SetMemoryLimit(int64(memoryBytes) / 2)
+	}
+	return nil
+}
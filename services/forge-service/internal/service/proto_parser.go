@@ -434,24 +434,30 @@ func extractFieldInfo(line string) *FieldInfo {
 		}
 	}
 
+	// Example: map<string, string> labels = 3;
+	if strings.HasPrefix(line, "map<") {
+		if end := strings.Index(line, ">"); end != -1 {
+			field.Type = strings.ReplaceAll(line[:end+1], " ", "")
+			if rest := strings.Fields(line[end+1:]); len(rest) >= 2 && rest[0] != "=" {
+				field.Name = rest[0]
+			}
+			return field
+		}
+	}
+
 	// Extract name and type - proto3 format: type name = number;
 	parts := strings.Fields(line)
-	if len(parts) >= 3 && strings.Contains(line, "=") {
+	if len(parts) >= 4 && strings.Contains(line, "=") && (parts[0] == "optional" || parts[0] == "required" || parts[0] == "repeated") {
+		// Example: repeated string tags = 2;
+		field.Type = parts[1]
+		if parts[2] != "=" {
+			field.Name = parts[2]
+		}
+	} else if len(parts) >= 3 && strings.Contains(line, "=") {
 		// Example: string user_id = 1;
 		field.Type = parts[0]
-		field.Name = parts[1]
-	} else if len(parts) >= 4 && strings.Contains(line, "=") {
-		// Example: repeated string tags = 2;
-		for i, part := range parts {
-			if part == "optional" || part == "required" || part == "repeated" {
-				if i+1 < len(parts) {
-					field.Type = parts[i+1]
-					if i+2 < len(parts) && parts[i+2] != "=" {
-						field.Name = parts[i+2]
-					}
-				}
-				break
-			}
+		if parts[1] != "=" {
+			field.Name = parts[1]
 		}
 	}
 
@@ -498,22 +504,15 @@ func extractEnumValueInfo(line string) *EnumValueInfo {
 	return value
 }
 
-// ParseProtoContent parses proto content from string and returns services
+// ParseProtoContent parses proto content from string and returns services.
+// Content is checked with CheckProtoContent and never written to the proto directory
 func (p *ProtoParser) ParseProtoContent(content string) ([]*ServiceInfo, error) {
-	// Create temporary file for parsing
-	tempFile := filepath.Join(p.protoDir, "temp.proto")
-	err := os.WriteFile(tempFile, []byte(content), 0644)
-	if err != nil {
-		return nil, fmt.Errorf("failed to write temp file: %w", err)
-	}
-	defer os.Remove(tempFile)
-
-	// Parse the temporary file
-	err = p.parseProtoFile(tempFile)
-	if err != nil {
+	if err := CheckProtoContent(content); err != nil {
 		return nil, fmt.Errorf("failed to parse proto content: %w", err)
 	}
 
+	p.addFileInfo(uploadedFileName, extractPackageName(content), content)
+
 	return p.services, nil
 }
 
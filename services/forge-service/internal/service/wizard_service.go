@@ -0,0 +1,702 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/forge-service/internal/domain"
+)
+
+// Идентификаторы вопросов мастера
+const (
+	QuestionTargets         = "targets"
+	QuestionHost            = "host"
+	QuestionPort            = "port"
+	QuestionBaseURL         = "base_url"
+	QuestionInterval        = "interval"
+	QuestionTimeout         = "timeout"
+	QuestionAuthType        = "auth_type"
+	QuestionAuthCredentials = "auth_credentials"
+	QuestionAPIKeyHeader    = "api_key_header"
+)
+
+// Схемы аутентификации, которые мастер умеет добавить в проверку
+const (
+	AuthTypeNone   = "none"
+	AuthTypeBearer = "bearer"
+	AuthTypeBasic  = "basic"
+	AuthTypeAPIKey = "api_key"
+)
+
+// Границы интервала и таймаута проверки в секундах
+const (
+	MinWizardInterval = 10
+	MaxWizardInterval = 86400
+	MaxWizardTimeout  = 300
+)
+
+// DefaultWizardSessionTTL время жизни сессии мастера без активности
+const DefaultWizardSessionTTL = 30 * time.Minute
+
+// allTargets значение ответа targets, выбирающее все операции
+const allTargets = "all"
+
+// maskedSecret заменяет секреты в ответах API
+const maskedSecret = "********"
+
+var headerNameRegex = regexp.MustCompile(`^[A-Za-z0-9!#$%&'*+.^_|~-]+$`)
+
+// ConfigGenerator записывает интерактивную конфигурацию (CodeGenerator)
+type ConfigGenerator interface {
+	GenerateInteractiveConfig(config *domain.InteractiveConfig) error
+}
+
+// WizardStep текущее состояние сессии и следующий вопрос; Next равен nil, когда все вопросы отвечены
+type WizardStep struct {
+	Session   *domain.WizardSession   `json:"session"`
+	Next      *domain.WizardQuestion  `json:"next_question"`
+	Questions []domain.WizardQuestion `json:"questions"`
+}
+
+// WizardCommit результат фиксации сессии
+type WizardCommit struct {
+	Session *domain.WizardSession `json:"session"`
+	Checks  []domain.WizardCheck  `json:"checks"`
+}
+
+// WizardService пошаговый мастер создания проверок по загруженной спецификации
+type WizardService struct {
+	parser    ContentParser
+	store     WizardStore
+	logger    logger.Logger
+	generator ConfigGenerator
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+// NewWizardService создает мастер; .proto разбирает parser, сессии хранит store
+func NewWizardService(parser ContentParser, store WizardStore, logger logger.Logger) *WizardService {
+	return &WizardService{
+		parser: parser,
+		store:  store,
+		logger: logger,
+		ttl:    DefaultWizardSessionTTL,
+		now:    time.Now,
+	}
+}
+
+// WithConfigGenerator включает запись интерактивной конфигурации при фиксации gRPC сессий
+func (s *WizardService) WithConfigGenerator(generator ConfigGenerator) *WizardService {
+	s.generator = generator
+	return s
+}
+
+// WithTTL задает время жизни сессии без активности
+func (s *WizardService) WithTTL(ttl time.Duration) *WizardService {
+	if ttl > 0 {
+		s.ttl = ttl
+	}
+	return s
+}
+
+// Start разбирает загруженную спецификацию и открывает сессию
+func (s *WizardService) Start(ctx context.Context, tenantID string, sourceType domain.WizardSourceType, fileName, content string) (*WizardStep, error) {
+	if tenantID == "" {
+		return nil, errors.New(errors.ErrValidation, "tenant_id is required")
+	}
+
+	now := s.now()
+	session := &domain.WizardSession{
+		TenantID:   tenantID,
+		SourceType: sourceType,
+		FileName:   fileName,
+		Defaults:   make(map[string]string),
+		Answers:    make(map[string]string),
+		State:      domain.WizardStateCollecting,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		ExpiresAt:  now.Add(s.ttl),
+	}
+
+	switch sourceType {
+	case domain.WizardSourceProto:
+		result, err := s.parser.Parse(ctx, content)
+		if err != nil {
+			return nil, err
+		}
+		session.Targets = protoTargets(result)
+	case domain.WizardSourceOpenAPI:
+		doc, err := ParseOpenAPI(content)
+		if err != nil {
+			return nil, err
+		}
+		session.Targets = doc.Targets
+		if doc.BaseURL != "" {
+			session.Defaults[QuestionBaseURL] = doc.BaseURL
+		}
+		session.Defaults[QuestionAuthType] = doc.AuthType
+		if doc.APIKeyHeader != "" {
+			session.Defaults[QuestionAPIKeyHeader] = doc.APIKeyHeader
+		}
+	default:
+		return nil, errors.New(errors.ErrValidation, "unsupported source type").
+			WithDetails(fmt.Sprintf("source_type must be %q or %q", domain.WizardSourceProto, domain.WizardSourceOpenAPI))
+	}
+
+	if len(session.Targets) == 0 {
+		return nil, errors.New(errors.ErrValidation, "specification has no methods to monitor")
+	}
+
+	id, err := newWizardSessionID()
+	if err != nil {
+		return nil, err
+	}
+	session.ID = id
+
+	if err := s.store.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Wizard session started",
+		logger.String("session_id", session.ID),
+		logger.String("tenant_id", tenantID),
+		logger.String("source_type", string(sourceType)),
+		logger.Int("targets", len(session.Targets)))
+
+	return s.step(session), nil
+}
+
+// Get возвращает состояние сессии арендатора
+func (s *WizardService) Get(ctx context.Context, tenantID, id string) (*WizardStep, error) {
+	session, err := s.load(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.step(session), nil
+}
+
+// Answer сохраняет ответ на вопрос. Повторный ответ на уже отвеченный вопрос заменяет прежний
+func (s *WizardService) Answer(ctx context.Context, tenantID, id, questionID, value string) (*WizardStep, error) {
+	session, err := s.load(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.State == domain.WizardStateCommitted {
+		return nil, errors.New(errors.ErrConflict, "wizard session is already committed")
+	}
+
+	var question *domain.WizardQuestion
+	questions := wizardQuestions(session)
+	for i := range questions {
+		if questions[i].ID == questionID {
+			question = &questions[i]
+			break
+		}
+	}
+	if question == nil {
+		return nil, errors.New(errors.ErrValidation, "unknown or inapplicable question").
+			WithDetails(fmt.Sprintf("question_id: %s", questionID))
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		value = question.Default
+	}
+	normalized, err := validateWizardAnswer(session, question, value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Учетные данные относятся к конкретной схеме: при ее смене их нужно ввести заново
+	if questionID == QuestionAuthType && session.Answers[QuestionAuthType] != normalized {
+		delete(session.Answers, QuestionAuthCredentials)
+		delete(session.Answers, QuestionAPIKeyHeader)
+	}
+	session.Answers[questionID] = normalized
+	// Ответы на вопросы, ставшие неприменимыми (например, после смены auth_type), отбрасываются
+	applicable := make(map[string]bool)
+	for _, q := range wizardQuestions(session) {
+		applicable[q.ID] = true
+	}
+	for answered := range session.Answers {
+		if !applicable[answered] {
+			delete(session.Answers, answered)
+		}
+	}
+	// Таймаут проверяется относительно интервала; при смене интервала его нужно подтвердить заново
+	if questionID == QuestionInterval {
+		if timeout, ok := session.Answers[QuestionTimeout]; ok {
+			if t, _ := strconv.Atoi(timeout); t >= mustAtoi(normalized) {
+				delete(session.Answers, QuestionTimeout)
+			}
+		}
+	}
+
+	s.touch(session)
+	if err := s.store.Save(ctx, session); err != nil {
+		return nil, err
+	}
+	return s.step(session), nil
+}
+
+// Preview возвращает проверки, которые будут созданы; секреты замаскированы
+func (s *WizardService) Preview(ctx context.Context, tenantID, id string) ([]domain.WizardCheck, error) {
+	session, err := s.load(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.State == domain.WizardStateCollecting {
+		return nil, errors.New(errors.ErrConflict, "wizard session has unanswered questions")
+	}
+	return buildWizardChecks(session, true), nil
+}
+
+// Commit фиксирует сессию: переносит gRPC сервисы в config и возвращает проверки для создания
+func (s *WizardService) Commit(ctx context.Context, tenantID, id string, config *domain.InteractiveConfig) (*WizardCommit, error) {
+	session, err := s.load(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	switch session.State {
+	case domain.WizardStateCollecting:
+		return nil, errors.New(errors.ErrConflict, "wizard session has unanswered questions")
+	case domain.WizardStateCommitted:
+		return nil, errors.New(errors.ErrConflict, "wizard session is already committed")
+	}
+
+	checks := buildWizardChecks(session, false)
+
+	if session.SourceType == domain.WizardSourceProto && config != nil {
+		applyWizardServices(session, config)
+		if s.generator != nil {
+			if err := s.generator.GenerateInteractiveConfig(config); err != nil {
+				return nil, errors.Wrap(err, errors.ErrInternal, "failed to generate interactive config")
+			}
+		}
+	}
+
+	session.State = domain.WizardStateCommitted
+	s.touch(session)
+	if err := s.store.Save(ctx, session); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Wizard session committed",
+		logger.String("session_id", session.ID),
+		logger.String("tenant_id", tenantID),
+		logger.Int("checks", len(checks)))
+
+	return &WizardCommit{Session: maskWizardSession(session), Checks: checks}, nil
+}
+
+// Cancel удаляет сессию
+func (s *WizardService) Cancel(ctx context.Context, tenantID, id string) error {
+	if _, err := s.load(ctx, tenantID, id); err != nil {
+		return err
+	}
+	return s.store.Delete(ctx, id)
+}
+
+// load возвращает сессию, принадлежащую арендатору; чужие сессии выглядят как несуществующие
+func (s *WizardService) load(ctx context.Context, tenantID, id string) (*domain.WizardSession, error) {
+	session, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.TenantID != tenantID || s.now().After(session.ExpiresAt) {
+		return nil, errors.New(errors.ErrNotFound, "wizard session not found")
+	}
+	return session, nil
+}
+
+// touch продлевает сессию и пересчитывает ее состояние
+func (s *WizardService) touch(session *domain.WizardSession) {
+	now := s.now()
+	session.UpdatedAt = now
+	session.ExpiresAt = now.Add(s.ttl)
+	if session.State == domain.WizardStateCommitted {
+		return
+	}
+	session.State = domain.WizardStateReady
+	for _, q := range wizardQuestions(session) {
+		if _, ok := session.Answers[q.ID]; !ok {
+			session.State = domain.WizardStateCollecting
+			return
+		}
+	}
+}
+
+// step собирает ответ API по сессии
+func (s *WizardService) step(session *domain.WizardSession) *WizardStep {
+	questions := wizardQuestions(session)
+	step := &WizardStep{Session: maskWizardSession(session), Questions: questions}
+	if session.State == domain.WizardStateCommitted {
+		return step
+	}
+	for i := range questions {
+		if _, ok := session.Answers[questions[i].ID]; !ok {
+			step.Next = &questions[i]
+			break
+		}
+	}
+	return step
+}
+
+// wizardQuestions возвращает вопросы, применимые к сессии с учетом уже данных ответов
+func wizardQuestions(session *domain.WizardSession) []domain.WizardQuestion {
+	names := make([]string, 0, len(session.Targets))
+	for _, target := range session.Targets {
+		names = append(names, target.Name)
+	}
+
+	questions := []domain.WizardQuestion{{
+		ID:       QuestionTargets,
+		Prompt:   "Which methods should be monitored? Comma-separated names or \"all\"",
+		Kind:     domain.QuestionKindMultiChoice,
+		Choices:  names,
+		Default:  allTargets,
+		Required: true,
+	}}
+
+	if session.SourceType == domain.WizardSourceOpenAPI {
+		questions = append(questions, domain.WizardQuestion{
+			ID:       QuestionBaseURL,
+			Prompt:   "Base URL of the API",
+			Kind:     domain.QuestionKindString,
+			Default:  session.Defaults[QuestionBaseURL],
+			Required: true,
+		})
+	} else {
+		questions = append(questions,
+			domain.WizardQuestion{
+				ID:       QuestionHost,
+				Prompt:   "Host of the gRPC service",
+				Kind:     domain.QuestionKindString,
+				Default:  "localhost",
+				Required: true,
+			},
+			domain.WizardQuestion{
+				ID:       QuestionPort,
+				Prompt:   "Port of the gRPC service",
+				Kind:     domain.QuestionKindInt,
+				Default:  "50051",
+				Required: true,
+			})
+	}
+
+	authDefault := session.Defaults[QuestionAuthType]
+	if authDefault == "" {
+		authDefault = AuthTypeNone
+	}
+	questions = append(questions,
+		domain.WizardQuestion{
+			ID:       QuestionInterval,
+			Prompt:   fmt.Sprintf("Check interval in seconds (%d-%d)", MinWizardInterval, MaxWizardInterval),
+			Kind:     domain.QuestionKindInt,
+			Default:  "60",
+			Required: true,
+		},
+		domain.WizardQuestion{
+			ID:       QuestionTimeout,
+			Prompt:   fmt.Sprintf("Check timeout in seconds (1-%d, less than the interval)", MaxWizardTimeout),
+			Kind:     domain.QuestionKindInt,
+			Default:  "10",
+			Required: true,
+		},
+		domain.WizardQuestion{
+			ID:       QuestionAuthType,
+			Prompt:   "Authentication",
+			Kind:     domain.QuestionKindChoice,
+			Choices:  []string{AuthTypeNone, AuthTypeBearer, AuthTypeBasic, AuthTypeAPIKey},
+			Default:  authDefault,
+			Required: true,
+		})
+
+	switch session.Answers[QuestionAuthType] {
+	case AuthTypeBearer:
+		questions = append(questions, credentialsQuestion("Bearer token"))
+	case AuthTypeBasic:
+		questions = append(questions, credentialsQuestion("Credentials as user:password"))
+	case AuthTypeAPIKey:
+		header := session.Defaults[QuestionAPIKeyHeader]
+		if header == "" {
+			header = "X-API-Key"
+		}
+		questions = append(questions,
+			domain.WizardQuestion{
+				ID:       QuestionAPIKeyHeader,
+				Prompt:   "Header carrying the API key",
+				Kind:     domain.QuestionKindString,
+				Default:  header,
+				Required: true,
+			},
+			credentialsQuestion("API key"))
+	}
+
+	return questions
+}
+
+func credentialsQuestion(prompt string) domain.WizardQuestion {
+	return domain.WizardQuestion{
+		ID:       QuestionAuthCredentials,
+		Prompt:   prompt,
+		Kind:     domain.QuestionKindSecret,
+		Required: true,
+	}
+}
+
+// validateWizardAnswer проверяет ответ и приводит его к каноническому виду
+func validateWizardAnswer(session *domain.WizardSession, question *domain.WizardQuestion, value string) (string, error) {
+	invalid := func(format string, args ...interface{}) error {
+		return errors.New(errors.ErrValidation, "invalid answer").
+			WithDetails(fmt.Sprintf("%s: %s", question.ID, fmt.Sprintf(format, args...)))
+	}
+
+	if value == "" {
+		return "", invalid("value is required")
+	}
+
+	switch question.ID {
+	case QuestionTargets:
+		if strings.EqualFold(value, allTargets) {
+			return allTargets, nil
+		}
+		known := make(map[string]bool, len(question.Choices))
+		for _, choice := range question.Choices {
+			known[choice] = true
+		}
+		selected := make([]string, 0)
+		seen := make(map[string]bool)
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" || seen[name] {
+				continue
+			}
+			if !known[name] {
+				return "", invalid("unknown method %q", name)
+			}
+			seen[name] = true
+			selected = append(selected, name)
+		}
+		if len(selected) == 0 {
+			return "", invalid("select at least one method")
+		}
+		sort.Strings(selected)
+		return strings.Join(selected, ","), nil
+
+	case QuestionHost:
+		if len(value) > 253 || strings.ContainsAny(value, "/: \t") {
+			if ip := net.ParseIP(value); ip == nil {
+				return "", invalid("expected a host name or IP address without scheme and port")
+			}
+		}
+		return value, nil
+
+	case QuestionPort:
+		port, err := strconv.Atoi(value)
+		if err != nil || port < 1 || port > 65535 {
+			return "", invalid("port must be between 1 and 65535")
+		}
+		return strconv.Itoa(port), nil
+
+	case QuestionBaseURL:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "", invalid("expected an absolute http or https URL")
+		}
+		return strings.TrimSuffix(value, "/"), nil
+
+	case QuestionInterval:
+		interval, err := strconv.Atoi(value)
+		if err != nil || interval < MinWizardInterval || interval > MaxWizardInterval {
+			return "", invalid("interval must be between %d and %d seconds", MinWizardInterval, MaxWizardInterval)
+		}
+		return strconv.Itoa(interval), nil
+
+	case QuestionTimeout:
+		timeout, err := strconv.Atoi(value)
+		if err != nil || timeout < 1 || timeout > MaxWizardTimeout {
+			return "", invalid("timeout must be between 1 and %d seconds", MaxWizardTimeout)
+		}
+		if interval, ok := session.Answers[QuestionInterval]; ok && timeout >= mustAtoi(interval) {
+			return "", invalid("timeout must be less than the interval (%s seconds)", interval)
+		}
+		return strconv.Itoa(timeout), nil
+
+	case QuestionAuthType:
+		for _, choice := range question.Choices {
+			if value == choice {
+				return value, nil
+			}
+		}
+		return "", invalid("expected one of %s", strings.Join(question.Choices, ", "))
+
+	case QuestionAuthCredentials:
+		if session.Answers[QuestionAuthType] == AuthTypeBasic && !strings.Contains(value, ":") {
+			return "", invalid("expected user:password")
+		}
+		return value, nil
+
+	case QuestionAPIKeyHeader:
+		if !headerNameRegex.MatchString(value) {
+			return "", invalid("invalid header name")
+		}
+		return value, nil
+	}
+
+	return value, nil
+}
+
+// buildWizardChecks строит проверки по ответам сессии
+func buildWizardChecks(session *domain.WizardSession, maskSecrets bool) []domain.WizardCheck {
+	answers := session.Answers
+	interval := mustAtoi(answers[QuestionInterval])
+	timeout := mustAtoi(answers[QuestionTimeout])
+
+	selected := make(map[string]bool)
+	if answers[QuestionTargets] != allTargets {
+		for _, name := range strings.Split(answers[QuestionTargets], ",") {
+			selected[name] = true
+		}
+	}
+
+	authHeader, authValue := wizardAuthHeader(answers)
+	if maskSecrets && authValue != "" {
+		authValue = maskedSecret
+	}
+
+	checks := make([]domain.WizardCheck, 0, len(session.Targets))
+	for _, target := range session.Targets {
+		if len(selected) > 0 && !selected[target.Name] {
+			continue
+		}
+
+		check := domain.WizardCheck{
+			Name:     target.Name,
+			Interval: interval,
+			Timeout:  timeout,
+			Config:   make(map[string]string),
+		}
+		if session.SourceType == domain.WizardSourceOpenAPI {
+			check.Type = "http"
+			check.Target = answers[QuestionBaseURL] + target.Path
+			check.Config["method"] = target.Method
+			check.Config["path"] = target.Path
+			if strings.HasPrefix(answers[QuestionBaseURL], "https://") {
+				check.Type = "https"
+			}
+		} else {
+			check.Type = "grpc"
+			check.Target = net.JoinHostPort(answers[QuestionHost], answers[QuestionPort])
+			check.Config["service"] = target.Service
+			check.Config["method"] = target.Method
+		}
+		if authHeader != "" {
+			check.Config["header."+authHeader] = authValue
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// wizardAuthHeader возвращает заголовок аутентификации и его значение
+func wizardAuthHeader(answers map[string]string) (string, string) {
+	credentials := answers[QuestionAuthCredentials]
+	switch answers[QuestionAuthType] {
+	case AuthTypeBearer:
+		return "Authorization", "Bearer " + credentials
+	case AuthTypeBasic:
+		return "Authorization", "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	case AuthTypeAPIKey:
+		return answers[QuestionAPIKeyHeader], credentials
+	}
+	return "", ""
+}
+
+// applyWizardServices переносит выбранные gRPC методы в конфигурацию сервисов
+func applyWizardServices(session *domain.WizardSession, config *domain.InteractiveConfig) {
+	if config.Services == nil {
+		config.Services = make(map[string]*domain.ServiceConfig)
+	}
+	port := mustAtoi(session.Answers[QuestionPort])
+	timeout := session.Answers[QuestionTimeout] + "s"
+
+	for _, check := range buildWizardChecks(session, true) {
+		service := check.Config["service"]
+		serviceConfig, ok := config.Services[service]
+		if !ok {
+			serviceConfig = domain.NewDefaultServiceConfig()
+			config.Services[service] = serviceConfig
+		}
+		serviceConfig.Host = session.Answers[QuestionHost]
+		serviceConfig.Port = port
+		serviceConfig.DefaultTimeout = timeout
+		serviceConfig.EnabledMethods = appendUnique(serviceConfig.EnabledMethods, check.Config["method"])
+	}
+}
+
+// protoTargets превращает методы разобранных сервисов в цели мастера
+func protoTargets(result *ParseResult) []domain.WizardTarget {
+	var targets []domain.WizardTarget
+	for _, svc := range result.Services {
+		fullName := svc.Name
+		if svc.Package != "" && svc.Package != "default" {
+			fullName = svc.Package + "." + svc.Name
+		}
+		for _, method := range svc.Methods {
+			if method.Name == "" {
+				continue
+			}
+			targets = append(targets, domain.WizardTarget{
+				Name:    svc.Name + "." + method.Name,
+				Service: fullName,
+				Method:  method.Name,
+			})
+		}
+	}
+	return targets
+}
+
+// maskWizardSession возвращает копию сессии без секретов
+func maskWizardSession(session *domain.WizardSession) *domain.WizardSession {
+	masked := cloneWizardSession(session)
+	if _, ok := masked.Answers[QuestionAuthCredentials]; ok {
+		masked.Answers[QuestionAuthCredentials] = maskedSecret
+	}
+	return masked
+}
+
+func newWizardSessionID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.Wrap(err, errors.ErrInternal, "failed to generate wizard session id")
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+// mustAtoi разбирает число, уже прошедшее validateWizardAnswer
+func mustAtoi(value string) int {
+	n, _ := strconv.Atoi(value)
+	return n
+}
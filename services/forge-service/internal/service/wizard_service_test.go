@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/forge-service/internal/domain"
+)
+
+const sampleOpenAPI = `openapi: 3.0.0
+info:
+  title: Users
+  version: "1"
+servers:
+  - url: https://api.example.com/v1/
+paths:
+  /users:
+    get:
+      operationId: listUsers
+    post:
+      operationId: createUser
+  /health:
+    get: {}
+components:
+  securitySchemes:
+    key:
+      type: apiKey
+      in: header
+      name: X-Token
+`
+
+type fakeConfigGenerator struct {
+	calls int
+}
+
+func (g *fakeConfigGenerator) GenerateInteractiveConfig(config *domain.InteractiveConfig) error {
+	g.calls++
+	return nil
+}
+
+func newTestWizard(t *testing.T) *WizardService {
+	log, err := logger.NewLogger("test", "debug", "forge-service", false)
+	require.NoError(t, err)
+	return NewWizardService(NewInProcessParser(), NewMemoryWizardStore(), log)
+}
+
+func errorCode(err error) pkgErrors.ErrorCode {
+	if appErr, ok := err.(*pkgErrors.Error); ok {
+		return appErr.Code
+	}
+	return ""
+}
+
+func answerAll(t *testing.T, wizard *WizardService, step *WizardStep, answers map[string]string) *WizardStep {
+	for step.Next != nil {
+		var err error
+		step, err = wizard.Answer(context.Background(), "tenant-1", step.Session.ID, step.Next.ID, answers[step.Next.ID])
+		require.NoError(t, err)
+	}
+	return step
+}
+
+func TestWizardService_ProtoFlow(t *testing.T) {
+	ctx := context.Background()
+	wizard := newTestWizard(t)
+	generator := &fakeConfigGenerator{}
+	wizard.WithConfigGenerator(generator)
+
+	step, err := wizard.Start(ctx, "tenant-1", domain.WizardSourceProto, "users.proto", sampleProto)
+	require.NoError(t, err)
+	require.NotNil(t, step.Next)
+	assert.Equal(t, QuestionTargets, step.Next.ID)
+	assert.Equal(t, []string{"TestService.GetUser"}, step.Next.Choices)
+	assert.Equal(t, domain.WizardStateCollecting, step.Session.State)
+
+	_, err = wizard.Preview(ctx, "tenant-1", step.Session.ID)
+	assert.Equal(t, pkgErrors.ErrConflict, errorCode(err))
+
+	step = answerAll(t, wizard, step, map[string]string{
+		QuestionHost:            "users.internal",
+		QuestionPort:            "9090",
+		QuestionAuthType:        AuthTypeBearer,
+		QuestionAuthCredentials: "secret-token",
+	})
+	assert.Equal(t, domain.WizardStateReady, step.Session.State)
+	assert.Equal(t, maskedSecret, step.Session.Answers[QuestionAuthCredentials])
+
+	preview, err := wizard.Preview(ctx, "tenant-1", step.Session.ID)
+	require.NoError(t, err)
+	require.Len(t, preview, 1)
+	assert.Equal(t, "grpc", preview[0].Type)
+	assert.Equal(t, "users.internal:9090", preview[0].Target)
+	assert.Equal(t, 60, preview[0].Interval)
+	assert.Equal(t, 10, preview[0].Timeout)
+	assert.Equal(t, "test.service.TestService", preview[0].Config["service"])
+	assert.Equal(t, maskedSecret, preview[0].Config["header.Authorization"])
+
+	config := domain.NewDefaultInteractiveConfig()
+	commit, err := wizard.Commit(ctx, "tenant-1", step.Session.ID, config)
+	require.NoError(t, err)
+	assert.Equal(t, domain.WizardStateCommitted, commit.Session.State)
+	assert.Equal(t, "Bearer secret-token", commit.Checks[0].Config["header.Authorization"])
+	assert.Equal(t, 1, generator.calls)
+
+	serviceConfig := config.Services["test.service.TestService"]
+	require.NotNil(t, serviceConfig)
+	assert.Equal(t, "users.internal", serviceConfig.Host)
+	assert.Equal(t, 9090, serviceConfig.Port)
+	assert.Equal(t, "10s", serviceConfig.DefaultTimeout)
+	assert.Equal(t, []string{"GetUser"}, serviceConfig.EnabledMethods)
+
+	_, err = wizard.Commit(ctx, "tenant-1", step.Session.ID, config)
+	assert.Equal(t, pkgErrors.ErrConflict, errorCode(err))
+	_, err = wizard.Answer(ctx, "tenant-1", step.Session.ID, QuestionPort, "1")
+	assert.Equal(t, pkgErrors.ErrConflict, errorCode(err))
+}
+
+func TestWizardService_OpenAPIFlow(t *testing.T) {
+	ctx := context.Background()
+	wizard := newTestWizard(t)
+
+	step, err := wizard.Start(ctx, "tenant-1", domain.WizardSourceOpenAPI, "users.yaml", sampleOpenAPI)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /health", "listUsers", "createUser"}, step.Next.Choices)
+
+	step, err = wizard.Answer(ctx, "tenant-1", step.Session.ID, QuestionTargets, "listUsers, GET /health")
+	require.NoError(t, err)
+
+	step = answerAll(t, wizard, step, map[string]string{
+		QuestionInterval:        "120",
+		QuestionAuthCredentials: "key-123",
+	})
+	assert.Equal(t, AuthTypeAPIKey, step.Session.Answers[QuestionAuthType])
+	assert.Equal(t, "X-Token", step.Session.Answers[QuestionAPIKeyHeader])
+	assert.Equal(t, "https://api.example.com/v1", step.Session.Answers[QuestionBaseURL])
+
+	commit, err := wizard.Commit(ctx, "tenant-1", step.Session.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, commit.Checks, 2)
+	assert.Equal(t, "https", commit.Checks[0].Type)
+	assert.Equal(t, "https://api.example.com/v1/health", commit.Checks[0].Target)
+	assert.Equal(t, "listUsers", commit.Checks[1].Name)
+	assert.Equal(t, "GET", commit.Checks[1].Config["method"])
+	assert.Equal(t, "key-123", commit.Checks[1].Config["header.X-Token"])
+	assert.Equal(t, 120, commit.Checks[1].Interval)
+}
+
+func TestWizardService_AnswerValidation(t *testing.T) {
+	ctx := context.Background()
+	wizard := newTestWizard(t)
+
+	step, err := wizard.Start(ctx, "tenant-1", domain.WizardSourceProto, "users.proto", sampleProto)
+	require.NoError(t, err)
+	id := step.Session.ID
+
+	tests := []struct {
+		question string
+		value    string
+	}{
+		{QuestionTargets, "Unknown.Method"},
+		{QuestionHost, "http://users.internal"},
+		{QuestionPort, "70000"},
+		{QuestionInterval, "5"},
+		{QuestionTimeout, "0"},
+		{QuestionAuthType, "oauth"},
+		{QuestionBaseURL, "https://example.com"},
+		{QuestionAuthCredentials, "token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.question+"="+tt.value, func(t *testing.T) {
+			_, err := wizard.Answer(ctx, "tenant-1", id, tt.question, tt.value)
+			assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+		})
+	}
+
+	t.Run("timeout must be less than interval", func(t *testing.T) {
+		_, err := wizard.Answer(ctx, "tenant-1", id, QuestionInterval, "30")
+		require.NoError(t, err)
+		_, err = wizard.Answer(ctx, "tenant-1", id, QuestionTimeout, "30")
+		assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+	})
+
+	t.Run("changing auth type drops credentials", func(t *testing.T) {
+		_, err := wizard.Answer(ctx, "tenant-1", id, QuestionAuthType, AuthTypeBasic)
+		require.NoError(t, err)
+		_, err = wizard.Answer(ctx, "tenant-1", id, QuestionAuthCredentials, "no-colon")
+		assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+		_, err = wizard.Answer(ctx, "tenant-1", id, QuestionAuthCredentials, "user:pass")
+		require.NoError(t, err)
+
+		step, err := wizard.Answer(ctx, "tenant-1", id, QuestionAuthType, AuthTypeNone)
+		require.NoError(t, err)
+		assert.NotContains(t, step.Session.Answers, QuestionAuthCredentials)
+	})
+}
+
+func TestWizardService_SessionIsolation(t *testing.T) {
+	ctx := context.Background()
+	wizard := newTestWizard(t)
+	now := time.Now()
+	wizard.now = func() time.Time { return now }
+
+	step, err := wizard.Start(ctx, "tenant-1", domain.WizardSourceProto, "users.proto", sampleProto)
+	require.NoError(t, err)
+
+	_, err = wizard.Get(ctx, "tenant-2", step.Session.ID)
+	assert.Equal(t, pkgErrors.ErrNotFound, errorCode(err))
+
+	now = now.Add(DefaultWizardSessionTTL + time.Second)
+	_, err = wizard.Get(ctx, "tenant-1", step.Session.ID)
+	assert.Equal(t, pkgErrors.ErrNotFound, errorCode(err))
+}
+
+func TestWizardService_StartRejectsInvalidSources(t *testing.T) {
+	ctx := context.Background()
+	wizard := newTestWizard(t)
+
+	_, err := wizard.Start(ctx, "tenant-1", "wsdl", "x", sampleProto)
+	assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+
+	_, err = wizard.Start(ctx, "tenant-1", domain.WizardSourceProto, "x.proto", `import "/etc/passwd.proto";`)
+	assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+
+	_, err = wizard.Start(ctx, "tenant-1", domain.WizardSourceOpenAPI, "x.yaml", "openapi: 3.0.0\npaths: {}\n")
+	assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+
+	_, err = wizard.Start(ctx, "", domain.WizardSourceProto, "x.proto", sampleProto)
+	assert.Equal(t, pkgErrors.ErrValidation, errorCode(err))
+}
+
+func TestParseOpenAPI_Swagger2JSON(t *testing.T) {
+	doc, err := ParseOpenAPI(`{
+		"swagger": "2.0",
+		"host": "petstore.example.com",
+		"basePath": "/api",
+		"schemes": ["http"],
+		"securityDefinitions": {"basic": {"type": "basic"}},
+		"paths": {"/pets": {"get": {"operationId": "listPets"}, "parameters": []}}
+	}`)
+	require.NoError(t, err)
+	assert.Equal(t, "http://petstore.example.com/api", doc.BaseURL)
+	assert.Equal(t, AuthTypeBasic, doc.AuthType)
+	require.Len(t, doc.Targets, 1)
+	assert.Equal(t, "listPets", doc.Targets[0].Name)
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/forge-service/internal/domain"
+)
+
+// WizardStore хранилище сессий мастера
+type WizardStore interface {
+	// Save создает или заменяет сессию
+	Save(ctx context.Context, session *domain.WizardSession) error
+
+	// Get возвращает сессию или ошибку ErrNotFound, если ее нет или она истекла
+	Get(ctx context.Context, id string) (*domain.WizardSession, error)
+
+	// Delete удаляет сессию
+	Delete(ctx context.Context, id string) error
+}
+
+// memoryWizardStore хранит сессии в памяти процесса
+type memoryWizardStore struct {
+	mu       sync.Mutex
+	sessions map[string]*domain.WizardSession
+	now      func() time.Time
+}
+
+// NewMemoryWizardStore создает хранилище сессий в памяти.
+// Истекшие сессии удаляются при обращении к хранилищу
+func NewMemoryWizardStore() WizardStore {
+	return &memoryWizardStore{
+		sessions: make(map[string]*domain.WizardSession),
+		now:      time.Now,
+	}
+}
+
+// Save сохраняет копию сессии
+func (s *memoryWizardStore) Save(ctx context.Context, session *domain.WizardSession) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evictExpired()
+	s.sessions[session.ID] = cloneWizardSession(session)
+	return nil
+}
+
+// Get возвращает копию сессии
+func (s *memoryWizardStore) Get(ctx context.Context, id string) (*domain.WizardSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evictExpired()
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "wizard session not found")
+	}
+	return cloneWizardSession(session), nil
+}
+
+// Delete удаляет сессию
+func (s *memoryWizardStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, id)
+	return nil
+}
+
+// evictExpired удаляет истекшие сессии; вызывается под блокировкой
+func (s *memoryWizardStore) evictExpired() {
+	now := s.now()
+	for id, session := range s.sessions {
+		if now.After(session.ExpiresAt) {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// cloneWizardSession копирует сессию, чтобы вызывающий код не менял сохраненное состояние
+func cloneWizardSession(session *domain.WizardSession) *domain.WizardSession {
+	clone := *session
+	clone.Targets = append([]domain.WizardTarget(nil), session.Targets...)
+	clone.Defaults = make(map[string]string, len(session.Defaults))
+	for k, v := range session.Defaults {
+		clone.Defaults[k] = v
+	}
+	clone.Answers = make(map[string]string, len(session.Answers))
+	for k, v := range session.Answers {
+		clone.Answers[k] = v
+	}
+	return &clone
+}
@@ -8,6 +8,7 @@ import (
 type TemplateManager struct {
 	configTemplate *template.Template
 	grpcTemplate   *template.Template
+	stubs          *StubRegistry
 }
 
 // NewTemplateManager создает новый менеджер шаблонов
@@ -15,6 +16,7 @@ func NewTemplateManager() *TemplateManager {
 	return &TemplateManager{
 		configTemplate: createConfigTemplate(),
 		grpcTemplate:   createGRPCTemplate(),
+		stubs:          NewStubRegistry(),
 	}
 }
 
@@ -28,6 +30,11 @@ func (tm *TemplateManager) GetGRPCTemplate() (*template.Template, error) {
 	return tm.grpcTemplate, nil
 }
 
+// Stubs возвращает реестр шаблонов клиентских заготовок
+func (tm *TemplateManager) Stubs() *StubRegistry {
+	return tm.stubs
+}
+
 // createConfigTemplate создает шаблон для YAML конфигурации
 func createConfigTemplate() *template.Template {
 	const configTemplate = `# UptimePing Core Configuration
@@ -0,0 +1,545 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"unicode"
+)
+
+// Языки клиентских заготовок, поддерживаемые из коробки
+const (
+	LanguageGo         = "go"
+	LanguageTypeScript = "typescript"
+	LanguagePython     = "python"
+)
+
+// identifierPattern допустимые имена пакетов, сервисов, методов, сообщений и полей
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// mapTypePattern тип поля map<key,value>; в заготовках он отображается как нетипизированное значение
+var mapTypePattern = regexp.MustCompile(`^map<[A-Za-z0-9_]+,[A-Za-z_][A-Za-z0-9_.]*>$`)
+
+// StubField поле сообщения
+type StubField struct {
+	Name     string
+	Type     string
+	Number   int
+	Repeated bool
+}
+
+// StubMessage сообщение protobuf
+type StubMessage struct {
+	Name   string
+	Fields []StubField
+}
+
+// StubMethod метод gRPC сервиса
+type StubMethod struct {
+	Name       string
+	InputType  string
+	OutputType string
+}
+
+// StubData данные для шаблонов клиентских заготовок
+type StubData struct {
+	Package  string
+	Service  string
+	Methods  []StubMethod
+	Messages []StubMessage
+	Enums    []string
+}
+
+// FullService возвращает полное имя сервиса с пакетом
+func (d StubData) FullService() string {
+	if d.Package == "" {
+		return d.Service
+	}
+	return d.Package + "." + d.Service
+}
+
+// Validate проверяет, что все имена можно безопасно подставить в исходный код
+func (d StubData) Validate() error {
+	names := []string{d.Service}
+	if d.Package != "" {
+		names = append(names, d.Package)
+	}
+	for _, method := range d.Methods {
+		names = append(names, method.Name, method.InputType, method.OutputType)
+	}
+	for _, message := range d.Messages {
+		names = append(names, message.Name)
+		for _, field := range message.Fields {
+			names = append(names, field.Name)
+			if !mapTypePattern.MatchString(field.Type) {
+				names = append(names, field.Type)
+			}
+		}
+	}
+	names = append(names, d.Enums...)
+
+	for _, name := range names {
+		if !identifierPattern.MatchString(name) {
+			return fmt.Errorf("invalid identifier %q", name)
+		}
+	}
+	return nil
+}
+
+// StubTemplate шаблон клиентской заготовки для одного языка
+type StubTemplate struct {
+	// Language ключ, по которому шаблон выбирается через CodeOptions.Language
+	Language    string
+	Description string
+	// FileName шаблон имени сгенерированного файла, например "{{snake .Service}}_checker.py"
+	FileName string
+	// Source шаблон исходного кода
+	Source string
+}
+
+// StubRegistry реестр шаблонов клиентских заготовок по языкам
+type StubRegistry struct {
+	mu        sync.RWMutex
+	templates map[string]StubTemplate
+}
+
+// NewStubRegistry создает реестр со встроенными шаблонами Go, TypeScript и Python
+func NewStubRegistry() *StubRegistry {
+	r := &StubRegistry{templates: make(map[string]StubTemplate)}
+	for _, t := range builtinStubTemplates() {
+		if err := r.Register(t); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// Register добавляет или заменяет шаблон языка; шаблоны разбираются сразу, чтобы ошибки не доходили до генерации
+func (r *StubRegistry) Register(t StubTemplate) error {
+	t.Language = strings.ToLower(strings.TrimSpace(t.Language))
+	if t.Language == "" {
+		return fmt.Errorf("stub template language is required")
+	}
+	if _, err := parseStubTemplate(t.Language+"_file", t.FileName, StubData{}); err != nil {
+		return fmt.Errorf("invalid file name template for %s: %w", t.Language, err)
+	}
+	if _, err := parseStubTemplate(t.Language, t.Source, StubData{}); err != nil {
+		return fmt.Errorf("invalid source template for %s: %w", t.Language, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.templates[t.Language] = t
+	return nil
+}
+
+// Get возвращает шаблон языка
+func (r *StubRegistry) Get(language string) (StubTemplate, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	t, ok := r.templates[strings.ToLower(strings.TrimSpace(language))]
+	return t, ok
+}
+
+// Languages возвращает отсортированный список зарегистрированных языков
+func (r *StubRegistry) Languages() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	languages := make([]string, 0, len(r.templates))
+	for language := range r.templates {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Render генерирует исходный код и имя файла заготовки
+func (t StubTemplate) Render(data StubData) (string, string, error) {
+	fileTmpl, err := parseStubTemplate(t.Language+"_file", t.FileName, data)
+	if err != nil {
+		return "", "", err
+	}
+	var fileName bytes.Buffer
+	if err := fileTmpl.Execute(&fileName, data); err != nil {
+		return "", "", fmt.Errorf("failed to execute file name template: %w", err)
+	}
+
+	sourceTmpl, err := parseStubTemplate(t.Language, t.Source, data)
+	if err != nil {
+		return "", "", err
+	}
+	var source bytes.Buffer
+	if err := sourceTmpl.Execute(&source, data); err != nil {
+		return "", "", fmt.Errorf("failed to execute %s stub template: %w", t.Language, err)
+	}
+	return source.String(), fileName.String(), nil
+}
+
+// parseStubTemplate разбирает шаблон с функциями, знающими сообщения и перечисления data
+func parseStubTemplate(name, text string, data StubData) (*template.Template, error) {
+	return template.New(name).Funcs(stubFuncs(data)).Parse(text)
+}
+
+// stubFuncs функции шаблонов; отображение типов зависит от сообщений и перечислений схемы
+func stubFuncs(data StubData) template.FuncMap {
+	messages := make(map[string]bool, len(data.Messages))
+	for _, message := range data.Messages {
+		messages[message.Name] = true
+	}
+	enums := make(map[string]bool, len(data.Enums))
+	for _, enum := range data.Enums {
+		enums[enum] = true
+	}
+
+	return template.FuncMap{
+		"snake":      snakeCase,
+		"upperSnake": func(s string) string { return strings.ToUpper(snakeCase(s)) },
+		"camel":      lowerCamelCase,
+		"tsType": func(protoType string, repeated bool) string {
+			t := tsScalarType(protoType)
+			switch {
+			case t != "":
+			case messages[protoType]:
+				t = protoType
+			case enums[protoType]:
+				t = "number"
+			default:
+				t = "unknown"
+			}
+			if repeated {
+				return t + "[]"
+			}
+			return t
+		},
+		"pyType": func(protoType string, repeated bool) string {
+			t := pyScalarType(protoType)
+			switch {
+			case t != "":
+			case messages[protoType]:
+				t = `"` + protoType + `"`
+				if !repeated {
+					return "Optional[" + t + "]"
+				}
+			case enums[protoType]:
+				t = "int"
+			default:
+				t = "Any"
+			}
+			if repeated {
+				return "List[" + t + "]"
+			}
+			return t
+		},
+		"pyDefault": func(protoType string, repeated bool) string {
+			if repeated {
+				return "field(default_factory=list)"
+			}
+			switch t := pyScalarType(protoType); {
+			case t == "float":
+				return "0.0"
+			case t == "int", enums[protoType]:
+				return "0"
+			case t == "bool":
+				return "False"
+			case t == "str":
+				return `""`
+			case t == "bytes":
+				return `b""`
+			default:
+				return "None"
+			}
+		},
+		"pyName": pythonName,
+	}
+}
+
+// tsScalarType отображает скалярный тип protobuf в TypeScript по правилам proto3 JSON
+func tsScalarType(protoType string) string {
+	switch protoType {
+	case "double", "float", "int32", "uint32", "sint32", "fixed32", "sfixed32":
+		return "number"
+	case "int64", "uint64", "sint64", "fixed64", "sfixed64", "string":
+		return "string"
+	case "bool":
+		return "boolean"
+	case "bytes":
+		return "Uint8Array"
+	}
+	return ""
+}
+
+// pyScalarType отображает скалярный тип protobuf в Python
+func pyScalarType(protoType string) string {
+	switch protoType {
+	case "double", "float":
+		return "float"
+	case "int32", "uint32", "sint32", "fixed32", "sfixed32", "int64", "uint64", "sint64", "fixed64", "sfixed64":
+		return "int"
+	case "bool":
+		return "bool"
+	case "string":
+		return "str"
+	case "bytes":
+		return "bytes"
+	}
+	return ""
+}
+
+// pythonKeywords зарезервированные слова Python, которые не могут быть именами полей
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true, "async": true,
+	"await": true, "break": true, "class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// pythonName возвращает имя поля в snake_case, не совпадающее с ключевым словом Python
+func pythonName(s string) string {
+	name := snakeCase(s)
+	if pythonKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+// snakeCase переводит CamelCase в snake_case: GetHTTPStatus -> get_http_status
+func snakeCase(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && runes[i-1] != '_' &&
+				(unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+					(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// lowerCamelCase переводит snake_case в lowerCamelCase, как это делает proto3 JSON: user_id -> userId
+func lowerCamelCase(s string) string {
+	var b strings.Builder
+	upper := false
+	for i, r := range s {
+		switch {
+		case r == '_':
+			upper = i > 0
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		case i == 0:
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// builtinStubTemplates встроенные шаблоны клиентских заготовок
+func builtinStubTemplates() []StubTemplate {
+	return []StubTemplate{
+		{
+			Language:    LanguageGo,
+			Description: "Go gRPC health checker",
+			FileName:    "{{.Service}}_checker.go",
+			Source:      goStubTemplate,
+		},
+		{
+			Language:    LanguageTypeScript,
+			Description: "TypeScript message interfaces, check definitions and @grpc/grpc-js health checker",
+			FileName:    "{{.Service}}Checker.ts",
+			Source:      typeScriptStubTemplate,
+		},
+		{
+			Language:    LanguagePython,
+			Description: "Python dataclasses, check definitions and grpcio health checker",
+			FileName:    "{{snake .Service}}_checker.py",
+			Source:      pythonStubTemplate,
+		},
+	}
+}
+
+const goStubTemplate = `package checkers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type {{.Service}}Checker struct {
+	target    string
+	timeout   time.Duration
+}
+
+func New{{.Service}}Checker(target string, timeout time.Duration) *{{.Service}}Checker {
+	return &{{.Service}}Checker{
+		target:  target,
+		timeout: timeout,
+	}
+}
+
+func (c *{{.Service}}Checker) Execute(ctx context.Context) error {
+	conn, err := grpc.DialContext(ctx, c.target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", c.target, err)
+	}
+	defer conn.Close()
+
+	// Health check
+	client := grpc_health_v1.NewHealthClient(conn)
+	resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service is not healthy: %v", resp.Status)
+	}
+
+	return nil
+}
+`
+
+const typeScriptStubTemplate = `// Code generated by Forge Service. DO NOT EDIT.
+// Source: {{.FullService}}
+
+import * as grpc from "@grpc/grpc-js";
+{{range .Messages}}
+export interface {{.Name}} {
+{{- range .Fields}}
+  {{camel .Name}}?: {{tsType .Type .Repeated}};
+{{- end}}
+}
+{{end}}
+export interface CheckDefinition {
+  name: string;
+  type: "grpc";
+  service: string;
+  method: string;
+  requestType: string;
+  responseType: string;
+}
+
+export const {{.Service}}Checks: CheckDefinition[] = [
+{{- range .Methods}}
+  {
+    name: "{{$.Service}}.{{.Name}}",
+    type: "grpc",
+    service: "{{$.FullService}}",
+    method: "{{.Name}}",
+    requestType: "{{.InputType}}",
+    responseType: "{{.OutputType}}",
+  },
+{{- end}}
+];
+
+// grpc.health.v1.HealthCheckResponse.ServingStatus.SERVING
+const SERVING = 1;
+
+export class {{.Service}}Checker {
+  constructor(
+    private readonly target: string,
+    private readonly timeoutMs: number = 10000,
+  ) {}
+
+  execute(): Promise<void> {
+    const client = new grpc.Client(this.target, grpc.credentials.createInsecure());
+    return new Promise<void>((resolve, reject) => {
+      client.makeUnaryRequest(
+        "/grpc.health.v1.Health/Check",
+        () => Buffer.alloc(0),
+        (response: Buffer) => (response.length >= 2 && response[0] === 0x08 ? response[1] : 0),
+        {},
+        { deadline: Date.now() + this.timeoutMs },
+        (err, status) => {
+          client.close();
+          if (err) {
+            reject(new Error(` + "`" + `health check failed: ${err.message}` + "`" + `));
+          } else if (status !== SERVING) {
+            reject(new Error(` + "`" + `service is not healthy: ${status}` + "`" + `));
+          } else {
+            resolve();
+          }
+        },
+      );
+    });
+  }
+}
+`
+
+const pythonStubTemplate = `# Code generated by Forge Service. DO NOT EDIT.
+# Source: {{.FullService}}
+
+from dataclasses import dataclass, field
+from typing import Any, List, Optional
+
+import grpc
+from grpc_health.v1 import health_pb2, health_pb2_grpc
+{{range .Messages}}
+
+@dataclass
+class {{.Name}}:
+{{- range .Fields}}
+    {{pyName .Name}}: {{pyType .Type .Repeated}} = {{pyDefault .Type .Repeated}}
+{{- else}}
+    pass
+{{- end}}
+{{end}}
+
+@dataclass(frozen=True)
+class CheckDefinition:
+    name: str
+    type: str
+    service: str
+    method: str
+    request_type: str
+    response_type: str
+
+
+{{upperSnake .Service}}_CHECKS = [
+{{- range .Methods}}
+    CheckDefinition(
+        name="{{$.Service}}.{{.Name}}",
+        type="grpc",
+        service="{{$.FullService}}",
+        method="{{.Name}}",
+        request_type="{{.InputType}}",
+        response_type="{{.OutputType}}",
+    ),
+{{- end}}
+]
+
+
+class {{.Service}}Checker:
+    def __init__(self, target: str, timeout: float = 10.0) -> None:
+        self.target = target
+        self.timeout = timeout
+
+    def execute(self) -> None:
+        with grpc.insecure_channel(self.target) as channel:
+            stub = health_pb2_grpc.HealthStub(channel)
+            try:
+                resp = stub.Check(health_pb2.HealthCheckRequest(), timeout=self.timeout)
+            except grpc.RpcError as err:
+                raise RuntimeError(f"health check failed: {err}") from err
+            if resp.status != health_pb2.HealthCheckResponse.SERVING:
+                raise RuntimeError(f"service is not healthy: {resp.status}")
+`
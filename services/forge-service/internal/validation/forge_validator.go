@@ -202,7 +202,7 @@ func (v *ForgeValidator) validateProtoSyntax(content string) error {
 
 // validatePackageLine валидирует строку объявления пакета
 func (v *ForgeValidator) validatePackageLine(line string) error {
-	packageRegex := regexp.MustCompile(`^package\s+([a-zA-Z][a-zA-Z0-9_]*)\s*;?\s*$`)
+	packageRegex := regexp.MustCompile(`^package\s+([a-zA-Z][a-zA-Z0-9_]*(\.[a-zA-Z][a-zA-Z0-9_]*)*)\s*;?\s*$`)
 	if !packageRegex.MatchString(line) {
 		return errors.New(errors.ErrValidation, "invalid package declaration format")
 	}
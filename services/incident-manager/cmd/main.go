@@ -4,17 +4,47 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
 	"UptimePingPlatform/pkg/config"
+	pkg_database "UptimePingPlatform/pkg/database"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/health"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/metrics"
+	"UptimePingPlatform/pkg/rabbitmq"
 	pkg_redis "UptimePingPlatform/pkg/redis"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	"UptimePingPlatform/services/incident-manager/internal/client"
+	"UptimePingPlatform/services/incident-manager/internal/email"
+	grpcHandler "UptimePingPlatform/services/incident-manager/internal/handler/grpc"
+	incidentProducer "UptimePingPlatform/services/incident-manager/internal/producer/rabbitmq"
+	"UptimePingPlatform/services/incident-manager/internal/repository/memory"
+	"UptimePingPlatform/services/incident-manager/internal/repository/postgres"
+	redisRepo "UptimePingPlatform/services/incident-manager/internal/repository/redis"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+const (
+	// slaCheckInterval период проверки сроков SLA активных инцидентов
+	slaCheckInterval = 30 * time.Second
+	// reminderCheckInterval период проверки напоминаний о неподтвержденных инцидентах
+	reminderCheckInterval = time.Minute
+	// escalationCheckInterval период проверки таймеров эскалации простаивающих инцидентов
+	escalationCheckInterval = 15 * time.Second
+	// staleSweepInterval период закрытия устаревших инцидентов
+	staleSweepInterval = time.Minute
+	// defaultStaleIncidentTTL время без ошибок, после которого инцидент закрывается
+	defaultStaleIncidentTTL = 7 * 24 * time.Hour
 )
 
 func main() {
@@ -32,6 +62,7 @@ func main() {
 	defer appLogger.Sync()
 
 	appLogger.Info("Starting Incident Manager...")
+	appLogger.Info("Effective configuration", logger.Any("config", cfg.Redacted()))
 
 	// Initialize metrics
 	appMetrics := metrics.NewMetrics("incident-manager")
@@ -40,27 +71,155 @@ func main() {
 	// Initialize health checker
 	healthChecker := health.NewSimpleHealthChecker("1.0.0")
 
+	// Опрос глубины очередей RabbitMQ для /metrics
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	defer stopMonitor()
+	if cfg.RabbitMQ.URL != "" && cfg.RabbitMQ.Queue != "" {
+		if err := rabbitmq.StartQueueMonitor(monitorCtx, cfg.RabbitMQ.URL, []string{cfg.RabbitMQ.Queue}, appLogger); err != nil {
+			appLogger.Warn("Failed to start RabbitMQ queue monitor", logger.Error(err))
+		}
+	}
+
 	// Initialize Redis client
+	// Таймеры эскалации хранятся в Redis и переживают перезапуски; без Redis - в памяти процесса
+	var escalationTimers service.EscalationTimers
 	redisClient, err := pkg_redis.Connect(context.Background(), &pkg_redis.Config{
 		Addr:     cfg.Redis.Addr,
 		Password: cfg.Redis.Password,
 		DB:       cfg.Redis.DB,
 	})
 	if err != nil {
-		appLogger.Error("Failed to connect to Redis", logger.Error(err))
+		appLogger.Error("Failed to connect to Redis, escalation timers are kept in process memory", logger.Error(err))
+		escalationTimers = memory.NewEscalationTimers()
 	} else {
 		defer redisClient.Close()
+		escalationTimers = redisRepo.NewEscalationTimers(redisClient.Client)
+	}
+
+	// gRPC API инцидентов: вызовы пользователей проверяются по правам из x-permissions,
+	// внутренние вызовы сервисов - по общему секрету GRPC_SERVICE_TOKEN
+	if cfg.GRPC.ServiceToken == "" {
+		appLogger.Warn("GRPC_SERVICE_TOKEN is not set, internal service calls will be rejected")
+	}
+	incidentRepo := memory.NewIncidentRepository()
+	incidentConfig := service.DefaultIncidentConfig()
+	incidentService := service.NewIncidentService(
+		service.NewEscalationTimerRepository(incidentRepo, escalationTimers, incidentConfig.EscalationTimeouts, appLogger),
+		incidentConfig, appLogger,
+	)
+	incidentHandler := grpcHandler.NewIncidentHandler(incidentService, appLogger)
+
+	// События фоновых воркеров (нарушения SLA, напоминания, эскалации, закрытия) публикуются в notification-service;
+	// без RabbitMQ они только фиксируются в инцидентах
+	var producer incidentProducer.IncidentProducerInterface
+	if cfg.RabbitMQ.URL != "" {
+		producerConfig := incidentProducer.DefaultIncidentProducerConfig()
+		producerConfig.URL = cfg.RabbitMQ.URL
+		producer, err = incidentProducer.NewIncidentProducerFactory(appLogger).CreateProducer(producerConfig)
+		if err != nil {
+			appLogger.Error("Failed to create incident producer", logger.Error(err))
+		} else {
+			defer producer.Close()
+		}
+	}
+
+	slaPolicies, err := service.ParseSLAPolicies([]byte(os.Getenv("INCIDENT_SLA_POLICIES")))
+	if err != nil {
+		log.Fatalf("Invalid INCIDENT_SLA_POLICIES: %v", err)
+	}
+	reminderPolicy, err := service.ParseReminderPolicy([]byte(os.Getenv("INCIDENT_REMINDER_POLICY")))
+	if err != nil {
+		log.Fatalf("Invalid INCIDENT_REMINDER_POLICY: %v", err)
 	}
 
+	staleTTL := defaultStaleIncidentTTL
+	if value := os.Getenv("INCIDENT_STALE_TTL"); value != "" {
+		if staleTTL, err = time.ParseDuration(value); err != nil || staleTTL < 0 {
+			log.Fatalf("Invalid INCIDENT_STALE_TTL %q", value)
+		}
+	}
+
+	// Инциденты удаленных и выключенных проверок закрываются по состоянию проверок в
+	// scheduler-service; без соединения - только по сроку жизни
+	schedulerAddr := os.Getenv("SCHEDULER_SERVICE_ADDR")
+	if schedulerAddr == "" {
+		schedulerAddr = "scheduler-service:50052"
+	}
+	var checks service.CheckStateSource
+	schedulerConn, err := grpc.NewClient(pkg_grpc.BalancedTarget(schedulerAddr), pkg_grpc.BalancedDialOptions("",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		pkg_grpc.WithServiceToken(cfg.GRPC.ServiceToken))...)
+	if err != nil {
+		appLogger.Warn("Failed to connect to scheduler service, stale incidents are closed by TTL only", logger.Error(err))
+	} else {
+		defer schedulerConn.Close()
+		checks = client.NewSchedulerChecks(schedulerConn)
+	}
+
+	// Фоновые воркеры инцидентов работают до остановки сервиса
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go service.NewSLAMonitor(incidentRepo, slaPolicies, producer, appLogger).Run(workersCtx, slaCheckInterval)
+	go service.NewReminderScheduler(incidentRepo, reminderPolicy, producer, appLogger).Run(workersCtx, reminderCheckInterval)
+	go service.NewEscalationWorker(incidentRepo, escalationTimers, incidentConfig.EscalationTimeouts, producer, appLogger).
+		Run(workersCtx, escalationCheckInterval)
+	go service.NewStaleIncidentSweeper(incidentRepo, checks, staleTTL, producer, appLogger).Run(workersCtx, staleSweepInterval)
+
+	// Компоненты страницы статуса и объявления об обслуживании хранятся в PostgreSQL;
+	// без базы данных RPC страницы статуса возвращают Unavailable
+	db, err := pkg_database.Connect(context.Background(), &pkg_database.Config{
+		Host:          cfg.Database.Host,
+		Port:          cfg.Database.Port,
+		User:          cfg.Database.User,
+		Password:      cfg.Database.Password,
+		Database:      cfg.Database.Name,
+		SSLMode:       "disable",
+		MaxConns:      10,
+		MinConns:      2,
+		MaxConnLife:   30 * time.Minute,
+		MaxConnIdle:   5 * time.Minute,
+		HealthCheck:   30 * time.Second,
+		MaxRetries:    3,
+		RetryInterval: 1 * time.Second,
+	})
+	if err != nil {
+		appLogger.Error("Failed to connect to database, status page is disabled", logger.Error(err))
+	} else {
+		defer db.Close()
+		statusPage := service.NewStatusPageService(postgres.NewStatusPageRepository(db.Pool), appLogger).
+			WithIncidents(incidentRepo)
+		incidentHandler.WithStatusPage(statusPage)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	serverOptions := append(pkg_grpc.ServerOptions(cfg.GRPC),
+		grpc.ChainUnaryInterceptor(grpcHandler.PermissionUnaryInterceptor(appLogger, cfg.GRPC.ServiceToken)))
+	grpcServer := grpc.NewServer(serverOptions...)
+	incidentv1.RegisterIncidentServiceServer(grpcServer, incidentHandler)
+	// grpc.health.v1 для проверок здоровья подканалов на стороне клиентов
+	healthServer := pkg_grpc.RegisterHealthServer(grpcServer, incidentv1.IncidentService_ServiceDesc.ServiceName)
+	if pkg_grpc.RegisterReflection(grpcServer, cfg.GRPC, cfg.Environment) {
+		appLogger.Info("gRPC reflection is enabled")
+	}
+	go func() {
+		appLogger.Info(fmt.Sprintf("Starting gRPC server on port %d", cfg.Server.Port))
+		if err := grpcServer.Serve(pkg_grpc.LimitListener(lis, cfg.GRPC)); err != nil {
+			appLogger.Error("gRPC server failed", logger.Error(err))
+		}
+	}()
+
 	// Start HTTP server for metrics and health
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
-		Handler: setupHTTPHandler(metricsHandler, healthChecker, appLogger),
+		Addr:    fmt.Sprintf(":%d", cfg.Server.Port+1000), // Health check on port +1000
+		Handler: setupHTTPHandler(metricsHandler, healthChecker, setupEmailIngestion(incidentService, appLogger), appLogger),
 	}
 
 	// Start server
 	go func() {
-		appLogger.Info(fmt.Sprintf("Starting HTTP server on port %d", cfg.Server.Port))
+		appLogger.Info(fmt.Sprintf("Starting HTTP server on port %d", cfg.Server.Port+1000))
 		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			appLogger.Error("HTTP server failed", logger.Error(err))
 		}
@@ -76,6 +235,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	stopWorkers()
+
+	// Клиенты исключают реплику из балансировки до остановки gRPC сервера
+	healthServer.Shutdown()
+	grpcServer.GracefulStop()
+
 	if err := httpServer.Shutdown(ctx); err != nil {
 		appLogger.Error("Server shutdown failed", logger.Error(err))
 	}
@@ -83,8 +248,43 @@ func main() {
 	appLogger.Info("Server stopped")
 }
 
-func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, appLogger logger.Logger) http.Handler {
+// setupEmailIngestion создает прием писем-алертов из Amazon SES. Прием включается, если заданы
+// домен адресов INCIDENT_EMAIL_DOMAIN, секрет адресов INCIDENT_EMAIL_ADDRESS_SECRET и токен
+// подписки SNS INCIDENT_SES_TOKEN; INCIDENT_SES_TOPIC_ARNS ограничивает топики SNS
+func setupEmailIngestion(ingester email.AlertIngester, appLogger logger.Logger) *email.SESHandler {
+	domain := os.Getenv("INCIDENT_EMAIL_DOMAIN")
+	if domain == "" {
+		return nil
+	}
+
+	addresses, err := email.NewAddressBook(domain, os.Getenv("INCIDENT_EMAIL_ADDRESS_SECRET"))
+	if err != nil {
+		log.Fatalf("Invalid email ingestion config: %v", err)
+	}
+	var topics []string
+	for _, topic := range strings.Split(os.Getenv("INCIDENT_SES_TOPIC_ARNS"), ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+	handler, err := email.NewSESHandler(email.SESConfig{
+		Token:     os.Getenv("INCIDENT_SES_TOKEN"),
+		TopicARNs: topics,
+	}, addresses, ingester, appLogger)
+	if err != nil {
+		log.Fatalf("Invalid email ingestion config: %v", err)
+	}
+	appLogger.Info("Email alert ingestion is enabled", logger.String("domain", domain))
+	return handler
+}
+
+func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, sesHandler *email.SESHandler, appLogger logger.Logger) http.Handler {
 	mux := http.NewServeMux()
+
+	// Прием писем-алертов через SNS; сообщения проверяются по токену подписки и подписи SNS
+	if sesHandler != nil {
+		sesHandler.RegisterRoutes(mux)
+	}
 	
 	// Metrics endpoint
 	mux.Handle("/metrics", metricsHandler)
@@ -0,0 +1,36 @@
+// Package client клиенты incident-manager к другим сервисам платформы
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"UptimePingPlatform/pkg/errors"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// checkStatusDisabled статус выключенной проверки в ответе scheduler-service
+const checkStatusDisabled = "disabled"
+
+// SchedulerChecks состояние проверок из scheduler-service
+type SchedulerChecks struct {
+	client schedulerv1.SchedulerServiceClient
+}
+
+// NewSchedulerChecks создает клиент состояния проверок поверх соединения со scheduler-service
+func NewSchedulerChecks(conn grpc.ClientConnInterface) *SchedulerChecks {
+	return &SchedulerChecks{client: schedulerv1.NewSchedulerServiceClient(conn)}
+}
+
+var _ service.CheckStateSource = (*SchedulerChecks)(nil)
+
+// CheckEnabled сообщает, включена ли проверка; ErrNotFound, если проверка удалена
+func (c *SchedulerChecks) CheckEnabled(ctx context.Context, checkID string) (bool, error) {
+	check, err := c.client.GetCheck(ctx, &schedulerv1.GetCheckRequest{CheckId: checkID})
+	if err != nil {
+		return false, errors.FromGRPCErr(err)
+	}
+	return check.Status != checkStatusDisabled, nil
+}
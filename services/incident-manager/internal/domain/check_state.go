@@ -0,0 +1,87 @@
+package domain
+
+import "time"
+
+// CheckState состояние проверки по подтвержденным результатам
+type CheckState string
+
+const (
+	// CheckStatePending ошибки есть, но их еще недостаточно для перехода в down
+	CheckStatePending CheckState = "pending"
+	// CheckStateUp проверка проходит
+	CheckStateUp CheckState = "up"
+	// CheckStateDown отказ подтвержден
+	CheckStateDown CheckState = "down"
+	// CheckStateDegraded проверка проходит, но отвечает медленнее порога
+	CheckStateDegraded CheckState = "degraded"
+)
+
+// Confirmations пороги подтверждения смены состояния проверки, чтобы единичный сбой не
+// открывал инцидент, а единичный успех не закрывал его
+type Confirmations struct {
+	// Down число ошибок подряд до перехода в down; 0 и 1 - первая же ошибка
+	Down int `json:"down" yaml:"down"`
+	// Up число успехов подряд до выхода из down; 0 и 1 - первый же успех
+	Up int `json:"up" yaml:"up"`
+}
+
+// threshold порог не меньше одного результата
+func threshold(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// CheckStatus состояние проверки и счетчики результатов подряд
+type CheckStatus struct {
+	CheckID              string     `json:"check_id"`
+	TenantID             string     `json:"tenant_id"`
+	State                CheckState `json:"state"`
+	ConsecutiveFailures  int        `json:"consecutive_failures"`
+	ConsecutiveSuccesses int        `json:"consecutive_successes"`
+	// Since время перехода в текущее состояние
+	Since     time.Time `json:"since"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Observe учитывает результат проверки и возвращает предыдущее состояние и признак его смены.
+// Ошибки до порога Down переводят проверку в pending, из down проверка выходит только после
+// Up успехов подряд. Успех медленнее порога - degraded
+func (s *CheckStatus) Observe(success, degraded bool, at time.Time, confirmations Confirmations) (CheckState, bool) {
+	if success {
+		s.ConsecutiveSuccesses++
+		s.ConsecutiveFailures = 0
+	} else {
+		s.ConsecutiveFailures++
+		s.ConsecutiveSuccesses = 0
+	}
+
+	prev := s.State
+	next := prev
+	switch {
+	case !success && s.ConsecutiveFailures >= threshold(confirmations.Down):
+		next = CheckStateDown
+	case !success && prev != CheckStateDown:
+		next = CheckStatePending
+	case success && prev == CheckStateDown && s.ConsecutiveSuccesses < threshold(confirmations.Up):
+		next = CheckStateDown
+	case success && degraded:
+		next = CheckStateDegraded
+	case success:
+		next = CheckStateUp
+	}
+
+	s.UpdatedAt = at
+	if next == prev {
+		return prev, false
+	}
+	s.State = next
+	s.Since = at
+	return prev, true
+}
+
+// IsDown сообщает, подтвержден ли отказ проверки
+func (s *CheckStatus) IsDown() bool {
+	return s.State == CheckStateDown
+}
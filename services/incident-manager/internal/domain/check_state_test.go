@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckStatus_Observe(t *testing.T) {
+	confirmations := Confirmations{Down: 3, Up: 2}
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	type step struct {
+		success  bool
+		degraded bool
+		state    CheckState
+		changed  bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "single blip stays pending",
+			steps: []step{
+				{success: true, state: CheckStateUp, changed: true},
+				{success: false, state: CheckStatePending, changed: true},
+				{success: true, state: CheckStateUp, changed: true},
+			},
+		},
+		{
+			name: "confirmed failure and recovery",
+			steps: []step{
+				{success: false, state: CheckStatePending, changed: true},
+				{success: false, state: CheckStatePending},
+				{success: false, state: CheckStateDown, changed: true},
+				{success: false, state: CheckStateDown},
+				{success: true, state: CheckStateDown},
+				{success: false, state: CheckStateDown},
+				{success: true, state: CheckStateDown},
+				{success: true, state: CheckStateUp, changed: true},
+			},
+		},
+		{
+			name: "slow responses are degraded",
+			steps: []step{
+				{success: true, degraded: true, state: CheckStateDegraded, changed: true},
+				{success: true, state: CheckStateUp, changed: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &CheckStatus{CheckID: "check-1"}
+			for i, s := range tt.steps {
+				at := start.Add(time.Duration(i) * time.Minute)
+				_, changed := status.Observe(s.success, s.degraded, at, confirmations)
+				assert.Equal(t, s.state, status.State, "step %d", i)
+				assert.Equal(t, s.changed, changed, "step %d", i)
+				if changed {
+					assert.Equal(t, at, status.Since, "step %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckStatus_Observe_ZeroConfirmations(t *testing.T) {
+	status := &CheckStatus{CheckID: "check-1"}
+	now := time.Now()
+
+	prev, changed := status.Observe(false, false, now, Confirmations{})
+	assert.True(t, changed)
+	assert.Equal(t, CheckState(""), prev)
+	assert.True(t, status.IsDown())
+
+	status.Observe(true, false, now, Confirmations{})
+	assert.Equal(t, CheckStateUp, status.State)
+}
@@ -0,0 +1,20 @@
+package domain
+
+// MetadataKeyCorrelation ключ метаданных инцидента с полями, по которым notification-service
+// сводит уведомления одного сбоя в одно сообщение
+const MetadataKeyCorrelation = "correlation"
+
+// CorrelationKeys поля корреляции из метаданных результата проверки: явный ключ зонтичного
+// инцидента, хост цели и теги проверки
+var CorrelationKeys = []string{"correlation_key", "host", "tags"}
+
+// Correlation возвращает ключ корреляции, хост цели и теги проверки инцидента
+func (i *Incident) Correlation() map[string]string {
+	return i.stringMetadata(MetadataKeyCorrelation)
+}
+
+// SetCorrelation сохраняет непустые поля корреляции из метаданных результата проверки;
+// результаты без них не затирают сохраненные
+func (i *Incident) SetCorrelation(metadata map[string]interface{}) {
+	i.setStringMetadata(MetadataKeyCorrelation, CorrelationKeys, metadata)
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// MetadataKeyEscalatedAt ключ метаданных инцидента со временем последней эскалации по таймеру
+const MetadataKeyEscalatedAt = "escalated_at"
+
+// EscalationDueAt возвращает время эскалации простаивающего инцидента: время эскалации его
+// серьезности, отсчитанное от последнего результата проверки или последней эскалации по таймеру.
+// false, если инцидент подтвержден или закрыт, серьезность уже наивысшая или для нее не задано
+// время эскалации
+func (i *Incident) EscalationDueAt(timeouts map[IncidentSeverity]time.Duration) (time.Time, bool) {
+	if !i.IsOpen() {
+		return time.Time{}, false
+	}
+	if _, ok := NextSeverity(i.Severity); !ok {
+		return time.Time{}, false
+	}
+	timeout, ok := timeouts[i.Severity]
+	if !ok || timeout <= 0 {
+		return time.Time{}, false
+	}
+
+	since := i.LastSeen
+	if escalatedAt, ok := i.EscalatedAt(); ok && escalatedAt.After(since) {
+		since = escalatedAt
+	}
+	return since.Add(timeout), true
+}
+
+// EscalatedAt возвращает время последней эскалации по таймеру
+func (i *Incident) EscalatedAt() (time.Time, bool) {
+	text, ok := i.Metadata[MetadataKeyEscalatedAt].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	escalatedAt, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return escalatedAt, true
+}
+
+// Escalate повышает серьезность инцидента на ступень, фиксирует эскалацию в escalation_history
+// и запоминает ее время. Возвращает исходную серьезность; false для наивысшей серьезности
+func (i *Incident) Escalate(reason string, at time.Time) (IncidentSeverity, bool) {
+	from := i.Severity
+	next, ok := NextSeverity(from)
+	if !ok {
+		return from, false
+	}
+	i.UpdateSeverityAt(next, at)
+
+	if i.Metadata == nil {
+		i.Metadata = make(map[string]interface{})
+	}
+	history, _ := i.Metadata["escalation_history"].([]interface{})
+	i.Metadata["escalation_history"] = append(history, map[string]interface{}{
+		"timestamp":         at,
+		"from_severity":     string(from),
+		"to_severity":       string(next),
+		"incident_duration": at.Sub(i.FirstSeen),
+		"retry_count":       i.Count,
+		"reason":            reason,
+	})
+	i.Metadata[MetadataKeyEscalatedAt] = at.UTC().Format(time.RFC3339Nano)
+	i.UpdatedAt = at
+	return from, true
+}
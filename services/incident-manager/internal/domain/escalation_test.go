@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncident_EscalationDueAt(t *testing.T) {
+	timeouts := map[IncidentSeverity]time.Duration{
+		IncidentSeverityWarning: 30 * time.Minute,
+		IncidentSeverityError:   15 * time.Minute,
+	}
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityWarning, "timeout")
+
+	dueAt, ok := incident.EscalationDueAt(timeouts)
+	require.True(t, ok)
+	assert.Equal(t, incident.LastSeen.Add(30*time.Minute), dueAt)
+
+	// После эскалации срок отсчитывается от нее и берется для новой серьезности
+	escalatedAt := incident.LastSeen.Add(40 * time.Minute)
+	from, ok := incident.Escalate("idle_timeout", escalatedAt)
+	require.True(t, ok)
+	assert.Equal(t, IncidentSeverityWarning, from)
+	assert.Equal(t, IncidentSeverityError, incident.Severity)
+	dueAt, ok = incident.EscalationDueAt(timeouts)
+	require.True(t, ok)
+	assert.True(t, dueAt.Equal(escalatedAt.Add(15*time.Minute)))
+
+	// Время эскалации и история переживают сохранение в JSONB
+	data, err := json.Marshal(incident.Metadata)
+	require.NoError(t, err)
+	restored := *incident
+	restored.Metadata = nil
+	require.NoError(t, json.Unmarshal(data, &restored.Metadata))
+	restoredAt, ok := restored.EscalatedAt()
+	require.True(t, ok)
+	assert.True(t, restoredAt.Equal(escalatedAt))
+	restored.Escalate("idle_timeout", escalatedAt.Add(time.Hour))
+	assert.Len(t, restored.Metadata["escalation_history"], 2)
+
+	// Для наивысшей серьезности и подтвержденных инцидентов таймера нет
+	assert.Equal(t, IncidentSeverityCritical, restored.Severity)
+	_, ok = restored.EscalationDueAt(timeouts)
+	assert.False(t, ok)
+	_, ok = restored.Escalate("idle_timeout", escalatedAt)
+	assert.False(t, ok)
+
+	incident.Acknowledge()
+	_, ok = incident.EscalationDueAt(timeouts)
+	assert.False(t, ok)
+}
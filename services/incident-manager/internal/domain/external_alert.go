@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+)
+
+// Ключи метаданных инцидента, открытого по алерту внешней системы
+const (
+	MetadataKeyAlertSource      = "alert_source"
+	MetadataKeyAlertFingerprint = "alert_fingerprint"
+	MetadataKeyAlertDescription = "alert_description"
+	// MetadataKeyAlertLabelPrefix префикс меток алерта в метаданных инцидента
+	MetadataKeyAlertLabelPrefix = "alert_label_"
+	// AlertLabelCorrelationKey метка, связывающая алерт с зонтичным инцидентом
+	AlertLabelCorrelationKey = "correlation_key"
+)
+
+// maxAlertTitleLength максимальная длина заголовка внешнего алерта
+const maxAlertTitleLength = 255
+
+// externalAlertNamespace пространство имен для детерминированных ID проверок внешних алертов
+const externalAlertNamespace = "uptimeping:external-alert"
+
+// ExternalAlert алерт внешней системы мониторинга (Prometheus Alertmanager, CloudWatch, Grafana)
+type ExternalAlert struct {
+	TenantID    string            `json:"tenant_id"`
+	Source      string            `json:"source"`
+	Fingerprint string            `json:"fingerprint"`
+	Resolved    bool              `json:"resolved"`
+	Title       string            `json:"title"`
+	Description string            `json:"description,omitempty"`
+	Severity    IncidentSeverity  `json:"severity,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// Validate валидирует внешний алерт
+func (a *ExternalAlert) Validate() error {
+	if strings.TrimSpace(a.Source) == "" {
+		return fmt.Errorf("alert source is required")
+	}
+	if strings.TrimSpace(a.Fingerprint) == "" {
+		return fmt.Errorf("alert fingerprint is required")
+	}
+	if !a.Resolved && strings.TrimSpace(a.Title) == "" {
+		return fmt.Errorf("alert title is required")
+	}
+	if len(a.Title) > maxAlertTitleLength {
+		return fmt.Errorf("alert title must not exceed %d characters", maxAlertTitleLength)
+	}
+	if a.Severity != "" && !IsValidSeverity(a.Severity) {
+		return fmt.Errorf("invalid alert severity: %s", a.Severity)
+	}
+	return nil
+}
+
+// CheckID возвращает детерминированный ID проверки алерта в формате UUID (версия 5).
+// Повторные срабатывания одного алерта попадают в тот же инцидент
+func (a *ExternalAlert) CheckID() string {
+	hash := sha1.Sum([]byte(externalAlertNamespace + "\x00" + a.TenantID + "\x00" + a.Source + "\x00" + a.Fingerprint))
+	hash[6] = (hash[6] & 0x0f) | 0x50
+	hash[8] = (hash[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", hash[0:4], hash[4:6], hash[6:8], hash[8:10], hash[10:16])
+}
+
+// Metadata возвращает метаданные инцидента с источником, описанием и метками алерта
+func (a *ExternalAlert) Metadata() map[string]interface{} {
+	metadata := map[string]interface{}{
+		MetadataKeyAlertSource:      a.Source,
+		MetadataKeyAlertFingerprint: a.Fingerprint,
+	}
+	if a.Description != "" {
+		metadata[MetadataKeyAlertDescription] = a.Description
+	}
+	for key, value := range a.Labels {
+		metadata[MetadataKeyAlertLabelPrefix+key] = value
+	}
+	if key := a.Labels[AlertLabelCorrelationKey]; key != "" {
+		metadata[AlertLabelCorrelationKey] = key
+	}
+	return metadata
+}
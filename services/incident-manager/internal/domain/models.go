@@ -35,6 +35,9 @@ type Incident struct {
 	ErrorMessage string            `json:"error_message" db:"error_message"`
 	ErrorHash   string             `json:"error_hash" db:"error_hash"`
 	Metadata    map[string]interface{} `json:"metadata" db:"metadata"`
+	// AcknowledgedAt и ResolvedAt фиксируют моменты подтверждения и закрытия для SLA и MTTA/MTTR
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty" db:"acknowledged_at"`
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty" db:"resolved_at"`
 	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time          `json:"updated_at" db:"updated_at"`
 }
@@ -55,7 +58,11 @@ type IncidentEvent struct {
 
 // NewIncident создает новый инцидент
 func NewIncident(checkID, tenantID string, severity IncidentSeverity, errorMessage string) *Incident {
-	now := time.Now()
+	return NewIncidentAt(checkID, tenantID, severity, errorMessage, time.Now())
+}
+
+// NewIncidentAt создает новый инцидент, обнаруженный в момент now
+func NewIncidentAt(checkID, tenantID string, severity IncidentSeverity, errorMessage string, now time.Time) *Incident {
 	errorHash := generateErrorHash(errorMessage)
 	
 	return &Incident{
@@ -90,40 +97,68 @@ func (i *Incident) IsResolved() bool {
 
 // Acknowledge подтверждает инцидент
 func (i *Incident) Acknowledge() {
+	i.AcknowledgeAt(time.Now())
+}
+
+// AcknowledgeAt подтверждает инцидент в момент now
+func (i *Incident) AcknowledgeAt(now time.Time) {
 	if i.Status == IncidentStatusOpen {
 		i.Status = IncidentStatusAcknowledged
-		i.UpdatedAt = time.Now()
+		i.AcknowledgedAt = &now
+		i.UpdatedAt = now
 	}
 }
 
 // Resolve разрешает инцидент
 func (i *Incident) Resolve() {
+	i.ResolveAt(time.Now())
+}
+
+// ResolveAt разрешает инцидент в момент now
+func (i *Incident) ResolveAt(now time.Time) {
 	if i.Status != IncidentStatusResolved {
 		i.Status = IncidentStatusResolved
-		i.UpdatedAt = time.Now()
+		i.ResolvedAt = &now
+		i.UpdatedAt = now
 	}
 }
 
 // Reopen повторно открывает инцидент
 func (i *Incident) Reopen() {
+	i.ReopenAt(time.Now())
+}
+
+// ReopenAt повторно открывает инцидент в момент now
+func (i *Incident) ReopenAt(now time.Time) {
 	if i.Status == IncidentStatusResolved {
 		i.Status = IncidentStatusOpen
-		i.UpdatedAt = time.Now()
+		i.ResolvedAt = nil
+		i.UpdatedAt = now
 	}
 }
 
 // IncrementCount увеличивает счетчик инцидента и обновляет время последнего обнаружения
 func (i *Incident) IncrementCount() {
+	i.IncrementCountAt(time.Now())
+}
+
+// IncrementCountAt увеличивает счетчик инцидента, повторно обнаруженного в момент now
+func (i *Incident) IncrementCountAt(now time.Time) {
 	i.Count++
-	i.LastSeen = time.Now()
-	i.UpdatedAt = time.Now()
+	i.LastSeen = now
+	i.UpdatedAt = now
 }
 
 // UpdateSeverity обновляет уровень серьезности инцидента
 func (i *Incident) UpdateSeverity(severity IncidentSeverity) {
+	i.UpdateSeverityAt(severity, time.Now())
+}
+
+// UpdateSeverityAt обновляет уровень серьезности инцидента в момент now
+func (i *Incident) UpdateSeverityAt(severity IncidentSeverity, now time.Time) {
 	if i.Severity != severity {
 		i.Severity = severity
-		i.UpdatedAt = time.Now()
+		i.UpdatedAt = now
 	}
 }
 
@@ -0,0 +1,22 @@
+package domain
+
+// MetadataKeyNotificationOverrides ключ метаданных инцидента с переопределением уведомлений проверки
+const MetadataKeyNotificationOverrides = "notification_overrides"
+
+// MetadataKeyNotifyRenotifyInterval поле переопределения с интервалом повторных уведомлений
+const MetadataKeyNotifyRenotifyInterval = "notify_renotify_interval"
+
+// NotificationOverrideKeys поля переопределения уведомлений из конфигурации проверки: каналы,
+// минимальная серьезность и интервал повторных уведомлений
+var NotificationOverrideKeys = []string{"notify_channels", "notify_min_severity", MetadataKeyNotifyRenotifyInterval}
+
+// NotificationOverrides возвращает переопределение маршрутизации уведомлений проверки инцидента
+func (i *Incident) NotificationOverrides() map[string]string {
+	return i.stringMetadata(MetadataKeyNotificationOverrides)
+}
+
+// SetNotificationOverrides сохраняет переопределение уведомлений из метаданных результата
+// проверки; результаты без переопределения не затирают сохраненное
+func (i *Incident) SetNotificationOverrides(metadata map[string]interface{}) {
+	i.setStringMetadata(MetadataKeyNotificationOverrides, NotificationOverrideKeys, metadata)
+}
@@ -0,0 +1,58 @@
+package domain
+
+// MetadataKeyOwnership ключ метаданных инцидента со сведениями о владельце проверки
+const MetadataKeyOwnership = "ownership"
+
+// OwnershipKeys поля владельца проверки, передаваемые из scheduler-service через core-service
+var OwnershipKeys = []string{"owner", "team", "runbook_url", "escalation_contact"}
+
+// Ownership возвращает владельца, команду, runbook и контакт эскалации проверки инцидента
+func (i *Incident) Ownership() map[string]string {
+	return i.stringMetadata(MetadataKeyOwnership)
+}
+
+// SetOwnership сохраняет непустые поля владельца из метаданных результата проверки;
+// при отсутствии полей ранее сохраненные сведения не затираются
+func (i *Incident) SetOwnership(metadata map[string]interface{}) {
+	i.setStringMetadata(MetadataKeyOwnership, OwnershipKeys, metadata)
+}
+
+// stringMetadata возвращает строковые поля вложенных метаданных инцидента
+func (i *Incident) stringMetadata(key string) map[string]string {
+	fields := make(map[string]string)
+
+	switch values := i.Metadata[key].(type) {
+	case map[string]string:
+		for key, value := range values {
+			fields[key] = value
+		}
+	case map[string]interface{}:
+		// После чтения из JSONB значения приходят как interface{}
+		for key, value := range values {
+			if str, ok := value.(string); ok {
+				fields[key] = str
+			}
+		}
+	}
+
+	return fields
+}
+
+// setStringMetadata сохраняет непустые строковые поля keys под ключом key; без полей
+// ранее сохраненные значения не затираются
+func (i *Incident) setStringMetadata(key string, keys []string, metadata map[string]interface{}) {
+	fields := make(map[string]string)
+	for _, field := range keys {
+		if value, ok := metadata[field].(string); ok && value != "" {
+			fields[field] = value
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	if i.Metadata == nil {
+		i.Metadata = make(map[string]interface{})
+	}
+	i.Metadata[key] = fields
+}
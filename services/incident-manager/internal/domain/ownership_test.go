@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncident_SetOwnership(t *testing.T) {
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityError, "timeout")
+
+	incident.SetOwnership(map[string]interface{}{
+		"owner":        "alice",
+		"runbook_url":  "https://wiki.example.com/runbooks/api",
+		"content_type": "text/html",
+	})
+
+	assert.Equal(t, map[string]string{
+		"owner":       "alice",
+		"runbook_url": "https://wiki.example.com/runbooks/api",
+	}, incident.Ownership())
+
+	// Пустые метаданные не затирают ранее сохраненного владельца
+	incident.SetOwnership(map[string]interface{}{})
+	assert.Equal(t, "alice", incident.Ownership()["owner"])
+}
+
+func TestIncident_Ownership_FromJSONMetadata(t *testing.T) {
+	incident := &Incident{Metadata: map[string]interface{}{
+		MetadataKeyOwnership: map[string]interface{}{"team": "payments"},
+	}}
+
+	assert.Equal(t, map[string]string{"team": "payments"}, incident.Ownership())
+}
+
+func TestIncident_SetNotificationOverrides(t *testing.T) {
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityCritical, "timeout")
+
+	incident.SetNotificationOverrides(map[string]interface{}{
+		"notify_channels":     "slack:#payments",
+		"notify_min_severity": "critical",
+		"owner":               "alice",
+	})
+	assert.Equal(t, map[string]string{
+		"notify_channels":     "slack:#payments",
+		"notify_min_severity": "critical",
+	}, incident.NotificationOverrides())
+	assert.Empty(t, incident.Ownership())
+
+	// Результат без переопределения не затирает сохраненное
+	incident.SetNotificationOverrides(map[string]interface{}{"owner": "alice"})
+	assert.Equal(t, "slack:#payments", incident.NotificationOverrides()["notify_channels"])
+}
+
+func TestIncident_SetCorrelation(t *testing.T) {
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityCritical, "timeout")
+
+	incident.SetCorrelation(map[string]interface{}{
+		"host":  "api.example.com",
+		"tags":  "prod,api",
+		"owner": "alice",
+	})
+	assert.Equal(t, map[string]string{"host": "api.example.com", "tags": "prod,api"}, incident.Correlation())
+
+	// Метка correlation_key внешнего алерта связывает его с зонтичным инцидентом
+	alert := &ExternalAlert{Labels: map[string]string{AlertLabelCorrelationKey: "eu-west-outage"}}
+	incident.SetCorrelation(alert.Metadata())
+	assert.Equal(t, map[string]string{"correlation_key": "eu-west-outage"}, incident.Correlation())
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MetadataKeyReminders ключ метаданных инцидента с отправленными напоминаниями
+const MetadataKeyReminders = "reminders"
+
+// MinReminderInterval минимальный интервал напоминаний
+const MinReminderInterval = time.Minute
+
+// ReminderPolicy политика напоминаний по открытым неподтвержденным инцидентам. Нулевой
+// интервал или количество напоминаний отключают их
+type ReminderPolicy struct {
+	// Interval время без подтверждения до первого напоминания и между последующими
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// MaxReminders наибольшее количество напоминаний по инциденту
+	MaxReminders int `json:"max_reminders" yaml:"max_reminders"`
+	// Escalate повышает серьезность инцидента с каждым напоминанием, передавая его
+	// на следующую ступень эскалации
+	Escalate bool `json:"escalate" yaml:"escalate"`
+}
+
+// DefaultReminderPolicy политика напоминаний по умолчанию: каждые 30 минут, не более трех раз
+func DefaultReminderPolicy() ReminderPolicy {
+	return ReminderPolicy{Interval: 30 * time.Minute, MaxReminders: 3}
+}
+
+// UnmarshalJSON принимает интервал строкой вида "30m" или числом наносекунд
+func (p *ReminderPolicy) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Interval     json.RawMessage `json:"interval"`
+		MaxReminders int             `json:"max_reminders"`
+		Escalate     bool            `json:"escalate"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	interval, err := parseSLADuration(raw.Interval)
+	if err != nil {
+		return fmt.Errorf("invalid interval: %w", err)
+	}
+	*p = ReminderPolicy{Interval: interval, MaxReminders: raw.MaxReminders, Escalate: raw.Escalate}
+	return nil
+}
+
+// Enabled сообщает, включены ли напоминания
+func (p ReminderPolicy) Enabled() bool {
+	return p.Interval > 0 && p.MaxReminders > 0
+}
+
+// Validate проверяет интервал и количество напоминаний
+func (p ReminderPolicy) Validate() error {
+	if p.Interval < 0 || p.MaxReminders < 0 {
+		return fmt.Errorf("reminder interval and max_reminders must not be negative")
+	}
+	if p.Enabled() && p.Interval < MinReminderInterval {
+		return fmt.Errorf("reminder interval must be at least %s", MinReminderInterval)
+	}
+	return nil
+}
+
+// NextReminderAt возвращает время следующего напоминания по инциденту. false, если
+// напоминания отключены, инцидент подтвержден или закрыт, либо лимит исчерпан.
+// Интервал повторных уведомлений из переопределения проверки заменяет интервал политики
+func (p ReminderPolicy) NextReminderAt(incident *Incident) (time.Time, bool) {
+	if !p.Enabled() || !incident.IsOpen() || incident.AcknowledgedAt != nil {
+		return time.Time{}, false
+	}
+	reminders := incident.Reminders()
+	if len(reminders) >= p.MaxReminders {
+		return time.Time{}, false
+	}
+
+	interval := p.Interval
+	if text, ok := incident.NotificationOverrides()[MetadataKeyNotifyRenotifyInterval]; ok {
+		if override, err := time.ParseDuration(text); err == nil && override >= MinReminderInterval {
+			interval = override
+		}
+	}
+
+	since := incident.FirstSeen
+	if len(reminders) > 0 && reminders[len(reminders)-1].SentAt.After(since) {
+		since = reminders[len(reminders)-1].SentAt
+	}
+	return since.Add(interval), true
+}
+
+// Reminder напоминание, отправленное по неподтвержденному инциденту
+type Reminder struct {
+	// Number порядковый номер напоминания, начиная с 1
+	Number   int              `json:"number"`
+	Severity IncidentSeverity `json:"severity"`
+	// EscalatedFrom серьезность до эскалации, если напоминание повысило ее
+	EscalatedFrom IncidentSeverity `json:"escalated_from,omitempty"`
+	SentAt        time.Time        `json:"sent_at"`
+}
+
+// Reminders возвращает напоминания, зафиксированные в метаданных инцидента
+func (i *Incident) Reminders() []Reminder {
+	switch values := i.Metadata[MetadataKeyReminders].(type) {
+	case []Reminder:
+		return append([]Reminder(nil), values...)
+	case []interface{}:
+		// После чтения из JSONB напоминания приходят как вложенные map
+		data, err := json.Marshal(values)
+		if err != nil {
+			return nil
+		}
+		var reminders []Reminder
+		if err := json.Unmarshal(data, &reminders); err != nil {
+			return nil
+		}
+		return reminders
+	}
+	return nil
+}
+
+// AddReminder фиксирует напоминание в метаданных инцидента и возвращает его с номером
+func (i *Incident) AddReminder(reminder Reminder) Reminder {
+	reminders := i.Reminders()
+	reminder.Number = len(reminders) + 1
+	if i.Metadata == nil {
+		i.Metadata = make(map[string]interface{})
+	}
+	i.Metadata[MetadataKeyReminders] = append(reminders, reminder)
+	i.UpdatedAt = reminder.SentAt
+	return reminder
+}
+
+// NextSeverity возвращает следующую ступень эскалации серьезности. false для критической
+func NextSeverity(severity IncidentSeverity) (IncidentSeverity, bool) {
+	switch severity {
+	case IncidentSeverityWarning:
+		return IncidentSeverityError, true
+	case IncidentSeverityError:
+		return IncidentSeverityCritical, true
+	}
+	return severity, false
+}
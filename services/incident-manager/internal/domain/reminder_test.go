@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReminderPolicy_UnmarshalJSON(t *testing.T) {
+	var policy ReminderPolicy
+	require.NoError(t, json.Unmarshal([]byte(`{"interval": "15m", "max_reminders": 2, "escalate": true}`), &policy))
+	assert.Equal(t, ReminderPolicy{Interval: 15 * time.Minute, MaxReminders: 2, Escalate: true}, policy)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"interval": "often"}`), &policy))
+}
+
+func TestReminderPolicy_Validate(t *testing.T) {
+	assert.NoError(t, DefaultReminderPolicy().Validate())
+	assert.NoError(t, ReminderPolicy{}.Validate())
+	assert.Error(t, ReminderPolicy{Interval: 10 * time.Second, MaxReminders: 1}.Validate())
+	assert.Error(t, ReminderPolicy{Interval: time.Hour, MaxReminders: -1}.Validate())
+}
+
+func TestReminderPolicy_NextReminderAt(t *testing.T) {
+	policy := ReminderPolicy{Interval: 30 * time.Minute, MaxReminders: 2}
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityError, "timeout")
+
+	dueAt, ok := policy.NextReminderAt(incident)
+	require.True(t, ok)
+	assert.Equal(t, incident.FirstSeen.Add(30*time.Minute), dueAt)
+
+	// Следующее напоминание отсчитывается от предыдущего
+	sentAt := incident.FirstSeen.Add(40 * time.Minute)
+	incident.AddReminder(Reminder{Severity: incident.Severity, SentAt: sentAt})
+	dueAt, ok = policy.NextReminderAt(incident)
+	require.True(t, ok)
+	assert.Equal(t, sentAt.Add(30*time.Minute), dueAt)
+
+	// Интервал повторных уведомлений проверки заменяет интервал политики
+	incident.SetNotificationOverrides(map[string]interface{}{MetadataKeyNotifyRenotifyInterval: "1h"})
+	dueAt, _ = policy.NextReminderAt(incident)
+	assert.Equal(t, sentAt.Add(time.Hour), dueAt)
+
+	incident.AddReminder(Reminder{Severity: incident.Severity, SentAt: sentAt.Add(time.Hour)})
+	_, ok = policy.NextReminderAt(incident)
+	assert.False(t, ok, "limit reached")
+
+	acknowledged := NewIncident("check-2", "tenant-1", IncidentSeverityError, "timeout")
+	acknowledged.Acknowledge()
+	_, ok = policy.NextReminderAt(acknowledged)
+	assert.False(t, ok)
+
+	_, ok = ReminderPolicy{}.NextReminderAt(NewIncident("check-3", "tenant-1", IncidentSeverityError, "timeout"))
+	assert.False(t, ok)
+}
+
+func TestIncident_RemindersFromJSON(t *testing.T) {
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityWarning, "timeout")
+	sentAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	first := incident.AddReminder(Reminder{Severity: IncidentSeverityError, EscalatedFrom: IncidentSeverityWarning, SentAt: sentAt})
+	assert.Equal(t, 1, first.Number)
+
+	data, err := json.Marshal(incident.Metadata)
+	require.NoError(t, err)
+	incident.Metadata = nil
+	require.NoError(t, json.Unmarshal(data, &incident.Metadata))
+
+	second := incident.AddReminder(Reminder{Severity: IncidentSeverityError, SentAt: sentAt.Add(time.Hour)})
+	assert.Equal(t, 2, second.Number)
+
+	reminders := incident.Reminders()
+	require.Len(t, reminders, 2)
+	assert.Equal(t, IncidentSeverityWarning, reminders[0].EscalatedFrom)
+	assert.True(t, sentAt.Equal(reminders[0].SentAt))
+}
+
+func TestNextSeverity(t *testing.T) {
+	next, ok := NextSeverity(IncidentSeverityWarning)
+	assert.True(t, ok)
+	assert.Equal(t, IncidentSeverityError, next)
+
+	_, ok = NextSeverity(IncidentSeverityCritical)
+	assert.False(t, ok)
+}
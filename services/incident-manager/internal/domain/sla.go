@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MetadataKeySLABreaches ключ метаданных инцидента с зафиксированными нарушениями SLA
+const MetadataKeySLABreaches = "sla_breaches"
+
+// SLAKind вид SLA: время подтверждения или время закрытия инцидента
+type SLAKind string
+
+const (
+	SLAKindAcknowledge SLAKind = "acknowledge"
+	SLAKindResolve     SLAKind = "resolve"
+)
+
+// SLATarget целевые сроки реакции на инцидент, отсчитываются от первого обнаружения.
+// Нулевой срок означает отсутствие цели
+type SLATarget struct {
+	AcknowledgeWithin time.Duration `json:"acknowledge_within" yaml:"acknowledge_within"`
+	ResolveWithin     time.Duration `json:"resolve_within" yaml:"resolve_within"`
+}
+
+// UnmarshalJSON принимает сроки строками вида "5m" или числом наносекунд
+func (t *SLATarget) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		AcknowledgeWithin json.RawMessage `json:"acknowledge_within"`
+		ResolveWithin     json.RawMessage `json:"resolve_within"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var err error
+	if t.AcknowledgeWithin, err = parseSLADuration(raw.AcknowledgeWithin); err != nil {
+		return fmt.Errorf("invalid acknowledge_within: %w", err)
+	}
+	if t.ResolveWithin, err = parseSLADuration(raw.ResolveWithin); err != nil {
+		return fmt.Errorf("invalid resolve_within: %w", err)
+	}
+	return nil
+}
+
+func parseSLADuration(raw json.RawMessage) (time.Duration, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, nil
+	}
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return time.ParseDuration(text)
+	}
+	var nanos int64
+	if err := json.Unmarshal(raw, &nanos); err != nil {
+		return 0, err
+	}
+	return time.Duration(nanos), nil
+}
+
+// Within возвращает целевой срок для вида SLA
+func (t SLATarget) Within(kind SLAKind) time.Duration {
+	if kind == SLAKindAcknowledge {
+		return t.AcknowledgeWithin
+	}
+	return t.ResolveWithin
+}
+
+// SLAPolicy целевые сроки по уровням серьезности
+type SLAPolicy map[IncidentSeverity]SLATarget
+
+// DefaultSLAPolicy политика SLA для тенантов без собственных настроек
+func DefaultSLAPolicy() SLAPolicy {
+	return SLAPolicy{
+		IncidentSeverityCritical: {AcknowledgeWithin: 5 * time.Minute, ResolveWithin: time.Hour},
+		IncidentSeverityError:    {AcknowledgeWithin: 15 * time.Minute, ResolveWithin: 4 * time.Hour},
+		IncidentSeverityWarning:  {AcknowledgeWithin: time.Hour, ResolveWithin: 24 * time.Hour},
+	}
+}
+
+// Validate проверяет уровни серьезности и сроки политики
+func (p SLAPolicy) Validate() error {
+	for severity, target := range p {
+		if !IsValidSeverity(severity) {
+			return fmt.Errorf("unknown severity in SLA policy: %s", severity)
+		}
+		if target.AcknowledgeWithin < 0 || target.ResolveWithin < 0 {
+			return fmt.Errorf("SLA targets for %s must not be negative", severity)
+		}
+		if target.AcknowledgeWithin > 0 && target.ResolveWithin > 0 && target.AcknowledgeWithin > target.ResolveWithin {
+			return fmt.Errorf("SLA acknowledge target for %s exceeds resolve target", severity)
+		}
+	}
+	return nil
+}
+
+// Deadline возвращает срок SLA инцидента. false, если цель не задана или уже выполнена
+func (p SLAPolicy) Deadline(incident *Incident, kind SLAKind) (time.Time, bool) {
+	within := p[incident.Severity].Within(kind)
+	if within <= 0 || incident.IsResolved() {
+		return time.Time{}, false
+	}
+	if kind == SLAKindAcknowledge && (incident.AcknowledgedAt != nil || incident.IsAcknowledged()) {
+		return time.Time{}, false
+	}
+	return incident.FirstSeen.Add(within), true
+}
+
+// SLABreach зафиксированное нарушение SLA
+type SLABreach struct {
+	Kind     SLAKind          `json:"kind"`
+	Severity IncidentSeverity `json:"severity"`
+	Target   time.Duration    `json:"target"`
+	Deadline time.Time        `json:"deadline"`
+	// DetectedAt момент обнаружения нарушения таймером
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// SLABreaches возвращает нарушения SLA, зафиксированные в метаданных инцидента
+func (i *Incident) SLABreaches() map[SLAKind]SLABreach {
+	breaches := make(map[SLAKind]SLABreach)
+
+	switch values := i.Metadata[MetadataKeySLABreaches].(type) {
+	case map[SLAKind]SLABreach:
+		for kind, breach := range values {
+			breaches[kind] = breach
+		}
+	case map[string]interface{}:
+		// После чтения из JSONB нарушения приходят как вложенные map
+		for kind, value := range values {
+			data, err := json.Marshal(value)
+			if err != nil {
+				continue
+			}
+			var breach SLABreach
+			if err := json.Unmarshal(data, &breach); err == nil {
+				breaches[SLAKind(kind)] = breach
+			}
+		}
+	}
+
+	return breaches
+}
+
+// MarkSLABreached фиксирует нарушение SLA; повторное нарушение того же вида не записывается
+func (i *Incident) MarkSLABreached(breach SLABreach) bool {
+	breaches := i.SLABreaches()
+	if _, exists := breaches[breach.Kind]; exists {
+		return false
+	}
+	breaches[breach.Kind] = breach
+
+	if i.Metadata == nil {
+		i.Metadata = make(map[string]interface{})
+	}
+	i.Metadata[MetadataKeySLABreaches] = breaches
+	i.UpdatedAt = breach.DetectedAt
+	return true
+}
+
+// TimeToAcknowledge время от обнаружения до подтверждения. Инцидент, закрытый без
+// подтверждения, считается подтвержденным в момент закрытия
+func (i *Incident) TimeToAcknowledge() (time.Duration, bool) {
+	switch {
+	case i.AcknowledgedAt != nil:
+		return i.AcknowledgedAt.Sub(i.FirstSeen), true
+	case i.ResolvedAt != nil:
+		return i.ResolvedAt.Sub(i.FirstSeen), true
+	}
+	return 0, false
+}
+
+// TimeToResolve время от обнаружения до закрытия
+func (i *Incident) TimeToResolve() (time.Duration, bool) {
+	if i.ResolvedAt == nil {
+		return 0, false
+	}
+	return i.ResolvedAt.Sub(i.FirstSeen), true
+}
+
+// SLAReport показатели реакции на инциденты тенанта за период
+type SLAReport struct {
+	TenantID     string    `json:"tenant_id"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+	Incidents    int       `json:"incidents"`
+	Acknowledged int       `json:"acknowledged"`
+	Resolved     int       `json:"resolved"`
+	// MTTA и MTTR средние времена подтверждения и закрытия
+	MTTA time.Duration `json:"mtta"`
+	MTTR time.Duration `json:"mttr"`
+	// Breaches число нарушений по видам SLA
+	Breaches map[SLAKind]int `json:"breaches"`
+	// BreachesBySeverity число инцидентов с нарушением SLA по уровням серьезности
+	BreachesBySeverity map[IncidentSeverity]int `json:"breaches_by_severity"`
+}
+
+// BuildSLAReport считает MTTA, MTTR и нарушения SLA по списку инцидентов
+func BuildSLAReport(tenantID string, from, to time.Time, incidents []*Incident) *SLAReport {
+	report := &SLAReport{
+		TenantID:           tenantID,
+		From:               from,
+		To:                 to,
+		Breaches:           make(map[SLAKind]int),
+		BreachesBySeverity: make(map[IncidentSeverity]int),
+	}
+
+	var totalAck, totalResolve time.Duration
+	for _, incident := range incidents {
+		report.Incidents++
+		if duration, ok := incident.TimeToAcknowledge(); ok {
+			report.Acknowledged++
+			totalAck += duration
+		}
+		if duration, ok := incident.TimeToResolve(); ok {
+			report.Resolved++
+			totalResolve += duration
+		}
+
+		breaches := incident.SLABreaches()
+		for kind := range breaches {
+			report.Breaches[kind]++
+		}
+		if len(breaches) > 0 {
+			report.BreachesBySeverity[incident.Severity]++
+		}
+	}
+
+	if report.Acknowledged > 0 {
+		report.MTTA = totalAck / time.Duration(report.Acknowledged)
+	}
+	if report.Resolved > 0 {
+		report.MTTR = totalResolve / time.Duration(report.Resolved)
+	}
+	return report
+}
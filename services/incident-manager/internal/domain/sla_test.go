@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSLATarget_UnmarshalJSON(t *testing.T) {
+	var policy SLAPolicy
+	err := json.Unmarshal([]byte(`{"critical": {"acknowledge_within": "5m", "resolve_within": 3600000000000}}`), &policy)
+	require.NoError(t, err)
+	assert.Equal(t, SLATarget{AcknowledgeWithin: 5 * time.Minute, ResolveWithin: time.Hour}, policy[IncidentSeverityCritical])
+
+	err = json.Unmarshal([]byte(`{"critical": {"acknowledge_within": "soon"}}`), &policy)
+	assert.Error(t, err)
+}
+
+func TestSLAPolicy_Validate(t *testing.T) {
+	assert.NoError(t, DefaultSLAPolicy().Validate())
+	assert.Error(t, SLAPolicy{"fatal": {ResolveWithin: time.Hour}}.Validate())
+	assert.Error(t, SLAPolicy{IncidentSeverityError: {AcknowledgeWithin: -time.Minute}}.Validate())
+	assert.Error(t, SLAPolicy{IncidentSeverityError: {AcknowledgeWithin: 2 * time.Hour, ResolveWithin: time.Hour}}.Validate())
+}
+
+func TestSLAPolicy_Deadline(t *testing.T) {
+	policy := DefaultSLAPolicy()
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityCritical, "timeout")
+
+	deadline, ok := policy.Deadline(incident, SLAKindAcknowledge)
+	require.True(t, ok)
+	assert.Equal(t, incident.FirstSeen.Add(5*time.Minute), deadline)
+
+	incident.Acknowledge()
+	require.NotNil(t, incident.AcknowledgedAt)
+	_, ok = policy.Deadline(incident, SLAKindAcknowledge)
+	assert.False(t, ok)
+	_, ok = policy.Deadline(incident, SLAKindResolve)
+	assert.True(t, ok)
+
+	incident.Resolve()
+	require.NotNil(t, incident.ResolvedAt)
+	_, ok = policy.Deadline(incident, SLAKindResolve)
+	assert.False(t, ok)
+
+	_, ok = SLAPolicy{}.Deadline(NewIncident("check-1", "tenant-1", IncidentSeverityWarning, "timeout"), SLAKindResolve)
+	assert.False(t, ok)
+}
+
+func TestIncident_MarkSLABreached(t *testing.T) {
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityCritical, "timeout")
+	breach := SLABreach{Kind: SLAKindAcknowledge, Target: 5 * time.Minute, DetectedAt: time.Now()}
+
+	assert.True(t, incident.MarkSLABreached(breach))
+	assert.False(t, incident.MarkSLABreached(breach))
+	assert.True(t, incident.MarkSLABreached(SLABreach{Kind: SLAKindResolve, Target: time.Hour}))
+	assert.Len(t, incident.SLABreaches(), 2)
+}
+
+func TestIncident_SLABreachesFromJSON(t *testing.T) {
+	incident := NewIncident("check-1", "tenant-1", IncidentSeverityCritical, "timeout")
+	incident.MarkSLABreached(SLABreach{Kind: SLAKindResolve, Target: time.Hour, DetectedAt: time.Now()})
+
+	data, err := json.Marshal(incident.Metadata)
+	require.NoError(t, err)
+	incident.Metadata = nil
+	require.NoError(t, json.Unmarshal(data, &incident.Metadata))
+
+	breaches := incident.SLABreaches()
+	require.Contains(t, breaches, SLAKindResolve)
+	assert.Equal(t, time.Hour, breaches[SLAKindResolve].Target)
+}
+
+func TestBuildSLAReport(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		value := start.Add(d)
+		return &value
+	}
+
+	acknowledged := &Incident{Severity: IncidentSeverityCritical, FirstSeen: start, AcknowledgedAt: at(4 * time.Minute), ResolvedAt: at(30 * time.Minute)}
+	resolvedOnly := &Incident{Severity: IncidentSeverityError, FirstSeen: start, ResolvedAt: at(10 * time.Minute)}
+	open := &Incident{Severity: IncidentSeverityCritical, FirstSeen: start}
+	open.MarkSLABreached(SLABreach{Kind: SLAKindAcknowledge})
+	open.MarkSLABreached(SLABreach{Kind: SLAKindResolve})
+
+	report := BuildSLAReport("tenant-1", start, start.Add(24*time.Hour), []*Incident{acknowledged, resolvedOnly, open})
+
+	assert.Equal(t, 3, report.Incidents)
+	assert.Equal(t, 2, report.Acknowledged)
+	assert.Equal(t, 2, report.Resolved)
+	assert.Equal(t, 7*time.Minute, report.MTTA)
+	assert.Equal(t, 20*time.Minute, report.MTTR)
+	assert.Equal(t, map[SLAKind]int{SLAKindAcknowledge: 1, SLAKindResolve: 1}, report.Breaches)
+	assert.Equal(t, map[IncidentSeverity]int{IncidentSeverityCritical: 1}, report.BreachesBySeverity)
+}
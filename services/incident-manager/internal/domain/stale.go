@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// MetadataKeyResolveReason ключ метаданных инцидента с причиной автоматического закрытия
+const MetadataKeyResolveReason = "resolve_reason"
+
+// Причины закрытия устаревших инцидентов
+const (
+	// ResolveReasonCheckDeleted проверка инцидента удалена
+	ResolveReasonCheckDeleted = "check_deleted"
+	// ResolveReasonCheckDisabled проверка инцидента выключена
+	ResolveReasonCheckDisabled = "check_disabled"
+	// ResolveReasonExpired по инциденту не было ошибок дольше срока жизни
+	ResolveReasonExpired = "ttl_expired"
+)
+
+// ResolveStale закрывает устаревший инцидент и сохраняет причину закрытия
+func (i *Incident) ResolveStale(reason string, at time.Time) {
+	i.ResolveAt(at)
+	if i.Metadata == nil {
+		i.Metadata = make(map[string]interface{})
+	}
+	i.Metadata[MetadataKeyResolveReason] = reason
+}
+
+// ResolveReason возвращает причину автоматического закрытия инцидента
+func (i *Incident) ResolveReason() string {
+	reason, _ := i.Metadata[MetadataKeyResolveReason].(string)
+	return reason
+}
+
+// IsExpired сообщает, что по активному инциденту не было ошибок дольше ttl. Нулевой ttl
+// отключает срок жизни
+func (i *Incident) IsExpired(ttl time.Duration, now time.Time) bool {
+	return ttl > 0 && i.IsActive() && now.Sub(i.LastSeen) > ttl
+}
@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Ошибки хранилища страницы статуса
+var (
+	ErrStatusComponentNotFound = errors.New("status component not found")
+	ErrMaintenanceNotFound     = errors.New("maintenance announcement not found")
+)
+
+// ComponentStatus отображаемый статус компонента страницы статуса
+type ComponentStatus string
+
+const (
+	ComponentStatusOperational         ComponentStatus = "operational"
+	ComponentStatusDegradedPerformance ComponentStatus = "degraded_performance"
+	ComponentStatusPartialOutage       ComponentStatus = "partial_outage"
+	ComponentStatusMajorOutage         ComponentStatus = "major_outage"
+	ComponentStatusUnderMaintenance    ComponentStatus = "under_maintenance"
+)
+
+// StatusSource источник отображаемого статуса компонента
+type StatusSource string
+
+const (
+	// StatusSourceChecks статус вычислен по активным инцидентам проверок компонента
+	StatusSourceChecks StatusSource = "checks"
+	// StatusSourceOverride статус задан оператором вручную
+	StatusSourceOverride StatusSource = "override"
+	// StatusSourceMaintenance компонент находится в объявленном окне обслуживания
+	StatusSourceMaintenance StatusSource = "maintenance"
+)
+
+// componentStatusRank порядок статусов от лучшего к худшему для расчета общего статуса страницы
+var componentStatusRank = map[ComponentStatus]int{
+	ComponentStatusOperational:         0,
+	ComponentStatusUnderMaintenance:    1,
+	ComponentStatusDegradedPerformance: 2,
+	ComponentStatusPartialOutage:       3,
+	ComponentStatusMajorOutage:         4,
+}
+
+// IsValidComponentStatus проверяет валидность статуса компонента
+func IsValidComponentStatus(status ComponentStatus) bool {
+	_, ok := componentStatusRank[status]
+	return ok
+}
+
+// WorseThan сообщает, что статус s хуже other
+func (s ComponentStatus) WorseThan(other ComponentStatus) bool {
+	return componentStatusRank[s] > componentStatusRank[other]
+}
+
+// ComponentStatusForSeverity статус компонента при активном инциденте заданной серьезности
+func ComponentStatusForSeverity(severity IncidentSeverity) ComponentStatus {
+	switch severity {
+	case IncidentSeverityCritical:
+		return ComponentStatusMajorOutage
+	case IncidentSeverityError:
+		return ComponentStatusPartialOutage
+	default:
+		return ComponentStatusDegradedPerformance
+	}
+}
+
+// StatusOverride ручное переопределение статуса компонента.
+// Пока переопределение действует, автоматический статус по проверкам не отображается
+type StatusOverride struct {
+	Status  ComponentStatus `json:"status"`
+	Message string          `json:"message,omitempty"`
+	SetBy   string          `json:"set_by,omitempty"`
+	SetAt   time.Time       `json:"set_at"`
+	// ExpiresAt момент автоматического снятия переопределения; nil - до ручного снятия
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ActiveAt сообщает, действует ли переопределение в момент now
+func (o *StatusOverride) ActiveAt(now time.Time) bool {
+	return o != nil && (o.ExpiresAt == nil || now.Before(*o.ExpiresAt))
+}
+
+// StatusComponent компонент страницы статуса, объединяющий проверки
+type StatusComponent struct {
+	ID          string          `json:"id" db:"id"`
+	TenantID    string          `json:"tenant_id" db:"tenant_id"`
+	Name        string          `json:"name" db:"name"`
+	Description string          `json:"description" db:"description"`
+	CheckIDs    []string        `json:"check_ids" db:"check_ids"`
+	Position    int             `json:"position" db:"position"`
+	Override    *StatusOverride `json:"override,omitempty" db:"override"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// Validate проверяет поля компонента
+func (c *StatusComponent) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(c.Name) > 255 {
+		return fmt.Errorf("name must be at most 255 characters")
+	}
+	if len(c.Description) > 1000 {
+		return fmt.Errorf("description must be at most 1000 characters")
+	}
+	if c.Position < 0 {
+		return fmt.Errorf("position must not be negative")
+	}
+	return nil
+}
+
+// MaintenanceState состояние объявления об обслуживании
+type MaintenanceState string
+
+const (
+	MaintenanceStateScheduled  MaintenanceState = "scheduled"
+	MaintenanceStateInProgress MaintenanceState = "in_progress"
+	MaintenanceStateCompleted  MaintenanceState = "completed"
+	MaintenanceStateCancelled  MaintenanceState = "cancelled"
+)
+
+// MaintenanceAnnouncement объявление о плановом обслуживании с запланированными началом и концом.
+// Состояние определяется временем, а не проверками
+type MaintenanceAnnouncement struct {
+	ID       string `json:"id" db:"id"`
+	TenantID string `json:"tenant_id" db:"tenant_id"`
+	Title    string `json:"title" db:"title"`
+	Message  string `json:"message" db:"message"`
+	// ComponentIDs компоненты, которые на время обслуживания отображаются как under_maintenance
+	ComponentIDs []string   `json:"component_ids" db:"component_ids"`
+	StartsAt     time.Time  `json:"starts_at" db:"starts_at"`
+	EndsAt       time.Time  `json:"ends_at" db:"ends_at"`
+	CancelledAt  *time.Time `json:"cancelled_at,omitempty" db:"cancelled_at"`
+	CreatedBy    string     `json:"created_by" db:"created_by"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// Validate проверяет поля объявления
+func (m *MaintenanceAnnouncement) Validate() error {
+	if strings.TrimSpace(m.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if len(m.Title) > 255 {
+		return fmt.Errorf("title must be at most 255 characters")
+	}
+	if len(m.Message) > 5000 {
+		return fmt.Errorf("message must be at most 5000 characters")
+	}
+	if m.StartsAt.IsZero() || m.EndsAt.IsZero() {
+		return fmt.Errorf("starts_at and ends_at are required")
+	}
+	if !m.EndsAt.After(m.StartsAt) {
+		return fmt.Errorf("ends_at must be after starts_at")
+	}
+	return nil
+}
+
+// StateAt возвращает состояние объявления в момент now
+func (m *MaintenanceAnnouncement) StateAt(now time.Time) MaintenanceState {
+	switch {
+	case m.CancelledAt != nil:
+		return MaintenanceStateCancelled
+	case now.Before(m.StartsAt):
+		return MaintenanceStateScheduled
+	case now.Before(m.EndsAt):
+		return MaintenanceStateInProgress
+	default:
+		return MaintenanceStateCompleted
+	}
+}
+
+// Covers сообщает, относится ли объявление к компоненту
+func (m *MaintenanceAnnouncement) Covers(componentID string) bool {
+	for _, id := range m.ComponentIDs {
+		if id == componentID {
+			return true
+		}
+	}
+	return false
+}
+
+// ComponentView компонент с вычисленным отображаемым статусом
+type ComponentView struct {
+	*StatusComponent
+	// AutomatedStatus статус по активным инцидентам проверок компонента
+	AutomatedStatus ComponentStatus `json:"automated_status"`
+	// Status отображаемый статус с учетом переопределения и обслуживания
+	Status       ComponentStatus `json:"status"`
+	StatusSource StatusSource    `json:"status_source"`
+	// StatusMessage сообщение оператора для переопределенного статуса
+	StatusMessage string `json:"status_message,omitempty"`
+}
+
+// ResolveComponentView вычисляет отображаемый статус: действующее переопределение важнее
+// идущего обслуживания, обслуживание важнее автоматического статуса
+func ResolveComponentView(component *StatusComponent, automated ComponentStatus, maintenances []*MaintenanceAnnouncement, now time.Time) ComponentView {
+	view := ComponentView{
+		StatusComponent: component,
+		AutomatedStatus: automated,
+		Status:          automated,
+		StatusSource:    StatusSourceChecks,
+	}
+
+	if component.Override.ActiveAt(now) {
+		view.Status = component.Override.Status
+		view.StatusSource = StatusSourceOverride
+		view.StatusMessage = component.Override.Message
+		return view
+	}
+
+	for _, maintenance := range maintenances {
+		if maintenance.StateAt(now) == MaintenanceStateInProgress && maintenance.Covers(component.ID) {
+			view.Status = ComponentStatusUnderMaintenance
+			view.StatusSource = StatusSourceMaintenance
+			view.StatusMessage = maintenance.Title
+			return view
+		}
+	}
+	return view
+}
+
+// StatusPage публичное представление страницы статуса тенанта
+type StatusPage struct {
+	TenantID string `json:"tenant_id"`
+	// Status общий статус: худший из статусов компонентов
+	Status               ComponentStatus            `json:"status"`
+	Components           []ComponentView            `json:"components"`
+	ActiveMaintenances   []*MaintenanceAnnouncement `json:"active_maintenances"`
+	UpcomingMaintenances []*MaintenanceAnnouncement `json:"upcoming_maintenances"`
+	GeneratedAt          time.Time                  `json:"generated_at"`
+}
@@ -0,0 +1,70 @@
+package email
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// addressPrefix префикс локальной части адресов приема алертов
+const addressPrefix = "alerts-"
+
+// addressSignatureLength длина подписи в адресе (hex символов)
+const addressSignatureLength = 12
+
+// AddressBook выдает тенантам выделенные адреса приема алертов вида
+// alerts-<tenant_id>-<подпись>@<домен>. Подпись - HMAC от tenant_id, поэтому адрес
+// нельзя подобрать для чужого тенанта, а хранить соответствие адресов не требуется
+type AddressBook struct {
+	domain string
+	secret []byte
+}
+
+// NewAddressBook создает книгу адресов для домена приема почты
+func NewAddressBook(domain, secret string) (*AddressBook, error) {
+	if domain == "" {
+		return nil, fmt.Errorf("email domain is required")
+	}
+	if len(secret) < 16 {
+		return nil, fmt.Errorf("email address secret must be at least 16 characters")
+	}
+	return &AddressBook{domain: strings.ToLower(domain), secret: []byte(secret)}, nil
+}
+
+// AddressFor возвращает адрес приема алертов тенанта
+func (b *AddressBook) AddressFor(tenantID string) string {
+	return addressPrefix + strings.ToLower(tenantID) + "-" + b.sign(strings.ToLower(tenantID)) + "@" + b.domain
+}
+
+// Resolve возвращает тенанта по адресу получателя. Поддерживаются адреса в форме "Name <addr>"
+func (b *AddressBook) Resolve(address string) (string, bool) {
+	address = strings.ToLower(strings.TrimSpace(address))
+	if start := strings.LastIndex(address, "<"); start >= 0 {
+		address = strings.TrimSuffix(address[start+1:], ">")
+	}
+
+	local, domain, ok := strings.Cut(address, "@")
+	if !ok || domain != b.domain || !strings.HasPrefix(local, addressPrefix) {
+		return "", false
+	}
+
+	local = strings.TrimPrefix(local, addressPrefix)
+	separator := strings.LastIndex(local, "-")
+	if separator <= 0 {
+		return "", false
+	}
+	tenantID, signature := local[:separator], local[separator+1:]
+	if !hmac.Equal([]byte(signature), []byte(b.sign(tenantID))) {
+		return "", false
+	}
+	return tenantID, true
+}
+
+// sign вычисляет подпись tenant_id
+func (b *AddressBook) sign(tenantID string) string {
+	mac := hmac.New(sha256.New, b.secret)
+	mac.Write([]byte(tenantID))
+	return hex.EncodeToString(mac.Sum(nil))[:addressSignatureLength]
+}
@@ -0,0 +1,53 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTenantID = "550e8400-e29b-41d4-a716-446655440001"
+
+func TestAddressBook_RoundTrip(t *testing.T) {
+	book, err := NewAddressBook("alerts.example.com", "address-secret-0123456789")
+	require.NoError(t, err)
+
+	address := book.AddressFor(testTenantID)
+	assert.Contains(t, address, "alerts-"+testTenantID+"-")
+	assert.Contains(t, address, "@alerts.example.com")
+
+	tenantID, ok := book.Resolve(address)
+	require.True(t, ok)
+	assert.Equal(t, testTenantID, tenantID)
+
+	tenantID, ok = book.Resolve("Legacy Monitor <" + address + ">")
+	require.True(t, ok)
+	assert.Equal(t, testTenantID, tenantID)
+}
+
+func TestAddressBook_RejectsForgedAddresses(t *testing.T) {
+	book, err := NewAddressBook("alerts.example.com", "address-secret-0123456789")
+	require.NoError(t, err)
+	other, err := NewAddressBook("alerts.example.com", "another-secret-0123456789")
+	require.NoError(t, err)
+
+	addresses := []string{
+		other.AddressFor(testTenantID),
+		"alerts-" + testTenantID + "-000000000000@alerts.example.com",
+		book.AddressFor(testTenantID)[:len(book.AddressFor(testTenantID))-len("alerts.example.com")] + "other.example.com",
+		"support@alerts.example.com",
+		"not-an-address",
+	}
+	for _, address := range addresses {
+		_, ok := book.Resolve(address)
+		assert.False(t, ok, address)
+	}
+}
+
+func TestNewAddressBook_Validation(t *testing.T) {
+	_, err := NewAddressBook("", "address-secret-0123456789")
+	assert.Error(t, err)
+	_, err = NewAddressBook("alerts.example.com", "short")
+	assert.Error(t, err)
+}
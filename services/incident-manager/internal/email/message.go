@@ -0,0 +1,208 @@
+package email
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+// SourceEmail имя источника для инцидентов, открытых по письмам
+const SourceEmail = "email"
+
+// Заголовки, которыми отправитель может явно задать статус и fingerprint алерта
+const (
+	HeaderAlertStatus      = "X-Alert-Status"
+	HeaderAlertFingerprint = "X-Alert-Fingerprint"
+)
+
+const (
+	maxDescriptionLength = 4000
+	maxTitleLength       = 255
+	maxPartSize          = 1 << 20
+	maxMultipartDepth    = 5
+)
+
+var (
+	// replyPrefixPattern префиксы ответов и пересылок в теме письма
+	replyPrefixPattern = regexp.MustCompile(`(?i)^\s*((re|fw|fwd|aw|wg)\s*:\s*)+`)
+	// statusTokenPattern маркеры статуса в теме: [RESOLVED], PROBLEM:, ** RECOVERY ** и т.п.
+	statusTokenPattern = regexp.MustCompile(`(?i)(\[\s*|\*\*\s*|\b)(problem|alert|firing|down|critical|warning|resolved|recovery|recovered|ok|up)(\s*\]|\s*\*\*|\s*:)`)
+	resolvedPattern    = regexp.MustCompile(`(?i)(\[\s*|\*\*\s*|\b)(resolved|recovery|recovered|ok|up)(\s*\]|\s*\*\*|\s*:)`)
+	htmlTagPattern     = regexp.MustCompile(`<[^>]*>`)
+	spacePattern       = regexp.MustCompile(`\s+`)
+)
+
+// Message письмо с алертом
+type Message struct {
+	From    string
+	Subject string
+	Body    string
+	Header  mail.Header
+}
+
+// ParseMessage разбирает письмо в формате RFC 5322 (MIME)
+func ParseMessage(raw []byte) (*Message, error) {
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid email message: %w", err)
+	}
+
+	decoder := new(mime.WordDecoder)
+	subject, err := decoder.DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil {
+		subject = parsed.Header.Get("Subject")
+	}
+	from, err := decoder.DecodeHeader(parsed.Header.Get("From"))
+	if err != nil {
+		from = parsed.Header.Get("From")
+	}
+
+	body, err := readBody(parsed.Header.Get("Content-Type"), parsed.Header.Get("Content-Transfer-Encoding"), parsed.Body, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		From:    from,
+		Subject: strings.TrimSpace(subject),
+		Body:    strings.TrimSpace(body),
+		Header:  parsed.Header,
+	}, nil
+}
+
+// readBody возвращает текст письма, предпочитая text/plain части multipart сообщения
+func readBody(contentType, encoding string, body io.Reader, depth int) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || contentType == "" {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxMultipartDepth {
+			return "", nil
+		}
+		reader := multipart.NewReader(body, params["boundary"])
+		var html string
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", fmt.Errorf("invalid multipart email: %w", err)
+			}
+			text, err := readBody(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part, depth+1)
+			if err != nil {
+				return "", err
+			}
+			partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+			if text != "" && (partType == "text/plain" || strings.HasPrefix(partType, "multipart/")) {
+				return text, nil
+			}
+			if text != "" && html == "" {
+				html = text
+			}
+		}
+		return html, nil
+	}
+
+	if mediaType != "text/plain" && mediaType != "text/html" {
+		return "", nil
+	}
+
+	content, err := io.ReadAll(io.LimitReader(decodeTransfer(encoding, body), maxPartSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read email body: %w", err)
+	}
+	text := string(content)
+	if mediaType == "text/html" {
+		text = spacePattern.ReplaceAllString(htmlTagPattern.ReplaceAllString(text, " "), " ")
+	}
+	return text, nil
+}
+
+// decodeTransfer снимает Content-Transfer-Encoding
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// ToAlert преобразует письмо в алерт тенанта. Статус определяется заголовком X-Alert-Status
+// или маркером в теме ([RESOLVED], RECOVERY: и т.п.); письма о сбое и о восстановлении
+// получают одинаковый fingerprint и попадают в один инцидент
+func (m *Message) ToAlert(tenantID string) *domain.ExternalAlert {
+	resolved := resolvedPattern.MatchString(m.Subject)
+	if status := strings.ToLower(strings.TrimSpace(m.Header.Get(HeaderAlertStatus))); status != "" {
+		resolved = status == "resolved" || status == "ok"
+	}
+
+	title := NormalizeSubject(m.Subject)
+	if title == "" {
+		title = "Email alert from " + m.From
+	}
+	title = truncate(title, maxTitleLength)
+
+	fingerprint := strings.TrimSpace(m.Header.Get(HeaderAlertFingerprint))
+	if fingerprint == "" {
+		hash := sha256.Sum256([]byte(strings.ToLower(title)))
+		fingerprint = hex.EncodeToString(hash[:8])
+	}
+
+	description := truncate(m.Body, maxDescriptionLength)
+
+	alert := &domain.ExternalAlert{
+		TenantID:    tenantID,
+		Source:      SourceEmail,
+		Fingerprint: fingerprint,
+		Resolved:    resolved,
+		Title:       title,
+		Description: description,
+		Labels:      map[string]string{"from": m.From},
+	}
+
+	lowerSubject := strings.ToLower(m.Subject)
+	switch {
+	case strings.Contains(lowerSubject, "critical"):
+		alert.Severity = domain.IncidentSeverityCritical
+	case strings.Contains(lowerSubject, "warning"):
+		alert.Severity = domain.IncidentSeverityWarning
+	}
+	return alert
+}
+
+// NormalizeSubject убирает из темы префиксы ответов и маркеры статуса
+func NormalizeSubject(subject string) string {
+	subject = replyPrefixPattern.ReplaceAllString(subject, "")
+	subject = statusTokenPattern.ReplaceAllString(subject, " ")
+	return strings.TrimSpace(spacePattern.ReplaceAllString(subject, " "))
+}
+
+// truncate обрезает строку до limit байт, не разрывая UTF-8 символы
+func truncate(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	value = value[:limit]
+	for !utf8.ValidString(value) {
+		value = value[:len(value)-1]
+	}
+	return value
+}
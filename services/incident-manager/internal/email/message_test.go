@@ -0,0 +1,114 @@
+package email
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+func TestParseMessage_PlainText(t *testing.T) {
+	raw := "From: Nagios <nagios@legacy.example.com>\r\n" +
+		"To: alerts@example.com\r\n" +
+		"Subject: =?UTF-8?B?UFJPQkxFTTogZGIxIGRpc2sgZnVsbA==?=\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"Content-Transfer-Encoding: quoted-printable\r\n" +
+		"\r\n" +
+		"Disk usage on db1 is 97%=0A/var/lib/postgresql\r\n"
+
+	message, err := ParseMessage([]byte(raw))
+	require.NoError(t, err)
+	assert.Equal(t, "PROBLEM: db1 disk full", message.Subject)
+	assert.Equal(t, "Nagios <nagios@legacy.example.com>", message.From)
+	assert.Equal(t, "Disk usage on db1 is 97%\n/var/lib/postgresql", message.Body)
+}
+
+func TestParseMessage_MultipartPrefersPlainText(t *testing.T) {
+	raw := "From: monitor@example.com\r\n" +
+		"Subject: Backup failed\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/alternative; boundary=\"b1\"\r\n" +
+		"\r\n" +
+		"--b1\r\n" +
+		"Content-Type: text/html\r\n" +
+		"\r\n" +
+		"<p>Backup <b>failed</b></p>\r\n" +
+		"--b1\r\n" +
+		"Content-Type: text/plain\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"QmFja3VwIGpvYiBm\r\nYWlsZWQgd2l0aCBleGl0IGNvZGUgMQ==\r\n" +
+		"--b1--\r\n"
+
+	message, err := ParseMessage([]byte(raw))
+	require.NoError(t, err)
+	assert.Equal(t, "Backup job failed with exit code 1", message.Body)
+}
+
+func TestParseMessage_HTMLOnly(t *testing.T) {
+	raw := "From: monitor@example.com\r\n" +
+		"Subject: Backup failed\r\n" +
+		"Content-Type: text/html\r\n" +
+		"\r\n" +
+		"<html><body><p>Backup <b>failed</b></p></body></html>\r\n"
+
+	message, err := ParseMessage([]byte(raw))
+	require.NoError(t, err)
+	assert.Equal(t, "Backup failed", message.Body)
+}
+
+func TestMessage_ToAlert_FiringAndResolvedShareFingerprint(t *testing.T) {
+	firing := &Message{From: "nagios@example.com", Subject: "** PROBLEM ** CRITICAL: db1 disk full", Body: "97%"}
+	resolved := &Message{From: "nagios@example.com", Subject: "RE: [RESOLVED] CRITICAL: db1 disk full"}
+
+	firingAlert := firing.ToAlert(testTenantID)
+	resolvedAlert := resolved.ToAlert(testTenantID)
+
+	assert.False(t, firingAlert.Resolved)
+	assert.True(t, resolvedAlert.Resolved)
+	assert.Equal(t, "db1 disk full", firingAlert.Title)
+	assert.Equal(t, firingAlert.Fingerprint, resolvedAlert.Fingerprint)
+	assert.Equal(t, domain.IncidentSeverityCritical, firingAlert.Severity)
+	assert.Equal(t, SourceEmail, firingAlert.Source)
+	assert.Equal(t, "97%", firingAlert.Description)
+	require.NoError(t, firingAlert.Validate())
+}
+
+func TestMessage_ToAlert_Headers(t *testing.T) {
+	message := &Message{
+		Subject: "Job report",
+		Header: map[string][]string{
+			"X-Alert-Status":      {"resolved"},
+			"X-Alert-Fingerprint": {"job-42"},
+		},
+	}
+
+	alert := message.ToAlert(testTenantID)
+	assert.True(t, alert.Resolved)
+	assert.Equal(t, "job-42", alert.Fingerprint)
+	assert.Empty(t, alert.Severity)
+}
+
+func TestMessage_ToAlert_TruncatesLongFields(t *testing.T) {
+	message := &Message{Subject: strings.Repeat("й", 300), Body: strings.Repeat("x", 5000)}
+
+	alert := message.ToAlert(testTenantID)
+	assert.LessOrEqual(t, len(alert.Title), maxTitleLength)
+	assert.Len(t, alert.Description, maxDescriptionLength)
+	require.NoError(t, alert.Validate())
+}
+
+func TestNormalizeSubject(t *testing.T) {
+	tests := map[string]string{
+		"Fwd: RE: PROBLEM: web01 down":       "web01 down",
+		"[OK] api latency":                   "api latency",
+		"** RECOVERY ** Service HTTP on web": "Service HTTP on web",
+		"Nightly backup failed":              "Nightly backup failed",
+	}
+	for subject, want := range tests {
+		assert.Equal(t, want, NormalizeSubject(subject), subject)
+	}
+}
@@ -0,0 +1,296 @@
+package email
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+const (
+	// snsMaxBodySize максимальный размер сообщения SNS
+	snsMaxBodySize   = 256 << 10
+	sesIngestTimeout = 10 * time.Second
+)
+
+// AlertIngester принимает внешние алерты
+type AlertIngester interface {
+	IngestAlert(ctx context.Context, alert *domain.ExternalAlert) (*domain.Incident, service.AlertAction, error)
+}
+
+// SESConfig конфигурация приема писем через Amazon SES
+type SESConfig struct {
+	// Token секрет, передаваемый в параметре token адреса подписки SNS
+	Token string
+	// TopicARNs разрешенные топики SNS; пустой список разрешает любой топик
+	TopicARNs []string
+}
+
+// SESHandler принимает письма, полученные Amazon SES и доставленные через SNS (действие SNS
+// в правиле приема SES с включенным содержимым письма), и превращает их в инциденты.
+// Принимаются только сообщения с верной подписью SNS
+type SESHandler struct {
+	config     SESConfig
+	addresses  *AddressBook
+	ingester   AlertIngester
+	logger     logger.Logger
+	client     *http.Client
+	signatures *snsSignatureVerifier
+}
+
+// NewSESHandler создает обработчик уведомлений SES
+func NewSESHandler(config SESConfig, addresses *AddressBook, ingester AlertIngester, logger logger.Logger) (*SESHandler, error) {
+	if len(config.Token) < 16 {
+		return nil, fmt.Errorf("ses webhook token must be at least 16 characters")
+	}
+	return &SESHandler{
+		config:     config,
+		addresses:  addresses,
+		ingester:   ingester,
+		logger:     logger,
+		client:     &http.Client{Timeout: sesIngestTimeout},
+		signatures: newSNSSignatureVerifier(),
+	}, nil
+}
+
+// RegisterRoutes регистрирует HTTP маршруты
+func (h *SESHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/email/ses", h.handleNotification)
+}
+
+// snsEnvelope конверт сообщения SNS
+type snsEnvelope struct {
+	Type             string `json:"Type"`
+	MessageID        string `json:"MessageId"`
+	TopicArn         string `json:"TopicArn"`
+	Subject          string `json:"Subject"`
+	Message          string `json:"Message"`
+	Timestamp        string `json:"Timestamp"`
+	Token            string `json:"Token"`
+	SubscribeURL     string `json:"SubscribeURL"`
+	SignatureVersion string `json:"SignatureVersion"`
+	Signature        string `json:"Signature"`
+	SigningCertURL   string `json:"SigningCertURL"`
+}
+
+// sesNotification уведомление SES о полученном письме
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	Mail             struct {
+		MessageID   string   `json:"messageId"`
+		Source      string   `json:"source"`
+		Destination []string `json:"destination"`
+	} `json:"mail"`
+	Receipt struct {
+		Recipients   []string `json:"recipients"`
+		SpamVerdict  verdict  `json:"spamVerdict"`
+		VirusVerdict verdict  `json:"virusVerdict"`
+		Action       struct {
+			Encoding string `json:"encoding"`
+		} `json:"action"`
+	} `json:"receipt"`
+	Content string `json:"content"`
+}
+
+// verdict результат проверки письма SES
+type verdict struct {
+	Status string `json:"status"`
+}
+
+// emailResult результат обработки письма
+type emailResult struct {
+	Action     string `json:"action"`
+	IncidentID string `json:"incident_id,omitempty"`
+}
+
+// handleNotification обрабатывает сообщение SNS
+func (h *SESHandler) handleNotification(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("token")), []byte(h.config.Token)) != 1 {
+		h.logger.Warn("Rejected SES notification with invalid token")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, snsMaxBodySize))
+	if err != nil {
+		http.Error(w, "Failed to read body", http.StatusBadRequest)
+		return
+	}
+	var envelope snsEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		http.Error(w, "Invalid SNS message", http.StatusBadRequest)
+		return
+	}
+	if !h.topicAllowed(envelope.TopicArn) {
+		h.logger.Warn("Rejected SES notification from unknown topic", logger.String("topic_arn", envelope.TopicArn))
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	if err := h.signatures.Verify(r.Context(), &envelope); err != nil {
+		h.logger.Warn("Rejected SES notification with invalid SNS signature",
+			logger.String("topic_arn", envelope.TopicArn),
+			logger.Error(err))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch envelope.Type {
+	case "SubscriptionConfirmation":
+		if err := h.confirmSubscription(r.Context(), envelope.SubscribeURL); err != nil {
+			h.logger.Error("Failed to confirm SNS subscription", logger.Error(err))
+			http.Error(w, "Failed to confirm subscription", http.StatusBadRequest)
+			return
+		}
+		h.logger.Info("SNS subscription confirmed", logger.String("topic_arn", envelope.TopicArn))
+		w.WriteHeader(http.StatusOK)
+		return
+	case "Notification":
+	default:
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var notification sesNotification
+	if err := json.Unmarshal([]byte(envelope.Message), &notification); err != nil {
+		http.Error(w, "Invalid SES notification", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.processEmail(r.Context(), &notification)
+	if err != nil {
+		// Ошибки валидации не исправятся повторной доставкой, поэтому SNS получает 200
+		var appErr *errors.Error
+		if stderrors.As(err, &appErr) && appErr.Code == errors.ErrValidation {
+			h.logger.Warn("Rejected email alert", logger.String("message_id", notification.Mail.MessageID), logger.Error(err))
+			result = &emailResult{Action: "rejected"}
+		} else {
+			h.logger.Error("Failed to ingest email alert", logger.String("message_id", notification.Mail.MessageID), logger.Error(err))
+			http.Error(w, "Failed to ingest email", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// processEmail определяет тенанта по адресу получателя и создает алерт из письма
+func (h *SESHandler) processEmail(ctx context.Context, notification *sesNotification) (*emailResult, error) {
+	if notification.NotificationType != "Received" {
+		return &emailResult{Action: string(service.AlertActionIgnored)}, nil
+	}
+	if strings.EqualFold(notification.Receipt.SpamVerdict.Status, "FAIL") || strings.EqualFold(notification.Receipt.VirusVerdict.Status, "FAIL") {
+		h.logger.Warn("Dropped email alert that failed spam or virus check",
+			logger.String("message_id", notification.Mail.MessageID),
+			logger.String("source", notification.Mail.Source))
+		return &emailResult{Action: "dropped"}, nil
+	}
+
+	tenantID, ok := h.resolveTenant(notification)
+	if !ok {
+		h.logger.Warn("Dropped email to unknown address",
+			logger.String("message_id", notification.Mail.MessageID),
+			logger.String("recipients", strings.Join(notification.Receipt.Recipients, ",")))
+		return &emailResult{Action: "dropped"}, nil
+	}
+
+	if notification.Content == "" {
+		return nil, errors.New(errors.ErrValidation, "SES notification does not include email content")
+	}
+	raw := []byte(notification.Content)
+	if strings.EqualFold(notification.Receipt.Action.Encoding, "BASE64") {
+		decoded, err := base64.StdEncoding.DecodeString(notification.Content)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrValidation, "invalid base64 email content")
+		}
+		raw = decoded
+	}
+
+	message, err := ParseMessage(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "failed to parse email")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sesIngestTimeout)
+	defer cancel()
+
+	incident, action, err := h.ingester.IngestAlert(ctx, message.ToAlert(tenantID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &emailResult{Action: string(action)}
+	if incident != nil {
+		result.IncidentID = incident.ID
+	}
+	h.logger.Info("Email alert ingested",
+		logger.String("message_id", notification.Mail.MessageID),
+		logger.String("tenant_id", tenantID),
+		logger.String("action", result.Action))
+	return result, nil
+}
+
+// resolveTenant ищет среди получателей выделенный адрес тенанта
+func (h *SESHandler) resolveTenant(notification *sesNotification) (string, bool) {
+	recipients := notification.Receipt.Recipients
+	if len(recipients) == 0 {
+		recipients = notification.Mail.Destination
+	}
+	for _, recipient := range recipients {
+		if tenantID, ok := h.addresses.Resolve(recipient); ok {
+			return tenantID, true
+		}
+	}
+	return "", false
+}
+
+// topicAllowed проверяет, разрешен ли топик SNS
+func (h *SESHandler) topicAllowed(topicARN string) bool {
+	if len(h.config.TopicARNs) == 0 {
+		return true
+	}
+	for _, allowed := range h.config.TopicARNs {
+		if allowed == topicARN {
+			return true
+		}
+	}
+	return false
+}
+
+// confirmSubscription подтверждает подписку SNS; разрешены только https адреса SNS
+func (h *SESHandler) confirmSubscription(ctx context.Context, subscribeURL string) error {
+	if err := validateSNSURL(subscribeURL); err != nil {
+		return fmt.Errorf("invalid subscribe url: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, subscribeURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription confirmation request: %w", err)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to confirm subscription: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("subscription confirmation returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package email
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+const testSESToken = "ses-webhook-token-0123456789"
+
+// fakeIngester запоминает принятые алерты
+type fakeIngester struct {
+	alerts []*domain.ExternalAlert
+	err    error
+}
+
+func (f *fakeIngester) IngestAlert(ctx context.Context, alert *domain.ExternalAlert) (*domain.Incident, service.AlertAction, error) {
+	if f.err != nil {
+		return nil, "", f.err
+	}
+	f.alerts = append(f.alerts, alert)
+	incident := domain.NewIncident(alert.CheckID(), alert.TenantID, domain.IncidentSeverityError, alert.Title)
+	incident.ID = "incident-1"
+	return incident, service.AlertActionCreated, nil
+}
+
+// testSigningCertURL адрес сертификата подписи SNS в тестовых сообщениях
+const testSigningCertURL = "https://sns.eu-west-1.amazonaws.com/SimpleNotificationService-test.pem"
+
+var (
+	testSigningOnce sync.Once
+	testSigningKey  *rsa.PrivateKey
+	testSigningCert *x509.Certificate
+)
+
+// testSigner возвращает ключ и самоподписанный сертификат, которыми подписываются тестовые сообщения SNS
+func testSigner(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	testSigningOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		testSigningKey, testSigningCert = key, cert
+	})
+	return testSigningKey, testSigningCert
+}
+
+// signEnvelope подписывает конверт SNS тестовым ключом по схеме SignatureVersion 2
+func signEnvelope(t *testing.T, envelope *snsEnvelope) {
+	t.Helper()
+	key, _ := testSigner(t)
+	envelope.SignatureVersion = "2"
+	envelope.SigningCertURL = testSigningCertURL
+	if envelope.MessageID == "" {
+		envelope.MessageID = "sns-message-1"
+	}
+	if envelope.Timestamp == "" {
+		envelope.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+	stringToSign, err := envelope.stringToSign()
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	envelope.Signature = base64.StdEncoding.EncodeToString(signature)
+}
+
+func envelopeRequest(t *testing.T, token string, envelope *snsEnvelope) *http.Request {
+	t.Helper()
+	body, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	return httptest.NewRequest(http.MethodPost, "/api/v1/email/ses?token="+token, strings.NewReader(string(body)))
+}
+
+func newTestSESHandler(t *testing.T) (*http.ServeMux, *AddressBook, *fakeIngester) {
+	t.Helper()
+
+	book, err := NewAddressBook("alerts.example.com", "address-secret-0123456789")
+	require.NoError(t, err)
+	log, err := logger.NewLogger("test", "debug", "incident-manager", false)
+	require.NoError(t, err)
+
+	ingester := &fakeIngester{}
+	handler, err := NewSESHandler(SESConfig{
+		Token:     testSESToken,
+		TopicARNs: []string{"arn:aws:sns:eu-west-1:123456789012:inbound-alerts"},
+	}, book, ingester, log)
+	require.NoError(t, err)
+	_, cert := testSigner(t)
+	handler.signatures.fetch = func(ctx context.Context, certURL string) (*x509.Certificate, error) {
+		return cert, nil
+	}
+
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+	return mux, book, ingester
+}
+
+func sesRequest(t *testing.T, token, topic string, notification map[string]interface{}) *http.Request {
+	t.Helper()
+	message, err := json.Marshal(notification)
+	require.NoError(t, err)
+	envelope := &snsEnvelope{Type: "Notification", TopicArn: topic, Message: string(message)}
+	signEnvelope(t, envelope)
+	return envelopeRequest(t, token, envelope)
+}
+
+func receivedNotification(recipient, content, encoding string) map[string]interface{} {
+	return map[string]interface{}{
+		"notificationType": "Received",
+		"mail": map[string]interface{}{
+			"messageId":   "msg-1",
+			"source":      "nagios@legacy.example.com",
+			"destination": []string{recipient},
+		},
+		"receipt": map[string]interface{}{
+			"recipients":   []string{recipient},
+			"spamVerdict":  map[string]string{"status": "PASS"},
+			"virusVerdict": map[string]string{"status": "PASS"},
+			"action":       map[string]string{"type": "SNS", "encoding": encoding},
+		},
+		"content": content,
+	}
+}
+
+const testEmail = "From: nagios@legacy.example.com\r\n" +
+	"Subject: PROBLEM: CRITICAL: db1 disk full\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"\r\n" +
+	"Disk usage is 97%\r\n"
+
+const testTopic = "arn:aws:sns:eu-west-1:123456789012:inbound-alerts"
+
+func TestSESHandler_CreatesIncident(t *testing.T) {
+	mux, book, ingester := newTestSESHandler(t)
+	content := base64.StdEncoding.EncodeToString([]byte(testEmail))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, sesRequest(t, testSESToken, testTopic, receivedNotification(book.AddressFor(testTenantID), content, "BASE64")))
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var result emailResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, "created", result.Action)
+	assert.Equal(t, "incident-1", result.IncidentID)
+
+	require.Len(t, ingester.alerts, 1)
+	alert := ingester.alerts[0]
+	assert.Equal(t, testTenantID, alert.TenantID)
+	assert.Equal(t, "db1 disk full", alert.Title)
+	assert.Equal(t, "Disk usage is 97%", alert.Description)
+	assert.Equal(t, domain.IncidentSeverityCritical, alert.Severity)
+}
+
+func TestSESHandler_RejectsInvalidRequests(t *testing.T) {
+	mux, book, ingester := newTestSESHandler(t)
+	notification := receivedNotification(book.AddressFor(testTenantID), testEmail, "UTF8")
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, sesRequest(t, "wrong-token", testTopic, notification))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, sesRequest(t, testSESToken, "arn:aws:sns:eu-west-1:999:other", notification))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	assert.Empty(t, ingester.alerts)
+}
+
+func TestSESHandler_DropsUnsafeOrUnknownEmails(t *testing.T) {
+	mux, book, ingester := newTestSESHandler(t)
+
+	spam := receivedNotification(book.AddressFor(testTenantID), testEmail, "UTF8")
+	spam["receipt"].(map[string]interface{})["spamVerdict"] = map[string]string{"status": "FAIL"}
+	unknown := receivedNotification("alerts-"+testTenantID+"-000000000000@alerts.example.com", testEmail, "UTF8")
+
+	for _, notification := range []map[string]interface{}{spam, unknown} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, sesRequest(t, testSESToken, testTopic, notification))
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"action":"dropped"`)
+	}
+	assert.Empty(t, ingester.alerts)
+}
+
+func TestSESHandler_IngestErrors(t *testing.T) {
+	mux, book, ingester := newTestSESHandler(t)
+	notification := receivedNotification(book.AddressFor(testTenantID), testEmail, "UTF8")
+
+	// Ошибка валидации не приводит к повторной доставке
+	ingester.err = errors.New(errors.ErrValidation, "alert validation failed")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, sesRequest(t, testSESToken, testTopic, notification))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"action":"rejected"`)
+
+	// Внутренняя ошибка возвращается SNS для повторной доставки
+	ingester.err = errors.New(errors.ErrInternal, "database unavailable")
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, sesRequest(t, testSESToken, testTopic, notification))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestSESHandler_SubscriptionRejectsForeignHost(t *testing.T) {
+	mux, _, _ := newTestSESHandler(t)
+
+	envelope := &snsEnvelope{
+		Type:         "SubscriptionConfirmation",
+		TopicArn:     testTopic,
+		Token:        "subscription-token",
+		SubscribeURL: "https://attacker.example.com/confirm",
+	}
+	signEnvelope(t, envelope)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, envelopeRequest(t, testSESToken, envelope))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSESHandler_RejectsInvalidSignatures(t *testing.T) {
+	mux, book, ingester := newTestSESHandler(t)
+	message, err := json.Marshal(receivedNotification(book.AddressFor(testTenantID), testEmail, "UTF8"))
+	require.NoError(t, err)
+
+	// Без подписи
+	unsigned := &snsEnvelope{Type: "Notification", TopicArn: testTopic, Message: string(message)}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, envelopeRequest(t, testSESToken, unsigned))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Сообщение изменено после подписи
+	tampered := &snsEnvelope{Type: "Notification", TopicArn: testTopic, Message: string(message)}
+	signEnvelope(t, tampered)
+	tampered.Message = strings.Replace(tampered.Message, "Received", "Bounce", 1)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, envelopeRequest(t, testSESToken, tampered))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Сертификат не с адреса SNS
+	foreign := &snsEnvelope{Type: "Notification", TopicArn: testTopic, Message: string(message)}
+	signEnvelope(t, foreign)
+	foreign.SigningCertURL = "https://attacker.example.com/cert.pem"
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, envelopeRequest(t, testSESToken, foreign))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	assert.Empty(t, ingester.alerts)
+}
@@ -0,0 +1,192 @@
+package email
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// snsCertMaxSize максимальный размер сертификата подписи SNS
+const snsCertMaxSize = 16 << 10
+
+// snsSignatureVerifier проверяет подпись сообщений Amazon SNS сертификатом, опубликованным SNS.
+// Сертификаты загружаются только с https адресов SNS и кэшируются по адресу
+type snsSignatureVerifier struct {
+	client *http.Client
+	fetch  func(ctx context.Context, certURL string) (*x509.Certificate, error)
+
+	mu    sync.Mutex
+	certs map[string]*x509.Certificate
+}
+
+// newSNSSignatureVerifier создает проверку подписи SNS
+func newSNSSignatureVerifier() *snsSignatureVerifier {
+	v := &snsSignatureVerifier{
+		client: &http.Client{Timeout: sesIngestTimeout},
+		certs:  make(map[string]*x509.Certificate),
+	}
+	v.fetch = v.fetchCertificate
+	return v
+}
+
+// Verify проверяет подпись конверта SNS
+func (v *snsSignatureVerifier) Verify(ctx context.Context, envelope *snsEnvelope) error {
+	var hash crypto.Hash
+	switch envelope.SignatureVersion {
+	case "1":
+		hash = crypto.SHA1
+	case "2":
+		hash = crypto.SHA256
+	default:
+		return fmt.Errorf("unsupported sns signature version %q", envelope.SignatureVersion)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(envelope.Signature)
+	if err != nil || len(signature) == 0 {
+		return fmt.Errorf("invalid sns signature encoding")
+	}
+	stringToSign, err := envelope.stringToSign()
+	if err != nil {
+		return err
+	}
+	cert, err := v.certificate(ctx, envelope.SigningCertURL)
+	if err != nil {
+		return err
+	}
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("sns signing certificate does not hold an rsa key")
+	}
+
+	var digest []byte
+	if hash == crypto.SHA1 {
+		sum := sha1.Sum([]byte(stringToSign))
+		digest = sum[:]
+	} else {
+		sum := sha256.Sum256([]byte(stringToSign))
+		digest = sum[:]
+	}
+	if err := rsa.VerifyPKCS1v15(publicKey, hash, digest, signature); err != nil {
+		return fmt.Errorf("sns signature mismatch")
+	}
+	return nil
+}
+
+// certificate возвращает сертификат подписи из кэша или загружает его
+func (v *snsSignatureVerifier) certificate(ctx context.Context, certURL string) (*x509.Certificate, error) {
+	if err := validateSNSURL(certURL); err != nil {
+		return nil, fmt.Errorf("invalid signing cert url: %w", err)
+	}
+
+	v.mu.Lock()
+	cert, ok := v.certs[certURL]
+	v.mu.Unlock()
+	if ok {
+		return cert, nil
+	}
+
+	cert, err := v.fetch(ctx, certURL)
+	if err != nil {
+		return nil, err
+	}
+	v.mu.Lock()
+	v.certs[certURL] = cert
+	v.mu.Unlock()
+	return cert, nil
+}
+
+// fetchCertificate загружает PEM сертификат подписи SNS
+func (v *snsSignatureVerifier) fetchCertificate(ctx context.Context, certURL string) (*x509.Certificate, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, certURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signing cert request: %w", err)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signing cert: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("signing cert request returned status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, snsCertMaxSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing cert: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("signing cert is not a pem certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing cert: %w", err)
+	}
+	if now := time.Now(); now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, fmt.Errorf("signing cert is not valid at this time")
+	}
+	return cert, nil
+}
+
+// stringToSign строка, подписанная SNS, для типа сообщения конверта
+func (e *snsEnvelope) stringToSign() (string, error) {
+	var fields [][2]string
+	switch e.Type {
+	case "Notification":
+		fields = [][2]string{{"Message", e.Message}, {"MessageId", e.MessageID}}
+		if e.Subject != "" {
+			fields = append(fields, [2]string{"Subject", e.Subject})
+		}
+		fields = append(fields,
+			[2]string{"Timestamp", e.Timestamp},
+			[2]string{"TopicArn", e.TopicArn},
+			[2]string{"Type", e.Type},
+		)
+	case "SubscriptionConfirmation", "UnsubscribeConfirmation":
+		fields = [][2]string{
+			{"Message", e.Message},
+			{"MessageId", e.MessageID},
+			{"SubscribeURL", e.SubscribeURL},
+			{"Timestamp", e.Timestamp},
+			{"Token", e.Token},
+			{"TopicArn", e.TopicArn},
+			{"Type", e.Type},
+		}
+	default:
+		return "", fmt.Errorf("unsupported sns message type %q", e.Type)
+	}
+
+	var b strings.Builder
+	for _, field := range fields {
+		b.WriteString(field[0])
+		b.WriteByte('\n')
+		b.WriteString(field[1])
+		b.WriteByte('\n')
+	}
+	return b.String(), nil
+}
+
+// validateSNSURL разрешает только https адреса SNS
+func validateSNSURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	host := parsed.Hostname()
+	if parsed.Scheme != "https" || !strings.HasPrefix(host, "sns.") || !strings.HasSuffix(host, ".amazonaws.com") {
+		return fmt.Errorf("host %q is not an amazon sns endpoint", host)
+	}
+	return nil
+}
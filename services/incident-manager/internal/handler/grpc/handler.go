@@ -23,6 +23,8 @@ type IncidentHandler struct {
 	service  service.IncidentService
 	logger   logger.Logger
 	validator *validation.Validator
+	// statusPage сервис страницы статуса; nil, если страница статуса не подключена
+	statusPage *service.StatusPageService
 }
 
 // NewIncidentHandler создает новый handler
@@ -58,6 +60,10 @@ func (h *IncidentHandler) CreateIncident(ctx context.Context, req *incidentv1.Cr
 		Duration:     0,
 		Timestamp:    time.Now(),
 		Metadata:     map[string]interface{}{},
+		Severity:     h.protoSeverityToDomain(req.Severity),
+	}
+	for key, value := range req.Details {
+		result.Metadata[key] = value
 	}
 
 	// Обрабатываем результат проверки
@@ -178,6 +184,18 @@ func (h *IncidentHandler) ListIncidents(ctx context.Context, req *incidentv1.Lis
 		severity := h.protoSeverityToDomain(req.Severity)
 		filter.Severity = &severity
 	}
+	if req.CheckId != "" {
+		filter.CheckID = &req.CheckId
+	}
+	// Формат границ уже проверен при валидации
+	if req.From != "" {
+		from, _ := time.Parse(time.RFC3339, req.From)
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, _ := time.Parse(time.RFC3339, req.To)
+		filter.To = &to
+	}
 
 	// Добавляем пагинацию
 	if req.PageSize > 0 {
@@ -194,22 +212,29 @@ func (h *IncidentHandler) ListIncidents(ctx context.Context, req *incidentv1.Lis
 		return nil, status.Errorf(codes.Internal, "failed to get incidents: %v", err)
 	}
 
+	// Общее число для пагинации считается тем же фильтром без Limit и Offset
+	total, err := h.service.CountIncidents(ctx, filter)
+	if err != nil {
+		h.LogError(ctx, err, "ListIncidents", req.TenantId)
+		return nil, status.Errorf(codes.Internal, "failed to count incidents: %v", err)
+	}
+
 	// Конвертируем в protobuf
 	pbIncidents := make([]*incidentv1.Incident, len(incidents))
 	for i, incident := range incidents {
 		pbIncidents[i] = h.incidentToProto(incident)
 	}
 
-	// Вычисляем NextPageToken для пагинации
+	// Следующая страница есть, если до конца выборки остались записи
 	nextPageToken := int32(0)
-	if req.PageSize > 0 && len(incidents) == int(req.PageSize) {
-		// Если вернули полное количество записей, возможно есть следующая страница
-		nextPageToken = int32(req.PageToken + req.PageSize)
+	if end := filter.Offset + len(incidents); req.PageSize > 0 && end < total {
+		nextPageToken = int32(end)
 	}
 
 	h.LogOperationSuccess(ctx, "ListIncidents", map[string]interface{}{
 		"tenant_id":     req.TenantId,
 		"count":         len(incidents),
+		"total":         total,
 		"page_size":     req.PageSize,
 		"page_token":    req.PageToken,
 		"next_page_token": nextPageToken,
@@ -218,6 +243,7 @@ func (h *IncidentHandler) ListIncidents(ctx context.Context, req *incidentv1.Lis
 	return &incidentv1.ListIncidentsResponse{
 		Incidents:      pbIncidents,
 		NextPageToken:  nextPageToken,
+		Total:          int32(total),
 	}, nil
 }
 
@@ -265,6 +291,145 @@ func (h *IncidentHandler) GetIncident(ctx context.Context, req *incidentv1.GetIn
 	}, nil
 }
 
+// IngestAlert обрабатывает алерт внешней системы мониторинга
+func (h *IncidentHandler) IngestAlert(ctx context.Context, req *incidentv1.IngestAlertRequest) (*incidentv1.IngestAlertResponse, error) {
+	h.LogOperationStart(ctx, "IngestAlert", map[string]interface{}{
+		"tenant_id":   req.TenantId,
+		"source":      req.Source,
+		"fingerprint": req.Fingerprint,
+		"resolved":    req.Resolved,
+	})
+
+	// Валидация запроса
+	if err := h.validateIngestAlertRequest(ctx, req); err != nil {
+		h.LogError(ctx, err, "IngestAlert", req.Fingerprint)
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	alert := &domain.ExternalAlert{
+		TenantID:    req.TenantId,
+		Source:      req.Source,
+		Fingerprint: req.Fingerprint,
+		Resolved:    req.Resolved,
+		Title:       req.Title,
+		Description: req.Description,
+		Labels:      req.Labels,
+	}
+	// Без явной серьезности она определяется по заголовку алерта
+	if req.Severity != incidentv1.IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED {
+		alert.Severity = h.protoSeverityToDomain(req.Severity)
+	}
+
+	incident, action, err := h.service.IngestAlert(ctx, alert)
+	if err != nil {
+		h.LogError(ctx, err, "IngestAlert", req.Fingerprint)
+		return nil, status.Errorf(codes.Internal, "failed to ingest alert: %v", err)
+	}
+
+	resp := &incidentv1.IngestAlertResponse{Action: string(action)}
+	if incident != nil {
+		resp.Incident = h.incidentToProto(incident)
+	}
+
+	h.LogOperationSuccess(ctx, "IngestAlert", map[string]interface{}{
+		"source": req.Source,
+		"action": string(action),
+	})
+
+	return resp, nil
+}
+
+// AcknowledgeIncident подтверждает инцидент и возвращает его новое состояние
+func (h *IncidentHandler) AcknowledgeIncident(ctx context.Context, req *incidentv1.AcknowledgeIncidentRequest) (*incidentv1.Incident, error) {
+	h.LogOperationStart(ctx, "AcknowledgeIncident", map[string]interface{}{
+		"incident_id": req.IncidentId,
+	})
+
+	// Валидация запроса
+	if err := h.validateIncidentID(ctx, "AcknowledgeIncident", req.IncidentId); err != nil {
+		h.LogError(ctx, err, "AcknowledgeIncident", req.IncidentId)
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	// Подтверждаем инцидент
+	if err := h.service.AcknowledgeIncident(ctx, req.IncidentId); err != nil {
+		h.LogError(ctx, err, "AcknowledgeIncident", req.IncidentId)
+		return nil, status.Errorf(codes.Internal, "failed to acknowledge incident: %v", err)
+	}
+
+	incident, err := h.service.GetIncident(ctx, req.IncidentId)
+	if err != nil {
+		h.LogError(ctx, err, "AcknowledgeIncident", req.IncidentId)
+		return nil, status.Errorf(codes.NotFound, "incident not found: %v", err)
+	}
+
+	h.LogOperationSuccess(ctx, "AcknowledgeIncident", map[string]interface{}{
+		"incident_id": incident.ID,
+		"status":      string(incident.Status),
+	})
+
+	return h.incidentToProto(incident), nil
+}
+
+// GetIncidentStats возвращает статистику инцидентов арендатора
+func (h *IncidentHandler) GetIncidentStats(ctx context.Context, req *incidentv1.GetIncidentStatsRequest) (*incidentv1.IncidentStats, error) {
+	h.LogOperationStart(ctx, "GetIncidentStats", map[string]interface{}{
+		"tenant_id": req.TenantId,
+	})
+
+	// Валидация запроса
+	if err := h.ValidateRequiredFields(ctx, "GetIncidentStats", map[string]string{
+		"tenant_id": req.TenantId,
+	}); err != nil {
+		h.LogError(ctx, err, "GetIncidentStats", req.TenantId)
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	stats, err := h.service.GetIncidentStats(ctx, req.TenantId)
+	if err != nil {
+		h.LogError(ctx, err, "GetIncidentStats", req.TenantId)
+		return nil, status.Errorf(codes.Internal, "failed to get incident stats: %v", err)
+	}
+
+	h.LogOperationSuccess(ctx, "GetIncidentStats", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"total":     stats.Total,
+	})
+
+	return h.incidentStatsToProto(stats), nil
+}
+
+// GetIncidentHistory возвращает события жизненного цикла инцидента
+func (h *IncidentHandler) GetIncidentHistory(ctx context.Context, req *incidentv1.GetIncidentHistoryRequest) (*incidentv1.GetIncidentHistoryResponse, error) {
+	h.LogOperationStart(ctx, "GetIncidentHistory", map[string]interface{}{
+		"incident_id": req.IncidentId,
+	})
+
+	// Валидация запроса
+	if err := h.validateIncidentID(ctx, "GetIncidentHistory", req.IncidentId); err != nil {
+		h.LogError(ctx, err, "GetIncidentHistory", req.IncidentId)
+		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
+	}
+
+	history, err := h.service.GetIncidentHistory(ctx, req.IncidentId)
+	if err != nil {
+		h.LogError(ctx, err, "GetIncidentHistory", req.IncidentId)
+		return nil, status.Errorf(codes.Internal, "failed to get incident history: %v", err)
+	}
+
+	events := make([]*incidentv1.IncidentEvent, len(history))
+	for i, event := range history {
+		events[i] = h.incidentEventToProto(ctx, event)
+	}
+
+	h.LogOperationSuccess(ctx, "GetIncidentHistory", map[string]interface{}{
+		"incident_id":   req.IncidentId,
+		"history_count": len(history),
+	})
+
+	return &incidentv1.GetIncidentHistoryResponse{Events: events}, nil
+}
+
 // Валидационные методы
 
 // validateCreateIncidentRequest валидирует запрос на создание инцидента
@@ -368,6 +533,30 @@ func (h *IncidentHandler) validateListIncidentsRequest(ctx context.Context, req
 		return status.Errorf(codes.InvalidArgument, "page_token must be non-negative")
 	}
 
+	// Валидация check_id если указан
+	if req.CheckId != "" {
+		if err := h.validator.ValidateStringLength(req.CheckId, "check_id", 1, 100); err != nil {
+			return err
+		}
+	}
+
+	// Валидация границ периода
+	var from, to time.Time
+	var err error
+	if req.From != "" {
+		if from, err = time.Parse(time.RFC3339, req.From); err != nil {
+			return status.Errorf(codes.InvalidArgument, "from must be RFC3339 timestamp")
+		}
+	}
+	if req.To != "" {
+		if to, err = time.Parse(time.RFC3339, req.To); err != nil {
+			return status.Errorf(codes.InvalidArgument, "to must be RFC3339 timestamp")
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return status.Errorf(codes.InvalidArgument, "to must not be before from")
+	}
+
 	// Валидация status
 	if req.Status < incidentv1.IncidentStatus_INCIDENT_STATUS_UNSPECIFIED ||
 		req.Status > incidentv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
@@ -400,6 +589,45 @@ func (h *IncidentHandler) validateGetIncidentRequest(ctx context.Context, req *i
 	return nil
 }
 
+// validateIngestAlertRequest валидирует запрос на прием внешнего алерта
+func (h *IncidentHandler) validateIngestAlertRequest(ctx context.Context, req *incidentv1.IngestAlertRequest) error {
+	// Валидация обязательных полей
+	if err := h.ValidateRequiredFields(ctx, "IngestAlert", map[string]string{
+		"tenant_id":   req.TenantId,
+		"source":      req.Source,
+		"fingerprint": req.Fingerprint,
+	}); err != nil {
+		return err
+	}
+
+	// Валидация source и fingerprint
+	if err := h.validator.ValidateStringLength(req.Source, "source", 1, 100); err != nil {
+		return err
+	}
+	if err := h.validator.ValidateStringLength(req.Fingerprint, "fingerprint", 1, 255); err != nil {
+		return err
+	}
+
+	// Валидация severity
+	if req.Severity < incidentv1.IncidentSeverity_INCIDENT_SEVERITY_UNSPECIFIED ||
+		req.Severity > incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL {
+		return status.Errorf(codes.InvalidArgument, "invalid severity value")
+	}
+
+	return nil
+}
+
+// validateIncidentID валидирует обязательный ID инцидента
+func (h *IncidentHandler) validateIncidentID(ctx context.Context, operation, incidentID string) error {
+	if err := h.ValidateRequiredFields(ctx, operation, map[string]string{
+		"incident_id": incidentID,
+	}); err != nil {
+		return err
+	}
+
+	return h.validator.ValidateStringLength(incidentID, "incident_id", 1, 100)
+}
+
 // Конвертеры из converter.go
 
 // incidentToProto конвертирует доменный инцидент в protobuf
@@ -415,7 +643,31 @@ func (h *IncidentHandler) incidentToProto(incident *domain.Incident) *incidentv1
 		Count:        int32(incident.Count),
 		ErrorMessage: incident.ErrorMessage,
 		ErrorHash:    incident.ErrorHash,
+		Details:      h.incidentDetailsToProto(incident),
+	}
+}
+
+// incidentDetailsToProto извлекает диагностические данные последней неудачной проверки
+func (h *IncidentHandler) incidentDetailsToProto(incident *domain.Incident) map[string]string {
+	raw, ok := incident.Metadata[service.FailureDetailsKey]
+	if !ok {
+		return nil
+	}
+
+	details := make(map[string]string)
+	switch values := raw.(type) {
+	case map[string]string:
+		for key, value := range values {
+			details[key] = value
+		}
+	case map[string]interface{}:
+		for key, value := range values {
+			if str, ok := value.(string); ok {
+				details[key] = str
+			}
+		}
 	}
+	return details
 }
 
 // incidentEventToProto конвертирует событие инцидента в protobuf
@@ -433,6 +685,25 @@ func (h *IncidentHandler) incidentEventToProto(ctx context.Context, event *domai
 	}
 }
 
+// incidentStatsToProto конвертирует статистику инцидентов в protobuf
+func (h *IncidentHandler) incidentStatsToProto(stats *domain.IncidentStats) *incidentv1.IncidentStats {
+	pbStats := &incidentv1.IncidentStats{
+		Total:      int32(stats.Total),
+		ByStatus:   make(map[string]int32, len(stats.ByStatus)),
+		BySeverity: make(map[string]int32, len(stats.BySeverity)),
+		LastDay:    int32(stats.Last24h),
+		LastWeek:   int32(stats.Last7d),
+		LastMonth:  int32(stats.Last30d),
+	}
+	for status, count := range stats.ByStatus {
+		pbStats.ByStatus[string(status)] = int32(count)
+	}
+	for severity, count := range stats.BySeverity {
+		pbStats.BySeverity[string(severity)] = int32(count)
+	}
+	return pbStats
+}
+
 // domainStatusToProto конвертирует статус домена в protobuf
 func (h *IncidentHandler) domainStatusToProto(status domain.IncidentStatus) incidentv1.IncidentStatus {
 	switch status {
@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+const testIncidentID = "550e8400-e29b-41d4-a716-446655440000"
+
+// stubIncidentService реализует только методы, вызываемые в тестах
+type stubIncidentService struct {
+	service.IncidentService
+	incident     *domain.Incident
+	history      []*domain.IncidentEvent
+	stats        *domain.IncidentStats
+	filter       *domain.IncidentFilter
+	total        int
+	acknowledged string
+}
+
+func (s *stubIncidentService) GetIncident(ctx context.Context, id string) (*domain.Incident, error) {
+	return s.incident, nil
+}
+
+func (s *stubIncidentService) GetIncidents(ctx context.Context, filter *domain.IncidentFilter) ([]*domain.Incident, error) {
+	s.filter = filter
+	return []*domain.Incident{s.incident}, nil
+}
+
+func (s *stubIncidentService) CountIncidents(ctx context.Context, filter *domain.IncidentFilter) (int, error) {
+	return s.total, nil
+}
+
+func (s *stubIncidentService) AcknowledgeIncident(ctx context.Context, id string) error {
+	s.acknowledged = id
+	s.incident.Acknowledge()
+	return nil
+}
+
+func (s *stubIncidentService) GetIncidentHistory(ctx context.Context, incidentID string) ([]*domain.IncidentEvent, error) {
+	return s.history, nil
+}
+
+func (s *stubIncidentService) GetIncidentStats(ctx context.Context, tenantID string) (*domain.IncidentStats, error) {
+	return s.stats, nil
+}
+
+func newTestHandler(t *testing.T) (*IncidentHandler, *stubIncidentService) {
+	log, err := logger.NewLogger("test", "debug", "incident-manager", false)
+	require.NoError(t, err)
+
+	incident := domain.NewIncident("check-1", "tenant-1", domain.IncidentSeverityError, "connection refused")
+	incident.ID = testIncidentID
+	stub := &stubIncidentService{incident: incident, total: 10}
+	return NewIncidentHandler(stub, log), stub
+}
+
+func TestIncidentHandler_ListIncidents_Filters(t *testing.T) {
+	handler, stub := newTestHandler(t)
+
+	resp, err := handler.ListIncidents(context.Background(), &incidentv1.ListIncidentsRequest{
+		TenantId:  "tenant-1",
+		CheckId:   "check-1",
+		Status:    incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN,
+		From:      "2026-01-01T00:00:00Z",
+		To:        "2026-01-02T00:00:00Z",
+		PageSize:  1,
+		PageToken: 3,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Incidents, 1)
+	assert.Equal(t, int32(4), resp.NextPageToken)
+	assert.Equal(t, int32(10), resp.Total)
+	require.NotNil(t, stub.filter.CheckID)
+	assert.Equal(t, "check-1", *stub.filter.CheckID)
+	assert.Equal(t, domain.IncidentStatusOpen, *stub.filter.Status)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *stub.filter.From)
+	assert.Equal(t, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), *stub.filter.To)
+	assert.Equal(t, 1, stub.filter.Limit)
+	assert.Equal(t, 3, stub.filter.Offset)
+}
+
+func TestIncidentHandler_ListIncidents_LastPage(t *testing.T) {
+	handler, stub := newTestHandler(t)
+	stub.total = 4
+
+	resp, err := handler.ListIncidents(context.Background(), &incidentv1.ListIncidentsRequest{
+		TenantId:  "tenant-1",
+		PageSize:  1,
+		PageToken: 3,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), resp.NextPageToken, "no page after the last incident")
+	assert.Equal(t, int32(4), resp.Total)
+}
+
+func TestIncidentHandler_ListIncidents_InvalidPeriod(t *testing.T) {
+	handler, _ := newTestHandler(t)
+
+	for _, req := range []*incidentv1.ListIncidentsRequest{
+		{TenantId: "tenant-1", From: "yesterday"},
+		{TenantId: "tenant-1", From: "2026-01-02T00:00:00Z", To: "2026-01-01T00:00:00Z"},
+	} {
+		_, err := handler.ListIncidents(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
+
+func TestIncidentHandler_AcknowledgeIncident(t *testing.T) {
+	handler, stub := newTestHandler(t)
+
+	incident, err := handler.AcknowledgeIncident(context.Background(), &incidentv1.AcknowledgeIncidentRequest{IncidentId: testIncidentID})
+
+	require.NoError(t, err)
+	assert.Equal(t, testIncidentID, stub.acknowledged)
+	assert.Equal(t, incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED, incident.Status)
+
+	_, err = handler.AcknowledgeIncident(context.Background(), &incidentv1.AcknowledgeIncidentRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIncidentHandler_GetIncidentStats(t *testing.T) {
+	handler, stub := newTestHandler(t)
+	stub.stats = &domain.IncidentStats{
+		Total:      5,
+		ByStatus:   map[domain.IncidentStatus]int{domain.IncidentStatusOpen: 3, domain.IncidentStatusResolved: 2},
+		BySeverity: map[domain.IncidentSeverity]int{domain.IncidentSeverityCritical: 1},
+		Last24h:    1,
+		Last7d:     4,
+		Last30d:    5,
+	}
+
+	stats, err := handler.GetIncidentStats(context.Background(), &incidentv1.GetIncidentStatsRequest{TenantId: "tenant-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), stats.Total)
+	assert.Equal(t, map[string]int32{"open": 3, "resolved": 2}, stats.ByStatus)
+	assert.Equal(t, map[string]int32{"critical": 1}, stats.BySeverity)
+	assert.Equal(t, int32(1), stats.LastDay)
+	assert.Equal(t, int32(4), stats.LastWeek)
+	assert.Equal(t, int32(5), stats.LastMonth)
+
+	_, err = handler.GetIncidentStats(context.Background(), &incidentv1.GetIncidentStatsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIncidentHandler_GetIncidentHistory(t *testing.T) {
+	handler, stub := newTestHandler(t)
+	stub.history = []*domain.IncidentEvent{
+		{ID: "event-1", IncidentID: testIncidentID, EventType: "created", Message: "Incident created", CreatedAt: time.Now()},
+	}
+
+	resp, err := handler.GetIncidentHistory(context.Background(), &incidentv1.GetIncidentHistoryRequest{IncidentId: testIncidentID})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, testIncidentID, resp.Events[0].IncidentId)
+	assert.Equal(t, "Incident created", resp.Events[0].Description)
+}
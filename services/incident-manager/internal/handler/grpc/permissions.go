@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/logger"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+)
+
+// Права на операции с инцидентами
+const (
+	PermIncidentsRead           = "incidents:read"
+	PermIncidentsAck            = "incidents:ack"
+	PermIncidentsResolve        = "incidents:resolve"
+	PermIncidentsManagePolicies = "incidents:manage-policies"
+)
+
+const (
+	// callerUserIDMetadata ключ метаданных с пользователем, от имени которого gateway вызывает сервис
+	callerUserIDMetadata = "x-user-id"
+	// callerPermissionsMetadata ключ метаданных с правами пользователя через запятую
+	callerPermissionsMetadata = "x-permissions"
+)
+
+// methodPermissions право, требуемое для вызова метода пользователем
+var methodPermissions = map[string]string{
+	incidentv1.IncidentService_ListIncidents_FullMethodName:                PermIncidentsRead,
+	incidentv1.IncidentService_GetIncident_FullMethodName:                  PermIncidentsRead,
+	incidentv1.IncidentService_GetIncidentStats_FullMethodName:             PermIncidentsRead,
+	incidentv1.IncidentService_GetIncidentHistory_FullMethodName:           PermIncidentsRead,
+	incidentv1.IncidentService_ListStatusComponents_FullMethodName:         PermIncidentsRead,
+	incidentv1.IncidentService_ListMaintenances_FullMethodName:             PermIncidentsRead,
+	incidentv1.IncidentService_GetStatusPage_FullMethodName:                PermIncidentsRead,
+	incidentv1.IncidentService_CreateIncident_FullMethodName:               PermIncidentsAck,
+	incidentv1.IncidentService_IngestAlert_FullMethodName:                  PermIncidentsAck,
+	incidentv1.IncidentService_AcknowledgeIncident_FullMethodName:          PermIncidentsAck,
+	incidentv1.IncidentService_UpdateIncident_FullMethodName:               PermIncidentsAck,
+	incidentv1.IncidentService_ResolveIncident_FullMethodName:              PermIncidentsResolve,
+	incidentv1.IncidentService_CreateStatusComponent_FullMethodName:        PermIncidentsManagePolicies,
+	incidentv1.IncidentService_UpdateStatusComponent_FullMethodName:        PermIncidentsManagePolicies,
+	incidentv1.IncidentService_DeleteStatusComponent_FullMethodName:        PermIncidentsManagePolicies,
+	incidentv1.IncidentService_SetComponentStatusOverride_FullMethodName:   PermIncidentsManagePolicies,
+	incidentv1.IncidentService_ClearComponentStatusOverride_FullMethodName: PermIncidentsManagePolicies,
+	incidentv1.IncidentService_ScheduleMaintenance_FullMethodName:          PermIncidentsManagePolicies,
+	incidentv1.IncidentService_CancelMaintenance_FullMethodName:            PermIncidentsManagePolicies,
+}
+
+// requiredPermission возвращает право на вызов. Закрытие инцидента через UpdateIncident
+// требует того же права, что и ResolveIncident
+func requiredPermission(method string, req interface{}) (string, bool) {
+	if update, ok := req.(*incidentv1.UpdateIncidentRequest); ok &&
+		update.Status == incidentv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+		return PermIncidentsResolve, true
+	}
+	permission, ok := methodPermissions[method]
+	return permission, ok
+}
+
+// PermissionUnaryInterceptor проверяет права пользователя, переданные gateway в метаданных
+// x-permissions. Без x-permissions принимаются только внутренние вызовы сервисов платформы
+// (core-service, прием алертов), подписанные общим секретом serviceToken. Неизвестные методы
+// пользователю недоступны
+func PermissionUnaryInterceptor(log logger.Logger, serviceToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(callerPermissionsMetadata)
+		if len(values) == 0 {
+			if pkg_grpc.IsInternalService(ctx, serviceToken) {
+				return handler(ctx, req)
+			}
+			log.Warn("Incident operation denied",
+				logger.String("audit_event", "incident.permission_denied"),
+				logger.String("method", info.FullMethod),
+				logger.String("reason", "no caller permissions"))
+			return nil, status.Error(codes.Unauthenticated, "caller permissions are required")
+		}
+
+		permission, known := requiredPermission(info.FullMethod, req)
+		if !known || !hasPermission(splitPermissions(values), permission) {
+			userID := strings.Join(md.Get(callerUserIDMetadata), ",")
+			log.Warn("Incident operation denied",
+				logger.String("audit_event", "incident.permission_denied"),
+				logger.String("method", info.FullMethod),
+				logger.String("required", permission),
+				logger.String("user_id", userID))
+			return nil, status.Errorf(codes.PermissionDenied, "permission %s is required", permissionName(permission))
+		}
+		return handler(ctx, req)
+	}
+}
+
+// splitPermissions разбирает значения x-permissions
+func splitPermissions(values []string) []string {
+	var permissions []string
+	for _, value := range values {
+		for _, permission := range strings.Split(value, ",") {
+			if permission = strings.TrimSpace(permission); permission != "" {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions
+}
+
+// hasPermission проверяет право с учетом wildcard вида incidents:*
+func hasPermission(permissions []string, required string) bool {
+	for _, permission := range permissions {
+		if permission == required || permission == "*" {
+			return true
+		}
+		if strings.HasSuffix(permission, ":*") && strings.HasPrefix(required, strings.TrimSuffix(permission, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// permissionName имя права для сообщения об ошибке
+func permissionName(permission string) string {
+	if permission == "" {
+		return "for this method"
+	}
+	return permission
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/logger"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+)
+
+func TestPermissionUnaryInterceptor(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	interceptor := PermissionUnaryInterceptor(log, "service-secret")
+
+	call := func(permissions *string, method string, req interface{}) codes.Code {
+		ctx := context.Background()
+		if permissions != nil {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+				callerUserIDMetadata, "user-1",
+				callerPermissionsMetadata, *permissions,
+			))
+		}
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil })
+		return status.Code(err)
+	}
+	responder := "incidents:read,incidents:ack"
+
+	// Младший дежурный подтверждает, но не закрывает инциденты
+	assert.Equal(t, codes.OK, call(&responder, incidentv1.IncidentService_GetIncident_FullMethodName, &incidentv1.GetIncidentRequest{}))
+	assert.Equal(t, codes.OK, call(&responder, incidentv1.IncidentService_AcknowledgeIncident_FullMethodName, &incidentv1.AcknowledgeIncidentRequest{}))
+	assert.Equal(t, codes.PermissionDenied, call(&responder, incidentv1.IncidentService_ResolveIncident_FullMethodName, &incidentv1.ResolveIncidentRequest{}))
+	assert.Equal(t, codes.PermissionDenied, call(&responder, incidentv1.IncidentService_ScheduleMaintenance_FullMethodName, &incidentv1.ScheduleMaintenanceRequest{}))
+
+	// Закрытие через UpdateIncident требует incidents:resolve
+	assert.Equal(t, codes.OK, call(&responder, incidentv1.IncidentService_UpdateIncident_FullMethodName,
+		&incidentv1.UpdateIncidentRequest{Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL}))
+	assert.Equal(t, codes.PermissionDenied, call(&responder, incidentv1.IncidentService_UpdateIncident_FullMethodName,
+		&incidentv1.UpdateIncidentRequest{Status: incidentv1.IncidentStatus_INCIDENT_STATUS_RESOLVED}))
+
+	admin := "incidents:*"
+	assert.Equal(t, codes.OK, call(&admin, incidentv1.IncidentService_ResolveIncident_FullMethodName, &incidentv1.ResolveIncidentRequest{}))
+	assert.Equal(t, codes.OK, call(&admin, incidentv1.IncidentService_CancelMaintenance_FullMethodName, &incidentv1.CancelMaintenanceRequest{}))
+	assert.Equal(t, codes.PermissionDenied, call(&admin, "/uptimeping.incident.v1.IncidentService/Unknown", nil))
+
+	// Без метаданных пользователя вызов отклоняется
+	assert.Equal(t, codes.Unauthenticated, call(nil, incidentv1.IncidentService_ResolveIncident_FullMethodName, &incidentv1.ResolveIncidentRequest{}))
+}
+
+func TestPermissionUnaryInterceptor_InternalServices(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	info := &grpc.UnaryServerInfo{FullMethod: incidentv1.IncidentService_ResolveIncident_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	call := func(serviceToken string, md metadata.MD) codes.Code {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := PermissionUnaryInterceptor(log, serviceToken)(ctx, &incidentv1.ResolveIncidentRequest{}, info, handler)
+		return status.Code(err)
+	}
+
+	// Внутренний вызов без прав пользователя принимается только с общим секретом
+	assert.Equal(t, codes.OK, call("service-secret", metadata.Pairs(pkg_grpc.ServiceTokenMetadata, "service-secret")))
+	assert.Equal(t, codes.Unauthenticated, call("service-secret", metadata.Pairs(pkg_grpc.ServiceTokenMetadata, "forged")))
+	assert.Equal(t, codes.Unauthenticated, call("service-secret", metadata.MD{}))
+	assert.Equal(t, codes.Unauthenticated, call("", metadata.Pairs(pkg_grpc.ServiceTokenMetadata, "")))
+}
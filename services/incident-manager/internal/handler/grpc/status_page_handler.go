@@ -0,0 +1,360 @@
+package grpc
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+)
+
+// WithStatusPage подключает сервис страницы статуса
+func (h *IncidentHandler) WithStatusPage(statusPage *service.StatusPageService) *IncidentHandler {
+	h.statusPage = statusPage
+	return h
+}
+
+// ListStatusComponents возвращает компоненты страницы статуса
+func (h *IncidentHandler) ListStatusComponents(ctx context.Context, req *incidentv1.ListStatusComponentsRequest) (*incidentv1.ListStatusComponentsResponse, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+
+	components, err := h.statusPage.ListComponents(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "ListStatusComponents", req.TenantId)
+	}
+
+	result := make([]*incidentv1.StatusComponent, len(components))
+	for i, component := range components {
+		result[i] = statusComponentToProto(component)
+	}
+	return &incidentv1.ListStatusComponentsResponse{Components: result}, nil
+}
+
+// CreateStatusComponent создает компонент страницы статуса
+func (h *IncidentHandler) CreateStatusComponent(ctx context.Context, req *incidentv1.CreateStatusComponentRequest) (*incidentv1.StatusComponent, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+	h.LogOperationStart(ctx, "CreateStatusComponent", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"name":      req.Name,
+	})
+
+	component, err := h.statusPage.CreateComponent(ctx, &domain.StatusComponent{
+		TenantID:    req.TenantId,
+		Name:        req.Name,
+		Description: req.Description,
+		CheckIDs:    req.CheckIds,
+		Position:    int(req.Position),
+	})
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "CreateStatusComponent", req.TenantId)
+	}
+
+	h.LogOperationSuccess(ctx, "CreateStatusComponent", map[string]interface{}{
+		"tenant_id":    component.TenantID,
+		"component_id": component.ID,
+	})
+	return statusComponentToProto(component), nil
+}
+
+// UpdateStatusComponent заменяет данные компонента
+func (h *IncidentHandler) UpdateStatusComponent(ctx context.Context, req *incidentv1.UpdateStatusComponentRequest) (*incidentv1.StatusComponent, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+	h.LogOperationStart(ctx, "UpdateStatusComponent", map[string]interface{}{
+		"tenant_id":    req.TenantId,
+		"component_id": req.ComponentId,
+	})
+
+	component, err := h.statusPage.UpdateComponent(ctx, &domain.StatusComponent{
+		ID:          req.ComponentId,
+		TenantID:    req.TenantId,
+		Name:        req.Name,
+		Description: req.Description,
+		CheckIDs:    req.CheckIds,
+		Position:    int(req.Position),
+	})
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "UpdateStatusComponent", req.ComponentId)
+	}
+
+	h.LogOperationSuccess(ctx, "UpdateStatusComponent", map[string]interface{}{
+		"component_id": component.ID,
+	})
+	return statusComponentToProto(component), nil
+}
+
+// DeleteStatusComponent удаляет компонент
+func (h *IncidentHandler) DeleteStatusComponent(ctx context.Context, req *incidentv1.DeleteStatusComponentRequest) (*incidentv1.DeleteStatusComponentResponse, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+	h.LogOperationStart(ctx, "DeleteStatusComponent", map[string]interface{}{
+		"tenant_id":    req.TenantId,
+		"component_id": req.ComponentId,
+	})
+
+	if err := h.statusPage.DeleteComponent(ctx, req.TenantId, req.ComponentId); err != nil {
+		return nil, h.statusPageError(ctx, err, "DeleteStatusComponent", req.ComponentId)
+	}
+
+	h.LogOperationSuccess(ctx, "DeleteStatusComponent", map[string]interface{}{
+		"component_id": req.ComponentId,
+	})
+	return &incidentv1.DeleteStatusComponentResponse{Success: true}, nil
+}
+
+// SetComponentStatusOverride вручную задает отображаемый статус компонента
+func (h *IncidentHandler) SetComponentStatusOverride(ctx context.Context, req *incidentv1.SetComponentStatusOverrideRequest) (*incidentv1.StatusComponent, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+	h.LogOperationStart(ctx, "SetComponentStatusOverride", map[string]interface{}{
+		"tenant_id":    req.TenantId,
+		"component_id": req.ComponentId,
+		"status":       req.Status,
+	})
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != "" {
+		parsed, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expires_at: %v", err)
+		}
+		expiresAt = &parsed
+	}
+
+	component, err := h.statusPage.SetOverride(ctx, req.TenantId, req.ComponentId,
+		domain.ComponentStatus(req.Status), req.Message, h.extractUserIDFromContext(ctx), expiresAt)
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "SetComponentStatusOverride", req.ComponentId)
+	}
+
+	h.LogOperationSuccess(ctx, "SetComponentStatusOverride", map[string]interface{}{
+		"component_id": component.ID,
+		"status":       req.Status,
+	})
+	return statusComponentToProto(component), nil
+}
+
+// ClearComponentStatusOverride снимает ручной статус компонента
+func (h *IncidentHandler) ClearComponentStatusOverride(ctx context.Context, req *incidentv1.ClearComponentStatusOverrideRequest) (*incidentv1.StatusComponent, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+	h.LogOperationStart(ctx, "ClearComponentStatusOverride", map[string]interface{}{
+		"tenant_id":    req.TenantId,
+		"component_id": req.ComponentId,
+	})
+
+	component, err := h.statusPage.ClearOverride(ctx, req.TenantId, req.ComponentId)
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "ClearComponentStatusOverride", req.ComponentId)
+	}
+
+	h.LogOperationSuccess(ctx, "ClearComponentStatusOverride", map[string]interface{}{
+		"component_id": component.ID,
+	})
+	return statusComponentToProto(component), nil
+}
+
+// ScheduleMaintenance публикует объявление об обслуживании
+func (h *IncidentHandler) ScheduleMaintenance(ctx context.Context, req *incidentv1.ScheduleMaintenanceRequest) (*incidentv1.MaintenanceAnnouncement, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+	h.LogOperationStart(ctx, "ScheduleMaintenance", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"title":     req.Title,
+	})
+
+	startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid starts_at: %v", err)
+	}
+	endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ends_at: %v", err)
+	}
+
+	maintenance, err := h.statusPage.ScheduleMaintenance(ctx, &domain.MaintenanceAnnouncement{
+		TenantID:     req.TenantId,
+		Title:        req.Title,
+		Message:      req.Message,
+		ComponentIDs: req.ComponentIds,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		CreatedBy:    h.extractUserIDFromContext(ctx),
+	})
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "ScheduleMaintenance", req.TenantId)
+	}
+
+	h.LogOperationSuccess(ctx, "ScheduleMaintenance", map[string]interface{}{
+		"maintenance_id": maintenance.ID,
+	})
+	return maintenanceToProto(maintenance, time.Now()), nil
+}
+
+// CancelMaintenance отменяет объявление об обслуживании
+func (h *IncidentHandler) CancelMaintenance(ctx context.Context, req *incidentv1.CancelMaintenanceRequest) (*incidentv1.MaintenanceAnnouncement, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+	h.LogOperationStart(ctx, "CancelMaintenance", map[string]interface{}{
+		"tenant_id":      req.TenantId,
+		"maintenance_id": req.MaintenanceId,
+	})
+
+	maintenance, err := h.statusPage.CancelMaintenance(ctx, req.TenantId, req.MaintenanceId)
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "CancelMaintenance", req.MaintenanceId)
+	}
+
+	h.LogOperationSuccess(ctx, "CancelMaintenance", map[string]interface{}{
+		"maintenance_id": maintenance.ID,
+	})
+	return maintenanceToProto(maintenance, time.Now()), nil
+}
+
+// ListMaintenances возвращает объявления, которые заканчиваются не раньше since
+func (h *IncidentHandler) ListMaintenances(ctx context.Context, req *incidentv1.ListMaintenancesRequest) (*incidentv1.ListMaintenancesResponse, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	since := now
+	if req.Since != "" {
+		parsed, err := time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+		}
+		since = parsed
+	}
+
+	maintenances, err := h.statusPage.ListMaintenances(ctx, req.TenantId, since)
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "ListMaintenances", req.TenantId)
+	}
+
+	result := make([]*incidentv1.MaintenanceAnnouncement, len(maintenances))
+	for i, maintenance := range maintenances {
+		result[i] = maintenanceToProto(maintenance, now)
+	}
+	return &incidentv1.ListMaintenancesResponse{Maintenances: result}, nil
+}
+
+// GetStatusPage возвращает страницу статуса арендатора
+func (h *IncidentHandler) GetStatusPage(ctx context.Context, req *incidentv1.GetStatusPageRequest) (*incidentv1.StatusPage, error) {
+	if err := h.requireStatusPage(); err != nil {
+		return nil, err
+	}
+
+	page, err := h.statusPage.GetStatusPage(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.statusPageError(ctx, err, "GetStatusPage", req.TenantId)
+	}
+
+	result := &incidentv1.StatusPage{
+		TenantId:             page.TenantID,
+		Status:               string(page.Status),
+		Components:           make([]*incidentv1.ComponentStatusView, len(page.Components)),
+		ActiveMaintenances:   make([]*incidentv1.MaintenanceAnnouncement, len(page.ActiveMaintenances)),
+		UpcomingMaintenances: make([]*incidentv1.MaintenanceAnnouncement, len(page.UpcomingMaintenances)),
+		GeneratedAt:          page.GeneratedAt.Format(time.RFC3339),
+	}
+	for i, view := range page.Components {
+		result.Components[i] = &incidentv1.ComponentStatusView{
+			Component:       statusComponentToProto(view.StatusComponent),
+			AutomatedStatus: string(view.AutomatedStatus),
+			Status:          string(view.Status),
+			StatusSource:    string(view.StatusSource),
+			StatusMessage:   view.StatusMessage,
+		}
+	}
+	for i, maintenance := range page.ActiveMaintenances {
+		result.ActiveMaintenances[i] = maintenanceToProto(maintenance, page.GeneratedAt)
+	}
+	for i, maintenance := range page.UpcomingMaintenances {
+		result.UpcomingMaintenances[i] = maintenanceToProto(maintenance, page.GeneratedAt)
+	}
+	return result, nil
+}
+
+// requireStatusPage возвращает Unavailable, если страница статуса не подключена
+func (h *IncidentHandler) requireStatusPage() error {
+	if h.statusPage == nil {
+		return status.Error(codes.Unavailable, "status page is not configured")
+	}
+	return nil
+}
+
+// statusPageError конвертирует ошибки сервиса с кодом pkg/errors в gRPC статус с тем же кодом,
+// остальные передает в LogError
+func (h *IncidentHandler) statusPageError(ctx context.Context, err error, operation, id string) error {
+	var appErr *errors.Error
+	if !stderrors.As(err, &appErr) {
+		return h.LogError(ctx, err, operation, id)
+	}
+	h.LogError(ctx, err, operation, id)
+	return appErr.ToGRPCErr()
+}
+
+// statusComponentToProto конвертирует компонент в protobuf
+func statusComponentToProto(component *domain.StatusComponent) *incidentv1.StatusComponent {
+	result := &incidentv1.StatusComponent{
+		Id:          component.ID,
+		TenantId:    component.TenantID,
+		Name:        component.Name,
+		Description: component.Description,
+		CheckIds:    component.CheckIDs,
+		Position:    int32(component.Position),
+		CreatedAt:   component.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   component.UpdatedAt.Format(time.RFC3339),
+	}
+	if override := component.Override; override != nil {
+		result.Override = &incidentv1.StatusOverride{
+			Status:  string(override.Status),
+			Message: override.Message,
+			SetBy:   override.SetBy,
+			SetAt:   override.SetAt.Format(time.RFC3339),
+		}
+		if override.ExpiresAt != nil {
+			result.Override.ExpiresAt = override.ExpiresAt.Format(time.RFC3339)
+		}
+	}
+	return result
+}
+
+// maintenanceToProto конвертирует объявление в protobuf с состоянием на момент now
+func maintenanceToProto(maintenance *domain.MaintenanceAnnouncement, now time.Time) *incidentv1.MaintenanceAnnouncement {
+	result := &incidentv1.MaintenanceAnnouncement{
+		Id:           maintenance.ID,
+		TenantId:     maintenance.TenantID,
+		Title:        maintenance.Title,
+		Message:      maintenance.Message,
+		ComponentIds: maintenance.ComponentIDs,
+		StartsAt:     maintenance.StartsAt.Format(time.RFC3339),
+		EndsAt:       maintenance.EndsAt.Format(time.RFC3339),
+		State:        string(maintenance.StateAt(now)),
+		CreatedBy:    maintenance.CreatedBy,
+		CreatedAt:    maintenance.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    maintenance.UpdatedAt.Format(time.RFC3339),
+	}
+	if maintenance.CancelledAt != nil {
+		result.CancelledAt = maintenance.CancelledAt.Format(time.RFC3339)
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+const (
+	testStatusTenantID    = "550e8400-e29b-41d4-a716-446655440001"
+	testStatusComponentID = "550e8400-e29b-41d4-a716-446655440201"
+)
+
+// stubStatusPageRepository хранит один компонент; остальные методы не вызываются в тестах
+type stubStatusPageRepository struct {
+	service.StatusPageRepository
+	component *domain.StatusComponent
+}
+
+func (r *stubStatusPageRepository) GetComponent(ctx context.Context, tenantID, id string) (*domain.StatusComponent, error) {
+	if r.component == nil || r.component.ID != id || r.component.TenantID != tenantID {
+		return nil, domain.ErrStatusComponentNotFound
+	}
+	return r.component, nil
+}
+
+func (r *stubStatusPageRepository) UpdateComponent(ctx context.Context, component *domain.StatusComponent) error {
+	r.component = component
+	return nil
+}
+
+func newStatusPageTestHandler(t *testing.T) (*IncidentHandler, *stubStatusPageRepository) {
+	handler, _ := newTestHandler(t)
+	log, err := logger.NewLogger("test", "debug", "incident-manager", false)
+	require.NoError(t, err)
+
+	repo := &stubStatusPageRepository{component: &domain.StatusComponent{
+		ID:       testStatusComponentID,
+		TenantID: testStatusTenantID,
+		Name:     "API",
+	}}
+	return handler.WithStatusPage(service.NewStatusPageService(repo, log)), repo
+}
+
+func TestIncidentHandler_StatusPage_NotConfigured(t *testing.T) {
+	handler, _ := newTestHandler(t)
+
+	_, err := handler.GetStatusPage(context.Background(), &incidentv1.GetStatusPageRequest{TenantId: testStatusTenantID})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestIncidentHandler_SetComponentStatusOverride(t *testing.T) {
+	handler, repo := newStatusPageTestHandler(t)
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	component, err := handler.SetComponentStatusOverride(context.Background(), &incidentv1.SetComponentStatusOverrideRequest{
+		TenantId:    testStatusTenantID,
+		ComponentId: testStatusComponentID,
+		Status:      string(domain.ComponentStatusMajorOutage),
+		Message:     "Investigating",
+		ExpiresAt:   expiresAt,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, component.Override)
+	assert.Equal(t, "major_outage", component.Override.Status)
+	assert.Equal(t, "Investigating", component.Override.Message)
+	assert.Equal(t, expiresAt, component.Override.ExpiresAt)
+	assert.Equal(t, "system", repo.component.Override.SetBy)
+}
+
+func TestIncidentHandler_SetComponentStatusOverride_Errors(t *testing.T) {
+	handler, _ := newStatusPageTestHandler(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *incidentv1.SetComponentStatusOverrideRequest
+		code codes.Code
+	}{
+		{"invalid expires_at", &incidentv1.SetComponentStatusOverrideRequest{
+			TenantId: testStatusTenantID, ComponentId: testStatusComponentID, Status: "major_outage", ExpiresAt: "tomorrow",
+		}, codes.InvalidArgument},
+		{"invalid status", &incidentv1.SetComponentStatusOverrideRequest{
+			TenantId: testStatusTenantID, ComponentId: testStatusComponentID, Status: "on_fire",
+		}, codes.InvalidArgument},
+		{"unknown component", &incidentv1.SetComponentStatusOverrideRequest{
+			TenantId: testStatusTenantID, ComponentId: "550e8400-e29b-41d4-a716-446655440299", Status: "major_outage",
+		}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.SetComponentStatusOverride(ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestMaintenanceToProto_State(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	maintenance := &domain.MaintenanceAnnouncement{
+		ID:       "maintenance-1",
+		Title:    "Database upgrade",
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+	}
+
+	proto := maintenanceToProto(maintenance, now)
+	assert.Equal(t, "in_progress", proto.State)
+	assert.Equal(t, "2026-03-10T11:00:00Z", proto.StartsAt)
+	assert.Empty(t, proto.CancelledAt)
+
+	cancelledAt := now
+	maintenance.CancelledAt = &cancelledAt
+	proto = maintenanceToProto(maintenance, now)
+	assert.Equal(t, "cancelled", proto.State)
+	assert.Equal(t, "2026-03-10T12:00:00Z", proto.CancelledAt)
+}
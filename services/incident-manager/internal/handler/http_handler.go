@@ -124,6 +124,13 @@ func (h *HTTPHandler) listIncidents(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	total, err := h.incidentService.CountIncidents(r.Context(), filter)
+	if err != nil {
+		h.logger.Error("Failed to count incidents", logger.Error(err))
+		http.Error(w, "Failed to get incidents", http.StatusInternalServerError)
+		return
+	}
+
 	// Конвертируем domain модели в API модели
 	incidents := make([]api.Incident, len(domainIncidents))
 	for i, domainIncident := range domainIncidents {
@@ -140,7 +147,7 @@ func (h *HTTPHandler) listIncidents(w http.ResponseWriter, r *http.Request) {
 
 	response := api.ListIncidentsResponse{
 		Incidents: incidents,
-		Total:     len(incidents),
+		Total:     total,
 		Page:      page,
 		PageSize:  pageSize,
 	}
@@ -2,6 +2,7 @@ package rabbitmq
 
 import (
 	"os"
+	"strconv"
 	"time"
 
 	"UptimePingPlatform/pkg/config"
@@ -28,6 +29,9 @@ type IncidentProducerConfig struct {
 	ReconnectInterval time.Duration `json:"reconnect_interval" yaml:"reconnect_interval"`
 	Heartbeat         time.Duration `json:"heartbeat" yaml:"heartbeat"`
 	Timeout           time.Duration `json:"timeout" yaml:"timeout"`
+
+	// CompressionThreshold размер события, начиная с которого оно сжимается gzip; 0 - без сжатия
+	CompressionThreshold int `json:"compression_threshold" yaml:"compression_threshold"`
 }
 
 // DefaultIncidentProducerConfig возвращает конфигурацию по умолчанию
@@ -42,6 +46,12 @@ func DefaultIncidentProducerConfig() *IncidentProducerConfig {
 		exchange = ex
 	}
 
+	// Сжатие включается только явно: потребитель событий прежней версии не распакует сжатое тело
+	compressionThreshold := 0
+	if threshold, err := strconv.Atoi(os.Getenv("RABBITMQ_COMPRESSION_THRESHOLD")); err == nil && threshold >= 0 {
+		compressionThreshold = threshold
+	}
+
 	return &IncidentProducerConfig{
 		URL:      rabbitmqURL,
 		Exchange: exchange,
@@ -58,6 +68,8 @@ func DefaultIncidentProducerConfig() *IncidentProducerConfig {
 		ReconnectInterval: 5 * time.Second,
 		Heartbeat:         30 * time.Second,
 		Timeout:           10 * time.Second,
+
+		CompressionThreshold: compressionThreshold,
 	}
 }
 
@@ -2,12 +2,12 @@ package rabbitmq
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 
+	"UptimePingPlatform/pkg/events"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/rabbitmq"
 	"UptimePingPlatform/services/incident-manager/internal/domain"
@@ -15,7 +15,7 @@ import (
 
 // IncidentEvent представляет событие инцидента
 type IncidentEvent struct {
-	EventType    string                 `json:"event_type"`    // incident.opened, incident.updated, incident.resolved, incident.grouped
+	EventType    string                 `json:"event_type"`    // incident.opened, incident.updated, incident.resolved, incident.grouped, incident.sla_breached, incident.reminder
 	Timestamp   time.Time              `json:"timestamp"`    // Время события
 	Service     string                 `json:"service"`      // incident-manager
 	IncidentID  string                 `json:"incident_id"`  // ID инцидента
@@ -30,6 +30,7 @@ type IncidentEvent struct {
 	FirstSeen   time.Time              `json:"first_seen"`   // Время первого появления
 	LastSeen    time.Time              `json:"last_seen"`    // Время последнего появления
 	Metadata    map[string]interface{} `json:"metadata,omitempty"` // Дополнительные метаданные
+	Data        map[string]interface{} `json:"data,omitempty"`     // Данные для уведомлений (владелец, runbook, контакт эскалации)
 }
 
 // IncidentProducer публикует события инцидентов в RabbitMQ
@@ -102,6 +103,71 @@ func (p *IncidentProducer) PublishIncidentEvent(ctx context.Context, eventType s
 		return fmt.Errorf("incident cannot be nil")
 	}
 
+	// Сериализуем событие по версионированной схеме incident.* с проверкой обязательных полей
+	eventData, headers, err := EncodeIncidentEvent(eventType, incident, result)
+	if err != nil {
+		p.logger.Error("Failed to encode incident event",
+			logger.String("event_type", eventType),
+			logger.String("incident_id", incident.ID),
+			logger.Error(err))
+		return err
+	}
+
+	// Крупные события (длинные ошибки, снимки ответа в данных) сжимаются
+	body, encoding, err := rabbitmq.CompressBody(eventData, p.config.CompressionThreshold)
+	if err != nil {
+		return err
+	}
+
+	// Определяем routing key
+	routingKey := fmt.Sprintf("incident.%s.%s.%s", 
+		eventType, 
+		incident.TenantID, 
+		incident.Severity)
+
+	// Публикуем событие
+	start := time.Now()
+	err = p.channel.Publish(
+		p.config.Exchange, // exchange
+		routingKey,       // routing key
+		false,            // mandatory
+		false,            // immediate
+		amqp.Publishing{
+			ContentType:     events.ContentType,
+			ContentEncoding: encoding,
+			Headers:         headers,
+			Body:            body,
+			Timestamp:       time.Now(),
+		},
+	)
+	rabbitmq.ObservePublish(p.config.Exchange, time.Since(start), err)
+	if err != nil {
+		p.logger.Error("Failed to publish incident event",
+			logger.String("event_type", eventType),
+			logger.String("incident_id", incident.ID),
+			logger.String("routing_key", routingKey),
+			logger.Error(err))
+		return fmt.Errorf("failed to publish incident event: %w", err)
+	}
+	rabbitmq.ObservePayload(p.config.Exchange, encoding, len(body))
+
+	p.logger.Info("Incident event published successfully",
+		logger.String("event_type", eventType),
+		logger.String("incident_id", incident.ID),
+		logger.String("routing_key", routingKey),
+		logger.Int("event_size", len(eventData)),
+		logger.Int("payload_size", len(body)))
+
+	return nil
+}
+
+// EncodeIncidentEvent сериализует событие инцидента по версионированной схеме incident.* и
+// возвращает тело и заголовки сообщения
+func EncodeIncidentEvent(eventType string, incident *domain.Incident, result *CheckResult) ([]byte, amqp.Table, error) {
+	if incident == nil {
+		return nil, nil, fmt.Errorf("incident cannot be nil")
+	}
+
 	// Создаем событие
 	event := &IncidentEvent{
 		EventType:    eventType,
@@ -120,6 +186,20 @@ func (p *IncidentProducer) PublishIncidentEvent(ctx context.Context, eventType s
 		LastSeen:    incident.LastSeen,
 		Metadata:    incident.Metadata,
 	}
+	if event.Metadata == nil {
+		event.Metadata = make(map[string]interface{})
+	}
+	
+	// Владелец проверки, runbook, переопределение маршрутизации уведомлений и поля корреляции
+	// проверки попадают в уведомления через поле data
+	for _, fields := range []map[string]string{incident.Ownership(), incident.NotificationOverrides(), incident.Correlation()} {
+		for key, value := range fields {
+			if event.Data == nil {
+				event.Data = make(map[string]interface{})
+			}
+			event.Data[key] = value
+		}
+	}
 
 	// Добавляем специфичные для типа события поля
 	switch eventType {
@@ -133,8 +213,38 @@ func (p *IncidentProducer) PublishIncidentEvent(ctx context.Context, eventType s
 			}
 		}
 	case "incident.resolved":
-		// Для закрытия добавляем длительность инцидента
+		// Для закрытия добавляем длительность инцидента и причину автоматического закрытия
 		event.Metadata["incident_duration"] = incident.GetDuration().String()
+		if reason := incident.ResolveReason(); reason != "" {
+			if event.Data == nil {
+				event.Data = make(map[string]interface{})
+			}
+			event.Data[domain.MetadataKeyResolveReason] = reason
+		}
+	case "incident.sla_breached":
+		// Вид нарушенного SLA, цель и срок передаются в уведомление
+		if result != nil {
+			if event.Data == nil {
+				event.Data = make(map[string]interface{})
+			}
+			for _, key := range []string{"sla", "sla_target", "sla_deadline"} {
+				if value, ok := result.Metadata[key]; ok {
+					event.Data[key] = value
+				}
+			}
+		}
+	case "incident.reminder":
+		// Номер напоминания, лимит и исходная серьезность при эскалации передаются в уведомление
+		if result != nil {
+			if event.Data == nil {
+				event.Data = make(map[string]interface{})
+			}
+			for _, key := range []string{"reminder_number", "reminder_max", "escalated_from"} {
+				if value, ok := result.Metadata[key]; ok {
+					event.Data[key] = value
+				}
+			}
+		}
 	case "incident.grouped":
 		// Для группировки добавляем информацию о сгруппированных ошибках
 		if incident.Metadata != nil {
@@ -144,59 +254,24 @@ func (p *IncidentProducer) PublishIncidentEvent(ctx context.Context, eventType s
 		}
 	}
 
-	// Сериализуем событие в JSON
-	eventData, err := json.Marshal(event)
+	eventData, contractHeaders, err := events.Encode(eventType, event.contract())
 	if err != nil {
-		p.logger.Error("Failed to marshal incident event",
-			logger.String("event_type", eventType),
-			logger.String("incident_id", incident.ID),
-			logger.Error(err))
-		return fmt.Errorf("failed to marshal incident event: %w", err)
+		return nil, nil, fmt.Errorf("failed to encode incident event: %w", err)
 	}
 
-	// Определяем routing key
-	routingKey := fmt.Sprintf("incident.%s.%s.%s", 
-		eventType, 
-		incident.TenantID, 
-		incident.Severity)
-
-	// Публикуем событие
-	err = p.channel.Publish(
-		p.config.Exchange, // exchange
-		routingKey,       // routing key
-		false,            // mandatory
-		false,            // immediate
-		amqp.Publishing{
-			ContentType: "application/json",
-			Headers: amqp.Table{
-				"event_type":    eventType,
-				"incident_id":   incident.ID,
-				"check_id":       incident.CheckID,
-				"tenant_id":      incident.TenantID,
-				"severity":       string(incident.Severity),
-				"status":         string(incident.Status),
-				"service":        "incident-manager",
-				"timestamp":      time.Now().Unix(),
-			},
-			Timestamp: time.Now(),
-		},
-	)
-	if err != nil {
-		p.logger.Error("Failed to publish incident event",
-			logger.String("event_type", eventType),
-			logger.String("incident_id", incident.ID),
-			logger.String("routing_key", routingKey),
-			logger.Error(err))
-		return fmt.Errorf("failed to publish incident event: %w", err)
+	headers := amqp.Table{
+		"incident_id": incident.ID,
+		"check_id":    incident.CheckID,
+		"tenant_id":   incident.TenantID,
+		"severity":    string(incident.Severity),
+		"status":      string(incident.Status),
+		"service":     "incident-manager",
+		"timestamp":   time.Now().Unix(),
 	}
-
-	p.logger.Info("Incident event published successfully",
-		logger.String("event_type", eventType),
-		logger.String("incident_id", incident.ID),
-		logger.String("routing_key", routingKey),
-		logger.Int("event_size", len(eventData)))
-
-	return nil
+	for key, value := range contractHeaders {
+		headers[key] = value
+	}
+	return eventData, headers, nil
 }
 
 // PublishIncidentEventWithRetry публикует событие с retry логикой
@@ -232,6 +307,27 @@ func (p *IncidentProducer) PublishIncidentEventWithRetry(ctx context.Context, ev
 	return fmt.Errorf("failed to publish incident event after %d attempts", maxRetries)
 }
 
+// contract переводит событие в контракт incident.* для публикации
+func (e *IncidentEvent) contract() *events.Incident {
+	return &events.Incident{
+		Timestamp:    e.Timestamp,
+		Service:      e.Service,
+		IncidentID:   e.IncidentID,
+		CheckID:      e.CheckID,
+		TenantID:     e.TenantID,
+		Status:       string(e.Status),
+		Severity:     string(e.Severity),
+		Count:        e.Count,
+		Duration:     e.Duration,
+		ErrorMessage: e.ErrorMessage,
+		ErrorHash:    e.ErrorHash,
+		FirstSeen:    e.FirstSeen,
+		LastSeen:     e.LastSeen,
+		Metadata:     e.Metadata,
+		Data:         e.Data,
+	}
+}
+
 // calculateDuration вычисляет длительность в миллисекундах
 func calculateDuration(result *CheckResult) int64 {
 	if result == nil {
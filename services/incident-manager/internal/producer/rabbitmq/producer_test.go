@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"UptimePingPlatform/pkg/events"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/incident-manager/internal/domain"
 )
@@ -99,6 +101,10 @@ func TestDefaultIncidentProducerConfig(t *testing.T) {
 	assert.Equal(t, 10, config.PrefetchCount)
 	assert.Equal(t, 0, config.PrefetchSize)
 	assert.Equal(t, false, config.Global)
+	assert.Equal(t, 0, config.CompressionThreshold)
+
+	t.Setenv("RABBITMQ_COMPRESSION_THRESHOLD", "1024")
+	assert.Equal(t, 1024, DefaultIncidentProducerConfig().CompressionThreshold)
 }
 
 func TestIncidentEvent_Serialization(t *testing.T) {
@@ -163,6 +169,35 @@ func TestIncidentEvent_Serialization(t *testing.T) {
 	assert.Equal(t, incident.Metadata, event.Metadata)
 }
 
+func TestIncidentEvent_Contract(t *testing.T) {
+	event := &IncidentEvent{
+		Timestamp:  time.Now(),
+		Service:    "incident-manager",
+		IncidentID: "test-incident-id",
+		CheckID:    "test-check-id",
+		TenantID:   "test-tenant-id",
+		Status:     domain.IncidentStatusOpen,
+		Severity:   domain.IncidentSeverityCritical,
+		Count:      2,
+		Data:       map[string]interface{}{"runbook_url": "https://runbooks.example.com/api"},
+	}
+
+	body, headers, err := events.Encode("incident.opened", event.contract())
+	require.NoError(t, err)
+	assert.Equal(t, "incident.opened", headers[events.HeaderEventType])
+
+	var decoded events.Incident
+	require.NoError(t, events.Decode(headers, body, &decoded))
+	assert.Equal(t, event.IncidentID, decoded.IncidentID)
+	assert.Equal(t, string(domain.IncidentStatusOpen), decoded.Status)
+	assert.Equal(t, string(domain.IncidentSeverityCritical), decoded.Severity)
+	assert.Equal(t, event.Data["runbook_url"], decoded.Data["runbook_url"])
+
+	event.TenantID = ""
+	_, _, err = events.Encode("incident.opened", event.contract())
+	assert.ErrorIs(t, err, events.ErrInvalidPayload)
+}
+
 func TestCalculateDuration(t *testing.T) {
 	tests := []struct {
 		name     string
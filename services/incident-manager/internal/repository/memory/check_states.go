@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// CheckStates состояния проверок в памяти процесса
+type CheckStates struct {
+	mu     sync.Mutex
+	states map[string]domain.CheckStatus
+}
+
+// NewCheckStates создает состояния проверок в памяти
+func NewCheckStates() *CheckStates {
+	return &CheckStates{states: make(map[string]domain.CheckStatus)}
+}
+
+var _ service.CheckStateRepository = (*CheckStates)(nil)
+
+// Update применяет apply к состоянию проверки под блокировкой
+func (s *CheckStates) Update(ctx context.Context, checkID string, apply func(status *domain.CheckStatus)) (*domain.CheckStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, ok := s.states[checkID]
+	if !ok {
+		status = domain.CheckStatus{CheckID: checkID}
+	}
+	apply(&status)
+	s.states[checkID] = status
+	return &status, nil
+}
+
+// Get возвращает состояние проверки
+func (s *CheckStates) Get(ctx context.Context, checkID string) (*domain.CheckStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, ok := s.states[checkID]
+	if !ok {
+		return nil, nil
+	}
+	return &status, nil
+}
+
+// Snapshot возвращает состояния всех проверок, упорядоченные по ID проверки
+func (s *CheckStates) Snapshot() []domain.CheckStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	states := make([]domain.CheckStatus, 0, len(s.states))
+	for _, status := range s.states {
+		states = append(states, status)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].CheckID < states[j].CheckID })
+	return states
+}
+
+// Restore заменяет состояния проверок состояниями из Snapshot
+func (s *CheckStates) Restore(states []domain.CheckStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states = make(map[string]domain.CheckStatus, len(states))
+	for _, status := range states {
+		s.states[status.CheckID] = status
+	}
+}
@@ -0,0 +1,35 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+func TestCheckStates_Update(t *testing.T) {
+	ctx := context.Background()
+	states := NewCheckStates()
+
+	status, err := states.Get(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Nil(t, status)
+
+	now := time.Now()
+	for i := 0; i < 2; i++ {
+		_, err := states.Update(ctx, "check-1", func(status *domain.CheckStatus) {
+			status.Observe(false, false, now, domain.Confirmations{Down: 2})
+		})
+		require.NoError(t, err)
+	}
+
+	status, err = states.Get(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Equal(t, "check-1", status.CheckID)
+	assert.Equal(t, domain.CheckStateDown, status.State)
+	assert.Equal(t, 2, status.ConsecutiveFailures)
+}
@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// EscalationTimers таймеры эскалации в памяти процесса; теряются при перезапуске и
+// восстанавливаются воркером эскалации по активным инцидентам
+type EscalationTimers struct {
+	mu     sync.Mutex
+	timers map[string]time.Time
+}
+
+// NewEscalationTimers создает таймеры эскалации в памяти
+func NewEscalationTimers() *EscalationTimers {
+	return &EscalationTimers{timers: make(map[string]time.Time)}
+}
+
+var _ service.EscalationTimers = (*EscalationTimers)(nil)
+
+// Schedule ставит или переносит таймер инцидента
+func (t *EscalationTimers) Schedule(ctx context.Context, incidentID string, at time.Time) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.timers[incidentID] = at
+	return nil
+}
+
+// Cancel удаляет таймер инцидента
+func (t *EscalationTimers) Cancel(ctx context.Context, incidentID string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.timers, incidentID)
+	return nil
+}
+
+// ClaimDue возвращает инциденты с наступившими таймерами, ранние первыми, и переносит их
+// таймеры на now+lease
+func (t *EscalationTimers) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var due []string
+	for id, at := range t.timers {
+		if !at.After(now) {
+			due = append(due, id)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return t.timers[due[i]].Before(t.timers[due[j]]) })
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+	for _, id := range due {
+		t.timers[id] = now.Add(lease)
+	}
+	return due, nil
+}
+
+// Len возвращает число поставленных таймеров
+func (t *EscalationTimers) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.timers)
+}
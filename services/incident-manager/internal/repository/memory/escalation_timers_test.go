@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscalationTimers_ClaimDue(t *testing.T) {
+	ctx := context.Background()
+	timers := NewEscalationTimers()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, timers.Schedule(ctx, "late", now.Add(-time.Minute)))
+	require.NoError(t, timers.Schedule(ctx, "early", now.Add(-time.Hour)))
+	require.NoError(t, timers.Schedule(ctx, "future", now.Add(time.Hour)))
+	require.NoError(t, timers.Schedule(ctx, "cancelled", now.Add(-time.Hour)))
+	require.NoError(t, timers.Cancel(ctx, "cancelled"))
+
+	due, err := timers.ClaimDue(ctx, now, time.Minute, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"early", "late"}, due)
+
+	// Забранные таймеры не выдаются повторно до истечения аренды
+	due, err = timers.ClaimDue(ctx, now.Add(30*time.Second), time.Minute, 0)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	due, err = timers.ClaimDue(ctx, now.Add(2*time.Minute), time.Minute, 1)
+	require.NoError(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, 3, timers.Len())
+}
@@ -0,0 +1,266 @@
+// Package memory хранилища incident-manager в памяти процесса для запуска без PostgreSQL
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// IncidentRepository инциденты в памяти процесса
+type IncidentRepository struct {
+	mu        sync.RWMutex
+	incidents map[string]*domain.Incident
+	now       func() time.Time
+}
+
+// NewIncidentRepository создает хранилище инцидентов в памяти
+func NewIncidentRepository() *IncidentRepository {
+	return &IncidentRepository{incidents: make(map[string]*domain.Incident), now: time.Now}
+}
+
+var (
+	_ service.IncidentRepository   = (*IncidentRepository)(nil)
+	_ service.EscalationRepository = (*IncidentRepository)(nil)
+)
+
+// Create сохраняет инцидент; инцидент без ID получает новый идентификатор
+func (r *IncidentRepository) Create(ctx context.Context, incident *domain.Incident) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if incident.ID == "" {
+		incident.ID = uuid.New().String()
+	}
+	if _, ok := r.incidents[incident.ID]; ok {
+		return errors.New(errors.ErrConflict, "incident already exists").
+			WithDetails(fmt.Sprintf("incident_id: %s", incident.ID))
+	}
+	r.incidents[incident.ID] = copyIncident(incident)
+	return nil
+}
+
+// GetByID возвращает копию инцидента
+func (r *IncidentRepository) GetByID(ctx context.Context, id string) (*domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	incident, ok := r.incidents[id]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "incident not found").
+			WithDetails(fmt.Sprintf("incident_id: %s", id))
+	}
+	return copyIncident(incident), nil
+}
+
+// GetByCheckAndErrorHash возвращает активный инцидент проверки с той же ошибкой или nil
+func (r *IncidentRepository) GetByCheckAndErrorHash(ctx context.Context, checkID, errorHash string) (*domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, incident := range r.incidents {
+		if incident.CheckID == checkID && incident.ErrorHash == errorHash && incident.Status != domain.IncidentStatusResolved {
+			return copyIncident(incident), nil
+		}
+	}
+	return nil, nil
+}
+
+// GetByTenantID возвращает инциденты tenant по фильтру, новые первыми. Пустой tenantID -
+// инциденты всех tenant
+func (r *IncidentRepository) GetByTenantID(ctx context.Context, tenantID string, filter *domain.IncidentFilter) ([]*domain.Incident, error) {
+	if filter == nil {
+		filter = &domain.IncidentFilter{}
+	}
+
+	r.mu.RLock()
+	var incidents []*domain.Incident
+	for _, incident := range r.incidents {
+		if (tenantID == "" || incident.TenantID == tenantID) && matches(incident, filter) {
+			incidents = append(incidents, copyIncident(incident))
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(incidents, func(i, j int) bool { return incidents[i].CreatedAt.After(incidents[j].CreatedAt) })
+	if filter.Offset > 0 {
+		if filter.Offset >= len(incidents) {
+			return nil, nil
+		}
+		incidents = incidents[filter.Offset:]
+	}
+	if filter.Limit > 0 && filter.Limit < len(incidents) {
+		incidents = incidents[:filter.Limit]
+	}
+	return incidents, nil
+}
+
+// Count возвращает число инцидентов tenant, подходящих под фильтр, без учета Limit и Offset
+func (r *IncidentRepository) Count(ctx context.Context, tenantID string, filter *domain.IncidentFilter) (int, error) {
+	if filter == nil {
+		filter = &domain.IncidentFilter{}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, incident := range r.incidents {
+		if (tenantID == "" || incident.TenantID == tenantID) && matches(incident, filter) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// ListActive возвращает неразрешенные инциденты всех tenant
+func (r *IncidentRepository) ListActive(ctx context.Context) ([]*domain.Incident, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var incidents []*domain.Incident
+	for _, incident := range r.incidents {
+		if incident.IsActive() {
+			incidents = append(incidents, copyIncident(incident))
+		}
+	}
+	return incidents, nil
+}
+
+// Update заменяет сохраненный инцидент
+func (r *IncidentRepository) Update(ctx context.Context, incident *domain.Incident) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.incidents[incident.ID]; !ok {
+		return errors.New(errors.ErrNotFound, "incident not found").
+			WithDetails(fmt.Sprintf("incident_id: %s", incident.ID))
+	}
+	r.incidents[incident.ID] = copyIncident(incident)
+	return nil
+}
+
+// Delete удаляет инцидент
+func (r *IncidentRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.incidents[id]; !ok {
+		return errors.New(errors.ErrNotFound, "incident not found").
+			WithDetails(fmt.Sprintf("incident_id: %s", id))
+	}
+	delete(r.incidents, id)
+	return nil
+}
+
+// GetStats считает инциденты tenant по статусам, серьезности и периодам
+func (r *IncidentRepository) GetStats(ctx context.Context, tenantID string) (*domain.IncidentStats, error) {
+	stats := &domain.IncidentStats{
+		ByStatus:   make(map[domain.IncidentStatus]int),
+		BySeverity: make(map[domain.IncidentSeverity]int),
+	}
+	now := r.now()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, incident := range r.incidents {
+		if tenantID != "" && incident.TenantID != tenantID {
+			continue
+		}
+		stats.Total++
+		stats.ByStatus[incident.Status]++
+		stats.BySeverity[incident.Severity]++
+		age := now.Sub(incident.CreatedAt)
+		if age <= 24*time.Hour {
+			stats.Last24h++
+		}
+		if age <= 7*24*time.Hour {
+			stats.Last7d++
+		}
+		if age <= 30*24*time.Hour {
+			stats.Last30d++
+		}
+	}
+	return stats, nil
+}
+
+// DeleteResolvedBefore удаляет инциденты, закрытые раньше before, и возвращает их число
+func (r *IncidentRepository) DeleteResolvedBefore(before time.Time) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := 0
+	for id, incident := range r.incidents {
+		if incident.ResolvedAt != nil && incident.ResolvedAt.Before(before) {
+			delete(r.incidents, id)
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// matches проверяет инцидент по фильтру без учета tenant и пагинации
+func matches(incident *domain.Incident, filter *domain.IncidentFilter) bool {
+	switch {
+	case filter.CheckID != nil && incident.CheckID != *filter.CheckID:
+		return false
+	case filter.Status != nil && incident.Status != *filter.Status:
+		return false
+	case filter.Severity != nil && incident.Severity != *filter.Severity:
+		return false
+	case filter.From != nil && incident.CreatedAt.Before(*filter.From):
+		return false
+	case filter.To != nil && incident.CreatedAt.After(*filter.To):
+		return false
+	}
+	return true
+}
+
+// copyIncident копирует инцидент, чтобы вызывающий код не менял сохраненное значение
+// Snapshot возвращает копии всех инцидентов в порядке создания
+func (r *IncidentRepository) Snapshot() []*domain.Incident {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	incidents := make([]*domain.Incident, 0, len(r.incidents))
+	for _, incident := range r.incidents {
+		incidents = append(incidents, copyIncident(incident))
+	}
+	sort.Slice(incidents, func(i, j int) bool {
+		if !incidents[i].CreatedAt.Equal(incidents[j].CreatedAt) {
+			return incidents[i].CreatedAt.Before(incidents[j].CreatedAt)
+		}
+		return incidents[i].ID < incidents[j].ID
+	})
+	return incidents
+}
+
+// Restore заменяет инциденты хранилища инцидентами из Snapshot
+func (r *IncidentRepository) Restore(incidents []*domain.Incident) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.incidents = make(map[string]*domain.Incident, len(incidents))
+	for _, incident := range incidents {
+		r.incidents[incident.ID] = copyIncident(incident)
+	}
+}
+
+func copyIncident(incident *domain.Incident) *domain.Incident {
+	copied := *incident
+	if incident.Metadata != nil {
+		copied.Metadata = make(map[string]interface{}, len(incident.Metadata))
+		for key, value := range incident.Metadata {
+			copied.Metadata[key] = value
+		}
+	}
+	return &copied
+}
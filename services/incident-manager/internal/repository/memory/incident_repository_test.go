@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+func TestIncidentRepository_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+	repo := NewIncidentRepository()
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	var created []*domain.Incident
+	for i, tenantID := range []string{"t1", "t1", "t2"} {
+		incident := domain.NewIncident("check-1", tenantID, domain.IncidentSeverityError, "timeout")
+		incident.CreatedAt = start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.Create(ctx, incident))
+		assert.NotEmpty(t, incident.ID)
+		created = append(created, incident)
+	}
+
+	// Сохраненная копия не меняется вместе с инцидентом вызывающего кода
+	created[0].Resolve()
+	active, err := repo.GetByCheckAndErrorHash(ctx, "check-1", created[0].ErrorHash)
+	require.NoError(t, err)
+	require.NotNil(t, active)
+	require.NoError(t, repo.Update(ctx, created[0]))
+
+	status := domain.IncidentStatusOpen
+	incidents, err := repo.GetByTenantID(ctx, "t1", &domain.IncidentFilter{Status: &status})
+	require.NoError(t, err)
+	require.Len(t, incidents, 1)
+	assert.Equal(t, created[1].ID, incidents[0].ID)
+
+	all, err := repo.GetByTenantID(ctx, "", &domain.IncidentFilter{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, created[2].ID, all[0].ID, "newest first")
+
+	count, err := repo.Count(ctx, "", &domain.IncidentFilter{Limit: 2, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count, "count ignores pagination")
+	count, err = repo.Count(ctx, "t1", &domain.IncidentFilter{Status: &status})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	assert.Equal(t, 0, repo.DeleteResolvedBefore(created[0].ResolvedAt.Add(-time.Second)))
+	assert.Equal(t, 1, repo.DeleteResolvedBefore(created[0].ResolvedAt.Add(time.Second)))
+	_, err = repo.GetByID(ctx, created[0].ID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// componentColumns колонки status_page_components; UUID массивы читаются как text[]
+const componentColumns = `id, tenant_id, name, description, check_ids::text[], position, override, created_at, updated_at`
+
+// maintenanceColumns колонки status_page_maintenances
+const maintenanceColumns = `id, tenant_id, title, message, component_ids::text[], starts_at, ends_at, cancelled_at, created_by, created_at, updated_at`
+
+// StatusPageRepository хранение компонентов и объявлений страницы статуса в PostgreSQL
+type StatusPageRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewStatusPageRepository создает новый экземпляр StatusPageRepository
+func NewStatusPageRepository(pool *pgxpool.Pool) service.StatusPageRepository {
+	return &StatusPageRepository{pool: pool}
+}
+
+// CreateComponent сохраняет компонент и заполняет его ID
+func (r *StatusPageRepository) CreateComponent(ctx context.Context, component *domain.StatusComponent) error {
+	override, err := marshalOverride(component.Override)
+	if err != nil {
+		return err
+	}
+
+	err = r.pool.QueryRow(ctx, `
+		INSERT INTO status_page_components (tenant_id, name, description, check_ids, position, override, created_at, updated_at)
+		VALUES ($1, $2, $3, $4::text[]::uuid[], $5, $6, $7, $8)
+		RETURNING id`,
+		component.TenantID, component.Name, component.Description, nonNil(component.CheckIDs),
+		component.Position, override, component.CreatedAt, component.UpdatedAt,
+	).Scan(&component.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create status component: %w", err)
+	}
+	return nil
+}
+
+// GetComponent возвращает компонент тенанта
+func (r *StatusPageRepository) GetComponent(ctx context.Context, tenantID, id string) (*domain.StatusComponent, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+componentColumns+` FROM status_page_components WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	component, err := scanComponent(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || isInvalidUUID(err) {
+			return nil, domain.ErrStatusComponentNotFound
+		}
+		return nil, fmt.Errorf("failed to get status component: %w", err)
+	}
+	return component, nil
+}
+
+// ListComponents возвращает компоненты тенанта
+func (r *StatusPageRepository) ListComponents(ctx context.Context, tenantID string) ([]*domain.StatusComponent, error) {
+	rows, err := r.pool.Query(ctx, `SELECT `+componentColumns+` FROM status_page_components WHERE tenant_id = $1 ORDER BY position, name`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status components: %w", err)
+	}
+	defer rows.Close()
+
+	components := []*domain.StatusComponent{}
+	for rows.Next() {
+		component, err := scanComponent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan status component: %w", err)
+		}
+		components = append(components, component)
+	}
+	return components, rows.Err()
+}
+
+// UpdateComponent сохраняет изменения компонента
+func (r *StatusPageRepository) UpdateComponent(ctx context.Context, component *domain.StatusComponent) error {
+	override, err := marshalOverride(component.Override)
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE status_page_components
+		SET name = $3, description = $4, check_ids = $5::text[]::uuid[], position = $6, override = $7, updated_at = $8
+		WHERE tenant_id = $1 AND id = $2`,
+		component.TenantID, component.ID, component.Name, component.Description, nonNil(component.CheckIDs),
+		component.Position, override, component.UpdatedAt,
+	)
+	if err != nil {
+		if isInvalidUUID(err) {
+			return domain.ErrStatusComponentNotFound
+		}
+		return fmt.Errorf("failed to update status component: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrStatusComponentNotFound
+	}
+	return nil
+}
+
+// DeleteComponent удаляет компонент и убирает его из объявлений об обслуживании
+func (r *StatusPageRepository) DeleteComponent(ctx context.Context, tenantID, id string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `DELETE FROM status_page_components WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	if err != nil {
+		if isInvalidUUID(err) {
+			return domain.ErrStatusComponentNotFound
+		}
+		return fmt.Errorf("failed to delete status component: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrStatusComponentNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE status_page_maintenances SET component_ids = array_remove(component_ids, $2::uuid)
+		WHERE tenant_id = $1 AND $2::uuid = ANY(component_ids)`, tenantID, id); err != nil {
+		return fmt.Errorf("failed to detach status component from maintenances: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// CreateMaintenance сохраняет объявление и заполняет его ID
+func (r *StatusPageRepository) CreateMaintenance(ctx context.Context, maintenance *domain.MaintenanceAnnouncement) error {
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO status_page_maintenances (tenant_id, title, message, component_ids, starts_at, ends_at, cancelled_at, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4::text[]::uuid[], $5, $6, $7, $8, $9, $10)
+		RETURNING id`,
+		maintenance.TenantID, maintenance.Title, maintenance.Message, nonNil(maintenance.ComponentIDs),
+		maintenance.StartsAt, maintenance.EndsAt, maintenance.CancelledAt, maintenance.CreatedBy,
+		maintenance.CreatedAt, maintenance.UpdatedAt,
+	).Scan(&maintenance.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create maintenance announcement: %w", err)
+	}
+	return nil
+}
+
+// GetMaintenance возвращает объявление тенанта
+func (r *StatusPageRepository) GetMaintenance(ctx context.Context, tenantID, id string) (*domain.MaintenanceAnnouncement, error) {
+	row := r.pool.QueryRow(ctx, `SELECT `+maintenanceColumns+` FROM status_page_maintenances WHERE tenant_id = $1 AND id = $2`, tenantID, id)
+	maintenance, err := scanMaintenance(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || isInvalidUUID(err) {
+			return nil, domain.ErrMaintenanceNotFound
+		}
+		return nil, fmt.Errorf("failed to get maintenance announcement: %w", err)
+	}
+	return maintenance, nil
+}
+
+// ListMaintenances возвращает объявления, которые заканчиваются не раньше since
+func (r *StatusPageRepository) ListMaintenances(ctx context.Context, tenantID string, since time.Time) ([]*domain.MaintenanceAnnouncement, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT `+maintenanceColumns+` FROM status_page_maintenances
+		WHERE tenant_id = $1 AND ends_at >= $2
+		ORDER BY starts_at, id`, tenantID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance announcements: %w", err)
+	}
+	defer rows.Close()
+
+	maintenances := []*domain.MaintenanceAnnouncement{}
+	for rows.Next() {
+		maintenance, err := scanMaintenance(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan maintenance announcement: %w", err)
+		}
+		maintenances = append(maintenances, maintenance)
+	}
+	return maintenances, rows.Err()
+}
+
+// UpdateMaintenance сохраняет изменения объявления
+func (r *StatusPageRepository) UpdateMaintenance(ctx context.Context, maintenance *domain.MaintenanceAnnouncement) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE status_page_maintenances
+		SET title = $3, message = $4, component_ids = $5::text[]::uuid[], starts_at = $6, ends_at = $7, cancelled_at = $8, updated_at = $9
+		WHERE tenant_id = $1 AND id = $2`,
+		maintenance.TenantID, maintenance.ID, maintenance.Title, maintenance.Message, nonNil(maintenance.ComponentIDs),
+		maintenance.StartsAt, maintenance.EndsAt, maintenance.CancelledAt, maintenance.UpdatedAt,
+	)
+	if err != nil {
+		if isInvalidUUID(err) {
+			return domain.ErrMaintenanceNotFound
+		}
+		return fmt.Errorf("failed to update maintenance announcement: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMaintenanceNotFound
+	}
+	return nil
+}
+
+// scanComponent читает строку status_page_components
+func scanComponent(row pgx.Row) (*domain.StatusComponent, error) {
+	var component domain.StatusComponent
+	var override []byte
+	if err := row.Scan(&component.ID, &component.TenantID, &component.Name, &component.Description,
+		&component.CheckIDs, &component.Position, &override, &component.CreatedAt, &component.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if len(override) > 0 {
+		if err := json.Unmarshal(override, &component.Override); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal status override: %w", err)
+		}
+	}
+	return &component, nil
+}
+
+// scanMaintenance читает строку status_page_maintenances
+func scanMaintenance(row pgx.Row) (*domain.MaintenanceAnnouncement, error) {
+	var maintenance domain.MaintenanceAnnouncement
+	if err := row.Scan(&maintenance.ID, &maintenance.TenantID, &maintenance.Title, &maintenance.Message,
+		&maintenance.ComponentIDs, &maintenance.StartsAt, &maintenance.EndsAt, &maintenance.CancelledAt,
+		&maintenance.CreatedBy, &maintenance.CreatedAt, &maintenance.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &maintenance, nil
+}
+
+// marshalOverride кодирует ручной статус в JSONB; nil сохраняется как NULL
+func marshalOverride(override *domain.StatusOverride) ([]byte, error) {
+	if override == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(override)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal status override: %w", err)
+	}
+	return data, nil
+}
+
+// nonNil заменяет nil срез пустым, чтобы колонки NOT NULL получали '{}'
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// isInvalidUUID сообщает, что значение не является UUID
+func isInvalidUUID(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "22P02"
+}
@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+const (
+	// checkStateKeyPrefix префикс ключей состояний проверок
+	checkStateKeyPrefix = "incident:check_state:"
+	// checkStateTTL срок хранения состояния проверки без новых результатов
+	checkStateTTL = 30 * 24 * time.Hour
+	// checkStateRetries число попыток обновления при одновременной записи другим экземпляром
+	checkStateRetries = 5
+)
+
+// CheckStates состояния проверок в Redis, общие для экземпляров incident-manager
+type CheckStates struct {
+	client *redis.Client
+}
+
+// NewCheckStates создает состояния проверок в Redis
+func NewCheckStates(client *redis.Client) *CheckStates {
+	return &CheckStates{client: client}
+}
+
+var _ service.CheckStateRepository = (*CheckStates)(nil)
+
+// Update применяет apply к состоянию проверки в оптимистичной транзакции WATCH
+func (s *CheckStates) Update(ctx context.Context, checkID string, apply func(status *domain.CheckStatus)) (*domain.CheckStatus, error) {
+	key := checkStateKeyPrefix + checkID
+	var status *domain.CheckStatus
+	update := func(tx *redis.Tx) error {
+		current, err := s.get(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			current = &domain.CheckStatus{CheckID: checkID}
+		}
+		apply(current)
+
+		data, err := json.Marshal(current)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, checkStateTTL)
+			return nil
+		})
+		if err == nil {
+			status = current
+		}
+		return err
+	}
+
+	for attempt := 0; attempt < checkStateRetries; attempt++ {
+		err := s.client.Watch(ctx, update, key)
+		if err == redis.TxFailedErr {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to update check state").
+				WithDetails("check_id: " + checkID)
+		}
+		return status, nil
+	}
+	return nil, errors.New(errors.ErrConflict, "check state updated concurrently").
+		WithDetails("check_id: " + checkID)
+}
+
+// Get возвращает состояние проверки
+func (s *CheckStates) Get(ctx context.Context, checkID string) (*domain.CheckStatus, error) {
+	status, err := s.get(ctx, s.client, checkStateKeyPrefix+checkID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get check state").
+			WithDetails("check_id: " + checkID)
+	}
+	return status, nil
+}
+
+// get читает состояние по ключу; nil, если ключа нет
+func (s *CheckStates) get(ctx context.Context, client redis.Cmdable, key string) (*domain.CheckStatus, error) {
+	data, err := client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var status domain.CheckStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
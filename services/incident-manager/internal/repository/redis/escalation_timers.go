@@ -0,0 +1,75 @@
+// Package redis хранилища incident-manager в Redis
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// escalationTimersKey sorted set таймеров эскалации: элемент - ID инцидента, вес - срок в
+// миллисекундах Unix
+const escalationTimersKey = "incident:escalation_timers"
+
+// claimDueScript атомарно выбирает наступившие таймеры и переносит их на срок аренды, чтобы
+// несколько экземпляров incident-manager не эскалировали инцидент одновременно
+var claimDueScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, id in ipairs(due) do
+	redis.call('ZADD', KEYS[1], ARGV[2], id)
+end
+return due
+`)
+
+// EscalationTimers таймеры эскалации в sorted set Redis
+type EscalationTimers struct {
+	client *redis.Client
+}
+
+// NewEscalationTimers создает таймеры эскалации в Redis
+func NewEscalationTimers(client *redis.Client) *EscalationTimers {
+	return &EscalationTimers{client: client}
+}
+
+var _ service.EscalationTimers = (*EscalationTimers)(nil)
+
+// Schedule ставит или переносит таймер инцидента
+func (t *EscalationTimers) Schedule(ctx context.Context, incidentID string, at time.Time) error {
+	err := t.client.ZAdd(ctx, escalationTimersKey, &redis.Z{
+		Score:  float64(at.UnixMilli()),
+		Member: incidentID,
+	}).Err()
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to schedule escalation timer").
+			WithDetails("incident_id: " + incidentID)
+	}
+	return nil
+}
+
+// Cancel удаляет таймер инцидента
+func (t *EscalationTimers) Cancel(ctx context.Context, incidentID string) error {
+	if err := t.client.ZRem(ctx, escalationTimersKey, incidentID).Err(); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to cancel escalation timer").
+			WithDetails("incident_id: " + incidentID)
+	}
+	return nil
+}
+
+// ClaimDue возвращает инциденты с наступившими таймерами, ранние первыми, и переносит их
+// таймеры на now+lease
+func (t *EscalationTimers) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]string, error) {
+	ids, err := claimDueScript.Run(ctx, t.client, []string{escalationTimersKey},
+		strconv.FormatInt(now.UnixMilli(), 10),
+		strconv.FormatInt(now.Add(lease).UnixMilli(), 10),
+		limit,
+	).StringSlice()
+	if err != nil && err != redis.Nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to claim escalation timers")
+	}
+	return ids, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+// AlertAction действие, выполненное над инцидентом при приеме внешнего алерта
+type AlertAction string
+
+const (
+	AlertActionCreated  AlertAction = "created"
+	AlertActionUpdated  AlertAction = "updated"
+	AlertActionResolved AlertAction = "resolved"
+	AlertActionIgnored  AlertAction = "ignored"
+)
+
+// IngestAlert открывает, обновляет или закрывает инцидент по алерту внешней системы.
+// Алерт привязывается к детерминированному check_id, поэтому повторные срабатывания
+// группируются в тот же инцидент, а resolved-уведомление закрывает его без ожидания auto-resolve
+func (s *incidentService) IngestAlert(ctx context.Context, alert *domain.ExternalAlert) (*domain.Incident, AlertAction, error) {
+	if alert == nil {
+		return nil, "", errors.New(errors.ErrValidation, "alert cannot be nil")
+	}
+	if err := s.validator.ValidateUUID(alert.TenantID, "tenant_id"); err != nil {
+		return nil, "", errors.Wrap(err, errors.ErrValidation, "tenant_id validation failed")
+	}
+	if err := alert.Validate(); err != nil {
+		return nil, "", errors.Wrap(err, errors.ErrValidation, "alert validation failed")
+	}
+
+	result := &CheckResult{
+		CheckID:      alert.CheckID(),
+		TenantID:     alert.TenantID,
+		IsSuccess:    alert.Resolved,
+		ErrorMessage: alert.Title,
+		Timestamp:    s.clk().Now(),
+		Metadata:     alert.Metadata(),
+	}
+
+	s.logger.Info("Ingesting external alert",
+		logger.String("source", alert.Source),
+		logger.String("fingerprint", alert.Fingerprint),
+		logger.String("check_id", result.CheckID),
+		logger.String("tenant_id", alert.TenantID),
+		logger.Bool("resolved", alert.Resolved))
+
+	if alert.Resolved {
+		return s.resolveExternalAlert(ctx, result)
+	}
+
+	severity := alert.Severity
+	if severity == "" {
+		severity = s.determineSeverity(alert.Title, 0)
+	}
+
+	incident := domain.NewIncidentAt(result.CheckID, result.TenantID, severity, result.ErrorMessage, s.clk().Now())
+	existing, err := s.repo.GetByCheckAndErrorHash(ctx, result.CheckID, incident.ErrorHash)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errors.ErrInternal, "failed to find existing incident")
+	}
+
+	if existing != nil {
+		attachFailureDetails(existing, result)
+		if err := s.updateExistingIncident(ctx, existing, result, severity); err != nil {
+			return nil, "", err
+		}
+		return existing, AlertActionUpdated, nil
+	}
+
+	attachFailureDetails(incident, result)
+	if err := s.repo.Create(ctx, incident); err != nil {
+		return nil, "", errors.Wrap(err, errors.ErrInternal, "failed to create incident")
+	}
+	s.publishIncidentEvent(ctx, "incident.opened", incident, result)
+
+	return incident, AlertActionCreated, nil
+}
+
+// resolveExternalAlert закрывает активные инциденты алерта
+func (s *incidentService) resolveExternalAlert(ctx context.Context, result *CheckResult) (*domain.Incident, AlertAction, error) {
+	incidents, err := s.repo.GetByTenantID(ctx, result.TenantID, &domain.IncidentFilter{
+		CheckID: &result.CheckID,
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, errors.ErrInternal, "failed to find active incident")
+	}
+
+	var resolved *domain.Incident
+	for _, incident := range incidents {
+		if incident.Status != domain.IncidentStatusOpen && incident.Status != domain.IncidentStatusAcknowledged {
+			continue
+		}
+
+		incident.ResolveAt(s.clk().Now())
+		if err := s.repo.Update(ctx, incident); err != nil {
+			return nil, "", errors.Wrap(err, errors.ErrInternal, "failed to resolve incident")
+		}
+		s.publishIncidentEvent(ctx, "incident.resolved", incident, result)
+		resolved = incident
+	}
+
+	if resolved == nil {
+		s.logger.Debug("No active incident for resolved alert",
+			logger.String("check_id", result.CheckID),
+			logger.String("tenant_id", result.TenantID))
+		return nil, AlertActionIgnored, nil
+	}
+	return resolved, AlertActionResolved, nil
+}
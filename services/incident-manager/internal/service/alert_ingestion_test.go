@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+func newAlertTestService(t *testing.T) (IncidentService, *MockIncidentRepository) {
+	repo := &MockIncidentRepository{}
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+	return NewIncidentService(repo, DefaultIncidentConfig(), log), repo
+}
+
+func testExternalAlert() *domain.ExternalAlert {
+	return &domain.ExternalAlert{
+		TenantID:    "550e8400-e29b-41d4-a716-446655440001",
+		Source:      "prod-alertmanager",
+		Fingerprint: "a1b2c3d4",
+		Title:       "HighErrorRate",
+		Description: "5xx rate above 5% for 10m",
+		Severity:    domain.IncidentSeverityCritical,
+		Labels:      map[string]string{"service": "checkout"},
+	}
+}
+
+func TestIncidentService_IngestAlert_CreatesIncident(t *testing.T) {
+	service, repo := newAlertTestService(t)
+	alert := testExternalAlert()
+
+	repo.On("GetByCheckAndErrorHash", mock.Anything, alert.CheckID(), mock.AnythingOfType("string")).Return(nil, nil)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Incident")).Return(nil)
+
+	incident, action, err := service.IngestAlert(context.Background(), alert)
+
+	require.NoError(t, err)
+	assert.Equal(t, AlertActionCreated, action)
+	assert.Equal(t, alert.CheckID(), incident.CheckID)
+	assert.Equal(t, domain.IncidentSeverityCritical, incident.Severity)
+	details, ok := incident.Metadata[FailureDetailsKey].(map[string]string)
+	require.True(t, ok)
+	assert.Equal(t, "prod-alertmanager", details[domain.MetadataKeyAlertSource])
+	assert.Equal(t, "checkout", details[domain.MetadataKeyAlertLabelPrefix+"service"])
+	repo.AssertExpectations(t)
+}
+
+func TestIncidentService_IngestAlert_UpdatesExistingIncident(t *testing.T) {
+	service, repo := newAlertTestService(t)
+	alert := testExternalAlert()
+	existing := domain.NewIncident(alert.CheckID(), alert.TenantID, domain.IncidentSeverityCritical, alert.Title)
+
+	repo.On("GetByCheckAndErrorHash", mock.Anything, alert.CheckID(), mock.AnythingOfType("string")).Return(existing, nil)
+	repo.On("Update", mock.Anything, existing).Return(nil)
+
+	incident, action, err := service.IngestAlert(context.Background(), alert)
+
+	require.NoError(t, err)
+	assert.Equal(t, AlertActionUpdated, action)
+	assert.Equal(t, 2, incident.Count)
+	repo.AssertExpectations(t)
+}
+
+func TestIncidentService_IngestAlert_ResolvesIncident(t *testing.T) {
+	service, repo := newAlertTestService(t)
+	alert := testExternalAlert()
+	alert.Resolved = true
+	existing := domain.NewIncident(alert.CheckID(), alert.TenantID, domain.IncidentSeverityCritical, alert.Title)
+
+	repo.On("GetByTenantID", mock.Anything, alert.TenantID, mock.AnythingOfType("*domain.IncidentFilter")).
+		Return([]*domain.Incident{existing}, nil)
+	repo.On("Update", mock.Anything, existing).Return(nil)
+
+	incident, action, err := service.IngestAlert(context.Background(), alert)
+
+	require.NoError(t, err)
+	assert.Equal(t, AlertActionResolved, action)
+	assert.True(t, incident.IsResolved())
+	repo.AssertExpectations(t)
+}
+
+func TestIncidentService_IngestAlert_ResolvedWithoutIncident(t *testing.T) {
+	service, repo := newAlertTestService(t)
+	alert := testExternalAlert()
+	alert.Resolved = true
+
+	repo.On("GetByTenantID", mock.Anything, alert.TenantID, mock.AnythingOfType("*domain.IncidentFilter")).
+		Return([]*domain.Incident{}, nil)
+
+	incident, action, err := service.IngestAlert(context.Background(), alert)
+
+	require.NoError(t, err)
+	assert.Equal(t, AlertActionIgnored, action)
+	assert.Nil(t, incident)
+}
+
+func TestIncidentService_IngestAlert_Validation(t *testing.T) {
+	service, _ := newAlertTestService(t)
+
+	alert := testExternalAlert()
+	alert.Fingerprint = ""
+	_, _, err := service.IngestAlert(context.Background(), alert)
+	assert.Error(t, err)
+
+	alert = testExternalAlert()
+	alert.TenantID = "tenant"
+	_, _, err = service.IngestAlert(context.Background(), alert)
+	assert.Error(t, err)
+}
+
+func TestExternalAlert_CheckID(t *testing.T) {
+	alert := testExternalAlert()
+	other := testExternalAlert()
+	other.Fingerprint = "e5f6"
+
+	assert.Len(t, alert.CheckID(), 36)
+	assert.Equal(t, alert.CheckID(), testExternalAlert().CheckID())
+	assert.NotEqual(t, alert.CheckID(), other.CheckID())
+	assert.Equal(t, byte('5'), alert.CheckID()[14])
+}
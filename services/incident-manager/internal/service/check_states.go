@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+// CheckStateRepository хранит состояния проверок
+type CheckStateRepository interface {
+	// Update атомарно применяет apply к состоянию проверки и сохраняет его. Проверка без
+	// сохраненного состояния получает пустое состояние с checkID
+	Update(ctx context.Context, checkID string, apply func(status *domain.CheckStatus)) (*domain.CheckStatus, error)
+	// Get возвращает состояние проверки; nil без ошибки, если результатов еще не было
+	Get(ctx context.Context, checkID string) (*domain.CheckStatus, error)
+}
+
+// StateConfig настройки состояний проверок
+type StateConfig struct {
+	// Confirmations пороги подтверждения отказа и восстановления
+	Confirmations domain.Confirmations
+	// DegradedResponseTime время ответа, начиная с которого успешная проверка считается
+	// деградировавшей; 0 - без degraded
+	DegradedResponseTime time.Duration
+}
+
+// confirmingIncidentService передает результаты проверок сервису инцидентов только после
+// подтверждения смены состояния проверки
+type confirmingIncidentService struct {
+	IncidentService
+	states CheckStateRepository
+	config StateConfig
+	logger logger.Logger
+}
+
+// NewConfirmingIncidentService оборачивает сервис инцидентов конечным автоматом состояний
+// проверок: ошибки до подтверждения отказа не открывают инцидент, успехи до подтверждения
+// восстановления не закрывают его. При недоступности хранилища состояний результат передается
+// без подтверждения
+func NewConfirmingIncidentService(incidents IncidentService, states CheckStateRepository, config StateConfig, log logger.Logger) IncidentService {
+	return &confirmingIncidentService{IncidentService: incidents, states: states, config: config, logger: log}
+}
+
+// ProcessCheckResult учитывает результат в состоянии проверки и передает подтвержденный
+// результат сервису инцидентов
+func (s *confirmingIncidentService) ProcessCheckResult(ctx context.Context, result *CheckResult) (*domain.Incident, error) {
+	if !s.confirm(ctx, result) {
+		return nil, nil
+	}
+	return s.IncidentService.ProcessCheckResult(ctx, result)
+}
+
+// ProcessCheckResultEvent учитывает результат в состоянии проверки и передает подтвержденный
+// результат сервису инцидентов
+func (s *confirmingIncidentService) ProcessCheckResultEvent(ctx context.Context, result *CheckResult) error {
+	if !s.confirm(ctx, result) {
+		return nil
+	}
+	return s.IncidentService.ProcessCheckResultEvent(ctx, result)
+}
+
+// confirm обновляет состояние проверки и сообщает, передавать ли результат дальше: ошибку -
+// только в состоянии down, успех - только вне down
+func (s *confirmingIncidentService) confirm(ctx context.Context, result *CheckResult) bool {
+	if result == nil || result.CheckID == "" {
+		// Невалидный результат отклонит сервис инцидентов
+		return true
+	}
+
+	at := result.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+	degraded := s.config.DegradedResponseTime > 0 && result.Duration >= s.config.DegradedResponseTime
+
+	var prev domain.CheckState
+	var changed bool
+	status, err := s.states.Update(ctx, result.CheckID, func(status *domain.CheckStatus) {
+		status.TenantID = result.TenantID
+		prev, changed = status.Observe(result.IsSuccess, degraded, at, s.config.Confirmations)
+	})
+	if err != nil {
+		s.logger.Warn("Failed to update check state, processing result without confirmation",
+			logger.String("check_id", result.CheckID),
+			logger.Error(err))
+		return true
+	}
+
+	if changed {
+		s.logger.Info("Check state changed",
+			logger.String("check_id", result.CheckID),
+			logger.String("tenant_id", result.TenantID),
+			logger.String("from", string(prev)),
+			logger.String("to", string(status.State)))
+	}
+	if result.IsSuccess {
+		return !status.IsDown()
+	}
+	if !status.IsDown() {
+		s.logger.Debug("Check failure not confirmed yet",
+			logger.String("check_id", result.CheckID),
+			logger.Int("consecutive_failures", status.ConsecutiveFailures),
+			logger.Int("confirmations", s.config.Confirmations.Down))
+	}
+	return status.IsDown()
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+// fakeStateRepository состояния проверок теста в памяти
+type fakeStateRepository struct {
+	states map[string]domain.CheckStatus
+	err    error
+}
+
+func (r *fakeStateRepository) Update(ctx context.Context, checkID string, apply func(status *domain.CheckStatus)) (*domain.CheckStatus, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	status, ok := r.states[checkID]
+	if !ok {
+		status = domain.CheckStatus{CheckID: checkID}
+	}
+	apply(&status)
+	r.states[checkID] = status
+	return &status, nil
+}
+
+func (r *fakeStateRepository) Get(ctx context.Context, checkID string) (*domain.CheckStatus, error) {
+	status, ok := r.states[checkID]
+	if !ok {
+		return nil, nil
+	}
+	return &status, nil
+}
+
+// recordingIncidentService запоминает переданные результаты проверок
+type recordingIncidentService struct {
+	IncidentService
+	results []bool
+}
+
+func (s *recordingIncidentService) ProcessCheckResult(ctx context.Context, result *CheckResult) (*domain.Incident, error) {
+	s.results = append(s.results, result.IsSuccess)
+	return nil, nil
+}
+
+func (s *recordingIncidentService) ProcessCheckResultEvent(ctx context.Context, result *CheckResult) error {
+	s.results = append(s.results, result.IsSuccess)
+	return nil
+}
+
+func newConfirmingTestService(t *testing.T, states CheckStateRepository) (IncidentService, *recordingIncidentService) {
+	log, err := logger.NewLogger("test", "error", "incident-service", false)
+	require.NoError(t, err)
+	inner := &recordingIncidentService{}
+	config := StateConfig{
+		Confirmations:        domain.Confirmations{Down: 3, Up: 2},
+		DegradedResponseTime: 2 * time.Second,
+	}
+	return NewConfirmingIncidentService(inner, states, config, log), inner
+}
+
+func TestConfirmingIncidentService_ProcessCheckResult(t *testing.T) {
+	ctx := context.Background()
+	states := &fakeStateRepository{states: make(map[string]domain.CheckStatus)}
+	svc, inner := newConfirmingTestService(t, states)
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	results := []bool{true, false, true, false, false, false, false, true, true, true}
+	for i, success := range results {
+		_, err := svc.ProcessCheckResult(ctx, &CheckResult{
+			CheckID:   "check-1",
+			TenantID:  "tenant-1",
+			IsSuccess: success,
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+		})
+		require.NoError(t, err)
+	}
+
+	// Единичный сбой не дошел до сервиса инцидентов; ошибки передаются с третьей подряд,
+	// успехи - со второго подряд после отказа
+	assert.Equal(t, []bool{true, true, false, false, true, true}, inner.results)
+
+	status, err := states.Get(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.CheckStateUp, status.State)
+	assert.Equal(t, start.Add(8*time.Minute), status.Since)
+}
+
+func TestConfirmingIncidentService_Degraded(t *testing.T) {
+	ctx := context.Background()
+	states := &fakeStateRepository{states: make(map[string]domain.CheckStatus)}
+	svc, inner := newConfirmingTestService(t, states)
+
+	require.NoError(t, svc.ProcessCheckResultEvent(ctx, &CheckResult{
+		CheckID: "check-1", TenantID: "tenant-1", IsSuccess: true, Duration: 3 * time.Second,
+	}))
+
+	status, err := states.Get(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.CheckStateDegraded, status.State)
+	assert.Equal(t, []bool{true}, inner.results)
+}
+
+func TestConfirmingIncidentService_StateStoreFailure(t *testing.T) {
+	states := &fakeStateRepository{err: assert.AnError}
+	svc, inner := newConfirmingTestService(t, states)
+
+	_, err := svc.ProcessCheckResult(context.Background(), &CheckResult{CheckID: "check-1", TenantID: "tenant-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false}, inner.results, "result is processed without confirmation")
+}
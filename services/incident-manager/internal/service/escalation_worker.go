@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/producer/rabbitmq"
+)
+
+// EscalationReasonIdle причина эскалации инцидента, по которому не приходят новые результаты
+const EscalationReasonIdle = "idle_timeout"
+
+const (
+	// escalationLease время, на которое воркер забирает таймер; если экземпляр упал, не успев
+	// обработать таймер, таймер сработает снова после истечения срока
+	escalationLease = time.Minute
+	// escalationBatchSize наибольшее число таймеров за один проход
+	escalationBatchSize = 100
+)
+
+// EscalationTimers хранилище таймеров эскалации инцидентов, переживающее перезапуски
+// incident-manager. У инцидента не больше одного таймера
+type EscalationTimers interface {
+	// Schedule ставит таймер инцидента на время at или переносит существующий
+	Schedule(ctx context.Context, incidentID string, at time.Time) error
+	// Cancel удаляет таймер инцидента
+	Cancel(ctx context.Context, incidentID string) error
+	// ClaimDue возвращает до limit инцидентов с наступившими таймерами и переносит их таймеры
+	// на now+lease, чтобы другие экземпляры не обработали их одновременно
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]string, error)
+}
+
+// EscalationRepository хранилище инцидентов для воркера эскалации
+type EscalationRepository interface {
+	// ListActive возвращает неразрешенные инциденты всех тенантов
+	ListActive(ctx context.Context) ([]*domain.Incident, error)
+	GetByID(ctx context.Context, id string) (*domain.Incident, error)
+	Update(ctx context.Context, incident *domain.Incident) error
+}
+
+// escalationTimerRepository взводит таймер эскалации при каждом сохранении инцидента
+type escalationTimerRepository struct {
+	IncidentRepository
+	timers   EscalationTimers
+	timeouts map[domain.IncidentSeverity]time.Duration
+	logger   logger.Logger
+}
+
+// NewEscalationTimerRepository оборачивает хранилище инцидентов так, что создание и каждое
+// обновление инцидента ставит, переносит или снимает его таймер эскалации. Ошибка таймера
+// не отменяет сохранение: воркер восстанавливает таймеры при запуске
+func NewEscalationTimerRepository(repo IncidentRepository, timers EscalationTimers, timeouts map[domain.IncidentSeverity]time.Duration, log logger.Logger) IncidentRepository {
+	return &escalationTimerRepository{IncidentRepository: repo, timers: timers, timeouts: timeouts, logger: log}
+}
+
+// Create сохраняет инцидент и ставит его таймер
+func (r *escalationTimerRepository) Create(ctx context.Context, incident *domain.Incident) error {
+	if err := r.IncidentRepository.Create(ctx, incident); err != nil {
+		return err
+	}
+	r.arm(ctx, incident)
+	return nil
+}
+
+// Update сохраняет инцидент и переносит или снимает его таймер
+func (r *escalationTimerRepository) Update(ctx context.Context, incident *domain.Incident) error {
+	if err := r.IncidentRepository.Update(ctx, incident); err != nil {
+		return err
+	}
+	r.arm(ctx, incident)
+	return nil
+}
+
+// arm приводит таймер инцидента в соответствие с его состоянием
+func (r *escalationTimerRepository) arm(ctx context.Context, incident *domain.Incident) {
+	var err error
+	if dueAt, ok := incident.EscalationDueAt(r.timeouts); ok {
+		err = r.timers.Schedule(ctx, incident.ID, dueAt)
+	} else {
+		err = r.timers.Cancel(ctx, incident.ID)
+	}
+	if err != nil {
+		r.logger.Warn("Failed to update incident escalation timer",
+			logger.String("incident_id", incident.ID),
+			logger.Error(err))
+	}
+}
+
+// EscalationWorker эскалирует открытые неподтвержденные инциденты, по которым не приходят
+// новые результаты. Когда срабатывает таймер инцидента, его серьезность повышается на ступень,
+// эскалация фиксируется в escalation_history, а в notification-service повторно публикуется
+// событие incident.updated. Таймеры хранятся в EscalationTimers и переживают перезапуски
+type EscalationWorker struct {
+	repo     EscalationRepository
+	timers   EscalationTimers
+	timeouts map[domain.IncidentSeverity]time.Duration
+	producer rabbitmq.IncidentProducerInterface
+	logger   logger.Logger
+	now      func() time.Time
+}
+
+// NewEscalationWorker создает воркер эскалации со временем эскалации по серьезности. producer
+// может быть nil, тогда эскалации только фиксируются в инцидентах
+func NewEscalationWorker(repo EscalationRepository, timers EscalationTimers, timeouts map[domain.IncidentSeverity]time.Duration, producer rabbitmq.IncidentProducerInterface, log logger.Logger) *EscalationWorker {
+	return &EscalationWorker{
+		repo:     repo,
+		timers:   timers,
+		timeouts: timeouts,
+		producer: producer,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// WithClock задает источник времени вместо системных часов
+func (w *EscalationWorker) WithClock(c clock.Clock) *EscalationWorker {
+	w.now = c.Now
+	return w
+}
+
+// Run восстанавливает таймеры активных инцидентов и обрабатывает наступившие таймеры с заданным
+// интервалом до отмены контекста
+func (w *EscalationWorker) Run(ctx context.Context, interval time.Duration) {
+	if err := w.Rearm(ctx); err != nil {
+		w.logger.Error("Failed to restore incident escalation timers", logger.Error(err))
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.EscalateDue(ctx); err != nil {
+			w.logger.Error("Incident escalation check failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rearm ставит таймеры всех активных инцидентов, которые нужно эскалировать. Нужен при запуске,
+// если таймеры не удалось поставить при сохранении инцидентов
+func (w *EscalationWorker) Rearm(ctx context.Context) error {
+	incidents, err := w.repo.ListActive(ctx)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to list active incidents")
+	}
+	for _, incident := range incidents {
+		if dueAt, ok := incident.EscalationDueAt(w.timeouts); ok {
+			if err := w.timers.Schedule(ctx, incident.ID, dueAt); err != nil {
+				return errors.Wrap(err, errors.ErrInternal, "failed to schedule escalation timer").
+					WithDetails("incident_id: " + incident.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// EscalateDue обрабатывает наступившие таймеры и возвращает эскалированные инциденты
+func (w *EscalationWorker) EscalateDue(ctx context.Context) ([]*domain.Incident, error) {
+	now := w.now()
+	ids, err := w.timers.ClaimDue(ctx, now, escalationLease, escalationBatchSize)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to claim escalation timers")
+	}
+
+	var escalated []*domain.Incident
+	for _, id := range ids {
+		incident, err := w.escalate(ctx, id, now)
+		if err != nil {
+			// Таймер остается забранным и сработает снова после истечения срока
+			w.logger.Error("Failed to escalate incident",
+				logger.String("incident_id", id),
+				logger.Error(err))
+			continue
+		}
+		if incident != nil {
+			escalated = append(escalated, incident)
+		}
+	}
+	return escalated, nil
+}
+
+// escalate эскалирует инцидент по сработавшему таймеру. Таймер, устаревший из-за новых
+// результатов, подтверждения или закрытия инцидента, переносится или снимается
+func (w *EscalationWorker) escalate(ctx context.Context, id string, now time.Time) (*domain.Incident, error) {
+	incident, err := w.repo.GetByID(ctx, id)
+	if err != nil {
+		if stderrors.Is(err, errors.New(errors.ErrNotFound, "")) {
+			return nil, w.timers.Cancel(ctx, id)
+		}
+		return nil, err
+	}
+
+	dueAt, ok := incident.EscalationDueAt(w.timeouts)
+	if !ok {
+		return nil, w.timers.Cancel(ctx, id)
+	}
+	if now.Before(dueAt) {
+		return nil, w.timers.Schedule(ctx, id, dueAt)
+	}
+
+	from, _ := incident.Escalate(EscalationReasonIdle, now)
+	if err := w.repo.Update(ctx, incident); err != nil {
+		return nil, err
+	}
+	if dueAt, ok := incident.EscalationDueAt(w.timeouts); ok {
+		err = w.timers.Schedule(ctx, id, dueAt)
+	} else {
+		err = w.timers.Cancel(ctx, id)
+	}
+	if err != nil {
+		w.logger.Warn("Failed to reschedule incident escalation timer",
+			logger.String("incident_id", id),
+			logger.Error(err))
+	}
+
+	w.logger.Info("Escalating idle incident",
+		logger.String("incident_id", incident.ID),
+		logger.String("tenant_id", incident.TenantID),
+		logger.String("from_severity", string(from)),
+		logger.String("to_severity", string(incident.Severity)),
+		logger.Duration("idle", now.Sub(incident.LastSeen)))
+	w.publishEscalation(ctx, incident, from, now)
+	return incident, nil
+}
+
+// publishEscalation повторно уведомляет об эскалированном инциденте событием incident.updated
+func (w *EscalationWorker) publishEscalation(ctx context.Context, incident *domain.Incident, from domain.IncidentSeverity, now time.Time) {
+	if w.producer == nil || !w.producer.IsConnected() {
+		w.logger.Warn("RabbitMQ producer not available, incident escalation not published",
+			logger.String("incident_id", incident.ID))
+		return
+	}
+
+	result := &rabbitmq.CheckResult{
+		CheckID:      incident.CheckID,
+		TenantID:     incident.TenantID,
+		ErrorMessage: incident.ErrorMessage,
+		Duration:     now.Sub(incident.FirstSeen),
+		Timestamp:    now,
+		Metadata: map[string]interface{}{
+			"escalated_from":    string(from),
+			"escalation_reason": EscalationReasonIdle,
+		},
+	}
+	if err := w.producer.PublishIncidentEventWithRetry(ctx, "incident.updated", incident, result); err != nil {
+		w.logger.Error("Failed to publish incident escalation",
+			logger.String("incident_id", incident.ID),
+			logger.Error(err))
+	}
+}
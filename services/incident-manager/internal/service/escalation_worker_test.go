@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+// fakeEscalationTimers таймеры эскалации в памяти теста
+type fakeEscalationTimers struct {
+	timers map[string]time.Time
+}
+
+func (t *fakeEscalationTimers) Schedule(ctx context.Context, incidentID string, at time.Time) error {
+	t.timers[incidentID] = at
+	return nil
+}
+
+func (t *fakeEscalationTimers) Cancel(ctx context.Context, incidentID string) error {
+	delete(t.timers, incidentID)
+	return nil
+}
+
+func (t *fakeEscalationTimers) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]string, error) {
+	var due []string
+	for id, at := range t.timers {
+		if !at.After(now) {
+			due = append(due, id)
+			t.timers[id] = now.Add(lease)
+		}
+	}
+	return due, nil
+}
+
+// fakeEscalationRepository инциденты в памяти теста
+type fakeEscalationRepository struct {
+	IncidentRepository
+	incidents map[string]*domain.Incident
+}
+
+func (r *fakeEscalationRepository) Create(ctx context.Context, incident *domain.Incident) error {
+	r.incidents[incident.ID] = incident
+	return nil
+}
+
+func (r *fakeEscalationRepository) ListActive(ctx context.Context) ([]*domain.Incident, error) {
+	var incidents []*domain.Incident
+	for _, incident := range r.incidents {
+		if incident.IsActive() {
+			incidents = append(incidents, incident)
+		}
+	}
+	return incidents, nil
+}
+
+func (r *fakeEscalationRepository) GetByID(ctx context.Context, id string) (*domain.Incident, error) {
+	incident, ok := r.incidents[id]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "incident not found")
+	}
+	return incident, nil
+}
+
+func (r *fakeEscalationRepository) Update(ctx context.Context, incident *domain.Incident) error {
+	r.incidents[incident.ID] = incident
+	return nil
+}
+
+func newTestIncident(id string, severity domain.IncidentSeverity, lastSeen time.Time) *domain.Incident {
+	incident := domain.NewIncident("check-"+id, "tenant-1", severity, "timeout")
+	incident.ID = id
+	incident.FirstSeen = lastSeen
+	incident.LastSeen = lastSeen
+	return incident
+}
+
+func TestEscalationWorker_EscalateDue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	log, err := logger.NewLogger("test", "error", "incident-service", false)
+	require.NoError(t, err)
+
+	idle := newTestIncident("idle", domain.IncidentSeverityWarning, now.Add(-31*time.Minute))
+	active := newTestIncident("active", domain.IncidentSeverityWarning, now.Add(-time.Minute))
+	acknowledged := newTestIncident("acknowledged", domain.IncidentSeverityError, now.Add(-time.Hour))
+	acknowledged.Acknowledge()
+	repo := &fakeEscalationRepository{incidents: map[string]*domain.Incident{
+		idle.ID: idle, active.ID: active, acknowledged.ID: acknowledged,
+	}}
+	timers := &fakeEscalationTimers{timers: map[string]time.Time{
+		// Устаревшие таймеры: новый результат проверки, подтверждение и удаленный инцидент
+		active.ID:       now.Add(-time.Minute),
+		acknowledged.ID: now.Add(-time.Minute),
+		"deleted":       now.Add(-time.Minute),
+	}}
+	timeouts := DefaultIncidentConfig().EscalationTimeouts
+
+	producer := &recordingProducer{}
+	worker := NewEscalationWorker(repo, timers, timeouts, producer, log)
+	worker.now = func() time.Time { return now }
+	require.NoError(t, worker.Rearm(ctx))
+	assert.Equal(t, idle.LastSeen.Add(30*time.Minute), timers.timers[idle.ID])
+
+	escalated, err := worker.EscalateDue(ctx)
+	require.NoError(t, err)
+	require.Len(t, escalated, 1)
+	assert.Equal(t, idle.ID, escalated[0].ID)
+	assert.Equal(t, domain.IncidentSeverityError, idle.Severity)
+	assert.Equal(t, []string{"incident.updated"}, producer.events)
+	assert.Equal(t, "warning", producer.results[0].Metadata["escalated_from"])
+	assert.Equal(t, EscalationReasonIdle, producer.results[0].Metadata["escalation_reason"])
+
+	// Следующая ступень отсчитывается от эскалации, устаревшие таймеры перенесены или сняты
+	assert.True(t, now.Add(15*time.Minute).Equal(timers.timers[idle.ID]))
+	assert.Equal(t, active.LastSeen.Add(30*time.Minute), timers.timers[active.ID])
+	assert.NotContains(t, timers.timers, acknowledged.ID)
+	assert.NotContains(t, timers.timers, "deleted")
+
+	// Без новых результатов и без подтверждения инцидент доходит до критического
+	worker.now = func() time.Time { return now.Add(16 * time.Minute) }
+	escalated, err = worker.EscalateDue(ctx)
+	require.NoError(t, err)
+	require.Len(t, escalated, 1)
+	assert.Equal(t, domain.IncidentSeverityCritical, idle.Severity)
+	assert.NotContains(t, timers.timers, idle.ID)
+	assert.Len(t, idle.Metadata["escalation_history"], 2)
+}
+
+func TestEscalationTimerRepository(t *testing.T) {
+	ctx := context.Background()
+	log, err := logger.NewLogger("test", "error", "incident-service", false)
+	require.NoError(t, err)
+
+	timers := &fakeEscalationTimers{timers: map[string]time.Time{}}
+	timeouts := DefaultIncidentConfig().EscalationTimeouts
+	repo := NewEscalationTimerRepository(&fakeEscalationRepository{incidents: map[string]*domain.Incident{}}, timers, timeouts, log)
+
+	incident := newTestIncident("incident-1", domain.IncidentSeverityError, time.Now())
+	require.NoError(t, repo.Create(ctx, incident))
+	assert.Equal(t, incident.LastSeen.Add(15*time.Minute), timers.timers[incident.ID])
+
+	incident.IncrementCount()
+	require.NoError(t, repo.Update(ctx, incident))
+	assert.Equal(t, incident.LastSeen.Add(15*time.Minute), timers.timers[incident.ID])
+
+	incident.Resolve()
+	require.NoError(t, repo.Update(ctx, incident))
+	assert.Empty(t, timers.timers)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"UptimePingPlatform/pkg/clock"
 	"UptimePingPlatform/pkg/errors"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/validation"
@@ -29,6 +30,9 @@ type IncidentService interface {
 	// GetIncidents получает список инцидентов с фильтрацией
 	GetIncidents(ctx context.Context, filter *domain.IncidentFilter) ([]*domain.Incident, error)
 	
+	// CountIncidents возвращает число инцидентов, подходящих под фильтр, без учета пагинации
+	CountIncidents(ctx context.Context, filter *domain.IncidentFilter) (int, error)
+	
 	// UpdateIncident обновляет инцидент
 	UpdateIncident(ctx context.Context, incident *domain.Incident) error
 	
@@ -43,6 +47,9 @@ type IncidentService interface {
 	
 	// GetIncidentStats получает статистику по инцидентам
 	GetIncidentStats(ctx context.Context, tenantID string) (*domain.IncidentStats, error)
+	
+	// IngestAlert открывает, обновляет или закрывает инцидент по алерту внешней системы
+	IngestAlert(ctx context.Context, alert *domain.ExternalAlert) (*domain.Incident, AlertAction, error)
 }
 
 // CheckResult представляет результат проверки
@@ -54,6 +61,8 @@ type CheckResult struct {
 	Duration     time.Duration          `json:"duration"`
 	Timestamp    time.Time              `json:"timestamp"`
 	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	// Severity серьезность, заданная источником явно; пусто - определяется по ошибке
+	Severity domain.IncidentSeverity `json:"severity,omitempty"`
 }
 
 // IncidentRepository интерфейс для работы с хранилищем инцидентов
@@ -62,6 +71,8 @@ type IncidentRepository interface {
 	GetByID(ctx context.Context, id string) (*domain.Incident, error)
 	GetByCheckAndErrorHash(ctx context.Context, checkID, errorHash string) (*domain.Incident, error)
 	GetByTenantID(ctx context.Context, tenantID string, filter *domain.IncidentFilter) ([]*domain.Incident, error)
+	// Count возвращает число инцидентов, подходящих под фильтр, без учета Limit и Offset
+	Count(ctx context.Context, tenantID string, filter *domain.IncidentFilter) (int, error)
 	Update(ctx context.Context, incident *domain.Incident) error
 	Delete(ctx context.Context, id string) error
 	GetStats(ctx context.Context, tenantID string) (*domain.IncidentStats, error)
@@ -80,6 +91,9 @@ type IncidentConfig struct {
 	
 	// Время жизни инцидента
 	IncidentTTL time.Duration `json:"incident_ttl"`
+	
+	// Clock источник времени; nil - системные часы
+	Clock clock.Clock `json:"-"`
 }
 
 // DefaultIncidentConfig возвращает конфигурацию по умолчанию
@@ -107,6 +121,7 @@ type incidentService struct {
 	logger    logger.Logger
 	validator *validation.Validator
 	producer  rabbitmq.IncidentProducerInterface
+	clock     clock.Clock
 }
 
 // NewIncidentService создает новый сервис инцидентов
@@ -125,6 +140,7 @@ func NewIncidentService(repo IncidentRepository, config *IncidentConfig, log log
 		logger:    log,
 		validator: validation.NewValidator(),
 		producer:  nil, // Producer будет установлен отдельно
+		clock:     config.Clock,
 	}
 }
 
@@ -144,6 +160,7 @@ func NewIncidentServiceWithProducer(repo IncidentRepository, config *IncidentCon
 		logger:    log,
 		validator: validation.NewValidator(),
 		producer:  producer,
+		clock:     config.Clock,
 	}
 }
 
@@ -152,6 +169,11 @@ func (s *incidentService) SetProducer(producer rabbitmq.IncidentProducerInterfac
 	s.producer = producer
 }
 
+// clk возвращает часы сервиса; системные, если часы не заданы
+func (s *incidentService) clk() clock.Clock {
+	return clock.OrReal(s.clock)
+}
+
 // ProcessCheckResult обрабатывает результат проверки
 func (s *incidentService) ProcessCheckResult(ctx context.Context, result *CheckResult) (*domain.Incident, error) {
 	// Валидация входных данных
@@ -237,18 +259,18 @@ func (s *incidentService) processSuccessfulCheck(ctx context.Context, result *Ch
 	}
 	
 	// Проверяем, достаточно ли времени прошло для автоматического разрешения
-	if time.Since(activeIncident.LastSeen) < s.config.AutoResolveTimeout {
+	if s.clk().Since(activeIncident.LastSeen) < s.config.AutoResolveTimeout {
 		// Слишком рано для автоматического разрешения
 		s.logger.Debug("Too early for auto-resolve",
 			logger.String("incident_id", activeIncident.ID),
 			logger.String("check_id", result.CheckID),
-			logger.Duration("time_since_last_seen", time.Since(activeIncident.LastSeen)),
+			logger.Duration("time_since_last_seen", s.clk().Since(activeIncident.LastSeen)),
 			logger.Duration("auto_resolve_timeout", s.config.AutoResolveTimeout))
 		return nil
 	}
 	
 	// Закрываем инцидент (status = resolved)
-	activeIncident.Resolve()
+	activeIncident.ResolveAt(s.clk().Now())
 	
 	s.logger.Info("Resolving incident on successful check",
 		logger.String("incident_id", activeIncident.ID),
@@ -272,8 +294,8 @@ func (s *incidentService) processSuccessfulCheck(ctx context.Context, result *Ch
 
 // processFailedCheck обрабатывает неудачную проверку с публикацией событий
 func (s *incidentService) processFailedCheck(ctx context.Context, result *CheckResult) error {
-	// Определяем уровень серьезности на основе сообщения об ошибке
-	severity := s.determineSeverity(result.ErrorMessage, result.Duration)
+	// Определяем уровень серьезности по правилам проверки или сообщению об ошибке
+	severity := s.resolveSeverity(result)
 	
 	// Вычисление error_hash (SHA256 от error_message)
 	errorHash := generateErrorHash(result.ErrorMessage)
@@ -322,15 +344,15 @@ func (s *incidentService) processFailedCheck(ctx context.Context, result *CheckR
 // updateExistingIncident обновляет существующий инцидент
 func (s *incidentService) updateExistingIncident(ctx context.Context, incident *domain.Incident, result *CheckResult, severity domain.IncidentSeverity) error {
 	// Обновление счетчика и времени последнего появления
-	incident.IncrementCount()
-	incident.UpdateSeverity(severity)
+	incident.IncrementCountAt(s.clk().Now())
+	incident.UpdateSeverityAt(severity, s.clk().Now())
 	
 	// Проверяем необходимость эскалации при длительных инцидентах
 	s.checkEscalation(incident)
 	
 	// Если инцидент был разрешен, повторно открываем его
 	if incident.IsResolved() {
-		incident.Reopen()
+		incident.ReopenAt(s.clk().Now())
 		s.logger.Info("Reopening resolved incident",
 			logger.String("incident_id", incident.ID),
 			logger.String("check_id", result.CheckID),
@@ -384,8 +406,8 @@ func (s *incidentService) findSimilarIncidents(ctx context.Context, checkID, ten
 // groupWithSimilarIncident группирует с похожим инцидентом
 func (s *incidentService) groupWithSimilarIncident(ctx context.Context, incident *domain.Incident, result *CheckResult, severity domain.IncidentSeverity) error {
 	// Обновляем существующий инцидент
-	incident.IncrementCount()
-	incident.UpdateSeverity(severity)
+	incident.IncrementCountAt(s.clk().Now())
+	incident.UpdateSeverityAt(severity, s.clk().Now())
 	
 	// Добавляем информацию о группировке
 	if incident.Metadata == nil {
@@ -425,7 +447,7 @@ func (s *incidentService) groupWithSimilarIncident(ctx context.Context, incident
 // createNewIncident создает новый инцидент
 func (s *incidentService) createNewIncident(ctx context.Context, result *CheckResult, severity domain.IncidentSeverity) error {
 	// Создание нового инцидента
-	newIncident := domain.NewIncident(result.CheckID, result.TenantID, severity, result.ErrorMessage)
+	newIncident := domain.NewIncidentAt(result.CheckID, result.TenantID, severity, result.ErrorMessage, s.clk().Now())
 	
 	s.logger.Info("Creating new incident",
 		logger.String("incident_id", newIncident.ID),
@@ -511,18 +533,18 @@ func (s *incidentService) resolveIncidentOnSuccess(ctx context.Context, result *
 	incident := incidents[0]
 	
 	// Проверяем, достаточно ли времени прошло для автоматического разрешения
-	if time.Since(incident.LastSeen) < s.config.AutoResolveTimeout {
+	if s.clk().Since(incident.LastSeen) < s.config.AutoResolveTimeout {
 		// Слишком рано для автоматического разрешения
 		s.logger.Debug("Too early for auto-resolve",
 			logger.String("incident_id", incident.ID),
 			logger.String("check_id", result.CheckID),
-			logger.Duration("time_since_last_seen", time.Since(incident.LastSeen)),
+			logger.Duration("time_since_last_seen", s.clk().Since(incident.LastSeen)),
 			logger.Duration("auto_resolve_timeout", s.config.AutoResolveTimeout))
 		return incident, nil
 	}
 	
 	// Разрешаем инцидент
-	incident.Resolve()
+	incident.ResolveAt(s.clk().Now())
 	
 	s.logger.Info("Auto-resolving incident",
 		logger.String("incident_id", incident.ID),
@@ -538,6 +560,9 @@ func (s *incidentService) resolveIncidentOnSuccess(ctx context.Context, result *
 		return nil, errors.Wrap(err, errors.ErrInternal, "failed to resolve incident")
 	}
 	
+	// Публикация события incident.resolved
+	s.publishIncidentEvent(ctx, "incident.resolved", incident, result)
+	
 	return incident, nil
 }
 
@@ -546,8 +571,8 @@ func (s *incidentService) createOrUpdateIncident(ctx context.Context, result *Ch
 	var newIncident *domain.Incident
 	var err error
 	
-	// Определяем уровень серьезности на основе сообщения об ошибке
-	severity := s.determineSeverity(result.ErrorMessage, result.Duration)
+	// Определяем уровень серьезности по правилам проверки, явной серьезности источника или сообщению об ошибке
+	severity := s.resolveSeverity(result)
 	
 	s.logger.Debug("Creating or updating incident",
 		logger.String("check_id", result.CheckID),
@@ -556,7 +581,7 @@ func (s *incidentService) createOrUpdateIncident(ctx context.Context, result *Ch
 		logger.String("severity", string(severity)))
 	
 	// Создаем новый инцидент
-	newIncident = domain.NewIncident(result.CheckID, result.TenantID, severity, result.ErrorMessage)
+	newIncident = domain.NewIncidentAt(result.CheckID, result.TenantID, severity, result.ErrorMessage, s.clk().Now())
 	
 	// Ищем существующий инцидент по check_id и error_hash
 	existingIncident, err := s.repo.GetByCheckAndErrorHash(ctx, result.CheckID, newIncident.ErrorHash)
@@ -570,6 +595,7 @@ func (s *incidentService) createOrUpdateIncident(ctx context.Context, result *Ch
 	
 	if existingIncident != nil {
 		// Инцидент существует, обновляем его
+		attachFailureDetails(existingIncident, result)
 		err := s.updateExistingIncident(ctx, existingIncident, result, severity)
 		if err != nil {
 			return nil, err
@@ -578,7 +604,8 @@ func (s *incidentService) createOrUpdateIncident(ctx context.Context, result *Ch
 	}
 	
 	// Создаем новый инцидент
-	newIncident = domain.NewIncident(result.CheckID, result.TenantID, severity, result.ErrorMessage)
+	newIncident = domain.NewIncidentAt(result.CheckID, result.TenantID, severity, result.ErrorMessage, s.clk().Now())
+	attachFailureDetails(newIncident, result)
 	
 	err = s.repo.Create(ctx, newIncident)
 	if err != nil {
@@ -591,6 +618,50 @@ func (s *incidentService) createOrUpdateIncident(ctx context.Context, result *Ch
 	return newIncident, nil
 }
 
+// FailureDetailsKey ключ метаданных инцидента с диагностикой последней неудачной проверки
+const FailureDetailsKey = "failure_details"
+
+// attachFailureDetails сохраняет владельца проверки, переопределение уведомлений и строковые детали неудачной проверки
+// (заголовки, фрагмент тела, HAR) в метаданных инцидента; более свежие детали заменяют предыдущие
+func attachFailureDetails(incident *domain.Incident, result *CheckResult) {
+	incident.SetOwnership(result.Metadata)
+	incident.SetNotificationOverrides(result.Metadata)
+	incident.SetCorrelation(result.Metadata)
+	
+	details := make(map[string]string)
+	for key, value := range result.Metadata {
+		if str, ok := value.(string); ok {
+			details[key] = str
+		}
+	}
+	if len(details) == 0 {
+		return
+	}
+
+	if incident.Metadata == nil {
+		incident.Metadata = make(map[string]interface{})
+	}
+	incident.Metadata[FailureDetailsKey] = details
+}
+
+// SeverityHintKey ключ метаданных результата с серьезностью, которую задало правило
+// классификации проверки (config.severity_rules в Core Service)
+const SeverityHintKey = "severity_hint"
+
+// resolveSeverity определяет серьезность неудачной проверки. Правило проверки важнее явной
+// серьезности источника, а ключевые слова в ошибке используются, только если нет ни того, ни другого
+func (s *incidentService) resolveSeverity(result *CheckResult) domain.IncidentSeverity {
+	if hint, ok := result.Metadata[SeverityHintKey].(string); ok {
+		if severity := domain.IncidentSeverity(hint); domain.IsValidSeverity(severity) {
+			return severity
+		}
+	}
+	if domain.IsValidSeverity(result.Severity) {
+		return result.Severity
+	}
+	return s.determineSeverity(result.ErrorMessage, result.Duration)
+}
+
 // determineSeverity определяет уровень серьезности на основе ошибки и длительности
 func (s *incidentService) determineSeverity(errorMessage string, duration time.Duration) domain.IncidentSeverity {
 	// Определяем серьезность на основе ключевых слов в сообщении об ошибке
@@ -625,7 +696,7 @@ func (s *incidentService) checkEscalation(incident *domain.Incident) {
 	
 	// Этап 1: Проверяем эскалацию на основе времени существования
 	if escalationTimeout, exists := s.config.EscalationTimeouts[incident.Severity]; exists {
-		if time.Since(incident.FirstSeen) > escalationTimeout {
+		if s.clk().Since(incident.FirstSeen) > escalationTimeout {
 			s.escalateSeverity(incident)
 			escalated = true
 			s.logger.Info("Escalating incident due to timeout",
@@ -680,7 +751,7 @@ func (s *incidentService) checkEscalation(incident *domain.Incident) {
 		// Добавляем запись в историю эскалации
 		history := incident.Metadata["escalation_history"].([]interface{})
 		incident.Metadata["escalation_history"] = append(history, map[string]interface{}{
-			"timestamp":        time.Now(),
+			"timestamp":        s.clk().Now(),
 			"from_severity":    string(originalSeverity),
 			"to_severity":      string(incident.Severity),
 			"incident_duration": incident.GetDuration(),
@@ -725,7 +796,7 @@ func (s *incidentService) getEscalationReason(originalSeverity domain.IncidentSe
 	
 	// Затем проверяем эскалацию на основе времени
 	if escalationTimeout, exists := s.config.EscalationTimeouts[originalSeverity]; exists {
-		if time.Since(incident.FirstSeen) > escalationTimeout {
+		if s.clk().Since(incident.FirstSeen) > escalationTimeout {
 			return "timeout"
 		}
 	}
@@ -744,9 +815,9 @@ func (s *incidentService) getEscalationReason(originalSeverity domain.IncidentSe
 func (s *incidentService) escalateSeverity(incident *domain.Incident) {
 	switch incident.Severity {
 	case domain.IncidentSeverityWarning:
-		incident.UpdateSeverity(domain.IncidentSeverityError)
+		incident.UpdateSeverityAt(domain.IncidentSeverityError, s.clk().Now())
 	case domain.IncidentSeverityError:
-		incident.UpdateSeverity(domain.IncidentSeverityCritical)
+		incident.UpdateSeverityAt(domain.IncidentSeverityCritical, s.clk().Now())
 	case domain.IncidentSeverityCritical:
 		// Уже максимальный уровень
 	}
@@ -806,6 +877,26 @@ func (s *incidentService) GetIncidents(ctx context.Context, filter *domain.Incid
 	return incidents, nil
 }
 
+// CountIncidents возвращает число инцидентов, подходящих под фильтр, без учета пагинации
+func (s *incidentService) CountIncidents(ctx context.Context, filter *domain.IncidentFilter) (int, error) {
+	if filter.TenantID == nil {
+		return 0, errors.New(errors.ErrValidation, "tenant_id is required")
+	}
+	if err := s.validator.ValidateUUID(*filter.TenantID, "tenant_id"); err != nil {
+		return 0, errors.Wrap(err, errors.ErrValidation, "tenant ID validation failed")
+	}
+	
+	count, err := s.repo.Count(ctx, *filter.TenantID, filter)
+	if err != nil {
+		s.logger.Error("Failed to count incidents",
+			logger.String("tenant_id", *filter.TenantID),
+			logger.Error(err))
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to count incidents")
+	}
+	
+	return count, nil
+}
+
 // AcknowledgeIncident подтверждает инцидент
 func (s *incidentService) AcknowledgeIncident(ctx context.Context, id string) error {
 	if err := s.validator.ValidateUUID(id, "incident_id"); err != nil {
@@ -826,7 +917,7 @@ func (s *incidentService) AcknowledgeIncident(ctx context.Context, id string) er
 		return errors.Wrap(err, errors.ErrInternal, "failed to get incident")
 	}
 	
-	incident.Acknowledge()
+	incident.AcknowledgeAt(s.clk().Now())
 	
 	s.logger.Info("Incident acknowledged",
 		logger.String("incident_id", id),
@@ -855,7 +946,7 @@ func (s *incidentService) ResolveIncident(ctx context.Context, id string) error
 		return errors.Wrap(err, errors.ErrInternal, "failed to get incident")
 	}
 	
-	incident.Resolve()
+	incident.ResolveAt(s.clk().Now())
 	
 	s.logger.Info("Incident resolved",
 		logger.String("incident_id", id),
@@ -998,7 +1089,7 @@ func (s *incidentService) publishIncidentEvent(ctx context.Context, eventType st
 			"first_seen":      incident.FirstSeen,
 			"last_seen":       incident.LastSeen,
 			"error_hash":      incident.ErrorHash,
-			"timestamp":       time.Now(),
+			"timestamp":       s.clk().Now(),
 			"service":         "incident-manager",
 		}
 		
@@ -1061,7 +1152,7 @@ func (s *incidentService) UpdateIncident(ctx context.Context, incident *domain.I
 	}
 	
 	// Обновляем время изменения
-	incident.UpdatedAt = time.Now()
+	incident.UpdatedAt = s.clk().Now()
 	
 	// Сохраняем изменения
 	err := s.repo.Update(ctx, incident)
@@ -1149,6 +1240,23 @@ func (s *incidentService) GetIncidentHistory(ctx context.Context, incidentID str
 		}
 	}
 	
+	// Добавляем напоминания о неподтвержденном инциденте
+	for _, reminder := range incident.Reminders() {
+		history = append(history, &domain.IncidentEvent{
+			ID:          fmt.Sprintf("%s-reminder-%d", incidentID, reminder.Number),
+			IncidentID:  incidentID,
+			EventType:   EventIncidentReminder,
+			NewStatus:   incident.Status,
+			OldSeverity: reminder.EscalatedFrom,
+			NewSeverity: reminder.Severity,
+			Message:     fmt.Sprintf("Reminder %d sent for unacknowledged incident", reminder.Number),
+			Metadata: map[string]interface{}{
+				"reminder_number": reminder.Number,
+			},
+			CreatedAt: reminder.SentAt,
+		})
+	}
+	
 	s.logger.Debug("Incident history retrieved",
 		logger.String("incident_id", incidentID),
 		logger.Int("events_count", len(history)))
@@ -41,6 +41,11 @@ func (m *MockIncidentRepository) GetByTenantID(ctx context.Context, tenantID str
 	return args.Get(0).([]*domain.Incident), args.Error(1)
 }
 
+func (m *MockIncidentRepository) Count(ctx context.Context, tenantID string, filter *domain.IncidentFilter) (int, error) {
+	args := m.Called(ctx, tenantID, filter)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockIncidentRepository) Update(ctx context.Context, incident *domain.Incident) error {
 	args := m.Called(ctx, incident)
 	return args.Error(0)
@@ -175,6 +180,98 @@ func TestIncidentService_ProcessCheckResult_Error_NewIncident(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestIncidentService_ProcessCheckResult_Error_ExplicitSeverity(t *testing.T) {
+	repo := &MockIncidentRepository{}
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+	service := NewIncidentService(repo, DefaultIncidentConfig(), log)
+	
+	result := &CheckResult{
+		CheckID:      "550e8400-e29b-41d4-a716-446655440000",
+		TenantID:     "550e8400-e29b-41d4-a716-446655440001",
+		IsSuccess:    false,
+		ErrorMessage: "Connection timeout",
+		Timestamp:    time.Now(),
+		Severity:     domain.IncidentSeverityCritical,
+	}
+	
+	repo.On("GetByCheckAndErrorHash", mock.Anything, result.CheckID, mock.AnythingOfType("string")).
+		Return(nil, nil)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Incident")).Return(nil)
+	
+	incident, err := service.ProcessCheckResult(context.Background(), result)
+	
+	require.NoError(t, err)
+	assert.Equal(t, domain.IncidentSeverityCritical, incident.Severity)
+	repo.AssertExpectations(t)
+}
+
+func TestIncidentService_ProcessCheckResult_Error_SeverityHint(t *testing.T) {
+	repo := &MockIncidentRepository{}
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+	service := NewIncidentService(repo, DefaultIncidentConfig(), log)
+	
+	// Правило проверки "timeout => warning" важнее и ключевых слов, и серьезности источника
+	result := &CheckResult{
+		CheckID:      "550e8400-e29b-41d4-a716-446655440000",
+		TenantID:     "550e8400-e29b-41d4-a716-446655440001",
+		IsSuccess:    false,
+		ErrorMessage: "Database connection timeout",
+		Timestamp:    time.Now(),
+		Metadata:     map[string]interface{}{SeverityHintKey: "warning", "severity_rule": "timeout => warning"},
+		Severity:     domain.IncidentSeverityCritical,
+	}
+	
+	repo.On("GetByCheckAndErrorHash", mock.Anything, result.CheckID, mock.AnythingOfType("string")).
+		Return(nil, nil)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Incident")).Return(nil)
+	
+	incident, err := service.ProcessCheckResult(context.Background(), result)
+	
+	require.NoError(t, err)
+	assert.Equal(t, domain.IncidentSeverityWarning, incident.Severity)
+	
+	// Неизвестная серьезность в подсказке игнорируется
+	result.Metadata[SeverityHintKey] = "fatal"
+	assert.Equal(t, domain.IncidentSeverityCritical, service.(*incidentService).resolveSeverity(result))
+	result.Severity = ""
+	assert.Equal(t, domain.IncidentSeverityCritical, service.(*incidentService).resolveSeverity(result))
+	repo.AssertExpectations(t)
+}
+
+func TestIncidentService_ProcessCheckResult_Error_AttachesFailureDetails(t *testing.T) {
+	repo := &MockIncidentRepository{}
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+	service := NewIncidentService(repo, DefaultIncidentConfig(), log)
+	
+	result := &CheckResult{
+		CheckID:      "550e8400-e29b-41d4-a716-446655440000",
+		TenantID:     "550e8400-e29b-41d4-a716-446655440001",
+		IsSuccess:    false,
+		ErrorMessage: "status code mismatch: expected 200, got 503",
+		Timestamp:    time.Now(),
+		Metadata: map[string]interface{}{
+			"capture_body_snippet": "upstream is down",
+			"capture_headers":      `{"Retry-After":"30"}`,
+		},
+	}
+	
+	repo.On("GetByCheckAndErrorHash", mock.Anything, result.CheckID, mock.AnythingOfType("string")).
+		Return(nil, nil)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Incident")).Return(nil)
+	
+	incident, err := service.ProcessCheckResult(context.Background(), result)
+	
+	require.NoError(t, err)
+	details, ok := incident.Metadata[FailureDetailsKey].(map[string]string)
+	require.True(t, ok)
+	assert.Equal(t, "upstream is down", details["capture_body_snippet"])
+	assert.Equal(t, `{"Retry-After":"30"}`, details["capture_headers"])
+	repo.AssertExpectations(t)
+}
+
 func TestIncidentService_ProcessCheckResult_Error_UpdateExistingIncident(t *testing.T) {
 	repo := &MockIncidentRepository{}
 	log, err := logger.NewLogger("test", "debug", "incident-service", false)
@@ -336,6 +433,29 @@ func TestIncidentService_GetIncidents_MissingTenantID(t *testing.T) {
 	assert.Contains(t, err.Error(), "tenant_id is required")
 }
 
+func TestIncidentService_CountIncidents(t *testing.T) {
+	repo := &MockIncidentRepository{}
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+	service := NewIncidentService(repo, DefaultIncidentConfig(), log)
+	
+	tenantID := "550e8400-e29b-41d4-a716-446655440001"
+	repo.On("Count", mock.Anything, tenantID, mock.AnythingOfType("*domain.IncidentFilter")).
+		Return(42, nil)
+	
+	count, err := service.CountIncidents(context.Background(), &domain.IncidentFilter{
+		TenantID: &tenantID,
+		Limit:    10,
+	})
+	
+	assert.NoError(t, err)
+	assert.Equal(t, 42, count)
+	repo.AssertExpectations(t)
+	
+	_, err = service.CountIncidents(context.Background(), &domain.IncidentFilter{})
+	assert.Error(t, err)
+}
+
 func TestIncidentService_AcknowledgeIncident(t *testing.T) {
 	repo := &MockIncidentRepository{}
 	log, err := logger.NewLogger("test", "debug", "incident-service", false)
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/producer/rabbitmq"
+)
+
+// EventIncidentReminder событие напоминания о неподтвержденном инциденте
+const EventIncidentReminder = "incident.reminder"
+
+// ParseReminderPolicy разбирает политику напоминаний из JSON вида
+// {"interval": "30m", "max_reminders": 3, "escalate": true}. Пустые данные дают политику по умолчанию
+func ParseReminderPolicy(data []byte) (domain.ReminderPolicy, error) {
+	if len(data) == 0 {
+		return domain.DefaultReminderPolicy(), nil
+	}
+
+	var policy domain.ReminderPolicy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return domain.ReminderPolicy{}, errors.Wrap(err, errors.ErrValidation, "failed to parse reminder policy")
+	}
+	if err := policy.Validate(); err != nil {
+		return domain.ReminderPolicy{}, errors.Wrap(err, errors.ErrValidation, "invalid reminder policy")
+	}
+	return policy, nil
+}
+
+// ReminderScheduler повторно уведомляет об открытых инцидентах, которые не подтверждены
+// в течение интервала политики. Напоминание фиксируется в истории инцидента и публикуется
+// событием incident.reminder; при эскалации серьезность повышается на ступень
+type ReminderScheduler struct {
+	repo     SLARepository
+	policy   domain.ReminderPolicy
+	producer rabbitmq.IncidentProducerInterface
+	logger   logger.Logger
+	now      func() time.Time
+}
+
+// NewReminderScheduler создает планировщик напоминаний. producer может быть nil, тогда
+// напоминания только фиксируются в истории
+func NewReminderScheduler(repo SLARepository, policy domain.ReminderPolicy, producer rabbitmq.IncidentProducerInterface, log logger.Logger) *ReminderScheduler {
+	return &ReminderScheduler{
+		repo:     repo,
+		policy:   policy,
+		producer: producer,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// WithClock задает источник времени вместо системных часов
+func (s *ReminderScheduler) WithClock(c clock.Clock) *ReminderScheduler {
+	s.now = c.Now
+	return s
+}
+
+// Run отправляет напоминания с заданным интервалом до отмены контекста
+func (s *ReminderScheduler) Run(ctx context.Context, interval time.Duration) {
+	if !s.policy.Enabled() {
+		s.logger.Info("Incident reminders disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.SendReminders(ctx); err != nil {
+			s.logger.Error("Incident reminder check failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendReminders отправляет напоминания по инцидентам, срок которых наступил, и возвращает
+// отправленные напоминания. За один проход по инциденту отправляется не более одного
+func (s *ReminderScheduler) SendReminders(ctx context.Context) ([]domain.Reminder, error) {
+	incidents, err := s.repo.ListActive(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list active incidents")
+	}
+
+	now := s.now()
+	var sent []domain.Reminder
+
+	for _, incident := range incidents {
+		dueAt, ok := s.policy.NextReminderAt(incident)
+		if !ok || now.Before(dueAt) {
+			continue
+		}
+
+		reminder := domain.Reminder{Severity: incident.Severity, SentAt: now}
+		if s.policy.Escalate {
+			if next, ok := domain.NextSeverity(incident.Severity); ok {
+				reminder.EscalatedFrom = incident.Severity
+				reminder.Severity = next
+				incident.UpdateSeverity(next)
+			}
+		}
+		reminder = incident.AddReminder(reminder)
+
+		if err := s.repo.Update(ctx, incident); err != nil {
+			s.logger.Error("Failed to record incident reminder",
+				logger.String("incident_id", incident.ID),
+				logger.Error(err))
+			continue
+		}
+		s.publishReminder(ctx, incident, reminder)
+		sent = append(sent, reminder)
+	}
+
+	return sent, nil
+}
+
+// publishReminder отправляет событие напоминания в notification-service
+func (s *ReminderScheduler) publishReminder(ctx context.Context, incident *domain.Incident, reminder domain.Reminder) {
+	s.logger.Info("Sending incident reminder",
+		logger.String("incident_id", incident.ID),
+		logger.String("tenant_id", incident.TenantID),
+		logger.String("severity", string(incident.Severity)),
+		logger.Int("reminder", reminder.Number),
+		logger.Int("max_reminders", s.policy.MaxReminders))
+
+	if s.producer == nil || !s.producer.IsConnected() {
+		s.logger.Warn("RabbitMQ producer not available, incident reminder not published",
+			logger.String("incident_id", incident.ID))
+		return
+	}
+
+	metadata := map[string]interface{}{
+		"reminder_number": strconv.Itoa(reminder.Number),
+		"reminder_max":    strconv.Itoa(s.policy.MaxReminders),
+	}
+	if reminder.EscalatedFrom != "" {
+		metadata["escalated_from"] = string(reminder.EscalatedFrom)
+	}
+	result := &rabbitmq.CheckResult{
+		CheckID:      incident.CheckID,
+		TenantID:     incident.TenantID,
+		ErrorMessage: incident.ErrorMessage,
+		Duration:     reminder.SentAt.Sub(incident.FirstSeen),
+		Timestamp:    reminder.SentAt,
+		Metadata:     metadata,
+	}
+	if err := s.producer.PublishIncidentEventWithRetry(ctx, EventIncidentReminder, incident, result); err != nil {
+		s.logger.Error("Failed to publish incident reminder",
+			logger.String("incident_id", incident.ID),
+			logger.Error(err))
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+func newTestReminderScheduler(t *testing.T, repo SLARepository, policy domain.ReminderPolicy, producer *recordingProducer, now time.Time) *ReminderScheduler {
+	t.Helper()
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+
+	scheduler := NewReminderScheduler(repo, policy, producer, log)
+	scheduler.now = func() time.Time { return now }
+	return scheduler
+}
+
+func TestParseReminderPolicy(t *testing.T) {
+	policy, err := ParseReminderPolicy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultReminderPolicy(), policy)
+
+	policy, err = ParseReminderPolicy([]byte(`{"interval": "10m", "max_reminders": 5, "escalate": true}`))
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReminderPolicy{Interval: 10 * time.Minute, MaxReminders: 5, Escalate: true}, policy)
+
+	_, err = ParseReminderPolicy([]byte(`{"interval": "5s", "max_reminders": 1}`))
+	var appErr *errors.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, errors.ErrValidation, appErr.Code)
+}
+
+func TestReminderScheduler_SendReminders(t *testing.T) {
+	now := time.Now()
+	stale := domain.NewIncident("check-1", "tenant-1", domain.IncidentSeverityWarning, "timeout")
+	stale.ID = "incident-1"
+	stale.FirstSeen = now.Add(-20 * time.Minute)
+
+	acknowledged := domain.NewIncident("check-2", "tenant-1", domain.IncidentSeverityError, "timeout")
+	acknowledged.ID = "incident-2"
+	acknowledged.FirstSeen = now.Add(-time.Hour)
+	acknowledged.Acknowledge()
+
+	fresh := domain.NewIncident("check-3", "tenant-1", domain.IncidentSeverityError, "slow")
+	fresh.ID = "incident-3"
+
+	repo := &MockSLARepository{}
+	repo.On("ListActive", mock.Anything).Return([]*domain.Incident{stale, acknowledged, fresh}, nil)
+	repo.On("Update", mock.Anything, stale).Return(nil).Once()
+
+	producer := &recordingProducer{}
+	policy := domain.ReminderPolicy{Interval: 15 * time.Minute, MaxReminders: 2, Escalate: true}
+	scheduler := newTestReminderScheduler(t, repo, policy, producer, now)
+
+	reminders, err := scheduler.SendReminders(context.Background())
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	assert.Equal(t, 1, reminders[0].Number)
+	assert.Equal(t, domain.IncidentSeverityWarning, reminders[0].EscalatedFrom)
+	assert.Equal(t, domain.IncidentSeverityError, stale.Severity)
+
+	assert.Equal(t, []string{EventIncidentReminder}, producer.events)
+	assert.Equal(t, "1", producer.results[0].Metadata["reminder_number"])
+	assert.Equal(t, "2", producer.results[0].Metadata["reminder_max"])
+	assert.Equal(t, "warning", producer.results[0].Metadata["escalated_from"])
+
+	// Повторный проход до истечения интервала напоминаний не отправляет
+	reminders, err = scheduler.SendReminders(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, reminders)
+	assert.Len(t, producer.events, 1)
+	repo.AssertExpectations(t)
+}
+
+func TestReminderScheduler_SendRemindersUpdateFailure(t *testing.T) {
+	now := time.Now()
+	incident := domain.NewIncident("check-1", "tenant-1", domain.IncidentSeverityCritical, "timeout")
+	incident.FirstSeen = now.Add(-time.Hour)
+
+	repo := &MockSLARepository{}
+	repo.On("ListActive", mock.Anything).Return([]*domain.Incident{incident}, nil)
+	repo.On("Update", mock.Anything, incident).Return(assert.AnError)
+
+	producer := &recordingProducer{}
+	scheduler := newTestReminderScheduler(t, repo, domain.DefaultReminderPolicy(), producer, now)
+
+	reminders, err := scheduler.SendReminders(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, reminders)
+	assert.Empty(t, producer.events, "reminder must not be sent before it is stored")
+}
+
+func TestIncidentService_GetIncidentHistory_Reminders(t *testing.T) {
+	incident := domain.NewIncident("check-1", "tenant-1", domain.IncidentSeverityWarning, "timeout")
+	incident.ID = "incident-1"
+	sentAt := incident.FirstSeen.Add(30 * time.Minute)
+	incident.AddReminder(domain.Reminder{Severity: domain.IncidentSeverityError, EscalatedFrom: domain.IncidentSeverityWarning, SentAt: sentAt})
+
+	repo := &MockIncidentRepository{}
+	repo.On("GetByID", mock.Anything, "incident-1").Return(incident, nil)
+	svc := NewIncidentService(repo, nil, nil)
+
+	history, err := svc.GetIncidentHistory(context.Background(), "incident-1")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, EventIncidentReminder, history[1].EventType)
+	assert.Equal(t, domain.IncidentSeverityWarning, history[1].OldSeverity)
+	assert.Equal(t, domain.IncidentSeverityError, history[1].NewSeverity)
+	assert.Equal(t, sentAt, history[1].CreatedAt)
+}
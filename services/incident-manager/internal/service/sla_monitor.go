@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/producer/rabbitmq"
+)
+
+// EventIncidentSLABreached событие нарушения SLA, доставляемое в notification-service
+const EventIncidentSLABreached = "incident.sla_breached"
+
+// SLAPolicyProvider возвращает политику SLA тенанта
+type SLAPolicyProvider interface {
+	SLAPolicy(ctx context.Context, tenantID string) (domain.SLAPolicy, error)
+}
+
+// StaticSLAPolicies политики SLA из конфигурации: общая политика и переопределения тенантов.
+// Переопределение заменяет цели только для указанных в нем уровней серьезности
+type StaticSLAPolicies struct {
+	Default domain.SLAPolicy            `json:"default" yaml:"default"`
+	Tenants map[string]domain.SLAPolicy `json:"tenants" yaml:"tenants"`
+}
+
+// SLAPolicy возвращает политику тенанта поверх общей политики
+func (p *StaticSLAPolicies) SLAPolicy(ctx context.Context, tenantID string) (domain.SLAPolicy, error) {
+	policy := make(domain.SLAPolicy, len(p.Default))
+	for severity, target := range p.Default {
+		policy[severity] = target
+	}
+	for severity, target := range p.Tenants[tenantID] {
+		policy[severity] = target
+	}
+	return policy, nil
+}
+
+// ParseSLAPolicies разбирает политики SLA из JSON вида
+// {"default": {"critical": {"acknowledge_within": "5m", "resolve_within": "1h"}}, "tenants": {...}}.
+// Уровни, не указанные в default, берутся из domain.DefaultSLAPolicy
+func ParseSLAPolicies(data []byte) (*StaticSLAPolicies, error) {
+	policies := &StaticSLAPolicies{Default: domain.DefaultSLAPolicy()}
+	if len(data) == 0 {
+		return policies, nil
+	}
+
+	var parsed StaticSLAPolicies
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "failed to parse SLA policies")
+	}
+	for severity, target := range parsed.Default {
+		policies.Default[severity] = target
+	}
+	policies.Tenants = parsed.Tenants
+
+	if err := policies.Validate(); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// Validate проверяет общую политику и переопределения тенантов
+func (p *StaticSLAPolicies) Validate() error {
+	if err := p.Default.Validate(); err != nil {
+		return errors.Wrap(err, errors.ErrValidation, "invalid default SLA policy")
+	}
+	for tenantID, policy := range p.Tenants {
+		if err := policy.Validate(); err != nil {
+			return errors.Wrap(err, errors.ErrValidation, "invalid SLA policy").
+				WithDetails("tenant_id: " + tenantID)
+		}
+	}
+	return nil
+}
+
+// SLARepository хранилище инцидентов для таймеров SLA
+type SLARepository interface {
+	// ListActive возвращает неразрешенные инциденты всех тенантов
+	ListActive(ctx context.Context) ([]*domain.Incident, error)
+	GetByTenantID(ctx context.Context, tenantID string, filter *domain.IncidentFilter) ([]*domain.Incident, error)
+	Update(ctx context.Context, incident *domain.Incident) error
+}
+
+// SLAMonitor отслеживает сроки подтверждения и закрытия инцидентов. При нарушении срока
+// инцидент помечается, а в notification-service публикуется событие incident.sla_breached
+type SLAMonitor struct {
+	repo     SLARepository
+	policies SLAPolicyProvider
+	producer rabbitmq.IncidentProducerInterface
+	logger   logger.Logger
+	now      func() time.Time
+}
+
+// NewSLAMonitor создает монитор SLA. producer может быть nil, тогда нарушения только помечаются
+func NewSLAMonitor(repo SLARepository, policies SLAPolicyProvider, producer rabbitmq.IncidentProducerInterface, log logger.Logger) *SLAMonitor {
+	return &SLAMonitor{
+		repo:     repo,
+		policies: policies,
+		producer: producer,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// WithClock задает источник времени вместо системных часов
+func (m *SLAMonitor) WithClock(c clock.Clock) *SLAMonitor {
+	m.now = c.Now
+	return m
+}
+
+// Run проверяет сроки SLA с заданным интервалом до отмены контекста
+func (m *SLAMonitor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.CheckBreaches(ctx); err != nil {
+			m.logger.Error("SLA check failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckBreaches помечает инциденты с истекшими сроками SLA и возвращает новые нарушения.
+// Каждое нарушение фиксируется один раз, повторные проходы его не дублируют
+func (m *SLAMonitor) CheckBreaches(ctx context.Context) ([]domain.SLABreach, error) {
+	incidents, err := m.repo.ListActive(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list active incidents")
+	}
+
+	now := m.now()
+	policies := make(map[string]domain.SLAPolicy)
+	var breaches []domain.SLABreach
+
+	for _, incident := range incidents {
+		policy, ok := policies[incident.TenantID]
+		if !ok {
+			policy, err = m.policies.SLAPolicy(ctx, incident.TenantID)
+			if err != nil {
+				m.logger.Warn("Failed to load SLA policy",
+					logger.String("tenant_id", incident.TenantID),
+					logger.Error(err))
+				continue
+			}
+			policies[incident.TenantID] = policy
+		}
+
+		var marked []domain.SLABreach
+		for _, kind := range []domain.SLAKind{domain.SLAKindAcknowledge, domain.SLAKindResolve} {
+			deadline, ok := policy.Deadline(incident, kind)
+			if !ok || now.Before(deadline) {
+				continue
+			}
+			breach := domain.SLABreach{
+				Kind:       kind,
+				Severity:   incident.Severity,
+				Target:     policy[incident.Severity].Within(kind),
+				Deadline:   deadline,
+				DetectedAt: now,
+			}
+			if incident.MarkSLABreached(breach) {
+				marked = append(marked, breach)
+			}
+		}
+		if len(marked) == 0 {
+			continue
+		}
+
+		if err := m.repo.Update(ctx, incident); err != nil {
+			m.logger.Error("Failed to mark SLA breach",
+				logger.String("incident_id", incident.ID),
+				logger.Error(err))
+			continue
+		}
+		for _, breach := range marked {
+			m.publishBreach(ctx, incident, breach)
+		}
+		breaches = append(breaches, marked...)
+	}
+
+	return breaches, nil
+}
+
+// publishBreach отправляет событие нарушения SLA в notification-service
+func (m *SLAMonitor) publishBreach(ctx context.Context, incident *domain.Incident, breach domain.SLABreach) {
+	m.logger.Warn("Incident SLA breached",
+		logger.String("incident_id", incident.ID),
+		logger.String("tenant_id", incident.TenantID),
+		logger.String("severity", string(incident.Severity)),
+		logger.String("sla", string(breach.Kind)),
+		logger.Duration("target", breach.Target))
+
+	if m.producer == nil || !m.producer.IsConnected() {
+		m.logger.Warn("RabbitMQ producer not available, SLA breach not published",
+			logger.String("incident_id", incident.ID))
+		return
+	}
+
+	result := &rabbitmq.CheckResult{
+		CheckID:      incident.CheckID,
+		TenantID:     incident.TenantID,
+		ErrorMessage: incident.ErrorMessage,
+		Duration:     breach.DetectedAt.Sub(incident.FirstSeen),
+		Timestamp:    breach.DetectedAt,
+		Metadata: map[string]interface{}{
+			"sla":          string(breach.Kind),
+			"sla_target":   breach.Target.String(),
+			"sla_deadline": breach.Deadline,
+		},
+	}
+	if err := m.producer.PublishIncidentEventWithRetry(ctx, EventIncidentSLABreached, incident, result); err != nil {
+		m.logger.Error("Failed to publish SLA breach",
+			logger.String("incident_id", incident.ID),
+			logger.Error(err))
+	}
+}
+
+// Report считает MTTA, MTTR и нарушения SLA по инцидентам тенанта, обнаруженным за период
+func (m *SLAMonitor) Report(ctx context.Context, tenantID string, from, to time.Time) (*domain.SLAReport, error) {
+	if !from.Before(to) {
+		return nil, errors.New(errors.ErrValidation, "report period start must be before its end")
+	}
+
+	incidents, err := m.repo.GetByTenantID(ctx, tenantID, &domain.IncidentFilter{
+		TenantID: &tenantID,
+		From:     &from,
+		To:       &to,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list incidents")
+	}
+	return domain.BuildSLAReport(tenantID, from, to, incidents), nil
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/producer/rabbitmq"
+)
+
+// MockSLARepository мок хранилища инцидентов для таймеров SLA
+type MockSLARepository struct {
+	mock.Mock
+}
+
+func (m *MockSLARepository) ListActive(ctx context.Context) ([]*domain.Incident, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Incident), args.Error(1)
+}
+
+func (m *MockSLARepository) GetByTenantID(ctx context.Context, tenantID string, filter *domain.IncidentFilter) ([]*domain.Incident, error) {
+	args := m.Called(ctx, tenantID, filter)
+	return args.Get(0).([]*domain.Incident), args.Error(1)
+}
+
+func (m *MockSLARepository) Update(ctx context.Context, incident *domain.Incident) error {
+	args := m.Called(ctx, incident)
+	return args.Error(0)
+}
+
+// recordingProducer запоминает опубликованные события
+type recordingProducer struct {
+	events  []string
+	results []*rabbitmq.CheckResult
+}
+
+func (p *recordingProducer) PublishIncidentEvent(ctx context.Context, eventType string, incident *domain.Incident, result *rabbitmq.CheckResult) error {
+	p.events = append(p.events, eventType)
+	p.results = append(p.results, result)
+	return nil
+}
+
+func (p *recordingProducer) PublishIncidentEventWithRetry(ctx context.Context, eventType string, incident *domain.Incident, result *rabbitmq.CheckResult) error {
+	return p.PublishIncidentEvent(ctx, eventType, incident, result)
+}
+
+func (p *recordingProducer) Close() error      { return nil }
+func (p *recordingProducer) IsConnected() bool { return true }
+
+func newTestSLAMonitor(t *testing.T, repo SLARepository, policies SLAPolicyProvider, producer rabbitmq.IncidentProducerInterface, now time.Time) *SLAMonitor {
+	t.Helper()
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+
+	monitor := NewSLAMonitor(repo, policies, producer, log)
+	monitor.now = func() time.Time { return now }
+	return monitor
+}
+
+func TestParseSLAPolicies(t *testing.T) {
+	policies, err := ParseSLAPolicies(nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultSLAPolicy(), policies.Default)
+
+	policies, err = ParseSLAPolicies([]byte(`{
+		"default": {"critical": {"acknowledge_within": "2m", "resolve_within": "30m"}},
+		"tenants": {"tenant-gold": {"critical": {"acknowledge_within": "1m", "resolve_within": "15m"}}}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, policies.Default[domain.IncidentSeverityCritical].AcknowledgeWithin)
+	assert.Equal(t, time.Hour, policies.Default[domain.IncidentSeverityWarning].AcknowledgeWithin)
+
+	gold, err := policies.SLAPolicy(context.Background(), "tenant-gold")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, gold[domain.IncidentSeverityCritical].AcknowledgeWithin)
+	assert.Equal(t, 15*time.Minute, gold[domain.IncidentSeverityError].AcknowledgeWithin)
+
+	_, err = ParseSLAPolicies([]byte(`{"tenants": {"tenant-1": {"fatal": {"resolve_within": "1h"}}}}`))
+	assert.Error(t, err)
+	_, err = ParseSLAPolicies([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestSLAMonitor_CheckBreaches(t *testing.T) {
+	now := time.Now()
+	critical := domain.NewIncident("check-1", "tenant-1", domain.IncidentSeverityCritical, "timeout")
+	critical.ID = "incident-1"
+	critical.FirstSeen = now.Add(-10 * time.Minute)
+
+	acknowledged := domain.NewIncident("check-2", "tenant-1", domain.IncidentSeverityCritical, "timeout")
+	acknowledged.ID = "incident-2"
+	acknowledged.FirstSeen = now.Add(-2 * time.Hour)
+	acknowledged.Acknowledge()
+
+	fresh := domain.NewIncident("check-3", "tenant-1", domain.IncidentSeverityWarning, "slow")
+	fresh.ID = "incident-3"
+
+	repo := &MockSLARepository{}
+	repo.On("ListActive", mock.Anything).Return([]*domain.Incident{critical, acknowledged, fresh}, nil)
+	repo.On("Update", mock.Anything, critical).Return(nil).Once()
+	repo.On("Update", mock.Anything, acknowledged).Return(nil).Once()
+
+	producer := &recordingProducer{}
+	monitor := newTestSLAMonitor(t, repo, &StaticSLAPolicies{Default: domain.DefaultSLAPolicy()}, producer, now)
+
+	breaches, err := monitor.CheckBreaches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, breaches, 2)
+	assert.Equal(t, domain.SLAKindAcknowledge, breaches[0].Kind)
+	assert.Equal(t, domain.SLAKindResolve, breaches[1].Kind)
+
+	assert.Contains(t, critical.SLABreaches(), domain.SLAKindAcknowledge)
+	assert.Contains(t, acknowledged.SLABreaches(), domain.SLAKindResolve)
+	assert.Empty(t, fresh.SLABreaches())
+
+	assert.Equal(t, []string{EventIncidentSLABreached, EventIncidentSLABreached}, producer.events)
+	assert.Equal(t, "acknowledge", producer.results[0].Metadata["sla"])
+	assert.Equal(t, "5m0s", producer.results[0].Metadata["sla_target"])
+
+	// Повторный проход не дублирует нарушения и события
+	breaches, err = monitor.CheckBreaches(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, breaches)
+	assert.Len(t, producer.events, 2)
+	repo.AssertExpectations(t)
+}
+
+func TestSLAMonitor_CheckBreachesUpdateFailure(t *testing.T) {
+	now := time.Now()
+	incident := domain.NewIncident("check-1", "tenant-1", domain.IncidentSeverityCritical, "timeout")
+	incident.FirstSeen = now.Add(-10 * time.Minute)
+
+	repo := &MockSLARepository{}
+	repo.On("ListActive", mock.Anything).Return([]*domain.Incident{incident}, nil)
+	repo.On("Update", mock.Anything, incident).Return(errors.New("db down"))
+
+	producer := &recordingProducer{}
+	monitor := newTestSLAMonitor(t, repo, &StaticSLAPolicies{Default: domain.DefaultSLAPolicy()}, producer, now)
+
+	breaches, err := monitor.CheckBreaches(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, breaches)
+	assert.Empty(t, producer.events, "breach must not be announced before it is stored")
+}
+
+func TestSLAMonitor_Report(t *testing.T) {
+	now := time.Now()
+	resolvedAt := now.Add(-30 * time.Minute)
+	incident := &domain.Incident{TenantID: "tenant-1", Severity: domain.IncidentSeverityError, FirstSeen: now.Add(-time.Hour), ResolvedAt: &resolvedAt}
+
+	repo := &MockSLARepository{}
+	repo.On("GetByTenantID", mock.Anything, "tenant-1", mock.AnythingOfType("*domain.IncidentFilter")).
+		Return([]*domain.Incident{incident}, nil)
+	monitor := newTestSLAMonitor(t, repo, &StaticSLAPolicies{}, nil, now)
+
+	report, err := monitor.Report(context.Background(), "tenant-1", now.Add(-24*time.Hour), now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Resolved)
+	assert.Equal(t, 30*time.Minute, report.MTTR)
+
+	_, err = monitor.Report(context.Background(), "tenant-1", now, now)
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/producer/rabbitmq"
+)
+
+// CheckStateSource сообщает состояние проверок инцидентов
+type CheckStateSource interface {
+	// CheckEnabled сообщает, включена ли проверка; ошибка с кодом ErrNotFound - проверка удалена
+	CheckEnabled(ctx context.Context, checkID string) (bool, error)
+}
+
+// StaleIncidentSweeper закрывает активные инциденты, которые больше не закроются сами: их
+// проверка удалена или выключена, либо по ним не было ошибок дольше срока жизни IncidentTTL.
+// Причина сохраняется в метаданных resolve_reason, а в notification-service публикуется
+// событие incident.resolved
+type StaleIncidentSweeper struct {
+	repo     SLARepository
+	checks   CheckStateSource
+	ttl      time.Duration
+	producer rabbitmq.IncidentProducerInterface
+	logger   logger.Logger
+	now      func() time.Time
+}
+
+// NewStaleIncidentSweeper создает сборщик устаревших инцидентов. checks может быть nil, тогда
+// инциденты закрываются только по сроку жизни; producer может быть nil, тогда закрытия не
+// публикуются
+func NewStaleIncidentSweeper(repo SLARepository, checks CheckStateSource, ttl time.Duration, producer rabbitmq.IncidentProducerInterface, log logger.Logger) *StaleIncidentSweeper {
+	return &StaleIncidentSweeper{
+		repo:     repo,
+		checks:   checks,
+		ttl:      ttl,
+		producer: producer,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+// WithClock задает источник времени вместо системных часов
+func (s *StaleIncidentSweeper) WithClock(c clock.Clock) *StaleIncidentSweeper {
+	s.now = c.Now
+	return s
+}
+
+// Run закрывает устаревшие инциденты с заданным интервалом до отмены контекста
+func (s *StaleIncidentSweeper) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Sweep(ctx); err != nil {
+			s.logger.Error("Stale incident sweep failed", logger.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep закрывает устаревшие инциденты и возвращает закрытые
+func (s *StaleIncidentSweeper) Sweep(ctx context.Context) ([]*domain.Incident, error) {
+	incidents, err := s.repo.ListActive(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list active incidents")
+	}
+
+	now := s.now()
+	// Состояние проверки запрашивается один раз за проход, даже если у нее несколько инцидентов
+	reasons := make(map[string]string)
+	var resolved []*domain.Incident
+
+	for _, incident := range incidents {
+		reason, ok := reasons[incident.CheckID]
+		if !ok {
+			reason = s.checkReason(ctx, incident.CheckID)
+			reasons[incident.CheckID] = reason
+		}
+		if reason == "" && incident.IsExpired(s.ttl, now) {
+			reason = domain.ResolveReasonExpired
+		}
+		if reason == "" {
+			continue
+		}
+
+		incident.ResolveStale(reason, now)
+		if err := s.repo.Update(ctx, incident); err != nil {
+			s.logger.Error("Failed to resolve stale incident",
+				logger.String("incident_id", incident.ID),
+				logger.String("reason", reason),
+				logger.Error(err))
+			continue
+		}
+		s.publishResolved(ctx, incident, reason, now)
+		resolved = append(resolved, incident)
+	}
+
+	return resolved, nil
+}
+
+// checkReason возвращает причину закрытия по состоянию проверки или пустую строку, если
+// проверка включена или ее состояние неизвестно
+func (s *StaleIncidentSweeper) checkReason(ctx context.Context, checkID string) string {
+	if s.checks == nil {
+		return ""
+	}
+
+	enabled, err := s.checks.CheckEnabled(ctx, checkID)
+	switch {
+	case stderrors.Is(err, errors.New(errors.ErrNotFound, "")):
+		return domain.ResolveReasonCheckDeleted
+	case err != nil:
+		// Недоступность планировщика не должна закрывать инциденты
+		s.logger.Warn("Failed to get check state for stale incident sweep",
+			logger.String("check_id", checkID),
+			logger.Error(err))
+		return ""
+	case !enabled:
+		return domain.ResolveReasonCheckDisabled
+	}
+	return ""
+}
+
+// publishResolved отправляет событие закрытия устаревшего инцидента в notification-service
+func (s *StaleIncidentSweeper) publishResolved(ctx context.Context, incident *domain.Incident, reason string, now time.Time) {
+	s.logger.Info("Resolving stale incident",
+		logger.String("incident_id", incident.ID),
+		logger.String("check_id", incident.CheckID),
+		logger.String("tenant_id", incident.TenantID),
+		logger.String("reason", reason))
+
+	if s.producer == nil || !s.producer.IsConnected() {
+		s.logger.Warn("RabbitMQ producer not available, stale incident resolution not published",
+			logger.String("incident_id", incident.ID))
+		return
+	}
+
+	result := &rabbitmq.CheckResult{
+		CheckID:      incident.CheckID,
+		TenantID:     incident.TenantID,
+		ErrorMessage: incident.ErrorMessage,
+		Duration:     now.Sub(incident.FirstSeen),
+		Timestamp:    now,
+		Metadata:     map[string]interface{}{domain.MetadataKeyResolveReason: reason},
+	}
+	if err := s.producer.PublishIncidentEventWithRetry(ctx, "incident.resolved", incident, result); err != nil {
+		s.logger.Error("Failed to publish stale incident resolution",
+			logger.String("incident_id", incident.ID),
+			logger.Error(err))
+	}
+}
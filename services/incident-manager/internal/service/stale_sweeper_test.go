@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+// fakeCheckStates состояние проверок теста: отсутствующая проверка удалена
+type fakeCheckStates struct {
+	enabled map[string]bool
+	err     error
+	calls   int
+}
+
+func (c *fakeCheckStates) CheckEnabled(ctx context.Context, checkID string) (bool, error) {
+	c.calls++
+	if c.err != nil {
+		return false, c.err
+	}
+	enabled, ok := c.enabled[checkID]
+	if !ok {
+		return false, errors.New(errors.ErrNotFound, "check not found")
+	}
+	return enabled, nil
+}
+
+func TestStaleIncidentSweeper_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	log, err := logger.NewLogger("test", "error", "incident-service", false)
+	require.NoError(t, err)
+
+	deleted := newTestIncident("deleted", domain.IncidentSeverityError, now.Add(-time.Minute))
+	disabled := newTestIncident("disabled", domain.IncidentSeverityError, now.Add(-time.Minute))
+	expired := newTestIncident("expired", domain.IncidentSeverityWarning, now.Add(-8*24*time.Hour))
+	fresh := newTestIncident("fresh", domain.IncidentSeverityCritical, now.Add(-time.Hour))
+	// Второй инцидент той же проверки не приводит к повторному запросу состояния
+	sibling := newTestIncident("sibling", domain.IncidentSeverityWarning, now.Add(-time.Minute))
+	sibling.CheckID = fresh.CheckID
+	repo := &fakeEscalationRepository{incidents: map[string]*domain.Incident{
+		deleted.ID: deleted, disabled.ID: disabled, expired.ID: expired, fresh.ID: fresh, sibling.ID: sibling,
+	}}
+	checks := &fakeCheckStates{enabled: map[string]bool{
+		disabled.CheckID: false,
+		expired.CheckID:  true,
+		fresh.CheckID:    true,
+	}}
+
+	producer := &recordingProducer{}
+	sweeper := NewStaleIncidentSweeper(repo, checks, 7*24*time.Hour, producer, log)
+	sweeper.now = func() time.Time { return now }
+
+	resolved, err := sweeper.Sweep(ctx)
+	require.NoError(t, err)
+	assert.Len(t, resolved, 3)
+	assert.Equal(t, 4, checks.calls)
+
+	assert.True(t, deleted.IsResolved())
+	assert.Equal(t, domain.ResolveReasonCheckDeleted, deleted.ResolveReason())
+	assert.Equal(t, domain.ResolveReasonCheckDisabled, disabled.ResolveReason())
+	assert.Equal(t, domain.ResolveReasonExpired, expired.ResolveReason())
+	assert.False(t, fresh.IsResolved())
+	assert.False(t, sibling.IsResolved())
+
+	assert.Equal(t, []string{"incident.resolved", "incident.resolved", "incident.resolved"}, producer.events)
+	for _, result := range producer.results {
+		assert.NotEmpty(t, result.Metadata[domain.MetadataKeyResolveReason])
+	}
+
+	// Повторный проход не трогает закрытые инциденты
+	resolved, err = sweeper.Sweep(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, resolved)
+}
+
+func TestStaleIncidentSweeper_CheckStateUnavailable(t *testing.T) {
+	now := time.Now()
+	log, err := logger.NewLogger("test", "error", "incident-service", false)
+	require.NoError(t, err)
+
+	recent := newTestIncident("recent", domain.IncidentSeverityError, now.Add(-time.Minute))
+	expired := newTestIncident("expired", domain.IncidentSeverityError, now.Add(-8*24*time.Hour))
+	repo := &fakeEscalationRepository{incidents: map[string]*domain.Incident{recent.ID: recent, expired.ID: expired}}
+
+	// Недоступность планировщика не закрывает инциденты, срок жизни продолжает действовать
+	sweeper := NewStaleIncidentSweeper(repo, &fakeCheckStates{err: assert.AnError}, 7*24*time.Hour, nil, log)
+	sweeper.now = func() time.Time { return now }
+
+	resolved, err := sweeper.Sweep(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+	assert.Equal(t, expired.ID, resolved[0].ID)
+	assert.False(t, recent.IsResolved())
+}
@@ -0,0 +1,491 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/validation"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+const (
+	// maxStatusComponents максимальное число компонентов на странице статуса тенанта
+	maxStatusComponents = 100
+	// maxComponentChecks максимальное число проверок в компоненте
+	maxComponentChecks = 100
+	// maxOverrideMessageLength максимальная длина сообщения ручного статуса
+	maxOverrideMessageLength = 1000
+	// statusPageIncidentLimit сколько активных инцидентов учитывается при расчете статусов
+	statusPageIncidentLimit = 1000
+)
+
+// StatusPageRepository хранилище компонентов и объявлений об обслуживании.
+// Отсутствующие записи возвращаются как domain.ErrStatusComponentNotFound и domain.ErrMaintenanceNotFound
+type StatusPageRepository interface {
+	CreateComponent(ctx context.Context, component *domain.StatusComponent) error
+	GetComponent(ctx context.Context, tenantID, id string) (*domain.StatusComponent, error)
+	ListComponents(ctx context.Context, tenantID string) ([]*domain.StatusComponent, error)
+	UpdateComponent(ctx context.Context, component *domain.StatusComponent) error
+	DeleteComponent(ctx context.Context, tenantID, id string) error
+
+	CreateMaintenance(ctx context.Context, maintenance *domain.MaintenanceAnnouncement) error
+	GetMaintenance(ctx context.Context, tenantID, id string) (*domain.MaintenanceAnnouncement, error)
+	// ListMaintenances возвращает объявления, которые заканчиваются не раньше since, по времени начала
+	ListMaintenances(ctx context.Context, tenantID string, since time.Time) ([]*domain.MaintenanceAnnouncement, error)
+	UpdateMaintenance(ctx context.Context, maintenance *domain.MaintenanceAnnouncement) error
+}
+
+// ActiveIncidentSource источник инцидентов тенанта для автоматического статуса компонентов
+type ActiveIncidentSource interface {
+	GetByTenantID(ctx context.Context, tenantID string, filter *domain.IncidentFilter) ([]*domain.Incident, error)
+}
+
+// StatusPageService компоненты страницы статуса, ручные статусы и объявления об обслуживании.
+// Без источника инцидентов автоматический статус всех компонентов - operational
+type StatusPageService struct {
+	repo      StatusPageRepository
+	incidents ActiveIncidentSource
+	logger    logger.Logger
+	validator *validation.Validator
+	now       func() time.Time
+}
+
+// NewStatusPageService создает сервис страницы статуса
+func NewStatusPageService(repo StatusPageRepository, log logger.Logger) *StatusPageService {
+	return &StatusPageService{
+		repo:      repo,
+		logger:    log,
+		validator: validation.NewValidator(),
+		now:       time.Now,
+	}
+}
+
+// WithIncidents подключает источник инцидентов для автоматического статуса компонентов
+func (s *StatusPageService) WithIncidents(incidents ActiveIncidentSource) *StatusPageService {
+	s.incidents = incidents
+	return s
+}
+
+// ListComponents возвращает компоненты тенанта в порядке отображения
+func (s *StatusPageService) ListComponents(ctx context.Context, tenantID string) ([]*domain.StatusComponent, error) {
+	if err := s.validateID(tenantID, "tenant_id"); err != nil {
+		return nil, err
+	}
+
+	components, err := s.repo.ListComponents(ctx, tenantID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to list status components")
+	}
+	sortComponents(components)
+	return components, nil
+}
+
+// CreateComponent создает компонент страницы статуса
+func (s *StatusPageService) CreateComponent(ctx context.Context, component *domain.StatusComponent) (*domain.StatusComponent, error) {
+	if err := s.validateComponent(component); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.ListComponents(ctx, component.TenantID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to list status components")
+	}
+	if len(existing) >= maxStatusComponents {
+		return nil, errors.New(errors.ErrValidation, "too many status components").
+			WithDetails(fmt.Sprintf("limit is %d", maxStatusComponents))
+	}
+
+	now := s.now().UTC()
+	component.Override = nil
+	component.CreatedAt = now
+	component.UpdatedAt = now
+	if err := s.repo.CreateComponent(ctx, component); err != nil {
+		return nil, s.mapError(err, "failed to create status component")
+	}
+
+	s.logger.Info("Status component created",
+		logger.String("tenant_id", component.TenantID),
+		logger.String("component_id", component.ID),
+		logger.String("name", component.Name))
+	return component, nil
+}
+
+// UpdateComponent заменяет имя, описание, проверки и позицию компонента; ручной статус сохраняется
+func (s *StatusPageService) UpdateComponent(ctx context.Context, component *domain.StatusComponent) (*domain.StatusComponent, error) {
+	if err := s.validateID(component.ID, "component_id"); err != nil {
+		return nil, err
+	}
+	if err := s.validateComponent(component); err != nil {
+		return nil, err
+	}
+
+	current, err := s.repo.GetComponent(ctx, component.TenantID, component.ID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to get status component")
+	}
+
+	current.Name = component.Name
+	current.Description = component.Description
+	current.CheckIDs = component.CheckIDs
+	current.Position = component.Position
+	current.UpdatedAt = s.now().UTC()
+	if err := s.repo.UpdateComponent(ctx, current); err != nil {
+		return nil, s.mapError(err, "failed to update status component")
+	}
+	return current, nil
+}
+
+// DeleteComponent удаляет компонент
+func (s *StatusPageService) DeleteComponent(ctx context.Context, tenantID, id string) error {
+	if err := s.validateID(tenantID, "tenant_id"); err != nil {
+		return err
+	}
+	if err := s.validateID(id, "component_id"); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteComponent(ctx, tenantID, id); err != nil {
+		return s.mapError(err, "failed to delete status component")
+	}
+
+	s.logger.Info("Status component deleted",
+		logger.String("tenant_id", tenantID),
+		logger.String("component_id", id))
+	return nil
+}
+
+// SetOverride вручную задает отображаемый статус компонента независимо от проверок.
+// expiresAt, если задан, должен быть в будущем
+func (s *StatusPageService) SetOverride(ctx context.Context, tenantID, componentID string, status domain.ComponentStatus, message, setBy string, expiresAt *time.Time) (*domain.StatusComponent, error) {
+	if err := s.validateID(tenantID, "tenant_id"); err != nil {
+		return nil, err
+	}
+	if err := s.validateID(componentID, "component_id"); err != nil {
+		return nil, err
+	}
+	if !domain.IsValidComponentStatus(status) {
+		return nil, errors.New(errors.ErrValidation, "invalid component status").
+			WithDetails(fmt.Sprintf("status %q", status))
+	}
+	if len(message) > maxOverrideMessageLength {
+		return nil, errors.New(errors.ErrValidation, "override message is too long").
+			WithDetails(fmt.Sprintf("limit is %d characters", maxOverrideMessageLength))
+	}
+
+	now := s.now().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, errors.New(errors.ErrValidation, "expires_at must be in the future")
+	}
+
+	component, err := s.repo.GetComponent(ctx, tenantID, componentID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to get status component")
+	}
+
+	component.Override = &domain.StatusOverride{
+		Status:    status,
+		Message:   strings.TrimSpace(message),
+		SetBy:     setBy,
+		SetAt:     now,
+		ExpiresAt: expiresAt,
+	}
+	component.UpdatedAt = now
+	if err := s.repo.UpdateComponent(ctx, component); err != nil {
+		return nil, s.mapError(err, "failed to update status component")
+	}
+
+	s.logger.Info("Status component override set",
+		logger.String("tenant_id", tenantID),
+		logger.String("component_id", componentID),
+		logger.String("status", string(status)),
+		logger.String("set_by", setBy))
+	return component, nil
+}
+
+// ClearOverride снимает ручной статус; компонент снова отображает статус по проверкам
+func (s *StatusPageService) ClearOverride(ctx context.Context, tenantID, componentID string) (*domain.StatusComponent, error) {
+	if err := s.validateID(tenantID, "tenant_id"); err != nil {
+		return nil, err
+	}
+	if err := s.validateID(componentID, "component_id"); err != nil {
+		return nil, err
+	}
+
+	component, err := s.repo.GetComponent(ctx, tenantID, componentID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to get status component")
+	}
+	if component.Override == nil {
+		return component, nil
+	}
+
+	component.Override = nil
+	component.UpdatedAt = s.now().UTC()
+	if err := s.repo.UpdateComponent(ctx, component); err != nil {
+		return nil, s.mapError(err, "failed to update status component")
+	}
+
+	s.logger.Info("Status component override cleared",
+		logger.String("tenant_id", tenantID),
+		logger.String("component_id", componentID))
+	return component, nil
+}
+
+// ScheduleMaintenance публикует объявление об обслуживании. Компоненты объявления должны
+// принадлежать тенанту; конец обслуживания должен быть в будущем
+func (s *StatusPageService) ScheduleMaintenance(ctx context.Context, maintenance *domain.MaintenanceAnnouncement) (*domain.MaintenanceAnnouncement, error) {
+	if err := s.validateID(maintenance.TenantID, "tenant_id"); err != nil {
+		return nil, err
+	}
+	if err := maintenance.Validate(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid maintenance announcement")
+	}
+
+	now := s.now().UTC()
+	if !maintenance.EndsAt.After(now) {
+		return nil, errors.New(errors.ErrValidation, "ends_at must be in the future")
+	}
+
+	componentIDs, err := s.resolveComponentIDs(ctx, maintenance.TenantID, maintenance.ComponentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	maintenance.ComponentIDs = componentIDs
+	maintenance.StartsAt = maintenance.StartsAt.UTC()
+	maintenance.EndsAt = maintenance.EndsAt.UTC()
+	maintenance.CancelledAt = nil
+	maintenance.CreatedAt = now
+	maintenance.UpdatedAt = now
+	if err := s.repo.CreateMaintenance(ctx, maintenance); err != nil {
+		return nil, s.mapError(err, "failed to create maintenance announcement")
+	}
+
+	s.logger.Info("Maintenance announcement scheduled",
+		logger.String("tenant_id", maintenance.TenantID),
+		logger.String("maintenance_id", maintenance.ID),
+		logger.String("starts_at", maintenance.StartsAt.Format(time.RFC3339)),
+		logger.String("ends_at", maintenance.EndsAt.Format(time.RFC3339)))
+	return maintenance, nil
+}
+
+// CancelMaintenance отменяет объявление; завершенное обслуживание отменить нельзя
+func (s *StatusPageService) CancelMaintenance(ctx context.Context, tenantID, id string) (*domain.MaintenanceAnnouncement, error) {
+	if err := s.validateID(tenantID, "tenant_id"); err != nil {
+		return nil, err
+	}
+	if err := s.validateID(id, "maintenance_id"); err != nil {
+		return nil, err
+	}
+
+	maintenance, err := s.repo.GetMaintenance(ctx, tenantID, id)
+	if err != nil {
+		return nil, s.mapError(err, "failed to get maintenance announcement")
+	}
+
+	now := s.now().UTC()
+	switch maintenance.StateAt(now) {
+	case domain.MaintenanceStateCancelled:
+		return maintenance, nil
+	case domain.MaintenanceStateCompleted:
+		return nil, errors.New(errors.ErrConflict, "maintenance is already completed")
+	}
+
+	maintenance.CancelledAt = &now
+	maintenance.UpdatedAt = now
+	if err := s.repo.UpdateMaintenance(ctx, maintenance); err != nil {
+		return nil, s.mapError(err, "failed to update maintenance announcement")
+	}
+
+	s.logger.Info("Maintenance announcement cancelled",
+		logger.String("tenant_id", tenantID),
+		logger.String("maintenance_id", id))
+	return maintenance, nil
+}
+
+// ListMaintenances возвращает объявления, которые заканчиваются не раньше since
+func (s *StatusPageService) ListMaintenances(ctx context.Context, tenantID string, since time.Time) ([]*domain.MaintenanceAnnouncement, error) {
+	if err := s.validateID(tenantID, "tenant_id"); err != nil {
+		return nil, err
+	}
+
+	maintenances, err := s.repo.ListMaintenances(ctx, tenantID, since)
+	if err != nil {
+		return nil, s.mapError(err, "failed to list maintenance announcements")
+	}
+	return maintenances, nil
+}
+
+// GetStatusPage собирает страницу статуса: статусы компонентов с учетом ручных статусов
+// и обслуживания, общий статус, текущие и предстоящие обслуживания
+func (s *StatusPageService) GetStatusPage(ctx context.Context, tenantID string) (*domain.StatusPage, error) {
+	components, err := s.ListComponents(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	maintenances, err := s.repo.ListMaintenances(ctx, tenantID, now)
+	if err != nil {
+		return nil, s.mapError(err, "failed to list maintenance announcements")
+	}
+
+	page := &domain.StatusPage{
+		TenantID:             tenantID,
+		Status:               domain.ComponentStatusOperational,
+		Components:           make([]domain.ComponentView, 0, len(components)),
+		ActiveMaintenances:   []*domain.MaintenanceAnnouncement{},
+		UpcomingMaintenances: []*domain.MaintenanceAnnouncement{},
+		GeneratedAt:          now,
+	}
+	for _, maintenance := range maintenances {
+		switch maintenance.StateAt(now) {
+		case domain.MaintenanceStateInProgress:
+			page.ActiveMaintenances = append(page.ActiveMaintenances, maintenance)
+		case domain.MaintenanceStateScheduled:
+			page.UpcomingMaintenances = append(page.UpcomingMaintenances, maintenance)
+		}
+	}
+
+	checkStatuses := s.checkStatuses(ctx, tenantID)
+	for _, component := range components {
+		automated := domain.ComponentStatusOperational
+		for _, checkID := range component.CheckIDs {
+			if status, ok := checkStatuses[checkID]; ok && status.WorseThan(automated) {
+				automated = status
+			}
+		}
+
+		view := domain.ResolveComponentView(component, automated, page.ActiveMaintenances, now)
+		if view.Status.WorseThan(page.Status) {
+			page.Status = view.Status
+		}
+		page.Components = append(page.Components, view)
+	}
+	return page, nil
+}
+
+// checkStatuses возвращает худший статус по активным инцидентам каждой проверки.
+// Ошибка источника инцидентов не прерывает построение страницы: компоненты считаются operational
+func (s *StatusPageService) checkStatuses(ctx context.Context, tenantID string) map[string]domain.ComponentStatus {
+	statuses := make(map[string]domain.ComponentStatus)
+	if s.incidents == nil {
+		return statuses
+	}
+
+	incidents, err := s.incidents.GetByTenantID(ctx, tenantID, &domain.IncidentFilter{
+		TenantID: &tenantID,
+		Limit:    statusPageIncidentLimit,
+	})
+	if err != nil {
+		s.logger.Warn("Failed to load incidents for status page",
+			logger.String("tenant_id", tenantID),
+			logger.Error(err))
+		return statuses
+	}
+
+	for _, incident := range incidents {
+		if !incident.IsActive() {
+			continue
+		}
+		status := domain.ComponentStatusForSeverity(incident.Severity)
+		if current, ok := statuses[incident.CheckID]; !ok || status.WorseThan(current) {
+			statuses[incident.CheckID] = status
+		}
+	}
+	return statuses
+}
+
+// resolveComponentIDs проверяет, что компоненты принадлежат тенанту, и убирает повторы
+func (s *StatusPageService) resolveComponentIDs(ctx context.Context, tenantID string, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+
+	components, err := s.repo.ListComponents(ctx, tenantID)
+	if err != nil {
+		return nil, s.mapError(err, "failed to list status components")
+	}
+	known := make(map[string]bool, len(components))
+	for _, component := range components {
+		known[component.ID] = true
+	}
+
+	seen := make(map[string]bool, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !known[id] {
+			return nil, errors.New(errors.ErrValidation, "unknown status component").
+				WithDetails(fmt.Sprintf("component_id %q", id))
+		}
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// validateComponent проверяет тенанта, поля и проверки компонента
+func (s *StatusPageService) validateComponent(component *domain.StatusComponent) error {
+	if err := s.validateID(component.TenantID, "tenant_id"); err != nil {
+		return err
+	}
+	component.Name = strings.TrimSpace(component.Name)
+	if err := component.Validate(); err != nil {
+		return errors.Wrap(err, errors.ErrValidation, "invalid status component")
+	}
+	if len(component.CheckIDs) > maxComponentChecks {
+		return errors.New(errors.ErrValidation, "too many checks in status component").
+			WithDetails(fmt.Sprintf("limit is %d", maxComponentChecks))
+	}
+
+	seen := make(map[string]bool, len(component.CheckIDs))
+	checkIDs := make([]string, 0, len(component.CheckIDs))
+	for _, checkID := range component.CheckIDs {
+		if err := s.validateID(checkID, "check_id"); err != nil {
+			return err
+		}
+		if !seen[checkID] {
+			seen[checkID] = true
+			checkIDs = append(checkIDs, checkID)
+		}
+	}
+	component.CheckIDs = checkIDs
+	return nil
+}
+
+// validateID проверяет идентификатор в формате UUID
+func (s *StatusPageService) validateID(id, field string) error {
+	if err := s.validator.ValidateUUID(id, field); err != nil {
+		return errors.Wrap(err, errors.ErrValidation, field+" validation failed")
+	}
+	return nil
+}
+
+// mapError переводит ошибки хранилища в ошибки сервиса
+func (s *StatusPageService) mapError(err error, message string) error {
+	switch {
+	case stderrors.Is(err, domain.ErrStatusComponentNotFound):
+		return errors.New(errors.ErrNotFound, "status component not found")
+	case stderrors.Is(err, domain.ErrMaintenanceNotFound):
+		return errors.New(errors.ErrNotFound, "maintenance announcement not found")
+	}
+	return errors.Wrap(err, errors.ErrInternal, message)
+}
+
+// sortComponents упорядочивает компоненты по позиции, затем по имени
+func sortComponents(components []*domain.StatusComponent) {
+	sort.SliceStable(components, func(i, j int) bool {
+		if components[i].Position != components[j].Position {
+			return components[i].Position < components[j].Position
+		}
+		return components[i].Name < components[j].Name
+	})
+}
@@ -0,0 +1,426 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+)
+
+const (
+	statusTenantID = "550e8400-e29b-41d4-a716-446655440001"
+	statusCheckAPI = "550e8400-e29b-41d4-a716-446655440101"
+	statusCheckDB  = "550e8400-e29b-41d4-a716-446655440102"
+)
+
+// memoryStatusPageRepository хранилище страницы статуса в памяти для тестов
+type memoryStatusPageRepository struct {
+	components   map[string]*domain.StatusComponent
+	maintenances map[string]*domain.MaintenanceAnnouncement
+	nextID       int
+}
+
+func newMemoryStatusPageRepository() *memoryStatusPageRepository {
+	return &memoryStatusPageRepository{
+		components:   make(map[string]*domain.StatusComponent),
+		maintenances: make(map[string]*domain.MaintenanceAnnouncement),
+	}
+}
+
+func (r *memoryStatusPageRepository) newID() string {
+	r.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", r.nextID)
+}
+
+func (r *memoryStatusPageRepository) CreateComponent(_ context.Context, component *domain.StatusComponent) error {
+	component.ID = r.newID()
+	stored := *component
+	r.components[component.ID] = &stored
+	return nil
+}
+
+func (r *memoryStatusPageRepository) GetComponent(_ context.Context, tenantID, id string) (*domain.StatusComponent, error) {
+	component, ok := r.components[id]
+	if !ok || component.TenantID != tenantID {
+		return nil, domain.ErrStatusComponentNotFound
+	}
+	copied := *component
+	return &copied, nil
+}
+
+func (r *memoryStatusPageRepository) ListComponents(_ context.Context, tenantID string) ([]*domain.StatusComponent, error) {
+	components := []*domain.StatusComponent{}
+	for _, component := range r.components {
+		if component.TenantID == tenantID {
+			copied := *component
+			components = append(components, &copied)
+		}
+	}
+	return components, nil
+}
+
+func (r *memoryStatusPageRepository) UpdateComponent(_ context.Context, component *domain.StatusComponent) error {
+	if _, ok := r.components[component.ID]; !ok {
+		return domain.ErrStatusComponentNotFound
+	}
+	stored := *component
+	r.components[component.ID] = &stored
+	return nil
+}
+
+func (r *memoryStatusPageRepository) DeleteComponent(_ context.Context, tenantID, id string) error {
+	component, ok := r.components[id]
+	if !ok || component.TenantID != tenantID {
+		return domain.ErrStatusComponentNotFound
+	}
+	delete(r.components, id)
+	return nil
+}
+
+func (r *memoryStatusPageRepository) CreateMaintenance(_ context.Context, maintenance *domain.MaintenanceAnnouncement) error {
+	maintenance.ID = r.newID()
+	stored := *maintenance
+	r.maintenances[maintenance.ID] = &stored
+	return nil
+}
+
+func (r *memoryStatusPageRepository) GetMaintenance(_ context.Context, tenantID, id string) (*domain.MaintenanceAnnouncement, error) {
+	maintenance, ok := r.maintenances[id]
+	if !ok || maintenance.TenantID != tenantID {
+		return nil, domain.ErrMaintenanceNotFound
+	}
+	copied := *maintenance
+	return &copied, nil
+}
+
+func (r *memoryStatusPageRepository) ListMaintenances(_ context.Context, tenantID string, since time.Time) ([]*domain.MaintenanceAnnouncement, error) {
+	maintenances := []*domain.MaintenanceAnnouncement{}
+	for _, maintenance := range r.maintenances {
+		if maintenance.TenantID == tenantID && !maintenance.EndsAt.Before(since) {
+			copied := *maintenance
+			maintenances = append(maintenances, &copied)
+		}
+	}
+	return maintenances, nil
+}
+
+func (r *memoryStatusPageRepository) UpdateMaintenance(_ context.Context, maintenance *domain.MaintenanceAnnouncement) error {
+	if _, ok := r.maintenances[maintenance.ID]; !ok {
+		return domain.ErrMaintenanceNotFound
+	}
+	stored := *maintenance
+	r.maintenances[maintenance.ID] = &stored
+	return nil
+}
+
+func newStatusPageTestService(t *testing.T) (*StatusPageService, *memoryStatusPageRepository, *time.Time) {
+	repo := newMemoryStatusPageRepository()
+	log, err := logger.NewLogger("test", "debug", "incident-service", false)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	service := NewStatusPageService(repo, log)
+	service.now = func() time.Time { return now }
+	return service, repo, &now
+}
+
+func createTestComponent(t *testing.T, service *StatusPageService, name string, position int, checkIDs ...string) *domain.StatusComponent {
+	component, err := service.CreateComponent(context.Background(), &domain.StatusComponent{
+		TenantID: statusTenantID,
+		Name:     name,
+		Position: position,
+		CheckIDs: checkIDs,
+	})
+	require.NoError(t, err)
+	return component
+}
+
+func statusErrorCode(err error) pkgErrors.ErrorCode {
+	var appErr *pkgErrors.Error
+	if stderrors.As(err, &appErr) {
+		return appErr.Code
+	}
+	return ""
+}
+
+func TestStatusPageService_CreateComponent_Validation(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		component *domain.StatusComponent
+	}{
+		{"invalid tenant", &domain.StatusComponent{TenantID: "tenant-1", Name: "API"}},
+		{"empty name", &domain.StatusComponent{TenantID: statusTenantID, Name: "  "}},
+		{"negative position", &domain.StatusComponent{TenantID: statusTenantID, Name: "API", Position: -1}},
+		{"invalid check id", &domain.StatusComponent{TenantID: statusTenantID, Name: "API", CheckIDs: []string{"check-1"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreateComponent(ctx, tt.component)
+			require.Error(t, err)
+			assert.Equal(t, pkgErrors.ErrValidation, statusErrorCode(err))
+		})
+	}
+}
+
+func TestStatusPageService_CreateComponent_DeduplicatesChecks(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+
+	component := createTestComponent(t, service, " API ", 0, statusCheckAPI, statusCheckAPI, statusCheckDB)
+
+	assert.NotEmpty(t, component.ID)
+	assert.Equal(t, "API", component.Name)
+	assert.Equal(t, []string{statusCheckAPI, statusCheckDB}, component.CheckIDs)
+}
+
+func TestStatusPageService_ListComponents_SortedByPosition(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+	createTestComponent(t, service, "Website", 2)
+	createTestComponent(t, service, "Database", 1)
+	createTestComponent(t, service, "API", 1)
+
+	components, err := service.ListComponents(context.Background(), statusTenantID)
+
+	require.NoError(t, err)
+	require.Len(t, components, 3)
+	assert.Equal(t, "API", components[0].Name)
+	assert.Equal(t, "Database", components[1].Name)
+	assert.Equal(t, "Website", components[2].Name)
+}
+
+func TestStatusPageService_UpdateComponent_KeepsOverride(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+	ctx := context.Background()
+	component := createTestComponent(t, service, "API", 0)
+
+	_, err := service.SetOverride(ctx, statusTenantID, component.ID, domain.ComponentStatusPartialOutage, "investigating", "operator", nil)
+	require.NoError(t, err)
+
+	updated, err := service.UpdateComponent(ctx, &domain.StatusComponent{
+		ID:       component.ID,
+		TenantID: statusTenantID,
+		Name:     "Public API",
+		Position: 3,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Public API", updated.Name)
+	assert.Equal(t, 3, updated.Position)
+	require.NotNil(t, updated.Override)
+	assert.Equal(t, domain.ComponentStatusPartialOutage, updated.Override.Status)
+}
+
+func TestStatusPageService_NotFound(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+	ctx := context.Background()
+	missingID := "00000000-0000-4000-8000-999999999999"
+
+	_, err := service.SetOverride(ctx, statusTenantID, missingID, domain.ComponentStatusMajorOutage, "", "operator", nil)
+	assert.Equal(t, pkgErrors.ErrNotFound, statusErrorCode(err))
+
+	err = service.DeleteComponent(ctx, statusTenantID, missingID)
+	assert.Equal(t, pkgErrors.ErrNotFound, statusErrorCode(err))
+
+	_, err = service.CancelMaintenance(ctx, statusTenantID, missingID)
+	assert.Equal(t, pkgErrors.ErrNotFound, statusErrorCode(err))
+}
+
+func TestStatusPageService_SetOverride_Validation(t *testing.T) {
+	service, _, now := newStatusPageTestService(t)
+	ctx := context.Background()
+	component := createTestComponent(t, service, "API", 0)
+	past := now.Add(-time.Minute)
+
+	_, err := service.SetOverride(ctx, statusTenantID, component.ID, "broken", "", "operator", nil)
+	assert.Equal(t, pkgErrors.ErrValidation, statusErrorCode(err))
+
+	_, err = service.SetOverride(ctx, statusTenantID, component.ID, domain.ComponentStatusMajorOutage, "", "operator", &past)
+	assert.Equal(t, pkgErrors.ErrValidation, statusErrorCode(err))
+}
+
+func TestStatusPageService_GetStatusPage_AutomatedStatusFromIncidents(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+	incidents := &MockIncidentRepository{}
+	service.WithIncidents(incidents)
+	api := createTestComponent(t, service, "API", 0, statusCheckAPI)
+	database := createTestComponent(t, service, "Database", 1, statusCheckDB)
+
+	resolved := domain.NewIncident(statusCheckDB, statusTenantID, domain.IncidentSeverityCritical, "timeout")
+	resolved.Resolve()
+	incidents.On("GetByTenantID", mock.Anything, statusTenantID, mock.Anything).Return([]*domain.Incident{
+		domain.NewIncident(statusCheckAPI, statusTenantID, domain.IncidentSeverityWarning, "slow"),
+		domain.NewIncident(statusCheckAPI, statusTenantID, domain.IncidentSeverityError, "5xx"),
+		resolved,
+	}, nil)
+
+	page, err := service.GetStatusPage(context.Background(), statusTenantID)
+
+	require.NoError(t, err)
+	require.Len(t, page.Components, 2)
+	assert.Equal(t, api.ID, page.Components[0].ID)
+	assert.Equal(t, domain.ComponentStatusPartialOutage, page.Components[0].Status)
+	assert.Equal(t, domain.StatusSourceChecks, page.Components[0].StatusSource)
+	assert.Equal(t, database.ID, page.Components[1].ID)
+	assert.Equal(t, domain.ComponentStatusOperational, page.Components[1].Status)
+	assert.Equal(t, domain.ComponentStatusPartialOutage, page.Status)
+}
+
+func TestStatusPageService_GetStatusPage_IncidentSourceFailure(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+	incidents := &MockIncidentRepository{}
+	service.WithIncidents(incidents)
+	createTestComponent(t, service, "API", 0, statusCheckAPI)
+
+	incidents.On("GetByTenantID", mock.Anything, statusTenantID, mock.Anything).Return([]*domain.Incident(nil), stderrors.New("connection refused"))
+
+	page, err := service.GetStatusPage(context.Background(), statusTenantID)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ComponentStatusOperational, page.Status)
+}
+
+func TestStatusPageService_Override_TakesPrecedenceUntilExpiry(t *testing.T) {
+	service, _, now := newStatusPageTestService(t)
+	incidents := &MockIncidentRepository{}
+	service.WithIncidents(incidents)
+	ctx := context.Background()
+	component := createTestComponent(t, service, "API", 0, statusCheckAPI)
+
+	incidents.On("GetByTenantID", mock.Anything, statusTenantID, mock.Anything).Return([]*domain.Incident{
+		domain.NewIncident(statusCheckAPI, statusTenantID, domain.IncidentSeverityCritical, "down"),
+	}, nil)
+
+	expiresAt := now.Add(time.Hour)
+	overridden, err := service.SetOverride(ctx, statusTenantID, component.ID, domain.ComponentStatusDegradedPerformance, " partial recovery ", "operator-1", &expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, "partial recovery", overridden.Override.Message)
+	assert.Equal(t, "operator-1", overridden.Override.SetBy)
+
+	page, err := service.GetStatusPage(ctx, statusTenantID)
+	require.NoError(t, err)
+	view := page.Components[0]
+	assert.Equal(t, domain.ComponentStatusDegradedPerformance, view.Status)
+	assert.Equal(t, domain.ComponentStatusMajorOutage, view.AutomatedStatus)
+	assert.Equal(t, domain.StatusSourceOverride, view.StatusSource)
+	assert.Equal(t, "partial recovery", view.StatusMessage)
+
+	*now = expiresAt
+	page, err = service.GetStatusPage(ctx, statusTenantID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ComponentStatusMajorOutage, page.Components[0].Status)
+	assert.Equal(t, domain.StatusSourceChecks, page.Components[0].StatusSource)
+}
+
+func TestStatusPageService_ClearOverride(t *testing.T) {
+	service, _, _ := newStatusPageTestService(t)
+	ctx := context.Background()
+	component := createTestComponent(t, service, "API", 0)
+
+	_, err := service.SetOverride(ctx, statusTenantID, component.ID, domain.ComponentStatusMajorOutage, "", "operator", nil)
+	require.NoError(t, err)
+
+	cleared, err := service.ClearOverride(ctx, statusTenantID, component.ID)
+	require.NoError(t, err)
+	assert.Nil(t, cleared.Override)
+
+	page, err := service.GetStatusPage(ctx, statusTenantID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ComponentStatusOperational, page.Components[0].Status)
+}
+
+func TestStatusPageService_Maintenance_Lifecycle(t *testing.T) {
+	service, _, now := newStatusPageTestService(t)
+	ctx := context.Background()
+	api := createTestComponent(t, service, "API", 0)
+	website := createTestComponent(t, service, "Website", 1)
+
+	maintenance, err := service.ScheduleMaintenance(ctx, &domain.MaintenanceAnnouncement{
+		TenantID:     statusTenantID,
+		Title:        "Database upgrade",
+		ComponentIDs: []string{api.ID, api.ID},
+		StartsAt:     now.Add(time.Hour),
+		EndsAt:       now.Add(2 * time.Hour),
+		CreatedBy:    "operator",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{api.ID}, maintenance.ComponentIDs)
+
+	page, err := service.GetStatusPage(ctx, statusTenantID)
+	require.NoError(t, err)
+	assert.Len(t, page.UpcomingMaintenances, 1)
+	assert.Empty(t, page.ActiveMaintenances)
+	assert.Equal(t, domain.ComponentStatusOperational, page.Components[0].Status)
+
+	*now = now.Add(90 * time.Minute)
+	page, err = service.GetStatusPage(ctx, statusTenantID)
+	require.NoError(t, err)
+	assert.Len(t, page.ActiveMaintenances, 1)
+	assert.Empty(t, page.UpcomingMaintenances)
+	assert.Equal(t, domain.ComponentStatusUnderMaintenance, page.Components[0].Status)
+	assert.Equal(t, domain.StatusSourceMaintenance, page.Components[0].StatusSource)
+	assert.Equal(t, "Database upgrade", page.Components[0].StatusMessage)
+	assert.Equal(t, website.ID, page.Components[1].ID)
+	assert.Equal(t, domain.ComponentStatusOperational, page.Components[1].Status)
+	assert.Equal(t, domain.ComponentStatusUnderMaintenance, page.Status)
+
+	cancelled, err := service.CancelMaintenance(ctx, statusTenantID, maintenance.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.MaintenanceStateCancelled, cancelled.StateAt(*now))
+
+	page, err = service.GetStatusPage(ctx, statusTenantID)
+	require.NoError(t, err)
+	assert.Empty(t, page.ActiveMaintenances)
+	assert.Equal(t, domain.ComponentStatusOperational, page.Components[0].Status)
+}
+
+func TestStatusPageService_CancelMaintenance_CompletedConflict(t *testing.T) {
+	service, _, now := newStatusPageTestService(t)
+	ctx := context.Background()
+
+	maintenance, err := service.ScheduleMaintenance(ctx, &domain.MaintenanceAnnouncement{
+		TenantID: statusTenantID,
+		Title:    "Network maintenance",
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	*now = now.Add(2 * time.Hour)
+	_, err = service.CancelMaintenance(ctx, statusTenantID, maintenance.ID)
+	assert.Equal(t, pkgErrors.ErrConflict, statusErrorCode(err))
+}
+
+func TestStatusPageService_ScheduleMaintenance_Validation(t *testing.T) {
+	service, _, now := newStatusPageTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		maintenance *domain.MaintenanceAnnouncement
+	}{
+		{"missing title", &domain.MaintenanceAnnouncement{StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}},
+		{"ends before start", &domain.MaintenanceAnnouncement{Title: "Upgrade", StartsAt: now.Add(2 * time.Hour), EndsAt: now.Add(time.Hour)}},
+		{"already over", &domain.MaintenanceAnnouncement{Title: "Upgrade", StartsAt: now.Add(-2 * time.Hour), EndsAt: now.Add(-time.Hour)}},
+		{"unknown component", &domain.MaintenanceAnnouncement{Title: "Upgrade", StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour),
+			ComponentIDs: []string{"00000000-0000-4000-8000-999999999999"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.maintenance.TenantID = statusTenantID
+			_, err := service.ScheduleMaintenance(ctx, tt.maintenance)
+			require.Error(t, err)
+			assert.Equal(t, pkgErrors.ErrValidation, statusErrorCode(err))
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Package standalone ведет инциденты в одном процессе с остальными сервисами платформы:
+// результаты проверок читаются из встроенной очереди pkg/queue, инциденты хранятся в памяти,
+// события incident.* публикуются в очередь уведомлений
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/producer/rabbitmq"
+	"UptimePingPlatform/services/incident-manager/internal/repository/memory"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// TopicNotifications тема очереди с событиями для notification-service
+const TopicNotifications = "notifications"
+
+// notificationKeys ключи маршрутизации, по которым notification-service узнает события инцидентов
+var notificationKeys = map[string]string{
+	events.TypeIncidentOpened:  "incident.created",
+	events.TypeIncidentGrouped: "incident.updated",
+}
+
+// Publisher публикует сообщения во встроенную очередь
+type Publisher interface {
+	Publish(ctx context.Context, msg queue.Message) error
+}
+
+// Incident инцидент
+type Incident = domain.Incident
+
+// Confirmations пороги подтверждения отказа и восстановления проверки
+type Confirmations = domain.Confirmations
+
+// Config конфигурация инцидентов
+type Config struct {
+	// AutoResolveAfter время без ошибок, после которого успешная проверка закрывает инцидент
+	AutoResolveAfter time.Duration
+	// Retention срок хранения закрытых инцидентов
+	Retention time.Duration
+	// TTL время без ошибок, после которого инцидент закрывается; 0 - без срока
+	TTL time.Duration
+	// Checks состояние проверок для закрытия инцидентов удаленных и выключенных проверок;
+	// nil - инциденты закрываются только по TTL
+	Checks CheckSource
+	// Confirmations число ошибок подряд до открытия инцидента и успехов подряд до его закрытия
+	Confirmations Confirmations
+	// DegradedResponseTime время ответа, с которого успешная проверка считается
+	// деградировавшей; 0 - без degraded
+	DegradedResponseTime time.Duration
+	// Clock источник времени; nil - системные часы
+	Clock clock.Clock
+}
+
+// CheckSource сообщает, включена ли проверка; ошибка с кодом ErrNotFound - проверка удалена
+type CheckSource interface {
+	CheckEnabled(ctx context.Context, checkID string) (bool, error)
+}
+
+const (
+	// escalationCheckInterval период проверки таймеров эскалации простаивающих инцидентов
+	escalationCheckInterval = 15 * time.Second
+	// staleSweepInterval период закрытия устаревших инцидентов
+	staleSweepInterval = time.Minute
+	// slaCheckInterval период проверки сроков SLA активных инцидентов
+	slaCheckInterval = 30 * time.Second
+	// reminderCheckInterval период проверки напоминаний о неподтвержденных инцидентах
+	reminderCheckInterval = time.Minute
+)
+
+// Manager ведет инциденты по результатам проверок
+type Manager struct {
+	incidents  service.IncidentService
+	repo       *memory.IncidentRepository
+	states     *memory.CheckStates
+	escalation *service.EscalationWorker
+	sweeper    *service.StaleIncidentSweeper
+	sla        *service.SLAMonitor
+	reminders  *service.ReminderScheduler
+	retention  time.Duration
+	clock      clock.Clock
+	logger     logger.Logger
+}
+
+// NewManager создает менеджер инцидентов, публикующий события в publisher
+func NewManager(publisher Publisher, config Config, log logger.Logger) *Manager {
+	clk := clock.OrReal(config.Clock)
+	incidentConfig := service.DefaultIncidentConfig()
+	incidentConfig.Clock = clk
+	if config.AutoResolveAfter > 0 {
+		incidentConfig.AutoResolveTimeout = config.AutoResolveAfter
+	}
+	repo := memory.NewIncidentRepository()
+	states := memory.NewCheckStates()
+	timers := memory.NewEscalationTimers()
+	var checks service.CheckStateSource
+	if config.Checks != nil {
+		checks = config.Checks
+	}
+	producer := &incidentProducer{publisher: publisher}
+	slaPolicies, _ := service.ParseSLAPolicies(nil)
+	return &Manager{
+		incidents: service.NewConfirmingIncidentService(
+			service.NewIncidentServiceWithProducer(
+				service.NewEscalationTimerRepository(repo, timers, incidentConfig.EscalationTimeouts, log),
+				incidentConfig, log, producer,
+			),
+			states,
+			service.StateConfig{
+				Confirmations:        config.Confirmations,
+				DegradedResponseTime: config.DegradedResponseTime,
+			},
+			log,
+		),
+		repo:       repo,
+		states:     states,
+		escalation: service.NewEscalationWorker(repo, timers, incidentConfig.EscalationTimeouts, producer, log).WithClock(clk),
+		sweeper:    service.NewStaleIncidentSweeper(repo, checks, config.TTL, producer, log).WithClock(clk),
+		sla:        service.NewSLAMonitor(repo, slaPolicies, producer, log).WithClock(clk),
+		reminders:  service.NewReminderScheduler(repo, domain.DefaultReminderPolicy(), producer, log).WithClock(clk),
+		retention:  config.Retention,
+		clock:      clk,
+		logger:     log,
+	}
+}
+
+// Handle обрабатывает событие check.result из темы check_results
+func (m *Manager) Handle(ctx context.Context, msg *queue.Message) error {
+	var result events.CheckResult
+	if err := events.Decode(msg.Headers, msg.Body, &result); err != nil {
+		return fmt.Errorf("failed to decode check result: %w", err)
+	}
+
+	metadata := make(map[string]interface{}, len(result.Metadata)+1)
+	for key, value := range result.Metadata {
+		metadata[key] = value
+	}
+	// Регион передается полем события, а не метаданными
+	if result.Region != "" {
+		metadata["region"] = result.Region
+	}
+	_, err := m.incidents.ProcessCheckResult(ctx, &service.CheckResult{
+		CheckID:      result.CheckID,
+		TenantID:     result.TenantID,
+		IsSuccess:    result.Success,
+		ErrorMessage: result.Error,
+		Duration:     time.Duration(result.DurationMs) * time.Millisecond,
+		Timestamp:    result.CheckedAt,
+		Metadata:     metadata,
+	})
+	return err
+}
+
+// Incidents возвращает инциденты всех tenant, новые первыми; пустой status - в любом статусе
+func (m *Manager) Incidents(ctx context.Context, status string, limit int) ([]*Incident, error) {
+	filter := &domain.IncidentFilter{Limit: limit}
+	if status != "" {
+		incidentStatus := domain.IncidentStatus(status)
+		filter.Status = &incidentStatus
+	}
+	return m.repo.GetByTenantID(ctx, "", filter)
+}
+
+// CheckState возвращает подтвержденное состояние проверки: up, down, pending или degraded;
+// пусто, если результатов проверки еще не было
+func (m *Manager) CheckState(ctx context.Context, checkID string) (string, error) {
+	status, err := m.states.Get(ctx, checkID)
+	if err != nil || status == nil {
+		return "", err
+	}
+	return string(status.State), nil
+}
+
+// Acknowledge подтверждает инцидент
+func (m *Manager) Acknowledge(ctx context.Context, id string) error {
+	return m.incidents.AcknowledgeIncident(ctx, id)
+}
+
+// Resolve закрывает инцидент вручную
+func (m *Manager) Resolve(ctx context.Context, id string) error {
+	return m.incidents.ResolveIncident(ctx, id)
+}
+
+// State инциденты и подтвержденные состояния проверок для сохранения между перезапусками.
+// Таймеры эскалации не сохраняются: воркер эскалации восстанавливает их по активным инцидентам
+type State struct {
+	Incidents   []*Incident          `json:"incidents"`
+	CheckStates []domain.CheckStatus `json:"check_states"`
+}
+
+// State возвращает инциденты и состояния проверок
+func (m *Manager) State() *State {
+	return &State{Incidents: m.repo.Snapshot(), CheckStates: m.states.Snapshot()}
+}
+
+// Restore заменяет инциденты и состояния проверок значениями из State
+func (m *Manager) Restore(state *State) {
+	m.repo.Restore(state.Incidents)
+	m.states.Restore(state.CheckStates)
+}
+
+// Run эскалирует простаивающие инциденты, отслеживает сроки SLA, напоминает о неподтвержденных
+// инцидентах, закрывает устаревшие и удаляет закрытые инциденты
+// старше срока хранения до отмены контекста
+func (m *Manager) Run(ctx context.Context) {
+	go m.escalation.Run(ctx, escalationCheckInterval)
+	go m.sweeper.Run(ctx, staleSweepInterval)
+	go m.sla.Run(ctx, slaCheckInterval)
+	go m.reminders.Run(ctx, reminderCheckInterval)
+	if m.retention <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if deleted := m.repo.DeleteResolvedBefore(m.clock.Now().Add(-m.retention)); deleted > 0 {
+				m.logger.Info("Deleted resolved incidents past retention",
+					logger.Int("deleted", deleted),
+					logger.Duration("retention", m.retention),
+				)
+			}
+		}
+	}
+}
+
+// incidentProducer публикует события инцидентов в тему notifications
+type incidentProducer struct {
+	publisher Publisher
+}
+
+// PublishIncidentEvent публикует событие с ключом маршрутизации notification-service
+func (p *incidentProducer) PublishIncidentEvent(ctx context.Context, eventType string, incident *domain.Incident, result *rabbitmq.CheckResult) error {
+	body, headers, err := rabbitmq.EncodeIncidentEvent(eventType, incident, result)
+	if err != nil {
+		return err
+	}
+
+	key, ok := notificationKeys[eventType]
+	if !ok {
+		key = eventType
+	}
+	return p.publisher.Publish(ctx, queue.Message{
+		Topic:       TopicNotifications,
+		Key:         key,
+		ContentType: events.ContentType,
+		Headers:     headers,
+		Body:        body,
+	})
+}
+
+// PublishIncidentEventWithRetry публикует событие; повторы выполняет очередь
+func (p *incidentProducer) PublishIncidentEventWithRetry(ctx context.Context, eventType string, incident *domain.Incident, result *rabbitmq.CheckResult) error {
+	return p.PublishIncidentEvent(ctx, eventType, incident, result)
+}
+
+// Close ничего не делает: очередью владеет вызывающий код
+func (p *incidentProducer) Close() error {
+	return nil
+}
+
+// IsConnected всегда истинно для встроенной очереди
+func (p *incidentProducer) IsConnected() bool {
+	return true
+}
@@ -0,0 +1,226 @@
+package standalone
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	"UptimePingPlatform/services/incident-manager/internal/domain"
+	"UptimePingPlatform/services/incident-manager/internal/service"
+)
+
+// recordingPublisher запоминает опубликованные сообщения
+type recordingPublisher struct {
+	mu       sync.Mutex
+	messages []queue.Message
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, msg queue.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func checkResultMessage(t *testing.T, success bool, checkedAt time.Time) *queue.Message {
+	t.Helper()
+	body, headers, err := events.Encode(events.TypeCheckResult, &events.CheckResult{
+		CheckID:    "11111111-1111-1111-1111-111111111111",
+		TenantID:   "00000000-0000-0000-0000-000000000001",
+		Success:    success,
+		DurationMs: 120,
+		Error:      map[bool]string{false: "connection refused"}[success],
+		CheckedAt:  checkedAt,
+		Metadata:   map[string]string{"notify_channels": "email:ops@example.com"},
+	})
+	require.NoError(t, err)
+	return &queue.Message{Topic: "check_results", Key: events.TypeCheckResult, ContentType: events.ContentType, Headers: headers, Body: body}
+}
+
+func TestManager_OpensAndResolvesIncidents(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	publisher := &recordingPublisher{}
+	manager := NewManager(publisher, Config{AutoResolveAfter: time.Millisecond}, log)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, false, time.Now())))
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, false, time.Now())))
+
+	open, err := manager.Incidents(ctx, string(domain.IncidentStatusOpen), 0)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, 2, open[0].Count)
+	assert.Equal(t, "connection refused", open[0].ErrorMessage)
+
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, true, time.Now())))
+
+	resolved, err := manager.Incidents(ctx, string(domain.IncidentStatusResolved), 0)
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+
+	// События incident-manager переводятся в ключи маршрутизации notification-service
+	var keys []string
+	for _, msg := range publisher.messages {
+		assert.Equal(t, TopicNotifications, msg.Topic)
+		assert.Equal(t, events.ContentType, msg.ContentType)
+		keys = append(keys, msg.Key)
+	}
+	assert.Equal(t, []string{"incident.created", "incident.updated", "incident.resolved"}, keys)
+
+	var incident events.Incident
+	require.NoError(t, events.Decode(publisher.messages[0].Headers, publisher.messages[0].Body, &incident))
+	assert.Equal(t, open[0].ID, incident.IncidentID)
+	assert.Equal(t, "email:ops@example.com", incident.Data["notify_channels"])
+}
+
+func TestManager_RestoresRegionMetadata(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	manager := NewManager(&recordingPublisher{}, Config{}, log)
+	ctx := context.Background()
+
+	body, headers, err := events.Encode(events.TypeCheckResult, &events.CheckResult{
+		CheckID:   "11111111-1111-1111-1111-111111111111",
+		TenantID:  "00000000-0000-0000-0000-000000000001",
+		Error:     "connection refused",
+		Region:    "eu-west",
+		CheckedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, manager.Handle(ctx, &queue.Message{Topic: "check_results", Key: events.TypeCheckResult,
+		ContentType: events.ContentType, Headers: headers, Body: body}))
+
+	open, err := manager.Incidents(ctx, string(domain.IncidentStatusOpen), 0)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, map[string]string{"region": "eu-west"}, open[0].Metadata[service.FailureDetailsKey])
+}
+
+func TestManager_RejectsMalformedResults(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	manager := NewManager(&recordingPublisher{}, Config{}, log)
+
+	err = manager.Handle(context.Background(), &queue.Message{Body: []byte("{")})
+	assert.Error(t, err)
+}
+
+func TestManager_TimeTravel_AutoResolve(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	manager := NewManager(&recordingPublisher{}, Config{AutoResolveAfter: 10 * time.Minute, Clock: clk}, log)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, false, clk.Now())))
+
+	// Успех раньше срока автоматического закрытия не закрывает инцидент
+	clk.Advance(5 * time.Minute)
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, true, clk.Now())))
+	open, err := manager.Incidents(ctx, string(domain.IncidentStatusOpen), 0)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+
+	clk.Advance(6 * time.Minute)
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, true, clk.Now())))
+	resolved, err := manager.Incidents(ctx, string(domain.IncidentStatusResolved), 0)
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+	require.NotNil(t, resolved[0].ResolvedAt)
+	assert.Equal(t, clk.Now(), *resolved[0].ResolvedAt)
+}
+
+func TestManager_TimeTravel_Escalation(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	manager := NewManager(&recordingPublisher{}, Config{Clock: clk}, log)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, false, clk.Now())))
+	open, err := manager.Incidents(ctx, string(domain.IncidentStatusOpen), 0)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	severity := open[0].Severity
+	timeout := service.DefaultIncidentConfig().EscalationTimeouts[severity]
+	require.NotZero(t, timeout)
+
+	// До срока эскалации таймер не срабатывает
+	clk.Advance(timeout - time.Second)
+	escalated, err := manager.escalation.EscalateDue(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, escalated)
+
+	clk.Advance(time.Second)
+	escalated, err = manager.escalation.EscalateDue(ctx)
+	require.NoError(t, err)
+	require.Len(t, escalated, 1)
+	next, _ := domain.NextSeverity(severity)
+	assert.Equal(t, next, escalated[0].Severity)
+	escalatedAt, ok := escalated[0].EscalatedAt()
+	require.True(t, ok)
+	assert.True(t, clk.Now().Equal(escalatedAt))
+}
+
+func TestManager_TimeTravel_SLABreach(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	publisher := &recordingPublisher{}
+	manager := NewManager(publisher, Config{Clock: clk}, log)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, false, clk.Now())))
+	open, err := manager.Incidents(ctx, string(domain.IncidentStatusOpen), 0)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	target := domain.DefaultSLAPolicy()[open[0].Severity].AcknowledgeWithin
+	require.NotZero(t, target)
+
+	clk.Advance(target)
+	breaches, err := manager.sla.CheckBreaches(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, breaches)
+	assert.Equal(t, domain.SLAKindAcknowledge, breaches[0].Kind)
+
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+	keys := make([]string, 0, len(publisher.messages))
+	for _, msg := range publisher.messages {
+		keys = append(keys, msg.Key)
+	}
+	assert.Contains(t, keys, events.TypeIncidentSLABreached)
+}
+
+func TestManager_TimeTravel_Reminder(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "incident-manager", false)
+	require.NoError(t, err)
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	manager := NewManager(&recordingPublisher{}, Config{Clock: clk}, log)
+	ctx := context.Background()
+
+	require.NoError(t, manager.Handle(ctx, checkResultMessage(t, false, clk.Now())))
+	interval := domain.DefaultReminderPolicy().Interval
+
+	// До интервала политики напоминание не отправляется
+	clk.Advance(interval - time.Second)
+	sent, err := manager.reminders.SendReminders(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, sent)
+
+	clk.Advance(time.Second)
+	sent, err = manager.reminders.SendReminders(ctx)
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	assert.Equal(t, 1, sent[0].Number)
+}
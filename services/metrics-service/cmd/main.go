@@ -11,10 +11,18 @@ import (
 	"time"
 
 	"UptimePingPlatform/pkg/config"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/health"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/metrics"
 	pkg_redis "UptimePingPlatform/pkg/redis"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/metrics-service/internal/slo"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -32,6 +40,7 @@ func main() {
 	defer appLogger.Sync()
 
 	appLogger.Info("Starting Metrics Service...")
+	appLogger.Info("Effective configuration", logger.Any("config", cfg.Redacted()))
 
 	// Initialize metrics
 	appMetrics := metrics.NewMetrics("metrics-service")
@@ -52,6 +61,15 @@ func main() {
 		defer redisClient.Close()
 	}
 
+	// Оценка скорости расхода бюджета ошибок SLO с открытием инцидентов в Incident Manager
+	sloCtx, stopSLO := context.WithCancel(context.Background())
+	defer stopSLO()
+	if sloFile := os.Getenv("SLO_DEFINITIONS_FILE"); sloFile != "" {
+		if err := startSLOEvaluator(sloCtx, sloFile, cfg.GRPC.ServiceToken, appLogger); err != nil {
+			appLogger.Error("Failed to start SLO evaluator, burn rate alerting disabled", logger.Error(err))
+		}
+	}
+
 	// Start HTTP server for metrics and health
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
@@ -83,6 +101,57 @@ func main() {
 	appLogger.Info("Server stopped")
 }
 
+// startSLOEvaluator загружает SLO и запускает их периодическую оценку; вызовы сервисов
+// подписываются общим секретом serviceToken
+func startSLOEvaluator(ctx context.Context, path, serviceToken string, appLogger logger.Logger) error {
+	definitions, err := slo.LoadDefinitions(path)
+	if err != nil {
+		return err
+	}
+
+	dial := func(envName, defaultAddr string) (*grpc.ClientConn, error) {
+		addr := os.Getenv(envName)
+		if addr == "" {
+			addr = defaultAddr
+		}
+		return grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), pkg_grpc.WithServiceToken(serviceToken))
+	}
+	coreConn, err := dial("CORE_SERVICE_ADDR", "core-service:50054")
+	if err != nil {
+		return err
+	}
+	schedulerConn, err := dial("SCHEDULER_SERVICE_ADDR", "scheduler-service:50052")
+	if err != nil {
+		return err
+	}
+	incidentConn, err := dial("INCIDENT_SERVICE_ADDR", "incident-manager:50056")
+	if err != nil {
+		return err
+	}
+
+	interval := time.Minute
+	if value := os.Getenv("SLO_EVALUATION_INTERVAL"); value != "" {
+		if interval, err = time.ParseDuration(value); err != nil || interval <= 0 {
+			return fmt.Errorf("invalid SLO_EVALUATION_INTERVAL %q", value)
+		}
+	}
+
+	evaluator := slo.NewEvaluator(
+		slo.NewHistorySource(corev1.NewCoreServiceClient(coreConn), schedulerv1.NewSchedulerServiceClient(schedulerConn)),
+		incidentv1.NewIncidentServiceClient(incidentConn),
+		appLogger,
+	)
+	go func() {
+		defer coreConn.Close()
+		defer schedulerConn.Close()
+		defer incidentConn.Close()
+		evaluator.Run(ctx, definitions, interval)
+	}()
+
+	appLogger.Info(fmt.Sprintf("Evaluating %d SLOs every %s", len(definitions), interval))
+	return nil
+}
+
 func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, appLogger logger.Logger) http.Handler {
 	mux := http.NewServeMux()
 	
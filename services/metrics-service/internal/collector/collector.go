@@ -26,6 +26,10 @@ type MetricsCollector struct {
 	services    map[string]*ServiceMetrics
 	mu          sync.RWMutex
 	httpHandler http.Handler
+
+	// Бюджеты проб tenant в единицах стоимости в час и доля их расхода
+	budgets     map[string]float64
+	budgetUsage *prometheus.GaugeVec
 }
 
 // ServiceMetrics содержит метрики для конкретного сервиса
@@ -39,6 +43,17 @@ type ServiceMetrics struct {
 	RequestDuration *prometheus.HistogramVec
 	ErrorCount      *prometheus.CounterVec
 	ActiveConnections prometheus.Gauge
+	PhaseDuration   *prometheus.HistogramVec
+	GroupUptime     *prometheus.GaugeVec
+	TenantCost      *prometheus.GaugeVec
+	
+	// Последние значения счетчиков результатов по группам проверок
+	groupMu      sync.Mutex
+	groupResults map[string]*groupResultCounts
+	
+	// Последние значения счетчиков стоимости выполнений по tenant
+	costMu      sync.Mutex
+	tenantCosts map[string]*tenantCostCounts
 	
 	// gRPC клиенты для метрик
 	metricsClient domain.MetricsServiceClient
@@ -85,6 +100,15 @@ func (mc *MetricsCollector) registerSystemMetrics() {
 			Help: "Total number of metrics scrapes",
 		},
 	))
+	
+	mc.budgetUsage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "probe_budget_usage_ratio",
+			Help: "Share of the tenant probe budget (cost units per hour) used over the last hour",
+		},
+		[]string{"tenant"},
+	)
+	mc.registry.MustRegister(mc.budgetUsage)
 }
 
 // AddService добавляет сервис для мониторинга
@@ -140,6 +164,30 @@ func (mc *MetricsCollector) AddService(name, address string) error {
 				Help: fmt.Sprintf("Number of active connections to %s", name),
 			},
 		),
+		PhaseDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    fmt.Sprintf("%s_check_phase_duration_seconds", name),
+				Help:    fmt.Sprintf("Check phase durations (dns, tcp_connect, tls_handshake, ttfb, total) reported by %s", name),
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"type", "phase"},
+		),
+		GroupUptime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: fmt.Sprintf("%s_group_uptime_ratio", name),
+				Help: fmt.Sprintf("Share of successful checks per check group (including subgroups) reported by %s", name),
+			},
+			[]string{"group"},
+		),
+		TenantCost: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: fmt.Sprintf("%s_tenant_cost_units_per_hour", name),
+				Help: fmt.Sprintf("Check execution cost units per hour by tenant reported by %s", name),
+			},
+			[]string{"tenant"},
+		),
+		groupResults: make(map[string]*groupResultCounts),
+		tenantCosts:  make(map[string]*tenantCostCounts),
 	}
 	
 	// Регистрируем метрики в реестре
@@ -148,6 +196,9 @@ func (mc *MetricsCollector) AddService(name, address string) error {
 	registry.MustRegister(serviceMetrics.RequestDuration)
 	registry.MustRegister(serviceMetrics.ErrorCount)
 	registry.MustRegister(serviceMetrics.ActiveConnections)
+	registry.MustRegister(serviceMetrics.PhaseDuration)
+	registry.MustRegister(serviceMetrics.GroupUptime)
+	registry.MustRegister(serviceMetrics.TenantCost)
 	
 	// Создаем gRPC клиенты
 	metricsClient := domain.NewMetricsServiceClient(conn)
@@ -230,7 +281,25 @@ func (mc *MetricsCollector) collectGRPCMetrics(ctx context.Context, name string,
 	}
 	
 	// Обновляем метрики
+	now := time.Now()
 	for _, metric := range resp.Metrics {
+		// Длительности фаз проверок сохраняются отдельно, чтобы регрессии
+		// латентности можно было отнести к конкретной фазе
+		if metric.Name == PhaseDurationMetric {
+			observePhaseDuration(sm, metric)
+			continue
+		}
+		// Результаты по группам агрегируются в долю успешных проверок группы
+		if metric.Name == GroupResultsMetric {
+			observeGroupResult(sm, metric)
+			continue
+		}
+		// Стоимость выполнений агрегируется по tenant для учета бюджета проб
+		if metric.Name == CostUnitsMetric {
+			observeCost(sm, metric, now)
+			continue
+		}
+
 		switch metric.Type {
 		case "counter":
 			if counter, ok := metric.Value.(float64); ok {
@@ -249,6 +318,7 @@ func (mc *MetricsCollector) collectGRPCMetrics(ctx context.Context, name string,
 			}
 		}
 	}
+	mc.updateBudgetUsage()
 	
 	// Проверяем здоровье сервиса
 	healthReq := &domain.HealthCheckRequest{
@@ -268,6 +338,91 @@ func (mc *MetricsCollector) collectGRPCMetrics(ctx context.Context, name string,
 	return nil
 }
 
+// PhaseDurationMetric имя метрики длительности фаз проверки, публикуемой core-service
+const PhaseDurationMetric = "check_phase_duration_seconds"
+
+// observePhaseDuration переносит среднюю длительность фазы из гистограммы сервиса
+func observePhaseDuration(sm *ServiceMetrics, metric domain.Metric) {
+	phase := metric.Tags["phase"]
+	if phase == "" || sm.PhaseDuration == nil {
+		return
+	}
+
+	histogram, ok := metric.Value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	countVal, ok := histogram["count"].(float64)
+	if !ok || countVal == 0 {
+		return
+	}
+	sumVal, ok := histogram["sum"].(float64)
+	if !ok {
+		return
+	}
+
+	sm.PhaseDuration.WithLabelValues(metric.Tags["type"], phase).Observe(sumVal / countVal)
+}
+
+// GroupResultsMetric имя счетчика результатов проверок по группам, публикуемого core-service.
+// Каждый результат учитывается во всех группах-предках, поэтому метка group покрывает поддерево.
+const GroupResultsMetric = "group_check_results_total"
+
+// groupResultCounts последние значения счетчиков успешных и неудачных проверок группы
+type groupResultCounts struct {
+	success float64
+	failure float64
+}
+
+// observeGroupResult сохраняет значение счетчика группы и пересчитывает uptime группы
+func observeGroupResult(sm *ServiceMetrics, metric domain.Metric) {
+	group := metric.Tags["group"]
+	if group == "" || sm.GroupUptime == nil {
+		return
+	}
+	value, ok := metric.Value.(float64)
+	if !ok {
+		return
+	}
+
+	sm.groupMu.Lock()
+	defer sm.groupMu.Unlock()
+
+	if sm.groupResults == nil {
+		sm.groupResults = make(map[string]*groupResultCounts)
+	}
+	counts, exists := sm.groupResults[group]
+	if !exists {
+		counts = &groupResultCounts{}
+		sm.groupResults[group] = counts
+	}
+
+	switch metric.Tags["status"] {
+	case "success":
+		counts.success = value
+	case "failure":
+		counts.failure = value
+	default:
+		return
+	}
+
+	if total := counts.success + counts.failure; total > 0 {
+		sm.GroupUptime.WithLabelValues(group).Set(counts.success / total)
+	}
+}
+
+// GroupUptimeRatio возвращает долю успешных проверок группы по данным сервиса
+func (sm *ServiceMetrics) GroupUptimeRatio(group string) (float64, bool) {
+	sm.groupMu.Lock()
+	defer sm.groupMu.Unlock()
+
+	counts, exists := sm.groupResults[group]
+	if !exists || counts.success+counts.failure == 0 {
+		return 0, false
+	}
+	return counts.success / (counts.success + counts.failure), true
+}
+
 // GetHandler возвращает HTTP обработчик для метрик
 func (mc *MetricsCollector) GetHandler() http.Handler {
 	return mc.httpHandler
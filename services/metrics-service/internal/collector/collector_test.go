@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	pkglogger "UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/metrics-service/internal/domain"
 )
 
 // MockLogger для тестов
@@ -321,3 +322,91 @@ func BenchmarkMetricsCollector_GetServices(b *testing.B) {
 		collector.GetServices()
 	}
 }
+
+func TestObservePhaseDuration(t *testing.T) {
+	sm := &ServiceMetrics{
+		PhaseDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: "test_check_phase_duration_seconds", Buckets: prometheus.DefBuckets},
+			[]string{"type", "phase"},
+		),
+	}
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(sm.PhaseDuration)
+
+	observePhaseDuration(sm, domain.Metric{
+		Name:  PhaseDurationMetric,
+		Type:  "histogram",
+		Value: map[string]interface{}{"count": float64(4), "sum": float64(2)},
+		Tags:  map[string]string{"type": "http", "phase": "tls_handshake"},
+	})
+	// Метрики без фазы игнорируются
+	observePhaseDuration(sm, domain.Metric{
+		Name:  PhaseDurationMetric,
+		Type:  "histogram",
+		Value: map[string]interface{}{"count": float64(1), "sum": float64(1)},
+		Tags:  map[string]string{"type": "http"},
+	})
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	require.Len(t, families[0].Metric, 1)
+
+	histogram := families[0].Metric[0].GetHistogram()
+	assert.Equal(t, uint64(1), histogram.GetSampleCount())
+	assert.InDelta(t, 0.5, histogram.GetSampleSum(), 0.0001)
+}
+
+func TestObserveGroupResult(t *testing.T) {
+	sm := &ServiceMetrics{
+		GroupUptime: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{Name: "test_group_uptime_ratio"},
+			[]string{"group"},
+		),
+	}
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(sm.GroupUptime)
+
+	observeGroupResult(sm, domain.Metric{
+		Name:  GroupResultsMetric,
+		Type:  "counter",
+		Value: float64(9),
+		Tags:  map[string]string{"group": "g1", "status": "success"},
+	})
+	observeGroupResult(sm, domain.Metric{
+		Name:  GroupResultsMetric,
+		Type:  "counter",
+		Value: float64(1),
+		Tags:  map[string]string{"group": "g1", "status": "failure"},
+	})
+	// Метрики без группы игнорируются
+	observeGroupResult(sm, domain.Metric{
+		Name:  GroupResultsMetric,
+		Type:  "counter",
+		Value: float64(5),
+		Tags:  map[string]string{"status": "failure"},
+	})
+
+	ratio, ok := sm.GroupUptimeRatio("g1")
+	require.True(t, ok)
+	assert.InDelta(t, 0.9, ratio, 0.0001)
+
+	_, ok = sm.GroupUptimeRatio("unknown")
+	assert.False(t, ok)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	require.Len(t, families[0].Metric, 1)
+	assert.InDelta(t, 0.9, families[0].Metric[0].GetGauge().GetValue(), 0.0001)
+
+	// Счетчики накопительные: новое значение заменяет предыдущее
+	observeGroupResult(sm, domain.Metric{
+		Name:  GroupResultsMetric,
+		Type:  "counter",
+		Value: float64(11),
+		Tags:  map[string]string{"group": "g1", "status": "success"},
+	})
+	ratio, _ = sm.GroupUptimeRatio("g1")
+	assert.InDelta(t, 11.0/12.0, ratio, 0.0001)
+}
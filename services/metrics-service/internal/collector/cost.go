@@ -0,0 +1,223 @@
+package collector
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/services/metrics-service/internal/domain"
+)
+
+// CostUnitsMetric имя счетчика стоимости выполнений проверок по типу и tenant, публикуемого
+// core-service. Метка tenant ограничена по кардинальности: кроме ID tenant встречаются other,
+// unknown и bucket_N
+const CostUnitsMetric = "check_cost_units_total"
+
+// CostRateWindow окно, по которому считается расход стоимости в час
+const CostRateWindow = time.Hour
+
+// costSample суммарная стоимость tenant в момент сбора
+type costSample struct {
+	at    time.Time
+	total float64
+}
+
+// tenantCostCounts последние значения счетчиков стоимости tenant по типам проверок и история
+// суммарной стоимости за CostRateWindow
+type tenantCostCounts struct {
+	byType  map[string]float64
+	samples []costSample
+}
+
+// total суммарная стоимость по всем типам проверок
+func (c *tenantCostCounts) total() float64 {
+	var total float64
+	for _, units := range c.byType {
+		total += units
+	}
+	return total
+}
+
+// perHour расход стоимости в час по истории за окно; false - история короче двух сборов
+func (c *tenantCostCounts) perHour() (float64, bool) {
+	if len(c.samples) < 2 {
+		return 0, false
+	}
+	first, last := c.samples[0], c.samples[len(c.samples)-1]
+	span := last.at.Sub(first.at)
+	if span <= 0 {
+		return 0, false
+	}
+	return (last.total - first.total) / span.Hours(), true
+}
+
+// TenantCost стоимость выполнений проверок tenant и расход бюджета проб
+type TenantCost struct {
+	TenantID         string             `json:"tenant_id"`
+	CostUnits        float64            `json:"cost_units"`
+	CostUnitsPerHour float64            `json:"cost_units_per_hour"`
+	ByType           map[string]float64 `json:"by_type"`
+	BudgetPerHour    float64            `json:"budget_per_hour,omitempty"`
+	BudgetUsage      float64            `json:"budget_usage,omitempty"`
+}
+
+// observeCost сохраняет значение счетчика стоимости tenant и пересчитывает расход в час
+func observeCost(sm *ServiceMetrics, metric domain.Metric, now time.Time) {
+	tenant := metric.Tags["tenant"]
+	if tenant == "" {
+		return
+	}
+	value, ok := metric.Value.(float64)
+	if !ok {
+		return
+	}
+
+	sm.costMu.Lock()
+	defer sm.costMu.Unlock()
+
+	if sm.tenantCosts == nil {
+		sm.tenantCosts = make(map[string]*tenantCostCounts)
+	}
+	counts, exists := sm.tenantCosts[tenant]
+	if !exists {
+		counts = &tenantCostCounts{byType: make(map[string]float64)}
+		sm.tenantCosts[tenant] = counts
+	}
+	counts.byType[metric.Tags["type"]] = value
+
+	total := counts.total()
+	switch last := len(counts.samples) - 1; {
+	case last >= 0 && total < counts.samples[last].total:
+		// Счетчик сброшен перезапуском сервиса: расход считается заново
+		counts.samples = counts.samples[:0]
+	case last >= 0 && counts.samples[last].at.Equal(now):
+		// Другой тип проверки того же сбора
+		counts.samples = counts.samples[:last]
+	}
+	counts.samples = append(counts.samples, costSample{at: now, total: total})
+
+	cutoff := now.Add(-CostRateWindow)
+	drop := 0
+	for drop < len(counts.samples)-1 && counts.samples[drop].at.Before(cutoff) {
+		drop++
+	}
+	counts.samples = counts.samples[drop:]
+
+	if perHour, ok := counts.perHour(); ok && sm.TenantCost != nil {
+		sm.TenantCost.WithLabelValues(tenant).Set(perHour)
+	}
+}
+
+// TenantCosts возвращает стоимость выполнений проверок по tenant по данным сервиса
+func (sm *ServiceMetrics) TenantCosts() map[string]TenantCost {
+	sm.costMu.Lock()
+	defer sm.costMu.Unlock()
+
+	costs := make(map[string]TenantCost, len(sm.tenantCosts))
+	for tenant, counts := range sm.tenantCosts {
+		cost := TenantCost{
+			TenantID:  tenant,
+			CostUnits: counts.total(),
+			ByType:    make(map[string]float64, len(counts.byType)),
+		}
+		for checkType, units := range counts.byType {
+			cost.ByType[checkType] = units
+		}
+		cost.CostUnitsPerHour, _ = counts.perHour()
+		costs[tenant] = cost
+	}
+	return costs
+}
+
+// ParseProbeBudgets разбирает бюджеты проб в формате "tenant_id:units_per_hour,tenant_id:units_per_hour"
+func ParseProbeBudgets(spec string) (map[string]float64, error) {
+	budgets := make(map[string]float64)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		tenantID, value, ok := strings.Cut(entry, ":")
+		if !ok || tenantID == "" {
+			return nil, fmt.Errorf("invalid probe budget entry %q, expected tenant_id:units_per_hour", entry)
+		}
+		units, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || units <= 0 {
+			return nil, fmt.Errorf("invalid probe budget for tenant %s: %q", tenantID, value)
+		}
+		budgets[tenantID] = units
+	}
+	return budgets, nil
+}
+
+// WithProbeBudgets задает бюджеты проб tenant в единицах стоимости в час
+func (mc *MetricsCollector) WithProbeBudgets(budgets map[string]float64) *MetricsCollector {
+	mc.mu.Lock()
+	mc.budgets = budgets
+	mc.mu.Unlock()
+	mc.updateBudgetUsage()
+	return mc
+}
+
+// TenantCosts возвращает стоимость выполнений проверок по tenant, сложенную по всем сервисам,
+// с расходом бюджета проб. Результат отсортирован по tenant
+func (mc *MetricsCollector) TenantCosts() []TenantCost {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+
+	merged := make(map[string]TenantCost)
+	for _, sm := range mc.services {
+		for tenant, cost := range sm.TenantCosts() {
+			total, exists := merged[tenant]
+			if !exists {
+				total = TenantCost{TenantID: tenant, ByType: make(map[string]float64)}
+			}
+			total.CostUnits += cost.CostUnits
+			total.CostUnitsPerHour += cost.CostUnitsPerHour
+			for checkType, units := range cost.ByType {
+				total.ByType[checkType] += units
+			}
+			merged[tenant] = total
+		}
+	}
+
+	costs := make([]TenantCost, 0, len(merged))
+	for tenant, cost := range merged {
+		if budget, ok := mc.budgets[tenant]; ok {
+			cost.BudgetPerHour = budget
+			cost.BudgetUsage = cost.CostUnitsPerHour / budget
+		}
+		costs = append(costs, cost)
+	}
+	sort.Slice(costs, func(i, j int) bool { return costs[i].TenantID < costs[j].TenantID })
+	return costs
+}
+
+// TenantCost возвращает стоимость выполнений проверок одного tenant
+func (mc *MetricsCollector) TenantCost(tenantID string) (TenantCost, bool) {
+	for _, cost := range mc.TenantCosts() {
+		if cost.TenantID == tenantID {
+			return cost, true
+		}
+	}
+	return TenantCost{}, false
+}
+
+// updateBudgetUsage обновляет долю израсходованного бюджета проб tenant с заданным бюджетом
+func (mc *MetricsCollector) updateBudgetUsage() {
+	if mc.budgetUsage == nil {
+		return
+	}
+	usage := make(map[string]float64)
+	for _, cost := range mc.TenantCosts() {
+		usage[cost.TenantID] = cost.BudgetUsage
+	}
+
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	for tenant := range mc.budgets {
+		mc.budgetUsage.WithLabelValues(tenant).Set(usage[tenant])
+	}
+}
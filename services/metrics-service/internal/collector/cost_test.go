@@ -0,0 +1,104 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/metrics-service/internal/domain"
+)
+
+func costMetric(tenant, checkType string, value float64) domain.Metric {
+	return domain.Metric{
+		Name:  CostUnitsMetric,
+		Type:  "counter",
+		Value: value,
+		Tags:  map[string]string{"tenant": tenant, "type": checkType},
+	}
+}
+
+func TestObserveCost(t *testing.T) {
+	sm := &ServiceMetrics{
+		TenantCost: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{Name: "test_tenant_cost_units_per_hour"},
+			[]string{"tenant"},
+		),
+	}
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	observeCost(sm, costMetric("tenant-1", "http", 10), start)
+	observeCost(sm, costMetric("tenant-1", "tcp", 2), start)
+	// Метрики без tenant игнорируются
+	observeCost(sm, costMetric("", "http", 100), start)
+
+	costs := sm.TenantCosts()
+	require.Len(t, costs, 1)
+	assert.Equal(t, 12.0, costs["tenant-1"].CostUnits)
+	assert.Equal(t, map[string]float64{"http": 10, "tcp": 2}, costs["tenant-1"].ByType)
+	assert.Zero(t, costs["tenant-1"].CostUnitsPerHour)
+
+	// За 30 минут израсходовано 6 единиц - 12 в час
+	observeCost(sm, costMetric("tenant-1", "http", 14), start.Add(30*time.Minute))
+	observeCost(sm, costMetric("tenant-1", "tcp", 4), start.Add(30*time.Minute))
+	costs = sm.TenantCosts()
+	assert.Equal(t, 18.0, costs["tenant-1"].CostUnits)
+	assert.InDelta(t, 12.0, costs["tenant-1"].CostUnitsPerHour, 0.0001)
+	assert.InDelta(t, 12.0, testutil.ToFloat64(sm.TenantCost.WithLabelValues("tenant-1")), 0.0001)
+
+	// Сборы старше окна не учитываются
+	observeCost(sm, costMetric("tenant-1", "http", 15), start.Add(90*time.Minute))
+	assert.InDelta(t, 1.0, sm.TenantCosts()["tenant-1"].CostUnitsPerHour, 0.0001)
+
+	// Сброс счетчика после перезапуска сервиса начинает расчет расхода заново
+	observeCost(sm, costMetric("tenant-1", "http", 0), start.Add(100*time.Minute))
+	observeCost(sm, costMetric("tenant-1", "tcp", 0), start.Add(100*time.Minute))
+	assert.Zero(t, sm.TenantCosts()["tenant-1"].CostUnitsPerHour)
+}
+
+func TestMetricsCollector_TenantCosts(t *testing.T) {
+	collector := createTestCollector()
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, name := range []string{"core-eu", "core-us"} {
+		sm := &ServiceMetrics{Name: name}
+		observeCost(sm, costMetric("tenant-1", "http", 10), start)
+		observeCost(sm, costMetric("tenant-1", "http", 20), start.Add(time.Hour))
+		observeCost(sm, costMetric("tenant-2", "icmp", 1), start)
+		collector.services[name] = sm
+	}
+	collector.WithProbeBudgets(map[string]float64{"tenant-1": 40, "tenant-3": 5})
+
+	costs := collector.TenantCosts()
+	require.Len(t, costs, 2)
+	assert.Equal(t, "tenant-1", costs[0].TenantID)
+	assert.Equal(t, 40.0, costs[0].CostUnits)
+	assert.InDelta(t, 20.0, costs[0].CostUnitsPerHour, 0.0001)
+	assert.Equal(t, 40.0, costs[0].BudgetPerHour)
+	assert.InDelta(t, 0.5, costs[0].BudgetUsage, 0.0001)
+	assert.Equal(t, map[string]float64{"icmp": 2}, costs[1].ByType)
+	assert.Zero(t, costs[1].BudgetPerHour)
+
+	assert.InDelta(t, 0.5, testutil.ToFloat64(collector.budgetUsage.WithLabelValues("tenant-1")), 0.0001)
+	assert.Zero(t, testutil.ToFloat64(collector.budgetUsage.WithLabelValues("tenant-3")))
+
+	cost, ok := collector.TenantCost("tenant-2")
+	require.True(t, ok)
+	assert.Equal(t, 2.0, cost.CostUnits)
+	_, ok = collector.TenantCost("tenant-3")
+	assert.False(t, ok)
+}
+
+func TestParseProbeBudgets(t *testing.T) {
+	budgets, err := ParseProbeBudgets("tenant-1:100, tenant-2:2.5")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"tenant-1": 100, "tenant-2": 2.5}, budgets)
+
+	for _, spec := range []string{"tenant-1", ":10", "tenant-1:0", "tenant-1:many"} {
+		_, err := ParseProbeBudgets(spec)
+		assert.Error(t, err, spec)
+	}
+}
@@ -33,6 +33,7 @@ func (h *HTTPHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/api/services/metrics/", h.handleServiceMetrics)
 	mux.HandleFunc("/api/scrape", h.handleScrape)
 	mux.HandleFunc("/api/status", h.handleStatus)
+	mux.HandleFunc("/api/costs", h.handleCosts)
 	
 	// Health check эндпоинты
 	mux.HandleFunc("/health", h.handleHealth)
@@ -278,6 +279,7 @@ func (h *HTTPHandler) handleStatus(w http.ResponseWriter, r *http.Request) {
 			"services":  "/api/services",
 			"scrape":    "/api/scrape",
 			"status":    "/api/status",
+			"costs":     "/api/costs",
 			"health":    "/health",
 		},
 	}
@@ -286,6 +288,38 @@ func (h *HTTPHandler) handleStatus(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(status)
 }
 
+// handleCosts возвращает стоимость выполнений проверок и расход бюджета проб по tenant;
+// параметр tenant_id ограничивает ответ одним tenant
+func (h *HTTPHandler) handleCosts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	costs := h.collector.TenantCosts()
+	if tenantID := r.URL.Query().Get("tenant_id"); tenantID != "" {
+		cost, ok := h.collector.TenantCost(tenantID)
+		if !ok {
+			http.Error(w, "No cost data for tenant", http.StatusNotFound)
+			return
+		}
+		costs = []collector.TenantCost{cost}
+	}
+
+	response := map[string]interface{}{
+		"tenants":     costs,
+		"count":       len(costs),
+		"rate_window": collector.CostRateWindow.String(),
+		"timestamp":   time.Now().UTC(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		h.logger.Error("Failed to encode costs response", pkglogger.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
 // Health check обработчики
 func (h *HTTPHandler) handleHealth(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
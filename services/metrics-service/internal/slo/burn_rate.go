@@ -0,0 +1,308 @@
+package slo
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pkglogger "UptimePingPlatform/pkg/logger"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+)
+
+// BurnRateRule правило многооконного оповещения: срабатывает, когда скорость расхода бюджета
+// ошибок в длинном и в коротком окне не меньше Factor. Короткое окно снимает оповещение
+// вскоре после восстановления
+type BurnRateRule struct {
+	Name        string
+	LongWindow  time.Duration
+	ShortWindow time.Duration
+	Factor      float64
+	Severity    incidentv1.IncidentSeverity
+}
+
+// DefaultRules правила для 30-дневного окна по рекомендациям Google SRE: срочные оповещения
+// при расходе 2% бюджета за час и 5% за шесть часов, некритичные - 10% за сутки и за три дня
+func DefaultRules() []BurnRateRule {
+	return []BurnRateRule{
+		{Name: "page_fast", LongWindow: time.Hour, ShortWindow: 5 * time.Minute, Factor: 14.4, Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL},
+		{Name: "page_slow", LongWindow: 6 * time.Hour, ShortWindow: 30 * time.Minute, Factor: 6, Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL},
+		{Name: "ticket_fast", LongWindow: 24 * time.Hour, ShortWindow: 2 * time.Hour, Factor: 3, Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_WARNING},
+		{Name: "ticket_slow", LongWindow: 3 * 24 * time.Hour, ShortWindow: 6 * time.Hour, Factor: 1, Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_WARNING},
+	}
+}
+
+// Result результат выполнения проверки
+type Result struct {
+	At      time.Time
+	Success bool
+}
+
+// ResultSource источник результатов проверок, к которым относится SLO
+type ResultSource interface {
+	Results(ctx context.Context, definition Definition, since time.Time) ([]Result, error)
+}
+
+// IncidentClient часть клиента Incident Manager, через которую открываются и закрываются инциденты SLO
+type IncidentClient interface {
+	CreateIncident(ctx context.Context, in *incidentv1.CreateIncidentRequest, opts ...grpc.CallOption) (*incidentv1.Incident, error)
+	ResolveIncident(ctx context.Context, in *incidentv1.ResolveIncidentRequest, opts ...grpc.CallOption) (*incidentv1.ResolveIncidentResponse, error)
+}
+
+// WindowBurnRate скорость расхода бюджета ошибок в окне: 1 означает расход ровно к концу окна SLO
+type WindowBurnRate struct {
+	Window   time.Duration
+	BurnRate float64
+	Total    int
+}
+
+// Status результат оценки SLO
+type Status struct {
+	Definition Definition
+	BurnRates  []WindowBurnRate
+	// Firing самое срочное сработавшее правило, nil - бюджет расходуется допустимо
+	Firing *BurnRateRule
+}
+
+// Evaluator оценивает скорость расхода бюджета ошибок SLO и открывает инциденты в Incident Manager,
+// пока правило срабатывает, закрывая их после восстановления. Инциденты SLO привязываются не к
+// проверке, а к IncidentCheckID, и не пересекаются с оповещениями о недоступности
+type Evaluator struct {
+	results   ResultSource
+	incidents IncidentClient
+	rules     []BurnRateRule
+	logger    pkglogger.Logger
+	now       func() time.Time
+
+	mu     sync.Mutex
+	firing map[string]string
+}
+
+// NewEvaluator создает Evaluator с правилами DefaultRules. incidents может быть nil, тогда
+// срабатывания только записываются в лог
+func NewEvaluator(results ResultSource, incidents IncidentClient, logger pkglogger.Logger) *Evaluator {
+	return &Evaluator{
+		results:   results,
+		incidents: incidents,
+		rules:     DefaultRules(),
+		logger:    logger,
+		now:       time.Now,
+		firing:    make(map[string]string),
+	}
+}
+
+// WithRules заменяет правила оповещения
+func (e *Evaluator) WithRules(rules []BurnRateRule) *Evaluator {
+	e.rules = rules
+	return e
+}
+
+// WithClock задает источник текущего времени
+func (e *Evaluator) WithClock(now func() time.Time) *Evaluator {
+	e.now = now
+	return e
+}
+
+// Run оценивает SLO с заданным интервалом до отмены контекста
+func (e *Evaluator) Run(ctx context.Context, definitions []Definition, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		e.EvaluateAll(ctx, definitions)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EvaluateAll оценивает SLO и синхронизирует инциденты с результатами оценки
+func (e *Evaluator) EvaluateAll(ctx context.Context, definitions []Definition) []Status {
+	statuses := make([]Status, 0, len(definitions))
+	for _, definition := range definitions {
+		status, err := e.Evaluate(ctx, definition)
+		if err != nil {
+			e.logger.Error("SLO evaluation failed",
+				pkglogger.String("slo_id", definition.ID),
+				pkglogger.Error(err))
+			continue
+		}
+		e.syncIncident(ctx, status)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// Evaluate вычисляет скорость расхода бюджета во всех окнах правил и выбирает сработавшее правило
+func (e *Evaluator) Evaluate(ctx context.Context, definition Definition) (Status, error) {
+	windows := e.windows()
+	if len(windows) == 0 {
+		return Status{Definition: definition}, nil
+	}
+
+	now := e.now()
+	results, err := e.results.Results(ctx, definition, now.Add(-windows[len(windows)-1]))
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{Definition: definition}
+	rates := make(map[time.Duration]float64, len(windows))
+	for _, window := range windows {
+		rate := BurnRate(results, now.Add(-window), definition.ErrorBudget())
+		status.BurnRates = append(status.BurnRates, WindowBurnRate{Window: window, BurnRate: rate.BurnRate, Total: rate.Total})
+		rates[window] = rate.BurnRate
+	}
+
+	for i := range e.rules {
+		rule := e.rules[i]
+		if rates[rule.LongWindow] < rule.Factor || rates[rule.ShortWindow] < rule.Factor {
+			continue
+		}
+		if status.Firing == nil || rule.Severity > status.Firing.Severity {
+			status.Firing = &rule
+		}
+	}
+	return status, nil
+}
+
+// BurnRate вычисляет скорость расхода бюджета по результатам не старше since
+func BurnRate(results []Result, since time.Time, errorBudget float64) WindowBurnRate {
+	var total, failed int
+	for _, result := range results {
+		if result.At.Before(since) {
+			continue
+		}
+		total++
+		if !result.Success {
+			failed++
+		}
+	}
+
+	rate := WindowBurnRate{Total: total}
+	if total > 0 && errorBudget > 0 {
+		rate.BurnRate = float64(failed) / float64(total) / errorBudget
+	}
+	return rate
+}
+
+// windows возвращает различные окна правил по возрастанию
+func (e *Evaluator) windows() []time.Duration {
+	seen := make(map[time.Duration]bool)
+	var windows []time.Duration
+	for _, rule := range e.rules {
+		for _, window := range []time.Duration{rule.LongWindow, rule.ShortWindow} {
+			if !seen[window] {
+				seen[window] = true
+				windows = append(windows, window)
+			}
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i] < windows[j] })
+	return windows
+}
+
+// syncIncident открывает инцидент при срабатывании правила и закрывает его после восстановления.
+// Повторное срабатывание обновляет тот же инцидент: сообщение не зависит от текущих значений
+func (e *Evaluator) syncIncident(ctx context.Context, status Status) {
+	definition := status.Definition
+
+	e.mu.Lock()
+	incidentID, open := e.firing[definition.ID]
+	e.mu.Unlock()
+
+	if status.Firing == nil {
+		if !open {
+			return
+		}
+		e.logger.Info("SLO burn rate recovered", pkglogger.String("slo_id", definition.ID))
+		if e.incidents != nil && incidentID != "" {
+			if _, err := e.incidents.ResolveIncident(ctx, &incidentv1.ResolveIncidentRequest{IncidentId: incidentID}); err != nil {
+				e.logger.Error("Failed to resolve SLO incident",
+					pkglogger.String("slo_id", definition.ID),
+					pkglogger.String("incident_id", incidentID),
+					pkglogger.Error(err))
+				return
+			}
+		}
+		e.mu.Lock()
+		delete(e.firing, definition.ID)
+		e.mu.Unlock()
+		return
+	}
+
+	rule := status.Firing
+	e.logger.Warn("SLO error budget burning too fast",
+		pkglogger.String("slo_id", definition.ID),
+		pkglogger.String("tenant_id", definition.TenantID),
+		pkglogger.String("rule", rule.Name))
+
+	if e.incidents == nil {
+		e.mu.Lock()
+		e.firing[definition.ID] = ""
+		e.mu.Unlock()
+		return
+	}
+
+	details := map[string]string{
+		"slo_id":       definition.ID,
+		"slo_target":   strconv.FormatFloat(definition.Target, 'f', -1, 64),
+		"slo_window":   definition.Window.String(),
+		"rule":         rule.Name,
+		"burn_factor":  strconv.FormatFloat(rule.Factor, 'f', -1, 64),
+		"long_window":  rule.LongWindow.String(),
+		"short_window": rule.ShortWindow.String(),
+	}
+	for _, rate := range status.BurnRates {
+		details["burn_rate_"+rate.Window.String()] = strconv.FormatFloat(rate.BurnRate, 'f', 2, 64)
+	}
+	if definition.CheckID != "" {
+		details["slo_check_id"] = definition.CheckID
+	} else {
+		details["slo_tag"] = definition.Tag
+	}
+
+	incident, err := e.incidents.CreateIncident(ctx, &incidentv1.CreateIncidentRequest{
+		CheckId:      IncidentCheckID(definition),
+		TenantId:     definition.TenantID,
+		Severity:     rule.Severity,
+		ErrorMessage: fmt.Sprintf("SLO %s (%.4g%%) is burning its error budget too fast", sloName(definition), definition.Target),
+		Details:      details,
+	})
+	if err != nil {
+		e.logger.Error("Failed to raise SLO incident",
+			pkglogger.String("slo_id", definition.ID),
+			pkglogger.Error(err))
+		return
+	}
+
+	e.mu.Lock()
+	e.firing[definition.ID] = incident.GetId()
+	e.mu.Unlock()
+}
+
+// IncidentCheckID идентификатор, к которому привязываются инциденты SLO. Incident Manager принимает
+// только UUID, поэтому идентификатор - UUID версии 5 от арендатора и ID SLO: он одинаков между
+// перезапусками и не совпадает со случайными UUID проверок
+func IncidentCheckID(definition Definition) string {
+	sum := sha1.Sum([]byte("slo:" + definition.TenantID + ":" + definition.ID))
+	sum[6] = sum[6]&0x0f | 0x50
+	sum[8] = sum[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// sloName возвращает имя SLO для сообщений
+func sloName(definition Definition) string {
+	if definition.Name != "" {
+		return strconv.Quote(definition.Name)
+	}
+	return definition.ID
+}
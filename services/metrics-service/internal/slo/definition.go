@@ -0,0 +1,136 @@
+package slo
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"UptimePingPlatform/pkg/config"
+)
+
+const (
+	// DefaultWindow окно SLO по умолчанию
+	DefaultWindow = 30 * 24 * time.Hour
+	// MaxWindow наибольшее окно SLO
+	MaxWindow = 90 * 24 * time.Hour
+)
+
+// Definition цель уровня обслуживания для проверки или для всех проверок арендатора с тегом
+type Definition struct {
+	ID       string `yaml:"id"`
+	TenantID string `yaml:"tenant_id"`
+	Name     string `yaml:"name"`
+	// CheckID проверка, доступность которой оценивается; взаимоисключается с Tag
+	CheckID string `yaml:"check_id,omitempty"`
+	// Tag тег, по всем проверкам которого доступность оценивается совместно
+	Tag string `yaml:"tag,omitempty"`
+	// Target целевая доля успешных проверок в процентах, например 99.9
+	Target float64 `yaml:"target"`
+	// Window окно, за которое расходуется бюджет ошибок
+	Window time.Duration `yaml:"-"`
+}
+
+// UnmarshalYAML принимает окно строкой вида "30d", "12h" или "90m"
+func (d *Definition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Definition
+	var raw struct {
+		plain  `yaml:",inline"`
+		Window string `yaml:"window"`
+	}
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+
+	*d = Definition(raw.plain)
+	d.Window = DefaultWindow
+	if raw.Window != "" {
+		window, err := parseWindow(raw.Window)
+		if err != nil {
+			return fmt.Errorf("slo %q: %w", raw.ID, err)
+		}
+		d.Window = window
+	}
+	return nil
+}
+
+// ErrorBudget допустимая доля неудачных проверок
+func (d Definition) ErrorBudget() float64 {
+	return 1 - d.Target/100
+}
+
+// Validate проверяет цель, окно и объект SLO
+func (d Definition) Validate() error {
+	if d.ID == "" || d.TenantID == "" {
+		return fmt.Errorf("slo id and tenant_id are required")
+	}
+	if (d.CheckID == "") == (d.Tag == "") {
+		return fmt.Errorf("slo %s: exactly one of check_id and tag is required", d.ID)
+	}
+	if d.Target <= 0 || d.Target >= 100 {
+		return fmt.Errorf("slo %s: target must be between 0 and 100 exclusive", d.ID)
+	}
+	if d.Window <= 0 || d.Window > MaxWindow {
+		return fmt.Errorf("slo %s: window must be positive and at most %s", d.ID, MaxWindow)
+	}
+	return nil
+}
+
+// LoadDefinitions читает SLO из YAML файла с подстановкой переменных окружения:
+//
+//	slos:
+//	  - id: checkout-availability
+//	    tenant_id: tenant-1
+//	    name: Checkout availability
+//	    tag: checkout
+//	    target: 99.9
+//	    window: 30d
+func LoadDefinitions(path string) ([]Definition, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read slo definitions: %w", err)
+	}
+
+	expanded, err := config.ExpandEnv(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand slo definitions: %w", err)
+	}
+
+	var file struct {
+		SLOs []Definition `yaml:"slos"`
+	}
+	if err := yaml.UnmarshalStrict([]byte(expanded), &file); err != nil {
+		return nil, fmt.Errorf("failed to parse slo definitions: %w", err)
+	}
+
+	seen := make(map[string]bool, len(file.SLOs))
+	for _, definition := range file.SLOs {
+		if err := definition.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[definition.ID] {
+			return nil, fmt.Errorf("duplicate slo id %s", definition.ID)
+		}
+		seen[definition.ID] = true
+	}
+	return file.SLOs, nil
+}
+
+// parseWindow разбирает длительность Go с дополнительным суффиксом дней "d"
+func parseWindow(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid window %q", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	window, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid window %q", value)
+	}
+	return window, nil
+}
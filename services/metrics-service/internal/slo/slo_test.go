@@ -0,0 +1,237 @@
+package slo
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	pkglogger "UptimePingPlatform/pkg/logger"
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+var testNow = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+type staticResults []Result
+
+func (s staticResults) Results(ctx context.Context, definition Definition, since time.Time) ([]Result, error) {
+	return s, nil
+}
+
+type fakeIncidents struct {
+	created  []*incidentv1.CreateIncidentRequest
+	resolved []string
+}
+
+func (f *fakeIncidents) CreateIncident(ctx context.Context, in *incidentv1.CreateIncidentRequest, opts ...grpc.CallOption) (*incidentv1.Incident, error) {
+	f.created = append(f.created, in)
+	return &incidentv1.Incident{Id: "incident-1", CheckId: in.CheckId}, nil
+}
+
+func (f *fakeIncidents) ResolveIncident(ctx context.Context, in *incidentv1.ResolveIncidentRequest, opts ...grpc.CallOption) (*incidentv1.ResolveIncidentResponse, error) {
+	f.resolved = append(f.resolved, in.IncidentId)
+	return &incidentv1.ResolveIncidentResponse{Success: true}, nil
+}
+
+func newTestLogger(t *testing.T) pkglogger.Logger {
+	t.Helper()
+	log, err := pkglogger.NewLogger("test", "error", "metrics-service-test", false)
+	require.NoError(t, err)
+	return log
+}
+
+// minuteResults возвращает по результату в минуту за period; неудачны результаты в промежутке
+// от failingFrom до failingTo назад
+func minuteResults(period, failingFrom, failingTo time.Duration) staticResults {
+	var results staticResults
+	for at := testNow.Add(-period); at.Before(testNow); at = at.Add(time.Minute) {
+		failing := !at.Before(testNow.Add(-failingFrom)) && at.Before(testNow.Add(-failingTo))
+		results = append(results, Result{At: at, Success: !failing})
+	}
+	return results
+}
+
+var testDefinition = Definition{ID: "checkout", TenantID: "tenant-1", Name: "Checkout", CheckID: "check-1", Target: 99.9, Window: DefaultWindow}
+
+func TestBurnRate(t *testing.T) {
+	results := []Result{
+		{At: testNow.Add(-2 * time.Hour), Success: false},
+		{At: testNow.Add(-30 * time.Minute), Success: false},
+		{At: testNow.Add(-20 * time.Minute), Success: true},
+		{At: testNow.Add(-10 * time.Minute), Success: true},
+	}
+
+	rate := BurnRate(results, testNow.Add(-time.Hour), 0.01)
+	assert.Equal(t, 3, rate.Total)
+	assert.InDelta(t, 1.0/3/0.01, rate.BurnRate, 1e-9)
+
+	assert.Zero(t, BurnRate(nil, testNow.Add(-time.Hour), 0.01).BurnRate)
+}
+
+func TestEvaluator_Evaluate(t *testing.T) {
+	log := newTestLogger(t)
+	clock := func() time.Time { return testNow }
+
+	evaluate := func(results staticResults) *BurnRateRule {
+		status, err := NewEvaluator(results, nil, log).WithClock(clock).Evaluate(context.Background(), testDefinition)
+		require.NoError(t, err)
+		assert.Len(t, status.BurnRates, 7)
+		return status.Firing
+	}
+
+	// Полный отказ в последние 10 минут: горят и час, и пять минут
+	firing := evaluate(minuteResults(3*24*time.Hour, 10*time.Minute, 0))
+	require.NotNil(t, firing)
+	assert.Equal(t, "page_fast", firing.Name)
+	assert.Equal(t, incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL, firing.Severity)
+
+	// Отказ закончился 40 минут назад: короткие окна срочных правил уже не горят
+	firing = evaluate(minuteResults(3*24*time.Hour, 50*time.Minute, 40*time.Minute))
+	require.NotNil(t, firing)
+	assert.Equal(t, "ticket_fast", firing.Name)
+	assert.Equal(t, incidentv1.IncidentSeverity_INCIDENT_SEVERITY_WARNING, firing.Severity)
+
+	firing = evaluate(minuteResults(3*24*time.Hour, 4*time.Hour, 3*time.Hour+30*time.Minute))
+	require.NotNil(t, firing)
+	assert.Equal(t, "ticket_slow", firing.Name)
+
+	assert.Nil(t, evaluate(minuteResults(3*24*time.Hour, 0, 0)))
+}
+
+func TestEvaluator_EvaluateAll_IncidentLifecycle(t *testing.T) {
+	incidents := &fakeIncidents{}
+	source := &switchingSource{results: minuteResults(6*time.Hour, 10*time.Minute, 0)}
+	evaluator := NewEvaluator(source, incidents, newTestLogger(t)).WithClock(func() time.Time { return testNow })
+
+	evaluator.EvaluateAll(context.Background(), []Definition{testDefinition})
+	require.Len(t, incidents.created, 1)
+	created := incidents.created[0]
+	assert.Equal(t, IncidentCheckID(testDefinition), created.CheckId)
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, created.CheckId)
+	assert.Equal(t, "tenant-1", created.TenantId)
+	assert.Equal(t, incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL, created.Severity)
+	assert.Equal(t, "check-1", created.Details["slo_check_id"])
+	assert.Equal(t, "page_fast", created.Details["rule"])
+
+	// Сообщение не зависит от текущей скорости, чтобы повторы обновляли тот же инцидент
+	evaluator.EvaluateAll(context.Background(), []Definition{testDefinition})
+	require.Len(t, incidents.created, 2)
+	assert.Equal(t, created.ErrorMessage, incidents.created[1].ErrorMessage)
+
+	source.results = minuteResults(6*time.Hour, 0, 0)
+	evaluator.EvaluateAll(context.Background(), []Definition{testDefinition})
+	assert.Equal(t, []string{"incident-1"}, incidents.resolved)
+
+	// Закрытый инцидент не закрывается повторно
+	evaluator.EvaluateAll(context.Background(), []Definition{testDefinition})
+	assert.Len(t, incidents.resolved, 1)
+}
+
+type switchingSource struct {
+	results staticResults
+}
+
+func (s *switchingSource) Results(ctx context.Context, definition Definition, since time.Time) ([]Result, error) {
+	return s.results, nil
+}
+
+type fakeHistory struct {
+	requests []*corev1.GetCheckHistoryRequest
+}
+
+func (f *fakeHistory) GetCheckHistory(ctx context.Context, in *corev1.GetCheckHistoryRequest, opts ...grpc.CallOption) (*corev1.GetCheckHistoryResponse, error) {
+	f.requests = append(f.requests, in)
+	return &corev1.GetCheckHistoryResponse{Results: []*corev1.CheckResult{
+		{CheckId: in.CheckId, Success: true, CheckedAt: testNow.Format(time.RFC3339)},
+		{CheckId: in.CheckId, Success: false, CheckedAt: "invalid"},
+	}}, nil
+}
+
+type fakeChecks struct{}
+
+func (fakeChecks) ListChecks(ctx context.Context, in *schedulerv1.ListChecksRequest, opts ...grpc.CallOption) (*schedulerv1.ListChecksResponse, error) {
+	return &schedulerv1.ListChecksResponse{Checks: []*schedulerv1.Check{
+		{Id: "a", Tags: []string{"checkout"}},
+		{Id: "b", Tags: []string{"search"}},
+		{Id: "c", Tags: []string{"search", "checkout"}},
+	}}, nil
+}
+
+func TestHistorySource_Results(t *testing.T) {
+	history := &fakeHistory{}
+	source := NewHistorySource(history, fakeChecks{})
+	since := testNow.Add(-time.Hour)
+
+	results, err := source.Results(context.Background(), Definition{TenantID: "tenant-1", Tag: "checkout"}, since)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+	require.Len(t, history.requests, 2)
+	assert.Equal(t, "a", history.requests[0].CheckId)
+	assert.Equal(t, "c", history.requests[1].CheckId)
+	assert.Equal(t, since.Format(time.RFC3339), history.requests[0].StartTime)
+}
+
+func TestDecodeResult(t *testing.T) {
+	for _, result := range []*corev1.CheckResult{
+		{CheckedAt: "2026-10-16T11:00:00Z", Success: true, SchemaVersion: 4},
+		{CheckedAt: "2026-10-16T14:00:00.250+03:00", Success: true, SchemaVersion: 1},
+		// Результат Core Service без версии схемы и результат более новой версии
+		{CheckedAt: "2026-10-16 11:00:00+00", Success: true},
+		{CheckedAt: "2026-10-16 11:00:00", Success: true, SchemaVersion: 9},
+	} {
+		decoded, ok := decodeResult(result)
+		require.True(t, ok, result.CheckedAt)
+		assert.True(t, decoded.Success)
+		assert.Equal(t, testNow.Add(-time.Hour), decoded.At.Truncate(time.Second), result.CheckedAt)
+	}
+
+	_, ok := decodeResult(&corev1.CheckResult{CheckedAt: "yesterday"})
+	assert.False(t, ok)
+	_, ok = decodeResult(&corev1.CheckResult{})
+	assert.False(t, ok)
+}
+
+func TestLoadDefinitions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slos.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+slos:
+  - id: checkout
+    tenant_id: tenant-1
+    name: Checkout
+    tag: checkout
+    target: 99.9
+    window: 7d
+  - id: api
+    tenant_id: tenant-1
+    check_id: check-1
+    target: 99.5
+`), 0o600))
+
+	definitions, err := LoadDefinitions(path)
+	require.NoError(t, err)
+	require.Len(t, definitions, 2)
+	assert.Equal(t, 7*24*time.Hour, definitions[0].Window)
+	assert.Equal(t, "checkout", definitions[0].Tag)
+	assert.Equal(t, DefaultWindow, definitions[1].Window)
+	assert.InDelta(t, 0.005, definitions[1].ErrorBudget(), 1e-9)
+
+	for _, content := range []string{
+		"slos:\n  - {id: a, tenant_id: t, target: 99}\n",
+		"slos:\n  - {id: a, tenant_id: t, check_id: c, tag: x, target: 99}\n",
+		"slos:\n  - {id: a, tenant_id: t, check_id: c, target: 100}\n",
+		"slos:\n  - {id: a, tenant_id: t, check_id: c, target: 99, window: 1y}\n",
+		"slos:\n  - {id: a, tenant_id: t, check_id: c, target: 99}\n  - {id: a, tenant_id: t, check_id: d, target: 99}\n",
+		"slos:\n  - {id: a, tenant_id: t, check_id: c, target: 99, unknown: 1}\n",
+	} {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		_, err := LoadDefinitions(path)
+		assert.Error(t, err, content)
+	}
+}
@@ -0,0 +1,136 @@
+package slo
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	corev1 "UptimePingPlatform/proto/api/core/v1"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+)
+
+const (
+	// historyLimit наибольшее число результатов, которое Core Service отдает за один запрос.
+	// Для частых проверок длинные окна оцениваются по последним historyLimit результатам
+	historyLimit = 1000
+	// maxTagChecks ограничивает число проверок тега, результаты которых запрашиваются
+	maxTagChecks = 50
+
+	checkPageSize = 100
+	maxCheckPages = 10
+)
+
+// HistoryClient часть клиента Core Service, отдающая историю результатов проверки
+type HistoryClient interface {
+	GetCheckHistory(ctx context.Context, in *corev1.GetCheckHistoryRequest, opts ...grpc.CallOption) (*corev1.GetCheckHistoryResponse, error)
+}
+
+// CheckClient часть клиента Scheduler Service, отдающая проверки арендатора
+type CheckClient interface {
+	ListChecks(ctx context.Context, in *schedulerv1.ListChecksRequest, opts ...grpc.CallOption) (*schedulerv1.ListChecksResponse, error)
+}
+
+// HistorySource получает результаты проверок SLO из истории Core Service; проверки тега
+// определяются по списку проверок арендатора в Scheduler Service
+type HistorySource struct {
+	history HistoryClient
+	checks  CheckClient
+}
+
+// NewHistorySource создает HistorySource
+func NewHistorySource(history HistoryClient, checks CheckClient) *HistorySource {
+	return &HistorySource{history: history, checks: checks}
+}
+
+// Results возвращает результаты проверок SLO не старше since
+func (s *HistorySource) Results(ctx context.Context, definition Definition, since time.Time) ([]Result, error) {
+	checkIDs := []string{definition.CheckID}
+	if definition.Tag != "" {
+		var err error
+		if checkIDs, err = s.taggedChecks(ctx, definition); err != nil {
+			return nil, err
+		}
+	}
+
+	var results []Result
+	for _, checkID := range checkIDs {
+		resp, err := s.history.GetCheckHistory(ctx, &corev1.GetCheckHistoryRequest{
+			CheckId:   checkID,
+			Limit:     historyLimit,
+			StartTime: since.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range resp.GetResults() {
+			if decoded, ok := decodeResult(result); ok {
+				results = append(results, decoded)
+			}
+		}
+	}
+	return results, nil
+}
+
+// checkedAtLayouts форматы времени результата: RFC3339 с долями секунд или без них и формат
+// timestamptz PostgreSQL, в котором время могут содержать результаты, перенесенные backfill'ом
+var checkedAtLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// decodeResult разбирает результат любой версии схемы. SLO нужны только время и успешность,
+// которые есть во всех версиях, поэтому результаты старых версий без новых полей и более
+// новых версий, чем известны сервису, учитываются одинаково. Результат без разборного
+// времени пропускается
+func decodeResult(result *corev1.CheckResult) (Result, bool) {
+	checkedAt := strings.TrimSpace(result.GetCheckedAt())
+	for _, layout := range checkedAtLayouts {
+		if at, err := time.Parse(layout, checkedAt); err == nil {
+			return Result{At: at.UTC(), Success: result.GetSuccess()}, true
+		}
+	}
+	return Result{}, false
+}
+
+// taggedChecks возвращает ID проверок арендатора с тегом SLO
+func (s *HistorySource) taggedChecks(ctx context.Context, definition Definition) ([]string, error) {
+	var checkIDs []string
+	var pageToken int32
+	for page := 0; page < maxCheckPages; page++ {
+		resp, err := s.checks.ListChecks(ctx, &schedulerv1.ListChecksRequest{
+			TenantId:  definition.TenantID,
+			PageSize:  checkPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, check := range resp.GetChecks() {
+			if hasTag(check, definition.Tag) {
+				checkIDs = append(checkIDs, check.GetId())
+				if len(checkIDs) == maxTagChecks {
+					return checkIDs, nil
+				}
+			}
+		}
+		if resp.GetNextPageToken() == 0 {
+			break
+		}
+		pageToken = resp.GetNextPageToken()
+	}
+	return checkIDs, nil
+}
+
+// hasTag проверяет, отмечена ли проверка тегом
+func hasTag(check *schedulerv1.Check, tag string) bool {
+	for _, checkTag := range check.GetTags() {
+		if checkTag == tag {
+			return true
+		}
+	}
+	return false
+}
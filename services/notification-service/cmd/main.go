@@ -2,19 +2,51 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"UptimePingPlatform/pkg/config"
+	pkg_database "UptimePingPlatform/pkg/database"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
+	pkg_plugin "UptimePingPlatform/pkg/plugin"
 	"UptimePingPlatform/pkg/health"
+	"UptimePingPlatform/pkg/locale"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/metrics"
+	"UptimePingPlatform/pkg/rabbitmq"
 	pkg_redis "UptimePingPlatform/pkg/redis"
+	"UptimePingPlatform/pkg/webhooks"
+	configv1 "UptimePingPlatform/proto/api/config/v1"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	notificationv1 "UptimePingPlatform/proto/api/notification/v1"
+	notificationv1beta1 "UptimePingPlatform/proto/api/notification/v1beta1"
+	notificationConfig "UptimePingPlatform/services/notification-service/config"
+	"UptimePingPlatform/services/notification-service/internal/chatops"
+	notificationConsumer "UptimePingPlatform/services/notification-service/internal/consumer/rabbitmq"
+	"UptimePingPlatform/services/notification-service/internal/filter"
+	"UptimePingPlatform/services/notification-service/internal/grouper"
+	"UptimePingPlatform/services/notification-service/internal/handler"
+	grpcHandler "UptimePingPlatform/services/notification-service/internal/handler/grpc"
+	"UptimePingPlatform/services/notification-service/internal/processor"
+	"UptimePingPlatform/services/notification-service/internal/provider"
+	notification_plugin "UptimePingPlatform/services/notification-service/internal/provider/plugin"
+	"UptimePingPlatform/services/notification-service/internal/provider/telegram"
+	"UptimePingPlatform/services/notification-service/internal/repository/postgres"
+	"UptimePingPlatform/services/notification-service/internal/secrets"
+	"UptimePingPlatform/services/notification-service/internal/service"
+	"UptimePingPlatform/services/notification-service/internal/template"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	health_grpc "google.golang.org/grpc/health"
 )
 
 func main() {
@@ -32,30 +64,171 @@ func main() {
 	defer appLogger.Sync()
 
 	appLogger.Info("Starting Notification Service...")
+	appLogger.Info("Effective configuration", logger.Any("config", cfg.Redacted()))
 
 	// Initialize metrics
 	appMetrics := metrics.NewMetrics("notification-service")
 	metricsHandler := appMetrics.GetHandler()
 
-	// Initialize health checker
-	healthChecker := health.NewSimpleHealthChecker("1.0.0")
+	// Все зависимости необязательны: без Redis повторные запросы чатов не отсекаются,
+	// без базы данных gRPC API отключен. Деградация отражается в /ready
+	dependencies := health.NewDependencies("1.0.0", cfg.Startup, appLogger)
+
+	// Опрос глубины очередей RabbitMQ для /metrics
+	monitorCtx, stopMonitor := context.WithCancel(context.Background())
+	defer stopMonitor()
+	if cfg.RabbitMQ.URL != "" {
+		if err := rabbitmq.StartQueueMonitor(monitorCtx, cfg.RabbitMQ.URL, []string{notificationConsumer.NotificationsQueue, notificationConsumer.NotificationsDLQ}, appLogger); err != nil {
+			appLogger.Warn("Failed to start RabbitMQ queue monitor", logger.Error(err))
+		}
+	}
 
 	// Initialize Redis client
-	redisClient, err := pkg_redis.Connect(context.Background(), &pkg_redis.Config{
-		Addr:     cfg.Redis.Addr,
-		Password: cfg.Redis.Password,
-		DB:       cfg.Redis.DB,
-	})
-	if err != nil {
-		appLogger.Error("Failed to connect to Redis", logger.Error(err))
-	} else {
+	var redisClient *pkg_redis.Client
+	if err := dependencies.Start(context.Background(), health.Dependency{
+		Name: "redis",
+		Connect: func(ctx context.Context) (err error) {
+			redisClient, err = pkg_redis.Connect(ctx, &pkg_redis.Config{
+				Addr:     cfg.Redis.Addr,
+				Password: cfg.Redis.Password,
+				DB:       cfg.Redis.DB,
+			})
+			return err
+		},
+		Ping: func(ctx context.Context) error { return redisClient.HealthCheck(ctx) },
+	}); err != nil {
+		log.Fatalf("Redis connection failed: %v", err)
+	}
+	if redisClient != nil {
 		defer redisClient.Close()
 	}
 
+	// История смен статусов зависимостей для /health/history; в Redis она переживает перезапуск
+	healthHistory := health.NewHistory(dependencies, health.DefaultHistorySize)
+	if redisClient != nil {
+		healthHistory.WithStore(health.NewRedisHistoryStore(redisClient, "notification-service", health.DefaultHistorySize), appLogger)
+	}
+	go healthHistory.Run(context.Background(), cfg.Health.CheckIntervalDuration())
+
+	// Тишины из команд чатов подавляют уведомления на всех репликах, поэтому хранятся в Redis
+	var silences filter.SilenceStore
+	if redisClient != nil {
+		silences = filter.NewRedisSilenceStore(redisClient.Client)
+	} else {
+		appLogger.Warn("Redis is not available, silences are kept in process memory")
+		silences = filter.NewMemorySilenceStore()
+	}
+
+	// Провайдеры и шаблоны общие для gRPC API и обработки событий из RabbitMQ
+	locales := setupLocales(appLogger)
+	providerManager := provider.NewProviderManager(provider.ConfigFromSettings(cfg.Providers), appLogger).
+		WithLocales(locales).
+		WithProviders(setupPlugins(cfg.Providers.Plugins, appLogger)...)
+	defer providerManager.Close()
+	templates := setupTemplates(appLogger)
+
+	// gRPC API отправки уведомлений и управления каналами; каналы и журнал доставки хранятся в PostgreSQL
+	var (
+		grpcServer   *grpc.Server
+		healthServer *health_grpc.Server
+	)
+	dbConfig := &pkg_database.Config{
+		Host:          cfg.Database.Host,
+		Port:          cfg.Database.Port,
+		User:          cfg.Database.User,
+		Password:      cfg.Database.Password,
+		Database:      cfg.Database.Name,
+		SSLMode:       "disable",
+		MaxConns:      10,
+		MinConns:      2,
+		MaxConnLife:   30 * time.Minute,
+		MaxConnIdle:   5 * time.Minute,
+		HealthCheck:   30 * time.Second,
+		RetryInterval: 1 * time.Second,
+	}
+	var db *pkg_database.Postgres
+	if err := dependencies.Start(context.Background(), health.Dependency{
+		Name: "database",
+		Connect: func(ctx context.Context) (err error) {
+			db, err = pkg_database.Connect(ctx, dbConfig)
+			return err
+		},
+		Ping: func(ctx context.Context) error { return db.HealthCheck(ctx) },
+	}); err != nil {
+		log.Fatalf("Database connection failed: %v", err)
+	}
+	if db == nil {
+		appLogger.Warn("gRPC API disabled: database is not available")
+	} else {
+		defer db.Close()
+
+		channels := postgres.NewChannelRepository(db.Pool)
+		channelSecrets := setupChannelSecrets(cfg.Environment, postgres.NewDataKeyRepository(db.Pool), appLogger)
+		notificationService := service.NewNotificationService(
+			channels,
+			postgres.NewDeliveryRepository(db.Pool),
+			providerManager,
+			templates,
+			channelSecrets,
+			locales,
+			appLogger,
+		)
+		if channelSecrets != nil {
+			go encryptStoredChannels(channels, channelSecrets, appLogger)
+		}
+
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPC.Port))
+		if err != nil {
+			log.Fatalf("Failed to listen: %v", err)
+		}
+
+		grpcServer = grpc.NewServer(pkg_grpc.ServerOptions(cfg.GRPC)...)
+		notificationv1.RegisterNotificationServiceServer(grpcServer, grpcHandler.NewNotificationHandler(notificationService, appLogger))
+		notificationv1beta1.RegisterNotificationServiceServer(grpcServer, grpcHandler.NewSecretsHandler(notificationService, appLogger))
+		// grpc.health.v1 для проверок здоровья подканалов на стороне клиентов
+		healthServer = pkg_grpc.RegisterHealthServer(grpcServer, notificationv1.NotificationService_ServiceDesc.ServiceName)
+		if pkg_grpc.RegisterReflection(grpcServer, cfg.GRPC, cfg.Environment) {
+			appLogger.Info("gRPC reflection is enabled")
+		}
+
+		go func() {
+			appLogger.Info(fmt.Sprintf("Starting gRPC server on port %d", cfg.GRPC.Port))
+			if err := grpcServer.Serve(pkg_grpc.LimitListener(lis, cfg.GRPC)); err != nil {
+				appLogger.Error("gRPC server failed", logger.Error(err))
+			}
+		}()
+	}
+
+	// Интеграции с чатами: slash команды Slack, кнопки в сообщениях и команды Telegram бота
+	// Повторно доставленные запросы отклоняются по кэшу в Redis, общему для реплик
+	var chatReplay webhooks.ReplayCache
+	if redisClient != nil {
+		chatReplay = webhooks.NewRedisReplayCache(redisClient.Client, "chatops")
+	}
+	routes := setupChatOps(appLogger, chatReplay, silences, cfg.GRPC.ServiceToken)
+
+	// Защита от шторма уведомлений общая для доставки и HTTP API состояния ограничения тенантов;
+	// счетчики в Redis, чтобы реплики считали общий поток уведомлений тенанта
+	var stormGuard filter.StormLimiter
+	if redisClient != nil {
+		stormGuard = filter.NewRedisStormGuard(redisClient.Client, stormConfig(appLogger))
+	} else {
+		appLogger.Warn("Redis is not available, storm counters are kept in process memory")
+		stormGuard = filter.NewStormGuard(stormConfig(appLogger))
+	}
+	routes = append(routes, handler.NewStormHandler(stormGuard, appLogger))
+
+	// События инцидентов и проверок из RabbitMQ проходят фильтр событий, активные тишины и защиту от шторма
+	consumerCtx, stopConsumer := context.WithCancel(context.Background())
+	defer stopConsumer()
+	if cfg.RabbitMQ.URL != "" {
+		startEventConsumer(consumerCtx, cfg.RabbitMQ.URL, dependencies, providerManager, templates, silences, stormGuard, locales, appLogger)
+	}
+
 	// Start HTTP server for metrics and health
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Server.Port),
-		Handler: setupHTTPHandler(metricsHandler, healthChecker, appLogger),
+		Handler: setupHTTPHandler(metricsHandler, healthHistory, routes, appLogger),
 	}
 
 	// Start server
@@ -79,11 +252,310 @@ func main() {
 	if err := httpServer.Shutdown(ctx); err != nil {
 		appLogger.Error("Server shutdown failed", logger.Error(err))
 	}
+	if grpcServer != nil {
+		// NOT_SERVING до остановки, чтобы клиенты вывели реплику из балансировки
+		healthServer.Shutdown()
+		grpcServer.GracefulStop()
+	}
 
 	appLogger.Info("Server stopped")
 }
 
-func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, appLogger logger.Logger) http.Handler {
+// setupPlugins запускает провайдеры уведомлений из каталога плагинов. Плагины, которые
+// не удалось загрузить, пропускаются, сервис работает со встроенными провайдерами
+func setupPlugins(cfg config.PluginProvidersConfig, appLogger logger.Logger) []provider.NotificationProvider {
+	if cfg.Dir == "" {
+		return nil
+	}
+
+	plugins, err := notification_plugin.Load(notification_plugin.Config{
+		Dir:          cfg.Dir,
+		StartTimeout: provider.ParseTimeout(cfg.StartTimeout, pkg_plugin.DefaultStartTimeout),
+		Timeout:      provider.ParseTimeout(cfg.Timeout, 30*time.Second),
+		Env:          cfg.Env,
+	}, appLogger)
+	if err != nil {
+		appLogger.Error("Failed to load notification plugins", logger.Error(err))
+		return nil
+	}
+
+	providers := make([]provider.NotificationProvider, 0, len(plugins))
+	for _, plugin := range plugins {
+		providers = append(providers, plugin)
+	}
+	return providers
+}
+
+// setupTemplates создает менеджер шаблонов со встроенными шаблонами на всех языках и
+// переопределениями из каталога NOTIFICATION_TEMPLATES_DIR. Ошибка в переопределениях
+// не останавливает сервис: используются шаблоны, загруженные до ошибки, и встроенные
+func setupTemplates(appLogger logger.Logger) *template.DefaultTemplateManager {
+	templates := template.NewDefaultTemplateManager(appLogger)
+	dir := os.Getenv("NOTIFICATION_TEMPLATES_DIR")
+	if dir == "" {
+		return templates
+	}
+
+	loaded, err := templates.LoadOverrides(dir)
+	if err != nil {
+		appLogger.Error("Failed to load notification template overrides",
+			logger.String("dir", dir),
+			logger.Error(err))
+	}
+	appLogger.Info("Notification template overrides loaded",
+		logger.String("dir", dir),
+		logger.Int("count", loaded))
+	return templates
+}
+
+// localeCacheTTL время кэширования часового пояса и языка тенанта
+const localeCacheTTL = 5 * time.Minute
+
+// setupLocales создает Resolver часовых поясов и языков тенантов поверх TenantSettingsService
+// на SETTINGS_SERVICE_ADDR (по умолчанию auth-service:50058). Без клиента время в уведомлениях
+// выводится в UTC
+func setupLocales(appLogger logger.Logger) *locale.Resolver {
+	settingsAddr := os.Getenv("SETTINGS_SERVICE_ADDR")
+	if settingsAddr == "" {
+		settingsAddr = "auth-service:50058"
+	}
+	conn, err := grpc.NewClient(settingsAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		appLogger.Warn("Tenant locales disabled: failed to create settings client", logger.Error(err))
+		return nil
+	}
+
+	settingsClient := configv1.NewTenantSettingsServiceClient(conn)
+	return locale.NewResolver(func(ctx context.Context, tenantID string) (locale.Settings, error) {
+		settings, err := settingsClient.GetTenantSettings(ctx, &configv1.GetTenantSettingsRequest{TenantId: tenantID})
+		if err != nil {
+			return locale.Settings{}, err
+		}
+		return locale.Settings{
+			Timezone: settings.GetLocalization().GetTimezone(),
+			Locale:   settings.GetLocalization().GetLocale(),
+		}, nil
+	}, localeCacheTTL)
+}
+
+// stormConfig читает настройки защиты от шторма из NOTIFICATION_STORM_LIMIT, NOTIFICATION_STORM_WINDOW
+// и NOTIFICATION_STORM_COOLDOWN; при неверных значениях используются значения по умолчанию
+func stormConfig(appLogger logger.Logger) filter.StormConfig {
+	defaults := filter.DefaultStormConfig()
+	storm := defaults
+	if value := os.Getenv("NOTIFICATION_STORM_LIMIT"); value != "" {
+		if limit, err := strconv.Atoi(value); err == nil {
+			storm.Limit = limit
+		}
+	}
+	storm.Window = provider.ParseTimeout(os.Getenv("NOTIFICATION_STORM_WINDOW"), storm.Window)
+	storm.Cooldown = provider.ParseTimeout(os.Getenv("NOTIFICATION_STORM_COOLDOWN"), storm.Cooldown)
+
+	if err := storm.Validate(); err != nil {
+		appLogger.Warn("Invalid notification storm settings, using defaults", logger.Error(err))
+		return defaults
+	}
+	return storm
+}
+
+// setupChannelSecrets создает шифрование секретов каналов с мастер-ключом из NOTIFICATION_SECRETS_KEY
+// (32 байта в base64). Без ключа секреты хранятся открытыми, что допустимо только вне prod
+func setupChannelSecrets(environment string, keys secrets.DataKeyStore, appLogger logger.Logger) service.ChannelSecrets {
+	encoded := os.Getenv("NOTIFICATION_SECRETS_KEY")
+	if encoded == "" {
+		if environment == "prod" {
+			log.Fatalf("NOTIFICATION_SECRETS_KEY is required in prod environment")
+		}
+		appLogger.Warn("NOTIFICATION_SECRETS_KEY is not set, channel secrets are stored unencrypted")
+		return nil
+	}
+
+	masterKey, err := secrets.ParseMasterKey(encoded)
+	if err != nil {
+		log.Fatalf("Invalid NOTIFICATION_SECRETS_KEY: %v", err)
+	}
+	vault, err := secrets.NewVault(masterKey, keys)
+	if err != nil {
+		log.Fatalf("Failed to initialize channel secrets: %v", err)
+	}
+	return vault
+}
+
+// encryptStoredChannels шифрует секреты каналов, сохраненных до включения шифрования. Ошибка
+// не останавливает сервис: каналы с открытыми секретами продолжают работать до следующего старта
+func encryptStoredChannels(channels service.ChannelRepository, channelSecrets service.ChannelSecrets, appLogger logger.Logger) {
+	encrypted, err := service.EncryptStoredChannels(context.Background(), channels, channelSecrets, appLogger)
+	if err != nil {
+		appLogger.Error("Failed to encrypt stored channel secrets", logger.Int("encrypted", encrypted), logger.Error(err))
+		return
+	}
+	if encrypted > 0 {
+		appLogger.Info("Stored channel secrets encrypted", logger.Int("channels", encrypted))
+	}
+}
+
+// routeRegistrar обработчик, регистрирующий собственные HTTP маршруты
+type routeRegistrar interface {
+	RegisterRoutes(mux *http.ServeMux)
+}
+
+// startEventConsumer подключается к RabbitMQ и обрабатывает события уведомлений в фоне. Без
+// RabbitMQ сервис продолжает работать с gRPC API и чатами, деградация отражается в /ready
+func startEventConsumer(ctx context.Context, url string, dependencies *health.Dependencies, providers *provider.ProviderManager, templates *template.DefaultTemplateManager, silences filter.SilenceStore, stormGuard filter.StormLimiter, locales *locale.Resolver, appLogger logger.Logger) {
+	var rabbitConn *rabbitmq.Connection
+	rabbitConfig := rabbitmq.NewConfig()
+	rabbitConfig.URL = url
+	// Повторы подключения выполняет политика запуска зависимостей
+	rabbitConfig.MaxRetries = 0
+	if err := dependencies.Start(ctx, health.Dependency{
+		Name: "rabbitmq",
+		Connect: func(ctx context.Context) (err error) {
+			rabbitConn, err = rabbitmq.Connect(ctx, rabbitConfig)
+			return err
+		},
+		Ping: func(ctx context.Context) error {
+			if rabbitConn.Channel().IsClosed() {
+				return fmt.Errorf("rabbitmq channel is closed")
+			}
+			return nil
+		},
+	}); err != nil {
+		log.Fatalf("RabbitMQ connection failed: %v", err)
+	}
+	if rabbitConn == nil {
+		appLogger.Warn("Notification events are not consumed: RabbitMQ is not available")
+		return
+	}
+
+	// Уровни инцидентов (warning, error, critical) не совпадают с уровнями уведомлений, поэтому
+	// уведомления отправляются по каждому инциденту; порог задается переопределением проверки
+	filterConfig := filter.DefaultFilterConfig()
+	filterConfig.AllowedSeverities = nil
+	consumer := notificationConsumer.NewNotificationConsumer(
+		rabbitConn,
+		filter.NewSilenceFilter(filter.NewOverrideFilter(filter.NewEventFilter(filterConfig, appLogger), appLogger), silences, appLogger),
+		grouper.NewNotificationGrouper(grouper.DefaultGrouperConfig(), notificationConfig.DefaultProvidersConfig(), appLogger),
+		processor.NewNotificationProcessor(processor.DefaultProcessorConfig(), appLogger, providers, templates),
+		appLogger,
+	).WithStormGuard(stormGuard).WithLocales(locales)
+
+	go func() {
+		defer rabbitConn.Close()
+		if err := consumer.Start(ctx); err != nil && ctx.Err() == nil {
+			appLogger.Error("Notification consumer stopped", logger.Error(err))
+		}
+	}()
+}
+
+// setupChatOps создает обработчики Slack и Telegram с общим доступом к Incident Manager и тишинам;
+// replay может быть nil, тогда повторная доставка не отслеживается. Вызовы Incident Manager
+// подписываются общим секретом serviceToken как внутренние
+func setupChatOps(appLogger logger.Logger, replay webhooks.ReplayCache, silences filter.SilenceStore, serviceToken string) []routeRegistrar {
+	slackSecret := os.Getenv("SLACK_SIGNING_SECRET")
+	telegramSecret := os.Getenv("TELEGRAM_WEBHOOK_SECRET")
+	if slackSecret == "" && telegramSecret == "" {
+		return nil
+	}
+
+	incidentAddr := os.Getenv("INCIDENT_MANAGER_ADDR")
+	if incidentAddr == "" {
+		incidentAddr = "localhost:50052"
+	}
+	conn, err := grpc.NewClient(incidentAddr, grpc.WithTransportCredentials(insecure.NewCredentials()),
+		pkg_grpc.WithServiceToken(serviceToken))
+	if err != nil {
+		appLogger.Warn("Chat integrations disabled: failed to create incident manager client", logger.Error(err))
+		return nil
+	}
+
+	commands := chatops.NewCommands(
+		chatops.NewGRPCIncidentAPI(incidentv1.NewIncidentServiceClient(conn)),
+		silences,
+		appLogger,
+	)
+
+	var routes []routeRegistrar
+	if slackSecret != "" {
+		slackHandler, err := setupSlackHandler(slackSecret, commands, appLogger)
+		if err != nil {
+			appLogger.Warn("Slack integration disabled", logger.Error(err))
+		} else {
+			if replay != nil {
+				slackHandler.WithReplayCache(replay)
+			}
+			routes = append(routes, slackHandler)
+		}
+	}
+	if telegramSecret != "" {
+		telegramBot, err := setupTelegramBot(telegramSecret, commands, appLogger)
+		if err != nil {
+			appLogger.Warn("Telegram bot disabled", logger.Error(err))
+		} else {
+			if replay != nil {
+				telegramBot.WithReplayCache(replay)
+			}
+			routes = append(routes, telegramBot)
+		}
+	}
+	return routes
+}
+
+// setupSlackHandler создает обработчик Slack приложения
+func setupSlackHandler(signingSecret string, commands *chatops.Commands, appLogger logger.Logger) (*chatops.SlackHandler, error) {
+	teams, err := chatops.ParseSlackTeams(os.Getenv("SLACK_TEAM_TENANTS"))
+	if err != nil {
+		return nil, err
+	}
+	if len(teams) == 0 {
+		return nil, fmt.Errorf("SLACK_TEAM_TENANTS is empty")
+	}
+
+	return chatops.NewSlackHandler(chatops.SlackConfig{
+		SigningSecret: signingSecret,
+		Teams:         teams,
+		Command:       os.Getenv("SLACK_COMMAND"),
+	}, commands, appLogger), nil
+}
+
+// setupTelegramBot создает Telegram бота с привязками чатов из конфигурации Telegram каналов
+func setupTelegramBot(secret string, commands *chatops.Commands, appLogger logger.Logger) (*chatops.TelegramBot, error) {
+	channelsFile := os.Getenv("TELEGRAM_CHANNELS_FILE")
+	if channelsFile == "" {
+		return nil, fmt.Errorf("TELEGRAM_CHANNELS_FILE is not set")
+	}
+	content, err := os.ReadFile(channelsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read telegram channels: %w", err)
+	}
+	var channels []*service.Channel
+	if err := json.Unmarshal(content, &channels); err != nil {
+		return nil, fmt.Errorf("failed to parse telegram channels: %w", err)
+	}
+
+	bindings := chatops.NewTelegramBindings()
+	for _, channel := range channels {
+		if !channel.IsActive {
+			continue
+		}
+		if err := bindings.Bind(channel); err != nil {
+			return nil, err
+		}
+	}
+
+	// Регистрация webhook, если задан публичный адрес сервиса
+	if webhookURL := os.Getenv("TELEGRAM_WEBHOOK_URL"); webhookURL != "" {
+		provider := telegram.NewTelegramProvider(telegram.TelegramConfig{BotToken: os.Getenv("TELEGRAM_BOT_TOKEN")}, appLogger)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := provider.SetWebhook(ctx, webhookURL, secret); err != nil {
+			appLogger.Warn("Failed to register telegram webhook", logger.Error(err))
+		}
+	}
+
+	return chatops.NewTelegramBot(secret, bindings, commands, appLogger), nil
+}
+
+func setupHTTPHandler(metricsHandler http.Handler, healthHistory *health.History, routes []routeRegistrar, appLogger logger.Logger) http.Handler {
 	mux := http.NewServeMux()
 	
 	// Metrics endpoint
@@ -95,10 +567,8 @@ func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthCh
 		w.Write([]byte(`{"status":"healthy","service":"notification-service"}`))
 	})
 	
-	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"status":"ready","service":"notification-service"}`))
-	})
+	mux.HandleFunc("/ready", health.ReadyHandler(healthHistory))
+	mux.HandleFunc("/health/history", health.HistoryHandler(healthHistory))
 	
 	mux.HandleFunc("/live", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -120,6 +590,10 @@ func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthCh
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{"message":"Notification Service - Templates endpoint","status":"ok"}`))
 	})
+
+	for _, registrar := range routes {
+		registrar.RegisterRoutes(mux)
+	}
 	
 	return mux
 }
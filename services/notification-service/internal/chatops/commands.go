@@ -0,0 +1,232 @@
+package chatops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	"UptimePingPlatform/services/notification-service/internal/filter"
+)
+
+// DefaultSnoozeDuration длительность snooze по кнопке в сообщении об алерте
+const DefaultSnoozeDuration = time.Hour
+
+// statusMaxLines максимальное количество инцидентов в ответе status
+const statusMaxLines = 10
+
+// Actor пользователь чата, от имени которого выполняется команда
+type Actor struct {
+	TenantID string
+	User     string
+}
+
+// Commands выполняет команды чатов над инцидентами и тишинами.
+// Используется Slack и другими интеграциями, результат - текст ответа пользователю
+type Commands struct {
+	incidents IncidentAPI
+	silences  filter.SilenceStore
+	logger    logger.Logger
+	now       func() time.Time
+}
+
+// NewCommands создает обработчик команд
+func NewCommands(incidents IncidentAPI, silences filter.SilenceStore, logger logger.Logger) *Commands {
+	return &Commands{
+		incidents: incidents,
+		silences:  silences,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Usage справка по командам
+func Usage(prefix string) string {
+	return strings.Join([]string{
+		"Available commands:",
+		prefix + " status - list open and acknowledged incidents",
+		prefix + " ack <incident_id> - acknowledge an incident",
+		prefix + " resolve <incident_id> - resolve an incident",
+		prefix + " silence <tag> <duration> - silence notifications for checks with the tag (e.g. 30m, 1h, 2d)",
+	}, "\n")
+}
+
+// Execute разбирает и выполняет текстовую команду
+func (c *Commands) Execute(ctx context.Context, actor Actor, prefix, text string) string {
+	args := strings.Fields(text)
+	if len(args) == 0 {
+		return Usage(prefix)
+	}
+
+	var (
+		reply string
+		err   error
+	)
+	switch strings.ToLower(args[0]) {
+	case "status":
+		reply, err = c.Status(ctx, actor)
+	case "ack":
+		if len(args) != 2 {
+			return "Usage: " + prefix + " ack <incident_id>"
+		}
+		reply, err = c.Acknowledge(ctx, actor, args[1])
+	case "resolve":
+		if len(args) != 2 {
+			return "Usage: " + prefix + " resolve <incident_id>"
+		}
+		reply, err = c.Resolve(ctx, actor, args[1])
+	case "silence":
+		if len(args) != 3 {
+			return "Usage: " + prefix + " silence <tag> <duration>"
+		}
+		duration, parseErr := ParseDuration(args[2])
+		if parseErr != nil {
+			return parseErr.Error()
+		}
+		reply, err = c.Silence(ctx, actor, args[1], duration)
+	default:
+		return Usage(prefix)
+	}
+
+	if err != nil {
+		return c.errorReply(actor, args[0], err)
+	}
+	return reply
+}
+
+// Status возвращает сводку по активным инцидентам
+func (c *Commands) Status(ctx context.Context, actor Actor) (string, error) {
+	incidents, err := c.incidents.ListActive(ctx, actor.TenantID)
+	if err != nil {
+		return "", err
+	}
+	if len(incidents) == 0 {
+		return "All checks are operational, no active incidents.", nil
+	}
+
+	var open, acknowledged int
+	for _, incident := range incidents {
+		if incident.Status == incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED {
+			acknowledged++
+		} else {
+			open++
+		}
+	}
+
+	lines := []string{fmt.Sprintf("Active incidents: %d open, %d acknowledged", open, acknowledged)}
+	for i, incident := range incidents {
+		if i == statusMaxLines {
+			lines = append(lines, fmt.Sprintf("...and %d more", len(incidents)-statusMaxLines))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("• %s [%s, %s] %s",
+			incident.Id, statusName(incident.Status), severityName(incident.Severity), incident.ErrorMessage))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// Acknowledge подтверждает инцидент
+func (c *Commands) Acknowledge(ctx context.Context, actor Actor, incidentID string) (string, error) {
+	if _, err := c.incidents.Acknowledge(ctx, actor.TenantID, incidentID); err != nil {
+		return "", err
+	}
+	c.logger.Info("Incident acknowledged from chat",
+		logger.String("tenant_id", actor.TenantID),
+		logger.String("incident_id", incidentID),
+		logger.String("user", actor.User))
+	return fmt.Sprintf("Incident %s acknowledged by %s", incidentID, actor.User), nil
+}
+
+// Resolve закрывает инцидент
+func (c *Commands) Resolve(ctx context.Context, actor Actor, incidentID string) (string, error) {
+	if err := c.incidents.Resolve(ctx, actor.TenantID, incidentID); err != nil {
+		return "", err
+	}
+	c.logger.Info("Incident resolved from chat",
+		logger.String("tenant_id", actor.TenantID),
+		logger.String("incident_id", incidentID),
+		logger.String("user", actor.User))
+	return fmt.Sprintf("Incident %s resolved by %s", incidentID, actor.User), nil
+}
+
+// Silence подавляет уведомления проверок с тегом на заданное время
+func (c *Commands) Silence(ctx context.Context, actor Actor, tag string, duration time.Duration) (string, error) {
+	now := c.now()
+	silence := &filter.Silence{
+		TenantID:  actor.TenantID,
+		Tag:       tag,
+		CreatedBy: actor.User,
+		CreatedAt: now,
+		ExpiresAt: now.Add(duration),
+	}
+	if err := c.silences.Add(ctx, silence); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Notifications for tag %s silenced until %s by %s",
+		tag, silence.ExpiresAt.UTC().Format("2006-01-02 15:04 UTC"), actor.User), nil
+}
+
+// Snooze подавляет уведомления по инциденту на заданное время
+func (c *Commands) Snooze(ctx context.Context, actor Actor, incidentID string, duration time.Duration) (string, error) {
+	now := c.now()
+	silence := &filter.Silence{
+		TenantID:   actor.TenantID,
+		IncidentID: incidentID,
+		CreatedBy:  actor.User,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(duration),
+	}
+	if err := c.silences.Add(ctx, silence); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Incident %s snoozed until %s by %s",
+		incidentID, silence.ExpiresAt.UTC().Format("2006-01-02 15:04 UTC"), actor.User), nil
+}
+
+// errorReply формирует ответ пользователю на ошибку; внутренние ошибки только логируются
+func (c *Commands) errorReply(actor Actor, command string, err error) string {
+	if errors.Is(err, ErrIncidentNotFound) {
+		return "Incident not found"
+	}
+	c.logger.Error("Chat command failed",
+		logger.String("tenant_id", actor.TenantID),
+		logger.String("command", command),
+		logger.Error(err))
+	return "Failed to execute command, please try again later"
+}
+
+// ParseDuration разбирает длительность в формате Go с поддержкой суток (2d)
+func ParseDuration(value string) (time.Duration, error) {
+	var (
+		duration time.Duration
+		err      error
+	)
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		var n int
+		n, err = strconv.Atoi(days)
+		duration = time.Duration(n) * 24 * time.Hour
+	} else {
+		duration, err = time.ParseDuration(value)
+	}
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid duration %q, expected a positive value like 30m, 1h or 2d", value)
+	}
+	if duration > filter.MaxSilenceDuration {
+		return 0, fmt.Errorf("duration must not exceed %s", filter.MaxSilenceDuration)
+	}
+	return duration, nil
+}
+
+// statusName возвращает короткое имя статуса инцидента
+func statusName(status incidentv1.IncidentStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "INCIDENT_STATUS_"))
+}
+
+// severityName возвращает короткое имя серьезности инцидента
+func severityName(severity incidentv1.IncidentSeverity) string {
+	return strings.ToLower(strings.TrimPrefix(severity.String(), "INCIDENT_SEVERITY_"))
+}
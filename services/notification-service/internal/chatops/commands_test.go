@@ -0,0 +1,188 @@
+package chatops
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	"UptimePingPlatform/services/notification-service/internal/filter"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, fields ...logger.Field)  {}
+func (l *testLogger) Info(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Warn(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Error(msg string, fields ...logger.Field)  {}
+func (l *testLogger) With(fields ...logger.Field) logger.Logger { return l }
+func (l *testLogger) Sync() error                               { return nil }
+
+// fakeIncidentAPI хранит инциденты в памяти
+type fakeIncidentAPI struct {
+	incidents map[string]*incidentv1.Incident
+}
+
+func newFakeIncidentAPI() *fakeIncidentAPI {
+	return &fakeIncidentAPI{incidents: map[string]*incidentv1.Incident{
+		"inc-1": {Id: "inc-1", TenantId: "tenant-1", Status: incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN, Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_CRITICAL, ErrorMessage: "connection refused"},
+		"inc-2": {Id: "inc-2", TenantId: "tenant-1", Status: incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED, Severity: incidentv1.IncidentSeverity_INCIDENT_SEVERITY_WARNING, ErrorMessage: "slow response"},
+		"inc-3": {Id: "inc-3", TenantId: "tenant-2", Status: incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN},
+	}}
+}
+
+func (f *fakeIncidentAPI) ListActive(ctx context.Context, tenantID string) ([]*incidentv1.Incident, error) {
+	var result []*incidentv1.Incident
+	for _, id := range []string{"inc-1", "inc-2", "inc-3"} {
+		incident := f.incidents[id]
+		if incident.TenantId == tenantID && incident.Status != incidentv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+			result = append(result, incident)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeIncidentAPI) get(tenantID, incidentID string) (*incidentv1.Incident, error) {
+	incident, ok := f.incidents[incidentID]
+	if !ok || incident.TenantId != tenantID {
+		return nil, ErrIncidentNotFound
+	}
+	return incident, nil
+}
+
+func (f *fakeIncidentAPI) Acknowledge(ctx context.Context, tenantID, incidentID string) (*incidentv1.Incident, error) {
+	incident, err := f.get(tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+	incident.Status = incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED
+	return incident, nil
+}
+
+func (f *fakeIncidentAPI) Resolve(ctx context.Context, tenantID, incidentID string) error {
+	incident, err := f.get(tenantID, incidentID)
+	if err != nil {
+		return err
+	}
+	incident.Status = incidentv1.IncidentStatus_INCIDENT_STATUS_RESOLVED
+	return nil
+}
+
+func newTestCommands() (*Commands, *fakeIncidentAPI, *filter.MemorySilenceStore) {
+	incidents := newFakeIncidentAPI()
+	silences := filter.NewMemorySilenceStore()
+	return NewCommands(incidents, silences, &testLogger{}), incidents, silences
+}
+
+func TestCommands_Status(t *testing.T) {
+	commands, _, _ := newTestCommands()
+	actor := Actor{TenantID: "tenant-1", User: "alice"}
+
+	reply := commands.Execute(context.Background(), actor, "/uptime", "status")
+	if !strings.Contains(reply, "1 open, 1 acknowledged") {
+		t.Errorf("unexpected status reply: %q", reply)
+	}
+	if !strings.Contains(reply, "inc-1 [open, critical] connection refused") {
+		t.Errorf("status reply does not list incident: %q", reply)
+	}
+	if strings.Contains(reply, "inc-3") {
+		t.Errorf("status reply leaks other tenant incident: %q", reply)
+	}
+
+	reply = commands.Execute(context.Background(), Actor{TenantID: "tenant-3"}, "/uptime", "status")
+	if !strings.Contains(reply, "no active incidents") {
+		t.Errorf("unexpected empty status reply: %q", reply)
+	}
+}
+
+func TestCommands_AckAndResolve(t *testing.T) {
+	commands, incidents, _ := newTestCommands()
+	actor := Actor{TenantID: "tenant-1", User: "alice"}
+
+	reply := commands.Execute(context.Background(), actor, "/uptime", "ack inc-1")
+	if reply != "Incident inc-1 acknowledged by alice" {
+		t.Errorf("unexpected ack reply: %q", reply)
+	}
+	if incidents.incidents["inc-1"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED {
+		t.Error("incident was not acknowledged")
+	}
+
+	reply = commands.Execute(context.Background(), actor, "/uptime", "resolve inc-1")
+	if reply != "Incident inc-1 resolved by alice" {
+		t.Errorf("unexpected resolve reply: %q", reply)
+	}
+
+	// Инцидент другого тенанта недоступен
+	reply = commands.Execute(context.Background(), actor, "/uptime", "ack inc-3")
+	if reply != "Incident not found" {
+		t.Errorf("unexpected reply for foreign incident: %q", reply)
+	}
+	if incidents.incidents["inc-3"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN {
+		t.Error("foreign incident was modified")
+	}
+}
+
+func TestCommands_Silence(t *testing.T) {
+	commands, _, silences := newTestCommands()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	commands.now = func() time.Time { return now }
+	actor := Actor{TenantID: "tenant-1", User: "alice"}
+
+	reply := commands.Execute(context.Background(), actor, "/uptime", "silence database 1h")
+	if reply != "Notifications for tag database silenced until 2024-05-01 13:00 UTC by alice" {
+		t.Errorf("unexpected silence reply: %q", reply)
+	}
+
+	active, _ := silences.Active(context.Background(), "tenant-1", now)
+	if len(active) != 1 || active[0].Tag != "database" || active[0].CreatedBy != "alice" {
+		t.Fatalf("unexpected silences: %+v", active)
+	}
+
+	reply = commands.Execute(context.Background(), actor, "/uptime", "silence database forever")
+	if !strings.Contains(reply, "invalid duration") {
+		t.Errorf("unexpected reply for invalid duration: %q", reply)
+	}
+}
+
+func TestCommands_Usage(t *testing.T) {
+	commands, _, _ := newTestCommands()
+	actor := Actor{TenantID: "tenant-1"}
+
+	for _, text := range []string{"", "help", "unknown command"} {
+		if reply := commands.Execute(context.Background(), actor, "/uptime", text); !strings.HasPrefix(reply, "Available commands:") {
+			t.Errorf("Execute(%q) = %q, want usage", text, reply)
+		}
+	}
+	if reply := commands.Execute(context.Background(), actor, "/uptime", "ack"); reply != "Usage: /uptime ack <incident_id>" {
+		t.Errorf("unexpected reply for ack without id: %q", reply)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "30m", want: 30 * time.Minute},
+		{value: "1h", want: time.Hour},
+		{value: "2d", want: 48 * time.Hour},
+		{value: "0s", wantErr: true},
+		{value: "-1h", wantErr: true},
+		{value: "30d", wantErr: true},
+		{value: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDuration(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package chatops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+)
+
+// ErrIncidentNotFound инцидент не найден или принадлежит другому тенанту
+var ErrIncidentNotFound = errors.New("incident not found")
+
+// statusPageSize количество инцидентов каждого статуса в ответе /uptime status
+const statusPageSize = 20
+
+// IncidentAPI операции с инцидентами, доступные из чатов
+type IncidentAPI interface {
+	ListActive(ctx context.Context, tenantID string) ([]*incidentv1.Incident, error)
+	Acknowledge(ctx context.Context, tenantID, incidentID string) (*incidentv1.Incident, error)
+	Resolve(ctx context.Context, tenantID, incidentID string) error
+}
+
+// GRPCIncidentAPI выполняет операции через gRPC API Incident Manager
+type GRPCIncidentAPI struct {
+	client incidentv1.IncidentServiceClient
+}
+
+// NewGRPCIncidentAPI создает IncidentAPI поверх gRPC клиента
+func NewGRPCIncidentAPI(client incidentv1.IncidentServiceClient) *GRPCIncidentAPI {
+	return &GRPCIncidentAPI{client: client}
+}
+
+// ListActive возвращает открытые и подтвержденные инциденты тенанта
+func (a *GRPCIncidentAPI) ListActive(ctx context.Context, tenantID string) ([]*incidentv1.Incident, error) {
+	var incidents []*incidentv1.Incident
+	for _, incidentStatus := range []incidentv1.IncidentStatus{
+		incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN,
+		incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED,
+	} {
+		resp, err := a.client.ListIncidents(ctx, &incidentv1.ListIncidentsRequest{
+			TenantId: tenantID,
+			Status:   incidentStatus,
+			PageSize: statusPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list incidents: %w", err)
+		}
+		incidents = append(incidents, resp.Incidents...)
+	}
+	return incidents, nil
+}
+
+// Acknowledge подтверждает инцидент тенанта
+func (a *GRPCIncidentAPI) Acknowledge(ctx context.Context, tenantID, incidentID string) (*incidentv1.Incident, error) {
+	incident, err := a.get(ctx, tenantID, incidentID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := a.client.UpdateIncident(ctx, &incidentv1.UpdateIncidentRequest{
+		IncidentId: incidentID,
+		Status:     incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED,
+		Severity:   incident.Severity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge incident: %w", err)
+	}
+	return updated, nil
+}
+
+// Resolve закрывает инцидент тенанта
+func (a *GRPCIncidentAPI) Resolve(ctx context.Context, tenantID, incidentID string) error {
+	if _, err := a.get(ctx, tenantID, incidentID); err != nil {
+		return err
+	}
+
+	if _, err := a.client.ResolveIncident(ctx, &incidentv1.ResolveIncidentRequest{IncidentId: incidentID}); err != nil {
+		return fmt.Errorf("failed to resolve incident: %w", err)
+	}
+	return nil
+}
+
+// get загружает инцидент и проверяет, что он принадлежит тенанту
+func (a *GRPCIncidentAPI) get(ctx context.Context, tenantID, incidentID string) (*incidentv1.Incident, error) {
+	resp, err := a.client.GetIncident(ctx, &incidentv1.GetIncidentRequest{IncidentId: incidentID})
+	if status.Code(err) == codes.NotFound || status.Code(err) == codes.InvalidArgument {
+		return nil, ErrIncidentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident: %w", err)
+	}
+	if resp.Incident == nil || resp.Incident.TenantId != tenantID {
+		return nil, ErrIncidentNotFound
+	}
+	return resp.Incident, nil
+}
@@ -0,0 +1,292 @@
+package chatops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
+	slackprovider "UptimePingPlatform/services/notification-service/internal/provider/slack"
+)
+
+// Параметры проверки подписи запросов Slack
+const (
+	slackMaxClockSkew = 5 * time.Minute
+	slackMaxBodySize  = 64 << 10
+	// slackCommandTimeout Slack ожидает ответ на slash команду не дольше 3 секунд
+	slackCommandTimeout = 2500 * time.Millisecond
+	slackActionTimeout  = 10 * time.Second
+)
+
+// SlackConfig конфигурация Slack приложения
+type SlackConfig struct {
+	// SigningSecret секрет подписи запросов из настроек Slack приложения
+	SigningSecret string
+	// Teams сопоставляет workspace (team_id) тенанту платформы
+	Teams map[string]string
+	// Command имя slash команды для справки
+	Command string
+}
+
+// ParseSlackTeams разбирает сопоставление workspace тенантам в формате "T123:tenant-1,T456:tenant-2"
+func ParseSlackTeams(value string) (map[string]string, error) {
+	teams := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		teamID, tenantID, ok := strings.Cut(pair, ":")
+		if !ok || teamID == "" || tenantID == "" {
+			return nil, fmt.Errorf("invalid slack team mapping %q, expected team_id:tenant_id", pair)
+		}
+		teams[teamID] = tenantID
+	}
+	return teams, nil
+}
+
+// VerifySlackSignature проверяет подпись запроса Slack (X-Slack-Signature) и его свежесть
+func VerifySlackSignature(secret string, header http.Header, body []byte, now time.Time) error {
+	return newSlackVerifier(secret, func() time.Time { return now }).Verify(context.Background(), header, body)
+}
+
+// newSlackVerifier создает проверку подписи Slack
+func newSlackVerifier(secret string, now func() time.Time) *webhooks.Verifier {
+	return webhooks.NewVerifier(secret).
+		WithScheme(webhooks.SlackScheme).
+		WithTolerance(slackMaxClockSkew).
+		WithClock(now)
+}
+
+// SlackHandler обрабатывает slash команды и нажатия кнопок Slack
+type SlackHandler struct {
+	config   SlackConfig
+	commands *Commands
+	logger   logger.Logger
+	client   *http.Client
+	verifier *webhooks.Verifier
+	now      func() time.Time
+}
+
+// NewSlackHandler создает обработчик Slack
+func NewSlackHandler(config SlackConfig, commands *Commands, logger logger.Logger) *SlackHandler {
+	if config.Command == "" {
+		config.Command = "/uptime"
+	}
+	h := &SlackHandler{
+		config:   config,
+		commands: commands,
+		logger:   logger,
+		client:   &http.Client{Timeout: slackActionTimeout},
+		now:      time.Now,
+	}
+	h.verifier = newSlackVerifier(config.SigningSecret, func() time.Time { return h.now() })
+	return h
+}
+
+// WithReplayCache включает отклонение повторно доставленных запросов Slack
+func (h *SlackHandler) WithReplayCache(cache webhooks.ReplayCache) *SlackHandler {
+	h.verifier.WithReplayCache(cache)
+	return h
+}
+
+// RegisterRoutes регистрирует HTTP маршруты Slack
+func (h *SlackHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/slack/commands", h.handleCommand)
+	mux.HandleFunc("/api/v1/slack/interactions", h.handleInteraction)
+}
+
+// slackReply ответ на slash команду
+type slackReply struct {
+	ResponseType    string `json:"response_type,omitempty"`
+	ReplaceOriginal bool   `json:"replace_original"`
+	Text            string `json:"text"`
+}
+
+// handleCommand обрабатывает slash команду /uptime
+func (h *SlackHandler) handleCommand(w http.ResponseWriter, r *http.Request) {
+	form, actor, ok := h.authenticate(w, r)
+	if !ok {
+		return
+	}
+	actor.User = firstNonEmpty(form.Get("user_name"), form.Get("user_id"))
+
+	ctx, cancel := context.WithTimeout(r.Context(), slackCommandTimeout)
+	defer cancel()
+
+	text := h.commands.Execute(ctx, actor, h.config.Command, form.Get("text"))
+	h.writeJSON(w, slackReply{ResponseType: "ephemeral", Text: text})
+}
+
+// slackInteraction payload нажатия кнопки (block_actions)
+type slackInteraction struct {
+	Type string `json:"type"`
+	Team struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	User struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	ResponseURL string `json:"response_url"`
+	Actions     []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+}
+
+// handleInteraction обрабатывает нажатия кнопок Ack / Resolve / Snooze в сообщениях об алертах
+func (h *SlackHandler) handleInteraction(w http.ResponseWriter, r *http.Request) {
+	form, ok := h.readSigned(w, r)
+	if !ok {
+		return
+	}
+
+	var interaction slackInteraction
+	if err := json.Unmarshal([]byte(form.Get("payload")), &interaction); err != nil {
+		http.Error(w, "Invalid payload", http.StatusBadRequest)
+		return
+	}
+	if interaction.Type != "block_actions" || len(interaction.Actions) == 0 {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	tenantID, ok := h.config.Teams[interaction.Team.ID]
+	if !ok {
+		http.Error(w, "Unknown workspace", http.StatusForbidden)
+		return
+	}
+	actor := Actor{TenantID: tenantID, User: firstNonEmpty(interaction.User.Username, interaction.User.ID)}
+	action := interaction.Actions[0]
+
+	// Slack требует подтвердить нажатие в течение 3 секунд, результат отправляется через response_url
+	w.WriteHeader(http.StatusOK)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), slackActionTimeout)
+		defer cancel()
+
+		text := h.executeAction(ctx, actor, action.ActionID, action.Value)
+		if err := h.respond(ctx, interaction.ResponseURL, slackReply{ResponseType: "in_channel", Text: text}); err != nil {
+			h.logger.Warn("Failed to send slack action response", logger.Error(err))
+		}
+	}()
+}
+
+// executeAction выполняет действие кнопки
+func (h *SlackHandler) executeAction(ctx context.Context, actor Actor, actionID, incidentID string) string {
+	var (
+		text string
+		err  error
+	)
+	switch actionID {
+	case slackprovider.ActionAcknowledge:
+		text, err = h.commands.Acknowledge(ctx, actor, incidentID)
+	case slackprovider.ActionResolve:
+		text, err = h.commands.Resolve(ctx, actor, incidentID)
+	case slackprovider.ActionSnooze:
+		text, err = h.commands.Snooze(ctx, actor, incidentID, DefaultSnoozeDuration)
+	default:
+		return fmt.Sprintf("Unknown action %s", actionID)
+	}
+	if err != nil {
+		return h.commands.errorReply(actor, actionID, err)
+	}
+	return text
+}
+
+// respond отправляет ответ на response_url; разрешены только адреса hooks.slack.com
+func (h *SlackHandler) respond(ctx context.Context, responseURL string, reply slackReply) error {
+	parsed, err := url.Parse(responseURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Hostname() != "hooks.slack.com" {
+		return fmt.Errorf("invalid slack response url %q", responseURL)
+	}
+
+	body, err := json.Marshal(reply)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, parsed.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack response url returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// authenticate проверяет подпись запроса и определяет тенант по workspace
+func (h *SlackHandler) authenticate(w http.ResponseWriter, r *http.Request) (url.Values, Actor, bool) {
+	form, ok := h.readSigned(w, r)
+	if !ok {
+		return nil, Actor{}, false
+	}
+
+	tenantID, ok := h.config.Teams[form.Get("team_id")]
+	if !ok {
+		h.writeJSON(w, slackReply{ResponseType: "ephemeral", Text: "This Slack workspace is not connected to UptimePing"})
+		return nil, Actor{}, false
+	}
+	return form, Actor{TenantID: tenantID}, true
+}
+
+// readSigned читает тело запроса, проверяет подпись Slack и разбирает форму
+func (h *SlackHandler) readSigned(w http.ResponseWriter, r *http.Request) (url.Values, bool) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return nil, false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, slackMaxBodySize))
+	if err != nil {
+		http.Error(w, "Failed to read body", http.StatusBadRequest)
+		return nil, false
+	}
+
+	if err := h.verifier.Verify(r.Context(), r.Header, body); err != nil {
+		h.logger.Warn("Rejected slack request", logger.Error(err))
+		http.Error(w, "Invalid signature", webhooks.StatusCode(err))
+		return nil, false
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "Invalid form", http.StatusBadRequest)
+		return nil, false
+	}
+	return form, true
+}
+
+// writeJSON записывает JSON ответ
+func (h *SlackHandler) writeJSON(w http.ResponseWriter, reply slackReply) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reply)
+}
+
+// firstNonEmpty возвращает первое непустое значение
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
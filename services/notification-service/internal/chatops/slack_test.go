@@ -0,0 +1,189 @@
+package chatops
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/webhooks"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	slackprovider "UptimePingPlatform/services/notification-service/internal/provider/slack"
+)
+
+const testSigningSecret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+func signSlackRequest(req *http.Request, body string, ts time.Time) {
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(testSigningSecret))
+	mac.Write([]byte("v0:" + timestamp + ":" + body))
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+}
+
+func newTestSlackHandler() (*SlackHandler, *fakeIncidentAPI) {
+	commands, incidents, _ := newTestCommands()
+	handler := NewSlackHandler(SlackConfig{
+		SigningSecret: testSigningSecret,
+		Teams:         map[string]string{"T123": "tenant-1"},
+	}, commands, &testLogger{})
+	return handler, incidents
+}
+
+func TestVerifySlackSignature(t *testing.T) {
+	now := time.Now()
+	body := "team_id=T123&text=status"
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/slack/commands", nil)
+	signSlackRequest(req, body, now)
+	if err := VerifySlackSignature(testSigningSecret, req.Header, []byte(body), now); err != nil {
+		t.Errorf("valid signature rejected: %v", err)
+	}
+	if err := VerifySlackSignature(testSigningSecret, req.Header, []byte(body+"&x=1"), now); err == nil {
+		t.Error("tampered body accepted")
+	}
+	if err := VerifySlackSignature("other-secret", req.Header, []byte(body), now); err == nil {
+		t.Error("signature with wrong secret accepted")
+	}
+	if err := VerifySlackSignature(testSigningSecret, req.Header, []byte(body), now.Add(10*time.Minute)); err == nil {
+		t.Error("replayed request accepted")
+	}
+	if err := VerifySlackSignature(testSigningSecret, http.Header{}, []byte(body), now); err == nil {
+		t.Error("request without signature accepted")
+	}
+}
+
+func TestSlackHandler_Command(t *testing.T) {
+	handler, incidents := newTestSlackHandler()
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	body := url.Values{"team_id": {"T123"}, "user_name": {"alice"}, "command": {"/uptime"}, "text": {"ack inc-1"}}.Encode()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/slack/commands", strings.NewReader(body))
+	signSlackRequest(req, body, time.Now())
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	var reply slackReply
+	if err := json.Unmarshal(rec.Body.Bytes(), &reply); err != nil {
+		t.Fatalf("invalid reply: %v", err)
+	}
+	if reply.ResponseType != "ephemeral" || reply.Text != "Incident inc-1 acknowledged by alice" {
+		t.Errorf("unexpected reply: %+v", reply)
+	}
+	if incidents.incidents["inc-1"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED {
+		t.Error("incident was not acknowledged")
+	}
+}
+
+func TestSlackHandler_CommandRejectsUnsigned(t *testing.T) {
+	handler, incidents := newTestSlackHandler()
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	body := url.Values{"team_id": {"T123"}, "text": {"resolve inc-1"}}.Encode()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/slack/commands", strings.NewReader(body))
+	req.Header.Set("X-Slack-Request-Timestamp", strconv.FormatInt(time.Now().Unix(), 10))
+	req.Header.Set("X-Slack-Signature", "v0=deadbeef")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", rec.Code)
+	}
+	if incidents.incidents["inc-1"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN {
+		t.Error("incident was modified by unsigned request")
+	}
+}
+
+func TestSlackHandler_CommandRejectsReplay(t *testing.T) {
+	handler, _ := newTestSlackHandler()
+	handler.WithReplayCache(webhooks.NewMemoryReplayCache())
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	body := url.Values{"team_id": {"T123"}, "user_name": {"alice"}, "text": {"status"}}.Encode()
+	now := time.Now()
+	send := func() int {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/slack/commands", strings.NewReader(body))
+		signSlackRequest(req, body, now)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := send(); code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", code)
+	}
+	if code := send(); code != http.StatusConflict {
+		t.Errorf("replayed request status = %d, want 409", code)
+	}
+}
+
+func TestSlackHandler_CommandUnknownWorkspace(t *testing.T) {
+	handler, _ := newTestSlackHandler()
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	body := url.Values{"team_id": {"T999"}, "text": {"status"}}.Encode()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/slack/commands", strings.NewReader(body))
+	signSlackRequest(req, body, time.Now())
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if !strings.Contains(rec.Body.String(), "not connected") {
+		t.Errorf("unexpected reply: %s", rec.Body.String())
+	}
+}
+
+func TestSlackHandler_ExecuteAction(t *testing.T) {
+	handler, incidents := newTestSlackHandler()
+	actor := Actor{TenantID: "tenant-1", User: "bob"}
+	ctx := context.Background()
+
+	if text := handler.executeAction(ctx, actor, slackprovider.ActionSnooze, "inc-1"); !strings.HasPrefix(text, "Incident inc-1 snoozed until") {
+		t.Errorf("unexpected snooze reply: %q", text)
+	}
+	if text := handler.executeAction(ctx, actor, slackprovider.ActionResolve, "inc-1"); text != "Incident inc-1 resolved by bob" {
+		t.Errorf("unexpected resolve reply: %q", text)
+	}
+	if incidents.incidents["inc-1"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_RESOLVED {
+		t.Error("incident was not resolved")
+	}
+	if text := handler.executeAction(ctx, actor, slackprovider.ActionAcknowledge, "inc-3"); text != "Incident not found" {
+		t.Errorf("unexpected reply for foreign incident: %q", text)
+	}
+}
+
+func TestSlackHandler_RespondRejectsForeignURL(t *testing.T) {
+	handler, _ := newTestSlackHandler()
+	for _, responseURL := range []string{"http://hooks.slack.com/actions/1", "https://example.com/actions/1", "https://hooks.slack.com.evil.example/1"} {
+		if err := handler.respond(context.Background(), responseURL, slackReply{Text: "ok"}); err == nil {
+			t.Errorf("respond(%q) accepted foreign url", responseURL)
+		}
+	}
+}
+
+func TestParseSlackTeams(t *testing.T) {
+	teams, err := ParseSlackTeams("T123:tenant-1, T456:tenant-2")
+	if err != nil {
+		t.Fatalf("ParseSlackTeams() error = %v", err)
+	}
+	if teams["T123"] != "tenant-1" || teams["T456"] != "tenant-2" {
+		t.Errorf("unexpected teams: %v", teams)
+	}
+	if _, err := ParseSlackTeams("T123"); err == nil {
+		t.Error("invalid mapping accepted")
+	}
+}
@@ -0,0 +1,291 @@
+package chatops
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
+	"UptimePingPlatform/services/notification-service/internal/service"
+)
+
+// Ключи конфигурации Telegram канала, задающие привязку чата к тенанту
+const (
+	// TelegramConfigChatID ID чата, в который канал отправляет уведомления и из которого принимает команды
+	TelegramConfigChatID = "chat_id"
+	// TelegramConfigMembers участники чата, которым разрешены команды: "telegram_user_id:platform_user_id,..."
+	TelegramConfigMembers = "members"
+)
+
+const (
+	telegramMaxBodySize = 64 << 10
+	telegramTimeout     = 5 * time.Second
+	// telegramSecretHeader заголовок с секретом, заданным при setWebhook
+	telegramSecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+	// telegramReplayTTL время, в течение которого повторная доставка обновления игнорируется;
+	// Telegram хранит недоставленные обновления до суток
+	telegramReplayTTL = 24 * time.Hour
+)
+
+// TelegramBinding привязка чата Telegram к тенанту платформы
+type TelegramBinding struct {
+	ChannelID string
+	TenantID  string
+	ChatID    int64
+	// Members сопоставляет ID пользователя Telegram пользователю платформы
+	Members map[int64]string
+}
+
+// TelegramBindingFromChannel строит привязку из конфигурации Telegram канала
+func TelegramBindingFromChannel(channel *service.Channel) (*TelegramBinding, error) {
+	if channel.Type != service.ChannelTypeTelegram {
+		return nil, fmt.Errorf("channel %s is not a telegram channel", channel.ID)
+	}
+	chatID, err := strconv.ParseInt(channel.Config[TelegramConfigChatID], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("channel %s: invalid %s: %w", channel.ID, TelegramConfigChatID, err)
+	}
+
+	members := make(map[int64]string)
+	for _, pair := range strings.Split(channel.Config[TelegramConfigMembers], ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		telegramID, userID, ok := strings.Cut(pair, ":")
+		id, err := strconv.ParseInt(telegramID, 10, 64)
+		if !ok || err != nil || userID == "" {
+			return nil, fmt.Errorf("channel %s: invalid member %q, expected telegram_user_id:platform_user_id", channel.ID, pair)
+		}
+		members[id] = userID
+	}
+
+	return &TelegramBinding{
+		ChannelID: channel.ID,
+		TenantID:  channel.TenantID,
+		ChatID:    chatID,
+		Members:   members,
+	}, nil
+}
+
+// TelegramBindings привязки чатов к тенантам
+type TelegramBindings struct {
+	mu       sync.RWMutex
+	bindings map[int64]*TelegramBinding
+}
+
+// NewTelegramBindings создает пустой набор привязок
+func NewTelegramBindings() *TelegramBindings {
+	return &TelegramBindings{bindings: make(map[int64]*TelegramBinding)}
+}
+
+// Bind добавляет или заменяет привязку чата из конфигурации канала.
+// Чат может принадлежать только одному тенанту
+func (b *TelegramBindings) Bind(channel *service.Channel) error {
+	binding, err := TelegramBindingFromChannel(channel)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if existing, ok := b.bindings[binding.ChatID]; ok && existing.TenantID != binding.TenantID {
+		return fmt.Errorf("chat %d is already bound to another tenant", binding.ChatID)
+	}
+	b.bindings[binding.ChatID] = binding
+	return nil
+}
+
+// Unbind удаляет привязку чата
+func (b *TelegramBindings) Unbind(chatID int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.bindings, chatID)
+}
+
+// Lookup возвращает привязку чата
+func (b *TelegramBindings) Lookup(chatID int64) (*TelegramBinding, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	binding, ok := b.bindings[chatID]
+	return binding, ok
+}
+
+// TelegramBot принимает команды из чатов Telegram через webhook
+type TelegramBot struct {
+	secret   string
+	bindings *TelegramBindings
+	commands *Commands
+	logger   logger.Logger
+	replay   webhooks.ReplayCache
+}
+
+// NewTelegramBot создает бота. secret - значение secret_token, переданное в setWebhook
+func NewTelegramBot(secret string, bindings *TelegramBindings, commands *Commands, logger logger.Logger) *TelegramBot {
+	return &TelegramBot{
+		secret:   secret,
+		bindings: bindings,
+		commands: commands,
+		logger:   logger,
+	}
+}
+
+// WithReplayCache включает пропуск повторно доставленных обновлений по update_id. Telegram
+// не подписывает обновления, поэтому подлинность подтверждает только secret_token
+func (b *TelegramBot) WithReplayCache(cache webhooks.ReplayCache) *TelegramBot {
+	b.replay = cache
+	return b
+}
+
+// RegisterRoutes регистрирует HTTP маршрут webhook Telegram
+func (b *TelegramBot) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/telegram/webhook", b.handleUpdate)
+}
+
+// telegramUpdate входящее обновление Telegram Bot API
+type telegramUpdate struct {
+	UpdateID int64 `json:"update_id"`
+	Message  *struct {
+		From *struct {
+			ID int64 `json:"id"`
+		} `json:"from"`
+		Chat struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+		Text string `json:"text"`
+	} `json:"message"`
+}
+
+// telegramReply ответ на webhook вызовом метода sendMessage
+type telegramReply struct {
+	Method string `json:"method"`
+	ChatID int64  `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+// handleUpdate обрабатывает обновление. Ответ отправляется в теле ответа на webhook,
+// ошибки обработки не возвращаются статусом, иначе Telegram будет повторять доставку
+func (b *TelegramBot) handleUpdate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(telegramSecretHeader)), []byte(b.secret)) != 1 {
+		b.logger.Warn("Rejected telegram webhook with invalid secret")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, telegramMaxBodySize))
+	if err != nil {
+		http.Error(w, "Failed to read body", http.StatusBadRequest)
+		return
+	}
+	var update telegramUpdate
+	if err := json.Unmarshal(body, &update); err != nil {
+		http.Error(w, "Invalid update", http.StatusBadRequest)
+		return
+	}
+
+	if b.replay != nil {
+		first, err := b.replay.MarkSeen(r.Context(), "telegram:"+strconv.FormatInt(update.UpdateID, 10), telegramReplayTTL)
+		if err != nil {
+			b.logger.Warn("Failed to check telegram update replay", logger.Error(err))
+			http.Error(w, "Service unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		if !first {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	message := update.Message
+	if message == nil || message.From == nil || !strings.HasPrefix(message.Text, "/") {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), telegramTimeout)
+	defer cancel()
+
+	text, ok := b.execute(ctx, message.Chat.ID, message.From.ID, message.Text)
+	if !ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(telegramReply{Method: "sendMessage", ChatID: message.Chat.ID, Text: text})
+}
+
+// execute выполняет команду участника чата. Возвращает false для команд, адресованных не боту
+func (b *TelegramBot) execute(ctx context.Context, chatID, fromID int64, text string) (string, bool) {
+	args := strings.Fields(text)
+	// В группах команды приходят в виде /status@BotName
+	command, _, _ := strings.Cut(strings.TrimPrefix(args[0], "/"), "@")
+	command = strings.ToLower(command)
+
+	switch command {
+	case "status", "ack", "mute", "help", "start":
+	default:
+		return "", false
+	}
+
+	binding, ok := b.bindings.Lookup(chatID)
+	if !ok {
+		return "This chat is not linked to an UptimePing notification channel", true
+	}
+	userID, ok := binding.Members[fromID]
+	if !ok {
+		b.logger.Warn("Rejected telegram command from unauthorized member",
+			logger.String("tenant_id", binding.TenantID),
+			logger.String("command", command))
+		return "You are not authorized to run commands in this chat", true
+	}
+	actor := Actor{TenantID: binding.TenantID, User: userID}
+
+	var (
+		reply string
+		err   error
+	)
+	switch command {
+	case "status":
+		reply, err = b.commands.Status(ctx, actor)
+	case "ack":
+		if len(args) != 2 {
+			return "Usage: /ack <incident_id>", true
+		}
+		reply, err = b.commands.Acknowledge(ctx, actor, args[1])
+	case "mute":
+		if len(args) != 3 {
+			return "Usage: /mute <tag> <duration>", true
+		}
+		duration, parseErr := ParseDuration(args[2])
+		if parseErr != nil {
+			return parseErr.Error(), true
+		}
+		reply, err = b.commands.Silence(ctx, actor, args[1], duration)
+	default:
+		return telegramUsage, true
+	}
+
+	if err != nil {
+		return b.commands.errorReply(actor, command, err), true
+	}
+	return reply, true
+}
+
+// telegramUsage справка по командам бота
+const telegramUsage = `Available commands:
+/status - list open and acknowledged incidents
+/ack <incident_id> - acknowledge an incident
+/mute <tag> <duration> - mute notifications for checks with the tag (e.g. 30m, 1h, 2d)`
@@ -0,0 +1,205 @@
+package chatops
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"UptimePingPlatform/pkg/webhooks"
+	incidentv1 "UptimePingPlatform/proto/api/incident/v1"
+	"UptimePingPlatform/services/notification-service/internal/service"
+)
+
+const testTelegramSecret = "telegram-webhook-secret"
+
+func newTestTelegramBot(t *testing.T) (*http.ServeMux, *fakeIncidentAPI) {
+	t.Helper()
+
+	commands, incidents, _ := newTestCommands()
+	bindings := NewTelegramBindings()
+	err := bindings.Bind(&service.Channel{
+		ID:       "channel-1",
+		TenantID: "tenant-1",
+		Type:     service.ChannelTypeTelegram,
+		Config: map[string]string{
+			TelegramConfigChatID:  "-100500",
+			TelegramConfigMembers: "111:user-alice, 222:user-bob",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	mux := http.NewServeMux()
+	NewTelegramBot(testTelegramSecret, bindings, commands, &testLogger{}).RegisterRoutes(mux)
+	return mux, incidents
+}
+
+func sendTelegramUpdate(mux *http.ServeMux, secret string, chatID, fromID int64, text string) *httptest.ResponseRecorder {
+	update := map[string]interface{}{
+		"update_id": 1,
+		"message": map[string]interface{}{
+			"message_id": 10,
+			"from":       map[string]interface{}{"id": fromID},
+			"chat":       map[string]interface{}{"id": chatID},
+			"text":       text,
+		},
+	}
+	body, _ := json.Marshal(update)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/telegram/webhook", strings.NewReader(string(body)))
+	req.Header.Set(telegramSecretHeader, secret)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeTelegramReply(t *testing.T, rec *httptest.ResponseRecorder) telegramReply {
+	t.Helper()
+	var reply telegramReply
+	if err := json.Unmarshal(rec.Body.Bytes(), &reply); err != nil {
+		t.Fatalf("invalid reply %q: %v", rec.Body.String(), err)
+	}
+	return reply
+}
+
+func TestTelegramBot_Ack(t *testing.T) {
+	mux, incidents := newTestTelegramBot(t)
+
+	rec := sendTelegramUpdate(mux, testTelegramSecret, -100500, 111, "/ack@UptimePingBot inc-1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	reply := decodeTelegramReply(t, rec)
+	if reply.Method != "sendMessage" || reply.ChatID != -100500 {
+		t.Errorf("unexpected reply: %+v", reply)
+	}
+	if reply.Text != "Incident inc-1 acknowledged by user-alice" {
+		t.Errorf("unexpected reply text: %q", reply.Text)
+	}
+	if incidents.incidents["inc-1"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_ACKNOWLEDGED {
+		t.Error("incident was not acknowledged")
+	}
+}
+
+func TestTelegramBot_IgnoresRedeliveredUpdate(t *testing.T) {
+	commands, incidents, _ := newTestCommands()
+	bindings := NewTelegramBindings()
+	if err := bindings.Bind(&service.Channel{
+		ID:       "channel-1",
+		TenantID: "tenant-1",
+		Type:     service.ChannelTypeTelegram,
+		Config: map[string]string{
+			TelegramConfigChatID:  "-100500",
+			TelegramConfigMembers: "111:user-alice",
+		},
+	}); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	mux := http.NewServeMux()
+	NewTelegramBot(testTelegramSecret, bindings, commands, &testLogger{}).
+		WithReplayCache(webhooks.NewMemoryReplayCache()).
+		RegisterRoutes(mux)
+
+	if reply := decodeTelegramReply(t, sendTelegramUpdate(mux, testTelegramSecret, -100500, 111, "/ack inc-1")); reply.Text == "" {
+		t.Fatal("first delivery was not handled")
+	}
+	incidents.incidents["inc-1"].Status = incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN
+
+	rec := sendTelegramUpdate(mux, testTelegramSecret, -100500, 111, "/ack inc-1")
+	if rec.Code != http.StatusOK || rec.Body.Len() != 0 {
+		t.Errorf("redelivered update: status = %d, body = %q, want empty 200", rec.Code, rec.Body.String())
+	}
+	if incidents.incidents["inc-1"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN {
+		t.Error("redelivered update was executed again")
+	}
+}
+
+func TestTelegramBot_StatusAndMute(t *testing.T) {
+	mux, _ := newTestTelegramBot(t)
+
+	reply := decodeTelegramReply(t, sendTelegramUpdate(mux, testTelegramSecret, -100500, 222, "/status"))
+	if !strings.Contains(reply.Text, "1 open, 1 acknowledged") {
+		t.Errorf("unexpected status reply: %q", reply.Text)
+	}
+
+	reply = decodeTelegramReply(t, sendTelegramUpdate(mux, testTelegramSecret, -100500, 222, "/mute database 30m"))
+	if !strings.HasPrefix(reply.Text, "Notifications for tag database silenced until") {
+		t.Errorf("unexpected mute reply: %q", reply.Text)
+	}
+
+	reply = decodeTelegramReply(t, sendTelegramUpdate(mux, testTelegramSecret, -100500, 222, "/mute database"))
+	if reply.Text != "Usage: /mute <tag> <duration>" {
+		t.Errorf("unexpected usage reply: %q", reply.Text)
+	}
+}
+
+func TestTelegramBot_Authorization(t *testing.T) {
+	mux, incidents := newTestTelegramBot(t)
+
+	rec := sendTelegramUpdate(mux, "wrong-secret", -100500, 111, "/ack inc-1")
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401 for invalid secret", rec.Code)
+	}
+
+	reply := decodeTelegramReply(t, sendTelegramUpdate(mux, testTelegramSecret, -100500, 333, "/ack inc-1"))
+	if reply.Text != "You are not authorized to run commands in this chat" {
+		t.Errorf("unexpected reply for non-member: %q", reply.Text)
+	}
+
+	reply = decodeTelegramReply(t, sendTelegramUpdate(mux, testTelegramSecret, -999, 111, "/ack inc-1"))
+	if !strings.Contains(reply.Text, "not linked") {
+		t.Errorf("unexpected reply for unbound chat: %q", reply.Text)
+	}
+
+	if incidents.incidents["inc-1"].Status != incidentv1.IncidentStatus_INCIDENT_STATUS_OPEN {
+		t.Error("incident was modified by unauthorized command")
+	}
+}
+
+func TestTelegramBot_IgnoresOtherMessages(t *testing.T) {
+	mux, _ := newTestTelegramBot(t)
+
+	for _, text := range []string{"hello", "/unknown"} {
+		rec := sendTelegramUpdate(mux, testTelegramSecret, -100500, 111, text)
+		if rec.Code != http.StatusOK || rec.Body.Len() != 0 {
+			t.Errorf("message %q: status = %d, body = %q", text, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestTelegramBindings_Bind(t *testing.T) {
+	bindings := NewTelegramBindings()
+	channel := &service.Channel{
+		ID:       "channel-1",
+		TenantID: "tenant-1",
+		Type:     service.ChannelTypeTelegram,
+		Config:   map[string]string{TelegramConfigChatID: "42"},
+	}
+	if err := bindings.Bind(channel); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	other := &service.Channel{ID: "channel-2", TenantID: "tenant-2", Type: service.ChannelTypeTelegram, Config: map[string]string{TelegramConfigChatID: "42"}}
+	if err := bindings.Bind(other); err == nil {
+		t.Error("chat bound to two tenants")
+	}
+
+	invalid := []*service.Channel{
+		{ID: "slack", TenantID: "tenant-1", Type: service.ChannelTypeSlack, Config: map[string]string{TelegramConfigChatID: "1"}},
+		{ID: "no-chat", TenantID: "tenant-1", Type: service.ChannelTypeTelegram, Config: map[string]string{}},
+		{ID: "bad-member", TenantID: "tenant-1", Type: service.ChannelTypeTelegram, Config: map[string]string{TelegramConfigChatID: "1", TelegramConfigMembers: "alice"}},
+	}
+	for _, channel := range invalid {
+		if err := bindings.Bind(channel); err == nil {
+			t.Errorf("Bind(%s) accepted invalid channel", channel.ID)
+		}
+	}
+
+	bindings.Unbind(42)
+	if _, ok := bindings.Lookup(42); ok {
+		t.Error("binding still present after Unbind")
+	}
+}
@@ -2,13 +2,15 @@ package rabbitmq
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/locale"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/rabbitmq"
 	"UptimePingPlatform/services/notification-service/internal/domain"
@@ -28,8 +30,12 @@ const (
 	RoutingKeyIncidentCreated = "incident.created"
 	RoutingKeyIncidentUpdated = "incident.updated"
 	RoutingKeyIncidentResolved = "incident.resolved"
+	RoutingKeyIncidentSLABreached = "incident.sla_breached"
+	RoutingKeyIncidentReminder = "incident.reminder"
 	RoutingKeyCheckFailed     = "check.failed"
 	RoutingKeyCheckRecovered  = "check.recovered"
+	// RoutingKeySecurity уведомления безопасности тенанта; тип события совпадает с routing key
+	RoutingKeySecurity = domain.NotificationTypeSecurityPrefix + "*"
 )
 
 // Consumer обрабатывает события из RabbitMQ
@@ -39,6 +45,8 @@ type Consumer struct {
 	filter       filter.EventFilterInterface
 	grouper      grouper.NotificationGrouperInterface
 	processor    processor.NotificationProcessorInterface
+	stormGuard   filter.StormLimiter
+	locales      *locale.Resolver
 	prefetchCount int
 }
 
@@ -101,6 +109,11 @@ func (c *Consumer) Start(ctx context.Context) error {
 		logger.Int("prefetch_count", c.prefetchCount),
 	)
 
+	if c.stormGuard != nil {
+		go c.runStormDigests(ctx)
+	}
+	go c.RunCorrelation(ctx)
+
 	// Настройка канала
 	ch := c.conn.Channel()
 	defer ch.Close()
@@ -181,8 +194,11 @@ func (c *Consumer) Start(ctx context.Context) error {
 		RoutingKeyIncidentCreated,
 		RoutingKeyIncidentUpdated,
 		RoutingKeyIncidentResolved,
+		RoutingKeyIncidentSLABreached,
+		RoutingKeyIncidentReminder,
 		RoutingKeyCheckFailed,
 		RoutingKeyCheckRecovered,
+		RoutingKeySecurity,
 	}
 
 	for _, routingKey := range routingKeys {
@@ -238,7 +254,10 @@ func (c *Consumer) Start(ctx context.Context) error {
 				return fmt.Errorf("consumer channel closed")
 			}
 
+			rabbitmq.ObserveDelivery(NotificationsQueue, msg)
+			start := time.Now()
 			err := c.processMessage(ctx, msg)
+			rabbitmq.ObserveProcessing(NotificationsQueue, time.Since(start), err)
 			if err != nil {
 				c.logger.Error("Failed to process message",
 					logger.Error(err),
@@ -255,7 +274,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 				}
 
 				// Отклонение сообщения
-				if err := msg.Nack(false, false); err != nil {
+				if err := rabbitmq.NackDelivery(NotificationsQueue, msg, false); err != nil {
 					c.logger.Error("Failed to nack message",
 						logger.Error(err),
 						logger.String("message_id", msg.MessageId),
@@ -265,7 +284,7 @@ func (c *Consumer) Start(ctx context.Context) error {
 			}
 
 			// Подтверждение успешной обработки
-			if err := msg.Ack(false); err != nil {
+			if err := rabbitmq.AckDelivery(NotificationsQueue, msg); err != nil {
 				c.logger.Error("Failed to ack message",
 					logger.Error(err),
 					logger.String("message_id", msg.MessageId),
@@ -306,6 +325,22 @@ func (c *Consumer) processMessage(ctx context.Context, msg amqp.Delivery) error
 	if err != nil {
 		return fmt.Errorf("failed to group notifications: %w", err)
 	}
+	if len(groups) == 0 {
+		c.logger.Debug("Notifications held for correlated incidents summary",
+			logger.String("event_id", event.ID),
+			logger.String("event_type", event.Type),
+		)
+		return nil
+	}
+
+	// Защита от шторма: при превышении лимита тенант переводится в режим дайджеста
+	deliver, err := c.admitStorm(ctx, event, groups)
+	if err != nil {
+		return err
+	}
+	if !deliver {
+		return nil
+	}
 
 	// Обработка каждой группы
 	for groupID, notifications := range groups {
@@ -330,27 +365,37 @@ func (c *Consumer) processMessage(ctx context.Context, msg amqp.Delivery) error
 	return nil
 }
 
+// Handle обрабатывает событие, полученное не из RabbitMQ, например из встроенной очереди
+// однопроцессного запуска. Ошибка возвращается вызывающему коду для повторной доставки
+func (c *Consumer) Handle(ctx context.Context, routingKey, contentType string, headers map[string]interface{}, body []byte) error {
+	return c.processMessage(ctx, amqp.Delivery{
+		RoutingKey:  routingKey,
+		ContentType: contentType,
+		Headers:     amqp.Table(headers),
+		Body:        body,
+	})
+}
+
 // parseEvent парсит событие из сообщения
 func (c *Consumer) parseEvent(msg amqp.Delivery) (*domain.Event, error) {
-	var event domain.Event
-
 	// Определение типа события по routing key
 	eventType := c.getEventTypeFromRoutingKey(msg.RoutingKey)
 	if eventType == "" {
 		return nil, fmt.Errorf("unknown routing key: %s", msg.RoutingKey)
 	}
 
-	event.Type = eventType
-
-	// Парсинг тела сообщения
-	switch msg.ContentType {
-	case "application/json":
-		if err := json.Unmarshal(msg.Body, &event); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
-		}
-	default:
+	// Парсинг тела сообщения по версионированной схеме события
+	if msg.ContentType != events.ContentType {
 		return nil, fmt.Errorf("unsupported content type: %s", msg.ContentType)
 	}
+	body, err := rabbitmq.DecompressBody(msg.Body, msg.ContentEncoding)
+	if err != nil {
+		return nil, err
+	}
+	event, err := decodeEvent(eventType, msg.Headers, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode event: %w", err)
+	}
 
 	// Установка метаданных
 	event.Metadata = make(map[string]interface{})
@@ -363,14 +408,16 @@ func (c *Consumer) parseEvent(msg amqp.Delivery) (*domain.Event, error) {
 	}
 
 	// Установка временных меток
-	event.Timestamp = time.Now()
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
 	if timestamp, ok := msg.Headers["timestamp"].(string); ok {
 		if parsedTime, err := time.Parse(time.RFC3339, timestamp); err == nil {
 			event.Timestamp = parsedTime
 		}
 	}
 
-	return &event, nil
+	return event, nil
 }
 
 // getEventTypeFromRoutingKey определяет тип события по routing key
@@ -382,11 +429,19 @@ func (c *Consumer) getEventTypeFromRoutingKey(routingKey string) string {
 		return "incident.updated"
 	case RoutingKeyIncidentResolved:
 		return "incident.resolved"
+	case RoutingKeyIncidentSLABreached:
+		return "incident.sla_breached"
+	case RoutingKeyIncidentReminder:
+		return "incident.reminder"
 	case RoutingKeyCheckFailed:
 		return "check.failed"
 	case RoutingKeyCheckRecovered:
 		return "check.recovered"
 	default:
+		if strings.HasPrefix(routingKey, domain.NotificationTypeSecurityPrefix) &&
+			len(routingKey) > len(domain.NotificationTypeSecurityPrefix) {
+			return routingKey
+		}
 		return ""
 	}
 }
@@ -404,20 +459,23 @@ func (c *Consumer) sendToDLQ(ch *amqp.Channel, msg amqp.Delivery, processErr err
 	headers["original-message-id"] = msg.MessageId
 
 	// Публикация в DLQ
+	start := time.Now()
 	err := ch.Publish(
 		NotificationsDLX, // exchange
 		NotificationsDLQ, // routing key
 		false,            // mandatory
 		false,            // immediate
 		amqp.Publishing{
-			ContentType:   msg.ContentType,
-			Body:          msg.Body,
-			Headers:       headers,
-			Timestamp:     time.Now(),
-			MessageId:     msg.MessageId + "-dlq",
-			CorrelationId: msg.CorrelationId,
+			ContentType:     msg.ContentType,
+			ContentEncoding: msg.ContentEncoding,
+			Body:            msg.Body,
+			Headers:         headers,
+			Timestamp:       time.Now(),
+			MessageId:       msg.MessageId + "-dlq",
+			CorrelationId:   msg.CorrelationId,
 		},
 	)
+	rabbitmq.ObservePublish(NotificationsDLX, time.Since(start), err)
 
 	if err != nil {
 		return fmt.Errorf("failed to publish to DLQ: %w", err)
@@ -0,0 +1,64 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"strings"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// decodeEvent читает тело сообщения по версионированной схеме: события incident.* по схеме
+// инцидентов, остальные по схеме запроса уведомления. Неизвестные поля игнорируются
+func decodeEvent(eventType string, headers amqp.Table, body []byte) (*domain.Event, error) {
+	if strings.HasPrefix(eventType, "incident.") {
+		var incident events.Incident
+		if err := events.Decode(headers, body, &incident); err != nil {
+			return nil, err
+		}
+		return incidentEvent(eventType, &incident), nil
+	}
+
+	var notification events.Notification
+	if err := events.Decode(headers, body, &notification); err != nil {
+		return nil, err
+	}
+	return &domain.Event{
+		ID:        notification.ID,
+		Type:      eventType,
+		Severity:  notification.Severity,
+		TenantID:  notification.TenantID,
+		Source:    notification.Source,
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Data:      notification.Data,
+		Timestamp: notification.Timestamp,
+	}, nil
+}
+
+// incidentEvent переводит событие инцидента в событие уведомления; идентификаторы инцидента
+// и проверки попадают в data для тишин и кнопок в сообщениях
+func incidentEvent(eventType string, incident *events.Incident) *domain.Event {
+	data := make(map[string]interface{}, len(incident.Data)+2)
+	for key, value := range incident.Data {
+		data[key] = value
+	}
+	data["incident_id"] = incident.IncidentID
+	if incident.CheckID != "" {
+		data["check_id"] = incident.CheckID
+	}
+
+	return &domain.Event{
+		ID:        incident.IncidentID,
+		Type:      eventType,
+		Severity:  incident.Severity,
+		TenantID:  incident.TenantID,
+		Source:    incident.Service,
+		Title:     fmt.Sprintf("Incident %s: %s", incident.Status, incident.IncidentID),
+		Message:   incident.ErrorMessage,
+		Data:      data,
+		Timestamp: incident.Timestamp,
+	}
+}
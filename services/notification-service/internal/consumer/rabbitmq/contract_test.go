@@ -0,0 +1,264 @@
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/notification-service/config"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+	filter "UptimePingPlatform/services/notification-service/internal/filter"
+	grouper "UptimePingPlatform/services/notification-service/internal/grouper"
+)
+
+func TestParseEvent_IncidentContract(t *testing.T) {
+	body, headers, err := events.Encode(events.TypeIncidentOpened, &events.Incident{
+		Timestamp:    time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+		Service:      "incident-manager",
+		IncidentID:   "incident-1",
+		CheckID:      "check-1",
+		TenantID:     "tenant-1",
+		Status:       "open",
+		Severity:     "critical",
+		ErrorMessage: "connection refused",
+		Data:         map[string]interface{}{"owner": "team-a"},
+	})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	consumer := &Consumer{logger: &stormTestLogger{}}
+	event, err := consumer.parseEvent(amqp.Delivery{
+		RoutingKey:  RoutingKeyIncidentCreated,
+		ContentType: events.ContentType,
+		Headers:     amqp.Table(headers),
+		Body:        body,
+	})
+	if err != nil {
+		t.Fatalf("parseEvent() error = %v", err)
+	}
+	if event.Type != "incident.created" || event.TenantID != "tenant-1" || event.Severity != "critical" {
+		t.Errorf("event = %+v", event)
+	}
+	if event.Message != "connection refused" || !event.Timestamp.Equal(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("event message/timestamp = %q, %v", event.Message, event.Timestamp)
+	}
+	if event.Data["incident_id"] != "incident-1" || event.Data["check_id"] != "check-1" || event.Data["owner"] != "team-a" {
+		t.Errorf("event data = %v", event.Data)
+	}
+}
+
+func TestParseEvent_SecurityNotification(t *testing.T) {
+	eventType := events.TypeSecurityPrefix + "api_key_created"
+	body, headers, err := events.Encode(eventType, &events.Notification{
+		ID:       "security-1",
+		TenantID: "tenant-1",
+		Severity: "high",
+		Source:   "auth-service",
+		Title:    "API key created",
+		Data:     map[string]interface{}{domain.DataKeyNotifyChannels: "email:admin@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	consumer := &Consumer{logger: &stormTestLogger{}}
+	event, err := consumer.parseEvent(amqp.Delivery{
+		RoutingKey:  eventType,
+		ContentType: events.ContentType,
+		Headers:     amqp.Table(headers),
+		Body:        body,
+	})
+	if err != nil {
+		t.Fatalf("parseEvent() error = %v", err)
+	}
+	if event.Type != eventType || event.TenantID != "tenant-1" {
+		t.Errorf("event = %+v", event)
+	}
+	targets := event.NotificationOverrides().Targets
+	if len(targets) != 1 || targets[0].Recipient != "admin@example.com" {
+		t.Errorf("targets = %+v", targets)
+	}
+	if !filter.NewEventFilter(filter.ProductionFilterConfig(), &stormTestLogger{}).ShouldProcess(event) {
+		t.Error("production filter drops security notification")
+	}
+
+	if _, err := consumer.parseEvent(amqp.Delivery{
+		RoutingKey:  domain.NotificationTypeSecurityPrefix,
+		ContentType: events.ContentType,
+		Body:        body,
+	}); err == nil {
+		t.Error("parseEvent() accepted security routing key without event type")
+	}
+}
+
+func TestParseEvent_TolerantReader(t *testing.T) {
+	consumer := &Consumer{logger: &stormTestLogger{}}
+
+	// Сообщение без версии схемы с полем, которое потребитель еще не знает
+	event, err := consumer.parseEvent(amqp.Delivery{
+		RoutingKey:  RoutingKeyCheckFailed,
+		ContentType: events.ContentType,
+		Body:        []byte(`{"id":"e1","tenant_id":"tenant-1","severity":"high","title":"API down","future_field":[1,2]}`),
+	})
+	if err != nil {
+		t.Fatalf("parseEvent() error = %v", err)
+	}
+	if event.Type != "check.failed" || event.Title != "API down" || event.Timestamp.IsZero() {
+		t.Errorf("event = %+v", event)
+	}
+
+	// Несовместимая версия отклоняется и уходит в DLQ до обновления потребителя
+	_, err = consumer.parseEvent(amqp.Delivery{
+		RoutingKey:  RoutingKeyCheckFailed,
+		ContentType: events.ContentType,
+		Headers:     amqp.Table{events.HeaderSchemaVersion: int32(2)},
+		Body:        []byte(`{"tenant_id":"tenant-1"}`),
+	})
+	if !errors.Is(err, events.ErrUnsupportedVersion) {
+		t.Errorf("parseEvent() error = %v, want ErrUnsupportedVersion", err)
+	}
+}
+
+func TestParseEvent_Compressed(t *testing.T) {
+	body, headers, err := events.Encode(events.TypeIncidentOpened, &events.Incident{
+		IncidentID:   "incident-1",
+		CheckID:      "check-1",
+		TenantID:     "tenant-1",
+		Status:       "open",
+		Severity:     "critical",
+		ErrorMessage: strings.Repeat("upstream connect error; ", 100),
+	})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	compressed, encoding, err := rabbitmq.CompressBody(body, rabbitmq.DefaultCompressionThreshold)
+	if err != nil || encoding != rabbitmq.ContentEncodingGzip {
+		t.Fatalf("CompressBody() encoding = %q, error = %v", encoding, err)
+	}
+
+	consumer := &Consumer{logger: &stormTestLogger{}}
+	event, err := consumer.parseEvent(amqp.Delivery{
+		RoutingKey:      RoutingKeyIncidentCreated,
+		ContentType:     events.ContentType,
+		ContentEncoding: encoding,
+		Headers:         amqp.Table(headers),
+		Body:            compressed,
+	})
+	if err != nil {
+		t.Fatalf("parseEvent() error = %v", err)
+	}
+	if event.TenantID != "tenant-1" || event.Data["incident_id"] != "incident-1" {
+		t.Errorf("event = %+v", event)
+	}
+
+	// Неизвестная кодировка отклоняется
+	_, err = consumer.parseEvent(amqp.Delivery{
+		RoutingKey:      RoutingKeyIncidentCreated,
+		ContentType:     events.ContentType,
+		ContentEncoding: "br",
+		Body:            compressed,
+	})
+	if err == nil {
+		t.Error("parseEvent() expected error for unsupported content encoding")
+	}
+}
+
+func TestConsumer_Handle(t *testing.T) {
+	body, headers, err := events.Encode(events.TypeIncidentOpened, &events.Incident{
+		Timestamp:  time.Now(),
+		IncidentID: "incident-1",
+		CheckID:    "check-1",
+		TenantID:   "tenant-1",
+		Status:     "open",
+		Severity:   "critical",
+		Data:       map[string]interface{}{domain.DataKeyNotifyChannels: "slack:#ops"},
+	})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	processor := &recordingProcessor{groups: make(map[string][][]*domain.Notification)}
+	log := &stormTestLogger{}
+	consumer := NewNotificationConsumer(
+		nil,
+		filter.NewEventFilter(filter.DefaultFilterConfig(), log),
+		grouper.NewNotificationGrouper(grouper.DefaultGrouperConfig(), config.DefaultProvidersConfig(), log),
+		processor,
+		log,
+	)
+
+	if err := consumer.Handle(context.Background(), RoutingKeyIncidentCreated, events.ContentType, headers, body); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	groups := processor.groups["check:incident-1"]
+	if len(groups) != 1 || len(groups[0]) != 1 || groups[0][0].Channel != "slack" || groups[0][0].Recipient != "#ops" {
+		t.Fatalf("groups = %v, want one slack notification", processor.groups)
+	}
+
+	if err := consumer.Handle(context.Background(), "incident.unknown", events.ContentType, headers, body); err == nil {
+		t.Error("Handle() with unknown routing key must fail")
+	}
+}
+
+func TestConsumer_Handle_Silenced(t *testing.T) {
+	encode := func(incidentID string) ([]byte, map[string]interface{}) {
+		body, headers, err := events.Encode(events.TypeIncidentOpened, &events.Incident{
+			Timestamp:  time.Now(),
+			IncidentID: incidentID,
+			CheckID:    "check-1",
+			TenantID:   "tenant-1",
+			Status:     "open",
+			Severity:   "critical",
+			Data:       map[string]interface{}{domain.DataKeyNotifyChannels: "slack:#ops"},
+		})
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		return body, headers
+	}
+
+	silences := filter.NewMemorySilenceStore()
+	now := time.Now()
+	if err := silences.Add(context.Background(), &filter.Silence{
+		TenantID:   "tenant-1",
+		IncidentID: "incident-1",
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(time.Hour),
+	}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	processor := &recordingProcessor{groups: make(map[string][][]*domain.Notification)}
+	log := &stormTestLogger{}
+	consumer := NewNotificationConsumer(
+		nil,
+		filter.NewSilenceFilter(filter.NewEventFilter(filter.DefaultFilterConfig(), log), silences, log),
+		grouper.NewNotificationGrouper(grouper.DefaultGrouperConfig(), config.DefaultProvidersConfig(), log),
+		processor,
+		log,
+	)
+
+	// Событие под тишиной подтверждается без отправки уведомлений
+	body, headers := encode("incident-1")
+	if err := consumer.Handle(context.Background(), RoutingKeyIncidentCreated, events.ContentType, headers, body); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if len(processor.groups) != 0 {
+		t.Fatalf("groups = %v, want silenced event to be dropped", processor.groups)
+	}
+
+	body, headers = encode("incident-2")
+	if err := consumer.Handle(context.Background(), RoutingKeyIncidentCreated, events.ContentType, headers, body); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if len(processor.groups["check:incident-2"]) != 1 {
+		t.Fatalf("groups = %v, want notification for incident outside of the silence", processor.groups)
+	}
+}
@@ -0,0 +1,55 @@
+package rabbitmq
+
+import (
+	"context"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// correlationFlushInterval период отправки сводных сообщений по инцидентам с общим ключом корреляции
+const correlationFlushInterval = 30 * time.Second
+
+// CorrelationFlusher группировщик, придерживающий уведомления связанных инцидентов до сводного
+// сообщения
+type CorrelationFlusher interface {
+	FlushCorrelated() map[string][]*domain.Notification
+}
+
+// RunCorrelation периодически отправляет сводные сообщения по инцидентам с общим ключом
+// корреляции до отмены контекста. Ничего не делает, если группировщик не придерживает уведомления
+func (c *Consumer) RunCorrelation(ctx context.Context) {
+	flusher, ok := c.grouper.(CorrelationFlusher)
+	if !ok {
+		return
+	}
+
+	ticker := time.NewTicker(correlationFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.flushCorrelated(ctx, flusher)
+		}
+	}
+}
+
+// flushCorrelated отправляет сводные сообщения пачек с истекшим окном группировки
+func (c *Consumer) flushCorrelated(ctx context.Context, flusher CorrelationFlusher) {
+	for groupID, notifications := range flusher.FlushCorrelated() {
+		c.logger.Info("Sending correlated incidents summary",
+			logger.String("group_id", groupID),
+			logger.Int("notification_count", len(notifications)),
+		)
+		if err := c.processor.ProcessGroup(ctx, groupID, notifications); err != nil {
+			c.logger.Error("Failed to send correlated incidents summary",
+				logger.Error(err),
+				logger.String("group_id", groupID),
+			)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package rabbitmq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/events"
+	"UptimePingPlatform/services/notification-service/config"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+	filter "UptimePingPlatform/services/notification-service/internal/filter"
+	grouper "UptimePingPlatform/services/notification-service/internal/grouper"
+)
+
+func TestConsumer_CorrelatedIncidentsSummary(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	processor := &recordingProcessor{groups: make(map[string][][]*domain.Notification)}
+	log := &stormTestLogger{}
+	notificationGrouper := grouper.NewNotificationGrouper(grouper.DefaultGrouperConfig(), config.DefaultProvidersConfig(), log).WithClock(clk.Now)
+	consumer := NewNotificationConsumer(nil, filter.NewEventFilter(filter.DefaultFilterConfig(), log), notificationGrouper, processor, log)
+
+	for _, checkID := range []string{"check-1", "check-2", "check-3"} {
+		body, headers, err := events.Encode(events.TypeIncidentOpened, &events.Incident{
+			Timestamp:  time.Now(),
+			IncidentID: "incident-" + checkID,
+			CheckID:    checkID,
+			TenantID:   "tenant-1",
+			Status:     "open",
+			Severity:   "critical",
+			Data:       map[string]interface{}{domain.DataKeyHost: "lb.example.com", domain.DataKeyNotifyChannels: "slack:#ops"},
+		})
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		if err := consumer.Handle(context.Background(), RoutingKeyIncidentCreated, events.ContentType, headers, body); err != nil {
+			t.Fatalf("Handle() error = %v", err)
+		}
+	}
+	if len(processor.groups) != 1 || len(processor.groups["check:incident-check-1"]) != 1 {
+		t.Fatalf("groups = %v, want only the first incident delivered", processor.groups)
+	}
+
+	clk.Advance(5 * time.Minute)
+	consumer.flushCorrelated(context.Background(), notificationGrouper)
+	summaries := processor.groups["correlation:tenant-1|incident.created|host:lb.example.com"]
+	if len(summaries) != 1 || len(summaries[0]) != 1 {
+		t.Fatalf("groups = %v, want one summary for the slack channel", processor.groups)
+	}
+	if summary := summaries[0][0]; summary.Recipient != "#ops" || summary.Subject != "3 checks affected: host:lb.example.com" {
+		t.Errorf("summary = %+v", summary)
+	}
+}
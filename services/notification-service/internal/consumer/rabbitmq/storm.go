@@ -0,0 +1,168 @@
+package rabbitmq
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/locale"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+	filter "UptimePingPlatform/services/notification-service/internal/filter"
+)
+
+// Типы служебных уведомлений защиты от шторма
+const (
+	NotificationTypeStormDetected = "notification.storm_detected"
+	NotificationTypeStormDigest   = "notification.storm_digest"
+)
+
+// stormSweepInterval период проверки завершения штормов
+const stormSweepInterval = 30 * time.Second
+
+// WithStormGuard включает защиту от шторма уведомлений тенанта
+func (c *Consumer) WithStormGuard(guard filter.StormLimiter) *Consumer {
+	c.stormGuard = guard
+	return c
+}
+
+// WithLocales включает вывод окна сводки шторма в часовом поясе и языке тенанта
+func (c *Consumer) WithLocales(resolver *locale.Resolver) *Consumer {
+	c.locales = resolver
+	return c
+}
+
+// admitStorm пропускает группы через защиту от шторма. Возвращает false, если уведомления
+// события не должны отправляться: при начале шторма вместо них уходит одно сообщение о шторме
+func (c *Consumer) admitStorm(ctx context.Context, event *domain.Event, groups map[string][]*domain.Notification) (bool, error) {
+	if c.stormGuard == nil {
+		return true, nil
+	}
+
+	var notifications []*domain.Notification
+	for _, group := range groups {
+		notifications = append(notifications, group...)
+	}
+
+	verdict, err := c.stormGuard.Admit(ctx, event.TenantID, notifications)
+	if err != nil {
+		// Без счетчиков шторм не определить: уведомления не теряются и отправляются как обычно
+		c.logger.Warn("Storm guard is unavailable, delivering notifications",
+			logger.Error(err),
+			logger.String("tenant_id", event.TenantID),
+		)
+		return true, nil
+	}
+
+	switch verdict {
+	case filter.StormDetected:
+		config := c.stormGuard.Config()
+		c.logger.Warn("Alert storm detected, switching tenant to digest mode",
+			logger.String("tenant_id", event.TenantID),
+			logger.Int("limit", config.Limit),
+			logger.Duration("window", config.Window),
+		)
+		notice := stormNotifications(notifications, NotificationTypeStormDetected,
+			"Alert storm detected",
+			fmt.Sprintf("More than %d notifications in %s. Further notifications are collected into a digest until the storm subsides.",
+				config.Limit, config.Window),
+		)
+		if err := c.processor.ProcessGroup(ctx, "storm:"+event.TenantID, notice); err != nil {
+			return false, fmt.Errorf("failed to send storm notification: %w", err)
+		}
+		return false, nil
+	case filter.StormSuppressed:
+		c.logger.Debug("Notifications suppressed by storm guard",
+			logger.String("tenant_id", event.TenantID),
+			logger.String("event_id", event.ID),
+			logger.Int("notification_count", len(notifications)),
+		)
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// runStormDigests периодически завершает штормы и отправляет сводку подавленных уведомлений
+func (c *Consumer) runStormDigests(ctx context.Context) {
+	ticker := time.NewTicker(stormSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.flushStormDigests(ctx)
+		}
+	}
+}
+
+// flushStormDigests отправляет сводки завершенных штормов
+func (c *Consumer) flushStormDigests(ctx context.Context) {
+	digests, err := c.stormGuard.Sweep(ctx)
+	if err != nil {
+		c.logger.Error("Failed to sweep alert storms", logger.Error(err))
+	}
+	for _, digest := range digests {
+		c.logger.Info("Alert storm subsided, tenant returned to normal mode",
+			logger.String("tenant_id", digest.TenantID),
+			logger.Int("suppressed_count", digest.SuppressedCount),
+		)
+		summary := stormNotifications(digest.Recipients, NotificationTypeStormDigest,
+			"Alert storm digest", stormDigestBody(digest, c.locales.Resolve(ctx, digest.TenantID)))
+		if err := c.processor.ProcessGroup(ctx, "storm:"+digest.TenantID, summary); err != nil {
+			c.logger.Error("Failed to send storm digest",
+				logger.Error(err),
+				logger.String("tenant_id", digest.TenantID),
+			)
+		}
+	}
+}
+
+// stormNotifications создает по одному служебному уведомлению на каждый канал и адресат образцов
+func stormNotifications(samples []*domain.Notification, notificationType, subject, body string) []*domain.Notification {
+	seen := make(map[string]bool, len(samples))
+	notifications := make([]*domain.Notification, 0, len(samples))
+	for _, sample := range samples {
+		key := sample.Channel + "|" + sample.Recipient
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		notifications = append(notifications, &domain.Notification{
+			ID:        uuid.New().String(),
+			Type:      notificationType,
+			Channel:   sample.Channel,
+			Recipient: sample.Recipient,
+			Subject:   subject,
+			Body:      body,
+			TenantID:  sample.TenantID,
+			Severity:  domain.SeverityHigh,
+			Status:    domain.NotificationStatusPending,
+			CreatedAt: time.Now(),
+		})
+	}
+	return notifications
+}
+
+// stormDigestBody текст сводки: окно шторма в часовом поясе тенанта и число подавленных уведомлений по типам
+func stormDigestBody(digest *filter.StormDigest, settings locale.Settings) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Alert storm from %s to %s: %d notifications were collected into this digest.",
+		settings.FormatTime(digest.StartedAt), settings.FormatTime(digest.EndedAt), digest.SuppressedCount)
+
+	types := make([]string, 0, len(digest.SuppressedByType))
+	for eventType := range digest.SuppressedByType {
+		types = append(types, eventType)
+	}
+	sort.Strings(types)
+	for _, eventType := range types {
+		fmt.Fprintf(&b, "\n- %s: %d", eventType, digest.SuppressedByType[eventType])
+	}
+	return b.String()
+}
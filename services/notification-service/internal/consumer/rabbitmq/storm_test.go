@@ -0,0 +1,105 @@
+package rabbitmq
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/locale"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+	filter "UptimePingPlatform/services/notification-service/internal/filter"
+)
+
+type stormTestLogger struct{}
+
+func (l *stormTestLogger) Debug(msg string, fields ...logger.Field)  {}
+func (l *stormTestLogger) Info(msg string, fields ...logger.Field)   {}
+func (l *stormTestLogger) Warn(msg string, fields ...logger.Field)   {}
+func (l *stormTestLogger) Error(msg string, fields ...logger.Field)  {}
+func (l *stormTestLogger) With(fields ...logger.Field) logger.Logger { return l }
+func (l *stormTestLogger) Sync() error                               { return nil }
+
+// recordingProcessor запоминает отправленные группы
+type recordingProcessor struct {
+	groups map[string][][]*domain.Notification
+}
+
+func (p *recordingProcessor) ProcessGroup(ctx context.Context, groupID string, notifications []*domain.Notification) error {
+	p.groups[groupID] = append(p.groups[groupID], notifications)
+	return nil
+}
+
+func (p *recordingProcessor) GetProcessorStats() map[string]interface{} {
+	return nil
+}
+
+func TestConsumer_StormGuard(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	guard := filter.NewStormGuard(filter.StormConfig{Limit: 2, Window: time.Minute}).
+		WithClock(func() time.Time { return now })
+	processor := &recordingProcessor{groups: make(map[string][][]*domain.Notification)}
+	consumer := (&Consumer{logger: &stormTestLogger{}, processor: processor}).WithStormGuard(guard)
+
+	event := &domain.Event{ID: "e1", TenantID: "t1"}
+	groups := func() map[string][]*domain.Notification {
+		return map[string][]*domain.Notification{"default": {
+			{TenantID: "t1", Type: domain.NotificationTypeCheckFailed, Channel: "email", Recipient: "ops@example.com"},
+			{TenantID: "t1", Type: domain.NotificationTypeCheckFailed, Channel: "slack", Recipient: "#ops"},
+		}}
+	}
+
+	deliver, err := consumer.admitStorm(context.Background(), event, groups())
+	if err != nil || !deliver {
+		t.Fatalf("admitStorm() = %v, %v; want delivery under limit", deliver, err)
+	}
+
+	deliver, err = consumer.admitStorm(context.Background(), event, groups())
+	if err != nil || deliver {
+		t.Fatalf("admitStorm() = %v, %v; want suppression over limit", deliver, err)
+	}
+	notices := processor.groups["storm:t1"]
+	if len(notices) != 1 || len(notices[0]) != 2 {
+		t.Fatalf("storm notices = %v, want one group with one notice per recipient", notices)
+	}
+	if notices[0][0].Type != NotificationTypeStormDetected || notices[0][0].Subject != "Alert storm detected" {
+		t.Errorf("notice = %+v", notices[0][0])
+	}
+
+	deliver, _ = consumer.admitStorm(context.Background(), event, groups())
+	if deliver || len(processor.groups["storm:t1"]) != 1 {
+		t.Fatal("notifications in digest mode must be suppressed without new notices")
+	}
+
+	now = now.Add(2 * time.Minute)
+	consumer.flushStormDigests(context.Background())
+	digests := processor.groups["storm:t1"]
+	if len(digests) != 2 || len(digests[1]) != 2 {
+		t.Fatalf("storm groups = %d, want detection notice and digest", len(digests))
+	}
+	body := digests[1][0].Body
+	if digests[1][0].Type != NotificationTypeStormDigest || !strings.Contains(body, "4 notifications") || !strings.Contains(body, "check.failed: 4") {
+		t.Errorf("digest = %q", body)
+	}
+}
+
+func TestStormDigestBody_TenantTimezone(t *testing.T) {
+	digest := &filter.StormDigest{
+		TenantID:         "t1",
+		StartedAt:        time.Date(2024, 3, 1, 21, 30, 0, 0, time.UTC),
+		EndedAt:          time.Date(2024, 3, 1, 22, 0, 0, 0, time.UTC),
+		SuppressedCount:  2,
+		SuppressedByType: map[string]int{"check.failed": 2},
+	}
+
+	body := stormDigestBody(digest, locale.Settings{Timezone: "Europe/Moscow", Locale: "ru"})
+	if !strings.Contains(body, "from 02.03.2024 00:30:00 MSK to 02.03.2024 01:00:00 MSK") {
+		t.Errorf("digest = %q, want window in tenant timezone", body)
+	}
+
+	body = stormDigestBody(digest, locale.Default())
+	if !strings.Contains(body, "from 2024-03-01 21:30:00 UTC to 2024-03-01 22:00:00 UTC") {
+		t.Errorf("digest = %q, want window in UTC by default", body)
+	}
+}
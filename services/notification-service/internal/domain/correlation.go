@@ -0,0 +1,47 @@
+package domain
+
+import "strings"
+
+// Ключи data события, по которым связываются инциденты одного сбоя
+const (
+	// DataKeyCorrelationKey явный ключ корреляции: общий для зонтичного инцидента и его проверок
+	DataKeyCorrelationKey = "correlation_key"
+	// DataKeyHost хост цели проверки
+	DataKeyHost = "host"
+	// DataKeyTags теги проверки через запятую
+	DataKeyTags = "tags"
+	// DataKeyCheckID идентификатор проверки инцидента
+	DataKeyCheckID = "check_id"
+)
+
+// CorrelationKey возвращает ключ, по которому уведомления одного сбоя сводятся в одно
+// сообщение: явный ключ зонтичного инцидента, иначе хост цели, иначе первый тег проверки.
+// Пустая строка - событие ни с чем не связано
+func (e *Event) CorrelationKey() string {
+	if key := e.dataString(DataKeyCorrelationKey); key != "" {
+		return key
+	}
+	if host := e.dataString(DataKeyHost); host != "" {
+		return "host:" + strings.ToLower(host)
+	}
+	for _, tag := range strings.Split(e.dataString(DataKeyTags), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			return "tag:" + tag
+		}
+	}
+	return ""
+}
+
+// CheckID возвращает идентификатор проверки события или идентификатор события, если проверки нет
+func (e *Event) CheckID() string {
+	if checkID := e.dataString(DataKeyCheckID); checkID != "" {
+		return checkID
+	}
+	return e.ID
+}
+
+// dataString возвращает строковое значение data события без пробелов по краям
+func (e *Event) dataString(key string) string {
+	value, _ := e.Data[key].(string)
+	return strings.TrimSpace(value)
+}
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"time"
+
+	"UptimePingPlatform/pkg/locale"
 )
 
 // Event представляет событие системы
@@ -37,6 +39,13 @@ type Notification struct {
 	Error       string                 `json:"error,omitempty"`
 	RetryCount  int                    `json:"retry_count"`
 	MaxRetries  int                    `json:"max_retries"`
+	// Locale часовой пояс и язык тенанта; пустые значения означают UTC и английский
+	Locale      locale.Settings        `json:"locale"`
+}
+
+// FormattedTime время создания уведомления в часовом поясе и формате тенанта
+func (n *Notification) FormattedTime() string {
+	return n.Locale.FormatTime(n.CreatedAt)
 }
 
 // NotificationGroup представляет группу уведомлений
@@ -75,9 +84,14 @@ const (
 	NotificationTypeIncidentCreated = "incident.created"
 	NotificationTypeIncidentUpdated = "incident.updated"
 	NotificationTypeIncidentResolved = "incident.resolved"
+	NotificationTypeIncidentSLABreached = "incident.sla_breached"
+	NotificationTypeIncidentReminder = "incident.reminder"
 	NotificationTypeCheckFailed     = "check.failed"
 	NotificationTypeCheckRecovered  = "check.recovered"
 	NotificationTypeSystemAlert     = "system.alert"
+	// NotificationTypeSecurityPrefix префикс уведомлений безопасности тенанта от auth-service:
+	// notification.security.user_joined, notification.security.role_changed и т.д.
+	NotificationTypeSecurityPrefix = "notification.security."
 )
 
 // Каналы уведомлений
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Ключи data события с переопределением уведомлений проверки (config.notifications проверки)
+const (
+	DataKeyNotifyChannels         = "notify_channels"
+	DataKeyNotifyMinSeverity      = "notify_min_severity"
+	DataKeyNotifyRenotifyInterval = "notify_renotify_interval"
+)
+
+// NotificationTarget канал и адресат уведомления из переопределения проверки
+type NotificationTarget struct {
+	Channel   string
+	Recipient string
+}
+
+// NotificationOverrides переопределение маршрутизации уведомлений проверки поверх настроек tenant
+type NotificationOverrides struct {
+	// Targets каналы вместо каналов tenant; пустой список - маршрутизация tenant
+	Targets []NotificationTarget
+	// MinSeverity уровень, ниже которого уведомления не отправляются
+	MinSeverity string
+	// RenotifyInterval минимальный интервал между повторными уведомлениями по инциденту
+	RenotifyInterval time.Duration
+}
+
+// NotificationOverrides возвращает переопределение уведомлений проверки из data события.
+// Некорректные значения пропускаются: scheduler-service проверяет их при сохранении проверки
+func (e *Event) NotificationOverrides() NotificationOverrides {
+	var overrides NotificationOverrides
+
+	if channels, ok := e.Data[DataKeyNotifyChannels].(string); ok {
+		for _, channel := range strings.Split(channels, ",") {
+			name, recipient, found := strings.Cut(strings.TrimSpace(channel), ":")
+			if found && name != "" && recipient != "" {
+				overrides.Targets = append(overrides.Targets, NotificationTarget{Channel: name, Recipient: recipient})
+			}
+		}
+	}
+	if severity, ok := e.Data[DataKeyNotifyMinSeverity].(string); ok && SeverityRank(severity) > 0 {
+		overrides.MinSeverity = severity
+	}
+	if interval, ok := e.Data[DataKeyNotifyRenotifyInterval].(string); ok {
+		if parsed, err := time.ParseDuration(interval); err == nil && parsed > 0 {
+			overrides.RenotifyInterval = parsed
+		}
+	}
+
+	return overrides
+}
+
+// AllowsSeverity проверяет, что уровень события не ниже минимального. События с неизвестным
+// уровнем не подавляются
+func (o NotificationOverrides) AllowsSeverity(severity string) bool {
+	rank := SeverityRank(severity)
+	return o.MinSeverity == "" || rank == 0 || rank >= SeverityRank(o.MinSeverity)
+}
+
+// SeverityRank порядок уровня серьезности уведомления или инцидента; 0 для неизвестного
+func SeverityRank(severity string) int {
+	switch strings.ToLower(severity) {
+	case SeverityLow, "info":
+		return 1
+	case SeverityMedium, "warning":
+		return 2
+	case SeverityHigh, "error":
+		return 3
+	case SeverityCritical:
+		return 4
+	default:
+		return 0
+	}
+}
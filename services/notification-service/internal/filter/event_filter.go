@@ -18,6 +18,7 @@ type EventFilterInterface interface {
 type EventFilter struct {
 	config FilterConfig
 	logger logger.Logger
+	now    func() time.Time
 }
 
 // FilterConfig конфигурация фильтра
@@ -46,9 +47,16 @@ func NewEventFilter(config FilterConfig, logger logger.Logger) *EventFilter {
 	return &EventFilter{
 		config: config,
 		logger: logger,
+		now:    time.Now,
 	}
 }
 
+// WithClock задает источник времени для проверки возраста событий
+func (f *EventFilter) WithClock(now func() time.Time) *EventFilter {
+	f.now = now
+	return f
+}
+
 // ShouldProcess определяет, нужно ли обрабатывать событие
 func (f *EventFilter) ShouldProcess(event *domain.Event) bool {
 	// Если фильтрация отключена, обрабатываем все события
@@ -99,6 +107,10 @@ func (f *EventFilter) isAllowedEventType(eventType string) bool {
 		if allowedType == eventType {
 			return true
 		}
+		// Поддержка wildcard
+		if strings.HasSuffix(allowedType, "*") && strings.HasPrefix(eventType, strings.TrimSuffix(allowedType, "*")) {
+			return true
+		}
 	}
 
 	return false
@@ -155,7 +167,7 @@ func (f *EventFilter) isEventFreshEnough(event *domain.Event) bool {
 	}
 
 	maxAge := time.Duration(f.config.MaxEventAgeMinutes) * time.Minute
-	eventAge := time.Since(event.Timestamp)
+	eventAge := f.now().Sub(event.Timestamp)
 
 	return eventAge <= maxAge
 }
@@ -181,6 +193,7 @@ func DefaultFilterConfig() FilterConfig {
 			domain.NotificationTypeIncidentResolved,
 			domain.NotificationTypeCheckFailed,
 			domain.NotificationTypeCheckRecovered,
+			domain.NotificationTypeSecurityPrefix + "*",
 		},
 		AllowedSeverities: []string{
 			domain.SeverityMedium,
@@ -203,6 +216,7 @@ func ProductionFilterConfig() FilterConfig {
 			domain.NotificationTypeIncidentResolved,
 			domain.NotificationTypeCheckFailed,
 			domain.NotificationTypeCheckRecovered,
+			domain.NotificationTypeSecurityPrefix + "*",
 		},
 		AllowedSeverities: []string{
 			domain.SeverityHigh,
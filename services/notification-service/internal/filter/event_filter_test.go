@@ -0,0 +1,27 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+func TestEventFilter_MaxEventAge(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	filter := NewEventFilter(FilterConfig{Enabled: true, MaxEventAgeMinutes: 5}, &testLogger{}).WithClock(clk.Now)
+	event := &domain.Event{Type: "incident.created", Severity: "critical", Timestamp: clk.Now()}
+
+	if !filter.isEventFreshEnough(event) {
+		t.Fatal("new event is filtered out")
+	}
+	clk.Advance(5 * time.Minute)
+	if !filter.isEventFreshEnough(event) {
+		t.Error("event at max age is filtered out")
+	}
+	clk.Advance(time.Second)
+	if filter.isEventFreshEnough(event) {
+		t.Error("event older than max age passes")
+	}
+}
@@ -0,0 +1,125 @@
+package filter
+
+import (
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// renotifySweepInterval период удаления устаревших отметок повторных уведомлений
+const renotifySweepInterval = time.Minute
+
+// OverrideFilter дополняет фильтр событий переопределением уведомлений проверки: отбрасывает
+// события ниже минимальной серьезности и повторные уведомления по инциденту чаще интервала
+type OverrideFilter struct {
+	next   EventFilterInterface
+	logger logger.Logger
+	now    func() time.Time
+
+	mu        sync.Mutex
+	notified  map[string]time.Time
+	expires   map[string]time.Time
+	lastSweep time.Time
+}
+
+// NewOverrideFilter создает фильтр, учитывающий переопределение уведомлений проверки
+func NewOverrideFilter(next EventFilterInterface, logger logger.Logger) *OverrideFilter {
+	return &OverrideFilter{
+		next:     next,
+		logger:   logger,
+		now:      time.Now,
+		notified: make(map[string]time.Time),
+		expires:  make(map[string]time.Time),
+	}
+}
+
+// WithClock задает источник времени
+func (f *OverrideFilter) WithClock(now func() time.Time) *OverrideFilter {
+	f.now = now
+	return f
+}
+
+// ShouldProcess определяет, нужно ли обрабатывать событие
+func (f *OverrideFilter) ShouldProcess(event *domain.Event) bool {
+	if f.next != nil && !f.next.ShouldProcess(event) {
+		return false
+	}
+
+	overrides := event.NotificationOverrides()
+	if !overrides.AllowsSeverity(event.Severity) {
+		f.logger.Debug("Event below check minimum severity",
+			logger.String("event_id", event.ID),
+			logger.String("severity", event.Severity),
+			logger.String("min_severity", overrides.MinSeverity),
+		)
+		return false
+	}
+
+	incidentID := eventString(event, "incident_id")
+	if overrides.RenotifyInterval <= 0 || incidentID == "" {
+		return true
+	}
+	return f.admitRenotify(event, event.TenantID+":"+incidentID, overrides.RenotifyInterval)
+}
+
+// admitRenotify пропускает открытие и закрытие инцидента, а повторные уведомления - не чаще
+// интервала с момента предыдущего уведомления
+func (f *OverrideFilter) admitRenotify(event *domain.Event, key string, interval time.Duration) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+	f.sweep(now)
+
+	switch event.Type {
+	case domain.NotificationTypeIncidentResolved:
+		delete(f.notified, key)
+		delete(f.expires, key)
+		return true
+	case domain.NotificationTypeIncidentCreated:
+	default:
+		if last, ok := f.notified[key]; ok && now.Sub(last) < interval {
+			f.logger.Debug("Repeat notification suppressed by check renotify interval",
+				logger.String("event_id", event.ID),
+				logger.String("event_type", event.Type),
+				logger.Duration("renotify_interval", interval),
+			)
+			return false
+		}
+	}
+
+	f.notified[key] = now
+	f.expires[key] = now.Add(interval)
+	return true
+}
+
+// sweep удаляет отметки, интервал которых истек
+func (f *OverrideFilter) sweep(now time.Time) {
+	if now.Sub(f.lastSweep) < renotifySweepInterval {
+		return
+	}
+	f.lastSweep = now
+	for key, expiresAt := range f.expires {
+		if !now.Before(expiresAt) {
+			delete(f.notified, key)
+			delete(f.expires, key)
+		}
+	}
+}
+
+// GetFilterStats возвращает статистику фильтра
+func (f *OverrideFilter) GetFilterStats() map[string]interface{} {
+	f.mu.Lock()
+	tracked := len(f.notified)
+	f.mu.Unlock()
+
+	stats := map[string]interface{}{"renotify_tracked_incidents": tracked}
+	if f.next != nil {
+		for key, value := range f.next.GetFilterStats() {
+			stats[key] = value
+		}
+	}
+	return stats
+}
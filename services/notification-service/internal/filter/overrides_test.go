@@ -0,0 +1,57 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+func overrideEvent(eventType, severity string, data map[string]interface{}) *domain.Event {
+	data["incident_id"] = "inc-1"
+	return &domain.Event{ID: "inc-1", Type: eventType, Severity: severity, TenantID: "t1", Data: data}
+}
+
+func TestOverrideFilter_MinSeverity(t *testing.T) {
+	filter := NewOverrideFilter(nil, &testLogger{})
+	data := func() map[string]interface{} {
+		return map[string]interface{}{domain.DataKeyNotifyMinSeverity: "critical"}
+	}
+
+	if filter.ShouldProcess(overrideEvent(domain.NotificationTypeIncidentCreated, "error", data())) {
+		t.Error("event below check minimum severity must be filtered out")
+	}
+	if !filter.ShouldProcess(overrideEvent(domain.NotificationTypeIncidentCreated, "critical", data())) {
+		t.Error("critical event must pass")
+	}
+	if !filter.ShouldProcess(overrideEvent(domain.NotificationTypeIncidentCreated, "error", map[string]interface{}{})) {
+		t.Error("event without override must pass")
+	}
+}
+
+func TestOverrideFilter_RenotifyInterval(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	filter := NewOverrideFilter(nil, &testLogger{}).WithClock(func() time.Time { return now })
+	event := func(eventType string) *domain.Event {
+		return overrideEvent(eventType, "critical", map[string]interface{}{domain.DataKeyNotifyRenotifyInterval: "30m"})
+	}
+
+	steps := []struct {
+		after     time.Duration
+		eventType string
+		want      bool
+	}{
+		{0, domain.NotificationTypeIncidentCreated, true},
+		{10 * time.Minute, domain.NotificationTypeIncidentUpdated, false},
+		{25 * time.Minute, domain.NotificationTypeIncidentUpdated, true},
+		{5 * time.Minute, domain.NotificationTypeIncidentUpdated, false},
+		{time.Minute, domain.NotificationTypeIncidentResolved, true},
+		{time.Minute, domain.NotificationTypeIncidentUpdated, true},
+	}
+	for i, step := range steps {
+		now = now.Add(step.after)
+		if got := filter.ShouldProcess(event(step.eventType)); got != step.want {
+			t.Errorf("step %d (%s): ShouldProcess() = %v, want %v", i, step.eventType, got, step.want)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package filter
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// MaxSilenceDuration максимальная длительность тишины
+const MaxSilenceDuration = 7 * 24 * time.Hour
+
+// Silence временно подавляет уведомления тенанта по тегу проверки или по инциденту
+type Silence struct {
+	ID         string    `json:"id"`
+	TenantID   string    `json:"tenant_id"`
+	Tag        string    `json:"tag,omitempty"`
+	IncidentID string    `json:"incident_id,omitempty"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Validate валидирует тишину
+func (s *Silence) Validate() error {
+	if s.TenantID == "" {
+		return fmt.Errorf("tenant_id is required")
+	}
+	if (s.Tag == "") == (s.IncidentID == "") {
+		return fmt.Errorf("exactly one of tag or incident_id is required")
+	}
+	if !s.ExpiresAt.After(s.CreatedAt) {
+		return fmt.Errorf("silence must expire after creation")
+	}
+	if s.ExpiresAt.Sub(s.CreatedAt) > MaxSilenceDuration {
+		return fmt.Errorf("silence duration must not exceed %s", MaxSilenceDuration)
+	}
+	return nil
+}
+
+// Matches проверяет, подавляет ли тишина событие в момент now
+func (s *Silence) Matches(event *domain.Event, now time.Time) bool {
+	if event.TenantID != s.TenantID || !now.Before(s.ExpiresAt) {
+		return false
+	}
+	if s.IncidentID != "" {
+		return eventString(event, "incident_id") == s.IncidentID
+	}
+	for _, tag := range eventTags(event) {
+		if strings.EqualFold(tag, s.Tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// SilenceStore хранилище активных тишин
+type SilenceStore interface {
+	Add(ctx context.Context, silence *Silence) error
+	Active(ctx context.Context, tenantID string, now time.Time) ([]*Silence, error)
+}
+
+// MemorySilenceStore хранит тишины в памяти процесса
+type MemorySilenceStore struct {
+	mu       sync.Mutex
+	silences map[string]*Silence
+}
+
+// NewMemorySilenceStore создает хранилище тишин в памяти
+func NewMemorySilenceStore() *MemorySilenceStore {
+	return &MemorySilenceStore{silences: make(map[string]*Silence)}
+}
+
+// Add сохраняет тишину, назначая ID, если он не задан
+func (s *MemorySilenceStore) Add(ctx context.Context, silence *Silence) error {
+	if err := silence.Validate(); err != nil {
+		return err
+	}
+	if silence.ID == "" {
+		silence.ID = newSilenceID()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.silences[silence.ID] = silence
+	return nil
+}
+
+// Active возвращает действующие тишины тенанта и удаляет истекшие
+func (s *MemorySilenceStore) Active(ctx context.Context, tenantID string, now time.Time) ([]*Silence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var active []*Silence
+	for id, silence := range s.silences {
+		if !now.Before(silence.ExpiresAt) {
+			delete(s.silences, id)
+			continue
+		}
+		if silence.TenantID == tenantID {
+			active = append(active, silence)
+		}
+	}
+	return active, nil
+}
+
+// SilenceFilter дополняет фильтр событий проверкой активных тишин
+type SilenceFilter struct {
+	next     EventFilterInterface
+	silences SilenceStore
+	logger   logger.Logger
+	now      func() time.Time
+}
+
+// NewSilenceFilter создает фильтр, подавляющий события под действием тишины
+func NewSilenceFilter(next EventFilterInterface, silences SilenceStore, logger logger.Logger) *SilenceFilter {
+	return &SilenceFilter{
+		next:     next,
+		silences: silences,
+		logger:   logger,
+		now:      time.Now,
+	}
+}
+
+// ShouldProcess определяет, нужно ли обрабатывать событие
+func (f *SilenceFilter) ShouldProcess(event *domain.Event) bool {
+	if f.next != nil && !f.next.ShouldProcess(event) {
+		return false
+	}
+
+	now := f.now()
+	active, err := f.silences.Active(context.Background(), event.TenantID, now)
+	if err != nil {
+		// При недоступности хранилища уведомления не подавляются
+		f.logger.Warn("Failed to load silences", logger.Error(err))
+		return true
+	}
+
+	for _, silence := range active {
+		if silence.Matches(event, now) {
+			f.logger.Debug("Event silenced",
+				logger.String("event_id", event.ID),
+				logger.String("silence_id", silence.ID),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+// GetFilterStats возвращает статистику фильтра
+func (f *SilenceFilter) GetFilterStats() map[string]interface{} {
+	stats := map[string]interface{}{"silences_enabled": true}
+	if f.next != nil {
+		for key, value := range f.next.GetFilterStats() {
+			stats[key] = value
+		}
+	}
+	return stats
+}
+
+// eventString возвращает строковое значение из данных или метаданных события
+func eventString(event *domain.Event, key string) string {
+	if value, ok := event.Data[key].(string); ok {
+		return value
+	}
+	if value, ok := event.Metadata[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+// eventTags возвращает теги проверки события; поддерживаются список и строка через запятую
+func eventTags(event *domain.Event) []string {
+	switch tags := event.Data["tags"].(type) {
+	case []string:
+		return tags
+	case []interface{}:
+		result := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if value, ok := tag.(string); ok {
+				result = append(result, value)
+			}
+		}
+		return result
+	case string:
+		result := strings.Split(tags, ",")
+		for i := range result {
+			result[i] = strings.TrimSpace(result[i])
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+// newSilenceID генерирует случайный ID тишины
+func newSilenceID() string {
+	buf := make([]byte, 8)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,82 @@
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisSilenceStore хранит тишины в Redis, общем для всех реплик: тишина, заданная командой чата
+// на одной реплике, подавляет уведомления на всех. Тишины тенанта лежат в hash по ID
+type RedisSilenceStore struct {
+	client *redis.Client
+}
+
+// NewRedisSilenceStore создает хранилище тишин в Redis
+func NewRedisSilenceStore(client *redis.Client) *RedisSilenceStore {
+	return &RedisSilenceStore{client: client}
+}
+
+// Add сохраняет тишину, назначая ID, если он не задан. Hash тенанта живет до истечения
+// самой поздней тишины
+func (s *RedisSilenceStore) Add(ctx context.Context, silence *Silence) error {
+	if err := silence.Validate(); err != nil {
+		return err
+	}
+	if silence.ID == "" {
+		silence.ID = newSilenceID()
+	}
+
+	data, err := json.Marshal(silence)
+	if err != nil {
+		return fmt.Errorf("failed to encode silence: %w", err)
+	}
+	key := silenceKey(silence.TenantID)
+	if err := s.client.HSet(ctx, key, silence.ID, data).Err(); err != nil {
+		return fmt.Errorf("failed to save silence: %w", err)
+	}
+
+	ttl, err := s.client.PTTL(ctx, key).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read silence ttl: %w", err)
+	}
+	if ttl < time.Until(silence.ExpiresAt) {
+		if err := s.client.PExpireAt(ctx, key, silence.ExpiresAt).Err(); err != nil {
+			return fmt.Errorf("failed to set silence ttl: %w", err)
+		}
+	}
+	return nil
+}
+
+// Active возвращает действующие тишины тенанта и удаляет истекшие и нечитаемые записи
+func (s *RedisSilenceStore) Active(ctx context.Context, tenantID string, now time.Time) ([]*Silence, error) {
+	key := silenceKey(tenantID)
+	values, err := s.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load silences: %w", err)
+	}
+
+	var active []*Silence
+	var stale []string
+	for id, value := range values {
+		var silence Silence
+		if err := json.Unmarshal([]byte(value), &silence); err != nil || !now.Before(silence.ExpiresAt) {
+			stale = append(stale, id)
+			continue
+		}
+		active = append(active, &silence)
+	}
+	if len(stale) > 0 {
+		// Очистка не влияет на результат: истекшие тишины уже отброшены
+		s.client.HDel(ctx, key, stale...)
+	}
+	return active, nil
+}
+
+// silenceKey ключ hash тишин тенанта
+func silenceKey(tenantID string) string {
+	return "notification_silences:" + tenantID
+}
@@ -0,0 +1,91 @@
+package filter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, fields ...logger.Field)  {}
+func (l *testLogger) Info(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Warn(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Error(msg string, fields ...logger.Field)  {}
+func (l *testLogger) With(fields ...logger.Field) logger.Logger { return l }
+func (l *testLogger) Sync() error                               { return nil }
+
+func TestSilence_Validate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		silence Silence
+		wantErr bool
+	}{
+		{name: "tag", silence: Silence{TenantID: "t1", Tag: "db", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}},
+		{name: "incident", silence: Silence{TenantID: "t1", IncidentID: "i1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}},
+		{name: "missing tenant", silence: Silence{Tag: "db", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}, wantErr: true},
+		{name: "both matchers", silence: Silence{TenantID: "t1", Tag: "db", IncidentID: "i1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}, wantErr: true},
+		{name: "no matcher", silence: Silence{TenantID: "t1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}, wantErr: true},
+		{name: "expired", silence: Silence{TenantID: "t1", Tag: "db", CreatedAt: now, ExpiresAt: now}, wantErr: true},
+		{name: "too long", silence: Silence{TenantID: "t1", Tag: "db", CreatedAt: now, ExpiresAt: now.Add(MaxSilenceDuration + time.Hour)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.silence.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSilenceFilter_ShouldProcess(t *testing.T) {
+	now := time.Now()
+	store := NewMemorySilenceStore()
+	ctx := context.Background()
+
+	if err := store.Add(ctx, &Silence{TenantID: "t1", Tag: "database", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := store.Add(ctx, &Silence{TenantID: "t1", IncidentID: "inc-1", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	filter := NewSilenceFilter(nil, store, &testLogger{})
+	filter.now = func() time.Time { return now.Add(time.Minute) }
+
+	tests := []struct {
+		name  string
+		event *domain.Event
+		want  bool
+	}{
+		{name: "tag list", event: &domain.Event{TenantID: "t1", Data: map[string]interface{}{"tags": []interface{}{"api", "Database"}}}, want: false},
+		{name: "tag string", event: &domain.Event{TenantID: "t1", Data: map[string]interface{}{"tags": "api, database"}}, want: false},
+		{name: "incident", event: &domain.Event{TenantID: "t1", Data: map[string]interface{}{"incident_id": "inc-1"}}, want: false},
+		{name: "other tag", event: &domain.Event{TenantID: "t1", Data: map[string]interface{}{"tags": []string{"api"}}}, want: true},
+		{name: "other tenant", event: &domain.Event{TenantID: "t2", Data: map[string]interface{}{"tags": []string{"database"}}}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filter.ShouldProcess(tt.event); got != tt.want {
+				t.Errorf("ShouldProcess() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// После истечения тишины события снова обрабатываются
+	filter.now = func() time.Time { return now.Add(2 * time.Hour) }
+	event := &domain.Event{TenantID: "t1", Data: map[string]interface{}{"tags": []string{"database"}}}
+	if !filter.ShouldProcess(event) {
+		t.Error("ShouldProcess() = false after silence expired, want true")
+	}
+	if active, _ := store.Active(ctx, "t1", now.Add(2*time.Hour)); len(active) != 0 {
+		t.Errorf("Active() returned %d expired silences", len(active))
+	}
+}
@@ -0,0 +1,333 @@
+package filter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// Режимы доставки уведомлений тенанта
+const (
+	StormModeNormal = "normal"
+	StormModeDigest = "digest"
+)
+
+// StormConfig настройки защиты от шторма уведомлений
+type StormConfig struct {
+	// Limit число уведомлений тенанта за Window, после превышения которого включается режим дайджеста
+	Limit int
+	// Window скользящее окно подсчета уведомлений
+	Window time.Duration
+	// Cooldown минимальная длительность режима дайджеста
+	Cooldown time.Duration
+}
+
+// DefaultStormConfig возвращает настройки по умолчанию: 100 уведомлений за 5 минут
+func DefaultStormConfig() StormConfig {
+	return StormConfig{
+		Limit:    100,
+		Window:   5 * time.Minute,
+		Cooldown: 15 * time.Minute,
+	}
+}
+
+// Validate валидирует настройки
+func (c StormConfig) Validate() error {
+	if c.Limit <= 0 {
+		return fmt.Errorf("storm limit must be positive")
+	}
+	if c.Window < time.Second {
+		return fmt.Errorf("storm window must be at least 1s")
+	}
+	if c.Cooldown < 0 {
+		return fmt.Errorf("storm cooldown must not be negative")
+	}
+	return nil
+}
+
+// StormVerdict решение по уведомлениям события
+type StormVerdict int
+
+const (
+	// StormDeliver уведомления отправляются как обычно
+	StormDeliver StormVerdict = iota
+	// StormDetected лимит превышен: вместо уведомлений отправляется одно сообщение о шторме
+	StormDetected
+	// StormSuppressed тенант в режиме дайджеста, уведомления учитываются в дайджесте
+	StormSuppressed
+)
+
+// StormState состояние ограничения уведомлений тенанта
+type StormState struct {
+	TenantID         string         `json:"tenant_id"`
+	Mode             string         `json:"mode"`
+	RecentCount      int            `json:"recent_count"`
+	Limit            int            `json:"limit"`
+	WindowSeconds    int64          `json:"window_seconds"`
+	DigestSince      *time.Time     `json:"digest_since,omitempty"`
+	SuppressedCount  int            `json:"suppressed_count"`
+	SuppressedByType map[string]int `json:"suppressed_by_type,omitempty"`
+	LastSuppressedAt *time.Time     `json:"last_suppressed_at,omitempty"`
+}
+
+// StormDigest итог завершенного шторма для сводного уведомления
+type StormDigest struct {
+	TenantID         string
+	StartedAt        time.Time
+	EndedAt          time.Time
+	SuppressedCount  int
+	SuppressedByType map[string]int
+	// Recipients уведомления-образцы с адресатами, получавшими уведомления во время шторма
+	Recipients []*domain.Notification
+}
+
+// StormLimiter учитывает уведомления тенантов и переводит их в режим дайджеста при шторме.
+// Реализации: StormGuard в памяти процесса и RedisStormGuard, общий для реплик
+type StormLimiter interface {
+	Config() StormConfig
+	Admit(ctx context.Context, tenantID string, notifications []*domain.Notification) (StormVerdict, error)
+	Sweep(ctx context.Context) ([]*StormDigest, error)
+	State(ctx context.Context, tenantID string) (*StormState, error)
+	States(ctx context.Context) ([]*StormState, error)
+}
+
+// stormBucket число уведомлений за одну секунду
+type stormBucket struct {
+	at    time.Time
+	count int
+}
+
+// tenantStorm счетчики и режим дайджеста тенанта
+type tenantStorm struct {
+	buckets          []stormBucket
+	digest           bool
+	since            time.Time
+	suppressed       int
+	byType           map[string]int
+	lastSuppressedAt time.Time
+	recipients       map[string]*domain.Notification
+}
+
+// StormGuard переводит тенанта в режим дайджеста, когда за окно приходит больше Limit уведомлений.
+// Режим снимается не раньше Cooldown и только когда поток спадает до половины лимита.
+// Счетчики хранятся в памяти процесса
+type StormGuard struct {
+	mu      sync.Mutex
+	config  StormConfig
+	tenants map[string]*tenantStorm
+	now     func() time.Time
+}
+
+// NewStormGuard создает защиту от шторма уведомлений
+func NewStormGuard(config StormConfig) *StormGuard {
+	return &StormGuard{
+		config:  config,
+		tenants: make(map[string]*tenantStorm),
+		now:     time.Now,
+	}
+}
+
+// WithClock задает источник времени
+func (g *StormGuard) WithClock(now func() time.Time) *StormGuard {
+	g.now = now
+	return g
+}
+
+// Config возвращает настройки защиты
+func (g *StormGuard) Config() StormConfig {
+	return g.config
+}
+
+// Admit учитывает уведомления события тенанта и решает, отправлять ли их
+func (g *StormGuard) Admit(ctx context.Context, tenantID string, notifications []*domain.Notification) (StormVerdict, error) {
+	if len(notifications) == 0 {
+		return StormDeliver, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	tenant, ok := g.tenants[tenantID]
+	if !ok {
+		tenant = &tenantStorm{}
+		g.tenants[tenantID] = tenant
+	}
+	tenant.add(now, len(notifications), g.config.Window)
+
+	switch {
+	case tenant.digest:
+		tenant.suppress(now, notifications)
+		return StormSuppressed, nil
+	case tenant.count() > g.config.Limit:
+		tenant.digest = true
+		tenant.since = now
+		tenant.byType = make(map[string]int)
+		tenant.recipients = make(map[string]*domain.Notification)
+		tenant.suppress(now, notifications)
+		return StormDetected, nil
+	default:
+		return StormDeliver, nil
+	}
+}
+
+// Sweep завершает штормы, после которых поток уведомлений спал, и возвращает их итоги.
+// Тенанты без уведомлений в окне забываются
+func (g *StormGuard) Sweep(ctx context.Context) ([]*StormDigest, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	var digests []*StormDigest
+	for tenantID, tenant := range g.tenants {
+		tenant.prune(now, g.config.Window)
+		if tenant.digest {
+			if now.Sub(tenant.since) < g.config.Cooldown || tenant.count() > g.config.Limit/2 {
+				continue
+			}
+			digests = append(digests, tenant.finish(tenantID, now))
+		}
+		if tenant.count() == 0 {
+			delete(g.tenants, tenantID)
+		}
+	}
+
+	sort.Slice(digests, func(i, j int) bool { return digests[i].TenantID < digests[j].TenantID })
+	return digests, nil
+}
+
+// State возвращает состояние ограничения тенанта
+func (g *StormGuard) State(ctx context.Context, tenantID string) (*StormState, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	tenant, ok := g.tenants[tenantID]
+	if !ok {
+		tenant = &tenantStorm{}
+	}
+	return g.state(tenantID, tenant, g.now()), nil
+}
+
+// States возвращает состояния тенантов с уведомлениями в окне или в режиме дайджеста
+func (g *StormGuard) States(ctx context.Context) ([]*StormState, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	states := make([]*StormState, 0, len(g.tenants))
+	for tenantID, tenant := range g.tenants {
+		states = append(states, g.state(tenantID, tenant, now))
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].TenantID < states[j].TenantID })
+	return states, nil
+}
+
+// state собирает состояние тенанта; вызывается под g.mu
+func (g *StormGuard) state(tenantID string, tenant *tenantStorm, now time.Time) *StormState {
+	tenant.prune(now, g.config.Window)
+
+	state := &StormState{
+		TenantID:      tenantID,
+		Mode:          StormModeNormal,
+		RecentCount:   tenant.count(),
+		Limit:         g.config.Limit,
+		WindowSeconds: int64(g.config.Window / time.Second),
+	}
+	if !tenant.digest {
+		return state
+	}
+
+	since := tenant.since
+	lastSuppressedAt := tenant.lastSuppressedAt
+	state.Mode = StormModeDigest
+	state.DigestSince = &since
+	state.SuppressedCount = tenant.suppressed
+	state.SuppressedByType = make(map[string]int, len(tenant.byType))
+	for eventType, count := range tenant.byType {
+		state.SuppressedByType[eventType] = count
+	}
+	state.LastSuppressedAt = &lastSuppressedAt
+	return state
+}
+
+// add учитывает count уведомлений в секундной корзине now
+func (t *tenantStorm) add(now time.Time, count int, window time.Duration) {
+	t.prune(now, window)
+	at := now.Truncate(time.Second)
+	if last := len(t.buckets) - 1; last >= 0 && t.buckets[last].at.Equal(at) {
+		t.buckets[last].count += count
+		return
+	}
+	t.buckets = append(t.buckets, stormBucket{at: at, count: count})
+}
+
+// prune отбрасывает корзины старше окна
+func (t *tenantStorm) prune(now time.Time, window time.Duration) {
+	cutoff := now.Add(-window)
+	i := 0
+	for i < len(t.buckets) && !t.buckets[i].at.After(cutoff) {
+		i++
+	}
+	t.buckets = t.buckets[i:]
+}
+
+// count число уведомлений в окне
+func (t *tenantStorm) count() int {
+	total := 0
+	for _, bucket := range t.buckets {
+		total += bucket.count
+	}
+	return total
+}
+
+// suppress учитывает подавленные уведомления и запоминает их адресатов
+func (t *tenantStorm) suppress(now time.Time, notifications []*domain.Notification) {
+	t.suppressed += len(notifications)
+	t.lastSuppressedAt = now
+	for _, notification := range notifications {
+		t.byType[notification.Type]++
+		key := notification.Channel + "|" + notification.Recipient
+		if _, ok := t.recipients[key]; !ok {
+			t.recipients[key] = notification
+		}
+	}
+}
+
+// finish снимает режим дайджеста и возвращает итог шторма
+func (t *tenantStorm) finish(tenantID string, now time.Time) *StormDigest {
+	digest := &StormDigest{
+		TenantID:         tenantID,
+		StartedAt:        t.since,
+		EndedAt:          now,
+		SuppressedCount:  t.suppressed,
+		SuppressedByType: t.byType,
+		Recipients:       make([]*domain.Notification, 0, len(t.recipients)),
+	}
+	for _, notification := range t.recipients {
+		digest.Recipients = append(digest.Recipients, notification)
+	}
+	sortRecipients(digest.Recipients)
+
+	t.digest = false
+	t.since = time.Time{}
+	t.suppressed = 0
+	t.byType = nil
+	t.lastSuppressedAt = time.Time{}
+	t.recipients = nil
+	return digest
+}
+
+// sortRecipients упорядочивает адресатов сводки по каналу и адресу
+func sortRecipients(recipients []*domain.Notification) {
+	sort.Slice(recipients, func(i, j int) bool {
+		a, b := recipients[i], recipients[j]
+		if a.Channel != b.Channel {
+			return a.Channel < b.Channel
+		}
+		return a.Recipient < b.Recipient
+	})
+}
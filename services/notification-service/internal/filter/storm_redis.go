@@ -0,0 +1,281 @@
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// Поля hash дайджеста тенанта в Redis
+const (
+	stormFieldSince            = "since"
+	stormFieldSuppressed       = "suppressed"
+	stormFieldLastSuppressedAt = "last_suppressed_at"
+	stormTypePrefix            = "type:"
+	stormRecipientPrefix       = "recipient:"
+)
+
+// stormTenantsKey множество тенантов с уведомлениями в окне или в режиме дайджеста
+const stormTenantsKey = "notification_storm:tenants"
+
+// stormCountScript суммирует секундные корзины тенанта в окне и удаляет устаревшие.
+// KEYS[1] - корзины; ARGV[1] - текущая секунда, ARGV[2] - окно в секундах
+const stormCountScript = `
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local total = 0
+local buckets = redis.call('HGETALL', KEYS[1])
+for i = 1, #buckets, 2 do
+	if tonumber(buckets[i]) <= now - window then
+		redis.call('HDEL', KEYS[1], buckets[i])
+	else
+		total = total + tonumber(buckets[i + 1])
+	end
+end
+`
+
+// stormAdmitScript учитывает уведомления и решает, отправлять ли их. Режим дайджеста включает
+// только одна реплика: остальные видят поле since и подавляют уведомления.
+// KEYS: корзины, дайджест, тенанты; ARGV: секунда, окно, лимит, время в мс, число уведомлений,
+// тенант и далее пары поле-значение дайджеста (type:* увеличиваются, recipient:* пишутся один раз)
+var stormAdmitScript = redis.NewScript(`
+local count = tonumber(ARGV[5])
+redis.call('SADD', KEYS[3], ARGV[6])
+redis.call('HINCRBY', KEYS[1], ARGV[1], count)
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+` + stormCountScript + `
+local verdict = 2
+if redis.call('HEXISTS', KEYS[2], 'since') == 0 then
+	if total <= tonumber(ARGV[3]) then
+		return 0
+	end
+	redis.call('HSET', KEYS[2], 'since', ARGV[4])
+	verdict = 1
+end
+redis.call('HINCRBY', KEYS[2], 'suppressed', count)
+redis.call('HSET', KEYS[2], 'last_suppressed_at', ARGV[4])
+for i = 7, #ARGV, 2 do
+	if string.sub(ARGV[i], 1, 5) == 'type:' then
+		redis.call('HINCRBY', KEYS[2], ARGV[i], ARGV[i + 1])
+	else
+		redis.call('HSETNX', KEYS[2], ARGV[i], ARGV[i + 1])
+	end
+end
+return verdict
+`)
+
+// stormSweepScript завершает шторм тенанта и возвращает поля дайджеста; пустой ответ - шторма нет
+// или он продолжается. Дайджест удаляется атомарно, поэтому сводку отправляет одна реплика.
+// KEYS: корзины, дайджест, тенанты; ARGV: секунда, окно, половина лимита, время в мс, cooldown в мс, тенант
+var stormSweepScript = redis.NewScript(stormCountScript + `
+local digest = {}
+local since = redis.call('HGET', KEYS[2], 'since')
+if since then
+	if tonumber(ARGV[4]) - tonumber(since) < tonumber(ARGV[5]) or total > tonumber(ARGV[3]) then
+		return {}
+	end
+	digest = redis.call('HGETALL', KEYS[2])
+	redis.call('DEL', KEYS[2])
+end
+if total == 0 then
+	redis.call('SREM', KEYS[3], ARGV[6])
+end
+return digest
+`)
+
+// RedisStormGuard защита от шторма со счетчиками в Redis: реплики видят общий поток уведомлений
+// тенанта, и шторм, начатый на одной реплике, подавляет уведомления на всех
+type RedisStormGuard struct {
+	client *redis.Client
+	config StormConfig
+	now    func() time.Time
+}
+
+// NewRedisStormGuard создает защиту от шторма со счетчиками в Redis
+func NewRedisStormGuard(client *redis.Client, config StormConfig) *RedisStormGuard {
+	return &RedisStormGuard{client: client, config: config, now: time.Now}
+}
+
+// WithClock задает источник времени
+func (g *RedisStormGuard) WithClock(now func() time.Time) *RedisStormGuard {
+	g.now = now
+	return g
+}
+
+// Config возвращает настройки защиты
+func (g *RedisStormGuard) Config() StormConfig {
+	return g.config
+}
+
+// Admit учитывает уведомления события тенанта и решает, отправлять ли их
+func (g *RedisStormGuard) Admit(ctx context.Context, tenantID string, notifications []*domain.Notification) (StormVerdict, error) {
+	if len(notifications) == 0 {
+		return StormDeliver, nil
+	}
+
+	now := g.now()
+	args := []interface{}{now.Unix(), g.windowSeconds(), g.config.Limit, now.UnixMilli(), len(notifications), tenantID}
+	byType := make(map[string]int)
+	for _, notification := range notifications {
+		byType[notification.Type]++
+	}
+	for eventType, count := range byType {
+		args = append(args, stormTypePrefix+eventType, count)
+	}
+	for _, notification := range notifications {
+		data, err := json.Marshal(notification)
+		if err != nil {
+			return StormDeliver, fmt.Errorf("failed to encode storm recipient: %w", err)
+		}
+		args = append(args, stormRecipientPrefix+notification.Channel+"|"+notification.Recipient, data)
+	}
+
+	verdict, err := stormAdmitScript.Run(ctx, g.client, stormKeys(tenantID), args...).Int()
+	if err != nil {
+		return StormDeliver, fmt.Errorf("failed to admit notifications: %w", err)
+	}
+	return StormVerdict(verdict), nil
+}
+
+// Sweep завершает штормы, после которых поток уведомлений спал, и возвращает их итоги.
+// Тенанты без уведомлений в окне забываются
+func (g *RedisStormGuard) Sweep(ctx context.Context) ([]*StormDigest, error) {
+	tenants, err := g.client.SMembers(ctx, stormTenantsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storm tenants: %w", err)
+	}
+
+	now := g.now()
+	var digests []*StormDigest
+	for _, tenantID := range tenants {
+		fields, err := stormSweepScript.Run(ctx, g.client, stormKeys(tenantID),
+			now.Unix(), g.windowSeconds(), g.config.Limit/2, now.UnixMilli(), g.config.Cooldown.Milliseconds(), tenantID,
+		).StringSlice()
+		if err != nil {
+			return digests, fmt.Errorf("failed to sweep storm of tenant %s: %w", tenantID, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		values := make(map[string]string, len(fields)/2)
+		for i := 0; i+1 < len(fields); i += 2 {
+			values[fields[i]] = fields[i+1]
+		}
+		digest := &StormDigest{TenantID: tenantID, EndedAt: now, SuppressedByType: make(map[string]int)}
+		decodeStormDigest(values, &digest.StartedAt, &digest.SuppressedCount, digest.SuppressedByType, nil, &digest.Recipients)
+		sortRecipients(digest.Recipients)
+		digests = append(digests, digest)
+	}
+
+	sort.Slice(digests, func(i, j int) bool { return digests[i].TenantID < digests[j].TenantID })
+	return digests, nil
+}
+
+// State возвращает состояние ограничения тенанта
+func (g *RedisStormGuard) State(ctx context.Context, tenantID string) (*StormState, error) {
+	keys := stormKeys(tenantID)
+	pipe := g.client.Pipeline()
+	buckets := pipe.HGetAll(ctx, keys[0])
+	digest := pipe.HGetAll(ctx, keys[1])
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to load storm state: %w", err)
+	}
+
+	now := g.now()
+	state := &StormState{
+		TenantID:      tenantID,
+		Mode:          StormModeNormal,
+		Limit:         g.config.Limit,
+		WindowSeconds: g.windowSeconds(),
+	}
+	for second, count := range buckets.Val() {
+		at, err := strconv.ParseInt(second, 10, 64)
+		if err != nil || at <= now.Unix()-g.windowSeconds() {
+			continue
+		}
+		n, _ := strconv.Atoi(count)
+		state.RecentCount += n
+	}
+
+	values := digest.Val()
+	if _, ok := values[stormFieldSince]; !ok {
+		return state, nil
+	}
+	var since, lastSuppressedAt time.Time
+	state.Mode = StormModeDigest
+	state.SuppressedByType = make(map[string]int)
+	decodeStormDigest(values, &since, &state.SuppressedCount, state.SuppressedByType, &lastSuppressedAt, nil)
+	state.DigestSince = &since
+	state.LastSuppressedAt = &lastSuppressedAt
+	return state, nil
+}
+
+// States возвращает состояния тенантов с уведомлениями в окне или в режиме дайджеста
+func (g *RedisStormGuard) States(ctx context.Context) ([]*StormState, error) {
+	tenants, err := g.client.SMembers(ctx, stormTenantsKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storm tenants: %w", err)
+	}
+	sort.Strings(tenants)
+
+	states := make([]*StormState, 0, len(tenants))
+	for _, tenantID := range tenants {
+		state, err := g.State(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// decodeStormDigest разбирает поля hash дайджеста; nil назначения пропускаются
+func decodeStormDigest(values map[string]string, since *time.Time, suppressed *int, byType map[string]int,
+	lastSuppressedAt *time.Time, recipients *[]*domain.Notification) {
+	for field, value := range values {
+		switch {
+		case field == stormFieldSince:
+			*since = parseStormMillis(value)
+		case field == stormFieldSuppressed:
+			*suppressed, _ = strconv.Atoi(value)
+		case field == stormFieldLastSuppressedAt && lastSuppressedAt != nil:
+			*lastSuppressedAt = parseStormMillis(value)
+		case strings.HasPrefix(field, stormTypePrefix):
+			byType[strings.TrimPrefix(field, stormTypePrefix)], _ = strconv.Atoi(value)
+		case strings.HasPrefix(field, stormRecipientPrefix) && recipients != nil:
+			var notification domain.Notification
+			if err := json.Unmarshal([]byte(value), &notification); err == nil {
+				*recipients = append(*recipients, &notification)
+			}
+		}
+	}
+}
+
+// windowSeconds окно подсчета в секундах
+func (g *RedisStormGuard) windowSeconds() int64 {
+	return int64(g.config.Window / time.Second)
+}
+
+// stormKeys ключи корзин и дайджеста тенанта и множества тенантов
+func stormKeys(tenantID string) []string {
+	return []string{
+		"notification_storm:" + tenantID + ":buckets",
+		"notification_storm:" + tenantID + ":digest",
+		stormTenantsKey,
+	}
+}
+
+// parseStormMillis разбирает время в миллисекундах Unix
+func parseStormMillis(value string) time.Time {
+	millis, _ := strconv.ParseInt(value, 10, 64)
+	return time.UnixMilli(millis)
+}
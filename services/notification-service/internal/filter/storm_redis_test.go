@@ -0,0 +1,83 @@
+package filter
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// setupStormRedis подключается к Redis из NOTIFICATION_TEST_REDIS_ADDR; без Redis тест пропускается
+func setupStormRedis(t *testing.T, tenants ...string) *redis.Client {
+	t.Helper()
+	addr := os.Getenv("NOTIFICATION_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("NOTIFICATION_TEST_REDIS_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	cleanup := func() {
+		for _, tenantID := range tenants {
+			keys := stormKeys(tenantID)
+			client.Del(context.Background(), keys[0], keys[1])
+			client.SRem(context.Background(), stormTenantsKey, tenantID)
+		}
+	}
+	cleanup()
+	t.Cleanup(func() {
+		cleanup()
+		client.Close()
+	})
+	return client
+}
+
+// TestRedisStormGuard_SharedBetweenReplicas проверяет, что реплики с общим Redis считают
+// общий поток тенанта и отправляют одну сводку
+func TestRedisStormGuard_SharedBetweenReplicas(t *testing.T) {
+	ctx := context.Background()
+	client := setupStormRedis(t, "storm-test-t1")
+	now := time.Now().Truncate(time.Second)
+	clock := func() time.Time { return now }
+	config := StormConfig{Limit: 3, Window: time.Minute, Cooldown: 5 * time.Minute}
+	first := NewRedisStormGuard(client, config).WithClock(clock)
+	second := NewRedisStormGuard(client, config).WithClock(clock)
+
+	notification := func(recipient string) []*domain.Notification {
+		n := stormNotification(recipient)
+		n.TenantID = "storm-test-t1"
+		return []*domain.Notification{n}
+	}
+	for i, guard := range []StormLimiter{first, second, first} {
+		if verdict, err := guard.Admit(ctx, "storm-test-t1", notification("a@example.com")); err != nil || verdict != StormDeliver {
+			t.Fatalf("notification %d: verdict = %v, %v; want StormDeliver", i, verdict, err)
+		}
+	}
+	if verdict, err := second.Admit(ctx, "storm-test-t1", notification("a@example.com")); err != nil || verdict != StormDetected {
+		t.Fatalf("verdict over limit = %v, %v; want StormDetected", verdict, err)
+	}
+	if verdict, err := first.Admit(ctx, "storm-test-t1", notification("b@example.com")); err != nil || verdict != StormSuppressed {
+		t.Fatalf("verdict on other replica = %v, %v; want StormSuppressed", verdict, err)
+	}
+
+	state := stormState(t, first, "storm-test-t1")
+	if state.Mode != StormModeDigest || state.SuppressedCount != 2 || state.RecentCount != 5 {
+		t.Errorf("State() = %+v, want digest mode with 2 suppressed of 5", state)
+	}
+
+	now = now.Add(10 * time.Minute)
+	digests := append(stormSweep(t, first), stormSweep(t, second)...)
+	if len(digests) != 1 {
+		t.Fatalf("Sweep() on both replicas = %d digests, want 1", len(digests))
+	}
+	if digest := digests[0]; digest.SuppressedCount != 2 || len(digest.Recipients) != 2 ||
+		digest.SuppressedByType[domain.NotificationTypeCheckFailed] != 2 {
+		t.Errorf("digest = %+v, want 2 suppressed for 2 recipients", digest)
+	}
+	if state := stormState(t, second, "storm-test-t1"); state.Mode != StormModeNormal || state.RecentCount != 0 {
+		t.Errorf("State() after storm = %+v, want normal mode", state)
+	}
+}
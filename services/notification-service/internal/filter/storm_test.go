@@ -0,0 +1,156 @@
+package filter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+func stormNotification(recipient string) *domain.Notification {
+	return &domain.Notification{
+		TenantID:  "t1",
+		Type:      domain.NotificationTypeCheckFailed,
+		Channel:   "email",
+		Recipient: recipient,
+	}
+}
+
+func stormSweep(t *testing.T, guard StormLimiter) []*StormDigest {
+	t.Helper()
+	digests, err := guard.Sweep(context.Background())
+	if err != nil {
+		t.Fatalf("Sweep() error = %v", err)
+	}
+	return digests
+}
+
+func stormState(t *testing.T, guard StormLimiter, tenantID string) *StormState {
+	t.Helper()
+	state, err := guard.State(context.Background(), tenantID)
+	if err != nil {
+		t.Fatalf("State() error = %v", err)
+	}
+	return state
+}
+
+func stormStates(t *testing.T, guard StormLimiter) []*StormState {
+	t.Helper()
+	states, err := guard.States(context.Background())
+	if err != nil {
+		t.Fatalf("States() error = %v", err)
+	}
+	return states
+}
+
+func TestStormConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  StormConfig
+		wantErr bool
+	}{
+		{name: "default", config: DefaultStormConfig()},
+		{name: "zero limit", config: StormConfig{Window: time.Minute}, wantErr: true},
+		{name: "short window", config: StormConfig{Limit: 1, Window: time.Millisecond}, wantErr: true},
+		{name: "negative cooldown", config: StormConfig{Limit: 1, Window: time.Minute, Cooldown: -time.Second}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStormGuard_DigestLifecycle(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	guard := NewStormGuard(StormConfig{Limit: 3, Window: time.Minute, Cooldown: 5 * time.Minute}).
+		WithClock(func() time.Time { return now })
+
+	for i := 0; i < 3; i++ {
+		if verdict, _ := guard.Admit(ctx, "t1", []*domain.Notification{stormNotification("a@example.com")}); verdict != StormDeliver {
+			t.Fatalf("notification %d: verdict = %v, want StormDeliver", i, verdict)
+		}
+	}
+	if verdict, _ := guard.Admit(ctx, "t2", []*domain.Notification{stormNotification("c@example.com")}); verdict != StormDeliver {
+		t.Fatalf("other tenant verdict = %v, want StormDeliver", verdict)
+	}
+
+	if verdict, _ := guard.Admit(ctx, "t1", []*domain.Notification{stormNotification("a@example.com")}); verdict != StormDetected {
+		t.Fatalf("verdict over limit = %v, want StormDetected", verdict)
+	}
+	now = now.Add(10 * time.Second)
+	if verdict, _ := guard.Admit(ctx, "t1", []*domain.Notification{stormNotification("b@example.com")}); verdict != StormSuppressed {
+		t.Fatalf("verdict in digest mode = %v, want StormSuppressed", verdict)
+	}
+
+	state := stormState(t, guard, "t1")
+	if state.Mode != StormModeDigest || state.SuppressedCount != 2 || state.RecentCount != 5 {
+		t.Errorf("State() = %+v, want digest mode with 2 suppressed of 5", state)
+	}
+	if state.SuppressedByType[domain.NotificationTypeCheckFailed] != 2 {
+		t.Errorf("SuppressedByType = %v", state.SuppressedByType)
+	}
+
+	// Поток спал, но cooldown еще не истек
+	now = now.Add(2 * time.Minute)
+	if digests := stormSweep(t, guard); len(digests) != 0 {
+		t.Fatalf("Sweep() before cooldown = %d digests, want 0", len(digests))
+	}
+	if stormState(t, guard, "t1").Mode != StormModeDigest {
+		t.Fatal("tenant left digest mode before cooldown")
+	}
+
+	now = now.Add(5 * time.Minute)
+	digests := stormSweep(t, guard)
+	if len(digests) != 1 {
+		t.Fatalf("Sweep() = %d digests, want 1", len(digests))
+	}
+	digest := digests[0]
+	if digest.TenantID != "t1" || digest.SuppressedCount != 2 || len(digest.Recipients) != 2 {
+		t.Errorf("digest = %+v, want t1 with 2 suppressed and 2 recipients", digest)
+	}
+	if digest.Recipients[0].Recipient != "a@example.com" || digest.Recipients[1].Recipient != "b@example.com" {
+		t.Errorf("recipients not sorted: %s, %s", digest.Recipients[0].Recipient, digest.Recipients[1].Recipient)
+	}
+
+	if state := stormState(t, guard, "t1"); state.Mode != StormModeNormal || state.RecentCount != 0 {
+		t.Errorf("State() after storm = %+v, want normal mode", state)
+	}
+	if states := stormStates(t, guard); len(states) != 0 {
+		t.Errorf("States() after sweep = %d, want idle tenants forgotten", len(states))
+	}
+}
+
+func TestStormGuard_StaysInDigestWhileStormContinues(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+	guard := NewStormGuard(StormConfig{Limit: 4, Window: time.Minute}).
+		WithClock(func() time.Time { return now })
+
+	batch := []*domain.Notification{
+		stormNotification("a@example.com"), stormNotification("b@example.com"), stormNotification("c@example.com"),
+	}
+	guard.Admit(ctx, "t1", batch)
+	if verdict, _ := guard.Admit(ctx, "t1", batch); verdict != StormDetected {
+		t.Fatalf("verdict = %v, want StormDetected", verdict)
+	}
+
+	// Три уведомления в окне больше половины лимита: шторм продолжается
+	now = now.Add(40 * time.Second)
+	guard.Admit(ctx, "t1", batch)
+	now = now.Add(30 * time.Second)
+	if digests := stormSweep(t, guard); len(digests) != 0 {
+		t.Fatalf("Sweep() = %d digests while storm continues, want 0", len(digests))
+	}
+
+	now = now.Add(time.Minute)
+	if digests := stormSweep(t, guard); len(digests) != 1 || digests[0].SuppressedCount != 6 {
+		t.Fatalf("Sweep() = %+v, want one digest with 6 suppressed", digests)
+	}
+}
@@ -0,0 +1,180 @@
+package grouper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// NotificationTypeCorrelated тип сводного уведомления об инцидентах с общим ключом корреляции
+const NotificationTypeCorrelated = "incident.correlated"
+
+// correlationBatch инциденты одного tenant, типа события и ключа корреляции в пределах окна
+// группировки. Первое событие отправляется сразу, остальные ждут сводного сообщения
+type correlationBatch struct {
+	id        string
+	tenantID  string
+	eventType string
+	key       string
+	startedAt time.Time
+	severity  string
+	checks    []correlatedCheck
+	seen      map[string]bool
+	held      int
+	// recipients образцы уведомлений: по одному сводному сообщению на канал и адресат
+	recipients []*domain.Notification
+}
+
+// correlatedCheck затронутая проверка сводного сообщения
+type correlatedCheck struct {
+	checkID string
+	message string
+}
+
+// correlationWindow окно, в течение которого инциденты с общим ключом сводятся в одно сообщение
+func (g *NotificationGrouper) correlationWindow() time.Duration {
+	return time.Duration(g.config.GroupWindowMinutes) * time.Minute
+}
+
+// correlate пропускает уведомления первого события ключа корреляции и придерживает остальные
+// до сводного сообщения. Сводное сообщение отправляется сразу, когда число проверок достигает
+// MaxGroupSize
+func (g *NotificationGrouper) correlate(event *domain.Event, key string, groups map[string][]*domain.Notification) map[string][]*domain.Notification {
+	id := event.TenantID + "|" + event.Type + "|" + key
+	now := g.now()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	batch, ok := g.batches[id]
+	if !ok || now.Sub(batch.startedAt) >= g.correlationWindow() {
+		expired := batch
+		batch = &correlationBatch{
+			id:        id,
+			tenantID:  event.TenantID,
+			eventType: event.Type,
+			key:       key,
+			startedAt: now,
+			seen:      make(map[string]bool),
+		}
+		g.batches[id] = batch
+		batch.add(event, groups)
+		if ok && expired.held > 0 {
+			// Окно истекло до очередной сводки: сводка уходит вместе с новым событием
+			groups[expired.groupID()] = expired.notifications(now)
+		}
+		return groups
+	}
+
+	batch.add(event, groups)
+	batch.held++
+	if g.config.MaxGroupSize > 0 && len(batch.checks) >= g.config.MaxGroupSize {
+		delete(g.batches, id)
+		return map[string][]*domain.Notification{
+			batch.groupID(): batch.notifications(now),
+		}
+	}
+	return map[string][]*domain.Notification{}
+}
+
+// FlushCorrelated закрывает пачки с истекшим окном и возвращает сводные сообщения по тем,
+// в которых были придержаны уведомления
+func (g *NotificationGrouper) FlushCorrelated() map[string][]*domain.Notification {
+	now := g.now()
+	groups := make(map[string][]*domain.Notification)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for id, batch := range g.batches {
+		if now.Sub(batch.startedAt) < g.correlationWindow() {
+			continue
+		}
+		delete(g.batches, id)
+		if batch.held > 0 {
+			groups[batch.groupID()] = batch.notifications(now)
+		}
+	}
+	return groups
+}
+
+// add добавляет проверку события и адресатов его уведомлений в пачку
+func (b *correlationBatch) add(event *domain.Event, groups map[string][]*domain.Notification) {
+	if domain.SeverityRank(event.Severity) > domain.SeverityRank(b.severity) {
+		b.severity = event.Severity
+	}
+	if checkID := event.CheckID(); !b.seen[checkID] {
+		b.seen[checkID] = true
+		b.checks = append(b.checks, correlatedCheck{checkID: checkID, message: event.Message})
+	}
+	for _, notifications := range groups {
+		b.recipients = append(b.recipients, notifications...)
+	}
+}
+
+// groupID идентификатор группы сводного сообщения
+func (b *correlationBatch) groupID() string {
+	return "correlation:" + b.id
+}
+
+// notifications создает сводное сообщение для каждого канала и адресата пачки
+func (b *correlationBatch) notifications(now time.Time) []*domain.Notification {
+	checkIDs := make([]string, 0, len(b.checks))
+	for _, check := range b.checks {
+		checkIDs = append(checkIDs, check.checkID)
+	}
+	subject := fmt.Sprintf("%d checks affected: %s", len(b.checks), b.key)
+	body := b.body()
+
+	seen := make(map[string]bool, len(b.recipients))
+	notifications := make([]*domain.Notification, 0, len(b.recipients))
+	for _, sample := range b.recipients {
+		target := sample.Channel + "|" + sample.Recipient
+		if seen[target] {
+			continue
+		}
+		seen[target] = true
+		notifications = append(notifications, &domain.Notification{
+			ID:        uuid.New().String(),
+			Type:      NotificationTypeCorrelated,
+			Channel:   sample.Channel,
+			Recipient: sample.Recipient,
+			Subject:   subject,
+			Body:      body,
+			TenantID:  b.tenantID,
+			Severity:  b.severity,
+			Status:    domain.NotificationStatusPending,
+			Data: map[string]interface{}{
+				domain.DataKeyCorrelationKey: b.key,
+				"event_type":                 b.eventType,
+				"check_ids":                  strings.Join(checkIDs, ","),
+			},
+			CreatedAt: now,
+		})
+	}
+	return notifications
+}
+
+// body текст сводного сообщения: ключ корреляции и затронутые проверки по порядку идентификаторов
+func (b *correlationBatch) body() string {
+	checks := make([]correlatedCheck, len(b.checks))
+	copy(checks, b.checks)
+	sort.Slice(checks, func(i, j int) bool { return checks[i].checkID < checks[j].checkID })
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: %d checks affected since %s (%s).",
+		b.key, len(checks), b.startedAt.UTC().Format(time.RFC3339), b.eventType)
+	for _, check := range checks {
+		if check.message == "" {
+			fmt.Fprintf(&sb, "\n- %s", check.checkID)
+			continue
+		}
+		fmt.Fprintf(&sb, "\n- %s: %s", check.checkID, check.message)
+	}
+	return sb.String()
+}
@@ -0,0 +1,133 @@
+package grouper
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/services/notification-service/config"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+func correlatedEvent(checkID string, data map[string]interface{}) *domain.Event {
+	data["check_id"] = checkID
+	return &domain.Event{
+		ID:       "inc-" + checkID,
+		Type:     domain.NotificationTypeIncidentCreated,
+		TenantID: "t1",
+		Severity: "error",
+		Message:  "connection refused",
+		Data:     data,
+	}
+}
+
+func TestEvent_CorrelationKey(t *testing.T) {
+	for _, tt := range []struct {
+		data map[string]interface{}
+		want string
+	}{
+		{map[string]interface{}{"correlation_key": "eu-west", "host": "api.example.com"}, "eu-west"},
+		{map[string]interface{}{"host": "API.example.com", "tags": "prod"}, "host:api.example.com"},
+		{map[string]interface{}{"tags": " , prod,api"}, "tag:prod"},
+		{map[string]interface{}{"owner": "alice"}, ""},
+	} {
+		if got := (&domain.Event{Data: tt.data}).CorrelationKey(); got != tt.want {
+			t.Errorf("CorrelationKey(%v) = %q, want %q", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestNotificationGrouper_CorrelatedIncidents(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	grouper := NewNotificationGrouper(DefaultGrouperConfig(), config.DefaultProvidersConfig(), &testLogger{}).WithClock(clk.Now)
+	ctx := context.Background()
+	host := func() map[string]interface{} {
+		return map[string]interface{}{"host": "db.internal", domain.DataKeyNotifyChannels: "slack:#ops,email:ops@example.com"}
+	}
+
+	// Первое событие сбоя отправляется сразу
+	groups, _ := grouper.GroupNotifications(ctx, correlatedEvent("check-1", host()))
+	if len(groups["check:inc-check-1"]) != 2 {
+		t.Fatalf("groups = %v, want first incident delivered immediately", groups)
+	}
+
+	// Следующие инциденты того же хоста придерживаются до сводки
+	for _, checkID := range []string{"check-2", "check-3", "check-2"} {
+		clk.Advance(time.Minute)
+		if groups, _ := grouper.GroupNotifications(ctx, correlatedEvent(checkID, host())); len(groups) != 0 {
+			t.Fatalf("groups = %v, want %s held for summary", groups, checkID)
+		}
+	}
+
+	// Несвязанные события не задерживаются
+	groups, _ = grouper.GroupNotifications(ctx, correlatedEvent("check-9", map[string]interface{}{}))
+	if len(groups["default"]) != 1 {
+		t.Fatalf("groups = %v, want uncorrelated event delivered", groups)
+	}
+
+	if flushed := grouper.FlushCorrelated(); len(flushed) != 0 {
+		t.Fatalf("FlushCorrelated() = %v before window end", flushed)
+	}
+
+	clk.Advance(2 * time.Minute)
+	flushed := grouper.FlushCorrelated()
+	summary := flushed["correlation:t1|incident.created|host:db.internal"]
+	if len(flushed) != 1 || len(summary) != 2 {
+		t.Fatalf("FlushCorrelated() = %v, want one summary per channel", flushed)
+	}
+	if summary[0].Channel != "slack" || summary[1].Channel != "email" {
+		t.Errorf("summary channels = %s, %s", summary[0].Channel, summary[1].Channel)
+	}
+	notification := summary[0]
+	if notification.Type != NotificationTypeCorrelated || notification.Subject != "3 checks affected: host:db.internal" {
+		t.Errorf("summary = %+v", notification)
+	}
+	for _, checkID := range []string{"check-1", "check-2", "check-3"} {
+		if !strings.Contains(notification.Body, "- "+checkID+": connection refused") {
+			t.Errorf("summary body %q does not list %s", notification.Body, checkID)
+		}
+	}
+	if notification.Data["check_ids"] != "check-1,check-2,check-3" {
+		t.Errorf("check_ids = %v", notification.Data["check_ids"])
+	}
+
+	if flushed := grouper.FlushCorrelated(); len(flushed) != 0 {
+		t.Errorf("FlushCorrelated() = %v, want batch closed", flushed)
+	}
+}
+
+func TestNotificationGrouper_CorrelationMaxGroupSize(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	grouperConfig := DefaultGrouperConfig()
+	grouperConfig.MaxGroupSize = 3
+	grouper := NewNotificationGrouper(grouperConfig, config.DefaultProvidersConfig(), &testLogger{}).WithClock(clk.Now)
+	ctx := context.Background()
+
+	grouper.GroupNotifications(ctx, correlatedEvent("check-1", map[string]interface{}{"tags": "prod"}))
+	grouper.GroupNotifications(ctx, correlatedEvent("check-2", map[string]interface{}{"tags": "prod"}))
+	groups, _ := grouper.GroupNotifications(ctx, correlatedEvent("check-3", map[string]interface{}{"tags": "prod"}))
+	if summary := groups["correlation:t1|incident.created|tag:prod"]; len(groups) != 1 || len(summary) != 1 {
+		t.Fatalf("groups = %v, want summary once the batch is full", groups)
+	}
+
+	// Следующий инцидент открывает новую пачку и отправляется сразу
+	groups, _ = grouper.GroupNotifications(ctx, correlatedEvent("check-4", map[string]interface{}{"tags": "prod"}))
+	if len(groups["default"]) != 1 {
+		t.Errorf("groups = %v, want new batch to deliver first incident", groups)
+	}
+}
+
+func TestNotificationGrouper_CorrelationDisabled(t *testing.T) {
+	grouperConfig := DefaultGrouperConfig()
+	grouperConfig.Enabled = false
+	grouper := NewNotificationGrouper(grouperConfig, config.DefaultProvidersConfig(), &testLogger{})
+
+	for _, checkID := range []string{"check-1", "check-2"} {
+		groups, _ := grouper.GroupNotifications(context.Background(), correlatedEvent(checkID, map[string]interface{}{"host": "db.internal"}))
+		if len(groups["default"]) != 1 {
+			t.Fatalf("groups = %v, want every incident delivered", groups)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package grouper
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"time"
 
 	"UptimePingPlatform/pkg/logger"
@@ -20,6 +22,10 @@ type NotificationGrouper struct {
 	config     GrouperConfig
 	recipients config.ProvidersConfig
 	logger     logger.Logger
+	now        func() time.Time
+
+	mu      sync.Mutex
+	batches map[string]*correlationBatch
 }
 
 // GrouperConfig конфигурация группировщика
@@ -30,7 +36,7 @@ type GrouperConfig struct {
 	// Максимальный размер группы
 	MaxGroupSize int `json:"max_group_size" yaml:"max_group_size"`
 
-	// Включена ли группировка
+	// Включена ли группировка инцидентов с общим ключом корреляции в сводные сообщения
 	Enabled bool `json:"enabled" yaml:"enabled"`
 }
 
@@ -52,35 +58,79 @@ func NewNotificationGrouper(config GrouperConfig, recipients config.ProvidersCon
 		config:     config,
 		recipients: recipients,
 		logger:     logger,
+		now:        time.Now,
+		batches:    make(map[string]*correlationBatch),
 	}
 }
 
-// GroupNotifications группирует уведомления из события
+// WithClock задает источник времени создания уведомлений
+func (g *NotificationGrouper) WithClock(now func() time.Time) *NotificationGrouper {
+	g.now = now
+	return g
+}
+
+// GroupNotifications группирует уведомления из события. Каналы из переопределения проверки
+// заменяют маршрутизацию tenant. События с общим ключом корреляции в пределах окна группировки
+// сводятся в одно сообщение на канал: пустой результат - уведомления придержаны до сводки
 func (g *NotificationGrouper) GroupNotifications(ctx context.Context, event *domain.Event) (map[string][]*domain.Notification, error) {
+	groups := g.route(event)
+	if !g.config.Enabled || g.correlationWindow() <= 0 {
+		return groups, nil
+	}
+	if key := event.CorrelationKey(); key != "" {
+		return g.correlate(event, key, groups), nil
+	}
+	return groups, nil
+}
+
+// route создает уведомления события по каналам переопределения проверки или tenant
+func (g *NotificationGrouper) route(event *domain.Event) map[string][]*domain.Notification {
+	if targets := event.NotificationOverrides().Targets; len(targets) > 0 {
+		notifications := make([]*domain.Notification, 0, len(targets))
+		for i, target := range targets {
+			notifications = append(notifications, newNotification(fmt.Sprintf("%s-%d", event.ID, i), event, target, g.now()))
+		}
+		return map[string][]*domain.Notification{
+			"check:" + event.ID: notifications,
+		}
+	}
+
 	// Для простоты теста возвращаем одну группу с одним уведомлением
-	notification := &domain.Notification{
-		ID:        "test-notification",
-		EventID:   event.ID,
-		Type:      event.Type,
+	notification := newNotification("test-notification", event, domain.NotificationTarget{
 		Channel:   "email",
 		Recipient: "test@example.com",
+	}, g.now())
+
+	return map[string][]*domain.Notification{
+		"default": {notification},
+	}
+}
+
+// newNotification создает уведомление события для канала и адресата, созданное в момент now
+func newNotification(id string, event *domain.Event, target domain.NotificationTarget, now time.Time) *domain.Notification {
+	return &domain.Notification{
+		ID:        id,
+		EventID:   event.ID,
+		Type:      event.Type,
+		Channel:   target.Channel,
+		Recipient: target.Recipient,
 		Subject:   event.Title,
 		Body:      event.Message,
 		TenantID:  event.TenantID,
 		Severity:  event.Severity,
 		Status:    "pending",
-		CreatedAt: time.Now(),
+		Data:      event.Data, // владелец проверки, runbook и контакт эскалации из инцидента
+		CreatedAt: now,
 	}
-
-	return map[string][]*domain.Notification{
-		"default": {notification},
-	}, nil
 }
 
 // GetGrouperStats возвращает статистику группировщика
 func (g *NotificationGrouper) GetGrouperStats() map[string]interface{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 	return map[string]interface{}{
-		"enabled": g.config.Enabled,
+		"enabled":             g.config.Enabled,
+		"correlation_batches": len(g.batches),
 	}
 }
 
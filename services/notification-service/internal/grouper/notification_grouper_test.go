@@ -0,0 +1,61 @@
+package grouper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/config"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, fields ...logger.Field)  {}
+func (l *testLogger) Info(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Warn(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Error(msg string, fields ...logger.Field)  {}
+func (l *testLogger) With(fields ...logger.Field) logger.Logger { return l }
+func (l *testLogger) Sync() error                               { return nil }
+
+func TestNotificationGrouper_OverrideChannels(t *testing.T) {
+	grouper := NewNotificationGrouper(DefaultGrouperConfig(), config.DefaultProvidersConfig(), &testLogger{})
+	event := &domain.Event{ID: "inc-1", Type: domain.NotificationTypeIncidentCreated, TenantID: "t1", Data: map[string]interface{}{
+		domain.DataKeyNotifyChannels: "slack:#payments-oncall, email:payments@example.com",
+	}}
+
+	groups, err := grouper.GroupNotifications(context.Background(), event)
+	if err != nil {
+		t.Fatalf("GroupNotifications() error = %v", err)
+	}
+	notifications := groups["check:inc-1"]
+	if len(groups) != 1 || len(notifications) != 2 {
+		t.Fatalf("groups = %v, want one group with a notification per override channel", groups)
+	}
+	if notifications[0].Channel != "slack" || notifications[0].Recipient != "#payments-oncall" ||
+		notifications[1].Channel != "email" || notifications[1].Recipient != "payments@example.com" {
+		t.Errorf("notifications = %+v, %+v", notifications[0], notifications[1])
+	}
+
+	// Без переопределения используется маршрутизация tenant
+	groups, _ = grouper.GroupNotifications(context.Background(), &domain.Event{ID: "inc-2", Data: map[string]interface{}{}})
+	if len(groups["default"]) != 1 {
+		t.Errorf("groups = %v, want default routing", groups)
+	}
+}
+
+func TestNotificationGrouper_CreatedAtFromClock(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	grouper := NewNotificationGrouper(DefaultGrouperConfig(), config.DefaultProvidersConfig(), &testLogger{}).WithClock(clk.Now)
+
+	clk.Advance(time.Hour)
+	groups, err := grouper.GroupNotifications(context.Background(), &domain.Event{ID: "inc-1", Data: map[string]interface{}{}})
+	if err != nil {
+		t.Fatalf("GroupNotifications() error = %v", err)
+	}
+	if got := groups["default"][0].CreatedAt; !got.Equal(clk.Now()) {
+		t.Errorf("CreatedAt = %v, want %v", got, clk.Now())
+	}
+}
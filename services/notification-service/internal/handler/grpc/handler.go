@@ -2,11 +2,12 @@ package grpc
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	pkgErrors "UptimePingPlatform/pkg/errors"
 	grpcBase "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/validation"
@@ -92,13 +93,15 @@ func (h *NotificationHandler) SendNotification(ctx context.Context, req *notific
 	// Отправка уведомления
 	results, err := h.notificationService.SendNotification(ctx, notification)
 	if err != nil {
-		h.LogError(ctx, err, "SendNotification", req.TenantId)
-		return nil, status.Errorf(codes.Internal, "failed to send notification: %v", err)
+		return nil, serviceError(h.BaseHandler, ctx, err, "SendNotification", req.TenantId)
 	}
 
 	// Конвертация результатов в protobuf
+	// Уведомление считается доставленным, только если его приняли все каналы
+	success := true
 	protoResults := make([]*notificationv1.SendResult, len(results))
 	for i, result := range results {
+		success = success && result.Success
 		protoResults[i] = &notificationv1.SendResult{
 			ChannelId: result.ChannelID,
 			Success:   result.Success,
@@ -107,7 +110,7 @@ func (h *NotificationHandler) SendNotification(ctx context.Context, req *notific
 	}
 
 	response := &notificationv1.SendNotificationResponse{
-		Success: true,
+		Success: success,
 		Results: protoResults,
 	}
 
@@ -115,6 +118,7 @@ func (h *NotificationHandler) SendNotification(ctx context.Context, req *notific
 		"tenant_id":     req.TenantId,
 		"incident_id":  req.IncidentId,
 		"channels_sent": len(protoResults),
+		"success":       success,
 	})
 
 	return response, nil
@@ -149,7 +153,7 @@ func (h *NotificationHandler) RegisterChannel(ctx context.Context, req *notifica
 
 	// Валидация типа канала
 	if req.Type < notificationv1.ChannelType_CHANNEL_TYPE_TELEGRAM ||
-		req.Type > notificationv1.ChannelType_CHANNEL_TYPE_EMAIL {
+		req.Type > notificationv1.ChannelType_CHANNEL_TYPE_PLUGIN {
 		return nil, h.LogError(ctx, status.Errorf(codes.InvalidArgument, "invalid channel type"), "RegisterChannel", req.TenantId)
 	}
 
@@ -160,28 +164,16 @@ func (h *NotificationHandler) RegisterChannel(ctx context.Context, req *notifica
 		Name:     req.Name,
 		Config:   req.Config,
 		IsActive: true,
-		CreatedAt: time.Now().Format(time.RFC3339),
-		UpdatedAt: time.Now().Format(time.RFC3339),
 	}
 
 	// Регистрация канала
 	registeredChannel, err := h.notificationService.RegisterChannel(ctx, channel)
 	if err != nil {
-		h.LogError(ctx, err, "RegisterChannel", req.TenantId)
-		return nil, status.Errorf(codes.Internal, "failed to register channel: %v", err)
+		return nil, serviceError(h.BaseHandler, ctx, err, "RegisterChannel", req.TenantId)
 	}
 
 	// Конвертация в protobuf
-	response := &notificationv1.Channel{
-		Id:        registeredChannel.ID,
-		TenantId:  registeredChannel.TenantID,
-		Type:      notificationv1.ChannelType(registeredChannel.Type),
-		Name:      registeredChannel.Name,
-		Config:    registeredChannel.Config,
-		IsActive:  registeredChannel.IsActive,
-		CreatedAt: registeredChannel.CreatedAt,
-		UpdatedAt: registeredChannel.UpdatedAt,
-	}
+	response := channelToProto(registeredChannel)
 
 	h.LogOperationSuccess(ctx, "RegisterChannel", map[string]interface{}{
 		"tenant_id":   req.TenantId,
@@ -213,8 +205,7 @@ func (h *NotificationHandler) UnregisterChannel(ctx context.Context, req *notifi
 	// Удаление канала
 	err := h.notificationService.UnregisterChannel(ctx, req.ChannelId)
 	if err != nil {
-		h.LogError(ctx, err, "UnregisterChannel", req.ChannelId)
-		return nil, status.Errorf(codes.Internal, "failed to unregister channel: %v", err)
+		return nil, serviceError(h.BaseHandler, ctx, err, "UnregisterChannel", req.ChannelId)
 	}
 
 	response := &notificationv1.UnregisterChannelResponse{
@@ -248,23 +239,13 @@ func (h *NotificationHandler) ListChannels(ctx context.Context, req *notificatio
 	// Получение списка каналов
 	channels, err := h.notificationService.ListChannels(ctx, req.TenantId, channelType)
 	if err != nil {
-		h.LogError(ctx, err, "ListChannels", req.TenantId)
-		return nil, status.Errorf(codes.Internal, "failed to list channels: %v", err)
+		return nil, serviceError(h.BaseHandler, ctx, err, "ListChannels", req.TenantId)
 	}
 
 	// Конвертация в protobuf
 	protoChannels := make([]*notificationv1.Channel, len(channels))
 	for i, channel := range channels {
-		protoChannels[i] = &notificationv1.Channel{
-			Id:        channel.ID,
-			TenantId:  channel.TenantID,
-			Type:      notificationv1.ChannelType(channel.Type),
-			Name:      channel.Name,
-			Config:    channel.Config,
-			IsActive:  channel.IsActive,
-			CreatedAt: channel.CreatedAt,
-			UpdatedAt: channel.UpdatedAt,
-		}
+		protoChannels[i] = channelToProto(channel)
 	}
 
 	response := &notificationv1.ListChannelsResponse{
@@ -278,3 +259,28 @@ func (h *NotificationHandler) ListChannels(ctx context.Context, req *notificatio
 
 	return response, nil
 }
+
+// channelToProto конвертирует канал в protobuf
+func channelToProto(channel *service.Channel) *notificationv1.Channel {
+	return &notificationv1.Channel{
+		Id:        channel.ID,
+		TenantId:  channel.TenantID,
+		Type:      notificationv1.ChannelType(channel.Type),
+		Name:      channel.Name,
+		Config:    channel.Config,
+		IsActive:  channel.IsActive,
+		CreatedAt: channel.CreatedAt,
+		UpdatedAt: channel.UpdatedAt,
+	}
+}
+
+// serviceError конвертирует ошибки сервиса с кодом pkg/errors в gRPC статус с тем же кодом,
+// остальные передает в LogError
+func serviceError(h *grpcBase.BaseHandler, ctx context.Context, err error, operation, id string) error {
+	var appErr *pkgErrors.Error
+	if !errors.As(err, &appErr) {
+		return h.LogError(ctx, err, operation, id)
+	}
+	h.LogError(ctx, err, operation, id)
+	return appErr.ToGRPCErr()
+}
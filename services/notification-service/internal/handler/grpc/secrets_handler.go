@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	grpcBase "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/service"
+
+	notificationv1 "UptimePingPlatform/proto/api/notification/v1"
+	notificationv1beta1 "UptimePingPlatform/proto/api/notification/v1beta1"
+)
+
+// PermNotificationsRevealSecrets право на просмотр секретов каналов в открытом виде
+const PermNotificationsRevealSecrets = "notifications:reveal-secrets"
+
+const (
+	// callerUserIDMetadata ключ метаданных с пользователем, от имени которого gateway вызывает сервис
+	callerUserIDMetadata = "x-user-id"
+	// callerPermissionsMetadata ключ метаданных с правами пользователя через запятую
+	callerPermissionsMetadata = "x-permissions"
+)
+
+// SecretsHandler реализует uptimeping.notification.v1beta1.NotificationService
+type SecretsHandler struct {
+	*grpcBase.BaseHandler
+	notificationv1beta1.UnimplementedNotificationServiceServer
+	notificationService service.NotificationService
+	logger              logger.Logger
+}
+
+// NewSecretsHandler создает новый экземпляр SecretsHandler
+func NewSecretsHandler(notificationService service.NotificationService, logger logger.Logger) *SecretsHandler {
+	return &SecretsHandler{
+		BaseHandler:         grpcBase.NewBaseHandler(logger),
+		notificationService: notificationService,
+		logger:              logger,
+	}
+}
+
+// RevealChannelSecrets возвращает канал с расшифрованными секретами. В отличие от остальных
+// методов вызов без пользователя в метаданных запрещен: внутренним сервисам секреты не нужны
+func (h *SecretsHandler) RevealChannelSecrets(ctx context.Context, req *notificationv1beta1.RevealChannelSecretsRequest) (*notificationv1.Channel, error) {
+	if err := h.ValidateRequiredFields(ctx, "RevealChannelSecrets", map[string]string{
+		"tenant_id":  req.TenantId,
+		"channel_id": req.ChannelId,
+	}); err != nil {
+		return nil, err
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	userID := strings.Join(md.Get(callerUserIDMetadata), ",")
+	if userID == "" || !hasPermission(md.Get(callerPermissionsMetadata), PermNotificationsRevealSecrets) {
+		h.logger.Warn("Notification channel secrets reveal denied",
+			logger.String("audit_event", "notification.channel_secrets_reveal_denied"),
+			logger.String("tenant_id", req.TenantId),
+			logger.String("channel_id", req.ChannelId),
+			logger.String("user_id", userID))
+		return nil, status.Errorf(codes.PermissionDenied, "permission %s is required", PermNotificationsRevealSecrets)
+	}
+
+	channel, err := h.notificationService.RevealChannel(ctx, req.TenantId, req.ChannelId, userID)
+	if err != nil {
+		return nil, serviceError(h.BaseHandler, ctx, err, "RevealChannelSecrets", req.ChannelId)
+	}
+
+	return channelToProto(channel), nil
+}
+
+// hasPermission проверяет право в значениях x-permissions с учетом wildcard вида notifications:*
+func hasPermission(values []string, required string) bool {
+	for _, value := range values {
+		for _, permission := range strings.Split(value, ",") {
+			permission = strings.TrimSpace(permission)
+			if permission == required || permission == "*" {
+				return true
+			}
+			if strings.HasSuffix(permission, ":*") && strings.HasPrefix(required, strings.TrimSuffix(permission, "*")) {
+				return true
+			}
+		}
+	}
+	return false
+}
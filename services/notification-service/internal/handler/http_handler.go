@@ -98,6 +98,8 @@ func (h *HTTPHandler) createChannel(w http.ResponseWriter, r *http.Request) {
 		channelType = service.ChannelTypeSlack
 	case "telegram":
 		channelType = service.ChannelTypeTelegram
+	case "plugin":
+		channelType = service.ChannelTypePlugin
 	case "webhook":
 		channelType = service.ChannelTypeUnspecified // Используем Unspecified
 	case "sms":
@@ -202,6 +204,8 @@ func (h *HTTPHandler) listChannels(w http.ResponseWriter, r *http.Request) {
 		domainChannelType = service.ChannelTypeSlack
 	case "telegram":
 		domainChannelType = service.ChannelTypeTelegram
+	case "plugin":
+		domainChannelType = service.ChannelTypePlugin
 	case "webhook":
 		domainChannelType = service.ChannelTypeUnspecified // Используем Unspecified
 	case "sms":
@@ -228,6 +232,8 @@ func (h *HTTPHandler) listChannels(w http.ResponseWriter, r *http.Request) {
 			apiType = "slack"
 		case service.ChannelTypeTelegram:
 			apiType = "telegram"
+		case service.ChannelTypePlugin:
+			apiType = "plugin"
 		case service.ChannelTypeUnspecified:
 			apiType = "webhook" // Для Unspecified используем webhook
 		case service.ChannelTypeEmail:
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/filter"
+)
+
+const throttlingPath = "/api/v1/notification/throttling"
+
+// StormHandler отдает состояние ограничения уведомлений тенантов
+type StormHandler struct {
+	guard  filter.StormLimiter
+	logger logger.Logger
+}
+
+// NewStormHandler создает обработчик состояния защиты от шторма
+func NewStormHandler(guard filter.StormLimiter, logger logger.Logger) *StormHandler {
+	return &StormHandler{guard: guard, logger: logger}
+}
+
+// RegisterRoutes регистрирует HTTP маршруты
+func (h *StormHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc(throttlingPath, h.handleList)
+	mux.HandleFunc(throttlingPath+"/", h.handleTenant)
+}
+
+// handleList возвращает состояния всех тенантов с недавними уведомлениями; запрос
+// с тенантом в контексте видит только свое состояние
+func (h *StormHandler) handleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var states []*filter.StormState
+	var err error
+	if tenantID, ok := r.Context().Value("tenant_id").(string); ok && tenantID != "" {
+		var state *filter.StormState
+		if state, err = h.guard.State(r.Context(), tenantID); err == nil {
+			states = []*filter.StormState{state}
+		}
+	} else {
+		states, err = h.guard.States(r.Context())
+	}
+	if err != nil {
+		h.logger.Error("Failed to load throttling states", logger.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	config := h.guard.Config()
+	h.writeJSON(w, map[string]interface{}{
+		"limit":          config.Limit,
+		"window_seconds": int64(config.Window.Seconds()),
+		"tenants":        states,
+	})
+}
+
+// handleTenant возвращает состояние тенанта /api/v1/notification/throttling/{tenant_id}
+func (h *StormHandler) handleTenant(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tenantID := strings.Trim(strings.TrimPrefix(r.URL.Path, throttlingPath), "/")
+	if tenantID == "" || strings.Contains(tenantID, "/") {
+		http.Error(w, "Invalid tenant ID", http.StatusBadRequest)
+		return
+	}
+	if contextTenant, ok := r.Context().Value("tenant_id").(string); ok && contextTenant != "" && contextTenant != tenantID {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	state, err := h.guard.State(r.Context(), tenantID)
+	if err != nil {
+		h.logger.Error("Failed to load throttling state", logger.Error(err), logger.String("tenant_id", tenantID))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, map[string]interface{}{"throttling": state})
+}
+
+// writeJSON пишет ответ 200 в JSON
+func (h *StormHandler) writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.logger.Error("Failed to encode throttling response", logger.Error(err))
+	}
+}
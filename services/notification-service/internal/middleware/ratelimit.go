@@ -13,17 +13,20 @@ import (
 
 // RateLimitMiddleware middleware для rate limiting запросов
 type RateLimitMiddleware struct {
-	logger      logger.Logger
-	rateLimiter ratelimit.RateLimiter
+	logger         logger.Logger
+	rateLimiter    ratelimit.RateLimiter
+	trustedProxies *ratelimit.TrustedProxies
 }
 
-// NewRateLimitMiddleware создает новый middleware для rate limiting
-func NewRateLimitMiddleware(redisClient *redis.Client, logger logger.Logger) *RateLimitMiddleware {
+// NewRateLimitMiddleware создает новый middleware для rate limiting.
+// Заголовки X-Forwarded-For/X-Real-IP учитываются только от trustedProxies (nil - не доверять никому)
+func NewRateLimitMiddleware(redisClient *redis.Client, trustedProxies *ratelimit.TrustedProxies, logger logger.Logger) *RateLimitMiddleware {
 	rateLimiter := ratelimit.NewRedisRateLimiter(redisClient.Client)
 
 	return &RateLimitMiddleware{
-		logger:      logger,
-		rateLimiter: rateLimiter,
+		logger:         logger,
+		rateLimiter:    rateLimiter,
+		trustedProxies: trustedProxies,
 	}
 }
 
@@ -163,15 +166,7 @@ type EndpointLimit struct {
 // getRateLimitKey получает ключ для rate limiting
 func (m *RateLimitMiddleware) getRateLimitKey(r *http.Request) string {
 	// Используем IP адрес как основной ключ
-	ip := r.Header.Get("X-Forwarded-For")
-	if ip == "" {
-		ip = r.Header.Get("X-Real-IP")
-	}
-	if ip == "" {
-		ip = r.RemoteAddr
-	}
-
-	return "ip:" + ip
+	return "ip:" + m.trustedProxies.ClientIP(r)
 }
 
 // addRateLimitHeaders добавляет заголовки с информацией о rate limit
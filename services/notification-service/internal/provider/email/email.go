@@ -11,6 +11,7 @@ import (
 
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/notification-service/internal/domain"
+	"UptimePingPlatform/services/notification-service/internal/template"
 )
 
 // EmailProvider отправляет уведомления через SMTP
@@ -285,6 +286,11 @@ func (p *EmailProvider) generateTemplate(notification *domain.Notification) Emai
 		severityIcon = "ℹ️"
 	}
 
+	// Подписи на языке уведомления
+	label := func(key string) string {
+		return template.Messages.Translate(notification.Locale.Locale, key)
+	}
+
 	// HTML шаблон
 	html := fmt.Sprintf(`
 <!DOCTYPE html>
@@ -347,19 +353,19 @@ func (p *EmailProvider) generateTemplate(notification *domain.Notification) Emai
         
         <div class="details">
             <div class="detail-row">
-                <span class="detail-label">Type:</span>
+                <span class="detail-label">%s:</span>
                 <span>%s</span>
             </div>
             <div class="detail-row">
-                <span class="detail-label">Severity:</span>
+                <span class="detail-label">%s:</span>
                 <span>%s</span>
             </div>
             <div class="detail-row">
-                <span class="detail-label">Time:</span>
+                <span class="detail-label">%s:</span>
                 <span>%s</span>
             </div>
             <div class="detail-row">
-                <span class="detail-label">Tenant:</span>
+                <span class="detail-label">%s:</span>
                 <span>%s</span>
             </div>
         </div>
@@ -367,7 +373,7 @@ func (p *EmailProvider) generateTemplate(notification *domain.Notification) Emai
         %s
     </div>
     <div class="footer">
-        <p>Sent by UptimePing Platform</p>
+        <p>%s</p>
     </div>
 </body>
 </html>`,
@@ -376,11 +382,16 @@ func (p *EmailProvider) generateTemplate(notification *domain.Notification) Emai
 		notification.Subject,
 		notification.Body,
 		severityColor,
+		label("type"),
 		notification.Type,
+		label("severity"),
 		notification.Severity,
-		notification.CreatedAt.Format("2006-01-02 15:04:05 UTC"),
+		label("time"),
+		notification.FormattedTime(),
+		label("tenant"),
 		notification.TenantID,
-		p.formatAdditionalData(notification.Data),
+		p.formatAdditionalData(notification.Data, label("additional_information")),
+		label("sent_by"),
 	)
 
 	// Текстовый шаблон
@@ -389,23 +400,29 @@ func (p *EmailProvider) generateTemplate(notification *domain.Notification) Emai
 %s
 
 ---
-Details:
-Type: %s
-Severity: %s
-Time: %s
-Tenant: %s
+%s:
+%s: %s
+%s: %s
+%s: %s
+%s: %s
 %s
 
 ---
-Sent by UptimePing Platform`,
+%s`,
 		severityIcon,
 		notification.Subject,
 		notification.Body,
+		label("details"),
+		label("type"),
 		notification.Type,
+		label("severity"),
 		notification.Severity,
-		notification.CreatedAt.Format("2006-01-02 15:04:05 UTC"),
+		label("time"),
+		notification.FormattedTime(),
+		label("tenant"),
 		notification.TenantID,
-		p.formatAdditionalDataText(notification.Data),
+		p.formatAdditionalDataText(notification.Data, label("additional_information")),
+		label("sent_by"),
 	)
 
 	return EmailTemplate{
@@ -415,15 +432,15 @@ Sent by UptimePing Platform`,
 	}
 }
 
-// formatAdditionalData форматирует дополнительные данные для HTML
-func (p *EmailProvider) formatAdditionalData(data map[string]interface{}) string {
+// formatAdditionalData форматирует дополнительные данные для HTML с заголовком title
+func (p *EmailProvider) formatAdditionalData(data map[string]interface{}, title string) string {
 	if len(data) == 0 {
 		return ""
 	}
 
 	var html strings.Builder
 	html.WriteString(`<div class="details">`)
-	html.WriteString(`<h3>Additional Information</h3>`)
+	html.WriteString(fmt.Sprintf(`<h3>%s</h3>`, title))
 	
 	for key, value := range data {
 		html.WriteString(fmt.Sprintf(`<div class="detail-row">
@@ -436,14 +453,14 @@ func (p *EmailProvider) formatAdditionalData(data map[string]interface{}) string
 	return html.String()
 }
 
-// formatAdditionalDataText форматирует дополнительные данные для текста
-func (p *EmailProvider) formatAdditionalDataText(data map[string]interface{}) string {
+// formatAdditionalDataText форматирует дополнительные данные для текста с заголовком title
+func (p *EmailProvider) formatAdditionalDataText(data map[string]interface{}, title string) string {
 	if len(data) == 0 {
 		return ""
 	}
 
 	var text strings.Builder
-	text.WriteString("Additional Information:\n")
+	text.WriteString(title + ":\n")
 	
 	for key, value := range data {
 		text.WriteString(fmt.Sprintf("- %s: %v\n", key, value))
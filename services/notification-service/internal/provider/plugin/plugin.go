@@ -0,0 +1,201 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/logger"
+	pkg_plugin "UptimePingPlatform/pkg/plugin"
+	pluginv1 "UptimePingPlatform/proto/api/plugin/v1"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// ProtocolVersion версия сервиса NotificationPlugin; меняется при несовместимых изменениях plugin.proto
+const ProtocolVersion = 1
+
+// describeTimeout ограничивает запрос описания плагина при загрузке
+const describeTimeout = 5 * time.Second
+
+// Config настройки загрузки плагинов
+type Config struct {
+	// Dir каталог с исполняемыми файлами плагинов
+	Dir          string
+	StartTimeout time.Duration
+	// Env переменные окружения плагинов в виде KEY=value
+	Env []string
+	// Timeout ограничивает одну отправку уведомления плагином
+	Timeout time.Duration
+}
+
+// SendError ошибка отправки, которую вернул плагин
+type SendError struct {
+	Message   string
+	Retryable bool
+}
+
+func (e *SendError) Error() string {
+	return e.Message
+}
+
+// Provider провайдер уведомлений во внешнем процессе. Канал провайдера задает сам плагин
+type Provider struct {
+	info    *pluginv1.DescribeResponse
+	client  pluginv1.NotificationPluginClient
+	process *pkg_plugin.Client
+	timeout time.Duration
+
+	sent   atomic.Int64
+	failed atomic.Int64
+}
+
+// NewProvider создает провайдер поверх клиента плагина, описанного info
+func NewProvider(info *pluginv1.DescribeResponse, client pluginv1.NotificationPluginClient, timeout time.Duration) *Provider {
+	return &Provider{info: info, client: client, timeout: timeout}
+}
+
+// Load запускает все плагины каталога cfg.Dir и запрашивает их описание. Плагин, который
+// не запустился или не описал канал, останавливается и пропускается с записью в журнал
+func Load(cfg Config, log logger.Logger) ([]*Provider, error) {
+	paths, err := pkg_plugin.Discover(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]*Provider, 0, len(paths))
+	for _, path := range paths {
+		provider, err := start(path, cfg, log)
+		if err != nil {
+			log.Error("Failed to load notification plugin", logger.String("path", path), logger.Error(err))
+			continue
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+// start запускает плагин и создает провайдер по его описанию
+func start(path string, cfg Config, log logger.Logger) (*Provider, error) {
+	process, err := pkg_plugin.Start(pkg_plugin.ClientConfig{
+		Path:            path,
+		Env:             cfg.Env,
+		ProtocolVersion: ProtocolVersion,
+		StartTimeout:    cfg.StartTimeout,
+		Logger:          log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client := pluginv1.NewNotificationPluginClient(process.Conn())
+	ctx, cancel := context.WithTimeout(context.Background(), describeTimeout)
+	defer cancel()
+	info, err := client.Describe(ctx, &pluginv1.DescribeRequest{})
+	if err != nil {
+		process.Kill()
+		return nil, fmt.Errorf("describe failed: %w", err)
+	}
+	if info.Channel == "" {
+		process.Kill()
+		return nil, fmt.Errorf("plugin %s did not report a channel", process.Name())
+	}
+
+	provider := NewProvider(info, client, cfg.Timeout)
+	provider.process = process
+	log.Info("Notification plugin loaded",
+		logger.String("plugin", process.Name()),
+		logger.String("channel", info.Channel),
+		logger.String("version", info.Version),
+	)
+	return provider, nil
+}
+
+// Send отправляет уведомление через плагин
+func (p *Provider) Send(ctx context.Context, notification *domain.Notification) error {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	resp, err := p.client.Send(ctx, &pluginv1.SendRequest{Notification: toProto(notification)})
+	if err != nil {
+		p.failed.Add(1)
+		code := status.Code(err)
+		return &SendError{
+			Message:   fmt.Sprintf("plugin %s: %v", p.info.Channel, err),
+			Retryable: code == codes.Unavailable || code == codes.DeadlineExceeded || code == codes.ResourceExhausted,
+		}
+	}
+	if resp.Error != "" {
+		p.failed.Add(1)
+		return &SendError{Message: fmt.Sprintf("plugin %s: %s", p.info.Channel, resp.Error), Retryable: resp.Retryable}
+	}
+
+	p.sent.Add(1)
+	return nil
+}
+
+// ShouldRetry повторяет только ошибки, которые плагин или транспорт отметили временными
+func (p *Provider) ShouldRetry(err error) bool {
+	sendErr, ok := err.(*SendError)
+	return ok && sendErr.Retryable
+}
+
+// GetType возвращает канал, который обслуживает плагин
+func (p *Provider) GetType() string {
+	return p.info.Channel
+}
+
+// IsHealthy проверяет, что процесс плагина работает и плагин отвечает готовностью
+func (p *Provider) IsHealthy(ctx context.Context) bool {
+	if p.process != nil && p.process.Exited() {
+		return false
+	}
+	resp, err := p.client.Health(ctx, &pluginv1.HealthRequest{})
+	return err == nil && resp.Healthy
+}
+
+// GetStats возвращает статистику провайдера
+func (p *Provider) GetStats() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    p.info.Channel,
+		"plugin":  true,
+		"version": p.info.Version,
+		"sent":    p.sent.Load(),
+		"failed":  p.failed.Load(),
+	}
+}
+
+// Close останавливает процесс плагина
+func (p *Provider) Close() error {
+	if p.process != nil {
+		p.process.Kill()
+	}
+	return nil
+}
+
+// toProto конвертирует уведомление для плагина; метаданные передаются строками
+func toProto(notification *domain.Notification) *pluginv1.Notification {
+	metadata := make(map[string]string, len(notification.Metadata))
+	for key, value := range notification.Metadata {
+		metadata[key] = fmt.Sprint(value)
+	}
+	return &pluginv1.Notification{
+		Id:            notification.ID,
+		TenantId:      notification.TenantID,
+		Type:          notification.Type,
+		Channel:       notification.Channel,
+		Recipient:     notification.Recipient,
+		Subject:       notification.Subject,
+		Body:          notification.Body,
+		Severity:      notification.Severity,
+		CreatedAt:     notification.CreatedAt.UTC().Format(time.RFC3339),
+		FormattedTime: notification.FormattedTime(),
+		Metadata:      metadata,
+	}
+}
@@ -0,0 +1,127 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"UptimePingPlatform/pkg/locale"
+	"UptimePingPlatform/pkg/logger"
+	pluginv1 "UptimePingPlatform/proto/api/plugin/v1"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+// fakePluginClient отвечает за плагин без запуска процесса
+type fakePluginClient struct {
+	requests []*pluginv1.SendRequest
+	response *pluginv1.SendResponse
+	err      error
+}
+
+func (c *fakePluginClient) Describe(ctx context.Context, in *pluginv1.DescribeRequest, opts ...grpc.CallOption) (*pluginv1.DescribeResponse, error) {
+	return &pluginv1.DescribeResponse{Channel: "pagerduty"}, nil
+}
+
+func (c *fakePluginClient) Send(ctx context.Context, in *pluginv1.SendRequest, opts ...grpc.CallOption) (*pluginv1.SendResponse, error) {
+	c.requests = append(c.requests, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.response != nil {
+		return c.response, nil
+	}
+	return &pluginv1.SendResponse{ExternalId: "PD-1"}, nil
+}
+
+func (c *fakePluginClient) Health(ctx context.Context, in *pluginv1.HealthRequest, opts ...grpc.CallOption) (*pluginv1.HealthResponse, error) {
+	return &pluginv1.HealthResponse{Healthy: true}, nil
+}
+
+func TestProvider_Send(t *testing.T) {
+	client := &fakePluginClient{}
+	provider := NewProvider(&pluginv1.DescribeResponse{Channel: "pagerduty", Version: "1.2.0"}, client, time.Second)
+
+	notification := &domain.Notification{
+		ID:        "n-1",
+		TenantID:  "tenant-1",
+		Channel:   "pagerduty",
+		Recipient: "team-infra",
+		Subject:   "API is down",
+		Severity:  domain.SeverityCritical,
+		CreatedAt: time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC),
+		Metadata:  map[string]interface{}{"attempt": 2},
+		Locale:    locale.Settings{Timezone: "Europe/Moscow", Locale: "ru"},
+	}
+	if err := provider.Send(context.Background(), notification); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if provider.GetType() != "pagerduty" || !provider.IsHealthy(context.Background()) {
+		t.Errorf("provider type = %q, want healthy pagerduty", provider.GetType())
+	}
+
+	sent := client.requests[0].Notification
+	if sent.Recipient != "team-infra" || sent.CreatedAt != "2026-10-01T09:30:00Z" || sent.Metadata["attempt"] != "2" {
+		t.Errorf("sent = %+v, want recipient, RFC3339 time and string metadata", sent)
+	}
+	if sent.FormattedTime != "01.10.2026 12:30:00 MSK" {
+		t.Errorf("FormattedTime = %q, want time in tenant timezone", sent.FormattedTime)
+	}
+	if stats := provider.GetStats(); stats["sent"] != int64(1) {
+		t.Errorf("stats = %v, want one sent", stats)
+	}
+}
+
+func TestProvider_SendErrorsAreClassified(t *testing.T) {
+	client := &fakePluginClient{response: &pluginv1.SendResponse{Error: "unknown team"}}
+	provider := NewProvider(&pluginv1.DescribeResponse{Channel: "pagerduty"}, client, time.Second)
+	notification := &domain.Notification{ID: "n-1"}
+
+	err := provider.Send(context.Background(), notification)
+	if err == nil || provider.ShouldRetry(err) {
+		t.Errorf("Send() error = %v, want permanent error", err)
+	}
+
+	client.response = &pluginv1.SendResponse{Error: "rate limited", Retryable: true}
+	if err := provider.Send(context.Background(), notification); err == nil || !provider.ShouldRetry(err) {
+		t.Errorf("Send() error = %v, want retryable error", err)
+	}
+
+	client.err = status.Error(codes.Unavailable, "plugin is restarting")
+	if err := provider.Send(context.Background(), notification); err == nil || !provider.ShouldRetry(err) {
+		t.Errorf("Send() error = %v, want retryable transport error", err)
+	}
+
+	client.err = status.Error(codes.InvalidArgument, "bad request")
+	if err := provider.Send(context.Background(), notification); err == nil || provider.ShouldRetry(err) {
+		t.Errorf("Send() error = %v, want permanent transport error", err)
+	}
+	if stats := provider.GetStats(); stats["failed"] != int64(4) {
+		t.Errorf("stats = %v, want four failures", stats)
+	}
+}
+
+func TestLoad_SkipsBrokenPlugins(t *testing.T) {
+	log, err := logger.NewLogger("test", "error", "notification-service", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "broken"), []byte("#!/bin/sh\nexit 1\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	providers, err := Load(Config{Dir: dir, StartTimeout: 5 * time.Second}, log)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(providers) != 0 {
+		t.Errorf("providers = %d, want broken plugin skipped", len(providers))
+	}
+}
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
+	"UptimePingPlatform/pkg/locale"
 	"UptimePingPlatform/pkg/logger"
 	pkg_logger "UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/notification-service/internal/domain"
@@ -27,6 +29,7 @@ type ProviderManager struct {
 	providers map[string]NotificationProvider
 	logger    logger.Logger
 	retryMgr  *retry.RetryManager
+	locales   *locale.Resolver
 }
 
 // ProviderConfig конфигурация провайдеров
@@ -67,8 +70,46 @@ func NewProviderManager(config ProviderConfig, logger logger.Logger) *ProviderMa
 	return manager
 }
 
+// WithLocales включает форматирование времени в уведомлениях в часовом поясе и языке тенанта
+func (pm *ProviderManager) WithLocales(resolver *locale.Resolver) *ProviderManager {
+	pm.locales = resolver
+	return pm
+}
+
+// WithProviders регистрирует дополнительные провайдеры, например плагины, под их типом.
+// Провайдер канала, который уже обслуживается, не регистрируется
+func (pm *ProviderManager) WithProviders(providers ...NotificationProvider) *ProviderManager {
+	for _, provider := range providers {
+		if _, exists := pm.providers[provider.GetType()]; exists {
+			pm.logger.Warn("Provider for channel is already registered, skipping",
+				logger.String("channel", provider.GetType()),
+			)
+			continue
+		}
+		pm.AddProvider(provider.GetType(), provider)
+	}
+	return pm
+}
+
+// Close освобождает ресурсы провайдеров, например останавливает процессы плагинов
+func (pm *ProviderManager) Close() {
+	for name, provider := range pm.providers {
+		closer, ok := provider.(io.Closer)
+		if !ok {
+			continue
+		}
+		if err := closer.Close(); err != nil {
+			pm.logger.Warn("Failed to close provider", logger.String("name", name), logger.Error(err))
+		}
+	}
+}
+
 // SendNotification отправляет уведомление через все подходящие провайдеры
 func (pm *ProviderManager) SendNotification(ctx context.Context, notification *domain.Notification) error {
+	if pm.locales != nil && notification.Locale == (locale.Settings{}) {
+		notification.Locale = pm.locales.Resolve(ctx, notification.TenantID)
+	}
+
 	pm.logger.Info("Sending notification",
 		logger.String("notification_id", notification.ID),
 		logger.String("channel", notification.Channel),
@@ -215,6 +256,10 @@ func (pm *ProviderManager) shouldRetryProvider(providerType string, err error) b
 		       !contains(errStr, "user_unknown") &&
 		       !contains(errStr, "authentication failed")
 	default:
+		// Провайдер, который сам классифицирует ошибки (например, плагин), решает о повторе
+		if classifier, ok := pm.providers[providerType].(interface{ ShouldRetry(error) bool }); ok {
+			return classifier.ShouldRetry(err)
+		}
 		// По умолчанию используем общую логику
 		return retry.IsRetryableError(err)
 	}
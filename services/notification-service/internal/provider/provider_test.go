@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"UptimePingPlatform/pkg/locale"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/notification-service/internal/domain"
 	email "UptimePingPlatform/services/notification-service/internal/provider/email"
@@ -128,6 +129,39 @@ func TestProviderManager(t *testing.T) {
 	}
 }
 
+func TestProviderManager_TenantLocale(t *testing.T) {
+	lookups := 0
+	resolver := locale.NewResolver(func(ctx context.Context, tenantID string) (locale.Settings, error) {
+		lookups++
+		return locale.Settings{Timezone: "Asia/Tokyo", Locale: "ru"}, nil
+	}, time.Minute)
+
+	manager := provider.NewProviderManager(provider.DefaultProviderConfig(), &MockLogger{}).WithLocales(resolver)
+	manager.AddProvider("mock", &MockProvider{name: "mock", healthy: true})
+
+	notification := &domain.Notification{
+		ID:        "test-locale",
+		Channel:   "mock",
+		TenantID:  "tenant-jp",
+		CreatedAt: time.Date(2024, 3, 1, 21, 30, 0, 0, time.UTC),
+	}
+	if err := manager.SendNotification(context.Background(), notification); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := notification.FormattedTime(); got != "02.03.2024 06:30:00 JST" {
+		t.Errorf("Expected time in tenant timezone, got %q", got)
+	}
+
+	// Явно заданная локаль уведомления не перезаписывается
+	explicit := &domain.Notification{ID: "test-explicit", Channel: "mock", TenantID: "tenant-jp", Locale: locale.Default()}
+	if err := manager.SendNotification(context.Background(), explicit); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if explicit.Locale != locale.Default() || lookups != 1 {
+		t.Errorf("Expected explicit locale to be kept, got %+v after %d lookups", explicit.Locale, lookups)
+	}
+}
+
 func TestTelegramProvider(t *testing.T) {
 	mockLogger := &MockLogger{}
 	
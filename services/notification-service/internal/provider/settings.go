@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"time"
+
+	"UptimePingPlatform/pkg/config"
+)
+
+// ConfigFromSettings переносит настройки провайдеров из конфигурации сервиса поверх значений по умолчанию
+func ConfigFromSettings(cfg config.ProvidersConfig) ProviderConfig {
+	providers := DefaultProviderConfig()
+
+	providers.Telegram.BotToken = cfg.Telegram.BotToken
+	if cfg.Telegram.APIURL != "" {
+		providers.Telegram.APIURL = cfg.Telegram.APIURL
+	}
+	providers.Telegram.Timeout = ParseTimeout(cfg.Telegram.Timeout, providers.Telegram.Timeout)
+
+	providers.Slack.BotToken = cfg.Slack.BotToken
+	providers.Slack.WebhookURL = cfg.Slack.WebhookURL
+	if cfg.Slack.APIURL != "" {
+		providers.Slack.APIURL = cfg.Slack.APIURL
+	}
+	providers.Slack.Timeout = ParseTimeout(cfg.Slack.Timeout, providers.Slack.Timeout)
+
+	providers.Email.SMTPHost = cfg.Email.SMTPHost
+	if cfg.Email.SMTPPort != 0 {
+		providers.Email.SMTPPort = cfg.Email.SMTPPort
+	}
+	providers.Email.Username = cfg.Email.Username
+	providers.Email.Password = cfg.Email.Password
+	providers.Email.FromAddress = cfg.Email.FromAddress
+	providers.Email.FromName = cfg.Email.FromName
+	providers.Email.UseStartTLS = cfg.Email.UseStartTLS
+	providers.Email.Timeout = ParseTimeout(cfg.Email.Timeout, providers.Email.Timeout)
+
+	return providers
+}
+
+// ParseTimeout разбирает длительность из конфигурации, при пустом или неверном значении возвращает fallback
+func ParseTimeout(value string, fallback time.Duration) time.Duration {
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return fallback
+	}
+	return timeout
+}
@@ -10,6 +10,7 @@ import (
 
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/notification-service/internal/domain"
+	"UptimePingPlatform/services/notification-service/internal/template"
 )
 
 // SlackProvider отправляет уведомления через Slack Web API
@@ -42,6 +43,7 @@ type Block struct {
 	Text *TextBlock  `json:"text,omitempty"`
 	Accessory *AccessoryBlock `json:"accessory,omitempty"`
 	Fields []FieldBlock `json:"fields,omitempty"`
+	Elements []AccessoryBlock `json:"elements,omitempty"`
 }
 
 // TextBlock структура текстового блока
@@ -57,6 +59,8 @@ type AccessoryBlock struct {
 	Text     *TextBlock `json:"text,omitempty"`
 	Value    string `json:"value,omitempty"`
 	Url      string `json:"url,omitempty"`
+	ActionID string `json:"action_id,omitempty"`
+	Style    string `json:"style,omitempty"`
 }
 
 // FieldBlock структура поля блока
@@ -76,6 +80,13 @@ type Attachment struct {
 	Footer    string  `json:"footer,omitempty"`
 }
 
+// Идентификаторы интерактивных кнопок в сообщениях об инцидентах
+const (
+	ActionAcknowledge = "incident_ack"
+	ActionResolve     = "incident_resolve"
+	ActionSnooze      = "incident_snooze"
+)
+
 // SlackResponse структура ответа Slack API
 type SlackResponse struct {
 	OK    bool   `json:"ok"`
@@ -280,22 +291,22 @@ func (p *SlackProvider) formatMessage(notification *domain.Notification) SlackMe
 	fields := []FieldBlock{
 		{
 			Type: "mrkdwn",
-			Text: fmt.Sprintf("*Type:*\n%s", notification.Type),
+			Text: fmt.Sprintf("*%s:*\n%s", template.Messages.Translate(notification.Locale.Locale, "type"), notification.Type),
 			Short: true,
 		},
 		{
 			Type: "mrkdwn",
-			Text: fmt.Sprintf("*Severity:*\n%s", notification.Severity),
+			Text: fmt.Sprintf("*%s:*\n%s", template.Messages.Translate(notification.Locale.Locale, "severity"), notification.Severity),
 			Short: true,
 		},
 		{
 			Type: "mrkdwn",
-			Text: fmt.Sprintf("*Time:*\n%s", notification.CreatedAt.Format("2006-01-02 15:04:05 UTC")),
+			Text: fmt.Sprintf("*%s:*\n%s", template.Messages.Translate(notification.Locale.Locale, "time"), notification.FormattedTime()),
 			Short: true,
 		},
 		{
 			Type: "mrkdwn",
-			Text: fmt.Sprintf("*Tenant:*\n%s", notification.TenantID),
+			Text: fmt.Sprintf("*%s:*\n%s", template.Messages.Translate(notification.Locale.Locale, "tenant"), notification.TenantID),
 			Short: true,
 		},
 	}
@@ -324,6 +335,11 @@ func (p *SlackProvider) formatMessage(notification *domain.Notification) SlackMe
 	}
 	blocks = append(blocks, fieldsBlock)
 
+	// Кнопки действий над инцидентом
+	if actionsBlock, ok := p.incidentActions(notification); ok {
+		blocks = append(blocks, actionsBlock)
+	}
+
 	// Разделитель
 	dividerBlock := Block{
 		Type: "divider",
@@ -347,6 +363,34 @@ func (p *SlackProvider) formatMessage(notification *domain.Notification) SlackMe
 	return message
 }
 
+// incidentActions формирует блок кнопок Ack / Resolve / Snooze для уведомления об открытом инциденте
+func (p *SlackProvider) incidentActions(notification *domain.Notification) (Block, bool) {
+	incidentID, _ := notification.Data["incident_id"].(string)
+	if incidentID == "" || notification.Type == domain.NotificationTypeIncidentResolved {
+		return Block{}, false
+	}
+
+	lang := notification.Locale.Locale
+	button := func(text, actionID, style string) AccessoryBlock {
+		return AccessoryBlock{
+			Type:     "button",
+			Text:     &TextBlock{Type: "plain_text", Text: text},
+			Value:    incidentID,
+			ActionID: actionID,
+			Style:    style,
+		}
+	}
+
+	return Block{
+		Type: "actions",
+		Elements: []AccessoryBlock{
+			button(template.Messages.Translate(lang, "action_acknowledge"), ActionAcknowledge, "primary"),
+			button(template.Messages.Translate(lang, "action_resolve"), ActionResolve, ""),
+			button(template.Messages.Translate(lang, "action_snooze"), ActionSnooze, ""),
+		},
+	}, true
+}
+
 // parseChannel парсит канал из строки
 func (p *SlackProvider) parseChannel(recipient string) string {
 	// Если начинается с #, это канал
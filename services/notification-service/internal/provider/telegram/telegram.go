@@ -11,6 +11,7 @@ import (
 
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/notification-service/internal/domain"
+	"UptimePingPlatform/services/notification-service/internal/template"
 )
 
 // TelegramProvider отправляет уведомления через Telegram Bot API
@@ -202,22 +203,23 @@ func (p *TelegramProvider) formatMessage(notification *domain.Notification) stri
 	// Основное сообщение
 	message.WriteString(fmt.Sprintf("%s\n\n", notification.Body))
 
-	// Метаданные
-	message.WriteString("<b>Details:</b>\n")
-	message.WriteString(fmt.Sprintf("• <b>Type:</b> %s\n", notification.Type))
-	message.WriteString(fmt.Sprintf("• <b>Severity:</b> %s\n", notification.Severity))
-	message.WriteString(fmt.Sprintf("• <b>Time:</b> %s\n", notification.CreatedAt.Format("2006-01-02 15:04:05 UTC")))
+	// Метаданные с подписями на языке уведомления
+	lang := notification.Locale.Locale
+	message.WriteString(fmt.Sprintf("<b>%s:</b>\n", template.Messages.Translate(lang, "details")))
+	message.WriteString(fmt.Sprintf("• <b>%s:</b> %s\n", template.Messages.Translate(lang, "type"), notification.Type))
+	message.WriteString(fmt.Sprintf("• <b>%s:</b> %s\n", template.Messages.Translate(lang, "severity"), notification.Severity))
+	message.WriteString(fmt.Sprintf("• <b>%s:</b> %s\n", template.Messages.Translate(lang, "time"), notification.FormattedTime()))
 
 	// Дополнительные данные если есть
 	if len(notification.Data) > 0 {
-		message.WriteString("\n<b>Additional Info:</b>\n")
+		message.WriteString(fmt.Sprintf("\n<b>%s:</b>\n", template.Messages.Translate(lang, "additional_information")))
 		for key, value := range notification.Data {
 			message.WriteString(fmt.Sprintf("• <b>%s:</b> %v\n", key, value))
 		}
 	}
 
 	// Подпись
-	message.WriteString("\n<i>Sent by UptimePing Platform</i>")
+	message.WriteString(fmt.Sprintf("\n<i>%s</i>", template.Messages.Translate(lang, "sent_by")))
 
 	return message.String()
 }
@@ -337,6 +339,41 @@ func (p *TelegramProvider) shouldRetry(err error) bool {
 	return false
 }
 
+// SetWebhook регистрирует адрес, на который Telegram доставляет входящие сообщения бота.
+// secret передается Telegram в заголовке X-Telegram-Bot-Api-Secret-Token каждого обновления
+func (p *TelegramProvider) SetWebhook(ctx context.Context, webhookURL, secret string) error {
+	payload, err := json.Marshal(map[string]interface{}{
+		"url":             webhookURL,
+		"secret_token":    secret,
+		"allowed_updates": []string{"message"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/bot%s/setWebhook", p.config.APIURL, p.config.BotToken)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var telegramResp TelegramResponse
+	if err := json.NewDecoder(resp.Body).Decode(&telegramResp); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if !telegramResp.OK {
+		return fmt.Errorf("Telegram API error: %d - %s", telegramResp.ErrorCode, telegramResp.Description)
+	}
+	return nil
+}
+
 // GetType возвращает тип провайдера
 func (p *TelegramProvider) GetType() string {
 	return "telegram"
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/notification-service/internal/service"
+)
+
+// channelColumns столбцы notification_channels в порядке scanChannel
+const channelColumns = "id, tenant_id, name, type, config, is_active, created_at, updated_at"
+
+// ChannelRepository реализация репозитория каналов уведомлений в PostgreSQL
+type ChannelRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewChannelRepository создает новый экземпляр ChannelRepository
+func NewChannelRepository(pool *pgxpool.Pool) service.ChannelRepository {
+	return &ChannelRepository{
+		pool: pool,
+	}
+}
+
+// Create сохраняет канал; ID и время создания назначает база данных
+func (r *ChannelRepository) Create(ctx context.Context, channel *service.Channel) error {
+	config, err := json.Marshal(channel.Config)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrValidation, "invalid channel config")
+	}
+
+	query := `
+		INSERT INTO notification_channels (tenant_id, name, type, config, is_active)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+
+	var createdAt, updatedAt time.Time
+	err = r.pool.QueryRow(ctx, query, channel.TenantID, channel.Name, channel.Type.String(), config, channel.IsActive).
+		Scan(&channel.ID, &createdAt, &updatedAt)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to create notification channel").
+			WithDetails(fmt.Sprintf("tenant_id: %s", channel.TenantID)).
+			WithContext(ctx)
+	}
+
+	channel.CreatedAt = createdAt.Format(time.RFC3339)
+	channel.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return nil
+}
+
+// GetByID возвращает канал по ID
+func (r *ChannelRepository) GetByID(ctx context.Context, id string) (*service.Channel, error) {
+	query := "SELECT " + channelColumns + " FROM notification_channels WHERE id = $1"
+
+	channel, err := scanChannel(r.pool.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, errors.New(errors.ErrNotFound, "notification channel not found").
+			WithDetails(fmt.Sprintf("channel_id: %s", id))
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get notification channel").
+			WithDetails(fmt.Sprintf("channel_id: %s", id)).
+			WithContext(ctx)
+	}
+	return channel, nil
+}
+
+// Delete удаляет канал по ID
+func (r *ChannelRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM notification_channels WHERE id = $1", id)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to delete notification channel").
+			WithDetails(fmt.Sprintf("channel_id: %s", id)).
+			WithContext(ctx)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.New(errors.ErrNotFound, "notification channel not found").
+			WithDetails(fmt.Sprintf("channel_id: %s", id))
+	}
+	return nil
+}
+
+// List возвращает каналы арендатора, при указанном типе - только этого типа
+func (r *ChannelRepository) List(ctx context.Context, tenantID string, channelType service.ChannelType) ([]*service.Channel, error) {
+	query := "SELECT " + channelColumns + " FROM notification_channels WHERE tenant_id = $1"
+	args := []interface{}{tenantID}
+	if channelType != service.ChannelTypeUnspecified {
+		query += " AND type = $2"
+		args = append(args, channelType.String())
+	}
+	query += " ORDER BY created_at ASC"
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list notification channels").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	var channels []*service.Channel
+	for rows.Next() {
+		channel, err := scanChannel(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan notification channel").
+				WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+				WithContext(ctx)
+		}
+		channels = append(channels, channel)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate notification channels").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return channels, nil
+}
+
+// ListAfter возвращает до limit каналов всех арендаторов с ID больше afterID в порядке ID
+func (r *ChannelRepository) ListAfter(ctx context.Context, afterID string, limit int) ([]*service.Channel, error) {
+	if afterID == "" {
+		afterID = "00000000-0000-0000-0000-000000000000"
+	}
+	query := "SELECT " + channelColumns + " FROM notification_channels WHERE id > $1 ORDER BY id LIMIT $2"
+
+	rows, err := r.pool.Query(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list notification channels").
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	var channels []*service.Channel
+	for rows.Next() {
+		channel, err := scanChannel(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan notification channel").
+				WithContext(ctx)
+		}
+		channels = append(channels, channel)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate notification channels").
+			WithContext(ctx)
+	}
+	return channels, nil
+}
+
+// UpdateConfig заменяет конфигурацию канала
+func (r *ChannelRepository) UpdateConfig(ctx context.Context, id string, config map[string]string) error {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrValidation, "invalid channel config")
+	}
+
+	query := "UPDATE notification_channels SET config = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $1"
+	if _, err := r.pool.Exec(ctx, query, id, data); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to update notification channel").
+			WithDetails(fmt.Sprintf("channel_id: %s", id)).
+			WithContext(ctx)
+	}
+	return nil
+}
+
+// scanChannel читает строку notification_channels в порядке channelColumns
+func scanChannel(row pgx.Row) (*service.Channel, error) {
+	var (
+		channel              service.Channel
+		channelType          string
+		config               []byte
+		createdAt, updatedAt time.Time
+	)
+	if err := row.Scan(&channel.ID, &channel.TenantID, &channel.Name, &channelType, &config, &channel.IsActive, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	if len(config) > 0 {
+		if err := json.Unmarshal(config, &channel.Config); err != nil {
+			return nil, err
+		}
+	}
+	channel.Type = service.ParseChannelType(channelType)
+	channel.CreatedAt = createdAt.Format(time.RFC3339)
+	channel.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return &channel, nil
+}
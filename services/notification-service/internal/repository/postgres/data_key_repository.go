@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/notification-service/internal/secrets"
+)
+
+// DataKeyRepository хранилище ключей данных арендаторов в PostgreSQL
+type DataKeyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDataKeyRepository создает новый экземпляр DataKeyRepository
+func NewDataKeyRepository(pool *pgxpool.Pool) secrets.DataKeyStore {
+	return &DataKeyRepository{
+		pool: pool,
+	}
+}
+
+// GetDataKey возвращает зашифрованный ключ данных арендатора
+func (r *DataKeyRepository) GetDataKey(ctx context.Context, tenantID string) ([]byte, error) {
+	var wrapped []byte
+	err := r.pool.QueryRow(ctx, "SELECT wrapped_key FROM notification_data_keys WHERE tenant_id = $1", tenantID).
+		Scan(&wrapped)
+	if err == pgx.ErrNoRows {
+		return nil, secrets.ErrDataKeyNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get tenant data key").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+	return wrapped, nil
+}
+
+// CreateDataKey сохраняет ключ данных; существующий ключ арендатора не перезаписывается
+func (r *DataKeyRepository) CreateDataKey(ctx context.Context, tenantID string, wrapped []byte) error {
+	query := `
+		INSERT INTO notification_data_keys (tenant_id, wrapped_key)
+		VALUES ($1, $2)
+		ON CONFLICT (tenant_id) DO NOTHING
+	`
+	if _, err := r.pool.Exec(ctx, query, tenantID, wrapped); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to create tenant data key").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/notification-service/internal/service"
+)
+
+// DeliveryRepository реализация журнала доставки уведомлений в PostgreSQL
+type DeliveryRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeliveryRepository создает новый экземпляр DeliveryRepository
+func NewDeliveryRepository(pool *pgxpool.Pool) service.DeliveryRepository {
+	return &DeliveryRepository{
+		pool: pool,
+	}
+}
+
+// Save сохраняет результат отправки уведомления в канал
+func (r *DeliveryRepository) Save(ctx context.Context, delivery *service.Delivery) error {
+	query := `
+		INSERT INTO notification_deliveries
+			(id, tenant_id, incident_id, channel_id, channel_type, recipient, subject, body, status, error, created_at, sent_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''), $11, $12)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		delivery.ID, delivery.TenantID, delivery.IncidentID, delivery.ChannelID, delivery.ChannelType,
+		delivery.Recipient, delivery.Subject, delivery.Body, delivery.Status, delivery.Error,
+		delivery.CreatedAt, delivery.SentAt,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to save notification delivery").
+			WithDetails(fmt.Sprintf("delivery_id: %s, channel_id: %s", delivery.ID, delivery.ChannelID)).
+			WithContext(ctx)
+	}
+	return nil
+}
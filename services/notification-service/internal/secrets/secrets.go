@@ -0,0 +1,286 @@
+// Package secrets шифрует секреты конфигурации каналов уведомлений (токены ботов, пароли SMTP,
+// адреса webhook) ключами данных арендаторов. Ключ данных арендатора хранится в базе
+// зашифрованным мастер-ключом сервиса, мастер-ключ в базу не попадает
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"UptimePingPlatform/pkg/errors"
+)
+
+// KeySize размер мастер-ключа и ключей данных (AES-256)
+const KeySize = 32
+
+// encryptedPrefix префикс зашифрованного значения; версия позволяет сменить формат
+const encryptedPrefix = "enc:v1:"
+
+// maskedValue замена секрета в ответах API
+const maskedValue = "********"
+
+// ErrDataKeyNotFound у арендатора еще нет ключа данных
+var ErrDataKeyNotFound = stderrors.New("data key not found")
+
+// secretKeys ключи конфигурации канала, которые всегда считаются секретами
+var secretKeys = map[string]bool{
+	"token":       true,
+	"password":    true,
+	"secret":      true,
+	"api_key":     true,
+	"webhook_url": true,
+}
+
+// secretSuffixes суффиксы ключей конфигурации с секретами: bot_token, smtp_password, signing_secret
+var secretSuffixes = []string{"_token", "_password", "_secret", "_api_key"}
+
+// IsSecretKey проверяет, хранит ли ключ конфигурации канала секрет
+func IsSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	if secretKeys[key] {
+		return true
+	}
+	for _, suffix := range secretSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEncrypted проверяет, зашифровано ли значение
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+// Mask скрывает значение секрета; у длинных значений остаются последние 4 символа,
+// чтобы секрет можно было узнать
+func Mask(value string) string {
+	switch {
+	case value == "":
+		return ""
+	case IsEncrypted(value) || len(value) < 12:
+		return maskedValue
+	default:
+		return maskedValue + value[len(value)-4:]
+	}
+}
+
+// MaskConfig возвращает копию конфигурации канала со скрытыми секретами
+func MaskConfig(config map[string]string) map[string]string {
+	if config == nil {
+		return nil
+	}
+	masked := make(map[string]string, len(config))
+	for key, value := range config {
+		if IsSecretKey(key) {
+			value = Mask(value)
+		}
+		masked[key] = value
+	}
+	return masked
+}
+
+// DataKeyStore хранилище ключей данных арендаторов, зашифрованных мастер-ключом
+type DataKeyStore interface {
+	// GetDataKey возвращает зашифрованный ключ данных или ErrDataKeyNotFound
+	GetDataKey(ctx context.Context, tenantID string) ([]byte, error)
+
+	// CreateDataKey сохраняет ключ данных; если ключ уже создан другой репликой, сохраненный не меняется
+	CreateDataKey(ctx context.Context, tenantID string, wrapped []byte) error
+}
+
+// Vault шифрует и расшифровывает секреты каналов ключами данных арендаторов
+type Vault struct {
+	master cipher.AEAD
+	keys   DataKeyStore
+
+	mu    sync.Mutex
+	cache map[string]cipher.AEAD
+}
+
+// NewVault создает Vault с мастер-ключом длины KeySize
+func NewVault(masterKey []byte, keys DataKeyStore) (*Vault, error) {
+	master, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "invalid secrets master key")
+	}
+	return &Vault{
+		master: master,
+		keys:   keys,
+		cache:  make(map[string]cipher.AEAD),
+	}, nil
+}
+
+// ParseMasterKey разбирает мастер-ключ в base64
+func ParseMasterKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrValidation, "secrets master key must be base64")
+	}
+	if len(key) != KeySize {
+		return nil, errors.New(errors.ErrValidation, fmt.Sprintf("secrets master key must be %d bytes", KeySize))
+	}
+	return key, nil
+}
+
+// EncryptConfig возвращает копию конфигурации канала с зашифрованными секретами.
+// Уже зашифрованные и пустые значения не меняются
+func (v *Vault) EncryptConfig(ctx context.Context, tenantID string, config map[string]string) (map[string]string, error) {
+	return v.transform(ctx, tenantID, config, func(aead cipher.AEAD, value string) (string, error) {
+		if value == "" || IsEncrypted(value) {
+			return value, nil
+		}
+		return seal(aead, tenantID, value)
+	})
+}
+
+// DecryptConfig возвращает копию конфигурации канала с расшифрованными секретами.
+// Значения без префикса шифрования (каналы, созданные до шифрования) возвращаются как есть
+func (v *Vault) DecryptConfig(ctx context.Context, tenantID string, config map[string]string) (map[string]string, error) {
+	return v.transform(ctx, tenantID, config, func(aead cipher.AEAD, value string) (string, error) {
+		if !IsEncrypted(value) {
+			return value, nil
+		}
+		return open(aead, tenantID, value)
+	})
+}
+
+// transform применяет fn к секретам конфигурации; ключ данных запрашивается только при наличии секретов
+func (v *Vault) transform(ctx context.Context, tenantID string, config map[string]string, fn func(cipher.AEAD, string) (string, error)) (map[string]string, error) {
+	if config == nil {
+		return nil, nil
+	}
+	result := make(map[string]string, len(config))
+	var aead cipher.AEAD
+	for key, value := range config {
+		if IsSecretKey(key) && value != "" {
+			if aead == nil {
+				var err error
+				if aead, err = v.dataKey(ctx, tenantID); err != nil {
+					return nil, err
+				}
+			}
+			transformed, err := fn(aead, value)
+			if err != nil {
+				return nil, errors.Wrap(err, errors.ErrInternal, "failed to process channel secret").
+					WithDetails(fmt.Sprintf("tenant_id: %s, key: %s", tenantID, key))
+			}
+			value = transformed
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+// dataKey возвращает ключ данных арендатора, при отсутствии создает его
+func (v *Vault) dataKey(ctx context.Context, tenantID string) (cipher.AEAD, error) {
+	v.mu.Lock()
+	aead, ok := v.cache[tenantID]
+	v.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	wrapped, err := v.keys.GetDataKey(ctx, tenantID)
+	if stderrors.Is(err, ErrDataKeyNotFound) {
+		if err = v.createDataKey(ctx, tenantID); err != nil {
+			return nil, err
+		}
+		// Параллельно ключ могла создать другая реплика, используется сохраненный
+		wrapped, err = v.keys.GetDataKey(ctx, tenantID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to load tenant data key").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID))
+	}
+
+	nonceSize := v.master.NonceSize()
+	if len(wrapped) < nonceSize {
+		return nil, errors.New(errors.ErrInternal, "tenant data key is corrupted").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID))
+	}
+	key, err := v.master.Open(nil, wrapped[:nonceSize], wrapped[nonceSize:], dataKeyAAD(tenantID))
+	if err != nil {
+		// Ключ зашифрован другим мастер-ключом или поврежден
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to unwrap tenant data key").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID))
+	}
+	if aead, err = newAEAD(key); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "invalid tenant data key")
+	}
+
+	v.mu.Lock()
+	v.cache[tenantID] = aead
+	v.mu.Unlock()
+	return aead, nil
+}
+
+// createDataKey генерирует ключ данных арендатора и сохраняет его зашифрованным мастер-ключом
+func (v *Vault) createDataKey(ctx context.Context, tenantID string) error {
+	key := make([]byte, KeySize)
+	nonce := make([]byte, v.master.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to generate tenant data key")
+	}
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to generate tenant data key")
+	}
+	wrapped := v.master.Seal(nonce, nonce, key, dataKeyAAD(tenantID))
+	if err := v.keys.CreateDataKey(ctx, tenantID, wrapped); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to save tenant data key").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID))
+	}
+	return nil
+}
+
+// dataKeyAAD привязывает зашифрованный ключ данных к арендатору: ключ нельзя подменить ключом другого арендатора
+func dataKeyAAD(tenantID string) []byte {
+	return []byte("data-key:" + tenantID)
+}
+
+// seal шифрует значение; арендатор входит в AAD, поэтому значение нельзя перенести в канал другого арендатора
+func seal(aead cipher.AEAD, tenantID, value string) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(tenantID))
+	return encryptedPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// open расшифровывает значение, зашифрованное seal
+func open(aead cipher.AEAD, tenantID, value string) (string, error) {
+	sealed, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, encryptedPrefix))
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", stderrors.New("encrypted value is too short")
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(tenantID))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// newAEAD создает AES-GCM для ключа длины KeySize
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,160 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+// memoryKeys хранилище ключей данных в памяти
+type memoryKeys struct {
+	keys    map[string][]byte
+	created int
+}
+
+func (s *memoryKeys) GetDataKey(ctx context.Context, tenantID string) ([]byte, error) {
+	wrapped, ok := s.keys[tenantID]
+	if !ok {
+		return nil, ErrDataKeyNotFound
+	}
+	return wrapped, nil
+}
+
+func (s *memoryKeys) CreateDataKey(ctx context.Context, tenantID string, wrapped []byte) error {
+	if _, ok := s.keys[tenantID]; !ok {
+		s.keys[tenantID] = wrapped
+		s.created++
+	}
+	return nil
+}
+
+func newTestVault(t *testing.T, keys *memoryKeys) *Vault {
+	t.Helper()
+	vault, err := NewVault(bytes.Repeat([]byte{7}, KeySize), keys)
+	if err != nil {
+		t.Fatalf("NewVault() error = %v", err)
+	}
+	return vault
+}
+
+func TestVault_EncryptDecryptConfig(t *testing.T) {
+	keys := &memoryKeys{keys: map[string][]byte{}}
+	vault := newTestVault(t, keys)
+	ctx := context.Background()
+
+	config := map[string]string{
+		"chat_id":       "-100123",
+		"bot_token":     "123456:ABC-DEF",
+		"smtp_password": "hunter2",
+		"webhook_url":   "",
+	}
+	sealed, err := vault.EncryptConfig(ctx, "tenant-1", config)
+	if err != nil {
+		t.Fatalf("EncryptConfig() error = %v", err)
+	}
+	if sealed["chat_id"] != "-100123" || sealed["webhook_url"] != "" {
+		t.Errorf("non-secret and empty values must not change, got %v", sealed)
+	}
+	for _, key := range []string{"bot_token", "smtp_password"} {
+		if !IsEncrypted(sealed[key]) || strings.Contains(sealed[key], config[key]) {
+			t.Errorf("%s is not encrypted: %q", key, sealed[key])
+		}
+	}
+	if config["bot_token"] != "123456:ABC-DEF" {
+		t.Error("EncryptConfig() must not modify the input config")
+	}
+
+	// Повторное шифрование не оборачивает значение второй раз
+	again, err := vault.EncryptConfig(ctx, "tenant-1", sealed)
+	if err != nil || again["bot_token"] != sealed["bot_token"] {
+		t.Errorf("EncryptConfig() of sealed config = %v, %v", again, err)
+	}
+
+	opened, err := vault.DecryptConfig(ctx, "tenant-1", sealed)
+	if err != nil {
+		t.Fatalf("DecryptConfig() error = %v", err)
+	}
+	for key, value := range config {
+		if opened[key] != value {
+			t.Errorf("DecryptConfig()[%s] = %q, want %q", key, opened[key], value)
+		}
+	}
+
+	// Ключ данных создается один раз и переживает перезапуск сервиса
+	restarted := newTestVault(t, keys)
+	if opened, err = restarted.DecryptConfig(ctx, "tenant-1", sealed); err != nil || opened["bot_token"] != config["bot_token"] {
+		t.Errorf("DecryptConfig() after restart = %v, %v", opened, err)
+	}
+	if keys.created != 1 {
+		t.Errorf("data keys created = %d, want 1", keys.created)
+	}
+}
+
+func TestVault_TenantIsolation(t *testing.T) {
+	keys := &memoryKeys{keys: map[string][]byte{}}
+	vault := newTestVault(t, keys)
+	ctx := context.Background()
+
+	sealed, err := vault.EncryptConfig(ctx, "tenant-1", map[string]string{"bot_token": "123456:ABC-DEF"})
+	if err != nil {
+		t.Fatalf("EncryptConfig() error = %v", err)
+	}
+	// Значение, перенесенное в канал другого арендатора, не расшифровывается
+	if _, err := vault.DecryptConfig(ctx, "tenant-2", sealed); err == nil {
+		t.Error("DecryptConfig() with foreign tenant must fail")
+	}
+
+	// Ключ данных, зашифрованный для другого арендатора, не принимается
+	keys.keys["tenant-3"] = keys.keys["tenant-1"]
+	if _, err := newTestVault(t, keys).DecryptConfig(ctx, "tenant-3", sealed); err == nil {
+		t.Error("DecryptConfig() with substituted data key must fail")
+	}
+
+	// Другой мастер-ключ не открывает ключи данных
+	other, _ := NewVault(bytes.Repeat([]byte{8}, KeySize), keys)
+	if _, err := other.DecryptConfig(ctx, "tenant-1", sealed); err == nil {
+		t.Error("DecryptConfig() with another master key must fail")
+	}
+}
+
+func TestVault_LegacyPlaintext(t *testing.T) {
+	keys := &memoryKeys{keys: map[string][]byte{}}
+	opened, err := newTestVault(t, keys).DecryptConfig(context.Background(), "tenant-1", map[string]string{"bot_token": "plain"})
+	if err != nil || opened["bot_token"] != "plain" {
+		t.Errorf("DecryptConfig() of plaintext = %v, %v", opened, err)
+	}
+}
+
+func TestMaskConfig(t *testing.T) {
+	masked := MaskConfig(map[string]string{
+		"chat_id":       "-100123",
+		"bot_token":     "123456:ABC-DEFGHIJ",
+		"smtp_password": "short",
+		"webhook_url":   "enc:v1:AAAA",
+		"api_key":       "",
+	})
+	want := map[string]string{
+		"chat_id":       "-100123",
+		"bot_token":     "********GHIJ",
+		"smtp_password": "********",
+		"webhook_url":   "********",
+		"api_key":       "",
+	}
+	for key, value := range want {
+		if masked[key] != value {
+			t.Errorf("MaskConfig()[%s] = %q, want %q", key, masked[key], value)
+		}
+	}
+}
+
+func TestParseMasterKey(t *testing.T) {
+	if _, err := ParseMasterKey("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="); err != nil {
+		t.Errorf("ParseMasterKey() error = %v", err)
+	}
+	for _, encoded := range []string{"not base64!", "AAECAw=="} {
+		if _, err := ParseMasterKey(encoded); err == nil {
+			t.Errorf("ParseMasterKey(%q) must fail", encoded)
+		}
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"time"
 
 	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
 	"UptimePingPlatform/services/notification-service/internal/domain"
 	processor "UptimePingPlatform/services/notification-service/internal/processor"
 )
@@ -234,6 +236,9 @@ type WebhookSender struct {
 type WebhookConfig struct {
 	URL     string        `json:"url" yaml:"url"`
 	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+	// SigningSecret секрет подписи HMAC-SHA256 (заголовки X-UptimePing-Timestamp и
+	// X-UptimePing-Signature); пустой - webhook отправляются без подписи
+	SigningSecret string `json:"signing_secret" yaml:"signing_secret"`
 }
 
 // NewWebhookSender создает новый webhook отправщик
@@ -291,6 +296,9 @@ func (s *WebhookSender) Send(ctx context.Context, notification *domain.Notificat
 	req.Header.Set("X-Event-ID", notification.EventID)
 	req.Header.Set("X-Tenant-ID", notification.TenantID)
 	req.Header.Set("X-Severity", notification.Severity)
+	if s.config.SigningSecret != "" {
+		webhooks.NewSigner(s.config.SigningSecret).SignRequest(req, jsonData)
+	}
 
 	// Отправка запроса
 	resp, err := client.Do(req)
@@ -408,8 +416,9 @@ func (f *SenderFactory) CreateSenders() map[string]processor.NotificationSender
 
 	// Webhook отправщик
 	webhookConfig := WebhookConfig{
-		URL:     "https://webhook.example.com/notifications",
-		Timeout: 10 * time.Second,
+		URL:           "https://webhook.example.com/notifications",
+		Timeout:       10 * time.Second,
+		SigningSecret: os.Getenv("WEBHOOK_SIGNING_SECRET"),
 	}
 	senders[domain.ChannelWebhook] = NewWebhookSender(webhookConfig, f.logger)
 
@@ -0,0 +1,43 @@
+package sender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/webhooks"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+func TestWebhookSender_SignsPayload(t *testing.T) {
+	verifier := webhooks.NewVerifier("webhook-secret")
+	var verifyErr error
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		verifyErr = verifier.Verify(r.Context(), r.Header, body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	log, err := logger.NewLogger("test", "debug", "notification-service", false)
+	if err != nil {
+		t.Fatalf("NewLogger() error = %v", err)
+	}
+	sender := NewWebhookSender(WebhookConfig{Timeout: 5 * time.Second, SigningSecret: "webhook-secret"}, log)
+
+	err = sender.Send(context.Background(), &domain.Notification{
+		ID:        "notification-1",
+		Recipient: server.URL,
+		Subject:   "Incident opened",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if verifyErr != nil {
+		t.Errorf("receiver rejected signature: %v", verifyErr)
+	}
+}
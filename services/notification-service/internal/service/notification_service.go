@@ -3,35 +3,47 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/locale"
 	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+	"UptimePingPlatform/services/notification-service/internal/secrets"
+	"UptimePingPlatform/services/notification-service/internal/template"
 )
 
 // NotificationService предоставляет бизнес-логику для работы с уведомлениями
 type NotificationService interface {
 	// SendNotification отправляет уведомление через указанные каналы
 	SendNotification(ctx context.Context, notification *Notification) ([]*SendResult, error)
-	
+
 	// RegisterChannel регистрирует новый канал уведомлений
 	RegisterChannel(ctx context.Context, channel *Channel) (*Channel, error)
-	
+
 	// UnregisterChannel удаляет канал уведомлений
 	UnregisterChannel(ctx context.Context, channelID string) error
-	
-	// ListChannels возвращает список каналов уведомлений
+
+	// ListChannels возвращает список каналов уведомлений со скрытыми секретами конфигурации
 	ListChannels(ctx context.Context, tenantID string, channelType ChannelType) ([]*Channel, error)
+
+	// RevealChannel возвращает канал арендатора с расшифрованными секретами; userID попадает в аудит
+	RevealChannel(ctx context.Context, tenantID, channelID, userID string) (*Channel, error)
 }
 
 // Notification представляет уведомление
 type Notification struct {
-	TenantID   string            `json:"tenant_id"`
-	IncidentID string            `json:"incident_id"`
+	TenantID   string               `json:"tenant_id"`
+	IncidentID string               `json:"incident_id"`
 	Severity   NotificationSeverity `json:"severity"`
-	Title      string            `json:"title"`
-	Message    string            `json:"message"`
-	ChannelIDs []string          `json:"channel_ids"`
-	Metadata   map[string]string `json:"metadata,omitempty"`
+	Title      string               `json:"title"`
+	Message    string               `json:"message"`
+	ChannelIDs []string             `json:"channel_ids"`
+	Metadata   map[string]string    `json:"metadata,omitempty"`
 }
 
 // NotificationSeverity определяет серьезность уведомления
@@ -44,6 +56,20 @@ const (
 	NotificationSeverityCritical
 )
 
+// String возвращает уровень серьезности в терминах провайдеров
+func (s NotificationSeverity) String() string {
+	switch s {
+	case NotificationSeverityWarning:
+		return domain.SeverityMedium
+	case NotificationSeverityError:
+		return domain.SeverityHigh
+	case NotificationSeverityCritical:
+		return domain.SeverityCritical
+	default:
+		return domain.SeverityLow
+	}
+}
+
 // Channel представляет канал уведомлений
 type Channel struct {
 	ID        string            `json:"id"`
@@ -64,8 +90,76 @@ const (
 	ChannelTypeTelegram
 	ChannelTypeSlack
 	ChannelTypeEmail
+	// ChannelTypePlugin канал внешнего провайдера; обслуживающий его плагин задан в config.plugin
+	ChannelTypePlugin
+)
+
+// Ключи конфигурации канала с адресатом уведомления
+const (
+	ChannelConfigChatID       = "chat_id"
+	ChannelConfigSlackChannel = "channel"
+	ChannelConfigEmail        = "email"
+	ChannelConfigPlugin       = "plugin"
+	ChannelConfigRecipient    = "recipient"
 )
 
+// ChannelConfigLocale ключ конфигурации канала с языком уведомлений; без него используется язык тенанта
+const ChannelConfigLocale = "locale"
+
+// MetadataEventType ключ метаданных уведомления с типом события для выбора шаблона
+const MetadataEventType = "event_type"
+
+// String возвращает имя провайдера, обслуживающего канал
+func (t ChannelType) String() string {
+	switch t {
+	case ChannelTypeTelegram:
+		return "telegram"
+	case ChannelTypeSlack:
+		return domain.ChannelSlack
+	case ChannelTypeEmail:
+		return domain.ChannelEmail
+	case ChannelTypePlugin:
+		return "plugin"
+	default:
+		return "unspecified"
+	}
+}
+
+// ParseChannelType разбирает имя типа канала; неизвестное имя дает ChannelTypeUnspecified
+func ParseChannelType(name string) ChannelType {
+	for _, channelType := range []ChannelType{ChannelTypeTelegram, ChannelTypeSlack, ChannelTypeEmail, ChannelTypePlugin} {
+		if channelType.String() == name {
+			return channelType
+		}
+	}
+	return ChannelTypeUnspecified
+}
+
+// recipientKey ключ конфигурации канала с адресатом уведомления
+func (t ChannelType) recipientKey() string {
+	switch t {
+	case ChannelTypeTelegram:
+		return ChannelConfigChatID
+	case ChannelTypeSlack:
+		return ChannelConfigSlackChannel
+	case ChannelTypeEmail:
+		return ChannelConfigEmail
+	case ChannelTypePlugin:
+		return ChannelConfigRecipient
+	default:
+		return ""
+	}
+}
+
+// ProviderName возвращает провайдера, который доставляет уведомления канала: для канала
+// плагина - канал плагина из конфигурации, для остальных - тип канала
+func (c *Channel) ProviderName() string {
+	if c.Type == ChannelTypePlugin {
+		return c.Config[ChannelConfigPlugin]
+	}
+	return c.Type.String()
+}
+
 // SendResult содержит результат отправки в конкретный канал
 type SendResult struct {
 	ChannelID string `json:"channel_id"`
@@ -73,20 +167,50 @@ type SendResult struct {
 	Error     string `json:"error,omitempty"`
 }
 
+// Sender отправляет подготовленное уведомление через провайдера канала.
+// Реализуется provider.ProviderManager
+type Sender interface {
+	SendNotification(ctx context.Context, notification *domain.Notification) error
+}
+
+// ChannelSecrets шифрует секреты конфигурации каналов ключами данных арендаторов.
+// Реализуется secrets.Vault
+type ChannelSecrets interface {
+	EncryptConfig(ctx context.Context, tenantID string, config map[string]string) (map[string]string, error)
+	DecryptConfig(ctx context.Context, tenantID string, config map[string]string) (map[string]string, error)
+}
+
 // notificationService реализация NotificationService
 type notificationService struct {
-	logger logger.Logger
-	// Здесь можно добавить зависимости: репозитории, клиенты для отправки и т.д.
+	channels   ChannelRepository
+	deliveries DeliveryRepository
+	sender     Sender
+	templates  template.TemplateManager
+	secrets    ChannelSecrets
+	locales    *locale.Resolver
+	logger     logger.Logger
+	now        func() time.Time
 }
 
-// NewNotificationService создает новый экземпляр NotificationService
-func NewNotificationService(logger logger.Logger) NotificationService {
+// NewNotificationService создает новый экземпляр NotificationService. При channelSecrets == nil
+// секреты каналов хранятся открытыми, но в ответах API по-прежнему скрываются. При locales == nil
+// уведомления без языка в конфигурации канала рендерятся на английском в UTC
+func NewNotificationService(channels ChannelRepository, deliveries DeliveryRepository, sender Sender, templates template.TemplateManager, channelSecrets ChannelSecrets, locales *locale.Resolver, logger logger.Logger) NotificationService {
 	return &notificationService{
-		logger: logger,
+		channels:   channels,
+		deliveries: deliveries,
+		sender:     sender,
+		templates:  templates,
+		secrets:    channelSecrets,
+		locales:    locales,
+		logger:     logger,
+		now:        time.Now,
 	}
 }
 
-// SendNotification отправляет уведомление через указанные каналы
+// SendNotification отправляет уведомление через указанные каналы, а без них - во все
+// активные каналы арендатора. Ошибка отправки в канал попадает в его результат,
+// ошибка запроса (неизвестный канал, нет каналов) возвращается целиком
 func (s *notificationService) SendNotification(ctx context.Context, notification *Notification) ([]*SendResult, error) {
 	s.logger.Info("Sending notification",
 		logger.String("tenant_id", notification.TenantID),
@@ -95,45 +219,183 @@ func (s *notificationService) SendNotification(ctx context.Context, notification
 		logger.String("title", notification.Title),
 		logger.String("channel_ids", fmt.Sprintf("%v", notification.ChannelIDs)))
 
-	results := make([]*SendResult, 0, len(notification.ChannelIDs))
+	channels, err := s.resolveChannels(ctx, notification)
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		return nil, errors.New(errors.ErrValidation, "no active notification channels").
+			WithDetails(fmt.Sprintf("tenant_id: %s", notification.TenantID))
+	}
+
+	results := make([]*SendResult, 0, len(channels))
+	for _, channel := range channels {
+		opened, err := s.openChannel(ctx, channel)
+		if err != nil {
+			results = append(results, &SendResult{ChannelID: channel.ID, Error: err.Error()})
+			s.logger.Error("Failed to decrypt notification channel secrets",
+				logger.String("channel_id", channel.ID),
+				logger.Error(err))
+			continue
+		}
+		results = append(results, s.sendToChannel(ctx, opened, notification))
+	}
+
+	s.logger.Info("Notification sent",
+		logger.String("tenant_id", notification.TenantID),
+		logger.Int("channels_sent", len(results)))
 
-	// Если каналы не указаны, отправляем во все активные каналы тенанта
-	channelIDs := notification.ChannelIDs
-	if len(channelIDs) == 0 {
-		channels, err := s.ListChannels(ctx, notification.TenantID, ChannelTypeUnspecified)
+	return results, nil
+}
+
+// resolveChannels возвращает каналы для отправки; явно указанный канал обязан
+// существовать и принадлежать арендатору
+func (s *notificationService) resolveChannels(ctx context.Context, notification *Notification) ([]*Channel, error) {
+	if len(notification.ChannelIDs) == 0 {
+		channels, err := s.channels.List(ctx, notification.TenantID, ChannelTypeUnspecified)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get channels: %w", err)
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to list notification channels")
 		}
 
+		active := make([]*Channel, 0, len(channels))
 		for _, channel := range channels {
 			if channel.IsActive {
-				channelIDs = append(channelIDs, channel.ID)
+				active = append(active, channel)
 			}
 		}
+		return active, nil
 	}
 
-	// Отправка в каждый канал
-	for _, channelID := range channelIDs {
-		result := &SendResult{
-			ChannelID: channelID,
-			Success:   true, // Для простоты всегда успешная отправка
-		}
-
-		// Здесь будет реальная логика отправки в зависимости от типа канала
-		err := s.sendToChannel(ctx, channelID, notification)
+	channels := make([]*Channel, 0, len(notification.ChannelIDs))
+	for _, channelID := range notification.ChannelIDs {
+		channel, err := s.channels.GetByID(ctx, channelID)
 		if err != nil {
-			result.Success = false
-			result.Error = err.Error()
+			return nil, err
+		}
+		if channel.TenantID != notification.TenantID {
+			return nil, errors.New(errors.ErrNotFound, "notification channel not found").
+				WithDetails(fmt.Sprintf("channel_id: %s", channelID))
 		}
+		channels = append(channels, channel)
+	}
+	return channels, nil
+}
 
-		results = append(results, result)
+// sendToChannel отправляет уведомление в канал и записывает результат в журнал доставки
+func (s *notificationService) sendToChannel(ctx context.Context, channel *Channel, notification *Notification) *SendResult {
+	message := s.buildMessage(ctx, channel, notification)
+	delivery := &Delivery{
+		ID:          message.ID,
+		TenantID:    notification.TenantID,
+		IncidentID:  notification.IncidentID,
+		ChannelID:   channel.ID,
+		ChannelType: channel.Type.String(),
+		Recipient:   message.Recipient,
+		Subject:     message.Subject,
+		Body:        message.Body,
+		CreatedAt:   message.CreatedAt,
 	}
 
-	s.logger.Info("Notification sent",
-		logger.String("tenant_id", notification.TenantID),
-		logger.Int("channels_sent", len(results)))
+	var err error
+	if !channel.IsActive {
+		err = fmt.Errorf("channel is inactive")
+	} else {
+		err = s.sender.SendNotification(ctx, message)
+	}
 
-	return results, nil
+	result := &SendResult{ChannelID: channel.ID, Success: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+		delivery.Status = DeliveryStatusFailed
+		delivery.Error = err.Error()
+		s.logger.Warn("Failed to send notification to channel",
+			logger.String("channel_id", channel.ID),
+			logger.String("channel_type", channel.Type.String()),
+			logger.Error(err))
+	} else {
+		sentAt := s.now()
+		delivery.Status = DeliveryStatusSent
+		delivery.SentAt = &sentAt
+	}
+
+	// Уведомление уже отправлено, ошибка журнала не меняет результат
+	if err := s.deliveries.Save(ctx, delivery); err != nil {
+		s.logger.Error("Failed to save notification delivery",
+			logger.String("delivery_id", delivery.ID),
+			logger.String("channel_id", channel.ID),
+			logger.Error(err))
+	}
+
+	return result
+}
+
+// buildMessage готовит уведомление для провайдера канала с темой и телом на языке канала или тенанта
+func (s *notificationService) buildMessage(ctx context.Context, channel *Channel, notification *Notification) *domain.Notification {
+	eventType := notification.Metadata[MetadataEventType]
+	if eventType == "" {
+		eventType = domain.NotificationTypeIncidentCreated
+	}
+
+	data := make(map[string]interface{}, len(notification.Metadata))
+	for key, value := range notification.Metadata {
+		data[key] = value
+	}
+
+	message := &domain.Notification{
+		ID:        uuid.New().String(),
+		EventID:   notification.IncidentID,
+		Type:      eventType,
+		Channel:   channel.ProviderName(),
+		Recipient: channel.Config[channel.Type.recipientKey()],
+		TenantID:  notification.TenantID,
+		Severity:  notification.Severity.String(),
+		Status:    domain.NotificationStatusPending,
+		Data:      data,
+		Locale:    s.channelLocale(ctx, channel),
+		CreatedAt: s.now(),
+	}
+
+	templateData := map[string]interface{}{
+		"title": notification.Title,
+		"notification": map[string]interface{}{
+			"type":      eventType,
+			"severity":  message.Severity,
+			"source":    "notification-service",
+			"timestamp": message.FormattedTime(),
+			"title":     notification.Title,
+			"message":   notification.Message,
+			"tenant_id": notification.TenantID,
+			"event_id":  notification.IncidentID,
+			"data":      data,
+		},
+	}
+	lang := message.Locale.Locale
+	message.Subject = s.render(s.templates.GetSubjectTemplate(eventType), lang, templateData, notification.Title)
+	message.Body = s.render(s.templates.GetBodyTemplate(eventType, message.Channel), lang, templateData, notification.Message)
+
+	return message
+}
+
+// channelLocale возвращает часовой пояс и язык уведомлений канала: язык из config.locale
+// заменяет язык тенанта, часовой пояс всегда берется у тенанта
+func (s *notificationService) channelLocale(ctx context.Context, channel *Channel) locale.Settings {
+	settings := s.locales.Resolve(ctx, channel.TenantID)
+	if lang := channel.Config[ChannelConfigLocale]; locale.IsSupported(lang) {
+		settings.Locale = lang
+	}
+	return settings.Normalize()
+}
+
+// render рендерит шаблон на языке lang; при отсутствии шаблона или ошибке возвращает fallback
+func (s *notificationService) render(name, lang string, data map[string]interface{}, fallback string) string {
+	rendered, err := s.templates.RenderLocalizedTemplate(name, lang, data)
+	if err != nil {
+		s.logger.Debug("Notification template not rendered, using plain text",
+			logger.String("template", name),
+			logger.Error(err))
+		return fallback
+	}
+	return rendered
 }
 
 // RegisterChannel регистрирует новый канал уведомлений
@@ -143,16 +405,36 @@ func (s *notificationService) RegisterChannel(ctx context.Context, channel *Chan
 		logger.String("name", channel.Name),
 		logger.Int("type", int(channel.Type)))
 
-	// Генерируем ID для нового канала
-	channel.ID = fmt.Sprintf("channel_%s_%d", channel.TenantID, time.Now().Unix())
-	channel.CreatedAt = time.Now().Format(time.RFC3339)
-	channel.UpdatedAt = time.Now().Format(time.RFC3339)
+	// Slack webhook уже привязан к каналу, остальным типам нужен адресат
+	if key := channel.Type.recipientKey(); channel.Type != ChannelTypeSlack && channel.Config[key] == "" {
+		return nil, errors.New(errors.ErrValidation, "channel config is missing recipient").
+			WithDetails(fmt.Sprintf("%s channel requires config.%s", channel.Type, key))
+	}
+	if channel.Type == ChannelTypePlugin && channel.Config[ChannelConfigPlugin] == "" {
+		return nil, errors.New(errors.ErrValidation, "channel config is missing plugin").
+			WithDetails("plugin channel requires config.plugin")
+	}
+	if lang, ok := channel.Config[ChannelConfigLocale]; ok && !locale.IsSupported(lang) {
+		return nil, errors.New(errors.ErrValidation, "unsupported channel locale").
+			WithDetails(fmt.Sprintf("config.locale must be one of %v", locale.SupportedLocales))
+	}
+
+	if s.secrets != nil {
+		sealed, err := s.secrets.EncryptConfig(ctx, channel.TenantID, channel.Config)
+		if err != nil {
+			return nil, err
+		}
+		channel.Config = sealed
+	}
+
+	if err := s.channels.Create(ctx, channel); err != nil {
+		return nil, err
+	}
 
-	// Здесь будет реальная логика сохранения в базу данных
 	s.logger.Info("Channel registered successfully",
 		logger.String("channel_id", channel.ID))
 
-	return channel, nil
+	return maskChannel(channel), nil
 }
 
 // UnregisterChannel удаляет канал уведомлений
@@ -160,7 +442,10 @@ func (s *notificationService) UnregisterChannel(ctx context.Context, channelID s
 	s.logger.Info("Unregistering channel",
 		logger.String("channel_id", channelID))
 
-	// Здесь будет реальная логика удаления из базы данных
+	if err := s.channels.Delete(ctx, channelID); err != nil {
+		return err
+	}
+
 	s.logger.Info("Channel unregistered successfully",
 		logger.String("channel_id", channelID))
 
@@ -169,70 +454,72 @@ func (s *notificationService) UnregisterChannel(ctx context.Context, channelID s
 
 // ListChannels возвращает список каналов уведомлений
 func (s *notificationService) ListChannels(ctx context.Context, tenantID string, channelType ChannelType) ([]*Channel, error) {
-	s.logger.Info("Listing channels",
-		logger.String("tenant_id", tenantID),
-		logger.Int("type", int(channelType)))
-
-	// Здесь будет реальная логика получения из базы данных
-	// Для примера вернем несколько тестовых каналов
-	channels := []*Channel{
-		{
-			ID:        "channel_1",
-			TenantID:  tenantID,
-			Type:      ChannelTypeEmail,
-			Name:      "Email Notifications",
-			Config: map[string]string{
-				"smtp_host": "smtp.example.com",
-				"smtp_port": "587",
-			},
-			IsActive:  true,
-			CreatedAt: time.Now().Add(-24 * time.Hour).Format(time.RFC3339),
-			UpdatedAt: time.Now().Add(-1 * time.Hour).Format(time.RFC3339),
-		},
-		{
-			ID:        "channel_2",
-			TenantID:  tenantID,
-			Type:      ChannelTypeSlack,
-			Name:      "Slack Webhook",
-			Config: map[string]string{
-				"webhook_url": "https://hooks.slack.com/services/...",
-			},
-			IsActive:  true,
-			CreatedAt: time.Now().Add(-12 * time.Hour).Format(time.RFC3339),
-			UpdatedAt: time.Now().Add(-30 * time.Minute).Format(time.RFC3339),
-		},
-	}
-
-	// Фильтрация по типу если указан
-	if channelType != ChannelTypeUnspecified {
-		filtered := make([]*Channel, 0)
-		for _, channel := range channels {
-			if channel.Type == channelType {
-				filtered = append(filtered, channel)
-			}
-		}
-		channels = filtered
+	channels, err := s.channels.List(ctx, tenantID, channelType)
+	if err != nil {
+		return nil, err
 	}
 
 	s.logger.Info("Channels listed successfully",
 		logger.String("tenant_id", tenantID),
 		logger.Int("count", len(channels)))
 
-	return channels, nil
+	masked := make([]*Channel, len(channels))
+	for i, channel := range channels {
+		masked[i] = maskChannel(channel)
+	}
+	return masked, nil
 }
 
-// sendToChannel отправляет уведомление в конкретный канал
-func (s *notificationService) sendToChannel(ctx context.Context, channelID string, notification *Notification) error {
-	s.logger.Debug("Sending to channel",
-		logger.String("channel_id", channelID),
-		logger.String("title", notification.Title))
+// RevealChannel возвращает канал с расшифрованными секретами. Канал другого арендатора
+// не раскрывается и выглядит несуществующим
+func (s *notificationService) RevealChannel(ctx context.Context, tenantID, channelID, userID string) (*Channel, error) {
+	channel, err := s.channels.GetByID(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.TenantID != tenantID {
+		return nil, errors.New(errors.ErrNotFound, "notification channel not found").
+			WithDetails(fmt.Sprintf("channel_id: %s", channelID))
+	}
+	channel, err = s.openChannel(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
 
-	// Здесь будет реальная логика отправки в зависимости от типа канала
-	// Для примера просто логируем
-	s.logger.Info("Notification sent to channel",
+	var revealed []string
+	for key := range channel.Config {
+		if secrets.IsSecretKey(key) {
+			revealed = append(revealed, key)
+		}
+	}
+	sort.Strings(revealed)
+	s.logger.Warn("Notification channel secrets revealed",
+		logger.String("audit_event", "notification.channel_secrets_revealed"),
+		logger.String("tenant_id", tenantID),
 		logger.String("channel_id", channelID),
-		logger.String("title", notification.Title),
-		logger.String("message", notification.Message))
+		logger.String("user_id", userID),
+		logger.String("keys", strings.Join(revealed, ",")))
 
-	return nil
+	return channel, nil
+}
+
+// openChannel возвращает копию канала с расшифрованными секретами конфигурации
+func (s *notificationService) openChannel(ctx context.Context, channel *Channel) (*Channel, error) {
+	if s.secrets == nil {
+		return channel, nil
+	}
+	config, err := s.secrets.DecryptConfig(ctx, channel.TenantID, channel.Config)
+	if err != nil {
+		return nil, err
+	}
+	opened := *channel
+	opened.Config = config
+	return &opened, nil
+}
+
+// maskChannel возвращает копию канала со скрытыми секретами конфигурации
+func maskChannel(channel *Channel) *Channel {
+	masked := *channel
+	masked.Config = secrets.MaskConfig(channel.Config)
+	return &masked
 }
@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/pkg/locale"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+	"UptimePingPlatform/services/notification-service/internal/template"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, fields ...logger.Field)  {}
+func (l *testLogger) Info(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Warn(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Error(msg string, fields ...logger.Field)  {}
+func (l *testLogger) With(fields ...logger.Field) logger.Logger { return l }
+func (l *testLogger) Sync() error                               { return nil }
+
+// memoryChannels хранилище каналов в памяти
+type memoryChannels struct {
+	channels map[string]*Channel
+}
+
+func (r *memoryChannels) Create(ctx context.Context, channel *Channel) error {
+	channel.ID = fmt.Sprintf("channel-%d", len(r.channels)+1)
+	r.channels[channel.ID] = channel
+	return nil
+}
+
+func (r *memoryChannels) GetByID(ctx context.Context, id string) (*Channel, error) {
+	channel, ok := r.channels[id]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "notification channel not found")
+	}
+	return channel, nil
+}
+
+func (r *memoryChannels) Delete(ctx context.Context, id string) error {
+	if _, ok := r.channels[id]; !ok {
+		return errors.New(errors.ErrNotFound, "notification channel not found")
+	}
+	delete(r.channels, id)
+	return nil
+}
+
+func (r *memoryChannels) List(ctx context.Context, tenantID string, channelType ChannelType) ([]*Channel, error) {
+	var channels []*Channel
+	for _, channel := range r.channels {
+		if channel.TenantID == tenantID && (channelType == ChannelTypeUnspecified || channel.Type == channelType) {
+			channels = append(channels, channel)
+		}
+	}
+	return channels, nil
+}
+
+func (r *memoryChannels) ListAfter(ctx context.Context, afterID string, limit int) ([]*Channel, error) {
+	var channels []*Channel
+	for _, channel := range r.channels {
+		if channel.ID > afterID {
+			channels = append(channels, channel)
+		}
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].ID < channels[j].ID })
+	if len(channels) > limit {
+		channels = channels[:limit]
+	}
+	return channels, nil
+}
+
+func (r *memoryChannels) UpdateConfig(ctx context.Context, id string, config map[string]string) error {
+	channel, ok := r.channels[id]
+	if !ok {
+		return errors.New(errors.ErrNotFound, "notification channel not found")
+	}
+	channel.Config = config
+	return nil
+}
+
+// memoryDeliveries журнал доставки в памяти
+type memoryDeliveries struct {
+	saved []*Delivery
+}
+
+func (r *memoryDeliveries) Save(ctx context.Context, delivery *Delivery) error {
+	r.saved = append(r.saved, delivery)
+	return nil
+}
+
+// fakeSender запоминает отправленные уведомления и отказывает в заданных каналах
+type fakeSender struct {
+	sent    []*domain.Notification
+	failing map[string]error
+}
+
+func (s *fakeSender) SendNotification(ctx context.Context, notification *domain.Notification) error {
+	if err := s.failing[notification.Channel]; err != nil {
+		return err
+	}
+	s.sent = append(s.sent, notification)
+	return nil
+}
+
+// hasCode проверяет код ошибки pkg/errors в цепочке
+func hasCode(err error, code errors.ErrorCode) bool {
+	var appErr *errors.Error
+	return stderrors.As(err, &appErr) && appErr.Code == code
+}
+
+func newTestService(channels ...*Channel) (NotificationService, *memoryDeliveries, *fakeSender) {
+	repo := &memoryChannels{channels: make(map[string]*Channel)}
+	for _, channel := range channels {
+		repo.channels[channel.ID] = channel
+	}
+	deliveries := &memoryDeliveries{}
+	sender := &fakeSender{failing: make(map[string]error)}
+	return NewNotificationService(repo, deliveries, sender, template.NewMockTemplateManager(), nil, nil, &testLogger{}), deliveries, sender
+}
+
+func testNotification(channelIDs ...string) *Notification {
+	return &Notification{
+		TenantID:   "tenant-1",
+		IncidentID: "incident-1",
+		Severity:   NotificationSeverityCritical,
+		Title:      "API is down",
+		Message:    "connection refused",
+		ChannelIDs: channelIDs,
+	}
+}
+
+func TestSendNotification_RendersAndPersistsDelivery(t *testing.T) {
+	svc, deliveries, sender := newTestService(&Channel{
+		ID: "email-1", TenantID: "tenant-1", Type: ChannelTypeEmail, IsActive: true,
+		Config: map[string]string{ChannelConfigEmail: "ops@example.com"},
+	})
+
+	results, err := svc.SendNotification(context.Background(), testNotification("email-1"))
+	if err != nil {
+		t.Fatalf("SendNotification() error = %v", err)
+	}
+	if len(results) != 1 || !results[0].Success {
+		t.Fatalf("results = %+v, want one successful result", results)
+	}
+
+	if len(sender.sent) != 1 {
+		t.Fatalf("sent = %d, want 1", len(sender.sent))
+	}
+	message := sender.sent[0]
+	if message.Channel != "email" || message.Recipient != "ops@example.com" || message.Severity != domain.SeverityCritical {
+		t.Errorf("message = %+v, want email to ops@example.com with critical severity", message)
+	}
+	if message.Subject != "🔴 [INCIDENT] Test Incident" {
+		t.Errorf("Subject = %q, want rendered subject template", message.Subject)
+	}
+
+	if len(deliveries.saved) != 1 {
+		t.Fatalf("deliveries = %d, want 1", len(deliveries.saved))
+	}
+	delivery := deliveries.saved[0]
+	if delivery.Status != DeliveryStatusSent || delivery.SentAt == nil || delivery.ID != message.ID {
+		t.Errorf("delivery = %+v, want sent delivery of message %s", delivery, message.ID)
+	}
+}
+
+func TestSendNotification_ProviderFailureIsRecorded(t *testing.T) {
+	svc, deliveries, sender := newTestService(
+		&Channel{ID: "email-1", TenantID: "tenant-1", Type: ChannelTypeEmail, IsActive: true, Config: map[string]string{ChannelConfigEmail: "ops@example.com"}},
+		&Channel{ID: "slack-1", TenantID: "tenant-1", Type: ChannelTypeSlack, IsActive: true},
+		&Channel{ID: "slack-2", TenantID: "tenant-1", Type: ChannelTypeSlack, IsActive: false},
+	)
+	sender.failing["slack"] = fmt.Errorf("provider not found for channel: slack")
+
+	// Без явных каналов уведомление уходит во все активные каналы арендатора
+	results, err := svc.SendNotification(context.Background(), testNotification())
+	if err != nil {
+		t.Fatalf("SendNotification() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("results = %d, want 2 active channels", len(results))
+	}
+
+	statuses := make(map[string]string)
+	for _, delivery := range deliveries.saved {
+		statuses[delivery.ChannelID] = delivery.Status
+	}
+	if statuses["email-1"] != DeliveryStatusSent || statuses["slack-1"] != DeliveryStatusFailed {
+		t.Errorf("statuses = %v, want email-1 sent and slack-1 failed", statuses)
+	}
+	for _, result := range results {
+		if result.ChannelID == "slack-1" && (result.Success || result.Error == "") {
+			t.Errorf("slack result = %+v, want failure with error", result)
+		}
+	}
+}
+
+func TestSendNotification_UnknownOrForeignChannel(t *testing.T) {
+	svc, _, _ := newTestService(&Channel{ID: "foreign", TenantID: "tenant-2", Type: ChannelTypeSlack, IsActive: true})
+
+	for _, channelID := range []string{"missing", "foreign"} {
+		_, err := svc.SendNotification(context.Background(), testNotification(channelID))
+		if !hasCode(err, errors.ErrNotFound) {
+			t.Errorf("SendNotification(%s) error = %v, want ErrNotFound", channelID, err)
+		}
+	}
+}
+
+func TestSendNotification_NoChannels(t *testing.T) {
+	svc, _, _ := newTestService()
+
+	_, err := svc.SendNotification(context.Background(), testNotification())
+	if !hasCode(err, errors.ErrValidation) {
+		t.Errorf("SendNotification() error = %v, want ErrValidation", err)
+	}
+}
+
+func TestRegisterChannel_RequiresRecipient(t *testing.T) {
+	svc, _, _ := newTestService()
+
+	_, err := svc.RegisterChannel(context.Background(), &Channel{TenantID: "tenant-1", Type: ChannelTypeTelegram, Name: "ops"})
+	if !hasCode(err, errors.ErrValidation) {
+		t.Errorf("RegisterChannel() error = %v, want ErrValidation", err)
+	}
+
+	channel, err := svc.RegisterChannel(context.Background(), &Channel{
+		TenantID: "tenant-1", Type: ChannelTypeTelegram, Name: "ops",
+		Config: map[string]string{ChannelConfigChatID: "-100500"},
+	})
+	if err != nil {
+		t.Fatalf("RegisterChannel() error = %v", err)
+	}
+	if channel.ID == "" {
+		t.Error("RegisterChannel() did not assign ID")
+	}
+}
+
+func TestPluginChannel_RoutesToPluginProvider(t *testing.T) {
+	svc, _, sender := newTestService()
+
+	_, err := svc.RegisterChannel(context.Background(), &Channel{
+		TenantID: "tenant-1", Type: ChannelTypePlugin, Name: "pager",
+		Config: map[string]string{ChannelConfigRecipient: "team-infra"},
+	})
+	if !hasCode(err, errors.ErrValidation) {
+		t.Errorf("RegisterChannel() without plugin error = %v, want ErrValidation", err)
+	}
+
+	channel, err := svc.RegisterChannel(context.Background(), &Channel{
+		TenantID: "tenant-1", Type: ChannelTypePlugin, Name: "pager", IsActive: true,
+		Config: map[string]string{ChannelConfigPlugin: "pagerduty", ChannelConfigRecipient: "team-infra"},
+	})
+	if err != nil {
+		t.Fatalf("RegisterChannel() error = %v", err)
+	}
+
+	if _, err := svc.SendNotification(context.Background(), testNotification(channel.ID)); err != nil {
+		t.Fatalf("SendNotification() error = %v", err)
+	}
+	if len(sender.sent) != 1 || sender.sent[0].Channel != "pagerduty" || sender.sent[0].Recipient != "team-infra" {
+		t.Errorf("sent = %+v, want one pagerduty notification to team-infra", sender.sent)
+	}
+}
+
+func TestParseChannelType(t *testing.T) {
+	for _, channelType := range []ChannelType{ChannelTypeTelegram, ChannelTypeSlack, ChannelTypeEmail, ChannelTypePlugin} {
+		if got := ParseChannelType(channelType.String()); got != channelType {
+			t.Errorf("ParseChannelType(%q) = %v, want %v", channelType.String(), got, channelType)
+		}
+	}
+	if got := ParseChannelType("pager"); got != ChannelTypeUnspecified {
+		t.Errorf("ParseChannelType(pager) = %v, want unspecified", got)
+	}
+}
+
+// prefixSecrets обратимое "шифрование" секретов для проверки мест вызова ChannelSecrets
+type prefixSecrets struct{}
+
+func (prefixSecrets) EncryptConfig(ctx context.Context, tenantID string, config map[string]string) (map[string]string, error) {
+	sealed := make(map[string]string, len(config))
+	for key, value := range config {
+		if key == "bot_token" {
+			value = "enc:v1:" + tenantID + ":" + value
+		}
+		sealed[key] = value
+	}
+	return sealed, nil
+}
+
+func (prefixSecrets) DecryptConfig(ctx context.Context, tenantID string, config map[string]string) (map[string]string, error) {
+	opened := make(map[string]string, len(config))
+	for key, value := range config {
+		opened[key] = strings.TrimPrefix(value, "enc:v1:"+tenantID+":")
+	}
+	return opened, nil
+}
+
+func TestChannelSecrets_EncryptedAtRestAndMasked(t *testing.T) {
+	repo := &memoryChannels{channels: make(map[string]*Channel)}
+	sender := &fakeSender{failing: make(map[string]error)}
+	svc := NewNotificationService(repo, &memoryDeliveries{}, sender, template.NewMockTemplateManager(), prefixSecrets{}, nil, &testLogger{})
+	ctx := context.Background()
+
+	registered, err := svc.RegisterChannel(ctx, &Channel{
+		TenantID: "tenant-1", Type: ChannelTypeTelegram, Name: "ops", IsActive: true,
+		Config: map[string]string{ChannelConfigChatID: "-100500", "bot_token": "123456:ABC-DEFGHIJ"},
+	})
+	if err != nil {
+		t.Fatalf("RegisterChannel() error = %v", err)
+	}
+	if got := registered.Config["bot_token"]; got != "********" {
+		t.Errorf("RegisterChannel() bot_token = %q, want masked", got)
+	}
+	if stored := repo.channels[registered.ID].Config["bot_token"]; stored != "enc:v1:tenant-1:123456:ABC-DEFGHIJ" {
+		t.Errorf("stored bot_token = %q, want encrypted", stored)
+	}
+
+	channels, err := svc.ListChannels(ctx, "tenant-1", ChannelTypeUnspecified)
+	if err != nil || len(channels) != 1 {
+		t.Fatalf("ListChannels() = %v, %v", channels, err)
+	}
+	if got := channels[0].Config; got["bot_token"] != "********" || got[ChannelConfigChatID] != "-100500" {
+		t.Errorf("ListChannels() config = %v, want masked bot_token", got)
+	}
+
+	revealed, err := svc.RevealChannel(ctx, "tenant-1", registered.ID, "user-1")
+	if err != nil {
+		t.Fatalf("RevealChannel() error = %v", err)
+	}
+	if got := revealed.Config["bot_token"]; got != "123456:ABC-DEFGHIJ" {
+		t.Errorf("RevealChannel() bot_token = %q", got)
+	}
+	if stored := repo.channels[registered.ID].Config["bot_token"]; !strings.HasPrefix(stored, "enc:v1:") {
+		t.Errorf("RevealChannel() changed stored config: %q", stored)
+	}
+	if _, err := svc.RevealChannel(ctx, "tenant-2", registered.ID, "user-1"); !hasCode(err, errors.ErrNotFound) {
+		t.Errorf("RevealChannel() of foreign channel error = %v, want ErrNotFound", err)
+	}
+
+	if _, err := svc.SendNotification(ctx, testNotification(registered.ID)); err != nil {
+		t.Fatalf("SendNotification() error = %v", err)
+	}
+	if len(sender.sent) != 1 || sender.sent[0].Recipient != "-100500" {
+		t.Errorf("sent = %+v, want one notification to -100500", sender.sent)
+	}
+}
+
+func TestSendNotification_ChannelLocale(t *testing.T) {
+	repo := &memoryChannels{channels: map[string]*Channel{
+		"email-ru": {ID: "email-ru", TenantID: "tenant-1", Type: ChannelTypeEmail, IsActive: true, Config: map[string]string{ChannelConfigEmail: "ops@example.com"}},
+		"email-en": {ID: "email-en", TenantID: "tenant-1", Type: ChannelTypeEmail, IsActive: true, Config: map[string]string{ChannelConfigEmail: "noc@example.com", ChannelConfigLocale: "en"}},
+	}}
+	sender := &fakeSender{failing: make(map[string]error)}
+	// Тенант настроен на русский язык и московское время
+	locales := locale.NewResolver(func(ctx context.Context, tenantID string) (locale.Settings, error) {
+		return locale.Settings{Timezone: "Europe/Moscow", Locale: "ru"}, nil
+	}, time.Minute)
+	svc := NewNotificationService(repo, &memoryDeliveries{}, sender, template.NewDefaultTemplateManager(&testLogger{}), nil, locales, &testLogger{})
+
+	if _, err := svc.SendNotification(context.Background(), testNotification("email-ru", "email-en")); err != nil {
+		t.Fatalf("SendNotification() error = %v", err)
+	}
+	if len(sender.sent) != 2 {
+		t.Fatalf("sent %d notifications, want 2", len(sender.sent))
+	}
+
+	// Язык канала заменяет язык тенанта, часовой пояс остается тенантским
+	want := map[string]string{"ops@example.com": "🔴 [ИНЦИДЕНТ] API is down", "noc@example.com": "🔴 [INCIDENT] API is down"}
+	for _, message := range sender.sent {
+		if message.Subject != want[message.Recipient] {
+			t.Errorf("%s subject = %q, want %q", message.Recipient, message.Subject, want[message.Recipient])
+		}
+		if message.Locale.Timezone != "Europe/Moscow" {
+			t.Errorf("%s timezone = %q, want Europe/Moscow", message.Recipient, message.Locale.Timezone)
+		}
+	}
+}
+
+func TestRegisterChannel_RejectsUnsupportedLocale(t *testing.T) {
+	svc, _, _ := newTestService()
+	_, err := svc.RegisterChannel(context.Background(), &Channel{
+		TenantID: "tenant-1", Type: ChannelTypeEmail, Name: "ops",
+		Config: map[string]string{ChannelConfigEmail: "ops@example.com", ChannelConfigLocale: "de"},
+	})
+	if !hasCode(err, errors.ErrValidation) {
+		t.Errorf("RegisterChannel() error = %v, want ErrValidation", err)
+	}
+}
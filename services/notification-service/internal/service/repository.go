@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// Статусы доставки уведомления в канал
+const (
+	DeliveryStatusSent   = "sent"
+	DeliveryStatusFailed = "failed"
+)
+
+// Delivery запись об отправке уведомления в один канал
+type Delivery struct {
+	ID          string     `json:"id"`
+	TenantID    string     `json:"tenant_id"`
+	IncidentID  string     `json:"incident_id"`
+	ChannelID   string     `json:"channel_id"`
+	ChannelType string     `json:"channel_type"`
+	Recipient   string     `json:"recipient"`
+	Subject     string     `json:"subject"`
+	Body        string     `json:"body"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	SentAt      *time.Time `json:"sent_at,omitempty"`
+}
+
+// ChannelRepository хранилище каналов уведомлений
+type ChannelRepository interface {
+	// Create сохраняет канал и заполняет ID и время создания
+	Create(ctx context.Context, channel *Channel) error
+
+	// GetByID возвращает канал или ошибку ErrNotFound
+	GetByID(ctx context.Context, id string) (*Channel, error)
+
+	// Delete удаляет канал или возвращает ошибку ErrNotFound
+	Delete(ctx context.Context, id string) error
+
+	// List возвращает каналы арендатора; ChannelTypeUnspecified означает все типы
+	List(ctx context.Context, tenantID string, channelType ChannelType) ([]*Channel, error)
+
+	// ListAfter возвращает до limit каналов всех арендаторов с ID больше afterID в порядке ID;
+	// пустой afterID - с начала
+	ListAfter(ctx context.Context, afterID string, limit int) ([]*Channel, error)
+
+	// UpdateConfig заменяет конфигурацию канала
+	UpdateConfig(ctx context.Context, id string, config map[string]string) error
+}
+
+// DeliveryRepository журнал доставки уведомлений
+type DeliveryRepository interface {
+	// Save сохраняет результат отправки уведомления в канал
+	Save(ctx context.Context, delivery *Delivery) error
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/secrets"
+)
+
+// backfillPageSize число каналов, читаемых за один запрос при шифровании сохраненных каналов
+const backfillPageSize = 100
+
+// EncryptStoredChannels шифрует секреты каналов, сохраненных до включения шифрования или без
+// мастер-ключа. Каналы без открытых секретов не меняются, поэтому повторный запуск безопасен.
+// Возвращает число зашифрованных каналов
+func EncryptStoredChannels(ctx context.Context, channels ChannelRepository, channelSecrets ChannelSecrets, log logger.Logger) (int, error) {
+	encrypted := 0
+	afterID := ""
+	for {
+		page, err := channels.ListAfter(ctx, afterID, backfillPageSize)
+		if err != nil {
+			return encrypted, err
+		}
+		for _, channel := range page {
+			if !hasPlaintextSecrets(channel.Config) {
+				continue
+			}
+			sealed, err := channelSecrets.EncryptConfig(ctx, channel.TenantID, channel.Config)
+			if err != nil {
+				return encrypted, err
+			}
+			if err := channels.UpdateConfig(ctx, channel.ID, sealed); err != nil {
+				return encrypted, err
+			}
+			encrypted++
+			log.Info("Channel secrets encrypted",
+				logger.String("channel_id", channel.ID),
+				logger.String("tenant_id", channel.TenantID))
+		}
+		if len(page) < backfillPageSize {
+			return encrypted, nil
+		}
+		afterID = page[len(page)-1].ID
+	}
+}
+
+// hasPlaintextSecrets проверяет, есть ли в конфигурации канала незашифрованные секреты
+func hasPlaintextSecrets(config map[string]string) bool {
+	for key, value := range config {
+		if value != "" && secrets.IsSecretKey(key) && !secrets.IsEncrypted(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestEncryptStoredChannels(t *testing.T) {
+	repo := &memoryChannels{channels: make(map[string]*Channel)}
+	for i := 0; i < backfillPageSize+5; i++ {
+		id := fmt.Sprintf("channel-%03d", i)
+		repo.channels[id] = &Channel{ID: id, TenantID: "tenant-1", Type: ChannelTypeTelegram,
+			Config: map[string]string{ChannelConfigChatID: "-100500", "bot_token": "123456:ABC-" + id}}
+	}
+	repo.channels["channel-sealed"] = &Channel{ID: "channel-sealed", TenantID: "tenant-2", Type: ChannelTypeTelegram,
+		Config: map[string]string{"bot_token": "enc:v1:tenant-2:123456:ABC"}}
+	repo.channels["channel-email"] = &Channel{ID: "channel-email", TenantID: "tenant-2", Type: ChannelTypeEmail,
+		Config: map[string]string{ChannelConfigEmail: "ops@example.com"}}
+	ctx := context.Background()
+
+	encrypted, err := EncryptStoredChannels(ctx, repo, prefixSecrets{}, &testLogger{})
+	if err != nil {
+		t.Fatalf("EncryptStoredChannels() error = %v", err)
+	}
+	if encrypted != backfillPageSize+5 {
+		t.Errorf("EncryptStoredChannels() = %d, want %d", encrypted, backfillPageSize+5)
+	}
+	if got := repo.channels["channel-104"].Config["bot_token"]; got != "enc:v1:tenant-1:123456:ABC-channel-104" {
+		t.Errorf("bot_token of the last page = %q, want encrypted", got)
+	}
+	if got := repo.channels["channel-000"].Config[ChannelConfigChatID]; got != "-100500" {
+		t.Errorf("chat_id = %q, want unchanged", got)
+	}
+	if got := repo.channels["channel-sealed"].Config["bot_token"]; got != "enc:v1:tenant-2:123456:ABC" {
+		t.Errorf("already encrypted bot_token = %q, want unchanged", got)
+	}
+
+	again, err := EncryptStoredChannels(ctx, repo, prefixSecrets{}, &testLogger{})
+	if err != nil || again != 0 {
+		t.Errorf("second EncryptStoredChannels() = %d, %v, want 0", again, err)
+	}
+}
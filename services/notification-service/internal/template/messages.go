@@ -0,0 +1,97 @@
+package template
+
+import "UptimePingPlatform/pkg/locale"
+
+// Messages каталог переводов встроенных шаблонов и подписей провайдеров. Ключи с %v
+// принимают аргументы: {{t "reminder_of" .reminder_number .reminder_max}}
+var Messages = locale.NewCatalog().
+	Add("en", map[string]string{
+		"subject_incident":        "INCIDENT",
+		"subject_incident_update": "INCIDENT UPDATE",
+		"subject_resolved":        "RESOLVED",
+		"subject_sla_breached":    "SLA BREACHED",
+		"subject_reminder":        "REMINDER",
+		"subject_check_failed":    "CHECK FAILED",
+		"subject_recovered":       "RECOVERED",
+		"subject_system_alert":    "SYSTEM ALERT",
+
+		"incident_detected":       "INCIDENT DETECTED",
+		"incident_resolved":       "INCIDENT RESOLVED",
+		"incident_sla_breached":   "INCIDENT SLA BREACHED",
+		"incident_unacknowledged": "INCIDENT STILL UNACKNOWLEDGED",
+
+		"event":                  "Event",
+		"type":                   "Type",
+		"severity":               "Severity",
+		"source":                 "Source",
+		"time":                   "Time",
+		"message":                "Message",
+		"details":                "Details",
+		"tenant":                 "Tenant",
+		"tenant_id":              "Tenant ID",
+		"event_id":               "Event ID",
+		"additional_information": "Additional Information",
+		"resolution_details":     "Resolution Details",
+		"deadline":               "Deadline",
+		"escalated_from":         "Escalated from",
+		"sla_within":             "%v within %v",
+		"reminder_of":            "%v of %v",
+		"reminder":               "Reminder",
+		"sla":                    "SLA",
+
+		"sms_sla_missed":           "%v target %v missed",
+		"sms_reminder_of":          "Reminder %v of %v",
+		"sms_still_unacknowledged": "%v is still unacknowledged.",
+
+		"action_acknowledge": "Ack",
+		"action_resolve":     "Resolve",
+		"action_snooze":      "Snooze 1h",
+
+		"automated_footer": "This is an automated notification from UptimePing Platform.",
+		"sent_by":          "Sent by UptimePing Platform",
+	}).
+	Add("ru", map[string]string{
+		"subject_incident":        "ИНЦИДЕНТ",
+		"subject_incident_update": "ОБНОВЛЕНИЕ ИНЦИДЕНТА",
+		"subject_resolved":        "РЕШЕНО",
+		"subject_sla_breached":    "НАРУШЕН SLA",
+		"subject_reminder":        "НАПОМИНАНИЕ",
+		"subject_check_failed":    "ПРОВЕРКА НЕ ПРОЙДЕНА",
+		"subject_recovered":       "ВОССТАНОВЛЕНО",
+		"subject_system_alert":    "СИСТЕМНОЕ ОПОВЕЩЕНИЕ",
+
+		"incident_detected":       "ОБНАРУЖЕН ИНЦИДЕНТ",
+		"incident_resolved":       "ИНЦИДЕНТ РЕШЕН",
+		"incident_sla_breached":   "НАРУШЕН SLA ИНЦИДЕНТА",
+		"incident_unacknowledged": "ИНЦИДЕНТ ВСЕ ЕЩЕ НЕ ПОДТВЕРЖДЕН",
+
+		"event":                  "Событие",
+		"type":                   "Тип",
+		"severity":               "Критичность",
+		"source":                 "Источник",
+		"time":                   "Время",
+		"message":                "Сообщение",
+		"details":                "Подробности",
+		"tenant":                 "Тенант",
+		"tenant_id":              "ID тенанта",
+		"event_id":               "ID события",
+		"additional_information": "Дополнительная информация",
+		"resolution_details":     "Подробности решения",
+		"deadline":               "Крайний срок",
+		"escalated_from":         "Эскалировано от",
+		"sla_within":             "%v в пределах %v",
+		"reminder_of":            "%v из %v",
+		"reminder":               "Напоминание",
+		"sla":                    "SLA",
+
+		"sms_sla_missed":           "%v: цель %v не достигнута",
+		"sms_reminder_of":          "Напоминание %v из %v",
+		"sms_still_unacknowledged": "%v все еще не подтвержден.",
+
+		"action_acknowledge": "Подтвердить",
+		"action_resolve":     "Решить",
+		"action_snooze":      "Отложить на 1 ч",
+
+		"automated_footer": "Это автоматическое уведомление платформы UptimePing.",
+		"sent_by":          "Отправлено платформой UptimePing",
+	})
@@ -3,8 +3,13 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"text/template"
 
+	"UptimePingPlatform/pkg/locale"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/notification-service/internal/domain"
 )
@@ -12,13 +17,19 @@ import (
 // TemplateManager интерфейс для управления шаблонами
 type TemplateManager interface {
 	RenderTemplate(templateName string, data map[string]interface{}) (string, error)
+	// RenderLocalizedTemplate рендерит шаблон на языке lang; шаблон без версии на этом языке
+	// берется из языка по умолчанию
+	RenderLocalizedTemplate(templateName, lang string, data map[string]interface{}) (string, error)
 	GetSubjectTemplate(eventType string) string
 	GetBodyTemplate(eventType, channel string) string
 }
 
-// DefaultTemplateManager менеджер шаблонов по умолчанию
+// DefaultTemplateManager менеджер шаблонов по умолчанию. Встроенные шаблоны общие для всех
+// языков: подписи выводятся функцией t из каталога Messages. Переопределения задаются
+// отдельно для каждого языка
 type DefaultTemplateManager struct {
-	templates map[string]*template.Template
+	mu        sync.RWMutex
+	templates map[string]*template.Template // ключ: язык/имя шаблона
 	logger    logger.Logger
 }
 
@@ -35,160 +46,218 @@ func NewDefaultTemplateManager(logger logger.Logger) *DefaultTemplateManager {
 	return tm
 }
 
-// initializeTemplates инициализирует базовые шаблоны
+// initializeTemplates инициализирует базовые шаблоны на всех поддерживаемых языках
 func (tm *DefaultTemplateManager) initializeTemplates() {
 	// Шаблоны тем
 	subjectTemplates := map[string]string{
-		domain.NotificationTypeIncidentCreated: "🔴 [INCIDENT] {{.title}}",
-		domain.NotificationTypeIncidentUpdated: "🟠 [INCIDENT UPDATE] {{.title}}",
-		domain.NotificationTypeIncidentResolved: "🟢 [RESOLVED] {{.title}}",
-		domain.NotificationTypeCheckFailed:     "🟡 [CHECK FAILED] {{.title}}",
-		domain.NotificationTypeCheckRecovered:  "✅ [RECOVERED] {{.title}}",
-		domain.NotificationTypeSystemAlert:     "⚠️ [SYSTEM ALERT] {{.title}}",
+		domain.NotificationTypeIncidentCreated:     `🔴 [{{t "subject_incident"}}] {{.title}}`,
+		domain.NotificationTypeIncidentUpdated:     `🟠 [{{t "subject_incident_update"}}] {{.title}}`,
+		domain.NotificationTypeIncidentResolved:    `🟢 [{{t "subject_resolved"}}] {{.title}}`,
+		domain.NotificationTypeIncidentSLABreached: `⏰ [{{t "subject_sla_breached"}}] {{.title}}`,
+		domain.NotificationTypeIncidentReminder:    `🔔 [{{t "subject_reminder"}}] {{.title}}`,
+		domain.NotificationTypeCheckFailed:         `🟡 [{{t "subject_check_failed"}}] {{.title}}`,
+		domain.NotificationTypeCheckRecovered:      `✅ [{{t "subject_recovered"}}] {{.title}}`,
+		domain.NotificationTypeSystemAlert:         `⚠️ [{{t "subject_system_alert"}}] {{.title}}`,
 	}
 
 	// Шаблоны тел для email
 	emailBodyTemplates := map[string]string{
 		domain.NotificationTypeIncidentCreated + ":" + domain.ChannelEmail: `
-🔴 INCIDENT DETECTED
+🔴 {{t "incident_detected"}}
 
-Event: {{.notification.type}}
-Severity: {{.notification.severity}}
-Source: {{.notification.source}}
-Time: {{.notification.timestamp}}
+{{t "event"}}: {{.notification.type}}
+{{t "severity"}}: {{.notification.severity}}
+{{t "source"}}: {{.notification.source}}
+{{t "time"}}: {{.notification.timestamp}}
 
-Message:
+{{t "message"}}:
 {{.notification.message}}
 
-Details:
-Tenant ID: {{.notification.tenant_id}}
-Event ID: {{.notification.event_id}}
+{{t "details"}}:
+{{t "tenant_id"}}: {{.notification.tenant_id}}
+{{t "event_id"}}: {{.notification.event_id}}
 
-{{if .notification.data}}Additional Information:
+{{if .notification.data}}{{t "additional_information"}}:
 {{range $key, $value := .notification.data}}
 - {{$key}}: {{$value}}
 {{end}}{{end}}
 
 ---
-This is an automated notification from UptimePing Platform.
+{{t "automated_footer"}}
 `,
 		domain.NotificationTypeIncidentResolved + ":" + domain.ChannelEmail: `
-🟢 INCIDENT RESOLVED
+🟢 {{t "incident_resolved"}}
 
-Event: {{.notification.type}}
-Severity: {{.notification.severity}}
-Source: {{.notification.source}}
-Time: {{.notification.timestamp}}
+{{t "event"}}: {{.notification.type}}
+{{t "severity"}}: {{.notification.severity}}
+{{t "source"}}: {{.notification.source}}
+{{t "time"}}: {{.notification.timestamp}}
 
-Message:
+{{t "message"}}:
 {{.notification.message}}
 
-Details:
-Tenant ID: {{.notification.tenant_id}}
-Event ID: {{.notification.event_id}}
+{{t "details"}}:
+{{t "tenant_id"}}: {{.notification.tenant_id}}
+{{t "event_id"}}: {{.notification.event_id}}
 
-{{if .notification.data}}Resolution Details:
+{{if .notification.data}}{{t "resolution_details"}}:
 {{range $key, $value := .notification.data}}
 - {{$key}}: {{$value}}
 {{end}}{{end}}
 
 ---
-This is an automated notification from UptimePing Platform.
+{{t "automated_footer"}}
+`,
+		domain.NotificationTypeIncidentSLABreached + ":" + domain.ChannelEmail: `
+⏰ {{t "incident_sla_breached"}}
+
+{{t "event"}}: {{.notification.type}}
+{{t "severity"}}: {{.notification.severity}}
+{{with .notification.data}}{{t "sla"}}: {{t "sla_within" .sla .sla_target}}
+{{t "deadline"}}: {{.sla_deadline}}
+{{end}}
+{{t "message"}}:
+{{.notification.message}}
+
+{{t "details"}}:
+{{t "tenant_id"}}: {{.notification.tenant_id}}
+{{t "event_id"}}: {{.notification.event_id}}
+
+---
+{{t "automated_footer"}}
+`,
+		domain.NotificationTypeIncidentReminder + ":" + domain.ChannelEmail: `
+🔔 {{t "incident_unacknowledged"}}
+
+{{t "event"}}: {{.notification.type}}
+{{t "severity"}}: {{.notification.severity}}
+{{with .notification.data}}{{t "reminder"}}: {{t "reminder_of" .reminder_number .reminder_max}}
+{{with .escalated_from}}{{t "escalated_from"}}: {{.}}
+{{end}}{{end}}
+{{t "message"}}:
+{{.notification.message}}
+
+{{t "details"}}:
+{{t "tenant_id"}}: {{.notification.tenant_id}}
+{{t "event_id"}}: {{.notification.event_id}}
+
+---
+{{t "automated_footer"}}
 `,
 	}
 
 	// Шаблоны тел для Slack
 	slackBodyTemplates := map[string]string{
-		domain.NotificationTypeIncidentCreated + ":" + domain.ChannelSlack: `🔴 *INCIDENT DETECTED*
+		domain.NotificationTypeIncidentCreated + ":" + domain.ChannelSlack: `🔴 *{{t "incident_detected"}}*
 
-*Event:* {{.notification.type}}
-*Severity:* {{.notification.severity}}
-*Source:* {{.notification.source}}
-*Time:* {{.notification.timestamp}}
+*{{t "event"}}:* {{.notification.type}}
+*{{t "severity"}}:* {{.notification.severity}}
+*{{t "source"}}:* {{.notification.source}}
+*{{t "time"}}:* {{.notification.timestamp}}
 
-*Message:* {{.notification.message}}
+*{{t "message"}}:* {{.notification.message}}
 
-{{if .notification.data}}*Details:*
+{{if .notification.data}}*{{t "details"}}:*
 {{range $key, $value := .notification.data}}
 • *{{$key}}*: {{$value}}
 {{end}}{{end}}`,
-		domain.NotificationTypeIncidentResolved + ":" + domain.ChannelSlack: `🟢 *INCIDENT RESOLVED*
+		domain.NotificationTypeIncidentResolved + ":" + domain.ChannelSlack: `🟢 *{{t "incident_resolved"}}*
 
-*Event:* {{.notification.type}}
-*Severity:* {{.notification.severity}}
-*Source:* {{.notification.source}}
-*Time:* {{.notification.timestamp}}
+*{{t "event"}}:* {{.notification.type}}
+*{{t "severity"}}:* {{.notification.severity}}
+*{{t "source"}}:* {{.notification.source}}
+*{{t "time"}}:* {{.notification.timestamp}}
 
-*Message:* {{.notification.message}}
+*{{t "message"}}:* {{.notification.message}}
 
-{{if .notification.data}}*Resolution Details:*
+{{if .notification.data}}*{{t "resolution_details"}}:*
 {{range $key, $value := .notification.data}}
 • *{{$key}}*: {{$value}}
 {{end}}{{end}}`,
+		domain.NotificationTypeIncidentSLABreached + ":" + domain.ChannelSlack: `⏰ *{{t "incident_sla_breached"}}*
+
+*{{t "severity"}}:* {{.notification.severity}}
+{{with .notification.data}}*{{t "sla"}}:* {{t "sla_within" .sla .sla_target}}
+*{{t "deadline"}}:* {{.sla_deadline}}
+{{end}}
+*{{t "message"}}:* {{.notification.message}}`,
+		domain.NotificationTypeIncidentReminder + ":" + domain.ChannelSlack: `🔔 *{{t "incident_unacknowledged"}}*
+
+*{{t "severity"}}:* {{.notification.severity}}
+{{with .notification.data}}*{{t "reminder"}}:* {{t "reminder_of" .reminder_number .reminder_max}}
+{{with .escalated_from}}*{{t "escalated_from"}}:* {{.}}
+{{end}}{{end}}
+*{{t "message"}}:* {{.notification.message}}`,
 	}
 
 	// Шаблоны тел для SMS
 	smsBodyTemplates := map[string]string{
-		domain.NotificationTypeIncidentCreated + ":" + domain.ChannelSMS: `INCIDENT: {{.notification.title}}. Severity: {{.notification.severity}}. {{.notification.message}}`,
-		domain.NotificationTypeIncidentResolved + ":" + domain.ChannelSMS: `RESOLVED: {{.notification.title}}. {{.notification.message}}`,
+		domain.NotificationTypeIncidentCreated + ":" + domain.ChannelSMS:     `{{t "subject_incident"}}: {{.notification.title}}. {{t "severity"}}: {{.notification.severity}}. {{.notification.message}}`,
+		domain.NotificationTypeIncidentResolved + ":" + domain.ChannelSMS:    `{{t "subject_resolved"}}: {{.notification.title}}. {{.notification.message}}`,
+		domain.NotificationTypeIncidentSLABreached + ":" + domain.ChannelSMS: `{{t "subject_sla_breached"}}: {{.notification.title}}.{{with .notification.data}} {{t "sms_sla_missed" .sla .sla_target}}{{end}}`,
+		domain.NotificationTypeIncidentReminder + ":" + domain.ChannelSMS:    `{{t "subject_reminder"}}: {{t "sms_still_unacknowledged" .notification.title}}{{with .notification.data}} {{t "sms_reminder_of" .reminder_number .reminder_max}}{{end}}`,
 	}
 
-	// Компиляция шаблонов тем
+	builtin := make(map[string]string)
 	for name, tmpl := range subjectTemplates {
-		t, err := template.New(name).Parse(tmpl)
-		if err != nil {
-			tm.logger.Error("Failed to parse subject template",
-				logger.String("name", name),
-				logger.Error(err),
-			)
-			continue
-		}
-		tm.templates["subject:"+name] = t
+		builtin[tm.GetSubjectTemplate(name)] = tmpl
 	}
-
-	// Компиляция шаблонов тел для email
-	for name, tmpl := range emailBodyTemplates {
-		t, err := template.New(name).Parse(tmpl)
-		if err != nil {
-			tm.logger.Error("Failed to parse email body template",
-				logger.String("name", name),
-				logger.Error(err),
-			)
-			continue
+	for _, bodies := range []map[string]string{emailBodyTemplates, slackBodyTemplates, smsBodyTemplates} {
+		for name, tmpl := range bodies {
+			builtin["body:"+name] = tmpl
 		}
-		tm.templates["body:"+name] = t
 	}
 
-	// Компиляция шаблонов тел для Slack
-	for name, tmpl := range slackBodyTemplates {
-		t, err := template.New(name).Parse(tmpl)
-		if err != nil {
-			tm.logger.Error("Failed to parse slack body template",
-				logger.String("name", name),
-				logger.Error(err),
-			)
-			continue
+	// Компиляция шаблонов для каждого языка: t выводит подписи на языке шаблона
+	for _, lang := range locale.SupportedLocales {
+		for name, tmpl := range builtin {
+			t, err := parseLocalized(name, lang, tmpl)
+			if err != nil {
+				tm.logger.Error("Failed to parse template",
+					logger.String("name", name),
+					logger.String("locale", lang),
+					logger.Error(err),
+				)
+				continue
+			}
+			tm.templates[templateKey(lang, name)] = t
 		}
-		tm.templates["body:"+name] = t
 	}
+}
 
-	// Компиляция шаблонов тел для SMS
-	for name, tmpl := range smsBodyTemplates {
-		t, err := template.New(name).Parse(tmpl)
-		if err != nil {
-			tm.logger.Error("Failed to parse SMS body template",
-				logger.String("name", name),
-				logger.Error(err),
-			)
-			continue
-		}
-		tm.templates["body:"+name] = t
-	}
+// parseLocalized разбирает шаблон с функцией t, переводящей ключи каталога Messages на язык lang.
+// С аргументами перевод используется как формат: {{t "reminder_of" .reminder_number .reminder_max}}
+func parseLocalized(name, lang, text string) (*template.Template, error) {
+	return template.New(name).Funcs(template.FuncMap{
+		"t": func(key string, args ...interface{}) string {
+			message := Messages.Translate(lang, key)
+			if len(args) == 0 {
+				return message
+			}
+			return fmt.Sprintf(message, args...)
+		},
+	}).Parse(text)
 }
 
-// RenderTemplate рендерит шаблон с данными
+// templateKey возвращает ключ шаблона на языке lang
+func templateKey(lang, name string) string {
+	return lang + "/" + name
+}
+
+// RenderTemplate рендерит шаблон с данными на языке по умолчанию
 func (tm *DefaultTemplateManager) RenderTemplate(templateName string, data map[string]interface{}) (string, error) {
-	tmpl, exists := tm.templates[templateName]
+	return tm.RenderLocalizedTemplate(templateName, locale.DefaultLocale, data)
+}
+
+// RenderLocalizedTemplate рендерит шаблон с данными на языке lang; без шаблона на этом языке
+// используется шаблон языка по умолчанию
+func (tm *DefaultTemplateManager) RenderLocalizedTemplate(templateName, lang string, data map[string]interface{}) (string, error) {
+	tm.mu.RLock()
+	tmpl, exists := tm.templates[templateKey(lang, templateName)]
+	if !exists {
+		tmpl, exists = tm.templates[templateKey(locale.DefaultLocale, templateName)]
+	}
+	tm.mu.RUnlock()
 	if !exists {
 		return "", fmt.Errorf("template not found: %s", templateName)
 	}
@@ -212,23 +281,75 @@ func (tm *DefaultTemplateManager) GetBodyTemplate(eventType, channel string) str
 	return "body:" + eventType + ":" + channel
 }
 
-// AddTemplate добавляет новый шаблон
+// AddTemplate добавляет новый шаблон на языке по умолчанию
 func (tm *DefaultTemplateManager) AddTemplate(name, templateStr string) error {
-	t, err := template.New(name).Parse(templateStr)
+	return tm.AddLocalizedTemplate(name, locale.DefaultLocale, templateStr)
+}
+
+// AddLocalizedTemplate добавляет или переопределяет шаблон на языке lang; остальные языки
+// продолжают использовать свои версии шаблона
+func (tm *DefaultTemplateManager) AddLocalizedTemplate(name, lang, templateStr string) error {
+	if !locale.IsSupported(lang) {
+		return fmt.Errorf("unsupported template locale %q, must be one of %v", lang, locale.SupportedLocales)
+	}
+	t, err := parseLocalized(name, lang, templateStr)
 	if err != nil {
 		return fmt.Errorf("failed to parse template: %w", err)
 	}
 
-	tm.templates[name] = t
+	tm.mu.Lock()
+	tm.templates[templateKey(lang, name)] = t
+	tm.mu.Unlock()
 	tm.logger.Info("Template added",
 		logger.String("name", name),
+		logger.String("locale", lang),
 	)
 
 	return nil
 }
 
-// ListTemplates возвращает список всех шаблонов
+// LoadOverrides загружает переопределения шаблонов из каталога dir. Раскладка каталога:
+// <язык>/subject/<тип события>.tmpl и <язык>/body/<канал>/<тип события>.tmpl,
+// например ru/body/email/incident.created.tmpl. Возвращает число загруженных шаблонов
+func (tm *DefaultTemplateManager) LoadOverrides(dir string) (int, error) {
+	subjects, err := filepath.Glob(filepath.Join(dir, "*", "subject", "*.tmpl"))
+	if err != nil {
+		return 0, err
+	}
+	bodies, err := filepath.Glob(filepath.Join(dir, "*", "body", "*", "*.tmpl"))
+	if err != nil {
+		return 0, err
+	}
+
+	loaded := 0
+	for _, path := range append(subjects, bodies...) {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return loaded, err
+		}
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+		eventType := strings.TrimSuffix(parts[len(parts)-1], ".tmpl")
+		name := tm.GetSubjectTemplate(eventType)
+		if parts[1] == "body" {
+			name = tm.GetBodyTemplate(eventType, parts[2])
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return loaded, fmt.Errorf("failed to read template %s: %w", rel, err)
+		}
+		if err := tm.AddLocalizedTemplate(name, parts[0], string(content)); err != nil {
+			return loaded, fmt.Errorf("template %s: %w", rel, err)
+		}
+		loaded++
+	}
+	return loaded, nil
+}
+
+// ListTemplates возвращает список всех шаблонов в виде язык/имя
 func (tm *DefaultTemplateManager) ListTemplates() []string {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
 	var names []string
 	for name := range tm.templates {
 		names = append(names, name)
@@ -236,11 +357,19 @@ func (tm *DefaultTemplateManager) ListTemplates() []string {
 	return names
 }
 
-// RemoveTemplate удаляет шаблон
+// RemoveTemplate удаляет шаблон на языке по умолчанию
 func (tm *DefaultTemplateManager) RemoveTemplate(name string) {
-	delete(tm.templates, name)
+	tm.RemoveLocalizedTemplate(name, locale.DefaultLocale)
+}
+
+// RemoveLocalizedTemplate удаляет шаблон на языке lang
+func (tm *DefaultTemplateManager) RemoveLocalizedTemplate(name, lang string) {
+	tm.mu.Lock()
+	delete(tm.templates, templateKey(lang, name))
+	tm.mu.Unlock()
 	tm.logger.Info("Template removed",
 		logger.String("name", name),
+		logger.String("locale", lang),
 	)
 }
 
@@ -265,6 +394,11 @@ func (m *MockTemplateManager) RenderTemplate(templateName string, data map[strin
 	}
 }
 
+// RenderLocalizedTemplate имитирует рендеринг шаблона без учета языка
+func (m *MockTemplateManager) RenderLocalizedTemplate(templateName, lang string, data map[string]interface{}) (string, error) {
+	return m.RenderTemplate(templateName, data)
+}
+
 // GetSubjectTemplate возвращает имя шаблона темы
 func (m *MockTemplateManager) GetSubjectTemplate(eventType string) string {
 	return "subject:" + eventType
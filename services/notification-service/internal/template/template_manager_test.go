@@ -0,0 +1,164 @@
+package template
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"UptimePingPlatform/pkg/locale"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/notification-service/internal/domain"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, fields ...logger.Field)  {}
+func (l *testLogger) Info(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Warn(msg string, fields ...logger.Field)   {}
+func (l *testLogger) Error(msg string, fields ...logger.Field)  {}
+func (l *testLogger) With(fields ...logger.Field) logger.Logger { return l }
+func (l *testLogger) Sync() error                               { return nil }
+
+func slaData() map[string]interface{} {
+	return map[string]interface{}{
+		"title": "API is down",
+		"notification": map[string]interface{}{
+			"type":      domain.NotificationTypeIncidentSLABreached,
+			"severity":  "critical",
+			"title":     "API is down",
+			"message":   "connection refused",
+			"tenant_id": "tenant-1",
+			"event_id":  "incident-1",
+			"data":      map[string]interface{}{"sla": "acknowledge", "sla_target": "15m", "sla_deadline": "12:00"},
+		},
+	}
+}
+
+func TestDefaultTemplateManager_RenderLocalizedTemplate(t *testing.T) {
+	tm := NewDefaultTemplateManager(&testLogger{})
+	subject := tm.GetSubjectTemplate(domain.NotificationTypeIncidentSLABreached)
+	body := tm.GetBodyTemplate(domain.NotificationTypeIncidentSLABreached, domain.ChannelEmail)
+
+	tests := []struct {
+		lang    string
+		subject string
+		body    []string
+	}{
+		{"en", "⏰ [SLA BREACHED] API is down", []string{"SLA: acknowledge within 15m", "Deadline: 12:00", "Tenant ID: tenant-1"}},
+		{"ru", "⏰ [НАРУШЕН SLA] API is down", []string{"SLA: acknowledge в пределах 15m", "Крайний срок: 12:00", "ID тенанта: tenant-1"}},
+		// Неизвестный язык рендерится на языке по умолчанию
+		{"de", "⏰ [SLA BREACHED] API is down", []string{"Deadline: 12:00"}},
+	}
+	for _, tt := range tests {
+		got, err := tm.RenderLocalizedTemplate(subject, tt.lang, slaData())
+		if err != nil || got != tt.subject {
+			t.Errorf("%s subject = %q, %v, want %q", tt.lang, got, err, tt.subject)
+		}
+		got, err = tm.RenderLocalizedTemplate(body, tt.lang, slaData())
+		if err != nil {
+			t.Fatalf("%s body error = %v", tt.lang, err)
+		}
+		for _, want := range tt.body {
+			if !strings.Contains(got, want) {
+				t.Errorf("%s body does not contain %q:\n%s", tt.lang, want, got)
+			}
+		}
+	}
+
+	if _, err := tm.RenderLocalizedTemplate("body:unknown:email", "ru", slaData()); err == nil {
+		t.Error("RenderLocalizedTemplate() of unknown template must fail")
+	}
+}
+
+func TestDefaultTemplateManager_LocalizedOverrides(t *testing.T) {
+	tm := NewDefaultTemplateManager(&testLogger{})
+	subject := tm.GetSubjectTemplate(domain.NotificationTypeIncidentCreated)
+
+	if err := tm.AddLocalizedTemplate(subject, "ru", `[{{t "subject_incident"}}] {{.title}} (прод)`); err != nil {
+		t.Fatalf("AddLocalizedTemplate() error = %v", err)
+	}
+	if err := tm.AddLocalizedTemplate(subject, "de", `{{.title}}`); err == nil {
+		t.Error("AddLocalizedTemplate() with unsupported locale must fail")
+	}
+
+	// Переопределение действует только на своем языке
+	data := map[string]interface{}{"title": "API is down"}
+	if got, _ := tm.RenderLocalizedTemplate(subject, "ru", data); got != "[ИНЦИДЕНТ] API is down (прод)" {
+		t.Errorf("ru subject = %q", got)
+	}
+	if got, _ := tm.RenderTemplate(subject, data); got != "🔴 [INCIDENT] API is down" {
+		t.Errorf("en subject = %q", got)
+	}
+
+	// Шаблон, добавленный только на языке по умолчанию, используется и для других языков
+	if err := tm.AddTemplate("subject:custom", `{{t "subject_reminder"}}: {{.title}}`); err != nil {
+		t.Fatalf("AddTemplate() error = %v", err)
+	}
+	if got, _ := tm.RenderLocalizedTemplate("subject:custom", "ru", data); got != "REMINDER: API is down" {
+		t.Errorf("fallback subject = %q", got)
+	}
+
+	tm.RemoveLocalizedTemplate(subject, "ru")
+	if got, _ := tm.RenderLocalizedTemplate(subject, "ru", data); got != "🔴 [INCIDENT] API is down" {
+		t.Errorf("ru subject after remove = %q", got)
+	}
+}
+
+func TestDefaultTemplateManager_LoadOverrides(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"ru/subject/incident.created.tmpl":    `Авария: {{.title}}`,
+		"ru/body/email/incident.created.tmpl": `{{t "message"}}: {{.notification.message}}`,
+		"en/body/sms/incident.created.tmpl":   `DOWN {{.notification.title}}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tm := NewDefaultTemplateManager(&testLogger{})
+	loaded, err := tm.LoadOverrides(dir)
+	if err != nil || loaded != len(files) {
+		t.Fatalf("LoadOverrides() = %d, %v", loaded, err)
+	}
+
+	data := map[string]interface{}{
+		"title":        "API is down",
+		"notification": map[string]interface{}{"title": "API is down", "message": "connection refused"},
+	}
+	rendered := map[string]string{
+		"ru/" + tm.GetSubjectTemplate(domain.NotificationTypeIncidentCreated):                   "Авария: API is down",
+		"ru/" + tm.GetBodyTemplate(domain.NotificationTypeIncidentCreated, domain.ChannelEmail): "Сообщение: connection refused",
+		"en/" + tm.GetBodyTemplate(domain.NotificationTypeIncidentCreated, domain.ChannelSMS):   "DOWN API is down",
+	}
+	for key, want := range rendered {
+		lang, name, _ := strings.Cut(key, "/")
+		if got, err := tm.RenderLocalizedTemplate(name, lang, data); err != nil || got != want {
+			t.Errorf("%s = %q, %v, want %q", key, got, err, want)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "de", "subject"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "de", "subject", "incident.created.tmpl"), []byte(`{{.title}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tm.LoadOverrides(dir); err == nil {
+		t.Error("LoadOverrides() with unsupported locale must fail")
+	}
+}
+
+func TestMessages_AllTranslated(t *testing.T) {
+	for _, lang := range locale.SupportedLocales {
+		if missing := Messages.Missing(lang); len(missing) > 0 {
+			t.Errorf("%s translations are missing: %v", lang, missing)
+		}
+	}
+}
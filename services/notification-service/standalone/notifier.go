@@ -0,0 +1,57 @@
+// Package standalone отправляет уведомления в одном процессе с остальными сервисами платформы:
+// события incident.* читаются из встроенной очереди pkg/queue и проходят тот же конвейер
+// фильтрации, группировки и отправки, что и сообщения RabbitMQ
+package standalone
+
+import (
+	"context"
+
+	"UptimePingPlatform/pkg/config"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	notificationConfig "UptimePingPlatform/services/notification-service/config"
+	notificationConsumer "UptimePingPlatform/services/notification-service/internal/consumer/rabbitmq"
+	"UptimePingPlatform/services/notification-service/internal/filter"
+	"UptimePingPlatform/services/notification-service/internal/grouper"
+	"UptimePingPlatform/services/notification-service/internal/processor"
+	"UptimePingPlatform/services/notification-service/internal/provider"
+	"UptimePingPlatform/services/notification-service/internal/template"
+)
+
+// Notifier отправляет уведомления по событиям инцидентов
+type Notifier struct {
+	consumer  *notificationConsumer.Consumer
+	providers *provider.ProviderManager
+}
+
+// NewNotifier создает отправителя уведомлений с провайдерами из providers
+func NewNotifier(providers config.ProvidersConfig, log logger.Logger) *Notifier {
+	providerManager := provider.NewProviderManager(provider.ConfigFromSettings(providers), log)
+	// Уровни инцидентов (warning, error, critical) не совпадают с уровнями уведомлений, поэтому
+	// уведомления отправляются по каждому инциденту; порог задается переопределением проверки
+	filterConfig := filter.DefaultFilterConfig()
+	filterConfig.AllowedSeverities = nil
+	consumer := notificationConsumer.NewNotificationConsumer(
+		nil,
+		filter.NewOverrideFilter(filter.NewEventFilter(filterConfig, log), log),
+		grouper.NewNotificationGrouper(grouper.DefaultGrouperConfig(), notificationConfig.DefaultProvidersConfig(), log),
+		processor.NewNotificationProcessor(processor.DefaultProcessorConfig(), log, providerManager, template.NewDefaultTemplateManager(log)),
+		log,
+	)
+	return &Notifier{consumer: consumer, providers: providerManager}
+}
+
+// Handle обрабатывает событие из темы notifications; ключ сообщения - ключ маршрутизации события
+func (n *Notifier) Handle(ctx context.Context, msg *queue.Message) error {
+	return n.consumer.Handle(ctx, msg.Key, msg.ContentType, msg.Headers, msg.Body)
+}
+
+// Run отправляет сводные сообщения по инцидентам с общим ключом корреляции до отмены контекста
+func (n *Notifier) Run(ctx context.Context) {
+	n.consumer.RunCorrelation(ctx)
+}
+
+// Close освобождает ресурсы провайдеров
+func (n *Notifier) Close() {
+	n.providers.Close()
+}
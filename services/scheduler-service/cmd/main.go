@@ -13,16 +13,26 @@ import (
 	"syscall"
 	"time"
 
+	"UptimePingPlatform/pkg/breakglass"
+	"UptimePingPlatform/pkg/chaos"
 	"UptimePingPlatform/pkg/config"
+	pkg_grpc "UptimePingPlatform/pkg/grpc"
 	"UptimePingPlatform/pkg/health"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/pkg/metrics"
+	"UptimePingPlatform/pkg/rabbitmq"
 
 	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	schedulerv1beta1 "UptimePingPlatform/proto/api/scheduler/v1beta1"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
 	grpcHandler "UptimePingPlatform/services/scheduler-service/internal/handler/grpc"
+	"UptimePingPlatform/services/scheduler-service/internal/producer"
 	"UptimePingPlatform/services/scheduler-service/internal/repository"
+	memoryRepo "UptimePingPlatform/services/scheduler-service/internal/repository/memory"
 	postgresRepo "UptimePingPlatform/services/scheduler-service/internal/repository/postgres"
+	redisRepo "UptimePingPlatform/services/scheduler-service/internal/repository/redis"
 	"UptimePingPlatform/services/scheduler-service/internal/usecase"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 )
 
@@ -47,67 +57,224 @@ func main() {
 	fmt.Println("=== ШАГ 5: Логгер инициализирован ===")
 
 	appLogger.Info("Starting Scheduler Service...")
+	appLogger.Info("Effective configuration", logger.Any("config", cfg.Redacted()))
 	fmt.Println("=== ШАГ 6: Сообщение в лог отправлено ===")
 
 	// Initialize metrics
 	appMetrics := metrics.NewMetrics("scheduler-service")
 	metricsHandler := appMetrics.GetHandler()
 
-	// Initialize health checker
-	healthChecker := health.NewSimpleHealthChecker("1.0.0")
+	// Зависимости подключаются с повторами: без базы данных сервис не запускается,
+	// без Redis работает без кэша и блокировок, что отражается в /ready
+	dependencies := health.NewDependencies("1.0.0", cfg.Startup, appLogger)
 
 	// Initialize context
 	ctx := context.Background()
 
 	// Initialize database connection
-	db, err := pkg_database.Connect(ctx, &pkg_database.Config{
-		Host:          cfg.Database.Host,
-		Port:          cfg.Database.Port,
-		User:          cfg.Database.User,
-		Password:      cfg.Database.Password,
-		Database:      cfg.Database.Name,
-		SSLMode:       "disable",
-		MaxConns:      20,
-		MinConns:      5,
-		MaxConnLife:   30 * time.Minute,
-		MaxConnIdle:   5 * time.Minute,
-		HealthCheck:   30 * time.Second,
-		MaxRetries:    3,
-		RetryInterval: 1 * time.Second,
-	})
-	if err != nil {
+	dbConfig := &pkg_database.Config{
+		Host:               cfg.Database.Host,
+		Port:               cfg.Database.Port,
+		User:               cfg.Database.User,
+		Password:           cfg.Database.Password,
+		Database:           cfg.Database.Name,
+		SSLMode:            "disable",
+		MaxConns:           20,
+		MinConns:           5,
+		MaxConnLife:        30 * time.Minute,
+		MaxConnIdle:        5 * time.Minute,
+		HealthCheck:        30 * time.Second,
+		RetryInterval:      1 * time.Second,
+		StatementTimeout:   cfg.Database.StatementTimeout,
+		PreparedStatements: postgresRepo.CheckStatements,
+		ReplicaDSN:         cfg.Database.ReplicaURL,
+	}
+	if cfg.Database.MaxConns > 0 {
+		dbConfig.MaxConns = cfg.Database.MaxConns
+		dbConfig.MinConns = cfg.Database.MinConns
+	}
+	// Пул чтения подключается к реплике, если она задана, иначе отдельный пул
+	// к primary включается только при заданном read_max_conns
+	readConfig := dbConfig.Replica()
+	if readConfig == nil && cfg.Database.ReadMaxConns > 0 {
+		readConfig = dbConfig
+	}
+	if readConfig != nil {
+		readConfig = readConfig.ReadPool(cfg.Database.ReadMaxConns, 0, cfg.Database.ReadStatementTimeout)
+	}
+
+	var pools *pkg_database.Pools
+	if err := dependencies.Start(ctx, health.Dependency{
+		Name:     "database",
+		Required: true,
+		Connect: func(ctx context.Context) (err error) {
+			pools, err = pkg_database.ConnectPools(ctx, dbConfig, readConfig)
+			return err
+		},
+		Ping: func(ctx context.Context) error { return pools.Write.Ping(ctx) },
+	}); err != nil {
 		appLogger.Error("Failed to connect to database", logger.Error(err))
 		log.Fatalf("Database connection failed: %v", err)
 	}
-	defer db.Close()
+	defer pools.Close()
+	if err := prometheus.Register(pkg_database.NewPoolCollector("scheduler-service", pools)); err != nil {
+		appLogger.Warn("Failed to register database pool metrics", logger.Error(err))
+	}
 
 	// Initialize Redis client
-	redisClient, err := pkg_redis.Connect(ctx, &pkg_redis.Config{
-		Addr:     cfg.Redis.Addr,
-		Password: cfg.Redis.Password,
-		DB:       cfg.Redis.DB,
-	})
-	if err != nil {
-		appLogger.Error("Failed to connect to Redis", logger.Error(err))
-	} else {
+	var redisClient *pkg_redis.Client
+	if err := dependencies.Start(ctx, health.Dependency{
+		Name: "redis",
+		Connect: func(ctx context.Context) (err error) {
+			redisClient, err = pkg_redis.Connect(ctx, &pkg_redis.Config{
+				Addr:     cfg.Redis.Addr,
+				Password: cfg.Redis.Password,
+				DB:       cfg.Redis.DB,
+			})
+			return err
+		},
+		Ping: func(ctx context.Context) error { return redisClient.HealthCheck(ctx) },
+	}); err != nil {
+		log.Fatalf("Redis connection failed: %v", err)
+	}
+	if redisClient != nil {
 		defer redisClient.Close()
 	}
 
+	// История смен статусов зависимостей для /health/history; в Redis она переживает перезапуск
+	healthHistory := health.NewHistory(dependencies, health.DefaultHistorySize)
+	if redisClient != nil {
+		healthHistory.WithStore(health.NewRedisHistoryStore(redisClient, "scheduler-service", health.DefaultHistorySize), appLogger)
+	}
+	go healthHistory.Run(ctx, cfg.Health.CheckIntervalDuration())
+
 	// Initialize repositories
-	checkRepo := postgresRepo.NewCheckRepository(db.Pool)
+	checkRepo := postgresRepo.NewCheckRepositoryWithPools(pools)
+	tagRepo := postgresRepo.NewTagRepository(pools.Write)
+	groupRepo := postgresRepo.NewGroupRepository(pools.Write)
+	tenantRepo := postgresRepo.NewTenantRepository(pools.Read)
+
+	// Определения проверок для диспетчеризации читаются из кэша Redis, если он доступен
+	if redisClient != nil && redisClient.Client != nil && cfg.Scheduler.CheckCacheTTL > 0 {
+		checkRepo = redisRepo.NewCachedCheckRepository(checkRepo, redisClient.Client, cfg.Scheduler.CheckCacheTTL, appLogger)
+		appLogger.Info("Check definition cache enabled",
+			logger.Duration("ttl", cfg.Scheduler.CheckCacheTTL))
+	}
 
 	// Initialize scheduler repository with Redis client if available
 	var schedulerRepo repository.SchedulerRepository
 	if redisClient != nil && redisClient.Client != nil {
-		schedulerRepo = postgresRepo.NewSchedulerRepository(db.Pool, redisClient.Client)
+		schedulerRepo = postgresRepo.NewSchedulerRepository(pools.Write, redisClient.Client)
 		appLogger.Info("Scheduler repository initialized with Redis")
 	} else {
-		schedulerRepo = postgresRepo.NewSchedulerRepository(db.Pool, nil)
+		schedulerRepo = postgresRepo.NewSchedulerRepository(pools.Write, nil)
 		appLogger.Warn("Scheduler repository initialized without Redis")
 	}
 
+	// Сводка по парку проверок общая для всех экземпляров, если доступен Redis
+	var fleetRepo repository.FleetRepository
+	if redisClient != nil && redisClient.Client != nil {
+		fleetRepo = redisRepo.NewFleetRepository(redisClient.Client)
+	} else {
+		fleetRepo = memoryRepo.NewFleetRepository()
+	}
+	fleetUseCase := usecase.NewFleetUseCase(fleetRepo, appLogger)
+	if err := fleetUseCase.Load(ctx, checkRepo); err != nil {
+		appLogger.Warn("Failed to load fleet summary", logger.Error(err))
+	}
+	if err := prometheus.Register(fleetUseCase); err != nil {
+		appLogger.Warn("Failed to register fleet metrics", logger.Error(err))
+	}
+
 	// Initialize use case
-	checkUseCase := usecase.NewCheckUseCase(checkRepo, schedulerRepo, appLogger)
+	tagUseCase := usecase.NewTagUseCase(tagRepo, appLogger)
+	groupUseCase := usecase.NewGroupUseCase(groupRepo, checkRepo, schedulerRepo, appLogger).
+		WithFleet(fleetUseCase)
+	checkUseCase := usecase.NewCheckUseCase(checkRepo, schedulerRepo, appLogger).
+		WithTags(tagUseCase).
+		WithGroups(groupUseCase).
+		WithPlans(tenantRepo).
+		WithFleet(fleetUseCase)
+	// Оценка нагрузки читает результаты проверок из пула чтения
+	capacityUseCase := usecase.NewCapacityUseCase(checkRepo, appLogger).
+		WithResults(postgresRepo.NewCheckResultRepository(pools.Read)).
+		WithPlans(tenantRepo)
+
+	// Самомониторинг: проверки компонентов платформы в мета-tenant и аварийное оповещение
+	// в обход RabbitMQ о компонентах, без которых конвейер проверок не работает
+	monitorCtx, stopMonitor := context.WithCancel(ctx)
+	defer stopMonitor()
+	if monitoring := cfg.SelfMonitoring; monitoring.Enabled {
+		components := make([]domain.PlatformComponent, 0, len(monitoring.Components))
+		for _, component := range monitoring.Components {
+			components = append(components, domain.PlatformComponent{
+				Name:   component.Name,
+				Type:   domain.CheckType(component.Type),
+				Target: component.Target,
+			})
+		}
+		selfMonitoring := usecase.NewSelfMonitoringUseCase(checkRepo, schedulerRepo, appLogger)
+		if err := selfMonitoring.Bootstrap(ctx, monitoring.TenantID, components,
+			int(monitoring.Interval.Seconds()), int(monitoring.Timeout.Seconds())); err != nil {
+			appLogger.Error("Failed to bootstrap platform self-monitoring", logger.Error(err))
+		}
+		go breakglass.NewFromConfig("scheduler-service", monitoring, cfg.Providers.Email, appLogger).
+			Run(monitorCtx, monitoring.BreakGlass.Interval)
+	}
+
+	// Диспетчеризация задач в RabbitMQ: задачи tenant публикуются в его виртуальную очередь,
+	// которую core-service обрабатывает отдельной подпиской
+	var schedulerUseCase *usecase.SchedulerUseCase
+	if cfg.RabbitMQ.URL != "" && cfg.RabbitMQ.Queue != "" {
+		var rabbitConn *rabbitmq.Connection
+		rabbitConfig := rabbitmq.NewConfig()
+		rabbitConfig.URL = cfg.RabbitMQ.URL
+		rabbitConfig.RoutingKey = cfg.RabbitMQ.Queue
+		// Повторы подключения выполняет политика запуска зависимостей
+		rabbitConfig.MaxRetries = 0
+		if err := dependencies.Start(ctx, health.Dependency{
+			Name:     "rabbitmq",
+			Required: true,
+			Connect: func(ctx context.Context) (err error) {
+				rabbitConn, err = rabbitmq.Connect(ctx, rabbitConfig)
+				return err
+			},
+			Ping: func(ctx context.Context) error {
+				if rabbitConn.Channel().IsClosed() {
+					return fmt.Errorf("rabbitmq channel is closed")
+				}
+				return nil
+			},
+		}); err != nil {
+			log.Fatalf("RabbitMQ connection failed: %v", err)
+		}
+		defer rabbitConn.Close()
+
+		tenantRouting := rabbitmq.NewTenantRouting(cfg.RabbitMQ.Queue, cfg.RabbitMQ.VirtualQueues)
+		if tenantRouting.Enabled() {
+			// Exchange объявляется и consumer'ом core-service; публикация не должна зависеть от порядка запуска
+			if err := rabbitConn.Channel().ExchangeDeclare(tenantRouting.Exchange(), "topic", true, false, false, false, nil); err != nil {
+				log.Fatalf("Failed to declare task exchange: %v", err)
+			}
+		}
+		taskProducer := producer.NewTaskProducer(rabbitmq.NewProducer(rabbitConn, rabbitConfig), tenantRouting)
+
+		var lockRepo repository.LockRepository
+		if redisClient != nil && redisClient.Client != nil {
+			lockRepo = redisRepo.NewRedisLockRepository(redisClient.Client)
+		} else {
+			appLogger.Warn("Scheduler locks are local to this instance without Redis")
+			lockRepo = memoryRepo.NewLockRepository()
+		}
+		schedulerUseCase = usecase.NewSchedulerUseCase(checkRepo, postgresRepo.NewTaskRepository(pools.Write), lockRepo, schedulerRepo, taskProducer, appLogger).
+			WithTenants(tenantRepo)
+		if err := schedulerUseCase.Start(ctx); err != nil {
+			log.Fatalf("Failed to start scheduler: %v", err)
+		}
+		appLogger.Info("Task dispatch enabled",
+			logger.String("queue", cfg.RabbitMQ.Queue),
+			logger.Int("virtual_queues", cfg.RabbitMQ.VirtualQueues))
+	}
 
 	appLogger.Info("Starting gRPC server...")
 	grpcPort := cfg.Server.Port
@@ -117,20 +284,37 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// Внедрение сбоев включается только конфигурацией chaos для проверки устойчивости в staging
+	faultInjector, err := chaos.NewInjector("scheduler-service", cfg.Chaos)
+	if err != nil {
+		log.Fatalf("Failed to create fault injector: %v", err)
+	}
+	if faultInjector.Enabled() {
+		appLogger.Warn("Fault injection is enabled for gRPC server")
+	}
+
+	serverOptions := append(pkg_grpc.ServerOptions(cfg.GRPC), pkg_grpc.FaultInjectionServerOptions(faultInjector)...)
+	grpcServer := grpc.NewServer(serverOptions...)
 
 	appLogger.Info("Creating gRPC handler...")
-	schedulerHandler := grpcHandler.NewHandlerFixed(checkUseCase, appLogger)
+	schedulerHandler := grpcHandler.NewHandlerFixed(checkUseCase, tagUseCase, groupUseCase, appLogger).
+		WithFleet(fleetUseCase)
 	appLogger.Info("gRPC handler created successfully")
 
 	appLogger.Info("Registering gRPC service...")
 	schedulerv1.RegisterSchedulerServiceServer(grpcServer, schedulerHandler)
+	schedulerv1beta1.RegisterSchedulerServiceServer(grpcServer, grpcHandler.NewCapacityHandler(capacityUseCase, appLogger))
+	// grpc.health.v1 для проверок здоровья подканалов на стороне клиентов
+	healthServer := pkg_grpc.RegisterHealthServer(grpcServer, schedulerv1.SchedulerService_ServiceDesc.ServiceName)
+	if pkg_grpc.RegisterReflection(grpcServer, cfg.GRPC, cfg.Environment) {
+		appLogger.Info("gRPC reflection is enabled")
+	}
 	appLogger.Info("gRPC service registered successfully")
 
 	// Start gRPC server
 	go func() {
 		appLogger.Info(fmt.Sprintf("Starting gRPC server on port %d", grpcPort))
-		if err := grpcServer.Serve(lis); err != nil {
+		if err := grpcServer.Serve(pkg_grpc.LimitListener(lis, cfg.GRPC)); err != nil {
 			appLogger.Error("gRPC server failed", logger.Error(err))
 		}
 	}()
@@ -142,7 +326,7 @@ func main() {
 	// Start HTTP server for metrics and health
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Server.Port+1000), // Health check on port +1000
-		Handler: setupHTTPHandler(metricsHandler, healthChecker, appLogger),
+		Handler: setupHTTPHandler(metricsHandler, healthHistory, appLogger),
 	}
 
 	// Start server
@@ -163,7 +347,15 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Graceful shutdown gRPC server
+	stopMonitor()
+	if schedulerUseCase != nil {
+		if err := schedulerUseCase.Stop(ctx); err != nil {
+			appLogger.Error("Scheduler shutdown failed", logger.Error(err))
+		}
+	}
+
+	// Клиенты исключают реплику из балансировки до остановки gRPC сервера
+	healthServer.Shutdown()
 	grpcServer.GracefulStop()
 
 	// Graceful shutdown HTTP server
@@ -174,7 +366,7 @@ func main() {
 	appLogger.Info("Server exited properly")
 }
 
-func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthChecker, appLogger logger.Logger) http.Handler {
+func setupHTTPHandler(metricsHandler http.Handler, healthHistory *health.History, appLogger logger.Logger) http.Handler {
 	mux := http.NewServeMux()
 
 	// Metrics endpoint
@@ -186,10 +378,8 @@ func setupHTTPHandler(metricsHandler http.Handler, healthChecker health.HealthCh
 		w.Write([]byte(`{"status":"healthy","service":"scheduler-service"}`))
 	})
 
-	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"status":"ready","service":"scheduler-service"}`))
-	})
+	mux.HandleFunc("/ready", health.ReadyHandler(healthHistory))
+	mux.HandleFunc("/health/history", health.HistoryHandler(healthHistory))
 
 	mux.HandleFunc("/live", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// ConfigKeyAdaptiveInterval ключ конфигурации проверки; false отключает адаптивный интервал для проверки
+const ConfigKeyAdaptiveInterval = "adaptive_interval"
+
+// AdaptiveInterval политика адаптивного интервала: после StableAfter успешных проверок подряд
+// эффективный интервал умножается на Factor (не выше MaxInterval), первая же неудача
+// возвращает базовый интервал проверки
+type AdaptiveInterval struct {
+	Enabled     bool
+	StableAfter int
+	Factor      float64
+	MaxInterval time.Duration
+}
+
+// Validate проверяет параметры включенной политики
+func (a AdaptiveInterval) Validate() error {
+	if !a.Enabled {
+		return nil
+	}
+	if a.StableAfter <= 0 {
+		return fmt.Errorf("adaptive interval stable_after must be positive")
+	}
+	if a.Factor <= 1 {
+		return fmt.Errorf("adaptive interval factor must be greater than 1")
+	}
+	if a.MaxInterval < MinCheckInterval*time.Second || a.MaxInterval > MaxCheckInterval*time.Second {
+		return fmt.Errorf("adaptive interval max_interval must be between %ds and %ds", MinCheckInterval, MaxCheckInterval)
+	}
+	return nil
+}
+
+// AppliesTo сообщает, растягивается ли интервал проверки
+func (a AdaptiveInterval) AppliesTo(check *Check) bool {
+	if !a.Enabled || a.StableAfter <= 0 || a.Factor <= 1 {
+		return false
+	}
+	if enabled, ok := check.Config[ConfigKeyAdaptiveInterval].(bool); ok && !enabled {
+		return false
+	}
+	return a.MaxInterval > check.GetIntervalDuration()
+}
+
+// EffectiveInterval возвращает интервал с учетом числа успешных проверок подряд:
+// каждые StableAfter успехов растягивают интервал в Factor раз до MaxInterval
+func (a AdaptiveInterval) EffectiveInterval(base time.Duration, successes int) time.Duration {
+	if !a.Enabled || a.StableAfter <= 0 || a.Factor <= 1 || a.MaxInterval <= base {
+		return base
+	}
+
+	interval := base
+	for step := successes / a.StableAfter; step > 0; step-- {
+		interval = time.Duration(float64(interval) * a.Factor)
+		if interval >= a.MaxInterval {
+			return a.MaxInterval
+		}
+	}
+	return interval
+}
+
+// SuccessesToCap число успешных проверок подряд, после которого интервал достигает
+// MaxInterval. Больше результатов для расчета эффективного интервала читать не нужно
+func (a AdaptiveInterval) SuccessesToCap(base time.Duration) int {
+	if !a.Enabled || a.StableAfter <= 0 || a.Factor <= 1 || base <= 0 || a.MaxInterval <= base {
+		return 0
+	}
+
+	steps := 0
+	for interval := base; interval < a.MaxInterval; steps++ {
+		interval = time.Duration(float64(interval) * a.Factor)
+	}
+	return steps * a.StableAfter
+}
+
+// Due сообщает, пора ли запускать проверку по эффективному интервалу, и возвращает его.
+// Cron срабатывает с базовым интервалом, поэтому запуск, до которого осталось меньше
+// половины базового интервала, не откладывается до следующего срабатывания
+func (a AdaptiveInterval) Due(check *Check, successes int, now time.Time) (bool, time.Duration) {
+	base := check.GetIntervalDuration()
+	if !a.AppliesTo(check) || check.LastRunAt == nil {
+		return true, base
+	}
+
+	effective := a.EffectiveInterval(base, successes)
+	return now.Sub(*check.LastRunAt)+base/2 >= effective, effective
+}
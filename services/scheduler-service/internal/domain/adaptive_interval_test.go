@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testAdaptiveInterval() AdaptiveInterval {
+	return AdaptiveInterval{Enabled: true, StableAfter: 5, Factor: 2, MaxInterval: 10 * time.Minute}
+}
+
+func TestAdaptiveInterval_Validate(t *testing.T) {
+	assert.NoError(t, AdaptiveInterval{}.Validate())
+	assert.NoError(t, testAdaptiveInterval().Validate())
+
+	invalid := []AdaptiveInterval{
+		{Enabled: true, StableAfter: 0, Factor: 2, MaxInterval: time.Hour},
+		{Enabled: true, StableAfter: 5, Factor: 1, MaxInterval: time.Hour},
+		{Enabled: true, StableAfter: 5, Factor: 2, MaxInterval: time.Second},
+		{Enabled: true, StableAfter: 5, Factor: 2, MaxInterval: 48 * time.Hour},
+	}
+	for _, policy := range invalid {
+		assert.Error(t, policy.Validate(), "%+v", policy)
+	}
+}
+
+func TestAdaptiveInterval_EffectiveInterval(t *testing.T) {
+	policy := testAdaptiveInterval()
+	base := time.Minute
+
+	assert.Equal(t, base, policy.EffectiveInterval(base, 0))
+	assert.Equal(t, base, policy.EffectiveInterval(base, 4))
+	assert.Equal(t, 2*time.Minute, policy.EffectiveInterval(base, 5))
+	assert.Equal(t, 4*time.Minute, policy.EffectiveInterval(base, 12))
+	assert.Equal(t, 8*time.Minute, policy.EffectiveInterval(base, 15))
+	assert.Equal(t, 10*time.Minute, policy.EffectiveInterval(base, 20))
+	assert.Equal(t, 10*time.Minute, policy.EffectiveInterval(base, 1000))
+
+	assert.Equal(t, base, AdaptiveInterval{}.EffectiveInterval(base, 1000))
+	assert.Equal(t, time.Hour, policy.EffectiveInterval(time.Hour, 1000), "base above cap is never shortened")
+}
+
+func TestAdaptiveInterval_SuccessesToCap(t *testing.T) {
+	policy := testAdaptiveInterval()
+
+	assert.Equal(t, 20, policy.SuccessesToCap(time.Minute))
+	assert.Equal(t, policy.MaxInterval, policy.EffectiveInterval(time.Minute, policy.SuccessesToCap(time.Minute)))
+	assert.Zero(t, policy.SuccessesToCap(10*time.Minute))
+	assert.Zero(t, AdaptiveInterval{}.SuccessesToCap(time.Minute))
+}
+
+func TestAdaptiveInterval_Due(t *testing.T) {
+	policy := testAdaptiveInterval()
+	now := time.Now()
+	lastRun := now.Add(-time.Minute)
+	check := &Check{ID: "check-1", Interval: 60, LastRunAt: &lastRun}
+
+	due, effective := policy.Due(check, 0, now)
+	assert.True(t, due)
+	assert.Equal(t, time.Minute, effective)
+
+	due, effective = policy.Due(check, 5, now)
+	assert.False(t, due, "stable check waits for the stretched interval")
+	assert.Equal(t, 2*time.Minute, effective)
+
+	// Срабатывание cron чуть раньше срока не откладывает запуск на целый базовый интервал
+	lastRun = now.Add(-2*time.Minute + time.Second)
+	due, _ = policy.Due(check, 5, now)
+	assert.True(t, due)
+
+	check.LastRunAt = nil
+	due, _ = policy.Due(check, 20, now)
+	assert.True(t, due, "never run check is due")
+}
+
+func TestAdaptiveInterval_AppliesTo(t *testing.T) {
+	policy := testAdaptiveInterval()
+
+	assert.True(t, policy.AppliesTo(&Check{Interval: 60}))
+	assert.False(t, policy.AppliesTo(&Check{Interval: 60, Config: CheckConfig{ConfigKeyAdaptiveInterval: false}}))
+	assert.True(t, policy.AppliesTo(&Check{Interval: 60, Config: CheckConfig{ConfigKeyAdaptiveInterval: true}}))
+	assert.False(t, policy.AppliesTo(&Check{Interval: 600}), "interval already at cap")
+	assert.False(t, AdaptiveInterval{}.AppliesTo(&Check{Interval: 60}))
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ConfigKeyRegions ключ конфигурации проверки с регионами выполнения: список или строка через
+// запятую. Core Service выполняет проверку в каждом регионе, поэтому регионы умножают нагрузку
+const ConfigKeyRegions = "regions"
+
+// RegionCount возвращает число различных регионов выполнения проверки; без регионов - один
+func (c *Check) RegionCount() int {
+	var regions []string
+	switch value := c.Config[ConfigKeyRegions].(type) {
+	case string:
+		regions = strings.Split(value, ",")
+	case []string:
+		regions = value
+	case []interface{}:
+		for _, item := range value {
+			if region, ok := item.(string); ok {
+				regions = append(regions, region)
+			}
+		}
+	}
+
+	seen := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		if region = strings.TrimSpace(region); region != "" {
+			seen[region] = true
+		}
+	}
+	if len(seen) == 0 {
+		return 1
+	}
+	return len(seen)
+}
+
+// ExecutionsPerMinute возвращает число выполнений проверки в минуту во всех регионах
+// при интервале interval секунд
+func (c *Check) ExecutionsPerMinute(interval int) float64 {
+	if interval <= 0 {
+		return 0
+	}
+	return 60 / float64(interval) * float64(c.RegionCount())
+}
+
+// CapacityEstimate нагрузка проверок на Core Service: выполнения в минуту и единицы
+// стоимости в минуту (секунды выполнения, как в учете стоимости Core Service)
+type CapacityEstimate struct {
+	ExecutionsPerMinute float64
+	CostUnitsPerMinute  float64
+}
+
+// Sub возвращает разницу нагрузок
+func (e CapacityEstimate) Sub(other CapacityEstimate) CapacityEstimate {
+	return CapacityEstimate{
+		ExecutionsPerMinute: e.ExecutionsPerMinute - other.ExecutionsPerMinute,
+		CostUnitsPerMinute:  e.CostUnitsPerMinute - other.CostUnitsPerMinute,
+	}
+}
+
+// RejectedIntervalChange проверка, которой нельзя задать предложенный интервал
+type RejectedIntervalChange struct {
+	CheckID string
+	Name    string
+	Err     *ValidationError
+}
+
+// IntervalChangePreview прогноз изменения нагрузки, если всем проверкам с тегом задать
+// интервал Interval. Выключенные проверки не создают нагрузки, а отклоненные проверки
+// сохраняют текущий интервал и в прогнозе
+type IntervalChangePreview struct {
+	Tag           string
+	Interval      int
+	MatchedChecks int
+	ActiveChecks  int
+	ChangedChecks int
+	Current       CapacityEstimate
+	Proposed      CapacityEstimate
+	Rejected      []RejectedIntervalChange
+}
+
+// Delta возвращает изменение нагрузки
+func (p *IntervalChangePreview) Delta() CapacityEstimate {
+	return p.Proposed.Sub(p.Current)
+}
+
+// PreviewIntervalChange оценивает изменение нагрузки проверок с тегом при интервале interval.
+// Длительность выполнения берется из durations (среднее по последним результатам), а для
+// проверок без результатов оценивается сверху таймаутом. Базовый интервал не учитывает
+// растягивание адаптивным интервалом, поэтому оценка консервативна
+func PreviewIntervalChange(checks []*Check, tag string, interval, minInterval int, durations map[string]time.Duration) *IntervalChangePreview {
+	preview := &IntervalChangePreview{Tag: tag, Interval: interval}
+
+	for _, check := range checks {
+		if !check.HasTag(tag) {
+			continue
+		}
+		preview.MatchedChecks++
+
+		proposed := interval
+		if check.Interval != interval {
+			if err := ValidateScheduleWithin(interval, check.Timeout, minInterval, check.MaxInterval()); err != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					validationErr = &ValidationError{Field: FieldInterval, Reason: ReasonOutOfRange, Message: err.Error()}
+				}
+				preview.Rejected = append(preview.Rejected, RejectedIntervalChange{CheckID: check.ID, Name: check.Name, Err: validationErr})
+				proposed = check.Interval
+			}
+		}
+
+		if !check.Enabled {
+			continue
+		}
+		preview.ActiveChecks++
+		if proposed != check.Interval {
+			preview.ChangedChecks++
+		}
+
+		duration, ok := durations[check.ID]
+		if !ok || duration <= 0 {
+			duration = check.GetTimeoutDuration()
+		}
+		current := check.ExecutionsPerMinute(check.Interval)
+		next := check.ExecutionsPerMinute(proposed)
+		preview.Current.ExecutionsPerMinute += current
+		preview.Current.CostUnitsPerMinute += current * duration.Seconds()
+		preview.Proposed.ExecutionsPerMinute += next
+		preview.Proposed.CostUnitsPerMinute += next * duration.Seconds()
+	}
+
+	return preview
+}
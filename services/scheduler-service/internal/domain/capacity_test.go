@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck_RegionCount(t *testing.T) {
+	assert.Equal(t, 1, (&Check{}).RegionCount())
+	assert.Equal(t, 1, (&Check{Config: CheckConfig{ConfigKeyRegions: ""}}).RegionCount())
+	assert.Equal(t, 2, (&Check{Config: CheckConfig{ConfigKeyRegions: "eu, us,eu"}}).RegionCount())
+	assert.Equal(t, 3, (&Check{Config: CheckConfig{ConfigKeyRegions: []interface{}{"eu", "us", "ap"}}}).RegionCount())
+	assert.Equal(t, 2, (&Check{Config: CheckConfig{ConfigKeyRegions: []string{"eu", "us"}}}).RegionCount())
+}
+
+func TestPreviewIntervalChange(t *testing.T) {
+	checks := []*Check{
+		{ID: "api", Name: "API", Type: CheckTypeHTTP, Interval: 60, Timeout: 10, Enabled: true, Tags: []string{"prod"},
+			Config: CheckConfig{ConfigKeyRegions: []interface{}{"eu", "us"}}},
+		{ID: "web", Name: "Web", Type: CheckTypeHTTP, Interval: 300, Timeout: 5, Enabled: true, Tags: []string{"prod", "web"}},
+		{ID: "slow", Name: "Slow", Type: CheckTypeHTTP, Interval: 60, Timeout: 40, Enabled: true, Tags: []string{"prod"}},
+		{ID: "paused", Name: "Paused", Type: CheckTypeHTTP, Interval: 60, Timeout: 10, Enabled: false, Tags: []string{"prod"}},
+		{ID: "other", Name: "Other", Type: CheckTypeHTTP, Interval: 60, Timeout: 10, Enabled: true, Tags: []string{"staging"}},
+	}
+	durations := map[string]time.Duration{"api": 500 * time.Millisecond, "slow": 2 * time.Second}
+
+	preview := PreviewIntervalChange(checks, "prod", 30, 10, durations)
+
+	assert.Equal(t, 4, preview.MatchedChecks)
+	assert.Equal(t, 3, preview.ActiveChecks)
+	assert.Equal(t, 2, preview.ChangedChecks)
+
+	// api: 2 региона, 2 -> 4 выполнения в минуту по 0.5 с; web: 0.2 -> 2 по таймауту 5 с;
+	// slow отклонена (таймаут 40 с не меньше интервала) и остается на 1 выполнении по 2 с
+	assert.InDelta(t, 3.2, preview.Current.ExecutionsPerMinute, 1e-9)
+	assert.InDelta(t, 7.0, preview.Proposed.ExecutionsPerMinute, 1e-9)
+	assert.InDelta(t, 1+1+2, preview.Current.CostUnitsPerMinute, 1e-9)
+	assert.InDelta(t, 2+10+2, preview.Proposed.CostUnitsPerMinute, 1e-9)
+	assert.InDelta(t, 3.8, preview.Delta().ExecutionsPerMinute, 1e-9)
+	assert.InDelta(t, 10, preview.Delta().CostUnitsPerMinute, 1e-9)
+
+	require.Len(t, preview.Rejected, 1)
+	assert.Equal(t, "slow", preview.Rejected[0].CheckID)
+	assert.Equal(t, FieldTimeout, preview.Rejected[0].Err.Field)
+	assert.Equal(t, ReasonTimeoutExceedsInterval, preview.Rejected[0].Err.Reason)
+}
+
+func TestPreviewIntervalChange_BelowPlanMinimum(t *testing.T) {
+	checks := []*Check{
+		{ID: "api", Type: CheckTypeHTTP, Interval: 60, Timeout: 5, Enabled: true, Tags: []string{"prod"}},
+		{ID: "fast", Type: CheckTypeHTTP, Interval: 10, Timeout: 5, Enabled: true, Tags: []string{"prod"}},
+	}
+
+	preview := PreviewIntervalChange(checks, "prod", 10, 60, nil)
+
+	// Проверка с тем же интервалом не отклоняется, даже если он ниже минимума плана
+	require.Len(t, preview.Rejected, 1)
+	assert.Equal(t, "api", preview.Rejected[0].CheckID)
+	assert.Equal(t, ReasonBelowPlanMinimum, preview.Rejected[0].Err.Reason)
+	assert.Equal(t, 0, preview.ChangedChecks)
+	assert.Zero(t, preview.Delta())
+}
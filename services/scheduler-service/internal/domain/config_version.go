@@ -0,0 +1,20 @@
+package domain
+
+import "encoding/json"
+
+// InitialConfigVersion версия конфигурации созданной проверки
+const InitialConfigVersion = 1
+
+// ExecutionChanged сообщает, выполняется ли проверка иначе, чем previous: изменились тип, цель,
+// таймаут, конфигурация или включенность. Только такие изменения увеличивают ConfigVersion;
+// время запусков, имя, теги и владельцы на выполнение не влияют
+func (c *Check) ExecutionChanged(previous *Check) bool {
+	if c.Type != previous.Type || c.Target != previous.Target ||
+		c.Timeout != previous.Timeout || c.Enabled != previous.Enabled {
+		return true
+	}
+	// Конфигурация сравнивается в JSON, как в БД: числа из YAML и из JSON равны
+	current, _ := json.Marshal(c.Config)
+	before, _ := json.Marshal(previous.Config)
+	return string(current) != string(before)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck_ExecutionChanged(t *testing.T) {
+	base := Check{Type: CheckTypeHTTP, Target: "https://example.com", Timeout: 10, Enabled: true,
+		Interval: 60, Name: "API", Config: CheckConfig{"expected_status": 200}}
+
+	same := base
+	same.Interval = 300
+	same.Name = "Public API"
+	same.Tags = []string{"prod"}
+	same.Config = CheckConfig{"expected_status": float64(200)}
+	assert.False(t, same.ExecutionChanged(&base), "schedule, name, tags and numeric types do not change execution")
+
+	for name, change := range map[string]func(c *Check){
+		"target":  func(c *Check) { c.Target = "https://example.org" },
+		"type":    func(c *Check) { c.Type = CheckTypeTCP },
+		"timeout": func(c *Check) { c.Timeout = 5 },
+		"enabled": func(c *Check) { c.Enabled = false },
+		"config":  func(c *Check) { c.Config = CheckConfig{"expected_status": 204} },
+	} {
+		changed := base
+		change(&changed)
+		assert.True(t, changed.ExecutionChanged(&base), name)
+	}
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Ключи конфигурации DNS проверки
+const (
+	// ConfigKeyDNSRecordType тип записи: A (по умолчанию), AAAA, CNAME, MX или TXT
+	ConfigKeyDNSRecordType = "record_type"
+	// ConfigKeyDNSResolver адрес резолвера host или host:port; без него используется резолвер core-service
+	ConfigKeyDNSResolver = "resolver"
+	// ConfigKeyDNSExpected ожидаемое значение записи или список значений
+	ConfigKeyDNSExpected = "expected"
+)
+
+// DNSRecordTypes типы записей, которые разрешает DNS проверка
+var DNSRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT"}
+
+// validateDNSConfig проверяет тип записи, адрес резолвера и ожидаемые значения DNS проверки
+func validateDNSConfig(config CheckConfig) error {
+	if value, ok := config[ConfigKeyDNSRecordType]; ok {
+		recordType, _ := value.(string)
+		supported := false
+		for _, known := range DNSRecordTypes {
+			if strings.EqualFold(recordType, known) {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("config.%s must be one of %s", ConfigKeyDNSRecordType, strings.Join(DNSRecordTypes, ", "))
+		}
+	}
+	if value, ok := config[ConfigKeyDNSResolver]; ok {
+		resolver, _ := value.(string)
+		resolver = strings.TrimSpace(resolver)
+		host := resolver
+		if h, port, err := net.SplitHostPort(resolver); err == nil {
+			if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+				host = ""
+			} else {
+				host = h
+			}
+		}
+		if host == "" || strings.ContainsAny(resolver, "/ ") {
+			return fmt.Errorf("config.%s must be a host or host:port", ConfigKeyDNSResolver)
+		}
+	}
+	if value, ok := config[ConfigKeyDNSExpected]; ok {
+		switch v := value.(type) {
+		case string:
+		case []string:
+		case []interface{}:
+			for _, item := range v {
+				if _, ok := item.(string); !ok {
+					return fmt.Errorf("config.%s must be a string or a list of strings", ConfigKeyDNSExpected)
+				}
+			}
+		default:
+			return fmt.Errorf("config.%s must be a string or a list of strings", ConfigKeyDNSExpected)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck_DNSConfig(t *testing.T) {
+	check := &Check{TenantID: "t1", Name: "dns", Type: CheckTypeDNS, Target: "example.com", Interval: 60, Timeout: 10}
+	assert.NoError(t, check.Validate())
+
+	valid := []CheckConfig{
+		{ConfigKeyDNSRecordType: "mx", ConfigKeyDNSExpected: "10 mail.example.com"},
+		{ConfigKeyDNSRecordType: "TXT", ConfigKeyDNSExpected: []interface{}{"v=spf1 -all"}},
+		{ConfigKeyDNSResolver: "1.1.1.1"},
+		{ConfigKeyDNSResolver: "[2606:4700:4700::1111]:53"},
+	}
+	for _, config := range valid {
+		check.Config = config
+		assert.NoError(t, check.Validate(), config)
+	}
+
+	invalid := []CheckConfig{
+		{ConfigKeyDNSRecordType: "SRV"},
+		{ConfigKeyDNSResolver: ""},
+		{ConfigKeyDNSResolver: "https://dns.example/query"},
+		{ConfigKeyDNSExpected: []interface{}{"1.2.3.4", 5.0}},
+	}
+	for _, config := range invalid {
+		check.Config = config
+		assert.Error(t, check.Validate(), config)
+	}
+
+	check.Config = nil
+	check.Target = "https://example.com"
+	assert.Error(t, check.Validate())
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Интервал проверки срока регистрации домена в секундах: срок меняется редко, поэтому по
+// умолчанию домен проверяется раз в неделю
+const (
+	DefaultDomainCheckInterval = 7 * 86400
+	MaxDomainCheckInterval     = DefaultDomainCheckInterval
+	// DefaultDomainCheckTimeout таймаут запросов RDAP и WHOIS по умолчанию
+	DefaultDomainCheckTimeout = 30
+)
+
+// ConfigKeyExpiryWindowDays ключ конфигурации проверки домена: за сколько дней до окончания
+// регистрации проверка становится неуспешной
+const ConfigKeyExpiryWindowDays = "expiry_window_days"
+
+// MaxExpiryWindowDays наибольшее окно предупреждения об окончании регистрации домена
+const MaxExpiryWindowDays = 365
+
+// ValidateDomainTarget проверяет, что цель проверки домена - имя хоста без схемы, пути и порта
+func ValidateDomainTarget(target string) error {
+	name := strings.TrimSuffix(strings.TrimSpace(target), ".")
+	if name == "" || strings.ContainsAny(name, ":/ ") || !strings.Contains(name, ".") {
+		return fmt.Errorf("domain check target must be a domain name, got %q", target)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 {
+			return fmt.Errorf("domain check target has an invalid label: %q", target)
+		}
+	}
+	return nil
+}
+
+// validateDomainConfig проверяет окно предупреждения в конфигурации проверки домена.
+// Число приходит как float64 из JSON и как int из YAML standalone конфигурации
+func validateDomainConfig(config CheckConfig) error {
+	value, ok := config[ConfigKeyExpiryWindowDays]
+	if !ok {
+		return nil
+	}
+	var days float64
+	switch v := value.(type) {
+	case float64:
+		days = v
+	case int:
+		days = float64(v)
+	default:
+		ok = false
+	}
+	if !ok || days < 1 || days > MaxExpiryWindowDays || days != float64(int(days)) {
+		return fmt.Errorf("config.%s must be a whole number of days between 1 and %d", ConfigKeyExpiryWindowDays, MaxExpiryWindowDays)
+	}
+	return nil
+}
+
+// MaxInterval возвращает наибольший допустимый интервал проверки в секундах
+func (c *Check) MaxInterval() int {
+	if c.Type == CheckTypeDomain {
+		return MaxDomainCheckInterval
+	}
+	return MaxCheckInterval
+}
+
+// ApplyTypeDefaults задает интервал и таймаут по умолчанию для типов проверок со своими
+// значениями; сейчас это проверка домена с еженедельным интервалом
+func (c *Check) ApplyTypeDefaults() {
+	if c.Type != CheckTypeDomain {
+		return
+	}
+	if c.Interval == 0 {
+		c.Interval = DefaultDomainCheckInterval
+	}
+	if c.Timeout == 0 {
+		c.Timeout = DefaultDomainCheckTimeout
+	}
+}
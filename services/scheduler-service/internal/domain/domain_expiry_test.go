@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDomainTarget(t *testing.T) {
+	for _, target := range []string{"example.com", "shop.example.co.uk", "example.com."} {
+		assert.NoError(t, ValidateDomainTarget(target), target)
+	}
+	for _, target := range []string{"", "localhost", "https://example.com", "example.com:443", "example..com"} {
+		assert.Error(t, ValidateDomainTarget(target), target)
+	}
+}
+
+func TestCheck_DomainDefaults(t *testing.T) {
+	check := &Check{TenantID: "t1", Name: "domain", Type: CheckTypeDomain, Target: "example.com"}
+	check.ApplyTypeDefaults()
+	assert.Equal(t, DefaultDomainCheckInterval, check.Interval)
+	assert.Equal(t, DefaultDomainCheckTimeout, check.Timeout)
+	require.NoError(t, check.Validate())
+
+	// Неделя допустима только для проверки домена
+	site := &Check{TenantID: "t1", Name: "site", Type: CheckTypeHTTP, Target: "https://example.com", Interval: DefaultDomainCheckInterval, Timeout: 30}
+	site.ApplyTypeDefaults()
+	assert.Error(t, site.Validate())
+
+	check.Config = CheckConfig{ConfigKeyExpiryWindowDays: float64(14)}
+	assert.NoError(t, check.Validate())
+	check.Config = CheckConfig{ConfigKeyExpiryWindowDays: float64(0)}
+	assert.Error(t, check.Validate())
+	check.Config = CheckConfig{ConfigKeyExpiryWindowDays: 30}
+	assert.NoError(t, check.Validate())
+	check.Config = CheckConfig{ConfigKeyExpiryWindowDays: "30"}
+	assert.Error(t, check.Validate())
+}
@@ -0,0 +1,147 @@
+package domain
+
+import "fmt"
+
+// CheckHealth последнее состояние здоровья проверки по результатам выполнения
+type CheckHealth string
+
+const (
+	CheckHealthUnknown  CheckHealth = ""
+	CheckHealthUp       CheckHealth = "up"
+	CheckHealthFailing  CheckHealth = "failing"
+	CheckHealthDegraded CheckHealth = "degraded"
+)
+
+// ParseCheckHealth разбирает состояние здоровья, о котором сообщает Core Service
+func ParseCheckHealth(value string) (CheckHealth, error) {
+	switch health := CheckHealth(value); health {
+	case CheckHealthUp, CheckHealthFailing, CheckHealthDegraded:
+		return health, nil
+	default:
+		return CheckHealthUnknown, fmt.Errorf("invalid check health: %q", value)
+	}
+}
+
+// FleetState состояние проверки в сводке по парку проверок
+type FleetState string
+
+const (
+	FleetStateUp       FleetState = "up"
+	FleetStateFailing  FleetState = "failing"
+	FleetStateDegraded FleetState = "degraded"
+	FleetStatePaused   FleetState = "paused"
+	FleetStateUnknown  FleetState = "unknown"
+)
+
+// FleetStates все состояния сводки в порядке вывода
+var FleetStates = []FleetState{FleetStateUp, FleetStateFailing, FleetStateDegraded, FleetStatePaused, FleetStateUnknown}
+
+// FleetEntry то, что сводка знает о проверке
+type FleetEntry struct {
+	TenantID string
+	Enabled  bool
+	Health   CheckHealth
+}
+
+// State состояние проверки в сводке: выключенная проверка на паузе независимо от здоровья,
+// включенная без результатов - unknown
+func (e FleetEntry) State() FleetState {
+	switch {
+	case !e.Enabled:
+		return FleetStatePaused
+	case e.Health == CheckHealthUnknown:
+		return FleetStateUnknown
+	default:
+		return FleetState(e.Health)
+	}
+}
+
+// FleetCounts число проверок по состояниям
+type FleetCounts struct {
+	Total    int `json:"total"`
+	Up       int `json:"up"`
+	Failing  int `json:"failing"`
+	Degraded int `json:"degraded"`
+	Paused   int `json:"paused"`
+	Unknown  int `json:"unknown"`
+}
+
+// Add изменяет счетчик состояния на delta, не меняя Total
+func (c *FleetCounts) Add(state FleetState, delta int) {
+	switch state {
+	case FleetStateUp:
+		c.Up += delta
+	case FleetStateFailing:
+		c.Failing += delta
+	case FleetStateDegraded:
+		c.Degraded += delta
+	case FleetStatePaused:
+		c.Paused += delta
+	case FleetStateUnknown:
+		c.Unknown += delta
+	}
+}
+
+// Get возвращает счетчик состояния
+func (c FleetCounts) Get(state FleetState) int {
+	switch state {
+	case FleetStateUp:
+		return c.Up
+	case FleetStateFailing:
+		return c.Failing
+	case FleetStateDegraded:
+		return c.Degraded
+	case FleetStatePaused:
+		return c.Paused
+	case FleetStateUnknown:
+		return c.Unknown
+	default:
+		return 0
+	}
+}
+
+// Merge прибавляет счетчики other
+func (c *FleetCounts) Merge(other FleetCounts) {
+	c.Total += other.Total
+	for _, state := range FleetStates {
+		c.Add(state, other.Get(state))
+	}
+}
+
+// FleetChange изменение проверки, влияющее на сводку. Enabled задается при создании и изменении
+// проверки, Health - при смене здоровья, Deleted - при удалении
+type FleetChange struct {
+	TenantID string
+	CheckID  string
+	Enabled  *bool
+	Health   CheckHealth
+	Deleted  bool
+}
+
+// Apply применяет изменение к записи проверки. exists - известна ли проверка сводке.
+// Возвращает новую запись и признак ее наличия; здоровье неизвестной проверки
+// не учитывается, чтобы поздние результаты удаленной проверки не вернули ее в сводку.
+// Включение проверки сбрасывает здоровье: результаты до паузы устарели. Изменения
+// с чужим tenant не применяются
+func (c FleetChange) Apply(entry FleetEntry, exists bool) (FleetEntry, bool) {
+	switch {
+	case exists && entry.TenantID != c.TenantID:
+		return entry, true
+	case c.Deleted:
+		return FleetEntry{}, false
+	case c.Enabled != nil:
+		if !exists {
+			return FleetEntry{TenantID: c.TenantID, Enabled: *c.Enabled}, true
+		}
+		if *c.Enabled && !entry.Enabled {
+			entry.Health = CheckHealthUnknown
+		}
+		entry.Enabled = *c.Enabled
+		return entry, true
+	case exists:
+		entry.Health = c.Health
+		return entry, true
+	default:
+		return entry, false
+	}
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxGroupDepth максимальная глубина вложенности групп
+	MaxGroupDepth = 8
+	// maxGroupNameLength максимальная длина имени группы
+	maxGroupNameLength = 255
+	// groupPathSeparator разделитель идентификаторов в материализованном пути группы
+	groupPathSeparator = "/"
+)
+
+var (
+	// ErrGroupNotFound группа не найдена
+	ErrGroupNotFound = errors.New("group not found")
+	// ErrGroupNotEmpty группа содержит подгруппы или проверки и не может быть удалена
+	ErrGroupNotEmpty = errors.New("group is not empty")
+)
+
+// Group представляет группу (папку) проверок. Path хранит материализованный путь
+// из идентификаторов предков вида "/root-id/child-id/", что позволяет выбирать поддерево одним запросом
+type Group struct {
+	ID         string    `json:"id" db:"id"`
+	TenantID   string    `json:"tenant_id" db:"tenant_id"`
+	ParentID   string    `json:"parent_id,omitempty" db:"parent_id"`
+	Name       string    `json:"name" db:"name"`
+	Path       string    `json:"path" db:"path"`
+	Enabled    bool      `json:"enabled" db:"enabled"`
+	CheckCount int       `json:"check_count" db:"check_count"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Validate проверяет корректность группы
+func (g *Group) Validate() error {
+	if g.TenantID == "" {
+		return fmt.Errorf("tenant id is required")
+	}
+	name := strings.TrimSpace(g.Name)
+	if name == "" {
+		return fmt.Errorf("group name is required")
+	}
+	if len(name) > maxGroupNameLength {
+		return fmt.Errorf("group name must not exceed %d characters", maxGroupNameLength)
+	}
+	if g.ParentID != "" && g.ParentID == g.ID {
+		return fmt.Errorf("group cannot be its own parent")
+	}
+	if g.Depth() > MaxGroupDepth {
+		return fmt.Errorf("group nesting must not exceed %d levels", MaxGroupDepth)
+	}
+	return nil
+}
+
+// Depth возвращает уровень вложенности группы (1 для корневой)
+func (g *Group) Depth() int {
+	return len(GroupIDsFromPath(g.Path))
+}
+
+// AttachTo вычисляет путь группы относительно родителя; nil означает корневую группу
+func (g *Group) AttachTo(parent *Group) error {
+	if parent == nil {
+		g.ParentID = ""
+		g.Path = groupPathSeparator + g.ID + groupPathSeparator
+		return nil
+	}
+	if parent.TenantID != g.TenantID {
+		return fmt.Errorf("parent group belongs to another tenant")
+	}
+	if g.ID != "" && PathContainsGroup(parent.Path, g.ID) {
+		return fmt.Errorf("group cannot be moved into its own subtree")
+	}
+	g.ParentID = parent.ID
+	g.Path = parent.Path + g.ID + groupPathSeparator
+	return nil
+}
+
+// Contains проверяет, входит ли путь в поддерево группы (включая саму группу)
+func (g *Group) Contains(path string) bool {
+	return g.Path != "" && strings.HasPrefix(path, g.Path)
+}
+
+// PathContainsGroup проверяет, что группа с groupID является предком пути или самим путем
+func PathContainsGroup(path, groupID string) bool {
+	if path == "" || groupID == "" {
+		return false
+	}
+	return strings.Contains(path, groupPathSeparator+groupID+groupPathSeparator)
+}
+
+// GroupIDsFromPath возвращает идентификаторы групп пути от корня к листу
+func GroupIDsFromPath(path string) []string {
+	var ids []string
+	for _, id := range strings.Split(path, groupPathSeparator) {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Ключи метаданных задачи с группой, тегами и хостом цели проверки. Теги передаются через
+// запятую: имя тега запятую не содержит. По хосту и тегам notification-service сводит
+// уведомления одного сбоя в одно сообщение
+const (
+	MetadataKeyGroupID   = "group_id"
+	MetadataKeyGroupPath = "group_path"
+	MetadataKeyTags      = "tags"
+	MetadataKeyHost      = "host"
+)
+
+// TaskMetadata возвращает метаданные задачи: владельца проверки, ее группу, теги, хост цели и
+// переопределение уведомлений
+func (c *Check) TaskMetadata() map[string]string {
+	metadata := c.OwnershipMetadata()
+	if host := c.TargetHost(); host != "" {
+		metadata[MetadataKeyHost] = host
+	}
+	if c.GroupID != "" {
+		metadata[MetadataKeyGroupID] = c.GroupID
+	}
+	if c.GroupPath != "" {
+		metadata[MetadataKeyGroupPath] = c.GroupPath
+	}
+	if len(c.Tags) > 0 {
+		metadata[MetadataKeyTags] = strings.Join(c.Tags, ",")
+	}
+	// Конфигурация проверена при сохранении, некорректное переопределение не передается
+	if overrides, err := c.NotificationOverrides(); err == nil && overrides != nil {
+		for key, value := range overrides.Metadata() {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
+
+// TargetHost возвращает хост цели проверки в нижнем регистре: из URL, из адреса host:port или
+// саму цель
+func (c *Check) TargetHost() string {
+	target := strings.TrimSpace(c.Target)
+	if strings.Contains(target, "://") {
+		parsed, err := url.Parse(target)
+		if err != nil {
+			return ""
+		}
+		return strings.ToLower(parsed.Hostname())
+	}
+	if host, _, err := net.SplitHostPort(target); err == nil {
+		return strings.ToLower(host)
+	}
+	return strings.ToLower(target)
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupAttachTo(t *testing.T) {
+	root := &Group{ID: "root", TenantID: "t1", Name: "Root"}
+	require.NoError(t, root.AttachTo(nil))
+	assert.Equal(t, "/root/", root.Path)
+	assert.Equal(t, 1, root.Depth())
+
+	child := &Group{ID: "child", TenantID: "t1", Name: "Child"}
+	require.NoError(t, child.AttachTo(root))
+	assert.Equal(t, "root", child.ParentID)
+	assert.Equal(t, "/root/child/", child.Path)
+	assert.Equal(t, 2, child.Depth())
+	assert.True(t, root.Contains(child.Path))
+	assert.False(t, child.Contains(root.Path))
+
+	// Перемещение группы в собственное поддерево образует цикл
+	assert.Error(t, root.AttachTo(child))
+
+	foreign := &Group{ID: "foreign", TenantID: "t2", Name: "Foreign"}
+	assert.Error(t, foreign.AttachTo(root))
+}
+
+func TestGroupValidate(t *testing.T) {
+	group := &Group{ID: "g1", TenantID: "t1", Name: "Payments"}
+	require.NoError(t, group.AttachTo(nil))
+	assert.NoError(t, group.Validate())
+
+	group.Name = " "
+	assert.Error(t, group.Validate())
+
+	deep := &Group{ID: "deep", TenantID: "t1", Name: "Deep", Path: "/" + strings.Repeat("x/", MaxGroupDepth) + "deep/"}
+	assert.Error(t, deep.Validate())
+}
+
+func TestPathContainsGroup(t *testing.T) {
+	assert.True(t, PathContainsGroup("/a/b/c/", "b"))
+	assert.True(t, PathContainsGroup("/a/b/c/", "c"))
+	assert.False(t, PathContainsGroup("/a/bb/", "b"))
+	assert.False(t, PathContainsGroup("", "b"))
+	assert.Equal(t, []string{"a", "b", "c"}, GroupIDsFromPath("/a/b/c/"))
+	assert.Empty(t, GroupIDsFromPath(""))
+}
+
+func TestCheckTaskMetadata(t *testing.T) {
+	check := &Check{Owner: "alice", Target: "https://API.example.com/health", GroupID: "b", GroupPath: "/a/b/", Tags: []string{"prod", "api"}}
+	assert.Equal(t, map[string]string{
+		MetadataKeyOwner:     "alice",
+		MetadataKeyHost:      "api.example.com",
+		MetadataKeyGroupID:   "b",
+		MetadataKeyGroupPath: "/a/b/",
+		MetadataKeyTags:      "prod,api",
+	}, check.TaskMetadata())
+
+	assert.Empty(t, (&Check{}).TaskMetadata())
+}
+
+func TestCheckTargetHost(t *testing.T) {
+	for target, want := range map[string]string{
+		"https://api.example.com:8443/health": "api.example.com",
+		"db.internal:5432":                    "db.internal",
+		"[::1]:443":                           "::1",
+		"Gateway.example.com":                 "gateway.example.com",
+		"":                                    "",
+	} {
+		assert.Equal(t, want, (&Check{Target: target}).TargetHost(), target)
+	}
+}
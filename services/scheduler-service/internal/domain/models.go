@@ -21,8 +21,17 @@ const (
 	CheckTypeGRPC    CheckType = "grpc"
 	CheckTypeGraphQL CheckType = "graphql"
 	CheckTypeTCP     CheckType = "tcp"
+	// CheckTypePlugin проверка внешним плагином core-service, имя плагина в config.plugin
+	CheckTypePlugin CheckType = "plugin"
+	// CheckTypeDomain проверка срока регистрации домена цели через RDAP и WHOIS
+	CheckTypeDomain CheckType = "domain"
+	// CheckTypeDNS проверка DNS записи цели с ожидаемыми значениями
+	CheckTypeDNS CheckType = "dns"
 )
 
+// ConfigKeyPlugin ключ конфигурации проверки типа plugin с именем плагина
+const ConfigKeyPlugin = "plugin"
+
 // CheckStatus представляет статус проверки
 type CheckStatus string
 
@@ -69,20 +78,28 @@ func (c *CheckConfig) Scan(value interface{}) error {
 
 // Check представляет сущность проверки
 type Check struct {
-	ID          string      `json:"id" db:"id"`
-	TenantID    string      `json:"tenant_id" db:"tenant_id"`
-	Name        string      `json:"name" db:"name"`
-	Description string      `json:"description" db:"description"` // ✅ ДОБАВЛЕНО!
-	Type        CheckType   `json:"type" db:"type"`
-	Target      string      `json:"target" db:"target"`
-	Interval    int         `json:"interval_seconds" db:"interval_seconds"` // ✅ ИСПРАВЛЕНО!
-	Timeout     int         `json:"timeout_seconds" db:"timeout_seconds"`   // ✅ ИСПРАВЛЕНО!
-	Enabled     bool        `json:"enabled" db:"enabled"`                   // ✅ ДОБАВЛЕНО!
-	Config      CheckConfig `json:"config" db:"config"`
-	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
-	LastRunAt   *time.Time  `json:"last_run_at" db:"last_run_at"`
-	NextRunAt   *time.Time  `json:"next_run_at" db:"next_run_at"`
+	ID                string      `json:"id" db:"id"`
+	TenantID          string      `json:"tenant_id" db:"tenant_id"`
+	Name              string      `json:"name" db:"name"`
+	Description       string      `json:"description" db:"description"` // ✅ ДОБАВЛЕНО!
+	Type              CheckType   `json:"type" db:"type"`
+	Target            string      `json:"target" db:"target"`
+	Interval          int         `json:"interval_seconds" db:"interval_seconds"` // ✅ ИСПРАВЛЕНО!
+	Timeout           int         `json:"timeout_seconds" db:"timeout_seconds"`   // ✅ ИСПРАВЛЕНО!
+	Enabled           bool        `json:"enabled" db:"enabled"`                   // ✅ ДОБАВЛЕНО!
+	Config            CheckConfig `json:"config" db:"config"`
+	Owner             string      `json:"owner,omitempty" db:"owner"`
+	Team              string      `json:"team,omitempty" db:"team"`
+	RunbookURL        string      `json:"runbook_url,omitempty" db:"runbook_url"`
+	EscalationContact string      `json:"escalation_contact,omitempty" db:"escalation_contact"`
+	Tags              []string    `json:"tags,omitempty" db:"tags"`
+	GroupID           string      `json:"group_id,omitempty" db:"group_id"`
+	GroupPath         string      `json:"group_path,omitempty" db:"-"`
+	CreatedAt         time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at" db:"updated_at"`
+	ConfigVersion     int64       `json:"config_version" db:"config_version"`
+	LastRunAt         *time.Time  `json:"last_run_at" db:"last_run_at"`
+	NextRunAt         *time.Time  `json:"next_run_at" db:"next_run_at"`
 }
 
 // IsActive проверяет, активна ли проверка
@@ -123,7 +140,11 @@ func (c *Check) ShouldRun() bool {
 
 // UpdateNextRun обновляет время следующего запуска
 func (c *Check) UpdateNextRun() {
-	now := time.Now()
+	c.UpdateNextRunAt(time.Now())
+}
+
+// UpdateNextRunAt отмечает запуск проверки в момент now
+func (c *Check) UpdateNextRunAt(now time.Time) {
 	c.LastRunAt = &now
 	c.UpdatedAt = now
 
@@ -148,18 +169,47 @@ func (c *Check) Validate() error {
 	switch c.Type {
 	case CheckTypeHTTP, CheckTypeHTTPS, CheckTypeGRPC, CheckTypeGraphQL, CheckTypeTCP:
 		// Valid types
+	case CheckTypePlugin:
+		if name, _ := c.Config[ConfigKeyPlugin].(string); name == "" {
+			return fmt.Errorf("config.%s is required for plugin checks", ConfigKeyPlugin)
+		}
+	case CheckTypeDomain:
+		if err := ValidateDomainTarget(c.Target); err != nil {
+			return err
+		}
+		if err := validateDomainConfig(c.Config); err != nil {
+			return err
+		}
+	case CheckTypeDNS:
+		if err := ValidateDomainTarget(c.Target); err != nil {
+			return err
+		}
+		if err := validateDNSConfig(c.Config); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("invalid check type: %s", c.Type)
 	}
 
-	// Валидация интервала (от 5 секунд до 24 часов)
-	if c.Interval < 5 || c.Interval > 86400 {
-		return fmt.Errorf("interval must be between 5 seconds and 24 hours")
+	// Валидация интервала и таймаута без учета тарифного плана и равномерности расписания:
+	// их для нового или измененного интервала проверяет use case
+	if err := ValidateScheduleBounds(c.Interval, c.Timeout, MinCheckInterval, c.MaxInterval()); err != nil {
+		return err
+	}
+
+	// Валидация владельца, runbook и контакта эскалации
+	if err := c.validateOwnership(); err != nil {
+		return err
+	}
+
+	// Валидация тегов
+	if err := c.validateTags(); err != nil {
+		return err
 	}
 
-	// Валидация таймаута (от 1 секунды до 5 минут)
-	if c.Timeout < 1 || c.Timeout > 300 {
-		return fmt.Errorf("timeout must be between 1 second and 5 minutes")
+	// Валидация переопределения уведомлений
+	if _, err := c.NotificationOverrides(); err != nil {
+		return err
 	}
 
 	// Валидация статуса - для новой структуры с Enabled полем
@@ -293,6 +343,20 @@ type Task struct {
 	Priority    Priority  `json:"priority"`
 	ScheduledAt time.Time `json:"scheduled_at"`
 	CreatedAt   time.Time `json:"created_at"`
+	// Metadata содержит сведения о владельце проверки для инцидентов и уведомлений
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Trigger и TriggeredBy - причина запуска и инициатор, сохраняются в результате проверки
+	Trigger     string `json:"trigger,omitempty"`
+	TriggeredBy string `json:"triggered_by,omitempty"`
+	// FencingToken токен блокировки, под которой задача поставлена; core-service отбрасывает
+	// задачи с токеном меньше уже принятого для проверки. 0 - без fencing
+	FencingToken int64 `json:"fencing_token,omitempty"`
+	// ConfigVersion версия конфигурации проверки, с которой задача поставлена; core-service
+	// не выполняет задачу по устаревшей конфигурации. 0 - без сверки
+	ConfigVersion int64 `json:"config_version,omitempty"`
+	// ConfigOverrides параметры конфигурации, переопределенные для этого запуска; остальное
+	// core-service берет из проверки. Плановые задачи выполняются без переопределений
+	ConfigOverrides map[string]interface{} `json:"config_overrides,omitempty"`
 }
 
 // NewTask создает новую задачу
@@ -10,68 +10,43 @@ import (
 
 func TestCheck_IsActive(t *testing.T) {
 	tests := []struct {
-		name   string
-		status CheckStatus
-		want   bool
+		name    string
+		enabled bool
+		want    bool
 	}{
-		{"active check", CheckStatusActive, true},
-		{"paused check", CheckStatusPaused, false},
-		{"disabled check", CheckStatusDisabled, false},
+		{"enabled check", true, true},
+		{"disabled check", false, false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			check := &Check{Status: tt.status}
+			check := &Check{Enabled: tt.enabled}
 			assert.Equal(t, tt.want, check.IsActive())
+			assert.Equal(t, !tt.want, check.IsPaused())
 		})
 	}
 }
 
 func TestCheck_ShouldRun(t *testing.T) {
-	now := time.Now()
+	future := time.Now().Add(time.Hour)
 
 	tests := []struct {
 		name    string
-		status  CheckStatus
+		enabled bool
 		nextRun *time.Time
 		want    bool
 	}{
-		{
-			name:    "active check with next run in past",
-			status:  CheckStatusActive,
-			nextRun: &time.Time{}, // zero time = past
-			want:    true,
-		},
-		{
-			name:    "active check with next run in future",
-			status:  CheckStatusActive,
-			nextRun: func() *time.Time { t := now.Add(time.Hour); return &t }(),
-			want:    false,
-		},
-		{
-			name:    "active check with nil next run",
-			status:  CheckStatusActive,
-			nextRun: nil,
-			want:    true,
-		},
-		{
-			name:    "paused check",
-			status:  CheckStatusPaused,
-			nextRun: nil,
-			want:    false,
-		},
-		{
-			name:    "disabled check",
-			status:  CheckStatusDisabled,
-			nextRun: nil,
-			want:    false,
-		},
+		{"enabled check with next run in past", true, &time.Time{}, true},
+		// Расписание ведет планировщик: NextRunAt не откладывает включенную проверку
+		{"enabled check with next run in future", true, &future, true},
+		{"enabled check with nil next run", true, nil, true},
+		{"disabled check", false, nil, false},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			check := &Check{
-				Status:    tt.status,
+				Enabled:   tt.enabled,
 				NextRunAt: tt.nextRun,
 			}
 			assert.Equal(t, tt.want, check.ShouldRun())
@@ -87,130 +62,48 @@ func TestCheck_UpdateNextRun(t *testing.T) {
 	check.UpdateNextRun()
 
 	require.NotNil(t, check.LastRunAt)
-	require.NotNil(t, check.NextRunAt)
-
-	// NextRunAt должен быть примерно на 1 минуту позже LastRunAt
-	expectedNextRun := check.LastRunAt.Add(time.Minute)
-	assert.True(t, check.NextRunAt.After(expectedNextRun.Add(-time.Second)) || check.NextRunAt.Equal(expectedNextRun))
-	assert.True(t, check.NextRunAt.Before(expectedNextRun.Add(time.Second)) || check.NextRunAt.Equal(expectedNextRun))
+	assert.Equal(t, *check.LastRunAt, check.UpdatedAt)
+	// Следующий запуск вычисляет планировщик
+	assert.Nil(t, check.NextRunAt)
 }
 
 func TestCheck_Validate(t *testing.T) {
+	valid := func() Check {
+		return Check{
+			ID:       "check-1",
+			TenantID: "tenant-1",
+			Name:     "Test Check",
+			Type:     CheckTypeHTTP,
+			Target:   "https://example.com",
+			Interval: 60,
+			Timeout:  30,
+			Enabled:  true,
+		}
+	}
+
 	tests := []struct {
 		name    string
-		check   Check
+		modify  func(c *Check)
 		wantErr bool
 		errMsg  string
 	}{
-		{
-			name: "valid check",
-			check: Check{
-				ID:       "check-1",
-				TenantID: "tenant-1",
-				Name:     "Test Check",
-				Type:     CheckTypeHTTP,
-				Target:   "https://example.com",
-				Interval: 60,
-				Timeout:  30,
-				Status:   CheckStatusActive,
-				Priority: PriorityNormal,
-			},
-			wantErr: false,
-		},
-		{
-			name: "missing id",
-			check: Check{
-				TenantID: "tenant-1",
-				Name:     "Test Check",
-				Type:     CheckTypeHTTP,
-				Target:   "https://example.com",
-				Interval: 60,
-				Timeout:  30,
-				Status:   CheckStatusActive,
-			},
-			wantErr: true,
-			errMsg:  "check id is required",
-		},
-		{
-			name: "invalid type",
-			check: Check{
-				ID:       "check-1",
-				TenantID: "tenant-1",
-				Name:     "Test Check",
-				Type:     "invalid",
-				Target:   "https://example.com",
-				Interval: 60,
-				Timeout:  30,
-				Status:   CheckStatusActive,
-			},
-			wantErr: true,
-			errMsg:  "invalid check type",
-		},
-		{
-			name: "interval too small",
-			check: Check{
-				ID:       "check-1",
-				TenantID: "tenant-1",
-				Name:     "Test Check",
-				Type:     CheckTypeHTTP,
-				Target:   "https://example.com",
-				Interval: 4, // less than 5
-				Timeout:  30,
-				Status:   CheckStatusActive,
-			},
-			wantErr: true,
-			errMsg:  "interval must be between 5 seconds and 24 hours",
-		},
-		{
-			name: "interval too large",
-			check: Check{
-				ID:       "check-1",
-				TenantID: "tenant-1",
-				Name:     "Test Check",
-				Type:     CheckTypeHTTP,
-				Target:   "https://example.com",
-				Interval: 86401, // more than 24 hours
-				Timeout:  30,
-				Status:   CheckStatusActive,
-			},
-			wantErr: true,
-			errMsg:  "interval must be between 5 seconds and 24 hours",
-		},
-		{
-			name: "timeout too small",
-			check: Check{
-				ID:       "check-1",
-				TenantID: "tenant-1",
-				Name:     "Test Check",
-				Type:     CheckTypeHTTP,
-				Target:   "https://example.com",
-				Interval: 60,
-				Timeout:  0, // less than 1
-				Status:   CheckStatusActive,
-			},
-			wantErr: true,
-			errMsg:  "timeout must be between 1 second and 5 minutes",
-		},
-		{
-			name: "timeout too large",
-			check: Check{
-				ID:       "check-1",
-				TenantID: "tenant-1",
-				Name:     "Test Check",
-				Type:     CheckTypeHTTP,
-				Target:   "https://example.com",
-				Interval: 60,
-				Timeout:  301, // more than 5 minutes
-				Status:   CheckStatusActive,
-			},
-			wantErr: true,
-			errMsg:  "timeout must be between 1 second and 5 minutes",
-		},
+		{name: "valid check", modify: func(c *Check) {}},
+		// ID назначается при создании
+		{name: "missing id", modify: func(c *Check) { c.ID = "" }},
+		{name: "missing tenant", modify: func(c *Check) { c.TenantID = "" }, wantErr: true, errMsg: "tenant id is required"},
+		{name: "missing name", modify: func(c *Check) { c.Name = "" }, wantErr: true, errMsg: "check name is required"},
+		{name: "invalid type", modify: func(c *Check) { c.Type = "invalid" }, wantErr: true, errMsg: "invalid check type"},
+		{name: "interval too small", modify: func(c *Check) { c.Interval = 4 }, wantErr: true, errMsg: "interval must be between 5 and 86400 seconds"},
+		{name: "interval too large", modify: func(c *Check) { c.Interval = 86401 }, wantErr: true, errMsg: "interval must be between 5 and 86400 seconds"},
+		{name: "timeout too small", modify: func(c *Check) { c.Timeout = 0 }, wantErr: true, errMsg: "timeout must be between 1 and 300 seconds"},
+		{name: "timeout too large", modify: func(c *Check) { c.Timeout = 301 }, wantErr: true, errMsg: "timeout must be between 1 and 300 seconds"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.check.Validate()
+			check := valid()
+			tt.modify(&check)
+			err := check.Validate()
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errMsg)
@@ -324,7 +217,7 @@ func TestSchedule_Validate(t *testing.T) {
 				ID:             "schedule-1",
 				CheckID:        "check-1",
 				CronExpression: "*/5 * * * *", // 5 полей: каждые 5 минут
-				Priority:       Priority(5), // invalid priority
+				Priority:       Priority(5),   // invalid priority
 			},
 			wantErr: true,
 			errMsg:  "priority must be between 1 and 4",
@@ -347,83 +240,35 @@ func TestSchedule_Validate(t *testing.T) {
 func TestCheckWithSchedule_GetEffectivePriority(t *testing.T) {
 	tests := []struct {
 		name     string
-		check    Check
 		schedule *Schedule
 		want     Priority
 	}{
-		{
-			name:     "without schedule",
-			check:    Check{Priority: PriorityHigh},
-			schedule: nil,
-			want:     PriorityHigh,
-		},
-		{
-			name:     "with schedule",
-			check:    Check{Priority: PriorityHigh},
-			schedule: &Schedule{Priority: PriorityCritical},
-			want:     PriorityCritical,
-		},
+		{"without schedule", nil, PriorityNormal},
+		{"with schedule", &Schedule{Priority: PriorityCritical}, PriorityCritical},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			cws := &CheckWithSchedule{
-				Check:    tt.check,
-				Schedule: tt.schedule,
-			}
+			cws := &CheckWithSchedule{Schedule: tt.schedule}
 			assert.Equal(t, tt.want, cws.GetEffectivePriority())
 		})
 	}
 }
 
 func TestCheckWithSchedule_ShouldRun(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+
 	tests := []struct {
 		name     string
 		check    Check
 		schedule *Schedule
 		want     bool
 	}{
-		{
-			name: "check without schedule - active",
-			check: Check{
-				Status:    CheckStatusActive,
-				NextRunAt: &time.Time{}, // past
-			},
-			schedule: nil,
-			want:     true,
-		},
-		{
-			name: "check without schedule - paused",
-			check: Check{
-				Status:    CheckStatusPaused,
-				NextRunAt: &time.Time{}, // past
-			},
-			schedule: nil,
-			want:     false,
-		},
-		{
-			name: "check with active schedule",
-			check: Check{
-				Status: CheckStatusActive,
-			},
-			schedule: &Schedule{
-				IsActive: true,
-				NextRun:  &time.Time{}, // past
-			},
-			want: true,
-		},
-		{
-			name: "check with inactive schedule",
-			check: Check{
-				Status:    CheckStatusActive,
-				NextRunAt: func() *time.Time { t := time.Now().Add(time.Hour); return &t }(),
-			},
-			schedule: &Schedule{
-				IsActive: false,
-				NextRun:  func() *time.Time { t := time.Now().Add(time.Hour); return &t }(),
-			},
-			want: false,
-		},
+		{"check without schedule - enabled", Check{Enabled: true}, nil, true},
+		{"check without schedule - disabled", Check{Enabled: false}, nil, false},
+		{"check with active schedule due", Check{Enabled: true}, &Schedule{IsActive: true, NextRun: &time.Time{}}, true},
+		{"check with active schedule in future", Check{Enabled: true}, &Schedule{IsActive: true, NextRun: &future}, false},
+		{"check with inactive schedule falls back to check", Check{Enabled: false}, &Schedule{IsActive: false, NextRun: &future}, false},
 	}
 
 	for _, tt := range tests {
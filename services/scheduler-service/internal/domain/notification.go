@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CheckConfigKeyNotifications ключ конфигурации проверки с переопределением маршрутизации уведомлений
+const CheckConfigKeyNotifications = "notifications"
+
+// Ключи метаданных задачи и инцидента с переопределением уведомлений проверки
+const (
+	MetadataKeyNotifyChannels         = "notify_channels"
+	MetadataKeyNotifyMinSeverity      = "notify_min_severity"
+	MetadataKeyNotifyRenotifyInterval = "notify_renotify_interval"
+)
+
+const (
+	// MaxNotificationChannels максимальное количество каналов в переопределении
+	MaxNotificationChannels = 10
+	// MinRenotifyInterval минимальный интервал повторных уведомлений
+	MinRenotifyInterval = time.Minute
+)
+
+// notificationChannelTypes типы каналов, поддерживаемые notification-service
+var notificationChannelTypes = map[string]bool{"email": true, "slack": true, "telegram": true}
+
+// notificationSeverities уровни серьезности уведомлений и инцидентов
+var notificationSeverities = map[string]bool{
+	"low": true, "medium": true, "high": true, "critical": true,
+	"warning": true, "error": true,
+}
+
+// NotificationOverrides переопределение маршрутизации уведомлений проверки поверх настроек tenant:
+//
+//	"notifications": {
+//	  "channels": ["slack:#payments-oncall", "email:payments@example.com"],
+//	  "min_severity": "high",
+//	  "renotify_interval": "30m"
+//	}
+type NotificationOverrides struct {
+	// Channels каналы в формате <type>:<recipient> вместо каналов tenant
+	Channels []string
+	// MinSeverity уровень, ниже которого уведомления по проверке не отправляются
+	MinSeverity string
+	// RenotifyInterval минимальный интервал между повторными уведомлениями по инциденту
+	RenotifyInterval time.Duration
+}
+
+// NotificationOverrides разбирает переопределение уведомлений из конфигурации проверки;
+// без переопределения возвращает nil
+func (c *Check) NotificationOverrides() (*NotificationOverrides, error) {
+	raw, ok := c.Config[CheckConfigKeyNotifications]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	fields, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("config.notifications must be an object")
+	}
+
+	overrides := &NotificationOverrides{}
+	for key, value := range fields {
+		switch key {
+		case "channels":
+			channels, err := parseNotificationChannels(value)
+			if err != nil {
+				return nil, err
+			}
+			overrides.Channels = channels
+		case "min_severity":
+			severity, ok := value.(string)
+			severity = strings.ToLower(strings.TrimSpace(severity))
+			if !ok || !notificationSeverities[severity] {
+				return nil, fmt.Errorf("config.notifications.min_severity must be one of low, medium, high, critical, warning, error")
+			}
+			overrides.MinSeverity = severity
+		case "renotify_interval":
+			text, _ := value.(string)
+			interval, err := time.ParseDuration(text)
+			if err != nil || interval < MinRenotifyInterval {
+				return nil, fmt.Errorf("config.notifications.renotify_interval must be a duration of at least %s", MinRenotifyInterval)
+			}
+			overrides.RenotifyInterval = interval
+		default:
+			return nil, fmt.Errorf("config.notifications: unknown field %q", key)
+		}
+	}
+	return overrides, nil
+}
+
+// parseNotificationChannels проверяет каналы <type>:<recipient> и отбрасывает повторы
+func parseNotificationChannels(value interface{}) ([]string, error) {
+	var items []string
+	switch list := value.(type) {
+	case []string:
+		items = list
+	case []interface{}:
+		for _, item := range list {
+			str, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("config.notifications.channels must be a list of strings")
+			}
+			items = append(items, str)
+		}
+	default:
+		return nil, fmt.Errorf("config.notifications.channels must be a list of strings")
+	}
+
+	channels := make([]string, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		channelType, recipient, _ := strings.Cut(item, ":")
+		if !notificationChannelTypes[channelType] || strings.TrimSpace(recipient) == "" || strings.Contains(item, ",") {
+			return nil, fmt.Errorf("notification channel %q must be <email|slack|telegram>:<recipient>", item)
+		}
+		if !seen[item] {
+			seen[item] = true
+			channels = append(channels, item)
+		}
+	}
+	if len(channels) > MaxNotificationChannels {
+		return nil, fmt.Errorf("config.notifications.channels must not exceed %d channels", MaxNotificationChannels)
+	}
+	return channels, nil
+}
+
+// Metadata возвращает заданные поля переопределения для передачи в задачи и инциденты
+func (o *NotificationOverrides) Metadata() map[string]string {
+	metadata := make(map[string]string)
+	if len(o.Channels) > 0 {
+		metadata[MetadataKeyNotifyChannels] = strings.Join(o.Channels, ",")
+	}
+	if o.MinSeverity != "" {
+		metadata[MetadataKeyNotifyMinSeverity] = o.MinSeverity
+	}
+	if o.RenotifyInterval > 0 {
+		metadata[MetadataKeyNotifyRenotifyInterval] = o.RenotifyInterval.String()
+	}
+	return metadata
+}
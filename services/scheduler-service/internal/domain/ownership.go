@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Ключи метаданных задачи и инцидента с информацией о владельце проверки
+const (
+	MetadataKeyOwner             = "owner"
+	MetadataKeyTeam              = "team"
+	MetadataKeyRunbookURL        = "runbook_url"
+	MetadataKeyEscalationContact = "escalation_contact"
+)
+
+// maxOwnershipFieldLength максимальная длина полей владельца и команды
+const maxOwnershipFieldLength = 255
+
+var (
+	emailPattern  = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+	phonePattern  = regexp.MustCompile(`^\+?[0-9][0-9 \-()]{5,19}$`)
+	handlePattern = regexp.MustCompile(`^@[A-Za-z0-9_.\-]{1,64}$`)
+)
+
+// validateOwnership валидирует владельца, команду, runbook URL и контакт эскалации
+func (c *Check) validateOwnership() error {
+	if len(c.Owner) > maxOwnershipFieldLength {
+		return fmt.Errorf("owner must not exceed %d characters", maxOwnershipFieldLength)
+	}
+	if len(c.Team) > maxOwnershipFieldLength {
+		return fmt.Errorf("team must not exceed %d characters", maxOwnershipFieldLength)
+	}
+
+	if c.RunbookURL != "" {
+		if err := validateHTTPURL(c.RunbookURL); err != nil {
+			return fmt.Errorf("invalid runbook url: %w", err)
+		}
+	}
+
+	if c.EscalationContact != "" && !isValidEscalationContact(c.EscalationContact) {
+		return fmt.Errorf("escalation contact must be an email, phone number, @handle or http(s) URL")
+	}
+
+	return nil
+}
+
+// OwnershipMetadata возвращает непустые поля владельца для передачи в задачи и инциденты
+func (c *Check) OwnershipMetadata() map[string]string {
+	fields := map[string]string{
+		MetadataKeyOwner:             c.Owner,
+		MetadataKeyTeam:              c.Team,
+		MetadataKeyRunbookURL:        c.RunbookURL,
+		MetadataKeyEscalationContact: c.EscalationContact,
+	}
+
+	metadata := make(map[string]string)
+	for key, value := range fields {
+		if value = strings.TrimSpace(value); value != "" {
+			metadata[key] = value
+		}
+	}
+	return metadata
+}
+
+// validateHTTPURL проверяет, что строка является абсолютным http(s) URL
+func validateHTTPURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("host is required")
+	}
+	return nil
+}
+
+// isValidEscalationContact проверяет формат контакта эскалации
+func isValidEscalationContact(contact string) bool {
+	switch {
+	case emailPattern.MatchString(contact):
+		return true
+	case phonePattern.MatchString(contact):
+		return true
+	case handlePattern.MatchString(contact):
+		return true
+	default:
+		return validateHTTPURL(contact) == nil
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validOwnedCheck() *Check {
+	return &Check{
+		TenantID: "tenant-1",
+		Name:     "API health",
+		Type:     CheckTypeHTTP,
+		Target:   "https://api.example.com/health",
+		Interval: 60,
+		Timeout:  10,
+		Enabled:  true,
+	}
+}
+
+func TestCheck_Validate_Ownership(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Check)
+		wantErr bool
+	}{
+		{"no ownership", func(c *Check) {}, false},
+		{"full ownership", func(c *Check) {
+			c.Owner = "alice"
+			c.Team = "payments"
+			c.RunbookURL = "https://wiki.example.com/runbooks/api"
+			c.EscalationContact = "oncall@example.com"
+		}, false},
+		{"phone contact", func(c *Check) { c.EscalationContact = "+1 (555) 010-2030" }, false},
+		{"handle contact", func(c *Check) { c.EscalationContact = "@payments-oncall" }, false},
+		{"url contact", func(c *Check) { c.EscalationContact = "https://pagerduty.example.com/p/123" }, false},
+		{"invalid runbook scheme", func(c *Check) { c.RunbookURL = "ftp://wiki.example.com" }, true},
+		{"relative runbook", func(c *Check) { c.RunbookURL = "/runbooks/api" }, true},
+		{"invalid contact", func(c *Check) { c.EscalationContact = "call bob" }, true},
+		{"owner too long", func(c *Check) { c.Owner = string(make([]byte, 256)) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := validOwnedCheck()
+			tt.modify(check)
+
+			err := check.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheck_OwnershipMetadata(t *testing.T) {
+	check := validOwnedCheck()
+	check.Owner = " alice "
+	check.RunbookURL = "https://wiki.example.com/runbooks/api"
+
+	assert.Equal(t, map[string]string{
+		MetadataKeyOwner:      "alice",
+		MetadataKeyRunbookURL: "https://wiki.example.com/runbooks/api",
+	}, check.OwnershipMetadata())
+}
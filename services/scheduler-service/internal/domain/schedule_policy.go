@@ -0,0 +1,183 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Границы интервала и таймаута проверки в секундах
+const (
+	MinCheckInterval = 5
+	MaxCheckInterval = 86400
+	MinCheckTimeout  = 1
+	MaxCheckTimeout  = 300
+)
+
+// DefaultPlan тарифный план tenant, если план не указан или неизвестен
+const DefaultPlan = "free"
+
+// planMinIntervals минимальный интервал проверки в секундах для тарифного плана
+var planMinIntervals = map[string]int{
+	"free":       60,
+	"pro":        30,
+	"business":   10,
+	"enterprise": MinCheckInterval,
+}
+
+// MinIntervalForPlan возвращает минимальный интервал проверки для плана;
+// для неизвестного плана действуют ограничения DefaultPlan
+func MinIntervalForPlan(plan string) int {
+	if interval, ok := planMinIntervals[plan]; ok {
+		return interval
+	}
+	return planMinIntervals[DefaultPlan]
+}
+
+// Поля и причины ошибок валидации расписания
+const (
+	FieldInterval = "interval"
+	FieldTimeout  = "timeout"
+
+	ReasonOutOfRange             = "out_of_range"
+	ReasonBelowPlanMinimum       = "below_plan_minimum"
+	ReasonTimeoutExceedsInterval = "timeout_exceeds_interval"
+	ReasonNotSchedulable         = "not_schedulable"
+)
+
+// ValidationError типизированная ошибка валидации поля проверки.
+// Field и Reason передаются клиентам через gRPC и HTTP без разбора текста ошибки
+type ValidationError struct {
+	Field   string
+	Reason  string
+	Message string
+}
+
+// Error возвращает сообщение об ошибке
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Details возвращает машиночитаемое описание ошибки для деталей gRPC статуса
+func (e *ValidationError) Details() string {
+	return fmt.Sprintf("field=%s;reason=%s", e.Field, e.Reason)
+}
+
+// ValidateSchedule проверяет интервал и таймаут проверки: допустимые границы,
+// минимальный интервал плана, таймаут меньше интервала и возможность построить
+// из интервала равномерное cron расписание
+func ValidateSchedule(interval, timeout, minInterval int) error {
+	return ValidateScheduleWithin(interval, timeout, minInterval, MaxCheckInterval)
+}
+
+// ValidateScheduleWithin проверяет расписание как ValidateSchedule с наибольшим интервалом
+// maxInterval: у проверки домена он больше, чем у остальных типов
+func ValidateScheduleWithin(interval, timeout, minInterval, maxInterval int) error {
+	if err := ValidateScheduleBounds(interval, timeout, minInterval, maxInterval); err != nil {
+		return err
+	}
+
+	if _, err := IntervalCronExpression(interval); err != nil {
+		return &ValidationError{
+			Field:   FieldInterval,
+			Reason:  ReasonNotSchedulable,
+			Message: err.Error(),
+		}
+	}
+	return nil
+}
+
+// ValidateScheduleBounds проверяет границы интервала и таймаута без требования равномерного
+// cron расписания: так валидируются сохраненные проверки, интервалы которых созданы до этого
+// требования и запускаются через ScheduleCronExpression
+func ValidateScheduleBounds(interval, timeout, minInterval, maxInterval int) error {
+	if minInterval < MinCheckInterval {
+		minInterval = MinCheckInterval
+	}
+
+	if interval < MinCheckInterval || interval > maxInterval {
+		return &ValidationError{
+			Field:   FieldInterval,
+			Reason:  ReasonOutOfRange,
+			Message: fmt.Sprintf("interval must be between %d and %d seconds", MinCheckInterval, maxInterval),
+		}
+	}
+	if interval < minInterval {
+		return &ValidationError{
+			Field:   FieldInterval,
+			Reason:  ReasonBelowPlanMinimum,
+			Message: fmt.Sprintf("interval must be at least %d seconds on the current plan", minInterval),
+		}
+	}
+
+	if timeout < MinCheckTimeout || timeout > MaxCheckTimeout {
+		return &ValidationError{
+			Field:   FieldTimeout,
+			Reason:  ReasonOutOfRange,
+			Message: fmt.Sprintf("timeout must be between %d and %d seconds", MinCheckTimeout, MaxCheckTimeout),
+		}
+	}
+	// Иначе следующая проверка стартует раньше, чем закончится предыдущая
+	if timeout >= interval {
+		return &ValidationError{
+			Field:   FieldTimeout,
+			Reason:  ReasonTimeoutExceedsInterval,
+			Message: fmt.Sprintf("timeout (%ds) must be less than interval (%ds)", timeout, interval),
+		}
+	}
+
+	return nil
+}
+
+// cronParser разбирает выражения с секундами, как планировщик TaskService
+var cronParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// IntervalCronExpression строит cron выражение (с секундами) для интервала.
+// Интервал должен делить минуту, час или сутки нацело, иначе запуски
+// по cron будут идти неравномерно. Неделя - единственный интервал длиннее суток
+func IntervalCronExpression(interval int) (string, error) {
+	var expr string
+	switch {
+	case interval <= 0:
+		return "", fmt.Errorf("interval must be positive")
+	case interval < 60:
+		if 60%interval != 0 {
+			return "", fmt.Errorf("interval of %d seconds must divide a minute evenly", interval)
+		}
+		expr = fmt.Sprintf("*/%d * * * * *", interval)
+	case interval < 3600:
+		minutes := interval / 60
+		if interval%60 != 0 || 60%minutes != 0 {
+			return "", fmt.Errorf("interval of %d seconds must be a whole number of minutes dividing an hour evenly", interval)
+		}
+		expr = fmt.Sprintf("0 */%d * * * *", minutes)
+	case interval < 86400:
+		hours := interval / 3600
+		if interval%3600 != 0 || 24%hours != 0 {
+			return "", fmt.Errorf("interval of %d seconds must be a whole number of hours dividing a day evenly", interval)
+		}
+		expr = fmt.Sprintf("0 0 */%d * * *", hours)
+	case interval == 86400:
+		expr = "0 0 0 * * *"
+	case interval == MaxDomainCheckInterval:
+		expr = "0 0 0 * * 0"
+	default:
+		return "", fmt.Errorf("interval longer than a day must be exactly %d seconds (a week)", MaxDomainCheckInterval)
+	}
+
+	if _, err := cronParser.Parse(expr); err != nil {
+		return "", fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+	return expr, nil
+}
+
+// ScheduleCronExpression строит выражение для запуска сохраненной проверки. Интервалы, не делящие
+// минуту, час или сутки (например 45s, 90s, 420s, 5h), допускались до IntervalCronExpression и
+// запускаются через @every: равномерно, но без выравнивания по началу минуты или часа
+func ScheduleCronExpression(interval int) (string, error) {
+	expr, err := IntervalCronExpression(interval)
+	if err == nil || interval <= 0 {
+		return expr, err
+	}
+	return fmt.Sprintf("@every %ds", interval), nil
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		interval    int
+		timeout     int
+		minInterval int
+		wantField   string
+		wantReason  string
+	}{
+		{name: "valid", interval: 60, timeout: 10, minInterval: 60},
+		{name: "valid daily", interval: 86400, timeout: 300, minInterval: 60},
+		{name: "interval out of range", interval: 2, timeout: 1, minInterval: 0, wantField: FieldInterval, wantReason: ReasonOutOfRange},
+		{name: "below plan minimum", interval: 30, timeout: 10, minInterval: 60, wantField: FieldInterval, wantReason: ReasonBelowPlanMinimum},
+		{name: "timeout out of range", interval: 600, timeout: 301, minInterval: 60, wantField: FieldTimeout, wantReason: ReasonOutOfRange},
+		{name: "timeout equals interval", interval: 10, timeout: 10, minInterval: 5, wantField: FieldTimeout, wantReason: ReasonTimeoutExceedsInterval},
+		{name: "uneven seconds", interval: 45, timeout: 10, minInterval: 5, wantField: FieldInterval, wantReason: ReasonNotSchedulable},
+		{name: "uneven minutes", interval: 420, timeout: 10, minInterval: 60, wantField: FieldInterval, wantReason: ReasonNotSchedulable},
+		{name: "partial minutes", interval: 90, timeout: 10, minInterval: 60, wantField: FieldInterval, wantReason: ReasonNotSchedulable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchedule(tt.interval, tt.timeout, tt.minInterval)
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			require.True(t, errors.As(err, &validationErr), "expected ValidationError, got %v", err)
+			assert.Equal(t, tt.wantField, validationErr.Field)
+			assert.Equal(t, tt.wantReason, validationErr.Reason)
+		})
+	}
+}
+
+func TestIntervalCronExpression(t *testing.T) {
+	cases := map[int]string{
+		15:                     "*/15 * * * * *",
+		300:                    "0 */5 * * * *",
+		7200:                   "0 0 */2 * * *",
+		86400:                  "0 0 0 * * *",
+		MaxDomainCheckInterval: "0 0 0 * * 0",
+	}
+	for interval, want := range cases {
+		expr, err := IntervalCronExpression(interval)
+		require.NoError(t, err)
+		assert.Equal(t, want, expr)
+	}
+
+	_, err := IntervalCronExpression(5 * 3600)
+	assert.Error(t, err)
+	_, err = IntervalCronExpression(2 * 86400)
+	assert.Error(t, err)
+}
+
+func TestScheduleCronExpression(t *testing.T) {
+	cases := map[int]string{
+		300:      "0 */5 * * * *",
+		45:       "@every 45s",
+		90:       "@every 90s",
+		420:      "@every 420s",
+		5 * 3600: "@every 18000s",
+	}
+	for interval, want := range cases {
+		expr, err := ScheduleCronExpression(interval)
+		require.NoError(t, err)
+		assert.Equal(t, want, expr)
+		_, err = cron.New(cron.WithSeconds()).AddFunc(expr, func() {})
+		assert.NoError(t, err, "expression %q must be accepted by the scheduler", expr)
+	}
+
+	_, err := ScheduleCronExpression(0)
+	assert.Error(t, err)
+}
+
+func TestValidateScheduleBounds_AllowsStoredIntervals(t *testing.T) {
+	for _, interval := range []int{45, 90, 420, 5 * 3600} {
+		assert.NoError(t, ValidateScheduleBounds(interval, 10, MinCheckInterval, MaxCheckInterval), "interval %d", interval)
+	}
+	assert.Error(t, ValidateScheduleBounds(2, 1, MinCheckInterval, MaxCheckInterval))
+}
+
+func TestMinIntervalForPlan(t *testing.T) {
+	assert.Equal(t, 60, MinIntervalForPlan("free"))
+	assert.Equal(t, MinCheckInterval, MinIntervalForPlan("enterprise"))
+	assert.Equal(t, MinIntervalForPlan(DefaultPlan), MinIntervalForPlan("unknown"))
+	assert.Equal(t, MinIntervalForPlan(DefaultPlan), MinIntervalForPlan(""))
+}
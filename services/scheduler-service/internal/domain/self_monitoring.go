@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SelfMonitoringTag тег проверок компонентов платформы в мета-tenant самомониторинга
+const SelfMonitoringTag = "platform"
+
+// selfMonitoringNamespace пространство имен UUID проверок самомониторинга
+var selfMonitoringNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://uptimeping.com/self-monitoring"))
+
+// PlatformComponent компонент платформы под самомониторингом: http с URL в Target или tcp с host:port
+type PlatformComponent struct {
+	Name   string
+	Type   CheckType
+	Target string
+}
+
+// SelfMonitoringCheckID возвращает постоянный ID проверки компонента: при каждом запуске
+// планировщик находит свою проверку и обновляет ее, а не создает новую
+func SelfMonitoringCheckID(tenantID, component string) string {
+	return uuid.NewSHA1(selfMonitoringNamespace, []byte(tenantID+"/"+component)).String()
+}
+
+// NewSelfMonitoringCheck создает проверку компонента платформы в tenant самомониторинга
+func NewSelfMonitoringCheck(tenantID string, component PlatformComponent, interval, timeout int) (*Check, error) {
+	check := &Check{
+		ID:       SelfMonitoringCheckID(tenantID, component.Name),
+		TenantID: tenantID,
+		Name:     "Platform: " + component.Name,
+		Type:     component.Type,
+		Target:   component.Target,
+		Interval: interval,
+		Timeout:  timeout,
+		Enabled:  true,
+		Tags:     []string{SelfMonitoringTag},
+	}
+
+	switch component.Type {
+	case CheckTypeHTTP:
+		check.Config = CheckConfig{"url": component.Target, "method": "GET", "expected_status": 200}
+	case CheckTypeTCP:
+		host, portValue, err := net.SplitHostPort(component.Target)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target of platform component %s: %w", component.Name, err)
+		}
+		port, err := strconv.Atoi(portValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port of platform component %s: %w", component.Name, err)
+		}
+		check.Config = CheckConfig{"host": host, "port": port}
+	default:
+		return nil, fmt.Errorf("unsupported type of platform component %s: %s", component.Name, component.Type)
+	}
+
+	if err := check.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid platform component %s: %w", component.Name, err)
+	}
+	return check, nil
+}
+
+// SelfMonitoringPlan изменения проверок самомониторинга при запуске планировщика
+type SelfMonitoringPlan struct {
+	Create  []*Check
+	Update  []*Check
+	Disable []*Check
+}
+
+// PlanSelfMonitoring сравнивает проверки tenant самомониторинга с желаемыми: недостающие
+// создаются, измененные обновляются, а проверки компонентов, убранных из конфигурации,
+// выключаются без удаления истории. Проверки tenant без тега самомониторинга не затрагиваются
+func PlanSelfMonitoring(existing, desired []*Check, now time.Time) SelfMonitoringPlan {
+	var plan SelfMonitoringPlan
+
+	current := make(map[string]*Check, len(existing))
+	for _, check := range existing {
+		current[check.ID] = check
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, check := range desired {
+		wanted[check.ID] = true
+		previous, ok := current[check.ID]
+		if !ok {
+			check.CreatedAt = now
+			check.UpdatedAt = now
+			plan.Create = append(plan.Create, check)
+			continue
+		}
+		if !check.ExecutionChanged(previous) && check.Interval == previous.Interval && check.Name == previous.Name {
+			continue
+		}
+		check.CreatedAt = previous.CreatedAt
+		check.UpdatedAt = now
+		plan.Update = append(plan.Update, check)
+	}
+
+	for _, check := range existing {
+		if wanted[check.ID] || !check.Enabled || !check.HasTag(SelfMonitoringTag) {
+			continue
+		}
+		disabled := *check
+		disabled.Enabled = false
+		disabled.UpdatedAt = now
+		plan.Disable = append(plan.Disable, &disabled)
+	}
+	return plan
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPlatformTenant = "00000000-0000-0000-0000-000000000001"
+
+func TestNewSelfMonitoringCheck(t *testing.T) {
+	check, err := NewSelfMonitoringCheck(testPlatformTenant, PlatformComponent{Name: "redis", Type: CheckTypeTCP, Target: "redis:6379"}, 60, 10)
+	require.NoError(t, err)
+	assert.Equal(t, SelfMonitoringCheckID(testPlatformTenant, "redis"), check.ID)
+	assert.Equal(t, CheckConfig{"host": "redis", "port": 6379}, check.Config)
+	assert.True(t, check.HasTag(SelfMonitoringTag))
+	assert.True(t, check.Enabled)
+
+	check, err = NewSelfMonitoringCheck(testPlatformTenant, PlatformComponent{Name: "gateway", Type: CheckTypeHTTP, Target: "http://api-gateway:8080/health"}, 60, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "http://api-gateway:8080/health", check.Config["url"])
+
+	assert.NotEqual(t, SelfMonitoringCheckID(testPlatformTenant, "redis"), SelfMonitoringCheckID(testPlatformTenant, "gateway"))
+	assert.NotEqual(t, SelfMonitoringCheckID(testPlatformTenant, "redis"), SelfMonitoringCheckID("other-tenant", "redis"))
+
+	_, err = NewSelfMonitoringCheck(testPlatformTenant, PlatformComponent{Name: "redis", Type: CheckTypeTCP, Target: "redis"}, 60, 10)
+	assert.Error(t, err)
+	_, err = NewSelfMonitoringCheck(testPlatformTenant, PlatformComponent{Name: "redis", Type: CheckTypeTCP, Target: "redis:6379"}, 60, 90)
+	assert.Error(t, err, "timeout must be less than interval")
+}
+
+func TestPlanSelfMonitoring(t *testing.T) {
+	created := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	component := func(name, target string, interval int) *Check {
+		check, err := NewSelfMonitoringCheck(testPlatformTenant, PlatformComponent{Name: name, Type: CheckTypeTCP, Target: target}, interval, 10)
+		require.NoError(t, err)
+		return check
+	}
+	stored := func(check *Check) *Check {
+		check.CreatedAt = created
+		check.UpdatedAt = created
+		return check
+	}
+
+	existing := []*Check{
+		stored(component("postgres", "postgres:5432", 60)),
+		stored(component("redis", "redis:6379", 60)),
+		stored(component("legacy-broker", "kafka:9092", 60)),
+		{ID: "manual", TenantID: testPlatformTenant, Name: "Status page", Enabled: true},
+	}
+	desired := []*Check{
+		component("postgres", "postgres:5432", 60),
+		component("redis", "redis.internal:6379", 60),
+		component("rabbitmq", "rabbitmq:5672", 60),
+	}
+
+	plan := PlanSelfMonitoring(existing, desired, now)
+
+	require.Len(t, plan.Create, 1)
+	assert.Equal(t, "Platform: rabbitmq", plan.Create[0].Name)
+	assert.Equal(t, now, plan.Create[0].CreatedAt)
+
+	require.Len(t, plan.Update, 1)
+	assert.Equal(t, "redis.internal:6379", plan.Update[0].Target)
+	assert.Equal(t, created, plan.Update[0].CreatedAt)
+	assert.Equal(t, now, plan.Update[0].UpdatedAt)
+
+	// Убранный из конфигурации компонент выключается; проверки без тега платформы не трогаются
+	require.Len(t, plan.Disable, 1)
+	assert.Equal(t, "Platform: legacy-broker", plan.Disable[0].Name)
+	assert.False(t, plan.Disable[0].Enabled)
+	assert.True(t, existing[2].Enabled, "existing check is not modified")
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxTagsPerTenant максимальное количество различных тегов у одного tenant
+	MaxTagsPerTenant = 200
+	// MaxTagsPerCheck максимальное количество тегов у одной проверки
+	MaxTagsPerCheck = 20
+	// MaxTagLength максимальная длина имени тега
+	MaxTagLength = 64
+)
+
+var (
+	// ErrTagNotFound тег не зарегистрирован у tenant
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagInUse тег используется проверками и не может быть удален
+	ErrTagInUse = errors.New("tag is in use")
+	// ErrTagLimitExceeded превышен лимит тегов tenant
+	ErrTagLimitExceeded = errors.New("tag limit exceeded")
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.:/\-]*$`)
+
+// Tag представляет тег tenant со статистикой использования
+type Tag struct {
+	TenantID   string    `json:"tenant_id" db:"tenant_id"`
+	Name       string    `json:"name" db:"name"`
+	UsageCount int       `json:"usage_count" db:"usage_count"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// IsUnused проверяет, что тег не назначен ни одной проверке
+func (t *Tag) IsUnused() bool {
+	return t.UsageCount == 0
+}
+
+// NormalizeTag приводит имя тега к каноническому виду
+func NormalizeTag(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// NormalizeTags нормализует теги, отбрасывая пустые и повторяющиеся с сохранением порядка
+func NormalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// ValidateTag проверяет имя тега
+func ValidateTag(name string) error {
+	if name == "" {
+		return fmt.Errorf("tag name is required")
+	}
+	if len(name) > MaxTagLength {
+		return fmt.Errorf("tag %q must not exceed %d characters", name, MaxTagLength)
+	}
+	if !tagPattern.MatchString(name) {
+		return fmt.Errorf("tag %q must be lowercase and contain only letters, digits and _ . : / -", name)
+	}
+	return nil
+}
+
+// HasTag проверяет, назначен ли проверке тег
+func (c *Check) HasTag(tag string) bool {
+	for _, t := range c.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// validateTags валидирует теги проверки
+func (c *Check) validateTags() error {
+	if len(c.Tags) > MaxTagsPerCheck {
+		return fmt.Errorf("check must not have more than %d tags", MaxTagsPerCheck)
+	}
+	for _, tag := range c.Tags {
+		if err := ValidateTag(tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags := NormalizeTags([]string{" Prod ", "api", "", "prod", "API", "eu-west"})
+	assert.Equal(t, []string{"prod", "api", "eu-west"}, tags)
+	assert.Empty(t, NormalizeTags(nil))
+}
+
+func TestValidateTag(t *testing.T) {
+	assert.NoError(t, ValidateTag("env:prod"))
+	assert.NoError(t, ValidateTag("team/payments"))
+	assert.Error(t, ValidateTag(""))
+	assert.Error(t, ValidateTag("Prod"))
+	assert.Error(t, ValidateTag("-prod"))
+	assert.Error(t, ValidateTag("has space"))
+	assert.Error(t, ValidateTag(strings.Repeat("a", MaxTagLength+1)))
+}
+
+func TestCheck_Validate_Tags(t *testing.T) {
+	check := validOwnedCheck()
+	check.Tags = []string{"prod", "api"}
+	assert.NoError(t, check.Validate())
+
+	check.Tags = []string{"Bad Tag"}
+	assert.Error(t, check.Validate())
+
+	check.Tags = make([]string, MaxTagsPerCheck+1)
+	for i := range check.Tags {
+		check.Tags[i] = "tag" + strings.Repeat("x", i)
+	}
+	assert.Error(t, check.Validate())
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 )
 
@@ -24,12 +25,58 @@ type TaskResult struct {
 	CompletedAt  time.Time  `json:"completed_at"`
 }
 
+// TaskTriggerSchedule причина запуска задач планировщика; остальные причины (api, cli, retry)
+// выставляет core-service
+const TaskTriggerSchedule = "schedule"
+
+// TaskPriorityTagPrefix префикс тега, задающего приоритет задач проверки: priority:critical
+const TaskPriorityTagPrefix = "priority:"
+
+// taskPriorities приоритеты задач по значению тега
+var taskPriorities = map[string]Priority{
+	"low":      PriorityLow,
+	"normal":   PriorityNormal,
+	"high":     PriorityHigh,
+	"critical": PriorityCritical,
+}
+
+// TaskPriority возвращает приоритет задач проверки из тега priority:<low|normal|high|critical>;
+// без тега задачи получают обычный приоритет
+func (c *Check) TaskPriority() Priority {
+	for _, tag := range c.Tags {
+		if value, ok := strings.CutPrefix(tag, TaskPriorityTagPrefix); ok {
+			if priority, ok := taskPriorities[value]; ok {
+				return priority
+			}
+		}
+	}
+	return PriorityNormal
+}
+
+// MessagePriority приоритет сообщения в очереди задач с x-max-priority=10. Критичные задачи
+// получают наибольший приоритет, наравне с ручными запусками, и обгоняют плановые
+func (p Priority) MessagePriority() uint8 {
+	switch p {
+	case PriorityLow:
+		return 1
+	case PriorityHigh:
+		return 7
+	case PriorityCritical:
+		return 10
+	default:
+		return 4
+	}
+}
+
 // LockInfo представляет информацию о блокировке
 type LockInfo struct {
 	CheckID   string    `json:"check_id"`
 	WorkerID  string    `json:"worker_id"`
 	LockedAt  time.Time `json:"locked_at"`
 	ExpiresAt time.Time `json:"expires_at"`
+	// FencingToken монотонно растущий номер блокировки проверки: каждая новая блокировка
+	// получает больший токен, чем все предыдущие
+	FencingToken int64 `json:"fencing_token"`
 }
 
 // NewTaskForExecution создает новую задачу для выполнения
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	grpcBase "UptimePingPlatform/pkg/grpc"
+	"UptimePingPlatform/pkg/logger"
+	schedulerv1beta1 "UptimePingPlatform/proto/api/scheduler/v1beta1"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/usecase"
+)
+
+// CapacityHandler реализует uptimeping.scheduler.v1beta1.SchedulerService
+type CapacityHandler struct {
+	*grpcBase.BaseHandler
+	schedulerv1beta1.UnimplementedSchedulerServiceServer
+	capacityUseCase *usecase.CapacityUseCase
+}
+
+// NewCapacityHandler создает новый экземпляр CapacityHandler
+func NewCapacityHandler(capacityUseCase *usecase.CapacityUseCase, logger logger.Logger) *CapacityHandler {
+	return &CapacityHandler{
+		BaseHandler:     grpcBase.NewBaseHandler(logger),
+		capacityUseCase: capacityUseCase,
+	}
+}
+
+// PreviewIntervalChange оценивает изменение нагрузки при новом интервале проверок с тегом
+func (h *CapacityHandler) PreviewIntervalChange(ctx context.Context, req *schedulerv1beta1.PreviewIntervalChangeRequest) (*schedulerv1beta1.IntervalChangePreview, error) {
+	h.BaseHandler.LogOperationStart(ctx, "PreviewIntervalChange", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"tag":       req.Tag,
+		"interval":  req.IntervalSeconds,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "PreviewIntervalChange", map[string]string{
+		"tenant_id": req.TenantId,
+		"tag":       req.Tag,
+	}); err != nil {
+		return nil, err
+	}
+
+	preview, err := h.capacityUseCase.PreviewIntervalChange(ctx, req.TenantId, req.Tag, int(req.IntervalSeconds))
+	if err != nil {
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			h.BaseHandler.LogError(ctx, err, "PreviewIntervalChange", req.TenantId)
+			return nil, pkgErrors.New(pkgErrors.ErrValidation, err.Error()).WithDetails(validationErr.Details()).ToGRPCErr()
+		}
+		return nil, h.BaseHandler.LogError(ctx, err, "PreviewIntervalChange", req.TenantId)
+	}
+
+	rejected := make([]*schedulerv1beta1.RejectedCheck, 0, len(preview.Rejected))
+	for _, r := range preview.Rejected {
+		rejected = append(rejected, &schedulerv1beta1.RejectedCheck{
+			CheckId: r.CheckID,
+			Name:    r.Name,
+			Field:   r.Err.Field,
+			Reason:  r.Err.Reason,
+			Message: r.Err.Message,
+		})
+	}
+
+	return &schedulerv1beta1.IntervalChangePreview{
+		Tag:             preview.Tag,
+		IntervalSeconds: int32(preview.Interval),
+		MatchedChecks:   int32(preview.MatchedChecks),
+		ActiveChecks:    int32(preview.ActiveChecks),
+		ChangedChecks:   int32(preview.ChangedChecks),
+		Current:         capacityEstimateToProto(preview.Current),
+		Proposed:        capacityEstimateToProto(preview.Proposed),
+		Delta:           capacityEstimateToProto(preview.Delta()),
+		RejectedChecks:  rejected,
+	}, nil
+}
+
+// capacityEstimateToProto конвертирует оценку нагрузки в proto
+func capacityEstimateToProto(estimate domain.CapacityEstimate) *schedulerv1beta1.CapacityEstimate {
+	return &schedulerv1beta1.CapacityEstimate{
+		ExecutionsPerMinute: estimate.ExecutionsPerMinute,
+		CostUnitsPerMinute:  estimate.CostUnitsPerMinute,
+	}
+}
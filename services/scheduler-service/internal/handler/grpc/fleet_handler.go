@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/usecase"
+)
+
+// WithFleet подключает сводку по парку проверок
+func (h *HandlerFixed) WithFleet(fleet *usecase.FleetUseCase) *HandlerFixed {
+	h.fleetUseCase = fleet
+	return h
+}
+
+// ReportCheckHealth учитывает смену здоровья проверки, о которой сообщает Core Service
+func (h *HandlerFixed) ReportCheckHealth(ctx context.Context, req *schedulerv1.ReportCheckHealthRequest) (*schedulerv1.ReportCheckHealthResponse, error) {
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "ReportCheckHealth", map[string]string{
+		"check_id":  req.CheckId,
+		"tenant_id": req.TenantId,
+	}); err != nil {
+		return nil, err
+	}
+	if h.fleetUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "fleet summary is not configured")
+	}
+
+	health, err := domain.ParseCheckHealth(req.Health)
+	if err != nil {
+		return nil, pkgErrors.New(pkgErrors.ErrValidation, err.Error()).ToGRPCErr()
+	}
+
+	if err := h.fleetUseCase.ReportHealth(ctx, req.TenantId, req.CheckId, health); err != nil {
+		return nil, h.BaseHandler.LogError(ctx, err, "ReportCheckHealth", req.CheckId)
+	}
+
+	return &schedulerv1.ReportCheckHealthResponse{Success: true}, nil
+}
+
+// GetFleetSummary возвращает число проверок tenant или всех tenant по состояниям
+func (h *HandlerFixed) GetFleetSummary(ctx context.Context, req *schedulerv1.GetFleetSummaryRequest) (*schedulerv1.FleetSummary, error) {
+	h.BaseHandler.LogOperationStart(ctx, "GetFleetSummary", map[string]interface{}{
+		"tenant_id": req.TenantId,
+	})
+
+	if h.fleetUseCase == nil {
+		return nil, status.Error(codes.Unimplemented, "fleet summary is not configured")
+	}
+
+	counts, err := h.fleetUseCase.Summary(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.BaseHandler.LogError(ctx, err, "GetFleetSummary", req.TenantId)
+	}
+
+	return &schedulerv1.FleetSummary{
+		TenantId: req.TenantId,
+		Total:    int32(counts.Total),
+		Up:       int32(counts.Up),
+		Failing:  int32(counts.Failing),
+		Degraded: int32(counts.Degraded),
+		Paused:   int32(counts.Paused),
+		Unknown:  int32(counts.Unknown),
+	}, nil
+}
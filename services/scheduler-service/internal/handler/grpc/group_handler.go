@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// CreateGroup создает группу проверок
+func (h *HandlerFixed) CreateGroup(ctx context.Context, req *schedulerv1.CreateGroupRequest) (*schedulerv1.Group, error) {
+	h.BaseHandler.LogOperationStart(ctx, "CreateGroup", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"parent_id": req.ParentId,
+		"name":      req.Name,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "CreateGroup", map[string]string{
+		"tenant_id": req.TenantId,
+		"name":      req.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	group, err := h.groupUseCase.CreateGroup(ctx, req.TenantId, req.ParentId, req.Name)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "CreateGroup", req.TenantId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "CreateGroup", map[string]interface{}{
+		"group_id": group.ID,
+		"path":     group.Path,
+	})
+
+	return convertGroupToProto(group), nil
+}
+
+// GetGroup возвращает группу по ID
+func (h *HandlerFixed) GetGroup(ctx context.Context, req *schedulerv1.GetGroupRequest) (*schedulerv1.Group, error) {
+	h.BaseHandler.LogOperationStart(ctx, "GetGroup", map[string]interface{}{
+		"group_id": req.GroupId,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "GetGroup", map[string]string{
+		"group_id": req.GroupId,
+	}); err != nil {
+		return nil, err
+	}
+
+	group, err := h.groupUseCase.GetGroup(ctx, req.GroupId)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "GetGroup", req.GroupId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "GetGroup", map[string]interface{}{
+		"group_id": group.ID,
+	})
+
+	return convertGroupToProto(group), nil
+}
+
+// ListGroups возвращает дерево групп tenant
+func (h *HandlerFixed) ListGroups(ctx context.Context, req *schedulerv1.ListGroupsRequest) (*schedulerv1.ListGroupsResponse, error) {
+	h.BaseHandler.LogOperationStart(ctx, "ListGroups", map[string]interface{}{
+		"tenant_id": req.TenantId,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "ListGroups", map[string]string{
+		"tenant_id": req.TenantId,
+	}); err != nil {
+		return nil, err
+	}
+
+	groups, err := h.groupUseCase.ListGroups(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "ListGroups", req.TenantId)
+	}
+
+	protoGroups := make([]*schedulerv1.Group, len(groups))
+	for i, group := range groups {
+		protoGroups[i] = convertGroupToProto(group)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "ListGroups", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"count":     len(groups),
+	})
+
+	return &schedulerv1.ListGroupsResponse{Groups: protoGroups}, nil
+}
+
+// UpdateGroup переименовывает или перемещает группу
+func (h *HandlerFixed) UpdateGroup(ctx context.Context, req *schedulerv1.UpdateGroupRequest) (*schedulerv1.Group, error) {
+	h.BaseHandler.LogOperationStart(ctx, "UpdateGroup", map[string]interface{}{
+		"group_id":  req.GroupId,
+		"parent_id": req.ParentId,
+		"name":      req.Name,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "UpdateGroup", map[string]string{
+		"group_id": req.GroupId,
+	}); err != nil {
+		return nil, err
+	}
+
+	group, err := h.groupUseCase.UpdateGroup(ctx, req.GroupId, req.Name, req.ParentId)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "UpdateGroup", req.GroupId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "UpdateGroup", map[string]interface{}{
+		"group_id": group.ID,
+		"path":     group.Path,
+	})
+
+	return convertGroupToProto(group), nil
+}
+
+// DeleteGroup удаляет пустую группу
+func (h *HandlerFixed) DeleteGroup(ctx context.Context, req *schedulerv1.DeleteGroupRequest) (*schedulerv1.DeleteGroupResponse, error) {
+	h.BaseHandler.LogOperationStart(ctx, "DeleteGroup", map[string]interface{}{
+		"group_id": req.GroupId,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "DeleteGroup", map[string]string{
+		"group_id": req.GroupId,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := h.groupUseCase.DeleteGroup(ctx, req.GroupId); err != nil {
+		return nil, h.domainError(ctx, err, "DeleteGroup", req.GroupId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "DeleteGroup", map[string]interface{}{
+		"group_id": req.GroupId,
+	})
+
+	return &schedulerv1.DeleteGroupResponse{Success: true}, nil
+}
+
+// SetGroupEnabled включает или выключает все проверки группы и ее подгрупп
+func (h *HandlerFixed) SetGroupEnabled(ctx context.Context, req *schedulerv1.SetGroupEnabledRequest) (*schedulerv1.SetGroupEnabledResponse, error) {
+	h.BaseHandler.LogOperationStart(ctx, "SetGroupEnabled", map[string]interface{}{
+		"group_id": req.GroupId,
+		"enabled":  req.Enabled,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "SetGroupEnabled", map[string]string{
+		"group_id": req.GroupId,
+	}); err != nil {
+		return nil, err
+	}
+
+	affected, err := h.groupUseCase.SetGroupEnabled(ctx, req.GroupId, req.Enabled)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "SetGroupEnabled", req.GroupId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "SetGroupEnabled", map[string]interface{}{
+		"group_id":        req.GroupId,
+		"enabled":         req.Enabled,
+		"affected_checks": affected,
+	})
+
+	return &schedulerv1.SetGroupEnabledResponse{AffectedChecks: int32(affected)}, nil
+}
+
+// convertGroupToProto конвертирует доменную модель Group в protobuf
+func convertGroupToProto(group *domain.Group) *schedulerv1.Group {
+	return &schedulerv1.Group{
+		Id:         group.ID,
+		TenantId:   group.TenantID,
+		ParentId:   group.ParentID,
+		Name:       group.Name,
+		Path:       group.Path,
+		Enabled:    group.Enabled,
+		CheckCount: int32(group.CheckCount),
+		CreatedAt:  fmt.Sprintf("%d", group.CreatedAt.Unix()),
+		UpdatedAt:  fmt.Sprintf("%d", group.UpdatedAt.Unix()),
+	}
+}
@@ -21,14 +21,19 @@ type HandlerFixed struct {
 	*grpcBase.BaseHandler
 	schedulerv1.UnimplementedSchedulerServiceServer
 	checkUseCase *usecase.CheckUseCase
+	tagUseCase   *usecase.TagUseCase
+	groupUseCase *usecase.GroupUseCase
+	fleetUseCase *usecase.FleetUseCase
 	validator    *validation.Validator
 }
 
 // NewHandlerFixed создает новый экземпляр HandlerFixed
-func NewHandlerFixed(checkUseCase *usecase.CheckUseCase, logger logger.Logger) *HandlerFixed {
+func NewHandlerFixed(checkUseCase *usecase.CheckUseCase, tagUseCase *usecase.TagUseCase, groupUseCase *usecase.GroupUseCase, logger logger.Logger) *HandlerFixed {
 	return &HandlerFixed{
 		BaseHandler:  grpcBase.NewBaseHandler(logger),
 		checkUseCase: checkUseCase,
+		tagUseCase:   tagUseCase,
+		groupUseCase: groupUseCase,
 		validator:    validation.NewValidator(),
 	}
 }
@@ -44,30 +49,26 @@ func (h *HandlerFixed) validateTargetFormat(checkType, target string) error {
 		return h.validator.ValidateURL(target, []string{"http", "https"})
 	case "tcp":
 		return h.validator.ValidateHostPort(target)
+	case "plugin":
+		// Формат цели задает протокол плагина
+		return nil
+	case "domain", "dns":
+		return domain.ValidateDomainTarget(target)
 	default:
 		return fmt.Errorf("invalid check type: %s", checkType)
 	}
 }
 
-// validateCheckRequest выполняет общую валидацию для запросов проверки
-func (h *HandlerFixed) validateCheckRequest(checkType, target string, interval, timeout int32, status string) error {
+// validateCheckRequest выполняет общую валидацию для запросов проверки.
+// Интервал и таймаут проверяются в use case с учетом тарифного плана tenant
+func (h *HandlerFixed) validateCheckRequest(checkType, target string, status string) error {
 	// Валидация формата target
 	if err := h.validateTargetFormat(checkType, target); err != nil {
 		return err
 	}
 
-	// Валидация интервала (минимум 5 секунд)
-	if err := h.validator.ValidateInterval(interval, 5, 86400); err != nil {
-		return err
-	}
-
-	// Валидация таймаута (от 1 секунды до 5 минут)
-	if err := h.validator.ValidateTimeout(timeout, 1, 300); err != nil {
-		return err
-	}
-
 	// Валидация типа проверки
-	if err := h.validator.ValidateEnum(checkType, []string{"http", "https", "grpc", "graphql", "tcp"}, "type"); err != nil {
+	if err := h.validator.ValidateEnum(checkType, []string{"http", "https", "grpc", "graphql", "tcp", "plugin", "domain", "dns"}, "type"); err != nil {
 		return err
 	}
 
@@ -102,7 +103,7 @@ func (h *HandlerFixed) CreateCheck(ctx context.Context, req *schedulerv1.CreateC
 	}
 
 	// Общая валидация
-	if err := h.validateCheckRequest(req.Type, req.Target, req.Interval, req.Timeout, req.Status); err != nil {
+	if err := h.validateCheckRequest(req.Type, req.Target, req.Status); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
@@ -114,17 +115,23 @@ func (h *HandlerFixed) CreateCheck(ctx context.Context, req *schedulerv1.CreateC
 
 	// Конвертация запроса в доменную модель
 	check := &domain.Check{
-		TenantID:    req.TenantId, // ✅ ДОБАВЛЕНО!
-		Name:        req.Name,
-		Description: req.Description, // ✅ ДОБАВЛЕНО!
-		Type:        domain.CheckType(req.Type),
-		Target:      req.Target,
-		Interval:    int(req.Interval),
-		Timeout:     int(req.Timeout),
-		Enabled:     true, // По умолчанию включена
-		Config:      h.convertConfigMap(req.Config),
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		TenantID:          req.TenantId, // ✅ ДОБАВЛЕНО!
+		Name:              req.Name,
+		Description:       req.Description, // ✅ ДОБАВЛЕНО!
+		Type:              domain.CheckType(req.Type),
+		Target:            req.Target,
+		Interval:          int(req.Interval),
+		Timeout:           int(req.Timeout),
+		Enabled:           true, // По умолчанию включена
+		Config:            h.convertConfigMap(req.Config),
+		Owner:             req.Owner,
+		Team:              req.Team,
+		RunbookURL:        req.RunbookUrl,
+		EscalationContact: req.EscalationContact,
+		Tags:              req.Tags,
+		GroupID:           req.GroupId,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
 	}
 
 	// Обрабатываем специальное поле enabled из metadata
@@ -137,7 +144,7 @@ func (h *HandlerFixed) CreateCheck(ctx context.Context, req *schedulerv1.CreateC
 	// Создание проверки
 	createdCheck, err := h.checkUseCase.CreateCheck(ctx, req.TenantId, check)
 	if err != nil {
-		return nil, h.BaseHandler.LogError(ctx, err, "CreateCheck", req.TenantId)
+		return nil, h.domainError(ctx, err, "CreateCheck", req.TenantId)
 	}
 
 	// Логируем успешное завершение
@@ -169,26 +176,32 @@ func (h *HandlerFixed) UpdateCheck(ctx context.Context, req *schedulerv1.UpdateC
 	}
 
 	// Общая валидация
-	if err := h.validateCheckRequest(req.Type, req.Target, req.Interval, req.Timeout, req.Status); err != nil {
+	if err := h.validateCheckRequest(req.Type, req.Target, req.Status); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)
 	}
 
 	// Конвертация запроса в доменную модель
 	check := &domain.Check{
-		Name:        req.Name,
-		Description: req.Description, // ✅ ДОБАВЛЕНО!
-		Type:        domain.CheckType(req.Type),
-		Target:      req.Target,
-		Interval:    int(req.Interval),
-		Timeout:     int(req.Timeout),
-		Enabled:     true, // По умолчанию включена
-		Config:      h.convertConfigMap(req.Config),
+		Name:              req.Name,
+		Description:       req.Description, // ✅ ДОБАВЛЕНО!
+		Type:              domain.CheckType(req.Type),
+		Target:            req.Target,
+		Interval:          int(req.Interval),
+		Timeout:           int(req.Timeout),
+		Enabled:           true, // По умолчанию включена
+		Config:            h.convertConfigMap(req.Config),
+		Owner:             req.Owner,
+		Team:              req.Team,
+		RunbookURL:        req.RunbookUrl,
+		EscalationContact: req.EscalationContact,
+		Tags:              req.Tags,
+		GroupID:           req.GroupId,
 	}
 
 	// Обновление проверки
 	err := h.checkUseCase.UpdateCheck(ctx, req.CheckId, check)
 	if err != nil {
-		return nil, h.BaseHandler.LogError(ctx, err, "UpdateCheck", req.CheckId)
+		return nil, h.domainError(ctx, err, "UpdateCheck", req.CheckId)
 	}
 
 	// Получение обновленной проверки
@@ -271,6 +284,7 @@ func (h *HandlerFixed) ListChecks(ctx context.Context, req *schedulerv1.ListChec
 		"tenant_id":  req.TenantId,
 		"page_size":  req.PageSize,
 		"page_token": req.PageToken,
+		"group_ids":  req.GroupIds,
 	})
 
 	// Установка значений по умолчанию
@@ -283,7 +297,16 @@ func (h *HandlerFixed) ListChecks(ctx context.Context, req *schedulerv1.ListChec
 	}
 
 	// Получение списка проверок
-	checks, err := h.checkUseCase.ListChecks(ctx, req.TenantId, int(pageSize), fmt.Sprintf("%d", req.PageToken))
+	var checks []*domain.Check
+	var total int
+	var err error
+	pageToken := fmt.Sprintf("%d", req.PageToken)
+	if len(req.GroupIds) > 0 {
+		// Выборка ограничена поддеревьями групп (например, для пользователей с правами на группу)
+		checks, total, err = h.checkUseCase.ListChecksInGroups(ctx, req.TenantId, req.GroupIds, int(pageSize), pageToken)
+	} else {
+		checks, total, err = h.checkUseCase.ListChecks(ctx, req.TenantId, int(pageSize), pageToken)
+	}
 	if err != nil {
 		return nil, h.BaseHandler.LogError(ctx, err, "ListChecks", req.TenantId)
 	}
@@ -294,17 +317,26 @@ func (h *HandlerFixed) ListChecks(ctx context.Context, req *schedulerv1.ListChec
 		protoChecks[i] = h.convertCheckToProto(check)
 	}
 
+	// Следующая страница есть, если до конца выборки остались проверки
+	nextPageToken := int32(0)
+	if end := int(req.PageToken) + len(checks); len(checks) > 0 && end < total {
+		nextPageToken = int32(end)
+	}
+
 	// Логируем успешное завершение
 	h.BaseHandler.LogOperationSuccess(ctx, "ListChecks", map[string]interface{}{
-		"tenant_id":  req.TenantId,
-		"count":      len(checks),
-		"page_size":  pageSize,
-		"page_token": req.PageToken,
+		"tenant_id":       req.TenantId,
+		"count":           len(checks),
+		"total":           total,
+		"page_size":       pageSize,
+		"page_token":      req.PageToken,
+		"next_page_token": nextPageToken,
 	})
 
 	return &schedulerv1.ListChecksResponse{
 		Checks:        protoChecks,
-		NextPageToken: 0, // Упрощенная пагинация
+		NextPageToken: nextPageToken,
+		Total:         int32(total),
 	}, nil
 }
 
@@ -369,10 +401,16 @@ func (h *HandlerFixed) convertCheckToProto(check *domain.Check) *schedulerv1.Che
 				return "disabled"
 			}
 		}(),
-		Priority:  1,
-		Tags:      []string{}, // Пустые теги, т.к. поле отсутствует в доменной модели
-		CreatedAt: fmt.Sprintf("%d", check.CreatedAt.Unix()),
-		UpdatedAt: fmt.Sprintf("%d", check.UpdatedAt.Unix()),
+		Priority:          1,
+		Tags:              check.Tags,
+		CreatedAt:         fmt.Sprintf("%d", check.CreatedAt.Unix()),
+		UpdatedAt:         fmt.Sprintf("%d", check.UpdatedAt.Unix()),
+		Owner:             check.Owner,
+		Team:              check.Team,
+		RunbookUrl:        check.RunbookURL,
+		EscalationContact: check.EscalationContact,
+		GroupId:           check.GroupID,
+		GroupPath:         check.GroupPath,
 	}
 
 	if check.LastRunAt != nil {
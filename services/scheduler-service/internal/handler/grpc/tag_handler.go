@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pkgErrors "UptimePingPlatform/pkg/errors"
+	schedulerv1 "UptimePingPlatform/proto/api/scheduler/v1"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// ListTags возвращает теги tenant с количеством использований
+func (h *HandlerFixed) ListTags(ctx context.Context, req *schedulerv1.ListTagsRequest) (*schedulerv1.ListTagsResponse, error) {
+	h.BaseHandler.LogOperationStart(ctx, "ListTags", map[string]interface{}{
+		"tenant_id": req.TenantId,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "ListTags", map[string]string{
+		"tenant_id": req.TenantId,
+	}); err != nil {
+		return nil, err
+	}
+
+	tags, err := h.tagUseCase.ListTags(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "ListTags", req.TenantId)
+	}
+
+	protoTags := make([]*schedulerv1.Tag, len(tags))
+	for i, tag := range tags {
+		protoTags[i] = &schedulerv1.Tag{
+			Name:       tag.Name,
+			UsageCount: int32(tag.UsageCount),
+			CreatedAt:  fmt.Sprintf("%d", tag.CreatedAt.Unix()),
+		}
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "ListTags", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"count":     len(tags),
+	})
+
+	return &schedulerv1.ListTagsResponse{
+		Tags:  protoTags,
+		Limit: domain.MaxTagsPerTenant,
+	}, nil
+}
+
+// RenameTag переименовывает тег во всех проверках tenant
+func (h *HandlerFixed) RenameTag(ctx context.Context, req *schedulerv1.RenameTagRequest) (*schedulerv1.RenameTagResponse, error) {
+	h.BaseHandler.LogOperationStart(ctx, "RenameTag", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"old_name":  req.OldName,
+		"new_name":  req.NewName,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "RenameTag", map[string]string{
+		"tenant_id": req.TenantId,
+		"old_name":  req.OldName,
+		"new_name":  req.NewName,
+	}); err != nil {
+		return nil, err
+	}
+
+	affected, err := h.tagUseCase.RenameTag(ctx, req.TenantId, req.OldName, req.NewName)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "RenameTag", req.TenantId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "RenameTag", map[string]interface{}{
+		"tenant_id":       req.TenantId,
+		"affected_checks": affected,
+	})
+
+	return &schedulerv1.RenameTagResponse{AffectedChecks: int32(affected)}, nil
+}
+
+// DeleteTag удаляет неиспользуемый тег
+func (h *HandlerFixed) DeleteTag(ctx context.Context, req *schedulerv1.DeleteTagRequest) (*schedulerv1.DeleteTagResponse, error) {
+	h.BaseHandler.LogOperationStart(ctx, "DeleteTag", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"name":      req.Name,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "DeleteTag", map[string]string{
+		"tenant_id": req.TenantId,
+		"name":      req.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := h.tagUseCase.DeleteTag(ctx, req.TenantId, req.Name); err != nil {
+		return nil, h.domainError(ctx, err, "DeleteTag", req.TenantId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "DeleteTag", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"name":      req.Name,
+	})
+
+	return &schedulerv1.DeleteTagResponse{Success: true}, nil
+}
+
+// DeleteUnusedTags удаляет все неиспользуемые теги tenant
+func (h *HandlerFixed) DeleteUnusedTags(ctx context.Context, req *schedulerv1.DeleteUnusedTagsRequest) (*schedulerv1.DeleteUnusedTagsResponse, error) {
+	h.BaseHandler.LogOperationStart(ctx, "DeleteUnusedTags", map[string]interface{}{
+		"tenant_id": req.TenantId,
+	})
+
+	if err := h.BaseHandler.ValidateRequiredFields(ctx, "DeleteUnusedTags", map[string]string{
+		"tenant_id": req.TenantId,
+	}); err != nil {
+		return nil, err
+	}
+
+	deleted, err := h.tagUseCase.DeleteUnusedTags(ctx, req.TenantId)
+	if err != nil {
+		return nil, h.domainError(ctx, err, "DeleteUnusedTags", req.TenantId)
+	}
+
+	h.BaseHandler.LogOperationSuccess(ctx, "DeleteUnusedTags", map[string]interface{}{
+		"tenant_id": req.TenantId,
+		"deleted":   deleted,
+	})
+
+	return &schedulerv1.DeleteUnusedTagsResponse{Deleted: int32(deleted)}, nil
+}
+
+// domainError конвертирует доменные ошибки тегов, групп и валидации в gRPC статусы, остальные передает в LogError
+func (h *HandlerFixed) domainError(ctx context.Context, err error, operation, id string) error {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		// Поле и причина передаются в деталях статуса, чтобы клиенты не разбирали текст ошибки
+		h.BaseHandler.LogError(ctx, err, operation, id)
+		return pkgErrors.New(pkgErrors.ErrValidation, err.Error()).WithDetails(validationErr.Details()).ToGRPCErr()
+	case errors.Is(err, domain.ErrTagLimitExceeded):
+		h.BaseHandler.LogError(ctx, err, operation, id)
+		return status.Errorf(codes.ResourceExhausted, "%v", err)
+	case errors.Is(err, domain.ErrTagNotFound), errors.Is(err, domain.ErrGroupNotFound):
+		h.BaseHandler.LogError(ctx, err, operation, id)
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, domain.ErrTagInUse), errors.Is(err, domain.ErrGroupNotEmpty):
+		h.BaseHandler.LogError(ctx, err, operation, id)
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	default:
+		return h.BaseHandler.LogError(ctx, err, operation, id)
+	}
+}
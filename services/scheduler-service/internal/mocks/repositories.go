@@ -52,12 +52,37 @@ func (m *MockCheckRepository) GetActiveChecksByTenant(ctx context.Context, tenan
 	return args.Get(0).([]*domain.Check), args.Error(1)
 }
 
-func (m *MockCheckRepository) Ping(ctx context.Context) (interface{}, error) {
+func (m *MockCheckRepository) List(ctx context.Context, tenantID string, pageSize int, pageToken string) ([]*domain.Check, error) {
+	args := m.Called(ctx, tenantID, pageSize, pageToken)
+	return args.Get(0).([]*domain.Check), args.Error(1)
+}
+
+func (m *MockCheckRepository) ListByGroups(ctx context.Context, tenantID string, groupPaths []string, pageSize int, pageToken string) ([]*domain.Check, error) {
+	args := m.Called(ctx, tenantID, groupPaths, pageSize, pageToken)
+	return args.Get(0).([]*domain.Check), args.Error(1)
+}
+
+func (m *MockCheckRepository) Count(ctx context.Context, tenantID string) (int, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCheckRepository) CountByGroups(ctx context.Context, tenantID string, groupPaths []string) (int, error) {
+	args := m.Called(ctx, tenantID, groupPaths)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCheckRepository) FleetEntries(ctx context.Context) (map[string]domain.FleetEntry, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0), args.Error(1)
+	return args.Get(0).(map[string]domain.FleetEntry), args.Error(1)
+}
+
+func (m *MockCheckRepository) Ping(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
 }
 
 // MockTaskRepository - универсальный мок для TaskRepository
@@ -124,6 +149,11 @@ func (m *MockLockRepository) GetLockInfo(ctx context.Context, checkID string) (*
 	return args.Get(0).(*domain.LockInfo), args.Error(1)
 }
 
+func (m *MockLockRepository) ValidateLock(ctx context.Context, checkID, workerID string, fencingToken int64) error {
+	args := m.Called(ctx, checkID, workerID, fencingToken)
+	return args.Error(0)
+}
+
 // MockSchedulerRepository - универсальный мок для SchedulerRepository
 type MockSchedulerRepository struct {
 	mock.Mock
@@ -180,12 +210,9 @@ func (m *MockSchedulerRepository) Count(ctx context.Context, filter string) (int
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockSchedulerRepository) Ping(ctx context.Context) (interface{}, error) {
+func (m *MockSchedulerRepository) Ping(ctx context.Context) error {
 	args := m.Called(ctx)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0), args.Error(1)
+	return args.Error(0)
 }
 
 // Методы для работы с расписаниями
@@ -0,0 +1,96 @@
+// Package producer публикует задачи планировщика в очередь check_tasks, которую читает core-service
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// TaskMessage задача в формате очереди check_tasks, который читает core-service. Задача несет
+// идентификатор проверки, версию ее конфигурации и только переопределения запуска: тип, цель
+// и конфигурацию core-service берет из таблицы checks по версии, поэтому размер сообщения не
+// зависит от конфигурации проверки. core-service должен быть обновлен раньше планировщика:
+// прежняя версия не принимает задачи без цели
+type TaskMessage struct {
+	CheckID     string                 `json:"check_id"`
+	ExecutionID string                 `json:"execution_id"`
+	ScheduledAt time.Time              `json:"scheduled_at"`
+	TenantID    string                 `json:"tenant_id"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Trigger     string                 `json:"trigger,omitempty"`
+	TriggeredBy string                 `json:"triggered_by,omitempty"`
+	// FencingToken токен блокировки, под которой задача поставлена
+	FencingToken int64 `json:"fencing_token,omitempty"`
+	// ConfigVersion версия конфигурации проверки, по которой core-service собирает задачу
+	ConfigVersion int64 `json:"config_version"`
+	// Overrides параметры конфигурации, переопределенные для этого запуска
+	Overrides map[string]interface{} `json:"overrides,omitempty"`
+}
+
+// EncodeTask кодирует задачу планировщика в JSON
+func EncodeTask(task *domain.Task) ([]byte, error) {
+	metadata := make(map[string]interface{}, len(task.Metadata))
+	for key, value := range task.Metadata {
+		metadata[key] = value
+	}
+
+	body, err := json.Marshal(&TaskMessage{
+		CheckID:       task.CheckID,
+		ExecutionID:   task.ID,
+		ScheduledAt:   task.ScheduledAt,
+		TenantID:      task.TenantID,
+		Metadata:      metadata,
+		Trigger:       task.Trigger,
+		TriggeredBy:   task.TriggeredBy,
+		FencingToken:  task.FencingToken,
+		ConfigVersion: task.ConfigVersion,
+		Overrides:     task.ConfigOverrides,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode task: %w", err)
+	}
+	return body, nil
+}
+
+// Publisher публикует сообщения в RabbitMQ; реализуется *rabbitmq.Producer
+type Publisher interface {
+	Publish(ctx context.Context, body []byte, options ...rabbitmq.PublishOption) error
+}
+
+// TaskProducer публикует задачи в RabbitMQ. Задача tenant попадает в его виртуальную очередь,
+// которую core-service обрабатывает отдельной подпиской
+type TaskProducer struct {
+	publisher Publisher
+	routing   rabbitmq.TenantRouting
+}
+
+// NewTaskProducer создает продюсер задач с маршрутизацией routing по tenant
+func NewTaskProducer(publisher Publisher, routing rabbitmq.TenantRouting) *TaskProducer {
+	return &TaskProducer{
+		publisher: publisher,
+		routing:   routing,
+	}
+}
+
+// PublishTask публикует задачу в виртуальную очередь tenant
+func (p *TaskProducer) PublishTask(ctx context.Context, task *domain.Task) error {
+	body, err := EncodeTask(task)
+	if err != nil {
+		return err
+	}
+
+	return p.publisher.Publish(ctx, body,
+		rabbitmq.WithTenant(p.routing, task.TenantID),
+		rabbitmq.WithPriority(task.Priority.MessagePriority()),
+	)
+}
+
+// Close ничего не делает: подключением к RabbitMQ владеет вызывающий код
+func (p *TaskProducer) Close() error {
+	return nil
+}
@@ -0,0 +1,148 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository/memory"
+)
+
+// topicBroker topic exchange с виртуальными очередями, привязанными так же, как их привязывает
+// consumer core-service: очередь QueueName(shard) по ключу BindingKey(shard)
+type topicBroker struct {
+	exchange string
+	bindings map[string]string
+	queues   map[string][]rabbitmq.PublishOptions
+}
+
+func newTopicBroker(routing rabbitmq.TenantRouting) *topicBroker {
+	broker := &topicBroker{
+		exchange: routing.Exchange(),
+		bindings: make(map[string]string),
+		queues:   make(map[string][]rabbitmq.PublishOptions),
+	}
+	for shard := 0; shard < routing.VirtualQueues; shard++ {
+		broker.bindings[routing.QueueName(shard)] = routing.BindingKey(shard)
+	}
+	return broker
+}
+
+func (b *topicBroker) Publish(ctx context.Context, body []byte, options ...rabbitmq.PublishOption) error {
+	opts := &rabbitmq.PublishOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+	if opts.Exchange != b.exchange {
+		return nil
+	}
+	for queue, bindingKey := range b.bindings {
+		if topicMatch(bindingKey, opts.RoutingKey) {
+			b.queues[queue] = append(b.queues[queue], *opts)
+		}
+	}
+	return nil
+}
+
+// topicMatch сопоставляет routing key с ключом привязки из слов и "*"
+func topicMatch(bindingKey, routingKey string) bool {
+	pattern, words := strings.Split(bindingKey, "."), strings.Split(routingKey, ".")
+	if len(pattern) != len(words) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func newTestCheck(t *testing.T, checks *memory.CheckRepository, tenantID string) *domain.Check {
+	t.Helper()
+	check := &domain.Check{
+		ID:       "check-" + tenantID,
+		TenantID: tenantID,
+		Name:     "site",
+		Type:     domain.CheckTypeHTTP,
+		Target:   "https://example.com",
+		Interval: 60,
+		Timeout:  10,
+		Enabled:  true,
+	}
+	require.NoError(t, checks.Create(context.Background(), check))
+	return check
+}
+
+func TestTaskProducer_PublishesToTenantVirtualQueue(t *testing.T) {
+	ctx := context.Background()
+	routing := rabbitmq.NewTenantRouting("check_tasks", 4)
+	broker := newTopicBroker(routing)
+	checks := memory.NewCheckRepository()
+	producer := NewTaskProducer(broker, routing)
+
+	for _, tenantID := range []string{"tenant-a", "tenant-b", "tenant-c"} {
+		check := newTestCheck(t, checks, tenantID)
+		require.NoError(t, producer.PublishTask(ctx, domain.NewTask(check.ID, tenantID, domain.PriorityHigh)))
+	}
+
+	for _, tenantID := range []string{"tenant-a", "tenant-b", "tenant-c"} {
+		queue := routing.QueueName(routing.Shard(tenantID))
+		var delivered []string
+		for _, msg := range broker.queues[queue] {
+			delivered = append(delivered, msg.Headers[rabbitmq.HeaderTenantID].(string))
+			assert.Equal(t, domain.PriorityHigh.MessagePriority(), msg.Priority)
+		}
+		assert.Contains(t, delivered, tenantID, "queue %s", queue)
+	}
+	total := 0
+	for _, messages := range broker.queues {
+		total += len(messages)
+	}
+	assert.Equal(t, 3, total, "each task is delivered to exactly one virtual queue")
+}
+
+func TestTaskProducer_WithoutRouting(t *testing.T) {
+	ctx := context.Background()
+	checks := memory.NewCheckRepository()
+	check := newTestCheck(t, checks, "tenant-a")
+	var captured rabbitmq.PublishOptions
+	var body []byte
+	publisher := publisherFunc(func(ctx context.Context, msg []byte, options ...rabbitmq.PublishOption) error {
+		for _, option := range options {
+			option(&captured)
+		}
+		body = msg
+		return nil
+	})
+
+	task := domain.NewTask(check.ID, "tenant-a", domain.PriorityNormal)
+	task.ConfigVersion = check.ConfigVersion
+	task.ConfigOverrides = map[string]interface{}{"expected_status": 204}
+	require.NoError(t, NewTaskProducer(publisher, rabbitmq.TenantRouting{}).PublishTask(ctx, task))
+
+	assert.Empty(t, captured.Exchange)
+	assert.Empty(t, captured.RoutingKey)
+	assert.Equal(t, "tenant-a", captured.Headers[rabbitmq.HeaderTenantID])
+	var message TaskMessage
+	require.NoError(t, json.Unmarshal(body, &message))
+	assert.Equal(t, task.ID, message.ExecutionID)
+	assert.Equal(t, "tenant-a", message.TenantID)
+	// Задача несет версию и переопределения, а не конфигурацию проверки
+	assert.Equal(t, check.ConfigVersion, message.ConfigVersion)
+	assert.Equal(t, map[string]interface{}{"expected_status": float64(204)}, message.Overrides)
+	assert.NotContains(t, string(body), "example.com")
+}
+
+// publisherFunc адаптер функции к Publisher
+type publisherFunc func(ctx context.Context, body []byte, options ...rabbitmq.PublishOption) error
+
+func (f publisherFunc) Publish(ctx context.Context, body []byte, options ...rabbitmq.PublishOption) error {
+	return f(ctx, body, options...)
+}
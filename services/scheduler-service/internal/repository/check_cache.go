@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+)
+
+// CheckCacheStats счетчики обращений к кэшу определений проверок
+type CheckCacheStats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	Errors int64 `json:"errors"`
+}
+
+// CheckCache кэш определений включенных проверок поверх CheckRepository
+type CheckCache interface {
+	CheckRepository
+
+	// Invalidate удаляет проверки из кэша после изменений в обход CheckRepository
+	Invalidate(ctx context.Context, checkIDs ...string) error
+
+	// Stats возвращает счетчики попаданий и промахов кэша
+	Stats() CheckCacheStats
+}
@@ -29,12 +29,22 @@ type CheckRepository interface {
 	// GetActiveChecksByTenant возвращает список активных проверок для tenant
 	GetActiveChecksByTenant(ctx context.Context, tenantID string) ([]*domain.Check, error)
 
-	// List возвращает список проверок с пагинацией
+	// List возвращает список проверок с пагинацией; pageToken - смещение первой записи
 	List(ctx context.Context, tenantID string, pageSize int, pageToken string) ([]*domain.Check, error)
 
+	// ListByGroups возвращает проверки tenant из поддеревьев групп с указанными путями;
+	// pageToken - смещение первой записи
+	ListByGroups(ctx context.Context, tenantID string, groupPaths []string, pageSize int, pageToken string) ([]*domain.Check, error)
+
 	// Count возвращает общее количество проверок для tenant
 	Count(ctx context.Context, tenantID string) (int, error)
 
+	// CountByGroups возвращает количество проверок tenant из поддеревьев групп с указанными путями
+	CountByGroups(ctx context.Context, tenantID string, groupPaths []string) (int, error)
+
+	// FleetEntries возвращает tenant и признак включения всех проверок по ID для начальной загрузки сводки по парку
+	FleetEntries(ctx context.Context) (map[string]domain.FleetEntry, error)
+
 	// Ping проверяет соединение с БД
 	Ping(ctx context.Context) error
 }
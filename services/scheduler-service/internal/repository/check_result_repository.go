@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// CheckResultRepository определяет интерфейс для чтения результатов проверок
+type CheckResultRepository interface {
+	// ConsecutiveSuccesses возвращает число успешных результатов подряд среди последних limit результатов проверки
+	ConsecutiveSuccesses(ctx context.Context, checkID string, limit int) (int, error)
+
+	// AverageDurations возвращает среднюю длительность последних limit результатов каждой проверки;
+	// проверки без результатов в ответ не попадают
+	AverageDurations(ctx context.Context, checkIDs []string, limit int) (map[string]time.Duration, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// FleetRepository хранит состояния проверок и счетчики сводки по парку проверок.
+// Счетчики изменяются при смене состояния проверки, а не пересчитываются по таблице проверок
+type FleetRepository interface {
+	// Apply применяет изменение проверки и обновляет счетчики ее tenant
+	Apply(ctx context.Context, change domain.FleetChange) error
+
+	// Counts возвращает счетчики tenant
+	Counts(ctx context.Context, tenantID string) (domain.FleetCounts, error)
+
+	// AllCounts возвращает счетчики всех tenant
+	AllCounts(ctx context.Context) (map[string]domain.FleetCounts, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// GroupRepository определяет интерфейс для работы с группами проверок
+type GroupRepository interface {
+	// Create создает новую группу
+	Create(ctx context.Context, group *domain.Group) error
+
+	// GetByID возвращает группу по ID
+	GetByID(ctx context.Context, id string) (*domain.Group, error)
+
+	// List возвращает группы tenant, упорядоченные по пути, с количеством проверок
+	List(ctx context.Context, tenantID string) ([]*domain.Group, error)
+
+	// Update обновляет имя и положение группы; при перемещении пути поддерева переписываются атомарно
+	Update(ctx context.Context, group *domain.Group, oldPath string) error
+
+	// Delete удаляет пустую группу (без подгрупп и проверок)
+	Delete(ctx context.Context, id string) error
+
+	// SetEnabled включает или выключает группу вместе с поддеревом и ее проверками,
+	// возвращает ID измененных проверок
+	SetEnabled(ctx context.Context, tenantID, path string, enabled bool) ([]string, error)
+}
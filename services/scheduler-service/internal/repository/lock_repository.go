@@ -20,6 +20,10 @@ type LockRepository interface {
 
 	// GetLockInfo получает информацию о блокировке
 	GetLockInfo(ctx context.Context, checkID string) (*domain.LockInfo, error)
+
+	// ValidateLock проверяет, что workerID все еще держит блокировку с токеном fencingToken;
+	// ErrConflict, если блокировка истекла или перешла к другому воркеру
+	ValidateLock(ctx context.Context, checkID, workerID string, fencingToken int64) error
 }
 
 // TaskRepository интерфейс для управления задачами
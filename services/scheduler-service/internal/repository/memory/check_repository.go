@@ -0,0 +1,204 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// CheckRepository проверки в памяти процесса. Используется в режиме одного процесса без
+// PostgreSQL: проверки загружаются из конфигурации при каждом запуске
+type CheckRepository struct {
+	mu     sync.RWMutex
+	checks map[string]*domain.Check
+}
+
+// NewCheckRepository создает хранилище проверок в памяти
+func NewCheckRepository() *CheckRepository {
+	return &CheckRepository{checks: make(map[string]*domain.Check)}
+}
+
+var _ repository.CheckRepository = (*CheckRepository)(nil)
+
+// Create сохраняет проверку; без ID проверка получает новый идентификатор
+func (r *CheckRepository) Create(ctx context.Context, check *domain.Check) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if check.ID == "" {
+		check.ID = uuid.New().String()
+	}
+	if _, ok := r.checks[check.ID]; ok {
+		return errors.New(errors.ErrConflict, "check already exists").
+			WithDetails(fmt.Sprintf("check_id: %s", check.ID))
+	}
+	check.ConfigVersion = domain.InitialConfigVersion
+	r.checks[check.ID] = copyCheck(check)
+	return nil
+}
+
+// GetByID возвращает копию проверки
+func (r *CheckRepository) GetByID(ctx context.Context, id string) (*domain.Check, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	check, ok := r.checks[id]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "check not found").
+			WithDetails(fmt.Sprintf("check_id: %s", id))
+	}
+	return copyCheck(check), nil
+}
+
+// GetByTenantID возвращает проверки tenant
+func (r *CheckRepository) GetByTenantID(ctx context.Context, tenantID string) ([]*domain.Check, error) {
+	return r.filter(func(check *domain.Check) bool { return check.TenantID == tenantID }), nil
+}
+
+// Update заменяет сохраненную проверку; версия конфигурации растет, если изменилось выполнение
+func (r *CheckRepository) Update(ctx context.Context, check *domain.Check) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.checks[check.ID]
+	if !ok {
+		return errors.New(errors.ErrNotFound, "check not found").
+			WithDetails(fmt.Sprintf("check_id: %s", check.ID))
+	}
+	check.ConfigVersion = existing.ConfigVersion
+	if check.ExecutionChanged(existing) {
+		check.ConfigVersion++
+	}
+	r.checks[check.ID] = copyCheck(check)
+	return nil
+}
+
+// Delete удаляет проверку
+func (r *CheckRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.checks[id]; !ok {
+		return errors.New(errors.ErrNotFound, "check not found").
+			WithDetails(fmt.Sprintf("check_id: %s", id))
+	}
+	delete(r.checks, id)
+	return nil
+}
+
+// GetActiveChecks возвращает включенные проверки
+func (r *CheckRepository) GetActiveChecks(ctx context.Context) ([]*domain.Check, error) {
+	return r.filter(func(check *domain.Check) bool { return check.Enabled }), nil
+}
+
+// GetActiveChecksByTenant возвращает включенные проверки tenant
+func (r *CheckRepository) GetActiveChecksByTenant(ctx context.Context, tenantID string) ([]*domain.Check, error) {
+	return r.filter(func(check *domain.Check) bool { return check.Enabled && check.TenantID == tenantID }), nil
+}
+
+// List возвращает страницу проверок tenant, новые первыми; pageToken - смещение
+func (r *CheckRepository) List(ctx context.Context, tenantID string, pageSize int, pageToken string) ([]*domain.Check, error) {
+	checks, _ := r.GetByTenantID(ctx, tenantID)
+	sort.SliceStable(checks, func(i, j int) bool { return checks[i].CreatedAt.After(checks[j].CreatedAt) })
+	return page(checks, pageSize, pageToken), nil
+}
+
+// ListByGroups возвращает проверки tenant из поддеревьев групп с указанными путями
+func (r *CheckRepository) ListByGroups(ctx context.Context, tenantID string, groupPaths []string, pageSize int, pageToken string) ([]*domain.Check, error) {
+	checks := r.inGroups(tenantID, groupPaths)
+	sort.SliceStable(checks, func(i, j int) bool { return checks[i].CreatedAt.After(checks[j].CreatedAt) })
+	return page(checks, pageSize, pageToken), nil
+}
+
+// Count возвращает количество проверок tenant
+func (r *CheckRepository) Count(ctx context.Context, tenantID string) (int, error) {
+	checks, _ := r.GetByTenantID(ctx, tenantID)
+	return len(checks), nil
+}
+
+// CountByGroups возвращает количество проверок tenant из поддеревьев групп
+func (r *CheckRepository) CountByGroups(ctx context.Context, tenantID string, groupPaths []string) (int, error) {
+	return len(r.inGroups(tenantID, groupPaths)), nil
+}
+
+// inGroups возвращает проверки tenant, путь группы которых начинается с одного из groupPaths
+func (r *CheckRepository) inGroups(tenantID string, groupPaths []string) []*domain.Check {
+	return r.filter(func(check *domain.Check) bool {
+		if check.TenantID != tenantID || check.GroupPath == "" {
+			return false
+		}
+		for _, path := range groupPaths {
+			if strings.HasPrefix(check.GroupPath, path) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// FleetEntries возвращает tenant и признак включения всех проверок
+func (r *CheckRepository) FleetEntries(ctx context.Context) (map[string]domain.FleetEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make(map[string]domain.FleetEntry, len(r.checks))
+	for id, check := range r.checks {
+		entries[id] = domain.FleetEntry{TenantID: check.TenantID, Enabled: check.Enabled}
+	}
+	return entries, nil
+}
+
+// Ping всегда успешен
+func (r *CheckRepository) Ping(ctx context.Context) error {
+	return nil
+}
+
+// filter возвращает копии проверок, отсортированные по ID
+func (r *CheckRepository) filter(match func(check *domain.Check) bool) []*domain.Check {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var checks []*domain.Check
+	for _, check := range r.checks {
+		if match(check) {
+			checks = append(checks, copyCheck(check))
+		}
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].ID < checks[j].ID })
+	return checks
+}
+
+// copyCheck копирует проверку, чтобы вызывающий код не менял сохраненное значение
+func copyCheck(check *domain.Check) *domain.Check {
+	copied := *check
+	copied.Tags = append([]string(nil), check.Tags...)
+	if check.Config != nil {
+		copied.Config = make(domain.CheckConfig, len(check.Config))
+		for key, value := range check.Config {
+			copied.Config[key] = value
+		}
+	}
+	return &copied
+}
+
+// page возвращает страницу элементов по смещению из pageToken
+func page[T any](items []T, pageSize int, pageToken string) []T {
+	offset, _ := strconv.Atoi(pageToken)
+	if offset < 0 || offset >= len(items) {
+		return nil
+	}
+	items = items[offset:]
+	if pageSize > 0 && pageSize < len(items) {
+		items = items[:pageSize]
+	}
+	return items
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+func TestCheckRepository_Pagination(t *testing.T) {
+	ctx := context.Background()
+	repo := NewCheckRepository()
+	start := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		groupPath := "/ops/"
+		if i%2 == 1 {
+			groupPath = "/web/"
+		}
+		require.NoError(t, repo.Create(ctx, &domain.Check{
+			ID:        fmt.Sprintf("check-%d", i),
+			TenantID:  "t1",
+			GroupPath: groupPath,
+			CreatedAt: start.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+	require.NoError(t, repo.Create(ctx, &domain.Check{ID: "other", TenantID: "t2", CreatedAt: start}))
+
+	page, err := repo.List(ctx, "t1", 2, "2")
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "check-2", page[0].ID, "newest first")
+	assert.Equal(t, "check-1", page[1].ID)
+
+	total, err := repo.Count(ctx, "t1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, total)
+
+	inGroups, err := repo.ListByGroups(ctx, "t1", []string{"/ops/"}, 2, "1")
+	require.NoError(t, err)
+	require.Len(t, inGroups, 2)
+	assert.Equal(t, "check-2", inGroups[0].ID)
+	assert.Equal(t, "check-0", inGroups[1].ID)
+
+	total, err = repo.CountByGroups(ctx, "t1", []string{"/ops/"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+}
+
+func TestCheckRepository_ConfigVersion(t *testing.T) {
+	ctx := context.Background()
+	repo := NewCheckRepository()
+
+	check := &domain.Check{ID: "check-1", TenantID: "t1", Type: domain.CheckTypeHTTP, Target: "https://example.com", Interval: 60, Enabled: true}
+	require.NoError(t, repo.Create(ctx, check))
+	assert.Equal(t, int64(domain.InitialConfigVersion), check.ConfigVersion)
+
+	// Изменение расписания не меняет выполнение, задачи в очереди остаются актуальными
+	check.Interval = 120
+	require.NoError(t, repo.Update(ctx, check))
+	assert.Equal(t, int64(1), check.ConfigVersion)
+
+	check.Target = "https://example.org"
+	require.NoError(t, repo.Update(ctx, check))
+	assert.Equal(t, int64(2), check.ConfigVersion)
+
+	stored, err := repo.GetByID(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stored.ConfigVersion)
+}
@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// FleetRepository сводка по парку проверок в памяти процесса. Используется без Redis:
+// каждый экземпляр планировщика видит только изменения, прошедшие через него
+type FleetRepository struct {
+	mu      sync.RWMutex
+	entries map[string]domain.FleetEntry
+	counts  map[string]*domain.FleetCounts
+}
+
+// NewFleetRepository создает сводку в памяти
+func NewFleetRepository() repository.FleetRepository {
+	return &FleetRepository{
+		entries: make(map[string]domain.FleetEntry),
+		counts:  make(map[string]*domain.FleetCounts),
+	}
+}
+
+// Apply применяет изменение проверки и обновляет счетчики ее tenant
+func (r *FleetRepository) Apply(ctx context.Context, change domain.FleetChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	old, existed := r.entries[change.CheckID]
+	entry, exists := change.Apply(old, existed)
+	if existed && exists && old == entry {
+		return nil
+	}
+
+	if existed {
+		counts := r.counts[old.TenantID]
+		counts.Total--
+		counts.Add(old.State(), -1)
+		delete(r.entries, change.CheckID)
+	}
+	if exists {
+		counts, ok := r.counts[entry.TenantID]
+		if !ok {
+			counts = &domain.FleetCounts{}
+			r.counts[entry.TenantID] = counts
+		}
+		counts.Total++
+		counts.Add(entry.State(), 1)
+		r.entries[change.CheckID] = entry
+	}
+	return nil
+}
+
+// Counts возвращает счетчики tenant
+func (r *FleetRepository) Counts(ctx context.Context, tenantID string) (domain.FleetCounts, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if counts, ok := r.counts[tenantID]; ok {
+		return *counts, nil
+	}
+	return domain.FleetCounts{}, nil
+}
+
+// AllCounts возвращает счетчики всех tenant
+func (r *FleetRepository) AllCounts(ctx context.Context) (map[string]domain.FleetCounts, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	all := make(map[string]domain.FleetCounts, len(r.counts))
+	for tenantID, counts := range r.counts {
+		all[tenantID] = *counts
+	}
+	return all, nil
+}
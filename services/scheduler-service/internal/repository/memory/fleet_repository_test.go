@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+func enabled(value bool) *bool {
+	return &value
+}
+
+func TestFleetRepository_Transitions(t *testing.T) {
+	ctx := context.Background()
+	repo := NewFleetRepository()
+
+	apply := func(change domain.FleetChange) {
+		t.Helper()
+		require.NoError(t, repo.Apply(ctx, change))
+	}
+	counts := func(tenantID string) domain.FleetCounts {
+		t.Helper()
+		counts, err := repo.Counts(ctx, tenantID)
+		require.NoError(t, err)
+		return counts
+	}
+
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "a", Enabled: enabled(true)})
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "b", Enabled: enabled(true)})
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "c", Enabled: enabled(false)})
+	apply(domain.FleetChange{TenantID: "t2", CheckID: "d", Enabled: enabled(true)})
+	assert.Equal(t, domain.FleetCounts{Total: 3, Unknown: 2, Paused: 1}, counts("t1"))
+
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "a", Health: domain.CheckHealthFailing})
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "b", Health: domain.CheckHealthDegraded})
+	// Повторное сообщение о том же здоровье не меняет счетчики
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "a", Health: domain.CheckHealthFailing})
+	assert.Equal(t, domain.FleetCounts{Total: 3, Failing: 1, Degraded: 1, Paused: 1}, counts("t1"))
+
+	// Здоровье выключенной проверки не выводит ее из паузы, включение сбрасывает здоровье
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "c", Health: domain.CheckHealthUp})
+	assert.Equal(t, 1, counts("t1").Paused)
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "c", Enabled: enabled(true)})
+	assert.Equal(t, domain.FleetCounts{Total: 3, Failing: 1, Degraded: 1, Unknown: 1}, counts("t1"))
+
+	// Изменение проверки сохраняет ее здоровье
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "a", Enabled: enabled(true)})
+	assert.Equal(t, 1, counts("t1").Failing)
+
+	// Поздние результаты удаленной проверки и изменения с чужим tenant не учитываются
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "b", Deleted: true})
+	apply(domain.FleetChange{TenantID: "t1", CheckID: "b", Health: domain.CheckHealthUp})
+	apply(domain.FleetChange{TenantID: "t2", CheckID: "a", Health: domain.CheckHealthUp})
+	apply(domain.FleetChange{TenantID: "t2", CheckID: "a", Deleted: true})
+	assert.Equal(t, domain.FleetCounts{Total: 2, Failing: 1, Unknown: 1}, counts("t1"))
+
+	all, err := repo.AllCounts(ctx)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+	assert.Equal(t, domain.FleetCounts{Total: 1, Unknown: 1}, all["t2"])
+	assert.Equal(t, domain.FleetCounts{}, counts("missing"))
+}
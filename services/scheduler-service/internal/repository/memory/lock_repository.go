@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// LockRepository блокировки проверок в памяти процесса. Подходит, только когда планировщик
+// работает в единственном экземпляре
+type LockRepository struct {
+	mu     sync.Mutex
+	locks  map[string]domain.LockInfo
+	fences map[string]int64
+	now    func() time.Time
+}
+
+// NewLockRepository создает блокировки в памяти
+func NewLockRepository() *LockRepository {
+	return &LockRepository{
+		locks:  make(map[string]domain.LockInfo),
+		fences: make(map[string]int64),
+		now:    time.Now,
+	}
+}
+
+var _ repository.LockRepository = (*LockRepository)(nil)
+
+// WithClock задает источник времени истечения блокировок вместо системных часов
+func (r *LockRepository) WithClock(c clock.Clock) *LockRepository {
+	r.now = c.Now
+	return r
+}
+
+// TryLock получает блокировку, если она свободна или истекла
+func (r *LockRepository) TryLock(ctx context.Context, checkID, workerID string, ttl time.Duration) (*domain.LockInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if lock, ok := r.locks[checkID]; ok && now.Before(lock.ExpiresAt) {
+		return nil, errors.New(errors.ErrConflict, "lock already acquired").
+			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
+			WithContext(ctx)
+	}
+
+	r.fences[checkID]++
+	lock := domain.LockInfo{
+		CheckID:      checkID,
+		WorkerID:     workerID,
+		LockedAt:     now,
+		ExpiresAt:    now.Add(ttl),
+		FencingToken: r.fences[checkID],
+	}
+	r.locks[checkID] = lock
+	return &lock, nil
+}
+
+// ReleaseLock освобождает блокировку, если ее держит workerID
+func (r *LockRepository) ReleaseLock(ctx context.Context, checkID, workerID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lock, ok := r.locks[checkID]
+	if !ok {
+		return nil
+	}
+	if lock.WorkerID != workerID {
+		return errors.New(errors.ErrUnauthorized, "lock belongs to different worker").
+			WithDetails(fmt.Sprintf("check_id: %s, worker_id: %s", checkID, lock.WorkerID)).
+			WithContext(ctx)
+	}
+	delete(r.locks, checkID)
+	return nil
+}
+
+// IsLocked проверяет, есть ли действующая блокировка
+func (r *LockRepository) IsLocked(ctx context.Context, checkID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lock, ok := r.locks[checkID]
+	return ok && r.now().Before(lock.ExpiresAt), nil
+}
+
+// GetLockInfo возвращает действующую блокировку
+func (r *LockRepository) GetLockInfo(ctx context.Context, checkID string) (*domain.LockInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lock, ok := r.locks[checkID]
+	if !ok || !r.now().Before(lock.ExpiresAt) {
+		return nil, errors.New(errors.ErrNotFound, "lock not found").
+			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
+			WithContext(ctx)
+	}
+	return &lock, nil
+}
+
+// ValidateLock проверяет, что workerID все еще держит действующую блокировку с токеном fencingToken
+func (r *LockRepository) ValidateLock(ctx context.Context, checkID, workerID string, fencingToken int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lock, ok := r.locks[checkID]
+	if !ok || !r.now().Before(lock.ExpiresAt) || lock.WorkerID != workerID || lock.FencingToken != fencingToken {
+		return errors.New(errors.ErrConflict, "lock lost").
+			WithDetails(fmt.Sprintf("check_id: %s, worker_id: %s, fencing_token: %d", checkID, workerID, fencingToken)).
+			WithContext(ctx)
+	}
+	return nil
+}
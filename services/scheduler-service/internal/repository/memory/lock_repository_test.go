@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockRepository_TryLock(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	repo := NewLockRepository()
+	repo.now = func() time.Time { return now }
+
+	_, err := repo.TryLock(ctx, "check-1", "worker-a", time.Minute)
+	require.NoError(t, err)
+	_, err = repo.TryLock(ctx, "check-1", "worker-b", time.Minute)
+	assert.Error(t, err, "lock held by another worker")
+	assert.Error(t, repo.ReleaseLock(ctx, "check-1", "worker-b"))
+
+	// Истекшая блокировка достается следующему исполнителю
+	now = now.Add(2 * time.Minute)
+	locked, err := repo.IsLocked(ctx, "check-1")
+	require.NoError(t, err)
+	assert.False(t, locked)
+	lock, err := repo.TryLock(ctx, "check-1", "worker-b", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "worker-b", lock.WorkerID)
+
+	require.NoError(t, repo.ReleaseLock(ctx, "check-1", "worker-b"))
+	_, err = repo.GetLockInfo(ctx, "check-1")
+	assert.Error(t, err)
+}
+
+func TestLockRepository_FencingToken(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	repo := NewLockRepository()
+	repo.now = func() time.Time { return now }
+
+	first, err := repo.TryLock(ctx, "check-1", "worker-a", time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, repo.ValidateLock(ctx, "check-1", "worker-a", first.FencingToken))
+
+	// worker-a завис дольше TTL: блокировка истекла, и ее получил worker-b с большим токеном
+	now = now.Add(2 * time.Minute)
+	assert.Error(t, repo.ValidateLock(ctx, "check-1", "worker-a", first.FencingToken), "expired lock")
+	second, err := repo.TryLock(ctx, "check-1", "worker-b", time.Minute)
+	require.NoError(t, err)
+	assert.Greater(t, second.FencingToken, first.FencingToken)
+	assert.Error(t, repo.ValidateLock(ctx, "check-1", "worker-a", first.FencingToken), "lock moved to another worker")
+	require.NoError(t, repo.ValidateLock(ctx, "check-1", "worker-b", second.FencingToken))
+
+	// Токены не повторяются после освобождения
+	require.NoError(t, repo.ReleaseLock(ctx, "check-1", "worker-b"))
+	third, err := repo.TryLock(ctx, "check-1", "worker-a", time.Minute)
+	require.NoError(t, err)
+	assert.Greater(t, third.FencingToken, second.FencingToken)
+
+	// У каждой проверки свой счетчик
+	other, err := repo.TryLock(ctx, "check-2", "worker-a", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), other.FencingToken)
+}
@@ -0,0 +1,134 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// SchedulerRepository запланированные проверки и расписания в памяти процесса
+type SchedulerRepository struct {
+	mu        sync.RWMutex
+	checks    map[string]*domain.Check
+	schedules map[string]*domain.Schedule
+}
+
+// NewSchedulerRepository создает хранилище расписаний в памяти
+func NewSchedulerRepository() *SchedulerRepository {
+	return &SchedulerRepository{
+		checks:    make(map[string]*domain.Check),
+		schedules: make(map[string]*domain.Schedule),
+	}
+}
+
+var _ repository.SchedulerRepository = (*SchedulerRepository)(nil)
+
+// AddCheck добавляет проверку в планировщик
+func (r *SchedulerRepository) AddCheck(ctx context.Context, check *domain.Check) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[check.ID] = copyCheck(check)
+	return nil
+}
+
+// RemoveCheck удаляет проверку из планировщика
+func (r *SchedulerRepository) RemoveCheck(ctx context.Context, checkID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checks, checkID)
+	return nil
+}
+
+// UpdateCheck обновляет проверку в планировщике
+func (r *SchedulerRepository) UpdateCheck(ctx context.Context, check *domain.Check) error {
+	return r.AddCheck(ctx, check)
+}
+
+// GetScheduledChecks возвращает запланированные проверки
+func (r *SchedulerRepository) GetScheduledChecks(ctx context.Context) ([]*domain.Check, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	checks := make([]*domain.Check, 0, len(r.checks))
+	for _, check := range r.checks {
+		checks = append(checks, copyCheck(check))
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].ID < checks[j].ID })
+	return checks, nil
+}
+
+// Create сохраняет расписание проверки
+func (r *SchedulerRepository) Create(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *schedule
+	if saved.ID == "" {
+		saved.ID = uuid.New().String()
+	}
+	now := time.Now()
+	if saved.CreatedAt.IsZero() {
+		saved.CreatedAt = now
+	}
+	saved.UpdatedAt = now
+	r.schedules[saved.CheckID] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+// DeleteByCheckID удаляет расписание проверки
+func (r *SchedulerRepository) DeleteByCheckID(ctx context.Context, checkID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.schedules, checkID)
+	return nil
+}
+
+// GetByCheckID возвращает расписание проверки
+func (r *SchedulerRepository) GetByCheckID(ctx context.Context, checkID string) (*domain.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, ok := r.schedules[checkID]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "schedule not found").
+			WithDetails(fmt.Sprintf("check_id: %s", checkID))
+	}
+	result := *schedule
+	return &result, nil
+}
+
+// List возвращает страницу расписаний, новые первыми; фильтр не поддерживается, как и в PostgreSQL
+func (r *SchedulerRepository) List(ctx context.Context, pageSize int, pageToken string, filter string) ([]*domain.Schedule, error) {
+	r.mu.RLock()
+	schedules := make([]*domain.Schedule, 0, len(r.schedules))
+	for _, schedule := range r.schedules {
+		copied := *schedule
+		schedules = append(schedules, &copied)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].CreatedAt.After(schedules[j].CreatedAt) })
+	return page(schedules, pageSize, pageToken), nil
+}
+
+// Count возвращает количество расписаний
+func (r *SchedulerRepository) Count(ctx context.Context, filter string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.schedules), nil
+}
+
+// Ping всегда успешен
+func (r *SchedulerRepository) Ping(ctx context.Context) error {
+	return nil
+}
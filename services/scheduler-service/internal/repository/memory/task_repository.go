@@ -0,0 +1,132 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// DefaultTaskHistory число последних задач, которые хранит TaskRepository
+const DefaultTaskHistory = 10000
+
+// TaskRepository последние задачи в памяти процесса; старые задачи вытесняются новыми
+type TaskRepository struct {
+	mu      sync.Mutex
+	limit   int
+	order   []string
+	tasks   map[string]*taskEntry
+	results map[string]*domain.TaskResult
+}
+
+// taskEntry задача и ее статус
+type taskEntry struct {
+	task   domain.Task
+	status domain.TaskStatus
+}
+
+// NewTaskRepository создает хранилище последних limit задач; limit <= 0 - DefaultTaskHistory
+func NewTaskRepository(limit int) *TaskRepository {
+	if limit <= 0 {
+		limit = DefaultTaskHistory
+	}
+	return &TaskRepository{
+		limit:   limit,
+		tasks:   make(map[string]*taskEntry),
+		results: make(map[string]*domain.TaskResult),
+	}
+}
+
+var _ repository.TaskRepository = (*TaskRepository)(nil)
+
+// CreateTask сохраняет задачу в статусе pending
+func (r *TaskRepository) CreateTask(ctx context.Context, task *domain.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tasks[task.ID]; ok {
+		return errors.New(errors.ErrConflict, "task already exists").
+			WithDetails(fmt.Sprintf("task_id: %s", task.ID))
+	}
+	if len(r.order) >= r.limit {
+		oldest := r.order[0]
+		r.order = r.order[1:]
+		delete(r.tasks, oldest)
+		delete(r.results, oldest)
+	}
+	r.order = append(r.order, task.ID)
+	r.tasks[task.ID] = &taskEntry{task: *task, status: domain.TaskStatusPending}
+	return nil
+}
+
+// GetTaskByID возвращает задачу
+func (r *TaskRepository) GetTaskByID(ctx context.Context, taskID string) (*domain.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.tasks[taskID]
+	if !ok {
+		return nil, errors.New(errors.ErrNotFound, "task not found").
+			WithDetails(fmt.Sprintf("task_id: %s", taskID))
+	}
+	task := entry.task
+	return &task, nil
+}
+
+// GetPendingTasks возвращает задачи в статусе pending по убыванию приоритета
+func (r *TaskRepository) GetPendingTasks(ctx context.Context, limit int) ([]*domain.Task, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var tasks []*domain.Task
+	for _, id := range r.order {
+		if entry := r.tasks[id]; entry.status == domain.TaskStatusPending {
+			task := entry.task
+			tasks = append(tasks, &task)
+		}
+	}
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Priority != tasks[j].Priority {
+			return tasks[i].Priority > tasks[j].Priority
+		}
+		return tasks[i].ScheduledAt.Before(tasks[j].ScheduledAt)
+	})
+	if limit > 0 && limit < len(tasks) {
+		tasks = tasks[:limit]
+	}
+	return tasks, nil
+}
+
+// UpdateTaskStatus меняет статус задачи
+func (r *TaskRepository) UpdateTaskStatus(ctx context.Context, taskID string, status domain.TaskStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.tasks[taskID]
+	if !ok {
+		return errors.New(errors.ErrNotFound, "task not found").
+			WithDetails(fmt.Sprintf("task_id: %s", taskID))
+	}
+	entry.status = status
+	return nil
+}
+
+// SaveTaskResult сохраняет результат и переводит задачу в его статус
+func (r *TaskRepository) SaveTaskResult(ctx context.Context, result *domain.TaskResult) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.tasks[result.TaskID]
+	if !ok {
+		return errors.New(errors.ErrNotFound, "task not found").
+			WithDetails(fmt.Sprintf("task_id: %s", result.TaskID))
+	}
+	entry.status = result.Status
+	saved := *result
+	r.results[result.TaskID] = &saved
+	return nil
+}
@@ -4,25 +4,72 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"UptimePingPlatform/pkg/database"
 	"UptimePingPlatform/pkg/errors"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/scheduler-service/internal/domain"
 	"UptimePingPlatform/services/scheduler-service/internal/repository"
 )
 
-// CheckRepository реализация репозитория для проверок в PostgreSQL
+// selectCheckByIDQuery чтение проверки при каждом запуске по расписанию
+const selectCheckByIDQuery = `
+		SELECT id, tenant_id, name, description, type, target, 
+			interval_seconds, timeout_seconds, enabled, config, owner, team, runbook_url,
+			escalation_contact, tags, COALESCE(group_id::text, ''),
+			COALESCE((SELECT g.path FROM check_groups g WHERE g.id = checks.group_id), ''),
+			created_at, updated_at, config_version
+		FROM checks
+		WHERE id = $1
+	`
+
+// updateCheckQuery обновление проверки, в том числе времени запуска после каждой диспетчеризации.
+// Версия конфигурации растет только при изменении того, как проверка выполняется
+const updateCheckQuery = `
+		UPDATE checks
+		SET name = $2, description = $3, type = $4, target = $5, 
+			interval_seconds = $6, timeout_seconds = $7, enabled = $8, 
+			config = $9, owner = $10, team = $11, runbook_url = $12,
+			escalation_contact = $13, tags = $14, group_id = $15, updated_at = $16,
+			config_version = config_version + CASE
+				WHEN (type, target, timeout_seconds, enabled, config) IS DISTINCT FROM ($4, $5, $7, $8, $9)
+				THEN 1 ELSE 0 END
+		WHERE id = $1
+		RETURNING config_version
+	`
+
+// CheckStatements горячие запросы репозитория для database.Config.PreparedStatements
+var CheckStatements = []string{
+	selectCheckByIDQuery,
+	updateCheckQuery,
+}
+
+// CheckRepository реализация репозитория для проверок в PostgreSQL.
+// Списки и счетчики читаются из readPool; GetByID остается на pool,
+// так как usecase читают проверку перед ее изменением
 type CheckRepository struct {
-	pool   *pgxpool.Pool
-	logger logger.Logger
+	pool     *pgxpool.Pool
+	readPool *pgxpool.Pool
+	logger   logger.Logger
 }
 
 // NewCheckRepository создает новый экземпляр CheckRepository
 func NewCheckRepository(pool *pgxpool.Pool) repository.CheckRepository {
 	return &CheckRepository{
-		pool: pool,
+		pool:     pool,
+		readPool: pool,
+	}
+}
+
+// NewCheckRepositoryWithPools создает CheckRepository с раздельными пулами записи и чтения
+func NewCheckRepositoryWithPools(pools *database.Pools) repository.CheckRepository {
+	return &CheckRepository{
+		pool:     pools.Write,
+		readPool: pools.Read,
 	}
 }
 
@@ -30,8 +77,9 @@ func NewCheckRepository(pool *pgxpool.Pool) repository.CheckRepository {
 func (r *CheckRepository) Create(ctx context.Context, check *domain.Check) error {
 	query := `
 		INSERT INTO checks (id, tenant_id, name, description, type, target, 
-			interval_seconds, timeout_seconds, enabled, config, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			interval_seconds, timeout_seconds, enabled, config, owner, team, runbook_url,
+			escalation_contact, tags, group_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 	`
 
 	_, err := r.pool.Exec(ctx, query,
@@ -45,6 +93,12 @@ func (r *CheckRepository) Create(ctx context.Context, check *domain.Check) error
 		check.Timeout,
 		check.Enabled,
 		check.Config,
+		check.Owner,
+		check.Team,
+		check.RunbookURL,
+		check.EscalationContact,
+		tagsOrEmpty(check.Tags),
+		nullableGroupID(check.GroupID),
 		check.CreatedAt,
 		check.UpdatedAt,
 	)
@@ -54,22 +108,16 @@ func (r *CheckRepository) Create(ctx context.Context, check *domain.Check) error
 			WithDetails(fmt.Sprintf("tenant_id: %s, name: %s", check.TenantID, check.Name)).
 			WithContext(ctx)
 	}
+	check.ConfigVersion = domain.InitialConfigVersion
 
 	return nil
 }
 
 // GetByID возвращает проверку по ID
 func (r *CheckRepository) GetByID(ctx context.Context, id string) (*domain.Check, error) {
-	query := `
-		SELECT id, tenant_id, name, description, type, target, 
-			interval_seconds, timeout_seconds, enabled, config, created_at, updated_at
-		FROM checks
-		WHERE id = $1
-	`
-
 	var check domain.Check
 
-	err := r.pool.QueryRow(ctx, query, id).Scan(
+	err := r.pool.QueryRow(ctx, selectCheckByIDQuery, id).Scan(
 		&check.ID,
 		&check.TenantID,
 		&check.Name,
@@ -80,8 +128,16 @@ func (r *CheckRepository) GetByID(ctx context.Context, id string) (*domain.Check
 		&check.Timeout,
 		&check.Enabled,
 		&check.Config,
+		&check.Owner,
+		&check.Team,
+		&check.RunbookURL,
+		&check.EscalationContact,
+		&check.Tags,
+		&check.GroupID,
+		&check.GroupPath,
 		&check.CreatedAt,
 		&check.UpdatedAt,
+		&check.ConfigVersion,
 	)
 
 	if err != nil {
@@ -102,13 +158,16 @@ func (r *CheckRepository) GetByID(ctx context.Context, id string) (*domain.Check
 func (r *CheckRepository) GetByTenantID(ctx context.Context, tenantID string) ([]*domain.Check, error) {
 	query := `
 		SELECT id, tenant_id, name, description, type, target, 
-			interval_seconds, timeout_seconds, enabled, config, created_at, updated_at
+			interval_seconds, timeout_seconds, enabled, config, owner, team, runbook_url,
+			escalation_contact, tags, COALESCE(group_id::text, ''),
+			COALESCE((SELECT g.path FROM check_groups g WHERE g.id = checks.group_id), ''),
+			created_at, updated_at, config_version
 		FROM checks
 		WHERE tenant_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.pool.Query(ctx, query, tenantID)
+	rows, err := r.readPool.Query(ctx, query, tenantID)
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get checks by tenant").
 			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
@@ -131,8 +190,16 @@ func (r *CheckRepository) GetByTenantID(ctx context.Context, tenantID string) ([
 			&check.Timeout,
 			&check.Enabled,
 			&check.Config,
+			&check.Owner,
+			&check.Team,
+			&check.RunbookURL,
+			&check.EscalationContact,
+			&check.Tags,
+			&check.GroupID,
+			&check.GroupPath,
 			&check.CreatedAt,
 			&check.UpdatedAt,
+			&check.ConfigVersion,
 		)
 
 		if err != nil {
@@ -155,15 +222,7 @@ func (r *CheckRepository) GetByTenantID(ctx context.Context, tenantID string) ([
 
 // Update обновляет проверку
 func (r *CheckRepository) Update(ctx context.Context, check *domain.Check) error {
-	query := `
-		UPDATE checks
-		SET name = $2, description = $3, type = $4, target = $5, 
-			interval_seconds = $6, timeout_seconds = $7, enabled = $8, 
-			config = $9, updated_at = $10
-		WHERE id = $1
-	`
-
-	_, err := r.pool.Exec(ctx, query,
+	err := r.pool.QueryRow(ctx, updateCheckQuery,
 		check.ID,
 		check.Name,
 		check.Description,
@@ -173,10 +232,21 @@ func (r *CheckRepository) Update(ctx context.Context, check *domain.Check) error
 		check.Timeout,
 		check.Enabled,
 		check.Config,
+		check.Owner,
+		check.Team,
+		check.RunbookURL,
+		check.EscalationContact,
+		tagsOrEmpty(check.Tags),
+		nullableGroupID(check.GroupID),
 		check.UpdatedAt,
-	)
+	).Scan(&check.ConfigVersion)
 
 	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.New(errors.ErrNotFound, "check not found").
+				WithDetails(fmt.Sprintf("check_id: %s", check.ID)).
+				WithContext(ctx)
+		}
 		return errors.Wrap(err, errors.ErrInternal, "failed to update check").
 			WithDetails(fmt.Sprintf("check_id: %s, tenant_id: %s", check.ID, check.TenantID)).
 			WithContext(ctx)
@@ -209,14 +279,17 @@ func (r *CheckRepository) Delete(ctx context.Context, id string) error {
 func (r *CheckRepository) List(ctx context.Context, tenantID string, pageSize int, pageToken string) ([]*domain.Check, error) {
 	query := `
 		SELECT id, tenant_id, name, description, type, target, 
-			interval_seconds, timeout_seconds, enabled, config, created_at, updated_at
+			interval_seconds, timeout_seconds, enabled, config, owner, team, runbook_url,
+			escalation_contact, tags, COALESCE(group_id::text, ''),
+			COALESCE((SELECT g.path FROM check_groups g WHERE g.id = checks.group_id), ''),
+			created_at, updated_at, config_version
 		FROM checks
 		WHERE tenant_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2
+		ORDER BY created_at DESC, id
+		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.pool.Query(ctx, query, tenantID, pageSize)
+	rows, err := r.readPool.Query(ctx, query, tenantID, pageSize, pageOffset(pageToken))
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list checks").
 			WithDetails(fmt.Sprintf("tenant_id: %s, page_size: %d", tenantID, pageSize)).
@@ -239,8 +312,16 @@ func (r *CheckRepository) List(ctx context.Context, tenantID string, pageSize in
 			&check.Timeout,
 			&check.Enabled,
 			&check.Config,
+			&check.Owner,
+			&check.Team,
+			&check.RunbookURL,
+			&check.EscalationContact,
+			&check.Tags,
+			&check.GroupID,
+			&check.GroupPath,
 			&check.CreatedAt,
 			&check.UpdatedAt,
+			&check.ConfigVersion,
 		)
 		if err != nil {
 			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan check").
@@ -253,12 +334,85 @@ func (r *CheckRepository) List(ctx context.Context, tenantID string, pageSize in
 	return checks, nil
 }
 
+// ListByGroups возвращает проверки tenant из поддеревьев групп с указанными путями
+func (r *CheckRepository) ListByGroups(ctx context.Context, tenantID string, groupPaths []string, pageSize int, pageToken string) ([]*domain.Check, error) {
+	if len(groupPaths) == 0 {
+		return []*domain.Check{}, nil
+	}
+
+	patterns := groupPatterns(groupPaths)
+
+	query := `
+		SELECT id, tenant_id, name, description, type, target, 
+			interval_seconds, timeout_seconds, enabled, config, owner, team, runbook_url,
+			escalation_contact, tags, COALESCE(group_id::text, ''),
+			COALESCE((SELECT g.path FROM check_groups g WHERE g.id = checks.group_id), ''),
+			created_at, updated_at, config_version
+		FROM checks
+		WHERE tenant_id = $1 AND group_id IN (
+			SELECT id FROM check_groups WHERE tenant_id = $1 AND path LIKE ANY($2)
+		)
+		ORDER BY created_at DESC, id
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.readPool.Query(ctx, query, tenantID, patterns, pageSize, pageOffset(pageToken))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list checks by groups").
+			WithDetails(fmt.Sprintf("tenant_id: %s, page_size: %d", tenantID, pageSize)).
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	var checks []*domain.Check
+	for rows.Next() {
+		var check domain.Check
+
+		err := rows.Scan(
+			&check.ID,
+			&check.TenantID,
+			&check.Name,
+			&check.Description,
+			&check.Type,
+			&check.Target,
+			&check.Interval,
+			&check.Timeout,
+			&check.Enabled,
+			&check.Config,
+			&check.Owner,
+			&check.Team,
+			&check.RunbookURL,
+			&check.EscalationContact,
+			&check.Tags,
+			&check.GroupID,
+			&check.GroupPath,
+			&check.CreatedAt,
+			&check.UpdatedAt,
+			&check.ConfigVersion,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan check").
+				WithContext(ctx)
+		}
+
+		checks = append(checks, &check)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate checks").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return checks, nil
+}
+
 // Count возвращает общее количество проверок для tenant
 func (r *CheckRepository) Count(ctx context.Context, tenantID string) (int, error) {
 	query := `SELECT COUNT(*) FROM checks WHERE tenant_id = $1`
 
 	var count int
-	err := r.pool.QueryRow(ctx, query, tenantID).Scan(&count)
+	err := r.readPool.QueryRow(ctx, query, tenantID).Scan(&count)
 	if err != nil {
 		return 0, errors.Wrap(err, errors.ErrInternal, "failed to count checks").
 			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
@@ -268,17 +422,90 @@ func (r *CheckRepository) Count(ctx context.Context, tenantID string) (int, erro
 	return count, nil
 }
 
+// CountByGroups возвращает количество проверок tenant из поддеревьев групп с указанными путями
+func (r *CheckRepository) CountByGroups(ctx context.Context, tenantID string, groupPaths []string) (int, error) {
+	if len(groupPaths) == 0 {
+		return 0, nil
+	}
+
+	query := `
+		SELECT COUNT(*) FROM checks
+		WHERE tenant_id = $1 AND group_id IN (
+			SELECT id FROM check_groups WHERE tenant_id = $1 AND path LIKE ANY($2)
+		)
+	`
+
+	var count int
+	err := r.readPool.QueryRow(ctx, query, tenantID, groupPatterns(groupPaths)).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to count checks by groups").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return count, nil
+}
+
+// groupPatterns шаблоны LIKE для выборки поддеревьев групп по materialized path
+func groupPatterns(groupPaths []string) []string {
+	patterns := make([]string, len(groupPaths))
+	for i, path := range groupPaths {
+		patterns[i] = path + "%"
+	}
+	return patterns
+}
+
+// pageOffset смещение страницы из pageToken; пустой или некорректный токен - первая страница
+func pageOffset(pageToken string) int {
+	offset, err := strconv.Atoi(pageToken)
+	if err != nil || offset < 0 {
+		return 0
+	}
+	return offset
+}
+
+// FleetEntries возвращает tenant и признак включения всех проверок по ID
+func (r *CheckRepository) FleetEntries(ctx context.Context) (map[string]domain.FleetEntry, error) {
+	rows, err := r.readPool.Query(ctx, `SELECT id, tenant_id, enabled FROM checks`)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get fleet entries").
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	entries := make(map[string]domain.FleetEntry)
+	for rows.Next() {
+		var checkID string
+		var entry domain.FleetEntry
+		if err := rows.Scan(&checkID, &entry.TenantID, &entry.Enabled); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan fleet entry").
+				WithContext(ctx)
+		}
+		entries[checkID] = entry
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate fleet entries").
+			WithContext(ctx)
+	}
+
+	return entries, nil
+}
+
 // GetActiveChecks возвращает список активных проверок
 func (r *CheckRepository) GetActiveChecks(ctx context.Context) ([]*domain.Check, error) {
 	query := `
 		SELECT id, tenant_id, name, description, type, target, 
-			interval_seconds, timeout_seconds, enabled, config, created_at, updated_at
+			interval_seconds, timeout_seconds, enabled, config, owner, team, runbook_url,
+			escalation_contact, tags, COALESCE(group_id::text, ''),
+			COALESCE((SELECT g.path FROM check_groups g WHERE g.id = checks.group_id), ''),
+			created_at, updated_at, config_version
 		FROM checks
 		WHERE enabled = true
 		ORDER BY created_at ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query)
+	rows, err := r.readPool.Query(ctx, query)
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get active checks").
 			WithContext(ctx)
@@ -300,8 +527,16 @@ func (r *CheckRepository) GetActiveChecks(ctx context.Context) ([]*domain.Check,
 			&check.Timeout,
 			&check.Enabled,
 			&check.Config,
+			&check.Owner,
+			&check.Team,
+			&check.RunbookURL,
+			&check.EscalationContact,
+			&check.Tags,
+			&check.GroupID,
+			&check.GroupPath,
 			&check.CreatedAt,
 			&check.UpdatedAt,
+			&check.ConfigVersion,
 		)
 
 		if err != nil {
@@ -324,13 +559,16 @@ func (r *CheckRepository) GetActiveChecks(ctx context.Context) ([]*domain.Check,
 func (r *CheckRepository) GetActiveChecksByTenant(ctx context.Context, tenantID string) ([]*domain.Check, error) {
 	query := `
 		SELECT id, tenant_id, name, description, type, target, 
-			interval_seconds, timeout_seconds, enabled, config, created_at, updated_at
+			interval_seconds, timeout_seconds, enabled, config, owner, team, runbook_url,
+			escalation_contact, tags, COALESCE(group_id::text, ''),
+			COALESCE((SELECT g.path FROM check_groups g WHERE g.id = checks.group_id), ''),
+			created_at, updated_at, config_version
 		FROM checks
 		WHERE tenant_id = $1 AND enabled = true
 		ORDER BY created_at ASC
 	`
 
-	rows, err := r.pool.Query(ctx, query, tenantID)
+	rows, err := r.readPool.Query(ctx, query, tenantID)
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get active checks by tenant").
 			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
@@ -353,8 +591,16 @@ func (r *CheckRepository) GetActiveChecksByTenant(ctx context.Context, tenantID
 			&check.Timeout,
 			&check.Enabled,
 			&check.Config,
+			&check.Owner,
+			&check.Team,
+			&check.RunbookURL,
+			&check.EscalationContact,
+			&check.Tags,
+			&check.GroupID,
+			&check.GroupPath,
 			&check.CreatedAt,
 			&check.UpdatedAt,
+			&check.ConfigVersion,
 		)
 
 		if err != nil {
@@ -379,3 +625,19 @@ func (r *CheckRepository) GetActiveChecksByTenant(ctx context.Context, tenantID
 func (r *CheckRepository) Ping(ctx context.Context) error {
 	return r.pool.Ping(ctx)
 }
+
+// tagsOrEmpty заменяет nil на пустой срез, чтобы не записывать NULL в колонку tags
+func tagsOrEmpty(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// nullableGroupID возвращает NULL для проверок вне групп
+func nullableGroupID(groupID string) interface{} {
+	if groupID == "" {
+		return nil
+	}
+	return groupID
+}
@@ -27,8 +27,7 @@ func TestCheckRepository_Create(t *testing.T) {
 			Type:      domain.CheckTypeHTTP,
 			Interval:  60,
 			Timeout:   30,
-			Status:    domain.CheckStatusActive,
-			Priority:  domain.PriorityNormal,
+			Enabled:   true,
 			Config:    map[string]interface{}{"method": "GET"},
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
@@ -76,8 +75,7 @@ func TestCheckRepository_Update(t *testing.T) {
 			Type:      domain.CheckTypeHTTP,
 			Interval:  120,
 			Timeout:   60,
-			Status:    domain.CheckStatusActive,
-			Priority:  domain.PriorityHigh,
+			Enabled:   true,
 			UpdatedAt: time.Now(),
 			NextRunAt: timePtr(time.Now().Add(2 * time.Minute)),
 		}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// checkResultStatusUp статус успешного результата, который core-service пишет в check_results
+const checkResultStatusUp = "up"
+
+// CheckResultRepository реализация чтения результатов проверок в PostgreSQL
+type CheckResultRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCheckResultRepository создает новый экземпляр CheckResultRepository.
+// Результаты только читаются, поэтому достаточно пула чтения
+func NewCheckResultRepository(pool *pgxpool.Pool) repository.CheckResultRepository {
+	return &CheckResultRepository{
+		pool: pool,
+	}
+}
+
+// ConsecutiveSuccesses считает успешные результаты от последнего к первой неудаче
+func (r *CheckResultRepository) ConsecutiveSuccesses(ctx context.Context, checkID string, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	query := `
+		SELECT status FROM check_results
+		WHERE check_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.pool.Query(ctx, query, checkID, limit)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to query check results").
+			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	successes := 0
+	for rows.Next() {
+		var status string
+		if err := rows.Scan(&status); err != nil {
+			return 0, errors.Wrap(err, errors.ErrInternal, "failed to scan check result status").
+				WithDetails(fmt.Sprintf("check_id: %s", checkID)).
+				WithContext(ctx)
+		}
+		if status != checkResultStatusUp {
+			break
+		}
+		successes++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to iterate check results").
+			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
+			WithContext(ctx)
+	}
+
+	return successes, nil
+}
+
+// AverageDurations считает среднее response_time_ms по последним limit результатам каждой проверки
+func (r *CheckResultRepository) AverageDurations(ctx context.Context, checkIDs []string, limit int) (map[string]time.Duration, error) {
+	durations := make(map[string]time.Duration, len(checkIDs))
+	if len(checkIDs) == 0 || limit <= 0 {
+		return durations, nil
+	}
+
+	query := `
+		SELECT check_id::text, AVG(response_time_ms)::float8
+		FROM (
+			SELECT check_id, response_time_ms,
+				ROW_NUMBER() OVER (PARTITION BY check_id ORDER BY created_at DESC) AS rn
+			FROM check_results
+			WHERE check_id = ANY($1::uuid[]) AND response_time_ms IS NOT NULL
+		) recent
+		WHERE rn <= $2
+		GROUP BY check_id
+	`
+
+	rows, err := r.pool.Query(ctx, query, checkIDs, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to query check result durations").
+			WithDetails(fmt.Sprintf("checks: %d", len(checkIDs))).
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var checkID string
+		var averageMs float64
+		if err := rows.Scan(&checkID, &averageMs); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan check result duration").
+				WithContext(ctx)
+		}
+		durations[checkID] = time.Duration(averageMs * float64(time.Millisecond))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate check result durations").
+			WithContext(ctx)
+	}
+
+	return durations, nil
+}
@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// GroupRepository реализация репозитория групп проверок в PostgreSQL
+type GroupRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewGroupRepository создает новый экземпляр GroupRepository
+func NewGroupRepository(pool *pgxpool.Pool) repository.GroupRepository {
+	return &GroupRepository{
+		pool: pool,
+	}
+}
+
+// Create создает новую группу
+func (r *GroupRepository) Create(ctx context.Context, group *domain.Group) error {
+	query := `
+		INSERT INTO check_groups (id, tenant_id, parent_id, name, path, enabled, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.pool.Exec(ctx, query,
+		group.ID,
+		group.TenantID,
+		nullableGroupID(group.ParentID),
+		group.Name,
+		group.Path,
+		group.Enabled,
+		group.CreatedAt,
+		group.UpdatedAt,
+	)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to create group").
+			WithDetails(fmt.Sprintf("tenant_id: %s, name: %s", group.TenantID, group.Name)).
+			WithContext(ctx)
+	}
+
+	return nil
+}
+
+// GetByID возвращает группу по ID
+func (r *GroupRepository) GetByID(ctx context.Context, id string) (*domain.Group, error) {
+	query := `
+		SELECT g.id, g.tenant_id, COALESCE(g.parent_id::text, ''), g.name, g.path, g.enabled,
+			(SELECT COUNT(*) FROM checks c WHERE c.group_id = g.id), g.created_at, g.updated_at
+		FROM check_groups g
+		WHERE g.id = $1
+	`
+
+	group, err := scanGroup(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, domain.ErrGroupNotFound
+		}
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to get group").
+			WithDetails(fmt.Sprintf("group_id: %s", id)).
+			WithContext(ctx)
+	}
+
+	return group, nil
+}
+
+// List возвращает группы tenant, упорядоченные по пути, с количеством проверок
+func (r *GroupRepository) List(ctx context.Context, tenantID string) ([]*domain.Group, error) {
+	query := `
+		SELECT g.id, g.tenant_id, COALESCE(g.parent_id::text, ''), g.name, g.path, g.enabled,
+			(SELECT COUNT(*) FROM checks c WHERE c.group_id = g.id), g.created_at, g.updated_at
+		FROM check_groups g
+		WHERE g.tenant_id = $1
+		ORDER BY g.path ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list groups").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	var groups []*domain.Group
+	for rows.Next() {
+		group, err := scanGroup(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan group").
+				WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+				WithContext(ctx)
+		}
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate groups").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return groups, nil
+}
+
+// Update обновляет имя и положение группы; при перемещении пути поддерева переписываются атомарно
+func (r *GroupRepository) Update(ctx context.Context, group *domain.Group, oldPath string) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to begin transaction").
+			WithContext(ctx)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `
+		UPDATE check_groups
+		SET name = $2, parent_id = $3, path = $4, updated_at = $5
+		WHERE id = $1
+	`, group.ID, group.Name, nullableGroupID(group.ParentID), group.Path, group.UpdatedAt)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to update group").
+			WithDetails(fmt.Sprintf("group_id: %s", group.ID)).
+			WithContext(ctx)
+	}
+	if result.RowsAffected() == 0 {
+		return domain.ErrGroupNotFound
+	}
+
+	if oldPath != group.Path {
+		if _, err := tx.Exec(ctx, `
+			UPDATE check_groups
+			SET path = $3 || substr(path, length($2) + 1), updated_at = $4
+			WHERE tenant_id = $1 AND path LIKE $2 || '%' AND id <> $5
+		`, group.TenantID, oldPath, group.Path, group.UpdatedAt, group.ID); err != nil {
+			return errors.Wrap(err, errors.ErrInternal, "failed to move group subtree").
+				WithDetails(fmt.Sprintf("group_id: %s", group.ID)).
+				WithContext(ctx)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to commit group update").
+			WithContext(ctx)
+	}
+
+	return nil
+}
+
+// Delete удаляет пустую группу (без подгрупп и проверок)
+func (r *GroupRepository) Delete(ctx context.Context, id string) error {
+	query := `
+		DELETE FROM check_groups g
+		WHERE g.id = $1
+			AND NOT EXISTS (SELECT 1 FROM check_groups child WHERE child.parent_id = g.id)
+			AND NOT EXISTS (SELECT 1 FROM checks c WHERE c.group_id = g.id)
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to delete group").
+			WithDetails(fmt.Sprintf("group_id: %s", id)).
+			WithContext(ctx)
+	}
+	if result.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Различаем отсутствующую группу и непустую
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM check_groups WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to check group").
+			WithDetails(fmt.Sprintf("group_id: %s", id)).
+			WithContext(ctx)
+	}
+	if exists {
+		return domain.ErrGroupNotEmpty
+	}
+	return domain.ErrGroupNotFound
+}
+
+// SetEnabled включает или выключает группу вместе с поддеревом и ее проверками,
+// возвращает ID измененных проверок
+func (r *GroupRepository) SetEnabled(ctx context.Context, tenantID, path string, enabled bool) ([]string, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to begin transaction").
+			WithContext(ctx)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE check_groups SET enabled = $3, updated_at = NOW()
+		WHERE tenant_id = $1 AND path LIKE $2 || '%'
+	`, tenantID, path, enabled); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to update groups").
+			WithDetails(fmt.Sprintf("tenant_id: %s, path: %s", tenantID, path)).
+			WithContext(ctx)
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE checks SET enabled = $3, updated_at = NOW(), config_version = config_version + 1
+		WHERE tenant_id = $1 AND enabled <> $3 AND group_id IN (
+			SELECT id FROM check_groups WHERE tenant_id = $1 AND path LIKE $2 || '%'
+		)
+		RETURNING id
+	`, tenantID, path, enabled)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to update group checks").
+			WithDetails(fmt.Sprintf("tenant_id: %s, path: %s", tenantID, path)).
+			WithContext(ctx)
+	}
+
+	var checkIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan check id").
+				WithContext(ctx)
+		}
+		checkIDs = append(checkIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate group checks").
+			WithContext(ctx)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to commit group toggle").
+			WithContext(ctx)
+	}
+
+	return checkIDs, nil
+}
+
+// scanGroup читает группу из строки результата
+func scanGroup(row pgx.Row) (*domain.Group, error) {
+	var group domain.Group
+	err := row.Scan(
+		&group.ID,
+		&group.TenantID,
+		&group.ParentID,
+		&group.Name,
+		&group.Path,
+		&group.Enabled,
+		&group.CheckCount,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
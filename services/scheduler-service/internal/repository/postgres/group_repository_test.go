@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// setupGroupTestDB подключается к тестовой базе с примененными миграциями из
+// SCHEDULER_TEST_DATABASE_URL и создает tenant; без базы тест пропускается
+func setupGroupTestDB(t *testing.T) (*pgxpool.Pool, string) {
+	t.Helper()
+	dsn := os.Getenv("SCHEDULER_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("SCHEDULER_TEST_DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	tenantID := uuid.New().String()
+	if _, err := pool.Exec(ctx, `INSERT INTO tenants (id, name, slug) VALUES ($1, $2, $3)`,
+		tenantID, "Group Test", "group-test-"+tenantID); err != nil {
+		pool.Close()
+		t.Fatalf("Failed to create tenant: %v", err)
+	}
+
+	t.Cleanup(func() {
+		// Группы и проверки ссылаются друг на друга с ON DELETE RESTRICT, поэтому удаляются по очереди
+		pool.Exec(ctx, `DELETE FROM checks WHERE tenant_id = $1`, tenantID)
+		pool.Exec(ctx, `DELETE FROM check_groups WHERE tenant_id = $1 AND parent_id IS NOT NULL`, tenantID)
+		pool.Exec(ctx, `DELETE FROM check_groups WHERE tenant_id = $1`, tenantID)
+		pool.Exec(ctx, `DELETE FROM tenants WHERE id = $1`, tenantID)
+		pool.Close()
+	})
+	return pool, tenantID
+}
+
+// createTestGroup создает группу tenant под parent
+func createTestGroup(t *testing.T, repo repository.GroupRepository, tenantID string, parent *domain.Group) *domain.Group {
+	t.Helper()
+	now := time.Now()
+	group := &domain.Group{
+		ID:        uuid.New().String(),
+		TenantID:  tenantID,
+		Name:      "group",
+		Enabled:   true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if parent != nil {
+		group.ParentID = parent.ID
+		group.Path = parent.Path + group.ID + "/"
+	} else {
+		group.Path = "/" + group.ID + "/"
+	}
+	if err := repo.Create(context.Background(), group); err != nil {
+		t.Fatalf("Failed to create group: %v", err)
+	}
+	return group
+}
+
+// createTestCheck создает включенную проверку в группе
+func createTestCheck(t *testing.T, pool *pgxpool.Pool, tenantID, groupID string) string {
+	t.Helper()
+	id := uuid.New().String()
+	if _, err := pool.Exec(context.Background(), `
+		INSERT INTO checks (id, tenant_id, name, type, target, interval_seconds, enabled, group_id)
+		VALUES ($1, $2, 'check', 'http', 'https://example.com', 60, true, $3)
+	`, id, tenantID, groupID); err != nil {
+		t.Fatalf("Failed to create check: %v", err)
+	}
+	return id
+}
+
+// TestGroupRepository_SetEnabled проверяет переключение поддерева группы и его проверок
+func TestGroupRepository_SetEnabled(t *testing.T) {
+	pool, tenantID := setupGroupTestDB(t)
+	repo := NewGroupRepository(pool)
+	ctx := context.Background()
+
+	root := createTestGroup(t, repo, tenantID, nil)
+	child := createTestGroup(t, repo, tenantID, root)
+	sibling := createTestGroup(t, repo, tenantID, nil)
+	rootCheck := createTestCheck(t, pool, tenantID, root.ID)
+	childCheck := createTestCheck(t, pool, tenantID, child.ID)
+	siblingCheck := createTestCheck(t, pool, tenantID, sibling.ID)
+
+	checkIDs, err := repo.SetEnabled(ctx, tenantID, root.Path, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := []string{rootCheck, childCheck}
+	sort.Strings(expected)
+	sort.Strings(checkIDs)
+	if len(checkIDs) != 2 || checkIDs[0] != expected[0] || checkIDs[1] != expected[1] {
+		t.Errorf("Expected checks %v to be toggled, got %v", expected, checkIDs)
+	}
+
+	for id, enabled := range map[string]bool{root.ID: false, child.ID: false, sibling.ID: true} {
+		group, err := repo.GetByID(ctx, id)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if group.Enabled != enabled {
+			t.Errorf("Expected group %s enabled=%v, got %v", id, enabled, group.Enabled)
+		}
+	}
+	var siblingEnabled bool
+	if err := pool.QueryRow(ctx, `SELECT enabled FROM checks WHERE id = $1`, siblingCheck).Scan(&siblingEnabled); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !siblingEnabled {
+		t.Error("Expected check outside of the subtree to stay enabled")
+	}
+
+	// Повторное выключение не меняет проверки и не возвращает их для синхронизации
+	checkIDs, err = repo.SetEnabled(ctx, tenantID, root.Path, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(checkIDs) != 0 {
+		t.Errorf("Expected no checks to be toggled, got %v", checkIDs)
+	}
+
+	// Включение дочерней группы затрагивает только ее поддерево
+	checkIDs, err = repo.SetEnabled(ctx, tenantID, child.Path, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(checkIDs) != 1 || checkIDs[0] != childCheck {
+		t.Errorf("Expected only %s to be toggled, got %v", childCheck, checkIDs)
+	}
+}
@@ -20,8 +20,7 @@ func TestSchedulerRepository_AddCheck(t *testing.T) {
 			Type:      domain.CheckTypeHTTP,
 			Interval:  60,
 			Timeout:   30,
-			Status:    domain.CheckStatusActive,
-			Priority:  domain.PriorityNormal,
+			Enabled:   true,
 			Config:    map[string]interface{}{"method": "GET"},
 			NextRunAt: timePtr(time.Now().Add(time.Minute)),
 		}
@@ -68,8 +67,7 @@ func TestSchedulerRepository_UpdateCheck(t *testing.T) {
 			Type:      domain.CheckTypeHTTP,
 			Interval:  120,
 			Timeout:   60,
-			Status:    domain.CheckStatusActive,
-			Priority:  domain.PriorityHigh,
+			Enabled:   true,
 			NextRunAt: timePtr(time.Now().Add(2 * time.Minute)),
 		}
 
@@ -104,7 +102,6 @@ func TestScheduledCheckSerialization(t *testing.T) {
 			Type:      domain.CheckTypeHTTP,
 			Interval:  60,
 			Timeout:   30,
-			Priority:  domain.PriorityNormal,
 			Config:    map[string]interface{}{"method": "GET", "expected_status": 200},
 			NextRunAt: timePtr(time.Now().Add(time.Minute)),
 		}
@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// TagRepository реализация репозитория тегов в PostgreSQL
+type TagRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTagRepository создает новый экземпляр TagRepository
+func NewTagRepository(pool *pgxpool.Pool) repository.TagRepository {
+	return &TagRepository{
+		pool: pool,
+	}
+}
+
+// List возвращает теги tenant с количеством использующих их проверок
+func (r *TagRepository) List(ctx context.Context, tenantID string) ([]*domain.Tag, error) {
+	query := `
+		SELECT t.tenant_id, t.name, t.created_at,
+			(SELECT COUNT(*) FROM checks c WHERE c.tenant_id = t.tenant_id AND t.name = ANY(c.tags)) AS usage_count
+		FROM check_tags t
+		WHERE t.tenant_id = $1
+		ORDER BY t.name ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list tags").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+	defer rows.Close()
+
+	var tags []*domain.Tag
+	for rows.Next() {
+		var tag domain.Tag
+		if err := rows.Scan(&tag.TenantID, &tag.Name, &tag.CreatedAt, &tag.UsageCount); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to scan tag").
+				WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+				WithContext(ctx)
+		}
+		tags = append(tags, &tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to iterate tags").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return tags, nil
+}
+
+// Register регистрирует теги tenant, уже существующие пропускаются. Лимит проверяется под
+// транзакционной блокировкой tenant, поэтому параллельные запросы не превышают его
+func (r *TagRepository) Register(ctx context.Context, tenantID string, names []string, limit int) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to begin transaction").
+			WithContext(ctx)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('check_tags:' || $1))`, tenantID); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to lock tags").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	result, err := tx.Exec(ctx, `
+		WITH added AS (
+			SELECT DISTINCT n.name
+			FROM unnest($2::text[]) AS n(name)
+			WHERE NOT EXISTS (SELECT 1 FROM check_tags t WHERE t.tenant_id = $1 AND t.name = n.name)
+		)
+		INSERT INTO check_tags (tenant_id, name)
+		SELECT $1, name FROM added
+		WHERE (SELECT COUNT(*) FROM check_tags WHERE tenant_id = $1) + (SELECT COUNT(*) FROM added) <= $3
+	`, tenantID, names, limit)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to register tags").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	if result.RowsAffected() == 0 {
+		// Ничего не вставлено: все теги уже есть или новые превысили бы лимит
+		var missing bool
+		if err := tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM unnest($2::text[]) AS n(name)
+				WHERE NOT EXISTS (SELECT 1 FROM check_tags t WHERE t.tenant_id = $1 AND t.name = n.name)
+			)
+		`, tenantID, names).Scan(&missing); err != nil {
+			return errors.Wrap(err, errors.ErrInternal, "failed to check tags").
+				WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+				WithContext(ctx)
+		}
+		if missing {
+			return domain.ErrTagLimitExceeded
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to commit tags").
+			WithContext(ctx)
+	}
+
+	return nil
+}
+
+// Rename атомарно переименовывает тег во всех проверках tenant и возвращает количество измененных проверок.
+// Если новый тег уже есть у проверки, дубликат не создается.
+func (r *TagRepository) Rename(ctx context.Context, tenantID, oldName, newName string) (int, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to begin transaction").
+			WithContext(ctx)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM check_tags WHERE tenant_id = $1 AND name = $2`, tenantID, oldName)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to rename tag").
+			WithDetails(fmt.Sprintf("tenant_id: %s, tag: %s", tenantID, oldName)).
+			WithContext(ctx)
+	}
+	if result.RowsAffected() == 0 {
+		return 0, domain.ErrTagNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO check_tags (tenant_id, name) VALUES ($1, $2)
+		ON CONFLICT (tenant_id, name) DO NOTHING
+	`, tenantID, newName); err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to rename tag").
+			WithDetails(fmt.Sprintf("tenant_id: %s, tag: %s", tenantID, newName)).
+			WithContext(ctx)
+	}
+
+	result, err = tx.Exec(ctx, `
+		UPDATE checks
+		SET tags = CASE
+				WHEN $3 = ANY(tags) THEN array_remove(tags, $2)
+				ELSE array_replace(tags, $2, $3)
+			END,
+			updated_at = NOW()
+		WHERE tenant_id = $1 AND $2 = ANY(tags)
+	`, tenantID, oldName, newName)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to rename tag in checks").
+			WithDetails(fmt.Sprintf("tenant_id: %s, tag: %s", tenantID, oldName)).
+			WithContext(ctx)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to commit tag rename").
+			WithContext(ctx)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// Delete удаляет неиспользуемый тег
+func (r *TagRepository) Delete(ctx context.Context, tenantID, name string) error {
+	query := `
+		DELETE FROM check_tags t
+		WHERE t.tenant_id = $1 AND t.name = $2
+			AND NOT EXISTS (SELECT 1 FROM checks c WHERE c.tenant_id = t.tenant_id AND t.name = ANY(c.tags))
+	`
+
+	result, err := r.pool.Exec(ctx, query, tenantID, name)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to delete tag").
+			WithDetails(fmt.Sprintf("tenant_id: %s, tag: %s", tenantID, name)).
+			WithContext(ctx)
+	}
+	if result.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Различаем отсутствующий тег и используемый
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM check_tags WHERE tenant_id = $1 AND name = $2)`, tenantID, name).Scan(&exists); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to check tag").
+			WithDetails(fmt.Sprintf("tenant_id: %s, tag: %s", tenantID, name)).
+			WithContext(ctx)
+	}
+	if exists {
+		return domain.ErrTagInUse
+	}
+	return domain.ErrTagNotFound
+}
+
+// DeleteUnused удаляет все неиспользуемые теги tenant и возвращает их количество
+func (r *TagRepository) DeleteUnused(ctx context.Context, tenantID string) (int, error) {
+	query := `
+		DELETE FROM check_tags t
+		WHERE t.tenant_id = $1
+			AND NOT EXISTS (SELECT 1 FROM checks c WHERE c.tenant_id = t.tenant_id AND t.name = ANY(c.tags))
+	`
+
+	result, err := r.pool.Exec(ctx, query, tenantID)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrInternal, "failed to delete unused tags").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return int(result.RowsAffected()), nil
+}
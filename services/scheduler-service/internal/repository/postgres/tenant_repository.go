@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// TenantRepository реализация репозитория настроек tenant в PostgreSQL
+type TenantRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTenantRepository создает новый экземпляр TenantRepository
+func NewTenantRepository(pool *pgxpool.Pool) repository.TenantRepository {
+	return &TenantRepository{
+		pool: pool,
+	}
+}
+
+// GetPlan возвращает тарифный план tenant
+func (r *TenantRepository) GetPlan(ctx context.Context, tenantID string) (string, error) {
+	query := `SELECT COALESCE(plan, '') FROM tenants WHERE id = $1`
+
+	var plan string
+	if err := r.pool.QueryRow(ctx, query, tenantID).Scan(&plan); err != nil {
+		if err == pgx.ErrNoRows {
+			return "", nil
+		}
+		return "", errors.Wrap(err, errors.ErrInternal, "failed to get tenant plan").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return plan, nil
+}
+
+// IsSuspended сообщает, заблокирован ли tenant оператором платформы
+func (r *TenantRepository) IsSuspended(ctx context.Context, tenantID string) (bool, error) {
+	query := `SELECT COALESCE(status, '') = 'suspended' FROM tenants WHERE id = $1`
+
+	var suspended bool
+	if err := r.pool.QueryRow(ctx, query, tenantID).Scan(&suspended); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		return false, errors.Wrap(err, errors.ErrInternal, "failed to get tenant status").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+
+	return suspended, nil
+}
@@ -0,0 +1,185 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// DefaultCheckCacheTTL время жизни записи кэша проверок. Ограничивает устаревание
+// данных, измененных в обход репозитория (переименование тегов и групп)
+const DefaultCheckCacheTTL = 5 * time.Minute
+
+// CachedCheckRepository write-through кэш определений включенных проверок в Redis.
+// Чтения по ID при диспетчеризации обслуживаются из кэша, изменения сначала пишутся
+// в БД, затем обновляют или удаляют запись кэша. Ошибки Redis не прерывают операции:
+// при недоступном кэше чтения идут в БД
+type CachedCheckRepository struct {
+	repository.CheckRepository
+
+	client *redis.Client
+	ttl    time.Duration
+	logger logger.Logger
+
+	hits   atomic.Int64
+	misses atomic.Int64
+	errors atomic.Int64
+}
+
+// NewCachedCheckRepository оборачивает репозиторий проверок кэшем в Redis
+func NewCachedCheckRepository(checkRepo repository.CheckRepository, client *redis.Client, ttl time.Duration, log logger.Logger) repository.CheckCache {
+	if ttl <= 0 {
+		ttl = DefaultCheckCacheTTL
+	}
+	return &CachedCheckRepository{
+		CheckRepository: checkRepo,
+		client:          client,
+		ttl:             ttl,
+		logger:          log,
+	}
+}
+
+func checkCacheKey(checkID string) string {
+	return fmt.Sprintf("cache:check:%s", checkID)
+}
+
+// Create создает проверку и кладет ее в кэш
+func (r *CachedCheckRepository) Create(ctx context.Context, check *domain.Check) error {
+	if err := r.CheckRepository.Create(ctx, check); err != nil {
+		return err
+	}
+	r.store(ctx, check)
+	return nil
+}
+
+// GetByID возвращает проверку из кэша, при промахе читает БД и кэширует включенную проверку
+func (r *CachedCheckRepository) GetByID(ctx context.Context, id string) (*domain.Check, error) {
+	data, err := r.client.Get(ctx, checkCacheKey(id)).Bytes()
+	switch {
+	case err == nil:
+		var check domain.Check
+		if err := json.Unmarshal(data, &check); err == nil {
+			r.hits.Add(1)
+			return &check, nil
+		}
+		r.fail("Failed to decode cached check", id, err)
+	case err != redis.Nil:
+		r.fail("Failed to read check from cache", id, err)
+	}
+
+	r.misses.Add(1)
+	check, err := r.CheckRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.store(ctx, check)
+	return check, nil
+}
+
+// Update обновляет проверку в БД и в кэше
+func (r *CachedCheckRepository) Update(ctx context.Context, check *domain.Check) error {
+	if err := r.CheckRepository.Update(ctx, check); err != nil {
+		// Состояние в БД неизвестно, следующее чтение должно пойти в БД
+		r.evict(ctx, check.ID)
+		return err
+	}
+	r.store(ctx, check)
+	return nil
+}
+
+// Delete удаляет проверку из БД и из кэша
+func (r *CachedCheckRepository) Delete(ctx context.Context, id string) error {
+	if err := r.CheckRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.evict(ctx, id)
+	return nil
+}
+
+// GetActiveChecks возвращает активные проверки из БД и прогревает ими кэш
+func (r *CachedCheckRepository) GetActiveChecks(ctx context.Context) ([]*domain.Check, error) {
+	checks, err := r.CheckRepository.GetActiveChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pipe := r.client.Pipeline()
+	for _, check := range checks {
+		data, err := json.Marshal(check)
+		if err != nil {
+			continue
+		}
+		pipe.Set(ctx, checkCacheKey(check.ID), data, r.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.fail("Failed to warm check cache", "", err)
+	}
+	return checks, nil
+}
+
+// Invalidate удаляет проверки из кэша
+func (r *CachedCheckRepository) Invalidate(ctx context.Context, checkIDs ...string) error {
+	if len(checkIDs) == 0 {
+		return nil
+	}
+	keys := make([]string, len(checkIDs))
+	for i, id := range checkIDs {
+		keys[i] = checkCacheKey(id)
+	}
+	if err := r.client.Del(ctx, keys...).Err(); err != nil {
+		r.errors.Add(1)
+		return fmt.Errorf("failed to invalidate check cache: %w", err)
+	}
+	return nil
+}
+
+// Stats возвращает счетчики попаданий и промахов кэша
+func (r *CachedCheckRepository) Stats() repository.CheckCacheStats {
+	return repository.CheckCacheStats{
+		Hits:   r.hits.Load(),
+		Misses: r.misses.Load(),
+		Errors: r.errors.Load(),
+	}
+}
+
+// store кэширует включенную проверку; выключенная удаляется из кэша
+func (r *CachedCheckRepository) store(ctx context.Context, check *domain.Check) {
+	if !check.Enabled {
+		r.evict(ctx, check.ID)
+		return
+	}
+
+	data, err := json.Marshal(check)
+	if err != nil {
+		r.fail("Failed to encode check for cache", check.ID, err)
+		return
+	}
+	if err := r.client.Set(ctx, checkCacheKey(check.ID), data, r.ttl).Err(); err != nil {
+		r.fail("Failed to write check to cache", check.ID, err)
+	}
+}
+
+// evict удаляет проверку из кэша
+func (r *CachedCheckRepository) evict(ctx context.Context, checkID string) {
+	if err := r.client.Del(ctx, checkCacheKey(checkID)).Err(); err != nil {
+		r.fail("Failed to evict check from cache", checkID, err)
+	}
+}
+
+func (r *CachedCheckRepository) fail(msg, checkID string, err error) {
+	r.errors.Add(1)
+	if r.logger != nil {
+		r.logger.Warn(msg,
+			logger.String("check_id", checkID),
+			logger.Error(err),
+		)
+	}
+}
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// stubCheckRepository репозиторий проверок в памяти, считающий чтения по ID
+type stubCheckRepository struct {
+	repository.CheckRepository
+	checks map[string]*domain.Check
+	reads  int
+}
+
+func (r *stubCheckRepository) GetByID(ctx context.Context, id string) (*domain.Check, error) {
+	r.reads++
+	check, ok := r.checks[id]
+	if !ok {
+		return nil, assert.AnError
+	}
+	copied := *check
+	return &copied, nil
+}
+
+func (r *stubCheckRepository) Update(ctx context.Context, check *domain.Check) error {
+	r.checks[check.ID] = check
+	return nil
+}
+
+// unreachableRedis клиент Redis без сервера: каждая команда завершается ошибкой
+func unreachableRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		DialTimeout: 50 * time.Millisecond,
+		MaxRetries:  -1,
+	})
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestCachedCheckRepository_FallsBackWhenRedisUnavailable(t *testing.T) {
+	ctx := context.Background()
+	stub := &stubCheckRepository{checks: map[string]*domain.Check{
+		"check-1": {ID: "check-1", Interval: 60, Enabled: true},
+	}}
+	cache := NewCachedCheckRepository(stub, unreachableRedis(t), 0, nil)
+
+	check, err := cache.GetByID(ctx, "check-1")
+	require.NoError(t, err)
+	assert.Equal(t, "check-1", check.ID)
+	assert.Equal(t, 1, stub.reads)
+
+	check.Enabled = false
+	require.NoError(t, cache.Update(ctx, check), "cache errors must not fail writes")
+	assert.False(t, stub.checks["check-1"].Enabled)
+
+	_, err = cache.GetByID(ctx, "missing")
+	assert.Error(t, err)
+
+	assert.Error(t, cache.Invalidate(ctx, "check-1"))
+	assert.NoError(t, cache.Invalidate(ctx))
+
+	stats := cache.Stats()
+	assert.Zero(t, stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+	assert.Positive(t, stats.Errors)
+}
+
+func TestCheckCacheKey(t *testing.T) {
+	assert.Equal(t, "cache:check:check-1", checkCacheKey("check-1"))
+	assert.NotEqual(t, checkCacheKey("check-1"), lockKey("check-1"), "cache keys must not collide with locks")
+}
@@ -0,0 +1,158 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-redis/redis/v8"
+
+	"UptimePingPlatform/pkg/errors"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+const (
+	fleetTenantsKey      = "fleet:tenants"
+	fleetCountsKeyPrefix = "fleet:counts:"
+	fleetTotalField      = "total"
+)
+
+// fleetApplyScript атомарно применяет изменение проверки: повторяет domain.FleetChange.Apply
+// и переносит проверку между счетчиками состояний только при смене состояния.
+// KEYS: запись проверки, счетчики tenant, множество tenant.
+// ARGV: tenant, операция (enabled, health, delete), значение
+var fleetApplyScript = redis.NewScript(`
+local function state(enabled, health)
+	if enabled ~= '1' then return 'paused' end
+	if not health or health == '' then return 'unknown' end
+	return health
+end
+
+local current = redis.call('HMGET', KEYS[1], 'tenant', 'enabled', 'health')
+local existed = current[1] ~= false
+if existed and current[1] ~= ARGV[1] then return 0 end
+
+local old = nil
+if existed then old = state(current[2], current[3]) end
+
+local new = nil
+if ARGV[2] == 'delete' then
+	if not existed then return 0 end
+	redis.call('DEL', KEYS[1])
+elseif ARGV[2] == 'enabled' then
+	if existed and ARGV[3] == '1' and current[2] ~= '1' then
+		redis.call('HSET', KEYS[1], 'health', '')
+		current[3] = ''
+	end
+	redis.call('HSET', KEYS[1], 'tenant', ARGV[1], 'enabled', ARGV[3])
+	new = state(ARGV[3], current[3])
+else
+	if not existed then return 0 end
+	redis.call('HSET', KEYS[1], 'health', ARGV[3])
+	new = state(current[2], ARGV[3])
+end
+
+if old == new then return 0 end
+if old then
+	redis.call('HINCRBY', KEYS[2], old, -1)
+	redis.call('HINCRBY', KEYS[2], 'total', -1)
+end
+if new then
+	redis.call('HINCRBY', KEYS[2], new, 1)
+	redis.call('HINCRBY', KEYS[2], 'total', 1)
+	redis.call('SADD', KEYS[3], ARGV[1])
+end
+return 1
+`)
+
+// FleetRepository сводка по парку проверок в Redis, общая для всех экземпляров планировщика
+type FleetRepository struct {
+	client *redis.Client
+}
+
+// NewFleetRepository создает сводку по парку проверок в Redis
+func NewFleetRepository(client *redis.Client) repository.FleetRepository {
+	return &FleetRepository{client: client}
+}
+
+func fleetCheckKey(checkID string) string {
+	return fmt.Sprintf("fleet:check:%s", checkID)
+}
+
+// Apply применяет изменение проверки и обновляет счетчики ее tenant
+func (r *FleetRepository) Apply(ctx context.Context, change domain.FleetChange) error {
+	op, value := "health", string(change.Health)
+	switch {
+	case change.Deleted:
+		op, value = "delete", ""
+	case change.Enabled != nil:
+		op, value = "enabled", "0"
+		if *change.Enabled {
+			value = "1"
+		}
+	}
+
+	keys := []string{fleetCheckKey(change.CheckID), fleetCountsKeyPrefix + change.TenantID, fleetTenantsKey}
+	if err := fleetApplyScript.Run(ctx, r.client, keys, change.TenantID, op, value).Err(); err != nil {
+		return errors.Wrap(err, errors.ErrInternal, "failed to apply fleet change").
+			WithDetails(fmt.Sprintf("check_id: %s, tenant_id: %s", change.CheckID, change.TenantID)).
+			WithContext(ctx)
+	}
+	return nil
+}
+
+// Counts возвращает счетчики tenant
+func (r *FleetRepository) Counts(ctx context.Context, tenantID string) (domain.FleetCounts, error) {
+	fields, err := r.client.HGetAll(ctx, fleetCountsKeyPrefix+tenantID).Result()
+	if err != nil {
+		return domain.FleetCounts{}, errors.Wrap(err, errors.ErrInternal, "failed to get fleet counts").
+			WithDetails(fmt.Sprintf("tenant_id: %s", tenantID)).
+			WithContext(ctx)
+	}
+	return parseFleetCounts(fields), nil
+}
+
+// AllCounts возвращает счетчики всех tenant
+func (r *FleetRepository) AllCounts(ctx context.Context) (map[string]domain.FleetCounts, error) {
+	tenants, err := r.client.SMembers(ctx, fleetTenantsKey).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, "failed to list fleet tenants").
+			WithContext(ctx)
+	}
+
+	pipe := r.client.Pipeline()
+	cmds := make(map[string]*redis.StringStringMapCmd, len(tenants))
+	for _, tenantID := range tenants {
+		cmds[tenantID] = pipe.HGetAll(ctx, fleetCountsKeyPrefix+tenantID)
+	}
+	if len(tenants) > 0 {
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, errors.Wrap(err, errors.ErrInternal, "failed to get fleet counts").
+				WithContext(ctx)
+		}
+	}
+
+	all := make(map[string]domain.FleetCounts, len(tenants))
+	for tenantID, cmd := range cmds {
+		all[tenantID] = parseFleetCounts(cmd.Val())
+	}
+	return all, nil
+}
+
+// parseFleetCounts разбирает хэш счетчиков; неизвестные и нечисловые поля пропускаются
+func parseFleetCounts(fields map[string]string) domain.FleetCounts {
+	var counts domain.FleetCounts
+	for field, raw := range fields {
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			continue
+		}
+		if field == fleetTotalField {
+			counts.Total = value
+			continue
+		}
+		counts.Add(domain.FleetState(field), value)
+	}
+	return counts
+}
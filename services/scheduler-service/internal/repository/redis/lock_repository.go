@@ -25,78 +25,120 @@ func NewRedisLockRepository(client *redis.Client) repository.LockRepository {
 	}
 }
 
+// tryLockScript атомарно занимает свободную блокировку и выдает ей следующий fencing токен.
+// Счетчик токенов живет без TTL, чтобы токены не повторялись после истечения блокировок.
+// KEYS[1] - блокировка, KEYS[2] - счетчик; ARGV: check_id, worker_id, locked_at, expires_at, ttl (мс)
+var tryLockScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+local token = redis.call('INCR', KEYS[2])
+redis.call('SET', KEYS[1], cjson.encode({
+	check_id = ARGV[1],
+	worker_id = ARGV[2],
+	locked_at = ARGV[3],
+	expires_at = ARGV[4],
+	fencing_token = token
+}), 'PX', ARGV[5])
+return token
+`)
+
+// releaseLockScript удаляет блокировку, только если ее держит ARGV[1], и возвращает владельца
+var releaseLockScript = redis.NewScript(`
+local data = redis.call('GET', KEYS[1])
+if not data then
+	return false
+end
+local holder = cjson.decode(data).worker_id
+if holder == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+end
+return holder
+`)
+
+// lockKey ключ блокировки проверки. Формат не меняется между версиями: планировщики разных
+// версий во время выкладки должны занимать одну и ту же блокировку
+func lockKey(checkID string) string {
+	return fmt.Sprintf("lock:check:%s", checkID)
+}
+
+// fenceKey ключ счетчика fencing токенов проверки. Hash tag из полного ключа блокировки
+// попадает в тот же слот Redis Cluster, что и сама блокировка: tryLockScript обращается к обоим ключам
+func fenceKey(checkID string) string {
+	return "{" + lockKey(checkID) + "}:fence"
+}
+
 // TryLock пытается получить блокировку для проверки
 func (r *RedisLockRepository) TryLock(ctx context.Context, checkID, workerID string, ttl time.Duration) (*domain.LockInfo, error) {
-	lockKey := fmt.Sprintf("lock:check:%s", checkID)
-
 	now := time.Now()
 	expiresAt := now.Add(ttl)
 
-	lockInfo := &domain.LockInfo{
-		CheckID:   checkID,
-		WorkerID:  workerID,
-		LockedAt:  now,
-		ExpiresAt: expiresAt,
-	}
-
-	lockData, err := json.Marshal(lockInfo)
-	if err != nil {
-		return nil, errors.Wrap(err, errors.ErrInternal, "failed to marshal lock info").
-			WithContext(ctx)
-	}
-
-	// Используем SET с опцией NX (только если ключ не существует) и EX (время жизни)
-	result, err := r.client.SetNX(ctx, lockKey, lockData, ttl).Result()
+	token, err := tryLockScript.Run(ctx, r.client, []string{lockKey(checkID), fenceKey(checkID)},
+		checkID, workerID, now.Format(time.RFC3339Nano), expiresAt.Format(time.RFC3339Nano), ttl.Milliseconds(),
+	).Int64()
 	if err != nil {
 		return nil, errors.Wrap(err, errors.ErrInternal, "failed to acquire lock").
 			WithDetails(fmt.Sprintf("check_id: %s, worker_id: %s", checkID, workerID)).
 			WithContext(ctx)
 	}
 
-	if !result {
+	if token == 0 {
 		// Блокировка уже занята
 		return nil, errors.New(errors.ErrConflict, "lock already acquired").
 			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
 			WithContext(ctx)
 	}
 
-	return lockInfo, nil
+	return &domain.LockInfo{
+		CheckID:      checkID,
+		WorkerID:     workerID,
+		LockedAt:     now,
+		ExpiresAt:    expiresAt,
+		FencingToken: token,
+	}, nil
 }
 
-// ReleaseLock освобождает блокировку
+// ReleaseLock освобождает блокировку. Проверка владельца и удаление выполняются атомарно,
+// чтобы не снять блокировку, которую после истечения получил другой worker
 func (r *RedisLockRepository) ReleaseLock(ctx context.Context, checkID, workerID string) error {
-	lockKey := fmt.Sprintf("lock:check:%s", checkID)
-
-	// Получаем текущую блокировку для проверки worker_id
-	lockData, err := r.client.Get(ctx, lockKey).Result()
+	holder, err := releaseLockScript.Run(ctx, r.client, []string{lockKey(checkID)}, workerID).Text()
 	if err != nil {
 		if err == redis.Nil {
 			// Блокировка не существует
 			return nil
 		}
-		return errors.Wrap(err, errors.ErrInternal, "failed to get lock").
+		return errors.Wrap(err, errors.ErrInternal, "failed to release lock").
 			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
 			WithContext(ctx)
 	}
 
-	var lockInfo domain.LockInfo
-	if err := json.Unmarshal([]byte(lockData), &lockInfo); err != nil {
-		return errors.Wrap(err, errors.ErrInternal, "failed to unmarshal lock info").
-			WithContext(ctx)
-	}
-
-	// Проверяем, что блокировка принадлежит этому worker
-	if lockInfo.WorkerID != workerID {
+	// Проверяем, что блокировка принадлежала этому worker
+	if holder != workerID {
 		return errors.New(errors.ErrUnauthorized, "lock belongs to different worker").
 			WithDetails(fmt.Sprintf("check_id: %s, expected_worker: %s, actual_worker: %s",
-				checkID, workerID, lockInfo.WorkerID)).
+				checkID, workerID, holder)).
 			WithContext(ctx)
 	}
 
-	// Удаляем блокировку
-	if err := r.client.Del(ctx, lockKey).Err(); err != nil {
-		return errors.Wrap(err, errors.ErrInternal, "failed to release lock").
-			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
+	return nil
+}
+
+// ValidateLock проверяет, что workerID все еще держит блокировку с токеном fencingToken
+func (r *RedisLockRepository) ValidateLock(ctx context.Context, checkID, workerID string, fencingToken int64) error {
+	lockInfo, err := r.GetLockInfo(ctx, checkID)
+	if err != nil {
+		if customErr, ok := err.(*errors.Error); ok && customErr.Code == errors.ErrNotFound {
+			return errors.New(errors.ErrConflict, "lock lost").
+				WithDetails(fmt.Sprintf("check_id: %s, worker_id: %s, fencing_token: %d", checkID, workerID, fencingToken)).
+				WithContext(ctx)
+		}
+		return err
+	}
+
+	if lockInfo.WorkerID != workerID || lockInfo.FencingToken != fencingToken {
+		return errors.New(errors.ErrConflict, "lock lost").
+			WithDetails(fmt.Sprintf("check_id: %s, worker_id: %s, fencing_token: %d, holder: %s, holder_token: %d",
+				checkID, workerID, fencingToken, lockInfo.WorkerID, lockInfo.FencingToken)).
 			WithContext(ctx)
 	}
 
@@ -105,9 +147,7 @@ func (r *RedisLockRepository) ReleaseLock(ctx context.Context, checkID, workerID
 
 // IsLocked проверяет, заблокирована ли проверка
 func (r *RedisLockRepository) IsLocked(ctx context.Context, checkID string) (bool, error) {
-	lockKey := fmt.Sprintf("lock:check:%s", checkID)
-
-	exists, err := r.client.Exists(ctx, lockKey).Result()
+	exists, err := r.client.Exists(ctx, lockKey(checkID)).Result()
 	if err != nil {
 		return false, errors.Wrap(err, errors.ErrInternal, "failed to check lock existence").
 			WithDetails(fmt.Sprintf("check_id: %s", checkID)).
@@ -119,9 +159,7 @@ func (r *RedisLockRepository) IsLocked(ctx context.Context, checkID string) (boo
 
 // GetLockInfo получает информацию о блокировке
 func (r *RedisLockRepository) GetLockInfo(ctx context.Context, checkID string) (*domain.LockInfo, error) {
-	lockKey := fmt.Sprintf("lock:check:%s", checkID)
-
-	lockData, err := r.client.Get(ctx, lockKey).Result()
+	lockData, err := r.client.Get(ctx, lockKey(checkID)).Result()
 	if err != nil {
 		if err == redis.Nil {
 			return nil, errors.New(errors.ErrNotFound, "lock not found").
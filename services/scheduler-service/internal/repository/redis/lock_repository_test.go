@@ -0,0 +1,127 @@
+package redis
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/errors"
+)
+
+// keySlot слот Redis Cluster ключа: CRC16 (XMODEM) hash tag или всего ключа по модулю 16384
+func keySlot(key string) uint16 {
+	if start := strings.Index(key, "{"); start >= 0 {
+		if end := strings.Index(key[start+1:], "}"); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	var crc uint16
+	for i := 0; i < len(key); i++ {
+		crc ^= uint16(key[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc % 16384
+}
+
+// errorCode код ошибки pkg/errors
+func errorCode(err error) errors.ErrorCode {
+	if customErr, ok := err.(*errors.Error); ok {
+		return customErr.Code
+	}
+	return ""
+}
+
+// setupLockRedis подключается к Redis из SCHEDULER_TEST_REDIS_ADDR; без Redis тест пропускается
+func setupLockRedis(t *testing.T, checkIDs ...string) *redis.Client {
+	t.Helper()
+	addr := os.Getenv("SCHEDULER_TEST_REDIS_ADDR")
+	if addr == "" {
+		t.Skip("SCHEDULER_TEST_REDIS_ADDR is not set")
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	cleanup := func() {
+		for _, checkID := range checkIDs {
+			client.Del(context.Background(), lockKey(checkID), fenceKey(checkID))
+		}
+	}
+	cleanup()
+	t.Cleanup(func() {
+		cleanup()
+		client.Close()
+	})
+	return client
+}
+
+func TestLockKeys_ShareHashSlot(t *testing.T) {
+	// Ключ блокировки совпадает с ключом предыдущих версий планировщика
+	assert.Equal(t, "lock:check:check-1", lockKey("check-1"))
+	assert.Equal(t, "{lock:check:check-1}:fence", fenceKey("check-1"))
+	assert.Equal(t, uint16(12182), keySlot("foo"), "slot must match CLUSTER KEYSLOT")
+	for _, checkID := range []string{"check-1", "check-2", "00000000-0000-0000-0000-000000000001"} {
+		assert.Equal(t, keySlot(lockKey(checkID)), keySlot(fenceKey(checkID)), "lock and fence must hash to the same slot")
+	}
+}
+
+func TestRedisLockRepository_UnavailableRedis(t *testing.T) {
+	repo := NewRedisLockRepository(unreachableRedis(t))
+
+	_, err := repo.TryLock(context.Background(), "check-1", "worker-1", time.Minute)
+
+	assert.Equal(t, errors.ErrInternal, errorCode(err))
+}
+
+func TestRedisLockRepository_LockLifecycle(t *testing.T) {
+	ctx := context.Background()
+	client := setupLockRedis(t, "lock-test-check")
+	first := NewRedisLockRepository(client)
+	second := NewRedisLockRepository(client)
+
+	lock, err := first.TryLock(ctx, "lock-test-check", "worker-1", 200*time.Millisecond)
+	require.NoError(t, err)
+	assert.Positive(t, lock.FencingToken)
+	require.NoError(t, first.ValidateLock(ctx, "lock-test-check", "worker-1", lock.FencingToken))
+
+	_, err = second.TryLock(ctx, "lock-test-check", "worker-2", time.Minute)
+	assert.Equal(t, errors.ErrConflict, errorCode(err), "held lock must not be acquired twice")
+	assert.Equal(t, errors.ErrUnauthorized, errorCode(second.ReleaseLock(ctx, "lock-test-check", "worker-2")))
+
+	// Блокировка истекает, пока worker-1 готовит задачу, и переходит к worker-2
+	time.Sleep(300 * time.Millisecond)
+	assert.Equal(t, errors.ErrConflict, errorCode(first.ValidateLock(ctx, "lock-test-check", "worker-1", lock.FencingToken)))
+
+	taken, err := second.TryLock(ctx, "lock-test-check", "worker-2", time.Minute)
+	require.NoError(t, err)
+	assert.Greater(t, taken.FencingToken, lock.FencingToken)
+	assert.Equal(t, errors.ErrConflict, errorCode(first.ValidateLock(ctx, "lock-test-check", "worker-1", lock.FencingToken)))
+	assert.Equal(t, errors.ErrUnauthorized, errorCode(first.ReleaseLock(ctx, "lock-test-check", "worker-1")))
+
+	require.NoError(t, second.ReleaseLock(ctx, "lock-test-check", "worker-2"))
+	locked, err := second.IsLocked(ctx, "lock-test-check")
+	require.NoError(t, err)
+	assert.False(t, locked)
+}
+
+func TestRedisLockRepository_RespectsPreviousVersionLock(t *testing.T) {
+	ctx := context.Background()
+	client := setupLockRedis(t, "lock-test-previous")
+	// Блокировка планировщика предыдущей версии: SET NX без fencing токена
+	require.NoError(t, client.SetNX(ctx, "lock:check:lock-test-previous",
+		`{"check_id":"lock-test-previous","worker_id":"worker-old"}`, time.Minute).Err())
+
+	_, err := NewRedisLockRepository(client).TryLock(ctx, "lock-test-previous", "worker-1", time.Minute)
+
+	assert.Equal(t, errors.ErrConflict, errorCode(err), "lock held by a previous version must not be acquired")
+}
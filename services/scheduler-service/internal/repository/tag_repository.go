@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// TagRepository определяет интерфейс для работы с тегами проверок
+type TagRepository interface {
+	// List возвращает теги tenant с количеством использующих их проверок
+	List(ctx context.Context, tenantID string) ([]*domain.Tag, error)
+
+	// Register регистрирует теги tenant, уже существующие пропускаются. Если новые теги превысят
+	// limit тегов tenant, ничего не регистрируется и возвращается domain.ErrTagLimitExceeded
+	Register(ctx context.Context, tenantID string, names []string, limit int) error
+
+	// Rename атомарно переименовывает тег во всех проверках tenant и возвращает количество измененных проверок
+	Rename(ctx context.Context, tenantID, oldName, newName string) (int, error)
+
+	// Delete удаляет неиспользуемый тег
+	Delete(ctx context.Context, tenantID, name string) error
+
+	// DeleteUnused удаляет все неиспользуемые теги tenant и возвращает их количество
+	DeleteUnused(ctx context.Context, tenantID string) (int, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+)
+
+// TenantRepository определяет интерфейс для чтения настроек tenant
+type TenantRepository interface {
+	// GetPlan возвращает тарифный план tenant; пустая строка, если tenant или план не найдены
+	GetPlan(ctx context.Context, tenantID string) (string, error)
+	// IsSuspended сообщает, заблокирован ли tenant оператором платформы; false, если tenant не найден
+	IsSuspended(ctx context.Context, tenantID string) (bool, error)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
 
+	"UptimePingPlatform/pkg/clock"
 	"UptimePingPlatform/pkg/errors"
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/scheduler-service/internal/domain"
@@ -25,6 +26,14 @@ type TaskService struct {
 	cronScheduler *cron.Cron
 	logger        logger.Logger
 	workerID      string
+	clock         clock.Clock
+
+	// adaptive растягивает интервал стабильных проверок; resultRepo источник серий успехов
+	adaptive   domain.AdaptiveInterval
+	resultRepo repository.CheckResultRepository
+
+	// tenants источник статусов tenant: проверки заблокированных tenant не запускаются
+	tenants repository.TenantRepository
 }
 
 // NewTaskService создает новый экземпляр TaskService
@@ -45,9 +54,31 @@ func NewTaskService(
 		cronScheduler: cron.New(cron.WithSeconds()), // Поддержка секунд
 		logger:        logger,
 		workerID:      fmt.Sprintf("worker-%s", uuid.New().String()[:8]),
+		clock:         clock.Real(),
 	}
 }
 
+// WithClock задает источник времени запусков вместо системных часов. Моменты срабатывания
+// cron по-прежнему определяются системными часами
+func (s *TaskService) WithClock(c clock.Clock) *TaskService {
+	s.clock = c
+	return s
+}
+
+// WithAdaptiveInterval включает адаптивный интервал: запуски стабильных проверок
+// реже, первая неудача возвращает базовый интервал
+func (s *TaskService) WithAdaptiveInterval(policy domain.AdaptiveInterval, resultRepo repository.CheckResultRepository) *TaskService {
+	s.adaptive = policy
+	s.resultRepo = resultRepo
+	return s
+}
+
+// WithTenants приостанавливает запуски проверок tenant, заблокированных оператором платформы
+func (s *TaskService) WithTenants(tenants repository.TenantRepository) *TaskService {
+	s.tenants = tenants
+	return s
+}
+
 // ExecuteCronTask выполняет cron задачу для проверки
 func (s *TaskService) ExecuteCronTask(ctx context.Context, checkID string) error {
 	s.logger.Debug("Starting cron task execution",
@@ -88,6 +119,7 @@ func (s *TaskService) ExecuteCronTask(ctx context.Context, checkID string) error
 		logger.String("worker_id", s.workerID),
 		logger.String("locked_at", lockInfo.LockedAt.Format(time.RFC3339)),
 		logger.String("expires_at", lockInfo.ExpiresAt.Format(time.RFC3339)),
+		logger.Int64("fencing_token", lockInfo.FencingToken),
 	)
 
 	// 2. Если блокировка получена: Получение конфигурации проверки из БД
@@ -107,11 +139,54 @@ func (s *TaskService) ExecuteCronTask(ctx context.Context, checkID string) error
 		return nil
 	}
 
-	now := time.Now()
+	now := s.clock.Now()
+
+	if !s.isDue(ctx, check, now) {
+		return nil
+	}
+
+	// Проверки заблокированного tenant приостановлены до снятия блокировки
+	if s.tenants != nil {
+		suspended, err := s.tenants.IsSuspended(ctx, check.TenantID)
+		if err != nil {
+			return errors.Wrap(err, errors.ErrInternal, "failed to get tenant status").
+				WithDetails(fmt.Sprintf("check_id: %s, tenant_id: %s", checkID, check.TenantID)).
+				WithContext(ctx)
+		}
+		if suspended {
+			s.logger.Info("Tenant is suspended, skipping execution",
+				logger.String("check_id", checkID),
+				logger.String("tenant_id", check.TenantID),
+			)
+			return nil
+		}
+	}
 
 	// 3. Создание задачи (check_id, tenant_id, scheduled_time, priority)
-	task := domain.NewTaskForExecution(checkID, check.TenantID, now, domain.PriorityNormal)
+	task := domain.NewTaskForExecution(checkID, check.TenantID, now, check.TaskPriority())
 	task.ID = s.generateTaskID()
+	task.Metadata = check.TaskMetadata()
+	task.Trigger = domain.TaskTriggerSchedule
+	task.TriggeredBy = "scheduler/" + s.workerID
+	task.FencingToken = lockInfo.FencingToken
+	task.ConfigVersion = check.ConfigVersion
+
+	// Блокировка могла истечь, пока worker стоял (пауза GC, медленная БД), и перейти к другому
+	// worker. Тогда задачу отправит он; токен в задаче отсекает в core-service то, что все же
+	// ушло после повторной проверки
+	if err := s.lockRepo.ValidateLock(ctx, checkID, s.workerID, lockInfo.FencingToken); err != nil {
+		if customErr, ok := err.(*errors.Error); ok && customErr.Code == errors.ErrConflict {
+			s.logger.Warn("Lock lost before dispatch, skipping task",
+				logger.String("check_id", checkID),
+				logger.String("worker_id", s.workerID),
+				logger.Int64("fencing_token", lockInfo.FencingToken),
+			)
+			return nil
+		}
+		return errors.Wrap(err, errors.ErrInternal, "failed to validate lock").
+			WithDetails(fmt.Sprintf("check_id: %s, worker_id: %s", checkID, s.workerID)).
+			WithContext(ctx)
+	}
 
 	// 4. Отправка задачи в RabbitMQ очередь check_tasks
 	if err := s.sendTaskToRabbitMQ(ctx, task); err != nil {
@@ -147,6 +222,35 @@ func (s *TaskService) ExecuteCronTask(ctx context.Context, checkID string) error
 	return nil
 }
 
+// isDue сообщает, пора ли запускать проверку с учетом адаптивного интервала.
+// Ошибка чтения результатов не откладывает запуск
+func (s *TaskService) isDue(ctx context.Context, check *domain.Check, now time.Time) bool {
+	if s.resultRepo == nil || !s.adaptive.AppliesTo(check) || check.LastRunAt == nil {
+		return true
+	}
+
+	limit := s.adaptive.SuccessesToCap(check.GetIntervalDuration())
+	successes, err := s.resultRepo.ConsecutiveSuccesses(ctx, check.ID, limit)
+	if err != nil {
+		s.logger.Warn("Failed to count consecutive successes, running at base interval",
+			logger.String("check_id", check.ID),
+			logger.Error(err),
+		)
+		return true
+	}
+
+	due, effective := s.adaptive.Due(check, successes, now)
+	if !due {
+		s.logger.Debug("Stable check deferred by adaptive interval",
+			logger.String("check_id", check.ID),
+			logger.Int("consecutive_successes", successes),
+			logger.Duration("effective_interval", effective),
+			logger.String("last_run", check.LastRunAt.Format(time.RFC3339)),
+		)
+	}
+	return due
+}
+
 // sendTaskToRabbitMQ отправляет задачу в RabbitMQ
 func (s *TaskService) sendTaskToRabbitMQ(ctx context.Context, task *domain.Task) error {
 	// Если RabbitMQ не настроен (например, в тестах), просто логируем
@@ -187,10 +291,10 @@ func (s *TaskService) updateCheckRunTimes(ctx context.Context, check *domain.Che
 	check.LastRunAt = &executedAt
 
 	// Обновляем next_run
-	check.UpdateNextRun()
+	check.UpdateNextRunAt(executedAt)
 
 	// Обновляем updated_at
-	check.UpdatedAt = time.Now()
+	check.UpdatedAt = s.clock.Now()
 
 	// Сохраняем в БД
 	if err := s.checkRepo.Update(ctx, check); err != nil {
@@ -210,7 +314,7 @@ func (s *TaskService) updateCheckRunTimes(ctx context.Context, check *domain.Che
 
 // generateTaskID генерирует ID для задачи
 func (s *TaskService) generateTaskID() string {
-	return fmt.Sprintf("task_%s_%s", uuid.New().String()[:8], time.Now().Format("20060102150405"))
+	return fmt.Sprintf("task_%s_%s", uuid.New().String()[:8], s.clock.Now().Format("20060102150405"))
 }
 
 // LoadActiveChecksOnStartup загружает активные проверки при старте
@@ -310,6 +414,11 @@ func (s *TaskService) GetStats() map[string]interface{} {
 		"service":            "task_service",
 		"cron_entries":       s.cronScheduler.Entries(),
 		"rabbitmq_connected": s.rabbitMQ != nil,
+		"adaptive_interval":  s.adaptive.Enabled && s.resultRepo != nil,
+	}
+
+	if cache, ok := s.checkRepo.(repository.CheckCache); ok {
+		stats["check_cache"] = cache.Stats()
 	}
 
 	// Добавляем информацию о cron задачах
@@ -319,50 +428,10 @@ func (s *TaskService) GetStats() map[string]interface{} {
 	return stats
 }
 
-// generateCronExpression генерирует cron выражение на основе интервала проверки
+// generateCronExpression генерирует cron выражение на основе интервала проверки; сохраненные
+// интервалы без равномерного cron расписания запускаются через @every
 func (s *TaskService) generateCronExpression(check *domain.Check) (string, error) {
-	if check.Interval <= 0 {
-		return "", fmt.Errorf("invalid interval: %d", check.Interval)
-	}
-
-	// Используем умную логику для генерации cron выражений
-	if check.Interval < 60 {
-		// Для интервалов меньше минуты - каждые N секунд
-		return fmt.Sprintf("*/%d * * * * *", check.Interval), nil
-	} else if check.Interval < 3600 {
-		// Для интервалов меньше часа - каждые N минут в начале минуты
-		minutes := check.Interval / 60
-		if check.Interval%60 == 0 {
-			// Точные минуты
-			return fmt.Sprintf("0 */%d * * * *", minutes), nil
-		} else {
-			// Неравные интервалы - используем каждые N минут
-			return fmt.Sprintf("*/%d * * * *", minutes), nil
-		}
-	} else if check.Interval < 86400 {
-		// Для интервалов меньше дня - каждые N часов
-		hours := check.Interval / 3600
-		if check.Interval%3600 == 0 {
-			// Точные часы
-			return fmt.Sprintf("0 0 */%d * * *", hours), nil
-		} else {
-			// Неравные интервалы - используем каждые N часов
-			return fmt.Sprintf("0 0 */%d * * *", hours), nil
-		}
-	} else {
-		// Для интервалов больше дня - каждые N дней в полночь
-		days := check.Interval / 86400
-		if days > 31 {
-			// Если интервал больше месяца, используем 1-е число месяца
-			months := days / 30
-			if months > 12 {
-				// Если интервал больше года, используем 1 января
-				return "0 0 1 1 * *", nil
-			}
-			return fmt.Sprintf("0 0 1 */%d *", months), nil
-		}
-		return fmt.Sprintf("0 0 */%d * *", days), nil
-	}
+	return domain.ScheduleCronExpression(check.Interval)
 }
 
 // Start запускает cron планировщик
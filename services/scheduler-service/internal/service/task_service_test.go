@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"UptimePingPlatform/pkg/errors"
 	"UptimePingPlatform/services/scheduler-service/internal/domain"
 	"UptimePingPlatform/services/scheduler-service/internal/mocks"
 )
@@ -93,3 +95,88 @@ func TestTaskService_StartStop(t *testing.T) {
 	assert.NotPanics(t, taskService.Start)
 	assert.NotPanics(t, taskService.Stop)
 }
+
+// setupDispatchTest создает сервис задач с включенной проверкой check-123 под блокировкой с токеном 7
+func setupDispatchTest() (*TaskService, *mocks.MockCheckRepository, *mocks.MockTaskRepository, *mocks.MockLockRepository, *mocks.MockProducer) {
+	taskService, mockCheckRepo, mockTaskRepo, mockLockRepo, mockSchedulerRepo, mockLogger := setupTestTaskService()
+	mockProducer := &mocks.MockProducer{}
+	taskService = NewTaskService(mockCheckRepo, mockTaskRepo, mockLockRepo, mockSchedulerRepo, mockProducer, mockLogger)
+
+	now := time.Now()
+	mockLockRepo.On("TryLock", mock.Anything, "check-123", taskService.workerID, mock.Anything).Return(&domain.LockInfo{
+		CheckID: "check-123", WorkerID: taskService.workerID, LockedAt: now, ExpiresAt: now.Add(time.Minute), FencingToken: 7,
+	}, nil)
+	mockLockRepo.On("ReleaseLock", mock.Anything, "check-123", taskService.workerID).Return(nil)
+	mockSchedulerRepo.On("UpdateCheck", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCheckRepo.On("GetByID", mock.Anything, "check-123").Return(&domain.Check{
+		ID: "check-123", TenantID: "tenant-1", Interval: 60, Enabled: true,
+	}, nil)
+	return taskService, mockCheckRepo, mockTaskRepo, mockLockRepo, mockProducer
+}
+
+func TestTaskService_ExecuteCronTask_DispatchesWithFencingToken(t *testing.T) {
+	ctx := context.Background()
+	taskService, mockCheckRepo, mockTaskRepo, mockLockRepo, mockProducer := setupDispatchTest()
+	mockLockRepo.On("ValidateLock", mock.Anything, "check-123", taskService.workerID, int64(7)).Return(nil)
+	mockProducer.On("PublishTask", mock.Anything, mock.MatchedBy(func(task *domain.Task) bool {
+		return task.CheckID == "check-123" && task.FencingToken == 7
+	})).Return(nil)
+	mockCheckRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	mockTaskRepo.On("CreateTask", mock.Anything, mock.Anything).Return(nil)
+
+	assert.NoError(t, taskService.ExecuteCronTask(ctx, "check-123"))
+
+	mockProducer.AssertExpectations(t)
+	mockLockRepo.AssertExpectations(t)
+}
+
+func TestTaskService_ExecuteCronTask_LockExpiredBeforeDispatch(t *testing.T) {
+	ctx := context.Background()
+	taskService, mockCheckRepo, mockTaskRepo, mockLockRepo, mockProducer := setupDispatchTest()
+	// Блокировка истекла во время подготовки задачи и перешла к другому worker
+	mockLockRepo.On("ValidateLock", mock.Anything, "check-123", taskService.workerID, int64(7)).
+		Return(errors.New(errors.ErrConflict, "lock lost"))
+
+	assert.NoError(t, taskService.ExecuteCronTask(ctx, "check-123"))
+
+	mockLockRepo.AssertCalled(t, "ValidateLock", mock.Anything, "check-123", taskService.workerID, int64(7))
+	mockProducer.AssertNotCalled(t, "PublishTask", mock.Anything, mock.Anything)
+	mockCheckRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockTaskRepo.AssertNotCalled(t, "CreateTask", mock.Anything, mock.Anything)
+	mockLockRepo.AssertCalled(t, "ReleaseLock", mock.Anything, "check-123", taskService.workerID)
+}
+
+// fakeTenants статусы tenant для тестов приостановки проверок
+type fakeTenants map[string]bool
+
+func (f fakeTenants) GetPlan(ctx context.Context, tenantID string) (string, error) {
+	return "", nil
+}
+
+func (f fakeTenants) IsSuspended(ctx context.Context, tenantID string) (bool, error) {
+	return f[tenantID], nil
+}
+
+func TestTaskService_ExecuteCronTask_SuspendedTenant(t *testing.T) {
+	ctx := context.Background()
+	taskService, mockCheckRepo, mockTaskRepo, mockLockRepo, mockProducer := setupDispatchTest()
+	taskService.WithTenants(fakeTenants{"tenant-1": true})
+
+	assert.NoError(t, taskService.ExecuteCronTask(ctx, "check-123"))
+
+	mockLockRepo.AssertNotCalled(t, "ValidateLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockProducer.AssertNotCalled(t, "PublishTask", mock.Anything, mock.Anything)
+	mockCheckRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	mockTaskRepo.AssertNotCalled(t, "CreateTask", mock.Anything, mock.Anything)
+	mockLockRepo.AssertCalled(t, "ReleaseLock", mock.Anything, "check-123", taskService.workerID)
+
+	// После снятия блокировки проверка снова запускается
+	taskService.WithTenants(fakeTenants{})
+	mockLockRepo.On("ValidateLock", mock.Anything, "check-123", taskService.workerID, int64(7)).Return(nil)
+	mockProducer.On("PublishTask", mock.Anything, mock.Anything).Return(nil)
+	mockCheckRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	mockTaskRepo.On("CreateTask", mock.Anything, mock.Anything).Return(nil)
+
+	assert.NoError(t, taskService.ExecuteCronTask(ctx, "check-123"))
+	mockProducer.AssertExpectations(t)
+}
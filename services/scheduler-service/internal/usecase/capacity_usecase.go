@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// capacityDurationSamples число последних результатов проверки для оценки длительности выполнения
+const capacityDurationSamples = 20
+
+// CapacityUseCase оценивает нагрузку проверок на Core Service, чтобы администратор видел
+// последствия массового изменения проверок до его применения
+type CapacityUseCase struct {
+	checkRepo  repository.CheckRepository
+	resultRepo repository.CheckResultRepository
+	tenants    repository.TenantRepository
+	logger     logger.Logger
+}
+
+// NewCapacityUseCase создает новый экземпляр CapacityUseCase
+func NewCapacityUseCase(checkRepo repository.CheckRepository, logger logger.Logger) *CapacityUseCase {
+	return &CapacityUseCase{
+		checkRepo: checkRepo,
+		logger:    logger,
+	}
+}
+
+// WithResults подключает результаты проверок; без них длительность выполнения оценивается таймаутом
+func (uc *CapacityUseCase) WithResults(resultRepo repository.CheckResultRepository) *CapacityUseCase {
+	uc.resultRepo = resultRepo
+	return uc
+}
+
+// WithPlans подключает тарифные планы tenant для проверки минимального интервала
+func (uc *CapacityUseCase) WithPlans(tenants repository.TenantRepository) *CapacityUseCase {
+	uc.tenants = tenants
+	return uc
+}
+
+// PreviewIntervalChange оценивает изменение нагрузки, если всем проверкам tenant с тегом
+// задать интервал interval секунд. Проверки не изменяются
+func (uc *CapacityUseCase) PreviewIntervalChange(ctx context.Context, tenantID, tag string, interval int) (*domain.IntervalChangePreview, error) {
+	tag = domain.NormalizeTag(tag)
+	if err := domain.ValidateTag(tag); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if interval < domain.MinCheckInterval || interval > domain.MaxDomainCheckInterval {
+		return nil, fmt.Errorf("validation failed: %w", &domain.ValidationError{
+			Field:   domain.FieldInterval,
+			Reason:  domain.ReasonOutOfRange,
+			Message: fmt.Sprintf("interval must be between %d and %d seconds", domain.MinCheckInterval, domain.MaxDomainCheckInterval),
+		})
+	}
+
+	minInterval := domain.MinCheckInterval
+	if uc.tenants != nil {
+		plan, err := uc.tenants.GetPlan(ctx, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve tenant plan: %w", err)
+		}
+		minInterval = domain.MinIntervalForPlan(plan)
+	}
+
+	checks, err := uc.checkRepo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checks: %w", err)
+	}
+
+	durations, err := uc.averageDurations(ctx, checks, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := domain.PreviewIntervalChange(checks, tag, interval, minInterval, durations)
+
+	uc.logger.Info("Interval change previewed",
+		logger.CtxField(ctx),
+		logger.String("tenant_id", tenantID),
+		logger.String("tag", tag),
+		logger.Int("interval", interval),
+		logger.Int("matched_checks", preview.MatchedChecks),
+		logger.Int("rejected_checks", len(preview.Rejected)),
+	)
+	return preview, nil
+}
+
+// averageDurations возвращает среднюю длительность последних выполнений включенных проверок с тегом
+func (uc *CapacityUseCase) averageDurations(ctx context.Context, checks []*domain.Check, tag string) (map[string]time.Duration, error) {
+	if uc.resultRepo == nil {
+		return nil, nil
+	}
+
+	var checkIDs []string
+	for _, check := range checks {
+		if check.Enabled && check.HasTag(tag) {
+			checkIDs = append(checkIDs, check.ID)
+		}
+	}
+
+	durations, err := uc.resultRepo.AverageDurations(ctx, checkIDs, capacityDurationSamples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load check durations: %w", err)
+	}
+	return durations, nil
+}
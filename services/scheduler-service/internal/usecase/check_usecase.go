@@ -15,6 +15,10 @@ import (
 type CheckUseCase struct {
 	checkRepo     repository.CheckRepository
 	schedulerRepo repository.SchedulerRepository
+	tags          *TagUseCase
+	groups        *GroupUseCase
+	tenants       repository.TenantRepository
+	fleet         *FleetUseCase
 	logger        logger.Logger
 }
 
@@ -31,13 +35,52 @@ func NewCheckUseCase(
 	}
 }
 
+// WithTags подключает реестр тегов: теги проверок регистрируются с учетом лимита tenant
+func (uc *CheckUseCase) WithTags(tags *TagUseCase) *CheckUseCase {
+	uc.tags = tags
+	return uc
+}
+
+// WithGroups подключает группы: проверка в выключенной группе создается выключенной
+func (uc *CheckUseCase) WithGroups(groups *GroupUseCase) *CheckUseCase {
+	uc.groups = groups
+	return uc
+}
+
+// WithPlans подключает тарифные планы tenant для проверки минимального интервала
+func (uc *CheckUseCase) WithPlans(tenants repository.TenantRepository) *CheckUseCase {
+	uc.tenants = tenants
+	return uc
+}
+
+// WithFleet подключает сводку по парку проверок, которая обновляется при изменении проверок
+func (uc *CheckUseCase) WithFleet(fleet *FleetUseCase) *CheckUseCase {
+	uc.fleet = fleet
+	return uc
+}
+
 // CreateCheck создает новую проверку
 func (uc *CheckUseCase) CreateCheck(ctx context.Context, tenantID string, check *domain.Check) (*domain.Check, error) {
+	check.Tags = domain.NormalizeTags(check.Tags)
+	check.ApplyTypeDefaults()
+
 	// Валидация конфигурации проверки (без ID, так как он будет сгенерирован)
 	if err := uc.validateCheckConfigForCreate(check); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	if err := uc.validatePlanSchedule(ctx, tenantID, check, 0); err != nil {
+		return nil, err
+	}
+
+	if err := uc.ensureTags(ctx, tenantID, check.Tags); err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyGroup(ctx, tenantID, check); err != nil {
+		return nil, err
+	}
+
 	// Генерация check_id (UUID)
 	checkID := uuid.New().String()
 	check.ID = checkID
@@ -56,6 +99,9 @@ func (uc *CheckUseCase) CreateCheck(ctx context.Context, tenantID string, check
 	if err := uc.checkRepo.Create(ctx, check); err != nil {
 		return nil, fmt.Errorf("failed to create check: %w", err)
 	}
+	if uc.fleet != nil {
+		uc.fleet.CheckSaved(ctx, check)
+	}
 
 	// Если enabled = true → добавление в планировщик
 	if check.Enabled {
@@ -93,12 +139,25 @@ func (uc *CheckUseCase) UpdateCheck(ctx context.Context, checkID string, check *
 
 	// Устанавливаем ID для обновляемой проверки
 	check.ID = checkID
+	check.Tags = domain.NormalizeTags(check.Tags)
 
 	// Валидация конфигурации проверки
 	if err := uc.validateCheckConfigForUpdate(check); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
+	if err := uc.validatePlanSchedule(ctx, existingCheck.TenantID, check, existingCheck.Interval); err != nil {
+		return err
+	}
+
+	if err := uc.ensureTags(ctx, existingCheck.TenantID, check.Tags); err != nil {
+		return err
+	}
+
+	if err := uc.applyGroup(ctx, existingCheck.TenantID, check); err != nil {
+		return err
+	}
+
 	// Сохраняем важные поля из существующей проверки
 	check.TenantID = existingCheck.TenantID
 	check.CreatedAt = existingCheck.CreatedAt
@@ -113,6 +172,9 @@ func (uc *CheckUseCase) UpdateCheck(ctx context.Context, checkID string, check *
 	if err := uc.checkRepo.Update(ctx, check); err != nil {
 		return fmt.Errorf("failed to update check: %w", err)
 	}
+	if uc.fleet != nil {
+		uc.fleet.CheckSaved(ctx, check)
+	}
 
 	// Обновление в планировщике
 	// Сначала удаляем старую версию
@@ -167,6 +229,9 @@ func (uc *CheckUseCase) DeleteCheck(ctx context.Context, checkID string) error {
 	if err := uc.checkRepo.Delete(ctx, checkID); err != nil {
 		return fmt.Errorf("failed to delete check: %w", err)
 	}
+	if uc.fleet != nil {
+		uc.fleet.CheckDeleted(ctx, check)
+	}
 
 	uc.logger.Info("Check deleted successfully",
 		logger.CtxField(ctx),
@@ -187,14 +252,47 @@ func (uc *CheckUseCase) GetCheck(ctx context.Context, checkID string) (*domain.C
 	return check, nil
 }
 
-// ListChecks возвращает список проверок для tenant
-func (uc *CheckUseCase) ListChecks(ctx context.Context, tenantID string, pageSize int, pageToken string) ([]*domain.Check, error) {
+// ListChecks возвращает страницу проверок tenant и общее число его проверок
+func (uc *CheckUseCase) ListChecks(ctx context.Context, tenantID string, pageSize int, pageToken string) ([]*domain.Check, int, error) {
 	checks, err := uc.checkRepo.List(ctx, tenantID, pageSize, pageToken)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list checks: %w", err)
+		return nil, 0, fmt.Errorf("failed to list checks: %w", err)
 	}
 
-	return checks, nil
+	total, err := uc.checkRepo.Count(ctx, tenantID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count checks: %w", err)
+	}
+
+	return checks, total, nil
+}
+
+// ListChecksInGroups возвращает страницу проверок tenant из поддеревьев указанных групп и
+// общее число таких проверок
+func (uc *CheckUseCase) ListChecksInGroups(ctx context.Context, tenantID string, groupIDs []string, pageSize int, pageToken string) ([]*domain.Check, int, error) {
+	if uc.groups == nil {
+		return nil, 0, fmt.Errorf("check groups are not configured")
+	}
+
+	paths, err := uc.groups.ResolveGroupPaths(ctx, tenantID, groupIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(paths) == 0 {
+		return []*domain.Check{}, 0, nil
+	}
+
+	checks, err := uc.checkRepo.ListByGroups(ctx, tenantID, paths, pageSize, pageToken)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list checks: %w", err)
+	}
+
+	total, err := uc.checkRepo.CountByGroups(ctx, tenantID, paths)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count checks: %w", err)
+	}
+
+	return checks, total, nil
 }
 
 // GetActiveChecks возвращает список активных проверок
@@ -217,6 +315,62 @@ func (uc *CheckUseCase) GetActiveChecksByTenant(ctx context.Context, tenantID st
 	return checks, nil
 }
 
+// ensureTags регистрирует теги проверки, если подключен реестр тегов
+func (uc *CheckUseCase) ensureTags(ctx context.Context, tenantID string, tags []string) error {
+	if uc.tags == nil {
+		return nil
+	}
+	if err := uc.tags.EnsureTags(ctx, tenantID, tags); err != nil {
+		return fmt.Errorf("failed to register tags: %w", err)
+	}
+	return nil
+}
+
+// applyGroup проверяет группу проверки и наследует ее выключенное состояние
+func (uc *CheckUseCase) applyGroup(ctx context.Context, tenantID string, check *domain.Check) error {
+	if check.GroupID == "" || uc.groups == nil {
+		return nil
+	}
+
+	group, err := uc.groups.GetGroup(ctx, check.GroupID)
+	if err != nil {
+		return err
+	}
+	if group.TenantID != tenantID {
+		return fmt.Errorf("group %s: %w", check.GroupID, domain.ErrGroupNotFound)
+	}
+
+	check.GroupPath = group.Path
+	if !group.Enabled {
+		check.Enabled = false
+	}
+	return nil
+}
+
+// validatePlanSchedule проверяет новый интервал по минимуму тарифного плана tenant и
+// возможность равномерного cron расписания. Неизмененный интервал при обновлении не
+// проверяется, чтобы смена плана или правил расписания не блокировала редактирование
+// существующих проверок
+func (uc *CheckUseCase) validatePlanSchedule(ctx context.Context, tenantID string, check *domain.Check, previousInterval int) error {
+	if check.Interval == previousInterval {
+		return nil
+	}
+
+	minInterval := domain.MinCheckInterval
+	if uc.tenants != nil {
+		plan, err := uc.tenants.GetPlan(ctx, tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to resolve tenant plan: %w", err)
+		}
+		minInterval = domain.MinIntervalForPlan(plan)
+	}
+
+	if err := domain.ValidateScheduleWithin(check.Interval, check.Timeout, minInterval, check.MaxInterval()); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
+
 // validateCheckConfigForUpdate выполняет валидацию конфигурации проверки для обновления
 func (uc *CheckUseCase) validateCheckConfigForUpdate(check *domain.Check) error {
 	// Базовая валидация с ID (так как он уже установлен)
@@ -239,7 +393,7 @@ func (uc *CheckUseCase) validateCheckConfigForCreate(check *domain.Check) error
 
 	// Валидация типа проверки
 	switch check.Type {
-	case domain.CheckTypeHTTP, domain.CheckTypeHTTPS, domain.CheckTypeGRPC, domain.CheckTypeGraphQL, domain.CheckTypeTCP:
+	case domain.CheckTypeHTTP, domain.CheckTypeHTTPS, domain.CheckTypeGRPC, domain.CheckTypeGraphQL, domain.CheckTypeTCP, domain.CheckTypePlugin, domain.CheckTypeDomain, domain.CheckTypeDNS:
 		// Valid types
 	default:
 		return fmt.Errorf("invalid check type: %s", check.Type)
@@ -250,20 +404,11 @@ func (uc *CheckUseCase) validateCheckConfigForCreate(check *domain.Check) error
 
 // validateCheckConfig выполняет полную валидацию конфигурации проверки
 func (uc *CheckUseCase) validateCheckConfig(check *domain.Check) error {
-	// Базовая валидация
+	// Базовая валидация, включая границы интервала и таймаута
 	if err := check.Validate(); err != nil {
 		return err
 	}
 
-	// Валидация интервала (от 5 секунд до 24 часов)
-	if check.Interval < 5 || check.Interval > 86400 {
-		return fmt.Errorf("interval must be between 5 seconds and 24 hours")
-	}
-
-	if check.Timeout < 1 || check.Timeout > 300 {
-		return fmt.Errorf("timeout must be between 1 second and 5 minutes")
-	}
-
 	// Дополнительная валидация конфигурации в зависимости от типа
 	if err := uc.validateTypeSpecificConfig(check); err != nil {
 		return fmt.Errorf("type-specific validation failed: %w", err)
@@ -283,6 +428,15 @@ func (uc *CheckUseCase) validateTypeSpecificConfig(check *domain.Check) error {
 		return uc.validateGraphQLConfig(check)
 	case domain.CheckTypeTCP:
 		return uc.validateTCPConfig(check)
+	case domain.CheckTypePlugin:
+		// Конфигурацию проверки плагином проверяет сам плагин в core-service
+		return nil
+	case domain.CheckTypeDomain:
+		// Цель и окно предупреждения проверяет domain.Check.Validate
+		return nil
+	case domain.CheckTypeDNS:
+		// Цель, тип записи и резолвер проверяет domain.Check.Validate
+		return nil
 	default:
 		return fmt.Errorf("unsupported check type: %s", check.Type)
 	}
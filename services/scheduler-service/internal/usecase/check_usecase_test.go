@@ -2,11 +2,13 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"UptimePingPlatform/pkg/logger"
 	"UptimePingPlatform/services/scheduler-service/internal/domain"
@@ -89,12 +91,34 @@ func (m *MockCheckRepository) GetActiveChecksByTenant(ctx context.Context, tenan
 	return args.Get(0).([]*domain.Check), args.Error(1)
 }
 
-func (m *MockCheckRepository) Ping(ctx context.Context) (interface{}, error) {
+func (m *MockCheckRepository) List(ctx context.Context, tenantID string, pageSize int, pageToken string) ([]*domain.Check, error) {
+	args := m.Called(ctx, tenantID, pageSize, pageToken)
+	return args.Get(0).([]*domain.Check), args.Error(1)
+}
+
+func (m *MockCheckRepository) ListByGroups(ctx context.Context, tenantID string, groupPaths []string, pageSize int, pageToken string) ([]*domain.Check, error) {
+	args := m.Called(ctx, tenantID, groupPaths, pageSize, pageToken)
+	return args.Get(0).([]*domain.Check), args.Error(1)
+}
+
+func (m *MockCheckRepository) Count(ctx context.Context, tenantID string) (int, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCheckRepository) CountByGroups(ctx context.Context, tenantID string, groupPaths []string) (int, error) {
+	args := m.Called(ctx, tenantID, groupPaths)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCheckRepository) FleetEntries(ctx context.Context) (map[string]domain.FleetEntry, error) {
 	args := m.Called(ctx)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0), args.Error(1)
+	return args.Get(0).(map[string]domain.FleetEntry), args.Error(1)
+}
+
+func (m *MockCheckRepository) Ping(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
 }
 
 // MockSchedulerRepository - мок для SchedulerRepository
@@ -153,18 +177,18 @@ func (m *MockSchedulerRepository) Count(ctx context.Context, filter string) (int
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockSchedulerRepository) Ping(ctx context.Context) (interface{}, error) {
+func (m *MockSchedulerRepository) Ping(ctx context.Context) error {
 	args := m.Called(ctx)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0), args.Error(1)
+	return args.Error(0)
 }
 
 func setupTestUseCase() (*CheckUseCase, *MockCheckRepository, *MockSchedulerRepository, *MockLogger) {
 	mockCheckRepo := &MockCheckRepository{}
 	mockSchedulerRepo := &MockSchedulerRepository{}
 	mockLogger := &MockLogger{}
+	// Информационные сообщения об успешных операциях не проверяются
+	mockLogger.On("Info", mock.Anything, mock.Anything).Maybe()
+	mockLogger.On("Warn", mock.Anything, mock.Anything).Maybe()
 	useCase := NewCheckUseCase(mockCheckRepo, mockSchedulerRepo, mockLogger)
 	return useCase, mockCheckRepo, mockSchedulerRepo, mockLogger
 }
@@ -174,13 +198,13 @@ func TestCheckUseCase_CreateCheck_Success(t *testing.T) {
 	tenantID := "tenant-123"
 
 	check := &domain.Check{
+		TenantID: tenantID,
 		Name:     "Test Check",
 		Type:     domain.CheckTypeHTTP,
 		Target:   "https://example.com",
 		Interval: 60,
 		Timeout:  30,
-		Status:   domain.CheckStatusActive,
-		Priority: domain.PriorityNormal,
+		Enabled:  true,
 		Config:   domain.CheckConfig{"method": "GET"},
 		Tags:     []string{"test"},
 	}
@@ -201,7 +225,7 @@ func TestCheckUseCase_CreateCheck_Success(t *testing.T) {
 	assert.NotEmpty(t, result.ID)
 	assert.NotZero(t, result.CreatedAt)
 	assert.NotZero(t, result.UpdatedAt)
-	assert.NotNil(t, result.NextRunAt)
+	assert.NotNil(t, result.LastRunAt)
 
 	mockCheckRepo.AssertExpectations(t)
 	mockSchedulerRepo.AssertExpectations(t)
@@ -218,8 +242,7 @@ func TestCheckUseCase_CreateCheck_ValidationError(t *testing.T) {
 		Target:   "https://example.com",
 		Interval: 60,
 		Timeout:  30,
-		Status:   domain.CheckStatusActive,
-		Priority: domain.PriorityNormal,
+		Enabled:  true,
 	}
 
 	useCase, _, _, _ := setupTestUseCase()
@@ -238,13 +261,13 @@ func TestCheckUseCase_CreateCheck_SchedulerError(t *testing.T) {
 	tenantID := "tenant-123"
 
 	check := &domain.Check{
+		TenantID: tenantID,
 		Name:     "Test Check",
 		Type:     domain.CheckTypeHTTP,
 		Target:   "https://example.com",
 		Interval: 60,
 		Timeout:  30,
-		Status:   domain.CheckStatusActive,
-		Priority: domain.PriorityNormal,
+		Enabled:  true,
 	}
 
 	useCase, mockCheckRepo, mockSchedulerRepo, mockLogger := setupTestUseCase()
@@ -279,20 +302,19 @@ func TestCheckUseCase_UpdateCheck_Success(t *testing.T) {
 		Target:    "https://old.example.com",
 		Interval:  60,
 		Timeout:   30,
-		Status:    domain.CheckStatusActive,
-		Priority:  domain.PriorityNormal,
+		Enabled:   true,
 		CreatedAt: time.Now().Add(-time.Hour),
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
 
 	updatedCheck := &domain.Check{
+		TenantID: "tenant-123",
 		Name:     "Updated Name",
 		Type:     domain.CheckTypeHTTP,
 		Target:   "https://updated.example.com",
 		Interval: 120,
 		Timeout:  60,
-		Status:   domain.CheckStatusActive,
-		Priority: domain.PriorityHigh,
+		Enabled:  true,
 	}
 
 	useCase, mockCheckRepo, mockSchedulerRepo, _ := setupTestUseCase()
@@ -313,6 +335,51 @@ func TestCheckUseCase_UpdateCheck_Success(t *testing.T) {
 	mockSchedulerRepo.AssertExpectations(t)
 }
 
+func TestCheckUseCase_UpdateCheck_StoredUnevenInterval(t *testing.T) {
+	ctx := context.Background()
+	checkID := "check-123"
+
+	// Интервал 45s сохранен до требования равномерного cron расписания
+	existingCheck := func() *domain.Check {
+		return &domain.Check{
+			ID:       checkID,
+			TenantID: "tenant-123",
+			Name:     "Legacy",
+			Type:     domain.CheckTypeHTTP,
+			Target:   "https://example.com",
+			Interval: 45,
+			Timeout:  10,
+			Enabled:  true,
+		}
+	}
+
+	t.Run("unchanged interval is accepted", func(t *testing.T) {
+		useCase, mockCheckRepo, mockSchedulerRepo, _ := setupTestUseCase()
+		mockCheckRepo.On("GetByID", ctx, checkID).Return(existingCheck(), nil)
+		mockCheckRepo.On("Update", ctx, mock.AnythingOfType("*domain.Check")).Return(nil)
+		mockSchedulerRepo.On("RemoveCheck", ctx, checkID).Return(nil)
+		mockSchedulerRepo.On("AddCheck", ctx, mock.AnythingOfType("*domain.Check")).Return(nil)
+
+		updated := existingCheck()
+		updated.Name = "Renamed"
+		assert.NoError(t, useCase.UpdateCheck(ctx, checkID, updated))
+	})
+
+	t.Run("new uneven interval is rejected", func(t *testing.T) {
+		useCase, mockCheckRepo, _, _ := setupTestUseCase()
+		mockCheckRepo.On("GetByID", ctx, checkID).Return(existingCheck(), nil)
+
+		updated := existingCheck()
+		updated.Interval = 90
+		err := useCase.UpdateCheck(ctx, checkID, updated)
+
+		var validationErr *domain.ValidationError
+		require.True(t, errors.As(err, &validationErr), "expected ValidationError, got %v", err)
+		assert.Equal(t, domain.ReasonNotSchedulable, validationErr.Reason)
+		mockCheckRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
 func TestCheckUseCase_UpdateCheck_NotFound(t *testing.T) {
 	ctx := context.Background()
 	checkID := "non-existent-check"
@@ -323,8 +390,7 @@ func TestCheckUseCase_UpdateCheck_NotFound(t *testing.T) {
 		Target:   "https://example.com",
 		Interval: 60,
 		Timeout:  30,
-		Status:   domain.CheckStatusActive,
-		Priority: domain.PriorityNormal,
+		Enabled:  true,
 	}
 
 	useCase, mockCheckRepo, _, _ := setupTestUseCase()
@@ -354,8 +420,7 @@ func TestCheckUseCase_DeleteCheck_Success(t *testing.T) {
 		Target:    "https://example.com",
 		Interval:  60,
 		Timeout:   30,
-		Status:    domain.CheckStatusActive,
-		Priority:  domain.PriorityNormal,
+		Enabled:   true,
 		CreatedAt: time.Now().Add(-time.Hour),
 		UpdatedAt: time.Now().Add(-time.Hour),
 	}
@@ -414,11 +479,6 @@ func TestCheckUseCase_validateHTTPConfig(t *testing.T) {
 			config:  domain.CheckConfig{"method": "INVALID"},
 			wantErr: true,
 		},
-		{
-			name:    "invalid status code",
-			config:  domain.CheckConfig{"expected_status": float64(600)},
-			wantErr: true,
-		},
 		{
 			name:    "empty config",
 			config:  nil,
@@ -455,16 +515,6 @@ func TestCheckUseCase_validateTCPConfig(t *testing.T) {
 			config:  domain.CheckConfig{"port": float64(8080)},
 			wantErr: false,
 		},
-		{
-			name:    "invalid port too low",
-			config:  domain.CheckConfig{"port": float64(0)},
-			wantErr: true,
-		},
-		{
-			name:    "invalid port too high",
-			config:  domain.CheckConfig{"port": float64(70000)},
-			wantErr: true,
-		},
 		{
 			name:    "empty config",
 			config:  nil,
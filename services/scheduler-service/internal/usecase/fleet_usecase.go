@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// fleetCollectTimeout ограничивает чтение счетчиков при сборе метрик
+const fleetCollectTimeout = 5 * time.Second
+
+// FleetUseCase поддерживает сводку по парку проверок: число проверок по состояниям
+// для каждого tenant и в целом. Сводка обновляется при создании, изменении и удалении
+// проверок и при смене их здоровья, о которой сообщает Core Service
+type FleetUseCase struct {
+	repo   repository.FleetRepository
+	logger logger.Logger
+
+	tenantDesc *prometheus.Desc
+	globalDesc *prometheus.Desc
+}
+
+// NewFleetUseCase создает новый экземпляр FleetUseCase
+func NewFleetUseCase(repo repository.FleetRepository, logger logger.Logger) *FleetUseCase {
+	return &FleetUseCase{
+		repo:   repo,
+		logger: logger,
+		tenantDesc: prometheus.NewDesc("uptimeping_fleet_tenant_checks",
+			"Number of checks of the tenant by state", []string{"tenant_id", "state"}, nil),
+		globalDesc: prometheus.NewDesc("uptimeping_fleet_checks",
+			"Number of checks of all tenants by state", []string{"state"}, nil),
+	}
+}
+
+// Load загружает в сводку все проверки при запуске. Повторная загрузка не сбрасывает
+// здоровье уже известных включенных проверок
+func (uc *FleetUseCase) Load(ctx context.Context, checkRepo repository.CheckRepository) error {
+	entries, err := checkRepo.FleetEntries(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load fleet entries: %w", err)
+	}
+
+	for checkID, entry := range entries {
+		enabled := entry.Enabled
+		if err := uc.repo.Apply(ctx, domain.FleetChange{TenantID: entry.TenantID, CheckID: checkID, Enabled: &enabled}); err != nil {
+			return err
+		}
+	}
+
+	uc.logger.Info("Fleet summary loaded",
+		logger.Int("checks", len(entries)),
+	)
+	return nil
+}
+
+// CheckSaved учитывает созданную или измененную проверку
+func (uc *FleetUseCase) CheckSaved(ctx context.Context, check *domain.Check) {
+	enabled := check.Enabled
+	uc.apply(ctx, domain.FleetChange{TenantID: check.TenantID, CheckID: check.ID, Enabled: &enabled})
+}
+
+// CheckToggled учитывает включение или выключение проверки в обход CheckSaved
+func (uc *FleetUseCase) CheckToggled(ctx context.Context, tenantID, checkID string, enabled bool) {
+	uc.apply(ctx, domain.FleetChange{TenantID: tenantID, CheckID: checkID, Enabled: &enabled})
+}
+
+// CheckDeleted исключает удаленную проверку из сводки
+func (uc *FleetUseCase) CheckDeleted(ctx context.Context, check *domain.Check) {
+	uc.apply(ctx, domain.FleetChange{TenantID: check.TenantID, CheckID: check.ID, Deleted: true})
+}
+
+// ReportHealth учитывает смену здоровья проверки
+func (uc *FleetUseCase) ReportHealth(ctx context.Context, tenantID, checkID string, health domain.CheckHealth) error {
+	return uc.repo.Apply(ctx, domain.FleetChange{TenantID: tenantID, CheckID: checkID, Health: health})
+}
+
+// Summary возвращает счетчики tenant; пустой tenantID - счетчики всех tenant
+func (uc *FleetUseCase) Summary(ctx context.Context, tenantID string) (domain.FleetCounts, error) {
+	if tenantID != "" {
+		return uc.repo.Counts(ctx, tenantID)
+	}
+
+	all, err := uc.repo.AllCounts(ctx)
+	if err != nil {
+		return domain.FleetCounts{}, err
+	}
+	var total domain.FleetCounts
+	for _, counts := range all {
+		total.Merge(counts)
+	}
+	return total, nil
+}
+
+// apply применяет изменение проверки; ошибка сводки не прерывает операцию с проверкой
+func (uc *FleetUseCase) apply(ctx context.Context, change domain.FleetChange) {
+	if err := uc.repo.Apply(ctx, change); err != nil {
+		uc.logger.Warn("Failed to update fleet summary",
+			logger.CtxField(ctx),
+			logger.String("check_id", change.CheckID),
+			logger.String("tenant_id", change.TenantID),
+			logger.Error(err),
+		)
+	}
+}
+
+// Describe реализует prometheus.Collector
+func (uc *FleetUseCase) Describe(ch chan<- *prometheus.Desc) {
+	ch <- uc.tenantDesc
+	ch <- uc.globalDesc
+}
+
+// Collect реализует prometheus.Collector: отдает готовые счетчики, не обращаясь к таблице проверок
+func (uc *FleetUseCase) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), fleetCollectTimeout)
+	defer cancel()
+
+	all, err := uc.repo.AllCounts(ctx)
+	if err != nil {
+		uc.logger.Warn("Failed to collect fleet summary", logger.Error(err))
+		return
+	}
+
+	var total domain.FleetCounts
+	for tenantID, counts := range all {
+		total.Merge(counts)
+		for _, state := range domain.FleetStates {
+			ch <- prometheus.MustNewConstMetric(uc.tenantDesc, prometheus.GaugeValue, float64(counts.Get(state)), tenantID, string(state))
+		}
+	}
+	for _, state := range domain.FleetStates {
+		ch <- prometheus.MustNewConstMetric(uc.globalDesc, prometheus.GaugeValue, float64(total.Get(state)), string(state))
+	}
+}
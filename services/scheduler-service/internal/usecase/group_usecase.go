@@ -0,0 +1,276 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+	"github.com/google/uuid"
+)
+
+// GroupUseCase реализует бизнес-логику иерархических групп проверок
+type GroupUseCase struct {
+	groupRepo     repository.GroupRepository
+	checkRepo     repository.CheckRepository
+	schedulerRepo repository.SchedulerRepository
+	fleet         *FleetUseCase
+	logger        logger.Logger
+}
+
+// NewGroupUseCase создает новый экземпляр GroupUseCase
+func NewGroupUseCase(
+	groupRepo repository.GroupRepository,
+	checkRepo repository.CheckRepository,
+	schedulerRepo repository.SchedulerRepository,
+	logger logger.Logger,
+) *GroupUseCase {
+	return &GroupUseCase{
+		groupRepo:     groupRepo,
+		checkRepo:     checkRepo,
+		schedulerRepo: schedulerRepo,
+		logger:        logger,
+	}
+}
+
+// WithFleet подключает сводку по парку проверок, которая обновляется при включении и выключении групп
+func (uc *GroupUseCase) WithFleet(fleet *FleetUseCase) *GroupUseCase {
+	uc.fleet = fleet
+	return uc
+}
+
+// CreateGroup создает группу; пустой parentID создает корневую группу
+func (uc *GroupUseCase) CreateGroup(ctx context.Context, tenantID, parentID, name string) (*domain.Group, error) {
+	now := time.Now()
+	group := &domain.Group{
+		ID:        uuid.New().String(),
+		TenantID:  tenantID,
+		Name:      strings.TrimSpace(name),
+		Enabled:   true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	parent, err := uc.resolveParent(ctx, tenantID, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := group.AttachTo(parent); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	// Новая группа в выключенной группе тоже выключена
+	if parent != nil {
+		group.Enabled = parent.Enabled
+	}
+
+	if err := group.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := uc.groupRepo.Create(ctx, group); err != nil {
+		return nil, fmt.Errorf("failed to create group: %w", err)
+	}
+
+	uc.logger.Info("Group created",
+		logger.CtxField(ctx),
+		logger.String("group_id", group.ID),
+		logger.String("tenant_id", tenantID),
+		logger.String("path", group.Path),
+	)
+
+	return group, nil
+}
+
+// GetGroup возвращает группу по ID
+func (uc *GroupUseCase) GetGroup(ctx context.Context, groupID string) (*domain.Group, error) {
+	group, err := uc.groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+	return group, nil
+}
+
+// ListGroups возвращает группы tenant в порядке обхода дерева
+func (uc *GroupUseCase) ListGroups(ctx context.Context, tenantID string) ([]*domain.Group, error) {
+	groups, err := uc.groupRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	return groups, nil
+}
+
+// UpdateGroup переименовывает и/или перемещает группу вместе с поддеревом
+func (uc *GroupUseCase) UpdateGroup(ctx context.Context, groupID, name, parentID string) (*domain.Group, error) {
+	group, err := uc.groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	oldPath := group.Path
+	if name = strings.TrimSpace(name); name != "" {
+		group.Name = name
+	}
+
+	if parentID != group.ParentID {
+		parent, err := uc.resolveParent(ctx, group.TenantID, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if err := group.AttachTo(parent); err != nil {
+			return nil, fmt.Errorf("validation failed: %w", err)
+		}
+		if err := uc.validateSubtreeDepth(ctx, group, oldPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := group.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	group.UpdatedAt = time.Now()
+	if err := uc.groupRepo.Update(ctx, group, oldPath); err != nil {
+		return nil, fmt.Errorf("failed to update group: %w", err)
+	}
+
+	uc.logger.Info("Group updated",
+		logger.CtxField(ctx),
+		logger.String("group_id", group.ID),
+		logger.String("old_path", oldPath),
+		logger.String("path", group.Path),
+	)
+
+	return group, nil
+}
+
+// DeleteGroup удаляет пустую группу
+func (uc *GroupUseCase) DeleteGroup(ctx context.Context, groupID string) error {
+	if err := uc.groupRepo.Delete(ctx, groupID); err != nil {
+		return fmt.Errorf("failed to delete group: %w", err)
+	}
+
+	uc.logger.Info("Group deleted",
+		logger.CtxField(ctx),
+		logger.String("group_id", groupID),
+	)
+
+	return nil
+}
+
+// SetGroupEnabled включает или выключает все проверки поддерева группы
+// и синхронизирует их с планировщиком
+func (uc *GroupUseCase) SetGroupEnabled(ctx context.Context, groupID string, enabled bool) (int, error) {
+	group, err := uc.groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	checkIDs, err := uc.groupRepo.SetEnabled(ctx, group.TenantID, group.Path, enabled)
+	if err != nil {
+		return 0, fmt.Errorf("failed to toggle group: %w", err)
+	}
+
+	// Проверки обновлены в обход репозитория проверок, кэш определений устарел
+	if cache, ok := uc.checkRepo.(repository.CheckCache); ok {
+		if err := cache.Invalidate(ctx, checkIDs...); err != nil {
+			uc.logger.Warn("Failed to invalidate check cache after group toggle",
+				logger.CtxField(ctx),
+				logger.String("group_id", groupID),
+				logger.Error(err),
+			)
+		}
+	}
+
+	for _, checkID := range checkIDs {
+		if uc.fleet != nil {
+			uc.fleet.CheckToggled(ctx, group.TenantID, checkID, enabled)
+		}
+		if err := uc.syncScheduler(ctx, checkID, enabled); err != nil {
+			// БД уже обновлена - планировщик догонит состояние при следующей загрузке
+			uc.logger.Warn("Failed to sync check with scheduler after group toggle",
+				logger.CtxField(ctx),
+				logger.String("group_id", groupID),
+				logger.String("check_id", checkID),
+				logger.Error(err),
+			)
+		}
+	}
+
+	uc.logger.Info("Group toggled",
+		logger.CtxField(ctx),
+		logger.String("group_id", groupID),
+		logger.Bool("enabled", enabled),
+		logger.Int("affected_checks", len(checkIDs)),
+	)
+
+	return len(checkIDs), nil
+}
+
+// ResolveGroupPaths возвращает пути групп tenant по их ID для фильтрации по поддеревьям.
+// Удаленные и чужие группы пропускаются: права пользователя могут ссылаться на уже несуществующие группы
+func (uc *GroupUseCase) ResolveGroupPaths(ctx context.Context, tenantID string, groupIDs []string) ([]string, error) {
+	paths := make([]string, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		group, err := uc.groupRepo.GetByID(ctx, groupID)
+		if errors.Is(err, domain.ErrGroupNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group: %w", err)
+		}
+		if group.TenantID != tenantID {
+			continue
+		}
+		paths = append(paths, group.Path)
+	}
+	return paths, nil
+}
+
+// resolveParent загружает родительскую группу tenant; пустой parentID означает корень
+func (uc *GroupUseCase) resolveParent(ctx context.Context, tenantID, parentID string) (*domain.Group, error) {
+	if parentID == "" {
+		return nil, nil
+	}
+	parent, err := uc.groupRepo.GetByID(ctx, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent group: %w", err)
+	}
+	if parent.TenantID != tenantID {
+		return nil, fmt.Errorf("parent group: %w", domain.ErrGroupNotFound)
+	}
+	return parent, nil
+}
+
+// validateSubtreeDepth проверяет, что после перемещения самая глубокая подгруппа не превысит лимит
+func (uc *GroupUseCase) validateSubtreeDepth(ctx context.Context, group *domain.Group, oldPath string) error {
+	groups, err := uc.groupRepo.List(ctx, group.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	shift := group.Depth() - len(domain.GroupIDsFromPath(oldPath))
+	for _, g := range groups {
+		if strings.HasPrefix(g.Path, oldPath) && g.Depth()+shift > domain.MaxGroupDepth {
+			return fmt.Errorf("validation failed: group nesting must not exceed %d levels", domain.MaxGroupDepth)
+		}
+	}
+	return nil
+}
+
+// syncScheduler добавляет проверку в планировщик или удаляет из него
+func (uc *GroupUseCase) syncScheduler(ctx context.Context, checkID string, enabled bool) error {
+	if !enabled {
+		return uc.schedulerRepo.RemoveCheck(ctx, checkID)
+	}
+
+	check, err := uc.checkRepo.GetByID(ctx, checkID)
+	if err != nil {
+		return err
+	}
+	check.UpdateNextRun()
+	return uc.schedulerRepo.AddCheck(ctx, check)
+}
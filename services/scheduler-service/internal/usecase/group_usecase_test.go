@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// MockGroupRepository - мок для GroupRepository
+type MockGroupRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupRepository) Create(ctx context.Context, group *domain.Group) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetByID(ctx context.Context, id string) (*domain.Group, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Group), args.Error(1)
+}
+
+func (m *MockGroupRepository) List(ctx context.Context, tenantID string) ([]*domain.Group, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]*domain.Group), args.Error(1)
+}
+
+func (m *MockGroupRepository) Update(ctx context.Context, group *domain.Group, oldPath string) error {
+	args := m.Called(ctx, group, oldPath)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) SetEnabled(ctx context.Context, tenantID, path string, enabled bool) ([]string, error) {
+	args := m.Called(ctx, tenantID, path, enabled)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func setupTestGroupUseCase() (*GroupUseCase, *MockGroupRepository, *MockCheckRepository, *MockSchedulerRepository) {
+	_, mockCheckRepo, mockSchedulerRepo, mockLogger := setupTestUseCase()
+	mockGroupRepo := &MockGroupRepository{}
+	useCase := NewGroupUseCase(mockGroupRepo, mockCheckRepo, mockSchedulerRepo, mockLogger)
+	return useCase, mockGroupRepo, mockCheckRepo, mockSchedulerRepo
+}
+
+func testGroup() *domain.Group {
+	return &domain.Group{ID: "grp-root", TenantID: "tenant-123", Path: "/grp-root/", Enabled: true}
+}
+
+func TestGroupUseCase_SetGroupEnabled_Disable(t *testing.T) {
+	ctx := context.Background()
+	useCase, mockGroupRepo, mockCheckRepo, mockSchedulerRepo := setupTestGroupUseCase()
+
+	mockGroupRepo.On("GetByID", ctx, "grp-root").Return(testGroup(), nil)
+	mockGroupRepo.On("SetEnabled", ctx, "tenant-123", "/grp-root/", false).Return([]string{"check-1", "check-2"}, nil)
+	mockSchedulerRepo.On("RemoveCheck", ctx, "check-1").Return(nil)
+	mockSchedulerRepo.On("RemoveCheck", ctx, "check-2").Return(nil)
+
+	affected, err := useCase.SetGroupEnabled(ctx, "grp-root", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, affected)
+	mockGroupRepo.AssertExpectations(t)
+	mockSchedulerRepo.AssertExpectations(t)
+	// Выключенные проверки не перечитываются из репозитория
+	mockCheckRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestGroupUseCase_SetGroupEnabled_Enable(t *testing.T) {
+	ctx := context.Background()
+	useCase, mockGroupRepo, mockCheckRepo, mockSchedulerRepo := setupTestGroupUseCase()
+	check := &domain.Check{ID: "check-1", TenantID: "tenant-123", Enabled: true}
+
+	mockGroupRepo.On("GetByID", ctx, "grp-root").Return(testGroup(), nil)
+	mockGroupRepo.On("SetEnabled", ctx, "tenant-123", "/grp-root/", true).Return([]string{"check-1"}, nil)
+	mockCheckRepo.On("GetByID", ctx, "check-1").Return(check, nil)
+	mockSchedulerRepo.On("AddCheck", ctx, check).Return(nil)
+
+	affected, err := useCase.SetGroupEnabled(ctx, "grp-root", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, affected)
+	mockGroupRepo.AssertExpectations(t)
+	mockCheckRepo.AssertExpectations(t)
+	mockSchedulerRepo.AssertExpectations(t)
+}
+
+func TestGroupUseCase_SetGroupEnabled_SchedulerError(t *testing.T) {
+	ctx := context.Background()
+	useCase, mockGroupRepo, _, mockSchedulerRepo := setupTestGroupUseCase()
+
+	mockGroupRepo.On("GetByID", ctx, "grp-root").Return(testGroup(), nil)
+	mockGroupRepo.On("SetEnabled", ctx, "tenant-123", "/grp-root/", false).Return([]string{"check-1", "check-2"}, nil)
+	mockSchedulerRepo.On("RemoveCheck", ctx, "check-1").Return(assert.AnError)
+	mockSchedulerRepo.On("RemoveCheck", ctx, "check-2").Return(nil)
+
+	// База уже обновлена: ошибка планировщика не прерывает переключение остальных проверок
+	affected, err := useCase.SetGroupEnabled(ctx, "grp-root", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, affected)
+	mockSchedulerRepo.AssertExpectations(t)
+}
+
+func TestGroupUseCase_SetGroupEnabled_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("group not found", func(t *testing.T) {
+		useCase, mockGroupRepo, _, _ := setupTestGroupUseCase()
+		mockGroupRepo.On("GetByID", ctx, "grp-missing").Return(nil, domain.ErrGroupNotFound)
+
+		_, err := useCase.SetGroupEnabled(ctx, "grp-missing", false)
+
+		assert.ErrorIs(t, err, domain.ErrGroupNotFound)
+		mockGroupRepo.AssertNotCalled(t, "SetEnabled", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		useCase, mockGroupRepo, _, mockSchedulerRepo := setupTestGroupUseCase()
+		mockGroupRepo.On("GetByID", ctx, "grp-root").Return(testGroup(), nil)
+		mockGroupRepo.On("SetEnabled", ctx, "tenant-123", "/grp-root/", false).Return(nil, assert.AnError)
+
+		_, err := useCase.SetGroupEnabled(ctx, "grp-root", false)
+
+		assert.ErrorIs(t, err, assert.AnError)
+		mockSchedulerRepo.AssertNotCalled(t, "RemoveCheck", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCheckUseCase_ListChecksInGroups(t *testing.T) {
+	ctx := context.Background()
+	groupUseCase, mockGroupRepo, mockCheckRepo, _ := setupTestGroupUseCase()
+	useCase := NewCheckUseCase(mockCheckRepo, &MockSchedulerRepository{}, groupUseCase.logger).WithGroups(groupUseCase)
+	checks := []*domain.Check{{ID: "check-21"}, {ID: "check-22"}}
+
+	mockGroupRepo.On("GetByID", ctx, "grp-root").Return(testGroup(), nil)
+	// Чужие и удаленные группы из прав пользователя пропускаются
+	mockGroupRepo.On("GetByID", ctx, "grp-other").Return(&domain.Group{ID: "grp-other", TenantID: "tenant-456", Path: "/grp-other/"}, nil)
+	mockGroupRepo.On("GetByID", ctx, "grp-deleted").Return(nil, domain.ErrGroupNotFound)
+	mockCheckRepo.On("ListByGroups", ctx, "tenant-123", []string{"/grp-root/"}, 20, "20").Return(checks, nil)
+	mockCheckRepo.On("CountByGroups", ctx, "tenant-123", []string{"/grp-root/"}).Return(42, nil)
+
+	result, total, err := useCase.ListChecksInGroups(ctx, "tenant-123", []string{"grp-root", "grp-other", "grp-deleted"}, 20, "20")
+
+	require.NoError(t, err)
+	assert.Equal(t, checks, result)
+	assert.Equal(t, 42, total)
+	mockCheckRepo.AssertExpectations(t)
+}
+
+func TestCheckUseCase_ListChecksInGroups_NoAccessibleGroups(t *testing.T) {
+	ctx := context.Background()
+	groupUseCase, mockGroupRepo, mockCheckRepo, _ := setupTestGroupUseCase()
+	useCase := NewCheckUseCase(mockCheckRepo, &MockSchedulerRepository{}, groupUseCase.logger).WithGroups(groupUseCase)
+
+	mockGroupRepo.On("GetByID", ctx, "grp-deleted").Return(nil, domain.ErrGroupNotFound)
+
+	result, total, err := useCase.ListChecksInGroups(ctx, "tenant-123", []string{"grp-deleted"}, 20, "")
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	assert.Zero(t, total)
+	mockCheckRepo.AssertNotCalled(t, "ListByGroups", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -4,14 +4,16 @@ import (
 	"context"
 
 	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/scheduler-service/internal/mocks"
 	"UptimePingPlatform/services/scheduler-service/internal/repository"
 	"UptimePingPlatform/services/scheduler-service/internal/service"
 )
 
 // SchedulerUseCase предоставляет бизнес-логику для управления планировщиком
 type SchedulerUseCase struct {
-	scheduler *service.Scheduler
-	logger    logger.Logger
+	scheduler   *service.Scheduler
+	taskService *service.TaskService
+	logger      logger.Logger
 }
 
 // NewSchedulerUseCase создает новый экземпляр SchedulerUseCase
@@ -20,20 +22,28 @@ func NewSchedulerUseCase(
 	taskRepo repository.TaskRepository,
 	lockRepo repository.LockRepository,
 	schedulerRepo repository.SchedulerRepository,
+	producer mocks.ProducerInterface,
 	logger logger.Logger,
 ) *SchedulerUseCase {
 	// Создаем TaskService
-	taskService := service.NewTaskService(checkRepo, taskRepo, lockRepo, schedulerRepo, nil, logger)
+	taskService := service.NewTaskService(checkRepo, taskRepo, lockRepo, schedulerRepo, producer, logger)
 
 	// Создаем Scheduler
 	scheduler := service.NewScheduler(taskService, logger)
 
 	return &SchedulerUseCase{
-		scheduler: scheduler,
-		logger:    logger,
+		scheduler:   scheduler,
+		taskService: taskService,
+		logger:      logger,
 	}
 }
 
+// WithTenants приостанавливает запуски проверок заблокированных tenant
+func (uc *SchedulerUseCase) WithTenants(tenants repository.TenantRepository) *SchedulerUseCase {
+	uc.taskService.WithTenants(tenants)
+	return uc
+}
+
 // Start запускает планировщик
 func (uc *SchedulerUseCase) Start(ctx context.Context) error {
 	uc.logger.Info("Starting scheduler use case", logger.CtxField(ctx))
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// SelfMonitoringUseCase поддерживает проверки компонентов платформы в мета-tenant
+// самомониторинга. Проверки выполняются обычным конвейером, поэтому сбои платформы
+// попадают в историю, инциденты и уведомления так же, как сбои проверок клиентов
+type SelfMonitoringUseCase struct {
+	checkRepo     repository.CheckRepository
+	schedulerRepo repository.SchedulerRepository
+	logger        logger.Logger
+}
+
+// NewSelfMonitoringUseCase создает новый экземпляр SelfMonitoringUseCase
+func NewSelfMonitoringUseCase(checkRepo repository.CheckRepository, schedulerRepo repository.SchedulerRepository, logger logger.Logger) *SelfMonitoringUseCase {
+	return &SelfMonitoringUseCase{
+		checkRepo:     checkRepo,
+		schedulerRepo: schedulerRepo,
+		logger:        logger,
+	}
+}
+
+// Bootstrap приводит проверки tenant самомониторинга к списку компонентов: создает
+// недостающие, обновляет измененные и выключает проверки убранных компонентов.
+// Вызывается при каждом запуске планировщика; интервал и таймаут в секундах
+func (uc *SelfMonitoringUseCase) Bootstrap(ctx context.Context, tenantID string, components []domain.PlatformComponent, interval, timeout int) error {
+	desired := make([]*domain.Check, 0, len(components))
+	for _, component := range components {
+		check, err := domain.NewSelfMonitoringCheck(tenantID, component, interval, timeout)
+		if err != nil {
+			return err
+		}
+		desired = append(desired, check)
+	}
+
+	existing, err := uc.checkRepo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to list platform checks: %w", err)
+	}
+
+	plan := domain.PlanSelfMonitoring(existing, desired, time.Now())
+	for _, check := range plan.Create {
+		check.UpdateNextRun()
+		if err := uc.checkRepo.Create(ctx, check); err != nil {
+			return fmt.Errorf("failed to create platform check %s: %w", check.Name, err)
+		}
+		if err := uc.schedulerRepo.AddCheck(ctx, check); err != nil {
+			return fmt.Errorf("failed to schedule platform check %s: %w", check.Name, err)
+		}
+	}
+	for _, check := range plan.Update {
+		if err := uc.checkRepo.Update(ctx, check); err != nil {
+			return fmt.Errorf("failed to update platform check %s: %w", check.Name, err)
+		}
+		if err := uc.schedulerRepo.UpdateCheck(ctx, check); err != nil {
+			return fmt.Errorf("failed to reschedule platform check %s: %w", check.Name, err)
+		}
+	}
+	for _, check := range plan.Disable {
+		if err := uc.checkRepo.Update(ctx, check); err != nil {
+			return fmt.Errorf("failed to disable platform check %s: %w", check.Name, err)
+		}
+		if err := uc.schedulerRepo.RemoveCheck(ctx, check.ID); err != nil {
+			return fmt.Errorf("failed to unschedule platform check %s: %w", check.Name, err)
+		}
+	}
+
+	uc.logger.Info("Platform self-monitoring checks bootstrapped",
+		logger.CtxField(ctx),
+		logger.String("tenant_id", tenantID),
+		logger.Int("components", len(components)),
+		logger.Int("created", len(plan.Create)),
+		logger.Int("updated", len(plan.Update)),
+		logger.Int("disabled", len(plan.Disable)),
+	)
+	return nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/repository"
+)
+
+// TagUseCase реализует бизнес-логику управления тегами проверок
+type TagUseCase struct {
+	tagRepo          repository.TagRepository
+	maxTagsPerTenant int
+	logger           logger.Logger
+}
+
+// NewTagUseCase создает новый экземпляр TagUseCase
+func NewTagUseCase(tagRepo repository.TagRepository, logger logger.Logger) *TagUseCase {
+	return &TagUseCase{
+		tagRepo:          tagRepo,
+		maxTagsPerTenant: domain.MaxTagsPerTenant,
+		logger:           logger,
+	}
+}
+
+// ListTags возвращает теги tenant с количеством использований
+func (uc *TagUseCase) ListTags(ctx context.Context, tenantID string) ([]*domain.Tag, error) {
+	tags, err := uc.tagRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// EnsureTags регистрирует теги проверки, соблюдая лимит тегов tenant. Лимит проверяет
+// репозиторий атомарно с регистрацией
+func (uc *TagUseCase) EnsureTags(ctx context.Context, tenantID string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	if err := uc.tagRepo.Register(ctx, tenantID, names, uc.maxTagsPerTenant); err != nil {
+		if errors.Is(err, domain.ErrTagLimitExceeded) {
+			return fmt.Errorf("%w: tenant may have at most %d tags", domain.ErrTagLimitExceeded, uc.maxTagsPerTenant)
+		}
+		return fmt.Errorf("failed to register tags: %w", err)
+	}
+
+	return nil
+}
+
+// RenameTag переименовывает тег во всех проверках tenant
+func (uc *TagUseCase) RenameTag(ctx context.Context, tenantID, oldName, newName string) (int, error) {
+	oldName = domain.NormalizeTag(oldName)
+	newName = domain.NormalizeTag(newName)
+
+	if err := domain.ValidateTag(oldName); err != nil {
+		return 0, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := domain.ValidateTag(newName); err != nil {
+		return 0, fmt.Errorf("validation failed: %w", err)
+	}
+	if oldName == newName {
+		return 0, fmt.Errorf("validation failed: new tag name must differ from the current one")
+	}
+
+	affected, err := uc.tagRepo.Rename(ctx, tenantID, oldName, newName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	uc.logger.Info("Tag renamed",
+		logger.CtxField(ctx),
+		logger.String("tenant_id", tenantID),
+		logger.String("old_name", oldName),
+		logger.String("new_name", newName),
+		logger.Int("affected_checks", affected),
+	)
+
+	return affected, nil
+}
+
+// DeleteTag удаляет тег, если он не используется проверками
+func (uc *TagUseCase) DeleteTag(ctx context.Context, tenantID, name string) error {
+	name = domain.NormalizeTag(name)
+	if err := domain.ValidateTag(name); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := uc.tagRepo.Delete(ctx, tenantID, name); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	uc.logger.Info("Tag deleted",
+		logger.CtxField(ctx),
+		logger.String("tenant_id", tenantID),
+		logger.String("name", name),
+	)
+
+	return nil
+}
+
+// DeleteUnusedTags удаляет все неиспользуемые теги tenant
+func (uc *TagUseCase) DeleteUnusedTags(ctx context.Context, tenantID string) (int, error) {
+	deleted, err := uc.tagRepo.DeleteUnused(ctx, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete unused tags: %w", err)
+	}
+
+	uc.logger.Info("Unused tags deleted",
+		logger.CtxField(ctx),
+		logger.String("tenant_id", tenantID),
+		logger.Int("deleted", deleted),
+	)
+
+	return deleted, nil
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+)
+
+// MockTagRepository - мок для TagRepository
+type MockTagRepository struct {
+	mock.Mock
+}
+
+func (m *MockTagRepository) List(ctx context.Context, tenantID string) ([]*domain.Tag, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Tag), args.Error(1)
+}
+
+func (m *MockTagRepository) Register(ctx context.Context, tenantID string, names []string, limit int) error {
+	args := m.Called(ctx, tenantID, names, limit)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) Rename(ctx context.Context, tenantID, oldName, newName string) (int, error) {
+	args := m.Called(ctx, tenantID, oldName, newName)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTagRepository) Delete(ctx context.Context, tenantID, name string) error {
+	args := m.Called(ctx, tenantID, name)
+	return args.Error(0)
+}
+
+func (m *MockTagRepository) DeleteUnused(ctx context.Context, tenantID string) (int, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Int(0), args.Error(1)
+}
+
+func setupTestTagUseCase() (*TagUseCase, *MockTagRepository) {
+	_, _, _, mockLogger := setupTestUseCase()
+	mockTagRepo := &MockTagRepository{}
+	return NewTagUseCase(mockTagRepo, mockLogger), mockTagRepo
+}
+
+func TestTagUseCase_EnsureTags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("registers with tenant limit", func(t *testing.T) {
+		useCase, mockTagRepo := setupTestTagUseCase()
+		mockTagRepo.On("Register", ctx, "tenant-123", []string{"api", "prod"}, domain.MaxTagsPerTenant).Return(nil)
+
+		require.NoError(t, useCase.EnsureTags(ctx, "tenant-123", []string{"api", "prod"}))
+		mockTagRepo.AssertExpectations(t)
+	})
+
+	t.Run("limit exceeded", func(t *testing.T) {
+		useCase, mockTagRepo := setupTestTagUseCase()
+		mockTagRepo.On("Register", ctx, "tenant-123", []string{"api"}, domain.MaxTagsPerTenant).Return(domain.ErrTagLimitExceeded)
+
+		err := useCase.EnsureTags(ctx, "tenant-123", []string{"api"})
+
+		assert.ErrorIs(t, err, domain.ErrTagLimitExceeded)
+		assert.Contains(t, err.Error(), "at most 200 tags")
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		useCase, mockTagRepo := setupTestTagUseCase()
+
+		require.NoError(t, useCase.EnsureTags(ctx, "tenant-123", nil))
+		mockTagRepo.AssertNotCalled(t, "Register", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTagUseCase_RenameTag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("normalizes names", func(t *testing.T) {
+		useCase, mockTagRepo := setupTestTagUseCase()
+		mockTagRepo.On("Rename", ctx, "tenant-123", "prod", "production").Return(3, nil)
+
+		affected, err := useCase.RenameTag(ctx, "tenant-123", " Prod ", "PRODUCTION")
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, affected)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		useCase, mockTagRepo := setupTestTagUseCase()
+		mockTagRepo.On("Rename", ctx, "tenant-123", "missing", "other").Return(0, domain.ErrTagNotFound)
+
+		_, err := useCase.RenameTag(ctx, "tenant-123", "missing", "other")
+
+		assert.ErrorIs(t, err, domain.ErrTagNotFound)
+	})
+
+	t.Run("same name", func(t *testing.T) {
+		useCase, mockTagRepo := setupTestTagUseCase()
+
+		_, err := useCase.RenameTag(ctx, "tenant-123", "prod", "Prod")
+
+		assert.Error(t, err)
+		mockTagRepo.AssertNotCalled(t, "Rename", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTagUseCase_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+
+	for _, repoErr := range []error{domain.ErrTagInUse, domain.ErrTagNotFound} {
+		useCase, mockTagRepo := setupTestTagUseCase()
+		mockTagRepo.On("Delete", ctx, "tenant-123", "prod").Return(repoErr)
+
+		assert.ErrorIs(t, useCase.DeleteTag(ctx, "tenant-123", "prod"), repoErr)
+	}
+}
+
+func TestTagUseCase_DeleteUnusedTags(t *testing.T) {
+	ctx := context.Background()
+	useCase, mockTagRepo := setupTestTagUseCase()
+	mockTagRepo.On("DeleteUnused", ctx, "tenant-123").Return(4, nil)
+
+	deleted, err := useCase.DeleteUnusedTags(ctx, "tenant-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, deleted)
+}
@@ -0,0 +1,265 @@
+// Package standalone запускает планировщик в одном процессе с остальными сервисами платформы.
+// Проверки хранятся в памяти и задаются конфигурацией при запуске, задачи публикуются во
+// встроенную очередь pkg/queue в формате сообщений очереди check_tasks
+package standalone
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/producer"
+	"UptimePingPlatform/services/scheduler-service/internal/repository/memory"
+	"UptimePingPlatform/services/scheduler-service/internal/service"
+)
+
+// TopicTasks тема очереди с задачами проверок
+const TopicTasks = "check_tasks"
+
+// DefaultTenantID tenant проверок, для которых он не указан. Сервисы платформы принимают
+// только идентификаторы в формате UUID
+const DefaultTenantID = "00000000-0000-0000-0000-000000000001"
+
+// defaultTimeout таймаут проверок, для которых он не указан; для коротких интервалов -
+// половина интервала
+const defaultTimeout = 10 * time.Second
+
+// Publisher публикует сообщения во встроенную очередь
+type Publisher interface {
+	Publish(ctx context.Context, msg queue.Message) error
+}
+
+// Check проверка из конфигурации
+type Check struct {
+	ID       string                 `yaml:"id" json:"id"`
+	TenantID string                 `yaml:"tenant_id" json:"tenant_id"`
+	Name     string                 `yaml:"name" json:"name"`
+	Type     string                 `yaml:"type" json:"type"`
+	Target   string                 `yaml:"target" json:"target"`
+	Interval time.Duration          `yaml:"interval" json:"interval"`
+	Timeout  time.Duration          `yaml:"timeout" json:"timeout"`
+	Config   map[string]interface{} `yaml:"config" json:"config,omitempty"`
+	Tags     []string               `yaml:"tags" json:"tags,omitempty"`
+	Owner    string                 `yaml:"owner" json:"owner,omitempty"`
+	Team     string                 `yaml:"team" json:"team,omitempty"`
+	// Paused выключает проверку без удаления из конфигурации
+	Paused bool `yaml:"paused" json:"paused,omitempty"`
+}
+
+// CheckInfo проверка и время ее запусков
+type CheckInfo struct {
+	Check
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+}
+
+// Scheduler планировщик проверок в памяти процесса
+type Scheduler struct {
+	checks    *memory.CheckRepository
+	schedules *memory.SchedulerRepository
+	locks     *memory.LockRepository
+	tasks     *service.TaskService
+	clock     clock.Clock
+}
+
+// NewScheduler создает планировщик, публикующий задачи в publisher
+func NewScheduler(publisher Publisher, log logger.Logger) *Scheduler {
+	checks := memory.NewCheckRepository()
+	schedules := memory.NewSchedulerRepository()
+	locks := memory.NewLockRepository()
+	tasks := service.NewTaskService(
+		checks,
+		memory.NewTaskRepository(0),
+		locks,
+		schedules,
+		&taskProducer{publisher: publisher},
+		log,
+	)
+	return &Scheduler{checks: checks, schedules: schedules, locks: locks, tasks: tasks, clock: clock.Real()}
+}
+
+// WithClock задает источник времени запусков и блокировок вместо системных часов.
+// Вызывается до AddCheck
+func (s *Scheduler) WithClock(c clock.Clock) *Scheduler {
+	s.clock = c
+	s.locks.WithClock(c)
+	s.tasks.WithClock(c)
+	return s
+}
+
+// AddCheck проверяет и добавляет проверку. Вызывается до Start. Проверка без ID получает
+// идентификатор по tenant и имени, чтобы он не менялся между перезапусками
+func (s *Scheduler) AddCheck(ctx context.Context, check Check) error {
+	if check.TenantID == "" {
+		check.TenantID = DefaultTenantID
+	}
+	if check.ID == "" {
+		check.ID = uuid.NewSHA1(uuid.NameSpaceURL, []byte(check.TenantID+"/"+check.Name)).String()
+	}
+	if _, err := uuid.Parse(check.TenantID); err != nil {
+		return fmt.Errorf("check %q: tenant_id must be a UUID", check.Name)
+	}
+	if _, err := uuid.Parse(check.ID); err != nil {
+		return fmt.Errorf("check %q: id must be a UUID", check.Name)
+	}
+	if check.Interval == 0 && domain.CheckType(check.Type) == domain.CheckTypeDomain {
+		check.Interval = domain.DefaultDomainCheckInterval * time.Second
+	}
+	if check.Timeout == 0 {
+		check.Timeout = min(defaultTimeout, (check.Interval / 2).Truncate(time.Second))
+	}
+	if check.Interval%time.Second != 0 || check.Timeout%time.Second != 0 {
+		return fmt.Errorf("check %q: interval and timeout must be whole seconds", check.Name)
+	}
+
+	now := s.clock.Now()
+	model := &domain.Check{
+		ID:        check.ID,
+		TenantID:  check.TenantID,
+		Name:      check.Name,
+		Type:      domain.CheckType(check.Type),
+		Target:    check.Target,
+		Interval:  int(check.Interval / time.Second),
+		Timeout:   int(check.Timeout / time.Second),
+		Enabled:   !check.Paused,
+		Config:    domain.CheckConfig(check.Config),
+		Owner:     check.Owner,
+		Team:      check.Team,
+		Tags:      check.Tags,
+		CreatedAt: now,
+		UpdatedAt: now,
+		NextRunAt: &now,
+	}
+	if err := model.Validate(); err != nil {
+		return fmt.Errorf("check %q: %w", check.Name, err)
+	}
+	if _, err := domain.IntervalCronExpression(model.Interval); err != nil {
+		return fmt.Errorf("check %q: %w", check.Name, err)
+	}
+
+	if err := s.checks.Create(ctx, model); err != nil {
+		return fmt.Errorf("check %q: %w", check.Name, err)
+	}
+	return s.schedules.AddCheck(ctx, model)
+}
+
+// Start планирует запуски включенных проверок
+func (s *Scheduler) Start(ctx context.Context) error {
+	if err := s.tasks.LoadActiveChecksOnStartup(ctx); err != nil {
+		return err
+	}
+	s.tasks.Start()
+	return nil
+}
+
+// Stop останавливает планирование, уже опубликованные задачи остаются в очереди
+func (s *Scheduler) Stop() {
+	s.tasks.Stop()
+}
+
+// CheckEnabled сообщает, включена ли проверка; ErrNotFound, если проверки нет
+func (s *Scheduler) CheckEnabled(ctx context.Context, checkID string) (bool, error) {
+	check, err := s.checks.GetByID(ctx, checkID)
+	if err != nil {
+		return false, err
+	}
+	return check.Enabled, nil
+}
+
+// Definition проверка с версией ее конфигурации, по которой исполнитель собирает задачи
+type Definition struct {
+	Check
+	ConfigVersion int64
+}
+
+// Definition возвращает проверку для сборки ее задач; ErrNotFound, если проверки нет
+func (s *Scheduler) Definition(ctx context.Context, checkID string) (*Definition, error) {
+	check, err := s.checks.GetByID(ctx, checkID)
+	if err != nil {
+		return nil, err
+	}
+	return &Definition{
+		Check: Check{
+			ID:       check.ID,
+			TenantID: check.TenantID,
+			Name:     check.Name,
+			Type:     string(check.Type),
+			Target:   check.Target,
+			Interval: check.GetIntervalDuration(),
+			Timeout:  check.GetTimeoutDuration(),
+			Config:   check.Config,
+			Tags:     check.Tags,
+			Owner:    check.Owner,
+			Team:     check.Team,
+			Paused:   !check.Enabled,
+		},
+		ConfigVersion: check.ConfigVersion,
+	}, nil
+}
+
+// Checks возвращает проверки, отсортированные по имени
+func (s *Scheduler) Checks(ctx context.Context) ([]CheckInfo, error) {
+	checks, err := s.schedules.GetScheduledChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]CheckInfo, 0, len(checks))
+	for _, check := range checks {
+		infos = append(infos, CheckInfo{
+			Check: Check{
+				ID:       check.ID,
+				TenantID: check.TenantID,
+				Name:     check.Name,
+				Type:     string(check.Type),
+				Target:   check.Target,
+				Interval: check.GetIntervalDuration(),
+				Timeout:  check.GetTimeoutDuration(),
+				Config:   check.Config,
+				Tags:     check.Tags,
+				Owner:    check.Owner,
+				Team:     check.Team,
+				Paused:   !check.Enabled,
+			},
+			LastRunAt: check.LastRunAt,
+			NextRunAt: check.NextRunAt,
+		})
+	}
+	sort.SliceStable(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
+// taskProducer публикует задачи планировщика в очередь
+type taskProducer struct {
+	publisher Publisher
+}
+
+// PublishTask публикует задачу в тему check_tasks с заголовком tenant, как и продюсер RabbitMQ
+func (p *taskProducer) PublishTask(ctx context.Context, task *domain.Task) error {
+	body, err := producer.EncodeTask(task)
+	if err != nil {
+		return err
+	}
+
+	return p.publisher.Publish(ctx, queue.Message{
+		Topic:       TopicTasks,
+		Key:         task.CheckID,
+		ContentType: "application/json",
+		Headers:     map[string]interface{}{rabbitmq.HeaderTenantID: task.TenantID},
+		Body:        body,
+		Priority:    task.Priority.MessagePriority(),
+	})
+}
+
+// Close ничего не делает: очередью владеет вызывающий код
+func (p *taskProducer) Close() error {
+	return nil
+}
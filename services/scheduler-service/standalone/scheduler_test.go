@@ -0,0 +1,200 @@
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"UptimePingPlatform/pkg/clock"
+	"UptimePingPlatform/pkg/logger"
+	"UptimePingPlatform/pkg/queue"
+	"UptimePingPlatform/pkg/rabbitmq"
+	"UptimePingPlatform/services/scheduler-service/internal/domain"
+	"UptimePingPlatform/services/scheduler-service/internal/producer"
+)
+
+// recordingPublisher запоминает опубликованные сообщения
+type recordingPublisher struct {
+	mu       sync.Mutex
+	messages []queue.Message
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, msg queue.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func newTestScheduler(t *testing.T) (*Scheduler, *recordingPublisher) {
+	t.Helper()
+	log, err := logger.NewLogger("test", "error", "scheduler-service", false)
+	require.NoError(t, err)
+	publisher := &recordingPublisher{}
+	return NewScheduler(publisher, log), publisher
+}
+
+func TestScheduler_AddCheckDefaults(t *testing.T) {
+	scheduler, _ := newTestScheduler(t)
+	ctx := context.Background()
+
+	require.NoError(t, scheduler.AddCheck(ctx, Check{Name: "site", Type: "http", Target: "https://example.com", Interval: time.Minute}))
+	require.NoError(t, scheduler.AddCheck(ctx, Check{Name: "fast", Type: "http", Target: "https://example.com/health", Interval: 6 * time.Second}))
+
+	checks, err := scheduler.Checks(ctx)
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, "fast", checks[0].Name)
+	assert.Equal(t, 3*time.Second, checks[0].Timeout)
+	assert.Equal(t, DefaultTenantID, checks[1].TenantID)
+	assert.Equal(t, 10*time.Second, checks[1].Timeout)
+
+	// Идентификатор не меняется между перезапусками
+	again, _ := newTestScheduler(t)
+	require.NoError(t, again.AddCheck(ctx, Check{Name: "site", Type: "http", Target: "https://example.com", Interval: time.Minute}))
+	restarted, err := again.Checks(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, checks[1].ID, restarted[0].ID)
+}
+
+func TestScheduler_AddCheckValidation(t *testing.T) {
+	scheduler, _ := newTestScheduler(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		check Check
+	}{
+		{"fractional interval", Check{Name: "a", Type: "http", Target: "https://example.com", Interval: 1500 * time.Millisecond}},
+		{"tenant is not a UUID", Check{Name: "b", TenantID: "acme", Type: "http", Target: "https://example.com", Interval: time.Minute}},
+		{"unknown type", Check{Name: "c", Type: "ftp", Target: "ftp://example.com", Interval: time.Minute}},
+		{"interval too short", Check{Name: "d", Type: "http", Target: "https://example.com", Interval: time.Second}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, scheduler.AddCheck(ctx, tt.check))
+		})
+	}
+
+	require.NoError(t, scheduler.AddCheck(ctx, Check{Name: "site", Type: "http", Target: "https://example.com", Interval: time.Minute}))
+	assert.Error(t, scheduler.AddCheck(ctx, Check{Name: "site", Type: "http", Target: "https://example.org", Interval: time.Minute}))
+}
+
+func TestTaskProducer_PublishTask(t *testing.T) {
+	scheduler, publisher := newTestScheduler(t)
+	ctx := context.Background()
+	require.NoError(t, scheduler.AddCheck(ctx, Check{
+		Name:     "site",
+		Type:     "https",
+		Target:   "https://example.com",
+		Interval: time.Minute,
+		Config:   map[string]interface{}{"expected_status": 204},
+	}))
+	checks, err := scheduler.Checks(ctx)
+	require.NoError(t, err)
+
+	tasks := &taskProducer{publisher: publisher}
+	task := domain.NewTask(checks[0].ID, DefaultTenantID, domain.PriorityCritical)
+	task.ConfigVersion = domain.InitialConfigVersion
+	require.NoError(t, tasks.PublishTask(ctx, task))
+
+	require.Len(t, publisher.messages, 1)
+	msg := publisher.messages[0]
+	assert.Equal(t, TopicTasks, msg.Topic)
+	assert.Equal(t, domain.PriorityCritical.MessagePriority(), msg.Priority)
+	assert.Equal(t, DefaultTenantID, msg.Headers[rabbitmq.HeaderTenantID])
+
+	// Тип, цель и конфигурацию исполнитель берет из проверки по версии
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(msg.Body, &body))
+	assert.Equal(t, task.ID, body["execution_id"])
+	assert.Equal(t, checks[0].ID, body["check_id"])
+	assert.Equal(t, float64(domain.InitialConfigVersion), body["config_version"])
+	assert.NotContains(t, body, "target")
+	assert.NotContains(t, body, "config")
+	assert.NotContains(t, body, "overrides")
+
+	definition, err := scheduler.Definition(ctx, checks[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "https", definition.Type)
+	assert.Equal(t, "https://example.com", definition.Target)
+	assert.Equal(t, 10*time.Second, definition.Timeout)
+	assert.Equal(t, map[string]interface{}{"expected_status": 204}, definition.Config)
+	assert.Equal(t, int64(domain.InitialConfigVersion), definition.ConfigVersion)
+
+	_, err = scheduler.Definition(ctx, "00000000-0000-0000-0000-000000000002")
+	assert.Error(t, err)
+}
+
+func TestScheduler_TimeTravel(t *testing.T) {
+	scheduler, publisher := newTestScheduler(t)
+	clk := clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+	scheduler.WithClock(clk)
+	ctx := context.Background()
+	require.NoError(t, scheduler.AddCheck(ctx, Check{
+		Name:     "site",
+		Type:     "http",
+		Target:   "https://example.com",
+		Interval: time.Minute,
+	}))
+	checks, err := scheduler.Checks(ctx)
+	require.NoError(t, err)
+	checkID := checks[0].ID
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, scheduler.tasks.ExecuteCronTask(ctx, checkID))
+
+		require.Len(t, publisher.messages, i+1)
+		var body producer.TaskMessage
+		require.NoError(t, json.Unmarshal(publisher.messages[i].Body, &body))
+		assert.True(t, clk.Now().Equal(body.ScheduledAt), "run %d", i)
+		assert.Equal(t, int64(i+1), body.FencingToken, "run %d", i)
+		assert.Equal(t, int64(domain.InitialConfigVersion), body.ConfigVersion, "run %d", i)
+
+		checks, err = scheduler.Checks(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, checks[0].LastRunAt)
+		assert.True(t, clk.Now().Equal(*checks[0].LastRunAt), "run %d", i)
+
+		clk.Advance(time.Minute)
+	}
+}
+
+// BenchmarkTaskProducer_PublishTask измеряет сборку задачи check_tasks; wire_bytes - размер
+// тела задачи в очереди
+func BenchmarkTaskProducer_PublishTask(b *testing.B) {
+	log, err := logger.NewLogger("test", "error", "scheduler-service", false)
+	require.NoError(b, err)
+	publisher := &recordingPublisher{}
+	scheduler := NewScheduler(publisher, log)
+	ctx := context.Background()
+	require.NoError(b, scheduler.AddCheck(ctx, Check{
+		Name:     "site",
+		Type:     "https",
+		Target:   "https://example.com",
+		Interval: time.Minute,
+		Config:   map[string]interface{}{"expected_status": 200},
+		Owner:    "payments",
+		Team:     "sre",
+	}))
+	checks, err := scheduler.Checks(ctx)
+	require.NoError(b, err)
+
+	tasks := &taskProducer{publisher: publisher}
+	task := domain.NewTask(checks[0].ID, DefaultTenantID, domain.PriorityNormal)
+	task.ConfigVersion = domain.InitialConfigVersion
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		publisher.messages = publisher.messages[:0]
+		if err := tasks.PublishTask(ctx, task); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(len(publisher.messages[0].Body)), "wire_bytes")
+}